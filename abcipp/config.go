@@ -1,10 +1,15 @@
 package abcipp
 
 import (
+	"fmt"
+	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/spf13/cast"
 
+	"cosmossdk.io/math"
+
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 )
 
@@ -12,6 +17,21 @@ const (
 	FlagMaxQueuedPerSender = "abcipp.max-queued-per-sender"
 	FlagMaxQueuedTotal     = "abcipp.max-queued-total"
 	FlagQueuedGapTTL       = "abcipp.queued-gap-ttl"
+	FlagJournalEnabled     = "abcipp.journal-enabled"
+	FlagJournalPath        = "abcipp.journal-path"
+
+	FlagReplacementPriorityBump = "abcipp.replacement-priority-bump"
+	FlagReportProposalOrdering  = "abcipp.report-proposal-ordering"
+	FlagStrictProcessProposal   = "abcipp.strict-process-proposal"
+	FlagSnapshotQueryEnabled    = "abcipp.snapshot-query-enabled"
+	FlagTiers                   = "abcipp.tiers"
+
+	FlagReputationEnabled             = "abcipp.reputation-enabled"
+	FlagReputationHalfLife            = "abcipp.reputation-half-life"
+	FlagReputationThrottleScore       = "abcipp.reputation-throttle-score"
+	FlagReputationBanScore            = "abcipp.reputation-ban-score"
+	FlagReputationBanDuration         = "abcipp.reputation-ban-duration"
+	FlagReputationThrottledMaxPending = "abcipp.reputation-throttled-max-pending"
 )
 
 const (
@@ -22,6 +42,13 @@ const (
 	// DefaultQueuedGapTTL is the default max time to keep sender queued txs when
 	// the sender has no active tx and is missing the head nonce.
 	DefaultQueuedGapTTL = 60 * time.Second
+	// DefaultReplacementPriorityBump is the default minimum priority increase,
+	// in percent, required to replace a tx with the same (sender, nonce). Zero
+	// keeps the rule that a replacement only needs a strictly higher priority.
+	DefaultReplacementPriorityBump = 0
+	// DefaultJournalPath is the default mempool journal location, relative to
+	// the node home directory.
+	DefaultJournalPath = "data/abcipp_mempool.journal"
 )
 
 // AppConfig is the node-local configuration for abcipp mempool behavior.
@@ -29,6 +56,126 @@ type AppConfig struct {
 	MaxQueuedPerSender int           `mapstructure:"max-queued-per-sender"`
 	MaxQueuedTotal     int           `mapstructure:"max-queued-total"`
 	QueuedGapTTL       time.Duration `mapstructure:"queued-gap-ttl"`
+	JournalEnabled     bool          `mapstructure:"journal-enabled"`
+	JournalPath        string        `mapstructure:"journal-path"`
+
+	ReplacementPriorityBump int  `mapstructure:"replacement-priority-bump"`
+	ReportProposalOrdering  bool `mapstructure:"report-proposal-ordering"`
+	StrictProcessProposal   bool `mapstructure:"strict-process-proposal"`
+	SnapshotQueryEnabled    bool `mapstructure:"snapshot-query-enabled"`
+
+	ReputationEnabled             bool          `mapstructure:"reputation-enabled"`
+	ReputationHalfLife            time.Duration `mapstructure:"reputation-half-life"`
+	ReputationThrottleScore       float64       `mapstructure:"reputation-throttle-score"`
+	ReputationBanScore            float64       `mapstructure:"reputation-ban-score"`
+	ReputationBanDuration         time.Duration `mapstructure:"reputation-ban-duration"`
+	ReputationThrottledMaxPending int           `mapstructure:"reputation-throttled-max-pending"`
+
+	// Tiers declares priority tiers in match order. Unmatched txs fall into
+	// the default tier.
+	Tiers []TierConfig `mapstructure:"tiers"`
+}
+
+// TierConfig declares a priority tier from app.toml. A tx matches the tier when
+// its first signer is listed in Senders (if set) and every message matches one
+// of MsgTypeURLs, MoveFunctions or IBCRelayer (if any of them is set).
+type TierConfig struct {
+	Name string `mapstructure:"name"`
+	// MsgTypeURLs lists message type URLs, e.g. "/cosmos.bank.v1beta1.MsgSend".
+	MsgTypeURLs []string `mapstructure:"msg-type-urls"`
+	// Senders lists first signer addresses, in bech32 or hex.
+	Senders []string `mapstructure:"senders"`
+	// MoveFunctions lists MsgExecute targets as "<address>::<module>" or
+	// "<address>::<module>::<function>".
+	MoveFunctions []string `mapstructure:"move-functions"`
+	// IBCRelayer matches IBC client update, packet, acknowledgement and timeout messages.
+	IBCRelayer bool `mapstructure:"ibc-relayer"`
+	// MaxBlockShare is the maximum fraction of block bytes and gas the tier may
+	// fill in a proposal, e.g. "0.25". Empty means unlimited.
+	MaxBlockShare string `mapstructure:"max-block-share"`
+	// ReservedBlockShare is the fraction of block bytes and gas kept for the
+	// tier in a proposal. Unused reservation spills over to the next tier.
+	ReservedBlockShare string `mapstructure:"reserved-block-share"`
+}
+
+// Validate checks that the tier has a usable name, at least one match
+// criterion and well-formed block shares.
+func (c TierConfig) Validate() error {
+	name := strings.TrimSpace(c.Name)
+	if name == "" {
+		return fmt.Errorf("tier name is required")
+	}
+	if name == DefaultTierName || name == QueuedTierName {
+		return fmt.Errorf("tier name %q is reserved", name)
+	}
+	if len(c.MsgTypeURLs) == 0 && len(c.Senders) == 0 && len(c.MoveFunctions) == 0 && !c.IBCRelayer {
+		return fmt.Errorf("tier %q has no match criteria", name)
+	}
+	for _, fn := range c.MoveFunctions {
+		if _, _, _, err := ParseMoveFunction(fn); err != nil {
+			return fmt.Errorf("tier %q: %w", name, err)
+		}
+	}
+	maxShare, err := c.ParseMaxBlockShare()
+	if err != nil {
+		return fmt.Errorf("tier %q: %w", name, err)
+	}
+	reserved, err := c.ParseReservedBlockShare()
+	if err != nil {
+		return fmt.Errorf("tier %q: %w", name, err)
+	}
+	if maxShare.IsPositive() && reserved.GT(maxShare) {
+		return fmt.Errorf("tier %q: reserved block share %s exceeds max block share %s", name, reserved, maxShare)
+	}
+	return nil
+}
+
+// ParseMaxBlockShare parses MaxBlockShare. An empty value yields zero (unlimited).
+func (c TierConfig) ParseMaxBlockShare() (math.LegacyDec, error) {
+	return parseBlockShare("max block share", c.MaxBlockShare)
+}
+
+// ParseReservedBlockShare parses ReservedBlockShare. An empty value yields zero
+// (no reservation).
+func (c TierConfig) ParseReservedBlockShare() (math.LegacyDec, error) {
+	return parseBlockShare("reserved block share", c.ReservedBlockShare)
+}
+
+// parseBlockShare parses a block fraction in [0, 1]. An empty value yields zero.
+func parseBlockShare(field, value string) (math.LegacyDec, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return math.LegacyZeroDec(), nil
+	}
+
+	share, err := math.LegacyNewDecFromStr(value)
+	if err != nil {
+		return math.LegacyDec{}, fmt.Errorf("invalid %s %q: %w", field, value, err)
+	}
+	if share.IsNegative() || share.GT(math.LegacyOneDec()) {
+		return math.LegacyDec{}, fmt.Errorf("%s %s must be between 0 and 1", field, share)
+	}
+	return share, nil
+}
+
+// ParseMoveFunction splits a "<address>::<module>[::<function>]" target. The
+// function is empty when the target covers the whole module.
+func ParseMoveFunction(target string) (addr, module, function string, err error) {
+	parts := strings.Split(strings.TrimSpace(target), "::")
+	if len(parts) < 2 || len(parts) > 3 {
+		return "", "", "", fmt.Errorf("invalid move function %q; expected <address>::<module>[::<function>]", target)
+	}
+	for _, part := range parts {
+		if part == "" {
+			return "", "", "", fmt.Errorf("invalid move function %q; empty segment", target)
+		}
+	}
+
+	addr, module = parts[0], parts[1]
+	if len(parts) == 3 {
+		function = parts[2]
+	}
+	return addr, module, function, nil
 }
 
 // DefaultAppConfig returns default abcipp app config values.
@@ -37,6 +184,22 @@ func DefaultAppConfig() AppConfig {
 		MaxQueuedPerSender: DefaultMaxQueuedPerSender,
 		MaxQueuedTotal:     DefaultMaxQueuedTotal,
 		QueuedGapTTL:       DefaultQueuedGapTTL,
+		JournalEnabled:     false,
+		JournalPath:        DefaultJournalPath,
+
+		ReplacementPriorityBump: DefaultReplacementPriorityBump,
+		ReportProposalOrdering:  false,
+		StrictProcessProposal:   false,
+		SnapshotQueryEnabled:    false,
+
+		ReputationEnabled:             false,
+		ReputationHalfLife:            DefaultReputationHalfLife,
+		ReputationThrottleScore:       DefaultReputationThrottleScore,
+		ReputationBanScore:            DefaultReputationBanScore,
+		ReputationBanDuration:         DefaultReputationBanDuration,
+		ReputationThrottledMaxPending: DefaultReputationThrottledMaxPending,
+
+		Tiers: []TierConfig{},
 	}
 }
 
@@ -46,9 +209,75 @@ func GetConfig(appOpts servertypes.AppOptions) AppConfig {
 		MaxQueuedPerSender: cast.ToInt(appOpts.Get(FlagMaxQueuedPerSender)),
 		MaxQueuedTotal:     cast.ToInt(appOpts.Get(FlagMaxQueuedTotal)),
 		QueuedGapTTL:       cast.ToDuration(appOpts.Get(FlagQueuedGapTTL)),
+		JournalEnabled:     cast.ToBool(appOpts.Get(FlagJournalEnabled)),
+		JournalPath:        cast.ToString(appOpts.Get(FlagJournalPath)),
+
+		ReplacementPriorityBump: cast.ToInt(appOpts.Get(FlagReplacementPriorityBump)),
+		ReportProposalOrdering:  cast.ToBool(appOpts.Get(FlagReportProposalOrdering)),
+		StrictProcessProposal:   cast.ToBool(appOpts.Get(FlagStrictProcessProposal)),
+		SnapshotQueryEnabled:    cast.ToBool(appOpts.Get(FlagSnapshotQueryEnabled)),
+
+		ReputationEnabled:             cast.ToBool(appOpts.Get(FlagReputationEnabled)),
+		ReputationHalfLife:            cast.ToDuration(appOpts.Get(FlagReputationHalfLife)),
+		ReputationThrottleScore:       cast.ToFloat64(appOpts.Get(FlagReputationThrottleScore)),
+		ReputationBanScore:            cast.ToFloat64(appOpts.Get(FlagReputationBanScore)),
+		ReputationBanDuration:         cast.ToDuration(appOpts.Get(FlagReputationBanDuration)),
+		ReputationThrottledMaxPending: cast.ToInt(appOpts.Get(FlagReputationThrottledMaxPending)),
+
+		Tiers: parseTierConfigs(appOpts.Get(FlagTiers)),
 	}
 }
 
+// parseTierConfigs reads the [[abcipp.tiers]] tables. Malformed entries decode
+// to an empty TierConfig and are rejected by TierConfig.Validate.
+func parseTierConfigs(raw any) []TierConfig {
+	items := cast.ToSlice(raw)
+	tiers := make([]TierConfig, 0, len(items))
+	for _, item := range items {
+		fields := cast.ToStringMap(item)
+		tiers = append(tiers, TierConfig{
+			Name:               cast.ToString(fields["name"]),
+			MsgTypeURLs:        cast.ToStringSlice(fields["msg-type-urls"]),
+			Senders:            cast.ToStringSlice(fields["senders"]),
+			MoveFunctions:      cast.ToStringSlice(fields["move-functions"]),
+			IBCRelayer:         cast.ToBool(fields["ibc-relayer"]),
+			MaxBlockShare:      cast.ToString(fields["max-block-share"]),
+			ReservedBlockShare: cast.ToString(fields["reserved-block-share"]),
+		})
+	}
+	return tiers
+}
+
+// ReputationConfig returns the sender admission scoring config.
+func (c AppConfig) ReputationConfig() ReputationConfig {
+	return ReputationConfig{
+		Enabled:             c.ReputationEnabled,
+		HalfLife:            c.ReputationHalfLife,
+		ThrottleScore:       c.ReputationThrottleScore,
+		BanScore:            c.ReputationBanScore,
+		BanDuration:         c.ReputationBanDuration,
+		ThrottledMaxPending: c.ReputationThrottledMaxPending,
+	}
+}
+
+// ResolveJournalPath returns the journal file path resolved against the node
+// home directory, or an empty string when journaling is disabled.
+func (c AppConfig) ResolveJournalPath(homeDir string) string {
+	if !c.JournalEnabled {
+		return ""
+	}
+
+	path := c.JournalPath
+	if path == "" {
+		path = DefaultJournalPath
+	}
+	if filepath.IsAbs(path) {
+		return path
+	}
+
+	return filepath.Join(homeDir, path)
+}
+
 // DefaultConfigTemplate is the app.toml section for abcipp config.
 const DefaultConfigTemplate = `
 ###############################################################################
@@ -62,4 +291,76 @@ max-queued-per-sender = {{ .ABCIPP.MaxQueuedPerSender }}
 max-queued-total = {{ .ABCIPP.MaxQueuedTotal }}
 # How long to keep queued txs for a stalled sender missing its head nonce.
 queued-gap-ttl = "{{ .ABCIPP.QueuedGapTTL }}"
+# Persist active and queued txs to disk so they survive node restarts.
+journal-enabled = {{ .ABCIPP.JournalEnabled }}
+# Journal file location. Relative paths are resolved against the node home directory.
+journal-path = "{{ .ABCIPP.JournalPath }}"
+# Minimum priority increase, in percent, for a tx to replace a pending tx with the
+# same sender and nonce. Zero disables the bump, so a replacement only needs a
+# strictly higher priority.
+replacement-priority-bump = {{ .ABCIPP.ReplacementPriorityBump }}
+# Log proposals with duplicate (sender, nonce) pairs or with nonces that are not
+# contiguous and ascending per sender, with the proposer and the reason. Such
+# proposals are still accepted.
+report-proposal-ordering = {{ .ABCIPP.ReportProposalOrdering }}
+# Reject proposals with duplicate (sender, nonce) pairs or with nonces that are not
+# contiguous and ascending per sender, logging the reason. The check depends only on
+# the proposal, but validators that disagree on this setting vote differently on such
+# proposals, so enable it on every validator or none.
+strict-process-proposal = {{ .ABCIPP.StrictProcessProposal }}
+# Serve the QueryMempoolSnapshot endpoint used by "initiad debug mempool-snapshot
+# export". The endpoint is served over gRPC and through CometBFT RPC abci_query, so
+# enabling it exposes the unpaginated full-pool dump on the public RPC port as well.
+# The dump copies the whole pool under the mempool lock, so only enable it on nodes
+# whose gRPC and RPC ports are reachable by operators alone.
+snapshot-query-enabled = {{ .ABCIPP.SnapshotQueryEnabled }}
+# Score senders on txs that pass CheckTx but fail ante in PrepareProposal or recheck,
+# and on queued txs that expire on a nonce gap. Scores halve every
+# reputation-half-life. At reputation-throttle-score a sender may keep only
+# reputation-throttled-max-pending txs in the mempool; at reputation-ban-score it is
+# refused for reputation-ban-duration.
+reputation-enabled = {{ .ABCIPP.ReputationEnabled }}
+reputation-half-life = "{{ .ABCIPP.ReputationHalfLife }}"
+reputation-throttle-score = {{ .ABCIPP.ReputationThrottleScore }}
+reputation-ban-score = {{ .ABCIPP.ReputationBanScore }}
+reputation-ban-duration = "{{ .ABCIPP.ReputationBanDuration }}"
+reputation-throttled-max-pending = {{ .ABCIPP.ReputationThrottledMaxPending }}
+
+# Priority tiers, matched in order; the first matching tier wins and unmatched txs
+# fall into the "default" tier. A tx matches when its first signer is listed in
+# senders (if set) and every message matches one of msg-type-urls, move-functions
+# or ibc-relayer (if set). max-block-share caps the fraction of block bytes and gas
+# the tier may fill in a proposal; leave it empty for no limit. reserved-block-share
+# keeps that fraction for the tier; space it does not use spills over to the next
+# tier. Tiers are local policy and only shape the proposals this node builds.
+# Example:
+#
+# [[abcipp.tiers]]
+# name = "ibc"
+# msg-type-urls = []
+# senders = []
+# move-functions = []
+# ibc-relayer = true
+# max-block-share = "0.25"
+# reserved-block-share = "0.1"
+#
+# [[abcipp.tiers]]
+# name = "dex"
+# msg-type-urls = []
+# senders = []
+# move-functions = ["0x1::dex::swap_script"]
+# ibc-relayer = false
+# max-block-share = "0.5"
+# reserved-block-share = ""
+{{- range .ABCIPP.Tiers }}
+
+[[abcipp.tiers]]
+name = "{{ .Name }}"
+msg-type-urls = [{{ range $i, $v := .MsgTypeURLs }}{{ if $i }}, {{ end }}"{{ $v }}"{{ end }}]
+senders = [{{ range $i, $v := .Senders }}{{ if $i }}, {{ end }}"{{ $v }}"{{ end }}]
+move-functions = [{{ range $i, $v := .MoveFunctions }}{{ if $i }}, {{ end }}"{{ $v }}"{{ end }}]
+ibc-relayer = {{ .IBCRelayer }}
+max-block-share = "{{ .MaxBlockShare }}"
+reserved-block-share = "{{ .ReservedBlockShare }}"
+{{- end }}
 `
@@ -0,0 +1,50 @@
+package abcipp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTierConfigs(t *testing.T) {
+	// shape produced by viper for [[abcipp.tiers]] tables.
+	raw := []any{
+		map[string]any{
+			"name":            "ibc",
+			"ibc-relayer":     true,
+			"max-block-share": 0.25,
+		},
+		map[string]any{
+			"name":           "dex",
+			"move-functions": []any{"0x1::dex", "0x1::stableswap::swap_script"},
+			"msg-type-urls":  []any{"/initia.move.v1.MsgExecute"},
+		},
+	}
+
+	tiers := parseTierConfigs(raw)
+	require.Len(t, tiers, 2)
+	require.True(t, tiers[0].IBCRelayer)
+	require.Equal(t, "0.25", tiers[0].MaxBlockShare)
+	require.Equal(t, []string{"0x1::dex", "0x1::stableswap::swap_script"}, tiers[1].MoveFunctions)
+	require.Equal(t, []string{"/initia.move.v1.MsgExecute"}, tiers[1].MsgTypeURLs)
+	for _, tier := range tiers {
+		require.NoError(t, tier.Validate())
+	}
+
+	require.Empty(t, parseTierConfigs(nil))
+}
+
+func TestParseMoveFunction(t *testing.T) {
+	addr, module, function, err := ParseMoveFunction("0x1::dex::swap_script")
+	require.NoError(t, err)
+	require.Equal(t, []string{"0x1", "dex", "swap_script"}, []string{addr, module, function})
+
+	_, _, function, err = ParseMoveFunction("0x1::dex")
+	require.NoError(t, err)
+	require.Empty(t, function)
+
+	for _, target := range []string{"0x1", "0x1::", "0x1::dex::swap::extra"} {
+		_, _, _, err := ParseMoveFunction(target)
+		require.Error(t, err, target)
+	}
+}
@@ -5,8 +5,12 @@ import (
 
 	cometabci "github.com/cometbft/cometbft/abci/types"
 
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+
+	"github.com/initia-labs/initia/abcipp/types"
 )
 
 // Mempool defines the interface a mempool should implement.
@@ -36,6 +40,27 @@ type Mempool interface {
 
 	// IterateQueuedTxs iterates over queued pool entries, calling fn for each. Stops early if fn returns false.
 	IterateQueuedTxs(fn func(sender string, nonce uint64, tx sdk.Tx) bool)
+
+	// GetSenderTxs returns the active and queued txs of a sender ordered by nonce.
+	GetSenderTxs(sender string) (SenderTxs, bool)
+
+	// GetTierTxs returns the txs of a tier ordered by sender and nonce.
+	GetTierTxs(tier string) []TxInfo
+
+	// GetTierLimits returns the block space limits of each tier in match order.
+	GetTierLimits() []TierLimit
+
+	// SelectTierName returns the name of the tier the tx matches.
+	SelectTierName(ctx sdk.Context, tx sdk.Tx) string
+
+	// GetSenderReputations returns the admission reputation of tracked senders.
+	GetSenderReputations() []SenderReputation
+
+	// ExportSnapshot returns the full mempool state for debugging and replay.
+	ExportSnapshot() *types.MempoolSnapshot
+
+	// SubscribeEvents registers a subscriber for mempool state changes matching filter.
+	SubscribeEvents(filter MempoolEventFilter, bufSize int) (<-chan MempoolEvent, func())
 }
 
 // TxInfoIterator extends sdkmempool.Iterator with TxInfo access.
@@ -57,6 +82,30 @@ type TxInfo struct {
 	GasLimit uint64
 	Tier     string
 	TxBytes  []byte
+
+	// Priority is the priority the tx was inserted with.
+	Priority int64
+	// ClampedPriority is the sender-clamped rank used for active ordering.
+	// It is zero for queued txs.
+	ClampedPriority int64
+}
+
+// SenderTxs contains the txs of a single sender in the mempool.
+type SenderTxs struct {
+	NextExpectedSequence uint64
+	Active               []TxInfo
+	Queued               []TxInfo
+}
+
+// TierLimit describes the block space a tier may use in a proposal.
+type TierLimit struct {
+	Name string
+	// MaxBlockShare is the maximum fraction of block bytes and gas the tier may
+	// fill. Zero means unlimited.
+	MaxBlockShare math.LegacyDec
+	// ReservedBlockShare is the fraction of block bytes and gas held for the
+	// tier. Zero means no reservation.
+	ReservedBlockShare math.LegacyDec
 }
 
 // TxInfoEntry bundles a transaction with its mempool info.
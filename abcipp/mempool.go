@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	cmtmempool "github.com/cometbft/cometbft/mempool"
 	"github.com/huandu/skiplist"
 
@@ -25,6 +26,18 @@ type PriorityMempoolConfig struct {
 	QueuedGapTTL       time.Duration
 	Tiers              []Tier
 
+	// ReplacementPriorityBump is the minimum priority increase, in percent, for
+	// a same-nonce replacement (<= 0 = strictly higher only).
+	ReplacementPriorityBump int
+
+	// JournalPath is the on-disk journal location. Empty disables journaling.
+	JournalPath string
+	// TxDecoder decodes journaled tx bytes on replay. Required when JournalPath is set.
+	TxDecoder sdk.TxDecoder
+
+	// Reputation configures sender admission scoring. Disabled by default.
+	Reputation ReputationConfig
+
 	// for cleanup
 	sdk.AnteHandler
 }
@@ -34,6 +47,13 @@ type TierMatcher func(ctx sdk.Context, tx sdk.Tx) bool
 type Tier struct {
 	Name    string
 	Matcher TierMatcher
+
+	// MaxBlockShare caps the fraction of block bytes and gas the tier may fill
+	// in a proposal. A nil or non-positive value leaves the tier unlimited.
+	MaxBlockShare math.LegacyDec
+	// ReservedBlockShare is the fraction of block bytes and gas held for the
+	// tier in a proposal. It is capped by MaxBlockShare.
+	ReservedBlockShare math.LegacyDec
 }
 
 type txKey struct {
@@ -44,6 +64,11 @@ type txKey struct {
 const (
 	// queuedTier marks entries in the queued pool.
 	queuedTier = -1
+
+	// QueuedTierName is the tier name reported for entries in the queued pool.
+	QueuedTierName = "queued"
+	// DefaultTierName is the catch-all tier appended after the configured tiers.
+	DefaultTierName = "default"
 )
 
 type RemovalReason uint8
@@ -58,6 +83,15 @@ const (
 	// RemovalReasonAnteRejectedInPrepare is used when a tx fails ante during
 	// proposal construction or recheck-time validation paths.
 	RemovalReasonAnteRejectedInPrepare
+	// RemovalReasonReplaced is used when a tx is displaced by a higher-priority
+	// tx with the same (sender, nonce).
+	RemovalReasonReplaced
+	// RemovalReasonStale is used when a tx nonce falls below the sender's
+	// on-chain sequence.
+	RemovalReasonStale
+	// RemovalReasonQueuedGapExpired is used when queued txs are dropped because
+	// the sender stayed blocked on a missing nonce for longer than QueuedGapTTL.
+	RemovalReasonQueuedGapExpired
 )
 
 // PriorityMempool is a transaction pool that keeps high-priority submissions
@@ -85,13 +119,24 @@ type PriorityMempool struct {
 	maxQueuedPerSender int
 	maxQueuedTotal     int
 	queuedGapTTL       time.Duration
+	replacementBump    int
+
+	subscriptions eventSubscriptions
+	reputations   *senderReputations
+
+	// journalRestored is set once the on-disk journal has been replayed, so
+	// journal writes never overwrite entries that were not restored yet.
+	journalRestored atomic.Bool
+	// journalDirty is set whenever the pool changes, so the journal is only
+	// rewritten when there is something new to persist.
+	journalDirty atomic.Bool
 
 	eventCh    atomic.Pointer[chan<- cmtmempool.AppMempoolEvent]
-	appEventCh atomic.Pointer[chan<- cmtmempool.AppMempoolEvent]
+	appEventCh atomic.Pointer[chan<- AppEvent]
 
 	eventMu    sync.Mutex
 	cometQueue []cmtmempool.AppMempoolEvent
-	appQueue   []cmtmempool.AppMempoolEvent
+	appQueue   []AppEvent
 
 	cometNotify chan struct{}
 	appNotify   chan struct{}
@@ -111,6 +156,9 @@ func NewPriorityMempool(cfg PriorityMempoolConfig, logger log.Logger, txEncoder
 	if ak == nil {
 		panic("account keeper is required")
 	}
+	if cfg.JournalPath != "" && cfg.TxDecoder == nil {
+		panic("tx decoder is required when journal is enabled")
+	}
 	tiers := buildTierMatchers(cfg)
 	dist := initTierDistribution(tiers)
 
@@ -126,6 +174,7 @@ func NewPriorityMempool(cfg PriorityMempoolConfig, logger log.Logger, txEncoder
 	if gapTTL <= 0 {
 		gapTTL = DefaultQueuedGapTTL
 	}
+	bump := max(cfg.ReplacementPriorityBump, 0)
 
 	p := &PriorityMempool{
 		cfg:                cfg,
@@ -139,6 +188,8 @@ func NewPriorityMempool(cfg PriorityMempoolConfig, logger log.Logger, txEncoder
 		maxQueuedPerSender: maxQPS,
 		maxQueuedTotal:     maxQT,
 		queuedGapTTL:       gapTTL,
+		replacementBump:    bump,
+		reputations:        newSenderReputations(cfg.Reputation),
 		ak:                 ak,
 		cometNotify:        make(chan struct{}, 1),
 		appNotify:          make(chan struct{}, 1),
@@ -150,9 +201,14 @@ func NewPriorityMempool(cfg PriorityMempoolConfig, logger log.Logger, txEncoder
 }
 
 // Stop signals all background workers to exit and waits for them to finish.
+// When journaling is enabled, the final pool state is written to disk.
 func (p *PriorityMempool) Stop() {
 	p.StopCleaningWorker()
+	if err := p.FlushJournal(); err != nil {
+		p.logger.Error("failed to flush mempool journal", "err", err)
+	}
 	p.StopEventDispatch()
+	p.closeSubscriptions()
 }
 
 // SetMaxQueuedPerSender overrides the default per-sender queued tx limit.
@@ -221,7 +277,7 @@ func (p *PriorityMempool) PromoteQueued(ctx context.Context) {
 	// process under lock
 	p.mtx.Lock()
 	var promoted []*txEntry
-	var removed []*txEntry
+	var staled, evicted []*txEntry
 
 	for sender, sr := range seqs {
 		ss := p.senders[sender]
@@ -231,7 +287,7 @@ func (p *PriorityMempool) PromoteQueued(ctx context.Context) {
 		ss.setOnChainSeqLocked(sr.onChainSeq)
 
 		//  Reconcile sender pools by dropping entries below the latest on-chain sequence.
-		removed = append(removed, p.removeStaleLocked(ss, sr.onChainSeq)...)
+		staled = append(staled, p.removeStaleLocked(ss, sr.onChainSeq)...)
 
 		// collect and promote from current sender cursor.
 		toPromote := p.collectPromotableLocked(ss)
@@ -253,7 +309,7 @@ func (p *PriorityMempool) PromoteQueued(ctx context.Context) {
 				pe.gas,
 				nil,
 			); accepted {
-				removed = append(removed, p.removeEntriesByReasonLocked(ev, RemovalReasonCapacityEvicted)...)
+				evicted = append(evicted, p.removeEntriesByReasonLocked(ev, RemovalReasonCapacityEvicted)...)
 				p.addEntryLocked(pe)
 				promoted = append(promoted, pe)
 			} else {
@@ -276,9 +332,10 @@ func (p *PriorityMempool) PromoteQueued(ctx context.Context) {
 		}
 	}
 
-	p.enqueueRemovedEvents(removed)
+	p.enqueueRemovedEvents(staled, RemovalReasonStale)
+	p.enqueueRemovedEvents(evicted, RemovalReasonCapacityEvicted)
 	for _, entry := range promoted {
-		p.enqueueEvent(cmtmempool.EventTxInserted, entry.bytes)
+		p.enqueueInsertedEvent(entry, MempoolEventPromoted)
 	}
 	p.mtx.Unlock()
 }
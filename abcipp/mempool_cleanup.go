@@ -10,6 +10,8 @@ import (
 const DefaultMempoolCleaningInterval = time.Second * 5
 
 // StartCleaningWorker starts a background worker that periodically cleans stale txs.
+// When journaling is enabled, the worker also replays the journal on its first
+// usable tick and flushes the pool state back to disk whenever it changed.
 func (p *PriorityMempool) StartCleaningWorker(baseApp BaseApp, interval time.Duration) {
 	if interval <= 0 {
 		interval = DefaultMempoolCleaningInterval
@@ -34,6 +36,11 @@ func (p *PriorityMempool) StartCleaningWorker(baseApp BaseApp, interval time.Dur
 			select {
 			case <-timer.C:
 				p.cleanUpEntries(baseApp, p.ak)
+				p.pruneReputations()
+				p.restoreJournal(baseApp)
+				if err := p.FlushJournal(); err != nil {
+					p.logger.Error("failed to flush mempool journal", "err", err)
+				}
 			case <-stopCh:
 				return
 			}
@@ -118,10 +125,11 @@ func (p *PriorityMempool) cleanUpEntries(bApp BaseApp, ak AccountKeeper) {
 		}
 		ss.setOnChainSeqLocked(onChainSeq)
 		if staled := p.removeStaleLocked(ss, onChainSeq); len(staled) > 0 {
-			p.enqueueRemovedEvents(staled)
+			p.enqueueRemovedEvents(staled, RemovalReasonStale)
 		}
 		if expired := p.expireQueuedGapLocked(ss, now); len(expired) > 0 {
-			p.enqueueRemovedEvents(expired)
+			p.enqueueRemovedEvents(expired, RemovalReasonQueuedGapExpired)
+			p.penalizeRemoved(expired, RemovalReasonQueuedGapExpired)
 		}
 		p.cleanupSenderLocked(sender)
 	}
@@ -12,6 +12,44 @@ import (
 // enough that copying is not worth it.
 const eventQueueCompactThreshold = 64
 
+// AppEventType is the type of an event delivered on the app event channel
+// (SetAppEventCh). It has its own value space, so app-only events never share
+// values with the cometbft event types.
+type AppEventType uint8
+
+const (
+	// AppEventTxInserted is delivered when a tx enters the active set.
+	AppEventTxInserted AppEventType = iota + 1
+	// AppEventTxRemoved is delivered when a tx leaves the pool.
+	AppEventTxRemoved
+	// AppEventTxQueued is delivered when a tx enters the queued pool.
+	AppEventTxQueued
+	// AppEventTxReplaced is delivered in place of AppEventTxRemoved when a tx is
+	// displaced by a same-nonce replacement, so app consumers can tell
+	// replacements apart from inclusion. The cometbft channel keeps receiving
+	// EventTxRemoved for the displaced tx.
+	AppEventTxReplaced
+)
+
+// AppEvent is an event delivered on the app event channel.
+type AppEvent struct {
+	Type  AppEventType
+	TxKey cmttypes.TxKey
+	Tx    cmttypes.Tx
+}
+
+// appEventType maps a cometbft event type to its app channel counterpart.
+func appEventType(eventType cmtmempool.AppMempoolEventType) AppEventType {
+	switch eventType {
+	case cmtmempool.EventTxInserted:
+		return AppEventTxInserted
+	case cmtmempool.EventTxQueued:
+		return AppEventTxQueued
+	default:
+		return AppEventTxRemoved
+	}
+}
+
 // SetEventCh stores the cometbft event channel for event dispatch.
 func (p *PriorityMempool) SetEventCh(ch chan<- cmtmempool.AppMempoolEvent) {
 	p.eventCh.Store(&ch)
@@ -25,7 +63,7 @@ func (p *PriorityMempool) SetEventCh(ch chan<- cmtmempool.AppMempoolEvent) {
 // SetAppEventCh stores an app-side event channel that receives all events.
 // Unlike the cometbft channel (SetEventCh), this channel is not filtered.
 // Apps use it for internal tracking.
-func (p *PriorityMempool) SetAppEventCh(ch chan<- cmtmempool.AppMempoolEvent) {
+func (p *PriorityMempool) SetAppEventCh(ch chan<- AppEvent) {
 	p.appEventCh.Store(&ch)
 	// Wake the app dispatch goroutine in case events were queued before channel wiring.
 	select {
@@ -50,6 +88,15 @@ func (p *PriorityMempool) StopEventDispatch() {
 
 // enqueueEvent appends one event to the relevant internal FIFO queues.
 func (p *PriorityMempool) enqueueEvent(eventType cmtmempool.AppMempoolEventType, txBytes []byte) {
+	p.enqueueSplitEvent(eventType, appEventType(eventType), txBytes)
+}
+
+// enqueueSplitEvent appends one event with separate cometbft and app event types.
+func (p *PriorityMempool) enqueueSplitEvent(cometType cmtmempool.AppMempoolEventType, appType AppEventType, txBytes []byte) {
+	// every pool change emits an event, so this is also where the journal
+	// learns it has to be rewritten.
+	p.journalDirty.Store(true)
+
 	hasCometCh := p.eventCh.Load() != nil
 	hasAppCh := p.appEventCh.Load() != nil
 	if !hasCometCh && !hasAppCh {
@@ -57,11 +104,16 @@ func (p *PriorityMempool) enqueueEvent(eventType cmtmempool.AppMempoolEventType,
 	}
 
 	cmtTx := cmttypes.Tx(txBytes)
-	ev := cmtmempool.AppMempoolEvent{
-		Type:  eventType,
+	cometEv := cmtmempool.AppMempoolEvent{
+		Type:  cometType,
 		TxKey: cmtTx.Key(),
 		Tx:    cmtTx,
 	}
+	appEv := AppEvent{
+		Type:  appType,
+		TxKey: cometEv.TxKey,
+		Tx:    cmtTx,
+	}
 
 	p.eventMu.Lock()
 	select {
@@ -71,15 +123,15 @@ func (p *PriorityMempool) enqueueEvent(eventType cmtmempool.AppMempoolEventType,
 	default:
 	}
 	// EventTxQueued is filtered from the comet channel to avoid double gossip.
-	if hasCometCh && eventType != cmtmempool.EventTxQueued {
-		p.cometQueue = append(p.cometQueue, ev)
+	if hasCometCh && cometType != cmtmempool.EventTxQueued {
+		p.cometQueue = append(p.cometQueue, cometEv)
 	}
 	if hasAppCh {
-		p.appQueue = append(p.appQueue, ev)
+		p.appQueue = append(p.appQueue, appEv)
 	}
 	p.eventMu.Unlock()
 
-	if hasCometCh && eventType != cmtmempool.EventTxQueued {
+	if hasCometCh && cometType != cmtmempool.EventTxQueued {
 		select {
 		case p.cometNotify <- struct{}{}:
 		default:
@@ -93,10 +145,33 @@ func (p *PriorityMempool) enqueueEvent(eventType cmtmempool.AppMempoolEventType,
 	}
 }
 
+// enqueueInsertedEvent appends EventTxInserted for an entry entering the active
+// set and notifies subscribers with eventType (inserted or promoted).
+func (p *PriorityMempool) enqueueInsertedEvent(entry *txEntry, eventType MempoolEventType) {
+	p.enqueueEvent(cmtmempool.EventTxInserted, entry.bytes)
+	p.publishEvent(eventType, entry, 0)
+}
+
+// enqueueQueuedEvent appends EventTxQueued for an entry entering the queued pool.
+func (p *PriorityMempool) enqueueQueuedEvent(entry *txEntry) {
+	p.enqueueEvent(cmtmempool.EventTxQueued, entry.bytes)
+	p.publishEvent(MempoolEventQueued, entry, 0)
+}
+
 // enqueueRemovedEvents appends EventTxRemoved for each removed tx entry.
-func (p *PriorityMempool) enqueueRemovedEvents(entries []*txEntry) {
+func (p *PriorityMempool) enqueueRemovedEvents(entries []*txEntry, reason RemovalReason) {
 	for _, entry := range entries {
 		p.enqueueEvent(cmtmempool.EventTxRemoved, entry.bytes)
+		p.publishEvent(MempoolEventRemoved, entry, reason)
+	}
+}
+
+// enqueueReplacedEvents appends EventTxRemoved (cometbft) and AppEventTxReplaced
+// (app) for each tx displaced by a same-nonce replacement.
+func (p *PriorityMempool) enqueueReplacedEvents(entries []*txEntry) {
+	for _, entry := range entries {
+		p.enqueueSplitEvent(cmtmempool.EventTxRemoved, AppEventTxReplaced, entry.bytes)
+		p.publishEvent(MempoolEventRemoved, entry, RemovalReasonReplaced)
 	}
 }
 
@@ -179,12 +254,12 @@ func (p *PriorityMempool) appDispatchLoop() {
 				break
 			}
 			ev := p.appQueue[0]
-			p.appQueue[0] = cmtmempool.AppMempoolEvent{}
+			p.appQueue[0] = AppEvent{}
 			p.appQueue = p.appQueue[1:]
 			// Compact only when there are enough live elements to make the
 			// copy worthwhile, and the backing array is at least 2x larger.
 			if len(p.appQueue) >= eventQueueCompactThreshold && cap(p.appQueue) > 2*len(p.appQueue) {
-				p.appQueue = append([]cmtmempool.AppMempoolEvent(nil), p.appQueue...)
+				p.appQueue = append([]AppEvent(nil), p.appQueue...)
 			}
 			p.eventMu.Unlock()
 
@@ -31,7 +31,7 @@ func TestAppEventChReceivesAllEvents(t *testing.T) {
 	t.Cleanup(func() { mp.StopEventDispatch() })
 
 	cometCh := make(chan cmtmempool.AppMempoolEvent, 16)
-	appCh := make(chan cmtmempool.AppMempoolEvent, 16)
+	appCh := make(chan AppEvent, 16)
 	mp.SetEventCh(cometCh)
 	mp.SetAppEventCh(appCh)
 
@@ -48,30 +48,16 @@ func TestAppEventChReceivesAllEvents(t *testing.T) {
 	// Insert nonce 2 (queued, future) — emits EventTxQueued only on app channel.
 	require.NoError(t, mp.Insert(ctx, newTestTxWithPriv(priv, 2, 1000, "default")))
 
-	collectN := func(ch chan cmtmempool.AppMempoolEvent, n int) []cmtmempool.AppMempoolEventType {
-		types := make([]cmtmempool.AppMempoolEventType, 0, n)
-		deadline := time.After(500 * time.Millisecond)
-		for len(types) < n {
-			select {
-			case ev := <-ch:
-				types = append(types, ev.Type)
-			case <-deadline:
-				return types
-			}
-		}
-		return types
-	}
-
 	// Comet channel: only EventTxInserted (EventTxQueued is filtered).
-	cometTypes := collectN(cometCh, 1)
-	require.Equal(t, []cmtmempool.AppMempoolEventType{cmtmempool.EventTxInserted}, cometTypes)
+	cometEvents := collectNEvents(t, cometCh, 1, 500*time.Millisecond)
+	require.Equal(t, cmtmempool.EventTxInserted, cometEvents[0].Type)
 	require.Empty(t, cometCh, "comet channel must not receive EventTxQueued")
 
-	// App channel: EventTxInserted then EventTxQueued, no filtering.
-	appTypes := collectN(appCh, 2)
+	// App channel: AppEventTxInserted then AppEventTxQueued, no filtering.
+	appEvents := collectNAppEvents(t, appCh, 2, 500*time.Millisecond)
 	require.ElementsMatch(t,
-		[]cmtmempool.AppMempoolEventType{cmtmempool.EventTxInserted, cmtmempool.EventTxQueued},
-		appTypes,
+		[]AppEventType{AppEventTxInserted, AppEventTxQueued},
+		[]AppEventType{appEvents[0].Type, appEvents[1].Type},
 	)
 }
 
@@ -84,7 +70,7 @@ func TestAppEventChIndependentFromCometCh(t *testing.T) {
 
 	// cometCh is buffered; appCh is unbuffered (always full / blocking).
 	cometCh := make(chan cmtmempool.AppMempoolEvent, 32)
-	appCh := make(chan cmtmempool.AppMempoolEvent) // unbuffered — blocks until read
+	appCh := make(chan AppEvent) // unbuffered — blocks until read
 	mp.SetEventCh(cometCh)
 	mp.SetAppEventCh(appCh)
 
@@ -133,3 +119,40 @@ func TestEnqueueEventAfterStopIsIgnored(t *testing.T) {
 	case <-time.After(20 * time.Millisecond):
 	}
 }
+
+// TestReplacementEmitsReplacedOnAppCh verifies a same-nonce replacement is
+// reported as EventTxReplaced on the app channel while the comet channel still
+// receives EventTxRemoved for the displaced tx.
+func TestReplacementEmitsReplacedOnAppCh(t *testing.T) {
+	keeper := newMockAccountKeeper()
+	mp := NewPriorityMempool(PriorityMempoolConfig{MaxTx: 32}, log.NewNopLogger(), testTxEncoder, keeper)
+	t.Cleanup(func() { mp.StopEventDispatch() })
+
+	cometCh := make(chan cmtmempool.AppMempoolEvent, 16)
+	appCh := make(chan AppEvent, 16)
+	mp.SetEventCh(cometCh)
+	mp.SetAppEventCh(appCh)
+
+	sdkCtx := testSDKContext()
+
+	priv := secp256k1.GenPrivKey()
+	sender := sdk.AccAddress(priv.PubKey().Address())
+	keeper.SetSequence(sender, 0)
+
+	txLow := newTestTxWithPriv(priv, 0, 1000, "default")
+	require.NoError(t, mp.Insert(sdk.WrapSDKContext(sdkCtx.WithPriority(10)), txLow))
+	collectNEvents(t, cometCh, 1, time.Second)
+	collectNAppEvents(t, appCh, 1, time.Second)
+
+	txHigh := newTestTxWithPriv(priv, 0, 1000, "default")
+	require.NoError(t, mp.Insert(sdk.WrapSDKContext(sdkCtx.WithPriority(100)), txHigh))
+
+	cometEvents := collectNEvents(t, cometCh, 2, time.Second)
+	require.Equal(t, cmtmempool.EventTxRemoved, cometEvents[0].Type)
+	require.Equal(t, cmtmempool.EventTxInserted, cometEvents[1].Type)
+
+	appEvents := collectNAppEvents(t, appCh, 2, time.Second)
+	require.Equal(t, AppEventTxReplaced, appEvents[0].Type)
+	require.Equal(t, encodeTx(t, txLow), []byte(appEvents[0].Tx))
+	require.Equal(t, AppEventTxInserted, appEvents[1].Type)
+}
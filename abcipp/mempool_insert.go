@@ -3,9 +3,9 @@ package abcipp
 import (
 	"context"
 	"fmt"
+	"math/big"
 
 	errorsmod "cosmossdk.io/errors"
-	cmtmempool "github.com/cometbft/cometbft/mempool"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -50,6 +50,13 @@ func (p *PriorityMempool) Insert(ctx context.Context, tx sdk.Tx) error {
 	}
 	ss, exists := p.senders[key.sender]
 	if !exists {
+		// refuse banned senders before fetching their sequence or creating
+		// sender state for them.
+		if err := p.checkSenderAdmissionLocked(nil, key); err != nil {
+			p.mtx.Unlock()
+			return err
+		}
+
 		ak := p.ak
 		p.mtx.Unlock()
 		seq, seqOk := fetchSequence(sdkCtx, ak, key.sender)
@@ -66,6 +73,11 @@ func (p *PriorityMempool) Insert(ctx context.Context, tx sdk.Tx) error {
 		}
 	}
 
+	if err := p.checkSenderAdmissionLocked(ss, key); err != nil {
+		p.mtx.Unlock()
+		return err
+	}
+
 	nextExpected := ss.nextExpectedNonce()
 	if key.nonce < nextExpected {
 		// same-nonce replacement is allowed even if sender cursor has moved forward.
@@ -113,10 +125,12 @@ func (p *PriorityMempool) Insert(ctx context.Context, tx sdk.Tx) error {
 			}
 			return sdkmempool.ErrMempoolTxMaxCapacity
 		}
-		if evicted != nil {
-			p.enqueueEvent(cmtmempool.EventTxRemoved, evicted.bytes)
+		if evicted != nil && evicted.key == key {
+			p.enqueueReplacedEvents([]*txEntry{evicted})
+		} else if evicted != nil {
+			p.enqueueRemovedEvents([]*txEntry{evicted}, RemovalReasonCapacityEvicted)
 		}
-		p.enqueueEvent(cmtmempool.EventTxQueued, entry.bytes)
+		p.enqueueQueuedEvent(entry)
 		p.mtx.Unlock()
 		return nil
 	}
@@ -133,21 +147,14 @@ func (p *PriorityMempool) Insert(ctx context.Context, tx sdk.Tx) error {
 		bytes:    bz,
 	}
 
-	var removed []*txEntry
+	var removed, replaced []*txEntry
 
 	// check if entry already exists in the active pool
 	existing, hasExisting := p.entries[entry.key]
 	if hasExisting {
-		if entry.priority <= existing.priority {
+		if err := p.checkReplacement("active", key, existing.priority, entry.priority); err != nil {
 			p.mtx.Unlock()
-			return errorsmod.Wrapf(
-				sdkerrors.ErrTxInMempoolCache,
-				"active tx with nonce %d for sender %s has priority %d >= replacement priority %d",
-				key.nonce,
-				key.sender,
-				existing.priority,
-				entry.priority,
-			)
+			return err
 		}
 	}
 	// If a queued tx exists for the same nonce, check priority but defer
@@ -155,16 +162,11 @@ func (p *PriorityMempool) Insert(ctx context.Context, tx sdk.Tx) error {
 	// queued entry is preserved when the active insert is rejected.
 	var queuedToRemove *txEntry
 	if queued, exists := ss.queued[key.nonce]; exists {
-		if !hasExisting && entry.priority <= queued.priority {
-			p.mtx.Unlock()
-			return errorsmod.Wrapf(
-				sdkerrors.ErrTxInMempoolCache,
-				"queued tx with nonce %d for sender %s has priority %d >= replacement priority %d",
-				key.nonce,
-				key.sender,
-				queued.priority,
-				entry.priority,
-			)
+		if !hasExisting {
+			if err := p.checkReplacement("queued", key, queued.priority, entry.priority); err != nil {
+				p.mtx.Unlock()
+				return err
+			}
 		}
 		queuedToRemove = queued
 	}
@@ -187,11 +189,11 @@ func (p *PriorityMempool) Insert(ctx context.Context, tx sdk.Tx) error {
 			delete(ss.queued, key.nonce)
 			p.queuedCount.Add(-1)
 			ss.setQueuedRangeOnRemoveLocked(key.nonce)
-			removed = append(removed, queuedToRemove)
+			replaced = append(replaced, queuedToRemove)
 		}
 		if hasExisting {
 			p.removeEntryLocked(existing)
-			removed = append(removed, existing)
+			replaced = append(replaced, existing)
 		}
 		removed = append(removed, p.removeEntriesByReasonLocked(ev, RemovalReasonCapacityEvicted)...)
 	} else {
@@ -232,31 +234,59 @@ func (p *PriorityMempool) Insert(ctx context.Context, tx sdk.Tx) error {
 		}
 	}
 
-	p.enqueueRemovedEvents(removed)
-	p.enqueueEvent(cmtmempool.EventTxInserted, entry.bytes)
+	p.enqueueReplacedEvents(replaced)
+	p.enqueueRemovedEvents(removed, RemovalReasonCapacityEvicted)
+	p.enqueueInsertedEvent(entry, MempoolEventInserted)
 	for _, pe := range promoted {
-		p.enqueueEvent(cmtmempool.EventTxInserted, pe.bytes)
+		p.enqueueInsertedEvent(pe, MempoolEventPromoted)
 	}
 	p.mtx.Unlock()
 
 	return nil
 }
 
+// checkReplacement returns ErrTxInMempoolCache unless the candidate priority
+// outbids the existing same-nonce tx by the configured minimum bump.
+func (p *PriorityMempool) checkReplacement(pool string, key txKey, existing int64, candidate int64) error {
+	if meetsReplacementBump(existing, candidate, p.replacementBump) {
+		return nil
+	}
+
+	return errorsmod.Wrapf(
+		sdkerrors.ErrTxInMempoolCache,
+		"%s tx with nonce %d for sender %s has priority %d; replacement priority %d is below the required %d%% bump",
+		pool,
+		key.nonce,
+		key.sender,
+		existing,
+		candidate,
+		p.replacementBump,
+	)
+}
+
+// meetsReplacementBump reports whether candidate >= existing * (100 + bumpPercent) / 100.
+// A strictly higher priority is always required, even when the bump rounds to zero.
+func meetsReplacementBump(existing int64, candidate int64, bumpPercent int) bool {
+	if candidate <= existing {
+		return false
+	}
+	if bumpPercent <= 0 || existing <= 0 {
+		return true
+	}
+
+	lhs := new(big.Int).Mul(big.NewInt(candidate), big.NewInt(100))
+	rhs := new(big.Int).Mul(big.NewInt(existing), big.NewInt(int64(100+bumpPercent)))
+	return lhs.Cmp(rhs) >= 0
+}
+
 // insertQueuedLocked adds or replaces a tx in the queued pool. When the per sender
 // limit is hit, the entry with the highest nonce is evicted (unless the new tx has
 // the highest nonce, in which case it is rejected). the caller must hold p.mtx.
 func (p *PriorityMempool) insertQueuedLocked(ss *senderState, key txKey, entry *txEntry) (bool, *txEntry, error) {
-	// same nonce replacement, only if higher priority
+	// same nonce replacement, only if the priority bump is met
 	if existing, exists := ss.queued[key.nonce]; exists {
-		if entry.priority <= existing.priority {
-			return false, nil, errorsmod.Wrapf(
-				sdkerrors.ErrTxInMempoolCache,
-				"queued tx with nonce %d for sender %s has priority %d >= replacement priority %d",
-				key.nonce,
-				key.sender,
-				existing.priority,
-				entry.priority,
-			)
+		if err := p.checkReplacement("queued", key, existing.priority, entry.priority); err != nil {
+			return false, nil, err
 		}
 		ss.queued[key.nonce] = entry
 		return true, existing, nil
@@ -0,0 +1,200 @@
+package abcipp
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// journalVersion is bumped whenever the on-disk journal layout changes.
+const journalVersion = 1
+
+// journalEntry is the on-disk form of a single active or queued mempool entry.
+type journalEntry struct {
+	Sender   string `json:"sender"`
+	Nonce    uint64 `json:"nonce"`
+	Priority int64  `json:"priority"`
+	Queued   bool   `json:"queued"`
+	TxBytes  []byte `json:"tx_bytes"`
+}
+
+// journalFile is the on-disk journal layout.
+type journalFile struct {
+	Version int            `json:"version"`
+	Entries []journalEntry `json:"entries"`
+}
+
+// FlushJournal writes the active and queued entries to the configured journal
+// path. It is a no-op when journaling is disabled, when the pool has not changed
+// since the last flush, or when the previous journal has not been replayed yet,
+// so a restart before replay never loses entries.
+func (p *PriorityMempool) FlushJournal() (err error) {
+	path := p.cfg.JournalPath
+	if path == "" || !p.journalRestored.Load() {
+		return nil
+	}
+
+	// clear the flag before the snapshot; changes racing with the write mark the
+	// pool dirty again and are picked up by the next flush.
+	if !p.journalDirty.Swap(false) {
+		return nil
+	}
+	defer func() {
+		if err != nil {
+			p.journalDirty.Store(true)
+		}
+	}()
+
+	p.mtx.RLock()
+	entries := p.journalEntriesLocked()
+	p.mtx.RUnlock()
+
+	bz, err := json.Marshal(journalFile{
+		Version: journalVersion,
+		Entries: entries,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	// write to a temp file and rename so a crash mid-write keeps the old journal.
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, bz, 0o600); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpPath, path)
+}
+
+// journalEntriesLocked snapshots active and queued entries ordered by sender
+// and nonce, so replay inserts each sender's head before its successors.
+// the caller must hold p.mtx.
+func (p *PriorityMempool) journalEntriesLocked() []journalEntry {
+	entries := make([]journalEntry, 0, len(p.entries)+int(p.queuedCount.Load()))
+	for sender, ss := range p.senders {
+		for nonce, entry := range ss.active {
+			entries = append(entries, journalEntry{
+				Sender:   sender,
+				Nonce:    nonce,
+				Priority: entry.priority,
+				TxBytes:  entry.bytes,
+			})
+		}
+		for nonce, entry := range ss.queued {
+			entries = append(entries, journalEntry{
+				Sender:   sender,
+				Nonce:    nonce,
+				Priority: entry.priority,
+				Queued:   true,
+				TxBytes:  entry.bytes,
+			})
+		}
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Sender != entries[j].Sender {
+			return entries[i].Sender < entries[j].Sender
+		}
+		return entries[i].Nonce < entries[j].Nonce
+	})
+
+	return entries
+}
+
+// readJournal loads journal entries from disk. A missing file yields no entries.
+func readJournal(path string) ([]journalEntry, error) {
+	bz, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var file journalFile
+	if err := json.Unmarshal(bz, &file); err != nil {
+		return nil, err
+	}
+	if file.Version != journalVersion {
+		return nil, fmt.Errorf("unsupported mempool journal version %d", file.Version)
+	}
+
+	return file.Entries, nil
+}
+
+// restoreJournal replays the on-disk journal once a committed state context is
+// available. It runs from the cleaning worker after cleanUpEntries so sender
+// sequences are resolved against the latest committed state.
+func (p *PriorityMempool) restoreJournal(bApp BaseApp) {
+	if p.cfg.JournalPath == "" || p.journalRestored.Load() {
+		return
+	}
+
+	sdkCtx, ok := safeGetContext(bApp)
+	if !ok {
+		// app state is not loaded yet; retry on the next tick.
+		return
+	}
+
+	p.replayJournal(sdkCtx)
+}
+
+// replayJournal re-validates journaled entries and inserts the ones that pass.
+// Entries are replayed in sender and nonce order against a branch of the
+// committed state, so each tx runs the CheckTx ante chain on top of the state
+// left by its predecessors and is inserted with the priority it yields. The
+// journaled priority is only used when no ante handler is configured.
+func (p *PriorityMempool) replayJournal(ctx sdk.Context) {
+	defer p.journalRestored.Store(true)
+
+	entries, err := readJournal(p.cfg.JournalPath)
+	if err != nil {
+		p.logger.Error("failed to read mempool journal", "path", p.cfg.JournalPath, "err", err)
+		return
+	}
+
+	// rewrite the journal after replay so dropped entries are not replayed again.
+	defer p.journalDirty.Store(true)
+
+	restored := 0
+	for _, entry := range entries {
+		tx, err := p.cfg.TxDecoder(entry.TxBytes)
+		if err != nil {
+			p.logger.Debug("failed to decode journaled tx", "sender", entry.Sender, "nonce", entry.Nonce, "err", err)
+			continue
+		}
+
+		entryCtx := ctx.WithTxBytes(entry.TxBytes).WithPriority(entry.Priority)
+		write := func() {}
+		if p.cfg.AnteHandler != nil {
+			var cacheCtx sdk.Context
+			cacheCtx, write = entryCtx.WithIsCheckTx(true).WithIsReCheckTx(false).CacheContext()
+
+			anteCtx, err := p.cfg.AnteHandler(cacheCtx, tx, false)
+			if err != nil {
+				p.logger.Debug("journaled tx failed check", "sender", entry.Sender, "nonce", entry.Nonce, "err", err)
+				continue
+			}
+
+			entryCtx = entryCtx.WithPriority(anteCtx.Priority())
+		}
+
+		if err := p.Insert(entryCtx, tx); err != nil {
+			p.logger.Debug("failed to restore journaled tx", "sender", entry.Sender, "nonce", entry.Nonce, "err", err)
+			continue
+		}
+
+		write()
+		restored++
+	}
+
+	p.logger.Info("restored mempool journal", "restored", restored, "total", len(entries))
+}
@@ -0,0 +1,222 @@
+package abcipp
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"cosmossdk.io/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// newJournalTestMempool builds a journaling mempool whose decoder resolves the
+// txs registered in known, so replayed txs keep their original signer.
+func newJournalTestMempool(t *testing.T, path string, keeper *mockAccountKeeper, known map[string]sdk.Tx) *PriorityMempool {
+	t.Helper()
+
+	decoder := func(bz []byte) (sdk.Tx, error) {
+		tx, ok := known[string(bz)]
+		if !ok {
+			return nil, fmt.Errorf("unknown tx bytes")
+		}
+		return tx, nil
+	}
+
+	mp := NewPriorityMempool(PriorityMempoolConfig{
+		MaxTx:       100,
+		JournalPath: path,
+		TxDecoder:   decoder,
+	}, log.NewNopLogger(), testTxEncoder, keeper)
+	t.Cleanup(func() {
+		mp.StopEventDispatch()
+		assertInvariant(t, mp)
+	})
+
+	return mp
+}
+
+func TestJournalRestoresActiveAndQueued(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "mempool.journal")
+	keeper := newMockAccountKeeper()
+	sdkCtx := testSDKContext()
+	baseApp := testBaseApp{ctx: sdkCtx}
+
+	priv := secp256k1.GenPrivKey()
+	sender := sdk.AccAddress(priv.PubKey().Address())
+	keeper.SetSequence(sender, 0)
+
+	known := make(map[string]sdk.Tx)
+	txs := []*testTx{
+		newTestTxWithPriv(priv, 0, 1000, "default"),
+		newTestTxWithPriv(priv, 1, 1000, "default"),
+		newTestTxWithPriv(priv, 3, 1000, "default"),
+	}
+	for _, tx := range txs {
+		known[string(encodeTx(t, tx))] = tx
+	}
+
+	mp := newJournalTestMempool(t, path, keeper, known)
+	mp.restoreJournal(baseApp) // no journal yet, marks restore as done
+	for _, tx := range txs {
+		require.NoError(t, mp.Insert(sdkCtx.WithPriority(10), tx))
+	}
+	require.NoError(t, mp.FlushJournal())
+
+	restarted := newJournalTestMempool(t, path, keeper, known)
+	require.Equal(t, 0, restarted.CountTx())
+
+	restarted.restoreJournal(baseApp)
+	require.Equal(t, 3, restarted.CountTx())
+	require.Equal(t, 2, activeCount(restarted))
+	require.Equal(t, int64(1), restarted.queuedCount.Load())
+
+	info, err := restarted.GetTxInfo(sdkCtx, txs[2])
+	require.NoError(t, err)
+	require.Equal(t, "queued", info.Tier)
+
+	restarted.mtx.RLock()
+	require.Equal(t, int64(10), restarted.entries[txKey{sender: sender.String(), nonce: 0}].priority)
+	restarted.mtx.RUnlock()
+}
+
+func TestJournalReplayDropsStaleEntries(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "mempool.journal")
+	keeper := newMockAccountKeeper()
+	sdkCtx := testSDKContext()
+	baseApp := testBaseApp{ctx: sdkCtx}
+
+	priv := secp256k1.GenPrivKey()
+	sender := sdk.AccAddress(priv.PubKey().Address())
+	keeper.SetSequence(sender, 0)
+
+	known := make(map[string]sdk.Tx)
+	tx0 := newTestTxWithPriv(priv, 0, 1000, "default")
+	tx1 := newTestTxWithPriv(priv, 1, 1000, "default")
+	known[string(encodeTx(t, tx0))] = tx0
+	known[string(encodeTx(t, tx1))] = tx1
+
+	mp := newJournalTestMempool(t, path, keeper, known)
+	mp.restoreJournal(baseApp)
+	require.NoError(t, mp.Insert(sdkCtx, tx0))
+	require.NoError(t, mp.Insert(sdkCtx, tx1))
+	require.NoError(t, mp.FlushJournal())
+
+	// nonce 0 was committed while the node was down.
+	keeper.SetSequence(sender, 1)
+
+	restarted := newJournalTestMempool(t, path, keeper, known)
+	restarted.restoreJournal(baseApp)
+	require.Equal(t, 1, restarted.CountTx())
+	require.False(t, restarted.Contains(tx0))
+	require.True(t, restarted.Contains(tx1))
+}
+
+func TestFlushJournalSkippedBeforeRestore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "mempool.journal")
+	keeper := newMockAccountKeeper()
+	sdkCtx := testSDKContext()
+
+	priv := secp256k1.GenPrivKey()
+	sender := sdk.AccAddress(priv.PubKey().Address())
+	keeper.SetSequence(sender, 0)
+
+	tx0 := newTestTxWithPriv(priv, 0, 1000, "default")
+	known := map[string]sdk.Tx{string(encodeTx(t, tx0)): tx0}
+
+	mp := newJournalTestMempool(t, path, keeper, known)
+	mp.restoreJournal(testBaseApp{ctx: sdkCtx})
+	require.NoError(t, mp.Insert(sdkCtx, tx0))
+	require.NoError(t, mp.FlushJournal())
+
+	// a node stopped before its journal was replayed must not clobber it.
+	restarted := newJournalTestMempool(t, path, keeper, known)
+	require.NoError(t, restarted.FlushJournal())
+
+	entries, err := readJournal(path)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+}
+
+func TestJournalReplayRunsCheckTx(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "mempool.journal")
+	keeper := newMockAccountKeeper()
+	sdkCtx := testSDKContext()
+	baseApp := testBaseApp{ctx: sdkCtx}
+
+	priv := secp256k1.GenPrivKey()
+	sender := sdk.AccAddress(priv.PubKey().Address())
+	keeper.SetSequence(sender, 0)
+
+	known := make(map[string]sdk.Tx)
+	tx0 := newTestTxWithPriv(priv, 0, 1000, "default")
+	tx1 := newTestTxWithPriv(priv, 1, 1000, "default")
+	known[string(encodeTx(t, tx0))] = tx0
+	known[string(encodeTx(t, tx1))] = tx1
+
+	mp := newJournalTestMempool(t, path, keeper, known)
+	mp.restoreJournal(baseApp)
+	require.NoError(t, mp.Insert(sdkCtx.WithPriority(10), tx0))
+	require.NoError(t, mp.Insert(sdkCtx.WithPriority(10), tx1))
+	require.NoError(t, mp.FlushJournal())
+
+	// the tx at nonce 1 became invalid while the node was down, and the
+	// priority of the remaining tx is recomputed by the ante chain.
+	restarted := newJournalTestMempool(t, path, keeper, known)
+	restarted.cfg.AnteHandler = func(ctx sdk.Context, tx sdk.Tx, _ bool) (sdk.Context, error) {
+		require.True(t, ctx.IsCheckTx())
+		require.False(t, ctx.IsReCheckTx())
+		if tx == tx1 {
+			return ctx, fmt.Errorf("invalid tx")
+		}
+		return ctx.WithPriority(7), nil
+	}
+
+	restarted.restoreJournal(baseApp)
+	require.Equal(t, 1, restarted.CountTx())
+	require.True(t, restarted.Contains(tx0))
+	require.False(t, restarted.Contains(tx1))
+
+	restarted.mtx.RLock()
+	require.Equal(t, int64(7), restarted.entries[txKey{sender: sender.String(), nonce: 0}].priority)
+	restarted.mtx.RUnlock()
+
+	// the dropped entry is removed from the journal on the next flush.
+	require.NoError(t, restarted.FlushJournal())
+	entries, err := readJournal(path)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+}
+
+func TestFlushJournalSkippedWhenUnchanged(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "mempool.journal")
+	keeper := newMockAccountKeeper()
+	sdkCtx := testSDKContext()
+
+	priv := secp256k1.GenPrivKey()
+	sender := sdk.AccAddress(priv.PubKey().Address())
+	keeper.SetSequence(sender, 0)
+
+	tx0 := newTestTxWithPriv(priv, 0, 1000, "default")
+	known := map[string]sdk.Tx{string(encodeTx(t, tx0)): tx0}
+
+	mp := newJournalTestMempool(t, path, keeper, known)
+	mp.restoreJournal(testBaseApp{ctx: sdkCtx})
+	require.NoError(t, mp.Insert(sdkCtx, tx0))
+	require.NoError(t, mp.FlushJournal())
+	require.FileExists(t, path)
+
+	// nothing changed since the last flush, so the journal is not rewritten.
+	require.NoError(t, os.Remove(path))
+	require.NoError(t, mp.FlushJournal())
+	require.NoFileExists(t, path)
+
+	require.NoError(t, mp.Remove(tx0))
+	require.NoError(t, mp.FlushJournal())
+	entries, err := readJournal(path)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+}
@@ -46,7 +46,7 @@ func (p *PriorityMempool) GetTxDistribution() map[string]uint64 {
 	out := make(map[string]uint64, len(p.tierDistribution)+1)
 	maps.Copy(out, p.tierDistribution)
 	if n := p.queuedCount.Load(); n > 0 {
-		out["queued"] = uint64(n)
+		out[QueuedTierName] = uint64(n)
 	}
 
 	return out
@@ -66,12 +66,14 @@ func (p *PriorityMempool) Select(_ context.Context, _ [][]byte) sdkmempool.Itera
 		entries = append(entries, TxInfoEntry{
 			Tx: e.tx,
 			Info: TxInfo{
-				Sender:   e.key.sender,
-				Sequence: e.sequence,
-				Size:     e.size,
-				GasLimit: e.gas,
-				TxBytes:  e.bytes,
-				Tier:     p.tierName(e.tier),
+				Sender:          e.key.sender,
+				Sequence:        e.sequence,
+				Size:            e.size,
+				GasLimit:        e.gas,
+				TxBytes:         e.bytes,
+				Tier:            p.tierName(e.tier),
+				Priority:        e.priority,
+				ClampedPriority: e.clampedPriority,
 			},
 		})
 	}
@@ -112,26 +114,12 @@ func (p *PriorityMempool) GetTxInfo(ctx sdk.Context, tx sdk.Tx) (TxInfo, error)
 	defer p.mtx.RUnlock()
 
 	if entry, ok := p.entries[key]; ok {
-		return TxInfo{
-			Size:     entry.size,
-			GasLimit: entry.gas,
-			Sender:   entry.key.sender,
-			Sequence: entry.sequence,
-			TxBytes:  entry.bytes,
-			Tier:     p.tierName(entry.tier),
-		}, nil
+		return p.activeTxInfo(entry), nil
 	}
 
 	if s := p.senders[key.sender]; s != nil {
 		if entry, exists := s.queued[key.nonce]; exists {
-			return TxInfo{
-				Size:     entry.size,
-				GasLimit: entry.gas,
-				Sender:   entry.key.sender,
-				Sequence: entry.sequence,
-				TxBytes:  entry.bytes,
-				Tier:     "queued",
-			}, nil
+			return queuedTxInfo(entry), nil
 		}
 	}
 
@@ -152,6 +140,99 @@ func (p *PriorityMempool) NextExpectedSequence(sender string) (uint64, bool, err
 	return next, true, nil
 }
 
+// GetSenderTxs returns the active and queued txs of a sender ordered by nonce,
+// together with the sender's next expected sequence.
+func (p *PriorityMempool) GetSenderTxs(sender string) (SenderTxs, bool) {
+	p.mtx.RLock()
+	defer p.mtx.RUnlock()
+
+	s := p.senders[sender]
+	if s == nil {
+		return SenderTxs{}, false
+	}
+
+	out := SenderTxs{
+		NextExpectedSequence: s.nextExpectedNonce(),
+		Active:               make([]TxInfo, 0, len(s.active)),
+		Queued:               make([]TxInfo, 0, len(s.queued)),
+	}
+	for _, entry := range s.active {
+		out.Active = append(out.Active, p.activeTxInfo(entry))
+	}
+	for _, entry := range s.queued {
+		out.Queued = append(out.Queued, queuedTxInfo(entry))
+	}
+
+	sortTxInfos(out.Active)
+	sortTxInfos(out.Queued)
+
+	return out, true
+}
+
+// GetTierTxs returns the txs of a tier ordered by sender and nonce. Only the
+// entries of the requested tier are copied and sorted.
+func (p *PriorityMempool) GetTierTxs(tier string) []TxInfo {
+	p.mtx.RLock()
+	defer p.mtx.RUnlock()
+
+	var out []TxInfo
+	if tier == QueuedTierName {
+		out = make([]TxInfo, 0, p.queuedCount.Load())
+		for _, s := range p.senders {
+			for _, entry := range s.queued {
+				out = append(out, queuedTxInfo(entry))
+			}
+		}
+	} else {
+		out = make([]TxInfo, 0, p.tierDistribution[tier])
+		for _, entry := range p.entries {
+			if p.tierName(entry.tier) == tier {
+				out = append(out, p.activeTxInfo(entry))
+			}
+		}
+	}
+
+	sortTxInfos(out)
+	return out
+}
+
+// activeTxInfo converts an active pool entry into TxInfo.
+func (p *PriorityMempool) activeTxInfo(entry *txEntry) TxInfo {
+	return TxInfo{
+		Size:            entry.size,
+		GasLimit:        entry.gas,
+		Sender:          entry.key.sender,
+		Sequence:        entry.sequence,
+		TxBytes:         entry.bytes,
+		Tier:            p.tierName(entry.tier),
+		Priority:        entry.priority,
+		ClampedPriority: entry.clampedPriority,
+	}
+}
+
+// queuedTxInfo converts a queued pool entry into TxInfo.
+func queuedTxInfo(entry *txEntry) TxInfo {
+	return TxInfo{
+		Size:     entry.size,
+		GasLimit: entry.gas,
+		Sender:   entry.key.sender,
+		Sequence: entry.sequence,
+		TxBytes:  entry.bytes,
+		Tier:     QueuedTierName,
+		Priority: entry.priority,
+	}
+}
+
+// sortTxInfos orders infos by sender and sequence.
+func sortTxInfos(infos []TxInfo) {
+	sort.Slice(infos, func(i, j int) bool {
+		if infos[i].Sender != infos[j].Sender {
+			return infos[i].Sender < infos[j].Sender
+		}
+		return infos[i].Sequence < infos[j].Sequence
+	})
+}
+
 // IteratePendingTxs iterates over sorted active pool entries, calling fn for each. Stops early if fn returns false.
 func (p *PriorityMempool) IteratePendingTxs(fn func(sender string, nonce uint64, tx sdk.Tx) bool) {
 	type item struct {
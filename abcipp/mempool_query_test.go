@@ -77,6 +77,49 @@ func TestGetTxInfo(t *testing.T) {
 	})
 }
 
+func TestGetSenderAndTierTxs(t *testing.T) {
+	mp, keeper, sdkCtx, _ := newTestMempoolWithEvents(t, 64)
+	ctx := sdk.WrapSDKContext(sdkCtx)
+
+	privA := secp256k1.GenPrivKey()
+	privB := secp256k1.GenPrivKey()
+	senderA := sdk.AccAddress(privA.PubKey().Address())
+	senderB := sdk.AccAddress(privB.PubKey().Address())
+	keeper.SetSequence(senderA, 0)
+	keeper.SetSequence(senderB, 0)
+
+	require.NoError(t, mp.Insert(ctx, newTestTxWithPriv(privA, 1, 1000, "default")))
+	require.NoError(t, mp.Insert(ctx, newTestTxWithPriv(privA, 0, 1000, "default")))
+	require.NoError(t, mp.Insert(ctx, newTestTxWithPriv(privA, 3, 1000, "default")))
+	require.NoError(t, mp.Insert(ctx, newTestTxWithPriv(privB, 0, 1000, "default")))
+
+	senderTxs, tracked := mp.GetSenderTxs(senderA.String())
+	require.True(t, tracked)
+	require.Equal(t, uint64(2), senderTxs.NextExpectedSequence)
+	require.Len(t, senderTxs.Active, 2)
+	require.Equal(t, uint64(0), senderTxs.Active[0].Sequence)
+	require.Equal(t, uint64(1), senderTxs.Active[1].Sequence)
+	require.Len(t, senderTxs.Queued, 1)
+	require.Equal(t, uint64(3), senderTxs.Queued[0].Sequence)
+	require.Equal(t, QueuedTierName, senderTxs.Queued[0].Tier)
+
+	_, tracked = mp.GetSenderTxs(testAddress(9).String())
+	require.False(t, tracked)
+
+	active := mp.GetTierTxs("default")
+	require.Len(t, active, 3)
+	for i := 1; i < len(active); i++ {
+		prev, cur := active[i-1], active[i]
+		require.True(t, prev.Sender < cur.Sender || (prev.Sender == cur.Sender && prev.Sequence < cur.Sequence))
+	}
+
+	queued := mp.GetTierTxs(QueuedTierName)
+	require.Len(t, queued, 1)
+	require.Equal(t, senderA.String(), queued[0].Sender)
+
+	require.Empty(t, mp.GetTierTxs("unknown"))
+}
+
 func TestIteratePendingTxsOrdersAndStopsEarly(t *testing.T) {
 	mp, keeper, sdkCtx, _ := newTestMempoolWithEvents(t, 64)
 	ctx := sdk.WrapSDKContext(sdkCtx)
@@ -26,7 +26,16 @@ func (p *PriorityMempool) RemoveWithReason(tx sdk.Tx, reason RemovalReason) erro
 	}
 	removed := p.removeByReasonLocked(key.sender, key.nonce, reason, onChainSeq, hasOnChainSeq)
 	if len(removed) > 0 {
-		p.enqueueRemovedEvents(removed)
+		p.enqueueRemovedEvents(removed, reason)
+
+		// only the tx itself counts against the sender; later nonces demoted
+		// or stale entries dropped alongside it are not charged.
+		for _, entry := range removed {
+			if entry.key == key {
+				p.penalizeRemoved([]*txEntry{entry}, reason)
+				break
+			}
+		}
 		p.mtx.Unlock()
 		return nil
 	}
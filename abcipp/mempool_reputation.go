@@ -0,0 +1,276 @@
+package abcipp
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// DefaultReputationHalfLife is the default time for a sender penalty score to halve.
+	DefaultReputationHalfLife = 10 * time.Minute
+	// DefaultReputationThrottleScore is the default score at which a sender is throttled.
+	DefaultReputationThrottleScore = 5.0
+	// DefaultReputationBanScore is the default score at which a sender is banned.
+	DefaultReputationBanScore = 20.0
+	// DefaultReputationBanDuration is the default time a banned sender is refused.
+	DefaultReputationBanDuration = 30 * time.Minute
+	// DefaultReputationThrottledMaxPending is the default number of pending txs
+	// a throttled sender may keep in the mempool.
+	DefaultReputationThrottledMaxPending = 1
+
+	// anteRejectionPenalty is added for each tx rejected by the ante handler
+	// after admission.
+	anteRejectionPenalty = 1.0
+	// queuedGapExpiredPenalty is added for each queued tx dropped because the
+	// sender never filled its nonce gap.
+	queuedGapExpiredPenalty = 0.5
+	// minTrackedReputationScore is the score below which a sender is forgotten.
+	minTrackedReputationScore = 0.01
+)
+
+// ReputationConfig configures sender admission scoring. Senders accumulate
+// penalty points for txs that pass CheckTx but are later rejected by the ante
+// handler, and for queued txs that expire on a nonce gap. Scores decay with
+// HalfLife. Zero values use the defaults.
+type ReputationConfig struct {
+	// Enabled turns admission scoring on.
+	Enabled bool
+	// HalfLife is the time for a penalty score to halve.
+	HalfLife time.Duration
+	// ThrottleScore is the score at which a sender may keep at most
+	// ThrottledMaxPending txs in the mempool.
+	ThrottleScore float64
+	// BanScore is the score at which a sender is refused for BanDuration.
+	BanScore float64
+	// BanDuration is how long a banned sender is refused.
+	BanDuration time.Duration
+	// ThrottledMaxPending is the active plus queued tx limit for throttled senders.
+	ThrottledMaxPending int
+}
+
+// withDefaults returns the config with zero values replaced by defaults.
+func (c ReputationConfig) withDefaults() ReputationConfig {
+	if c.HalfLife <= 0 {
+		c.HalfLife = DefaultReputationHalfLife
+	}
+	if c.ThrottleScore <= 0 {
+		c.ThrottleScore = DefaultReputationThrottleScore
+	}
+	if c.BanScore <= 0 {
+		c.BanScore = DefaultReputationBanScore
+	}
+	if c.BanDuration <= 0 {
+		c.BanDuration = DefaultReputationBanDuration
+	}
+	if c.ThrottledMaxPending <= 0 {
+		c.ThrottledMaxPending = DefaultReputationThrottledMaxPending
+	}
+	return c
+}
+
+// SenderReputation is the admission state of a sender.
+type SenderReputation struct {
+	Sender string
+	// Score is the decayed penalty score.
+	Score float64
+	// Throttled reports whether the sender is limited to ThrottledMaxPending txs.
+	Throttled bool
+	// BannedUntil is set while the sender is banned.
+	BannedUntil time.Time
+}
+
+// Banned reports whether the sender is banned at now.
+func (r SenderReputation) Banned(now time.Time) bool {
+	return now.Before(r.BannedUntil)
+}
+
+// senderScore is the tracked penalty state of a sender.
+type senderScore struct {
+	score       float64
+	updatedAt   time.Time
+	bannedUntil time.Time
+}
+
+// senderReputations tracks sender penalty scores. It has its own lock so
+// admission checks and penalties never depend on p.mtx.
+type senderReputations struct {
+	mu     sync.Mutex
+	cfg    ReputationConfig
+	scores map[string]*senderScore
+}
+
+// newSenderReputations returns a tracker for cfg, or nil when scoring is disabled.
+func newSenderReputations(cfg ReputationConfig) *senderReputations {
+	if !cfg.Enabled {
+		return nil
+	}
+	return &senderReputations{
+		cfg:    cfg.withDefaults(),
+		scores: make(map[string]*senderScore),
+	}
+}
+
+// decayLocked applies score decay up to now. The caller must hold r.mu.
+func (r *senderReputations) decayLocked(s *senderScore, now time.Time) {
+	if elapsed := now.Sub(s.updatedAt); elapsed > 0 {
+		s.score *= math.Exp2(-float64(elapsed) / float64(r.cfg.HalfLife))
+		s.updatedAt = now
+	}
+}
+
+// penalize adds points to the sender score, banning the sender once the score
+// reaches the ban threshold.
+func (r *senderReputations) penalize(sender string, points float64, now time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	s, ok := r.scores[sender]
+	if !ok {
+		s = &senderScore{updatedAt: now}
+		r.scores[sender] = s
+	}
+
+	r.decayLocked(s, now)
+	s.score += points
+	if s.score >= r.cfg.BanScore && !now.Before(s.bannedUntil) {
+		s.bannedUntil = now.Add(r.cfg.BanDuration)
+	}
+}
+
+// checkAdmission returns an error when the sender is banned, or when it is
+// throttled and a new tx would exceed the throttled pending limit. Replacing
+// one of the sender's pending txs does not add load and is not throttled.
+func (r *senderReputations) checkAdmission(sender string, pending int, replacing bool, now time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	s, ok := r.scores[sender]
+	if !ok {
+		return nil
+	}
+
+	r.decayLocked(s, now)
+	if now.Before(s.bannedUntil) {
+		return errorsmod.Wrapf(
+			sdkerrors.ErrUnauthorized,
+			"sender %s is banned from the mempool until %s",
+			sender,
+			s.bannedUntil.UTC().Format(time.RFC3339),
+		)
+	}
+	if !replacing && s.score >= r.cfg.ThrottleScore && pending >= r.cfg.ThrottledMaxPending {
+		return errorsmod.Wrapf(
+			sdkerrors.ErrUnauthorized,
+			"sender %s is throttled to %d pending txs (score %.2f)",
+			sender,
+			r.cfg.ThrottledMaxPending,
+			s.score,
+		)
+	}
+	return nil
+}
+
+// prune forgets senders whose score decayed away and whose ban expired.
+func (r *senderReputations) prune(now time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for sender, s := range r.scores {
+		r.decayLocked(s, now)
+		if s.score < minTrackedReputationScore && !now.Before(s.bannedUntil) {
+			delete(r.scores, sender)
+		}
+	}
+}
+
+// snapshot returns the reputation of every tracked sender, highest score first.
+func (r *senderReputations) snapshot(now time.Time) []SenderReputation {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	out := make([]SenderReputation, 0, len(r.scores))
+	for sender, s := range r.scores {
+		r.decayLocked(s, now)
+
+		rep := SenderReputation{
+			Sender:    sender,
+			Score:     s.score,
+			Throttled: s.score >= r.cfg.ThrottleScore,
+		}
+		if now.Before(s.bannedUntil) {
+			rep.BannedUntil = s.bannedUntil
+		}
+		out = append(out, rep)
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Score != out[j].Score {
+			return out[i].Score > out[j].Score
+		}
+		return out[i].Sender < out[j].Sender
+	})
+	return out
+}
+
+// reasonPenalty returns the points charged to a sender for a removal reason.
+func reasonPenalty(reason RemovalReason) float64 {
+	switch reason {
+	case RemovalReasonAnteRejectedInPrepare:
+		return anteRejectionPenalty
+	case RemovalReasonQueuedGapExpired:
+		return queuedGapExpiredPenalty
+	default:
+		return 0
+	}
+}
+
+// penalizeRemoved charges the senders of removed entries for the removal reason.
+func (p *PriorityMempool) penalizeRemoved(entries []*txEntry, reason RemovalReason) {
+	points := reasonPenalty(reason)
+	if p.reputations == nil || points == 0 {
+		return
+	}
+
+	now := time.Now()
+	for _, entry := range entries {
+		p.reputations.penalize(entry.key.sender, points, now)
+	}
+}
+
+// checkSenderAdmissionLocked rejects txs from banned senders and new txs from
+// throttled senders. ss is nil for senders without mempool state. the caller
+// must hold p.mtx.
+func (p *PriorityMempool) checkSenderAdmissionLocked(ss *senderState, key txKey) error {
+	if p.reputations == nil {
+		return nil
+	}
+	if ss == nil {
+		return p.reputations.checkAdmission(key.sender, 0, false, time.Now())
+	}
+
+	_, active := ss.active[key.nonce]
+	_, queued := ss.queued[key.nonce]
+	return p.reputations.checkAdmission(key.sender, len(ss.active)+len(ss.queued), active || queued, time.Now())
+}
+
+// pruneReputations forgets senders whose penalties decayed away.
+func (p *PriorityMempool) pruneReputations() {
+	if p.reputations != nil {
+		p.reputations.prune(time.Now())
+	}
+}
+
+// GetSenderReputations returns the reputation of every tracked sender, highest
+// score first. It is empty when sender scoring is disabled.
+func (p *PriorityMempool) GetSenderReputations() []SenderReputation {
+	if p.reputations == nil {
+		return nil
+	}
+	return p.reputations.snapshot(time.Now())
+}
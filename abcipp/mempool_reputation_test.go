@@ -0,0 +1,138 @@
+package abcipp
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/initia/abcipp/types"
+)
+
+func TestReputationThrottlesAndBansSender(t *testing.T) {
+	keeper := newMockAccountKeeper()
+	mp := NewPriorityMempool(PriorityMempoolConfig{
+		MaxTx: 100,
+		Reputation: ReputationConfig{
+			Enabled: true,
+			// thresholds sit between whole penalties since scores decay continuously.
+			ThrottleScore:       1.5,
+			BanScore:            2.5,
+			ThrottledMaxPending: 1,
+		},
+	}, log.NewNopLogger(), testTxEncoder, keeper)
+	t.Cleanup(func() {
+		mp.StopEventDispatch()
+		assertInvariant(t, mp)
+	})
+	ctx := sdk.WrapSDKContext(testSDKContext())
+
+	priv := secp256k1.GenPrivKey()
+	sender := sdk.AccAddress(priv.PubKey().Address())
+	keeper.SetSequence(sender, 0)
+
+	tx0 := newTestTxWithPriv(priv, 0, 1000, "default")
+	tx1 := newTestTxWithPriv(priv, 1, 1000, "default")
+
+	// two ante rejections reach the throttle score.
+	for range 2 {
+		require.NoError(t, mp.Insert(ctx, tx0))
+		require.NoError(t, mp.RemoveWithReason(tx0, RemovalReasonAnteRejectedInPrepare))
+	}
+
+	require.NoError(t, mp.Insert(ctx, tx0))
+	require.ErrorContains(t, mp.Insert(ctx, tx1), "throttled")
+	// replacing the pending tx is still allowed.
+	require.NoError(t, mp.Insert(sdk.UnwrapSDKContext(ctx).WithPriority(100), tx0))
+
+	// the third rejection bans the sender.
+	require.NoError(t, mp.RemoveWithReason(tx0, RemovalReasonAnteRejectedInPrepare))
+	require.ErrorContains(t, mp.Insert(ctx, tx0), "banned")
+
+	// the banned sender is refused before any sender state is created.
+	mp.mtx.RLock()
+	_, tracked := mp.senders[sender.String()]
+	mp.mtx.RUnlock()
+	require.False(t, tracked)
+
+	// other removal reasons do not count against the sender.
+	other := secp256k1.GenPrivKey()
+	keeper.SetSequence(sdk.AccAddress(other.PubKey().Address()), 0)
+	otherTx := newTestTxWithPriv(other, 0, 1000, "default")
+	require.NoError(t, mp.Insert(ctx, otherTx))
+	require.NoError(t, mp.Remove(otherTx))
+
+	server := &MempoolQueryServer{mempool: mp}
+	resp, err := server.QuerySenderReputations(context.Background(), &types.QuerySenderReputationsRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Reputations, 1)
+	require.Equal(t, sender.String(), resp.Reputations[0].Sender)
+	require.True(t, resp.Reputations[0].Throttled)
+	require.True(t, resp.Reputations[0].Banned)
+	require.True(t, resp.Reputations[0].BannedUntil.After(time.Now()))
+}
+
+func TestReputationChargesOnlyRejectedTx(t *testing.T) {
+	keeper := newMockAccountKeeper()
+	mp := NewPriorityMempool(PriorityMempoolConfig{
+		MaxTx: 100,
+		Reputation: ReputationConfig{
+			Enabled:             true,
+			ThrottleScore:       10,
+			BanScore:            20,
+			ThrottledMaxPending: 1,
+		},
+	}, log.NewNopLogger(), testTxEncoder, keeper)
+	t.Cleanup(func() {
+		mp.StopEventDispatch()
+		assertInvariant(t, mp)
+	})
+	ctx := sdk.WrapSDKContext(testSDKContext())
+
+	priv := secp256k1.GenPrivKey()
+	sender := sdk.AccAddress(priv.PubKey().Address())
+	keeper.SetSequence(sender, 0)
+
+	tx0 := newTestTxWithPriv(priv, 0, 1000, "default")
+	for nonce := range uint64(3) {
+		require.NoError(t, mp.Insert(ctx, newTestTxWithPriv(priv, nonce, 1000, "default")))
+	}
+
+	// rejecting the head demotes nonces 1 and 2, but only the head is charged.
+	require.NoError(t, mp.RemoveWithReason(tx0, RemovalReasonAnteRejectedInPrepare))
+
+	reps := mp.GetSenderReputations()
+	require.Len(t, reps, 1)
+	require.InDelta(t, anteRejectionPenalty, reps[0].Score, 1e-3)
+}
+
+func TestSenderReputationDecay(t *testing.T) {
+	reps := newSenderReputations(ReputationConfig{
+		Enabled:       true,
+		HalfLife:      time.Minute,
+		ThrottleScore: 3,
+		BanScore:      100,
+	})
+	now := time.Unix(1_000_000, 0)
+
+	reps.penalize("sender", 4, now)
+	require.Error(t, reps.checkAdmission("sender", 1, false, now))
+
+	// one half-life later the score dropped below the throttle score.
+	later := now.Add(time.Minute)
+	require.NoError(t, reps.checkAdmission("sender", 1, false, later))
+	snapshot := reps.snapshot(later)
+	require.Len(t, snapshot, 1)
+	require.InDelta(t, 2.0, snapshot[0].Score, 1e-9)
+	require.False(t, snapshot[0].Throttled)
+
+	reps.prune(now.Add(time.Hour))
+	require.Empty(t, reps.snapshot(now.Add(time.Hour)))
+
+	require.Nil(t, newSenderReputations(ReputationConfig{}))
+}
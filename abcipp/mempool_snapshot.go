@@ -0,0 +1,249 @@
+package abcipp
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/huandu/skiplist"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/initia/abcipp/types"
+)
+
+// SnapshotVersion is bumped whenever the mempool snapshot layout changes.
+const SnapshotVersion = 1
+
+// ExportSnapshot returns the full mempool state. Active entries are listed in
+// Select order and queued entries by sender and nonce, so replaying the
+// snapshot with ImportSnapshot reproduces the same Select order.
+func (p *PriorityMempool) ExportSnapshot() *types.MempoolSnapshot {
+	p.mtx.RLock()
+	defer p.mtx.RUnlock()
+
+	snapshot := &types.MempoolSnapshot{
+		Version:  SnapshotVersion,
+		Tiers:    make([]string, 0, len(p.tiers)),
+		OrderSeq: p.orderSeq,
+		Senders:  make([]*types.MempoolSnapshotSender, 0, len(p.senders)),
+		Active:   make([]*types.MempoolSnapshotEntry, 0, p.priorityIndex.Len()),
+		Queued:   make([]*types.MempoolSnapshotEntry, 0, int(p.queuedCount.Load())),
+	}
+	for _, tier := range p.tiers {
+		snapshot.Tiers = append(snapshot.Tiers, tier.Name)
+	}
+
+	for sender, ss := range p.senders {
+		snapshot.Senders = append(snapshot.Senders, &types.MempoolSnapshotSender{
+			Sender:     sender,
+			OnChainSeq: ss.onChainSeq,
+			ActiveMin:  ss.activeMin,
+			ActiveMax:  ss.activeMax,
+			QueuedMin:  ss.queuedMin,
+			QueuedMax:  ss.queuedMax,
+			GapSince:   ss.gapSince,
+		})
+		for _, entry := range ss.queued {
+			snapshot.Queued = append(snapshot.Queued, p.snapshotEntryLocked(entry))
+		}
+	}
+	sort.Slice(snapshot.Senders, func(i, j int) bool {
+		return snapshot.Senders[i].Sender < snapshot.Senders[j].Sender
+	})
+	sort.Slice(snapshot.Queued, func(i, j int) bool {
+		if snapshot.Queued[i].Sender != snapshot.Queued[j].Sender {
+			return snapshot.Queued[i].Sender < snapshot.Queued[j].Sender
+		}
+		return snapshot.Queued[i].Sequence < snapshot.Queued[j].Sequence
+	})
+
+	for node := p.priorityIndex.Front(); node != nil; node = node.Next() {
+		snapshot.Active = append(snapshot.Active, p.snapshotEntryLocked(node.Value.(*txEntry)))
+	}
+
+	return snapshot
+}
+
+// snapshotEntryLocked converts an entry into its snapshot form. the caller must hold p.mtx.
+func (p *PriorityMempool) snapshotEntryLocked(entry *txEntry) *types.MempoolSnapshotEntry {
+	tier := QueuedTierName
+	if entry.tier != queuedTier {
+		tier = p.tierName(entry.tier)
+	}
+
+	return &types.MempoolSnapshotEntry{
+		Sender:          entry.key.sender,
+		Sequence:        entry.key.nonce,
+		Tier:            tier,
+		Priority:        entry.priority,
+		ClampedPriority: entry.clampedPriority,
+		ClampedOrder:    entry.clampedOrder,
+		Order:           entry.order,
+		GasLimit:        entry.gas,
+		TxSize:          entry.size,
+		TxBytes:         entry.bytes,
+	}
+}
+
+// ImportSnapshot restores a snapshot taken by ExportSnapshot into an empty
+// mempool. Entries keep their recorded ranks instead of being re-inserted, so
+// tier matchers, capacity limits and account sequences are not consulted and
+// no events are emitted. Every tier named by the snapshot must be configured.
+func (p *PriorityMempool) ImportSnapshot(snapshot *types.MempoolSnapshot, txDecoder sdk.TxDecoder) error {
+	if snapshot == nil {
+		return fmt.Errorf("snapshot is required")
+	}
+	if snapshot.Version != SnapshotVersion {
+		return fmt.Errorf("unsupported mempool snapshot version %d", snapshot.Version)
+	}
+	if txDecoder == nil {
+		return fmt.Errorf("tx decoder is required")
+	}
+
+	if err := p.importSnapshot(snapshot, txDecoder); err != nil {
+		return err
+	}
+
+	if err := p.ValidateInvariants(); err != nil {
+		p.reset()
+		return fmt.Errorf("inconsistent mempool snapshot: %w", err)
+	}
+
+	p.journalDirty.Store(true)
+	return nil
+}
+
+// importSnapshot rebuilds pool state from snapshot under p.mtx.
+func (p *PriorityMempool) importSnapshot(snapshot *types.MempoolSnapshot, txDecoder sdk.TxDecoder) (err error) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	if len(p.entries) != 0 || len(p.senders) != 0 || p.queuedCount.Load() != 0 {
+		return fmt.Errorf("mempool must be empty to import a snapshot")
+	}
+
+	defer func() {
+		if err != nil {
+			p.clearLocked()
+		}
+	}()
+
+	tierIdx := make(map[string]int, len(p.tiers))
+	for idx, tier := range p.tiers {
+		tierIdx[tier.Name] = idx
+	}
+
+	for _, s := range snapshot.Senders {
+		if _, ok := p.senders[s.Sender]; ok {
+			return fmt.Errorf("duplicate snapshot sender %s", s.Sender)
+		}
+		ss := p.getOrCreateSenderLocked(s.Sender)
+		ss.onChainSeq = s.OnChainSeq
+		ss.gapSince = s.GapSince
+	}
+
+	maxOrder := snapshot.OrderSeq
+	for _, e := range snapshot.Active {
+		idx, ok := tierIdx[e.Tier]
+		if !ok {
+			return fmt.Errorf("snapshot tx %s/%d has unknown tier %q", e.Sender, e.Sequence, e.Tier)
+		}
+
+		entry, err := decodeSnapshotEntry(e, txDecoder)
+		if err != nil {
+			return err
+		}
+		if _, ok := p.entries[entry.key]; ok {
+			return fmt.Errorf("duplicate snapshot tx %s/%d", e.Sender, e.Sequence)
+		}
+		if _, ok := p.senders[e.Sender]; !ok {
+			return fmt.Errorf("snapshot tx %s/%d has no sender state", e.Sender, e.Sequence)
+		}
+
+		entry.tier = idx
+		entry.order = e.Order
+		entry.clampedPriority = e.ClampedPriority
+		entry.clampedOrder = e.ClampedOrder
+		p.addEntryLocked(entry)
+		maxOrder = max(maxOrder, e.Order)
+	}
+
+	for _, e := range snapshot.Queued {
+		entry, err := decodeSnapshotEntry(e, txDecoder)
+		if err != nil {
+			return err
+		}
+
+		ss, ok := p.senders[e.Sender]
+		if !ok {
+			return fmt.Errorf("snapshot tx %s/%d has no sender state", e.Sender, e.Sequence)
+		}
+		if _, ok := ss.queued[e.Sequence]; ok {
+			return fmt.Errorf("duplicate snapshot tx %s/%d", e.Sender, e.Sequence)
+		}
+
+		entry.tier = queuedTier
+		ss.queued[e.Sequence] = entry
+		p.queuedCount.Add(1)
+		ss.setQueuedRangeOnInsertLocked(e.Sequence)
+	}
+
+	// recorded ranges must agree with the ranges rebuilt from the entries.
+	for _, s := range snapshot.Senders {
+		ss := p.senders[s.Sender]
+		if ss.activeMin != s.ActiveMin || ss.activeMax != s.ActiveMax ||
+			ss.queuedMin != s.QueuedMin || ss.queuedMax != s.QueuedMax {
+			return fmt.Errorf(
+				"snapshot sender %s ranges active [%d,%d] queued [%d,%d] do not match its txs",
+				s.Sender, s.ActiveMin, s.ActiveMax, s.QueuedMin, s.QueuedMax,
+			)
+		}
+	}
+
+	p.orderSeq = maxOrder
+	return nil
+}
+
+// decodeSnapshotEntry decodes the tx of a snapshot entry and checks that its
+// first signature matches the recorded sender and sequence.
+func decodeSnapshotEntry(e *types.MempoolSnapshotEntry, txDecoder sdk.TxDecoder) (*txEntry, error) {
+	tx, err := txDecoder(e.TxBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode snapshot tx %s/%d: %w", e.Sender, e.Sequence, err)
+	}
+
+	key, err := txKeyFromTx(tx)
+	if err != nil {
+		return nil, fmt.Errorf("snapshot tx %s/%d: %w", e.Sender, e.Sequence, err)
+	}
+	if key.sender != e.Sender || key.nonce != e.Sequence {
+		return nil, fmt.Errorf("snapshot tx %s/%d is signed by %s/%d", e.Sender, e.Sequence, key.sender, key.nonce)
+	}
+
+	return &txEntry{
+		tx:       tx,
+		priority: e.Priority,
+		size:     e.TxSize,
+		key:      key,
+		sequence: key.nonce,
+		gas:      e.GasLimit,
+		bytes:    e.TxBytes,
+	}, nil
+}
+
+// reset drops all pool state.
+func (p *PriorityMempool) reset() {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	p.clearLocked()
+}
+
+// clearLocked drops all pool state. the caller must hold p.mtx.
+func (p *PriorityMempool) clearLocked() {
+	p.priorityIndex = skiplist.New(skiplist.GreaterThanFunc(compareEntries))
+	p.entries = make(map[txKey]*txEntry)
+	p.senders = make(map[string]*senderState)
+	p.tierDistribution = initTierDistribution(p.tiers)
+	p.queuedCount.Store(0)
+}
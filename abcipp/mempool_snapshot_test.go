@@ -0,0 +1,107 @@
+package abcipp
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// selectOrder returns the (sender, nonce) pairs in Select order.
+func selectOrder(mp *PriorityMempool) []string {
+	var order []string
+	for it := mp.Select(context.Background(), nil); it != nil; it = it.Next() {
+		info := it.(TxInfoIterator).TxInfo()
+		order = append(order, fmt.Sprintf("%s/%d", info.Sender, info.Sequence))
+	}
+	return order
+}
+
+func TestSnapshotRoundtripPreservesSelectOrder(t *testing.T) {
+	tiers := []Tier{testTierMatcher("high"), testTierMatcher("low")}
+	mp := newTestPriorityMempool(t, tiers)
+	sdkCtx := testSDKContext()
+
+	known := make(map[string]sdk.Tx)
+	insert := func(tx *testTx, priority int64) {
+		known[string(encodeTx(t, tx))] = tx
+		require.NoError(t, mp.Insert(sdk.WrapSDKContext(sdkCtx.WithPriority(priority)), tx))
+	}
+
+	privA := secp256k1.GenPrivKey()
+	privB := secp256k1.GenPrivKey()
+	privC := secp256k1.GenPrivKey()
+	insert(newTestTxWithPriv(privA, 0, 1000, "low"), 50)
+	insert(newTestTxWithPriv(privA, 1, 1000, "high"), 90)
+	insert(newTestTxWithPriv(privB, 0, 1000, "high"), 10)
+	insert(newTestTxWithPriv(privB, 3, 1000, "high"), 10)
+	insert(newTestTxWithPriv(privC, 0, 1000, "unknown"), 70)
+	insert(newTestTxWithPriv(privC, 1, 1000, "low"), 70)
+
+	snapshot := mp.ExportSnapshot()
+	require.Equal(t, []string{"high", "low", DefaultTierName}, snapshot.Tiers)
+	require.Len(t, snapshot.Active, 5)
+	require.Len(t, snapshot.Queued, 1)
+	require.Equal(t, QueuedTierName, snapshot.Queued[0].Tier)
+	require.Equal(t, uint64(3), snapshot.Queued[0].Sequence)
+
+	decoder := func(bz []byte) (sdk.Tx, error) {
+		tx, ok := known[string(bz)]
+		if !ok {
+			return nil, fmt.Errorf("unknown tx bytes")
+		}
+		return tx, nil
+	}
+
+	// the replayed mempool never consults its matchers, so the restored tiers
+	// come from the snapshot alone.
+	replay := newTestPriorityMempool(t, []Tier{testTierMatcher("high"), testTierMatcher("low")})
+	require.NoError(t, replay.ImportSnapshot(snapshot, decoder))
+	assertInvariant(t, replay)
+
+	require.Equal(t, selectOrder(mp), selectOrder(replay))
+	require.Equal(t, mp.GetTxDistribution(), replay.GetTxDistribution())
+	require.Equal(t, snapshot, replay.ExportSnapshot())
+
+	// new txs are ordered after the restored ones.
+	senderB := sdk.AccAddress(privB.PubKey().Address()).String()
+	require.NoError(t, replay.Insert(sdk.WrapSDKContext(sdkCtx.WithPriority(10)), newTestTxWithPriv(privB, 1, 1000, "high")))
+	next, ok, err := replay.NextExpectedSequence(senderB)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, uint64(2), next)
+}
+
+func TestImportSnapshotRejectsInvalidSnapshots(t *testing.T) {
+	mp := newTestPriorityMempool(t, []Tier{testTierMatcher("high")})
+	ctx := sdk.WrapSDKContext(testSDKContext())
+
+	priv := secp256k1.GenPrivKey()
+	tx := newTestTxWithPriv(priv, 0, 1000, "high")
+	require.NoError(t, mp.Insert(ctx, tx))
+
+	decoder := func([]byte) (sdk.Tx, error) { return tx, nil }
+	snapshot := mp.ExportSnapshot()
+
+	// a non-empty mempool cannot import.
+	require.Error(t, mp.ImportSnapshot(snapshot, decoder))
+
+	// tiers must be configured on the importing mempool.
+	replay := newTestPriorityMempool(t, []Tier{testTierMatcher("low")})
+	require.Error(t, replay.ImportSnapshot(snapshot, decoder))
+	require.Equal(t, 0, replay.CountTx())
+
+	// recorded ranges must match the txs.
+	replay = newTestPriorityMempool(t, []Tier{testTierMatcher("high")})
+	snapshot.Senders[0].ActiveMax = 3
+	require.Error(t, replay.ImportSnapshot(snapshot, decoder))
+	require.Equal(t, 0, replay.CountTx())
+	snapshot.Senders[0].ActiveMax = 0
+
+	snapshot.Version = SnapshotVersion + 1
+	require.Error(t, replay.ImportSnapshot(snapshot, decoder))
+}
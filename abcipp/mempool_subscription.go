@@ -0,0 +1,161 @@
+package abcipp
+
+import (
+	"slices"
+	"sync"
+)
+
+// DefaultEventSubscriptionBuffer is the default per-subscriber event buffer.
+const DefaultEventSubscriptionBuffer = 1024
+
+// MempoolEventType identifies the kind of state change reported to subscribers.
+type MempoolEventType uint8
+
+const (
+	// MempoolEventInserted is emitted when a tx enters the active set directly.
+	MempoolEventInserted MempoolEventType = iota
+	// MempoolEventQueued is emitted when a future-nonce tx enters the queued pool.
+	MempoolEventQueued
+	// MempoolEventPromoted is emitted when a queued tx is promoted to the active set.
+	MempoolEventPromoted
+	// MempoolEventRemoved is emitted when a tx is deleted from the mempool.
+	MempoolEventRemoved
+)
+
+// MempoolEvent describes a single mempool state change delivered to subscribers.
+type MempoolEvent struct {
+	Type     MempoolEventType
+	Sender   string
+	Sequence uint64
+	Tier     string
+	TxHash   string
+	// Reason is only meaningful for MempoolEventRemoved.
+	Reason RemovalReason
+}
+
+// MempoolEventFilter restricts the events delivered to a subscriber.
+// Empty fields match everything.
+type MempoolEventFilter struct {
+	Senders []string
+	Tiers   []string
+}
+
+// matches reports whether ev passes the filter.
+func (f MempoolEventFilter) matches(ev MempoolEvent) bool {
+	if len(f.Senders) > 0 && !slices.Contains(f.Senders, ev.Sender) {
+		return false
+	}
+	if len(f.Tiers) > 0 && !slices.Contains(f.Tiers, ev.Tier) {
+		return false
+	}
+	return true
+}
+
+// eventSubscription is a single registered subscriber.
+type eventSubscription struct {
+	ch     chan MempoolEvent
+	filter MempoolEventFilter
+}
+
+// eventSubscriptions tracks registered subscribers.
+type eventSubscriptions struct {
+	mu     sync.Mutex
+	nextID uint64
+	subs   map[uint64]*eventSubscription
+	closed bool
+}
+
+// SubscribeEvents registers a subscriber for mempool state changes matching
+// filter. The returned channel is closed when cancel is called, when the
+// mempool stops, or when the subscriber falls more than bufSize events behind;
+// a lagging subscriber never blocks the mempool.
+func (p *PriorityMempool) SubscribeEvents(filter MempoolEventFilter, bufSize int) (<-chan MempoolEvent, func()) {
+	if bufSize <= 0 {
+		bufSize = DefaultEventSubscriptionBuffer
+	}
+
+	s := &p.subscriptions
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sub := &eventSubscription{
+		ch:     make(chan MempoolEvent, bufSize),
+		filter: filter,
+	}
+	if s.closed {
+		close(sub.ch)
+		return sub.ch, func() {}
+	}
+	if s.subs == nil {
+		s.subs = make(map[uint64]*eventSubscription)
+	}
+
+	id := s.nextID
+	s.nextID++
+	s.subs[id] = sub
+
+	return sub.ch, func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		if _, ok := s.subs[id]; ok {
+			delete(s.subs, id)
+			close(sub.ch)
+		}
+	}
+}
+
+// closeSubscriptions closes every subscriber channel and rejects new subscribers.
+func (p *PriorityMempool) closeSubscriptions() {
+	s := &p.subscriptions
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.closed = true
+	for id, sub := range s.subs {
+		delete(s.subs, id)
+		close(sub.ch)
+	}
+}
+
+// publishEvent delivers an event for entry to every matching subscriber.
+func (p *PriorityMempool) publishEvent(eventType MempoolEventType, entry *txEntry, reason RemovalReason) {
+	s := &p.subscriptions
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.subs) == 0 {
+		return
+	}
+
+	ev := MempoolEvent{
+		Type:     eventType,
+		Sender:   entry.key.sender,
+		Sequence: entry.key.nonce,
+		Tier:     p.entryTierName(entry),
+		TxHash:   TxHash(entry.bytes),
+		Reason:   reason,
+	}
+	for id, sub := range s.subs {
+		if !sub.filter.matches(ev) {
+			continue
+		}
+
+		select {
+		case sub.ch <- ev:
+		default:
+			// the subscriber fell behind; drop it so it can resync via queries.
+			delete(s.subs, id)
+			close(sub.ch)
+		}
+	}
+}
+
+// entryTierName returns the tier name of an active entry, or QueuedTierName
+// for queued entries.
+func (p *PriorityMempool) entryTierName(entry *txEntry) string {
+	if entry.tier == queuedTier {
+		return QueuedTierName
+	}
+	return p.tierName(entry.tier)
+}
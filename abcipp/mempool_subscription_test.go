@@ -0,0 +1,124 @@
+package abcipp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// readEvents reads n buffered events from a subscription channel.
+func readEvents(t *testing.T, ch <-chan MempoolEvent, n int) []MempoolEvent {
+	t.Helper()
+
+	events := make([]MempoolEvent, 0, n)
+	for range n {
+		select {
+		case ev, ok := <-ch:
+			require.True(t, ok, "subscription closed early")
+			events = append(events, ev)
+		default:
+			t.Fatalf("expected %d events, got %d", n, len(events))
+		}
+	}
+	return events
+}
+
+func TestSubscribeEventsReportsLifecycle(t *testing.T) {
+	mp, keeper, sdkCtx, _ := newTestMempoolWithEvents(t, 32)
+	ctx := sdk.WrapSDKContext(sdkCtx)
+
+	priv := secp256k1.GenPrivKey()
+	sender := sdk.AccAddress(priv.PubKey().Address())
+	keeper.SetSequence(sender, 0)
+
+	events, cancel := mp.SubscribeEvents(MempoolEventFilter{}, 16)
+	defer cancel()
+
+	tx0 := newTestTxWithPriv(priv, 0, 1000, "default")
+	require.NoError(t, mp.Insert(ctx, tx0))
+	require.NoError(t, mp.Insert(ctx, newTestTxWithPriv(priv, 2, 1000, "default")))
+	require.NoError(t, mp.Insert(ctx, newTestTxWithPriv(priv, 1, 1000, "default")))
+	require.NoError(t, mp.RemoveWithReason(tx0, RemovalReasonAnteRejectedInPrepare))
+
+	got := readEvents(t, events, 4)
+	require.Equal(t, MempoolEventInserted, got[0].Type)
+	require.Equal(t, uint64(0), got[0].Sequence)
+	require.Equal(t, "default", got[0].Tier)
+	require.Equal(t, TxHash(encodeTx(t, tx0)), got[0].TxHash)
+
+	require.Equal(t, MempoolEventQueued, got[1].Type)
+	require.Equal(t, QueuedTierName, got[1].Tier)
+
+	require.Equal(t, MempoolEventInserted, got[2].Type)
+	require.Equal(t, uint64(1), got[2].Sequence)
+	require.Equal(t, MempoolEventPromoted, got[3].Type)
+	require.Equal(t, uint64(2), got[3].Sequence)
+
+	// ante rejection removes nonce 0 and demotes the suffix without deleting it.
+	removed := readEvents(t, events, 1)
+	require.Equal(t, MempoolEventRemoved, removed[0].Type)
+	require.Equal(t, RemovalReasonAnteRejectedInPrepare, removed[0].Reason)
+	require.Equal(t, sender.String(), removed[0].Sender)
+}
+
+func TestSubscribeEventsFiltersBySender(t *testing.T) {
+	mp, keeper, sdkCtx, _ := newTestMempoolWithEvents(t, 32)
+	ctx := sdk.WrapSDKContext(sdkCtx)
+
+	privA := secp256k1.GenPrivKey()
+	privB := secp256k1.GenPrivKey()
+	senderA := sdk.AccAddress(privA.PubKey().Address())
+	keeper.SetSequence(senderA, 0)
+	keeper.SetSequence(sdk.AccAddress(privB.PubKey().Address()), 0)
+
+	events, cancel := mp.SubscribeEvents(MempoolEventFilter{Senders: []string{senderA.String()}}, 16)
+	defer cancel()
+
+	require.NoError(t, mp.Insert(ctx, newTestTxWithPriv(privB, 0, 1000, "default")))
+	require.NoError(t, mp.Insert(ctx, newTestTxWithPriv(privA, 0, 1000, "default")))
+
+	got := readEvents(t, events, 1)
+	require.Equal(t, senderA.String(), got[0].Sender)
+	require.Empty(t, events)
+}
+
+func TestSubscribeEventsDropsLaggingSubscriber(t *testing.T) {
+	mp, keeper, sdkCtx, _ := newTestMempoolWithEvents(t, 32)
+	ctx := sdk.WrapSDKContext(sdkCtx)
+
+	priv := secp256k1.GenPrivKey()
+	keeper.SetSequence(sdk.AccAddress(priv.PubKey().Address()), 0)
+
+	events, cancel := mp.SubscribeEvents(MempoolEventFilter{}, 1)
+	defer cancel()
+
+	require.NoError(t, mp.Insert(ctx, newTestTxWithPriv(priv, 0, 1000, "default")))
+	require.NoError(t, mp.Insert(ctx, newTestTxWithPriv(priv, 1, 1000, "default")))
+
+	// the buffered event is still delivered, then the channel is closed.
+	_, ok := <-events
+	require.True(t, ok)
+	_, ok = <-events
+	require.False(t, ok)
+
+	// cancelling an already dropped subscription is a no-op.
+	require.NotPanics(t, cancel)
+}
+
+func TestSubscribeEventsClosedOnStop(t *testing.T) {
+	mp := newTestPriorityMempool(t, nil)
+
+	events, cancel := mp.SubscribeEvents(MempoolEventFilter{}, 4)
+	defer cancel()
+
+	mp.Stop()
+	_, ok := <-events
+	require.False(t, ok)
+
+	late, _ := mp.SubscribeEvents(MempoolEventFilter{}, 4)
+	_, ok = <-late
+	require.False(t, ok)
+}
@@ -576,3 +576,91 @@ func TestValidateInvariantsDetectsCorruption(t *testing.T) {
 		require.ErrorContains(t, err, "active/global count mismatch")
 	})
 }
+
+// TestReplacementRequiresMinimumBump verifies same-nonce replacement only
+// succeeds once the configured minimum priority bump is met.
+func TestReplacementRequiresMinimumBump(t *testing.T) {
+	keeper := newMockAccountKeeper()
+	mp := NewPriorityMempool(PriorityMempoolConfig{
+		MaxTx:                   32,
+		ReplacementPriorityBump: 20,
+	}, log.NewNopLogger(), testTxEncoder, keeper)
+	sdkCtx := testSDKContext()
+	t.Cleanup(func() {
+		mp.StopEventDispatch()
+		assertInvariant(t, mp)
+	})
+
+	priv := secp256k1.GenPrivKey()
+	sender := sdk.AccAddress(priv.PubKey().Address())
+	keeper.SetSequence(sender, 0)
+
+	txOrig := newTestTxWithPriv(priv, 0, 1000, "default")
+	require.NoError(t, mp.Insert(sdk.WrapSDKContext(sdkCtx.WithPriority(100)), txOrig))
+	txQueued := newTestTxWithPriv(priv, 2, 1000, "default")
+	require.NoError(t, mp.Insert(sdk.WrapSDKContext(sdkCtx.WithPriority(100)), txQueued))
+
+	// +19% is below the 20% bump for both active and queued entries.
+	err := mp.Insert(sdk.WrapSDKContext(sdkCtx.WithPriority(119)), newTestTxWithPriv(priv, 0, 1000, "default"))
+	require.ErrorIs(t, err, sdkerrors.ErrTxInMempoolCache)
+	err = mp.Insert(sdk.WrapSDKContext(sdkCtx.WithPriority(119)), newTestTxWithPriv(priv, 2, 1000, "default"))
+	require.ErrorIs(t, err, sdkerrors.ErrTxInMempoolCache)
+	require.True(t, mp.Contains(txOrig))
+	require.True(t, mp.Contains(txQueued))
+
+	// +20% meets the bump.
+	txBumped := newTestTxWithPriv(priv, 0, 1000, "default")
+	require.NoError(t, mp.Insert(sdk.WrapSDKContext(sdkCtx.WithPriority(120)), txBumped))
+	hash, ok := mp.Lookup(sender.String(), 0)
+	require.True(t, ok)
+	require.Equal(t, TxHash(encodeTx(t, txBumped)), hash)
+	require.Equal(t, 2, mp.CountTx())
+}
+
+// TestReplacementDefaultsToNoBump verifies the default config keeps the rule
+// that a replacement only needs a strictly higher priority.
+func TestReplacementDefaultsToNoBump(t *testing.T) {
+	keeper := newMockAccountKeeper()
+	mp := NewPriorityMempool(PriorityMempoolConfig{MaxTx: 32}, log.NewNopLogger(), testTxEncoder, keeper)
+	sdkCtx := testSDKContext()
+	t.Cleanup(func() {
+		mp.StopEventDispatch()
+		assertInvariant(t, mp)
+	})
+	require.Equal(t, 0, mp.replacementBump)
+
+	priv := secp256k1.GenPrivKey()
+	sender := sdk.AccAddress(priv.PubKey().Address())
+	keeper.SetSequence(sender, 0)
+
+	require.NoError(t, mp.Insert(sdk.WrapSDKContext(sdkCtx.WithPriority(100)), newTestTxWithPriv(priv, 0, 1000, "default")))
+	err := mp.Insert(sdk.WrapSDKContext(sdkCtx.WithPriority(100)), newTestTxWithPriv(priv, 0, 1000, "default"))
+	require.ErrorIs(t, err, sdkerrors.ErrTxInMempoolCache)
+
+	txHigher := newTestTxWithPriv(priv, 0, 1000, "default")
+	require.NoError(t, mp.Insert(sdk.WrapSDKContext(sdkCtx.WithPriority(101)), txHigher))
+	hash, ok := mp.Lookup(sender.String(), 0)
+	require.True(t, ok)
+	require.Equal(t, TxHash(encodeTx(t, txHigher)), hash)
+}
+
+func TestMeetsReplacementBump(t *testing.T) {
+	cases := []struct {
+		existing, candidate int64
+		bump                int
+		expected            bool
+	}{
+		{existing: 100, candidate: 100, bump: 0, expected: false},
+		{existing: 100, candidate: 101, bump: -1, expected: true},
+		{existing: 100, candidate: 109, bump: 10, expected: false},
+		{existing: 100, candidate: 110, bump: 10, expected: true},
+		{existing: 0, candidate: 1, bump: 10, expected: true},
+		{existing: 1, candidate: 2, bump: 10, expected: true},
+		{existing: 1 << 62, candidate: 1<<62 + 1, bump: 10, expected: false},
+	}
+
+	for _, tc := range cases {
+		require.Equal(t, tc.expected, meetsReplacementBump(tc.existing, tc.candidate, tc.bump),
+			"existing=%d candidate=%d bump=%d", tc.existing, tc.candidate, tc.bump)
+	}
+}
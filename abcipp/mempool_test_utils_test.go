@@ -101,6 +101,26 @@ func collectNEvents(t *testing.T, ch <-chan cmtmempool.AppMempoolEvent, n int, t
 	return events
 }
 
+// collectNAppEvents is collectNEvents for the app event channel.
+func collectNAppEvents(t *testing.T, ch <-chan AppEvent, n int, timeout time.Duration) []AppEvent {
+	t.Helper()
+
+	events := make([]AppEvent, 0, n)
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	for len(events) < n {
+		select {
+		case ev := <-ch:
+			events = append(events, ev)
+		case <-timer.C:
+			t.Fatalf("timed out waiting for %d app events, got %d", n, len(events))
+		}
+	}
+
+	return events
+}
+
 // encodeTx serializes a test tx and fails the test if encoding fails.
 func encodeTx(t *testing.T, tx sdk.Tx) []byte {
 	t.Helper()
@@ -4,12 +4,16 @@ import (
 	"fmt"
 	"strings"
 
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 type tierMatcher struct {
-	Name    string
-	Matcher TierMatcher
+	Name               string
+	Matcher            TierMatcher
+	MaxBlockShare      math.LegacyDec
+	ReservedBlockShare math.LegacyDec
 }
 
 // compareEntries orders active txEntries by clamped rank and deterministic ties.
@@ -89,20 +93,60 @@ func buildTierMatchers(cfg PriorityMempoolConfig) []tierMatcher {
 			name = fmt.Sprintf("tier-%d", idx)
 		}
 
+		maxShare := normalizeBlockShare(tier.MaxBlockShare)
+		reserved := normalizeBlockShare(tier.ReservedBlockShare)
+		if maxShare.IsPositive() && reserved.GT(maxShare) {
+			reserved = maxShare
+		}
+
 		matchers = append(matchers, tierMatcher{
-			Name:    name,
-			Matcher: tier.Matcher,
+			Name:               name,
+			Matcher:            tier.Matcher,
+			MaxBlockShare:      maxShare,
+			ReservedBlockShare: reserved,
 		})
 	}
 
 	matchers = append(matchers, tierMatcher{
-		Name:    "default",
-		Matcher: func(ctx sdk.Context, tx sdk.Tx) bool { return true },
+		Name:               DefaultTierName,
+		Matcher:            func(ctx sdk.Context, tx sdk.Tx) bool { return true },
+		MaxBlockShare:      math.LegacyZeroDec(),
+		ReservedBlockShare: math.LegacyZeroDec(),
 	})
 
 	return matchers
 }
 
+// normalizeBlockShare maps unset or non-positive shares to zero (unlimited) and
+// caps shares above one.
+func normalizeBlockShare(share math.LegacyDec) math.LegacyDec {
+	if share.IsNil() || !share.IsPositive() {
+		return math.LegacyZeroDec()
+	}
+	if share.GT(math.LegacyOneDec()) {
+		return math.LegacyOneDec()
+	}
+	return share
+}
+
+// GetTierLimits returns the block space limits of each tier in match order.
+func (p *PriorityMempool) GetTierLimits() []TierLimit {
+	limits := make([]TierLimit, 0, len(p.tiers))
+	for _, tier := range p.tiers {
+		limits = append(limits, TierLimit{
+			Name:               tier.Name,
+			MaxBlockShare:      tier.MaxBlockShare,
+			ReservedBlockShare: tier.ReservedBlockShare,
+		})
+	}
+	return limits
+}
+
+// SelectTierName returns the name of the tier the tx matches.
+func (p *PriorityMempool) SelectTierName(ctx sdk.Context, tx sdk.Tx) string {
+	return p.tierName(p.selectTier(ctx, tx))
+}
+
 // initTierDistribution creates a zeroed counter map for each named tier.
 func initTierDistribution(tiers []tierMatcher) map[string]uint64 {
 	dist := make(map[string]uint64, len(tiers))
@@ -1,12 +1,14 @@
 package abcipp
 
 import (
+	"bytes"
 	"fmt"
 	"sync"
 	"testing"
 	"time"
 
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/stretchr/testify/require"
 
@@ -533,3 +535,220 @@ func TestPrepareProposalSkipsTxWhenMaxBytesWouldOverflow(t *testing.T) {
 		t.Fatalf("expected tx2 to remain in mempool after being skipped")
 	}
 }
+
+func TestPrepareProposalEnforcesTierBlockShare(t *testing.T) {
+	capped := testTierMatcher("capped")
+	capped.MaxBlockShare = math.LegacyMustNewDecFromStr("0.5")
+	mp := newTestPriorityMempool(t, []Tier{capped, testTierMatcher("default")})
+
+	ctx := testSDKContextWithParams(1<<20, 100)
+	wrappedCtx := sdk.WrapSDKContext(ctx)
+
+	capped1 := newTestTx(testAddress(1), 0, 30, "capped")
+	capped2 := newTestTx(testAddress(2), 0, 30, "capped")
+	uncapped := newTestTx(testAddress(3), 0, 30, "default")
+	for _, tx := range []*testTx{capped1, capped2, uncapped} {
+		require.NoError(t, mp.Insert(wrappedCtx, tx))
+	}
+
+	ante := func(ctx sdk.Context, tx sdk.Tx, _ bool) (sdk.Context, error) {
+		return ctx, nil
+	}
+	handler, err := NewProposalHandler(log.NewNopLogger(), testTxDecoder, testTxEncoder, mp, ante)
+	require.NoError(t, err)
+
+	resp, err := handler.PrepareProposalHandler()(ctx, &abci.RequestPrepareProposal{
+		Height:     2,
+		MaxTxBytes: 1 << 20,
+	})
+	require.NoError(t, err)
+
+	// the capped tier may use at most 50 gas, so only one of its txs fits.
+	decoded, err := GetDecodedTxs(testTxDecoder, resp.Txs)
+	require.NoError(t, err)
+	require.Len(t, decoded, 2)
+
+	tiers := make(map[string]int)
+	for _, tx := range decoded {
+		tiers[tx.(*testTx).tier]++
+	}
+	require.Equal(t, map[string]int{"capped": 1, "default": 1}, tiers)
+	require.True(t, mp.Contains(capped1))
+	require.True(t, mp.Contains(capped2))
+}
+
+// prepareTierCounts runs PrepareProposal and counts the included txs per tier.
+func prepareTierCounts(t *testing.T, mp *PriorityMempool, ctx sdk.Context) map[string]int {
+	t.Helper()
+
+	ante := func(ctx sdk.Context, tx sdk.Tx, _ bool) (sdk.Context, error) {
+		return ctx, nil
+	}
+	handler, err := NewProposalHandler(log.NewNopLogger(), testTxDecoder, testTxEncoder, mp, ante)
+	require.NoError(t, err)
+
+	resp, err := handler.PrepareProposalHandler()(ctx, &abci.RequestPrepareProposal{
+		Height:     2,
+		MaxTxBytes: 1 << 20,
+	})
+	require.NoError(t, err)
+
+	decoded, err := GetDecodedTxs(testTxDecoder, resp.Txs)
+	require.NoError(t, err)
+
+	counts := make(map[string]int)
+	for _, tx := range decoded {
+		counts[tx.(*testTx).tier]++
+	}
+	return counts
+}
+
+func TestPrepareProposalHoldsTierReservation(t *testing.T) {
+	low := testTierMatcher("low")
+	low.ReservedBlockShare = math.LegacyMustNewDecFromStr("0.4")
+	mp := newTestPriorityMempool(t, []Tier{testTierMatcher("high"), low})
+
+	ctx := testSDKContextWithParams(1<<20, 100)
+	wrappedCtx := sdk.WrapSDKContext(ctx)
+	for i := range 4 {
+		require.NoError(t, mp.Insert(wrappedCtx, newTestTx(testAddress(i), 0, 25, "high")))
+	}
+	for i := range 2 {
+		require.NoError(t, mp.Insert(wrappedCtx, newTestTx(testAddress(10+i), 0, 20, "low")))
+	}
+
+	// without the reservation the high tier would fill the whole block.
+	require.Equal(t, map[string]int{"high": 2, "low": 2}, prepareTierCounts(t, mp, ctx))
+}
+
+func TestPrepareProposalSpillsUnusedReservation(t *testing.T) {
+	idle := testTierMatcher("idle")
+	idle.ReservedBlockShare = math.LegacyMustNewDecFromStr("0.3")
+	low := testTierMatcher("low")
+	low.ReservedBlockShare = math.LegacyMustNewDecFromStr("0.1")
+	mp := newTestPriorityMempool(t, []Tier{testTierMatcher("high"), idle, low})
+
+	ctx := testSDKContextWithParams(1<<20, 100)
+	wrappedCtx := sdk.WrapSDKContext(ctx)
+	for i := range 5 {
+		require.NoError(t, mp.Insert(wrappedCtx, newTestTx(testAddress(i), 0, 20, "high")))
+	}
+	for i := range 3 {
+		require.NoError(t, mp.Insert(wrappedCtx, newTestTx(testAddress(10+i), 0, 20, "low")))
+	}
+
+	// the idle tier has no txs, so its 30 gas spills into low's 10 gas reservation.
+	require.Equal(t, map[string]int{"high": 3, "low": 2}, prepareTierCounts(t, mp, ctx))
+}
+
+func TestProcessProposalAcceptsTierShareOverflow(t *testing.T) {
+	capped := testTierMatcher("capped")
+	capped.MaxBlockShare = math.LegacyMustNewDecFromStr("0.5")
+	mp := newTestPriorityMempool(t, []Tier{capped})
+
+	ante := func(ctx sdk.Context, tx sdk.Tx, _ bool) (sdk.Context, error) {
+		return ctx, nil
+	}
+	handler, err := NewProposalHandler(log.NewNopLogger(), testTxDecoder, testTxEncoder, mp, ante)
+	require.NoError(t, err)
+
+	ctx := testSDKContextWithParams(1<<20, 100)
+	req := &abci.RequestProcessProposal{Height: 2}
+	for _, tx := range []*testTx{
+		newTestTx(testAddress(1), 0, 30, "capped"),
+		newTestTx(testAddress(2), 0, 30, "capped"),
+	} {
+		req.Txs = append(req.Txs, encodeTx(t, tx))
+	}
+
+	// tier shares are local policy, so an overflow must not reject the block.
+	resp, err := handler.ProcessProposalHandler()(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, resp.Status)
+}
+
+func TestProcessProposalReportsOrderingViolations(t *testing.T) {
+	priv := secp256k1.GenPrivKey()
+	tx0 := newTestTxWithPriv(priv, 0, 10, "default")
+	tx1 := newTestTxWithPriv(priv, 1, 10, "default")
+	tx2 := newTestTxWithPriv(priv, 2, 10, "default")
+
+	// a tx without a usable signature has no sender nonce to order.
+	unsigned := newTestTx(sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()), 5, 10, "default")
+	unsigned.sig.PubKey = nil
+
+	// resolve proposal bytes back to the original txs so the signer is kept.
+	known := make(map[string]sdk.Tx)
+	for _, tx := range []*testTx{tx0, tx1, tx2, unsigned} {
+		known[string(encodeTx(t, tx))] = tx
+	}
+	decoder := func(bz []byte) (sdk.Tx, error) {
+		tx, ok := known[string(bz)]
+		if !ok {
+			return nil, fmt.Errorf("unknown tx bytes")
+		}
+		return tx, nil
+	}
+
+	ante := func(ctx sdk.Context, tx sdk.Tx, _ bool) (sdk.Context, error) {
+		return ctx, nil
+	}
+	var logs bytes.Buffer
+	handler, err := NewProposalHandler(log.NewLogger(&logs), decoder, testTxEncoder, newTestPriorityMempool(t, nil), ante)
+	require.NoError(t, err)
+
+	ctx := testSDKContext()
+	process := func(txs ...*testTx) error {
+		req := &abci.RequestProcessProposal{Height: 2}
+		for _, tx := range txs {
+			req.Txs = append(req.Txs, encodeTx(t, tx))
+		}
+		_, err := handler.ProcessProposalHandler()(ctx, req)
+		return err
+	}
+
+	violations := map[string][]*testTx{
+		"duplicate":    {tx0, tx0},
+		"out-of-order": {tx1, tx0},
+		"nonce-gap":    {tx0, tx2},
+	}
+
+	// without reporting only the ante handler decides.
+	for name, txs := range violations {
+		require.NoError(t, process(txs...), name)
+	}
+	require.NotContains(t, logs.String(), "proposer violated mempool ordering")
+
+	handler.SetReportProposalOrdering(true)
+	require.NoError(t, process(tx0, tx1, tx2))
+	require.NotContains(t, logs.String(), "proposer violated mempool ordering")
+
+	// violations are logged, but never reject the proposal.
+	for name, txs := range violations {
+		logs.Reset()
+		require.NoError(t, process(txs...), name)
+		require.Contains(t, logs.String(), "proposer violated mempool ordering", name)
+	}
+
+	// unsigned txs are skipped instead of being reported as violations.
+	logs.Reset()
+	require.NoError(t, process(tx0, unsigned, tx1, unsigned))
+	require.NotContains(t, logs.String(), "proposer violated mempool ordering")
+
+	// strict mode rejects violations with the logged reason.
+	handler.SetReportProposalOrdering(false)
+	handler.SetStrictProcessProposal(true)
+	require.NoError(t, process(tx0, tx1, tx2))
+	require.NoError(t, process(unsigned, tx0, unsigned))
+	for name, txs := range violations {
+		logs.Reset()
+		req := &abci.RequestProcessProposal{Height: 2}
+		for _, tx := range txs {
+			req.Txs = append(req.Txs, encodeTx(t, tx))
+		}
+		resp, err := handler.ProcessProposalHandler()(ctx, req)
+		require.Error(t, err, name)
+		require.Equal(t, abci.ResponseProcessProposal_REJECT, resp.Status, name)
+		require.Contains(t, logs.String(), "proposer violated mempool ordering", name)
+	}
+}
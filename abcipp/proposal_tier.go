@@ -0,0 +1,153 @@
+package abcipp
+
+import (
+	"cosmossdk.io/math"
+)
+
+// tierBudget tracks the block space of a single tier while building or
+// verifying a proposal. A negative max leaves that dimension unbounded.
+type tierBudget struct {
+	maxSize int64
+	maxGas  int64
+
+	// reservedSize and reservedGas are the space held for the tier, including
+	// reservations spilled over from the previous tier.
+	reservedSize int64
+	reservedGas  int64
+
+	// pendingSize and pendingGas are the candidate txs of the tier that have
+	// not been considered yet; a reservation is only held up to this demand.
+	pendingSize int64
+	pendingGas  uint64
+
+	size int64
+	gas  uint64
+}
+
+// tierBudgets tracks per-tier block space for one proposal.
+type tierBudgets struct {
+	order        []string
+	budgets      map[string]*tierBudget
+	maxBlockSize int64
+	maxGasLimit  int64
+}
+
+// newTierBudgets derives per-tier caps and reservations from the tier limits.
+// Reservations are sized against the candidate txs of each tier, and whatever
+// a tier cannot use spills over to the next tier in match order. Block limits
+// that are unset leave the matching tier dimension unbounded.
+func newTierBudgets(limits []TierLimit, candidates []TxInfoEntry, maxBlockSize, maxGasLimit int64) *tierBudgets {
+	b := &tierBudgets{
+		order:        make([]string, 0, len(limits)),
+		budgets:      make(map[string]*tierBudget, len(limits)),
+		maxBlockSize: maxBlockSize,
+		maxGasLimit:  maxGasLimit,
+	}
+	for _, limit := range limits {
+		budget := &tierBudget{
+			maxSize:      shareOf(limit.MaxBlockShare, maxBlockSize),
+			maxGas:       shareOf(limit.MaxBlockShare, maxGasLimit),
+			reservedSize: max(shareOf(limit.ReservedBlockShare, maxBlockSize), 0),
+			reservedGas:  max(shareOf(limit.ReservedBlockShare, maxGasLimit), 0),
+		}
+		b.order = append(b.order, limit.Name)
+		b.budgets[limit.Name] = budget
+	}
+
+	for _, candidate := range candidates {
+		if budget := b.budgets[candidate.Info.Tier]; budget != nil {
+			budget.pendingSize += candidate.Info.Size
+			budget.pendingGas += candidate.Info.GasLimit
+		}
+	}
+
+	// spill unused reservations over to the next tier.
+	var spillSize, spillGas int64
+	for _, name := range b.order {
+		budget := b.budgets[name]
+		budget.reservedSize, spillSize = spillReservation(budget.reservedSize+spillSize, budget.pendingSize, budget.maxSize)
+		budget.reservedGas, spillGas = spillReservation(budget.reservedGas+spillGas, int64(budget.pendingGas), budget.maxGas)
+	}
+
+	return b
+}
+
+// shareOf returns share*limit, or -1 when either the share or the limit is unset.
+func shareOf(share math.LegacyDec, limit int64) int64 {
+	if limit <= 0 || share.IsNil() || !share.IsPositive() {
+		return -1
+	}
+	return share.MulInt64(limit).TruncateInt64()
+}
+
+// spillReservation caps a reservation by the tier demand and max, returning
+// the kept reservation and the remainder to pass on.
+func spillReservation(reserved, demand, maxAllowed int64) (int64, int64) {
+	kept := min(reserved, demand)
+	if maxAllowed >= 0 {
+		kept = min(kept, maxAllowed)
+	}
+	return kept, reserved - kept
+}
+
+// withinTierMax reports whether a tx of the given size and gas keeps the tier
+// within its max block share.
+func (b *tierBudgets) withinTierMax(tier string, size int64, gas uint64) bool {
+	budget := b.budgets[tier]
+	if budget == nil {
+		return true
+	}
+	if budget.maxSize >= 0 && budget.size+size > budget.maxSize {
+		return false
+	}
+	if budget.maxGas >= 0 && budget.gas+gas > uint64(budget.maxGas) {
+		return false
+	}
+	return true
+}
+
+// outsideReservations reports whether a tx of the given tier fits into the
+// block without eating into space still held for other tiers.
+func (b *tierBudgets) outsideReservations(tier string, size int64, gas uint64, totalSize int64, totalGas uint64) bool {
+	var heldSize, heldGas int64
+	for name, budget := range b.budgets {
+		if name == tier {
+			continue
+		}
+		heldSize += max(min(budget.reservedSize-budget.size, budget.pendingSize), 0)
+		heldGas += max(min(budget.reservedGas-int64(budget.gas), int64(budget.pendingGas)), 0)
+	}
+
+	if b.maxBlockSize > 0 && totalSize+size+heldSize > b.maxBlockSize {
+		return false
+	}
+	if b.maxGasLimit > 0 && totalGas+gas+uint64(heldGas) > uint64(b.maxGasLimit) {
+		return false
+	}
+	return true
+}
+
+// settle marks a candidate tx as considered, so its tier no longer holds
+// reservation on its behalf.
+func (b *tierBudgets) settle(tier string, size int64, gas uint64) {
+	if budget := b.budgets[tier]; budget != nil {
+		budget.pendingSize -= size
+		budget.pendingGas -= gas
+	}
+}
+
+// consume records an included tx against its tier.
+func (b *tierBudgets) consume(tier string, size int64, gas uint64) {
+	if budget := b.budgets[tier]; budget != nil {
+		budget.size += size
+		budget.gas += gas
+	}
+}
+
+// usage returns the size and gas consumed by a tier.
+func (b *tierBudgets) usage(tier string) (int64, uint64) {
+	if budget := b.budgets[tier]; budget != nil {
+		return budget.size, budget.gas
+	}
+	return 0, 0
+}
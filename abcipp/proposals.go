@@ -19,6 +19,14 @@ type (
 		txEncoder   sdk.TxEncoder
 		mempool     Mempool
 		anteHandler sdk.AnteHandler
+
+		// reportProposalOrdering logs proposals that break the sender-local
+		// nonce ordering PrepareProposal maintains.
+		reportProposalOrdering bool
+
+		// strictProcessProposal rejects proposals that break the sender-local
+		// nonce ordering PrepareProposal maintains.
+		strictProcessProposal bool
 	}
 )
 
@@ -55,6 +63,22 @@ func NewProposalHandler(
 	}, nil
 }
 
+// SetReportProposalOrdering enables or disables ordering reports in ProcessProposal.
+// When enabled, proposals with duplicate (sender, nonce) pairs or with nonces that
+// are not contiguous and ascending per sender are logged. They are not rejected,
+// since the setting is node-local and must not affect consensus.
+func (h *ProposalHandler) SetReportProposalOrdering(enabled bool) {
+	h.reportProposalOrdering = enabled
+}
+
+// SetStrictProcessProposal enables or disables rejecting proposals that break the
+// sender-local ordering in ProcessProposal. The check depends only on the proposal
+// contents, so it is deterministic, but validators that disagree on the setting
+// vote differently on such proposals; enable it on every validator or none.
+func (h *ProposalHandler) SetStrictProcessProposal(enabled bool) {
+	h.strictProcessProposal = enabled
+}
+
 // PrepareProposalHandler only runs on the block proposer. It selects transactions from the mempool,
 // enforces gas/byte limits, removes submissions that exceed the block limits, and drops entries from
 // the mempool whenever the ante handler rejects them (including txs that individually exceed the
@@ -108,9 +132,21 @@ func (h *ProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
 		//   progression and avoid creating nonce holes from proposal-time ordering.
 		blockedSenders := make(map[string]struct{})
 
+		// Snapshot the candidates first so tier reservations can be sized
+		// against what each tier actually has pending.
+		var candidates []TxInfoEntry
 		for iter := h.mempool.Select(ctx, nil); iter != nil; iter = iter.Next() {
-			tx := iter.Tx()
-			txInfo := iter.(TxInfoIterator).TxInfo()
+			candidates = append(candidates, TxInfoEntry{Tx: iter.Tx(), Info: iter.(TxInfoIterator).TxInfo()})
+		}
+
+		// tierBudgets enforces per-tier max shares and holds reserved space for
+		// tiers that still have candidates.
+		tierBudgets := newTierBudgets(h.mempool.GetTierLimits(), candidates, maxBlockSize, maxGasLimit)
+
+		for _, candidate := range candidates {
+			tx, txInfo := candidate.Tx, candidate.Info
+			tierBudgets.settle(txInfo.Tier, txInfo.Size, txInfo.GasLimit)
+
 			// Sender was blocked earlier in this pass; defer all remaining txs for
 			// this sender to later proposals.
 			if _, blocked := blockedSenders[txInfo.Sender]; blocked {
@@ -167,6 +203,30 @@ func (h *ProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
 				continue
 			}
 
+			// If the tier already used up its block share, or the remaining space
+			// is held for other tiers, skip it.
+			tierMax := tierBudgets.withinTierMax(txInfo.Tier, txInfo.Size, txInfo.GasLimit)
+			if !tierMax || !tierBudgets.outsideReservations(txInfo.Tier, txInfo.Size, txInfo.GasLimit, totalSize, totalGas) {
+				tierSize, tierGas := tierBudgets.usage(txInfo.Tier)
+				h.logger.Debug(
+					"failed to select tx for tier limit; tier block space exhausted",
+					"tx_size", txInfo.Size,
+					"tx_gas", txInfo.GasLimit,
+					"tier_size", tierSize,
+					"tier_gas", tierGas,
+					"tier_max_reached", !tierMax,
+					"tier", txInfo.Tier,
+					"sender", txInfo.Sender,
+					"sequence", txInfo.Sequence,
+					"tx_hash", TxHash(txInfo.TxBytes),
+				)
+
+				// Same as cumulative overflow; keep the sender's nonce order intact.
+				blockedSenders[txInfo.Sender] = struct{}{}
+
+				continue
+			}
+
 			// Verify the transaction.
 			catchCtx, write := ctx.CacheContext()
 			if _, err := h.anteHandler(catchCtx, tx, false); err != nil {
@@ -187,6 +247,7 @@ func (h *ProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
 
 			totalSize += txInfo.Size
 			totalGas += txInfo.GasLimit
+			tierBudgets.consume(txInfo.Tier, txInfo.Size, txInfo.GasLimit)
 			txsToInclude = append(txsToInclude, txInfo.TxBytes)
 		}
 
@@ -263,10 +324,34 @@ func (h *ProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
 		var totalTxBytes int64
 		var totalGas uint64
 
+		// Only tier max shares are checked; reservations depend on the
+		// proposer's mempool and cannot be checked here.
+		tierBudgets := newTierBudgets(h.mempool.GetTierLimits(), nil, maxBlockSize, maxGasLimit)
+
+		// senderNonces tracks the last nonce seen per sender when reporting ordering.
+		senderNonces := make(map[string]uint64)
+
 		for i, tx := range decodedTxs {
 			txBytes := req.Txs[i]
+			if h.reportProposalOrdering || h.strictProcessProposal {
+				if err := checkSenderOrder(senderNonces, tx); err != nil {
+					h.logger.Error(
+						"proposer violated mempool ordering",
+						"reason", err,
+						"proposer", fmt.Sprintf("%X", req.ProposerAddress),
+						"tx_index", i,
+						"tx_hash", TxHash(txBytes),
+						"height", req.Height,
+					)
+					if h.strictProcessProposal {
+						return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, err
+					}
+				}
+			}
+
+			var gas uint64
 			if feeTx, ok := tx.(sdk.FeeTx); ok {
-				gas := feeTx.GetGas()
+				gas = feeTx.GetGas()
 				if maxGasLimit > 0 && totalGas+gas > uint64(maxGasLimit) {
 					h.logger.Error(
 						"failed to process proposal; gas limit above the maximum allowed",
@@ -302,6 +387,26 @@ func (h *ProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
 
 			totalTxBytes += size
 
+			// Tier limits are node-local config, so an overflow is only reported
+			// and never rejects the proposal.
+			tier := h.mempool.SelectTierName(ctx, tx)
+			if !tierBudgets.withinTierMax(tier, size, gas) {
+				tierSize, tierGas := tierBudgets.usage(tier)
+				h.logger.Info(
+					"proposal exceeds local tier block share",
+					"tx_size", size,
+					"tx_gas", gas,
+					"tier_size", tierSize,
+					"tier_gas", tierGas,
+					"tier", tier,
+					"proposer", fmt.Sprintf("%X", req.ProposerAddress),
+					"tx_hash", TxHash(txBytes),
+					"height", req.Height,
+				)
+			}
+
+			tierBudgets.consume(tier, size, gas)
+
 			// Verify the transaction.
 			catchCtx, write := ctx.CacheContext()
 			if _, err := h.anteHandler(catchCtx, tx, false); err != nil {
@@ -2,22 +2,32 @@ package abcipp
 
 import (
 	"context"
+	"encoding/binary"
 	"fmt"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"cosmossdk.io/math"
 	gogogrpc "github.com/cosmos/gogoproto/grpc"
 
+	"github.com/cosmos/cosmos-sdk/types/query"
+
 	"github.com/initia-labs/initia/abcipp/types"
 )
 
-// RegisterQueryServer registers the ABCI++ query server.
+// RegisterQueryServer registers the ABCI++ query server. QueryMempoolSnapshot
+// is only served when snapshotEnabled is set.
 func RegisterQueryServer(
 	server gogogrpc.Server,
 	mempool Mempool,
+	snapshotEnabled bool,
 ) {
-	types.RegisterQueryServer(server, &MempoolQueryServer{mempool: mempool})
+	types.RegisterQueryServer(server, &MempoolQueryServer{mempool: mempool, snapshotEnabled: snapshotEnabled})
 }
 
 // RegisterGRPCGatewayRoutes mounts the ABCI++ query's GRPC-gateway routes on the
@@ -29,6 +39,10 @@ func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.Serv
 // MempoolQueryServer implements the ABCI++ mempool query server.
 type MempoolQueryServer struct {
 	mempool Mempool
+
+	// snapshotEnabled enables the QueryMempoolSnapshot endpoint, which is then
+	// also reachable through CometBFT RPC abci_query.
+	snapshotEnabled bool
 }
 
 // QueryTxDistribution returns the current distribution of transactions in the mempool.
@@ -66,3 +80,253 @@ func (p *MempoolQueryServer) QueryTxHash(ctx context.Context, req *types.QueryTx
 		TxHash: "",
 	}, nil
 }
+
+// QuerySenderTxs returns the active and queued txs of a sender, and the missing
+// sequence that blocks queued txs from being promoted.
+func (p *MempoolQueryServer) QuerySenderTxs(ctx context.Context, req *types.QuerySenderTxsRequest) (*types.QuerySenderTxsResponse, error) {
+	addr, err := DecodeAddress(req.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	senderTxs, tracked := p.mempool.GetSenderTxs(addr.String())
+	resp := &types.QuerySenderTxsResponse{
+		Tracked:              tracked,
+		NextExpectedSequence: senderTxs.NextExpectedSequence,
+		Active:               newMempoolTxs(senderTxs.Active),
+		Queued:               newMempoolTxs(senderTxs.Queued),
+	}
+
+	// queued txs are promoted only once the sender cursor reaches the lowest
+	// queued sequence; until then the cursor itself is the missing sequence.
+	if next := senderTxs.NextExpectedSequence; len(resp.Queued) > 0 && resp.Queued[0].Sequence > next {
+		resp.Blocked = true
+		resp.MissingSequence = next
+	}
+
+	return resp, nil
+}
+
+// QueryTierTxs pages through the txs of a tier, ordered by sender and sequence.
+func (p *MempoolQueryServer) QueryTierTxs(ctx context.Context, req *types.QueryTierTxsRequest) (*types.QueryTierTxsResponse, error) {
+	tier := strings.TrimSpace(req.Tier)
+	if tier == "" {
+		return nil, fmt.Errorf("tier is required")
+	}
+
+	txs := newMempoolTxs(p.mempool.GetTierTxs(tier))
+	page, pageRes, err := paginateMempoolTxs(txs, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTierTxsResponse{
+		Txs:        page,
+		Pagination: pageRes,
+	}, nil
+}
+
+// QuerySenderReputations returns the admission scores of tracked senders,
+// highest score first.
+func (p *MempoolQueryServer) QuerySenderReputations(ctx context.Context, req *types.QuerySenderReputationsRequest) (*types.QuerySenderReputationsResponse, error) {
+	now := time.Now()
+	reps := p.mempool.GetSenderReputations()
+
+	out := make([]*types.SenderReputation, 0, len(reps))
+	for _, rep := range reps {
+		banned := rep.Banned(now)
+		if req.BannedOnly && !banned {
+			continue
+		}
+
+		out = append(out, &types.SenderReputation{
+			Sender:      rep.Sender,
+			Score:       rep.Score,
+			Throttled:   rep.Throttled,
+			Banned:      banned,
+			BannedUntil: rep.BannedUntil,
+		})
+	}
+
+	return &types.QuerySenderReputationsResponse{
+		Reputations: out,
+	}, nil
+}
+
+// QueryMempoolSnapshot dumps the full mempool state. It is disabled unless the
+// node operator enables it, since the dump is unpaginated and holds the mempool
+// read lock while it is built. Once enabled it is served over gRPC and through
+// CometBFT RPC abci_query alike.
+func (p *MempoolQueryServer) QueryMempoolSnapshot(ctx context.Context, req *types.QueryMempoolSnapshotRequest) (*types.QueryMempoolSnapshotResponse, error) {
+	if !p.snapshotEnabled {
+		return nil, status.Errorf(codes.Unavailable, "mempool snapshot query is disabled; set %s to enable it", FlagSnapshotQueryEnabled)
+	}
+
+	return &types.QueryMempoolSnapshotResponse{
+		Snapshot: p.mempool.ExportSnapshot(),
+	}, nil
+}
+
+// SubscribeMempoolEvents streams mempool state changes matching the request
+// filters until the client disconnects or the subscription is dropped.
+func (p *MempoolQueryServer) SubscribeMempoolEvents(req *types.SubscribeMempoolEventsRequest, stream types.Query_SubscribeMempoolEventsServer) error {
+	filter := MempoolEventFilter{Tiers: req.Tiers}
+	for _, sender := range req.Senders {
+		addr, err := DecodeAddress(sender)
+		if err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+		filter.Senders = append(filter.Senders, addr.String())
+	}
+
+	events, cancel := p.mempool.SubscribeEvents(filter, DefaultEventSubscriptionBuffer)
+	defer cancel()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case ev, ok := <-events:
+			if !ok {
+				return status.Error(codes.Unavailable, "mempool event subscription closed; resync and resubscribe")
+			}
+			if err := stream.Send(newMempoolEventResponse(ev)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// newMempoolEventResponse converts MempoolEvent into its query representation.
+func newMempoolEventResponse(ev MempoolEvent) *types.MempoolEvent {
+	res := &types.MempoolEvent{
+		Sender:   ev.Sender,
+		Sequence: ev.Sequence,
+		Tier:     ev.Tier,
+		TxHash:   ev.TxHash,
+	}
+
+	switch ev.Type {
+	case MempoolEventInserted:
+		res.Type = types.MempoolEventType_MEMPOOL_EVENT_TYPE_INSERTED
+	case MempoolEventQueued:
+		res.Type = types.MempoolEventType_MEMPOOL_EVENT_TYPE_QUEUED
+	case MempoolEventPromoted:
+		res.Type = types.MempoolEventType_MEMPOOL_EVENT_TYPE_PROMOTED
+	case MempoolEventRemoved:
+		res.Type = types.MempoolEventType_MEMPOOL_EVENT_TYPE_REMOVED
+		res.Reason = removalReasonToProto(ev.Reason)
+	}
+
+	return res
+}
+
+// removalReasonToProto converts a RemovalReason into its query representation.
+func removalReasonToProto(reason RemovalReason) types.RemovalReason {
+	switch reason {
+	case RemovalReasonCapacityEvicted:
+		return types.RemovalReason_REMOVAL_REASON_CAPACITY_EVICTED
+	case RemovalReasonCommittedInBlock:
+		return types.RemovalReason_REMOVAL_REASON_COMMITTED_IN_BLOCK
+	case RemovalReasonAnteRejectedInPrepare:
+		return types.RemovalReason_REMOVAL_REASON_ANTE_REJECTED_IN_PREPARE
+	case RemovalReasonReplaced:
+		return types.RemovalReason_REMOVAL_REASON_REPLACED
+	case RemovalReasonStale:
+		return types.RemovalReason_REMOVAL_REASON_STALE
+	case RemovalReasonQueuedGapExpired:
+		return types.RemovalReason_REMOVAL_REASON_QUEUED_GAP_EXPIRED
+	default:
+		return types.RemovalReason_REMOVAL_REASON_UNSPECIFIED
+	}
+}
+
+// newMempoolTxs converts TxInfos into their query representation.
+func newMempoolTxs(infos []TxInfo) []*types.MempoolTx {
+	if len(infos) == 0 {
+		return nil
+	}
+
+	txs := make([]*types.MempoolTx, 0, len(infos))
+	for _, info := range infos {
+		txs = append(txs, newMempoolTx(info))
+	}
+
+	return txs
+}
+
+// newMempoolTx converts TxInfo into its query representation.
+func newMempoolTx(info TxInfo) *types.MempoolTx {
+	return &types.MempoolTx{
+		Sender:          info.Sender,
+		Sequence:        info.Sequence,
+		TxHash:          TxHash(info.TxBytes),
+		Tier:            info.Tier,
+		Priority:        info.Priority,
+		ClampedPriority: info.ClampedPriority,
+		GasLimit:        info.GasLimit,
+		TxSize:          info.Size,
+	}
+}
+
+// paginateMempoolTxs applies key or offset pagination over txs ordered by
+// (sender, sequence). Page keys encode the first (sender, sequence) of the next
+// page, so they stay valid while the mempool changes between requests.
+func paginateMempoolTxs(txs []*types.MempoolTx, pageReq *query.PageRequest) ([]*types.MempoolTx, *query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if len(pageReq.Key) > 0 && pageReq.Offset > 0 {
+		return nil, nil, fmt.Errorf("either offset or key is expected, got both")
+	}
+
+	limit := pageReq.Limit
+	countTotal := pageReq.CountTotal
+	if limit == 0 {
+		limit = query.DefaultLimit
+		countTotal = true
+	}
+
+	var start int
+	if len(pageReq.Key) > 0 {
+		sender, sequence, err := decodeMempoolPageKey(pageReq.Key)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		start = sort.Search(len(txs), func(i int) bool {
+			if txs[i].Sender != sender {
+				return txs[i].Sender > sender
+			}
+			return txs[i].Sequence >= sequence
+		})
+	} else {
+		start = int(min(pageReq.Offset, uint64(len(txs))))
+	}
+
+	// clamp the limit before adding it to start so a huge limit cannot overflow
+	end := start + int(min(limit, uint64(len(txs)-start)))
+	pageRes := &query.PageResponse{}
+	if end < len(txs) {
+		pageRes.NextKey = encodeMempoolPageKey(txs[end].Sender, txs[end].Sequence)
+	}
+	if countTotal {
+		pageRes.Total = uint64(len(txs))
+	}
+
+	return txs[start:end], pageRes, nil
+}
+
+// encodeMempoolPageKey encodes a (sender, sequence) page key.
+func encodeMempoolPageKey(sender string, sequence uint64) []byte {
+	return binary.BigEndian.AppendUint64([]byte(sender), sequence)
+}
+
+// decodeMempoolPageKey decodes a (sender, sequence) page key.
+func decodeMempoolPageKey(key []byte) (string, uint64, error) {
+	if len(key) <= 8 {
+		return "", 0, fmt.Errorf("invalid pagination key")
+	}
+
+	return string(key[:len(key)-8]), binary.BigEndian.Uint64(key[len(key)-8:]), nil
+}
@@ -3,12 +3,18 @@ package abcipp
 import (
 	"context"
 	"encoding/hex"
+	"math"
 	"testing"
 
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"github.com/initia-labs/initia/abcipp/types"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 func TestQueryTxDistribution(t *testing.T) {
@@ -112,3 +118,135 @@ func TestQueryTxHashInvalidSequence(t *testing.T) {
 		t.Fatalf("expected error for invalid sequence")
 	}
 }
+
+func TestQuerySenderTxsReportsMissingSequence(t *testing.T) {
+	mp, keeper, sdkCtx, _ := newTestMempoolWithEvents(t, 64)
+	server := &MempoolQueryServer{mempool: mp}
+
+	priv := secp256k1.GenPrivKey()
+	sender := sdk.AccAddress(priv.PubKey().Address())
+	keeper.SetSequence(sender, 0)
+
+	require.NoError(t, mp.Insert(sdk.WrapSDKContext(sdkCtx.WithPriority(10)), newTestTxWithPriv(priv, 0, 1000, "default")))
+	require.NoError(t, mp.Insert(sdk.WrapSDKContext(sdkCtx.WithPriority(20)), newTestTxWithPriv(priv, 1, 1000, "default")))
+	require.NoError(t, mp.Insert(sdk.WrapSDKContext(sdkCtx.WithPriority(30)), newTestTxWithPriv(priv, 3, 1000, "default")))
+
+	resp, err := server.QuerySenderTxs(context.Background(), &types.QuerySenderTxsRequest{Sender: sender.String()})
+	require.NoError(t, err)
+	require.True(t, resp.Tracked)
+	require.Equal(t, uint64(2), resp.NextExpectedSequence)
+	require.Len(t, resp.Active, 2)
+	require.Len(t, resp.Queued, 1)
+	require.Equal(t, uint64(3), resp.Queued[0].Sequence)
+	require.Equal(t, QueuedTierName, resp.Queued[0].Tier)
+	require.True(t, resp.Blocked)
+	require.Equal(t, uint64(2), resp.MissingSequence)
+
+	// nonce 1 is clamped to its predecessor rank.
+	require.Equal(t, resp.Active[0].ClampedPriority, resp.Active[1].ClampedPriority)
+	require.Equal(t, int64(20), resp.Active[1].Priority)
+
+	// an untracked sender returns an empty response.
+	resp, err = server.QuerySenderTxs(context.Background(), &types.QuerySenderTxsRequest{
+		Sender: "0x" + hex.EncodeToString(testAddress(99).Bytes()),
+	})
+	require.NoError(t, err)
+	require.False(t, resp.Tracked)
+	require.Empty(t, resp.Active)
+}
+
+func TestQueryTierTxsPagination(t *testing.T) {
+	tiers := []Tier{
+		testTierMatcher("high"),
+		testTierMatcher("low"),
+	}
+	mp := newTestPriorityMempool(t, tiers)
+	server := &MempoolQueryServer{mempool: mp}
+	ctx := sdk.WrapSDKContext(testSDKContext())
+
+	for i := 0; i < 5; i++ {
+		require.NoError(t, mp.Insert(ctx, newTestTx(testAddress(i), 0, 1000, "high")))
+	}
+	require.NoError(t, mp.Insert(ctx, newTestTx(testAddress(10), 0, 1000, "low")))
+
+	var seen []string
+	var key []byte
+	for {
+		resp, err := server.QueryTierTxs(context.Background(), &types.QueryTierTxsRequest{
+			Tier:       "high",
+			Pagination: &query.PageRequest{Key: key, Limit: 2, CountTotal: true},
+		})
+		require.NoError(t, err)
+		require.Equal(t, uint64(5), resp.Pagination.Total)
+		for _, tx := range resp.Txs {
+			require.Equal(t, "high", tx.Tier)
+			seen = append(seen, tx.Sender)
+		}
+
+		key = resp.Pagination.NextKey
+		if len(key) == 0 {
+			break
+		}
+	}
+	require.Len(t, seen, 5)
+	require.IsIncreasing(t, seen)
+
+	resp, err := server.QueryTierTxs(context.Background(), &types.QueryTierTxsRequest{
+		Tier:       "high",
+		Pagination: &query.PageRequest{Offset: 4},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Txs, 1)
+	require.Empty(t, resp.Pagination.NextKey)
+
+	_, err = server.QueryTierTxs(context.Background(), &types.QueryTierTxsRequest{})
+	require.Error(t, err)
+}
+
+func TestQueryTierTxsPaginationMaxLimit(t *testing.T) {
+	tiers := []Tier{testTierMatcher("high")}
+	mp := newTestPriorityMempool(t, tiers)
+	server := &MempoolQueryServer{mempool: mp}
+	ctx := sdk.WrapSDKContext(testSDKContext())
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, mp.Insert(ctx, newTestTx(testAddress(i), 0, 1000, "high")))
+	}
+
+	resp, err := server.QueryTierTxs(context.Background(), &types.QueryTierTxsRequest{
+		Tier:       "high",
+		Pagination: &query.PageRequest{Offset: 1, Limit: math.MaxUint64},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Txs, 2)
+	require.Empty(t, resp.Pagination.NextKey)
+
+	resp, err = server.QueryTierTxs(context.Background(), &types.QueryTierTxsRequest{
+		Tier:       "high",
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, resp.Pagination.NextKey)
+
+	resp, err = server.QueryTierTxs(context.Background(), &types.QueryTierTxsRequest{
+		Tier:       "high",
+		Pagination: &query.PageRequest{Key: resp.Pagination.NextKey, Limit: math.MaxUint64},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Txs, 2)
+	require.Empty(t, resp.Pagination.NextKey)
+}
+
+func TestQueryMempoolSnapshotOperatorOnly(t *testing.T) {
+	mp := newTestPriorityMempool(t, nil)
+	require.NoError(t, mp.Insert(sdk.WrapSDKContext(testSDKContext()), newTestTx(testAddress(1), 0, 1000, "default")))
+
+	server := &MempoolQueryServer{mempool: mp}
+	_, err := server.QueryMempoolSnapshot(context.Background(), &types.QueryMempoolSnapshotRequest{})
+	require.Equal(t, codes.Unavailable, status.Code(err))
+
+	server.snapshotEnabled = true
+	resp, err := server.QueryMempoolSnapshot(context.Background(), &types.QueryMempoolSnapshotRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Snapshot.Active, 1)
+}
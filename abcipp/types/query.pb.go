@@ -5,22 +5,29 @@ package types
 
 import (
 	context "context"
+	encoding_binary "encoding/binary"
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -28,6 +35,86 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// MempoolEventType is the kind of mempool state change.
+type MempoolEventType int32
+
+const (
+	MempoolEventType_MEMPOOL_EVENT_TYPE_UNSPECIFIED MempoolEventType = 0
+	// A tx entered the active set directly.
+	MempoolEventType_MEMPOOL_EVENT_TYPE_INSERTED MempoolEventType = 1
+	// A future-nonce tx entered the queued pool.
+	MempoolEventType_MEMPOOL_EVENT_TYPE_QUEUED MempoolEventType = 2
+	// A queued tx was promoted to the active set.
+	MempoolEventType_MEMPOOL_EVENT_TYPE_PROMOTED MempoolEventType = 3
+	// A tx was deleted from the mempool.
+	MempoolEventType_MEMPOOL_EVENT_TYPE_REMOVED MempoolEventType = 4
+)
+
+var MempoolEventType_name = map[int32]string{
+	0: "MEMPOOL_EVENT_TYPE_UNSPECIFIED",
+	1: "MEMPOOL_EVENT_TYPE_INSERTED",
+	2: "MEMPOOL_EVENT_TYPE_QUEUED",
+	3: "MEMPOOL_EVENT_TYPE_PROMOTED",
+	4: "MEMPOOL_EVENT_TYPE_REMOVED",
+}
+
+var MempoolEventType_value = map[string]int32{
+	"MEMPOOL_EVENT_TYPE_UNSPECIFIED": 0,
+	"MEMPOOL_EVENT_TYPE_INSERTED":    1,
+	"MEMPOOL_EVENT_TYPE_QUEUED":      2,
+	"MEMPOOL_EVENT_TYPE_PROMOTED":    3,
+	"MEMPOOL_EVENT_TYPE_REMOVED":     4,
+}
+
+func (x MempoolEventType) String() string {
+	return proto.EnumName(MempoolEventType_name, int32(x))
+}
+
+func (MempoolEventType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_35466e4a18ef73b8, []int{0}
+}
+
+// RemovalReason is the reason a tx was deleted from the mempool.
+type RemovalReason int32
+
+const (
+	RemovalReason_REMOVAL_REASON_UNSPECIFIED              RemovalReason = 0
+	RemovalReason_REMOVAL_REASON_CAPACITY_EVICTED         RemovalReason = 1
+	RemovalReason_REMOVAL_REASON_COMMITTED_IN_BLOCK       RemovalReason = 2
+	RemovalReason_REMOVAL_REASON_ANTE_REJECTED_IN_PREPARE RemovalReason = 3
+	RemovalReason_REMOVAL_REASON_REPLACED                 RemovalReason = 4
+	RemovalReason_REMOVAL_REASON_STALE                    RemovalReason = 5
+	RemovalReason_REMOVAL_REASON_QUEUED_GAP_EXPIRED       RemovalReason = 6
+)
+
+var RemovalReason_name = map[int32]string{
+	0: "REMOVAL_REASON_UNSPECIFIED",
+	1: "REMOVAL_REASON_CAPACITY_EVICTED",
+	2: "REMOVAL_REASON_COMMITTED_IN_BLOCK",
+	3: "REMOVAL_REASON_ANTE_REJECTED_IN_PREPARE",
+	4: "REMOVAL_REASON_REPLACED",
+	5: "REMOVAL_REASON_STALE",
+	6: "REMOVAL_REASON_QUEUED_GAP_EXPIRED",
+}
+
+var RemovalReason_value = map[string]int32{
+	"REMOVAL_REASON_UNSPECIFIED":              0,
+	"REMOVAL_REASON_CAPACITY_EVICTED":         1,
+	"REMOVAL_REASON_COMMITTED_IN_BLOCK":       2,
+	"REMOVAL_REASON_ANTE_REJECTED_IN_PREPARE": 3,
+	"REMOVAL_REASON_REPLACED":                 4,
+	"REMOVAL_REASON_STALE":                    5,
+	"REMOVAL_REASON_QUEUED_GAP_EXPIRED":       6,
+}
+
+func (x RemovalReason) String() string {
+	return proto.EnumName(RemovalReason_name, int32(x))
+}
+
+func (RemovalReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_35466e4a18ef73b8, []int{1}
+}
+
 // QueryTxDistributionRequest is the request type for the Query.QueryTxDistribution
 // RPC method.
 type QueryTxDistributionRequest struct {
@@ -213,374 +300,5111 @@ func (m *QueryTxHashResponse) GetTxHash() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*QueryTxDistributionRequest)(nil), "initia.abcipp.mempool.v1.QueryTxDistributionRequest")
-	proto.RegisterType((*QueryTxDistributionResponse)(nil), "initia.abcipp.mempool.v1.QueryTxDistributionResponse")
-	proto.RegisterMapType((map[string]uint64)(nil), "initia.abcipp.mempool.v1.QueryTxDistributionResponse.DistributionEntry")
-	proto.RegisterType((*QueryTxHashRequest)(nil), "initia.abcipp.mempool.v1.QueryTxHashRequest")
-	proto.RegisterType((*QueryTxHashResponse)(nil), "initia.abcipp.mempool.v1.QueryTxHashResponse")
+// MempoolTx describes a single transaction held by the mempool.
+type MempoolTx struct {
+	Sender   string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	TxHash   string `protobuf:"bytes,3,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// tier is the tier name of an active tx, or "queued" for a queued tx.
+	Tier     string `protobuf:"bytes,4,opt,name=tier,proto3" json:"tier,omitempty"`
+	Priority int64  `protobuf:"varint,5,opt,name=priority,proto3" json:"priority,omitempty"`
+	// clamped_priority is the sender-clamped rank used for active ordering.
+	// It is zero for queued txs.
+	ClampedPriority int64  `protobuf:"varint,6,opt,name=clamped_priority,json=clampedPriority,proto3" json:"clamped_priority,omitempty"`
+	GasLimit        uint64 `protobuf:"varint,7,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	TxSize          int64  `protobuf:"varint,8,opt,name=tx_size,json=txSize,proto3" json:"tx_size,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("initia/abcipp/mempool/v1/query.proto", fileDescriptor_35466e4a18ef73b8)
+func (m *MempoolTx) Reset()         { *m = MempoolTx{} }
+func (m *MempoolTx) String() string { return proto.CompactTextString(m) }
+func (*MempoolTx) ProtoMessage()    {}
+func (*MempoolTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35466e4a18ef73b8, []int{4}
 }
-
-var fileDescriptor_35466e4a18ef73b8 = []byte{
-	// 418 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xc9, 0xcc, 0xcb, 0x2c,
-	0xc9, 0x4c, 0xd4, 0x4f, 0x4c, 0x4a, 0xce, 0x2c, 0x28, 0xd0, 0xcf, 0x4d, 0xcd, 0x2d, 0xc8, 0xcf,
-	0xcf, 0xd1, 0x2f, 0x33, 0xd4, 0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x92, 0x80, 0xa8, 0xd2, 0x83, 0xa8, 0xd2, 0x83, 0xaa, 0xd2, 0x2b, 0x33, 0x94, 0x92, 0x49,
-	0xcf, 0xcf, 0x4f, 0xcf, 0x49, 0xd5, 0x4f, 0x2c, 0xc8, 0xd4, 0x4f, 0xcc, 0xcb, 0xcb, 0x2f, 0x49,
-	0x2c, 0xc9, 0xcc, 0xcf, 0x2b, 0x86, 0xe8, 0x53, 0x92, 0xe1, 0x92, 0x0a, 0x04, 0x19, 0x13, 0x52,
-	0xe1, 0x92, 0x59, 0x5c, 0x52, 0x94, 0x99, 0x54, 0x0a, 0x92, 0x0d, 0x4a, 0x2d, 0x2c, 0x4d, 0x2d,
-	0x2e, 0x51, 0x3a, 0xcd, 0xc8, 0x25, 0x8d, 0x55, 0xba, 0xb8, 0x20, 0x3f, 0xaf, 0x38, 0x55, 0x28,
-	0x9b, 0x8b, 0x27, 0x05, 0x49, 0x5c, 0x82, 0x51, 0x81, 0x59, 0x83, 0xdb, 0xc8, 0x5d, 0x0f, 0x97,
-	0x63, 0xf4, 0xf0, 0x18, 0xa6, 0x87, 0x2c, 0xe8, 0x9a, 0x57, 0x52, 0x54, 0x19, 0x84, 0x62, 0xb8,
-	0x94, 0x3d, 0x97, 0x20, 0x86, 0x12, 0x21, 0x01, 0x2e, 0xe6, 0xec, 0xd4, 0x4a, 0x09, 0x46, 0x05,
-	0x46, 0x0d, 0xce, 0x20, 0x10, 0x53, 0x48, 0x84, 0x8b, 0xb5, 0x2c, 0x31, 0xa7, 0x34, 0x55, 0x82,
-	0x49, 0x81, 0x51, 0x83, 0x25, 0x08, 0xc2, 0xb1, 0x62, 0xb2, 0x60, 0x54, 0xf2, 0xe0, 0x12, 0x82,
-	0xda, 0xef, 0x91, 0x58, 0x9c, 0x01, 0xf5, 0xa3, 0x90, 0x18, 0x17, 0x5b, 0x71, 0x6a, 0x5e, 0x4a,
-	0x6a, 0x11, 0xd4, 0x10, 0x28, 0x4f, 0x48, 0x8a, 0x8b, 0xa3, 0x18, 0xa4, 0x24, 0x2f, 0x19, 0x62,
-	0x14, 0x67, 0x10, 0x9c, 0xaf, 0xa4, 0xcb, 0x25, 0x8c, 0x62, 0x12, 0x34, 0x38, 0xc4, 0xb8, 0xd8,
-	0x4a, 0xc0, 0x22, 0x30, 0xa3, 0x20, 0x3c, 0xa3, 0x07, 0x4c, 0x5c, 0xac, 0x60, 0xf5, 0x42, 0x9b,
-	0x18, 0xe1, 0x3a, 0x91, 0xfd, 0x22, 0x64, 0x42, 0x62, 0x90, 0x81, 0x9d, 0x2e, 0x65, 0x4a, 0x56,
-	0x40, 0x2b, 0xe9, 0x35, 0x5d, 0x7e, 0x32, 0x99, 0x49, 0x43, 0x48, 0x4d, 0x1f, 0x67, 0xd2, 0x42,
-	0x0e, 0x78, 0xa1, 0x85, 0x8c, 0x5c, 0xdc, 0x48, 0xde, 0x15, 0xd2, 0x21, 0x68, 0x2d, 0x52, 0xf8,
-	0x4a, 0xe9, 0x12, 0xa9, 0x1a, 0xea, 0x38, 0x53, 0xb0, 0xe3, 0xf4, 0x85, 0x74, 0x71, 0x3b, 0xae,
-	0x1a, 0x12, 0x43, 0xb5, 0x20, 0x06, 0x24, 0x42, 0x6a, 0x9d, 0x5c, 0x4e, 0x3c, 0x92, 0x63, 0xbc,
-	0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63,
-	0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x2b, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f,
-	0x17, 0x6a, 0xa4, 0x6e, 0x4e, 0x62, 0x52, 0x31, 0x9a, 0xf1, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49,
-	0x6c, 0xe0, 0x4c, 0x61, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xeb, 0x0f, 0xc3, 0xc0, 0x74, 0x03,
-	0x00, 0x00,
+func (m *MempoolTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MempoolTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MempoolTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MempoolTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MempoolTx.Merge(m, src)
+}
+func (m *MempoolTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *MempoolTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_MempoolTx.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MempoolTx proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// QueryTxDistribution returns the distribution of transactions in the mempool.
-	QueryTxDistribution(ctx context.Context, in *QueryTxDistributionRequest, opts ...grpc.CallOption) (*QueryTxDistributionResponse, error)
-	// QueryTxHash checks if a transaction is in the mempool.
-	QueryTxHash(ctx context.Context, in *QueryTxHashRequest, opts ...grpc.CallOption) (*QueryTxHashResponse, error)
+func (m *MempoolTx) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *MempoolTx) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *MempoolTx) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
 }
 
-func (c *queryClient) QueryTxDistribution(ctx context.Context, in *QueryTxDistributionRequest, opts ...grpc.CallOption) (*QueryTxDistributionResponse, error) {
-	out := new(QueryTxDistributionResponse)
-	err := c.cc.Invoke(ctx, "/initia.abcipp.mempool.v1.Query/QueryTxDistribution", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MempoolTx) GetTier() string {
+	if m != nil {
+		return m.Tier
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) QueryTxHash(ctx context.Context, in *QueryTxHashRequest, opts ...grpc.CallOption) (*QueryTxHashResponse, error) {
-	out := new(QueryTxHashResponse)
-	err := c.cc.Invoke(ctx, "/initia.abcipp.mempool.v1.Query/QueryTxHash", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MempoolTx) GetPriority() int64 {
+	if m != nil {
+		return m.Priority
 	}
-	return out, nil
+	return 0
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// QueryTxDistribution returns the distribution of transactions in the mempool.
-	QueryTxDistribution(context.Context, *QueryTxDistributionRequest) (*QueryTxDistributionResponse, error)
-	// QueryTxHash checks if a transaction is in the mempool.
-	QueryTxHash(context.Context, *QueryTxHashRequest) (*QueryTxHashResponse, error)
+func (m *MempoolTx) GetClampedPriority() int64 {
+	if m != nil {
+		return m.ClampedPriority
+	}
+	return 0
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *MempoolTx) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
 }
 
-func (*UnimplementedQueryServer) QueryTxDistribution(ctx context.Context, req *QueryTxDistributionRequest) (*QueryTxDistributionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryTxDistribution not implemented")
-}
-func (*UnimplementedQueryServer) QueryTxHash(ctx context.Context, req *QueryTxHashRequest) (*QueryTxHashResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryTxHash not implemented")
+func (m *MempoolTx) GetTxSize() int64 {
+	if m != nil {
+		return m.TxSize
+	}
+	return 0
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+// QuerySenderTxsRequest is the request type for the Query.QuerySenderTxs
+// RPC method.
+type QuerySenderTxsRequest struct {
+	// sender accepts either a bech32 or a 0x-prefixed hex address.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 }
 
-func _Query_QueryTxDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTxDistributionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryTxDistribution(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/initia.abcipp.mempool.v1.Query/QueryTxDistribution",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryTxDistribution(ctx, req.(*QueryTxDistributionRequest))
+func (m *QuerySenderTxsRequest) Reset()         { *m = QuerySenderTxsRequest{} }
+func (m *QuerySenderTxsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySenderTxsRequest) ProtoMessage()    {}
+func (*QuerySenderTxsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35466e4a18ef73b8, []int{5}
+}
+func (m *QuerySenderTxsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySenderTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySenderTxsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QuerySenderTxsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySenderTxsRequest.Merge(m, src)
+}
+func (m *QuerySenderTxsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySenderTxsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySenderTxsRequest.DiscardUnknown(m)
 }
 
-func _Query_QueryTxHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTxHashRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryTxHash(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/initia.abcipp.mempool.v1.Query/QueryTxHash",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryTxHash(ctx, req.(*QueryTxHashRequest))
+var xxx_messageInfo_QuerySenderTxsRequest proto.InternalMessageInfo
+
+func (m *QuerySenderTxsRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "initia.abcipp.mempool.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "QueryTxDistribution",
-			Handler:    _Query_QueryTxDistribution_Handler,
-		},
-		{
-			MethodName: "QueryTxHash",
-			Handler:    _Query_QueryTxHash_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "initia/abcipp/mempool/v1/query.proto",
+// QuerySenderTxsResponse is the response type for the Query.QuerySenderTxs
+// RPC method.
+type QuerySenderTxsResponse struct {
+	// tracked is false when the mempool holds no state for the sender.
+	Tracked bool `protobuf:"varint,1,opt,name=tracked,proto3" json:"tracked,omitempty"`
+	// next_expected_sequence is the sequence the mempool expects next from the sender.
+	NextExpectedSequence uint64       `protobuf:"varint,2,opt,name=next_expected_sequence,json=nextExpectedSequence,proto3" json:"next_expected_sequence,omitempty"`
+	Active               []*MempoolTx `protobuf:"bytes,3,rep,name=active,proto3" json:"active,omitempty"`
+	Queued               []*MempoolTx `protobuf:"bytes,4,rep,name=queued,proto3" json:"queued,omitempty"`
+	// blocked is true when queued txs exist but cannot be promoted because
+	// missing_sequence has not been received yet.
+	Blocked         bool   `protobuf:"varint,5,opt,name=blocked,proto3" json:"blocked,omitempty"`
+	MissingSequence uint64 `protobuf:"varint,6,opt,name=missing_sequence,json=missingSequence,proto3" json:"missing_sequence,omitempty"`
 }
 
-func (m *QueryTxDistributionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySenderTxsResponse) Reset()         { *m = QuerySenderTxsResponse{} }
+func (m *QuerySenderTxsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySenderTxsResponse) ProtoMessage()    {}
+func (*QuerySenderTxsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35466e4a18ef73b8, []int{6}
+}
+func (m *QuerySenderTxsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySenderTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySenderTxsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QuerySenderTxsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySenderTxsResponse.Merge(m, src)
+}
+func (m *QuerySenderTxsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySenderTxsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySenderTxsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryTxDistributionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QuerySenderTxsResponse proto.InternalMessageInfo
+
+func (m *QuerySenderTxsResponse) GetTracked() bool {
+	if m != nil {
+		return m.Tracked
+	}
+	return false
 }
 
-func (m *QueryTxDistributionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QuerySenderTxsResponse) GetNextExpectedSequence() uint64 {
+	if m != nil {
+		return m.NextExpectedSequence
+	}
+	return 0
 }
 
-func (m *QueryTxDistributionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySenderTxsResponse) GetActive() []*MempoolTx {
+	if m != nil {
+		return m.Active
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryTxDistributionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySenderTxsResponse) GetQueued() []*MempoolTx {
+	if m != nil {
+		return m.Queued
+	}
+	return nil
 }
 
-func (m *QueryTxDistributionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Distribution) > 0 {
-		for k := range m.Distribution {
-			v := m.Distribution[k]
-			baseI := i
-			i = encodeVarintQuery(dAtA, i, uint64(v))
-			i--
-			dAtA[i] = 0x10
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintQuery(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *QuerySenderTxsResponse) GetBlocked() bool {
+	if m != nil {
+		return m.Blocked
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *QueryTxHashRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySenderTxsResponse) GetMissingSequence() uint64 {
+	if m != nil {
+		return m.MissingSequence
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryTxHashRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryTierTxsRequest is the request type for the Query.QueryTierTxs
+// RPC method.
+type QueryTierTxsRequest struct {
+	Tier       string             `protobuf:"bytes,1,opt,name=tier,proto3" json:"tier,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryTxHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Sequence) > 0 {
-		i -= len(m.Sequence)
-		copy(dAtA[i:], m.Sequence)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sequence)))
-		i--
-		dAtA[i] = 0x12
+func (m *QueryTierTxsRequest) Reset()         { *m = QueryTierTxsRequest{} }
+func (m *QueryTierTxsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTierTxsRequest) ProtoMessage()    {}
+func (*QueryTierTxsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35466e4a18ef73b8, []int{7}
+}
+func (m *QueryTierTxsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTierTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTierTxsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryTierTxsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTierTxsRequest.Merge(m, src)
+}
+func (m *QueryTierTxsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTierTxsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTierTxsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTierTxsRequest proto.InternalMessageInfo
+
+func (m *QueryTierTxsRequest) GetTier() string {
+	if m != nil {
+		return m.Tier
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryTxHashResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryTierTxsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryTxHashResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryTierTxsResponse is the response type for the Query.QueryTierTxs
+// RPC method.
+type QueryTierTxsResponse struct {
+	Txs        []*MempoolTx        `protobuf:"bytes,1,rep,name=txs,proto3" json:"txs,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryTxHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TxHash) > 0 {
-		i -= len(m.TxHash)
-		copy(dAtA[i:], m.TxHash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxHash)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryTierTxsResponse) Reset()         { *m = QueryTierTxsResponse{} }
+func (m *QueryTierTxsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTierTxsResponse) ProtoMessage()    {}
+func (*QueryTierTxsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35466e4a18ef73b8, []int{8}
+}
+func (m *QueryTierTxsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTierTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTierTxsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryTierTxsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTierTxsResponse.Merge(m, src)
+}
+func (m *QueryTierTxsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTierTxsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTierTxsResponse.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryTierTxsResponse proto.InternalMessageInfo
+
+func (m *QueryTierTxsResponse) GetTxs() []*MempoolTx {
+	if m != nil {
+		return m.Txs
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryTxDistributionRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryTierTxsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *QueryTxDistributionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+// SenderReputation is the admission state of a sender.
+type SenderReputation struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// score is the decayed penalty score.
+	Score float64 `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	// throttled is true when the sender may only keep a limited number of pending txs.
+	Throttled bool `protobuf:"varint,3,opt,name=throttled,proto3" json:"throttled,omitempty"`
+	// banned is true while the sender is refused by the mempool.
+	Banned bool `protobuf:"varint,4,opt,name=banned,proto3" json:"banned,omitempty"`
+	// banned_until is set while the sender is banned.
+	BannedUntil time.Time `protobuf:"bytes,5,opt,name=banned_until,json=bannedUntil,proto3,stdtime" json:"banned_until"`
+}
+
+func (m *SenderReputation) Reset()         { *m = SenderReputation{} }
+func (m *SenderReputation) String() string { return proto.CompactTextString(m) }
+func (*SenderReputation) ProtoMessage()    {}
+func (*SenderReputation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35466e4a18ef73b8, []int{9}
+}
+func (m *SenderReputation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SenderReputation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SenderReputation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if len(m.Distribution) > 0 {
-		for k, v := range m.Distribution {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovQuery(uint64(len(k))) + 1 + sovQuery(uint64(v))
-			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
+}
+func (m *SenderReputation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SenderReputation.Merge(m, src)
+}
+func (m *SenderReputation) XXX_Size() int {
+	return m.Size()
+}
+func (m *SenderReputation) XXX_DiscardUnknown() {
+	xxx_messageInfo_SenderReputation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SenderReputation proto.InternalMessageInfo
+
+func (m *SenderReputation) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *SenderReputation) GetScore() float64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+func (m *SenderReputation) GetThrottled() bool {
+	if m != nil {
+		return m.Throttled
+	}
+	return false
+}
+
+func (m *SenderReputation) GetBanned() bool {
+	if m != nil {
+		return m.Banned
+	}
+	return false
+}
+
+func (m *SenderReputation) GetBannedUntil() time.Time {
+	if m != nil {
+		return m.BannedUntil
+	}
+	return time.Time{}
+}
+
+// QuerySenderReputationsRequest is the request type for the
+// Query.QuerySenderReputations RPC method.
+type QuerySenderReputationsRequest struct {
+	// banned_only restricts the result to currently banned senders.
+	BannedOnly bool `protobuf:"varint,1,opt,name=banned_only,json=bannedOnly,proto3" json:"banned_only,omitempty"`
+}
+
+func (m *QuerySenderReputationsRequest) Reset()         { *m = QuerySenderReputationsRequest{} }
+func (m *QuerySenderReputationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySenderReputationsRequest) ProtoMessage()    {}
+func (*QuerySenderReputationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35466e4a18ef73b8, []int{10}
+}
+func (m *QuerySenderReputationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySenderReputationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySenderReputationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySenderReputationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySenderReputationsRequest.Merge(m, src)
+}
+func (m *QuerySenderReputationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySenderReputationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySenderReputationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySenderReputationsRequest proto.InternalMessageInfo
+
+func (m *QuerySenderReputationsRequest) GetBannedOnly() bool {
+	if m != nil {
+		return m.BannedOnly
+	}
+	return false
+}
+
+// QuerySenderReputationsResponse is the response type for the
+// Query.QuerySenderReputations RPC method.
+type QuerySenderReputationsResponse struct {
+	// reputations is empty when sender scoring is disabled on this node.
+	Reputations []*SenderReputation `protobuf:"bytes,1,rep,name=reputations,proto3" json:"reputations,omitempty"`
+}
+
+func (m *QuerySenderReputationsResponse) Reset()         { *m = QuerySenderReputationsResponse{} }
+func (m *QuerySenderReputationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySenderReputationsResponse) ProtoMessage()    {}
+func (*QuerySenderReputationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35466e4a18ef73b8, []int{11}
+}
+func (m *QuerySenderReputationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySenderReputationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySenderReputationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySenderReputationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySenderReputationsResponse.Merge(m, src)
+}
+func (m *QuerySenderReputationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySenderReputationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySenderReputationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySenderReputationsResponse proto.InternalMessageInfo
+
+func (m *QuerySenderReputationsResponse) GetReputations() []*SenderReputation {
+	if m != nil {
+		return m.Reputations
+	}
+	return nil
+}
+
+// MempoolSnapshotSender is the nonce bookkeeping of a single sender.
+type MempoolSnapshotSender struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// on_chain_seq is the cached on-chain sequence of the sender.
+	OnChainSeq uint64 `protobuf:"varint,2,opt,name=on_chain_seq,json=onChainSeq,proto3" json:"on_chain_seq,omitempty"`
+	// active_min and active_max bound the active nonces; both are zero when
+	// the sender has no active txs.
+	ActiveMin uint64 `protobuf:"varint,3,opt,name=active_min,json=activeMin,proto3" json:"active_min,omitempty"`
+	ActiveMax uint64 `protobuf:"varint,4,opt,name=active_max,json=activeMax,proto3" json:"active_max,omitempty"`
+	// queued_min and queued_max bound the queued nonces; both are zero when
+	// the sender has no queued txs.
+	QueuedMin uint64 `protobuf:"varint,5,opt,name=queued_min,json=queuedMin,proto3" json:"queued_min,omitempty"`
+	QueuedMax uint64 `protobuf:"varint,6,opt,name=queued_max,json=queuedMax,proto3" json:"queued_max,omitempty"`
+	// gap_since is when the sender became blocked on a missing nonce, if any.
+	GapSince time.Time `protobuf:"bytes,7,opt,name=gap_since,json=gapSince,proto3,stdtime" json:"gap_since"`
+}
+
+func (m *MempoolSnapshotSender) Reset()         { *m = MempoolSnapshotSender{} }
+func (m *MempoolSnapshotSender) String() string { return proto.CompactTextString(m) }
+func (*MempoolSnapshotSender) ProtoMessage()    {}
+func (*MempoolSnapshotSender) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35466e4a18ef73b8, []int{12}
+}
+func (m *MempoolSnapshotSender) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MempoolSnapshotSender) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MempoolSnapshotSender.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MempoolSnapshotSender) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MempoolSnapshotSender.Merge(m, src)
+}
+func (m *MempoolSnapshotSender) XXX_Size() int {
+	return m.Size()
+}
+func (m *MempoolSnapshotSender) XXX_DiscardUnknown() {
+	xxx_messageInfo_MempoolSnapshotSender.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MempoolSnapshotSender proto.InternalMessageInfo
+
+func (m *MempoolSnapshotSender) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MempoolSnapshotSender) GetOnChainSeq() uint64 {
+	if m != nil {
+		return m.OnChainSeq
+	}
+	return 0
+}
+
+func (m *MempoolSnapshotSender) GetActiveMin() uint64 {
+	if m != nil {
+		return m.ActiveMin
+	}
+	return 0
+}
+
+func (m *MempoolSnapshotSender) GetActiveMax() uint64 {
+	if m != nil {
+		return m.ActiveMax
+	}
+	return 0
+}
+
+func (m *MempoolSnapshotSender) GetQueuedMin() uint64 {
+	if m != nil {
+		return m.QueuedMin
+	}
+	return 0
+}
+
+func (m *MempoolSnapshotSender) GetQueuedMax() uint64 {
+	if m != nil {
+		return m.QueuedMax
+	}
+	return 0
+}
+
+func (m *MempoolSnapshotSender) GetGapSince() time.Time {
+	if m != nil {
+		return m.GapSince
+	}
+	return time.Time{}
+}
+
+// MempoolSnapshotEntry is a single active or queued tx with its ranking fields.
+type MempoolSnapshotEntry struct {
+	Sender   string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// tier is the tier name of an active tx, or "queued" for a queued tx.
+	Tier     string `protobuf:"bytes,3,opt,name=tier,proto3" json:"tier,omitempty"`
+	Priority int64  `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
+	// clamped_priority and clamped_order are the sender-clamped rank used for
+	// active ordering. Both are zero for queued txs.
+	ClampedPriority int64 `protobuf:"varint,5,opt,name=clamped_priority,json=clampedPriority,proto3" json:"clamped_priority,omitempty"`
+	ClampedOrder    int64 `protobuf:"varint,6,opt,name=clamped_order,json=clampedOrder,proto3" json:"clamped_order,omitempty"`
+	// order is the insertion order of an active tx.
+	Order    int64  `protobuf:"varint,7,opt,name=order,proto3" json:"order,omitempty"`
+	GasLimit uint64 `protobuf:"varint,8,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	TxSize   int64  `protobuf:"varint,9,opt,name=tx_size,json=txSize,proto3" json:"tx_size,omitempty"`
+	TxBytes  []byte `protobuf:"bytes,10,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+}
+
+func (m *MempoolSnapshotEntry) Reset()         { *m = MempoolSnapshotEntry{} }
+func (m *MempoolSnapshotEntry) String() string { return proto.CompactTextString(m) }
+func (*MempoolSnapshotEntry) ProtoMessage()    {}
+func (*MempoolSnapshotEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35466e4a18ef73b8, []int{13}
+}
+func (m *MempoolSnapshotEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MempoolSnapshotEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MempoolSnapshotEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MempoolSnapshotEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MempoolSnapshotEntry.Merge(m, src)
+}
+func (m *MempoolSnapshotEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *MempoolSnapshotEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_MempoolSnapshotEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MempoolSnapshotEntry proto.InternalMessageInfo
+
+func (m *MempoolSnapshotEntry) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MempoolSnapshotEntry) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *MempoolSnapshotEntry) GetTier() string {
+	if m != nil {
+		return m.Tier
+	}
+	return ""
+}
+
+func (m *MempoolSnapshotEntry) GetPriority() int64 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *MempoolSnapshotEntry) GetClampedPriority() int64 {
+	if m != nil {
+		return m.ClampedPriority
+	}
+	return 0
+}
+
+func (m *MempoolSnapshotEntry) GetClampedOrder() int64 {
+	if m != nil {
+		return m.ClampedOrder
+	}
+	return 0
+}
+
+func (m *MempoolSnapshotEntry) GetOrder() int64 {
+	if m != nil {
+		return m.Order
+	}
+	return 0
+}
+
+func (m *MempoolSnapshotEntry) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *MempoolSnapshotEntry) GetTxSize() int64 {
+	if m != nil {
+		return m.TxSize
+	}
+	return 0
+}
+
+func (m *MempoolSnapshotEntry) GetTxBytes() []byte {
+	if m != nil {
+		return m.TxBytes
+	}
+	return nil
+}
+
+// MempoolSnapshot is the full state of the mempool at a point in time.
+type MempoolSnapshot struct {
+	// version is bumped whenever the snapshot layout changes.
+	Version uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// tiers are the tier names in match order, ending with the default tier.
+	Tiers []string `protobuf:"bytes,2,rep,name=tiers,proto3" json:"tiers,omitempty"`
+	// order_seq is the last insertion order handed out by the mempool.
+	OrderSeq int64 `protobuf:"varint,3,opt,name=order_seq,json=orderSeq,proto3" json:"order_seq,omitempty"`
+	// senders are ordered by sender address.
+	Senders []*MempoolSnapshotSender `protobuf:"bytes,4,rep,name=senders,proto3" json:"senders,omitempty"`
+	// active txs are ordered as returned by Select.
+	Active []*MempoolSnapshotEntry `protobuf:"bytes,5,rep,name=active,proto3" json:"active,omitempty"`
+	// queued txs are ordered by sender and sequence.
+	Queued []*MempoolSnapshotEntry `protobuf:"bytes,6,rep,name=queued,proto3" json:"queued,omitempty"`
+}
+
+func (m *MempoolSnapshot) Reset()         { *m = MempoolSnapshot{} }
+func (m *MempoolSnapshot) String() string { return proto.CompactTextString(m) }
+func (*MempoolSnapshot) ProtoMessage()    {}
+func (*MempoolSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35466e4a18ef73b8, []int{14}
+}
+func (m *MempoolSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MempoolSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MempoolSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MempoolSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MempoolSnapshot.Merge(m, src)
+}
+func (m *MempoolSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *MempoolSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_MempoolSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MempoolSnapshot proto.InternalMessageInfo
+
+func (m *MempoolSnapshot) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *MempoolSnapshot) GetTiers() []string {
+	if m != nil {
+		return m.Tiers
+	}
+	return nil
+}
+
+func (m *MempoolSnapshot) GetOrderSeq() int64 {
+	if m != nil {
+		return m.OrderSeq
+	}
+	return 0
+}
+
+func (m *MempoolSnapshot) GetSenders() []*MempoolSnapshotSender {
+	if m != nil {
+		return m.Senders
+	}
+	return nil
+}
+
+func (m *MempoolSnapshot) GetActive() []*MempoolSnapshotEntry {
+	if m != nil {
+		return m.Active
+	}
+	return nil
+}
+
+func (m *MempoolSnapshot) GetQueued() []*MempoolSnapshotEntry {
+	if m != nil {
+		return m.Queued
+	}
+	return nil
+}
+
+// QueryMempoolSnapshotRequest is the request type for the
+// Query.QueryMempoolSnapshot RPC method.
+type QueryMempoolSnapshotRequest struct {
+}
+
+func (m *QueryMempoolSnapshotRequest) Reset()         { *m = QueryMempoolSnapshotRequest{} }
+func (m *QueryMempoolSnapshotRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMempoolSnapshotRequest) ProtoMessage()    {}
+func (*QueryMempoolSnapshotRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35466e4a18ef73b8, []int{15}
+}
+func (m *QueryMempoolSnapshotRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMempoolSnapshotRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMempoolSnapshotRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMempoolSnapshotRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMempoolSnapshotRequest.Merge(m, src)
+}
+func (m *QueryMempoolSnapshotRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMempoolSnapshotRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMempoolSnapshotRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMempoolSnapshotRequest proto.InternalMessageInfo
+
+// QueryMempoolSnapshotResponse is the response type for the
+// Query.QueryMempoolSnapshot RPC method.
+type QueryMempoolSnapshotResponse struct {
+	Snapshot *MempoolSnapshot `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+}
+
+func (m *QueryMempoolSnapshotResponse) Reset()         { *m = QueryMempoolSnapshotResponse{} }
+func (m *QueryMempoolSnapshotResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMempoolSnapshotResponse) ProtoMessage()    {}
+func (*QueryMempoolSnapshotResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35466e4a18ef73b8, []int{16}
+}
+func (m *QueryMempoolSnapshotResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMempoolSnapshotResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMempoolSnapshotResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMempoolSnapshotResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMempoolSnapshotResponse.Merge(m, src)
+}
+func (m *QueryMempoolSnapshotResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMempoolSnapshotResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMempoolSnapshotResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMempoolSnapshotResponse proto.InternalMessageInfo
+
+func (m *QueryMempoolSnapshotResponse) GetSnapshot() *MempoolSnapshot {
+	if m != nil {
+		return m.Snapshot
+	}
+	return nil
+}
+
+// SubscribeMempoolEventsRequest is the request type for the
+// Query.SubscribeMempoolEvents RPC method.
+type SubscribeMempoolEventsRequest struct {
+	// senders restricts events to the given senders (bech32 or 0x-prefixed hex).
+	// Empty matches every sender.
+	Senders []string `protobuf:"bytes,1,rep,name=senders,proto3" json:"senders,omitempty"`
+	// tiers restricts events to the given tier names, including "queued".
+	// Empty matches every tier.
+	Tiers []string `protobuf:"bytes,2,rep,name=tiers,proto3" json:"tiers,omitempty"`
+}
+
+func (m *SubscribeMempoolEventsRequest) Reset()         { *m = SubscribeMempoolEventsRequest{} }
+func (m *SubscribeMempoolEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeMempoolEventsRequest) ProtoMessage()    {}
+func (*SubscribeMempoolEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35466e4a18ef73b8, []int{17}
+}
+func (m *SubscribeMempoolEventsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscribeMempoolEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscribeMempoolEventsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubscribeMempoolEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeMempoolEventsRequest.Merge(m, src)
+}
+func (m *SubscribeMempoolEventsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubscribeMempoolEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeMempoolEventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeMempoolEventsRequest proto.InternalMessageInfo
+
+func (m *SubscribeMempoolEventsRequest) GetSenders() []string {
+	if m != nil {
+		return m.Senders
+	}
+	return nil
+}
+
+func (m *SubscribeMempoolEventsRequest) GetTiers() []string {
+	if m != nil {
+		return m.Tiers
+	}
+	return nil
+}
+
+// MempoolEvent is a single mempool state change.
+type MempoolEvent struct {
+	Type     MempoolEventType `protobuf:"varint,1,opt,name=type,proto3,enum=initia.abcipp.mempool.v1.MempoolEventType" json:"type,omitempty"`
+	Sender   string           `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	Sequence uint64           `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// tier is the tier name at the time of the event, or "queued".
+	Tier   string `protobuf:"bytes,4,opt,name=tier,proto3" json:"tier,omitempty"`
+	TxHash string `protobuf:"bytes,5,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// reason is set for removed events.
+	Reason RemovalReason `protobuf:"varint,6,opt,name=reason,proto3,enum=initia.abcipp.mempool.v1.RemovalReason" json:"reason,omitempty"`
+}
+
+func (m *MempoolEvent) Reset()         { *m = MempoolEvent{} }
+func (m *MempoolEvent) String() string { return proto.CompactTextString(m) }
+func (*MempoolEvent) ProtoMessage()    {}
+func (*MempoolEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35466e4a18ef73b8, []int{18}
+}
+func (m *MempoolEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MempoolEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MempoolEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MempoolEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MempoolEvent.Merge(m, src)
+}
+func (m *MempoolEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *MempoolEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_MempoolEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MempoolEvent proto.InternalMessageInfo
+
+func (m *MempoolEvent) GetType() MempoolEventType {
+	if m != nil {
+		return m.Type
+	}
+	return MempoolEventType_MEMPOOL_EVENT_TYPE_UNSPECIFIED
+}
+
+func (m *MempoolEvent) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MempoolEvent) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *MempoolEvent) GetTier() string {
+	if m != nil {
+		return m.Tier
+	}
+	return ""
+}
+
+func (m *MempoolEvent) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *MempoolEvent) GetReason() RemovalReason {
+	if m != nil {
+		return m.Reason
+	}
+	return RemovalReason_REMOVAL_REASON_UNSPECIFIED
+}
+
+func init() {
+	proto.RegisterEnum("initia.abcipp.mempool.v1.MempoolEventType", MempoolEventType_name, MempoolEventType_value)
+	proto.RegisterEnum("initia.abcipp.mempool.v1.RemovalReason", RemovalReason_name, RemovalReason_value)
+	proto.RegisterType((*QueryTxDistributionRequest)(nil), "initia.abcipp.mempool.v1.QueryTxDistributionRequest")
+	proto.RegisterType((*QueryTxDistributionResponse)(nil), "initia.abcipp.mempool.v1.QueryTxDistributionResponse")
+	proto.RegisterMapType((map[string]uint64)(nil), "initia.abcipp.mempool.v1.QueryTxDistributionResponse.DistributionEntry")
+	proto.RegisterType((*QueryTxHashRequest)(nil), "initia.abcipp.mempool.v1.QueryTxHashRequest")
+	proto.RegisterType((*QueryTxHashResponse)(nil), "initia.abcipp.mempool.v1.QueryTxHashResponse")
+	proto.RegisterType((*MempoolTx)(nil), "initia.abcipp.mempool.v1.MempoolTx")
+	proto.RegisterType((*QuerySenderTxsRequest)(nil), "initia.abcipp.mempool.v1.QuerySenderTxsRequest")
+	proto.RegisterType((*QuerySenderTxsResponse)(nil), "initia.abcipp.mempool.v1.QuerySenderTxsResponse")
+	proto.RegisterType((*QueryTierTxsRequest)(nil), "initia.abcipp.mempool.v1.QueryTierTxsRequest")
+	proto.RegisterType((*QueryTierTxsResponse)(nil), "initia.abcipp.mempool.v1.QueryTierTxsResponse")
+	proto.RegisterType((*SenderReputation)(nil), "initia.abcipp.mempool.v1.SenderReputation")
+	proto.RegisterType((*QuerySenderReputationsRequest)(nil), "initia.abcipp.mempool.v1.QuerySenderReputationsRequest")
+	proto.RegisterType((*QuerySenderReputationsResponse)(nil), "initia.abcipp.mempool.v1.QuerySenderReputationsResponse")
+	proto.RegisterType((*MempoolSnapshotSender)(nil), "initia.abcipp.mempool.v1.MempoolSnapshotSender")
+	proto.RegisterType((*MempoolSnapshotEntry)(nil), "initia.abcipp.mempool.v1.MempoolSnapshotEntry")
+	proto.RegisterType((*MempoolSnapshot)(nil), "initia.abcipp.mempool.v1.MempoolSnapshot")
+	proto.RegisterType((*QueryMempoolSnapshotRequest)(nil), "initia.abcipp.mempool.v1.QueryMempoolSnapshotRequest")
+	proto.RegisterType((*QueryMempoolSnapshotResponse)(nil), "initia.abcipp.mempool.v1.QueryMempoolSnapshotResponse")
+	proto.RegisterType((*SubscribeMempoolEventsRequest)(nil), "initia.abcipp.mempool.v1.SubscribeMempoolEventsRequest")
+	proto.RegisterType((*MempoolEvent)(nil), "initia.abcipp.mempool.v1.MempoolEvent")
+}
+
+func init() {
+	proto.RegisterFile("initia/abcipp/mempool/v1/query.proto", fileDescriptor_35466e4a18ef73b8)
+}
+
+var fileDescriptor_35466e4a18ef73b8 = []byte{
+	// 1687 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0xcd, 0x6f, 0xdb, 0xc8,
+	0x15, 0x37, 0xf5, 0x65, 0xf9, 0xd9, 0x49, 0xd4, 0xa9, 0xd7, 0xcb, 0x55, 0x62, 0xd9, 0x65, 0xba,
+	0x89, 0xd7, 0x5d, 0x93, 0x89, 0xbb, 0xd9, 0x2e, 0x5a, 0xa0, 0x5b, 0xc5, 0x66, 0xb2, 0x6a, 0x6d,
+	0x4b, 0x3b, 0x52, 0x82, 0x6e, 0x2f, 0x04, 0x25, 0x4f, 0x65, 0x22, 0x12, 0x49, 0x93, 0x23, 0x41,
+	0xda, 0x60, 0x2f, 0x05, 0xda, 0x73, 0x80, 0x02, 0xbd, 0xef, 0xa9, 0x87, 0x9e, 0xda, 0x4b, 0xd1,
+	0x5e, 0x7a, 0x5d, 0xa0, 0x97, 0x05, 0x7a, 0xe9, 0xa9, 0x2d, 0x92, 0x5e, 0x7a, 0xeb, 0xa1, 0x7f,
+	0x40, 0x31, 0x1f, 0x94, 0x28, 0x46, 0xb4, 0xe5, 0xdc, 0x38, 0xef, 0xbd, 0xdf, 0xe3, 0x6f, 0xde,
+	0x9b, 0xf7, 0xe6, 0x0d, 0x7c, 0xdb, 0x71, 0x1d, 0xea, 0xd8, 0x86, 0xdd, 0xee, 0x38, 0xbe, 0x6f,
+	0xf4, 0x49, 0xdf, 0xf7, 0xbc, 0x9e, 0x31, 0xbc, 0x6f, 0x9c, 0x0f, 0x48, 0x30, 0xd6, 0xfd, 0xc0,
+	0xa3, 0x1e, 0x52, 0x85, 0x95, 0x2e, 0xac, 0x74, 0x69, 0xa5, 0x0f, 0xef, 0x97, 0x77, 0x3b, 0x5e,
+	0xd8, 0xf7, 0x42, 0xa3, 0x6d, 0x87, 0x44, 0x40, 0x8c, 0xe1, 0xfd, 0x36, 0xa1, 0xf6, 0x7d, 0xc3,
+	0xb7, 0xbb, 0x8e, 0x6b, 0x53, 0xc7, 0x73, 0x85, 0x97, 0xf2, 0x7a, 0xd7, 0xeb, 0x7a, 0xfc, 0xd3,
+	0x60, 0x5f, 0x52, 0x7a, 0xab, 0xeb, 0x79, 0xdd, 0x1e, 0x31, 0x6c, 0xdf, 0x31, 0x6c, 0xd7, 0xf5,
+	0x28, 0x87, 0x84, 0x52, 0xbb, 0x25, 0xb5, 0x7c, 0xd5, 0x1e, 0xfc, 0xdc, 0xa0, 0x4e, 0x9f, 0x84,
+	0xd4, 0xee, 0xfb, 0xc2, 0x40, 0xbb, 0x05, 0xe5, 0x4f, 0xd9, 0x6f, 0x5b, 0xa3, 0x43, 0x27, 0xa4,
+	0x81, 0xd3, 0x1e, 0x30, 0x38, 0x26, 0xe7, 0x03, 0x12, 0x52, 0xed, 0xaf, 0x0a, 0xdc, 0x9c, 0xab,
+	0x0e, 0x7d, 0xcf, 0x0d, 0x09, 0x7a, 0x06, 0x6b, 0xa7, 0x31, 0xb9, 0xaa, 0x6c, 0x67, 0x77, 0x56,
+	0xf7, 0x1f, 0xeb, 0x69, 0xfb, 0xd5, 0x2f, 0x70, 0xa6, 0xc7, 0x85, 0xa6, 0x4b, 0x83, 0x31, 0x9e,
+	0x71, 0x5e, 0xfe, 0x18, 0xbe, 0xf1, 0x9a, 0x09, 0x2a, 0x41, 0xf6, 0x19, 0x19, 0xab, 0xca, 0xb6,
+	0xb2, 0xb3, 0x82, 0xd9, 0x27, 0x5a, 0x87, 0xfc, 0xd0, 0xee, 0x0d, 0x88, 0x9a, 0xd9, 0x56, 0x76,
+	0x72, 0x58, 0x2c, 0xbe, 0x9f, 0xf9, 0x48, 0xd1, 0x3e, 0x01, 0x24, 0xff, 0xff, 0x89, 0x1d, 0x9e,
+	0xc9, 0x3d, 0xa2, 0x0d, 0x28, 0x84, 0xc4, 0x3d, 0x25, 0x81, 0x74, 0x22, 0x57, 0xa8, 0x0c, 0xc5,
+	0x90, 0x99, 0xb8, 0x1d, 0xe1, 0x6a, 0x05, 0x4f, 0xd6, 0xda, 0x1e, 0x7c, 0x73, 0xc6, 0x93, 0x0c,
+	0xc7, 0x06, 0x14, 0x28, 0x97, 0x44, 0xae, 0xc4, 0x4a, 0xfb, 0x8f, 0x02, 0x2b, 0xc7, 0x22, 0x08,
+	0xad, 0xd1, 0xc2, 0x3f, 0xcc, 0x4d, 0x7f, 0x88, 0xde, 0x86, 0x65, 0x3a, 0xb2, 0xce, 0x98, 0xeb,
+	0x6c, 0xdc, 0x35, 0x42, 0x90, 0xa3, 0x0e, 0x09, 0xd4, 0x1c, 0x97, 0xf2, 0x6f, 0xe6, 0xc8, 0x0f,
+	0x1c, 0x2f, 0x70, 0xe8, 0x58, 0xcd, 0x6f, 0x2b, 0x3b, 0x59, 0x3c, 0x59, 0xa3, 0xf7, 0xa0, 0xd4,
+	0xe9, 0xd9, 0x7d, 0x9f, 0x9c, 0x5a, 0x13, 0x9b, 0x02, 0xb7, 0xb9, 0x21, 0xe5, 0x8d, 0xc8, 0xf4,
+	0x26, 0xac, 0x74, 0xed, 0xd0, 0xea, 0x39, 0x7d, 0x87, 0xaa, 0xcb, 0x82, 0x50, 0xd7, 0x0e, 0x8f,
+	0xd8, 0x5a, 0x12, 0x0a, 0x9d, 0xcf, 0x89, 0x5a, 0xe4, 0xf0, 0x02, 0x1d, 0x35, 0x9d, 0xcf, 0x89,
+	0x66, 0xc0, 0x5b, 0x3c, 0x34, 0x4d, 0xbe, 0xa9, 0xd6, 0x28, 0xbc, 0x24, 0xce, 0xda, 0x6f, 0x33,
+	0xb0, 0x91, 0x44, 0xc8, 0x78, 0xaa, 0xb0, 0x4c, 0x03, 0xbb, 0xf3, 0x8c, 0x9c, 0x72, 0x4c, 0x11,
+	0x47, 0x4b, 0xf4, 0x01, 0x6c, 0xb8, 0x64, 0x44, 0x2d, 0x32, 0xf2, 0x49, 0x87, 0x92, 0x53, 0x2b,
+	0x11, 0xb9, 0x75, 0xa6, 0x35, 0xa5, 0xb2, 0x19, 0x45, 0xf1, 0x07, 0x50, 0xb0, 0x3b, 0xd4, 0x19,
+	0x12, 0x35, 0xcb, 0x0f, 0xea, 0xed, 0xf4, 0x83, 0x3a, 0x49, 0x17, 0x96, 0x10, 0x06, 0x3e, 0x1f,
+	0x90, 0x01, 0x39, 0x55, 0x73, 0x57, 0x00, 0x0b, 0x08, 0xdb, 0x49, 0xbb, 0xe7, 0xf1, 0x9d, 0xe4,
+	0xc5, 0x4e, 0xe4, 0x92, 0x25, 0xa4, 0xef, 0x84, 0xa1, 0xe3, 0x76, 0xa7, 0x7b, 0x28, 0xf0, 0x3d,
+	0xdc, 0x90, 0xf2, 0x88, 0xbe, 0x76, 0x1e, 0x9d, 0x3a, 0x67, 0x26, 0xb0, 0xd1, 0x11, 0x50, 0x62,
+	0x47, 0xe0, 0x11, 0xc0, 0xb4, 0x7f, 0xf0, 0x98, 0xac, 0xee, 0xdf, 0xd1, 0x45, 0xb3, 0xd1, 0x59,
+	0xb3, 0xd1, 0x45, 0x7f, 0x92, 0xcd, 0x46, 0x6f, 0xd8, 0x5d, 0x22, 0xfd, 0xe1, 0x18, 0x52, 0xfb,
+	0x8d, 0x02, 0xeb, 0xb3, 0xff, 0x94, 0xa9, 0x79, 0x00, 0x59, 0x3a, 0x0a, 0x55, 0x65, 0xf1, 0x50,
+	0x30, 0x7b, 0xf4, 0x78, 0x0e, 0xaf, 0xbb, 0x97, 0xf2, 0x12, 0xff, 0x9c, 0x21, 0xf6, 0x17, 0x05,
+	0x4a, 0xe2, 0xc0, 0x60, 0xe2, 0x0f, 0x44, 0xd3, 0x4b, 0xad, 0xac, 0x75, 0xc8, 0x87, 0x1d, 0x2f,
+	0x10, 0x87, 0x43, 0xc1, 0x62, 0x81, 0x6e, 0xc1, 0x0a, 0x3d, 0x0b, 0x3c, 0x4a, 0x7b, 0xe4, 0x94,
+	0x57, 0x55, 0x11, 0x4f, 0x05, 0xcc, 0x57, 0xdb, 0x76, 0x5d, 0x9e, 0x6e, 0xa6, 0x92, 0x2b, 0xf4,
+	0x18, 0xd6, 0xc4, 0x97, 0x35, 0x70, 0xa9, 0xd3, 0xe3, 0xe9, 0x5c, 0xdd, 0x2f, 0xeb, 0xa2, 0xd1,
+	0xea, 0x51, 0xa3, 0xd5, 0x5b, 0x51, 0xa3, 0x7d, 0x58, 0xfc, 0xea, 0x1f, 0x5b, 0x4b, 0x2f, 0xfe,
+	0xb9, 0xa5, 0xe0, 0x55, 0x81, 0x7c, 0xc2, 0x80, 0xda, 0x8f, 0x60, 0x33, 0x76, 0xec, 0xa7, 0xbb,
+	0x98, 0xe4, 0x75, 0x0b, 0xa4, 0xbd, 0xe5, 0xb9, 0xbd, 0xb1, 0xac, 0x00, 0x10, 0xa2, 0xba, 0xdb,
+	0x1b, 0x6b, 0x2e, 0x54, 0xd2, 0x3c, 0xc8, 0x2c, 0x1d, 0xc1, 0x6a, 0x30, 0x15, 0xcb, 0x6c, 0xed,
+	0xa6, 0x67, 0x2b, 0xe9, 0x09, 0xc7, 0xe1, 0xda, 0x8b, 0x0c, 0xbc, 0x25, 0xf3, 0xd9, 0x74, 0x6d,
+	0x3f, 0x3c, 0xf3, 0xa8, 0x00, 0xa4, 0x06, 0x7e, 0x1b, 0xd6, 0x3c, 0xd7, 0xea, 0x9c, 0xd9, 0x8e,
+	0xcb, 0x4e, 0xb7, 0x2c, 0x4e, 0xf0, 0xdc, 0x03, 0x26, 0x6a, 0x92, 0x73, 0xb4, 0x09, 0x20, 0xea,
+	0xcb, 0xea, 0x3b, 0x2e, 0xcf, 0x42, 0x0e, 0xaf, 0x08, 0xc9, 0xb1, 0xe3, 0xc6, 0xd5, 0xf6, 0x48,
+	0xcd, 0xcd, 0xa8, 0xed, 0x11, 0x53, 0x8b, 0x02, 0xe3, 0xe8, 0xbc, 0x50, 0x0b, 0x89, 0x44, 0x47,
+	0x6a, 0x7b, 0xa4, 0x16, 0x66, 0xd4, 0xf6, 0x08, 0x55, 0x59, 0x83, 0xf3, 0xad, 0xd0, 0x61, 0x35,
+	0xb7, 0x7c, 0x85, 0x3c, 0x16, 0xbb, 0xb6, 0xdf, 0x64, 0x28, 0xed, 0xf7, 0x19, 0x58, 0x4f, 0x84,
+	0x44, 0xdc, 0x4b, 0x6f, 0xd2, 0xe4, 0xa3, 0x42, 0xce, 0xa6, 0xf4, 0xf2, 0xdc, 0x02, 0xbd, 0x3c,
+	0x3f, 0xbf, 0x97, 0xdf, 0x86, 0x6b, 0x91, 0xa9, 0x17, 0x30, 0x56, 0xa2, 0xe7, 0xaf, 0x49, 0x61,
+	0x3d, 0x90, 0x65, 0x22, 0x94, 0xcb, 0x5c, 0x29, 0x16, 0xb3, 0xd7, 0x40, 0x31, 0xfd, 0x1a, 0x58,
+	0x89, 0x5f, 0x03, 0xe8, 0x1d, 0x28, 0xd2, 0x91, 0xd5, 0x1e, 0x53, 0x12, 0xaa, 0xb0, 0xad, 0xec,
+	0xac, 0xe1, 0x65, 0x3a, 0x7a, 0xc8, 0x96, 0xda, 0x9f, 0x32, 0x70, 0x23, 0x11, 0x33, 0xd6, 0x1f,
+	0x87, 0x24, 0x08, 0xc5, 0x0c, 0xa1, 0xec, 0x5c, 0xc3, 0xd1, 0x92, 0x91, 0x62, 0x81, 0x08, 0xd5,
+	0xcc, 0x76, 0x76, 0x67, 0x05, 0x8b, 0x05, 0x23, 0xc5, 0xd9, 0xf1, 0x53, 0x95, 0x15, 0x71, 0xe1,
+	0x02, 0x76, 0xa6, 0x6a, 0xb0, 0x2c, 0xa2, 0x1d, 0xca, 0x56, 0x6d, 0x5c, 0xda, 0x9f, 0x66, 0xcf,
+	0x33, 0x8e, 0xf0, 0xe8, 0xd1, 0xe4, 0xc6, 0xc8, 0x73, 0x4f, 0xfa, 0xc2, 0x9e, 0xc4, 0x04, 0x13,
+	0x5d, 0x1e, 0x8f, 0x26, 0x97, 0x47, 0xe1, 0xcd, 0xfc, 0x08, 0xb4, 0xb6, 0x29, 0xe7, 0xb1, 0x84,
+	0x51, 0x34, 0xaf, 0x11, 0xb8, 0x35, 0x5f, 0x2d, 0xfb, 0x81, 0x09, 0xc5, 0x50, 0xca, 0x78, 0x9c,
+	0x57, 0xf7, 0xdf, 0x5b, 0x98, 0x08, 0x9e, 0x40, 0xb5, 0x3a, 0x6c, 0x36, 0x07, 0xed, 0xb0, 0x13,
+	0x38, 0x6d, 0x22, 0xad, 0xcc, 0x21, 0x71, 0xe9, 0xa4, 0x75, 0xa9, 0xd3, 0x0c, 0x28, 0x3c, 0x6d,
+	0x93, 0x80, 0xce, 0x4d, 0xa7, 0xf6, 0x5f, 0x05, 0xd6, 0xe2, 0x8e, 0xd0, 0x0f, 0x21, 0x47, 0xc7,
+	0x3e, 0xe1, 0x24, 0xaf, 0x5f, 0xd4, 0xb1, 0xe2, 0xa8, 0xd6, 0xd8, 0x27, 0x98, 0xe3, 0x62, 0xe5,
+	0x97, 0x49, 0x2d, 0xbf, 0x6c, 0x4a, 0xf9, 0xc5, 0x47, 0xa9, 0xd8, 0xdc, 0x95, 0x9f, 0x99, 0xbb,
+	0x3e, 0x86, 0x42, 0x40, 0xec, 0xd0, 0x73, 0x79, 0x25, 0x5d, 0xdf, 0xbf, 0x9b, 0x4e, 0x11, 0x93,
+	0xbe, 0x37, 0xb4, 0x7b, 0x98, 0x9b, 0x63, 0x09, 0xdb, 0xfd, 0xa3, 0x02, 0xa5, 0x24, 0x79, 0xa4,
+	0x41, 0xe5, 0xd8, 0x3c, 0x6e, 0xd4, 0xeb, 0x47, 0x96, 0xf9, 0xd4, 0x3c, 0x69, 0x59, 0xad, 0xcf,
+	0x1a, 0xa6, 0xf5, 0xe4, 0xa4, 0xd9, 0x30, 0x0f, 0x6a, 0x8f, 0x6a, 0xe6, 0x61, 0x69, 0x09, 0x6d,
+	0xc1, 0xcd, 0x39, 0x36, 0xb5, 0x93, 0xa6, 0x89, 0x5b, 0xe6, 0x61, 0x49, 0x41, 0x9b, 0xf0, 0xce,
+	0x1c, 0x83, 0x4f, 0x9f, 0x98, 0x4f, 0xcc, 0xc3, 0x52, 0x26, 0x05, 0xdf, 0xc0, 0xf5, 0xe3, 0x3a,
+	0xc3, 0x67, 0x51, 0x05, 0xca, 0x73, 0x0c, 0xb0, 0x79, 0x5c, 0x7f, 0x6a, 0x1e, 0x96, 0x72, 0xbb,
+	0xbf, 0xca, 0xc0, 0xb5, 0x99, 0x3d, 0x31, 0x04, 0x57, 0x57, 0x8f, 0x2c, 0x6c, 0x56, 0x9b, 0xf5,
+	0x93, 0x04, 0xe5, 0xdb, 0xb0, 0x95, 0xd0, 0x1f, 0x54, 0x1b, 0xd5, 0x83, 0x5a, 0xeb, 0x33, 0xcb,
+	0x7c, 0x5a, 0x3b, 0x10, 0xb4, 0xdf, 0x85, 0x6f, 0x25, 0x8d, 0xea, 0xc7, 0xc7, 0xb5, 0x56, 0xcb,
+	0x3c, 0xb4, 0x6a, 0x27, 0xd6, 0xc3, 0xa3, 0xfa, 0xc1, 0x4f, 0x4a, 0x19, 0xf4, 0x1d, 0xb8, 0x9b,
+	0x30, 0xab, 0x9e, 0xb4, 0x18, 0xbd, 0x1f, 0x9b, 0x07, 0xd2, 0xb4, 0x81, 0xcd, 0x46, 0x15, 0x9b,
+	0xa5, 0x2c, 0xba, 0x09, 0x6f, 0x27, 0x8c, 0xb1, 0xd9, 0x38, 0xaa, 0x1e, 0xb0, 0x7d, 0x20, 0x15,
+	0xd6, 0x13, 0xca, 0x66, 0xab, 0x7a, 0x64, 0x96, 0xf2, 0x73, 0xa8, 0x88, 0xe8, 0x59, 0x8f, 0xab,
+	0x0d, 0xcb, 0xfc, 0x69, 0xa3, 0x86, 0xcd, 0xc3, 0x52, 0x61, 0xff, 0x7f, 0x45, 0xc8, 0xf3, 0x72,
+	0x43, 0x7f, 0x50, 0x26, 0x0f, 0x82, 0xf8, 0x13, 0x05, 0x7d, 0x70, 0xc5, 0x97, 0x10, 0xaf, 0x9e,
+	0xf2, 0x83, 0x37, 0x7a, 0x3f, 0x69, 0xfa, 0x2f, 0xfe, 0xf6, 0xef, 0x5f, 0x67, 0x76, 0xd0, 0x1d,
+	0x23, 0xf5, 0x51, 0x1a, 0x7f, 0x4f, 0xa1, 0x2f, 0x15, 0x58, 0x8d, 0xbd, 0x62, 0xd0, 0xfb, 0x97,
+	0xfe, 0x36, 0xf6, 0x6c, 0x2a, 0xef, 0x2d, 0x68, 0x2d, 0xc9, 0x3d, 0xe0, 0xe4, 0x0c, 0xb4, 0x97,
+	0x4e, 0xee, 0xb9, 0xa8, 0xd1, 0x2f, 0x8c, 0xe7, 0x51, 0x49, 0x7e, 0x81, 0x7e, 0xa7, 0xc0, 0xf5,
+	0xd9, 0xc7, 0x01, 0x32, 0x2e, 0xf9, 0x71, 0xf2, 0xe1, 0x51, 0xbe, 0xb7, 0x38, 0x40, 0x92, 0xfd,
+	0x90, 0x93, 0xbd, 0x87, 0xf4, 0x74, 0xb2, 0xb2, 0x9f, 0x4d, 0x49, 0xb3, 0xe9, 0xf6, 0x4b, 0x05,
+	0xd6, 0xe2, 0xd3, 0x32, 0xba, 0x34, 0x48, 0x33, 0x93, 0x7c, 0x59, 0x5f, 0xd4, 0x5c, 0xf2, 0xdc,
+	0xe7, 0x3c, 0xdf, 0x47, 0xbb, 0xe9, 0x3c, 0x79, 0x7f, 0x35, 0x9e, 0x53, 0x27, 0xe2, 0xf8, 0x67,
+	0x65, 0xe6, 0xb9, 0x15, 0x9b, 0x1a, 0xd1, 0xf7, 0x16, 0x0a, 0xd4, 0xeb, 0x93, 0x6a, 0xf9, 0xa3,
+	0xab, 0x03, 0xe5, 0x0e, 0xf6, 0xf8, 0x0e, 0xee, 0xa2, 0x77, 0xd3, 0x77, 0x10, 0x9b, 0x40, 0xd1,
+	0x2f, 0xa3, 0xe7, 0x48, 0x72, 0x7e, 0xb8, 0xac, 0x64, 0xe6, 0xdf, 0x97, 0xe5, 0x0f, 0xaf, 0x0a,
+	0x93, 0xf7, 0xe8, 0x73, 0xd8, 0x98, 0x7f, 0x01, 0x5e, 0x14, 0xc3, 0x0b, 0xaf, 0xcc, 0xf2, 0x9d,
+	0xc5, 0xee, 0xb8, 0x7b, 0xca, 0xc3, 0xc3, 0xaf, 0x5e, 0x56, 0x94, 0xaf, 0x5f, 0x56, 0x94, 0x7f,
+	0xbd, 0xac, 0x28, 0x2f, 0x5e, 0x55, 0x96, 0xbe, 0x7e, 0x55, 0x59, 0xfa, 0xfb, 0xab, 0xca, 0xd2,
+	0xcf, 0x76, 0xbb, 0x0e, 0x3d, 0x1b, 0xb4, 0xf5, 0x8e, 0xd7, 0x97, 0xf1, 0xdc, 0xeb, 0xd9, 0xed,
+	0x30, 0x11, 0x5b, 0x76, 0x41, 0x86, 0xed, 0x02, 0x9f, 0x70, 0xbf, 0xfb, 0xff, 0x01, 0x00, 0x0d,
+	0x75, 0xd9, 0x9d, 0xc2, 0x12, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// QueryTxDistribution returns the distribution of transactions in the mempool.
+	QueryTxDistribution(ctx context.Context, in *QueryTxDistributionRequest, opts ...grpc.CallOption) (*QueryTxDistributionResponse, error)
+	// QueryTxHash checks if a transaction is in the mempool.
+	QueryTxHash(ctx context.Context, in *QueryTxHashRequest, opts ...grpc.CallOption) (*QueryTxHashResponse, error)
+	// QuerySenderTxs returns the active and queued txs of a sender, and the missing
+	// sequence that blocks queued txs from being promoted.
+	QuerySenderTxs(ctx context.Context, in *QuerySenderTxsRequest, opts ...grpc.CallOption) (*QuerySenderTxsResponse, error)
+	// QueryTierTxs pages through the txs of a tier. The "queued" tier lists queued txs.
+	QueryTierTxs(ctx context.Context, in *QueryTierTxsRequest, opts ...grpc.CallOption) (*QueryTierTxsResponse, error)
+	// QuerySenderReputations returns the admission scores of tracked senders,
+	// highest score first, including throttled and banned senders.
+	QuerySenderReputations(ctx context.Context, in *QuerySenderReputationsRequest, opts ...grpc.CallOption) (*QuerySenderReputationsResponse, error)
+	// QueryMempoolSnapshot dumps the full mempool state: active txs in Select
+	// order, queued txs, per-sender nonce ranges and tiers. Replaying the
+	// snapshot into an empty mempool reproduces the same Select order. It is a
+	// debug endpoint that is only served by nodes that set
+	// abcipp.snapshot-query-enabled. It is not exposed over REST, but once
+	// enabled it is reachable over gRPC and through CometBFT RPC abci_query.
+	QueryMempoolSnapshot(ctx context.Context, in *QueryMempoolSnapshotRequest, opts ...grpc.CallOption) (*QueryMempoolSnapshotResponse, error)
+	// SubscribeMempoolEvents streams inserts, promotions from queued to active, and
+	// removals with their reason. The stream is closed when the subscriber falls
+	// too far behind; clients should resync with the other queries and resubscribe.
+	SubscribeMempoolEvents(ctx context.Context, in *SubscribeMempoolEventsRequest, opts ...grpc.CallOption) (Query_SubscribeMempoolEventsClient, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) QueryTxDistribution(ctx context.Context, in *QueryTxDistributionRequest, opts ...grpc.CallOption) (*QueryTxDistributionResponse, error) {
+	out := new(QueryTxDistributionResponse)
+	err := c.cc.Invoke(ctx, "/initia.abcipp.mempool.v1.Query/QueryTxDistribution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryTxHash(ctx context.Context, in *QueryTxHashRequest, opts ...grpc.CallOption) (*QueryTxHashResponse, error) {
+	out := new(QueryTxHashResponse)
+	err := c.cc.Invoke(ctx, "/initia.abcipp.mempool.v1.Query/QueryTxHash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QuerySenderTxs(ctx context.Context, in *QuerySenderTxsRequest, opts ...grpc.CallOption) (*QuerySenderTxsResponse, error) {
+	out := new(QuerySenderTxsResponse)
+	err := c.cc.Invoke(ctx, "/initia.abcipp.mempool.v1.Query/QuerySenderTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryTierTxs(ctx context.Context, in *QueryTierTxsRequest, opts ...grpc.CallOption) (*QueryTierTxsResponse, error) {
+	out := new(QueryTierTxsResponse)
+	err := c.cc.Invoke(ctx, "/initia.abcipp.mempool.v1.Query/QueryTierTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QuerySenderReputations(ctx context.Context, in *QuerySenderReputationsRequest, opts ...grpc.CallOption) (*QuerySenderReputationsResponse, error) {
+	out := new(QuerySenderReputationsResponse)
+	err := c.cc.Invoke(ctx, "/initia.abcipp.mempool.v1.Query/QuerySenderReputations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryMempoolSnapshot(ctx context.Context, in *QueryMempoolSnapshotRequest, opts ...grpc.CallOption) (*QueryMempoolSnapshotResponse, error) {
+	out := new(QueryMempoolSnapshotResponse)
+	err := c.cc.Invoke(ctx, "/initia.abcipp.mempool.v1.Query/QueryMempoolSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SubscribeMempoolEvents(ctx context.Context, in *SubscribeMempoolEventsRequest, opts ...grpc.CallOption) (Query_SubscribeMempoolEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Query_serviceDesc.Streams[0], "/initia.abcipp.mempool.v1.Query/SubscribeMempoolEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &querySubscribeMempoolEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Query_SubscribeMempoolEventsClient interface {
+	Recv() (*MempoolEvent, error)
+	grpc.ClientStream
+}
+
+type querySubscribeMempoolEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *querySubscribeMempoolEventsClient) Recv() (*MempoolEvent, error) {
+	m := new(MempoolEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// QueryTxDistribution returns the distribution of transactions in the mempool.
+	QueryTxDistribution(context.Context, *QueryTxDistributionRequest) (*QueryTxDistributionResponse, error)
+	// QueryTxHash checks if a transaction is in the mempool.
+	QueryTxHash(context.Context, *QueryTxHashRequest) (*QueryTxHashResponse, error)
+	// QuerySenderTxs returns the active and queued txs of a sender, and the missing
+	// sequence that blocks queued txs from being promoted.
+	QuerySenderTxs(context.Context, *QuerySenderTxsRequest) (*QuerySenderTxsResponse, error)
+	// QueryTierTxs pages through the txs of a tier. The "queued" tier lists queued txs.
+	QueryTierTxs(context.Context, *QueryTierTxsRequest) (*QueryTierTxsResponse, error)
+	// QuerySenderReputations returns the admission scores of tracked senders,
+	// highest score first, including throttled and banned senders.
+	QuerySenderReputations(context.Context, *QuerySenderReputationsRequest) (*QuerySenderReputationsResponse, error)
+	// QueryMempoolSnapshot dumps the full mempool state: active txs in Select
+	// order, queued txs, per-sender nonce ranges and tiers. Replaying the
+	// snapshot into an empty mempool reproduces the same Select order. It is a
+	// debug endpoint that is only served by nodes that set
+	// abcipp.snapshot-query-enabled. It is not exposed over REST, but once
+	// enabled it is reachable over gRPC and through CometBFT RPC abci_query.
+	QueryMempoolSnapshot(context.Context, *QueryMempoolSnapshotRequest) (*QueryMempoolSnapshotResponse, error)
+	// SubscribeMempoolEvents streams inserts, promotions from queued to active, and
+	// removals with their reason. The stream is closed when the subscriber falls
+	// too far behind; clients should resync with the other queries and resubscribe.
+	SubscribeMempoolEvents(*SubscribeMempoolEventsRequest, Query_SubscribeMempoolEventsServer) error
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) QueryTxDistribution(ctx context.Context, req *QueryTxDistributionRequest) (*QueryTxDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryTxDistribution not implemented")
+}
+func (*UnimplementedQueryServer) QueryTxHash(ctx context.Context, req *QueryTxHashRequest) (*QueryTxHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryTxHash not implemented")
+}
+func (*UnimplementedQueryServer) QuerySenderTxs(ctx context.Context, req *QuerySenderTxsRequest) (*QuerySenderTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuerySenderTxs not implemented")
+}
+func (*UnimplementedQueryServer) QueryTierTxs(ctx context.Context, req *QueryTierTxsRequest) (*QueryTierTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryTierTxs not implemented")
+}
+func (*UnimplementedQueryServer) QuerySenderReputations(ctx context.Context, req *QuerySenderReputationsRequest) (*QuerySenderReputationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuerySenderReputations not implemented")
+}
+func (*UnimplementedQueryServer) QueryMempoolSnapshot(ctx context.Context, req *QueryMempoolSnapshotRequest) (*QueryMempoolSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryMempoolSnapshot not implemented")
+}
+func (*UnimplementedQueryServer) SubscribeMempoolEvents(req *SubscribeMempoolEventsRequest, srv Query_SubscribeMempoolEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeMempoolEvents not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_QueryTxDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTxDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryTxDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.abcipp.mempool.v1.Query/QueryTxDistribution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryTxDistribution(ctx, req.(*QueryTxDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryTxHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTxHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryTxHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.abcipp.mempool.v1.Query/QueryTxHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryTxHash(ctx, req.(*QueryTxHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QuerySenderTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySenderTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QuerySenderTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.abcipp.mempool.v1.Query/QuerySenderTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuerySenderTxs(ctx, req.(*QuerySenderTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryTierTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTierTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryTierTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.abcipp.mempool.v1.Query/QueryTierTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryTierTxs(ctx, req.(*QueryTierTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QuerySenderReputations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySenderReputationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QuerySenderReputations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.abcipp.mempool.v1.Query/QuerySenderReputations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuerySenderReputations(ctx, req.(*QuerySenderReputationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryMempoolSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMempoolSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryMempoolSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.abcipp.mempool.v1.Query/QueryMempoolSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryMempoolSnapshot(ctx, req.(*QueryMempoolSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SubscribeMempoolEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeMempoolEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueryServer).SubscribeMempoolEvents(m, &querySubscribeMempoolEventsServer{stream})
+}
+
+type Query_SubscribeMempoolEventsServer interface {
+	Send(*MempoolEvent) error
+	grpc.ServerStream
+}
+
+type querySubscribeMempoolEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *querySubscribeMempoolEventsServer) Send(m *MempoolEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "initia.abcipp.mempool.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "QueryTxDistribution",
+			Handler:    _Query_QueryTxDistribution_Handler,
+		},
+		{
+			MethodName: "QueryTxHash",
+			Handler:    _Query_QueryTxHash_Handler,
+		},
+		{
+			MethodName: "QuerySenderTxs",
+			Handler:    _Query_QuerySenderTxs_Handler,
+		},
+		{
+			MethodName: "QueryTierTxs",
+			Handler:    _Query_QueryTierTxs_Handler,
+		},
+		{
+			MethodName: "QuerySenderReputations",
+			Handler:    _Query_QuerySenderReputations_Handler,
+		},
+		{
+			MethodName: "QueryMempoolSnapshot",
+			Handler:    _Query_QueryMempoolSnapshot_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeMempoolEvents",
+			Handler:       _Query_SubscribeMempoolEvents_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "initia/abcipp/mempool/v1/query.proto",
+}
+
+func (m *QueryTxDistributionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTxDistributionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTxDistributionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTxDistributionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTxDistributionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTxDistributionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Distribution) > 0 {
+		for k := range m.Distribution {
+			v := m.Distribution[k]
+			baseI := i
+			i = encodeVarintQuery(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintQuery(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTxHashRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTxHashRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTxHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sequence) > 0 {
+		i -= len(m.Sequence)
+		copy(dAtA[i:], m.Sequence)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sequence)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTxHashResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTxHashResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTxHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MempoolTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MempoolTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MempoolTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TxSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TxSize))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ClampedPriority != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ClampedPriority))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Priority != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Tier) > 0 {
+		i -= len(m.Tier)
+		copy(dAtA[i:], m.Tier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Tier)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySenderTxsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySenderTxsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySenderTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySenderTxsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySenderTxsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySenderTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MissingSequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MissingSequence))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Blocked {
+		i--
+		if m.Blocked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Queued) > 0 {
+		for iNdEx := len(m.Queued) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Queued[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Active) > 0 {
+		for iNdEx := len(m.Active) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Active[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.NextExpectedSequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NextExpectedSequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Tracked {
+		i--
+		if m.Tracked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTierTxsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTierTxsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTierTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tier) > 0 {
+		i -= len(m.Tier)
+		copy(dAtA[i:], m.Tier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Tier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTierTxsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTierTxsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTierTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Txs) > 0 {
+		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Txs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SenderReputation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SenderReputation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SenderReputation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BannedUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BannedUntil):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintQuery(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x2a
+	if m.Banned {
+		i--
+		if m.Banned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Throttled {
+		i--
+		if m.Throttled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Score != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Score))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySenderReputationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySenderReputationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySenderReputationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BannedOnly {
+		i--
+		if m.BannedOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySenderReputationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySenderReputationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySenderReputationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reputations) > 0 {
+		for iNdEx := len(m.Reputations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reputations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MempoolSnapshotSender) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MempoolSnapshotSender) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MempoolSnapshotSender) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.GapSince, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.GapSince):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintQuery(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x3a
+	if m.QueuedMax != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.QueuedMax))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.QueuedMin != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.QueuedMin))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ActiveMax != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ActiveMax))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ActiveMin != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ActiveMin))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.OnChainSeq != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OnChainSeq))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MempoolSnapshotEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MempoolSnapshotEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MempoolSnapshotEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxBytes) > 0 {
+		i -= len(m.TxBytes)
+		copy(dAtA[i:], m.TxBytes)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxBytes)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.TxSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TxSize))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Order != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Order))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ClampedOrder != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ClampedOrder))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ClampedPriority != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ClampedPriority))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Priority != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Tier) > 0 {
+		i -= len(m.Tier)
+		copy(dAtA[i:], m.Tier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Tier)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MempoolSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MempoolSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MempoolSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Queued) > 0 {
+		for iNdEx := len(m.Queued) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Queued[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Active) > 0 {
+		for iNdEx := len(m.Active) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Active[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Senders) > 0 {
+		for iNdEx := len(m.Senders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Senders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.OrderSeq != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OrderSeq))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Tiers) > 0 {
+		for iNdEx := len(m.Tiers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tiers[iNdEx])
+			copy(dAtA[i:], m.Tiers[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Tiers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Version != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMempoolSnapshotRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMempoolSnapshotRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMempoolSnapshotRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMempoolSnapshotResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMempoolSnapshotResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMempoolSnapshotResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Snapshot != nil {
+		{
+			size, err := m.Snapshot.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubscribeMempoolEventsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscribeMempoolEventsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscribeMempoolEventsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tiers) > 0 {
+		for iNdEx := len(m.Tiers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tiers[iNdEx])
+			copy(dAtA[i:], m.Tiers[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Tiers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Senders) > 0 {
+		for iNdEx := len(m.Senders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Senders[iNdEx])
+			copy(dAtA[i:], m.Senders[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Senders[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MempoolEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MempoolEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MempoolEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Reason != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Tier) > 0 {
+		i -= len(m.Tier)
+		copy(dAtA[i:], m.Tier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Tier)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryTxDistributionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTxDistributionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Distribution) > 0 {
+		for k, v := range m.Distribution {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovQuery(uint64(len(k))) + 1 + sovQuery(uint64(v))
+			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *QueryTxHashRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Sequence)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTxHashResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *MempoolTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Tier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Priority != 0 {
+		n += 1 + sovQuery(uint64(m.Priority))
+	}
+	if m.ClampedPriority != 0 {
+		n += 1 + sovQuery(uint64(m.ClampedPriority))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovQuery(uint64(m.GasLimit))
+	}
+	if m.TxSize != 0 {
+		n += 1 + sovQuery(uint64(m.TxSize))
+	}
+	return n
+}
+
+func (m *QuerySenderTxsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySenderTxsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Tracked {
+		n += 2
+	}
+	if m.NextExpectedSequence != 0 {
+		n += 1 + sovQuery(uint64(m.NextExpectedSequence))
+	}
+	if len(m.Active) > 0 {
+		for _, e := range m.Active {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Queued) > 0 {
+		for _, e := range m.Queued {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Blocked {
+		n += 2
+	}
+	if m.MissingSequence != 0 {
+		n += 1 + sovQuery(uint64(m.MissingSequence))
+	}
+	return n
+}
+
+func (m *QueryTierTxsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTierTxsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for _, e := range m.Txs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SenderReputation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Score != 0 {
+		n += 9
+	}
+	if m.Throttled {
+		n += 2
+	}
+	if m.Banned {
+		n += 2
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BannedUntil)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySenderReputationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BannedOnly {
+		n += 2
+	}
+	return n
+}
+
+func (m *QuerySenderReputationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Reputations) > 0 {
+		for _, e := range m.Reputations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MempoolSnapshotSender) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.OnChainSeq != 0 {
+		n += 1 + sovQuery(uint64(m.OnChainSeq))
+	}
+	if m.ActiveMin != 0 {
+		n += 1 + sovQuery(uint64(m.ActiveMin))
+	}
+	if m.ActiveMax != 0 {
+		n += 1 + sovQuery(uint64(m.ActiveMax))
+	}
+	if m.QueuedMin != 0 {
+		n += 1 + sovQuery(uint64(m.QueuedMin))
+	}
+	if m.QueuedMax != 0 {
+		n += 1 + sovQuery(uint64(m.QueuedMax))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.GapSince)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *MempoolSnapshotEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	l = len(m.Tier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Priority != 0 {
+		n += 1 + sovQuery(uint64(m.Priority))
+	}
+	if m.ClampedPriority != 0 {
+		n += 1 + sovQuery(uint64(m.ClampedPriority))
+	}
+	if m.ClampedOrder != 0 {
+		n += 1 + sovQuery(uint64(m.ClampedOrder))
+	}
+	if m.Order != 0 {
+		n += 1 + sovQuery(uint64(m.Order))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovQuery(uint64(m.GasLimit))
+	}
+	if m.TxSize != 0 {
+		n += 1 + sovQuery(uint64(m.TxSize))
+	}
+	l = len(m.TxBytes)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *MempoolSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
+	}
+	if len(m.Tiers) > 0 {
+		for _, s := range m.Tiers {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.OrderSeq != 0 {
+		n += 1 + sovQuery(uint64(m.OrderSeq))
+	}
+	if len(m.Senders) > 0 {
+		for _, e := range m.Senders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Active) > 0 {
+		for _, e := range m.Active {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Queued) > 0 {
+		for _, e := range m.Queued {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryMempoolSnapshotRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryMempoolSnapshotResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Snapshot != nil {
+		l = m.Snapshot.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SubscribeMempoolEventsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Senders) > 0 {
+		for _, s := range m.Senders {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Tiers) > 0 {
+		for _, s := range m.Tiers {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MempoolEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovQuery(uint64(m.Type))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	l = len(m.Tier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Reason != 0 {
+		n += 1 + sovQuery(uint64(m.Reason))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryTxDistributionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTxDistributionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTxDistributionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTxDistributionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTxDistributionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTxDistributionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distribution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Distribution == nil {
+				m.Distribution = make(map[string]uint64)
+			}
+			var mapkey string
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipQuery(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Distribution[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTxHashRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTxHashRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTxHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sequence = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTxHashResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTxHashResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTxHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MempoolTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MempoolTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MempoolTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClampedPriority", wireType)
+			}
+			m.ClampedPriority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClampedPriority |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxSize", wireType)
+			}
+			m.TxSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySenderTxsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySenderTxsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySenderTxsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySenderTxsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySenderTxsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySenderTxsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tracked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Tracked = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextExpectedSequence", wireType)
+			}
+			m.NextExpectedSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextExpectedSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Active = append(m.Active, &MempoolTx{})
+			if err := m.Active[len(m.Active)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queued", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queued = append(m.Queued, &MempoolTx{})
+			if err := m.Queued[len(m.Queued)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Blocked = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingSequence", wireType)
+			}
+			m.MissingSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissingSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTierTxsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTierTxsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTierTxsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTierTxsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTierTxsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTierTxsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txs = append(m.Txs, &MempoolTx{})
+			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SenderReputation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SenderReputation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SenderReputation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Score = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Throttled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Throttled = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Banned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Banned = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BannedUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BannedUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySenderReputationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySenderReputationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySenderReputationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BannedOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BannedOnly = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySenderReputationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySenderReputationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySenderReputationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reputations = append(m.Reputations, &SenderReputation{})
+			if err := m.Reputations[len(m.Reputations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MempoolSnapshotSender) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MempoolSnapshotSender: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MempoolSnapshotSender: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnChainSeq", wireType)
+			}
+			m.OnChainSeq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OnChainSeq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveMin", wireType)
+			}
+			m.ActiveMin = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActiveMin |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveMax", wireType)
+			}
+			m.ActiveMax = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActiveMax |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedMin", wireType)
+			}
+			m.QueuedMin = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueuedMin |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedMax", wireType)
+			}
+			m.QueuedMax = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueuedMax |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GapSince", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.GapSince, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MempoolSnapshotEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MempoolSnapshotEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MempoolSnapshotEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClampedPriority", wireType)
+			}
+			m.ClampedPriority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClampedPriority |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClampedOrder", wireType)
+			}
+			m.ClampedOrder = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClampedOrder |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			m.Order = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Order |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxSize", wireType)
+			}
+			m.TxSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxBytes = append(m.TxBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxBytes == nil {
+				m.TxBytes = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MempoolSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MempoolSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MempoolSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tiers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tiers = append(m.Tiers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderSeq", wireType)
+			}
+			m.OrderSeq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderSeq |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Senders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Senders = append(m.Senders, &MempoolSnapshotSender{})
+			if err := m.Senders[len(m.Senders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Active = append(m.Active, &MempoolSnapshotEntry{})
+			if err := m.Active[len(m.Active)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queued", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queued = append(m.Queued, &MempoolSnapshotEntry{})
+			if err := m.Queued[len(m.Queued)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *QueryTxHashRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Sequence)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func (m *QueryTxHashResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.TxHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryTxDistributionRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMempoolSnapshotRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -603,10 +5427,10 @@ func (m *QueryTxDistributionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTxDistributionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMempoolSnapshotRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTxDistributionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMempoolSnapshotRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -630,7 +5454,7 @@ func (m *QueryTxDistributionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTxDistributionResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMempoolSnapshotResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -653,15 +5477,15 @@ func (m *QueryTxDistributionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTxDistributionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMempoolSnapshotResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTxDistributionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMempoolSnapshotResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Distribution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -688,89 +5512,12 @@ func (m *QueryTxDistributionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Distribution == nil {
-				m.Distribution = make(map[string]uint64)
+			if m.Snapshot == nil {
+				m.Snapshot = &MempoolSnapshot{}
 			}
-			var mapkey string
-			var mapvalue uint64
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthQuery
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipQuery(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.Snapshot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Distribution[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -793,7 +5540,7 @@ func (m *QueryTxDistributionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTxHashRequest) Unmarshal(dAtA []byte) error {
+func (m *SubscribeMempoolEventsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -816,15 +5563,15 @@ func (m *QueryTxHashRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTxHashRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SubscribeMempoolEventsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTxHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SubscribeMempoolEventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Senders", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -852,11 +5599,11 @@ func (m *QueryTxHashRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Senders = append(m.Senders, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tiers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -884,7 +5631,7 @@ func (m *QueryTxHashRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sequence = string(dAtA[iNdEx:postIndex])
+			m.Tiers = append(m.Tiers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -907,7 +5654,7 @@ func (m *QueryTxHashRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTxHashResponse) Unmarshal(dAtA []byte) error {
+func (m *MempoolEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -930,13 +5677,115 @@ func (m *QueryTxHashResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTxHashResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MempoolEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTxHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MempoolEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= MempoolEventType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
 			}
@@ -968,6 +5817,25 @@ func (m *QueryTxHashResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.TxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= RemovalReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -65,6 +65,30 @@ func FirstSignature(tx sdk.Tx) (sdk.AccAddress, uint64, error) {
 	return addr, sigs[0].Sequence, nil
 }
 
+// checkSenderOrder records the first signature of tx in seen and reports
+// duplicate (sender, nonce) pairs and nonces that do not directly follow the
+// sender's previous tx in the proposal. Txs without a usable first signature
+// have no sender nonce to order and are skipped.
+func checkSenderOrder(seen map[string]uint64, tx sdk.Tx) error {
+	signer, nonce, err := FirstSignature(tx)
+	if err != nil {
+		return nil
+	}
+
+	sender := signer.String()
+	if last, ok := seen[sender]; ok {
+		switch {
+		case nonce == last:
+			return fmt.Errorf("duplicate tx for sender %s nonce %d", sender, nonce)
+		case nonce != last+1:
+			return fmt.Errorf("out-of-order nonce for sender %s: got %d after %d", sender, nonce, last)
+		}
+	}
+
+	seen[sender] = nonce
+	return nil
+}
+
 // fetchSequence queries the on-chain sequence for a sender.
 func fetchSequence(ctx sdk.Context, ak AccountKeeper, sender string) (uint64, bool) {
 	addr, err := sdk.AccAddressFromBech32(sender)
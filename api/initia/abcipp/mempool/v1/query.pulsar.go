@@ -2,13 +2,18 @@
 package mempoolv1
 
 import (
+	v1beta1 "cosmossdk.io/api/cosmos/base/query/v1beta1"
+	binary "encoding/binary"
 	fmt "fmt"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
+	math "math"
 	reflect "reflect"
 	sort "sort"
 	sync "sync"
@@ -1918,167 +1923,10587 @@ func (x *fastReflection_QueryTxHashResponse) ProtoMethods() *protoiface.Methods
 	}
 }
 
+var (
+	md_MempoolTx                  protoreflect.MessageDescriptor
+	fd_MempoolTx_sender           protoreflect.FieldDescriptor
+	fd_MempoolTx_sequence         protoreflect.FieldDescriptor
+	fd_MempoolTx_tx_hash          protoreflect.FieldDescriptor
+	fd_MempoolTx_tier             protoreflect.FieldDescriptor
+	fd_MempoolTx_priority         protoreflect.FieldDescriptor
+	fd_MempoolTx_clamped_priority protoreflect.FieldDescriptor
+	fd_MempoolTx_gas_limit        protoreflect.FieldDescriptor
+	fd_MempoolTx_tx_size          protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_abcipp_mempool_v1_query_proto_init()
+	md_MempoolTx = File_initia_abcipp_mempool_v1_query_proto.Messages().ByName("MempoolTx")
+	fd_MempoolTx_sender = md_MempoolTx.Fields().ByName("sender")
+	fd_MempoolTx_sequence = md_MempoolTx.Fields().ByName("sequence")
+	fd_MempoolTx_tx_hash = md_MempoolTx.Fields().ByName("tx_hash")
+	fd_MempoolTx_tier = md_MempoolTx.Fields().ByName("tier")
+	fd_MempoolTx_priority = md_MempoolTx.Fields().ByName("priority")
+	fd_MempoolTx_clamped_priority = md_MempoolTx.Fields().ByName("clamped_priority")
+	fd_MempoolTx_gas_limit = md_MempoolTx.Fields().ByName("gas_limit")
+	fd_MempoolTx_tx_size = md_MempoolTx.Fields().ByName("tx_size")
+}
+
+var _ protoreflect.Message = (*fastReflection_MempoolTx)(nil)
+
+type fastReflection_MempoolTx MempoolTx
+
+func (x *MempoolTx) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MempoolTx)(x)
+}
+
+func (x *MempoolTx) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_abcipp_mempool_v1_query_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MempoolTx_messageType fastReflection_MempoolTx_messageType
+var _ protoreflect.MessageType = fastReflection_MempoolTx_messageType{}
+
+type fastReflection_MempoolTx_messageType struct{}
+
+func (x fastReflection_MempoolTx_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MempoolTx)(nil)
+}
+func (x fastReflection_MempoolTx_messageType) New() protoreflect.Message {
+	return new(fastReflection_MempoolTx)
+}
+func (x fastReflection_MempoolTx_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MempoolTx
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MempoolTx) Descriptor() protoreflect.MessageDescriptor {
+	return md_MempoolTx
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MempoolTx) Type() protoreflect.MessageType {
+	return _fastReflection_MempoolTx_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MempoolTx) New() protoreflect.Message {
+	return new(fastReflection_MempoolTx)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MempoolTx) Interface() protoreflect.ProtoMessage {
+	return (*MempoolTx)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MempoolTx) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_MempoolTx_sender, value) {
+			return
+		}
+	}
+	if x.Sequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Sequence)
+		if !f(fd_MempoolTx_sequence, value) {
+			return
+		}
+	}
+	if x.TxHash != "" {
+		value := protoreflect.ValueOfString(x.TxHash)
+		if !f(fd_MempoolTx_tx_hash, value) {
+			return
+		}
+	}
+	if x.Tier != "" {
+		value := protoreflect.ValueOfString(x.Tier)
+		if !f(fd_MempoolTx_tier, value) {
+			return
+		}
+	}
+	if x.Priority != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Priority)
+		if !f(fd_MempoolTx_priority, value) {
+			return
+		}
+	}
+	if x.ClampedPriority != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ClampedPriority)
+		if !f(fd_MempoolTx_clamped_priority, value) {
+			return
+		}
+	}
+	if x.GasLimit != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GasLimit)
+		if !f(fd_MempoolTx_gas_limit, value) {
+			return
+		}
+	}
+	if x.TxSize != int64(0) {
+		value := protoreflect.ValueOfInt64(x.TxSize)
+		if !f(fd_MempoolTx_tx_size, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MempoolTx) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.MempoolTx.sender":
+		return x.Sender != ""
+	case "initia.abcipp.mempool.v1.MempoolTx.sequence":
+		return x.Sequence != uint64(0)
+	case "initia.abcipp.mempool.v1.MempoolTx.tx_hash":
+		return x.TxHash != ""
+	case "initia.abcipp.mempool.v1.MempoolTx.tier":
+		return x.Tier != ""
+	case "initia.abcipp.mempool.v1.MempoolTx.priority":
+		return x.Priority != int64(0)
+	case "initia.abcipp.mempool.v1.MempoolTx.clamped_priority":
+		return x.ClampedPriority != int64(0)
+	case "initia.abcipp.mempool.v1.MempoolTx.gas_limit":
+		return x.GasLimit != uint64(0)
+	case "initia.abcipp.mempool.v1.MempoolTx.tx_size":
+		return x.TxSize != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.MempoolTx"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.MempoolTx does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MempoolTx) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.MempoolTx.sender":
+		x.Sender = ""
+	case "initia.abcipp.mempool.v1.MempoolTx.sequence":
+		x.Sequence = uint64(0)
+	case "initia.abcipp.mempool.v1.MempoolTx.tx_hash":
+		x.TxHash = ""
+	case "initia.abcipp.mempool.v1.MempoolTx.tier":
+		x.Tier = ""
+	case "initia.abcipp.mempool.v1.MempoolTx.priority":
+		x.Priority = int64(0)
+	case "initia.abcipp.mempool.v1.MempoolTx.clamped_priority":
+		x.ClampedPriority = int64(0)
+	case "initia.abcipp.mempool.v1.MempoolTx.gas_limit":
+		x.GasLimit = uint64(0)
+	case "initia.abcipp.mempool.v1.MempoolTx.tx_size":
+		x.TxSize = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.MempoolTx"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.MempoolTx does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MempoolTx) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.abcipp.mempool.v1.MempoolTx.sender":
+		value := x.Sender
+		return protoreflect.ValueOfString(value)
+	case "initia.abcipp.mempool.v1.MempoolTx.sequence":
+		value := x.Sequence
+		return protoreflect.ValueOfUint64(value)
+	case "initia.abcipp.mempool.v1.MempoolTx.tx_hash":
+		value := x.TxHash
+		return protoreflect.ValueOfString(value)
+	case "initia.abcipp.mempool.v1.MempoolTx.tier":
+		value := x.Tier
+		return protoreflect.ValueOfString(value)
+	case "initia.abcipp.mempool.v1.MempoolTx.priority":
+		value := x.Priority
+		return protoreflect.ValueOfInt64(value)
+	case "initia.abcipp.mempool.v1.MempoolTx.clamped_priority":
+		value := x.ClampedPriority
+		return protoreflect.ValueOfInt64(value)
+	case "initia.abcipp.mempool.v1.MempoolTx.gas_limit":
+		value := x.GasLimit
+		return protoreflect.ValueOfUint64(value)
+	case "initia.abcipp.mempool.v1.MempoolTx.tx_size":
+		value := x.TxSize
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.MempoolTx"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.MempoolTx does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MempoolTx) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.MempoolTx.sender":
+		x.Sender = value.Interface().(string)
+	case "initia.abcipp.mempool.v1.MempoolTx.sequence":
+		x.Sequence = value.Uint()
+	case "initia.abcipp.mempool.v1.MempoolTx.tx_hash":
+		x.TxHash = value.Interface().(string)
+	case "initia.abcipp.mempool.v1.MempoolTx.tier":
+		x.Tier = value.Interface().(string)
+	case "initia.abcipp.mempool.v1.MempoolTx.priority":
+		x.Priority = value.Int()
+	case "initia.abcipp.mempool.v1.MempoolTx.clamped_priority":
+		x.ClampedPriority = value.Int()
+	case "initia.abcipp.mempool.v1.MempoolTx.gas_limit":
+		x.GasLimit = value.Uint()
+	case "initia.abcipp.mempool.v1.MempoolTx.tx_size":
+		x.TxSize = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.MempoolTx"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.MempoolTx does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MempoolTx) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.MempoolTx.sender":
+		panic(fmt.Errorf("field sender of message initia.abcipp.mempool.v1.MempoolTx is not mutable"))
+	case "initia.abcipp.mempool.v1.MempoolTx.sequence":
+		panic(fmt.Errorf("field sequence of message initia.abcipp.mempool.v1.MempoolTx is not mutable"))
+	case "initia.abcipp.mempool.v1.MempoolTx.tx_hash":
+		panic(fmt.Errorf("field tx_hash of message initia.abcipp.mempool.v1.MempoolTx is not mutable"))
+	case "initia.abcipp.mempool.v1.MempoolTx.tier":
+		panic(fmt.Errorf("field tier of message initia.abcipp.mempool.v1.MempoolTx is not mutable"))
+	case "initia.abcipp.mempool.v1.MempoolTx.priority":
+		panic(fmt.Errorf("field priority of message initia.abcipp.mempool.v1.MempoolTx is not mutable"))
+	case "initia.abcipp.mempool.v1.MempoolTx.clamped_priority":
+		panic(fmt.Errorf("field clamped_priority of message initia.abcipp.mempool.v1.MempoolTx is not mutable"))
+	case "initia.abcipp.mempool.v1.MempoolTx.gas_limit":
+		panic(fmt.Errorf("field gas_limit of message initia.abcipp.mempool.v1.MempoolTx is not mutable"))
+	case "initia.abcipp.mempool.v1.MempoolTx.tx_size":
+		panic(fmt.Errorf("field tx_size of message initia.abcipp.mempool.v1.MempoolTx is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.MempoolTx"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.MempoolTx does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MempoolTx) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.MempoolTx.sender":
+		return protoreflect.ValueOfString("")
+	case "initia.abcipp.mempool.v1.MempoolTx.sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "initia.abcipp.mempool.v1.MempoolTx.tx_hash":
+		return protoreflect.ValueOfString("")
+	case "initia.abcipp.mempool.v1.MempoolTx.tier":
+		return protoreflect.ValueOfString("")
+	case "initia.abcipp.mempool.v1.MempoolTx.priority":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "initia.abcipp.mempool.v1.MempoolTx.clamped_priority":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "initia.abcipp.mempool.v1.MempoolTx.gas_limit":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "initia.abcipp.mempool.v1.MempoolTx.tx_size":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.MempoolTx"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.MempoolTx does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MempoolTx) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.abcipp.mempool.v1.MempoolTx", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MempoolTx) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MempoolTx) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MempoolTx) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MempoolTx) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MempoolTx)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Sender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Sequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.Sequence))
+		}
+		l = len(x.TxHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Tier)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Priority != 0 {
+			n += 1 + runtime.Sov(uint64(x.Priority))
+		}
+		if x.ClampedPriority != 0 {
+			n += 1 + runtime.Sov(uint64(x.ClampedPriority))
+		}
+		if x.GasLimit != 0 {
+			n += 1 + runtime.Sov(uint64(x.GasLimit))
+		}
+		if x.TxSize != 0 {
+			n += 1 + runtime.Sov(uint64(x.TxSize))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MempoolTx)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.TxSize != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TxSize))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.GasLimit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasLimit))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.ClampedPriority != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ClampedPriority))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.Priority != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Priority))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Tier) > 0 {
+			i -= len(x.Tier)
+			copy(dAtA[i:], x.Tier)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Tier)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.TxHash) > 0 {
+			i -= len(x.TxHash)
+			copy(dAtA[i:], x.TxHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TxHash)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Sequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Sequence))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MempoolTx)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MempoolTx: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MempoolTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				}
+				x.Sequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Sequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TxHash = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tier", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Tier = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+				}
+				x.Priority = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Priority |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClampedPriority", wireType)
+				}
+				x.ClampedPriority = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ClampedPriority |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+				}
+				x.GasLimit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GasLimit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxSize", wireType)
+				}
+				x.TxSize = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TxSize |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QuerySenderTxsRequest        protoreflect.MessageDescriptor
+	fd_QuerySenderTxsRequest_sender protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_abcipp_mempool_v1_query_proto_init()
+	md_QuerySenderTxsRequest = File_initia_abcipp_mempool_v1_query_proto.Messages().ByName("QuerySenderTxsRequest")
+	fd_QuerySenderTxsRequest_sender = md_QuerySenderTxsRequest.Fields().ByName("sender")
+}
+
+var _ protoreflect.Message = (*fastReflection_QuerySenderTxsRequest)(nil)
+
+type fastReflection_QuerySenderTxsRequest QuerySenderTxsRequest
+
+func (x *QuerySenderTxsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QuerySenderTxsRequest)(x)
+}
+
+func (x *QuerySenderTxsRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_abcipp_mempool_v1_query_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QuerySenderTxsRequest_messageType fastReflection_QuerySenderTxsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QuerySenderTxsRequest_messageType{}
+
+type fastReflection_QuerySenderTxsRequest_messageType struct{}
+
+func (x fastReflection_QuerySenderTxsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QuerySenderTxsRequest)(nil)
+}
+func (x fastReflection_QuerySenderTxsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QuerySenderTxsRequest)
+}
+func (x fastReflection_QuerySenderTxsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySenderTxsRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QuerySenderTxsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySenderTxsRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QuerySenderTxsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QuerySenderTxsRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QuerySenderTxsRequest) New() protoreflect.Message {
+	return new(fastReflection_QuerySenderTxsRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QuerySenderTxsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QuerySenderTxsRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QuerySenderTxsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_QuerySenderTxsRequest_sender, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QuerySenderTxsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.QuerySenderTxsRequest.sender":
+		return x.Sender != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.QuerySenderTxsRequest"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.QuerySenderTxsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySenderTxsRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.QuerySenderTxsRequest.sender":
+		x.Sender = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.QuerySenderTxsRequest"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.QuerySenderTxsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QuerySenderTxsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.abcipp.mempool.v1.QuerySenderTxsRequest.sender":
+		value := x.Sender
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.QuerySenderTxsRequest"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.QuerySenderTxsRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySenderTxsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.QuerySenderTxsRequest.sender":
+		x.Sender = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.QuerySenderTxsRequest"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.QuerySenderTxsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySenderTxsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.QuerySenderTxsRequest.sender":
+		panic(fmt.Errorf("field sender of message initia.abcipp.mempool.v1.QuerySenderTxsRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.QuerySenderTxsRequest"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.QuerySenderTxsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QuerySenderTxsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.QuerySenderTxsRequest.sender":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.QuerySenderTxsRequest"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.QuerySenderTxsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QuerySenderTxsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.abcipp.mempool.v1.QuerySenderTxsRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QuerySenderTxsRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySenderTxsRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QuerySenderTxsRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QuerySenderTxsRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QuerySenderTxsRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Sender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySenderTxsRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySenderTxsRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySenderTxsRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySenderTxsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QuerySenderTxsResponse_3_list)(nil)
+
+type _QuerySenderTxsResponse_3_list struct {
+	list *[]*MempoolTx
+}
+
+func (x *_QuerySenderTxsResponse_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QuerySenderTxsResponse_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QuerySenderTxsResponse_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MempoolTx)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QuerySenderTxsResponse_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MempoolTx)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QuerySenderTxsResponse_3_list) AppendMutable() protoreflect.Value {
+	v := new(MempoolTx)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QuerySenderTxsResponse_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QuerySenderTxsResponse_3_list) NewElement() protoreflect.Value {
+	v := new(MempoolTx)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QuerySenderTxsResponse_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_QuerySenderTxsResponse_4_list)(nil)
+
+type _QuerySenderTxsResponse_4_list struct {
+	list *[]*MempoolTx
+}
+
+func (x *_QuerySenderTxsResponse_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QuerySenderTxsResponse_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QuerySenderTxsResponse_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MempoolTx)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QuerySenderTxsResponse_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MempoolTx)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QuerySenderTxsResponse_4_list) AppendMutable() protoreflect.Value {
+	v := new(MempoolTx)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QuerySenderTxsResponse_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QuerySenderTxsResponse_4_list) NewElement() protoreflect.Value {
+	v := new(MempoolTx)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QuerySenderTxsResponse_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QuerySenderTxsResponse                        protoreflect.MessageDescriptor
+	fd_QuerySenderTxsResponse_tracked                protoreflect.FieldDescriptor
+	fd_QuerySenderTxsResponse_next_expected_sequence protoreflect.FieldDescriptor
+	fd_QuerySenderTxsResponse_active                 protoreflect.FieldDescriptor
+	fd_QuerySenderTxsResponse_queued                 protoreflect.FieldDescriptor
+	fd_QuerySenderTxsResponse_blocked                protoreflect.FieldDescriptor
+	fd_QuerySenderTxsResponse_missing_sequence       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_abcipp_mempool_v1_query_proto_init()
+	md_QuerySenderTxsResponse = File_initia_abcipp_mempool_v1_query_proto.Messages().ByName("QuerySenderTxsResponse")
+	fd_QuerySenderTxsResponse_tracked = md_QuerySenderTxsResponse.Fields().ByName("tracked")
+	fd_QuerySenderTxsResponse_next_expected_sequence = md_QuerySenderTxsResponse.Fields().ByName("next_expected_sequence")
+	fd_QuerySenderTxsResponse_active = md_QuerySenderTxsResponse.Fields().ByName("active")
+	fd_QuerySenderTxsResponse_queued = md_QuerySenderTxsResponse.Fields().ByName("queued")
+	fd_QuerySenderTxsResponse_blocked = md_QuerySenderTxsResponse.Fields().ByName("blocked")
+	fd_QuerySenderTxsResponse_missing_sequence = md_QuerySenderTxsResponse.Fields().ByName("missing_sequence")
+}
+
+var _ protoreflect.Message = (*fastReflection_QuerySenderTxsResponse)(nil)
+
+type fastReflection_QuerySenderTxsResponse QuerySenderTxsResponse
+
+func (x *QuerySenderTxsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QuerySenderTxsResponse)(x)
+}
+
+func (x *QuerySenderTxsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_abcipp_mempool_v1_query_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QuerySenderTxsResponse_messageType fastReflection_QuerySenderTxsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QuerySenderTxsResponse_messageType{}
+
+type fastReflection_QuerySenderTxsResponse_messageType struct{}
+
+func (x fastReflection_QuerySenderTxsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QuerySenderTxsResponse)(nil)
+}
+func (x fastReflection_QuerySenderTxsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QuerySenderTxsResponse)
+}
+func (x fastReflection_QuerySenderTxsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySenderTxsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QuerySenderTxsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySenderTxsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QuerySenderTxsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QuerySenderTxsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QuerySenderTxsResponse) New() protoreflect.Message {
+	return new(fastReflection_QuerySenderTxsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QuerySenderTxsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QuerySenderTxsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QuerySenderTxsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Tracked != false {
+		value := protoreflect.ValueOfBool(x.Tracked)
+		if !f(fd_QuerySenderTxsResponse_tracked, value) {
+			return
+		}
+	}
+	if x.NextExpectedSequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NextExpectedSequence)
+		if !f(fd_QuerySenderTxsResponse_next_expected_sequence, value) {
+			return
+		}
+	}
+	if len(x.Active) != 0 {
+		value := protoreflect.ValueOfList(&_QuerySenderTxsResponse_3_list{list: &x.Active})
+		if !f(fd_QuerySenderTxsResponse_active, value) {
+			return
+		}
+	}
+	if len(x.Queued) != 0 {
+		value := protoreflect.ValueOfList(&_QuerySenderTxsResponse_4_list{list: &x.Queued})
+		if !f(fd_QuerySenderTxsResponse_queued, value) {
+			return
+		}
+	}
+	if x.Blocked != false {
+		value := protoreflect.ValueOfBool(x.Blocked)
+		if !f(fd_QuerySenderTxsResponse_blocked, value) {
+			return
+		}
+	}
+	if x.MissingSequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MissingSequence)
+		if !f(fd_QuerySenderTxsResponse_missing_sequence, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QuerySenderTxsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.QuerySenderTxsResponse.tracked":
+		return x.Tracked != false
+	case "initia.abcipp.mempool.v1.QuerySenderTxsResponse.next_expected_sequence":
+		return x.NextExpectedSequence != uint64(0)
+	case "initia.abcipp.mempool.v1.QuerySenderTxsResponse.active":
+		return len(x.Active) != 0
+	case "initia.abcipp.mempool.v1.QuerySenderTxsResponse.queued":
+		return len(x.Queued) != 0
+	case "initia.abcipp.mempool.v1.QuerySenderTxsResponse.blocked":
+		return x.Blocked != false
+	case "initia.abcipp.mempool.v1.QuerySenderTxsResponse.missing_sequence":
+		return x.MissingSequence != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.QuerySenderTxsResponse"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.QuerySenderTxsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySenderTxsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.QuerySenderTxsResponse.tracked":
+		x.Tracked = false
+	case "initia.abcipp.mempool.v1.QuerySenderTxsResponse.next_expected_sequence":
+		x.NextExpectedSequence = uint64(0)
+	case "initia.abcipp.mempool.v1.QuerySenderTxsResponse.active":
+		x.Active = nil
+	case "initia.abcipp.mempool.v1.QuerySenderTxsResponse.queued":
+		x.Queued = nil
+	case "initia.abcipp.mempool.v1.QuerySenderTxsResponse.blocked":
+		x.Blocked = false
+	case "initia.abcipp.mempool.v1.QuerySenderTxsResponse.missing_sequence":
+		x.MissingSequence = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.QuerySenderTxsResponse"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.QuerySenderTxsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QuerySenderTxsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.abcipp.mempool.v1.QuerySenderTxsResponse.tracked":
+		value := x.Tracked
+		return protoreflect.ValueOfBool(value)
+	case "initia.abcipp.mempool.v1.QuerySenderTxsResponse.next_expected_sequence":
+		value := x.NextExpectedSequence
+		return protoreflect.ValueOfUint64(value)
+	case "initia.abcipp.mempool.v1.QuerySenderTxsResponse.active":
+		if len(x.Active) == 0 {
+			return protoreflect.ValueOfList(&_QuerySenderTxsResponse_3_list{})
+		}
+		listValue := &_QuerySenderTxsResponse_3_list{list: &x.Active}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.abcipp.mempool.v1.QuerySenderTxsResponse.queued":
+		if len(x.Queued) == 0 {
+			return protoreflect.ValueOfList(&_QuerySenderTxsResponse_4_list{})
+		}
+		listValue := &_QuerySenderTxsResponse_4_list{list: &x.Queued}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.abcipp.mempool.v1.QuerySenderTxsResponse.blocked":
+		value := x.Blocked
+		return protoreflect.ValueOfBool(value)
+	case "initia.abcipp.mempool.v1.QuerySenderTxsResponse.missing_sequence":
+		value := x.MissingSequence
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.QuerySenderTxsResponse"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.QuerySenderTxsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySenderTxsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.QuerySenderTxsResponse.tracked":
+		x.Tracked = value.Bool()
+	case "initia.abcipp.mempool.v1.QuerySenderTxsResponse.next_expected_sequence":
+		x.NextExpectedSequence = value.Uint()
+	case "initia.abcipp.mempool.v1.QuerySenderTxsResponse.active":
+		lv := value.List()
+		clv := lv.(*_QuerySenderTxsResponse_3_list)
+		x.Active = *clv.list
+	case "initia.abcipp.mempool.v1.QuerySenderTxsResponse.queued":
+		lv := value.List()
+		clv := lv.(*_QuerySenderTxsResponse_4_list)
+		x.Queued = *clv.list
+	case "initia.abcipp.mempool.v1.QuerySenderTxsResponse.blocked":
+		x.Blocked = value.Bool()
+	case "initia.abcipp.mempool.v1.QuerySenderTxsResponse.missing_sequence":
+		x.MissingSequence = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.QuerySenderTxsResponse"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.QuerySenderTxsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySenderTxsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.QuerySenderTxsResponse.active":
+		if x.Active == nil {
+			x.Active = []*MempoolTx{}
+		}
+		value := &_QuerySenderTxsResponse_3_list{list: &x.Active}
+		return protoreflect.ValueOfList(value)
+	case "initia.abcipp.mempool.v1.QuerySenderTxsResponse.queued":
+		if x.Queued == nil {
+			x.Queued = []*MempoolTx{}
+		}
+		value := &_QuerySenderTxsResponse_4_list{list: &x.Queued}
+		return protoreflect.ValueOfList(value)
+	case "initia.abcipp.mempool.v1.QuerySenderTxsResponse.tracked":
+		panic(fmt.Errorf("field tracked of message initia.abcipp.mempool.v1.QuerySenderTxsResponse is not mutable"))
+	case "initia.abcipp.mempool.v1.QuerySenderTxsResponse.next_expected_sequence":
+		panic(fmt.Errorf("field next_expected_sequence of message initia.abcipp.mempool.v1.QuerySenderTxsResponse is not mutable"))
+	case "initia.abcipp.mempool.v1.QuerySenderTxsResponse.blocked":
+		panic(fmt.Errorf("field blocked of message initia.abcipp.mempool.v1.QuerySenderTxsResponse is not mutable"))
+	case "initia.abcipp.mempool.v1.QuerySenderTxsResponse.missing_sequence":
+		panic(fmt.Errorf("field missing_sequence of message initia.abcipp.mempool.v1.QuerySenderTxsResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.QuerySenderTxsResponse"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.QuerySenderTxsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QuerySenderTxsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.QuerySenderTxsResponse.tracked":
+		return protoreflect.ValueOfBool(false)
+	case "initia.abcipp.mempool.v1.QuerySenderTxsResponse.next_expected_sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "initia.abcipp.mempool.v1.QuerySenderTxsResponse.active":
+		list := []*MempoolTx{}
+		return protoreflect.ValueOfList(&_QuerySenderTxsResponse_3_list{list: &list})
+	case "initia.abcipp.mempool.v1.QuerySenderTxsResponse.queued":
+		list := []*MempoolTx{}
+		return protoreflect.ValueOfList(&_QuerySenderTxsResponse_4_list{list: &list})
+	case "initia.abcipp.mempool.v1.QuerySenderTxsResponse.blocked":
+		return protoreflect.ValueOfBool(false)
+	case "initia.abcipp.mempool.v1.QuerySenderTxsResponse.missing_sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.QuerySenderTxsResponse"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.QuerySenderTxsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QuerySenderTxsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.abcipp.mempool.v1.QuerySenderTxsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QuerySenderTxsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySenderTxsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QuerySenderTxsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QuerySenderTxsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QuerySenderTxsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Tracked {
+			n += 2
+		}
+		if x.NextExpectedSequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.NextExpectedSequence))
+		}
+		if len(x.Active) > 0 {
+			for _, e := range x.Active {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Queued) > 0 {
+			for _, e := range x.Queued {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Blocked {
+			n += 2
+		}
+		if x.MissingSequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.MissingSequence))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySenderTxsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.MissingSequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MissingSequence))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.Blocked {
+			i--
+			if x.Blocked {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Queued) > 0 {
+			for iNdEx := len(x.Queued) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Queued[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.Active) > 0 {
+			for iNdEx := len(x.Active) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Active[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if x.NextExpectedSequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextExpectedSequence))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Tracked {
+			i--
+			if x.Tracked {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySenderTxsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySenderTxsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySenderTxsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tracked", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Tracked = bool(v != 0)
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextExpectedSequence", wireType)
+				}
+				x.NextExpectedSequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NextExpectedSequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Active = append(x.Active, &MempoolTx{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Active[len(x.Active)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Queued", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Queued = append(x.Queued, &MempoolTx{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Queued[len(x.Queued)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Blocked", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Blocked = bool(v != 0)
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MissingSequence", wireType)
+				}
+				x.MissingSequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MissingSequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryTierTxsRequest            protoreflect.MessageDescriptor
+	fd_QueryTierTxsRequest_tier       protoreflect.FieldDescriptor
+	fd_QueryTierTxsRequest_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_abcipp_mempool_v1_query_proto_init()
+	md_QueryTierTxsRequest = File_initia_abcipp_mempool_v1_query_proto.Messages().ByName("QueryTierTxsRequest")
+	fd_QueryTierTxsRequest_tier = md_QueryTierTxsRequest.Fields().ByName("tier")
+	fd_QueryTierTxsRequest_pagination = md_QueryTierTxsRequest.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryTierTxsRequest)(nil)
+
+type fastReflection_QueryTierTxsRequest QueryTierTxsRequest
+
+func (x *QueryTierTxsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryTierTxsRequest)(x)
+}
+
+func (x *QueryTierTxsRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_abcipp_mempool_v1_query_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryTierTxsRequest_messageType fastReflection_QueryTierTxsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryTierTxsRequest_messageType{}
+
+type fastReflection_QueryTierTxsRequest_messageType struct{}
+
+func (x fastReflection_QueryTierTxsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryTierTxsRequest)(nil)
+}
+func (x fastReflection_QueryTierTxsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryTierTxsRequest)
+}
+func (x fastReflection_QueryTierTxsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTierTxsRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryTierTxsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTierTxsRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryTierTxsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryTierTxsRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryTierTxsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryTierTxsRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryTierTxsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryTierTxsRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryTierTxsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Tier != "" {
+		value := protoreflect.ValueOfString(x.Tier)
+		if !f(fd_QueryTierTxsRequest_tier, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryTierTxsRequest_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryTierTxsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.QueryTierTxsRequest.tier":
+		return x.Tier != ""
+	case "initia.abcipp.mempool.v1.QueryTierTxsRequest.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.QueryTierTxsRequest"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.QueryTierTxsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTierTxsRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.QueryTierTxsRequest.tier":
+		x.Tier = ""
+	case "initia.abcipp.mempool.v1.QueryTierTxsRequest.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.QueryTierTxsRequest"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.QueryTierTxsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryTierTxsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.abcipp.mempool.v1.QueryTierTxsRequest.tier":
+		value := x.Tier
+		return protoreflect.ValueOfString(value)
+	case "initia.abcipp.mempool.v1.QueryTierTxsRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.QueryTierTxsRequest"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.QueryTierTxsRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTierTxsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.QueryTierTxsRequest.tier":
+		x.Tier = value.Interface().(string)
+	case "initia.abcipp.mempool.v1.QueryTierTxsRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.QueryTierTxsRequest"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.QueryTierTxsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTierTxsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.QueryTierTxsRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "initia.abcipp.mempool.v1.QueryTierTxsRequest.tier":
+		panic(fmt.Errorf("field tier of message initia.abcipp.mempool.v1.QueryTierTxsRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.QueryTierTxsRequest"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.QueryTierTxsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryTierTxsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.QueryTierTxsRequest.tier":
+		return protoreflect.ValueOfString("")
+	case "initia.abcipp.mempool.v1.QueryTierTxsRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.QueryTierTxsRequest"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.QueryTierTxsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryTierTxsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.abcipp.mempool.v1.QueryTierTxsRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryTierTxsRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTierTxsRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryTierTxsRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryTierTxsRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryTierTxsRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Tier)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTierTxsRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Tier) > 0 {
+			i -= len(x.Tier)
+			copy(dAtA[i:], x.Tier)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Tier)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTierTxsRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTierTxsRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTierTxsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tier", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Tier = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryTierTxsResponse_1_list)(nil)
+
+type _QueryTierTxsResponse_1_list struct {
+	list *[]*MempoolTx
+}
+
+func (x *_QueryTierTxsResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryTierTxsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryTierTxsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MempoolTx)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryTierTxsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MempoolTx)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryTierTxsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(MempoolTx)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryTierTxsResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryTierTxsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(MempoolTx)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryTierTxsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryTierTxsResponse            protoreflect.MessageDescriptor
+	fd_QueryTierTxsResponse_txs        protoreflect.FieldDescriptor
+	fd_QueryTierTxsResponse_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_abcipp_mempool_v1_query_proto_init()
+	md_QueryTierTxsResponse = File_initia_abcipp_mempool_v1_query_proto.Messages().ByName("QueryTierTxsResponse")
+	fd_QueryTierTxsResponse_txs = md_QueryTierTxsResponse.Fields().ByName("txs")
+	fd_QueryTierTxsResponse_pagination = md_QueryTierTxsResponse.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryTierTxsResponse)(nil)
+
+type fastReflection_QueryTierTxsResponse QueryTierTxsResponse
+
+func (x *QueryTierTxsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryTierTxsResponse)(x)
+}
+
+func (x *QueryTierTxsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_abcipp_mempool_v1_query_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryTierTxsResponse_messageType fastReflection_QueryTierTxsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryTierTxsResponse_messageType{}
+
+type fastReflection_QueryTierTxsResponse_messageType struct{}
+
+func (x fastReflection_QueryTierTxsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryTierTxsResponse)(nil)
+}
+func (x fastReflection_QueryTierTxsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryTierTxsResponse)
+}
+func (x fastReflection_QueryTierTxsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTierTxsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryTierTxsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTierTxsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryTierTxsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryTierTxsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryTierTxsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryTierTxsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryTierTxsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryTierTxsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryTierTxsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Txs) != 0 {
+		value := protoreflect.ValueOfList(&_QueryTierTxsResponse_1_list{list: &x.Txs})
+		if !f(fd_QueryTierTxsResponse_txs, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryTierTxsResponse_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryTierTxsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.QueryTierTxsResponse.txs":
+		return len(x.Txs) != 0
+	case "initia.abcipp.mempool.v1.QueryTierTxsResponse.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.QueryTierTxsResponse"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.QueryTierTxsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTierTxsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.QueryTierTxsResponse.txs":
+		x.Txs = nil
+	case "initia.abcipp.mempool.v1.QueryTierTxsResponse.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.QueryTierTxsResponse"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.QueryTierTxsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryTierTxsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.abcipp.mempool.v1.QueryTierTxsResponse.txs":
+		if len(x.Txs) == 0 {
+			return protoreflect.ValueOfList(&_QueryTierTxsResponse_1_list{})
+		}
+		listValue := &_QueryTierTxsResponse_1_list{list: &x.Txs}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.abcipp.mempool.v1.QueryTierTxsResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.QueryTierTxsResponse"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.QueryTierTxsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTierTxsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.QueryTierTxsResponse.txs":
+		lv := value.List()
+		clv := lv.(*_QueryTierTxsResponse_1_list)
+		x.Txs = *clv.list
+	case "initia.abcipp.mempool.v1.QueryTierTxsResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.QueryTierTxsResponse"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.QueryTierTxsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTierTxsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.QueryTierTxsResponse.txs":
+		if x.Txs == nil {
+			x.Txs = []*MempoolTx{}
+		}
+		value := &_QueryTierTxsResponse_1_list{list: &x.Txs}
+		return protoreflect.ValueOfList(value)
+	case "initia.abcipp.mempool.v1.QueryTierTxsResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.QueryTierTxsResponse"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.QueryTierTxsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryTierTxsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.QueryTierTxsResponse.txs":
+		list := []*MempoolTx{}
+		return protoreflect.ValueOfList(&_QueryTierTxsResponse_1_list{list: &list})
+	case "initia.abcipp.mempool.v1.QueryTierTxsResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.QueryTierTxsResponse"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.QueryTierTxsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryTierTxsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.abcipp.mempool.v1.QueryTierTxsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryTierTxsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTierTxsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryTierTxsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryTierTxsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryTierTxsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Txs) > 0 {
+			for _, e := range x.Txs {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTierTxsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Txs) > 0 {
+			for iNdEx := len(x.Txs) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Txs[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTierTxsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTierTxsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTierTxsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Txs = append(x.Txs, &MempoolTx{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Txs[len(x.Txs)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SenderReputation              protoreflect.MessageDescriptor
+	fd_SenderReputation_sender       protoreflect.FieldDescriptor
+	fd_SenderReputation_score        protoreflect.FieldDescriptor
+	fd_SenderReputation_throttled    protoreflect.FieldDescriptor
+	fd_SenderReputation_banned       protoreflect.FieldDescriptor
+	fd_SenderReputation_banned_until protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_abcipp_mempool_v1_query_proto_init()
+	md_SenderReputation = File_initia_abcipp_mempool_v1_query_proto.Messages().ByName("SenderReputation")
+	fd_SenderReputation_sender = md_SenderReputation.Fields().ByName("sender")
+	fd_SenderReputation_score = md_SenderReputation.Fields().ByName("score")
+	fd_SenderReputation_throttled = md_SenderReputation.Fields().ByName("throttled")
+	fd_SenderReputation_banned = md_SenderReputation.Fields().ByName("banned")
+	fd_SenderReputation_banned_until = md_SenderReputation.Fields().ByName("banned_until")
+}
+
+var _ protoreflect.Message = (*fastReflection_SenderReputation)(nil)
+
+type fastReflection_SenderReputation SenderReputation
+
+func (x *SenderReputation) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SenderReputation)(x)
+}
+
+func (x *SenderReputation) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_abcipp_mempool_v1_query_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SenderReputation_messageType fastReflection_SenderReputation_messageType
+var _ protoreflect.MessageType = fastReflection_SenderReputation_messageType{}
+
+type fastReflection_SenderReputation_messageType struct{}
+
+func (x fastReflection_SenderReputation_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SenderReputation)(nil)
+}
+func (x fastReflection_SenderReputation_messageType) New() protoreflect.Message {
+	return new(fastReflection_SenderReputation)
+}
+func (x fastReflection_SenderReputation_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SenderReputation
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SenderReputation) Descriptor() protoreflect.MessageDescriptor {
+	return md_SenderReputation
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SenderReputation) Type() protoreflect.MessageType {
+	return _fastReflection_SenderReputation_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SenderReputation) New() protoreflect.Message {
+	return new(fastReflection_SenderReputation)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SenderReputation) Interface() protoreflect.ProtoMessage {
+	return (*SenderReputation)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SenderReputation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_SenderReputation_sender, value) {
+			return
+		}
+	}
+	if x.Score != float64(0) || math.Signbit(x.Score) {
+		value := protoreflect.ValueOfFloat64(x.Score)
+		if !f(fd_SenderReputation_score, value) {
+			return
+		}
+	}
+	if x.Throttled != false {
+		value := protoreflect.ValueOfBool(x.Throttled)
+		if !f(fd_SenderReputation_throttled, value) {
+			return
+		}
+	}
+	if x.Banned != false {
+		value := protoreflect.ValueOfBool(x.Banned)
+		if !f(fd_SenderReputation_banned, value) {
+			return
+		}
+	}
+	if x.BannedUntil != nil {
+		value := protoreflect.ValueOfMessage(x.BannedUntil.ProtoReflect())
+		if !f(fd_SenderReputation_banned_until, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SenderReputation) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.SenderReputation.sender":
+		return x.Sender != ""
+	case "initia.abcipp.mempool.v1.SenderReputation.score":
+		return x.Score != float64(0) || math.Signbit(x.Score)
+	case "initia.abcipp.mempool.v1.SenderReputation.throttled":
+		return x.Throttled != false
+	case "initia.abcipp.mempool.v1.SenderReputation.banned":
+		return x.Banned != false
+	case "initia.abcipp.mempool.v1.SenderReputation.banned_until":
+		return x.BannedUntil != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.SenderReputation"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.SenderReputation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SenderReputation) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.SenderReputation.sender":
+		x.Sender = ""
+	case "initia.abcipp.mempool.v1.SenderReputation.score":
+		x.Score = float64(0)
+	case "initia.abcipp.mempool.v1.SenderReputation.throttled":
+		x.Throttled = false
+	case "initia.abcipp.mempool.v1.SenderReputation.banned":
+		x.Banned = false
+	case "initia.abcipp.mempool.v1.SenderReputation.banned_until":
+		x.BannedUntil = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.SenderReputation"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.SenderReputation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SenderReputation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.abcipp.mempool.v1.SenderReputation.sender":
+		value := x.Sender
+		return protoreflect.ValueOfString(value)
+	case "initia.abcipp.mempool.v1.SenderReputation.score":
+		value := x.Score
+		return protoreflect.ValueOfFloat64(value)
+	case "initia.abcipp.mempool.v1.SenderReputation.throttled":
+		value := x.Throttled
+		return protoreflect.ValueOfBool(value)
+	case "initia.abcipp.mempool.v1.SenderReputation.banned":
+		value := x.Banned
+		return protoreflect.ValueOfBool(value)
+	case "initia.abcipp.mempool.v1.SenderReputation.banned_until":
+		value := x.BannedUntil
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.SenderReputation"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.SenderReputation does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SenderReputation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.SenderReputation.sender":
+		x.Sender = value.Interface().(string)
+	case "initia.abcipp.mempool.v1.SenderReputation.score":
+		x.Score = value.Float()
+	case "initia.abcipp.mempool.v1.SenderReputation.throttled":
+		x.Throttled = value.Bool()
+	case "initia.abcipp.mempool.v1.SenderReputation.banned":
+		x.Banned = value.Bool()
+	case "initia.abcipp.mempool.v1.SenderReputation.banned_until":
+		x.BannedUntil = value.Message().Interface().(*timestamppb.Timestamp)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.SenderReputation"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.SenderReputation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SenderReputation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.SenderReputation.banned_until":
+		if x.BannedUntil == nil {
+			x.BannedUntil = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.BannedUntil.ProtoReflect())
+	case "initia.abcipp.mempool.v1.SenderReputation.sender":
+		panic(fmt.Errorf("field sender of message initia.abcipp.mempool.v1.SenderReputation is not mutable"))
+	case "initia.abcipp.mempool.v1.SenderReputation.score":
+		panic(fmt.Errorf("field score of message initia.abcipp.mempool.v1.SenderReputation is not mutable"))
+	case "initia.abcipp.mempool.v1.SenderReputation.throttled":
+		panic(fmt.Errorf("field throttled of message initia.abcipp.mempool.v1.SenderReputation is not mutable"))
+	case "initia.abcipp.mempool.v1.SenderReputation.banned":
+		panic(fmt.Errorf("field banned of message initia.abcipp.mempool.v1.SenderReputation is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.SenderReputation"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.SenderReputation does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SenderReputation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.SenderReputation.sender":
+		return protoreflect.ValueOfString("")
+	case "initia.abcipp.mempool.v1.SenderReputation.score":
+		return protoreflect.ValueOfFloat64(float64(0))
+	case "initia.abcipp.mempool.v1.SenderReputation.throttled":
+		return protoreflect.ValueOfBool(false)
+	case "initia.abcipp.mempool.v1.SenderReputation.banned":
+		return protoreflect.ValueOfBool(false)
+	case "initia.abcipp.mempool.v1.SenderReputation.banned_until":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.SenderReputation"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.SenderReputation does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SenderReputation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.abcipp.mempool.v1.SenderReputation", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SenderReputation) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SenderReputation) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SenderReputation) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SenderReputation) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SenderReputation)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Sender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Score != 0 || math.Signbit(x.Score) {
+			n += 9
+		}
+		if x.Throttled {
+			n += 2
+		}
+		if x.Banned {
+			n += 2
+		}
+		if x.BannedUntil != nil {
+			l = options.Size(x.BannedUntil)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SenderReputation)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.BannedUntil != nil {
+			encoded, err := options.Marshal(x.BannedUntil)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.Banned {
+			i--
+			if x.Banned {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Throttled {
+			i--
+			if x.Throttled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Score != 0 || math.Signbit(x.Score) {
+			i -= 8
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(x.Score))))
+			i--
+			dAtA[i] = 0x11
+		}
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SenderReputation)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SenderReputation: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SenderReputation: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 1 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+				}
+				var v uint64
+				if (iNdEx + 8) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				x.Score = float64(math.Float64frombits(v))
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Throttled", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Throttled = bool(v != 0)
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Banned", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Banned = bool(v != 0)
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BannedUntil", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.BannedUntil == nil {
+					x.BannedUntil = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BannedUntil); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QuerySenderReputationsRequest             protoreflect.MessageDescriptor
+	fd_QuerySenderReputationsRequest_banned_only protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_abcipp_mempool_v1_query_proto_init()
+	md_QuerySenderReputationsRequest = File_initia_abcipp_mempool_v1_query_proto.Messages().ByName("QuerySenderReputationsRequest")
+	fd_QuerySenderReputationsRequest_banned_only = md_QuerySenderReputationsRequest.Fields().ByName("banned_only")
+}
+
+var _ protoreflect.Message = (*fastReflection_QuerySenderReputationsRequest)(nil)
+
+type fastReflection_QuerySenderReputationsRequest QuerySenderReputationsRequest
+
+func (x *QuerySenderReputationsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QuerySenderReputationsRequest)(x)
+}
+
+func (x *QuerySenderReputationsRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_abcipp_mempool_v1_query_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QuerySenderReputationsRequest_messageType fastReflection_QuerySenderReputationsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QuerySenderReputationsRequest_messageType{}
+
+type fastReflection_QuerySenderReputationsRequest_messageType struct{}
+
+func (x fastReflection_QuerySenderReputationsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QuerySenderReputationsRequest)(nil)
+}
+func (x fastReflection_QuerySenderReputationsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QuerySenderReputationsRequest)
+}
+func (x fastReflection_QuerySenderReputationsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySenderReputationsRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QuerySenderReputationsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySenderReputationsRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QuerySenderReputationsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QuerySenderReputationsRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QuerySenderReputationsRequest) New() protoreflect.Message {
+	return new(fastReflection_QuerySenderReputationsRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QuerySenderReputationsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QuerySenderReputationsRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QuerySenderReputationsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BannedOnly != false {
+		value := protoreflect.ValueOfBool(x.BannedOnly)
+		if !f(fd_QuerySenderReputationsRequest_banned_only, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QuerySenderReputationsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.QuerySenderReputationsRequest.banned_only":
+		return x.BannedOnly != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.QuerySenderReputationsRequest"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.QuerySenderReputationsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySenderReputationsRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.QuerySenderReputationsRequest.banned_only":
+		x.BannedOnly = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.QuerySenderReputationsRequest"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.QuerySenderReputationsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QuerySenderReputationsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.abcipp.mempool.v1.QuerySenderReputationsRequest.banned_only":
+		value := x.BannedOnly
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.QuerySenderReputationsRequest"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.QuerySenderReputationsRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySenderReputationsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.QuerySenderReputationsRequest.banned_only":
+		x.BannedOnly = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.QuerySenderReputationsRequest"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.QuerySenderReputationsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySenderReputationsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.QuerySenderReputationsRequest.banned_only":
+		panic(fmt.Errorf("field banned_only of message initia.abcipp.mempool.v1.QuerySenderReputationsRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.QuerySenderReputationsRequest"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.QuerySenderReputationsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QuerySenderReputationsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.QuerySenderReputationsRequest.banned_only":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.QuerySenderReputationsRequest"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.QuerySenderReputationsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QuerySenderReputationsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.abcipp.mempool.v1.QuerySenderReputationsRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QuerySenderReputationsRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySenderReputationsRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QuerySenderReputationsRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QuerySenderReputationsRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QuerySenderReputationsRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.BannedOnly {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySenderReputationsRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.BannedOnly {
+			i--
+			if x.BannedOnly {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySenderReputationsRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySenderReputationsRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySenderReputationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BannedOnly", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.BannedOnly = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QuerySenderReputationsResponse_1_list)(nil)
+
+type _QuerySenderReputationsResponse_1_list struct {
+	list *[]*SenderReputation
+}
+
+func (x *_QuerySenderReputationsResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QuerySenderReputationsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QuerySenderReputationsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SenderReputation)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QuerySenderReputationsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SenderReputation)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QuerySenderReputationsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(SenderReputation)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QuerySenderReputationsResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QuerySenderReputationsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(SenderReputation)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QuerySenderReputationsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QuerySenderReputationsResponse             protoreflect.MessageDescriptor
+	fd_QuerySenderReputationsResponse_reputations protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_abcipp_mempool_v1_query_proto_init()
+	md_QuerySenderReputationsResponse = File_initia_abcipp_mempool_v1_query_proto.Messages().ByName("QuerySenderReputationsResponse")
+	fd_QuerySenderReputationsResponse_reputations = md_QuerySenderReputationsResponse.Fields().ByName("reputations")
+}
+
+var _ protoreflect.Message = (*fastReflection_QuerySenderReputationsResponse)(nil)
+
+type fastReflection_QuerySenderReputationsResponse QuerySenderReputationsResponse
+
+func (x *QuerySenderReputationsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QuerySenderReputationsResponse)(x)
+}
+
+func (x *QuerySenderReputationsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_abcipp_mempool_v1_query_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QuerySenderReputationsResponse_messageType fastReflection_QuerySenderReputationsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QuerySenderReputationsResponse_messageType{}
+
+type fastReflection_QuerySenderReputationsResponse_messageType struct{}
+
+func (x fastReflection_QuerySenderReputationsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QuerySenderReputationsResponse)(nil)
+}
+func (x fastReflection_QuerySenderReputationsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QuerySenderReputationsResponse)
+}
+func (x fastReflection_QuerySenderReputationsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySenderReputationsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QuerySenderReputationsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySenderReputationsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QuerySenderReputationsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QuerySenderReputationsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QuerySenderReputationsResponse) New() protoreflect.Message {
+	return new(fastReflection_QuerySenderReputationsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QuerySenderReputationsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QuerySenderReputationsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QuerySenderReputationsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Reputations) != 0 {
+		value := protoreflect.ValueOfList(&_QuerySenderReputationsResponse_1_list{list: &x.Reputations})
+		if !f(fd_QuerySenderReputationsResponse_reputations, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QuerySenderReputationsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.QuerySenderReputationsResponse.reputations":
+		return len(x.Reputations) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.QuerySenderReputationsResponse"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.QuerySenderReputationsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySenderReputationsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.QuerySenderReputationsResponse.reputations":
+		x.Reputations = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.QuerySenderReputationsResponse"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.QuerySenderReputationsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QuerySenderReputationsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.abcipp.mempool.v1.QuerySenderReputationsResponse.reputations":
+		if len(x.Reputations) == 0 {
+			return protoreflect.ValueOfList(&_QuerySenderReputationsResponse_1_list{})
+		}
+		listValue := &_QuerySenderReputationsResponse_1_list{list: &x.Reputations}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.QuerySenderReputationsResponse"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.QuerySenderReputationsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySenderReputationsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.QuerySenderReputationsResponse.reputations":
+		lv := value.List()
+		clv := lv.(*_QuerySenderReputationsResponse_1_list)
+		x.Reputations = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.QuerySenderReputationsResponse"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.QuerySenderReputationsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySenderReputationsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.QuerySenderReputationsResponse.reputations":
+		if x.Reputations == nil {
+			x.Reputations = []*SenderReputation{}
+		}
+		value := &_QuerySenderReputationsResponse_1_list{list: &x.Reputations}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.QuerySenderReputationsResponse"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.QuerySenderReputationsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QuerySenderReputationsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.QuerySenderReputationsResponse.reputations":
+		list := []*SenderReputation{}
+		return protoreflect.ValueOfList(&_QuerySenderReputationsResponse_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.QuerySenderReputationsResponse"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.QuerySenderReputationsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QuerySenderReputationsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.abcipp.mempool.v1.QuerySenderReputationsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QuerySenderReputationsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySenderReputationsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QuerySenderReputationsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QuerySenderReputationsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QuerySenderReputationsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Reputations) > 0 {
+			for _, e := range x.Reputations {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySenderReputationsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Reputations) > 0 {
+			for iNdEx := len(x.Reputations) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Reputations[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySenderReputationsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySenderReputationsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySenderReputationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reputations", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reputations = append(x.Reputations, &SenderReputation{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Reputations[len(x.Reputations)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MempoolSnapshotSender              protoreflect.MessageDescriptor
+	fd_MempoolSnapshotSender_sender       protoreflect.FieldDescriptor
+	fd_MempoolSnapshotSender_on_chain_seq protoreflect.FieldDescriptor
+	fd_MempoolSnapshotSender_active_min   protoreflect.FieldDescriptor
+	fd_MempoolSnapshotSender_active_max   protoreflect.FieldDescriptor
+	fd_MempoolSnapshotSender_queued_min   protoreflect.FieldDescriptor
+	fd_MempoolSnapshotSender_queued_max   protoreflect.FieldDescriptor
+	fd_MempoolSnapshotSender_gap_since    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_abcipp_mempool_v1_query_proto_init()
+	md_MempoolSnapshotSender = File_initia_abcipp_mempool_v1_query_proto.Messages().ByName("MempoolSnapshotSender")
+	fd_MempoolSnapshotSender_sender = md_MempoolSnapshotSender.Fields().ByName("sender")
+	fd_MempoolSnapshotSender_on_chain_seq = md_MempoolSnapshotSender.Fields().ByName("on_chain_seq")
+	fd_MempoolSnapshotSender_active_min = md_MempoolSnapshotSender.Fields().ByName("active_min")
+	fd_MempoolSnapshotSender_active_max = md_MempoolSnapshotSender.Fields().ByName("active_max")
+	fd_MempoolSnapshotSender_queued_min = md_MempoolSnapshotSender.Fields().ByName("queued_min")
+	fd_MempoolSnapshotSender_queued_max = md_MempoolSnapshotSender.Fields().ByName("queued_max")
+	fd_MempoolSnapshotSender_gap_since = md_MempoolSnapshotSender.Fields().ByName("gap_since")
+}
+
+var _ protoreflect.Message = (*fastReflection_MempoolSnapshotSender)(nil)
+
+type fastReflection_MempoolSnapshotSender MempoolSnapshotSender
+
+func (x *MempoolSnapshotSender) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MempoolSnapshotSender)(x)
+}
+
+func (x *MempoolSnapshotSender) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_abcipp_mempool_v1_query_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MempoolSnapshotSender_messageType fastReflection_MempoolSnapshotSender_messageType
+var _ protoreflect.MessageType = fastReflection_MempoolSnapshotSender_messageType{}
+
+type fastReflection_MempoolSnapshotSender_messageType struct{}
+
+func (x fastReflection_MempoolSnapshotSender_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MempoolSnapshotSender)(nil)
+}
+func (x fastReflection_MempoolSnapshotSender_messageType) New() protoreflect.Message {
+	return new(fastReflection_MempoolSnapshotSender)
+}
+func (x fastReflection_MempoolSnapshotSender_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MempoolSnapshotSender
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MempoolSnapshotSender) Descriptor() protoreflect.MessageDescriptor {
+	return md_MempoolSnapshotSender
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MempoolSnapshotSender) Type() protoreflect.MessageType {
+	return _fastReflection_MempoolSnapshotSender_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MempoolSnapshotSender) New() protoreflect.Message {
+	return new(fastReflection_MempoolSnapshotSender)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MempoolSnapshotSender) Interface() protoreflect.ProtoMessage {
+	return (*MempoolSnapshotSender)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MempoolSnapshotSender) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_MempoolSnapshotSender_sender, value) {
+			return
+		}
+	}
+	if x.OnChainSeq != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OnChainSeq)
+		if !f(fd_MempoolSnapshotSender_on_chain_seq, value) {
+			return
+		}
+	}
+	if x.ActiveMin != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ActiveMin)
+		if !f(fd_MempoolSnapshotSender_active_min, value) {
+			return
+		}
+	}
+	if x.ActiveMax != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ActiveMax)
+		if !f(fd_MempoolSnapshotSender_active_max, value) {
+			return
+		}
+	}
+	if x.QueuedMin != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.QueuedMin)
+		if !f(fd_MempoolSnapshotSender_queued_min, value) {
+			return
+		}
+	}
+	if x.QueuedMax != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.QueuedMax)
+		if !f(fd_MempoolSnapshotSender_queued_max, value) {
+			return
+		}
+	}
+	if x.GapSince != nil {
+		value := protoreflect.ValueOfMessage(x.GapSince.ProtoReflect())
+		if !f(fd_MempoolSnapshotSender_gap_since, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MempoolSnapshotSender) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.MempoolSnapshotSender.sender":
+		return x.Sender != ""
+	case "initia.abcipp.mempool.v1.MempoolSnapshotSender.on_chain_seq":
+		return x.OnChainSeq != uint64(0)
+	case "initia.abcipp.mempool.v1.MempoolSnapshotSender.active_min":
+		return x.ActiveMin != uint64(0)
+	case "initia.abcipp.mempool.v1.MempoolSnapshotSender.active_max":
+		return x.ActiveMax != uint64(0)
+	case "initia.abcipp.mempool.v1.MempoolSnapshotSender.queued_min":
+		return x.QueuedMin != uint64(0)
+	case "initia.abcipp.mempool.v1.MempoolSnapshotSender.queued_max":
+		return x.QueuedMax != uint64(0)
+	case "initia.abcipp.mempool.v1.MempoolSnapshotSender.gap_since":
+		return x.GapSince != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.MempoolSnapshotSender"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.MempoolSnapshotSender does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MempoolSnapshotSender) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.MempoolSnapshotSender.sender":
+		x.Sender = ""
+	case "initia.abcipp.mempool.v1.MempoolSnapshotSender.on_chain_seq":
+		x.OnChainSeq = uint64(0)
+	case "initia.abcipp.mempool.v1.MempoolSnapshotSender.active_min":
+		x.ActiveMin = uint64(0)
+	case "initia.abcipp.mempool.v1.MempoolSnapshotSender.active_max":
+		x.ActiveMax = uint64(0)
+	case "initia.abcipp.mempool.v1.MempoolSnapshotSender.queued_min":
+		x.QueuedMin = uint64(0)
+	case "initia.abcipp.mempool.v1.MempoolSnapshotSender.queued_max":
+		x.QueuedMax = uint64(0)
+	case "initia.abcipp.mempool.v1.MempoolSnapshotSender.gap_since":
+		x.GapSince = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.MempoolSnapshotSender"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.MempoolSnapshotSender does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MempoolSnapshotSender) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.abcipp.mempool.v1.MempoolSnapshotSender.sender":
+		value := x.Sender
+		return protoreflect.ValueOfString(value)
+	case "initia.abcipp.mempool.v1.MempoolSnapshotSender.on_chain_seq":
+		value := x.OnChainSeq
+		return protoreflect.ValueOfUint64(value)
+	case "initia.abcipp.mempool.v1.MempoolSnapshotSender.active_min":
+		value := x.ActiveMin
+		return protoreflect.ValueOfUint64(value)
+	case "initia.abcipp.mempool.v1.MempoolSnapshotSender.active_max":
+		value := x.ActiveMax
+		return protoreflect.ValueOfUint64(value)
+	case "initia.abcipp.mempool.v1.MempoolSnapshotSender.queued_min":
+		value := x.QueuedMin
+		return protoreflect.ValueOfUint64(value)
+	case "initia.abcipp.mempool.v1.MempoolSnapshotSender.queued_max":
+		value := x.QueuedMax
+		return protoreflect.ValueOfUint64(value)
+	case "initia.abcipp.mempool.v1.MempoolSnapshotSender.gap_since":
+		value := x.GapSince
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.MempoolSnapshotSender"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.MempoolSnapshotSender does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MempoolSnapshotSender) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.MempoolSnapshotSender.sender":
+		x.Sender = value.Interface().(string)
+	case "initia.abcipp.mempool.v1.MempoolSnapshotSender.on_chain_seq":
+		x.OnChainSeq = value.Uint()
+	case "initia.abcipp.mempool.v1.MempoolSnapshotSender.active_min":
+		x.ActiveMin = value.Uint()
+	case "initia.abcipp.mempool.v1.MempoolSnapshotSender.active_max":
+		x.ActiveMax = value.Uint()
+	case "initia.abcipp.mempool.v1.MempoolSnapshotSender.queued_min":
+		x.QueuedMin = value.Uint()
+	case "initia.abcipp.mempool.v1.MempoolSnapshotSender.queued_max":
+		x.QueuedMax = value.Uint()
+	case "initia.abcipp.mempool.v1.MempoolSnapshotSender.gap_since":
+		x.GapSince = value.Message().Interface().(*timestamppb.Timestamp)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.MempoolSnapshotSender"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.MempoolSnapshotSender does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MempoolSnapshotSender) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.MempoolSnapshotSender.gap_since":
+		if x.GapSince == nil {
+			x.GapSince = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.GapSince.ProtoReflect())
+	case "initia.abcipp.mempool.v1.MempoolSnapshotSender.sender":
+		panic(fmt.Errorf("field sender of message initia.abcipp.mempool.v1.MempoolSnapshotSender is not mutable"))
+	case "initia.abcipp.mempool.v1.MempoolSnapshotSender.on_chain_seq":
+		panic(fmt.Errorf("field on_chain_seq of message initia.abcipp.mempool.v1.MempoolSnapshotSender is not mutable"))
+	case "initia.abcipp.mempool.v1.MempoolSnapshotSender.active_min":
+		panic(fmt.Errorf("field active_min of message initia.abcipp.mempool.v1.MempoolSnapshotSender is not mutable"))
+	case "initia.abcipp.mempool.v1.MempoolSnapshotSender.active_max":
+		panic(fmt.Errorf("field active_max of message initia.abcipp.mempool.v1.MempoolSnapshotSender is not mutable"))
+	case "initia.abcipp.mempool.v1.MempoolSnapshotSender.queued_min":
+		panic(fmt.Errorf("field queued_min of message initia.abcipp.mempool.v1.MempoolSnapshotSender is not mutable"))
+	case "initia.abcipp.mempool.v1.MempoolSnapshotSender.queued_max":
+		panic(fmt.Errorf("field queued_max of message initia.abcipp.mempool.v1.MempoolSnapshotSender is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.MempoolSnapshotSender"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.MempoolSnapshotSender does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MempoolSnapshotSender) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.MempoolSnapshotSender.sender":
+		return protoreflect.ValueOfString("")
+	case "initia.abcipp.mempool.v1.MempoolSnapshotSender.on_chain_seq":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "initia.abcipp.mempool.v1.MempoolSnapshotSender.active_min":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "initia.abcipp.mempool.v1.MempoolSnapshotSender.active_max":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "initia.abcipp.mempool.v1.MempoolSnapshotSender.queued_min":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "initia.abcipp.mempool.v1.MempoolSnapshotSender.queued_max":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "initia.abcipp.mempool.v1.MempoolSnapshotSender.gap_since":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.MempoolSnapshotSender"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.MempoolSnapshotSender does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MempoolSnapshotSender) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.abcipp.mempool.v1.MempoolSnapshotSender", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MempoolSnapshotSender) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MempoolSnapshotSender) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MempoolSnapshotSender) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MempoolSnapshotSender) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MempoolSnapshotSender)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Sender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.OnChainSeq != 0 {
+			n += 1 + runtime.Sov(uint64(x.OnChainSeq))
+		}
+		if x.ActiveMin != 0 {
+			n += 1 + runtime.Sov(uint64(x.ActiveMin))
+		}
+		if x.ActiveMax != 0 {
+			n += 1 + runtime.Sov(uint64(x.ActiveMax))
+		}
+		if x.QueuedMin != 0 {
+			n += 1 + runtime.Sov(uint64(x.QueuedMin))
+		}
+		if x.QueuedMax != 0 {
+			n += 1 + runtime.Sov(uint64(x.QueuedMax))
+		}
+		if x.GapSince != nil {
+			l = options.Size(x.GapSince)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MempoolSnapshotSender)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.GapSince != nil {
+			encoded, err := options.Marshal(x.GapSince)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.QueuedMax != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.QueuedMax))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.QueuedMin != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.QueuedMin))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.ActiveMax != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ActiveMax))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.ActiveMin != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ActiveMin))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.OnChainSeq != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OnChainSeq))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MempoolSnapshotSender)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MempoolSnapshotSender: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MempoolSnapshotSender: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OnChainSeq", wireType)
+				}
+				x.OnChainSeq = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OnChainSeq |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ActiveMin", wireType)
+				}
+				x.ActiveMin = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ActiveMin |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ActiveMax", wireType)
+				}
+				x.ActiveMax = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ActiveMax |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field QueuedMin", wireType)
+				}
+				x.QueuedMin = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.QueuedMin |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field QueuedMax", wireType)
+				}
+				x.QueuedMax = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.QueuedMax |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GapSince", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.GapSince == nil {
+					x.GapSince = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.GapSince); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MempoolSnapshotEntry                  protoreflect.MessageDescriptor
+	fd_MempoolSnapshotEntry_sender           protoreflect.FieldDescriptor
+	fd_MempoolSnapshotEntry_sequence         protoreflect.FieldDescriptor
+	fd_MempoolSnapshotEntry_tier             protoreflect.FieldDescriptor
+	fd_MempoolSnapshotEntry_priority         protoreflect.FieldDescriptor
+	fd_MempoolSnapshotEntry_clamped_priority protoreflect.FieldDescriptor
+	fd_MempoolSnapshotEntry_clamped_order    protoreflect.FieldDescriptor
+	fd_MempoolSnapshotEntry_order            protoreflect.FieldDescriptor
+	fd_MempoolSnapshotEntry_gas_limit        protoreflect.FieldDescriptor
+	fd_MempoolSnapshotEntry_tx_size          protoreflect.FieldDescriptor
+	fd_MempoolSnapshotEntry_tx_bytes         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_abcipp_mempool_v1_query_proto_init()
+	md_MempoolSnapshotEntry = File_initia_abcipp_mempool_v1_query_proto.Messages().ByName("MempoolSnapshotEntry")
+	fd_MempoolSnapshotEntry_sender = md_MempoolSnapshotEntry.Fields().ByName("sender")
+	fd_MempoolSnapshotEntry_sequence = md_MempoolSnapshotEntry.Fields().ByName("sequence")
+	fd_MempoolSnapshotEntry_tier = md_MempoolSnapshotEntry.Fields().ByName("tier")
+	fd_MempoolSnapshotEntry_priority = md_MempoolSnapshotEntry.Fields().ByName("priority")
+	fd_MempoolSnapshotEntry_clamped_priority = md_MempoolSnapshotEntry.Fields().ByName("clamped_priority")
+	fd_MempoolSnapshotEntry_clamped_order = md_MempoolSnapshotEntry.Fields().ByName("clamped_order")
+	fd_MempoolSnapshotEntry_order = md_MempoolSnapshotEntry.Fields().ByName("order")
+	fd_MempoolSnapshotEntry_gas_limit = md_MempoolSnapshotEntry.Fields().ByName("gas_limit")
+	fd_MempoolSnapshotEntry_tx_size = md_MempoolSnapshotEntry.Fields().ByName("tx_size")
+	fd_MempoolSnapshotEntry_tx_bytes = md_MempoolSnapshotEntry.Fields().ByName("tx_bytes")
+}
+
+var _ protoreflect.Message = (*fastReflection_MempoolSnapshotEntry)(nil)
+
+type fastReflection_MempoolSnapshotEntry MempoolSnapshotEntry
+
+func (x *MempoolSnapshotEntry) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MempoolSnapshotEntry)(x)
+}
+
+func (x *MempoolSnapshotEntry) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_abcipp_mempool_v1_query_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MempoolSnapshotEntry_messageType fastReflection_MempoolSnapshotEntry_messageType
+var _ protoreflect.MessageType = fastReflection_MempoolSnapshotEntry_messageType{}
+
+type fastReflection_MempoolSnapshotEntry_messageType struct{}
+
+func (x fastReflection_MempoolSnapshotEntry_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MempoolSnapshotEntry)(nil)
+}
+func (x fastReflection_MempoolSnapshotEntry_messageType) New() protoreflect.Message {
+	return new(fastReflection_MempoolSnapshotEntry)
+}
+func (x fastReflection_MempoolSnapshotEntry_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MempoolSnapshotEntry
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MempoolSnapshotEntry) Descriptor() protoreflect.MessageDescriptor {
+	return md_MempoolSnapshotEntry
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MempoolSnapshotEntry) Type() protoreflect.MessageType {
+	return _fastReflection_MempoolSnapshotEntry_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MempoolSnapshotEntry) New() protoreflect.Message {
+	return new(fastReflection_MempoolSnapshotEntry)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MempoolSnapshotEntry) Interface() protoreflect.ProtoMessage {
+	return (*MempoolSnapshotEntry)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MempoolSnapshotEntry) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_MempoolSnapshotEntry_sender, value) {
+			return
+		}
+	}
+	if x.Sequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Sequence)
+		if !f(fd_MempoolSnapshotEntry_sequence, value) {
+			return
+		}
+	}
+	if x.Tier != "" {
+		value := protoreflect.ValueOfString(x.Tier)
+		if !f(fd_MempoolSnapshotEntry_tier, value) {
+			return
+		}
+	}
+	if x.Priority != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Priority)
+		if !f(fd_MempoolSnapshotEntry_priority, value) {
+			return
+		}
+	}
+	if x.ClampedPriority != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ClampedPriority)
+		if !f(fd_MempoolSnapshotEntry_clamped_priority, value) {
+			return
+		}
+	}
+	if x.ClampedOrder != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ClampedOrder)
+		if !f(fd_MempoolSnapshotEntry_clamped_order, value) {
+			return
+		}
+	}
+	if x.Order != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Order)
+		if !f(fd_MempoolSnapshotEntry_order, value) {
+			return
+		}
+	}
+	if x.GasLimit != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GasLimit)
+		if !f(fd_MempoolSnapshotEntry_gas_limit, value) {
+			return
+		}
+	}
+	if x.TxSize != int64(0) {
+		value := protoreflect.ValueOfInt64(x.TxSize)
+		if !f(fd_MempoolSnapshotEntry_tx_size, value) {
+			return
+		}
+	}
+	if len(x.TxBytes) != 0 {
+		value := protoreflect.ValueOfBytes(x.TxBytes)
+		if !f(fd_MempoolSnapshotEntry_tx_bytes, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MempoolSnapshotEntry) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.MempoolSnapshotEntry.sender":
+		return x.Sender != ""
+	case "initia.abcipp.mempool.v1.MempoolSnapshotEntry.sequence":
+		return x.Sequence != uint64(0)
+	case "initia.abcipp.mempool.v1.MempoolSnapshotEntry.tier":
+		return x.Tier != ""
+	case "initia.abcipp.mempool.v1.MempoolSnapshotEntry.priority":
+		return x.Priority != int64(0)
+	case "initia.abcipp.mempool.v1.MempoolSnapshotEntry.clamped_priority":
+		return x.ClampedPriority != int64(0)
+	case "initia.abcipp.mempool.v1.MempoolSnapshotEntry.clamped_order":
+		return x.ClampedOrder != int64(0)
+	case "initia.abcipp.mempool.v1.MempoolSnapshotEntry.order":
+		return x.Order != int64(0)
+	case "initia.abcipp.mempool.v1.MempoolSnapshotEntry.gas_limit":
+		return x.GasLimit != uint64(0)
+	case "initia.abcipp.mempool.v1.MempoolSnapshotEntry.tx_size":
+		return x.TxSize != int64(0)
+	case "initia.abcipp.mempool.v1.MempoolSnapshotEntry.tx_bytes":
+		return len(x.TxBytes) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.MempoolSnapshotEntry"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.MempoolSnapshotEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MempoolSnapshotEntry) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.MempoolSnapshotEntry.sender":
+		x.Sender = ""
+	case "initia.abcipp.mempool.v1.MempoolSnapshotEntry.sequence":
+		x.Sequence = uint64(0)
+	case "initia.abcipp.mempool.v1.MempoolSnapshotEntry.tier":
+		x.Tier = ""
+	case "initia.abcipp.mempool.v1.MempoolSnapshotEntry.priority":
+		x.Priority = int64(0)
+	case "initia.abcipp.mempool.v1.MempoolSnapshotEntry.clamped_priority":
+		x.ClampedPriority = int64(0)
+	case "initia.abcipp.mempool.v1.MempoolSnapshotEntry.clamped_order":
+		x.ClampedOrder = int64(0)
+	case "initia.abcipp.mempool.v1.MempoolSnapshotEntry.order":
+		x.Order = int64(0)
+	case "initia.abcipp.mempool.v1.MempoolSnapshotEntry.gas_limit":
+		x.GasLimit = uint64(0)
+	case "initia.abcipp.mempool.v1.MempoolSnapshotEntry.tx_size":
+		x.TxSize = int64(0)
+	case "initia.abcipp.mempool.v1.MempoolSnapshotEntry.tx_bytes":
+		x.TxBytes = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.MempoolSnapshotEntry"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.MempoolSnapshotEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MempoolSnapshotEntry) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.abcipp.mempool.v1.MempoolSnapshotEntry.sender":
+		value := x.Sender
+		return protoreflect.ValueOfString(value)
+	case "initia.abcipp.mempool.v1.MempoolSnapshotEntry.sequence":
+		value := x.Sequence
+		return protoreflect.ValueOfUint64(value)
+	case "initia.abcipp.mempool.v1.MempoolSnapshotEntry.tier":
+		value := x.Tier
+		return protoreflect.ValueOfString(value)
+	case "initia.abcipp.mempool.v1.MempoolSnapshotEntry.priority":
+		value := x.Priority
+		return protoreflect.ValueOfInt64(value)
+	case "initia.abcipp.mempool.v1.MempoolSnapshotEntry.clamped_priority":
+		value := x.ClampedPriority
+		return protoreflect.ValueOfInt64(value)
+	case "initia.abcipp.mempool.v1.MempoolSnapshotEntry.clamped_order":
+		value := x.ClampedOrder
+		return protoreflect.ValueOfInt64(value)
+	case "initia.abcipp.mempool.v1.MempoolSnapshotEntry.order":
+		value := x.Order
+		return protoreflect.ValueOfInt64(value)
+	case "initia.abcipp.mempool.v1.MempoolSnapshotEntry.gas_limit":
+		value := x.GasLimit
+		return protoreflect.ValueOfUint64(value)
+	case "initia.abcipp.mempool.v1.MempoolSnapshotEntry.tx_size":
+		value := x.TxSize
+		return protoreflect.ValueOfInt64(value)
+	case "initia.abcipp.mempool.v1.MempoolSnapshotEntry.tx_bytes":
+		value := x.TxBytes
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.MempoolSnapshotEntry"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.MempoolSnapshotEntry does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MempoolSnapshotEntry) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.MempoolSnapshotEntry.sender":
+		x.Sender = value.Interface().(string)
+	case "initia.abcipp.mempool.v1.MempoolSnapshotEntry.sequence":
+		x.Sequence = value.Uint()
+	case "initia.abcipp.mempool.v1.MempoolSnapshotEntry.tier":
+		x.Tier = value.Interface().(string)
+	case "initia.abcipp.mempool.v1.MempoolSnapshotEntry.priority":
+		x.Priority = value.Int()
+	case "initia.abcipp.mempool.v1.MempoolSnapshotEntry.clamped_priority":
+		x.ClampedPriority = value.Int()
+	case "initia.abcipp.mempool.v1.MempoolSnapshotEntry.clamped_order":
+		x.ClampedOrder = value.Int()
+	case "initia.abcipp.mempool.v1.MempoolSnapshotEntry.order":
+		x.Order = value.Int()
+	case "initia.abcipp.mempool.v1.MempoolSnapshotEntry.gas_limit":
+		x.GasLimit = value.Uint()
+	case "initia.abcipp.mempool.v1.MempoolSnapshotEntry.tx_size":
+		x.TxSize = value.Int()
+	case "initia.abcipp.mempool.v1.MempoolSnapshotEntry.tx_bytes":
+		x.TxBytes = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.MempoolSnapshotEntry"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.MempoolSnapshotEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MempoolSnapshotEntry) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.MempoolSnapshotEntry.sender":
+		panic(fmt.Errorf("field sender of message initia.abcipp.mempool.v1.MempoolSnapshotEntry is not mutable"))
+	case "initia.abcipp.mempool.v1.MempoolSnapshotEntry.sequence":
+		panic(fmt.Errorf("field sequence of message initia.abcipp.mempool.v1.MempoolSnapshotEntry is not mutable"))
+	case "initia.abcipp.mempool.v1.MempoolSnapshotEntry.tier":
+		panic(fmt.Errorf("field tier of message initia.abcipp.mempool.v1.MempoolSnapshotEntry is not mutable"))
+	case "initia.abcipp.mempool.v1.MempoolSnapshotEntry.priority":
+		panic(fmt.Errorf("field priority of message initia.abcipp.mempool.v1.MempoolSnapshotEntry is not mutable"))
+	case "initia.abcipp.mempool.v1.MempoolSnapshotEntry.clamped_priority":
+		panic(fmt.Errorf("field clamped_priority of message initia.abcipp.mempool.v1.MempoolSnapshotEntry is not mutable"))
+	case "initia.abcipp.mempool.v1.MempoolSnapshotEntry.clamped_order":
+		panic(fmt.Errorf("field clamped_order of message initia.abcipp.mempool.v1.MempoolSnapshotEntry is not mutable"))
+	case "initia.abcipp.mempool.v1.MempoolSnapshotEntry.order":
+		panic(fmt.Errorf("field order of message initia.abcipp.mempool.v1.MempoolSnapshotEntry is not mutable"))
+	case "initia.abcipp.mempool.v1.MempoolSnapshotEntry.gas_limit":
+		panic(fmt.Errorf("field gas_limit of message initia.abcipp.mempool.v1.MempoolSnapshotEntry is not mutable"))
+	case "initia.abcipp.mempool.v1.MempoolSnapshotEntry.tx_size":
+		panic(fmt.Errorf("field tx_size of message initia.abcipp.mempool.v1.MempoolSnapshotEntry is not mutable"))
+	case "initia.abcipp.mempool.v1.MempoolSnapshotEntry.tx_bytes":
+		panic(fmt.Errorf("field tx_bytes of message initia.abcipp.mempool.v1.MempoolSnapshotEntry is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.MempoolSnapshotEntry"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.MempoolSnapshotEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MempoolSnapshotEntry) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.MempoolSnapshotEntry.sender":
+		return protoreflect.ValueOfString("")
+	case "initia.abcipp.mempool.v1.MempoolSnapshotEntry.sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "initia.abcipp.mempool.v1.MempoolSnapshotEntry.tier":
+		return protoreflect.ValueOfString("")
+	case "initia.abcipp.mempool.v1.MempoolSnapshotEntry.priority":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "initia.abcipp.mempool.v1.MempoolSnapshotEntry.clamped_priority":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "initia.abcipp.mempool.v1.MempoolSnapshotEntry.clamped_order":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "initia.abcipp.mempool.v1.MempoolSnapshotEntry.order":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "initia.abcipp.mempool.v1.MempoolSnapshotEntry.gas_limit":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "initia.abcipp.mempool.v1.MempoolSnapshotEntry.tx_size":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "initia.abcipp.mempool.v1.MempoolSnapshotEntry.tx_bytes":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.MempoolSnapshotEntry"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.MempoolSnapshotEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MempoolSnapshotEntry) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.abcipp.mempool.v1.MempoolSnapshotEntry", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MempoolSnapshotEntry) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MempoolSnapshotEntry) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MempoolSnapshotEntry) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MempoolSnapshotEntry) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MempoolSnapshotEntry)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Sender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Sequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.Sequence))
+		}
+		l = len(x.Tier)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Priority != 0 {
+			n += 1 + runtime.Sov(uint64(x.Priority))
+		}
+		if x.ClampedPriority != 0 {
+			n += 1 + runtime.Sov(uint64(x.ClampedPriority))
+		}
+		if x.ClampedOrder != 0 {
+			n += 1 + runtime.Sov(uint64(x.ClampedOrder))
+		}
+		if x.Order != 0 {
+			n += 1 + runtime.Sov(uint64(x.Order))
+		}
+		if x.GasLimit != 0 {
+			n += 1 + runtime.Sov(uint64(x.GasLimit))
+		}
+		if x.TxSize != 0 {
+			n += 1 + runtime.Sov(uint64(x.TxSize))
+		}
+		l = len(x.TxBytes)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MempoolSnapshotEntry)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.TxBytes) > 0 {
+			i -= len(x.TxBytes)
+			copy(dAtA[i:], x.TxBytes)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TxBytes)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if x.TxSize != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TxSize))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.GasLimit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasLimit))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.Order != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Order))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.ClampedOrder != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ClampedOrder))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.ClampedPriority != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ClampedPriority))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.Priority != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Priority))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Tier) > 0 {
+			i -= len(x.Tier)
+			copy(dAtA[i:], x.Tier)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Tier)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Sequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Sequence))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MempoolSnapshotEntry)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MempoolSnapshotEntry: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MempoolSnapshotEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				}
+				x.Sequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Sequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tier", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Tier = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+				}
+				x.Priority = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Priority |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClampedPriority", wireType)
+				}
+				x.ClampedPriority = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ClampedPriority |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClampedOrder", wireType)
+				}
+				x.ClampedOrder = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ClampedOrder |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+				}
+				x.Order = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Order |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+				}
+				x.GasLimit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GasLimit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxSize", wireType)
+				}
+				x.TxSize = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TxSize |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TxBytes = append(x.TxBytes[:0], dAtA[iNdEx:postIndex]...)
+				if x.TxBytes == nil {
+					x.TxBytes = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MempoolSnapshot_2_list)(nil)
+
+type _MempoolSnapshot_2_list struct {
+	list *[]string
+}
+
+func (x *_MempoolSnapshot_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MempoolSnapshot_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MempoolSnapshot_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MempoolSnapshot_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MempoolSnapshot_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MempoolSnapshot at list field Tiers as it is not of Message kind"))
+}
+
+func (x *_MempoolSnapshot_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MempoolSnapshot_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MempoolSnapshot_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_MempoolSnapshot_4_list)(nil)
+
+type _MempoolSnapshot_4_list struct {
+	list *[]*MempoolSnapshotSender
+}
+
+func (x *_MempoolSnapshot_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MempoolSnapshot_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MempoolSnapshot_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MempoolSnapshotSender)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MempoolSnapshot_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MempoolSnapshotSender)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MempoolSnapshot_4_list) AppendMutable() protoreflect.Value {
+	v := new(MempoolSnapshotSender)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MempoolSnapshot_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MempoolSnapshot_4_list) NewElement() protoreflect.Value {
+	v := new(MempoolSnapshotSender)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MempoolSnapshot_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_MempoolSnapshot_5_list)(nil)
+
+type _MempoolSnapshot_5_list struct {
+	list *[]*MempoolSnapshotEntry
+}
+
+func (x *_MempoolSnapshot_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MempoolSnapshot_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MempoolSnapshot_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MempoolSnapshotEntry)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MempoolSnapshot_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MempoolSnapshotEntry)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MempoolSnapshot_5_list) AppendMutable() protoreflect.Value {
+	v := new(MempoolSnapshotEntry)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MempoolSnapshot_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MempoolSnapshot_5_list) NewElement() protoreflect.Value {
+	v := new(MempoolSnapshotEntry)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MempoolSnapshot_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_MempoolSnapshot_6_list)(nil)
+
+type _MempoolSnapshot_6_list struct {
+	list *[]*MempoolSnapshotEntry
+}
+
+func (x *_MempoolSnapshot_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MempoolSnapshot_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MempoolSnapshot_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MempoolSnapshotEntry)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MempoolSnapshot_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MempoolSnapshotEntry)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MempoolSnapshot_6_list) AppendMutable() protoreflect.Value {
+	v := new(MempoolSnapshotEntry)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MempoolSnapshot_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MempoolSnapshot_6_list) NewElement() protoreflect.Value {
+	v := new(MempoolSnapshotEntry)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MempoolSnapshot_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MempoolSnapshot           protoreflect.MessageDescriptor
+	fd_MempoolSnapshot_version   protoreflect.FieldDescriptor
+	fd_MempoolSnapshot_tiers     protoreflect.FieldDescriptor
+	fd_MempoolSnapshot_order_seq protoreflect.FieldDescriptor
+	fd_MempoolSnapshot_senders   protoreflect.FieldDescriptor
+	fd_MempoolSnapshot_active    protoreflect.FieldDescriptor
+	fd_MempoolSnapshot_queued    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_abcipp_mempool_v1_query_proto_init()
+	md_MempoolSnapshot = File_initia_abcipp_mempool_v1_query_proto.Messages().ByName("MempoolSnapshot")
+	fd_MempoolSnapshot_version = md_MempoolSnapshot.Fields().ByName("version")
+	fd_MempoolSnapshot_tiers = md_MempoolSnapshot.Fields().ByName("tiers")
+	fd_MempoolSnapshot_order_seq = md_MempoolSnapshot.Fields().ByName("order_seq")
+	fd_MempoolSnapshot_senders = md_MempoolSnapshot.Fields().ByName("senders")
+	fd_MempoolSnapshot_active = md_MempoolSnapshot.Fields().ByName("active")
+	fd_MempoolSnapshot_queued = md_MempoolSnapshot.Fields().ByName("queued")
+}
+
+var _ protoreflect.Message = (*fastReflection_MempoolSnapshot)(nil)
+
+type fastReflection_MempoolSnapshot MempoolSnapshot
+
+func (x *MempoolSnapshot) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MempoolSnapshot)(x)
+}
+
+func (x *MempoolSnapshot) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_abcipp_mempool_v1_query_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MempoolSnapshot_messageType fastReflection_MempoolSnapshot_messageType
+var _ protoreflect.MessageType = fastReflection_MempoolSnapshot_messageType{}
+
+type fastReflection_MempoolSnapshot_messageType struct{}
+
+func (x fastReflection_MempoolSnapshot_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MempoolSnapshot)(nil)
+}
+func (x fastReflection_MempoolSnapshot_messageType) New() protoreflect.Message {
+	return new(fastReflection_MempoolSnapshot)
+}
+func (x fastReflection_MempoolSnapshot_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MempoolSnapshot
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MempoolSnapshot) Descriptor() protoreflect.MessageDescriptor {
+	return md_MempoolSnapshot
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MempoolSnapshot) Type() protoreflect.MessageType {
+	return _fastReflection_MempoolSnapshot_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MempoolSnapshot) New() protoreflect.Message {
+	return new(fastReflection_MempoolSnapshot)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MempoolSnapshot) Interface() protoreflect.ProtoMessage {
+	return (*MempoolSnapshot)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MempoolSnapshot) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Version != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Version)
+		if !f(fd_MempoolSnapshot_version, value) {
+			return
+		}
+	}
+	if len(x.Tiers) != 0 {
+		value := protoreflect.ValueOfList(&_MempoolSnapshot_2_list{list: &x.Tiers})
+		if !f(fd_MempoolSnapshot_tiers, value) {
+			return
+		}
+	}
+	if x.OrderSeq != int64(0) {
+		value := protoreflect.ValueOfInt64(x.OrderSeq)
+		if !f(fd_MempoolSnapshot_order_seq, value) {
+			return
+		}
+	}
+	if len(x.Senders) != 0 {
+		value := protoreflect.ValueOfList(&_MempoolSnapshot_4_list{list: &x.Senders})
+		if !f(fd_MempoolSnapshot_senders, value) {
+			return
+		}
+	}
+	if len(x.Active) != 0 {
+		value := protoreflect.ValueOfList(&_MempoolSnapshot_5_list{list: &x.Active})
+		if !f(fd_MempoolSnapshot_active, value) {
+			return
+		}
+	}
+	if len(x.Queued) != 0 {
+		value := protoreflect.ValueOfList(&_MempoolSnapshot_6_list{list: &x.Queued})
+		if !f(fd_MempoolSnapshot_queued, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MempoolSnapshot) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.MempoolSnapshot.version":
+		return x.Version != uint32(0)
+	case "initia.abcipp.mempool.v1.MempoolSnapshot.tiers":
+		return len(x.Tiers) != 0
+	case "initia.abcipp.mempool.v1.MempoolSnapshot.order_seq":
+		return x.OrderSeq != int64(0)
+	case "initia.abcipp.mempool.v1.MempoolSnapshot.senders":
+		return len(x.Senders) != 0
+	case "initia.abcipp.mempool.v1.MempoolSnapshot.active":
+		return len(x.Active) != 0
+	case "initia.abcipp.mempool.v1.MempoolSnapshot.queued":
+		return len(x.Queued) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.MempoolSnapshot"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.MempoolSnapshot does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MempoolSnapshot) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.MempoolSnapshot.version":
+		x.Version = uint32(0)
+	case "initia.abcipp.mempool.v1.MempoolSnapshot.tiers":
+		x.Tiers = nil
+	case "initia.abcipp.mempool.v1.MempoolSnapshot.order_seq":
+		x.OrderSeq = int64(0)
+	case "initia.abcipp.mempool.v1.MempoolSnapshot.senders":
+		x.Senders = nil
+	case "initia.abcipp.mempool.v1.MempoolSnapshot.active":
+		x.Active = nil
+	case "initia.abcipp.mempool.v1.MempoolSnapshot.queued":
+		x.Queued = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.MempoolSnapshot"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.MempoolSnapshot does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MempoolSnapshot) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.abcipp.mempool.v1.MempoolSnapshot.version":
+		value := x.Version
+		return protoreflect.ValueOfUint32(value)
+	case "initia.abcipp.mempool.v1.MempoolSnapshot.tiers":
+		if len(x.Tiers) == 0 {
+			return protoreflect.ValueOfList(&_MempoolSnapshot_2_list{})
+		}
+		listValue := &_MempoolSnapshot_2_list{list: &x.Tiers}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.abcipp.mempool.v1.MempoolSnapshot.order_seq":
+		value := x.OrderSeq
+		return protoreflect.ValueOfInt64(value)
+	case "initia.abcipp.mempool.v1.MempoolSnapshot.senders":
+		if len(x.Senders) == 0 {
+			return protoreflect.ValueOfList(&_MempoolSnapshot_4_list{})
+		}
+		listValue := &_MempoolSnapshot_4_list{list: &x.Senders}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.abcipp.mempool.v1.MempoolSnapshot.active":
+		if len(x.Active) == 0 {
+			return protoreflect.ValueOfList(&_MempoolSnapshot_5_list{})
+		}
+		listValue := &_MempoolSnapshot_5_list{list: &x.Active}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.abcipp.mempool.v1.MempoolSnapshot.queued":
+		if len(x.Queued) == 0 {
+			return protoreflect.ValueOfList(&_MempoolSnapshot_6_list{})
+		}
+		listValue := &_MempoolSnapshot_6_list{list: &x.Queued}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.MempoolSnapshot"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.MempoolSnapshot does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MempoolSnapshot) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.MempoolSnapshot.version":
+		x.Version = uint32(value.Uint())
+	case "initia.abcipp.mempool.v1.MempoolSnapshot.tiers":
+		lv := value.List()
+		clv := lv.(*_MempoolSnapshot_2_list)
+		x.Tiers = *clv.list
+	case "initia.abcipp.mempool.v1.MempoolSnapshot.order_seq":
+		x.OrderSeq = value.Int()
+	case "initia.abcipp.mempool.v1.MempoolSnapshot.senders":
+		lv := value.List()
+		clv := lv.(*_MempoolSnapshot_4_list)
+		x.Senders = *clv.list
+	case "initia.abcipp.mempool.v1.MempoolSnapshot.active":
+		lv := value.List()
+		clv := lv.(*_MempoolSnapshot_5_list)
+		x.Active = *clv.list
+	case "initia.abcipp.mempool.v1.MempoolSnapshot.queued":
+		lv := value.List()
+		clv := lv.(*_MempoolSnapshot_6_list)
+		x.Queued = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.MempoolSnapshot"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.MempoolSnapshot does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MempoolSnapshot) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.MempoolSnapshot.tiers":
+		if x.Tiers == nil {
+			x.Tiers = []string{}
+		}
+		value := &_MempoolSnapshot_2_list{list: &x.Tiers}
+		return protoreflect.ValueOfList(value)
+	case "initia.abcipp.mempool.v1.MempoolSnapshot.senders":
+		if x.Senders == nil {
+			x.Senders = []*MempoolSnapshotSender{}
+		}
+		value := &_MempoolSnapshot_4_list{list: &x.Senders}
+		return protoreflect.ValueOfList(value)
+	case "initia.abcipp.mempool.v1.MempoolSnapshot.active":
+		if x.Active == nil {
+			x.Active = []*MempoolSnapshotEntry{}
+		}
+		value := &_MempoolSnapshot_5_list{list: &x.Active}
+		return protoreflect.ValueOfList(value)
+	case "initia.abcipp.mempool.v1.MempoolSnapshot.queued":
+		if x.Queued == nil {
+			x.Queued = []*MempoolSnapshotEntry{}
+		}
+		value := &_MempoolSnapshot_6_list{list: &x.Queued}
+		return protoreflect.ValueOfList(value)
+	case "initia.abcipp.mempool.v1.MempoolSnapshot.version":
+		panic(fmt.Errorf("field version of message initia.abcipp.mempool.v1.MempoolSnapshot is not mutable"))
+	case "initia.abcipp.mempool.v1.MempoolSnapshot.order_seq":
+		panic(fmt.Errorf("field order_seq of message initia.abcipp.mempool.v1.MempoolSnapshot is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.MempoolSnapshot"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.MempoolSnapshot does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MempoolSnapshot) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.MempoolSnapshot.version":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "initia.abcipp.mempool.v1.MempoolSnapshot.tiers":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MempoolSnapshot_2_list{list: &list})
+	case "initia.abcipp.mempool.v1.MempoolSnapshot.order_seq":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "initia.abcipp.mempool.v1.MempoolSnapshot.senders":
+		list := []*MempoolSnapshotSender{}
+		return protoreflect.ValueOfList(&_MempoolSnapshot_4_list{list: &list})
+	case "initia.abcipp.mempool.v1.MempoolSnapshot.active":
+		list := []*MempoolSnapshotEntry{}
+		return protoreflect.ValueOfList(&_MempoolSnapshot_5_list{list: &list})
+	case "initia.abcipp.mempool.v1.MempoolSnapshot.queued":
+		list := []*MempoolSnapshotEntry{}
+		return protoreflect.ValueOfList(&_MempoolSnapshot_6_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.MempoolSnapshot"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.MempoolSnapshot does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MempoolSnapshot) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.abcipp.mempool.v1.MempoolSnapshot", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MempoolSnapshot) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MempoolSnapshot) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MempoolSnapshot) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MempoolSnapshot) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MempoolSnapshot)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Version != 0 {
+			n += 1 + runtime.Sov(uint64(x.Version))
+		}
+		if len(x.Tiers) > 0 {
+			for _, s := range x.Tiers {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.OrderSeq != 0 {
+			n += 1 + runtime.Sov(uint64(x.OrderSeq))
+		}
+		if len(x.Senders) > 0 {
+			for _, e := range x.Senders {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Active) > 0 {
+			for _, e := range x.Active {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Queued) > 0 {
+			for _, e := range x.Queued {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MempoolSnapshot)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Queued) > 0 {
+			for iNdEx := len(x.Queued) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Queued[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.Active) > 0 {
+			for iNdEx := len(x.Active) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Active[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.Senders) > 0 {
+			for iNdEx := len(x.Senders) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Senders[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.OrderSeq != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OrderSeq))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Tiers) > 0 {
+			for iNdEx := len(x.Tiers) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Tiers[iNdEx])
+				copy(dAtA[i:], x.Tiers[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Tiers[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.Version != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Version))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MempoolSnapshot)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MempoolSnapshot: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MempoolSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				}
+				x.Version = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Version |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tiers", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Tiers = append(x.Tiers, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OrderSeq", wireType)
+				}
+				x.OrderSeq = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OrderSeq |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Senders", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Senders = append(x.Senders, &MempoolSnapshotSender{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Senders[len(x.Senders)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Active = append(x.Active, &MempoolSnapshotEntry{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Active[len(x.Active)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Queued", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Queued = append(x.Queued, &MempoolSnapshotEntry{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Queued[len(x.Queued)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryMempoolSnapshotRequest protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_initia_abcipp_mempool_v1_query_proto_init()
+	md_QueryMempoolSnapshotRequest = File_initia_abcipp_mempool_v1_query_proto.Messages().ByName("QueryMempoolSnapshotRequest")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryMempoolSnapshotRequest)(nil)
+
+type fastReflection_QueryMempoolSnapshotRequest QueryMempoolSnapshotRequest
+
+func (x *QueryMempoolSnapshotRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryMempoolSnapshotRequest)(x)
+}
+
+func (x *QueryMempoolSnapshotRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_abcipp_mempool_v1_query_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryMempoolSnapshotRequest_messageType fastReflection_QueryMempoolSnapshotRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryMempoolSnapshotRequest_messageType{}
+
+type fastReflection_QueryMempoolSnapshotRequest_messageType struct{}
+
+func (x fastReflection_QueryMempoolSnapshotRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryMempoolSnapshotRequest)(nil)
+}
+func (x fastReflection_QueryMempoolSnapshotRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryMempoolSnapshotRequest)
+}
+func (x fastReflection_QueryMempoolSnapshotRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryMempoolSnapshotRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryMempoolSnapshotRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryMempoolSnapshotRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryMempoolSnapshotRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryMempoolSnapshotRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryMempoolSnapshotRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryMempoolSnapshotRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryMempoolSnapshotRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryMempoolSnapshotRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryMempoolSnapshotRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryMempoolSnapshotRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.QueryMempoolSnapshotRequest"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.QueryMempoolSnapshotRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryMempoolSnapshotRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.QueryMempoolSnapshotRequest"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.QueryMempoolSnapshotRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryMempoolSnapshotRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.QueryMempoolSnapshotRequest"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.QueryMempoolSnapshotRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryMempoolSnapshotRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.QueryMempoolSnapshotRequest"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.QueryMempoolSnapshotRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryMempoolSnapshotRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.QueryMempoolSnapshotRequest"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.QueryMempoolSnapshotRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryMempoolSnapshotRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.QueryMempoolSnapshotRequest"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.QueryMempoolSnapshotRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryMempoolSnapshotRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.abcipp.mempool.v1.QueryMempoolSnapshotRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryMempoolSnapshotRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryMempoolSnapshotRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryMempoolSnapshotRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryMempoolSnapshotRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryMempoolSnapshotRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryMempoolSnapshotRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryMempoolSnapshotRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryMempoolSnapshotRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryMempoolSnapshotRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryMempoolSnapshotResponse          protoreflect.MessageDescriptor
+	fd_QueryMempoolSnapshotResponse_snapshot protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_abcipp_mempool_v1_query_proto_init()
+	md_QueryMempoolSnapshotResponse = File_initia_abcipp_mempool_v1_query_proto.Messages().ByName("QueryMempoolSnapshotResponse")
+	fd_QueryMempoolSnapshotResponse_snapshot = md_QueryMempoolSnapshotResponse.Fields().ByName("snapshot")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryMempoolSnapshotResponse)(nil)
+
+type fastReflection_QueryMempoolSnapshotResponse QueryMempoolSnapshotResponse
+
+func (x *QueryMempoolSnapshotResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryMempoolSnapshotResponse)(x)
+}
+
+func (x *QueryMempoolSnapshotResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_abcipp_mempool_v1_query_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryMempoolSnapshotResponse_messageType fastReflection_QueryMempoolSnapshotResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryMempoolSnapshotResponse_messageType{}
+
+type fastReflection_QueryMempoolSnapshotResponse_messageType struct{}
+
+func (x fastReflection_QueryMempoolSnapshotResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryMempoolSnapshotResponse)(nil)
+}
+func (x fastReflection_QueryMempoolSnapshotResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryMempoolSnapshotResponse)
+}
+func (x fastReflection_QueryMempoolSnapshotResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryMempoolSnapshotResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryMempoolSnapshotResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryMempoolSnapshotResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryMempoolSnapshotResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryMempoolSnapshotResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryMempoolSnapshotResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryMempoolSnapshotResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryMempoolSnapshotResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryMempoolSnapshotResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryMempoolSnapshotResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Snapshot != nil {
+		value := protoreflect.ValueOfMessage(x.Snapshot.ProtoReflect())
+		if !f(fd_QueryMempoolSnapshotResponse_snapshot, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryMempoolSnapshotResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.QueryMempoolSnapshotResponse.snapshot":
+		return x.Snapshot != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.QueryMempoolSnapshotResponse"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.QueryMempoolSnapshotResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryMempoolSnapshotResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.QueryMempoolSnapshotResponse.snapshot":
+		x.Snapshot = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.QueryMempoolSnapshotResponse"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.QueryMempoolSnapshotResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryMempoolSnapshotResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.abcipp.mempool.v1.QueryMempoolSnapshotResponse.snapshot":
+		value := x.Snapshot
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.QueryMempoolSnapshotResponse"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.QueryMempoolSnapshotResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryMempoolSnapshotResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.QueryMempoolSnapshotResponse.snapshot":
+		x.Snapshot = value.Message().Interface().(*MempoolSnapshot)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.QueryMempoolSnapshotResponse"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.QueryMempoolSnapshotResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryMempoolSnapshotResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.QueryMempoolSnapshotResponse.snapshot":
+		if x.Snapshot == nil {
+			x.Snapshot = new(MempoolSnapshot)
+		}
+		return protoreflect.ValueOfMessage(x.Snapshot.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.QueryMempoolSnapshotResponse"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.QueryMempoolSnapshotResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryMempoolSnapshotResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.QueryMempoolSnapshotResponse.snapshot":
+		m := new(MempoolSnapshot)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.QueryMempoolSnapshotResponse"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.QueryMempoolSnapshotResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryMempoolSnapshotResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.abcipp.mempool.v1.QueryMempoolSnapshotResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryMempoolSnapshotResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryMempoolSnapshotResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryMempoolSnapshotResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryMempoolSnapshotResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryMempoolSnapshotResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Snapshot != nil {
+			l = options.Size(x.Snapshot)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryMempoolSnapshotResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Snapshot != nil {
+			encoded, err := options.Marshal(x.Snapshot)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryMempoolSnapshotResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryMempoolSnapshotResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryMempoolSnapshotResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Snapshot == nil {
+					x.Snapshot = &MempoolSnapshot{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Snapshot); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_SubscribeMempoolEventsRequest_1_list)(nil)
+
+type _SubscribeMempoolEventsRequest_1_list struct {
+	list *[]string
+}
+
+func (x *_SubscribeMempoolEventsRequest_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_SubscribeMempoolEventsRequest_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_SubscribeMempoolEventsRequest_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_SubscribeMempoolEventsRequest_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_SubscribeMempoolEventsRequest_1_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message SubscribeMempoolEventsRequest at list field Senders as it is not of Message kind"))
+}
+
+func (x *_SubscribeMempoolEventsRequest_1_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_SubscribeMempoolEventsRequest_1_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_SubscribeMempoolEventsRequest_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_SubscribeMempoolEventsRequest_2_list)(nil)
+
+type _SubscribeMempoolEventsRequest_2_list struct {
+	list *[]string
+}
+
+func (x *_SubscribeMempoolEventsRequest_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_SubscribeMempoolEventsRequest_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_SubscribeMempoolEventsRequest_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_SubscribeMempoolEventsRequest_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_SubscribeMempoolEventsRequest_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message SubscribeMempoolEventsRequest at list field Tiers as it is not of Message kind"))
+}
+
+func (x *_SubscribeMempoolEventsRequest_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_SubscribeMempoolEventsRequest_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_SubscribeMempoolEventsRequest_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_SubscribeMempoolEventsRequest         protoreflect.MessageDescriptor
+	fd_SubscribeMempoolEventsRequest_senders protoreflect.FieldDescriptor
+	fd_SubscribeMempoolEventsRequest_tiers   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_abcipp_mempool_v1_query_proto_init()
+	md_SubscribeMempoolEventsRequest = File_initia_abcipp_mempool_v1_query_proto.Messages().ByName("SubscribeMempoolEventsRequest")
+	fd_SubscribeMempoolEventsRequest_senders = md_SubscribeMempoolEventsRequest.Fields().ByName("senders")
+	fd_SubscribeMempoolEventsRequest_tiers = md_SubscribeMempoolEventsRequest.Fields().ByName("tiers")
+}
+
+var _ protoreflect.Message = (*fastReflection_SubscribeMempoolEventsRequest)(nil)
+
+type fastReflection_SubscribeMempoolEventsRequest SubscribeMempoolEventsRequest
+
+func (x *SubscribeMempoolEventsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SubscribeMempoolEventsRequest)(x)
+}
+
+func (x *SubscribeMempoolEventsRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_abcipp_mempool_v1_query_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SubscribeMempoolEventsRequest_messageType fastReflection_SubscribeMempoolEventsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_SubscribeMempoolEventsRequest_messageType{}
+
+type fastReflection_SubscribeMempoolEventsRequest_messageType struct{}
+
+func (x fastReflection_SubscribeMempoolEventsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SubscribeMempoolEventsRequest)(nil)
+}
+func (x fastReflection_SubscribeMempoolEventsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_SubscribeMempoolEventsRequest)
+}
+func (x fastReflection_SubscribeMempoolEventsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SubscribeMempoolEventsRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SubscribeMempoolEventsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_SubscribeMempoolEventsRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SubscribeMempoolEventsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_SubscribeMempoolEventsRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SubscribeMempoolEventsRequest) New() protoreflect.Message {
+	return new(fastReflection_SubscribeMempoolEventsRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SubscribeMempoolEventsRequest) Interface() protoreflect.ProtoMessage {
+	return (*SubscribeMempoolEventsRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SubscribeMempoolEventsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Senders) != 0 {
+		value := protoreflect.ValueOfList(&_SubscribeMempoolEventsRequest_1_list{list: &x.Senders})
+		if !f(fd_SubscribeMempoolEventsRequest_senders, value) {
+			return
+		}
+	}
+	if len(x.Tiers) != 0 {
+		value := protoreflect.ValueOfList(&_SubscribeMempoolEventsRequest_2_list{list: &x.Tiers})
+		if !f(fd_SubscribeMempoolEventsRequest_tiers, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SubscribeMempoolEventsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.SubscribeMempoolEventsRequest.senders":
+		return len(x.Senders) != 0
+	case "initia.abcipp.mempool.v1.SubscribeMempoolEventsRequest.tiers":
+		return len(x.Tiers) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.SubscribeMempoolEventsRequest"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.SubscribeMempoolEventsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SubscribeMempoolEventsRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.SubscribeMempoolEventsRequest.senders":
+		x.Senders = nil
+	case "initia.abcipp.mempool.v1.SubscribeMempoolEventsRequest.tiers":
+		x.Tiers = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.SubscribeMempoolEventsRequest"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.SubscribeMempoolEventsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SubscribeMempoolEventsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.abcipp.mempool.v1.SubscribeMempoolEventsRequest.senders":
+		if len(x.Senders) == 0 {
+			return protoreflect.ValueOfList(&_SubscribeMempoolEventsRequest_1_list{})
+		}
+		listValue := &_SubscribeMempoolEventsRequest_1_list{list: &x.Senders}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.abcipp.mempool.v1.SubscribeMempoolEventsRequest.tiers":
+		if len(x.Tiers) == 0 {
+			return protoreflect.ValueOfList(&_SubscribeMempoolEventsRequest_2_list{})
+		}
+		listValue := &_SubscribeMempoolEventsRequest_2_list{list: &x.Tiers}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.SubscribeMempoolEventsRequest"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.SubscribeMempoolEventsRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SubscribeMempoolEventsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.SubscribeMempoolEventsRequest.senders":
+		lv := value.List()
+		clv := lv.(*_SubscribeMempoolEventsRequest_1_list)
+		x.Senders = *clv.list
+	case "initia.abcipp.mempool.v1.SubscribeMempoolEventsRequest.tiers":
+		lv := value.List()
+		clv := lv.(*_SubscribeMempoolEventsRequest_2_list)
+		x.Tiers = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.SubscribeMempoolEventsRequest"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.SubscribeMempoolEventsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SubscribeMempoolEventsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.SubscribeMempoolEventsRequest.senders":
+		if x.Senders == nil {
+			x.Senders = []string{}
+		}
+		value := &_SubscribeMempoolEventsRequest_1_list{list: &x.Senders}
+		return protoreflect.ValueOfList(value)
+	case "initia.abcipp.mempool.v1.SubscribeMempoolEventsRequest.tiers":
+		if x.Tiers == nil {
+			x.Tiers = []string{}
+		}
+		value := &_SubscribeMempoolEventsRequest_2_list{list: &x.Tiers}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.SubscribeMempoolEventsRequest"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.SubscribeMempoolEventsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SubscribeMempoolEventsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.SubscribeMempoolEventsRequest.senders":
+		list := []string{}
+		return protoreflect.ValueOfList(&_SubscribeMempoolEventsRequest_1_list{list: &list})
+	case "initia.abcipp.mempool.v1.SubscribeMempoolEventsRequest.tiers":
+		list := []string{}
+		return protoreflect.ValueOfList(&_SubscribeMempoolEventsRequest_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.SubscribeMempoolEventsRequest"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.SubscribeMempoolEventsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SubscribeMempoolEventsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.abcipp.mempool.v1.SubscribeMempoolEventsRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SubscribeMempoolEventsRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SubscribeMempoolEventsRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SubscribeMempoolEventsRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SubscribeMempoolEventsRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SubscribeMempoolEventsRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Senders) > 0 {
+			for _, s := range x.Senders {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Tiers) > 0 {
+			for _, s := range x.Tiers {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SubscribeMempoolEventsRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Tiers) > 0 {
+			for iNdEx := len(x.Tiers) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Tiers[iNdEx])
+				copy(dAtA[i:], x.Tiers[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Tiers[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Senders) > 0 {
+			for iNdEx := len(x.Senders) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Senders[iNdEx])
+				copy(dAtA[i:], x.Senders[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Senders[iNdEx])))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SubscribeMempoolEventsRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SubscribeMempoolEventsRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SubscribeMempoolEventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Senders", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Senders = append(x.Senders, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tiers", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Tiers = append(x.Tiers, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MempoolEvent          protoreflect.MessageDescriptor
+	fd_MempoolEvent_type     protoreflect.FieldDescriptor
+	fd_MempoolEvent_sender   protoreflect.FieldDescriptor
+	fd_MempoolEvent_sequence protoreflect.FieldDescriptor
+	fd_MempoolEvent_tier     protoreflect.FieldDescriptor
+	fd_MempoolEvent_tx_hash  protoreflect.FieldDescriptor
+	fd_MempoolEvent_reason   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_abcipp_mempool_v1_query_proto_init()
+	md_MempoolEvent = File_initia_abcipp_mempool_v1_query_proto.Messages().ByName("MempoolEvent")
+	fd_MempoolEvent_type = md_MempoolEvent.Fields().ByName("type")
+	fd_MempoolEvent_sender = md_MempoolEvent.Fields().ByName("sender")
+	fd_MempoolEvent_sequence = md_MempoolEvent.Fields().ByName("sequence")
+	fd_MempoolEvent_tier = md_MempoolEvent.Fields().ByName("tier")
+	fd_MempoolEvent_tx_hash = md_MempoolEvent.Fields().ByName("tx_hash")
+	fd_MempoolEvent_reason = md_MempoolEvent.Fields().ByName("reason")
+}
+
+var _ protoreflect.Message = (*fastReflection_MempoolEvent)(nil)
+
+type fastReflection_MempoolEvent MempoolEvent
+
+func (x *MempoolEvent) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MempoolEvent)(x)
+}
+
+func (x *MempoolEvent) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_abcipp_mempool_v1_query_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MempoolEvent_messageType fastReflection_MempoolEvent_messageType
+var _ protoreflect.MessageType = fastReflection_MempoolEvent_messageType{}
+
+type fastReflection_MempoolEvent_messageType struct{}
+
+func (x fastReflection_MempoolEvent_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MempoolEvent)(nil)
+}
+func (x fastReflection_MempoolEvent_messageType) New() protoreflect.Message {
+	return new(fastReflection_MempoolEvent)
+}
+func (x fastReflection_MempoolEvent_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MempoolEvent
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MempoolEvent) Descriptor() protoreflect.MessageDescriptor {
+	return md_MempoolEvent
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MempoolEvent) Type() protoreflect.MessageType {
+	return _fastReflection_MempoolEvent_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MempoolEvent) New() protoreflect.Message {
+	return new(fastReflection_MempoolEvent)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MempoolEvent) Interface() protoreflect.ProtoMessage {
+	return (*MempoolEvent)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MempoolEvent) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Type_ != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Type_))
+		if !f(fd_MempoolEvent_type, value) {
+			return
+		}
+	}
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_MempoolEvent_sender, value) {
+			return
+		}
+	}
+	if x.Sequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Sequence)
+		if !f(fd_MempoolEvent_sequence, value) {
+			return
+		}
+	}
+	if x.Tier != "" {
+		value := protoreflect.ValueOfString(x.Tier)
+		if !f(fd_MempoolEvent_tier, value) {
+			return
+		}
+	}
+	if x.TxHash != "" {
+		value := protoreflect.ValueOfString(x.TxHash)
+		if !f(fd_MempoolEvent_tx_hash, value) {
+			return
+		}
+	}
+	if x.Reason != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Reason))
+		if !f(fd_MempoolEvent_reason, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MempoolEvent) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.MempoolEvent.type":
+		return x.Type_ != 0
+	case "initia.abcipp.mempool.v1.MempoolEvent.sender":
+		return x.Sender != ""
+	case "initia.abcipp.mempool.v1.MempoolEvent.sequence":
+		return x.Sequence != uint64(0)
+	case "initia.abcipp.mempool.v1.MempoolEvent.tier":
+		return x.Tier != ""
+	case "initia.abcipp.mempool.v1.MempoolEvent.tx_hash":
+		return x.TxHash != ""
+	case "initia.abcipp.mempool.v1.MempoolEvent.reason":
+		return x.Reason != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.MempoolEvent"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.MempoolEvent does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MempoolEvent) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.MempoolEvent.type":
+		x.Type_ = 0
+	case "initia.abcipp.mempool.v1.MempoolEvent.sender":
+		x.Sender = ""
+	case "initia.abcipp.mempool.v1.MempoolEvent.sequence":
+		x.Sequence = uint64(0)
+	case "initia.abcipp.mempool.v1.MempoolEvent.tier":
+		x.Tier = ""
+	case "initia.abcipp.mempool.v1.MempoolEvent.tx_hash":
+		x.TxHash = ""
+	case "initia.abcipp.mempool.v1.MempoolEvent.reason":
+		x.Reason = 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.MempoolEvent"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.MempoolEvent does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MempoolEvent) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.abcipp.mempool.v1.MempoolEvent.type":
+		value := x.Type_
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "initia.abcipp.mempool.v1.MempoolEvent.sender":
+		value := x.Sender
+		return protoreflect.ValueOfString(value)
+	case "initia.abcipp.mempool.v1.MempoolEvent.sequence":
+		value := x.Sequence
+		return protoreflect.ValueOfUint64(value)
+	case "initia.abcipp.mempool.v1.MempoolEvent.tier":
+		value := x.Tier
+		return protoreflect.ValueOfString(value)
+	case "initia.abcipp.mempool.v1.MempoolEvent.tx_hash":
+		value := x.TxHash
+		return protoreflect.ValueOfString(value)
+	case "initia.abcipp.mempool.v1.MempoolEvent.reason":
+		value := x.Reason
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.MempoolEvent"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.MempoolEvent does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MempoolEvent) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.MempoolEvent.type":
+		x.Type_ = (MempoolEventType)(value.Enum())
+	case "initia.abcipp.mempool.v1.MempoolEvent.sender":
+		x.Sender = value.Interface().(string)
+	case "initia.abcipp.mempool.v1.MempoolEvent.sequence":
+		x.Sequence = value.Uint()
+	case "initia.abcipp.mempool.v1.MempoolEvent.tier":
+		x.Tier = value.Interface().(string)
+	case "initia.abcipp.mempool.v1.MempoolEvent.tx_hash":
+		x.TxHash = value.Interface().(string)
+	case "initia.abcipp.mempool.v1.MempoolEvent.reason":
+		x.Reason = (RemovalReason)(value.Enum())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.MempoolEvent"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.MempoolEvent does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MempoolEvent) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.MempoolEvent.type":
+		panic(fmt.Errorf("field type of message initia.abcipp.mempool.v1.MempoolEvent is not mutable"))
+	case "initia.abcipp.mempool.v1.MempoolEvent.sender":
+		panic(fmt.Errorf("field sender of message initia.abcipp.mempool.v1.MempoolEvent is not mutable"))
+	case "initia.abcipp.mempool.v1.MempoolEvent.sequence":
+		panic(fmt.Errorf("field sequence of message initia.abcipp.mempool.v1.MempoolEvent is not mutable"))
+	case "initia.abcipp.mempool.v1.MempoolEvent.tier":
+		panic(fmt.Errorf("field tier of message initia.abcipp.mempool.v1.MempoolEvent is not mutable"))
+	case "initia.abcipp.mempool.v1.MempoolEvent.tx_hash":
+		panic(fmt.Errorf("field tx_hash of message initia.abcipp.mempool.v1.MempoolEvent is not mutable"))
+	case "initia.abcipp.mempool.v1.MempoolEvent.reason":
+		panic(fmt.Errorf("field reason of message initia.abcipp.mempool.v1.MempoolEvent is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.MempoolEvent"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.MempoolEvent does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MempoolEvent) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.abcipp.mempool.v1.MempoolEvent.type":
+		return protoreflect.ValueOfEnum(0)
+	case "initia.abcipp.mempool.v1.MempoolEvent.sender":
+		return protoreflect.ValueOfString("")
+	case "initia.abcipp.mempool.v1.MempoolEvent.sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "initia.abcipp.mempool.v1.MempoolEvent.tier":
+		return protoreflect.ValueOfString("")
+	case "initia.abcipp.mempool.v1.MempoolEvent.tx_hash":
+		return protoreflect.ValueOfString("")
+	case "initia.abcipp.mempool.v1.MempoolEvent.reason":
+		return protoreflect.ValueOfEnum(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.abcipp.mempool.v1.MempoolEvent"))
+		}
+		panic(fmt.Errorf("message initia.abcipp.mempool.v1.MempoolEvent does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MempoolEvent) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.abcipp.mempool.v1.MempoolEvent", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MempoolEvent) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MempoolEvent) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MempoolEvent) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MempoolEvent) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MempoolEvent)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Type_ != 0 {
+			n += 1 + runtime.Sov(uint64(x.Type_))
+		}
+		l = len(x.Sender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Sequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.Sequence))
+		}
+		l = len(x.Tier)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TxHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Reason != 0 {
+			n += 1 + runtime.Sov(uint64(x.Reason))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MempoolEvent)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Reason != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Reason))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.TxHash) > 0 {
+			i -= len(x.TxHash)
+			copy(dAtA[i:], x.TxHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TxHash)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Tier) > 0 {
+			i -= len(x.Tier)
+			copy(dAtA[i:], x.Tier)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Tier)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Sequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Sequence))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Type_ != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Type_))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MempoolEvent)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MempoolEvent: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MempoolEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Type_", wireType)
+				}
+				x.Type_ = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Type_ |= MempoolEventType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				}
+				x.Sequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Sequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tier", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Tier = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TxHash = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				x.Reason = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Reason |= RemovalReason(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
 // 	protoc-gen-go v1.27.0
 // 	protoc        (unknown)
 // source: initia/abcipp/mempool/v1/query.proto
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// MempoolEventType is the kind of mempool state change.
+type MempoolEventType int32
+
+const (
+	MempoolEventType_MEMPOOL_EVENT_TYPE_UNSPECIFIED MempoolEventType = 0
+	// A tx entered the active set directly.
+	MempoolEventType_MEMPOOL_EVENT_TYPE_INSERTED MempoolEventType = 1
+	// A future-nonce tx entered the queued pool.
+	MempoolEventType_MEMPOOL_EVENT_TYPE_QUEUED MempoolEventType = 2
+	// A queued tx was promoted to the active set.
+	MempoolEventType_MEMPOOL_EVENT_TYPE_PROMOTED MempoolEventType = 3
+	// A tx was deleted from the mempool.
+	MempoolEventType_MEMPOOL_EVENT_TYPE_REMOVED MempoolEventType = 4
+)
+
+// Enum value maps for MempoolEventType.
+var (
+	MempoolEventType_name = map[int32]string{
+		0: "MEMPOOL_EVENT_TYPE_UNSPECIFIED",
+		1: "MEMPOOL_EVENT_TYPE_INSERTED",
+		2: "MEMPOOL_EVENT_TYPE_QUEUED",
+		3: "MEMPOOL_EVENT_TYPE_PROMOTED",
+		4: "MEMPOOL_EVENT_TYPE_REMOVED",
+	}
+	MempoolEventType_value = map[string]int32{
+		"MEMPOOL_EVENT_TYPE_UNSPECIFIED": 0,
+		"MEMPOOL_EVENT_TYPE_INSERTED":    1,
+		"MEMPOOL_EVENT_TYPE_QUEUED":      2,
+		"MEMPOOL_EVENT_TYPE_PROMOTED":    3,
+		"MEMPOOL_EVENT_TYPE_REMOVED":     4,
+	}
+)
+
+func (x MempoolEventType) Enum() *MempoolEventType {
+	p := new(MempoolEventType)
+	*p = x
+	return p
+}
+
+func (x MempoolEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MempoolEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_initia_abcipp_mempool_v1_query_proto_enumTypes[0].Descriptor()
+}
+
+func (MempoolEventType) Type() protoreflect.EnumType {
+	return &file_initia_abcipp_mempool_v1_query_proto_enumTypes[0]
+}
+
+func (x MempoolEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MempoolEventType.Descriptor instead.
+func (MempoolEventType) EnumDescriptor() ([]byte, []int) {
+	return file_initia_abcipp_mempool_v1_query_proto_rawDescGZIP(), []int{0}
+}
+
+// RemovalReason is the reason a tx was deleted from the mempool.
+type RemovalReason int32
+
+const (
+	RemovalReason_REMOVAL_REASON_UNSPECIFIED              RemovalReason = 0
+	RemovalReason_REMOVAL_REASON_CAPACITY_EVICTED         RemovalReason = 1
+	RemovalReason_REMOVAL_REASON_COMMITTED_IN_BLOCK       RemovalReason = 2
+	RemovalReason_REMOVAL_REASON_ANTE_REJECTED_IN_PREPARE RemovalReason = 3
+	RemovalReason_REMOVAL_REASON_REPLACED                 RemovalReason = 4
+	RemovalReason_REMOVAL_REASON_STALE                    RemovalReason = 5
+	RemovalReason_REMOVAL_REASON_QUEUED_GAP_EXPIRED       RemovalReason = 6
+)
+
+// Enum value maps for RemovalReason.
+var (
+	RemovalReason_name = map[int32]string{
+		0: "REMOVAL_REASON_UNSPECIFIED",
+		1: "REMOVAL_REASON_CAPACITY_EVICTED",
+		2: "REMOVAL_REASON_COMMITTED_IN_BLOCK",
+		3: "REMOVAL_REASON_ANTE_REJECTED_IN_PREPARE",
+		4: "REMOVAL_REASON_REPLACED",
+		5: "REMOVAL_REASON_STALE",
+		6: "REMOVAL_REASON_QUEUED_GAP_EXPIRED",
+	}
+	RemovalReason_value = map[string]int32{
+		"REMOVAL_REASON_UNSPECIFIED":              0,
+		"REMOVAL_REASON_CAPACITY_EVICTED":         1,
+		"REMOVAL_REASON_COMMITTED_IN_BLOCK":       2,
+		"REMOVAL_REASON_ANTE_REJECTED_IN_PREPARE": 3,
+		"REMOVAL_REASON_REPLACED":                 4,
+		"REMOVAL_REASON_STALE":                    5,
+		"REMOVAL_REASON_QUEUED_GAP_EXPIRED":       6,
+	}
+)
+
+func (x RemovalReason) Enum() *RemovalReason {
+	p := new(RemovalReason)
+	*p = x
+	return p
+}
+
+func (x RemovalReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RemovalReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_initia_abcipp_mempool_v1_query_proto_enumTypes[1].Descriptor()
+}
+
+func (RemovalReason) Type() protoreflect.EnumType {
+	return &file_initia_abcipp_mempool_v1_query_proto_enumTypes[1]
+}
+
+func (x RemovalReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RemovalReason.Descriptor instead.
+func (RemovalReason) EnumDescriptor() ([]byte, []int) {
+	return file_initia_abcipp_mempool_v1_query_proto_rawDescGZIP(), []int{1}
+}
+
+// QueryTxDistributionRequest is the request type for the Query.QueryTxDistribution
+// RPC method.
+type QueryTxDistributionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QueryTxDistributionRequest) Reset() {
+	*x = QueryTxDistributionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_abcipp_mempool_v1_query_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryTxDistributionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryTxDistributionRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryTxDistributionRequest.ProtoReflect.Descriptor instead.
+func (*QueryTxDistributionRequest) Descriptor() ([]byte, []int) {
+	return file_initia_abcipp_mempool_v1_query_proto_rawDescGZIP(), []int{0}
+}
+
+// QueryTxDistributionResponse is the response type for the Query.QueryTxDistribution
+// RPC method.
+type QueryTxDistributionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Distribution is a map of lane to the number of transactions in the mempool for that lane.
+	Distribution map[string]uint64 `protobuf:"bytes,1,rep,name=distribution,proto3" json:"distribution,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *QueryTxDistributionResponse) Reset() {
+	*x = QueryTxDistributionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_abcipp_mempool_v1_query_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryTxDistributionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryTxDistributionResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryTxDistributionResponse.ProtoReflect.Descriptor instead.
+func (*QueryTxDistributionResponse) Descriptor() ([]byte, []int) {
+	return file_initia_abcipp_mempool_v1_query_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *QueryTxDistributionResponse) GetDistribution() map[string]uint64 {
+	if x != nil {
+		return x.Distribution
+	}
+	return nil
+}
+
+// QueryTxHashRequest is the request type for the Query.QueryTxHash
+// RPC method.
+type QueryTxHashRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sender   string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Sequence string `protobuf:"bytes,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (x *QueryTxHashRequest) Reset() {
+	*x = QueryTxHashRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_abcipp_mempool_v1_query_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryTxHashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryTxHashRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryTxHashRequest.ProtoReflect.Descriptor instead.
+func (*QueryTxHashRequest) Descriptor() ([]byte, []int) {
+	return file_initia_abcipp_mempool_v1_query_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *QueryTxHashRequest) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *QueryTxHashRequest) GetSequence() string {
+	if x != nil {
+		return x.Sequence
+	}
+	return ""
+}
+
+// QueryTxHashResponse is the response type for the Query.QueryTxHash
+// RPC method.
+type QueryTxHashResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxHash string `protobuf:"bytes,1,opt,name=txHash,proto3" json:"txHash,omitempty"`
+}
+
+func (x *QueryTxHashResponse) Reset() {
+	*x = QueryTxHashResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_abcipp_mempool_v1_query_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryTxHashResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryTxHashResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryTxHashResponse.ProtoReflect.Descriptor instead.
+func (*QueryTxHashResponse) Descriptor() ([]byte, []int) {
+	return file_initia_abcipp_mempool_v1_query_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *QueryTxHashResponse) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+// MempoolTx describes a single transaction held by the mempool.
+type MempoolTx struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sender   string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	TxHash   string `protobuf:"bytes,3,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// tier is the tier name of an active tx, or "queued" for a queued tx.
+	Tier     string `protobuf:"bytes,4,opt,name=tier,proto3" json:"tier,omitempty"`
+	Priority int64  `protobuf:"varint,5,opt,name=priority,proto3" json:"priority,omitempty"`
+	// clamped_priority is the sender-clamped rank used for active ordering.
+	// It is zero for queued txs.
+	ClampedPriority int64  `protobuf:"varint,6,opt,name=clamped_priority,json=clampedPriority,proto3" json:"clamped_priority,omitempty"`
+	GasLimit        uint64 `protobuf:"varint,7,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	TxSize          int64  `protobuf:"varint,8,opt,name=tx_size,json=txSize,proto3" json:"tx_size,omitempty"`
+}
+
+func (x *MempoolTx) Reset() {
+	*x = MempoolTx{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_abcipp_mempool_v1_query_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MempoolTx) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MempoolTx) ProtoMessage() {}
+
+// Deprecated: Use MempoolTx.ProtoReflect.Descriptor instead.
+func (*MempoolTx) Descriptor() ([]byte, []int) {
+	return file_initia_abcipp_mempool_v1_query_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MempoolTx) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *MempoolTx) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *MempoolTx) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *MempoolTx) GetTier() string {
+	if x != nil {
+		return x.Tier
+	}
+	return ""
+}
+
+func (x *MempoolTx) GetPriority() int64 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *MempoolTx) GetClampedPriority() int64 {
+	if x != nil {
+		return x.ClampedPriority
+	}
+	return 0
+}
+
+func (x *MempoolTx) GetGasLimit() uint64 {
+	if x != nil {
+		return x.GasLimit
+	}
+	return 0
+}
+
+func (x *MempoolTx) GetTxSize() int64 {
+	if x != nil {
+		return x.TxSize
+	}
+	return 0
+}
+
+// QuerySenderTxsRequest is the request type for the Query.QuerySenderTxs
+// RPC method.
+type QuerySenderTxsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// sender accepts either a bech32 or a 0x-prefixed hex address.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (x *QuerySenderTxsRequest) Reset() {
+	*x = QuerySenderTxsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_abcipp_mempool_v1_query_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuerySenderTxsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuerySenderTxsRequest) ProtoMessage() {}
+
+// Deprecated: Use QuerySenderTxsRequest.ProtoReflect.Descriptor instead.
+func (*QuerySenderTxsRequest) Descriptor() ([]byte, []int) {
+	return file_initia_abcipp_mempool_v1_query_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *QuerySenderTxsRequest) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+// QuerySenderTxsResponse is the response type for the Query.QuerySenderTxs
+// RPC method.
+type QuerySenderTxsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// tracked is false when the mempool holds no state for the sender.
+	Tracked bool `protobuf:"varint,1,opt,name=tracked,proto3" json:"tracked,omitempty"`
+	// next_expected_sequence is the sequence the mempool expects next from the sender.
+	NextExpectedSequence uint64       `protobuf:"varint,2,opt,name=next_expected_sequence,json=nextExpectedSequence,proto3" json:"next_expected_sequence,omitempty"`
+	Active               []*MempoolTx `protobuf:"bytes,3,rep,name=active,proto3" json:"active,omitempty"`
+	Queued               []*MempoolTx `protobuf:"bytes,4,rep,name=queued,proto3" json:"queued,omitempty"`
+	// blocked is true when queued txs exist but cannot be promoted because
+	// missing_sequence has not been received yet.
+	Blocked         bool   `protobuf:"varint,5,opt,name=blocked,proto3" json:"blocked,omitempty"`
+	MissingSequence uint64 `protobuf:"varint,6,opt,name=missing_sequence,json=missingSequence,proto3" json:"missing_sequence,omitempty"`
+}
+
+func (x *QuerySenderTxsResponse) Reset() {
+	*x = QuerySenderTxsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_abcipp_mempool_v1_query_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuerySenderTxsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuerySenderTxsResponse) ProtoMessage() {}
+
+// Deprecated: Use QuerySenderTxsResponse.ProtoReflect.Descriptor instead.
+func (*QuerySenderTxsResponse) Descriptor() ([]byte, []int) {
+	return file_initia_abcipp_mempool_v1_query_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *QuerySenderTxsResponse) GetTracked() bool {
+	if x != nil {
+		return x.Tracked
+	}
+	return false
+}
+
+func (x *QuerySenderTxsResponse) GetNextExpectedSequence() uint64 {
+	if x != nil {
+		return x.NextExpectedSequence
+	}
+	return 0
+}
+
+func (x *QuerySenderTxsResponse) GetActive() []*MempoolTx {
+	if x != nil {
+		return x.Active
+	}
+	return nil
+}
+
+func (x *QuerySenderTxsResponse) GetQueued() []*MempoolTx {
+	if x != nil {
+		return x.Queued
+	}
+	return nil
+}
+
+func (x *QuerySenderTxsResponse) GetBlocked() bool {
+	if x != nil {
+		return x.Blocked
+	}
+	return false
+}
+
+func (x *QuerySenderTxsResponse) GetMissingSequence() uint64 {
+	if x != nil {
+		return x.MissingSequence
+	}
+	return 0
+}
+
+// QueryTierTxsRequest is the request type for the Query.QueryTierTxs
+// RPC method.
+type QueryTierTxsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tier       string               `protobuf:"bytes,1,opt,name=tier,proto3" json:"tier,omitempty"`
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryTierTxsRequest) Reset() {
+	*x = QueryTierTxsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_abcipp_mempool_v1_query_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryTierTxsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryTierTxsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryTierTxsRequest.ProtoReflect.Descriptor instead.
+func (*QueryTierTxsRequest) Descriptor() ([]byte, []int) {
+	return file_initia_abcipp_mempool_v1_query_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *QueryTierTxsRequest) GetTier() string {
+	if x != nil {
+		return x.Tier
+	}
+	return ""
+}
+
+func (x *QueryTierTxsRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryTierTxsResponse is the response type for the Query.QueryTierTxs
+// RPC method.
+type QueryTierTxsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Txs        []*MempoolTx          `protobuf:"bytes,1,rep,name=txs,proto3" json:"txs,omitempty"`
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryTierTxsResponse) Reset() {
+	*x = QueryTierTxsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_abcipp_mempool_v1_query_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryTierTxsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryTierTxsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryTierTxsResponse.ProtoReflect.Descriptor instead.
+func (*QueryTierTxsResponse) Descriptor() ([]byte, []int) {
+	return file_initia_abcipp_mempool_v1_query_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *QueryTierTxsResponse) GetTxs() []*MempoolTx {
+	if x != nil {
+		return x.Txs
+	}
+	return nil
+}
+
+func (x *QueryTierTxsResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// SenderReputation is the admission state of a sender.
+type SenderReputation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// score is the decayed penalty score.
+	Score float64 `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	// throttled is true when the sender may only keep a limited number of pending txs.
+	Throttled bool `protobuf:"varint,3,opt,name=throttled,proto3" json:"throttled,omitempty"`
+	// banned is true while the sender is refused by the mempool.
+	Banned bool `protobuf:"varint,4,opt,name=banned,proto3" json:"banned,omitempty"`
+	// banned_until is set while the sender is banned.
+	BannedUntil *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=banned_until,json=bannedUntil,proto3" json:"banned_until,omitempty"`
+}
+
+func (x *SenderReputation) Reset() {
+	*x = SenderReputation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_abcipp_mempool_v1_query_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SenderReputation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SenderReputation) ProtoMessage() {}
+
+// Deprecated: Use SenderReputation.ProtoReflect.Descriptor instead.
+func (*SenderReputation) Descriptor() ([]byte, []int) {
+	return file_initia_abcipp_mempool_v1_query_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SenderReputation) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *SenderReputation) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *SenderReputation) GetThrottled() bool {
+	if x != nil {
+		return x.Throttled
+	}
+	return false
+}
+
+func (x *SenderReputation) GetBanned() bool {
+	if x != nil {
+		return x.Banned
+	}
+	return false
+}
+
+func (x *SenderReputation) GetBannedUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BannedUntil
+	}
+	return nil
+}
+
+// QuerySenderReputationsRequest is the request type for the
+// Query.QuerySenderReputations RPC method.
+type QuerySenderReputationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// banned_only restricts the result to currently banned senders.
+	BannedOnly bool `protobuf:"varint,1,opt,name=banned_only,json=bannedOnly,proto3" json:"banned_only,omitempty"`
+}
+
+func (x *QuerySenderReputationsRequest) Reset() {
+	*x = QuerySenderReputationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_abcipp_mempool_v1_query_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuerySenderReputationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuerySenderReputationsRequest) ProtoMessage() {}
+
+// Deprecated: Use QuerySenderReputationsRequest.ProtoReflect.Descriptor instead.
+func (*QuerySenderReputationsRequest) Descriptor() ([]byte, []int) {
+	return file_initia_abcipp_mempool_v1_query_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *QuerySenderReputationsRequest) GetBannedOnly() bool {
+	if x != nil {
+		return x.BannedOnly
+	}
+	return false
+}
+
+// QuerySenderReputationsResponse is the response type for the
+// Query.QuerySenderReputations RPC method.
+type QuerySenderReputationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// reputations is empty when sender scoring is disabled on this node.
+	Reputations []*SenderReputation `protobuf:"bytes,1,rep,name=reputations,proto3" json:"reputations,omitempty"`
+}
+
+func (x *QuerySenderReputationsResponse) Reset() {
+	*x = QuerySenderReputationsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_abcipp_mempool_v1_query_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuerySenderReputationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuerySenderReputationsResponse) ProtoMessage() {}
+
+// Deprecated: Use QuerySenderReputationsResponse.ProtoReflect.Descriptor instead.
+func (*QuerySenderReputationsResponse) Descriptor() ([]byte, []int) {
+	return file_initia_abcipp_mempool_v1_query_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *QuerySenderReputationsResponse) GetReputations() []*SenderReputation {
+	if x != nil {
+		return x.Reputations
+	}
+	return nil
+}
+
+// MempoolSnapshotSender is the nonce bookkeeping of a single sender.
+type MempoolSnapshotSender struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// on_chain_seq is the cached on-chain sequence of the sender.
+	OnChainSeq uint64 `protobuf:"varint,2,opt,name=on_chain_seq,json=onChainSeq,proto3" json:"on_chain_seq,omitempty"`
+	// active_min and active_max bound the active nonces; both are zero when
+	// the sender has no active txs.
+	ActiveMin uint64 `protobuf:"varint,3,opt,name=active_min,json=activeMin,proto3" json:"active_min,omitempty"`
+	ActiveMax uint64 `protobuf:"varint,4,opt,name=active_max,json=activeMax,proto3" json:"active_max,omitempty"`
+	// queued_min and queued_max bound the queued nonces; both are zero when
+	// the sender has no queued txs.
+	QueuedMin uint64 `protobuf:"varint,5,opt,name=queued_min,json=queuedMin,proto3" json:"queued_min,omitempty"`
+	QueuedMax uint64 `protobuf:"varint,6,opt,name=queued_max,json=queuedMax,proto3" json:"queued_max,omitempty"`
+	// gap_since is when the sender became blocked on a missing nonce, if any.
+	GapSince *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=gap_since,json=gapSince,proto3" json:"gap_since,omitempty"`
+}
+
+func (x *MempoolSnapshotSender) Reset() {
+	*x = MempoolSnapshotSender{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_abcipp_mempool_v1_query_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MempoolSnapshotSender) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MempoolSnapshotSender) ProtoMessage() {}
+
+// Deprecated: Use MempoolSnapshotSender.ProtoReflect.Descriptor instead.
+func (*MempoolSnapshotSender) Descriptor() ([]byte, []int) {
+	return file_initia_abcipp_mempool_v1_query_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MempoolSnapshotSender) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *MempoolSnapshotSender) GetOnChainSeq() uint64 {
+	if x != nil {
+		return x.OnChainSeq
+	}
+	return 0
+}
+
+func (x *MempoolSnapshotSender) GetActiveMin() uint64 {
+	if x != nil {
+		return x.ActiveMin
+	}
+	return 0
+}
+
+func (x *MempoolSnapshotSender) GetActiveMax() uint64 {
+	if x != nil {
+		return x.ActiveMax
+	}
+	return 0
+}
+
+func (x *MempoolSnapshotSender) GetQueuedMin() uint64 {
+	if x != nil {
+		return x.QueuedMin
+	}
+	return 0
+}
+
+func (x *MempoolSnapshotSender) GetQueuedMax() uint64 {
+	if x != nil {
+		return x.QueuedMax
+	}
+	return 0
+}
+
+func (x *MempoolSnapshotSender) GetGapSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GapSince
+	}
+	return nil
+}
+
+// MempoolSnapshotEntry is a single active or queued tx with its ranking fields.
+type MempoolSnapshotEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sender   string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// tier is the tier name of an active tx, or "queued" for a queued tx.
+	Tier     string `protobuf:"bytes,3,opt,name=tier,proto3" json:"tier,omitempty"`
+	Priority int64  `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
+	// clamped_priority and clamped_order are the sender-clamped rank used for
+	// active ordering. Both are zero for queued txs.
+	ClampedPriority int64 `protobuf:"varint,5,opt,name=clamped_priority,json=clampedPriority,proto3" json:"clamped_priority,omitempty"`
+	ClampedOrder    int64 `protobuf:"varint,6,opt,name=clamped_order,json=clampedOrder,proto3" json:"clamped_order,omitempty"`
+	// order is the insertion order of an active tx.
+	Order    int64  `protobuf:"varint,7,opt,name=order,proto3" json:"order,omitempty"`
+	GasLimit uint64 `protobuf:"varint,8,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	TxSize   int64  `protobuf:"varint,9,opt,name=tx_size,json=txSize,proto3" json:"tx_size,omitempty"`
+	TxBytes  []byte `protobuf:"bytes,10,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+}
+
+func (x *MempoolSnapshotEntry) Reset() {
+	*x = MempoolSnapshotEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_abcipp_mempool_v1_query_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MempoolSnapshotEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MempoolSnapshotEntry) ProtoMessage() {}
+
+// Deprecated: Use MempoolSnapshotEntry.ProtoReflect.Descriptor instead.
+func (*MempoolSnapshotEntry) Descriptor() ([]byte, []int) {
+	return file_initia_abcipp_mempool_v1_query_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *MempoolSnapshotEntry) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *MempoolSnapshotEntry) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *MempoolSnapshotEntry) GetTier() string {
+	if x != nil {
+		return x.Tier
+	}
+	return ""
+}
+
+func (x *MempoolSnapshotEntry) GetPriority() int64 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *MempoolSnapshotEntry) GetClampedPriority() int64 {
+	if x != nil {
+		return x.ClampedPriority
+	}
+	return 0
+}
+
+func (x *MempoolSnapshotEntry) GetClampedOrder() int64 {
+	if x != nil {
+		return x.ClampedOrder
+	}
+	return 0
+}
+
+func (x *MempoolSnapshotEntry) GetOrder() int64 {
+	if x != nil {
+		return x.Order
+	}
+	return 0
+}
+
+func (x *MempoolSnapshotEntry) GetGasLimit() uint64 {
+	if x != nil {
+		return x.GasLimit
+	}
+	return 0
+}
+
+func (x *MempoolSnapshotEntry) GetTxSize() int64 {
+	if x != nil {
+		return x.TxSize
+	}
+	return 0
+}
+
+func (x *MempoolSnapshotEntry) GetTxBytes() []byte {
+	if x != nil {
+		return x.TxBytes
+	}
+	return nil
+}
+
+// MempoolSnapshot is the full state of the mempool at a point in time.
+type MempoolSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// version is bumped whenever the snapshot layout changes.
+	Version uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// tiers are the tier names in match order, ending with the default tier.
+	Tiers []string `protobuf:"bytes,2,rep,name=tiers,proto3" json:"tiers,omitempty"`
+	// order_seq is the last insertion order handed out by the mempool.
+	OrderSeq int64 `protobuf:"varint,3,opt,name=order_seq,json=orderSeq,proto3" json:"order_seq,omitempty"`
+	// senders are ordered by sender address.
+	Senders []*MempoolSnapshotSender `protobuf:"bytes,4,rep,name=senders,proto3" json:"senders,omitempty"`
+	// active txs are ordered as returned by Select.
+	Active []*MempoolSnapshotEntry `protobuf:"bytes,5,rep,name=active,proto3" json:"active,omitempty"`
+	// queued txs are ordered by sender and sequence.
+	Queued []*MempoolSnapshotEntry `protobuf:"bytes,6,rep,name=queued,proto3" json:"queued,omitempty"`
+}
+
+func (x *MempoolSnapshot) Reset() {
+	*x = MempoolSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_abcipp_mempool_v1_query_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MempoolSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MempoolSnapshot) ProtoMessage() {}
+
+// Deprecated: Use MempoolSnapshot.ProtoReflect.Descriptor instead.
+func (*MempoolSnapshot) Descriptor() ([]byte, []int) {
+	return file_initia_abcipp_mempool_v1_query_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *MempoolSnapshot) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *MempoolSnapshot) GetTiers() []string {
+	if x != nil {
+		return x.Tiers
+	}
+	return nil
+}
+
+func (x *MempoolSnapshot) GetOrderSeq() int64 {
+	if x != nil {
+		return x.OrderSeq
+	}
+	return 0
+}
+
+func (x *MempoolSnapshot) GetSenders() []*MempoolSnapshotSender {
+	if x != nil {
+		return x.Senders
+	}
+	return nil
+}
 
-// QueryTxDistributionRequest is the request type for the Query.QueryTxDistribution
-// RPC method.
-type QueryTxDistributionRequest struct {
+func (x *MempoolSnapshot) GetActive() []*MempoolSnapshotEntry {
+	if x != nil {
+		return x.Active
+	}
+	return nil
+}
+
+func (x *MempoolSnapshot) GetQueued() []*MempoolSnapshotEntry {
+	if x != nil {
+		return x.Queued
+	}
+	return nil
+}
+
+// QueryMempoolSnapshotRequest is the request type for the
+// Query.QueryMempoolSnapshot RPC method.
+type QueryMempoolSnapshotRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *QueryTxDistributionRequest) Reset() {
-	*x = QueryTxDistributionRequest{}
+func (x *QueryMempoolSnapshotRequest) Reset() {
+	*x = QueryMempoolSnapshotRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_initia_abcipp_mempool_v1_query_proto_msgTypes[0]
+		mi := &file_initia_abcipp_mempool_v1_query_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryTxDistributionRequest) String() string {
+func (x *QueryMempoolSnapshotRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryTxDistributionRequest) ProtoMessage() {}
+func (*QueryMempoolSnapshotRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryTxDistributionRequest.ProtoReflect.Descriptor instead.
-func (*QueryTxDistributionRequest) Descriptor() ([]byte, []int) {
-	return file_initia_abcipp_mempool_v1_query_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use QueryMempoolSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*QueryMempoolSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_initia_abcipp_mempool_v1_query_proto_rawDescGZIP(), []int{15}
 }
 
-// QueryTxDistributionResponse is the response type for the Query.QueryTxDistribution
-// RPC method.
-type QueryTxDistributionResponse struct {
+// QueryMempoolSnapshotResponse is the response type for the
+// Query.QueryMempoolSnapshot RPC method.
+type QueryMempoolSnapshotResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Distribution is a map of lane to the number of transactions in the mempool for that lane.
-	Distribution map[string]uint64 `protobuf:"bytes,1,rep,name=distribution,proto3" json:"distribution,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Snapshot *MempoolSnapshot `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
 }
 
-func (x *QueryTxDistributionResponse) Reset() {
-	*x = QueryTxDistributionResponse{}
+func (x *QueryMempoolSnapshotResponse) Reset() {
+	*x = QueryMempoolSnapshotResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_initia_abcipp_mempool_v1_query_proto_msgTypes[1]
+		mi := &file_initia_abcipp_mempool_v1_query_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryTxDistributionResponse) String() string {
+func (x *QueryMempoolSnapshotResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryTxDistributionResponse) ProtoMessage() {}
+func (*QueryMempoolSnapshotResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryTxDistributionResponse.ProtoReflect.Descriptor instead.
-func (*QueryTxDistributionResponse) Descriptor() ([]byte, []int) {
-	return file_initia_abcipp_mempool_v1_query_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use QueryMempoolSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*QueryMempoolSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_initia_abcipp_mempool_v1_query_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *QueryTxDistributionResponse) GetDistribution() map[string]uint64 {
+func (x *QueryMempoolSnapshotResponse) GetSnapshot() *MempoolSnapshot {
 	if x != nil {
-		return x.Distribution
+		return x.Snapshot
 	}
 	return nil
 }
 
-// QueryTxHashRequest is the request type for the Query.QueryTxHash
-// RPC method.
-type QueryTxHashRequest struct {
+// SubscribeMempoolEventsRequest is the request type for the
+// Query.SubscribeMempoolEvents RPC method.
+type SubscribeMempoolEventsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Sender   string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	Sequence string `protobuf:"bytes,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// senders restricts events to the given senders (bech32 or 0x-prefixed hex).
+	// Empty matches every sender.
+	Senders []string `protobuf:"bytes,1,rep,name=senders,proto3" json:"senders,omitempty"`
+	// tiers restricts events to the given tier names, including "queued".
+	// Empty matches every tier.
+	Tiers []string `protobuf:"bytes,2,rep,name=tiers,proto3" json:"tiers,omitempty"`
 }
 
-func (x *QueryTxHashRequest) Reset() {
-	*x = QueryTxHashRequest{}
+func (x *SubscribeMempoolEventsRequest) Reset() {
+	*x = SubscribeMempoolEventsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_initia_abcipp_mempool_v1_query_proto_msgTypes[2]
+		mi := &file_initia_abcipp_mempool_v1_query_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryTxHashRequest) String() string {
+func (x *SubscribeMempoolEventsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryTxHashRequest) ProtoMessage() {}
+func (*SubscribeMempoolEventsRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryTxHashRequest.ProtoReflect.Descriptor instead.
-func (*QueryTxHashRequest) Descriptor() ([]byte, []int) {
-	return file_initia_abcipp_mempool_v1_query_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use SubscribeMempoolEventsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeMempoolEventsRequest) Descriptor() ([]byte, []int) {
+	return file_initia_abcipp_mempool_v1_query_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *QueryTxHashRequest) GetSender() string {
+func (x *SubscribeMempoolEventsRequest) GetSenders() []string {
 	if x != nil {
-		return x.Sender
+		return x.Senders
 	}
-	return ""
+	return nil
 }
 
-func (x *QueryTxHashRequest) GetSequence() string {
+func (x *SubscribeMempoolEventsRequest) GetTiers() []string {
 	if x != nil {
-		return x.Sequence
+		return x.Tiers
 	}
-	return ""
+	return nil
 }
 
-// QueryTxHashResponse is the response type for the Query.QueryTxHash
-// RPC method.
-type QueryTxHashResponse struct {
+// MempoolEvent is a single mempool state change.
+type MempoolEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TxHash string `protobuf:"bytes,1,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	Type_    MempoolEventType `protobuf:"varint,1,opt,name=type,proto3,enum=initia.abcipp.mempool.v1.MempoolEventType" json:"type,omitempty"`
+	Sender   string           `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	Sequence uint64           `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// tier is the tier name at the time of the event, or "queued".
+	Tier   string `protobuf:"bytes,4,opt,name=tier,proto3" json:"tier,omitempty"`
+	TxHash string `protobuf:"bytes,5,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// reason is set for removed events.
+	Reason RemovalReason `protobuf:"varint,6,opt,name=reason,proto3,enum=initia.abcipp.mempool.v1.RemovalReason" json:"reason,omitempty"`
 }
 
-func (x *QueryTxHashResponse) Reset() {
-	*x = QueryTxHashResponse{}
+func (x *MempoolEvent) Reset() {
+	*x = MempoolEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_initia_abcipp_mempool_v1_query_proto_msgTypes[3]
+		mi := &file_initia_abcipp_mempool_v1_query_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryTxHashResponse) String() string {
+func (x *MempoolEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryTxHashResponse) ProtoMessage() {}
+func (*MempoolEvent) ProtoMessage() {}
 
-// Deprecated: Use QueryTxHashResponse.ProtoReflect.Descriptor instead.
-func (*QueryTxHashResponse) Descriptor() ([]byte, []int) {
-	return file_initia_abcipp_mempool_v1_query_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use MempoolEvent.ProtoReflect.Descriptor instead.
+func (*MempoolEvent) Descriptor() ([]byte, []int) {
+	return file_initia_abcipp_mempool_v1_query_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *QueryTxHashResponse) GetTxHash() string {
+func (x *MempoolEvent) GetType_() MempoolEventType {
+	if x != nil {
+		return x.Type_
+	}
+	return MempoolEventType_MEMPOOL_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *MempoolEvent) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *MempoolEvent) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *MempoolEvent) GetTier() string {
+	if x != nil {
+		return x.Tier
+	}
+	return ""
+}
+
+func (x *MempoolEvent) GetTxHash() string {
 	if x != nil {
 		return x.TxHash
 	}
 	return ""
 }
 
+func (x *MempoolEvent) GetReason() RemovalReason {
+	if x != nil {
+		return x.Reason
+	}
+	return RemovalReason_REMOVAL_REASON_UNSPECIFIED
+}
+
 var File_initia_abcipp_mempool_v1_query_proto protoreflect.FileDescriptor
 
 var file_initia_abcipp_mempool_v1_query_proto_rawDesc = []byte{
@@ -2086,69 +12511,314 @@ var file_initia_abcipp_mempool_v1_query_proto_rawDesc = []byte{
 	0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x76, 0x31, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x18, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x61,
 	0x62, 0x63, 0x69, 0x70, 0x70, 0x2e, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x76, 0x31,
-	0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e,
-	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x1c,
-	0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x78, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62,
-	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xcb, 0x01, 0x0a,
-	0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x78, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x0c,
-	0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x47, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x61, 0x62, 0x63, 0x69,
-	0x70, 0x70, 0x2e, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x54, 0x78, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69,
-	0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x64, 0x69, 0x73,
-	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x3f, 0x0a, 0x11, 0x44, 0x69, 0x73,
-	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x48, 0x0a, 0x12, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75,
-	0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75,
-	0x65, 0x6e, 0x63, 0x65, 0x22, 0x2d, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x78, 0x48,
-	0x61, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74,
-	0x78, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x48,
-	0x61, 0x73, 0x68, 0x32, 0xe0, 0x02, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0xb2, 0x01,
-	0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x78, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62,
-	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x61,
+	0x1a, 0x2a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f,
+	0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
+	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x1c, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x78, 0x44, 0x69, 0x73, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0xcb, 0x01, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x78, 0x44, 0x69, 0x73, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x6b, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x47, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x61,
 	0x62, 0x63, 0x69, 0x70, 0x70, 0x2e, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x76, 0x31,
 	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x78, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x69, 0x6e,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x69, 0x73,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c,
+	0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x3f, 0x0a, 0x11,
+	0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x48, 0x0a,
+	0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x2d, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x22, 0xe9, 0x01, 0x0a, 0x09, 0x4d, 0x65, 0x6d, 0x70, 0x6f,
+	0x6f, 0x6c, 0x54, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08,
+	0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73,
+	0x68, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x69, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6c, 0x61, 0x6d, 0x70, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x69,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x63, 0x6c, 0x61,
+	0x6d, 0x70, 0x65, 0x64, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1b, 0x0a, 0x09,
+	0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x67, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x74, 0x78, 0x53, 0x69,
+	0x7a, 0x65, 0x22, 0x2f, 0x0a, 0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x54, 0x78, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x22, 0xa7, 0x02, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x54, 0x78, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x16, 0x6e, 0x65, 0x78, 0x74,
+	0x5f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x6e, 0x65, 0x78, 0x74, 0x45, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x3b,
+	0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23,
+	0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x70, 0x70, 0x2e, 0x6d,
+	0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f,
+	0x6c, 0x54, 0x78, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x3b, 0x0a, 0x06, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x6e,
 	0x69, 0x74, 0x69, 0x61, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x70, 0x70, 0x2e, 0x6d, 0x65, 0x6d, 0x70,
-	0x6f, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x78, 0x44, 0x69,
-	0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x12, 0x26, 0x2f, 0x69, 0x6e, 0x69,
-	0x74, 0x69, 0x61, 0x2f, 0x61, 0x62, 0x63, 0x69, 0x70, 0x70, 0x2f, 0x6d, 0x65, 0x6d, 0x70, 0x6f,
-	0x6f, 0x6c, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0xa1, 0x01, 0x0a, 0x0b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x78, 0x48, 0x61,
-	0x73, 0x68, 0x12, 0x2c, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x61, 0x62, 0x63, 0x69,
-	0x70, 0x70, 0x2e, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x2d, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x70, 0x70,
-	0x2e, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x12, 0x2d, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61,
-	0x2f, 0x61, 0x62, 0x63, 0x69, 0x70, 0x70, 0x2f, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x2f,
-	0x76, 0x31, 0x2f, 0x7b, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x7d, 0x2f, 0x7b, 0x73, 0x65, 0x71,
-	0x75, 0x65, 0x6e, 0x63, 0x65, 0x7d, 0x42, 0xf3, 0x01, 0x0a, 0x1c, 0x63, 0x6f, 0x6d, 0x2e, 0x69,
+	0x6f, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x54, 0x78,
+	0x52, 0x06, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x71, 0x0a,
+	0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x69, 0x65, 0x72, 0x54, 0x78, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x65, 0x72, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x96, 0x01, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x69, 0x65, 0x72, 0x54, 0x78,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x03, 0x74, 0x78, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e,
+	0x61, 0x62, 0x63, 0x69, 0x70, 0x70, 0x2e, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x54, 0x78, 0x52, 0x03, 0x74, 0x78, 0x73,
+	0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xbf, 0x01, 0x0a, 0x10, 0x53, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x52, 0x65, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x74, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x74, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x61,
+	0x6e, 0x6e, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x62, 0x61, 0x6e, 0x6e,
+	0x65, 0x64, 0x12, 0x47, 0x0a, 0x0c, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x5f, 0x75, 0x6e, 0x74,
+	0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0b,
+	0x62, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x22, 0x40, 0x0a, 0x1d, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x65, 0x70, 0x75, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x62, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0a, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x6e, 0x0a,
+	0x1e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x65, 0x70, 0x75,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x4c, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x61, 0x62,
+	0x63, 0x69, 0x70, 0x70, 0x2e, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x65, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0b, 0x72, 0x65, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x90, 0x02,
+	0x0a, 0x15, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12,
+	0x20, 0x0a, 0x0c, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x71, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x65,
+	0x71, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4d, 0x69, 0x6e,
+	0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x6d, 0x61, 0x78, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4d, 0x61, 0x78, 0x12,
+	0x1d, 0x0a, 0x0a, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x5f, 0x6d, 0x69, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x4d, 0x69, 0x6e, 0x12, 0x1d,
+	0x0a, 0x0a, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x5f, 0x6d, 0x61, 0x78, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x4d, 0x61, 0x78, 0x12, 0x41, 0x0a,
+	0x09, 0x67, 0x61, 0x70, 0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde,
+	0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x08, 0x67, 0x61, 0x70, 0x53, 0x69, 0x6e, 0x63, 0x65,
+	0x22, 0xb1, 0x02, 0x0a, 0x14, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x69, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x65,
+	0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x29, 0x0a,
+	0x10, 0x63, 0x6c, 0x61, 0x6d, 0x70, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x63, 0x6c, 0x61, 0x6d, 0x70, 0x65, 0x64,
+	0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6c, 0x61, 0x6d,
+	0x70, 0x65, 0x64, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0c, 0x63, 0x6c, 0x61, 0x6d, 0x70, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x67, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x74, 0x78, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x5f,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x74, 0x78, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x22, 0xb9, 0x02, 0x0a, 0x0f, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x69, 0x65, 0x72, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x5f, 0x73, 0x65, 0x71, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x53, 0x65, 0x71, 0x12, 0x49, 0x0a, 0x07, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e,
+	0x61, 0x62, 0x63, 0x69, 0x70, 0x70, 0x2e, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x07, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x73,
+	0x12, 0x46, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2e, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x70, 0x70,
+	0x2e, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6d, 0x70,
+	0x6f, 0x6f, 0x6c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x46, 0x0a, 0x06, 0x71, 0x75, 0x65, 0x75,
+	0x65, 0x64, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x70, 0x70, 0x2e, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64,
+	0x22, 0x1d, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x65, 0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x45, 0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x29, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x70,
+	0x70, 0x2e, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6d,
+	0x70, 0x6f, 0x6f, 0x6c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x08, 0x73, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x22, 0x4f, 0x0a, 0x1d, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x69, 0x65, 0x72, 0x73, 0x22, 0xf0, 0x01, 0x0a, 0x0c, 0x4d, 0x65, 0x6d, 0x70,
+	0x6f, 0x6f, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x3e, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e,
+	0x61, 0x62, 0x63, 0x69, 0x70, 0x70, 0x2e, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x69, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x65, 0x72,
+	0x12, 0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x3f, 0x0a, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x69, 0x6e, 0x69, 0x74,
+	0x69, 0x61, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x70, 0x70, 0x2e, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x2a, 0xb7, 0x01, 0x0a, 0x10, 0x4d,
+	0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x22, 0x0a, 0x1e, 0x4d, 0x45, 0x4d, 0x50, 0x4f, 0x4f, 0x4c, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x4d, 0x45, 0x4d, 0x50, 0x4f, 0x4f, 0x4c, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x53, 0x45, 0x52, 0x54,
+	0x45, 0x44, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x4d, 0x45, 0x4d, 0x50, 0x4f, 0x4f, 0x4c, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x51, 0x55, 0x45, 0x55, 0x45,
+	0x44, 0x10, 0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x4d, 0x45, 0x4d, 0x50, 0x4f, 0x4f, 0x4c, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x4d, 0x4f, 0x54,
+	0x45, 0x44, 0x10, 0x03, 0x12, 0x1e, 0x0a, 0x1a, 0x4d, 0x45, 0x4d, 0x50, 0x4f, 0x4f, 0x4c, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x56,
+	0x45, 0x44, 0x10, 0x04, 0x2a, 0x86, 0x02, 0x0a, 0x0d, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c,
+	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x1a, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x41,
+	0x4c, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x23, 0x0a, 0x1f, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x41,
+	0x4c, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x43, 0x41, 0x50, 0x41, 0x43, 0x49, 0x54,
+	0x59, 0x5f, 0x45, 0x56, 0x49, 0x43, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x25, 0x0a, 0x21, 0x52,
+	0x45, 0x4d, 0x4f, 0x56, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x43, 0x4f,
+	0x4d, 0x4d, 0x49, 0x54, 0x54, 0x45, 0x44, 0x5f, 0x49, 0x4e, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b,
+	0x10, 0x02, 0x12, 0x2b, 0x0a, 0x27, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x41, 0x4c, 0x5f, 0x52, 0x45,
+	0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x41, 0x4e, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54,
+	0x45, 0x44, 0x5f, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x45, 0x50, 0x41, 0x52, 0x45, 0x10, 0x03, 0x12,
+	0x1b, 0x0a, 0x17, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f,
+	0x4e, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x41, 0x43, 0x45, 0x44, 0x10, 0x04, 0x12, 0x18, 0x0a, 0x14,
+	0x52, 0x45, 0x4d, 0x4f, 0x56, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x53,
+	0x54, 0x41, 0x4c, 0x45, 0x10, 0x05, 0x12, 0x25, 0x0a, 0x21, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x41,
+	0x4c, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x51, 0x55, 0x45, 0x55, 0x45, 0x44, 0x5f,
+	0x47, 0x41, 0x50, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x06, 0x32, 0xf4, 0x08,
+	0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0xb2, 0x01, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x54, 0x78, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x34, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x70, 0x70, 0x2e,
+	0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x54, 0x78, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x61,
+	0x62, 0x63, 0x69, 0x70, 0x70, 0x2e, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x78, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x28, 0x12, 0x26, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x61, 0x62,
+	0x63, 0x69, 0x70, 0x70, 0x2f, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x76, 0x31, 0x2f,
+	0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0xa1, 0x01, 0x0a,
+	0x0b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x2c, 0x2e, 0x69,
 	0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x70, 0x70, 0x2e, 0x6d, 0x65, 0x6d,
-	0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x44, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x69, 0x6e,
-	0x69, 0x74, 0x69, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f,
+	0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x78, 0x48,
+	0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x61, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x70, 0x70, 0x2e, 0x6d, 0x65, 0x6d, 0x70, 0x6f,
+	0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x78, 0x48, 0x61, 0x73,
+	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x2f, 0x12, 0x2d, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x61, 0x62, 0x63, 0x69, 0x70,
+	0x70, 0x2f, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x73, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x7d, 0x2f, 0x7b, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x7d,
+	0x12, 0xab, 0x01, 0x0a, 0x0e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x54, 0x78, 0x73, 0x12, 0x2f, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x61, 0x62, 0x63,
+	0x69, 0x70, 0x70, 0x2e, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x54, 0x78, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x61, 0x62,
+	0x63, 0x69, 0x70, 0x70, 0x2e, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x54, 0x78, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x12, 0x2e,
+	0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x61, 0x62, 0x63, 0x69, 0x70, 0x70, 0x2f, 0x6d,
+	0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x73, 0x2f, 0x7b, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x7d, 0x2f, 0x74, 0x78, 0x73, 0x12, 0xa1,
+	0x01, 0x0a, 0x0c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x69, 0x65, 0x72, 0x54, 0x78, 0x73, 0x12,
+	0x2d, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x70, 0x70, 0x2e,
+	0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x54, 0x69, 0x65, 0x72, 0x54, 0x78, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e,
+	0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x70, 0x70, 0x2e, 0x6d,
+	0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54,
+	0x69, 0x65, 0x72, 0x54, 0x78, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x32,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x12, 0x2a, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f,
 	0x61, 0x62, 0x63, 0x69, 0x70, 0x70, 0x2f, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x76,
-	0x31, 0x3b, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x49, 0x41,
-	0x4d, 0xaa, 0x02, 0x18, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x41, 0x62, 0x63, 0x69, 0x70,
-	0x70, 0x2e, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x18, 0x49,
-	0x6e, 0x69, 0x74, 0x69, 0x61, 0x5c, 0x41, 0x62, 0x63, 0x69, 0x70, 0x70, 0x5c, 0x4d, 0x65, 0x6d,
-	0x70, 0x6f, 0x6f, 0x6c, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x24, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61,
-	0x5c, 0x41, 0x62, 0x63, 0x69, 0x70, 0x70, 0x5c, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x5c,
-	0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
-	0x1b, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x3a, 0x3a, 0x41, 0x62, 0x63, 0x69, 0x70, 0x70, 0x3a,
-	0x3a, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x31, 0x2f, 0x74, 0x69, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x74, 0x69, 0x65, 0x72, 0x7d, 0x2f, 0x74,
+	0x78, 0x73, 0x12, 0xba, 0x01, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x52, 0x65, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x37, 0x2e,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x70, 0x70, 0x2e, 0x6d, 0x65,
+	0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x52, 0x65, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e,
+	0x61, 0x62, 0x63, 0x69, 0x70, 0x70, 0x2e, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x65, 0x70,
+	0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12, 0x25, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x2f, 0x61, 0x62, 0x63, 0x69, 0x70, 0x70, 0x2f, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c,
+	0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x85, 0x01, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x35, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x70, 0x70, 0x2e, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x36, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x70, 0x70, 0x2e,
+	0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7b, 0x0a, 0x16, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x37, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x70,
+	0x70, 0x2e, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x61, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x70, 0x70, 0x2e, 0x6d, 0x65, 0x6d, 0x70, 0x6f,
+	0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x30, 0x01, 0x42, 0xf3, 0x01, 0x0a, 0x1c, 0x63, 0x6f, 0x6d, 0x2e, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x61, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x70, 0x70, 0x2e, 0x6d, 0x65, 0x6d, 0x70, 0x6f,
+	0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x50, 0x01, 0x5a, 0x44, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x69, 0x6e, 0x69, 0x74,
+	0x69, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x61, 0x62,
+	0x63, 0x69, 0x70, 0x70, 0x2f, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x76, 0x31, 0x3b,
+	0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x49, 0x41, 0x4d, 0xaa,
+	0x02, 0x18, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x41, 0x62, 0x63, 0x69, 0x70, 0x70, 0x2e,
+	0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x18, 0x49, 0x6e, 0x69,
+	0x74, 0x69, 0x61, 0x5c, 0x41, 0x62, 0x63, 0x69, 0x70, 0x70, 0x5c, 0x4d, 0x65, 0x6d, 0x70, 0x6f,
+	0x6f, 0x6c, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x24, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x5c, 0x41,
+	0x62, 0x63, 0x69, 0x70, 0x70, 0x5c, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x5c, 0x56, 0x31,
+	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1b, 0x49,
+	0x6e, 0x69, 0x74, 0x69, 0x61, 0x3a, 0x3a, 0x41, 0x62, 0x63, 0x69, 0x70, 0x70, 0x3a, 0x3a, 0x4d,
+	0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -2163,25 +12833,70 @@ func file_initia_abcipp_mempool_v1_query_proto_rawDescGZIP() []byte {
 	return file_initia_abcipp_mempool_v1_query_proto_rawDescData
 }
 
-var file_initia_abcipp_mempool_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_initia_abcipp_mempool_v1_query_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_initia_abcipp_mempool_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_initia_abcipp_mempool_v1_query_proto_goTypes = []interface{}{
-	(*QueryTxDistributionRequest)(nil),  // 0: initia.abcipp.mempool.v1.QueryTxDistributionRequest
-	(*QueryTxDistributionResponse)(nil), // 1: initia.abcipp.mempool.v1.QueryTxDistributionResponse
-	(*QueryTxHashRequest)(nil),          // 2: initia.abcipp.mempool.v1.QueryTxHashRequest
-	(*QueryTxHashResponse)(nil),         // 3: initia.abcipp.mempool.v1.QueryTxHashResponse
-	nil,                                 // 4: initia.abcipp.mempool.v1.QueryTxDistributionResponse.DistributionEntry
+	(MempoolEventType)(0),                  // 0: initia.abcipp.mempool.v1.MempoolEventType
+	(RemovalReason)(0),                     // 1: initia.abcipp.mempool.v1.RemovalReason
+	(*QueryTxDistributionRequest)(nil),     // 2: initia.abcipp.mempool.v1.QueryTxDistributionRequest
+	(*QueryTxDistributionResponse)(nil),    // 3: initia.abcipp.mempool.v1.QueryTxDistributionResponse
+	(*QueryTxHashRequest)(nil),             // 4: initia.abcipp.mempool.v1.QueryTxHashRequest
+	(*QueryTxHashResponse)(nil),            // 5: initia.abcipp.mempool.v1.QueryTxHashResponse
+	(*MempoolTx)(nil),                      // 6: initia.abcipp.mempool.v1.MempoolTx
+	(*QuerySenderTxsRequest)(nil),          // 7: initia.abcipp.mempool.v1.QuerySenderTxsRequest
+	(*QuerySenderTxsResponse)(nil),         // 8: initia.abcipp.mempool.v1.QuerySenderTxsResponse
+	(*QueryTierTxsRequest)(nil),            // 9: initia.abcipp.mempool.v1.QueryTierTxsRequest
+	(*QueryTierTxsResponse)(nil),           // 10: initia.abcipp.mempool.v1.QueryTierTxsResponse
+	(*SenderReputation)(nil),               // 11: initia.abcipp.mempool.v1.SenderReputation
+	(*QuerySenderReputationsRequest)(nil),  // 12: initia.abcipp.mempool.v1.QuerySenderReputationsRequest
+	(*QuerySenderReputationsResponse)(nil), // 13: initia.abcipp.mempool.v1.QuerySenderReputationsResponse
+	(*MempoolSnapshotSender)(nil),          // 14: initia.abcipp.mempool.v1.MempoolSnapshotSender
+	(*MempoolSnapshotEntry)(nil),           // 15: initia.abcipp.mempool.v1.MempoolSnapshotEntry
+	(*MempoolSnapshot)(nil),                // 16: initia.abcipp.mempool.v1.MempoolSnapshot
+	(*QueryMempoolSnapshotRequest)(nil),    // 17: initia.abcipp.mempool.v1.QueryMempoolSnapshotRequest
+	(*QueryMempoolSnapshotResponse)(nil),   // 18: initia.abcipp.mempool.v1.QueryMempoolSnapshotResponse
+	(*SubscribeMempoolEventsRequest)(nil),  // 19: initia.abcipp.mempool.v1.SubscribeMempoolEventsRequest
+	(*MempoolEvent)(nil),                   // 20: initia.abcipp.mempool.v1.MempoolEvent
+	nil,                                    // 21: initia.abcipp.mempool.v1.QueryTxDistributionResponse.DistributionEntry
+	(*v1beta1.PageRequest)(nil),            // 22: cosmos.base.query.v1beta1.PageRequest
+	(*v1beta1.PageResponse)(nil),           // 23: cosmos.base.query.v1beta1.PageResponse
+	(*timestamppb.Timestamp)(nil),          // 24: google.protobuf.Timestamp
 }
 var file_initia_abcipp_mempool_v1_query_proto_depIdxs = []int32{
-	4, // 0: initia.abcipp.mempool.v1.QueryTxDistributionResponse.distribution:type_name -> initia.abcipp.mempool.v1.QueryTxDistributionResponse.DistributionEntry
-	0, // 1: initia.abcipp.mempool.v1.Query.QueryTxDistribution:input_type -> initia.abcipp.mempool.v1.QueryTxDistributionRequest
-	2, // 2: initia.abcipp.mempool.v1.Query.QueryTxHash:input_type -> initia.abcipp.mempool.v1.QueryTxHashRequest
-	1, // 3: initia.abcipp.mempool.v1.Query.QueryTxDistribution:output_type -> initia.abcipp.mempool.v1.QueryTxDistributionResponse
-	3, // 4: initia.abcipp.mempool.v1.Query.QueryTxHash:output_type -> initia.abcipp.mempool.v1.QueryTxHashResponse
-	3, // [3:5] is the sub-list for method output_type
-	1, // [1:3] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	21, // 0: initia.abcipp.mempool.v1.QueryTxDistributionResponse.distribution:type_name -> initia.abcipp.mempool.v1.QueryTxDistributionResponse.DistributionEntry
+	6,  // 1: initia.abcipp.mempool.v1.QuerySenderTxsResponse.active:type_name -> initia.abcipp.mempool.v1.MempoolTx
+	6,  // 2: initia.abcipp.mempool.v1.QuerySenderTxsResponse.queued:type_name -> initia.abcipp.mempool.v1.MempoolTx
+	22, // 3: initia.abcipp.mempool.v1.QueryTierTxsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	6,  // 4: initia.abcipp.mempool.v1.QueryTierTxsResponse.txs:type_name -> initia.abcipp.mempool.v1.MempoolTx
+	23, // 5: initia.abcipp.mempool.v1.QueryTierTxsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	24, // 6: initia.abcipp.mempool.v1.SenderReputation.banned_until:type_name -> google.protobuf.Timestamp
+	11, // 7: initia.abcipp.mempool.v1.QuerySenderReputationsResponse.reputations:type_name -> initia.abcipp.mempool.v1.SenderReputation
+	24, // 8: initia.abcipp.mempool.v1.MempoolSnapshotSender.gap_since:type_name -> google.protobuf.Timestamp
+	14, // 9: initia.abcipp.mempool.v1.MempoolSnapshot.senders:type_name -> initia.abcipp.mempool.v1.MempoolSnapshotSender
+	15, // 10: initia.abcipp.mempool.v1.MempoolSnapshot.active:type_name -> initia.abcipp.mempool.v1.MempoolSnapshotEntry
+	15, // 11: initia.abcipp.mempool.v1.MempoolSnapshot.queued:type_name -> initia.abcipp.mempool.v1.MempoolSnapshotEntry
+	16, // 12: initia.abcipp.mempool.v1.QueryMempoolSnapshotResponse.snapshot:type_name -> initia.abcipp.mempool.v1.MempoolSnapshot
+	0,  // 13: initia.abcipp.mempool.v1.MempoolEvent.type:type_name -> initia.abcipp.mempool.v1.MempoolEventType
+	1,  // 14: initia.abcipp.mempool.v1.MempoolEvent.reason:type_name -> initia.abcipp.mempool.v1.RemovalReason
+	2,  // 15: initia.abcipp.mempool.v1.Query.QueryTxDistribution:input_type -> initia.abcipp.mempool.v1.QueryTxDistributionRequest
+	4,  // 16: initia.abcipp.mempool.v1.Query.QueryTxHash:input_type -> initia.abcipp.mempool.v1.QueryTxHashRequest
+	7,  // 17: initia.abcipp.mempool.v1.Query.QuerySenderTxs:input_type -> initia.abcipp.mempool.v1.QuerySenderTxsRequest
+	9,  // 18: initia.abcipp.mempool.v1.Query.QueryTierTxs:input_type -> initia.abcipp.mempool.v1.QueryTierTxsRequest
+	12, // 19: initia.abcipp.mempool.v1.Query.QuerySenderReputations:input_type -> initia.abcipp.mempool.v1.QuerySenderReputationsRequest
+	17, // 20: initia.abcipp.mempool.v1.Query.QueryMempoolSnapshot:input_type -> initia.abcipp.mempool.v1.QueryMempoolSnapshotRequest
+	19, // 21: initia.abcipp.mempool.v1.Query.SubscribeMempoolEvents:input_type -> initia.abcipp.mempool.v1.SubscribeMempoolEventsRequest
+	3,  // 22: initia.abcipp.mempool.v1.Query.QueryTxDistribution:output_type -> initia.abcipp.mempool.v1.QueryTxDistributionResponse
+	5,  // 23: initia.abcipp.mempool.v1.Query.QueryTxHash:output_type -> initia.abcipp.mempool.v1.QueryTxHashResponse
+	8,  // 24: initia.abcipp.mempool.v1.Query.QuerySenderTxs:output_type -> initia.abcipp.mempool.v1.QuerySenderTxsResponse
+	10, // 25: initia.abcipp.mempool.v1.Query.QueryTierTxs:output_type -> initia.abcipp.mempool.v1.QueryTierTxsResponse
+	13, // 26: initia.abcipp.mempool.v1.Query.QuerySenderReputations:output_type -> initia.abcipp.mempool.v1.QuerySenderReputationsResponse
+	18, // 27: initia.abcipp.mempool.v1.Query.QueryMempoolSnapshot:output_type -> initia.abcipp.mempool.v1.QueryMempoolSnapshotResponse
+	20, // 28: initia.abcipp.mempool.v1.Query.SubscribeMempoolEvents:output_type -> initia.abcipp.mempool.v1.MempoolEvent
+	22, // [22:29] is the sub-list for method output_type
+	15, // [15:22] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_initia_abcipp_mempool_v1_query_proto_init() }
@@ -2238,19 +12953,200 @@ func file_initia_abcipp_mempool_v1_query_proto_init() {
 				return nil
 			}
 		}
+		file_initia_abcipp_mempool_v1_query_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MempoolTx); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_abcipp_mempool_v1_query_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuerySenderTxsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_abcipp_mempool_v1_query_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuerySenderTxsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_abcipp_mempool_v1_query_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryTierTxsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_abcipp_mempool_v1_query_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryTierTxsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_abcipp_mempool_v1_query_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SenderReputation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_abcipp_mempool_v1_query_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuerySenderReputationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_abcipp_mempool_v1_query_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuerySenderReputationsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_abcipp_mempool_v1_query_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MempoolSnapshotSender); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_abcipp_mempool_v1_query_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MempoolSnapshotEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_abcipp_mempool_v1_query_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MempoolSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_abcipp_mempool_v1_query_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryMempoolSnapshotRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_abcipp_mempool_v1_query_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryMempoolSnapshotResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_abcipp_mempool_v1_query_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeMempoolEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_abcipp_mempool_v1_query_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MempoolEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_initia_abcipp_mempool_v1_query_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   5,
+			NumEnums:      2,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_initia_abcipp_mempool_v1_query_proto_goTypes,
 		DependencyIndexes: file_initia_abcipp_mempool_v1_query_proto_depIdxs,
+		EnumInfos:         file_initia_abcipp_mempool_v1_query_proto_enumTypes,
 		MessageInfos:      file_initia_abcipp_mempool_v1_query_proto_msgTypes,
 	}.Build()
 	File_initia_abcipp_mempool_v1_query_proto = out.File
@@ -19,8 +19,13 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_QueryTxDistribution_FullMethodName = "/initia.abcipp.mempool.v1.Query/QueryTxDistribution"
-	Query_QueryTxHash_FullMethodName         = "/initia.abcipp.mempool.v1.Query/QueryTxHash"
+	Query_QueryTxDistribution_FullMethodName    = "/initia.abcipp.mempool.v1.Query/QueryTxDistribution"
+	Query_QueryTxHash_FullMethodName            = "/initia.abcipp.mempool.v1.Query/QueryTxHash"
+	Query_QuerySenderTxs_FullMethodName         = "/initia.abcipp.mempool.v1.Query/QuerySenderTxs"
+	Query_QueryTierTxs_FullMethodName           = "/initia.abcipp.mempool.v1.Query/QueryTierTxs"
+	Query_QuerySenderReputations_FullMethodName = "/initia.abcipp.mempool.v1.Query/QuerySenderReputations"
+	Query_QueryMempoolSnapshot_FullMethodName   = "/initia.abcipp.mempool.v1.Query/QueryMempoolSnapshot"
+	Query_SubscribeMempoolEvents_FullMethodName = "/initia.abcipp.mempool.v1.Query/SubscribeMempoolEvents"
 )
 
 // QueryClient is the client API for Query service.
@@ -33,6 +38,25 @@ type QueryClient interface {
 	QueryTxDistribution(ctx context.Context, in *QueryTxDistributionRequest, opts ...grpc.CallOption) (*QueryTxDistributionResponse, error)
 	// QueryTxHash checks if a transaction is in the mempool.
 	QueryTxHash(ctx context.Context, in *QueryTxHashRequest, opts ...grpc.CallOption) (*QueryTxHashResponse, error)
+	// QuerySenderTxs returns the active and queued txs of a sender, and the missing
+	// sequence that blocks queued txs from being promoted.
+	QuerySenderTxs(ctx context.Context, in *QuerySenderTxsRequest, opts ...grpc.CallOption) (*QuerySenderTxsResponse, error)
+	// QueryTierTxs pages through the txs of a tier. The "queued" tier lists queued txs.
+	QueryTierTxs(ctx context.Context, in *QueryTierTxsRequest, opts ...grpc.CallOption) (*QueryTierTxsResponse, error)
+	// QuerySenderReputations returns the admission scores of tracked senders,
+	// highest score first, including throttled and banned senders.
+	QuerySenderReputations(ctx context.Context, in *QuerySenderReputationsRequest, opts ...grpc.CallOption) (*QuerySenderReputationsResponse, error)
+	// QueryMempoolSnapshot dumps the full mempool state: active txs in Select
+	// order, queued txs, per-sender nonce ranges and tiers. Replaying the
+	// snapshot into an empty mempool reproduces the same Select order. It is a
+	// debug endpoint that is only served by nodes that set
+	// abcipp.snapshot-query-enabled. It is not exposed over REST, but once
+	// enabled it is reachable over gRPC and through CometBFT RPC abci_query.
+	QueryMempoolSnapshot(ctx context.Context, in *QueryMempoolSnapshotRequest, opts ...grpc.CallOption) (*QueryMempoolSnapshotResponse, error)
+	// SubscribeMempoolEvents streams inserts, promotions from queued to active, and
+	// removals with their reason. The stream is closed when the subscriber falls
+	// too far behind; clients should resync with the other queries and resubscribe.
+	SubscribeMempoolEvents(ctx context.Context, in *SubscribeMempoolEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[MempoolEvent], error)
 }
 
 type queryClient struct {
@@ -63,6 +87,65 @@ func (c *queryClient) QueryTxHash(ctx context.Context, in *QueryTxHashRequest, o
 	return out, nil
 }
 
+func (c *queryClient) QuerySenderTxs(ctx context.Context, in *QuerySenderTxsRequest, opts ...grpc.CallOption) (*QuerySenderTxsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuerySenderTxsResponse)
+	err := c.cc.Invoke(ctx, Query_QuerySenderTxs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryTierTxs(ctx context.Context, in *QueryTierTxsRequest, opts ...grpc.CallOption) (*QueryTierTxsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryTierTxsResponse)
+	err := c.cc.Invoke(ctx, Query_QueryTierTxs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QuerySenderReputations(ctx context.Context, in *QuerySenderReputationsRequest, opts ...grpc.CallOption) (*QuerySenderReputationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuerySenderReputationsResponse)
+	err := c.cc.Invoke(ctx, Query_QuerySenderReputations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryMempoolSnapshot(ctx context.Context, in *QueryMempoolSnapshotRequest, opts ...grpc.CallOption) (*QueryMempoolSnapshotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryMempoolSnapshotResponse)
+	err := c.cc.Invoke(ctx, Query_QueryMempoolSnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SubscribeMempoolEvents(ctx context.Context, in *SubscribeMempoolEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[MempoolEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Query_ServiceDesc.Streams[0], Query_SubscribeMempoolEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SubscribeMempoolEventsRequest, MempoolEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Query_SubscribeMempoolEventsClient = grpc.ServerStreamingClient[MempoolEvent]
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -73,6 +156,25 @@ type QueryServer interface {
 	QueryTxDistribution(context.Context, *QueryTxDistributionRequest) (*QueryTxDistributionResponse, error)
 	// QueryTxHash checks if a transaction is in the mempool.
 	QueryTxHash(context.Context, *QueryTxHashRequest) (*QueryTxHashResponse, error)
+	// QuerySenderTxs returns the active and queued txs of a sender, and the missing
+	// sequence that blocks queued txs from being promoted.
+	QuerySenderTxs(context.Context, *QuerySenderTxsRequest) (*QuerySenderTxsResponse, error)
+	// QueryTierTxs pages through the txs of a tier. The "queued" tier lists queued txs.
+	QueryTierTxs(context.Context, *QueryTierTxsRequest) (*QueryTierTxsResponse, error)
+	// QuerySenderReputations returns the admission scores of tracked senders,
+	// highest score first, including throttled and banned senders.
+	QuerySenderReputations(context.Context, *QuerySenderReputationsRequest) (*QuerySenderReputationsResponse, error)
+	// QueryMempoolSnapshot dumps the full mempool state: active txs in Select
+	// order, queued txs, per-sender nonce ranges and tiers. Replaying the
+	// snapshot into an empty mempool reproduces the same Select order. It is a
+	// debug endpoint that is only served by nodes that set
+	// abcipp.snapshot-query-enabled. It is not exposed over REST, but once
+	// enabled it is reachable over gRPC and through CometBFT RPC abci_query.
+	QueryMempoolSnapshot(context.Context, *QueryMempoolSnapshotRequest) (*QueryMempoolSnapshotResponse, error)
+	// SubscribeMempoolEvents streams inserts, promotions from queued to active, and
+	// removals with their reason. The stream is closed when the subscriber falls
+	// too far behind; clients should resync with the other queries and resubscribe.
+	SubscribeMempoolEvents(*SubscribeMempoolEventsRequest, grpc.ServerStreamingServer[MempoolEvent]) error
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -89,6 +191,21 @@ func (UnimplementedQueryServer) QueryTxDistribution(context.Context, *QueryTxDis
 func (UnimplementedQueryServer) QueryTxHash(context.Context, *QueryTxHashRequest) (*QueryTxHashResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method QueryTxHash not implemented")
 }
+func (UnimplementedQueryServer) QuerySenderTxs(context.Context, *QuerySenderTxsRequest) (*QuerySenderTxsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method QuerySenderTxs not implemented")
+}
+func (UnimplementedQueryServer) QueryTierTxs(context.Context, *QueryTierTxsRequest) (*QueryTierTxsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method QueryTierTxs not implemented")
+}
+func (UnimplementedQueryServer) QuerySenderReputations(context.Context, *QuerySenderReputationsRequest) (*QuerySenderReputationsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method QuerySenderReputations not implemented")
+}
+func (UnimplementedQueryServer) QueryMempoolSnapshot(context.Context, *QueryMempoolSnapshotRequest) (*QueryMempoolSnapshotResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method QueryMempoolSnapshot not implemented")
+}
+func (UnimplementedQueryServer) SubscribeMempoolEvents(*SubscribeMempoolEventsRequest, grpc.ServerStreamingServer[MempoolEvent]) error {
+	return status.Error(codes.Unimplemented, "method SubscribeMempoolEvents not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -146,6 +263,89 @@ func _Query_QueryTxHash_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_QuerySenderTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySenderTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QuerySenderTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_QuerySenderTxs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuerySenderTxs(ctx, req.(*QuerySenderTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryTierTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTierTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryTierTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_QueryTierTxs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryTierTxs(ctx, req.(*QueryTierTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QuerySenderReputations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySenderReputationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QuerySenderReputations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_QuerySenderReputations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuerySenderReputations(ctx, req.(*QuerySenderReputationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryMempoolSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMempoolSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryMempoolSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_QueryMempoolSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryMempoolSnapshot(ctx, req.(*QueryMempoolSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SubscribeMempoolEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeMempoolEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueryServer).SubscribeMempoolEvents(m, &grpc.GenericServerStream[SubscribeMempoolEventsRequest, MempoolEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Query_SubscribeMempoolEventsServer = grpc.ServerStreamingServer[MempoolEvent]
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -161,7 +361,29 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "QueryTxHash",
 			Handler:    _Query_QueryTxHash_Handler,
 		},
+		{
+			MethodName: "QuerySenderTxs",
+			Handler:    _Query_QuerySenderTxs_Handler,
+		},
+		{
+			MethodName: "QueryTierTxs",
+			Handler:    _Query_QueryTierTxs_Handler,
+		},
+		{
+			MethodName: "QuerySenderReputations",
+			Handler:    _Query_QuerySenderReputations_Handler,
+		},
+		{
+			MethodName: "QueryMempoolSnapshot",
+			Handler:    _Query_QueryMempoolSnapshot_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeMempoolEvents",
+			Handler:       _Query_SubscribeMempoolEvents_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "initia/abcipp/mempool/v1/query.proto",
 }
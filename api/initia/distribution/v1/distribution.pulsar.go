@@ -68,10 +68,13 @@ func (x *_Params_3_list) IsValid() bool {
 }
 
 var (
-	md_Params                       protoreflect.MessageDescriptor
-	fd_Params_community_tax         protoreflect.FieldDescriptor
-	fd_Params_withdraw_addr_enabled protoreflect.FieldDescriptor
-	fd_Params_reward_weights        protoreflect.FieldDescriptor
+	md_Params                             protoreflect.MessageDescriptor
+	fd_Params_community_tax               protoreflect.FieldDescriptor
+	fd_Params_withdraw_addr_enabled       protoreflect.FieldDescriptor
+	fd_Params_reward_weights              protoreflect.FieldDescriptor
+	fd_Params_decentralization_bonus      protoreflect.FieldDescriptor
+	fd_Params_auto_compound_interval      protoreflect.FieldDescriptor
+	fd_Params_auto_compound_max_per_block protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -80,6 +83,9 @@ func init() {
 	fd_Params_community_tax = md_Params.Fields().ByName("community_tax")
 	fd_Params_withdraw_addr_enabled = md_Params.Fields().ByName("withdraw_addr_enabled")
 	fd_Params_reward_weights = md_Params.Fields().ByName("reward_weights")
+	fd_Params_decentralization_bonus = md_Params.Fields().ByName("decentralization_bonus")
+	fd_Params_auto_compound_interval = md_Params.Fields().ByName("auto_compound_interval")
+	fd_Params_auto_compound_max_per_block = md_Params.Fields().ByName("auto_compound_max_per_block")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -165,6 +171,24 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.DecentralizationBonus != nil {
+		value := protoreflect.ValueOfMessage(x.DecentralizationBonus.ProtoReflect())
+		if !f(fd_Params_decentralization_bonus, value) {
+			return
+		}
+	}
+	if x.AutoCompoundInterval != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AutoCompoundInterval)
+		if !f(fd_Params_auto_compound_interval, value) {
+			return
+		}
+	}
+	if x.AutoCompoundMaxPerBlock != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.AutoCompoundMaxPerBlock)
+		if !f(fd_Params_auto_compound_max_per_block, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -186,6 +210,12 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.WithdrawAddrEnabled != false
 	case "initia.distribution.v1.Params.reward_weights":
 		return len(x.RewardWeights) != 0
+	case "initia.distribution.v1.Params.decentralization_bonus":
+		return x.DecentralizationBonus != nil
+	case "initia.distribution.v1.Params.auto_compound_interval":
+		return x.AutoCompoundInterval != uint64(0)
+	case "initia.distribution.v1.Params.auto_compound_max_per_block":
+		return x.AutoCompoundMaxPerBlock != uint32(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.Params"))
@@ -208,6 +238,12 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.WithdrawAddrEnabled = false
 	case "initia.distribution.v1.Params.reward_weights":
 		x.RewardWeights = nil
+	case "initia.distribution.v1.Params.decentralization_bonus":
+		x.DecentralizationBonus = nil
+	case "initia.distribution.v1.Params.auto_compound_interval":
+		x.AutoCompoundInterval = uint64(0)
+	case "initia.distribution.v1.Params.auto_compound_max_per_block":
+		x.AutoCompoundMaxPerBlock = uint32(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.Params"))
@@ -236,6 +272,15 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 		}
 		listValue := &_Params_3_list{list: &x.RewardWeights}
 		return protoreflect.ValueOfList(listValue)
+	case "initia.distribution.v1.Params.decentralization_bonus":
+		value := x.DecentralizationBonus
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "initia.distribution.v1.Params.auto_compound_interval":
+		value := x.AutoCompoundInterval
+		return protoreflect.ValueOfUint64(value)
+	case "initia.distribution.v1.Params.auto_compound_max_per_block":
+		value := x.AutoCompoundMaxPerBlock
+		return protoreflect.ValueOfUint32(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.Params"))
@@ -264,6 +309,12 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		lv := value.List()
 		clv := lv.(*_Params_3_list)
 		x.RewardWeights = *clv.list
+	case "initia.distribution.v1.Params.decentralization_bonus":
+		x.DecentralizationBonus = value.Message().Interface().(*DecentralizationBonus)
+	case "initia.distribution.v1.Params.auto_compound_interval":
+		x.AutoCompoundInterval = value.Uint()
+	case "initia.distribution.v1.Params.auto_compound_max_per_block":
+		x.AutoCompoundMaxPerBlock = uint32(value.Uint())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.Params"))
@@ -290,10 +341,19 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		}
 		value := &_Params_3_list{list: &x.RewardWeights}
 		return protoreflect.ValueOfList(value)
+	case "initia.distribution.v1.Params.decentralization_bonus":
+		if x.DecentralizationBonus == nil {
+			x.DecentralizationBonus = new(DecentralizationBonus)
+		}
+		return protoreflect.ValueOfMessage(x.DecentralizationBonus.ProtoReflect())
 	case "initia.distribution.v1.Params.community_tax":
 		panic(fmt.Errorf("field community_tax of message initia.distribution.v1.Params is not mutable"))
 	case "initia.distribution.v1.Params.withdraw_addr_enabled":
 		panic(fmt.Errorf("field withdraw_addr_enabled of message initia.distribution.v1.Params is not mutable"))
+	case "initia.distribution.v1.Params.auto_compound_interval":
+		panic(fmt.Errorf("field auto_compound_interval of message initia.distribution.v1.Params is not mutable"))
+	case "initia.distribution.v1.Params.auto_compound_max_per_block":
+		panic(fmt.Errorf("field auto_compound_max_per_block of message initia.distribution.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.Params"))
@@ -314,6 +374,13 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 	case "initia.distribution.v1.Params.reward_weights":
 		list := []*RewardWeight{}
 		return protoreflect.ValueOfList(&_Params_3_list{list: &list})
+	case "initia.distribution.v1.Params.decentralization_bonus":
+		m := new(DecentralizationBonus)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "initia.distribution.v1.Params.auto_compound_interval":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "initia.distribution.v1.Params.auto_compound_max_per_block":
+		return protoreflect.ValueOfUint32(uint32(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.Params"))
@@ -396,6 +463,16 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if x.DecentralizationBonus != nil {
+			l = options.Size(x.DecentralizationBonus)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AutoCompoundInterval != 0 {
+			n += 1 + runtime.Sov(uint64(x.AutoCompoundInterval))
+		}
+		if x.AutoCompoundMaxPerBlock != 0 {
+			n += 1 + runtime.Sov(uint64(x.AutoCompoundMaxPerBlock))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -425,6 +502,30 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.AutoCompoundMaxPerBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AutoCompoundMaxPerBlock))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.AutoCompoundInterval != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AutoCompoundInterval))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.DecentralizationBonus != nil {
+			encoded, err := options.Marshal(x.DecentralizationBonus)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
 		if len(x.RewardWeights) > 0 {
 			for iNdEx := len(x.RewardWeights) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.RewardWeights[iNdEx])
@@ -558,12 +659,1828 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
-				x.WithdrawAddrEnabled = bool(v != 0)
+				x.WithdrawAddrEnabled = bool(v != 0)
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RewardWeights", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RewardWeights = append(x.RewardWeights, &RewardWeight{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RewardWeights[len(x.RewardWeights)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DecentralizationBonus", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.DecentralizationBonus == nil {
+					x.DecentralizationBonus = &DecentralizationBonus{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DecentralizationBonus); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundInterval", wireType)
+				}
+				x.AutoCompoundInterval = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AutoCompoundInterval |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundMaxPerBlock", wireType)
+				}
+				x.AutoCompoundMaxPerBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AutoCompoundMaxPerBlock |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_DecentralizationBonus            protoreflect.MessageDescriptor
+	fd_DecentralizationBonus_enabled    protoreflect.FieldDescriptor
+	fd_DecentralizationBonus_top_n      protoreflect.FieldDescriptor
+	fd_DecentralizationBonus_multiplier protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_distribution_v1_distribution_proto_init()
+	md_DecentralizationBonus = File_initia_distribution_v1_distribution_proto.Messages().ByName("DecentralizationBonus")
+	fd_DecentralizationBonus_enabled = md_DecentralizationBonus.Fields().ByName("enabled")
+	fd_DecentralizationBonus_top_n = md_DecentralizationBonus.Fields().ByName("top_n")
+	fd_DecentralizationBonus_multiplier = md_DecentralizationBonus.Fields().ByName("multiplier")
+}
+
+var _ protoreflect.Message = (*fastReflection_DecentralizationBonus)(nil)
+
+type fastReflection_DecentralizationBonus DecentralizationBonus
+
+func (x *DecentralizationBonus) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DecentralizationBonus)(x)
+}
+
+func (x *DecentralizationBonus) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_distribution_v1_distribution_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_DecentralizationBonus_messageType fastReflection_DecentralizationBonus_messageType
+var _ protoreflect.MessageType = fastReflection_DecentralizationBonus_messageType{}
+
+type fastReflection_DecentralizationBonus_messageType struct{}
+
+func (x fastReflection_DecentralizationBonus_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DecentralizationBonus)(nil)
+}
+func (x fastReflection_DecentralizationBonus_messageType) New() protoreflect.Message {
+	return new(fastReflection_DecentralizationBonus)
+}
+func (x fastReflection_DecentralizationBonus_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DecentralizationBonus
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DecentralizationBonus) Descriptor() protoreflect.MessageDescriptor {
+	return md_DecentralizationBonus
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DecentralizationBonus) Type() protoreflect.MessageType {
+	return _fastReflection_DecentralizationBonus_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DecentralizationBonus) New() protoreflect.Message {
+	return new(fastReflection_DecentralizationBonus)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DecentralizationBonus) Interface() protoreflect.ProtoMessage {
+	return (*DecentralizationBonus)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DecentralizationBonus) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Enabled != false {
+		value := protoreflect.ValueOfBool(x.Enabled)
+		if !f(fd_DecentralizationBonus_enabled, value) {
+			return
+		}
+	}
+	if x.TopN != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.TopN)
+		if !f(fd_DecentralizationBonus_top_n, value) {
+			return
+		}
+	}
+	if x.Multiplier != "" {
+		value := protoreflect.ValueOfString(x.Multiplier)
+		if !f(fd_DecentralizationBonus_multiplier, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DecentralizationBonus) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.distribution.v1.DecentralizationBonus.enabled":
+		return x.Enabled != false
+	case "initia.distribution.v1.DecentralizationBonus.top_n":
+		return x.TopN != uint32(0)
+	case "initia.distribution.v1.DecentralizationBonus.multiplier":
+		return x.Multiplier != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.DecentralizationBonus"))
+		}
+		panic(fmt.Errorf("message initia.distribution.v1.DecentralizationBonus does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DecentralizationBonus) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.distribution.v1.DecentralizationBonus.enabled":
+		x.Enabled = false
+	case "initia.distribution.v1.DecentralizationBonus.top_n":
+		x.TopN = uint32(0)
+	case "initia.distribution.v1.DecentralizationBonus.multiplier":
+		x.Multiplier = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.DecentralizationBonus"))
+		}
+		panic(fmt.Errorf("message initia.distribution.v1.DecentralizationBonus does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_DecentralizationBonus) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.distribution.v1.DecentralizationBonus.enabled":
+		value := x.Enabled
+		return protoreflect.ValueOfBool(value)
+	case "initia.distribution.v1.DecentralizationBonus.top_n":
+		value := x.TopN
+		return protoreflect.ValueOfUint32(value)
+	case "initia.distribution.v1.DecentralizationBonus.multiplier":
+		value := x.Multiplier
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.DecentralizationBonus"))
+		}
+		panic(fmt.Errorf("message initia.distribution.v1.DecentralizationBonus does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DecentralizationBonus) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.distribution.v1.DecentralizationBonus.enabled":
+		x.Enabled = value.Bool()
+	case "initia.distribution.v1.DecentralizationBonus.top_n":
+		x.TopN = uint32(value.Uint())
+	case "initia.distribution.v1.DecentralizationBonus.multiplier":
+		x.Multiplier = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.DecentralizationBonus"))
+		}
+		panic(fmt.Errorf("message initia.distribution.v1.DecentralizationBonus does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DecentralizationBonus) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.distribution.v1.DecentralizationBonus.enabled":
+		panic(fmt.Errorf("field enabled of message initia.distribution.v1.DecentralizationBonus is not mutable"))
+	case "initia.distribution.v1.DecentralizationBonus.top_n":
+		panic(fmt.Errorf("field top_n of message initia.distribution.v1.DecentralizationBonus is not mutable"))
+	case "initia.distribution.v1.DecentralizationBonus.multiplier":
+		panic(fmt.Errorf("field multiplier of message initia.distribution.v1.DecentralizationBonus is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.DecentralizationBonus"))
+		}
+		panic(fmt.Errorf("message initia.distribution.v1.DecentralizationBonus does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DecentralizationBonus) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.distribution.v1.DecentralizationBonus.enabled":
+		return protoreflect.ValueOfBool(false)
+	case "initia.distribution.v1.DecentralizationBonus.top_n":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "initia.distribution.v1.DecentralizationBonus.multiplier":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.DecentralizationBonus"))
+		}
+		panic(fmt.Errorf("message initia.distribution.v1.DecentralizationBonus does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DecentralizationBonus) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.distribution.v1.DecentralizationBonus", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DecentralizationBonus) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DecentralizationBonus) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DecentralizationBonus) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DecentralizationBonus) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DecentralizationBonus)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Enabled {
+			n += 2
+		}
+		if x.TopN != 0 {
+			n += 1 + runtime.Sov(uint64(x.TopN))
+		}
+		l = len(x.Multiplier)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DecentralizationBonus)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Multiplier) > 0 {
+			i -= len(x.Multiplier)
+			copy(dAtA[i:], x.Multiplier)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Multiplier)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.TopN != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TopN))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Enabled {
+			i--
+			if x.Enabled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DecentralizationBonus)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DecentralizationBonus: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DecentralizationBonus: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Enabled = bool(v != 0)
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopN", wireType)
+				}
+				x.TopN = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TopN |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Multiplier = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_ValidatorAllocation_4_list)(nil)
+
+type _ValidatorAllocation_4_list struct {
+	list *[]*PoolAllocation
+}
+
+func (x *_ValidatorAllocation_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ValidatorAllocation_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_ValidatorAllocation_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PoolAllocation)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ValidatorAllocation_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PoolAllocation)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ValidatorAllocation_4_list) AppendMutable() protoreflect.Value {
+	v := new(PoolAllocation)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ValidatorAllocation_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ValidatorAllocation_4_list) NewElement() protoreflect.Value {
+	v := new(PoolAllocation)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ValidatorAllocation_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_ValidatorAllocation                   protoreflect.MessageDescriptor
+	fd_ValidatorAllocation_validator_address protoreflect.FieldDescriptor
+	fd_ValidatorAllocation_rank              protoreflect.FieldDescriptor
+	fd_ValidatorAllocation_multiplier        protoreflect.FieldDescriptor
+	fd_ValidatorAllocation_pools             protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_distribution_v1_distribution_proto_init()
+	md_ValidatorAllocation = File_initia_distribution_v1_distribution_proto.Messages().ByName("ValidatorAllocation")
+	fd_ValidatorAllocation_validator_address = md_ValidatorAllocation.Fields().ByName("validator_address")
+	fd_ValidatorAllocation_rank = md_ValidatorAllocation.Fields().ByName("rank")
+	fd_ValidatorAllocation_multiplier = md_ValidatorAllocation.Fields().ByName("multiplier")
+	fd_ValidatorAllocation_pools = md_ValidatorAllocation.Fields().ByName("pools")
+}
+
+var _ protoreflect.Message = (*fastReflection_ValidatorAllocation)(nil)
+
+type fastReflection_ValidatorAllocation ValidatorAllocation
+
+func (x *ValidatorAllocation) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ValidatorAllocation)(x)
+}
+
+func (x *ValidatorAllocation) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_distribution_v1_distribution_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ValidatorAllocation_messageType fastReflection_ValidatorAllocation_messageType
+var _ protoreflect.MessageType = fastReflection_ValidatorAllocation_messageType{}
+
+type fastReflection_ValidatorAllocation_messageType struct{}
+
+func (x fastReflection_ValidatorAllocation_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ValidatorAllocation)(nil)
+}
+func (x fastReflection_ValidatorAllocation_messageType) New() protoreflect.Message {
+	return new(fastReflection_ValidatorAllocation)
+}
+func (x fastReflection_ValidatorAllocation_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorAllocation
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ValidatorAllocation) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorAllocation
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ValidatorAllocation) Type() protoreflect.MessageType {
+	return _fastReflection_ValidatorAllocation_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ValidatorAllocation) New() protoreflect.Message {
+	return new(fastReflection_ValidatorAllocation)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ValidatorAllocation) Interface() protoreflect.ProtoMessage {
+	return (*ValidatorAllocation)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ValidatorAllocation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ValidatorAddress != "" {
+		value := protoreflect.ValueOfString(x.ValidatorAddress)
+		if !f(fd_ValidatorAllocation_validator_address, value) {
+			return
+		}
+	}
+	if x.Rank != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Rank)
+		if !f(fd_ValidatorAllocation_rank, value) {
+			return
+		}
+	}
+	if x.Multiplier != "" {
+		value := protoreflect.ValueOfString(x.Multiplier)
+		if !f(fd_ValidatorAllocation_multiplier, value) {
+			return
+		}
+	}
+	if len(x.Pools) != 0 {
+		value := protoreflect.ValueOfList(&_ValidatorAllocation_4_list{list: &x.Pools})
+		if !f(fd_ValidatorAllocation_pools, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ValidatorAllocation) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.distribution.v1.ValidatorAllocation.validator_address":
+		return x.ValidatorAddress != ""
+	case "initia.distribution.v1.ValidatorAllocation.rank":
+		return x.Rank != uint32(0)
+	case "initia.distribution.v1.ValidatorAllocation.multiplier":
+		return x.Multiplier != ""
+	case "initia.distribution.v1.ValidatorAllocation.pools":
+		return len(x.Pools) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorAllocation"))
+		}
+		panic(fmt.Errorf("message initia.distribution.v1.ValidatorAllocation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorAllocation) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.distribution.v1.ValidatorAllocation.validator_address":
+		x.ValidatorAddress = ""
+	case "initia.distribution.v1.ValidatorAllocation.rank":
+		x.Rank = uint32(0)
+	case "initia.distribution.v1.ValidatorAllocation.multiplier":
+		x.Multiplier = ""
+	case "initia.distribution.v1.ValidatorAllocation.pools":
+		x.Pools = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorAllocation"))
+		}
+		panic(fmt.Errorf("message initia.distribution.v1.ValidatorAllocation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ValidatorAllocation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.distribution.v1.ValidatorAllocation.validator_address":
+		value := x.ValidatorAddress
+		return protoreflect.ValueOfString(value)
+	case "initia.distribution.v1.ValidatorAllocation.rank":
+		value := x.Rank
+		return protoreflect.ValueOfUint32(value)
+	case "initia.distribution.v1.ValidatorAllocation.multiplier":
+		value := x.Multiplier
+		return protoreflect.ValueOfString(value)
+	case "initia.distribution.v1.ValidatorAllocation.pools":
+		if len(x.Pools) == 0 {
+			return protoreflect.ValueOfList(&_ValidatorAllocation_4_list{})
+		}
+		listValue := &_ValidatorAllocation_4_list{list: &x.Pools}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorAllocation"))
+		}
+		panic(fmt.Errorf("message initia.distribution.v1.ValidatorAllocation does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorAllocation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.distribution.v1.ValidatorAllocation.validator_address":
+		x.ValidatorAddress = value.Interface().(string)
+	case "initia.distribution.v1.ValidatorAllocation.rank":
+		x.Rank = uint32(value.Uint())
+	case "initia.distribution.v1.ValidatorAllocation.multiplier":
+		x.Multiplier = value.Interface().(string)
+	case "initia.distribution.v1.ValidatorAllocation.pools":
+		lv := value.List()
+		clv := lv.(*_ValidatorAllocation_4_list)
+		x.Pools = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorAllocation"))
+		}
+		panic(fmt.Errorf("message initia.distribution.v1.ValidatorAllocation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorAllocation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.distribution.v1.ValidatorAllocation.pools":
+		if x.Pools == nil {
+			x.Pools = []*PoolAllocation{}
+		}
+		value := &_ValidatorAllocation_4_list{list: &x.Pools}
+		return protoreflect.ValueOfList(value)
+	case "initia.distribution.v1.ValidatorAllocation.validator_address":
+		panic(fmt.Errorf("field validator_address of message initia.distribution.v1.ValidatorAllocation is not mutable"))
+	case "initia.distribution.v1.ValidatorAllocation.rank":
+		panic(fmt.Errorf("field rank of message initia.distribution.v1.ValidatorAllocation is not mutable"))
+	case "initia.distribution.v1.ValidatorAllocation.multiplier":
+		panic(fmt.Errorf("field multiplier of message initia.distribution.v1.ValidatorAllocation is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorAllocation"))
+		}
+		panic(fmt.Errorf("message initia.distribution.v1.ValidatorAllocation does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ValidatorAllocation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.distribution.v1.ValidatorAllocation.validator_address":
+		return protoreflect.ValueOfString("")
+	case "initia.distribution.v1.ValidatorAllocation.rank":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "initia.distribution.v1.ValidatorAllocation.multiplier":
+		return protoreflect.ValueOfString("")
+	case "initia.distribution.v1.ValidatorAllocation.pools":
+		list := []*PoolAllocation{}
+		return protoreflect.ValueOfList(&_ValidatorAllocation_4_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.ValidatorAllocation"))
+		}
+		panic(fmt.Errorf("message initia.distribution.v1.ValidatorAllocation does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ValidatorAllocation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.distribution.v1.ValidatorAllocation", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ValidatorAllocation) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorAllocation) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ValidatorAllocation) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ValidatorAllocation) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ValidatorAllocation)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ValidatorAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Rank != 0 {
+			n += 1 + runtime.Sov(uint64(x.Rank))
+		}
+		l = len(x.Multiplier)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Pools) > 0 {
+			for _, e := range x.Pools {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ValidatorAllocation)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Pools) > 0 {
+			for iNdEx := len(x.Pools) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Pools[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.Multiplier) > 0 {
+			i -= len(x.Multiplier)
+			copy(dAtA[i:], x.Multiplier)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Multiplier)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Rank != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Rank))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.ValidatorAddress) > 0 {
+			i -= len(x.ValidatorAddress)
+			copy(dAtA[i:], x.ValidatorAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ValidatorAllocation)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorAllocation: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Rank", wireType)
+				}
+				x.Rank = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Rank |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Multiplier = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Pools = append(x.Pools, &PoolAllocation{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pools[len(x.Pools)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_PoolAllocation                   protoreflect.MessageDescriptor
+	fd_PoolAllocation_denom             protoreflect.FieldDescriptor
+	fd_PoolAllocation_base_fraction     protoreflect.FieldDescriptor
+	fd_PoolAllocation_adjusted_fraction protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_distribution_v1_distribution_proto_init()
+	md_PoolAllocation = File_initia_distribution_v1_distribution_proto.Messages().ByName("PoolAllocation")
+	fd_PoolAllocation_denom = md_PoolAllocation.Fields().ByName("denom")
+	fd_PoolAllocation_base_fraction = md_PoolAllocation.Fields().ByName("base_fraction")
+	fd_PoolAllocation_adjusted_fraction = md_PoolAllocation.Fields().ByName("adjusted_fraction")
+}
+
+var _ protoreflect.Message = (*fastReflection_PoolAllocation)(nil)
+
+type fastReflection_PoolAllocation PoolAllocation
+
+func (x *PoolAllocation) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PoolAllocation)(x)
+}
+
+func (x *PoolAllocation) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_distribution_v1_distribution_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PoolAllocation_messageType fastReflection_PoolAllocation_messageType
+var _ protoreflect.MessageType = fastReflection_PoolAllocation_messageType{}
+
+type fastReflection_PoolAllocation_messageType struct{}
+
+func (x fastReflection_PoolAllocation_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PoolAllocation)(nil)
+}
+func (x fastReflection_PoolAllocation_messageType) New() protoreflect.Message {
+	return new(fastReflection_PoolAllocation)
+}
+func (x fastReflection_PoolAllocation_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PoolAllocation
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PoolAllocation) Descriptor() protoreflect.MessageDescriptor {
+	return md_PoolAllocation
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PoolAllocation) Type() protoreflect.MessageType {
+	return _fastReflection_PoolAllocation_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PoolAllocation) New() protoreflect.Message {
+	return new(fastReflection_PoolAllocation)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PoolAllocation) Interface() protoreflect.ProtoMessage {
+	return (*PoolAllocation)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PoolAllocation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_PoolAllocation_denom, value) {
+			return
+		}
+	}
+	if x.BaseFraction != "" {
+		value := protoreflect.ValueOfString(x.BaseFraction)
+		if !f(fd_PoolAllocation_base_fraction, value) {
+			return
+		}
+	}
+	if x.AdjustedFraction != "" {
+		value := protoreflect.ValueOfString(x.AdjustedFraction)
+		if !f(fd_PoolAllocation_adjusted_fraction, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PoolAllocation) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.distribution.v1.PoolAllocation.denom":
+		return x.Denom != ""
+	case "initia.distribution.v1.PoolAllocation.base_fraction":
+		return x.BaseFraction != ""
+	case "initia.distribution.v1.PoolAllocation.adjusted_fraction":
+		return x.AdjustedFraction != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.PoolAllocation"))
+		}
+		panic(fmt.Errorf("message initia.distribution.v1.PoolAllocation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PoolAllocation) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.distribution.v1.PoolAllocation.denom":
+		x.Denom = ""
+	case "initia.distribution.v1.PoolAllocation.base_fraction":
+		x.BaseFraction = ""
+	case "initia.distribution.v1.PoolAllocation.adjusted_fraction":
+		x.AdjustedFraction = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.PoolAllocation"))
+		}
+		panic(fmt.Errorf("message initia.distribution.v1.PoolAllocation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PoolAllocation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.distribution.v1.PoolAllocation.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "initia.distribution.v1.PoolAllocation.base_fraction":
+		value := x.BaseFraction
+		return protoreflect.ValueOfString(value)
+	case "initia.distribution.v1.PoolAllocation.adjusted_fraction":
+		value := x.AdjustedFraction
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.PoolAllocation"))
+		}
+		panic(fmt.Errorf("message initia.distribution.v1.PoolAllocation does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PoolAllocation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.distribution.v1.PoolAllocation.denom":
+		x.Denom = value.Interface().(string)
+	case "initia.distribution.v1.PoolAllocation.base_fraction":
+		x.BaseFraction = value.Interface().(string)
+	case "initia.distribution.v1.PoolAllocation.adjusted_fraction":
+		x.AdjustedFraction = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.PoolAllocation"))
+		}
+		panic(fmt.Errorf("message initia.distribution.v1.PoolAllocation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PoolAllocation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.distribution.v1.PoolAllocation.denom":
+		panic(fmt.Errorf("field denom of message initia.distribution.v1.PoolAllocation is not mutable"))
+	case "initia.distribution.v1.PoolAllocation.base_fraction":
+		panic(fmt.Errorf("field base_fraction of message initia.distribution.v1.PoolAllocation is not mutable"))
+	case "initia.distribution.v1.PoolAllocation.adjusted_fraction":
+		panic(fmt.Errorf("field adjusted_fraction of message initia.distribution.v1.PoolAllocation is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.PoolAllocation"))
+		}
+		panic(fmt.Errorf("message initia.distribution.v1.PoolAllocation does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PoolAllocation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.distribution.v1.PoolAllocation.denom":
+		return protoreflect.ValueOfString("")
+	case "initia.distribution.v1.PoolAllocation.base_fraction":
+		return protoreflect.ValueOfString("")
+	case "initia.distribution.v1.PoolAllocation.adjusted_fraction":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.distribution.v1.PoolAllocation"))
+		}
+		panic(fmt.Errorf("message initia.distribution.v1.PoolAllocation does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PoolAllocation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.distribution.v1.PoolAllocation", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PoolAllocation) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PoolAllocation) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PoolAllocation) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PoolAllocation) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PoolAllocation)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BaseFraction)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.AdjustedFraction)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PoolAllocation)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.AdjustedFraction) > 0 {
+			i -= len(x.AdjustedFraction)
+			copy(dAtA[i:], x.AdjustedFraction)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AdjustedFraction)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.BaseFraction) > 0 {
+			i -= len(x.BaseFraction)
+			copy(dAtA[i:], x.BaseFraction)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BaseFraction)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PoolAllocation)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PoolAllocation: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PoolAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseFraction", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BaseFraction = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RewardWeights", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AdjustedFraction", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -573,25 +2490,23 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.RewardWeights = append(x.RewardWeights, &RewardWeight{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RewardWeights[len(x.RewardWeights)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.AdjustedFraction = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -650,7 +2565,7 @@ func (x *RewardWeight) ProtoReflect() protoreflect.Message {
 }
 
 func (x *RewardWeight) slowProtoReflect() protoreflect.Message {
-	mi := &file_initia_distribution_v1_distribution_proto_msgTypes[1]
+	mi := &file_initia_distribution_v1_distribution_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1185,7 +3100,7 @@ func (x *Pool) ProtoReflect() protoreflect.Message {
 }
 
 func (x *Pool) slowProtoReflect() protoreflect.Message {
-	mi := &file_initia_distribution_v1_distribution_proto_msgTypes[2]
+	mi := &file_initia_distribution_v1_distribution_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1743,7 +3658,7 @@ func (x *DecPool) ProtoReflect() protoreflect.Message {
 }
 
 func (x *DecPool) slowProtoReflect() protoreflect.Message {
-	mi := &file_initia_distribution_v1_distribution_proto_msgTypes[3]
+	mi := &file_initia_distribution_v1_distribution_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2301,7 +4216,7 @@ func (x *ValidatorHistoricalRewards) ProtoReflect() protoreflect.Message {
 }
 
 func (x *ValidatorHistoricalRewards) slowProtoReflect() protoreflect.Message {
-	mi := &file_initia_distribution_v1_distribution_proto_msgTypes[4]
+	mi := &file_initia_distribution_v1_distribution_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2843,7 +4758,7 @@ func (x *ValidatorCurrentRewards) ProtoReflect() protoreflect.Message {
 }
 
 func (x *ValidatorCurrentRewards) slowProtoReflect() protoreflect.Message {
-	mi := &file_initia_distribution_v1_distribution_proto_msgTypes[5]
+	mi := &file_initia_distribution_v1_distribution_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3383,7 +5298,7 @@ func (x *ValidatorAccumulatedCommission) ProtoReflect() protoreflect.Message {
 }
 
 func (x *ValidatorAccumulatedCommission) slowProtoReflect() protoreflect.Message {
-	mi := &file_initia_distribution_v1_distribution_proto_msgTypes[6]
+	mi := &file_initia_distribution_v1_distribution_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3877,7 +5792,7 @@ func (x *ValidatorOutstandingRewards) ProtoReflect() protoreflect.Message {
 }
 
 func (x *ValidatorOutstandingRewards) slowProtoReflect() protoreflect.Message {
-	mi := &file_initia_distribution_v1_distribution_proto_msgTypes[7]
+	mi := &file_initia_distribution_v1_distribution_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4373,7 +6288,7 @@ func (x *ValidatorSlashEvent) ProtoReflect() protoreflect.Message {
 }
 
 func (x *ValidatorSlashEvent) slowProtoReflect() protoreflect.Message {
-	mi := &file_initia_distribution_v1_distribution_proto_msgTypes[8]
+	mi := &file_initia_distribution_v1_distribution_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4913,7 +6828,7 @@ func (x *ValidatorSlashEvents) ProtoReflect() protoreflect.Message {
 }
 
 func (x *ValidatorSlashEvents) slowProtoReflect() protoreflect.Message {
-	mi := &file_initia_distribution_v1_distribution_proto_msgTypes[9]
+	mi := &file_initia_distribution_v1_distribution_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5411,7 +7326,7 @@ func (x *DelegatorStartingInfo) ProtoReflect() protoreflect.Message {
 }
 
 func (x *DelegatorStartingInfo) slowProtoReflect() protoreflect.Message {
-	mi := &file_initia_distribution_v1_distribution_proto_msgTypes[10]
+	mi := &file_initia_distribution_v1_distribution_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5999,7 +7914,7 @@ func (x *DelegationDelegatorReward) ProtoReflect() protoreflect.Message {
 }
 
 func (x *DelegationDelegatorReward) slowProtoReflect() protoreflect.Message {
-	mi := &file_initia_distribution_v1_distribution_proto_msgTypes[11]
+	mi := &file_initia_distribution_v1_distribution_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6506,6 +8421,16 @@ type Params struct {
 	CommunityTax        string          `protobuf:"bytes,1,opt,name=community_tax,json=communityTax,proto3" json:"community_tax,omitempty"`
 	WithdrawAddrEnabled bool            `protobuf:"varint,2,opt,name=withdraw_addr_enabled,json=withdrawAddrEnabled,proto3" json:"withdraw_addr_enabled,omitempty"`
 	RewardWeights       []*RewardWeight `protobuf:"bytes,3,rep,name=reward_weights,json=rewardWeights,proto3" json:"reward_weights,omitempty"`
+	// decentralization_bonus configures the built-in allocation policy.
+	DecentralizationBonus *DecentralizationBonus `protobuf:"bytes,4,opt,name=decentralization_bonus,json=decentralizationBonus,proto3" json:"decentralization_bonus,omitempty"`
+	// auto_compound_interval is the number of blocks between the passes that
+	// compound the rewards of the auto-compounding delegations. Zero disables
+	// auto-compounding.
+	AutoCompoundInterval uint64 `protobuf:"varint,5,opt,name=auto_compound_interval,json=autoCompoundInterval,proto3" json:"auto_compound_interval,omitempty"`
+	// auto_compound_max_per_block is the maximum number of delegations
+	// compounded in a block. A pass continues in the next blocks until every
+	// delegation is processed.
+	AutoCompoundMaxPerBlock uint32 `protobuf:"varint,6,opt,name=auto_compound_max_per_block,json=autoCompoundMaxPerBlock,proto3" json:"auto_compound_max_per_block,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -6549,6 +8474,200 @@ func (x *Params) GetRewardWeights() []*RewardWeight {
 	return nil
 }
 
+func (x *Params) GetDecentralizationBonus() *DecentralizationBonus {
+	if x != nil {
+		return x.DecentralizationBonus
+	}
+	return nil
+}
+
+func (x *Params) GetAutoCompoundInterval() uint64 {
+	if x != nil {
+		return x.AutoCompoundInterval
+	}
+	return 0
+}
+
+func (x *Params) GetAutoCompoundMaxPerBlock() uint32 {
+	if x != nil {
+		return x.AutoCompoundMaxPerBlock
+	}
+	return 0
+}
+
+// DecentralizationBonus multiplies the reward weights of the validators
+// ranked below the top N by voting power, directing more of every reward
+// pool toward them.
+type DecentralizationBonus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// top_n is the number of validators by voting power that get no bonus.
+	TopN uint32 `protobuf:"varint,2,opt,name=top_n,json=topN,proto3" json:"top_n,omitempty"`
+	// multiplier is the reward weight multiplier of the other validators.
+	Multiplier string `protobuf:"bytes,3,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
+}
+
+func (x *DecentralizationBonus) Reset() {
+	*x = DecentralizationBonus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_distribution_v1_distribution_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DecentralizationBonus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecentralizationBonus) ProtoMessage() {}
+
+// Deprecated: Use DecentralizationBonus.ProtoReflect.Descriptor instead.
+func (*DecentralizationBonus) Descriptor() ([]byte, []int) {
+	return file_initia_distribution_v1_distribution_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DecentralizationBonus) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *DecentralizationBonus) GetTopN() uint32 {
+	if x != nil {
+		return x.TopN
+	}
+	return 0
+}
+
+func (x *DecentralizationBonus) GetMultiplier() string {
+	if x != nil {
+		return x.Multiplier
+	}
+	return ""
+}
+
+// ValidatorAllocation represents the effect of the allocation policy on the
+// reward weights of a validator.
+type ValidatorAllocation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// rank is the rank of the validator by voting power among the bonded
+	// validators, starting from 1. It is zero when the validator is not bonded.
+	Rank uint32 `protobuf:"varint,2,opt,name=rank,proto3" json:"rank,omitempty"`
+	// multiplier is the reward weight multiplier the policy applies.
+	Multiplier string            `protobuf:"bytes,3,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
+	Pools      []*PoolAllocation `protobuf:"bytes,4,rep,name=pools,proto3" json:"pools,omitempty"`
+}
+
+func (x *ValidatorAllocation) Reset() {
+	*x = ValidatorAllocation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_distribution_v1_distribution_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorAllocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorAllocation) ProtoMessage() {}
+
+// Deprecated: Use ValidatorAllocation.ProtoReflect.Descriptor instead.
+func (*ValidatorAllocation) Descriptor() ([]byte, []int) {
+	return file_initia_distribution_v1_distribution_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ValidatorAllocation) GetValidatorAddress() string {
+	if x != nil {
+		return x.ValidatorAddress
+	}
+	return ""
+}
+
+func (x *ValidatorAllocation) GetRank() uint32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *ValidatorAllocation) GetMultiplier() string {
+	if x != nil {
+		return x.Multiplier
+	}
+	return ""
+}
+
+func (x *ValidatorAllocation) GetPools() []*PoolAllocation {
+	if x != nil {
+		return x.Pools
+	}
+	return nil
+}
+
+// PoolAllocation represents the share of a reward pool allocated to a
+// validator before and after the allocation policy.
+type PoolAllocation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Denom            string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	BaseFraction     string `protobuf:"bytes,2,opt,name=base_fraction,json=baseFraction,proto3" json:"base_fraction,omitempty"`
+	AdjustedFraction string `protobuf:"bytes,3,opt,name=adjusted_fraction,json=adjustedFraction,proto3" json:"adjusted_fraction,omitempty"`
+}
+
+func (x *PoolAllocation) Reset() {
+	*x = PoolAllocation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_distribution_v1_distribution_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PoolAllocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PoolAllocation) ProtoMessage() {}
+
+// Deprecated: Use PoolAllocation.ProtoReflect.Descriptor instead.
+func (*PoolAllocation) Descriptor() ([]byte, []int) {
+	return file_initia_distribution_v1_distribution_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PoolAllocation) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *PoolAllocation) GetBaseFraction() string {
+	if x != nil {
+		return x.BaseFraction
+	}
+	return ""
+}
+
+func (x *PoolAllocation) GetAdjustedFraction() string {
+	if x != nil {
+		return x.AdjustedFraction
+	}
+	return ""
+}
+
 // RewardWeight represents reward allocation ratio between
 // pools.
 type RewardWeight struct {
@@ -6563,7 +8682,7 @@ type RewardWeight struct {
 func (x *RewardWeight) Reset() {
 	*x = RewardWeight{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_initia_distribution_v1_distribution_proto_msgTypes[1]
+		mi := &file_initia_distribution_v1_distribution_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6577,7 +8696,7 @@ func (*RewardWeight) ProtoMessage() {}
 
 // Deprecated: Use RewardWeight.ProtoReflect.Descriptor instead.
 func (*RewardWeight) Descriptor() ([]byte, []int) {
-	return file_initia_distribution_v1_distribution_proto_rawDescGZIP(), []int{1}
+	return file_initia_distribution_v1_distribution_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *RewardWeight) GetDenom() string {
@@ -6607,7 +8726,7 @@ type Pool struct {
 func (x *Pool) Reset() {
 	*x = Pool{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_initia_distribution_v1_distribution_proto_msgTypes[2]
+		mi := &file_initia_distribution_v1_distribution_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6621,7 +8740,7 @@ func (*Pool) ProtoMessage() {}
 
 // Deprecated: Use Pool.ProtoReflect.Descriptor instead.
 func (*Pool) Descriptor() ([]byte, []int) {
-	return file_initia_distribution_v1_distribution_proto_rawDescGZIP(), []int{2}
+	return file_initia_distribution_v1_distribution_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Pool) GetDenom() string {
@@ -6651,7 +8770,7 @@ type DecPool struct {
 func (x *DecPool) Reset() {
 	*x = DecPool{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_initia_distribution_v1_distribution_proto_msgTypes[3]
+		mi := &file_initia_distribution_v1_distribution_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6665,7 +8784,7 @@ func (*DecPool) ProtoMessage() {}
 
 // Deprecated: Use DecPool.ProtoReflect.Descriptor instead.
 func (*DecPool) Descriptor() ([]byte, []int) {
-	return file_initia_distribution_v1_distribution_proto_rawDescGZIP(), []int{3}
+	return file_initia_distribution_v1_distribution_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *DecPool) GetDenom() string {
@@ -6707,7 +8826,7 @@ type ValidatorHistoricalRewards struct {
 func (x *ValidatorHistoricalRewards) Reset() {
 	*x = ValidatorHistoricalRewards{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_initia_distribution_v1_distribution_proto_msgTypes[4]
+		mi := &file_initia_distribution_v1_distribution_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6721,7 +8840,7 @@ func (*ValidatorHistoricalRewards) ProtoMessage() {}
 
 // Deprecated: Use ValidatorHistoricalRewards.ProtoReflect.Descriptor instead.
 func (*ValidatorHistoricalRewards) Descriptor() ([]byte, []int) {
-	return file_initia_distribution_v1_distribution_proto_rawDescGZIP(), []int{4}
+	return file_initia_distribution_v1_distribution_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ValidatorHistoricalRewards) GetCumulativeRewardRatios() []*DecPool {
@@ -6753,7 +8872,7 @@ type ValidatorCurrentRewards struct {
 func (x *ValidatorCurrentRewards) Reset() {
 	*x = ValidatorCurrentRewards{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_initia_distribution_v1_distribution_proto_msgTypes[5]
+		mi := &file_initia_distribution_v1_distribution_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6767,7 +8886,7 @@ func (*ValidatorCurrentRewards) ProtoMessage() {}
 
 // Deprecated: Use ValidatorCurrentRewards.ProtoReflect.Descriptor instead.
 func (*ValidatorCurrentRewards) Descriptor() ([]byte, []int) {
-	return file_initia_distribution_v1_distribution_proto_rawDescGZIP(), []int{5}
+	return file_initia_distribution_v1_distribution_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ValidatorCurrentRewards) GetRewards() []*DecPool {
@@ -6797,7 +8916,7 @@ type ValidatorAccumulatedCommission struct {
 func (x *ValidatorAccumulatedCommission) Reset() {
 	*x = ValidatorAccumulatedCommission{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_initia_distribution_v1_distribution_proto_msgTypes[6]
+		mi := &file_initia_distribution_v1_distribution_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6811,7 +8930,7 @@ func (*ValidatorAccumulatedCommission) ProtoMessage() {}
 
 // Deprecated: Use ValidatorAccumulatedCommission.ProtoReflect.Descriptor instead.
 func (*ValidatorAccumulatedCommission) Descriptor() ([]byte, []int) {
-	return file_initia_distribution_v1_distribution_proto_rawDescGZIP(), []int{6}
+	return file_initia_distribution_v1_distribution_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ValidatorAccumulatedCommission) GetCommissions() []*DecPool {
@@ -6834,7 +8953,7 @@ type ValidatorOutstandingRewards struct {
 func (x *ValidatorOutstandingRewards) Reset() {
 	*x = ValidatorOutstandingRewards{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_initia_distribution_v1_distribution_proto_msgTypes[7]
+		mi := &file_initia_distribution_v1_distribution_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6848,7 +8967,7 @@ func (*ValidatorOutstandingRewards) ProtoMessage() {}
 
 // Deprecated: Use ValidatorOutstandingRewards.ProtoReflect.Descriptor instead.
 func (*ValidatorOutstandingRewards) Descriptor() ([]byte, []int) {
-	return file_initia_distribution_v1_distribution_proto_rawDescGZIP(), []int{7}
+	return file_initia_distribution_v1_distribution_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ValidatorOutstandingRewards) GetRewards() []*DecPool {
@@ -6874,7 +8993,7 @@ type ValidatorSlashEvent struct {
 func (x *ValidatorSlashEvent) Reset() {
 	*x = ValidatorSlashEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_initia_distribution_v1_distribution_proto_msgTypes[8]
+		mi := &file_initia_distribution_v1_distribution_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6888,7 +9007,7 @@ func (*ValidatorSlashEvent) ProtoMessage() {}
 
 // Deprecated: Use ValidatorSlashEvent.ProtoReflect.Descriptor instead.
 func (*ValidatorSlashEvent) Descriptor() ([]byte, []int) {
-	return file_initia_distribution_v1_distribution_proto_rawDescGZIP(), []int{8}
+	return file_initia_distribution_v1_distribution_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ValidatorSlashEvent) GetValidatorPeriod() uint64 {
@@ -6917,7 +9036,7 @@ type ValidatorSlashEvents struct {
 func (x *ValidatorSlashEvents) Reset() {
 	*x = ValidatorSlashEvents{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_initia_distribution_v1_distribution_proto_msgTypes[9]
+		mi := &file_initia_distribution_v1_distribution_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6931,7 +9050,7 @@ func (*ValidatorSlashEvents) ProtoMessage() {}
 
 // Deprecated: Use ValidatorSlashEvents.ProtoReflect.Descriptor instead.
 func (*ValidatorSlashEvents) Descriptor() ([]byte, []int) {
-	return file_initia_distribution_v1_distribution_proto_rawDescGZIP(), []int{9}
+	return file_initia_distribution_v1_distribution_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ValidatorSlashEvents) GetValidatorSlashEvents() []*ValidatorSlashEvent {
@@ -6960,7 +9079,7 @@ type DelegatorStartingInfo struct {
 func (x *DelegatorStartingInfo) Reset() {
 	*x = DelegatorStartingInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_initia_distribution_v1_distribution_proto_msgTypes[10]
+		mi := &file_initia_distribution_v1_distribution_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6974,7 +9093,7 @@ func (*DelegatorStartingInfo) ProtoMessage() {}
 
 // Deprecated: Use DelegatorStartingInfo.ProtoReflect.Descriptor instead.
 func (*DelegatorStartingInfo) Descriptor() ([]byte, []int) {
-	return file_initia_distribution_v1_distribution_proto_rawDescGZIP(), []int{10}
+	return file_initia_distribution_v1_distribution_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *DelegatorStartingInfo) GetPreviousPeriod() uint64 {
@@ -7012,7 +9131,7 @@ type DelegationDelegatorReward struct {
 func (x *DelegationDelegatorReward) Reset() {
 	*x = DelegationDelegatorReward{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_initia_distribution_v1_distribution_proto_msgTypes[11]
+		mi := &file_initia_distribution_v1_distribution_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7026,7 +9145,7 @@ func (*DelegationDelegatorReward) ProtoMessage() {}
 
 // Deprecated: Use DelegationDelegatorReward.ProtoReflect.Descriptor instead.
 func (*DelegationDelegatorReward) Descriptor() ([]byte, []int) {
-	return file_initia_distribution_v1_distribution_proto_rawDescGZIP(), []int{11}
+	return file_initia_distribution_v1_distribution_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *DelegationDelegatorReward) GetValidatorAddress() string {
@@ -7056,7 +9175,7 @@ var file_initia_distribution_v1_distribution_proto_rawDesc = []byte{
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67,
-	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe1, 0x02, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61,
+	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb3, 0x05, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61,
 	0x6d, 0x73, 0x12, 0x73, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x5f,
 	0x74, 0x61, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4e, 0xc8, 0xde, 0x1f, 0x00, 0xda,
 	0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
@@ -7076,153 +9195,216 @@ var file_initia_distribution_v1_distribution_proto_rawDesc = []byte{
 	0x65, 0x77, 0x61, 0x72, 0x64, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x42, 0x1d, 0xc8, 0xde, 0x1f,
 	0x00, 0xf2, 0xde, 0x1f, 0x15, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65, 0x77, 0x61, 0x72,
 	0x64, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x22, 0x52, 0x0d, 0x72, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x3a, 0x20, 0x98, 0xa0, 0x1f, 0x00, 0xe8,
-	0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x13, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75,
-	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x97, 0x01, 0x0a, 0x0c,
-	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x26, 0x0a, 0x05,
-	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xf2, 0xde, 0x1f,
-	0x0c, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x52, 0x05, 0x64,
-	0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x5f, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x47, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
-	0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xf2, 0xde, 0x1f, 0x0d, 0x79, 0x61, 0x6d,
-	0x6c, 0x3a, 0x22, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x77,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xa1, 0x01, 0x0a, 0x04, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x26,
-	0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xf2,
-	0xde, 0x1f, 0x0c, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x52,
-	0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x71, 0x0a, 0x05, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
-	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
-	0x42, 0x40, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x0c, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
-	0x63, 0x6f, 0x69, 0x6e, 0x73, 0x22, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69,
-	0x6e, 0x73, 0x52, 0x05, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x22, 0xb6, 0x01, 0x0a, 0x07, 0x44, 0x65,
-	0x63, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x26, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xf2, 0xde, 0x1f, 0x0c, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
-	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x82, 0x01,
-	0x0a, 0x09, 0x64, 0x65, 0x63, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x42,
-	0x47, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x10, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64,
-	0x65, 0x63, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x22, 0xaa, 0xdf, 0x1f, 0x2b, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x08, 0x64, 0x65, 0x63, 0x43, 0x6f, 0x69,
-	0x6e, 0x73, 0x22, 0xf2, 0x01, 0x0a, 0x1a, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
-	0x73, 0x12, 0x8e, 0x01, 0x0a, 0x18, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65,
-	0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x64, 0x69,
-	0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
-	0x63, 0x50, 0x6f, 0x6f, 0x6c, 0x42, 0x33, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x1f, 0x79,
-	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f,
-	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x73, 0x22, 0xaa, 0xdf,
-	0x1f, 0x08, 0x44, 0x65, 0x63, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x16, 0x63, 0x75, 0x6d, 0x75,
-	0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x61, 0x74, 0x69,
-	0x6f, 0x73, 0x12, 0x43, 0x0a, 0x0f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x1a, 0xf2, 0xde, 0x1f,
-	0x16, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
-	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x52, 0x0e, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
-	0x63, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x90, 0x01, 0x0a, 0x17, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x73, 0x12, 0x5d, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x64, 0x69,
-	0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
-	0x63, 0x50, 0x6f, 0x6f, 0x6c, 0x42, 0x22, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x0e, 0x79,
-	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x22, 0xaa, 0xdf, 0x1f,
-	0x08, 0x44, 0x65, 0x63, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72,
-	0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x22, 0x8b, 0x01, 0x0a, 0x1e, 0x56,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61,
-	0x74, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x69, 0x0a,
-	0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x72, 0x64, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0x90, 0x01, 0x0a, 0x16, 0x64, 0x65,
+	0x63, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62,
+	0x6f, 0x6e, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x61, 0x2e, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6f, 0x6e, 0x75, 0x73, 0x42, 0x2a, 0xc8, 0xde, 0x1f, 0x00, 0xf2,
+	0xde, 0x1f, 0x1d, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x72,
+	0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6f, 0x6e, 0x75, 0x73, 0x22,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x15, 0x64, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x6c,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6f, 0x6e, 0x75, 0x73, 0x12, 0x57, 0x0a, 0x16,
+	0x61, 0x75, 0x74, 0x6f, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x42, 0x21, 0xf2, 0xde,
+	0x1f, 0x1d, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x63, 0x6f, 0x6d,
+	0x70, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x22, 0x52,
+	0x14, 0x61, 0x75, 0x74, 0x6f, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x64, 0x0a, 0x1b, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x63, 0x6f,
+	0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x26, 0xf2, 0xde, 0x1f, 0x22,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
+	0x75, 0x6e, 0x64, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x22, 0x52, 0x17, 0x61, 0x75, 0x74, 0x6f, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64,
+	0x4d, 0x61, 0x78, 0x50, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x3a, 0x20, 0x98, 0xa0, 0x1f,
+	0x00, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x13, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0xb0, 0x01,
+	0x0a, 0x15, 0x44, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x6f, 0x6e, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x12, 0x25, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x5f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x42, 0x10, 0xf2, 0xde, 0x1f, 0x0c, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x74, 0x6f, 0x70, 0x5f,
+	0x6e, 0x22, 0x52, 0x04, 0x74, 0x6f, 0x70, 0x4e, 0x12, 0x56, 0x0a, 0x0a, 0x6d, 0x75, 0x6c, 0x74,
+	0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde,
+	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65,
+	0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72,
+	0x22, 0xf7, 0x01, 0x0a, 0x13, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x6c,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x56, 0x0a, 0x0a, 0x6d, 0x75, 0x6c,
+	0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8,
+	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44,
+	0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65,
+	0x72, 0x12, 0x47, 0x0a, 0x05, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x26, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x41, 0x6c,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x05, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x22, 0xe8, 0x01, 0x0a, 0x0e, 0x50,
+	0x6f, 0x6f, 0x6c, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a,
+	0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65,
+	0x6e, 0x6f, 0x6d, 0x12, 0x5b, 0x0a, 0x0d, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x72, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2,
+	0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x63, 0x0a, 0x11, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63,
+	0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x10, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x65, 0x64, 0x46, 0x72, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x97, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x26, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xf2, 0xde, 0x1f, 0x0c, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
+	0x22, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x5f,
+	0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x47,
+	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79,
+	0x44, 0x65, 0x63, 0xf2, 0xde, 0x1f, 0x0d, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x77, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x22, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44,
+	0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22,
+	0xa1, 0x01, 0x0a, 0x04, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x26, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f,
+	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xf2, 0xde, 0x1f, 0x0c, 0x79, 0x61, 0x6d,
+	0x6c, 0x3a, 0x22, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
+	0x12, 0x71, 0x0a, 0x05, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x40, 0xc8, 0xde, 0x1f, 0x00,
+	0xf2, 0xde, 0x1f, 0x0c, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x22,
+	0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b,
+	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x05, 0x63, 0x6f,
+	0x69, 0x6e, 0x73, 0x22, 0xb6, 0x01, 0x0a, 0x07, 0x44, 0x65, 0x63, 0x50, 0x6f, 0x6f, 0x6c, 0x12,
+	0x26, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10,
+	0xf2, 0xde, 0x1f, 0x0c, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22,
+	0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x82, 0x01, 0x0a, 0x09, 0x64, 0x65, 0x63, 0x5f,
+	0x63, 0x6f, 0x69, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x47, 0xc8, 0xde, 0x1f, 0x00, 0xf2,
+	0xde, 0x1f, 0x10, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64, 0x65, 0x63, 0x5f, 0x63, 0x6f, 0x69,
+	0x6e, 0x73, 0x22, 0xaa, 0xdf, 0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d,
+	0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69,
+	0x6e, 0x73, 0x52, 0x08, 0x64, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x22, 0xf2, 0x01, 0x0a,
+	0x1a, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x8e, 0x01, 0x0a, 0x18,
+	0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x50, 0x6f, 0x6f, 0x6c, 0x42,
+	0x33, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x1f, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63,
+	0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x73, 0x22, 0xaa, 0xdf, 0x1f, 0x08, 0x44, 0x65, 0x63, 0x50,
+	0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x16, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x73, 0x12, 0x43, 0x0a, 0x0f,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x1a, 0xf2, 0xde, 0x1f, 0x16, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
+	0x22, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x52, 0x0e, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x22, 0x90, 0x01, 0x0a, 0x17, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x43,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x5d, 0x0a,
+	0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x50, 0x6f, 0x6f, 0x6c, 0x42,
+	0x22, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x0e, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x22, 0xaa, 0xdf, 0x1f, 0x08, 0x44, 0x65, 0x63, 0x50, 0x6f,
+	0x6f, 0x6c, 0x73, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x22, 0x8b, 0x01, 0x0a, 0x1e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x41, 0x63, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x69, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x50, 0x6f, 0x6f, 0x6c, 0x42, 0x26, 0xc8,
+	0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x12, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xaa, 0xdf, 0x1f, 0x08, 0x44, 0x65, 0x63,
+	0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x22, 0x7c, 0x0a, 0x1b, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x4f,
+	0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x73, 0x12, 0x5d, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
 	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x64, 0x69, 0x73, 0x74,
 	0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x50,
-	0x6f, 0x6f, 0x6c, 0x42, 0x26, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x12, 0x79, 0x61, 0x6d,
-	0x6c, 0x3a, 0x22, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xaa,
-	0xdf, 0x1f, 0x08, 0x44, 0x65, 0x63, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x0b, 0x63, 0x6f, 0x6d,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x7c, 0x0a, 0x1b, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67,
-	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x5d, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72,
-	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69,
-	0x61, 0x2e, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
-	0x31, 0x2e, 0x44, 0x65, 0x63, 0x50, 0x6f, 0x6f, 0x6c, 0x42, 0x22, 0xc8, 0xde, 0x1f, 0x00, 0xf2,
-	0xde, 0x1f, 0x0e, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73,
-	0x22, 0xaa, 0xdf, 0x1f, 0x08, 0x44, 0x65, 0x63, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x07, 0x72,
-	0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x22, 0xe3, 0x01, 0x0a, 0x13, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x6f, 0x72, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x46,
-	0x0a, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x70, 0x65, 0x72, 0x69,
-	0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1b, 0xf2, 0xde, 0x1f, 0x17, 0x79, 0x61,
-	0x6d, 0x6c, 0x3a, 0x22, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x70, 0x65,
-	0x72, 0x69, 0x6f, 0x64, 0x22, 0x52, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x83, 0x01, 0x0a, 0x09, 0x66, 0x72, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x47, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde,
-	0x1f, 0x10, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x22, 0xaa, 0xdf, 0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73,
-	0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e,
-	0x73, 0x52, 0x09, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xa7, 0x01, 0x0a,
-	0x14, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x88, 0x01, 0x0a, 0x16, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x5f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e,
-	0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
-	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x42, 0x25, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x1d, 0x79, 0x61, 0x6d,
-	0x6c, 0x3a, 0x22, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x6c, 0x61,
-	0x73, 0x68, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x52, 0x14, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
-	0x3a, 0x04, 0x98, 0xa0, 0x1f, 0x00, 0x22, 0x9f, 0x02, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x67,
-	0x61, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f,
-	0x12, 0x43, 0x0a, 0x0f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x70, 0x65, 0x72,
-	0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xf2, 0xde, 0x1f, 0x16, 0x79,
-	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x70, 0x65,
-	0x72, 0x69, 0x6f, 0x64, 0x22, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x50,
-	0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x7a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
-	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x43,
-	0x6f, 0x69, 0x6e, 0x42, 0x44, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x0d, 0x79, 0x61, 0x6d,
-	0x6c, 0x3a, 0x22, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x73, 0x22, 0xaa, 0xdf, 0x1f, 0x2b, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x6b, 0x65,
-	0x73, 0x12, 0x45, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x04, 0x42, 0x2d, 0xea, 0xde, 0x1f, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0xf2, 0xde, 0x1f, 0x16, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22,
-	0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xbb, 0x01, 0x0a, 0x19, 0x44, 0x65, 0x6c,
-	0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72,
-	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x49, 0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x1c, 0xf2, 0xde, 0x1f, 0x18, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x52,
-	0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x12, 0x49, 0x0a, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x64, 0x69, 0x73, 0x74, 0x72,
-	0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x50, 0x6f,
-	0x6f, 0x6c, 0x42, 0x10, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x08, 0x44, 0x65, 0x63, 0x50,
-	0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x3a, 0x08, 0x88, 0xa0,
-	0x1f, 0x00, 0x98, 0xa0, 0x1f, 0x01, 0x42, 0xf6, 0x01, 0xa8, 0xe2, 0x1e, 0x01, 0x0a, 0x1a, 0x63,
-	0x6f, 0x6d, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69,
-	0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x42, 0x11, 0x44, 0x69, 0x73, 0x74, 0x72,
-	0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x47,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69,
-	0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62,
-	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62,
-	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x49, 0x44, 0x58, 0xaa, 0x02, 0x16,
-	0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
-	0x69, 0x6f, 0x6e, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x16, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x5c,
-	0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x31, 0xe2,
-	0x02, 0x22, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x5c, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62,
-	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x18, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x3a, 0x3a, 0x44,
-	0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x3a, 0x56, 0x31, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x6f, 0x6c, 0x42, 0x22, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x0e, 0x79, 0x61, 0x6d,
+	0x6c, 0x3a, 0x22, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x22, 0xaa, 0xdf, 0x1f, 0x08, 0x44,
+	0x65, 0x63, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73,
+	0x22, 0xe3, 0x01, 0x0a, 0x13, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x6c,
+	0x61, 0x73, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x46, 0x0a, 0x10, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x1b, 0xf2, 0xde, 0x1f, 0x17, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x22, 0x52,
+	0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x12, 0x83, 0x01, 0x0a, 0x09, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f,
+	0x69, 0x6e, 0x42, 0x47, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x10, 0x79, 0x61, 0x6d, 0x6c,
+	0x3a, 0x22, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xaa, 0xdf, 0x1f, 0x2b,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x09, 0x66, 0x72, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xa7, 0x01, 0x0a, 0x14, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x88, 0x01, 0x0a, 0x16, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x6c,
+	0x61, 0x73, 0x68, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2b, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x25, 0xc8,
+	0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x1d, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x22, 0x52, 0x14, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53,
+	0x6c, 0x61, 0x73, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x3a, 0x04, 0x98, 0xa0, 0x1f, 0x00,
+	0x22, 0x9f, 0x02, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x43, 0x0a, 0x0f, 0x70, 0x72,
+	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x1a, 0xf2, 0xde, 0x1f, 0x16, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x70,
+	0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x22, 0x52,
+	0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12,
+	0x7a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x44, 0xc8,
+	0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x0d, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x74, 0x61,
+	0x6b, 0x65, 0x73, 0x22, 0xaa, 0xdf, 0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f,
+	0x69, 0x6e, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x73, 0x12, 0x45, 0x0a, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2d, 0xea, 0xde, 0x1f,
+	0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0xf2, 0xde, 0x1f, 0x16, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x22, 0xbb, 0x01, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x12, 0x49, 0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xf2, 0xde, 0x1f,
+	0x18, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x49, 0x0a, 0x06, 0x72,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e,
+	0x69, 0x74, 0x69, 0x61, 0x2e, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x50, 0x6f, 0x6f, 0x6c, 0x42, 0x10, 0xc8, 0xde,
+	0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x08, 0x44, 0x65, 0x63, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x06,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0x98, 0xa0, 0x1f, 0x01,
+	0x42, 0xf6, 0x01, 0xa8, 0xe2, 0x1e, 0x01, 0x0a, 0x1a, 0x63, 0x6f, 0x6d, 0x2e, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x61, 0x2e, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x42, 0x11, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x47, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73,
+	0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x6e, 0x69, 0x74,
+	0x69, 0x61, 0x2f, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2f,
+	0x76, 0x31, 0x3b, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x76,
+	0x31, 0xa2, 0x02, 0x03, 0x49, 0x44, 0x58, 0xaa, 0x02, 0x16, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61,
+	0x2e, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x56, 0x31,
+	0xca, 0x02, 0x16, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x5c, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x22, 0x49, 0x6e, 0x69, 0x74,
+	0x69, 0x61, 0x5c, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5c,
+	0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
+	0x18, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x3a, 0x3a, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -7237,40 +9419,45 @@ func file_initia_distribution_v1_distribution_proto_rawDescGZIP() []byte {
 	return file_initia_distribution_v1_distribution_proto_rawDescData
 }
 
-var file_initia_distribution_v1_distribution_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_initia_distribution_v1_distribution_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_initia_distribution_v1_distribution_proto_goTypes = []interface{}{
 	(*Params)(nil),                         // 0: initia.distribution.v1.Params
-	(*RewardWeight)(nil),                   // 1: initia.distribution.v1.RewardWeight
-	(*Pool)(nil),                           // 2: initia.distribution.v1.Pool
-	(*DecPool)(nil),                        // 3: initia.distribution.v1.DecPool
-	(*ValidatorHistoricalRewards)(nil),     // 4: initia.distribution.v1.ValidatorHistoricalRewards
-	(*ValidatorCurrentRewards)(nil),        // 5: initia.distribution.v1.ValidatorCurrentRewards
-	(*ValidatorAccumulatedCommission)(nil), // 6: initia.distribution.v1.ValidatorAccumulatedCommission
-	(*ValidatorOutstandingRewards)(nil),    // 7: initia.distribution.v1.ValidatorOutstandingRewards
-	(*ValidatorSlashEvent)(nil),            // 8: initia.distribution.v1.ValidatorSlashEvent
-	(*ValidatorSlashEvents)(nil),           // 9: initia.distribution.v1.ValidatorSlashEvents
-	(*DelegatorStartingInfo)(nil),          // 10: initia.distribution.v1.DelegatorStartingInfo
-	(*DelegationDelegatorReward)(nil),      // 11: initia.distribution.v1.DelegationDelegatorReward
-	(*v1beta1.Coin)(nil),                   // 12: cosmos.base.v1beta1.Coin
-	(*v1beta1.DecCoin)(nil),                // 13: cosmos.base.v1beta1.DecCoin
+	(*DecentralizationBonus)(nil),          // 1: initia.distribution.v1.DecentralizationBonus
+	(*ValidatorAllocation)(nil),            // 2: initia.distribution.v1.ValidatorAllocation
+	(*PoolAllocation)(nil),                 // 3: initia.distribution.v1.PoolAllocation
+	(*RewardWeight)(nil),                   // 4: initia.distribution.v1.RewardWeight
+	(*Pool)(nil),                           // 5: initia.distribution.v1.Pool
+	(*DecPool)(nil),                        // 6: initia.distribution.v1.DecPool
+	(*ValidatorHistoricalRewards)(nil),     // 7: initia.distribution.v1.ValidatorHistoricalRewards
+	(*ValidatorCurrentRewards)(nil),        // 8: initia.distribution.v1.ValidatorCurrentRewards
+	(*ValidatorAccumulatedCommission)(nil), // 9: initia.distribution.v1.ValidatorAccumulatedCommission
+	(*ValidatorOutstandingRewards)(nil),    // 10: initia.distribution.v1.ValidatorOutstandingRewards
+	(*ValidatorSlashEvent)(nil),            // 11: initia.distribution.v1.ValidatorSlashEvent
+	(*ValidatorSlashEvents)(nil),           // 12: initia.distribution.v1.ValidatorSlashEvents
+	(*DelegatorStartingInfo)(nil),          // 13: initia.distribution.v1.DelegatorStartingInfo
+	(*DelegationDelegatorReward)(nil),      // 14: initia.distribution.v1.DelegationDelegatorReward
+	(*v1beta1.Coin)(nil),                   // 15: cosmos.base.v1beta1.Coin
+	(*v1beta1.DecCoin)(nil),                // 16: cosmos.base.v1beta1.DecCoin
 }
 var file_initia_distribution_v1_distribution_proto_depIdxs = []int32{
-	1,  // 0: initia.distribution.v1.Params.reward_weights:type_name -> initia.distribution.v1.RewardWeight
-	12, // 1: initia.distribution.v1.Pool.coins:type_name -> cosmos.base.v1beta1.Coin
-	13, // 2: initia.distribution.v1.DecPool.dec_coins:type_name -> cosmos.base.v1beta1.DecCoin
-	3,  // 3: initia.distribution.v1.ValidatorHistoricalRewards.cumulative_reward_ratios:type_name -> initia.distribution.v1.DecPool
-	3,  // 4: initia.distribution.v1.ValidatorCurrentRewards.rewards:type_name -> initia.distribution.v1.DecPool
-	3,  // 5: initia.distribution.v1.ValidatorAccumulatedCommission.commissions:type_name -> initia.distribution.v1.DecPool
-	3,  // 6: initia.distribution.v1.ValidatorOutstandingRewards.rewards:type_name -> initia.distribution.v1.DecPool
-	13, // 7: initia.distribution.v1.ValidatorSlashEvent.fractions:type_name -> cosmos.base.v1beta1.DecCoin
-	8,  // 8: initia.distribution.v1.ValidatorSlashEvents.validator_slash_events:type_name -> initia.distribution.v1.ValidatorSlashEvent
-	13, // 9: initia.distribution.v1.DelegatorStartingInfo.stakes:type_name -> cosmos.base.v1beta1.DecCoin
-	3,  // 10: initia.distribution.v1.DelegationDelegatorReward.reward:type_name -> initia.distribution.v1.DecPool
-	11, // [11:11] is the sub-list for method output_type
-	11, // [11:11] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	4,  // 0: initia.distribution.v1.Params.reward_weights:type_name -> initia.distribution.v1.RewardWeight
+	1,  // 1: initia.distribution.v1.Params.decentralization_bonus:type_name -> initia.distribution.v1.DecentralizationBonus
+	3,  // 2: initia.distribution.v1.ValidatorAllocation.pools:type_name -> initia.distribution.v1.PoolAllocation
+	15, // 3: initia.distribution.v1.Pool.coins:type_name -> cosmos.base.v1beta1.Coin
+	16, // 4: initia.distribution.v1.DecPool.dec_coins:type_name -> cosmos.base.v1beta1.DecCoin
+	6,  // 5: initia.distribution.v1.ValidatorHistoricalRewards.cumulative_reward_ratios:type_name -> initia.distribution.v1.DecPool
+	6,  // 6: initia.distribution.v1.ValidatorCurrentRewards.rewards:type_name -> initia.distribution.v1.DecPool
+	6,  // 7: initia.distribution.v1.ValidatorAccumulatedCommission.commissions:type_name -> initia.distribution.v1.DecPool
+	6,  // 8: initia.distribution.v1.ValidatorOutstandingRewards.rewards:type_name -> initia.distribution.v1.DecPool
+	16, // 9: initia.distribution.v1.ValidatorSlashEvent.fractions:type_name -> cosmos.base.v1beta1.DecCoin
+	11, // 10: initia.distribution.v1.ValidatorSlashEvents.validator_slash_events:type_name -> initia.distribution.v1.ValidatorSlashEvent
+	16, // 11: initia.distribution.v1.DelegatorStartingInfo.stakes:type_name -> cosmos.base.v1beta1.DecCoin
+	6,  // 12: initia.distribution.v1.DelegationDelegatorReward.reward:type_name -> initia.distribution.v1.DecPool
+	13, // [13:13] is the sub-list for method output_type
+	13, // [13:13] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_initia_distribution_v1_distribution_proto_init() }
@@ -7292,7 +9479,7 @@ func file_initia_distribution_v1_distribution_proto_init() {
 			}
 		}
 		file_initia_distribution_v1_distribution_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RewardWeight); i {
+			switch v := v.(*DecentralizationBonus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7304,7 +9491,7 @@ func file_initia_distribution_v1_distribution_proto_init() {
 			}
 		}
 		file_initia_distribution_v1_distribution_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pool); i {
+			switch v := v.(*ValidatorAllocation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7316,7 +9503,7 @@ func file_initia_distribution_v1_distribution_proto_init() {
 			}
 		}
 		file_initia_distribution_v1_distribution_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DecPool); i {
+			switch v := v.(*PoolAllocation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7328,7 +9515,7 @@ func file_initia_distribution_v1_distribution_proto_init() {
 			}
 		}
 		file_initia_distribution_v1_distribution_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidatorHistoricalRewards); i {
+			switch v := v.(*RewardWeight); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7340,7 +9527,7 @@ func file_initia_distribution_v1_distribution_proto_init() {
 			}
 		}
 		file_initia_distribution_v1_distribution_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidatorCurrentRewards); i {
+			switch v := v.(*Pool); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7352,7 +9539,7 @@ func file_initia_distribution_v1_distribution_proto_init() {
 			}
 		}
 		file_initia_distribution_v1_distribution_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidatorAccumulatedCommission); i {
+			switch v := v.(*DecPool); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7364,7 +9551,7 @@ func file_initia_distribution_v1_distribution_proto_init() {
 			}
 		}
 		file_initia_distribution_v1_distribution_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidatorOutstandingRewards); i {
+			switch v := v.(*ValidatorHistoricalRewards); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7376,7 +9563,7 @@ func file_initia_distribution_v1_distribution_proto_init() {
 			}
 		}
 		file_initia_distribution_v1_distribution_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidatorSlashEvent); i {
+			switch v := v.(*ValidatorCurrentRewards); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7388,7 +9575,7 @@ func file_initia_distribution_v1_distribution_proto_init() {
 			}
 		}
 		file_initia_distribution_v1_distribution_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidatorSlashEvents); i {
+			switch v := v.(*ValidatorAccumulatedCommission); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7400,7 +9587,7 @@ func file_initia_distribution_v1_distribution_proto_init() {
 			}
 		}
 		file_initia_distribution_v1_distribution_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DelegatorStartingInfo); i {
+			switch v := v.(*ValidatorOutstandingRewards); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7412,6 +9599,42 @@ func file_initia_distribution_v1_distribution_proto_init() {
 			}
 		}
 		file_initia_distribution_v1_distribution_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorSlashEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_distribution_v1_distribution_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorSlashEvents); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_distribution_v1_distribution_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelegatorStartingInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_distribution_v1_distribution_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DelegationDelegatorReward); i {
 			case 0:
 				return &v.state
@@ -7430,7 +9653,7 @@ func file_initia_distribution_v1_distribution_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_initia_distribution_v1_distribution_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   12,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
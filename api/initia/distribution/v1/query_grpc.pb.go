@@ -25,6 +25,7 @@ const (
 	Query_ValidatorSlashes_FullMethodName            = "/initia.distribution.v1.Query/ValidatorSlashes"
 	Query_DelegationRewards_FullMethodName           = "/initia.distribution.v1.Query/DelegationRewards"
 	Query_DelegationTotalRewards_FullMethodName      = "/initia.distribution.v1.Query/DelegationTotalRewards"
+	Query_ValidatorAllocation_FullMethodName         = "/initia.distribution.v1.Query/ValidatorAllocation"
 )
 
 // QueryClient is the client API for Query service.
@@ -46,6 +47,9 @@ type QueryClient interface {
 	// DelegationTotalRewards queries the total rewards accrued by a each
 	// validator.
 	DelegationTotalRewards(ctx context.Context, in *QueryDelegationTotalRewardsRequest, opts ...grpc.CallOption) (*QueryDelegationTotalRewardsResponse, error)
+	// ValidatorAllocation queries the effect of the allocation policy on the
+	// reward weights of a validator.
+	ValidatorAllocation(ctx context.Context, in *QueryValidatorAllocationRequest, opts ...grpc.CallOption) (*QueryValidatorAllocationResponse, error)
 }
 
 type queryClient struct {
@@ -116,6 +120,16 @@ func (c *queryClient) DelegationTotalRewards(ctx context.Context, in *QueryDeleg
 	return out, nil
 }
 
+func (c *queryClient) ValidatorAllocation(ctx context.Context, in *QueryValidatorAllocationRequest, opts ...grpc.CallOption) (*QueryValidatorAllocationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryValidatorAllocationResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorAllocation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -135,6 +149,9 @@ type QueryServer interface {
 	// DelegationTotalRewards queries the total rewards accrued by a each
 	// validator.
 	DelegationTotalRewards(context.Context, *QueryDelegationTotalRewardsRequest) (*QueryDelegationTotalRewardsResponse, error)
+	// ValidatorAllocation queries the effect of the allocation policy on the
+	// reward weights of a validator.
+	ValidatorAllocation(context.Context, *QueryValidatorAllocationRequest) (*QueryValidatorAllocationResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -163,6 +180,9 @@ func (UnimplementedQueryServer) DelegationRewards(context.Context, *QueryDelegat
 func (UnimplementedQueryServer) DelegationTotalRewards(context.Context, *QueryDelegationTotalRewardsRequest) (*QueryDelegationTotalRewardsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DelegationTotalRewards not implemented")
 }
+func (UnimplementedQueryServer) ValidatorAllocation(context.Context, *QueryValidatorAllocationRequest) (*QueryValidatorAllocationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ValidatorAllocation not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -292,6 +312,24 @@ func _Query_DelegationTotalRewards_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ValidatorAllocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorAllocationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorAllocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorAllocation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorAllocation(ctx, req.(*QueryValidatorAllocationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -323,6 +361,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DelegationTotalRewards",
 			Handler:    _Query_DelegationTotalRewards_Handler,
 		},
+		{
+			MethodName: "ValidatorAllocation",
+			Handler:    _Query_ValidatorAllocation_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "initia/distribution/v1/query.proto",
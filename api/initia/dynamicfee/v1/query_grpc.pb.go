@@ -19,7 +19,8 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_Params_FullMethodName = "/initia.dynamicfee.v1.Query/Params"
+	Query_Params_FullMethodName     = "/initia.dynamicfee.v1.Query/Params"
+	Query_FeeHistory_FullMethodName = "/initia.dynamicfee.v1.Query/FeeHistory"
 )
 
 // QueryClient is the client API for Query service.
@@ -30,6 +31,9 @@ const (
 type QueryClient interface {
 	// Params queries the params
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// FeeHistory queries the base gas price history of the most recent blocks
+	// and the percentiles of the tip gas prices paid by their txs.
+	FeeHistory(ctx context.Context, in *QueryFeeHistoryRequest, opts ...grpc.CallOption) (*QueryFeeHistoryResponse, error)
 }
 
 type queryClient struct {
@@ -50,6 +54,16 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) FeeHistory(ctx context.Context, in *QueryFeeHistoryRequest, opts ...grpc.CallOption) (*QueryFeeHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryFeeHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_FeeHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -58,6 +72,9 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 type QueryServer interface {
 	// Params queries the params
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// FeeHistory queries the base gas price history of the most recent blocks
+	// and the percentiles of the tip gas prices paid by their txs.
+	FeeHistory(context.Context, *QueryFeeHistoryRequest) (*QueryFeeHistoryResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -71,6 +88,9 @@ type UnimplementedQueryServer struct{}
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServer) FeeHistory(context.Context, *QueryFeeHistoryRequest) (*QueryFeeHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method FeeHistory not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -110,6 +130,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FeeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FeeHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeHistory(ctx, req.(*QueryFeeHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -121,6 +159,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "FeeHistory",
+			Handler:    _Query_FeeHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "initia/dynamicfee/v1/query.proto",
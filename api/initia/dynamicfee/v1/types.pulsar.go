@@ -10,18 +10,77 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	reflect "reflect"
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_Params_9_list)(nil)
+
+type _Params_9_list struct {
+	list *[]*FeeDenomOracle
+}
+
+func (x *_Params_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeeDenomOracle)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeeDenomOracle)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_9_list) AppendMutable() protoreflect.Value {
+	v := new(FeeDenomOracle)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_9_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_9_list) NewElement() protoreflect.Value {
+	v := new(FeeDenomOracle)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_9_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Params                    protoreflect.MessageDescriptor
-	fd_Params_base_gas_price     protoreflect.FieldDescriptor
-	fd_Params_min_base_gas_price protoreflect.FieldDescriptor
-	fd_Params_max_base_gas_price protoreflect.FieldDescriptor
-	fd_Params_max_change_rate    protoreflect.FieldDescriptor
-	fd_Params_target_gas         protoreflect.FieldDescriptor
+	md_Params                             protoreflect.MessageDescriptor
+	fd_Params_base_gas_price              protoreflect.FieldDescriptor
+	fd_Params_min_base_gas_price          protoreflect.FieldDescriptor
+	fd_Params_max_base_gas_price          protoreflect.FieldDescriptor
+	fd_Params_max_change_rate             protoreflect.FieldDescriptor
+	fd_Params_target_gas                  protoreflect.FieldDescriptor
+	fd_Params_base_fee_recipient          protoreflect.FieldDescriptor
+	fd_Params_accumulate_gas_used         protoreflect.FieldDescriptor
+	fd_Params_use_twap_price              protoreflect.FieldDescriptor
+	fd_Params_fee_denom_oracles           protoreflect.FieldDescriptor
+	fd_Params_oracle_divergence_threshold protoreflect.FieldDescriptor
+	fd_Params_oracle_max_price_age        protoreflect.FieldDescriptor
+	fd_Params_settle_base_fee             protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -32,6 +91,13 @@ func init() {
 	fd_Params_max_base_gas_price = md_Params.Fields().ByName("max_base_gas_price")
 	fd_Params_max_change_rate = md_Params.Fields().ByName("max_change_rate")
 	fd_Params_target_gas = md_Params.Fields().ByName("target_gas")
+	fd_Params_base_fee_recipient = md_Params.Fields().ByName("base_fee_recipient")
+	fd_Params_accumulate_gas_used = md_Params.Fields().ByName("accumulate_gas_used")
+	fd_Params_use_twap_price = md_Params.Fields().ByName("use_twap_price")
+	fd_Params_fee_denom_oracles = md_Params.Fields().ByName("fee_denom_oracles")
+	fd_Params_oracle_divergence_threshold = md_Params.Fields().ByName("oracle_divergence_threshold")
+	fd_Params_oracle_max_price_age = md_Params.Fields().ByName("oracle_max_price_age")
+	fd_Params_settle_base_fee = md_Params.Fields().ByName("settle_base_fee")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -129,6 +195,48 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.BaseFeeRecipient != "" {
+		value := protoreflect.ValueOfString(x.BaseFeeRecipient)
+		if !f(fd_Params_base_fee_recipient, value) {
+			return
+		}
+	}
+	if x.AccumulateGasUsed != false {
+		value := protoreflect.ValueOfBool(x.AccumulateGasUsed)
+		if !f(fd_Params_accumulate_gas_used, value) {
+			return
+		}
+	}
+	if x.UseTwapPrice != false {
+		value := protoreflect.ValueOfBool(x.UseTwapPrice)
+		if !f(fd_Params_use_twap_price, value) {
+			return
+		}
+	}
+	if len(x.FeeDenomOracles) != 0 {
+		value := protoreflect.ValueOfList(&_Params_9_list{list: &x.FeeDenomOracles})
+		if !f(fd_Params_fee_denom_oracles, value) {
+			return
+		}
+	}
+	if x.OracleDivergenceThreshold != "" {
+		value := protoreflect.ValueOfString(x.OracleDivergenceThreshold)
+		if !f(fd_Params_oracle_divergence_threshold, value) {
+			return
+		}
+	}
+	if x.OracleMaxPriceAge != nil {
+		value := protoreflect.ValueOfMessage(x.OracleMaxPriceAge.ProtoReflect())
+		if !f(fd_Params_oracle_max_price_age, value) {
+			return
+		}
+	}
+	if x.SettleBaseFee != false {
+		value := protoreflect.ValueOfBool(x.SettleBaseFee)
+		if !f(fd_Params_settle_base_fee, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -154,6 +262,20 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.MaxChangeRate != ""
 	case "initia.dynamicfee.v1.Params.target_gas":
 		return x.TargetGas != int64(0)
+	case "initia.dynamicfee.v1.Params.base_fee_recipient":
+		return x.BaseFeeRecipient != ""
+	case "initia.dynamicfee.v1.Params.accumulate_gas_used":
+		return x.AccumulateGasUsed != false
+	case "initia.dynamicfee.v1.Params.use_twap_price":
+		return x.UseTwapPrice != false
+	case "initia.dynamicfee.v1.Params.fee_denom_oracles":
+		return len(x.FeeDenomOracles) != 0
+	case "initia.dynamicfee.v1.Params.oracle_divergence_threshold":
+		return x.OracleDivergenceThreshold != ""
+	case "initia.dynamicfee.v1.Params.oracle_max_price_age":
+		return x.OracleMaxPriceAge != nil
+	case "initia.dynamicfee.v1.Params.settle_base_fee":
+		return x.SettleBaseFee != false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.dynamicfee.v1.Params"))
@@ -180,6 +302,20 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.MaxChangeRate = ""
 	case "initia.dynamicfee.v1.Params.target_gas":
 		x.TargetGas = int64(0)
+	case "initia.dynamicfee.v1.Params.base_fee_recipient":
+		x.BaseFeeRecipient = ""
+	case "initia.dynamicfee.v1.Params.accumulate_gas_used":
+		x.AccumulateGasUsed = false
+	case "initia.dynamicfee.v1.Params.use_twap_price":
+		x.UseTwapPrice = false
+	case "initia.dynamicfee.v1.Params.fee_denom_oracles":
+		x.FeeDenomOracles = nil
+	case "initia.dynamicfee.v1.Params.oracle_divergence_threshold":
+		x.OracleDivergenceThreshold = ""
+	case "initia.dynamicfee.v1.Params.oracle_max_price_age":
+		x.OracleMaxPriceAge = nil
+	case "initia.dynamicfee.v1.Params.settle_base_fee":
+		x.SettleBaseFee = false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.dynamicfee.v1.Params"))
@@ -211,6 +347,30 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "initia.dynamicfee.v1.Params.target_gas":
 		value := x.TargetGas
 		return protoreflect.ValueOfInt64(value)
+	case "initia.dynamicfee.v1.Params.base_fee_recipient":
+		value := x.BaseFeeRecipient
+		return protoreflect.ValueOfString(value)
+	case "initia.dynamicfee.v1.Params.accumulate_gas_used":
+		value := x.AccumulateGasUsed
+		return protoreflect.ValueOfBool(value)
+	case "initia.dynamicfee.v1.Params.use_twap_price":
+		value := x.UseTwapPrice
+		return protoreflect.ValueOfBool(value)
+	case "initia.dynamicfee.v1.Params.fee_denom_oracles":
+		if len(x.FeeDenomOracles) == 0 {
+			return protoreflect.ValueOfList(&_Params_9_list{})
+		}
+		listValue := &_Params_9_list{list: &x.FeeDenomOracles}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.dynamicfee.v1.Params.oracle_divergence_threshold":
+		value := x.OracleDivergenceThreshold
+		return protoreflect.ValueOfString(value)
+	case "initia.dynamicfee.v1.Params.oracle_max_price_age":
+		value := x.OracleMaxPriceAge
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "initia.dynamicfee.v1.Params.settle_base_fee":
+		value := x.SettleBaseFee
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.dynamicfee.v1.Params"))
@@ -241,6 +401,22 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.MaxChangeRate = value.Interface().(string)
 	case "initia.dynamicfee.v1.Params.target_gas":
 		x.TargetGas = value.Int()
+	case "initia.dynamicfee.v1.Params.base_fee_recipient":
+		x.BaseFeeRecipient = value.Interface().(string)
+	case "initia.dynamicfee.v1.Params.accumulate_gas_used":
+		x.AccumulateGasUsed = value.Bool()
+	case "initia.dynamicfee.v1.Params.use_twap_price":
+		x.UseTwapPrice = value.Bool()
+	case "initia.dynamicfee.v1.Params.fee_denom_oracles":
+		lv := value.List()
+		clv := lv.(*_Params_9_list)
+		x.FeeDenomOracles = *clv.list
+	case "initia.dynamicfee.v1.Params.oracle_divergence_threshold":
+		x.OracleDivergenceThreshold = value.Interface().(string)
+	case "initia.dynamicfee.v1.Params.oracle_max_price_age":
+		x.OracleMaxPriceAge = value.Message().Interface().(*durationpb.Duration)
+	case "initia.dynamicfee.v1.Params.settle_base_fee":
+		x.SettleBaseFee = value.Bool()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.dynamicfee.v1.Params"))
@@ -261,6 +437,17 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "initia.dynamicfee.v1.Params.fee_denom_oracles":
+		if x.FeeDenomOracles == nil {
+			x.FeeDenomOracles = []*FeeDenomOracle{}
+		}
+		value := &_Params_9_list{list: &x.FeeDenomOracles}
+		return protoreflect.ValueOfList(value)
+	case "initia.dynamicfee.v1.Params.oracle_max_price_age":
+		if x.OracleMaxPriceAge == nil {
+			x.OracleMaxPriceAge = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.OracleMaxPriceAge.ProtoReflect())
 	case "initia.dynamicfee.v1.Params.base_gas_price":
 		panic(fmt.Errorf("field base_gas_price of message initia.dynamicfee.v1.Params is not mutable"))
 	case "initia.dynamicfee.v1.Params.min_base_gas_price":
@@ -271,6 +458,16 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field max_change_rate of message initia.dynamicfee.v1.Params is not mutable"))
 	case "initia.dynamicfee.v1.Params.target_gas":
 		panic(fmt.Errorf("field target_gas of message initia.dynamicfee.v1.Params is not mutable"))
+	case "initia.dynamicfee.v1.Params.base_fee_recipient":
+		panic(fmt.Errorf("field base_fee_recipient of message initia.dynamicfee.v1.Params is not mutable"))
+	case "initia.dynamicfee.v1.Params.accumulate_gas_used":
+		panic(fmt.Errorf("field accumulate_gas_used of message initia.dynamicfee.v1.Params is not mutable"))
+	case "initia.dynamicfee.v1.Params.use_twap_price":
+		panic(fmt.Errorf("field use_twap_price of message initia.dynamicfee.v1.Params is not mutable"))
+	case "initia.dynamicfee.v1.Params.oracle_divergence_threshold":
+		panic(fmt.Errorf("field oracle_divergence_threshold of message initia.dynamicfee.v1.Params is not mutable"))
+	case "initia.dynamicfee.v1.Params.settle_base_fee":
+		panic(fmt.Errorf("field settle_base_fee of message initia.dynamicfee.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.dynamicfee.v1.Params"))
@@ -294,6 +491,22 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfString("")
 	case "initia.dynamicfee.v1.Params.target_gas":
 		return protoreflect.ValueOfInt64(int64(0))
+	case "initia.dynamicfee.v1.Params.base_fee_recipient":
+		return protoreflect.ValueOfString("")
+	case "initia.dynamicfee.v1.Params.accumulate_gas_used":
+		return protoreflect.ValueOfBool(false)
+	case "initia.dynamicfee.v1.Params.use_twap_price":
+		return protoreflect.ValueOfBool(false)
+	case "initia.dynamicfee.v1.Params.fee_denom_oracles":
+		list := []*FeeDenomOracle{}
+		return protoreflect.ValueOfList(&_Params_9_list{list: &list})
+	case "initia.dynamicfee.v1.Params.oracle_divergence_threshold":
+		return protoreflect.ValueOfString("")
+	case "initia.dynamicfee.v1.Params.oracle_max_price_age":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "initia.dynamicfee.v1.Params.settle_base_fee":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.dynamicfee.v1.Params"))
@@ -382,6 +595,33 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if x.TargetGas != 0 {
 			n += 1 + runtime.Sov(uint64(x.TargetGas))
 		}
+		l = len(x.BaseFeeRecipient)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AccumulateGasUsed {
+			n += 2
+		}
+		if x.UseTwapPrice {
+			n += 2
+		}
+		if len(x.FeeDenomOracles) > 0 {
+			for _, e := range x.FeeDenomOracles {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.OracleDivergenceThreshold)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.OracleMaxPriceAge != nil {
+			l = options.Size(x.OracleMaxPriceAge)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.SettleBaseFee {
+			n += 2
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -411,6 +651,80 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.SettleBaseFee {
+			i--
+			if x.SettleBaseFee {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x60
+		}
+		if x.OracleMaxPriceAge != nil {
+			encoded, err := options.Marshal(x.OracleMaxPriceAge)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if len(x.OracleDivergenceThreshold) > 0 {
+			i -= len(x.OracleDivergenceThreshold)
+			copy(dAtA[i:], x.OracleDivergenceThreshold)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OracleDivergenceThreshold)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if len(x.FeeDenomOracles) > 0 {
+			for iNdEx := len(x.FeeDenomOracles) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.FeeDenomOracles[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if x.UseTwapPrice {
+			i--
+			if x.UseTwapPrice {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.AccumulateGasUsed {
+			i--
+			if x.AccumulateGasUsed {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.BaseFeeRecipient) > 0 {
+			i -= len(x.BaseFeeRecipient)
+			copy(dAtA[i:], x.BaseFeeRecipient)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BaseFeeRecipient)))
+			i--
+			dAtA[i] = 0x32
+		}
 		if x.TargetGas != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.TargetGas))
 			i--
@@ -640,6 +954,200 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseFeeRecipient", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BaseFeeRecipient = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AccumulateGasUsed", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.AccumulateGasUsed = bool(v != 0)
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UseTwapPrice", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.UseTwapPrice = bool(v != 0)
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeDenomOracles", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeeDenomOracles = append(x.FeeDenomOracles, &FeeDenomOracle{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeDenomOracles[len(x.FeeDenomOracles)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleDivergenceThreshold", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OracleDivergenceThreshold = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleMaxPriceAge", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.OracleMaxPriceAge == nil {
+					x.OracleMaxPriceAge = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.OracleMaxPriceAge); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SettleBaseFee", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.SettleBaseFee = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -675,87 +1183,3400 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: initia/dynamicfee/v1/types.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_FeeDenomOracle               protoreflect.MessageDescriptor
+	fd_FeeDenomOracle_denom         protoreflect.FieldDescriptor
+	fd_FeeDenomOracle_currency_pair protoreflect.FieldDescriptor
+	fd_FeeDenomOracle_decimals      protoreflect.FieldDescriptor
 )
 
-// Params defines the parameters for the dynamicfee module.
-type Params struct {
-	state         protoimpl.MessageState
+func init() {
+	file_initia_dynamicfee_v1_types_proto_init()
+	md_FeeDenomOracle = File_initia_dynamicfee_v1_types_proto.Messages().ByName("FeeDenomOracle")
+	fd_FeeDenomOracle_denom = md_FeeDenomOracle.Fields().ByName("denom")
+	fd_FeeDenomOracle_currency_pair = md_FeeDenomOracle.Fields().ByName("currency_pair")
+	fd_FeeDenomOracle_decimals = md_FeeDenomOracle.Fields().ByName("decimals")
+}
+
+var _ protoreflect.Message = (*fastReflection_FeeDenomOracle)(nil)
+
+type fastReflection_FeeDenomOracle FeeDenomOracle
+
+func (x *FeeDenomOracle) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FeeDenomOracle)(x)
+}
+
+func (x *FeeDenomOracle) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_dynamicfee_v1_types_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_FeeDenomOracle_messageType fastReflection_FeeDenomOracle_messageType
+var _ protoreflect.MessageType = fastReflection_FeeDenomOracle_messageType{}
+
+type fastReflection_FeeDenomOracle_messageType struct{}
+
+func (x fastReflection_FeeDenomOracle_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FeeDenomOracle)(nil)
+}
+func (x fastReflection_FeeDenomOracle_messageType) New() protoreflect.Message {
+	return new(fastReflection_FeeDenomOracle)
+}
+func (x fastReflection_FeeDenomOracle_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeDenomOracle
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_FeeDenomOracle) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeDenomOracle
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_FeeDenomOracle) Type() protoreflect.MessageType {
+	return _fastReflection_FeeDenomOracle_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_FeeDenomOracle) New() protoreflect.Message {
+	return new(fastReflection_FeeDenomOracle)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_FeeDenomOracle) Interface() protoreflect.ProtoMessage {
+	return (*FeeDenomOracle)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_FeeDenomOracle) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_FeeDenomOracle_denom, value) {
+			return
+		}
+	}
+	if x.CurrencyPair != "" {
+		value := protoreflect.ValueOfString(x.CurrencyPair)
+		if !f(fd_FeeDenomOracle_currency_pair, value) {
+			return
+		}
+	}
+	if x.Decimals != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Decimals)
+		if !f(fd_FeeDenomOracle_decimals, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_FeeDenomOracle) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.dynamicfee.v1.FeeDenomOracle.denom":
+		return x.Denom != ""
+	case "initia.dynamicfee.v1.FeeDenomOracle.currency_pair":
+		return x.CurrencyPair != ""
+	case "initia.dynamicfee.v1.FeeDenomOracle.decimals":
+		return x.Decimals != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.dynamicfee.v1.FeeDenomOracle"))
+		}
+		panic(fmt.Errorf("message initia.dynamicfee.v1.FeeDenomOracle does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeDenomOracle) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.dynamicfee.v1.FeeDenomOracle.denom":
+		x.Denom = ""
+	case "initia.dynamicfee.v1.FeeDenomOracle.currency_pair":
+		x.CurrencyPair = ""
+	case "initia.dynamicfee.v1.FeeDenomOracle.decimals":
+		x.Decimals = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.dynamicfee.v1.FeeDenomOracle"))
+		}
+		panic(fmt.Errorf("message initia.dynamicfee.v1.FeeDenomOracle does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_FeeDenomOracle) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.dynamicfee.v1.FeeDenomOracle.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "initia.dynamicfee.v1.FeeDenomOracle.currency_pair":
+		value := x.CurrencyPair
+		return protoreflect.ValueOfString(value)
+	case "initia.dynamicfee.v1.FeeDenomOracle.decimals":
+		value := x.Decimals
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.dynamicfee.v1.FeeDenomOracle"))
+		}
+		panic(fmt.Errorf("message initia.dynamicfee.v1.FeeDenomOracle does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeDenomOracle) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.dynamicfee.v1.FeeDenomOracle.denom":
+		x.Denom = value.Interface().(string)
+	case "initia.dynamicfee.v1.FeeDenomOracle.currency_pair":
+		x.CurrencyPair = value.Interface().(string)
+	case "initia.dynamicfee.v1.FeeDenomOracle.decimals":
+		x.Decimals = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.dynamicfee.v1.FeeDenomOracle"))
+		}
+		panic(fmt.Errorf("message initia.dynamicfee.v1.FeeDenomOracle does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeDenomOracle) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.dynamicfee.v1.FeeDenomOracle.denom":
+		panic(fmt.Errorf("field denom of message initia.dynamicfee.v1.FeeDenomOracle is not mutable"))
+	case "initia.dynamicfee.v1.FeeDenomOracle.currency_pair":
+		panic(fmt.Errorf("field currency_pair of message initia.dynamicfee.v1.FeeDenomOracle is not mutable"))
+	case "initia.dynamicfee.v1.FeeDenomOracle.decimals":
+		panic(fmt.Errorf("field decimals of message initia.dynamicfee.v1.FeeDenomOracle is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.dynamicfee.v1.FeeDenomOracle"))
+		}
+		panic(fmt.Errorf("message initia.dynamicfee.v1.FeeDenomOracle does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_FeeDenomOracle) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.dynamicfee.v1.FeeDenomOracle.denom":
+		return protoreflect.ValueOfString("")
+	case "initia.dynamicfee.v1.FeeDenomOracle.currency_pair":
+		return protoreflect.ValueOfString("")
+	case "initia.dynamicfee.v1.FeeDenomOracle.decimals":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.dynamicfee.v1.FeeDenomOracle"))
+		}
+		panic(fmt.Errorf("message initia.dynamicfee.v1.FeeDenomOracle does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_FeeDenomOracle) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.dynamicfee.v1.FeeDenomOracle", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_FeeDenomOracle) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeDenomOracle) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_FeeDenomOracle) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_FeeDenomOracle) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*FeeDenomOracle)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.CurrencyPair)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Decimals != 0 {
+			n += 1 + runtime.Sov(uint64(x.Decimals))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*FeeDenomOracle)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Decimals != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Decimals))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.CurrencyPair) > 0 {
+			i -= len(x.CurrencyPair)
+			copy(dAtA[i:], x.CurrencyPair)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CurrencyPair)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*FeeDenomOracle)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeDenomOracle: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeDenomOracle: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CurrencyPair = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+				}
+				x.Decimals = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Decimals |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_FeeSample               protoreflect.MessageDescriptor
+	fd_FeeSample_tip_gas_price protoreflect.FieldDescriptor
+	fd_FeeSample_gas           protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_dynamicfee_v1_types_proto_init()
+	md_FeeSample = File_initia_dynamicfee_v1_types_proto.Messages().ByName("FeeSample")
+	fd_FeeSample_tip_gas_price = md_FeeSample.Fields().ByName("tip_gas_price")
+	fd_FeeSample_gas = md_FeeSample.Fields().ByName("gas")
+}
+
+var _ protoreflect.Message = (*fastReflection_FeeSample)(nil)
+
+type fastReflection_FeeSample FeeSample
+
+func (x *FeeSample) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FeeSample)(x)
+}
+
+func (x *FeeSample) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_dynamicfee_v1_types_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_FeeSample_messageType fastReflection_FeeSample_messageType
+var _ protoreflect.MessageType = fastReflection_FeeSample_messageType{}
+
+type fastReflection_FeeSample_messageType struct{}
+
+func (x fastReflection_FeeSample_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FeeSample)(nil)
+}
+func (x fastReflection_FeeSample_messageType) New() protoreflect.Message {
+	return new(fastReflection_FeeSample)
+}
+func (x fastReflection_FeeSample_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeSample
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_FeeSample) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeSample
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_FeeSample) Type() protoreflect.MessageType {
+	return _fastReflection_FeeSample_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_FeeSample) New() protoreflect.Message {
+	return new(fastReflection_FeeSample)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_FeeSample) Interface() protoreflect.ProtoMessage {
+	return (*FeeSample)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_FeeSample) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TipGasPrice != "" {
+		value := protoreflect.ValueOfString(x.TipGasPrice)
+		if !f(fd_FeeSample_tip_gas_price, value) {
+			return
+		}
+	}
+	if x.Gas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Gas)
+		if !f(fd_FeeSample_gas, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_FeeSample) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.dynamicfee.v1.FeeSample.tip_gas_price":
+		return x.TipGasPrice != ""
+	case "initia.dynamicfee.v1.FeeSample.gas":
+		return x.Gas != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.dynamicfee.v1.FeeSample"))
+		}
+		panic(fmt.Errorf("message initia.dynamicfee.v1.FeeSample does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeSample) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.dynamicfee.v1.FeeSample.tip_gas_price":
+		x.TipGasPrice = ""
+	case "initia.dynamicfee.v1.FeeSample.gas":
+		x.Gas = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.dynamicfee.v1.FeeSample"))
+		}
+		panic(fmt.Errorf("message initia.dynamicfee.v1.FeeSample does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_FeeSample) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.dynamicfee.v1.FeeSample.tip_gas_price":
+		value := x.TipGasPrice
+		return protoreflect.ValueOfString(value)
+	case "initia.dynamicfee.v1.FeeSample.gas":
+		value := x.Gas
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.dynamicfee.v1.FeeSample"))
+		}
+		panic(fmt.Errorf("message initia.dynamicfee.v1.FeeSample does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeSample) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.dynamicfee.v1.FeeSample.tip_gas_price":
+		x.TipGasPrice = value.Interface().(string)
+	case "initia.dynamicfee.v1.FeeSample.gas":
+		x.Gas = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.dynamicfee.v1.FeeSample"))
+		}
+		panic(fmt.Errorf("message initia.dynamicfee.v1.FeeSample does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeSample) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.dynamicfee.v1.FeeSample.tip_gas_price":
+		panic(fmt.Errorf("field tip_gas_price of message initia.dynamicfee.v1.FeeSample is not mutable"))
+	case "initia.dynamicfee.v1.FeeSample.gas":
+		panic(fmt.Errorf("field gas of message initia.dynamicfee.v1.FeeSample is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.dynamicfee.v1.FeeSample"))
+		}
+		panic(fmt.Errorf("message initia.dynamicfee.v1.FeeSample does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_FeeSample) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.dynamicfee.v1.FeeSample.tip_gas_price":
+		return protoreflect.ValueOfString("")
+	case "initia.dynamicfee.v1.FeeSample.gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.dynamicfee.v1.FeeSample"))
+		}
+		panic(fmt.Errorf("message initia.dynamicfee.v1.FeeSample does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_FeeSample) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.dynamicfee.v1.FeeSample", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_FeeSample) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeSample) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_FeeSample) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_FeeSample) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*FeeSample)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.TipGasPrice)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Gas != 0 {
+			n += 1 + runtime.Sov(uint64(x.Gas))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*FeeSample)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Gas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Gas))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.TipGasPrice) > 0 {
+			i -= len(x.TipGasPrice)
+			copy(dAtA[i:], x.TipGasPrice)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TipGasPrice)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*FeeSample)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeSample: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeSample: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TipGasPrice", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TipGasPrice = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+				}
+				x.Gas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Gas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_FeeHistoryRecord_5_list)(nil)
+
+type _FeeHistoryRecord_5_list struct {
+	list *[]string
+}
+
+func (x *_FeeHistoryRecord_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_FeeHistoryRecord_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_FeeHistoryRecord_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_FeeHistoryRecord_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_FeeHistoryRecord_5_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message FeeHistoryRecord at list field TipPercentiles as it is not of Message kind"))
+}
+
+func (x *_FeeHistoryRecord_5_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_FeeHistoryRecord_5_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_FeeHistoryRecord_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_FeeHistoryRecord                 protoreflect.MessageDescriptor
+	fd_FeeHistoryRecord_height          protoreflect.FieldDescriptor
+	fd_FeeHistoryRecord_base_gas_price  protoreflect.FieldDescriptor
+	fd_FeeHistoryRecord_accumulated_gas protoreflect.FieldDescriptor
+	fd_FeeHistoryRecord_target_gas      protoreflect.FieldDescriptor
+	fd_FeeHistoryRecord_tip_percentiles protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_dynamicfee_v1_types_proto_init()
+	md_FeeHistoryRecord = File_initia_dynamicfee_v1_types_proto.Messages().ByName("FeeHistoryRecord")
+	fd_FeeHistoryRecord_height = md_FeeHistoryRecord.Fields().ByName("height")
+	fd_FeeHistoryRecord_base_gas_price = md_FeeHistoryRecord.Fields().ByName("base_gas_price")
+	fd_FeeHistoryRecord_accumulated_gas = md_FeeHistoryRecord.Fields().ByName("accumulated_gas")
+	fd_FeeHistoryRecord_target_gas = md_FeeHistoryRecord.Fields().ByName("target_gas")
+	fd_FeeHistoryRecord_tip_percentiles = md_FeeHistoryRecord.Fields().ByName("tip_percentiles")
+}
+
+var _ protoreflect.Message = (*fastReflection_FeeHistoryRecord)(nil)
+
+type fastReflection_FeeHistoryRecord FeeHistoryRecord
+
+func (x *FeeHistoryRecord) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FeeHistoryRecord)(x)
+}
+
+func (x *FeeHistoryRecord) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_dynamicfee_v1_types_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_FeeHistoryRecord_messageType fastReflection_FeeHistoryRecord_messageType
+var _ protoreflect.MessageType = fastReflection_FeeHistoryRecord_messageType{}
+
+type fastReflection_FeeHistoryRecord_messageType struct{}
+
+func (x fastReflection_FeeHistoryRecord_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FeeHistoryRecord)(nil)
+}
+func (x fastReflection_FeeHistoryRecord_messageType) New() protoreflect.Message {
+	return new(fastReflection_FeeHistoryRecord)
+}
+func (x fastReflection_FeeHistoryRecord_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeHistoryRecord
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_FeeHistoryRecord) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeHistoryRecord
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_FeeHistoryRecord) Type() protoreflect.MessageType {
+	return _fastReflection_FeeHistoryRecord_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_FeeHistoryRecord) New() protoreflect.Message {
+	return new(fastReflection_FeeHistoryRecord)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_FeeHistoryRecord) Interface() protoreflect.ProtoMessage {
+	return (*FeeHistoryRecord)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_FeeHistoryRecord) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_FeeHistoryRecord_height, value) {
+			return
+		}
+	}
+	if x.BaseGasPrice != "" {
+		value := protoreflect.ValueOfString(x.BaseGasPrice)
+		if !f(fd_FeeHistoryRecord_base_gas_price, value) {
+			return
+		}
+	}
+	if x.AccumulatedGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AccumulatedGas)
+		if !f(fd_FeeHistoryRecord_accumulated_gas, value) {
+			return
+		}
+	}
+	if x.TargetGas != int64(0) {
+		value := protoreflect.ValueOfInt64(x.TargetGas)
+		if !f(fd_FeeHistoryRecord_target_gas, value) {
+			return
+		}
+	}
+	if len(x.TipPercentiles) != 0 {
+		value := protoreflect.ValueOfList(&_FeeHistoryRecord_5_list{list: &x.TipPercentiles})
+		if !f(fd_FeeHistoryRecord_tip_percentiles, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_FeeHistoryRecord) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.dynamicfee.v1.FeeHistoryRecord.height":
+		return x.Height != int64(0)
+	case "initia.dynamicfee.v1.FeeHistoryRecord.base_gas_price":
+		return x.BaseGasPrice != ""
+	case "initia.dynamicfee.v1.FeeHistoryRecord.accumulated_gas":
+		return x.AccumulatedGas != uint64(0)
+	case "initia.dynamicfee.v1.FeeHistoryRecord.target_gas":
+		return x.TargetGas != int64(0)
+	case "initia.dynamicfee.v1.FeeHistoryRecord.tip_percentiles":
+		return len(x.TipPercentiles) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.dynamicfee.v1.FeeHistoryRecord"))
+		}
+		panic(fmt.Errorf("message initia.dynamicfee.v1.FeeHistoryRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeHistoryRecord) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.dynamicfee.v1.FeeHistoryRecord.height":
+		x.Height = int64(0)
+	case "initia.dynamicfee.v1.FeeHistoryRecord.base_gas_price":
+		x.BaseGasPrice = ""
+	case "initia.dynamicfee.v1.FeeHistoryRecord.accumulated_gas":
+		x.AccumulatedGas = uint64(0)
+	case "initia.dynamicfee.v1.FeeHistoryRecord.target_gas":
+		x.TargetGas = int64(0)
+	case "initia.dynamicfee.v1.FeeHistoryRecord.tip_percentiles":
+		x.TipPercentiles = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.dynamicfee.v1.FeeHistoryRecord"))
+		}
+		panic(fmt.Errorf("message initia.dynamicfee.v1.FeeHistoryRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_FeeHistoryRecord) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.dynamicfee.v1.FeeHistoryRecord.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "initia.dynamicfee.v1.FeeHistoryRecord.base_gas_price":
+		value := x.BaseGasPrice
+		return protoreflect.ValueOfString(value)
+	case "initia.dynamicfee.v1.FeeHistoryRecord.accumulated_gas":
+		value := x.AccumulatedGas
+		return protoreflect.ValueOfUint64(value)
+	case "initia.dynamicfee.v1.FeeHistoryRecord.target_gas":
+		value := x.TargetGas
+		return protoreflect.ValueOfInt64(value)
+	case "initia.dynamicfee.v1.FeeHistoryRecord.tip_percentiles":
+		if len(x.TipPercentiles) == 0 {
+			return protoreflect.ValueOfList(&_FeeHistoryRecord_5_list{})
+		}
+		listValue := &_FeeHistoryRecord_5_list{list: &x.TipPercentiles}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.dynamicfee.v1.FeeHistoryRecord"))
+		}
+		panic(fmt.Errorf("message initia.dynamicfee.v1.FeeHistoryRecord does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeHistoryRecord) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.dynamicfee.v1.FeeHistoryRecord.height":
+		x.Height = value.Int()
+	case "initia.dynamicfee.v1.FeeHistoryRecord.base_gas_price":
+		x.BaseGasPrice = value.Interface().(string)
+	case "initia.dynamicfee.v1.FeeHistoryRecord.accumulated_gas":
+		x.AccumulatedGas = value.Uint()
+	case "initia.dynamicfee.v1.FeeHistoryRecord.target_gas":
+		x.TargetGas = value.Int()
+	case "initia.dynamicfee.v1.FeeHistoryRecord.tip_percentiles":
+		lv := value.List()
+		clv := lv.(*_FeeHistoryRecord_5_list)
+		x.TipPercentiles = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.dynamicfee.v1.FeeHistoryRecord"))
+		}
+		panic(fmt.Errorf("message initia.dynamicfee.v1.FeeHistoryRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeHistoryRecord) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.dynamicfee.v1.FeeHistoryRecord.tip_percentiles":
+		if x.TipPercentiles == nil {
+			x.TipPercentiles = []string{}
+		}
+		value := &_FeeHistoryRecord_5_list{list: &x.TipPercentiles}
+		return protoreflect.ValueOfList(value)
+	case "initia.dynamicfee.v1.FeeHistoryRecord.height":
+		panic(fmt.Errorf("field height of message initia.dynamicfee.v1.FeeHistoryRecord is not mutable"))
+	case "initia.dynamicfee.v1.FeeHistoryRecord.base_gas_price":
+		panic(fmt.Errorf("field base_gas_price of message initia.dynamicfee.v1.FeeHistoryRecord is not mutable"))
+	case "initia.dynamicfee.v1.FeeHistoryRecord.accumulated_gas":
+		panic(fmt.Errorf("field accumulated_gas of message initia.dynamicfee.v1.FeeHistoryRecord is not mutable"))
+	case "initia.dynamicfee.v1.FeeHistoryRecord.target_gas":
+		panic(fmt.Errorf("field target_gas of message initia.dynamicfee.v1.FeeHistoryRecord is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.dynamicfee.v1.FeeHistoryRecord"))
+		}
+		panic(fmt.Errorf("message initia.dynamicfee.v1.FeeHistoryRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_FeeHistoryRecord) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.dynamicfee.v1.FeeHistoryRecord.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "initia.dynamicfee.v1.FeeHistoryRecord.base_gas_price":
+		return protoreflect.ValueOfString("")
+	case "initia.dynamicfee.v1.FeeHistoryRecord.accumulated_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "initia.dynamicfee.v1.FeeHistoryRecord.target_gas":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "initia.dynamicfee.v1.FeeHistoryRecord.tip_percentiles":
+		list := []string{}
+		return protoreflect.ValueOfList(&_FeeHistoryRecord_5_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.dynamicfee.v1.FeeHistoryRecord"))
+		}
+		panic(fmt.Errorf("message initia.dynamicfee.v1.FeeHistoryRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_FeeHistoryRecord) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.dynamicfee.v1.FeeHistoryRecord", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_FeeHistoryRecord) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeHistoryRecord) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_FeeHistoryRecord) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_FeeHistoryRecord) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*FeeHistoryRecord)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		l = len(x.BaseGasPrice)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AccumulatedGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.AccumulatedGas))
+		}
+		if x.TargetGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.TargetGas))
+		}
+		if len(x.TipPercentiles) > 0 {
+			for _, s := range x.TipPercentiles {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*FeeHistoryRecord)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.TipPercentiles) > 0 {
+			for iNdEx := len(x.TipPercentiles) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.TipPercentiles[iNdEx])
+				copy(dAtA[i:], x.TipPercentiles[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TipPercentiles[iNdEx])))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.TargetGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TargetGas))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.AccumulatedGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AccumulatedGas))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.BaseGasPrice) > 0 {
+			i -= len(x.BaseGasPrice)
+			copy(dAtA[i:], x.BaseGasPrice)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BaseGasPrice)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*FeeHistoryRecord)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeHistoryRecord: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeHistoryRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseGasPrice", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BaseGasPrice = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AccumulatedGas", wireType)
+				}
+				x.AccumulatedGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AccumulatedGas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TargetGas", wireType)
+				}
+				x.TargetGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TargetGas |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TipPercentiles", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TipPercentiles = append(x.TipPercentiles, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_FeeHistoryEntry_5_list)(nil)
+
+type _FeeHistoryEntry_5_list struct {
+	list *[]string
+}
+
+func (x *_FeeHistoryEntry_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_FeeHistoryEntry_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_FeeHistoryEntry_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_FeeHistoryEntry_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_FeeHistoryEntry_5_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message FeeHistoryEntry at list field Rewards as it is not of Message kind"))
+}
+
+func (x *_FeeHistoryEntry_5_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_FeeHistoryEntry_5_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_FeeHistoryEntry_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_FeeHistoryEntry                 protoreflect.MessageDescriptor
+	fd_FeeHistoryEntry_height          protoreflect.FieldDescriptor
+	fd_FeeHistoryEntry_base_gas_price  protoreflect.FieldDescriptor
+	fd_FeeHistoryEntry_accumulated_gas protoreflect.FieldDescriptor
+	fd_FeeHistoryEntry_target_gas      protoreflect.FieldDescriptor
+	fd_FeeHistoryEntry_rewards         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_dynamicfee_v1_types_proto_init()
+	md_FeeHistoryEntry = File_initia_dynamicfee_v1_types_proto.Messages().ByName("FeeHistoryEntry")
+	fd_FeeHistoryEntry_height = md_FeeHistoryEntry.Fields().ByName("height")
+	fd_FeeHistoryEntry_base_gas_price = md_FeeHistoryEntry.Fields().ByName("base_gas_price")
+	fd_FeeHistoryEntry_accumulated_gas = md_FeeHistoryEntry.Fields().ByName("accumulated_gas")
+	fd_FeeHistoryEntry_target_gas = md_FeeHistoryEntry.Fields().ByName("target_gas")
+	fd_FeeHistoryEntry_rewards = md_FeeHistoryEntry.Fields().ByName("rewards")
+}
+
+var _ protoreflect.Message = (*fastReflection_FeeHistoryEntry)(nil)
+
+type fastReflection_FeeHistoryEntry FeeHistoryEntry
+
+func (x *FeeHistoryEntry) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FeeHistoryEntry)(x)
+}
+
+func (x *FeeHistoryEntry) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_dynamicfee_v1_types_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_FeeHistoryEntry_messageType fastReflection_FeeHistoryEntry_messageType
+var _ protoreflect.MessageType = fastReflection_FeeHistoryEntry_messageType{}
+
+type fastReflection_FeeHistoryEntry_messageType struct{}
+
+func (x fastReflection_FeeHistoryEntry_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FeeHistoryEntry)(nil)
+}
+func (x fastReflection_FeeHistoryEntry_messageType) New() protoreflect.Message {
+	return new(fastReflection_FeeHistoryEntry)
+}
+func (x fastReflection_FeeHistoryEntry_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeHistoryEntry
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_FeeHistoryEntry) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeHistoryEntry
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_FeeHistoryEntry) Type() protoreflect.MessageType {
+	return _fastReflection_FeeHistoryEntry_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_FeeHistoryEntry) New() protoreflect.Message {
+	return new(fastReflection_FeeHistoryEntry)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_FeeHistoryEntry) Interface() protoreflect.ProtoMessage {
+	return (*FeeHistoryEntry)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_FeeHistoryEntry) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_FeeHistoryEntry_height, value) {
+			return
+		}
+	}
+	if x.BaseGasPrice != "" {
+		value := protoreflect.ValueOfString(x.BaseGasPrice)
+		if !f(fd_FeeHistoryEntry_base_gas_price, value) {
+			return
+		}
+	}
+	if x.AccumulatedGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AccumulatedGas)
+		if !f(fd_FeeHistoryEntry_accumulated_gas, value) {
+			return
+		}
+	}
+	if x.TargetGas != int64(0) {
+		value := protoreflect.ValueOfInt64(x.TargetGas)
+		if !f(fd_FeeHistoryEntry_target_gas, value) {
+			return
+		}
+	}
+	if len(x.Rewards) != 0 {
+		value := protoreflect.ValueOfList(&_FeeHistoryEntry_5_list{list: &x.Rewards})
+		if !f(fd_FeeHistoryEntry_rewards, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_FeeHistoryEntry) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.dynamicfee.v1.FeeHistoryEntry.height":
+		return x.Height != int64(0)
+	case "initia.dynamicfee.v1.FeeHistoryEntry.base_gas_price":
+		return x.BaseGasPrice != ""
+	case "initia.dynamicfee.v1.FeeHistoryEntry.accumulated_gas":
+		return x.AccumulatedGas != uint64(0)
+	case "initia.dynamicfee.v1.FeeHistoryEntry.target_gas":
+		return x.TargetGas != int64(0)
+	case "initia.dynamicfee.v1.FeeHistoryEntry.rewards":
+		return len(x.Rewards) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.dynamicfee.v1.FeeHistoryEntry"))
+		}
+		panic(fmt.Errorf("message initia.dynamicfee.v1.FeeHistoryEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeHistoryEntry) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.dynamicfee.v1.FeeHistoryEntry.height":
+		x.Height = int64(0)
+	case "initia.dynamicfee.v1.FeeHistoryEntry.base_gas_price":
+		x.BaseGasPrice = ""
+	case "initia.dynamicfee.v1.FeeHistoryEntry.accumulated_gas":
+		x.AccumulatedGas = uint64(0)
+	case "initia.dynamicfee.v1.FeeHistoryEntry.target_gas":
+		x.TargetGas = int64(0)
+	case "initia.dynamicfee.v1.FeeHistoryEntry.rewards":
+		x.Rewards = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.dynamicfee.v1.FeeHistoryEntry"))
+		}
+		panic(fmt.Errorf("message initia.dynamicfee.v1.FeeHistoryEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_FeeHistoryEntry) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.dynamicfee.v1.FeeHistoryEntry.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "initia.dynamicfee.v1.FeeHistoryEntry.base_gas_price":
+		value := x.BaseGasPrice
+		return protoreflect.ValueOfString(value)
+	case "initia.dynamicfee.v1.FeeHistoryEntry.accumulated_gas":
+		value := x.AccumulatedGas
+		return protoreflect.ValueOfUint64(value)
+	case "initia.dynamicfee.v1.FeeHistoryEntry.target_gas":
+		value := x.TargetGas
+		return protoreflect.ValueOfInt64(value)
+	case "initia.dynamicfee.v1.FeeHistoryEntry.rewards":
+		if len(x.Rewards) == 0 {
+			return protoreflect.ValueOfList(&_FeeHistoryEntry_5_list{})
+		}
+		listValue := &_FeeHistoryEntry_5_list{list: &x.Rewards}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.dynamicfee.v1.FeeHistoryEntry"))
+		}
+		panic(fmt.Errorf("message initia.dynamicfee.v1.FeeHistoryEntry does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeHistoryEntry) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.dynamicfee.v1.FeeHistoryEntry.height":
+		x.Height = value.Int()
+	case "initia.dynamicfee.v1.FeeHistoryEntry.base_gas_price":
+		x.BaseGasPrice = value.Interface().(string)
+	case "initia.dynamicfee.v1.FeeHistoryEntry.accumulated_gas":
+		x.AccumulatedGas = value.Uint()
+	case "initia.dynamicfee.v1.FeeHistoryEntry.target_gas":
+		x.TargetGas = value.Int()
+	case "initia.dynamicfee.v1.FeeHistoryEntry.rewards":
+		lv := value.List()
+		clv := lv.(*_FeeHistoryEntry_5_list)
+		x.Rewards = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.dynamicfee.v1.FeeHistoryEntry"))
+		}
+		panic(fmt.Errorf("message initia.dynamicfee.v1.FeeHistoryEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeHistoryEntry) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.dynamicfee.v1.FeeHistoryEntry.rewards":
+		if x.Rewards == nil {
+			x.Rewards = []string{}
+		}
+		value := &_FeeHistoryEntry_5_list{list: &x.Rewards}
+		return protoreflect.ValueOfList(value)
+	case "initia.dynamicfee.v1.FeeHistoryEntry.height":
+		panic(fmt.Errorf("field height of message initia.dynamicfee.v1.FeeHistoryEntry is not mutable"))
+	case "initia.dynamicfee.v1.FeeHistoryEntry.base_gas_price":
+		panic(fmt.Errorf("field base_gas_price of message initia.dynamicfee.v1.FeeHistoryEntry is not mutable"))
+	case "initia.dynamicfee.v1.FeeHistoryEntry.accumulated_gas":
+		panic(fmt.Errorf("field accumulated_gas of message initia.dynamicfee.v1.FeeHistoryEntry is not mutable"))
+	case "initia.dynamicfee.v1.FeeHistoryEntry.target_gas":
+		panic(fmt.Errorf("field target_gas of message initia.dynamicfee.v1.FeeHistoryEntry is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.dynamicfee.v1.FeeHistoryEntry"))
+		}
+		panic(fmt.Errorf("message initia.dynamicfee.v1.FeeHistoryEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_FeeHistoryEntry) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.dynamicfee.v1.FeeHistoryEntry.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "initia.dynamicfee.v1.FeeHistoryEntry.base_gas_price":
+		return protoreflect.ValueOfString("")
+	case "initia.dynamicfee.v1.FeeHistoryEntry.accumulated_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "initia.dynamicfee.v1.FeeHistoryEntry.target_gas":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "initia.dynamicfee.v1.FeeHistoryEntry.rewards":
+		list := []string{}
+		return protoreflect.ValueOfList(&_FeeHistoryEntry_5_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.dynamicfee.v1.FeeHistoryEntry"))
+		}
+		panic(fmt.Errorf("message initia.dynamicfee.v1.FeeHistoryEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_FeeHistoryEntry) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.dynamicfee.v1.FeeHistoryEntry", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_FeeHistoryEntry) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeHistoryEntry) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_FeeHistoryEntry) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_FeeHistoryEntry) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*FeeHistoryEntry)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		l = len(x.BaseGasPrice)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AccumulatedGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.AccumulatedGas))
+		}
+		if x.TargetGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.TargetGas))
+		}
+		if len(x.Rewards) > 0 {
+			for _, s := range x.Rewards {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*FeeHistoryEntry)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Rewards) > 0 {
+			for iNdEx := len(x.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Rewards[iNdEx])
+				copy(dAtA[i:], x.Rewards[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Rewards[iNdEx])))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.TargetGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TargetGas))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.AccumulatedGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AccumulatedGas))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.BaseGasPrice) > 0 {
+			i -= len(x.BaseGasPrice)
+			copy(dAtA[i:], x.BaseGasPrice)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BaseGasPrice)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*FeeHistoryEntry)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeHistoryEntry: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseGasPrice", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BaseGasPrice = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AccumulatedGas", wireType)
+				}
+				x.AccumulatedGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AccumulatedGas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TargetGas", wireType)
+				}
+				x.TargetGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TargetGas |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Rewards = append(x.Rewards, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_FeeHistory_1_list)(nil)
+
+type _FeeHistory_1_list struct {
+	list *[]*FeeHistoryEntry
+}
+
+func (x *_FeeHistory_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_FeeHistory_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_FeeHistory_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeeHistoryEntry)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_FeeHistory_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeeHistoryEntry)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_FeeHistory_1_list) AppendMutable() protoreflect.Value {
+	v := new(FeeHistoryEntry)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeeHistory_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_FeeHistory_1_list) NewElement() protoreflect.Value {
+	v := new(FeeHistoryEntry)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeeHistory_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_FeeHistory                     protoreflect.MessageDescriptor
+	fd_FeeHistory_entries             protoreflect.FieldDescriptor
+	fd_FeeHistory_next_base_gas_price protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_dynamicfee_v1_types_proto_init()
+	md_FeeHistory = File_initia_dynamicfee_v1_types_proto.Messages().ByName("FeeHistory")
+	fd_FeeHistory_entries = md_FeeHistory.Fields().ByName("entries")
+	fd_FeeHistory_next_base_gas_price = md_FeeHistory.Fields().ByName("next_base_gas_price")
+}
+
+var _ protoreflect.Message = (*fastReflection_FeeHistory)(nil)
+
+type fastReflection_FeeHistory FeeHistory
+
+func (x *FeeHistory) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FeeHistory)(x)
+}
+
+func (x *FeeHistory) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_dynamicfee_v1_types_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_FeeHistory_messageType fastReflection_FeeHistory_messageType
+var _ protoreflect.MessageType = fastReflection_FeeHistory_messageType{}
+
+type fastReflection_FeeHistory_messageType struct{}
+
+func (x fastReflection_FeeHistory_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FeeHistory)(nil)
+}
+func (x fastReflection_FeeHistory_messageType) New() protoreflect.Message {
+	return new(fastReflection_FeeHistory)
+}
+func (x fastReflection_FeeHistory_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeHistory
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_FeeHistory) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeHistory
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_FeeHistory) Type() protoreflect.MessageType {
+	return _fastReflection_FeeHistory_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_FeeHistory) New() protoreflect.Message {
+	return new(fastReflection_FeeHistory)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_FeeHistory) Interface() protoreflect.ProtoMessage {
+	return (*FeeHistory)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_FeeHistory) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Entries) != 0 {
+		value := protoreflect.ValueOfList(&_FeeHistory_1_list{list: &x.Entries})
+		if !f(fd_FeeHistory_entries, value) {
+			return
+		}
+	}
+	if x.NextBaseGasPrice != "" {
+		value := protoreflect.ValueOfString(x.NextBaseGasPrice)
+		if !f(fd_FeeHistory_next_base_gas_price, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_FeeHistory) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.dynamicfee.v1.FeeHistory.entries":
+		return len(x.Entries) != 0
+	case "initia.dynamicfee.v1.FeeHistory.next_base_gas_price":
+		return x.NextBaseGasPrice != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.dynamicfee.v1.FeeHistory"))
+		}
+		panic(fmt.Errorf("message initia.dynamicfee.v1.FeeHistory does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeHistory) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.dynamicfee.v1.FeeHistory.entries":
+		x.Entries = nil
+	case "initia.dynamicfee.v1.FeeHistory.next_base_gas_price":
+		x.NextBaseGasPrice = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.dynamicfee.v1.FeeHistory"))
+		}
+		panic(fmt.Errorf("message initia.dynamicfee.v1.FeeHistory does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_FeeHistory) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.dynamicfee.v1.FeeHistory.entries":
+		if len(x.Entries) == 0 {
+			return protoreflect.ValueOfList(&_FeeHistory_1_list{})
+		}
+		listValue := &_FeeHistory_1_list{list: &x.Entries}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.dynamicfee.v1.FeeHistory.next_base_gas_price":
+		value := x.NextBaseGasPrice
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.dynamicfee.v1.FeeHistory"))
+		}
+		panic(fmt.Errorf("message initia.dynamicfee.v1.FeeHistory does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeHistory) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.dynamicfee.v1.FeeHistory.entries":
+		lv := value.List()
+		clv := lv.(*_FeeHistory_1_list)
+		x.Entries = *clv.list
+	case "initia.dynamicfee.v1.FeeHistory.next_base_gas_price":
+		x.NextBaseGasPrice = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.dynamicfee.v1.FeeHistory"))
+		}
+		panic(fmt.Errorf("message initia.dynamicfee.v1.FeeHistory does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeHistory) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.dynamicfee.v1.FeeHistory.entries":
+		if x.Entries == nil {
+			x.Entries = []*FeeHistoryEntry{}
+		}
+		value := &_FeeHistory_1_list{list: &x.Entries}
+		return protoreflect.ValueOfList(value)
+	case "initia.dynamicfee.v1.FeeHistory.next_base_gas_price":
+		panic(fmt.Errorf("field next_base_gas_price of message initia.dynamicfee.v1.FeeHistory is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.dynamicfee.v1.FeeHistory"))
+		}
+		panic(fmt.Errorf("message initia.dynamicfee.v1.FeeHistory does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_FeeHistory) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.dynamicfee.v1.FeeHistory.entries":
+		list := []*FeeHistoryEntry{}
+		return protoreflect.ValueOfList(&_FeeHistory_1_list{list: &list})
+	case "initia.dynamicfee.v1.FeeHistory.next_base_gas_price":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.dynamicfee.v1.FeeHistory"))
+		}
+		panic(fmt.Errorf("message initia.dynamicfee.v1.FeeHistory does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_FeeHistory) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.dynamicfee.v1.FeeHistory", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_FeeHistory) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeHistory) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_FeeHistory) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_FeeHistory) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*FeeHistory)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Entries) > 0 {
+			for _, e := range x.Entries {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.NextBaseGasPrice)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*FeeHistory)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.NextBaseGasPrice) > 0 {
+			i -= len(x.NextBaseGasPrice)
+			copy(dAtA[i:], x.NextBaseGasPrice)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NextBaseGasPrice)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Entries) > 0 {
+			for iNdEx := len(x.Entries) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Entries[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*FeeHistory)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeHistory: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Entries = append(x.Entries, &FeeHistoryEntry{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Entries[len(x.Entries)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextBaseGasPrice", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NextBaseGasPrice = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: initia/dynamicfee/v1/types.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Params defines the parameters for the dynamicfee module.
+type Params struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BaseGasPrice    string `protobuf:"bytes,1,opt,name=base_gas_price,json=baseGasPrice,proto3" json:"base_gas_price,omitempty"`
+	MinBaseGasPrice string `protobuf:"bytes,2,opt,name=min_base_gas_price,json=minBaseGasPrice,proto3" json:"min_base_gas_price,omitempty"`
+	MaxBaseGasPrice string `protobuf:"bytes,3,opt,name=max_base_gas_price,json=maxBaseGasPrice,proto3" json:"max_base_gas_price,omitempty"`
+	MaxChangeRate   string `protobuf:"bytes,4,opt,name=max_change_rate,json=maxChangeRate,proto3" json:"max_change_rate,omitempty"`
+	TargetGas       int64  `protobuf:"varint,5,opt,name=target_gas,json=targetGas,proto3" json:"target_gas,omitempty"`
+	// base_fee_recipient receives the base fee portion of every tx fee, the base
+	// gas price times the gas limit, when settle_base_fee is enabled. An empty
+	// recipient burns it. Only the remaining priority tip stays in the fee
+	// collector for proposer rewards.
+	BaseFeeRecipient string `protobuf:"bytes,6,opt,name=base_fee_recipient,json=baseFeeRecipient,proto3" json:"base_fee_recipient,omitempty"`
+	// accumulate_gas_used makes the base gas price react to the gas consumed by
+	// the txs of a block, read from the block gas meter at the end of the block.
+	// When false, the declared gas limits accumulated in the ante handler are
+	// used instead, which is the legacy behavior.
+	AccumulateGasUsed bool `protobuf:"varint,7,opt,name=accumulate_gas_used,json=accumulateGasUsed,proto3" json:"accumulate_gas_used,omitempty"`
+	// use_twap_price makes fee pricing value whitelisted gas tokens at their
+	// time-weighted average base price instead of the instantaneous pool spot
+	// price, which can be moved within a single block.
+	UseTwapPrice bool `protobuf:"varint,8,opt,name=use_twap_price,json=useTwapPrice,proto3" json:"use_twap_price,omitempty"`
+	// fee_denom_oracles map fee denoms to the Connect currency pairs pricing
+	// them. A mapped denom without a dex pair is priced by the oracle, so it can
+	// pay fees without a pool. The base denom must be mapped too, since oracle
+	// prices are converted to base prices through it.
+	FeeDenomOracles []*FeeDenomOracle `protobuf:"bytes,9,rep,name=fee_denom_oracles,json=feeDenomOracles,proto3" json:"fee_denom_oracles,omitempty"`
+	// oracle_divergence_threshold is the relative difference between the dex
+	// and the oracle price of a mapped denom above which the oracle price is
+	// used. Zero disables the check, so mapped denoms with a dex pair keep the
+	// dex price.
+	OracleDivergenceThreshold string `protobuf:"bytes,10,opt,name=oracle_divergence_threshold,json=oracleDivergenceThreshold,proto3" json:"oracle_divergence_threshold,omitempty"`
+	// oracle_max_price_age is the maximum age of an oracle price before it is
+	// considered stale and not used.
+	OracleMaxPriceAge *durationpb.Duration `protobuf:"bytes,11,opt,name=oracle_max_price_age,json=oracleMaxPriceAge,proto3" json:"oracle_max_price_age,omitempty"`
+	// settle_base_fee moves the base fee portion of every tx fee out of the fee
+	// collector, to base_fee_recipient or burned when it is empty. It is off by
+	// default and decodes as false on chains upgrading from a version without
+	// it, so the whole fee keeps going to proposer rewards until governance
+	// enables it with MsgUpdateParams.
+	SettleBaseFee bool `protobuf:"varint,12,opt,name=settle_base_fee,json=settleBaseFee,proto3" json:"settle_base_fee,omitempty"`
+}
+
+func (x *Params) Reset() {
+	*x = Params{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_dynamicfee_v1_types_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Params) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Params) ProtoMessage() {}
+
+// Deprecated: Use Params.ProtoReflect.Descriptor instead.
+func (*Params) Descriptor() ([]byte, []int) {
+	return file_initia_dynamicfee_v1_types_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Params) GetBaseGasPrice() string {
+	if x != nil {
+		return x.BaseGasPrice
+	}
+	return ""
+}
+
+func (x *Params) GetMinBaseGasPrice() string {
+	if x != nil {
+		return x.MinBaseGasPrice
+	}
+	return ""
+}
+
+func (x *Params) GetMaxBaseGasPrice() string {
+	if x != nil {
+		return x.MaxBaseGasPrice
+	}
+	return ""
+}
+
+func (x *Params) GetMaxChangeRate() string {
+	if x != nil {
+		return x.MaxChangeRate
+	}
+	return ""
+}
+
+func (x *Params) GetTargetGas() int64 {
+	if x != nil {
+		return x.TargetGas
+	}
+	return 0
+}
+
+func (x *Params) GetBaseFeeRecipient() string {
+	if x != nil {
+		return x.BaseFeeRecipient
+	}
+	return ""
+}
+
+func (x *Params) GetAccumulateGasUsed() bool {
+	if x != nil {
+		return x.AccumulateGasUsed
+	}
+	return false
+}
+
+func (x *Params) GetUseTwapPrice() bool {
+	if x != nil {
+		return x.UseTwapPrice
+	}
+	return false
+}
+
+func (x *Params) GetFeeDenomOracles() []*FeeDenomOracle {
+	if x != nil {
+		return x.FeeDenomOracles
+	}
+	return nil
+}
+
+func (x *Params) GetOracleDivergenceThreshold() string {
+	if x != nil {
+		return x.OracleDivergenceThreshold
+	}
+	return ""
+}
+
+func (x *Params) GetOracleMaxPriceAge() *durationpb.Duration {
+	if x != nil {
+		return x.OracleMaxPriceAge
+	}
+	return nil
+}
+
+func (x *Params) GetSettleBaseFee() bool {
+	if x != nil {
+		return x.SettleBaseFee
+	}
+	return false
+}
+
+// FeeDenomOracle maps a fee denom to the Connect currency pair pricing it.
+type FeeDenomOracle struct {
+	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BaseGasPrice    string `protobuf:"bytes,1,opt,name=base_gas_price,json=baseGasPrice,proto3" json:"base_gas_price,omitempty"`
-	MinBaseGasPrice string `protobuf:"bytes,2,opt,name=min_base_gas_price,json=minBaseGasPrice,proto3" json:"min_base_gas_price,omitempty"`
-	MaxBaseGasPrice string `protobuf:"bytes,3,opt,name=max_base_gas_price,json=maxBaseGasPrice,proto3" json:"max_base_gas_price,omitempty"`
-	MaxChangeRate   string `protobuf:"bytes,4,opt,name=max_change_rate,json=maxChangeRate,proto3" json:"max_change_rate,omitempty"`
-	TargetGas       int64  `protobuf:"varint,5,opt,name=target_gas,json=targetGas,proto3" json:"target_gas,omitempty"`
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// currency_pair is the Connect currency pair pricing one display unit of
+	// the denom, e.g. "USDC/USD". All pairs must share the same quote.
+	CurrencyPair string `protobuf:"bytes,2,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair,omitempty"`
+	// decimals is the exponent of the display unit of the denom, e.g. 6 for
+	// uusdc.
+	Decimals uint32 `protobuf:"varint,3,opt,name=decimals,proto3" json:"decimals,omitempty"`
 }
 
-func (x *Params) Reset() {
-	*x = Params{}
+func (x *FeeDenomOracle) Reset() {
+	*x = FeeDenomOracle{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_initia_dynamicfee_v1_types_proto_msgTypes[0]
+		mi := &file_initia_dynamicfee_v1_types_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Params) String() string {
+func (x *FeeDenomOracle) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Params) ProtoMessage() {}
+func (*FeeDenomOracle) ProtoMessage() {}
 
-// Deprecated: Use Params.ProtoReflect.Descriptor instead.
-func (*Params) Descriptor() ([]byte, []int) {
-	return file_initia_dynamicfee_v1_types_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use FeeDenomOracle.ProtoReflect.Descriptor instead.
+func (*FeeDenomOracle) Descriptor() ([]byte, []int) {
+	return file_initia_dynamicfee_v1_types_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *Params) GetBaseGasPrice() string {
+func (x *FeeDenomOracle) GetDenom() string {
 	if x != nil {
-		return x.BaseGasPrice
+		return x.Denom
 	}
 	return ""
 }
 
-func (x *Params) GetMinBaseGasPrice() string {
+func (x *FeeDenomOracle) GetCurrencyPair() string {
 	if x != nil {
-		return x.MinBaseGasPrice
+		return x.CurrencyPair
 	}
 	return ""
 }
 
-func (x *Params) GetMaxBaseGasPrice() string {
+func (x *FeeDenomOracle) GetDecimals() uint32 {
 	if x != nil {
-		return x.MaxBaseGasPrice
+		return x.Decimals
+	}
+	return 0
+}
+
+// FeeSample is the effective priority paid by a tx included in the current
+// block, kept in the transient store until the block's fee history record is
+// written.
+type FeeSample struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// tip_gas_price is the gas price paid above the base gas price, valued in
+	// the base denom.
+	TipGasPrice string `protobuf:"bytes,1,opt,name=tip_gas_price,json=tipGasPrice,proto3" json:"tip_gas_price,omitempty"`
+	// gas is the gas limit of the tx, used to weight the sample.
+	Gas uint64 `protobuf:"varint,2,opt,name=gas,proto3" json:"gas,omitempty"`
+}
+
+func (x *FeeSample) Reset() {
+	*x = FeeSample{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_dynamicfee_v1_types_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeSample) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeSample) ProtoMessage() {}
+
+// Deprecated: Use FeeSample.ProtoReflect.Descriptor instead.
+func (*FeeSample) Descriptor() ([]byte, []int) {
+	return file_initia_dynamicfee_v1_types_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FeeSample) GetTipGasPrice() string {
+	if x != nil {
+		return x.TipGasPrice
 	}
 	return ""
 }
 
-func (x *Params) GetMaxChangeRate() string {
+func (x *FeeSample) GetGas() uint64 {
 	if x != nil {
-		return x.MaxChangeRate
+		return x.Gas
+	}
+	return 0
+}
+
+// FeeHistoryRecord is the fee state of a single block kept in the fee history
+// ring buffer.
+type FeeHistoryRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// base_gas_price is the base gas price the block was executed with.
+	BaseGasPrice string `protobuf:"bytes,2,opt,name=base_gas_price,json=baseGasPrice,proto3" json:"base_gas_price,omitempty"`
+	// accumulated_gas is the block gas the base gas price update reacted to.
+	AccumulatedGas uint64 `protobuf:"varint,3,opt,name=accumulated_gas,json=accumulatedGas,proto3" json:"accumulated_gas,omitempty"`
+	TargetGas      int64  `protobuf:"varint,4,opt,name=target_gas,json=targetGas,proto3" json:"target_gas,omitempty"`
+	// tip_percentiles are the gas weighted percentiles of the tip gas prices of
+	// the block's txs, sampled every FeeHistoryPercentileStep percent from 0 to
+	// 100. Empty when the block has no txs.
+	TipPercentiles []string `protobuf:"bytes,5,rep,name=tip_percentiles,json=tipPercentiles,proto3" json:"tip_percentiles,omitempty"`
+}
+
+func (x *FeeHistoryRecord) Reset() {
+	*x = FeeHistoryRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_dynamicfee_v1_types_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeHistoryRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeHistoryRecord) ProtoMessage() {}
+
+// Deprecated: Use FeeHistoryRecord.ProtoReflect.Descriptor instead.
+func (*FeeHistoryRecord) Descriptor() ([]byte, []int) {
+	return file_initia_dynamicfee_v1_types_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FeeHistoryRecord) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *FeeHistoryRecord) GetBaseGasPrice() string {
+	if x != nil {
+		return x.BaseGasPrice
 	}
 	return ""
 }
 
-func (x *Params) GetTargetGas() int64 {
+func (x *FeeHistoryRecord) GetAccumulatedGas() uint64 {
+	if x != nil {
+		return x.AccumulatedGas
+	}
+	return 0
+}
+
+func (x *FeeHistoryRecord) GetTargetGas() int64 {
+	if x != nil {
+		return x.TargetGas
+	}
+	return 0
+}
+
+func (x *FeeHistoryRecord) GetTipPercentiles() []string {
+	if x != nil {
+		return x.TipPercentiles
+	}
+	return nil
+}
+
+// FeeHistoryEntry is the fee state of a single block, with the tip gas prices
+// at the requested percentiles.
+type FeeHistoryEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height         int64  `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	BaseGasPrice   string `protobuf:"bytes,2,opt,name=base_gas_price,json=baseGasPrice,proto3" json:"base_gas_price,omitempty"`
+	AccumulatedGas uint64 `protobuf:"varint,3,opt,name=accumulated_gas,json=accumulatedGas,proto3" json:"accumulated_gas,omitempty"`
+	TargetGas      int64  `protobuf:"varint,4,opt,name=target_gas,json=targetGas,proto3" json:"target_gas,omitempty"`
+	// rewards are the tip gas prices at the requested percentiles. The tip is
+	// what feeds the mempool priority of a tx.
+	Rewards []string `protobuf:"bytes,5,rep,name=rewards,proto3" json:"rewards,omitempty"`
+}
+
+func (x *FeeHistoryEntry) Reset() {
+	*x = FeeHistoryEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_dynamicfee_v1_types_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeHistoryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeHistoryEntry) ProtoMessage() {}
+
+// Deprecated: Use FeeHistoryEntry.ProtoReflect.Descriptor instead.
+func (*FeeHistoryEntry) Descriptor() ([]byte, []int) {
+	return file_initia_dynamicfee_v1_types_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *FeeHistoryEntry) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *FeeHistoryEntry) GetBaseGasPrice() string {
+	if x != nil {
+		return x.BaseGasPrice
+	}
+	return ""
+}
+
+func (x *FeeHistoryEntry) GetAccumulatedGas() uint64 {
+	if x != nil {
+		return x.AccumulatedGas
+	}
+	return 0
+}
+
+func (x *FeeHistoryEntry) GetTargetGas() int64 {
 	if x != nil {
 		return x.TargetGas
 	}
 	return 0
 }
 
+func (x *FeeHistoryEntry) GetRewards() []string {
+	if x != nil {
+		return x.Rewards
+	}
+	return nil
+}
+
+// FeeHistory is the fee history of the most recent blocks, in base denom gas
+// prices.
+type FeeHistory struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// entries are ordered from the oldest to the newest block.
+	Entries []*FeeHistoryEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	// next_base_gas_price is the base gas price of the next block.
+	NextBaseGasPrice string `protobuf:"bytes,2,opt,name=next_base_gas_price,json=nextBaseGasPrice,proto3" json:"next_base_gas_price,omitempty"`
+}
+
+func (x *FeeHistory) Reset() {
+	*x = FeeHistory{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_dynamicfee_v1_types_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeHistory) ProtoMessage() {}
+
+// Deprecated: Use FeeHistory.ProtoReflect.Descriptor instead.
+func (*FeeHistory) Descriptor() ([]byte, []int) {
+	return file_initia_dynamicfee_v1_types_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *FeeHistory) GetEntries() []*FeeHistoryEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *FeeHistory) GetNextBaseGasPrice() string {
+	if x != nil {
+		return x.NextBaseGasPrice
+	}
+	return ""
+}
+
 var File_initia_dynamicfee_v1_types_proto protoreflect.FileDescriptor
 
 var file_initia_dynamicfee_v1_types_proto_rawDesc = []byte{
@@ -766,7 +4587,9 @@ var file_initia_dynamicfee_v1_types_proto_rawDesc = []byte{
 	0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73,
 	0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9e, 0x04, 0x0a,
+	0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xea, 0x09, 0x0a,
 	0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x75, 0x0a, 0x0e, 0x62, 0x61, 0x73, 0x65, 0x5f,
 	0x67, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
 	0x4f, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
@@ -800,22 +4623,133 @@ var file_initia_dynamicfee_v1_types_proto_rawDesc = []byte{
 	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
 	0x0d, 0x6d, 0x61, 0x78, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1d,
 	0x0a, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x47, 0x61, 0x73, 0x42, 0xe5, 0x01,
-	0xc8, 0xe1, 0x1e, 0x00, 0xa8, 0xe2, 0x1e, 0x01, 0x0a, 0x18, 0x63, 0x6f, 0x6d, 0x2e, 0x69, 0x6e,
-	0x69, 0x74, 0x69, 0x61, 0x2e, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x66, 0x65, 0x65, 0x2e,
-	0x76, 0x31, 0x42, 0x0a, 0x54, 0x79, 0x70, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
-	0x5a, 0x43, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x69,
-	0x74, 0x69, 0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x64, 0x79, 0x6e, 0x61, 0x6d,
-	0x69, 0x63, 0x66, 0x65, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63,
-	0x66, 0x65, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x49, 0x44, 0x58, 0xaa, 0x02, 0x14, 0x49, 0x6e,
-	0x69, 0x74, 0x69, 0x61, 0x2e, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x66, 0x65, 0x65, 0x2e,
-	0x56, 0x31, 0xca, 0x02, 0x14, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x5c, 0x44, 0x79, 0x6e, 0x61,
-	0x6d, 0x69, 0x63, 0x66, 0x65, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x20, 0x49, 0x6e, 0x69, 0x74,
-	0x69, 0x61, 0x5c, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x66, 0x65, 0x65, 0x5c, 0x56, 0x31,
-	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x16, 0x49,
-	0x6e, 0x69, 0x74, 0x69, 0x61, 0x3a, 0x3a, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x66, 0x65,
-	0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x28, 0x03, 0x52, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x47, 0x61, 0x73, 0x12, 0x63, 0x0a,
+	0x12, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69,
+	0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x35, 0xf2, 0xde, 0x1f, 0x19, 0x79,
+	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x65,
+	0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x22, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x52, 0x10, 0x62, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65,
+	0x6e, 0x74, 0x12, 0x4e, 0x0a, 0x13, 0x61, 0x63, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65,
+	0x5f, 0x67, 0x61, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x42,
+	0x1e, 0xf2, 0xde, 0x1f, 0x1a, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x63, 0x63, 0x75, 0x6d,
+	0x75, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x22, 0x52,
+	0x11, 0x61, 0x63, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x47, 0x61, 0x73, 0x55, 0x73,
+	0x65, 0x64, 0x12, 0x3f, 0x0a, 0x0e, 0x75, 0x73, 0x65, 0x5f, 0x74, 0x77, 0x61, 0x70, 0x5f, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x42, 0x19, 0xf2, 0xde, 0x1f, 0x15,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x75, 0x73, 0x65, 0x5f, 0x74, 0x77, 0x61, 0x70, 0x5f, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x22, 0x52, 0x0c, 0x75, 0x73, 0x65, 0x54, 0x77, 0x61, 0x70, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x12, 0x77, 0x0a, 0x11, 0x66, 0x65, 0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
+	0x5f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x66,
+	0x65, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x4f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x42, 0x25, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x18, 0x79, 0x61,
+	0x6d, 0x6c, 0x3a, 0x22, 0x66, 0x65, 0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x5f, 0x6f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x73, 0x22, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0f, 0x66, 0x65, 0x65,
+	0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x73, 0x12, 0x9c, 0x01, 0x0a,
+	0x1b, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x64, 0x69, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e,
+	0x63, 0x65, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x5c, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65,
+	0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xf2, 0xde, 0x1f, 0x22, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
+	0x22, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x64, 0x69, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e,
+	0x63, 0x65, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0xd2, 0xb4, 0x2d,
+	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x19, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x44, 0x69, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e,
+	0x63, 0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x78, 0x0a, 0x14, 0x6f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f,
+	0x61, 0x67, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x2c, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x1b, 0x79, 0x61,
+	0x6d, 0x6c, 0x3a, 0x22, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x67, 0x65, 0x22, 0x98, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x11, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x4d, 0x61, 0x78, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x41, 0x67, 0x65, 0x12, 0x42, 0x0a, 0x0f, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x5f,
+	0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x42, 0x1a,
+	0xf2, 0xde, 0x1f, 0x16, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65,
+	0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x22, 0x52, 0x0d, 0x73, 0x65, 0x74, 0x74,
+	0x6c, 0x65, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x22, 0x81, 0x01, 0x0a, 0x0e, 0x46, 0x65,
+	0x65, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e,
+	0x6f, 0x6d, 0x12, 0x3d, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x70,
+	0x61, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xf2, 0xde, 0x1f, 0x14, 0x79,
+	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x70, 0x61,
+	0x69, 0x72, 0x22, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69,
+	0x72, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x08, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x22, 0x79, 0x0a,
+	0x09, 0x46, 0x65, 0x65, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x5a, 0x0a, 0x0d, 0x74, 0x69,
+	0x70, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67,
+	0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x74, 0x69, 0x70, 0x47, 0x61,
+	0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x67, 0x61, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x03, 0x67, 0x61, 0x73, 0x22, 0xb1, 0x02, 0x0a, 0x10, 0x46, 0x65, 0x65,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x5c, 0x0a, 0x0e, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x67, 0x61,
+	0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8,
+	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44,
+	0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x47, 0x61, 0x73, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x63, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x61, 0x63,
+	0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x47, 0x61, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x47, 0x61, 0x73, 0x12, 0x5f, 0x0a, 0x0f, 0x74,
+	0x69, 0x70, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
+	0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0e, 0x74, 0x69,
+	0x70, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x22, 0xa1, 0x02, 0x0a,
+	0x0f, 0x46, 0x65, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x5c, 0x0a, 0x0e, 0x62, 0x61, 0x73, 0x65,
+	0x5f, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61,
+	0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x47, 0x61,
+	0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x63, 0x63, 0x75, 0x6d, 0x75,
+	0x6c, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0e, 0x61, 0x63, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x47, 0x61, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x47, 0x61, 0x73, 0x12, 0x50,
+	0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x42,
+	0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63,
+	0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44,
+	0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73,
+	0x22, 0xbf, 0x01, 0x0a, 0x0a, 0x46, 0x65, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12,
+	0x4a, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69,
+	0x63, 0x66, 0x65, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x65, 0x0a, 0x13, 0x6e,
+	0x65, 0x78, 0x74, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
+	0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
+	0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d,
+	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x10, 0x6e, 0x65, 0x78, 0x74, 0x42, 0x61, 0x73, 0x65, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x42, 0xe5, 0x01, 0xc8, 0xe1, 0x1e, 0x00, 0xa8, 0xe2, 0x1e, 0x01, 0x0a, 0x18, 0x63,
+	0x6f, 0x6d, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69,
+	0x63, 0x66, 0x65, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x54, 0x79, 0x70, 0x65, 0x73, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x43, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x69, 0x6e,
+	0x69, 0x74, 0x69, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f,
+	0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x66, 0x65, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x64, 0x79,
+	0x6e, 0x61, 0x6d, 0x69, 0x63, 0x66, 0x65, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x49, 0x44, 0x58,
+	0xaa, 0x02, 0x14, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69,
+	0x63, 0x66, 0x65, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x14, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61,
+	0x5c, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x66, 0x65, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02,
+	0x20, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x5c, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x66,
+	0x65, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0xea, 0x02, 0x16, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x3a, 0x3a, 0x44, 0x79, 0x6e, 0x61,
+	0x6d, 0x69, 0x63, 0x66, 0x65, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -830,16 +4764,25 @@ func file_initia_dynamicfee_v1_types_proto_rawDescGZIP() []byte {
 	return file_initia_dynamicfee_v1_types_proto_rawDescData
 }
 
-var file_initia_dynamicfee_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_initia_dynamicfee_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_initia_dynamicfee_v1_types_proto_goTypes = []interface{}{
-	(*Params)(nil), // 0: initia.dynamicfee.v1.Params
+	(*Params)(nil),              // 0: initia.dynamicfee.v1.Params
+	(*FeeDenomOracle)(nil),      // 1: initia.dynamicfee.v1.FeeDenomOracle
+	(*FeeSample)(nil),           // 2: initia.dynamicfee.v1.FeeSample
+	(*FeeHistoryRecord)(nil),    // 3: initia.dynamicfee.v1.FeeHistoryRecord
+	(*FeeHistoryEntry)(nil),     // 4: initia.dynamicfee.v1.FeeHistoryEntry
+	(*FeeHistory)(nil),          // 5: initia.dynamicfee.v1.FeeHistory
+	(*durationpb.Duration)(nil), // 6: google.protobuf.Duration
 }
 var file_initia_dynamicfee_v1_types_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	1, // 0: initia.dynamicfee.v1.Params.fee_denom_oracles:type_name -> initia.dynamicfee.v1.FeeDenomOracle
+	6, // 1: initia.dynamicfee.v1.Params.oracle_max_price_age:type_name -> google.protobuf.Duration
+	4, // 2: initia.dynamicfee.v1.FeeHistory.entries:type_name -> initia.dynamicfee.v1.FeeHistoryEntry
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_initia_dynamicfee_v1_types_proto_init() }
@@ -860,6 +4803,66 @@ func file_initia_dynamicfee_v1_types_proto_init() {
 				return nil
 			}
 		}
+		file_initia_dynamicfee_v1_types_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeDenomOracle); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_dynamicfee_v1_types_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeSample); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_dynamicfee_v1_types_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeHistoryRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_dynamicfee_v1_types_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeHistoryEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_dynamicfee_v1_types_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeHistory); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -867,7 +4870,7 @@ func file_initia_dynamicfee_v1_types_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_initia_dynamicfee_v1_types_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
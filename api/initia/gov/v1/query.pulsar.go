@@ -4680,6 +4680,2062 @@ func (x *fastReflection_QueryTallyResultResponse) ProtoMethods() *protoiface.Met
 	}
 }
 
+var (
+	md_QueryEffectiveVoteDelegateRequest           protoreflect.MessageDescriptor
+	fd_QueryEffectiveVoteDelegateRequest_delegator protoreflect.FieldDescriptor
+	fd_QueryEffectiveVoteDelegateRequest_msg_type  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_gov_v1_query_proto_init()
+	md_QueryEffectiveVoteDelegateRequest = File_initia_gov_v1_query_proto.Messages().ByName("QueryEffectiveVoteDelegateRequest")
+	fd_QueryEffectiveVoteDelegateRequest_delegator = md_QueryEffectiveVoteDelegateRequest.Fields().ByName("delegator")
+	fd_QueryEffectiveVoteDelegateRequest_msg_type = md_QueryEffectiveVoteDelegateRequest.Fields().ByName("msg_type")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryEffectiveVoteDelegateRequest)(nil)
+
+type fastReflection_QueryEffectiveVoteDelegateRequest QueryEffectiveVoteDelegateRequest
+
+func (x *QueryEffectiveVoteDelegateRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryEffectiveVoteDelegateRequest)(x)
+}
+
+func (x *QueryEffectiveVoteDelegateRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_gov_v1_query_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryEffectiveVoteDelegateRequest_messageType fastReflection_QueryEffectiveVoteDelegateRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryEffectiveVoteDelegateRequest_messageType{}
+
+type fastReflection_QueryEffectiveVoteDelegateRequest_messageType struct{}
+
+func (x fastReflection_QueryEffectiveVoteDelegateRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryEffectiveVoteDelegateRequest)(nil)
+}
+func (x fastReflection_QueryEffectiveVoteDelegateRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryEffectiveVoteDelegateRequest)
+}
+func (x fastReflection_QueryEffectiveVoteDelegateRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryEffectiveVoteDelegateRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryEffectiveVoteDelegateRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryEffectiveVoteDelegateRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryEffectiveVoteDelegateRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryEffectiveVoteDelegateRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryEffectiveVoteDelegateRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryEffectiveVoteDelegateRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryEffectiveVoteDelegateRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryEffectiveVoteDelegateRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryEffectiveVoteDelegateRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Delegator != "" {
+		value := protoreflect.ValueOfString(x.Delegator)
+		if !f(fd_QueryEffectiveVoteDelegateRequest_delegator, value) {
+			return
+		}
+	}
+	if x.MsgType != "" {
+		value := protoreflect.ValueOfString(x.MsgType)
+		if !f(fd_QueryEffectiveVoteDelegateRequest_msg_type, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryEffectiveVoteDelegateRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.gov.v1.QueryEffectiveVoteDelegateRequest.delegator":
+		return x.Delegator != ""
+	case "initia.gov.v1.QueryEffectiveVoteDelegateRequest.msg_type":
+		return x.MsgType != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.QueryEffectiveVoteDelegateRequest"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.QueryEffectiveVoteDelegateRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEffectiveVoteDelegateRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.gov.v1.QueryEffectiveVoteDelegateRequest.delegator":
+		x.Delegator = ""
+	case "initia.gov.v1.QueryEffectiveVoteDelegateRequest.msg_type":
+		x.MsgType = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.QueryEffectiveVoteDelegateRequest"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.QueryEffectiveVoteDelegateRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryEffectiveVoteDelegateRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.gov.v1.QueryEffectiveVoteDelegateRequest.delegator":
+		value := x.Delegator
+		return protoreflect.ValueOfString(value)
+	case "initia.gov.v1.QueryEffectiveVoteDelegateRequest.msg_type":
+		value := x.MsgType
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.QueryEffectiveVoteDelegateRequest"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.QueryEffectiveVoteDelegateRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEffectiveVoteDelegateRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.gov.v1.QueryEffectiveVoteDelegateRequest.delegator":
+		x.Delegator = value.Interface().(string)
+	case "initia.gov.v1.QueryEffectiveVoteDelegateRequest.msg_type":
+		x.MsgType = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.QueryEffectiveVoteDelegateRequest"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.QueryEffectiveVoteDelegateRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEffectiveVoteDelegateRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.gov.v1.QueryEffectiveVoteDelegateRequest.delegator":
+		panic(fmt.Errorf("field delegator of message initia.gov.v1.QueryEffectiveVoteDelegateRequest is not mutable"))
+	case "initia.gov.v1.QueryEffectiveVoteDelegateRequest.msg_type":
+		panic(fmt.Errorf("field msg_type of message initia.gov.v1.QueryEffectiveVoteDelegateRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.QueryEffectiveVoteDelegateRequest"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.QueryEffectiveVoteDelegateRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryEffectiveVoteDelegateRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.gov.v1.QueryEffectiveVoteDelegateRequest.delegator":
+		return protoreflect.ValueOfString("")
+	case "initia.gov.v1.QueryEffectiveVoteDelegateRequest.msg_type":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.QueryEffectiveVoteDelegateRequest"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.QueryEffectiveVoteDelegateRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryEffectiveVoteDelegateRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.gov.v1.QueryEffectiveVoteDelegateRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryEffectiveVoteDelegateRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEffectiveVoteDelegateRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryEffectiveVoteDelegateRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryEffectiveVoteDelegateRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryEffectiveVoteDelegateRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Delegator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MsgType)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryEffectiveVoteDelegateRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.MsgType) > 0 {
+			i -= len(x.MsgType)
+			copy(dAtA[i:], x.MsgType)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MsgType)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Delegator) > 0 {
+			i -= len(x.Delegator)
+			copy(dAtA[i:], x.Delegator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Delegator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryEffectiveVoteDelegateRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEffectiveVoteDelegateRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEffectiveVoteDelegateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Delegator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MsgType", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MsgType = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryEffectiveVoteDelegateResponse_2_list)(nil)
+
+type _QueryEffectiveVoteDelegateResponse_2_list struct {
+	list *[]string
+}
+
+func (x *_QueryEffectiveVoteDelegateResponse_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryEffectiveVoteDelegateResponse_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_QueryEffectiveVoteDelegateResponse_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryEffectiveVoteDelegateResponse_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryEffectiveVoteDelegateResponse_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message QueryEffectiveVoteDelegateResponse at list field Chain as it is not of Message kind"))
+}
+
+func (x *_QueryEffectiveVoteDelegateResponse_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryEffectiveVoteDelegateResponse_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_QueryEffectiveVoteDelegateResponse_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryEffectiveVoteDelegateResponse          protoreflect.MessageDescriptor
+	fd_QueryEffectiveVoteDelegateResponse_delegate protoreflect.FieldDescriptor
+	fd_QueryEffectiveVoteDelegateResponse_chain    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_gov_v1_query_proto_init()
+	md_QueryEffectiveVoteDelegateResponse = File_initia_gov_v1_query_proto.Messages().ByName("QueryEffectiveVoteDelegateResponse")
+	fd_QueryEffectiveVoteDelegateResponse_delegate = md_QueryEffectiveVoteDelegateResponse.Fields().ByName("delegate")
+	fd_QueryEffectiveVoteDelegateResponse_chain = md_QueryEffectiveVoteDelegateResponse.Fields().ByName("chain")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryEffectiveVoteDelegateResponse)(nil)
+
+type fastReflection_QueryEffectiveVoteDelegateResponse QueryEffectiveVoteDelegateResponse
+
+func (x *QueryEffectiveVoteDelegateResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryEffectiveVoteDelegateResponse)(x)
+}
+
+func (x *QueryEffectiveVoteDelegateResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_gov_v1_query_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryEffectiveVoteDelegateResponse_messageType fastReflection_QueryEffectiveVoteDelegateResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryEffectiveVoteDelegateResponse_messageType{}
+
+type fastReflection_QueryEffectiveVoteDelegateResponse_messageType struct{}
+
+func (x fastReflection_QueryEffectiveVoteDelegateResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryEffectiveVoteDelegateResponse)(nil)
+}
+func (x fastReflection_QueryEffectiveVoteDelegateResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryEffectiveVoteDelegateResponse)
+}
+func (x fastReflection_QueryEffectiveVoteDelegateResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryEffectiveVoteDelegateResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryEffectiveVoteDelegateResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryEffectiveVoteDelegateResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryEffectiveVoteDelegateResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryEffectiveVoteDelegateResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryEffectiveVoteDelegateResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryEffectiveVoteDelegateResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryEffectiveVoteDelegateResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryEffectiveVoteDelegateResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryEffectiveVoteDelegateResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Delegate != "" {
+		value := protoreflect.ValueOfString(x.Delegate)
+		if !f(fd_QueryEffectiveVoteDelegateResponse_delegate, value) {
+			return
+		}
+	}
+	if len(x.Chain) != 0 {
+		value := protoreflect.ValueOfList(&_QueryEffectiveVoteDelegateResponse_2_list{list: &x.Chain})
+		if !f(fd_QueryEffectiveVoteDelegateResponse_chain, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryEffectiveVoteDelegateResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.gov.v1.QueryEffectiveVoteDelegateResponse.delegate":
+		return x.Delegate != ""
+	case "initia.gov.v1.QueryEffectiveVoteDelegateResponse.chain":
+		return len(x.Chain) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.QueryEffectiveVoteDelegateResponse"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.QueryEffectiveVoteDelegateResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEffectiveVoteDelegateResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.gov.v1.QueryEffectiveVoteDelegateResponse.delegate":
+		x.Delegate = ""
+	case "initia.gov.v1.QueryEffectiveVoteDelegateResponse.chain":
+		x.Chain = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.QueryEffectiveVoteDelegateResponse"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.QueryEffectiveVoteDelegateResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryEffectiveVoteDelegateResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.gov.v1.QueryEffectiveVoteDelegateResponse.delegate":
+		value := x.Delegate
+		return protoreflect.ValueOfString(value)
+	case "initia.gov.v1.QueryEffectiveVoteDelegateResponse.chain":
+		if len(x.Chain) == 0 {
+			return protoreflect.ValueOfList(&_QueryEffectiveVoteDelegateResponse_2_list{})
+		}
+		listValue := &_QueryEffectiveVoteDelegateResponse_2_list{list: &x.Chain}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.QueryEffectiveVoteDelegateResponse"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.QueryEffectiveVoteDelegateResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEffectiveVoteDelegateResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.gov.v1.QueryEffectiveVoteDelegateResponse.delegate":
+		x.Delegate = value.Interface().(string)
+	case "initia.gov.v1.QueryEffectiveVoteDelegateResponse.chain":
+		lv := value.List()
+		clv := lv.(*_QueryEffectiveVoteDelegateResponse_2_list)
+		x.Chain = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.QueryEffectiveVoteDelegateResponse"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.QueryEffectiveVoteDelegateResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEffectiveVoteDelegateResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.gov.v1.QueryEffectiveVoteDelegateResponse.chain":
+		if x.Chain == nil {
+			x.Chain = []string{}
+		}
+		value := &_QueryEffectiveVoteDelegateResponse_2_list{list: &x.Chain}
+		return protoreflect.ValueOfList(value)
+	case "initia.gov.v1.QueryEffectiveVoteDelegateResponse.delegate":
+		panic(fmt.Errorf("field delegate of message initia.gov.v1.QueryEffectiveVoteDelegateResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.QueryEffectiveVoteDelegateResponse"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.QueryEffectiveVoteDelegateResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryEffectiveVoteDelegateResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.gov.v1.QueryEffectiveVoteDelegateResponse.delegate":
+		return protoreflect.ValueOfString("")
+	case "initia.gov.v1.QueryEffectiveVoteDelegateResponse.chain":
+		list := []string{}
+		return protoreflect.ValueOfList(&_QueryEffectiveVoteDelegateResponse_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.QueryEffectiveVoteDelegateResponse"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.QueryEffectiveVoteDelegateResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryEffectiveVoteDelegateResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.gov.v1.QueryEffectiveVoteDelegateResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryEffectiveVoteDelegateResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEffectiveVoteDelegateResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryEffectiveVoteDelegateResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryEffectiveVoteDelegateResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryEffectiveVoteDelegateResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Delegate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Chain) > 0 {
+			for _, s := range x.Chain {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryEffectiveVoteDelegateResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Chain) > 0 {
+			for iNdEx := len(x.Chain) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Chain[iNdEx])
+				copy(dAtA[i:], x.Chain[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Chain[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Delegate) > 0 {
+			i -= len(x.Delegate)
+			copy(dAtA[i:], x.Delegate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Delegate)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryEffectiveVoteDelegateResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEffectiveVoteDelegateResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEffectiveVoteDelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Delegate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Chain = append(x.Chain, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryDelegatedVotingPowerRequest          protoreflect.MessageDescriptor
+	fd_QueryDelegatedVotingPowerRequest_delegate protoreflect.FieldDescriptor
+	fd_QueryDelegatedVotingPowerRequest_msg_type protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_gov_v1_query_proto_init()
+	md_QueryDelegatedVotingPowerRequest = File_initia_gov_v1_query_proto.Messages().ByName("QueryDelegatedVotingPowerRequest")
+	fd_QueryDelegatedVotingPowerRequest_delegate = md_QueryDelegatedVotingPowerRequest.Fields().ByName("delegate")
+	fd_QueryDelegatedVotingPowerRequest_msg_type = md_QueryDelegatedVotingPowerRequest.Fields().ByName("msg_type")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryDelegatedVotingPowerRequest)(nil)
+
+type fastReflection_QueryDelegatedVotingPowerRequest QueryDelegatedVotingPowerRequest
+
+func (x *QueryDelegatedVotingPowerRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryDelegatedVotingPowerRequest)(x)
+}
+
+func (x *QueryDelegatedVotingPowerRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_gov_v1_query_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryDelegatedVotingPowerRequest_messageType fastReflection_QueryDelegatedVotingPowerRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryDelegatedVotingPowerRequest_messageType{}
+
+type fastReflection_QueryDelegatedVotingPowerRequest_messageType struct{}
+
+func (x fastReflection_QueryDelegatedVotingPowerRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryDelegatedVotingPowerRequest)(nil)
+}
+func (x fastReflection_QueryDelegatedVotingPowerRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryDelegatedVotingPowerRequest)
+}
+func (x fastReflection_QueryDelegatedVotingPowerRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDelegatedVotingPowerRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryDelegatedVotingPowerRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDelegatedVotingPowerRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryDelegatedVotingPowerRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryDelegatedVotingPowerRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryDelegatedVotingPowerRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryDelegatedVotingPowerRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryDelegatedVotingPowerRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryDelegatedVotingPowerRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryDelegatedVotingPowerRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Delegate != "" {
+		value := protoreflect.ValueOfString(x.Delegate)
+		if !f(fd_QueryDelegatedVotingPowerRequest_delegate, value) {
+			return
+		}
+	}
+	if x.MsgType != "" {
+		value := protoreflect.ValueOfString(x.MsgType)
+		if !f(fd_QueryDelegatedVotingPowerRequest_msg_type, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryDelegatedVotingPowerRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.gov.v1.QueryDelegatedVotingPowerRequest.delegate":
+		return x.Delegate != ""
+	case "initia.gov.v1.QueryDelegatedVotingPowerRequest.msg_type":
+		return x.MsgType != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.QueryDelegatedVotingPowerRequest"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.QueryDelegatedVotingPowerRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDelegatedVotingPowerRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.gov.v1.QueryDelegatedVotingPowerRequest.delegate":
+		x.Delegate = ""
+	case "initia.gov.v1.QueryDelegatedVotingPowerRequest.msg_type":
+		x.MsgType = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.QueryDelegatedVotingPowerRequest"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.QueryDelegatedVotingPowerRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryDelegatedVotingPowerRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.gov.v1.QueryDelegatedVotingPowerRequest.delegate":
+		value := x.Delegate
+		return protoreflect.ValueOfString(value)
+	case "initia.gov.v1.QueryDelegatedVotingPowerRequest.msg_type":
+		value := x.MsgType
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.QueryDelegatedVotingPowerRequest"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.QueryDelegatedVotingPowerRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDelegatedVotingPowerRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.gov.v1.QueryDelegatedVotingPowerRequest.delegate":
+		x.Delegate = value.Interface().(string)
+	case "initia.gov.v1.QueryDelegatedVotingPowerRequest.msg_type":
+		x.MsgType = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.QueryDelegatedVotingPowerRequest"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.QueryDelegatedVotingPowerRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDelegatedVotingPowerRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.gov.v1.QueryDelegatedVotingPowerRequest.delegate":
+		panic(fmt.Errorf("field delegate of message initia.gov.v1.QueryDelegatedVotingPowerRequest is not mutable"))
+	case "initia.gov.v1.QueryDelegatedVotingPowerRequest.msg_type":
+		panic(fmt.Errorf("field msg_type of message initia.gov.v1.QueryDelegatedVotingPowerRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.QueryDelegatedVotingPowerRequest"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.QueryDelegatedVotingPowerRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryDelegatedVotingPowerRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.gov.v1.QueryDelegatedVotingPowerRequest.delegate":
+		return protoreflect.ValueOfString("")
+	case "initia.gov.v1.QueryDelegatedVotingPowerRequest.msg_type":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.QueryDelegatedVotingPowerRequest"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.QueryDelegatedVotingPowerRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryDelegatedVotingPowerRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.gov.v1.QueryDelegatedVotingPowerRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryDelegatedVotingPowerRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDelegatedVotingPowerRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryDelegatedVotingPowerRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryDelegatedVotingPowerRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryDelegatedVotingPowerRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Delegate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MsgType)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryDelegatedVotingPowerRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.MsgType) > 0 {
+			i -= len(x.MsgType)
+			copy(dAtA[i:], x.MsgType)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MsgType)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Delegate) > 0 {
+			i -= len(x.Delegate)
+			copy(dAtA[i:], x.Delegate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Delegate)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryDelegatedVotingPowerRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDelegatedVotingPowerRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDelegatedVotingPowerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Delegate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MsgType", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MsgType = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryDelegatedVotingPowerResponse_2_list)(nil)
+
+type _QueryDelegatedVotingPowerResponse_2_list struct {
+	list *[]string
+}
+
+func (x *_QueryDelegatedVotingPowerResponse_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryDelegatedVotingPowerResponse_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_QueryDelegatedVotingPowerResponse_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryDelegatedVotingPowerResponse_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryDelegatedVotingPowerResponse_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message QueryDelegatedVotingPowerResponse at list field Delegators as it is not of Message kind"))
+}
+
+func (x *_QueryDelegatedVotingPowerResponse_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryDelegatedVotingPowerResponse_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_QueryDelegatedVotingPowerResponse_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryDelegatedVotingPowerResponse              protoreflect.MessageDescriptor
+	fd_QueryDelegatedVotingPowerResponse_voting_power protoreflect.FieldDescriptor
+	fd_QueryDelegatedVotingPowerResponse_delegators   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_gov_v1_query_proto_init()
+	md_QueryDelegatedVotingPowerResponse = File_initia_gov_v1_query_proto.Messages().ByName("QueryDelegatedVotingPowerResponse")
+	fd_QueryDelegatedVotingPowerResponse_voting_power = md_QueryDelegatedVotingPowerResponse.Fields().ByName("voting_power")
+	fd_QueryDelegatedVotingPowerResponse_delegators = md_QueryDelegatedVotingPowerResponse.Fields().ByName("delegators")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryDelegatedVotingPowerResponse)(nil)
+
+type fastReflection_QueryDelegatedVotingPowerResponse QueryDelegatedVotingPowerResponse
+
+func (x *QueryDelegatedVotingPowerResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryDelegatedVotingPowerResponse)(x)
+}
+
+func (x *QueryDelegatedVotingPowerResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_gov_v1_query_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryDelegatedVotingPowerResponse_messageType fastReflection_QueryDelegatedVotingPowerResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryDelegatedVotingPowerResponse_messageType{}
+
+type fastReflection_QueryDelegatedVotingPowerResponse_messageType struct{}
+
+func (x fastReflection_QueryDelegatedVotingPowerResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryDelegatedVotingPowerResponse)(nil)
+}
+func (x fastReflection_QueryDelegatedVotingPowerResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryDelegatedVotingPowerResponse)
+}
+func (x fastReflection_QueryDelegatedVotingPowerResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDelegatedVotingPowerResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryDelegatedVotingPowerResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDelegatedVotingPowerResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryDelegatedVotingPowerResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryDelegatedVotingPowerResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryDelegatedVotingPowerResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryDelegatedVotingPowerResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryDelegatedVotingPowerResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryDelegatedVotingPowerResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryDelegatedVotingPowerResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.VotingPower != "" {
+		value := protoreflect.ValueOfString(x.VotingPower)
+		if !f(fd_QueryDelegatedVotingPowerResponse_voting_power, value) {
+			return
+		}
+	}
+	if len(x.Delegators) != 0 {
+		value := protoreflect.ValueOfList(&_QueryDelegatedVotingPowerResponse_2_list{list: &x.Delegators})
+		if !f(fd_QueryDelegatedVotingPowerResponse_delegators, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryDelegatedVotingPowerResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.gov.v1.QueryDelegatedVotingPowerResponse.voting_power":
+		return x.VotingPower != ""
+	case "initia.gov.v1.QueryDelegatedVotingPowerResponse.delegators":
+		return len(x.Delegators) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.QueryDelegatedVotingPowerResponse"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.QueryDelegatedVotingPowerResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDelegatedVotingPowerResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.gov.v1.QueryDelegatedVotingPowerResponse.voting_power":
+		x.VotingPower = ""
+	case "initia.gov.v1.QueryDelegatedVotingPowerResponse.delegators":
+		x.Delegators = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.QueryDelegatedVotingPowerResponse"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.QueryDelegatedVotingPowerResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryDelegatedVotingPowerResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.gov.v1.QueryDelegatedVotingPowerResponse.voting_power":
+		value := x.VotingPower
+		return protoreflect.ValueOfString(value)
+	case "initia.gov.v1.QueryDelegatedVotingPowerResponse.delegators":
+		if len(x.Delegators) == 0 {
+			return protoreflect.ValueOfList(&_QueryDelegatedVotingPowerResponse_2_list{})
+		}
+		listValue := &_QueryDelegatedVotingPowerResponse_2_list{list: &x.Delegators}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.QueryDelegatedVotingPowerResponse"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.QueryDelegatedVotingPowerResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDelegatedVotingPowerResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.gov.v1.QueryDelegatedVotingPowerResponse.voting_power":
+		x.VotingPower = value.Interface().(string)
+	case "initia.gov.v1.QueryDelegatedVotingPowerResponse.delegators":
+		lv := value.List()
+		clv := lv.(*_QueryDelegatedVotingPowerResponse_2_list)
+		x.Delegators = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.QueryDelegatedVotingPowerResponse"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.QueryDelegatedVotingPowerResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDelegatedVotingPowerResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.gov.v1.QueryDelegatedVotingPowerResponse.delegators":
+		if x.Delegators == nil {
+			x.Delegators = []string{}
+		}
+		value := &_QueryDelegatedVotingPowerResponse_2_list{list: &x.Delegators}
+		return protoreflect.ValueOfList(value)
+	case "initia.gov.v1.QueryDelegatedVotingPowerResponse.voting_power":
+		panic(fmt.Errorf("field voting_power of message initia.gov.v1.QueryDelegatedVotingPowerResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.QueryDelegatedVotingPowerResponse"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.QueryDelegatedVotingPowerResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryDelegatedVotingPowerResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.gov.v1.QueryDelegatedVotingPowerResponse.voting_power":
+		return protoreflect.ValueOfString("")
+	case "initia.gov.v1.QueryDelegatedVotingPowerResponse.delegators":
+		list := []string{}
+		return protoreflect.ValueOfList(&_QueryDelegatedVotingPowerResponse_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.QueryDelegatedVotingPowerResponse"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.QueryDelegatedVotingPowerResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryDelegatedVotingPowerResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.gov.v1.QueryDelegatedVotingPowerResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryDelegatedVotingPowerResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDelegatedVotingPowerResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryDelegatedVotingPowerResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryDelegatedVotingPowerResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryDelegatedVotingPowerResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.VotingPower)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Delegators) > 0 {
+			for _, s := range x.Delegators {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryDelegatedVotingPowerResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Delegators) > 0 {
+			for iNdEx := len(x.Delegators) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Delegators[iNdEx])
+				copy(dAtA[i:], x.Delegators[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Delegators[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.VotingPower) > 0 {
+			i -= len(x.VotingPower)
+			copy(dAtA[i:], x.VotingPower)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VotingPower)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryDelegatedVotingPowerResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDelegatedVotingPowerResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDelegatedVotingPowerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VotingPower = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Delegators", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Delegators = append(x.Delegators, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
 var (
 	md_QuerySimulateProposalRequest                     protoreflect.MessageDescriptor
 	fd_QuerySimulateProposalRequest_msg_submit_proposal protoreflect.FieldDescriptor
@@ -4687,20 +6743,1030 @@ var (
 
 func init() {
 	file_initia_gov_v1_query_proto_init()
-	md_QuerySimulateProposalRequest = File_initia_gov_v1_query_proto.Messages().ByName("QuerySimulateProposalRequest")
-	fd_QuerySimulateProposalRequest_msg_submit_proposal = md_QuerySimulateProposalRequest.Fields().ByName("msg_submit_proposal")
+	md_QuerySimulateProposalRequest = File_initia_gov_v1_query_proto.Messages().ByName("QuerySimulateProposalRequest")
+	fd_QuerySimulateProposalRequest_msg_submit_proposal = md_QuerySimulateProposalRequest.Fields().ByName("msg_submit_proposal")
+}
+
+var _ protoreflect.Message = (*fastReflection_QuerySimulateProposalRequest)(nil)
+
+type fastReflection_QuerySimulateProposalRequest QuerySimulateProposalRequest
+
+func (x *QuerySimulateProposalRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QuerySimulateProposalRequest)(x)
+}
+
+func (x *QuerySimulateProposalRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_gov_v1_query_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QuerySimulateProposalRequest_messageType fastReflection_QuerySimulateProposalRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QuerySimulateProposalRequest_messageType{}
+
+type fastReflection_QuerySimulateProposalRequest_messageType struct{}
+
+func (x fastReflection_QuerySimulateProposalRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QuerySimulateProposalRequest)(nil)
+}
+func (x fastReflection_QuerySimulateProposalRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QuerySimulateProposalRequest)
+}
+func (x fastReflection_QuerySimulateProposalRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySimulateProposalRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QuerySimulateProposalRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySimulateProposalRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QuerySimulateProposalRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QuerySimulateProposalRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QuerySimulateProposalRequest) New() protoreflect.Message {
+	return new(fastReflection_QuerySimulateProposalRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QuerySimulateProposalRequest) Interface() protoreflect.ProtoMessage {
+	return (*QuerySimulateProposalRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QuerySimulateProposalRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MsgSubmitProposal != nil {
+		value := protoreflect.ValueOfMessage(x.MsgSubmitProposal.ProtoReflect())
+		if !f(fd_QuerySimulateProposalRequest_msg_submit_proposal, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QuerySimulateProposalRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.gov.v1.QuerySimulateProposalRequest.msg_submit_proposal":
+		return x.MsgSubmitProposal != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.QuerySimulateProposalRequest"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.QuerySimulateProposalRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateProposalRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.gov.v1.QuerySimulateProposalRequest.msg_submit_proposal":
+		x.MsgSubmitProposal = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.QuerySimulateProposalRequest"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.QuerySimulateProposalRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QuerySimulateProposalRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.gov.v1.QuerySimulateProposalRequest.msg_submit_proposal":
+		value := x.MsgSubmitProposal
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.QuerySimulateProposalRequest"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.QuerySimulateProposalRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateProposalRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.gov.v1.QuerySimulateProposalRequest.msg_submit_proposal":
+		x.MsgSubmitProposal = value.Message().Interface().(*v1.MsgSubmitProposal)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.QuerySimulateProposalRequest"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.QuerySimulateProposalRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateProposalRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.gov.v1.QuerySimulateProposalRequest.msg_submit_proposal":
+		if x.MsgSubmitProposal == nil {
+			x.MsgSubmitProposal = new(v1.MsgSubmitProposal)
+		}
+		return protoreflect.ValueOfMessage(x.MsgSubmitProposal.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.QuerySimulateProposalRequest"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.QuerySimulateProposalRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QuerySimulateProposalRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.gov.v1.QuerySimulateProposalRequest.msg_submit_proposal":
+		m := new(v1.MsgSubmitProposal)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.QuerySimulateProposalRequest"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.QuerySimulateProposalRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QuerySimulateProposalRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.gov.v1.QuerySimulateProposalRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QuerySimulateProposalRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateProposalRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QuerySimulateProposalRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QuerySimulateProposalRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QuerySimulateProposalRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.MsgSubmitProposal != nil {
+			l = options.Size(x.MsgSubmitProposal)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySimulateProposalRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.MsgSubmitProposal != nil {
+			encoded, err := options.Marshal(x.MsgSubmitProposal)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySimulateProposalRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySimulateProposalRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySimulateProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MsgSubmitProposal", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.MsgSubmitProposal == nil {
+					x.MsgSubmitProposal = &v1.MsgSubmitProposal{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MsgSubmitProposal); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QuerySimulateProposalResponse_2_list)(nil)
+
+type _QuerySimulateProposalResponse_2_list struct {
+	list *[]*v1beta11.Result
+}
+
+func (x *_QuerySimulateProposalResponse_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QuerySimulateProposalResponse_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QuerySimulateProposalResponse_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Result)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QuerySimulateProposalResponse_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Result)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QuerySimulateProposalResponse_2_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta11.Result)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QuerySimulateProposalResponse_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QuerySimulateProposalResponse_2_list) NewElement() protoreflect.Value {
+	v := new(v1beta11.Result)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QuerySimulateProposalResponse_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QuerySimulateProposalResponse          protoreflect.MessageDescriptor
+	fd_QuerySimulateProposalResponse_gas_info protoreflect.FieldDescriptor
+	fd_QuerySimulateProposalResponse_results  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_gov_v1_query_proto_init()
+	md_QuerySimulateProposalResponse = File_initia_gov_v1_query_proto.Messages().ByName("QuerySimulateProposalResponse")
+	fd_QuerySimulateProposalResponse_gas_info = md_QuerySimulateProposalResponse.Fields().ByName("gas_info")
+	fd_QuerySimulateProposalResponse_results = md_QuerySimulateProposalResponse.Fields().ByName("results")
+}
+
+var _ protoreflect.Message = (*fastReflection_QuerySimulateProposalResponse)(nil)
+
+type fastReflection_QuerySimulateProposalResponse QuerySimulateProposalResponse
+
+func (x *QuerySimulateProposalResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QuerySimulateProposalResponse)(x)
+}
+
+func (x *QuerySimulateProposalResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_gov_v1_query_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QuerySimulateProposalResponse_messageType fastReflection_QuerySimulateProposalResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QuerySimulateProposalResponse_messageType{}
+
+type fastReflection_QuerySimulateProposalResponse_messageType struct{}
+
+func (x fastReflection_QuerySimulateProposalResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QuerySimulateProposalResponse)(nil)
+}
+func (x fastReflection_QuerySimulateProposalResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QuerySimulateProposalResponse)
+}
+func (x fastReflection_QuerySimulateProposalResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySimulateProposalResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QuerySimulateProposalResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySimulateProposalResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QuerySimulateProposalResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QuerySimulateProposalResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QuerySimulateProposalResponse) New() protoreflect.Message {
+	return new(fastReflection_QuerySimulateProposalResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QuerySimulateProposalResponse) Interface() protoreflect.ProtoMessage {
+	return (*QuerySimulateProposalResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QuerySimulateProposalResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.GasInfo != nil {
+		value := protoreflect.ValueOfMessage(x.GasInfo.ProtoReflect())
+		if !f(fd_QuerySimulateProposalResponse_gas_info, value) {
+			return
+		}
+	}
+	if len(x.Results) != 0 {
+		value := protoreflect.ValueOfList(&_QuerySimulateProposalResponse_2_list{list: &x.Results})
+		if !f(fd_QuerySimulateProposalResponse_results, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QuerySimulateProposalResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.gov.v1.QuerySimulateProposalResponse.gas_info":
+		return x.GasInfo != nil
+	case "initia.gov.v1.QuerySimulateProposalResponse.results":
+		return len(x.Results) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.QuerySimulateProposalResponse"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.QuerySimulateProposalResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateProposalResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.gov.v1.QuerySimulateProposalResponse.gas_info":
+		x.GasInfo = nil
+	case "initia.gov.v1.QuerySimulateProposalResponse.results":
+		x.Results = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.QuerySimulateProposalResponse"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.QuerySimulateProposalResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QuerySimulateProposalResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.gov.v1.QuerySimulateProposalResponse.gas_info":
+		value := x.GasInfo
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "initia.gov.v1.QuerySimulateProposalResponse.results":
+		if len(x.Results) == 0 {
+			return protoreflect.ValueOfList(&_QuerySimulateProposalResponse_2_list{})
+		}
+		listValue := &_QuerySimulateProposalResponse_2_list{list: &x.Results}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.QuerySimulateProposalResponse"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.QuerySimulateProposalResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateProposalResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.gov.v1.QuerySimulateProposalResponse.gas_info":
+		x.GasInfo = value.Message().Interface().(*v1beta11.GasInfo)
+	case "initia.gov.v1.QuerySimulateProposalResponse.results":
+		lv := value.List()
+		clv := lv.(*_QuerySimulateProposalResponse_2_list)
+		x.Results = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.QuerySimulateProposalResponse"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.QuerySimulateProposalResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateProposalResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.gov.v1.QuerySimulateProposalResponse.gas_info":
+		if x.GasInfo == nil {
+			x.GasInfo = new(v1beta11.GasInfo)
+		}
+		return protoreflect.ValueOfMessage(x.GasInfo.ProtoReflect())
+	case "initia.gov.v1.QuerySimulateProposalResponse.results":
+		if x.Results == nil {
+			x.Results = []*v1beta11.Result{}
+		}
+		value := &_QuerySimulateProposalResponse_2_list{list: &x.Results}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.QuerySimulateProposalResponse"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.QuerySimulateProposalResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QuerySimulateProposalResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.gov.v1.QuerySimulateProposalResponse.gas_info":
+		m := new(v1beta11.GasInfo)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "initia.gov.v1.QuerySimulateProposalResponse.results":
+		list := []*v1beta11.Result{}
+		return protoreflect.ValueOfList(&_QuerySimulateProposalResponse_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.QuerySimulateProposalResponse"))
+		}
+		panic(fmt.Errorf("message initia.gov.v1.QuerySimulateProposalResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QuerySimulateProposalResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.gov.v1.QuerySimulateProposalResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QuerySimulateProposalResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateProposalResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QuerySimulateProposalResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QuerySimulateProposalResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QuerySimulateProposalResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.GasInfo != nil {
+			l = options.Size(x.GasInfo)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Results) > 0 {
+			for _, e := range x.Results {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySimulateProposalResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Results) > 0 {
+			for iNdEx := len(x.Results) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Results[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.GasInfo != nil {
+			encoded, err := options.Marshal(x.GasInfo)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySimulateProposalResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySimulateProposalResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySimulateProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasInfo", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.GasInfo == nil {
+					x.GasInfo = &v1beta11.GasInfo{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.GasInfo); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Results = append(x.Results, &v1beta11.Result{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Results[len(x.Results)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryVotingLocksRequest            protoreflect.MessageDescriptor
+	fd_QueryVotingLocksRequest_owner      protoreflect.FieldDescriptor
+	fd_QueryVotingLocksRequest_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_gov_v1_query_proto_init()
+	md_QueryVotingLocksRequest = File_initia_gov_v1_query_proto.Messages().ByName("QueryVotingLocksRequest")
+	fd_QueryVotingLocksRequest_owner = md_QueryVotingLocksRequest.Fields().ByName("owner")
+	fd_QueryVotingLocksRequest_pagination = md_QueryVotingLocksRequest.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QuerySimulateProposalRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryVotingLocksRequest)(nil)
 
-type fastReflection_QuerySimulateProposalRequest QuerySimulateProposalRequest
+type fastReflection_QueryVotingLocksRequest QueryVotingLocksRequest
 
-func (x *QuerySimulateProposalRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QuerySimulateProposalRequest)(x)
+func (x *QueryVotingLocksRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryVotingLocksRequest)(x)
 }
 
-func (x *QuerySimulateProposalRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_initia_gov_v1_query_proto_msgTypes[10]
+func (x *QueryVotingLocksRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_gov_v1_query_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4711,43 +7777,43 @@ func (x *QuerySimulateProposalRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QuerySimulateProposalRequest_messageType fastReflection_QuerySimulateProposalRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QuerySimulateProposalRequest_messageType{}
+var _fastReflection_QueryVotingLocksRequest_messageType fastReflection_QueryVotingLocksRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryVotingLocksRequest_messageType{}
 
-type fastReflection_QuerySimulateProposalRequest_messageType struct{}
+type fastReflection_QueryVotingLocksRequest_messageType struct{}
 
-func (x fastReflection_QuerySimulateProposalRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QuerySimulateProposalRequest)(nil)
+func (x fastReflection_QueryVotingLocksRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryVotingLocksRequest)(nil)
 }
-func (x fastReflection_QuerySimulateProposalRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QuerySimulateProposalRequest)
+func (x fastReflection_QueryVotingLocksRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryVotingLocksRequest)
 }
-func (x fastReflection_QuerySimulateProposalRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QuerySimulateProposalRequest
+func (x fastReflection_QueryVotingLocksRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVotingLocksRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QuerySimulateProposalRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QuerySimulateProposalRequest
+func (x *fastReflection_QueryVotingLocksRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVotingLocksRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QuerySimulateProposalRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QuerySimulateProposalRequest_messageType
+func (x *fastReflection_QueryVotingLocksRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryVotingLocksRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QuerySimulateProposalRequest) New() protoreflect.Message {
-	return new(fastReflection_QuerySimulateProposalRequest)
+func (x *fastReflection_QueryVotingLocksRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryVotingLocksRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QuerySimulateProposalRequest) Interface() protoreflect.ProtoMessage {
-	return (*QuerySimulateProposalRequest)(x)
+func (x *fastReflection_QueryVotingLocksRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryVotingLocksRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4755,10 +7821,16 @@ func (x *fastReflection_QuerySimulateProposalRequest) Interface() protoreflect.P
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QuerySimulateProposalRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.MsgSubmitProposal != nil {
-		value := protoreflect.ValueOfMessage(x.MsgSubmitProposal.ProtoReflect())
-		if !f(fd_QuerySimulateProposalRequest_msg_submit_proposal, value) {
+func (x *fastReflection_QueryVotingLocksRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Owner != "" {
+		value := protoreflect.ValueOfString(x.Owner)
+		if !f(fd_QueryVotingLocksRequest_owner, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryVotingLocksRequest_pagination, value) {
 			return
 		}
 	}
@@ -4775,15 +7847,17 @@ func (x *fastReflection_QuerySimulateProposalRequest) Range(f func(protoreflect.
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QuerySimulateProposalRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryVotingLocksRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "initia.gov.v1.QuerySimulateProposalRequest.msg_submit_proposal":
-		return x.MsgSubmitProposal != nil
+	case "initia.gov.v1.QueryVotingLocksRequest.owner":
+		return x.Owner != ""
+	case "initia.gov.v1.QueryVotingLocksRequest.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.QuerySimulateProposalRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.QueryVotingLocksRequest"))
 		}
-		panic(fmt.Errorf("message initia.gov.v1.QuerySimulateProposalRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.gov.v1.QueryVotingLocksRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4793,15 +7867,17 @@ func (x *fastReflection_QuerySimulateProposalRequest) Has(fd protoreflect.FieldD
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QuerySimulateProposalRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryVotingLocksRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "initia.gov.v1.QuerySimulateProposalRequest.msg_submit_proposal":
-		x.MsgSubmitProposal = nil
+	case "initia.gov.v1.QueryVotingLocksRequest.owner":
+		x.Owner = ""
+	case "initia.gov.v1.QueryVotingLocksRequest.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.QuerySimulateProposalRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.QueryVotingLocksRequest"))
 		}
-		panic(fmt.Errorf("message initia.gov.v1.QuerySimulateProposalRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.gov.v1.QueryVotingLocksRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4811,16 +7887,19 @@ func (x *fastReflection_QuerySimulateProposalRequest) Clear(fd protoreflect.Fiel
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QuerySimulateProposalRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryVotingLocksRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "initia.gov.v1.QuerySimulateProposalRequest.msg_submit_proposal":
-		value := x.MsgSubmitProposal
+	case "initia.gov.v1.QueryVotingLocksRequest.owner":
+		value := x.Owner
+		return protoreflect.ValueOfString(value)
+	case "initia.gov.v1.QueryVotingLocksRequest.pagination":
+		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.QuerySimulateProposalRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.QueryVotingLocksRequest"))
 		}
-		panic(fmt.Errorf("message initia.gov.v1.QuerySimulateProposalRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message initia.gov.v1.QueryVotingLocksRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4834,15 +7913,17 @@ func (x *fastReflection_QuerySimulateProposalRequest) Get(descriptor protoreflec
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QuerySimulateProposalRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryVotingLocksRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "initia.gov.v1.QuerySimulateProposalRequest.msg_submit_proposal":
-		x.MsgSubmitProposal = value.Message().Interface().(*v1.MsgSubmitProposal)
+	case "initia.gov.v1.QueryVotingLocksRequest.owner":
+		x.Owner = value.Interface().(string)
+	case "initia.gov.v1.QueryVotingLocksRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.QuerySimulateProposalRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.QueryVotingLocksRequest"))
 		}
-		panic(fmt.Errorf("message initia.gov.v1.QuerySimulateProposalRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.gov.v1.QueryVotingLocksRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4856,44 +7937,48 @@ func (x *fastReflection_QuerySimulateProposalRequest) Set(fd protoreflect.FieldD
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QuerySimulateProposalRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryVotingLocksRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "initia.gov.v1.QuerySimulateProposalRequest.msg_submit_proposal":
-		if x.MsgSubmitProposal == nil {
-			x.MsgSubmitProposal = new(v1.MsgSubmitProposal)
+	case "initia.gov.v1.QueryVotingLocksRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
 		}
-		return protoreflect.ValueOfMessage(x.MsgSubmitProposal.ProtoReflect())
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "initia.gov.v1.QueryVotingLocksRequest.owner":
+		panic(fmt.Errorf("field owner of message initia.gov.v1.QueryVotingLocksRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.QuerySimulateProposalRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.QueryVotingLocksRequest"))
 		}
-		panic(fmt.Errorf("message initia.gov.v1.QuerySimulateProposalRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.gov.v1.QueryVotingLocksRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QuerySimulateProposalRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryVotingLocksRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "initia.gov.v1.QuerySimulateProposalRequest.msg_submit_proposal":
-		m := new(v1.MsgSubmitProposal)
+	case "initia.gov.v1.QueryVotingLocksRequest.owner":
+		return protoreflect.ValueOfString("")
+	case "initia.gov.v1.QueryVotingLocksRequest.pagination":
+		m := new(v1beta1.PageRequest)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.QuerySimulateProposalRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.QueryVotingLocksRequest"))
 		}
-		panic(fmt.Errorf("message initia.gov.v1.QuerySimulateProposalRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.gov.v1.QueryVotingLocksRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QuerySimulateProposalRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryVotingLocksRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in initia.gov.v1.QuerySimulateProposalRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in initia.gov.v1.QueryVotingLocksRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4901,7 +7986,7 @@ func (x *fastReflection_QuerySimulateProposalRequest) WhichOneof(d protoreflect.
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QuerySimulateProposalRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryVotingLocksRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4912,7 +7997,7 @@ func (x *fastReflection_QuerySimulateProposalRequest) GetUnknown() protoreflect.
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QuerySimulateProposalRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryVotingLocksRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4924,7 +8009,7 @@ func (x *fastReflection_QuerySimulateProposalRequest) SetUnknown(fields protoref
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QuerySimulateProposalRequest) IsValid() bool {
+func (x *fastReflection_QueryVotingLocksRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -4934,9 +8019,9 @@ func (x *fastReflection_QuerySimulateProposalRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QuerySimulateProposalRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryVotingLocksRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QuerySimulateProposalRequest)
+		x := input.Message.Interface().(*QueryVotingLocksRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4948,8 +8033,12 @@ func (x *fastReflection_QuerySimulateProposalRequest) ProtoMethods() *protoiface
 		var n int
 		var l int
 		_ = l
-		if x.MsgSubmitProposal != nil {
-			l = options.Size(x.MsgSubmitProposal)
+		l = len(x.Owner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -4962,7 +8051,7 @@ func (x *fastReflection_QuerySimulateProposalRequest) ProtoMethods() *protoiface
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QuerySimulateProposalRequest)
+		x := input.Message.Interface().(*QueryVotingLocksRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4981,8 +8070,8 @@ func (x *fastReflection_QuerySimulateProposalRequest) ProtoMethods() *protoiface
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.MsgSubmitProposal != nil {
-			encoded, err := options.Marshal(x.MsgSubmitProposal)
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4993,6 +8082,13 @@ func (x *fastReflection_QuerySimulateProposalRequest) ProtoMethods() *protoiface
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Owner) > 0 {
+			i -= len(x.Owner)
+			copy(dAtA[i:], x.Owner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
+			i--
 			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
@@ -5006,7 +8102,7 @@ func (x *fastReflection_QuerySimulateProposalRequest) ProtoMethods() *protoiface
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QuerySimulateProposalRequest)
+		x := input.Message.Interface().(*QueryVotingLocksRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5038,15 +8134,47 @@ func (x *fastReflection_QuerySimulateProposalRequest) ProtoMethods() *protoiface
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySimulateProposalRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVotingLocksRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySimulateProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVotingLocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MsgSubmitProposal", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Owner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -5073,10 +8201,10 @@ func (x *fastReflection_QuerySimulateProposalRequest) ProtoMethods() *protoiface
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.MsgSubmitProposal == nil {
-					x.MsgSubmitProposal = &v1.MsgSubmitProposal{}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MsgSubmitProposal); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -5115,80 +8243,80 @@ func (x *fastReflection_QuerySimulateProposalRequest) ProtoMethods() *protoiface
 	}
 }
 
-var _ protoreflect.List = (*_QuerySimulateProposalResponse_2_list)(nil)
+var _ protoreflect.List = (*_QueryVotingLocksResponse_1_list)(nil)
 
-type _QuerySimulateProposalResponse_2_list struct {
-	list *[]*v1beta11.Result
+type _QueryVotingLocksResponse_1_list struct {
+	list *[]*VotingLock
 }
 
-func (x *_QuerySimulateProposalResponse_2_list) Len() int {
+func (x *_QueryVotingLocksResponse_1_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_QuerySimulateProposalResponse_2_list) Get(i int) protoreflect.Value {
+func (x *_QueryVotingLocksResponse_1_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_QuerySimulateProposalResponse_2_list) Set(i int, value protoreflect.Value) {
+func (x *_QueryVotingLocksResponse_1_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta11.Result)
+	concreteValue := valueUnwrapped.Interface().(*VotingLock)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_QuerySimulateProposalResponse_2_list) Append(value protoreflect.Value) {
+func (x *_QueryVotingLocksResponse_1_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta11.Result)
+	concreteValue := valueUnwrapped.Interface().(*VotingLock)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_QuerySimulateProposalResponse_2_list) AppendMutable() protoreflect.Value {
-	v := new(v1beta11.Result)
+func (x *_QueryVotingLocksResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(VotingLock)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QuerySimulateProposalResponse_2_list) Truncate(n int) {
+func (x *_QueryVotingLocksResponse_1_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_QuerySimulateProposalResponse_2_list) NewElement() protoreflect.Value {
-	v := new(v1beta11.Result)
+func (x *_QueryVotingLocksResponse_1_list) NewElement() protoreflect.Value {
+	v := new(VotingLock)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QuerySimulateProposalResponse_2_list) IsValid() bool {
+func (x *_QueryVotingLocksResponse_1_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_QuerySimulateProposalResponse          protoreflect.MessageDescriptor
-	fd_QuerySimulateProposalResponse_gas_info protoreflect.FieldDescriptor
-	fd_QuerySimulateProposalResponse_results  protoreflect.FieldDescriptor
+	md_QueryVotingLocksResponse              protoreflect.MessageDescriptor
+	fd_QueryVotingLocksResponse_voting_locks protoreflect.FieldDescriptor
+	fd_QueryVotingLocksResponse_pagination   protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_initia_gov_v1_query_proto_init()
-	md_QuerySimulateProposalResponse = File_initia_gov_v1_query_proto.Messages().ByName("QuerySimulateProposalResponse")
-	fd_QuerySimulateProposalResponse_gas_info = md_QuerySimulateProposalResponse.Fields().ByName("gas_info")
-	fd_QuerySimulateProposalResponse_results = md_QuerySimulateProposalResponse.Fields().ByName("results")
+	md_QueryVotingLocksResponse = File_initia_gov_v1_query_proto.Messages().ByName("QueryVotingLocksResponse")
+	fd_QueryVotingLocksResponse_voting_locks = md_QueryVotingLocksResponse.Fields().ByName("voting_locks")
+	fd_QueryVotingLocksResponse_pagination = md_QueryVotingLocksResponse.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QuerySimulateProposalResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryVotingLocksResponse)(nil)
 
-type fastReflection_QuerySimulateProposalResponse QuerySimulateProposalResponse
+type fastReflection_QueryVotingLocksResponse QueryVotingLocksResponse
 
-func (x *QuerySimulateProposalResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QuerySimulateProposalResponse)(x)
+func (x *QueryVotingLocksResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryVotingLocksResponse)(x)
 }
 
-func (x *QuerySimulateProposalResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_initia_gov_v1_query_proto_msgTypes[11]
+func (x *QueryVotingLocksResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_gov_v1_query_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5199,43 +8327,43 @@ func (x *QuerySimulateProposalResponse) slowProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QuerySimulateProposalResponse_messageType fastReflection_QuerySimulateProposalResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QuerySimulateProposalResponse_messageType{}
+var _fastReflection_QueryVotingLocksResponse_messageType fastReflection_QueryVotingLocksResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryVotingLocksResponse_messageType{}
 
-type fastReflection_QuerySimulateProposalResponse_messageType struct{}
+type fastReflection_QueryVotingLocksResponse_messageType struct{}
 
-func (x fastReflection_QuerySimulateProposalResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QuerySimulateProposalResponse)(nil)
+func (x fastReflection_QueryVotingLocksResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryVotingLocksResponse)(nil)
 }
-func (x fastReflection_QuerySimulateProposalResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QuerySimulateProposalResponse)
+func (x fastReflection_QueryVotingLocksResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryVotingLocksResponse)
 }
-func (x fastReflection_QuerySimulateProposalResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QuerySimulateProposalResponse
+func (x fastReflection_QueryVotingLocksResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVotingLocksResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QuerySimulateProposalResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QuerySimulateProposalResponse
+func (x *fastReflection_QueryVotingLocksResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVotingLocksResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QuerySimulateProposalResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QuerySimulateProposalResponse_messageType
+func (x *fastReflection_QueryVotingLocksResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryVotingLocksResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QuerySimulateProposalResponse) New() protoreflect.Message {
-	return new(fastReflection_QuerySimulateProposalResponse)
+func (x *fastReflection_QueryVotingLocksResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryVotingLocksResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QuerySimulateProposalResponse) Interface() protoreflect.ProtoMessage {
-	return (*QuerySimulateProposalResponse)(x)
+func (x *fastReflection_QueryVotingLocksResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryVotingLocksResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5243,16 +8371,16 @@ func (x *fastReflection_QuerySimulateProposalResponse) Interface() protoreflect.
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QuerySimulateProposalResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.GasInfo != nil {
-		value := protoreflect.ValueOfMessage(x.GasInfo.ProtoReflect())
-		if !f(fd_QuerySimulateProposalResponse_gas_info, value) {
+func (x *fastReflection_QueryVotingLocksResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.VotingLocks) != 0 {
+		value := protoreflect.ValueOfList(&_QueryVotingLocksResponse_1_list{list: &x.VotingLocks})
+		if !f(fd_QueryVotingLocksResponse_voting_locks, value) {
 			return
 		}
 	}
-	if len(x.Results) != 0 {
-		value := protoreflect.ValueOfList(&_QuerySimulateProposalResponse_2_list{list: &x.Results})
-		if !f(fd_QuerySimulateProposalResponse_results, value) {
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryVotingLocksResponse_pagination, value) {
 			return
 		}
 	}
@@ -5269,17 +8397,17 @@ func (x *fastReflection_QuerySimulateProposalResponse) Range(f func(protoreflect
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QuerySimulateProposalResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryVotingLocksResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "initia.gov.v1.QuerySimulateProposalResponse.gas_info":
-		return x.GasInfo != nil
-	case "initia.gov.v1.QuerySimulateProposalResponse.results":
-		return len(x.Results) != 0
+	case "initia.gov.v1.QueryVotingLocksResponse.voting_locks":
+		return len(x.VotingLocks) != 0
+	case "initia.gov.v1.QueryVotingLocksResponse.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.QuerySimulateProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.QueryVotingLocksResponse"))
 		}
-		panic(fmt.Errorf("message initia.gov.v1.QuerySimulateProposalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.gov.v1.QueryVotingLocksResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5289,17 +8417,17 @@ func (x *fastReflection_QuerySimulateProposalResponse) Has(fd protoreflect.Field
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QuerySimulateProposalResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryVotingLocksResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "initia.gov.v1.QuerySimulateProposalResponse.gas_info":
-		x.GasInfo = nil
-	case "initia.gov.v1.QuerySimulateProposalResponse.results":
-		x.Results = nil
+	case "initia.gov.v1.QueryVotingLocksResponse.voting_locks":
+		x.VotingLocks = nil
+	case "initia.gov.v1.QueryVotingLocksResponse.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.QuerySimulateProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.QueryVotingLocksResponse"))
 		}
-		panic(fmt.Errorf("message initia.gov.v1.QuerySimulateProposalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.gov.v1.QueryVotingLocksResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5309,22 +8437,22 @@ func (x *fastReflection_QuerySimulateProposalResponse) Clear(fd protoreflect.Fie
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QuerySimulateProposalResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryVotingLocksResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "initia.gov.v1.QuerySimulateProposalResponse.gas_info":
-		value := x.GasInfo
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "initia.gov.v1.QuerySimulateProposalResponse.results":
-		if len(x.Results) == 0 {
-			return protoreflect.ValueOfList(&_QuerySimulateProposalResponse_2_list{})
+	case "initia.gov.v1.QueryVotingLocksResponse.voting_locks":
+		if len(x.VotingLocks) == 0 {
+			return protoreflect.ValueOfList(&_QueryVotingLocksResponse_1_list{})
 		}
-		listValue := &_QuerySimulateProposalResponse_2_list{list: &x.Results}
+		listValue := &_QueryVotingLocksResponse_1_list{list: &x.VotingLocks}
 		return protoreflect.ValueOfList(listValue)
+	case "initia.gov.v1.QueryVotingLocksResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.QuerySimulateProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.QueryVotingLocksResponse"))
 		}
-		panic(fmt.Errorf("message initia.gov.v1.QuerySimulateProposalResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message initia.gov.v1.QueryVotingLocksResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5338,19 +8466,19 @@ func (x *fastReflection_QuerySimulateProposalResponse) Get(descriptor protorefle
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QuerySimulateProposalResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryVotingLocksResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "initia.gov.v1.QuerySimulateProposalResponse.gas_info":
-		x.GasInfo = value.Message().Interface().(*v1beta11.GasInfo)
-	case "initia.gov.v1.QuerySimulateProposalResponse.results":
+	case "initia.gov.v1.QueryVotingLocksResponse.voting_locks":
 		lv := value.List()
-		clv := lv.(*_QuerySimulateProposalResponse_2_list)
-		x.Results = *clv.list
+		clv := lv.(*_QueryVotingLocksResponse_1_list)
+		x.VotingLocks = *clv.list
+	case "initia.gov.v1.QueryVotingLocksResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.QuerySimulateProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.QueryVotingLocksResponse"))
 		}
-		panic(fmt.Errorf("message initia.gov.v1.QuerySimulateProposalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.gov.v1.QueryVotingLocksResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5364,53 +8492,53 @@ func (x *fastReflection_QuerySimulateProposalResponse) Set(fd protoreflect.Field
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QuerySimulateProposalResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryVotingLocksResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "initia.gov.v1.QuerySimulateProposalResponse.gas_info":
-		if x.GasInfo == nil {
-			x.GasInfo = new(v1beta11.GasInfo)
+	case "initia.gov.v1.QueryVotingLocksResponse.voting_locks":
+		if x.VotingLocks == nil {
+			x.VotingLocks = []*VotingLock{}
 		}
-		return protoreflect.ValueOfMessage(x.GasInfo.ProtoReflect())
-	case "initia.gov.v1.QuerySimulateProposalResponse.results":
-		if x.Results == nil {
-			x.Results = []*v1beta11.Result{}
-		}
-		value := &_QuerySimulateProposalResponse_2_list{list: &x.Results}
+		value := &_QueryVotingLocksResponse_1_list{list: &x.VotingLocks}
 		return protoreflect.ValueOfList(value)
+	case "initia.gov.v1.QueryVotingLocksResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.QuerySimulateProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.QueryVotingLocksResponse"))
 		}
-		panic(fmt.Errorf("message initia.gov.v1.QuerySimulateProposalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.gov.v1.QueryVotingLocksResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QuerySimulateProposalResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryVotingLocksResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "initia.gov.v1.QuerySimulateProposalResponse.gas_info":
-		m := new(v1beta11.GasInfo)
+	case "initia.gov.v1.QueryVotingLocksResponse.voting_locks":
+		list := []*VotingLock{}
+		return protoreflect.ValueOfList(&_QueryVotingLocksResponse_1_list{list: &list})
+	case "initia.gov.v1.QueryVotingLocksResponse.pagination":
+		m := new(v1beta1.PageResponse)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "initia.gov.v1.QuerySimulateProposalResponse.results":
-		list := []*v1beta11.Result{}
-		return protoreflect.ValueOfList(&_QuerySimulateProposalResponse_2_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.QuerySimulateProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.gov.v1.QueryVotingLocksResponse"))
 		}
-		panic(fmt.Errorf("message initia.gov.v1.QuerySimulateProposalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message initia.gov.v1.QueryVotingLocksResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QuerySimulateProposalResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryVotingLocksResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in initia.gov.v1.QuerySimulateProposalResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in initia.gov.v1.QueryVotingLocksResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5418,7 +8546,7 @@ func (x *fastReflection_QuerySimulateProposalResponse) WhichOneof(d protoreflect
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QuerySimulateProposalResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryVotingLocksResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5429,7 +8557,7 @@ func (x *fastReflection_QuerySimulateProposalResponse) GetUnknown() protoreflect
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QuerySimulateProposalResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryVotingLocksResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5441,7 +8569,7 @@ func (x *fastReflection_QuerySimulateProposalResponse) SetUnknown(fields protore
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QuerySimulateProposalResponse) IsValid() bool {
+func (x *fastReflection_QueryVotingLocksResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -5451,9 +8579,9 @@ func (x *fastReflection_QuerySimulateProposalResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QuerySimulateProposalResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryVotingLocksResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QuerySimulateProposalResponse)
+		x := input.Message.Interface().(*QueryVotingLocksResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5465,16 +8593,16 @@ func (x *fastReflection_QuerySimulateProposalResponse) ProtoMethods() *protoifac
 		var n int
 		var l int
 		_ = l
-		if x.GasInfo != nil {
-			l = options.Size(x.GasInfo)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if len(x.Results) > 0 {
-			for _, e := range x.Results {
+		if len(x.VotingLocks) > 0 {
+			for _, e := range x.VotingLocks {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -5485,7 +8613,7 @@ func (x *fastReflection_QuerySimulateProposalResponse) ProtoMethods() *protoifac
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QuerySimulateProposalResponse)
+		x := input.Message.Interface().(*QueryVotingLocksResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5504,9 +8632,23 @@ func (x *fastReflection_QuerySimulateProposalResponse) ProtoMethods() *protoifac
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Results) > 0 {
-			for iNdEx := len(x.Results) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Results[iNdEx])
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.VotingLocks) > 0 {
+			for iNdEx := len(x.VotingLocks) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.VotingLocks[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5517,22 +8659,8 @@ func (x *fastReflection_QuerySimulateProposalResponse) ProtoMethods() *protoifac
 				copy(dAtA[i:], encoded)
 				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0x12
-			}
-		}
-		if x.GasInfo != nil {
-			encoded, err := options.Marshal(x.GasInfo)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
+				dAtA[i] = 0xa
 			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -5545,7 +8673,7 @@ func (x *fastReflection_QuerySimulateProposalResponse) ProtoMethods() *protoifac
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QuerySimulateProposalResponse)
+		x := input.Message.Interface().(*QueryVotingLocksResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5577,15 +8705,15 @@ func (x *fastReflection_QuerySimulateProposalResponse) ProtoMethods() *protoifac
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySimulateProposalResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVotingLocksResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySimulateProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVotingLocksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasInfo", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VotingLocks", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -5610,18 +8738,16 @@ func (x *fastReflection_QuerySimulateProposalResponse) ProtoMethods() *protoifac
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.GasInfo == nil {
-					x.GasInfo = &v1beta11.GasInfo{}
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.GasInfo); err != nil {
+				x.VotingLocks = append(x.VotingLocks, &VotingLock{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VotingLocks[len(x.VotingLocks)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -5648,8 +8774,10 @@ func (x *fastReflection_QuerySimulateProposalResponse) ProtoMethods() *protoifac
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Results = append(x.Results, &v1beta11.Result{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Results[len(x.Results)-1]); err != nil {
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -6108,6 +9236,198 @@ func (x *QueryTallyResultResponse) GetTallyResult() *TallyResult {
 	return nil
 }
 
+// QueryEffectiveVoteDelegateRequest is the request type for the
+// Query/EffectiveVoteDelegate RPC method.
+type QueryEffectiveVoteDelegateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// delegator defines the address of the delegator.
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	// msg_type defines the message type URL of the proposal topic.
+	// If it is empty, only the unscoped delegations are followed.
+	MsgType string `protobuf:"bytes,2,opt,name=msg_type,json=msgType,proto3" json:"msg_type,omitempty"`
+}
+
+func (x *QueryEffectiveVoteDelegateRequest) Reset() {
+	*x = QueryEffectiveVoteDelegateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_gov_v1_query_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryEffectiveVoteDelegateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryEffectiveVoteDelegateRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryEffectiveVoteDelegateRequest.ProtoReflect.Descriptor instead.
+func (*QueryEffectiveVoteDelegateRequest) Descriptor() ([]byte, []int) {
+	return file_initia_gov_v1_query_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *QueryEffectiveVoteDelegateRequest) GetDelegator() string {
+	if x != nil {
+		return x.Delegator
+	}
+	return ""
+}
+
+func (x *QueryEffectiveVoteDelegateRequest) GetMsgType() string {
+	if x != nil {
+		return x.MsgType
+	}
+	return ""
+}
+
+// QueryEffectiveVoteDelegateResponse is the response type for the
+// Query/EffectiveVoteDelegate RPC method.
+type QueryEffectiveVoteDelegateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// delegate is the address at the end of the delegation chain.
+	// It is empty if the delegator has no vote delegation.
+	Delegate string `protobuf:"bytes,1,opt,name=delegate,proto3" json:"delegate,omitempty"`
+	// chain is the delegates in the delegation chain, in order.
+	Chain []string `protobuf:"bytes,2,rep,name=chain,proto3" json:"chain,omitempty"`
+}
+
+func (x *QueryEffectiveVoteDelegateResponse) Reset() {
+	*x = QueryEffectiveVoteDelegateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_gov_v1_query_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryEffectiveVoteDelegateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryEffectiveVoteDelegateResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryEffectiveVoteDelegateResponse.ProtoReflect.Descriptor instead.
+func (*QueryEffectiveVoteDelegateResponse) Descriptor() ([]byte, []int) {
+	return file_initia_gov_v1_query_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *QueryEffectiveVoteDelegateResponse) GetDelegate() string {
+	if x != nil {
+		return x.Delegate
+	}
+	return ""
+}
+
+func (x *QueryEffectiveVoteDelegateResponse) GetChain() []string {
+	if x != nil {
+		return x.Chain
+	}
+	return nil
+}
+
+// QueryDelegatedVotingPowerRequest is the request type for the
+// Query/DelegatedVotingPower RPC method.
+type QueryDelegatedVotingPowerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// delegate defines the address of the delegate.
+	Delegate string `protobuf:"bytes,1,opt,name=delegate,proto3" json:"delegate,omitempty"`
+	// msg_type defines the message type URL of the proposal topic.
+	// If it is empty, only the unscoped delegations are followed.
+	MsgType string `protobuf:"bytes,2,opt,name=msg_type,json=msgType,proto3" json:"msg_type,omitempty"`
+}
+
+func (x *QueryDelegatedVotingPowerRequest) Reset() {
+	*x = QueryDelegatedVotingPowerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_gov_v1_query_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryDelegatedVotingPowerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryDelegatedVotingPowerRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryDelegatedVotingPowerRequest.ProtoReflect.Descriptor instead.
+func (*QueryDelegatedVotingPowerRequest) Descriptor() ([]byte, []int) {
+	return file_initia_gov_v1_query_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *QueryDelegatedVotingPowerRequest) GetDelegate() string {
+	if x != nil {
+		return x.Delegate
+	}
+	return ""
+}
+
+func (x *QueryDelegatedVotingPowerRequest) GetMsgType() string {
+	if x != nil {
+		return x.MsgType
+	}
+	return ""
+}
+
+// QueryDelegatedVotingPowerResponse is the response type for the
+// Query/DelegatedVotingPower RPC method.
+type QueryDelegatedVotingPowerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// voting_power is the voting power of the delegators whose delegation
+	// chain reaches the delegate.
+	VotingPower string `protobuf:"bytes,1,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
+	// delegators is the addresses of the delegators.
+	Delegators []string `protobuf:"bytes,2,rep,name=delegators,proto3" json:"delegators,omitempty"`
+}
+
+func (x *QueryDelegatedVotingPowerResponse) Reset() {
+	*x = QueryDelegatedVotingPowerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_gov_v1_query_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryDelegatedVotingPowerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryDelegatedVotingPowerResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryDelegatedVotingPowerResponse.ProtoReflect.Descriptor instead.
+func (*QueryDelegatedVotingPowerResponse) Descriptor() ([]byte, []int) {
+	return file_initia_gov_v1_query_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *QueryDelegatedVotingPowerResponse) GetVotingPower() string {
+	if x != nil {
+		return x.VotingPower
+	}
+	return ""
+}
+
+func (x *QueryDelegatedVotingPowerResponse) GetDelegators() []string {
+	if x != nil {
+		return x.Delegators
+	}
+	return nil
+}
+
 // QuerySimulateProposalRequest is the request type for the Query/SimulateProposal RPC method.
 type QuerySimulateProposalRequest struct {
 	state         protoimpl.MessageState
@@ -6120,7 +9440,7 @@ type QuerySimulateProposalRequest struct {
 func (x *QuerySimulateProposalRequest) Reset() {
 	*x = QuerySimulateProposalRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_initia_gov_v1_query_proto_msgTypes[10]
+		mi := &file_initia_gov_v1_query_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6134,7 +9454,7 @@ func (*QuerySimulateProposalRequest) ProtoMessage() {}
 
 // Deprecated: Use QuerySimulateProposalRequest.ProtoReflect.Descriptor instead.
 func (*QuerySimulateProposalRequest) Descriptor() ([]byte, []int) {
-	return file_initia_gov_v1_query_proto_rawDescGZIP(), []int{10}
+	return file_initia_gov_v1_query_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *QuerySimulateProposalRequest) GetMsgSubmitProposal() *v1.MsgSubmitProposal {
@@ -6159,7 +9479,7 @@ type QuerySimulateProposalResponse struct {
 func (x *QuerySimulateProposalResponse) Reset() {
 	*x = QuerySimulateProposalResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_initia_gov_v1_query_proto_msgTypes[11]
+		mi := &file_initia_gov_v1_query_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6173,7 +9493,7 @@ func (*QuerySimulateProposalResponse) ProtoMessage() {}
 
 // Deprecated: Use QuerySimulateProposalResponse.ProtoReflect.Descriptor instead.
 func (*QuerySimulateProposalResponse) Descriptor() ([]byte, []int) {
-	return file_initia_gov_v1_query_proto_rawDescGZIP(), []int{11}
+	return file_initia_gov_v1_query_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *QuerySimulateProposalResponse) GetGasInfo() *v1beta11.GasInfo {
@@ -6190,6 +9510,97 @@ func (x *QuerySimulateProposalResponse) GetResults() []*v1beta11.Result {
 	return nil
 }
 
+// QueryVotingLocksRequest is the request type for the Query/VotingLocks RPC method.
+type QueryVotingLocksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// owner defines the address of the lock owner.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryVotingLocksRequest) Reset() {
+	*x = QueryVotingLocksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_gov_v1_query_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryVotingLocksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryVotingLocksRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryVotingLocksRequest.ProtoReflect.Descriptor instead.
+func (*QueryVotingLocksRequest) Descriptor() ([]byte, []int) {
+	return file_initia_gov_v1_query_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *QueryVotingLocksRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *QueryVotingLocksRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryVotingLocksResponse is the response type for the Query/VotingLocks RPC method.
+type QueryVotingLocksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VotingLocks []*VotingLock `protobuf:"bytes,1,rep,name=voting_locks,json=votingLocks,proto3" json:"voting_locks,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryVotingLocksResponse) Reset() {
+	*x = QueryVotingLocksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_gov_v1_query_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryVotingLocksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryVotingLocksResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryVotingLocksResponse.ProtoReflect.Descriptor instead.
+func (*QueryVotingLocksResponse) Descriptor() ([]byte, []int) {
+	return file_initia_gov_v1_query_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *QueryVotingLocksResponse) GetVotingLocks() []*VotingLock {
+	if x != nil {
+		return x.VotingLocks
+	}
+	return nil
+}
+
+func (x *QueryVotingLocksResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
 var File_initia_gov_v1_query_proto protoreflect.FileDescriptor
 
 var file_initia_gov_v1_query_proto_rawDesc = []byte{
@@ -6281,24 +9692,77 @@ var file_initia_gov_v1_query_proto_rawDesc = []byte{
 	0x69, 0x61, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x52,
 	0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
 	0x52, 0x0b, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x76, 0x0a,
-	0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x72,
-	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x56, 0x0a,
-	0x13, 0x6d, 0x73, 0x67, 0x5f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x70,
-	0x6f, 0x73, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75,
-	0x62, 0x6d, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x42, 0x04, 0xc8, 0xde,
-	0x1f, 0x00, 0x52, 0x11, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x50, 0x72, 0x6f,
-	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x22, 0xa5, 0x01, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53,
-	0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x08, 0x67, 0x61, 0x73, 0x5f, 0x69,
-	0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x21, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x56,
+	0x6f, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x36, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x73,
+	0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x73,
+	0x67, 0x54, 0x79, 0x70, 0x65, 0x22, 0x8a, 0x01, 0x0a, 0x22, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45,
+	0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x65,
+	0x67, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x08,
+	0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
+	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61,
+	0x74, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x22, 0x73, 0x0a, 0x20, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x6c, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x64, 0x56, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x08, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61,
+	0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x52, 0x08, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08,
+	0x6d, 0x73, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x22, 0x90, 0x01, 0x0a, 0x21, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x56, 0x6f, 0x74, 0x69, 0x6e, 0x67,
+	0x50, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a,
+	0x0c, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x44, 0x65, 0x63, 0x52, 0x0b, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x77, 0x65, 0x72,
+	0x12, 0x38, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0a,
+	0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x22, 0x76, 0x0a, 0x1c, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x56, 0x0a, 0x13, 0x6d, 0x73,
+	0x67, 0x5f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x11, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x22, 0xa5, 0x01, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x69, 0x6d, 0x75,
+	0x6c, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x08, 0x67, 0x61, 0x73, 0x5f, 0x69, 0x6e, 0x66, 0x6f,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x47, 0x61, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x07, 0x67, 0x61, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x40, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
 	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x04, 0xc8, 0xde,
-	0x1f, 0x00, 0x52, 0x07, 0x67, 0x61, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x40, 0x0a, 0x07, 0x72,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x04,
-	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x32, 0xd1, 0x06,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x91, 0x01, 0x0a, 0x17, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x56, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xac,
+	0x01, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x4c, 0x6f,
+	0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0c, 0x76,
+	0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76,
+	0x31, 0x2e, 0x56, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x4c, 0x6f, 0x63, 0x6b, 0x42, 0x09, 0xc8, 0xde,
+	0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x4c,
+	0x6f, 0x63, 0x6b, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0xe1, 0x0a,
 	0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x6e, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d,
 	0x73, 0x12, 0x21, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76,
 	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71,
@@ -6342,7 +9806,40 @@ var file_initia_gov_v1_query_proto_rawDesc = []byte{
 	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x12, 0x2c, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f,
 	0x67, 0x6f, 0x76, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73,
 	0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x74,
-	0x61, 0x6c, 0x6c, 0x79, 0x12, 0x9a, 0x01, 0x0a, 0x10, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74,
+	0x61, 0x6c, 0x6c, 0x79, 0x12, 0x8b, 0x01, 0x0a, 0x0b, 0x56, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x4c,
+	0x6f, 0x63, 0x6b, 0x73, 0x12, 0x26, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x67, 0x6f,
+	0x76, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x6f, 0x74, 0x69, 0x6e, 0x67,
+	0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x56, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12, 0x23, 0x2f,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x67, 0x6f, 0x76, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x6f,
+	0x74, 0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2f, 0x7b, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x7d, 0x12, 0xc4, 0x01, 0x0a, 0x15, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x56, 0x6f, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x30, 0x2e, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x44,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31,
+	0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x56, 0x6f, 0x74,
+	0x65, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x46, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x40, 0x12, 0x3e, 0x2f, 0x69, 0x6e, 0x69, 0x74,
+	0x69, 0x61, 0x2f, 0x67, 0x6f, 0x76, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x64,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x64, 0x65, 0x6c, 0x65,
+	0x67, 0x61, 0x74, 0x6f, 0x72, 0x7d, 0x2f, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0xb8, 0x01, 0x0a, 0x14, 0x44, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x56, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x77,
+	0x65, 0x72, 0x12, 0x2f, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x67, 0x6f, 0x76, 0x2e,
+	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65,
+	0x64, 0x56, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x67, 0x6f, 0x76,
+	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x64, 0x56, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x37, 0x12, 0x35, 0x2f,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x67, 0x6f, 0x76, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x6f,
+	0x74, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x73, 0x2f, 0x7b, 0x64, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x7d, 0x2f, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70,
+	0x6f, 0x77, 0x65, 0x72, 0x12, 0x9a, 0x01, 0x0a, 0x10, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74,
 	0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x2b, 0x2e, 0x69, 0x6e, 0x69, 0x74,
 	0x69, 0x61, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53,
 	0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52,
@@ -6378,61 +9875,77 @@ func file_initia_gov_v1_query_proto_rawDescGZIP() []byte {
 	return file_initia_gov_v1_query_proto_rawDescData
 }
 
-var file_initia_gov_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_initia_gov_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_initia_gov_v1_query_proto_goTypes = []interface{}{
-	(*QueryParamsRequest)(nil),              // 0: initia.gov.v1.QueryParamsRequest
-	(*QueryParamsResponse)(nil),             // 1: initia.gov.v1.QueryParamsResponse
-	(*QueryEmergencyProposalsRequest)(nil),  // 2: initia.gov.v1.QueryEmergencyProposalsRequest
-	(*QueryEmergencyProposalsResponse)(nil), // 3: initia.gov.v1.QueryEmergencyProposalsResponse
-	(*QueryProposalRequest)(nil),            // 4: initia.gov.v1.QueryProposalRequest
-	(*QueryProposalResponse)(nil),           // 5: initia.gov.v1.QueryProposalResponse
-	(*QueryProposalsRequest)(nil),           // 6: initia.gov.v1.QueryProposalsRequest
-	(*QueryProposalsResponse)(nil),          // 7: initia.gov.v1.QueryProposalsResponse
-	(*QueryTallyResultRequest)(nil),         // 8: initia.gov.v1.QueryTallyResultRequest
-	(*QueryTallyResultResponse)(nil),        // 9: initia.gov.v1.QueryTallyResultResponse
-	(*QuerySimulateProposalRequest)(nil),    // 10: initia.gov.v1.QuerySimulateProposalRequest
-	(*QuerySimulateProposalResponse)(nil),   // 11: initia.gov.v1.QuerySimulateProposalResponse
-	(*Params)(nil),                          // 12: initia.gov.v1.Params
-	(*v1beta1.PageRequest)(nil),             // 13: cosmos.base.query.v1beta1.PageRequest
-	(*Proposal)(nil),                        // 14: initia.gov.v1.Proposal
-	(*v1beta1.PageResponse)(nil),            // 15: cosmos.base.query.v1beta1.PageResponse
-	(v1.ProposalStatus)(0),                  // 16: cosmos.gov.v1.ProposalStatus
-	(*TallyResult)(nil),                     // 17: initia.gov.v1.TallyResult
-	(*v1.MsgSubmitProposal)(nil),            // 18: cosmos.gov.v1.MsgSubmitProposal
-	(*v1beta11.GasInfo)(nil),                // 19: cosmos.base.abci.v1beta1.GasInfo
-	(*v1beta11.Result)(nil),                 // 20: cosmos.base.abci.v1beta1.Result
+	(*QueryParamsRequest)(nil),                 // 0: initia.gov.v1.QueryParamsRequest
+	(*QueryParamsResponse)(nil),                // 1: initia.gov.v1.QueryParamsResponse
+	(*QueryEmergencyProposalsRequest)(nil),     // 2: initia.gov.v1.QueryEmergencyProposalsRequest
+	(*QueryEmergencyProposalsResponse)(nil),    // 3: initia.gov.v1.QueryEmergencyProposalsResponse
+	(*QueryProposalRequest)(nil),               // 4: initia.gov.v1.QueryProposalRequest
+	(*QueryProposalResponse)(nil),              // 5: initia.gov.v1.QueryProposalResponse
+	(*QueryProposalsRequest)(nil),              // 6: initia.gov.v1.QueryProposalsRequest
+	(*QueryProposalsResponse)(nil),             // 7: initia.gov.v1.QueryProposalsResponse
+	(*QueryTallyResultRequest)(nil),            // 8: initia.gov.v1.QueryTallyResultRequest
+	(*QueryTallyResultResponse)(nil),           // 9: initia.gov.v1.QueryTallyResultResponse
+	(*QueryEffectiveVoteDelegateRequest)(nil),  // 10: initia.gov.v1.QueryEffectiveVoteDelegateRequest
+	(*QueryEffectiveVoteDelegateResponse)(nil), // 11: initia.gov.v1.QueryEffectiveVoteDelegateResponse
+	(*QueryDelegatedVotingPowerRequest)(nil),   // 12: initia.gov.v1.QueryDelegatedVotingPowerRequest
+	(*QueryDelegatedVotingPowerResponse)(nil),  // 13: initia.gov.v1.QueryDelegatedVotingPowerResponse
+	(*QuerySimulateProposalRequest)(nil),       // 14: initia.gov.v1.QuerySimulateProposalRequest
+	(*QuerySimulateProposalResponse)(nil),      // 15: initia.gov.v1.QuerySimulateProposalResponse
+	(*QueryVotingLocksRequest)(nil),            // 16: initia.gov.v1.QueryVotingLocksRequest
+	(*QueryVotingLocksResponse)(nil),           // 17: initia.gov.v1.QueryVotingLocksResponse
+	(*Params)(nil),                             // 18: initia.gov.v1.Params
+	(*v1beta1.PageRequest)(nil),                // 19: cosmos.base.query.v1beta1.PageRequest
+	(*Proposal)(nil),                           // 20: initia.gov.v1.Proposal
+	(*v1beta1.PageResponse)(nil),               // 21: cosmos.base.query.v1beta1.PageResponse
+	(v1.ProposalStatus)(0),                     // 22: cosmos.gov.v1.ProposalStatus
+	(*TallyResult)(nil),                        // 23: initia.gov.v1.TallyResult
+	(*v1.MsgSubmitProposal)(nil),               // 24: cosmos.gov.v1.MsgSubmitProposal
+	(*v1beta11.GasInfo)(nil),                   // 25: cosmos.base.abci.v1beta1.GasInfo
+	(*v1beta11.Result)(nil),                    // 26: cosmos.base.abci.v1beta1.Result
+	(*VotingLock)(nil),                         // 27: initia.gov.v1.VotingLock
 }
 var file_initia_gov_v1_query_proto_depIdxs = []int32{
-	12, // 0: initia.gov.v1.QueryParamsResponse.params:type_name -> initia.gov.v1.Params
-	13, // 1: initia.gov.v1.QueryEmergencyProposalsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	14, // 2: initia.gov.v1.QueryEmergencyProposalsResponse.proposals:type_name -> initia.gov.v1.Proposal
-	15, // 3: initia.gov.v1.QueryEmergencyProposalsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	14, // 4: initia.gov.v1.QueryProposalResponse.proposal:type_name -> initia.gov.v1.Proposal
-	16, // 5: initia.gov.v1.QueryProposalsRequest.proposal_status:type_name -> cosmos.gov.v1.ProposalStatus
-	13, // 6: initia.gov.v1.QueryProposalsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	14, // 7: initia.gov.v1.QueryProposalsResponse.proposals:type_name -> initia.gov.v1.Proposal
-	15, // 8: initia.gov.v1.QueryProposalsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	17, // 9: initia.gov.v1.QueryTallyResultResponse.tally_result:type_name -> initia.gov.v1.TallyResult
-	18, // 10: initia.gov.v1.QuerySimulateProposalRequest.msg_submit_proposal:type_name -> cosmos.gov.v1.MsgSubmitProposal
-	19, // 11: initia.gov.v1.QuerySimulateProposalResponse.gas_info:type_name -> cosmos.base.abci.v1beta1.GasInfo
-	20, // 12: initia.gov.v1.QuerySimulateProposalResponse.results:type_name -> cosmos.base.abci.v1beta1.Result
-	0,  // 13: initia.gov.v1.Query.Params:input_type -> initia.gov.v1.QueryParamsRequest
-	2,  // 14: initia.gov.v1.Query.EmergencyProposals:input_type -> initia.gov.v1.QueryEmergencyProposalsRequest
-	4,  // 15: initia.gov.v1.Query.Proposal:input_type -> initia.gov.v1.QueryProposalRequest
-	6,  // 16: initia.gov.v1.Query.Proposals:input_type -> initia.gov.v1.QueryProposalsRequest
-	8,  // 17: initia.gov.v1.Query.TallyResult:input_type -> initia.gov.v1.QueryTallyResultRequest
-	10, // 18: initia.gov.v1.Query.SimulateProposal:input_type -> initia.gov.v1.QuerySimulateProposalRequest
-	1,  // 19: initia.gov.v1.Query.Params:output_type -> initia.gov.v1.QueryParamsResponse
-	3,  // 20: initia.gov.v1.Query.EmergencyProposals:output_type -> initia.gov.v1.QueryEmergencyProposalsResponse
-	5,  // 21: initia.gov.v1.Query.Proposal:output_type -> initia.gov.v1.QueryProposalResponse
-	7,  // 22: initia.gov.v1.Query.Proposals:output_type -> initia.gov.v1.QueryProposalsResponse
-	9,  // 23: initia.gov.v1.Query.TallyResult:output_type -> initia.gov.v1.QueryTallyResultResponse
-	11, // 24: initia.gov.v1.Query.SimulateProposal:output_type -> initia.gov.v1.QuerySimulateProposalResponse
-	19, // [19:25] is the sub-list for method output_type
-	13, // [13:19] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	18, // 0: initia.gov.v1.QueryParamsResponse.params:type_name -> initia.gov.v1.Params
+	19, // 1: initia.gov.v1.QueryEmergencyProposalsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	20, // 2: initia.gov.v1.QueryEmergencyProposalsResponse.proposals:type_name -> initia.gov.v1.Proposal
+	21, // 3: initia.gov.v1.QueryEmergencyProposalsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	20, // 4: initia.gov.v1.QueryProposalResponse.proposal:type_name -> initia.gov.v1.Proposal
+	22, // 5: initia.gov.v1.QueryProposalsRequest.proposal_status:type_name -> cosmos.gov.v1.ProposalStatus
+	19, // 6: initia.gov.v1.QueryProposalsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	20, // 7: initia.gov.v1.QueryProposalsResponse.proposals:type_name -> initia.gov.v1.Proposal
+	21, // 8: initia.gov.v1.QueryProposalsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	23, // 9: initia.gov.v1.QueryTallyResultResponse.tally_result:type_name -> initia.gov.v1.TallyResult
+	24, // 10: initia.gov.v1.QuerySimulateProposalRequest.msg_submit_proposal:type_name -> cosmos.gov.v1.MsgSubmitProposal
+	25, // 11: initia.gov.v1.QuerySimulateProposalResponse.gas_info:type_name -> cosmos.base.abci.v1beta1.GasInfo
+	26, // 12: initia.gov.v1.QuerySimulateProposalResponse.results:type_name -> cosmos.base.abci.v1beta1.Result
+	19, // 13: initia.gov.v1.QueryVotingLocksRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	27, // 14: initia.gov.v1.QueryVotingLocksResponse.voting_locks:type_name -> initia.gov.v1.VotingLock
+	21, // 15: initia.gov.v1.QueryVotingLocksResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	0,  // 16: initia.gov.v1.Query.Params:input_type -> initia.gov.v1.QueryParamsRequest
+	2,  // 17: initia.gov.v1.Query.EmergencyProposals:input_type -> initia.gov.v1.QueryEmergencyProposalsRequest
+	4,  // 18: initia.gov.v1.Query.Proposal:input_type -> initia.gov.v1.QueryProposalRequest
+	6,  // 19: initia.gov.v1.Query.Proposals:input_type -> initia.gov.v1.QueryProposalsRequest
+	8,  // 20: initia.gov.v1.Query.TallyResult:input_type -> initia.gov.v1.QueryTallyResultRequest
+	16, // 21: initia.gov.v1.Query.VotingLocks:input_type -> initia.gov.v1.QueryVotingLocksRequest
+	10, // 22: initia.gov.v1.Query.EffectiveVoteDelegate:input_type -> initia.gov.v1.QueryEffectiveVoteDelegateRequest
+	12, // 23: initia.gov.v1.Query.DelegatedVotingPower:input_type -> initia.gov.v1.QueryDelegatedVotingPowerRequest
+	14, // 24: initia.gov.v1.Query.SimulateProposal:input_type -> initia.gov.v1.QuerySimulateProposalRequest
+	1,  // 25: initia.gov.v1.Query.Params:output_type -> initia.gov.v1.QueryParamsResponse
+	3,  // 26: initia.gov.v1.Query.EmergencyProposals:output_type -> initia.gov.v1.QueryEmergencyProposalsResponse
+	5,  // 27: initia.gov.v1.Query.Proposal:output_type -> initia.gov.v1.QueryProposalResponse
+	7,  // 28: initia.gov.v1.Query.Proposals:output_type -> initia.gov.v1.QueryProposalsResponse
+	9,  // 29: initia.gov.v1.Query.TallyResult:output_type -> initia.gov.v1.QueryTallyResultResponse
+	17, // 30: initia.gov.v1.Query.VotingLocks:output_type -> initia.gov.v1.QueryVotingLocksResponse
+	11, // 31: initia.gov.v1.Query.EffectiveVoteDelegate:output_type -> initia.gov.v1.QueryEffectiveVoteDelegateResponse
+	13, // 32: initia.gov.v1.Query.DelegatedVotingPower:output_type -> initia.gov.v1.QueryDelegatedVotingPowerResponse
+	15, // 33: initia.gov.v1.Query.SimulateProposal:output_type -> initia.gov.v1.QuerySimulateProposalResponse
+	25, // [25:34] is the sub-list for method output_type
+	16, // [16:25] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_initia_gov_v1_query_proto_init() }
@@ -6563,7 +10076,7 @@ func file_initia_gov_v1_query_proto_init() {
 			}
 		}
 		file_initia_gov_v1_query_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QuerySimulateProposalRequest); i {
+			switch v := v.(*QueryEffectiveVoteDelegateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6575,6 +10088,54 @@ func file_initia_gov_v1_query_proto_init() {
 			}
 		}
 		file_initia_gov_v1_query_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryEffectiveVoteDelegateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_gov_v1_query_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryDelegatedVotingPowerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_gov_v1_query_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryDelegatedVotingPowerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_gov_v1_query_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuerySimulateProposalRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_gov_v1_query_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QuerySimulateProposalResponse); i {
 			case 0:
 				return &v.state
@@ -6586,6 +10147,30 @@ func file_initia_gov_v1_query_proto_init() {
 				return nil
 			}
 		}
+		file_initia_gov_v1_query_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryVotingLocksRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_gov_v1_query_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryVotingLocksResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -6593,7 +10178,7 @@ func file_initia_gov_v1_query_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_initia_gov_v1_query_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   12,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
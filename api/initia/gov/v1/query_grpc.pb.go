@@ -19,12 +19,15 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_Params_FullMethodName             = "/initia.gov.v1.Query/Params"
-	Query_EmergencyProposals_FullMethodName = "/initia.gov.v1.Query/EmergencyProposals"
-	Query_Proposal_FullMethodName           = "/initia.gov.v1.Query/Proposal"
-	Query_Proposals_FullMethodName          = "/initia.gov.v1.Query/Proposals"
-	Query_TallyResult_FullMethodName        = "/initia.gov.v1.Query/TallyResult"
-	Query_SimulateProposal_FullMethodName   = "/initia.gov.v1.Query/SimulateProposal"
+	Query_Params_FullMethodName                = "/initia.gov.v1.Query/Params"
+	Query_EmergencyProposals_FullMethodName    = "/initia.gov.v1.Query/EmergencyProposals"
+	Query_Proposal_FullMethodName              = "/initia.gov.v1.Query/Proposal"
+	Query_Proposals_FullMethodName             = "/initia.gov.v1.Query/Proposals"
+	Query_TallyResult_FullMethodName           = "/initia.gov.v1.Query/TallyResult"
+	Query_VotingLocks_FullMethodName           = "/initia.gov.v1.Query/VotingLocks"
+	Query_EffectiveVoteDelegate_FullMethodName = "/initia.gov.v1.Query/EffectiveVoteDelegate"
+	Query_DelegatedVotingPower_FullMethodName  = "/initia.gov.v1.Query/DelegatedVotingPower"
+	Query_SimulateProposal_FullMethodName      = "/initia.gov.v1.Query/SimulateProposal"
 )
 
 // QueryClient is the client API for Query service.
@@ -43,6 +46,13 @@ type QueryClient interface {
 	Proposals(ctx context.Context, in *QueryProposalsRequest, opts ...grpc.CallOption) (*QueryProposalsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
+	// VotingLocks queries the voting locks of an owner.
+	VotingLocks(ctx context.Context, in *QueryVotingLocksRequest, opts ...grpc.CallOption) (*QueryVotingLocksResponse, error)
+	// EffectiveVoteDelegate queries the delegate at the end of the vote delegation
+	// chain of a delegator.
+	EffectiveVoteDelegate(ctx context.Context, in *QueryEffectiveVoteDelegateRequest, opts ...grpc.CallOption) (*QueryEffectiveVoteDelegateResponse, error)
+	// DelegatedVotingPower queries the voting power delegated to a delegate.
+	DelegatedVotingPower(ctx context.Context, in *QueryDelegatedVotingPowerRequest, opts ...grpc.CallOption) (*QueryDelegatedVotingPowerResponse, error)
 	// SimulateProposal queries the simulation of a proposal.
 	SimulateProposal(ctx context.Context, in *QuerySimulateProposalRequest, opts ...grpc.CallOption) (*QuerySimulateProposalResponse, error)
 }
@@ -105,6 +115,36 @@ func (c *queryClient) TallyResult(ctx context.Context, in *QueryTallyResultReque
 	return out, nil
 }
 
+func (c *queryClient) VotingLocks(ctx context.Context, in *QueryVotingLocksRequest, opts ...grpc.CallOption) (*QueryVotingLocksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryVotingLocksResponse)
+	err := c.cc.Invoke(ctx, Query_VotingLocks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EffectiveVoteDelegate(ctx context.Context, in *QueryEffectiveVoteDelegateRequest, opts ...grpc.CallOption) (*QueryEffectiveVoteDelegateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryEffectiveVoteDelegateResponse)
+	err := c.cc.Invoke(ctx, Query_EffectiveVoteDelegate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegatedVotingPower(ctx context.Context, in *QueryDelegatedVotingPowerRequest, opts ...grpc.CallOption) (*QueryDelegatedVotingPowerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryDelegatedVotingPowerResponse)
+	err := c.cc.Invoke(ctx, Query_DelegatedVotingPower_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) SimulateProposal(ctx context.Context, in *QuerySimulateProposalRequest, opts ...grpc.CallOption) (*QuerySimulateProposalResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QuerySimulateProposalResponse)
@@ -131,6 +171,13 @@ type QueryServer interface {
 	Proposals(context.Context, *QueryProposalsRequest) (*QueryProposalsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
+	// VotingLocks queries the voting locks of an owner.
+	VotingLocks(context.Context, *QueryVotingLocksRequest) (*QueryVotingLocksResponse, error)
+	// EffectiveVoteDelegate queries the delegate at the end of the vote delegation
+	// chain of a delegator.
+	EffectiveVoteDelegate(context.Context, *QueryEffectiveVoteDelegateRequest) (*QueryEffectiveVoteDelegateResponse, error)
+	// DelegatedVotingPower queries the voting power delegated to a delegate.
+	DelegatedVotingPower(context.Context, *QueryDelegatedVotingPowerRequest) (*QueryDelegatedVotingPowerResponse, error)
 	// SimulateProposal queries the simulation of a proposal.
 	SimulateProposal(context.Context, *QuerySimulateProposalRequest) (*QuerySimulateProposalResponse, error)
 	mustEmbedUnimplementedQueryServer()
@@ -158,6 +205,15 @@ func (UnimplementedQueryServer) Proposals(context.Context, *QueryProposalsReques
 func (UnimplementedQueryServer) TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method TallyResult not implemented")
 }
+func (UnimplementedQueryServer) VotingLocks(context.Context, *QueryVotingLocksRequest) (*QueryVotingLocksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method VotingLocks not implemented")
+}
+func (UnimplementedQueryServer) EffectiveVoteDelegate(context.Context, *QueryEffectiveVoteDelegateRequest) (*QueryEffectiveVoteDelegateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method EffectiveVoteDelegate not implemented")
+}
+func (UnimplementedQueryServer) DelegatedVotingPower(context.Context, *QueryDelegatedVotingPowerRequest) (*QueryDelegatedVotingPowerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DelegatedVotingPower not implemented")
+}
 func (UnimplementedQueryServer) SimulateProposal(context.Context, *QuerySimulateProposalRequest) (*QuerySimulateProposalResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method SimulateProposal not implemented")
 }
@@ -272,6 +328,60 @@ func _Query_TallyResult_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_VotingLocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVotingLocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VotingLocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_VotingLocks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VotingLocks(ctx, req.(*QueryVotingLocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EffectiveVoteDelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEffectiveVoteDelegateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EffectiveVoteDelegate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EffectiveVoteDelegate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EffectiveVoteDelegate(ctx, req.(*QueryEffectiveVoteDelegateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegatedVotingPower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatedVotingPowerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatedVotingPower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DelegatedVotingPower_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatedVotingPower(ctx, req.(*QueryDelegatedVotingPowerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_SimulateProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QuerySimulateProposalRequest)
 	if err := dec(in); err != nil {
@@ -317,6 +427,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TallyResult",
 			Handler:    _Query_TallyResult_Handler,
 		},
+		{
+			MethodName: "VotingLocks",
+			Handler:    _Query_VotingLocks_Handler,
+		},
+		{
+			MethodName: "EffectiveVoteDelegate",
+			Handler:    _Query_EffectiveVoteDelegate_Handler,
+		},
+		{
+			MethodName: "DelegatedVotingPower",
+			Handler:    _Query_DelegatedVotingPower_Handler,
+		},
 		{
 			MethodName: "SimulateProposal",
 			Handler:    _Query_SimulateProposal_Handler,
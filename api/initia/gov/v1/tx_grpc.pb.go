@@ -23,6 +23,10 @@ const (
 	Msg_AddEmergencySubmitters_FullMethodName    = "/initia.gov.v1.Msg/AddEmergencySubmitters"
 	Msg_RemoveEmergencySubmitters_FullMethodName = "/initia.gov.v1.Msg/RemoveEmergencySubmitters"
 	Msg_ActivateEmergencyProposal_FullMethodName = "/initia.gov.v1.Msg/ActivateEmergencyProposal"
+	Msg_LockTokens_FullMethodName                = "/initia.gov.v1.Msg/LockTokens"
+	Msg_UnlockTokens_FullMethodName              = "/initia.gov.v1.Msg/UnlockTokens"
+	Msg_DelegateVote_FullMethodName              = "/initia.gov.v1.Msg/DelegateVote"
+	Msg_UndelegateVote_FullMethodName            = "/initia.gov.v1.Msg/UndelegateVote"
 )
 
 // MsgClient is the client API for Msg service.
@@ -40,6 +44,14 @@ type MsgClient interface {
 	RemoveEmergencySubmitters(ctx context.Context, in *MsgRemoveEmergencySubmitters, opts ...grpc.CallOption) (*MsgRemoveEmergencySubmittersResponse, error)
 	// ActivateEmergencyProposal defines a governance operation for activating an emergency proposal.
 	ActivateEmergencyProposal(ctx context.Context, in *MsgActivateEmergencyProposal, opts ...grpc.CallOption) (*MsgActivateEmergencyProposalResponse, error)
+	// LockTokens defines a method for locking tokens to get the conviction voting power.
+	LockTokens(ctx context.Context, in *MsgLockTokens, opts ...grpc.CallOption) (*MsgLockTokensResponse, error)
+	// UnlockTokens defines a method for unlocking the expired voting locks.
+	UnlockTokens(ctx context.Context, in *MsgUnlockTokens, opts ...grpc.CallOption) (*MsgUnlockTokensResponse, error)
+	// DelegateVote defines a method for delegating the governance vote to another account.
+	DelegateVote(ctx context.Context, in *MsgDelegateVote, opts ...grpc.CallOption) (*MsgDelegateVoteResponse, error)
+	// UndelegateVote defines a method for removing a vote delegation.
+	UndelegateVote(ctx context.Context, in *MsgUndelegateVote, opts ...grpc.CallOption) (*MsgUndelegateVoteResponse, error)
 }
 
 type msgClient struct {
@@ -90,6 +102,46 @@ func (c *msgClient) ActivateEmergencyProposal(ctx context.Context, in *MsgActiva
 	return out, nil
 }
 
+func (c *msgClient) LockTokens(ctx context.Context, in *MsgLockTokens, opts ...grpc.CallOption) (*MsgLockTokensResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgLockTokensResponse)
+	err := c.cc.Invoke(ctx, Msg_LockTokens_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnlockTokens(ctx context.Context, in *MsgUnlockTokens, opts ...grpc.CallOption) (*MsgUnlockTokensResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUnlockTokensResponse)
+	err := c.cc.Invoke(ctx, Msg_UnlockTokens_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DelegateVote(ctx context.Context, in *MsgDelegateVote, opts ...grpc.CallOption) (*MsgDelegateVoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgDelegateVoteResponse)
+	err := c.cc.Invoke(ctx, Msg_DelegateVote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UndelegateVote(ctx context.Context, in *MsgUndelegateVote, opts ...grpc.CallOption) (*MsgUndelegateVoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUndelegateVoteResponse)
+	err := c.cc.Invoke(ctx, Msg_UndelegateVote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -105,6 +157,14 @@ type MsgServer interface {
 	RemoveEmergencySubmitters(context.Context, *MsgRemoveEmergencySubmitters) (*MsgRemoveEmergencySubmittersResponse, error)
 	// ActivateEmergencyProposal defines a governance operation for activating an emergency proposal.
 	ActivateEmergencyProposal(context.Context, *MsgActivateEmergencyProposal) (*MsgActivateEmergencyProposalResponse, error)
+	// LockTokens defines a method for locking tokens to get the conviction voting power.
+	LockTokens(context.Context, *MsgLockTokens) (*MsgLockTokensResponse, error)
+	// UnlockTokens defines a method for unlocking the expired voting locks.
+	UnlockTokens(context.Context, *MsgUnlockTokens) (*MsgUnlockTokensResponse, error)
+	// DelegateVote defines a method for delegating the governance vote to another account.
+	DelegateVote(context.Context, *MsgDelegateVote) (*MsgDelegateVoteResponse, error)
+	// UndelegateVote defines a method for removing a vote delegation.
+	UndelegateVote(context.Context, *MsgUndelegateVote) (*MsgUndelegateVoteResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -127,6 +187,18 @@ func (UnimplementedMsgServer) RemoveEmergencySubmitters(context.Context, *MsgRem
 func (UnimplementedMsgServer) ActivateEmergencyProposal(context.Context, *MsgActivateEmergencyProposal) (*MsgActivateEmergencyProposalResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ActivateEmergencyProposal not implemented")
 }
+func (UnimplementedMsgServer) LockTokens(context.Context, *MsgLockTokens) (*MsgLockTokensResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method LockTokens not implemented")
+}
+func (UnimplementedMsgServer) UnlockTokens(context.Context, *MsgUnlockTokens) (*MsgUnlockTokensResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnlockTokens not implemented")
+}
+func (UnimplementedMsgServer) DelegateVote(context.Context, *MsgDelegateVote) (*MsgDelegateVoteResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DelegateVote not implemented")
+}
+func (UnimplementedMsgServer) UndelegateVote(context.Context, *MsgUndelegateVote) (*MsgUndelegateVoteResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UndelegateVote not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -220,6 +292,78 @@ func _Msg_ActivateEmergencyProposal_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_LockTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLockTokens)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).LockTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_LockTokens_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LockTokens(ctx, req.(*MsgLockTokens))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnlockTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnlockTokens)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnlockTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UnlockTokens_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnlockTokens(ctx, req.(*MsgUnlockTokens))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DelegateVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateVote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DelegateVote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateVote(ctx, req.(*MsgDelegateVote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UndelegateVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUndelegateVote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UndelegateVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UndelegateVote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UndelegateVote(ctx, req.(*MsgUndelegateVote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -243,6 +387,22 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ActivateEmergencyProposal",
 			Handler:    _Msg_ActivateEmergencyProposal_Handler,
 		},
+		{
+			MethodName: "LockTokens",
+			Handler:    _Msg_LockTokens_Handler,
+		},
+		{
+			MethodName: "UnlockTokens",
+			Handler:    _Msg_UnlockTokens_Handler,
+		},
+		{
+			MethodName: "DelegateVote",
+			Handler:    _Msg_DelegateVote_Handler,
+		},
+		{
+			MethodName: "UndelegateVote",
+			Handler:    _Msg_UndelegateVote_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "initia/gov/v1/tx.proto",
@@ -4,6 +4,7 @@ package movev1
 import (
 	_ "cosmossdk.io/api/amino"
 	v1beta1 "cosmossdk.io/api/cosmos/base/query/v1beta1"
+	v1beta11 "cosmossdk.io/api/cosmos/base/v1beta1"
 	fmt "fmt"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -18690,6 +18691,1664 @@ func (x *fastReflection_QueryDexPairsResponse) ProtoMethods() *protoiface.Method
 	}
 }
 
+var (
+	md_QueryBalanceBreakdownRequest                         protoreflect.MessageDescriptor
+	fd_QueryBalanceBreakdownRequest_address                 protoreflect.FieldDescriptor
+	fd_QueryBalanceBreakdownRequest_vesting_module_address  protoreflect.FieldDescriptor
+	fd_QueryBalanceBreakdownRequest_vesting_module_name     protoreflect.FieldDescriptor
+	fd_QueryBalanceBreakdownRequest_vesting_creator_address protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_move_v1_query_proto_init()
+	md_QueryBalanceBreakdownRequest = File_initia_move_v1_query_proto.Messages().ByName("QueryBalanceBreakdownRequest")
+	fd_QueryBalanceBreakdownRequest_address = md_QueryBalanceBreakdownRequest.Fields().ByName("address")
+	fd_QueryBalanceBreakdownRequest_vesting_module_address = md_QueryBalanceBreakdownRequest.Fields().ByName("vesting_module_address")
+	fd_QueryBalanceBreakdownRequest_vesting_module_name = md_QueryBalanceBreakdownRequest.Fields().ByName("vesting_module_name")
+	fd_QueryBalanceBreakdownRequest_vesting_creator_address = md_QueryBalanceBreakdownRequest.Fields().ByName("vesting_creator_address")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryBalanceBreakdownRequest)(nil)
+
+type fastReflection_QueryBalanceBreakdownRequest QueryBalanceBreakdownRequest
+
+func (x *QueryBalanceBreakdownRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryBalanceBreakdownRequest)(x)
+}
+
+func (x *QueryBalanceBreakdownRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_move_v1_query_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryBalanceBreakdownRequest_messageType fastReflection_QueryBalanceBreakdownRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryBalanceBreakdownRequest_messageType{}
+
+type fastReflection_QueryBalanceBreakdownRequest_messageType struct{}
+
+func (x fastReflection_QueryBalanceBreakdownRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryBalanceBreakdownRequest)(nil)
+}
+func (x fastReflection_QueryBalanceBreakdownRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryBalanceBreakdownRequest)
+}
+func (x fastReflection_QueryBalanceBreakdownRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryBalanceBreakdownRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryBalanceBreakdownRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryBalanceBreakdownRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryBalanceBreakdownRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryBalanceBreakdownRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryBalanceBreakdownRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryBalanceBreakdownRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryBalanceBreakdownRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryBalanceBreakdownRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryBalanceBreakdownRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_QueryBalanceBreakdownRequest_address, value) {
+			return
+		}
+	}
+	if x.VestingModuleAddress != "" {
+		value := protoreflect.ValueOfString(x.VestingModuleAddress)
+		if !f(fd_QueryBalanceBreakdownRequest_vesting_module_address, value) {
+			return
+		}
+	}
+	if x.VestingModuleName != "" {
+		value := protoreflect.ValueOfString(x.VestingModuleName)
+		if !f(fd_QueryBalanceBreakdownRequest_vesting_module_name, value) {
+			return
+		}
+	}
+	if x.VestingCreatorAddress != "" {
+		value := protoreflect.ValueOfString(x.VestingCreatorAddress)
+		if !f(fd_QueryBalanceBreakdownRequest_vesting_creator_address, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryBalanceBreakdownRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.move.v1.QueryBalanceBreakdownRequest.address":
+		return x.Address != ""
+	case "initia.move.v1.QueryBalanceBreakdownRequest.vesting_module_address":
+		return x.VestingModuleAddress != ""
+	case "initia.move.v1.QueryBalanceBreakdownRequest.vesting_module_name":
+		return x.VestingModuleName != ""
+	case "initia.move.v1.QueryBalanceBreakdownRequest.vesting_creator_address":
+		return x.VestingCreatorAddress != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.QueryBalanceBreakdownRequest"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.QueryBalanceBreakdownRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBalanceBreakdownRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.move.v1.QueryBalanceBreakdownRequest.address":
+		x.Address = ""
+	case "initia.move.v1.QueryBalanceBreakdownRequest.vesting_module_address":
+		x.VestingModuleAddress = ""
+	case "initia.move.v1.QueryBalanceBreakdownRequest.vesting_module_name":
+		x.VestingModuleName = ""
+	case "initia.move.v1.QueryBalanceBreakdownRequest.vesting_creator_address":
+		x.VestingCreatorAddress = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.QueryBalanceBreakdownRequest"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.QueryBalanceBreakdownRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryBalanceBreakdownRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.move.v1.QueryBalanceBreakdownRequest.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "initia.move.v1.QueryBalanceBreakdownRequest.vesting_module_address":
+		value := x.VestingModuleAddress
+		return protoreflect.ValueOfString(value)
+	case "initia.move.v1.QueryBalanceBreakdownRequest.vesting_module_name":
+		value := x.VestingModuleName
+		return protoreflect.ValueOfString(value)
+	case "initia.move.v1.QueryBalanceBreakdownRequest.vesting_creator_address":
+		value := x.VestingCreatorAddress
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.QueryBalanceBreakdownRequest"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.QueryBalanceBreakdownRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBalanceBreakdownRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.move.v1.QueryBalanceBreakdownRequest.address":
+		x.Address = value.Interface().(string)
+	case "initia.move.v1.QueryBalanceBreakdownRequest.vesting_module_address":
+		x.VestingModuleAddress = value.Interface().(string)
+	case "initia.move.v1.QueryBalanceBreakdownRequest.vesting_module_name":
+		x.VestingModuleName = value.Interface().(string)
+	case "initia.move.v1.QueryBalanceBreakdownRequest.vesting_creator_address":
+		x.VestingCreatorAddress = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.QueryBalanceBreakdownRequest"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.QueryBalanceBreakdownRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBalanceBreakdownRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.move.v1.QueryBalanceBreakdownRequest.address":
+		panic(fmt.Errorf("field address of message initia.move.v1.QueryBalanceBreakdownRequest is not mutable"))
+	case "initia.move.v1.QueryBalanceBreakdownRequest.vesting_module_address":
+		panic(fmt.Errorf("field vesting_module_address of message initia.move.v1.QueryBalanceBreakdownRequest is not mutable"))
+	case "initia.move.v1.QueryBalanceBreakdownRequest.vesting_module_name":
+		panic(fmt.Errorf("field vesting_module_name of message initia.move.v1.QueryBalanceBreakdownRequest is not mutable"))
+	case "initia.move.v1.QueryBalanceBreakdownRequest.vesting_creator_address":
+		panic(fmt.Errorf("field vesting_creator_address of message initia.move.v1.QueryBalanceBreakdownRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.QueryBalanceBreakdownRequest"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.QueryBalanceBreakdownRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryBalanceBreakdownRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.move.v1.QueryBalanceBreakdownRequest.address":
+		return protoreflect.ValueOfString("")
+	case "initia.move.v1.QueryBalanceBreakdownRequest.vesting_module_address":
+		return protoreflect.ValueOfString("")
+	case "initia.move.v1.QueryBalanceBreakdownRequest.vesting_module_name":
+		return protoreflect.ValueOfString("")
+	case "initia.move.v1.QueryBalanceBreakdownRequest.vesting_creator_address":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.QueryBalanceBreakdownRequest"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.QueryBalanceBreakdownRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryBalanceBreakdownRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.move.v1.QueryBalanceBreakdownRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryBalanceBreakdownRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBalanceBreakdownRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryBalanceBreakdownRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryBalanceBreakdownRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryBalanceBreakdownRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.VestingModuleAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.VestingModuleName)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.VestingCreatorAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryBalanceBreakdownRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.VestingCreatorAddress) > 0 {
+			i -= len(x.VestingCreatorAddress)
+			copy(dAtA[i:], x.VestingCreatorAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VestingCreatorAddress)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.VestingModuleName) > 0 {
+			i -= len(x.VestingModuleName)
+			copy(dAtA[i:], x.VestingModuleName)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VestingModuleName)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.VestingModuleAddress) > 0 {
+			i -= len(x.VestingModuleAddress)
+			copy(dAtA[i:], x.VestingModuleAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VestingModuleAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryBalanceBreakdownRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBalanceBreakdownRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBalanceBreakdownRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VestingModuleAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VestingModuleAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VestingModuleName", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VestingModuleName = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VestingCreatorAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VestingCreatorAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryBalanceBreakdownResponse_1_list)(nil)
+
+type _QueryBalanceBreakdownResponse_1_list struct {
+	list *[]*v1beta11.Coin
+}
+
+func (x *_QueryBalanceBreakdownResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryBalanceBreakdownResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryBalanceBreakdownResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryBalanceBreakdownResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryBalanceBreakdownResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta11.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryBalanceBreakdownResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryBalanceBreakdownResponse_1_list) NewElement() protoreflect.Value {
+	v := new(v1beta11.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryBalanceBreakdownResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_QueryBalanceBreakdownResponse_2_list)(nil)
+
+type _QueryBalanceBreakdownResponse_2_list struct {
+	list *[]*v1beta11.Coin
+}
+
+func (x *_QueryBalanceBreakdownResponse_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryBalanceBreakdownResponse_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryBalanceBreakdownResponse_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryBalanceBreakdownResponse_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryBalanceBreakdownResponse_2_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta11.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryBalanceBreakdownResponse_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryBalanceBreakdownResponse_2_list) NewElement() protoreflect.Value {
+	v := new(v1beta11.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryBalanceBreakdownResponse_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_QueryBalanceBreakdownResponse_3_list)(nil)
+
+type _QueryBalanceBreakdownResponse_3_list struct {
+	list *[]*v1beta11.Coin
+}
+
+func (x *_QueryBalanceBreakdownResponse_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryBalanceBreakdownResponse_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryBalanceBreakdownResponse_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryBalanceBreakdownResponse_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryBalanceBreakdownResponse_3_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta11.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryBalanceBreakdownResponse_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryBalanceBreakdownResponse_3_list) NewElement() protoreflect.Value {
+	v := new(v1beta11.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryBalanceBreakdownResponse_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_QueryBalanceBreakdownResponse_4_list)(nil)
+
+type _QueryBalanceBreakdownResponse_4_list struct {
+	list *[]*v1beta11.Coin
+}
+
+func (x *_QueryBalanceBreakdownResponse_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryBalanceBreakdownResponse_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryBalanceBreakdownResponse_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryBalanceBreakdownResponse_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryBalanceBreakdownResponse_4_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta11.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryBalanceBreakdownResponse_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryBalanceBreakdownResponse_4_list) NewElement() protoreflect.Value {
+	v := new(v1beta11.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryBalanceBreakdownResponse_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_QueryBalanceBreakdownResponse_5_list)(nil)
+
+type _QueryBalanceBreakdownResponse_5_list struct {
+	list *[]*v1beta11.Coin
+}
+
+func (x *_QueryBalanceBreakdownResponse_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryBalanceBreakdownResponse_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryBalanceBreakdownResponse_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryBalanceBreakdownResponse_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryBalanceBreakdownResponse_5_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta11.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryBalanceBreakdownResponse_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryBalanceBreakdownResponse_5_list) NewElement() protoreflect.Value {
+	v := new(v1beta11.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryBalanceBreakdownResponse_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryBalanceBreakdownResponse                   protoreflect.MessageDescriptor
+	fd_QueryBalanceBreakdownResponse_liquid            protoreflect.FieldDescriptor
+	fd_QueryBalanceBreakdownResponse_vesting_locked    protoreflect.FieldDescriptor
+	fd_QueryBalanceBreakdownResponse_vesting_claimable protoreflect.FieldDescriptor
+	fd_QueryBalanceBreakdownResponse_delegated         protoreflect.FieldDescriptor
+	fd_QueryBalanceBreakdownResponse_unbonding         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_move_v1_query_proto_init()
+	md_QueryBalanceBreakdownResponse = File_initia_move_v1_query_proto.Messages().ByName("QueryBalanceBreakdownResponse")
+	fd_QueryBalanceBreakdownResponse_liquid = md_QueryBalanceBreakdownResponse.Fields().ByName("liquid")
+	fd_QueryBalanceBreakdownResponse_vesting_locked = md_QueryBalanceBreakdownResponse.Fields().ByName("vesting_locked")
+	fd_QueryBalanceBreakdownResponse_vesting_claimable = md_QueryBalanceBreakdownResponse.Fields().ByName("vesting_claimable")
+	fd_QueryBalanceBreakdownResponse_delegated = md_QueryBalanceBreakdownResponse.Fields().ByName("delegated")
+	fd_QueryBalanceBreakdownResponse_unbonding = md_QueryBalanceBreakdownResponse.Fields().ByName("unbonding")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryBalanceBreakdownResponse)(nil)
+
+type fastReflection_QueryBalanceBreakdownResponse QueryBalanceBreakdownResponse
+
+func (x *QueryBalanceBreakdownResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryBalanceBreakdownResponse)(x)
+}
+
+func (x *QueryBalanceBreakdownResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_move_v1_query_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryBalanceBreakdownResponse_messageType fastReflection_QueryBalanceBreakdownResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryBalanceBreakdownResponse_messageType{}
+
+type fastReflection_QueryBalanceBreakdownResponse_messageType struct{}
+
+func (x fastReflection_QueryBalanceBreakdownResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryBalanceBreakdownResponse)(nil)
+}
+func (x fastReflection_QueryBalanceBreakdownResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryBalanceBreakdownResponse)
+}
+func (x fastReflection_QueryBalanceBreakdownResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryBalanceBreakdownResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryBalanceBreakdownResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryBalanceBreakdownResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryBalanceBreakdownResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryBalanceBreakdownResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryBalanceBreakdownResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryBalanceBreakdownResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryBalanceBreakdownResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryBalanceBreakdownResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryBalanceBreakdownResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Liquid) != 0 {
+		value := protoreflect.ValueOfList(&_QueryBalanceBreakdownResponse_1_list{list: &x.Liquid})
+		if !f(fd_QueryBalanceBreakdownResponse_liquid, value) {
+			return
+		}
+	}
+	if len(x.VestingLocked) != 0 {
+		value := protoreflect.ValueOfList(&_QueryBalanceBreakdownResponse_2_list{list: &x.VestingLocked})
+		if !f(fd_QueryBalanceBreakdownResponse_vesting_locked, value) {
+			return
+		}
+	}
+	if len(x.VestingClaimable) != 0 {
+		value := protoreflect.ValueOfList(&_QueryBalanceBreakdownResponse_3_list{list: &x.VestingClaimable})
+		if !f(fd_QueryBalanceBreakdownResponse_vesting_claimable, value) {
+			return
+		}
+	}
+	if len(x.Delegated) != 0 {
+		value := protoreflect.ValueOfList(&_QueryBalanceBreakdownResponse_4_list{list: &x.Delegated})
+		if !f(fd_QueryBalanceBreakdownResponse_delegated, value) {
+			return
+		}
+	}
+	if len(x.Unbonding) != 0 {
+		value := protoreflect.ValueOfList(&_QueryBalanceBreakdownResponse_5_list{list: &x.Unbonding})
+		if !f(fd_QueryBalanceBreakdownResponse_unbonding, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryBalanceBreakdownResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.move.v1.QueryBalanceBreakdownResponse.liquid":
+		return len(x.Liquid) != 0
+	case "initia.move.v1.QueryBalanceBreakdownResponse.vesting_locked":
+		return len(x.VestingLocked) != 0
+	case "initia.move.v1.QueryBalanceBreakdownResponse.vesting_claimable":
+		return len(x.VestingClaimable) != 0
+	case "initia.move.v1.QueryBalanceBreakdownResponse.delegated":
+		return len(x.Delegated) != 0
+	case "initia.move.v1.QueryBalanceBreakdownResponse.unbonding":
+		return len(x.Unbonding) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.QueryBalanceBreakdownResponse"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.QueryBalanceBreakdownResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBalanceBreakdownResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.move.v1.QueryBalanceBreakdownResponse.liquid":
+		x.Liquid = nil
+	case "initia.move.v1.QueryBalanceBreakdownResponse.vesting_locked":
+		x.VestingLocked = nil
+	case "initia.move.v1.QueryBalanceBreakdownResponse.vesting_claimable":
+		x.VestingClaimable = nil
+	case "initia.move.v1.QueryBalanceBreakdownResponse.delegated":
+		x.Delegated = nil
+	case "initia.move.v1.QueryBalanceBreakdownResponse.unbonding":
+		x.Unbonding = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.QueryBalanceBreakdownResponse"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.QueryBalanceBreakdownResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryBalanceBreakdownResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.move.v1.QueryBalanceBreakdownResponse.liquid":
+		if len(x.Liquid) == 0 {
+			return protoreflect.ValueOfList(&_QueryBalanceBreakdownResponse_1_list{})
+		}
+		listValue := &_QueryBalanceBreakdownResponse_1_list{list: &x.Liquid}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.move.v1.QueryBalanceBreakdownResponse.vesting_locked":
+		if len(x.VestingLocked) == 0 {
+			return protoreflect.ValueOfList(&_QueryBalanceBreakdownResponse_2_list{})
+		}
+		listValue := &_QueryBalanceBreakdownResponse_2_list{list: &x.VestingLocked}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.move.v1.QueryBalanceBreakdownResponse.vesting_claimable":
+		if len(x.VestingClaimable) == 0 {
+			return protoreflect.ValueOfList(&_QueryBalanceBreakdownResponse_3_list{})
+		}
+		listValue := &_QueryBalanceBreakdownResponse_3_list{list: &x.VestingClaimable}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.move.v1.QueryBalanceBreakdownResponse.delegated":
+		if len(x.Delegated) == 0 {
+			return protoreflect.ValueOfList(&_QueryBalanceBreakdownResponse_4_list{})
+		}
+		listValue := &_QueryBalanceBreakdownResponse_4_list{list: &x.Delegated}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.move.v1.QueryBalanceBreakdownResponse.unbonding":
+		if len(x.Unbonding) == 0 {
+			return protoreflect.ValueOfList(&_QueryBalanceBreakdownResponse_5_list{})
+		}
+		listValue := &_QueryBalanceBreakdownResponse_5_list{list: &x.Unbonding}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.QueryBalanceBreakdownResponse"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.QueryBalanceBreakdownResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBalanceBreakdownResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.move.v1.QueryBalanceBreakdownResponse.liquid":
+		lv := value.List()
+		clv := lv.(*_QueryBalanceBreakdownResponse_1_list)
+		x.Liquid = *clv.list
+	case "initia.move.v1.QueryBalanceBreakdownResponse.vesting_locked":
+		lv := value.List()
+		clv := lv.(*_QueryBalanceBreakdownResponse_2_list)
+		x.VestingLocked = *clv.list
+	case "initia.move.v1.QueryBalanceBreakdownResponse.vesting_claimable":
+		lv := value.List()
+		clv := lv.(*_QueryBalanceBreakdownResponse_3_list)
+		x.VestingClaimable = *clv.list
+	case "initia.move.v1.QueryBalanceBreakdownResponse.delegated":
+		lv := value.List()
+		clv := lv.(*_QueryBalanceBreakdownResponse_4_list)
+		x.Delegated = *clv.list
+	case "initia.move.v1.QueryBalanceBreakdownResponse.unbonding":
+		lv := value.List()
+		clv := lv.(*_QueryBalanceBreakdownResponse_5_list)
+		x.Unbonding = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.QueryBalanceBreakdownResponse"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.QueryBalanceBreakdownResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBalanceBreakdownResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.move.v1.QueryBalanceBreakdownResponse.liquid":
+		if x.Liquid == nil {
+			x.Liquid = []*v1beta11.Coin{}
+		}
+		value := &_QueryBalanceBreakdownResponse_1_list{list: &x.Liquid}
+		return protoreflect.ValueOfList(value)
+	case "initia.move.v1.QueryBalanceBreakdownResponse.vesting_locked":
+		if x.VestingLocked == nil {
+			x.VestingLocked = []*v1beta11.Coin{}
+		}
+		value := &_QueryBalanceBreakdownResponse_2_list{list: &x.VestingLocked}
+		return protoreflect.ValueOfList(value)
+	case "initia.move.v1.QueryBalanceBreakdownResponse.vesting_claimable":
+		if x.VestingClaimable == nil {
+			x.VestingClaimable = []*v1beta11.Coin{}
+		}
+		value := &_QueryBalanceBreakdownResponse_3_list{list: &x.VestingClaimable}
+		return protoreflect.ValueOfList(value)
+	case "initia.move.v1.QueryBalanceBreakdownResponse.delegated":
+		if x.Delegated == nil {
+			x.Delegated = []*v1beta11.Coin{}
+		}
+		value := &_QueryBalanceBreakdownResponse_4_list{list: &x.Delegated}
+		return protoreflect.ValueOfList(value)
+	case "initia.move.v1.QueryBalanceBreakdownResponse.unbonding":
+		if x.Unbonding == nil {
+			x.Unbonding = []*v1beta11.Coin{}
+		}
+		value := &_QueryBalanceBreakdownResponse_5_list{list: &x.Unbonding}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.QueryBalanceBreakdownResponse"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.QueryBalanceBreakdownResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryBalanceBreakdownResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.move.v1.QueryBalanceBreakdownResponse.liquid":
+		list := []*v1beta11.Coin{}
+		return protoreflect.ValueOfList(&_QueryBalanceBreakdownResponse_1_list{list: &list})
+	case "initia.move.v1.QueryBalanceBreakdownResponse.vesting_locked":
+		list := []*v1beta11.Coin{}
+		return protoreflect.ValueOfList(&_QueryBalanceBreakdownResponse_2_list{list: &list})
+	case "initia.move.v1.QueryBalanceBreakdownResponse.vesting_claimable":
+		list := []*v1beta11.Coin{}
+		return protoreflect.ValueOfList(&_QueryBalanceBreakdownResponse_3_list{list: &list})
+	case "initia.move.v1.QueryBalanceBreakdownResponse.delegated":
+		list := []*v1beta11.Coin{}
+		return protoreflect.ValueOfList(&_QueryBalanceBreakdownResponse_4_list{list: &list})
+	case "initia.move.v1.QueryBalanceBreakdownResponse.unbonding":
+		list := []*v1beta11.Coin{}
+		return protoreflect.ValueOfList(&_QueryBalanceBreakdownResponse_5_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.QueryBalanceBreakdownResponse"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.QueryBalanceBreakdownResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryBalanceBreakdownResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.move.v1.QueryBalanceBreakdownResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryBalanceBreakdownResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBalanceBreakdownResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryBalanceBreakdownResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryBalanceBreakdownResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryBalanceBreakdownResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Liquid) > 0 {
+			for _, e := range x.Liquid {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.VestingLocked) > 0 {
+			for _, e := range x.VestingLocked {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.VestingClaimable) > 0 {
+			for _, e := range x.VestingClaimable {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Delegated) > 0 {
+			for _, e := range x.Delegated {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Unbonding) > 0 {
+			for _, e := range x.Unbonding {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryBalanceBreakdownResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Unbonding) > 0 {
+			for iNdEx := len(x.Unbonding) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Unbonding[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.Delegated) > 0 {
+			for iNdEx := len(x.Delegated) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Delegated[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.VestingClaimable) > 0 {
+			for iNdEx := len(x.VestingClaimable) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.VestingClaimable[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.VestingLocked) > 0 {
+			for iNdEx := len(x.VestingLocked) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.VestingLocked[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Liquid) > 0 {
+			for iNdEx := len(x.Liquid) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Liquid[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryBalanceBreakdownResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBalanceBreakdownResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBalanceBreakdownResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Liquid", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Liquid = append(x.Liquid, &v1beta11.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Liquid[len(x.Liquid)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VestingLocked", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VestingLocked = append(x.VestingLocked, &v1beta11.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VestingLocked[len(x.VestingLocked)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VestingClaimable", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VestingClaimable = append(x.VestingClaimable, &v1beta11.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VestingClaimable[len(x.VestingClaimable)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Delegated", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Delegated = append(x.Delegated, &v1beta11.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Delegated[len(x.Delegated)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Unbonding", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Unbonding = append(x.Unbonding, &v1beta11.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Unbonding[len(x.Unbonding)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
 // 	protoc-gen-go v1.27.0
@@ -20326,6 +21985,146 @@ func (x *QueryDexPairsResponse) GetPagination() *v1beta1.PageResponse {
 	return nil
 }
 
+// QueryBalanceBreakdownRequest is the request type for the Query/BalanceBreakdown
+// RPC method.
+type QueryBalanceBreakdownRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// address is the account address to query
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// vesting_module_address is the address of the vesting module. The vesting
+	// amounts are not queried if the vesting module is not given.
+	VestingModuleAddress string `protobuf:"bytes,2,opt,name=vesting_module_address,json=vestingModuleAddress,proto3" json:"vesting_module_address,omitempty"`
+	// vesting_module_name is the name of the vesting module
+	VestingModuleName string `protobuf:"bytes,3,opt,name=vesting_module_name,json=vestingModuleName,proto3" json:"vesting_module_name,omitempty"`
+	// vesting_creator_address is the creator address of the vesting
+	VestingCreatorAddress string `protobuf:"bytes,4,opt,name=vesting_creator_address,json=vestingCreatorAddress,proto3" json:"vesting_creator_address,omitempty"`
+}
+
+func (x *QueryBalanceBreakdownRequest) Reset() {
+	*x = QueryBalanceBreakdownRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_move_v1_query_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryBalanceBreakdownRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryBalanceBreakdownRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryBalanceBreakdownRequest.ProtoReflect.Descriptor instead.
+func (*QueryBalanceBreakdownRequest) Descriptor() ([]byte, []int) {
+	return file_initia_move_v1_query_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *QueryBalanceBreakdownRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *QueryBalanceBreakdownRequest) GetVestingModuleAddress() string {
+	if x != nil {
+		return x.VestingModuleAddress
+	}
+	return ""
+}
+
+func (x *QueryBalanceBreakdownRequest) GetVestingModuleName() string {
+	if x != nil {
+		return x.VestingModuleName
+	}
+	return ""
+}
+
+func (x *QueryBalanceBreakdownRequest) GetVestingCreatorAddress() string {
+	if x != nil {
+		return x.VestingCreatorAddress
+	}
+	return ""
+}
+
+// QueryBalanceBreakdownResponse is the response type for the Query/BalanceBreakdown
+// RPC method.
+type QueryBalanceBreakdownResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// liquid is the balances of the primary fungible stores
+	Liquid []*v1beta11.Coin `protobuf:"bytes,1,rep,name=liquid,proto3" json:"liquid,omitempty"`
+	// vesting_locked is the amounts not vested yet
+	VestingLocked []*v1beta11.Coin `protobuf:"bytes,2,rep,name=vesting_locked,json=vestingLocked,proto3" json:"vesting_locked,omitempty"`
+	// vesting_claimable is the amounts vested but not claimed yet
+	VestingClaimable []*v1beta11.Coin `protobuf:"bytes,3,rep,name=vesting_claimable,json=vestingClaimable,proto3" json:"vesting_claimable,omitempty"`
+	// delegated is the token amounts of the delegations
+	Delegated []*v1beta11.Coin `protobuf:"bytes,4,rep,name=delegated,proto3" json:"delegated,omitempty"`
+	// unbonding is the amounts of the unbonding delegations
+	Unbonding []*v1beta11.Coin `protobuf:"bytes,5,rep,name=unbonding,proto3" json:"unbonding,omitempty"`
+}
+
+func (x *QueryBalanceBreakdownResponse) Reset() {
+	*x = QueryBalanceBreakdownResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_move_v1_query_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryBalanceBreakdownResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryBalanceBreakdownResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryBalanceBreakdownResponse.ProtoReflect.Descriptor instead.
+func (*QueryBalanceBreakdownResponse) Descriptor() ([]byte, []int) {
+	return file_initia_move_v1_query_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *QueryBalanceBreakdownResponse) GetLiquid() []*v1beta11.Coin {
+	if x != nil {
+		return x.Liquid
+	}
+	return nil
+}
+
+func (x *QueryBalanceBreakdownResponse) GetVestingLocked() []*v1beta11.Coin {
+	if x != nil {
+		return x.VestingLocked
+	}
+	return nil
+}
+
+func (x *QueryBalanceBreakdownResponse) GetVestingClaimable() []*v1beta11.Coin {
+	if x != nil {
+		return x.VestingClaimable
+	}
+	return nil
+}
+
+func (x *QueryBalanceBreakdownResponse) GetDelegated() []*v1beta11.Coin {
+	if x != nil {
+		return x.Delegated
+	}
+	return nil
+}
+
+func (x *QueryBalanceBreakdownResponse) GetUnbonding() []*v1beta11.Coin {
+	if x != nil {
+		return x.Unbonding
+	}
+	return nil
+}
+
 var File_initia_move_v1_query_proto protoreflect.FileDescriptor
 
 var file_initia_move_v1_query_proto_rawDesc = []byte{
@@ -20335,7 +22134,9 @@ var file_initia_move_v1_query_proto_rawDesc = []byte{
 	0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
 	0x2a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x71, 0x75, 0x65,
 	0x72, 0x79, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x61, 0x67, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67,
 	0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e,
 	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
@@ -20573,177 +22374,239 @@ var file_initia_move_v1_query_proto_rawDesc = []byte{
 	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
 	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31,
 	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0xcc,
-	0x13, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x93, 0x01, 0x0a, 0x06, 0x4d, 0x6f, 0x64,
-	0x75, 0x6c, 0x65, 0x12, 0x22, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61,
+	0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xd6,
+	0x01, 0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x42,
+	0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x76, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x76, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x2e, 0x0a, 0x13, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x76, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x36, 0x0a, 0x17, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x15, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xe1, 0x04, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x06, 0x6c, 0x69, 0x71,
+	0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x6c, 0x69, 0x71,
+	0x75, 0x69, 0x64, 0x12, 0x77, 0x0a, 0x0e, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x6c,
+	0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x76,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x7d, 0x0a, 0x11,
+	0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x62, 0x6c,
+	0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f,
+	0x69, 0x6e, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43,
+	0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x10, 0x76, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x67, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x6e, 0x0a, 0x09, 0x64,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xaa,
+	0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x12, 0x6e, 0x0a, 0x09, 0x75,
+	0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xaa,
+	0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x09, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x32, 0xfc, 0x14, 0x0a, 0x05,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x93, 0x01, 0x0a, 0x06, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x12, 0x22, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f,
+	0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x40, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x3a, 0x12, 0x38, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x6d, 0x6f, 0x76, 0x65, 0x2f,
+	0x76, 0x31, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x7d, 0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x6d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0x88, 0x01, 0x0a, 0x07,
+	0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x23, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61,
 	0x2e, 0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x6f,
-	0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x40, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x3a, 0x12, 0x38, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x6d, 0x6f,
-	0x76, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x7b,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73,
-	0x2f, 0x7b, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0x88,
-	0x01, 0x0a, 0x07, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x23, 0x2e, 0x69, 0x6e, 0x69,
-	0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x24, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x12, 0x2a, 0x2f,
-	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x6d, 0x6f, 0x76, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x61,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x7d, 0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x9b, 0x01, 0x0a, 0x08, 0x52, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x24, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e,
-	0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x69,
+	0x64, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x69,
 	0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x42, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3c, 0x12, 0x3a, 0x2f, 0x69, 0x6e,
-	0x69, 0x74, 0x69, 0x61, 0x2f, 0x6d, 0x6f, 0x76, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x2f,
-	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2f, 0x62, 0x79, 0x5f, 0x73, 0x74, 0x72,
-	0x75, 0x63, 0x74, 0x5f, 0x74, 0x61, 0x67, 0x12, 0x90, 0x01, 0x0a, 0x09, 0x52, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x25, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d,
-	0x6f, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x69,
+	0x65, 0x72, 0x79, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x12, 0x2a, 0x2f, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x61, 0x2f, 0x6d, 0x6f, 0x76, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x2f, 0x6d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x9b, 0x01, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x12, 0x24, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x69, 0x6e, 0x69, 0x74,
+	0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x42, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3c, 0x12, 0x3a, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x2f, 0x6d, 0x6f, 0x76, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x2f, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2f, 0x62, 0x79, 0x5f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x5f, 0x74, 0x61, 0x67, 0x12, 0x90, 0x01, 0x0a, 0x09, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x73, 0x12, 0x25, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x69, 0x6e, 0x69, 0x74,
+	0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x12, 0x2c, 0x2f, 0x69, 0x6e, 0x69, 0x74,
+	0x69, 0x61, 0x2f, 0x6d, 0x6f, 0x76, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x2f, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x84, 0x01, 0x0a, 0x09, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x25, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d,
+	0x6f, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x69,
 	0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x12, 0x2c, 0x2f, 0x69,
-	0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x6d, 0x6f, 0x76, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d,
-	0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x84, 0x01, 0x0a, 0x09, 0x54,
-	0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x25, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x65, 0x72, 0x79, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x6d, 0x6f, 0x76, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x9c,
+	0x01, 0x0a, 0x0a, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x26, 0x2e,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d,
+	0x6f, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x37, 0x12, 0x35, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f,
+	0x6d, 0x6f, 0x76, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x2f, 0x7b,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x2f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x2f, 0x62, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x95, 0x01,
+	0x0a, 0x0c, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x28,
+	0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69,
 	0x61, 0x2e, 0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54,
-	0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x61, 0x62, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x12, 0x28, 0x2f, 0x69, 0x6e,
+	0x69, 0x74, 0x69, 0x61, 0x2f, 0x6d, 0x6f, 0x76, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x2f, 0x65, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0xc4, 0x01, 0x0a, 0x0a, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79,
+	0x56, 0x69, 0x65, 0x77, 0x12, 0x26, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f,
+	0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x65, 0x67, 0x61, 0x63,
+	0x79, 0x56, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x56, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x5c, 0x3a, 0x01, 0x2a,
+	0x22, 0x57, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x6d, 0x6f, 0x76, 0x65, 0x2f, 0x76,
+	0x31, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x7d, 0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x6d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x76, 0x69, 0x65, 0x77, 0x5f,
+	0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x66, 0x75, 0x6e, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x88, 0x02, 0x01, 0x12, 0x6c, 0x0a, 0x04,
+	0x56, 0x69, 0x65, 0x77, 0x12, 0x20, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f,
+	0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x69, 0x65, 0x77, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e,
+	0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x69, 0x65,
+	0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x19, 0x3a, 0x01, 0x2a, 0x22, 0x14, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x6d, 0x6f,
+	0x76, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x69, 0x65, 0x77, 0x12, 0x81, 0x01, 0x0a, 0x09, 0x56,
+	0x69, 0x65, 0x77, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x25, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x2e, 0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56,
+	0x69, 0x65, 0x77, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
 	0x26, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12,
-	0x20, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x6d, 0x6f, 0x76, 0x65, 0x2f, 0x76, 0x31,
-	0x2f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x7d, 0x12, 0x9c, 0x01, 0x0a, 0x0a, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x26, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69,
-	0x61, 0x2e, 0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54,
-	0x61, 0x62, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x3d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x37, 0x12, 0x35, 0x2f, 0x69, 0x6e, 0x69, 0x74,
-	0x69, 0x61, 0x2f, 0x6d, 0x6f, 0x76, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x61, 0x62, 0x6c, 0x65,
-	0x73, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x2f, 0x65, 0x6e, 0x74, 0x72,
-	0x69, 0x65, 0x73, 0x2f, 0x62, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73,
-	0x12, 0x95, 0x01, 0x0a, 0x0c, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65,
-	0x73, 0x12, 0x28, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x6e, 0x74,
-	0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x69, 0x6e,
-	0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x12, 0x28,
-	0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x6d, 0x6f, 0x76, 0x65, 0x2f, 0x76, 0x31, 0x2f,
-	0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d,
-	0x2f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0xc4, 0x01, 0x0a, 0x0a, 0x4c, 0x65, 0x67,
-	0x61, 0x63, 0x79, 0x56, 0x69, 0x65, 0x77, 0x12, 0x26, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61,
-	0x2e, 0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x65,
-	0x67, 0x61, 0x63, 0x79, 0x56, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x27, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x56, 0x69, 0x65, 0x77,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x5c,
-	0x3a, 0x01, 0x2a, 0x22, 0x57, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x6d, 0x6f, 0x76,
-	0x65, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x2f,
-	0x7b, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x76, 0x69,
-	0x65, 0x77, 0x5f, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x66, 0x75,
-	0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x88, 0x02, 0x01, 0x12,
-	0x6c, 0x0a, 0x04, 0x56, 0x69, 0x65, 0x77, 0x12, 0x20, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61,
-	0x2e, 0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x69,
-	0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x69, 0x6e, 0x69, 0x74,
-	0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x56, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x19, 0x3a, 0x01, 0x2a, 0x22, 0x14, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61,
-	0x2f, 0x6d, 0x6f, 0x76, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x69, 0x65, 0x77, 0x12, 0x81, 0x01,
-	0x0a, 0x09, 0x56, 0x69, 0x65, 0x77, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x25, 0x2e, 0x69, 0x6e,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x69, 0x65, 0x77, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x3a,
+	0x01, 0x2a, 0x22, 0x1a, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x6d, 0x6f, 0x76, 0x65,
+	0x2f, 0x76, 0x31, 0x2f, 0x76, 0x69, 0x65, 0x77, 0x2f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x12, 0x7d,
+	0x0a, 0x08, 0x56, 0x69, 0x65, 0x77, 0x4a, 0x53, 0x4f, 0x4e, 0x12, 0x24, 0x2e, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x56, 0x69, 0x65, 0x77, 0x4a, 0x53, 0x4f, 0x4e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x25, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x69, 0x65, 0x77, 0x4a, 0x53, 0x4f, 0x4e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x3a,
+	0x01, 0x2a, 0x22, 0x19, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x6d, 0x6f, 0x76, 0x65,
+	0x2f, 0x76, 0x31, 0x2f, 0x76, 0x69, 0x65, 0x77, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x92, 0x01,
+	0x0a, 0x0d, 0x56, 0x69, 0x65, 0x77, 0x4a, 0x53, 0x4f, 0x4e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12,
+	0x29, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x69, 0x65, 0x77, 0x4a, 0x53, 0x4f, 0x4e, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x56, 0x69, 0x65, 0x77, 0x4a, 0x53, 0x4f, 0x4e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x3a, 0x01,
+	0x2a, 0x22, 0x1f, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x6d, 0x6f, 0x76, 0x65, 0x2f,
+	0x76, 0x31, 0x2f, 0x76, 0x69, 0x65, 0x77, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x2f, 0x62, 0x61, 0x74,
+	0x63, 0x68, 0x12, 0x81, 0x01, 0x0a, 0x09, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x41, 0x42, 0x49,
+	0x12, 0x25, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x41, 0x42, 0x49,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61,
+	0x2e, 0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x41, 0x42, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x3a, 0x01, 0x2a, 0x22, 0x1a, 0x2f, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x61, 0x2f, 0x6d, 0x6f, 0x76, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x2f, 0x61, 0x62, 0x69, 0x12, 0x71, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x12, 0x22, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f,
+	0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x18, 0x12, 0x16, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x6d, 0x6f, 0x76, 0x65, 0x2f,
+	0x76, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x79, 0x0a, 0x08, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x24, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d,
+	0x6f, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x69, 0x6e,
 	0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x56, 0x69, 0x65, 0x77, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x26, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x69, 0x65, 0x77, 0x42, 0x61, 0x74,
-	0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x1f, 0x3a, 0x01, 0x2a, 0x22, 0x1a, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x6d,
-	0x6f, 0x76, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x69, 0x65, 0x77, 0x2f, 0x62, 0x61, 0x74, 0x63,
-	0x68, 0x12, 0x7d, 0x0a, 0x08, 0x56, 0x69, 0x65, 0x77, 0x4a, 0x53, 0x4f, 0x4e, 0x12, 0x24, 0x2e,
-	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x56, 0x69, 0x65, 0x77, 0x4a, 0x53, 0x4f, 0x4e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x69, 0x65, 0x77, 0x4a, 0x53,
-	0x4f, 0x4e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x1e, 0x3a, 0x01, 0x2a, 0x22, 0x19, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x6d,
-	0x6f, 0x76, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x69, 0x65, 0x77, 0x2f, 0x6a, 0x73, 0x6f, 0x6e,
-	0x12, 0x92, 0x01, 0x0a, 0x0d, 0x56, 0x69, 0x65, 0x77, 0x4a, 0x53, 0x4f, 0x4e, 0x42, 0x61, 0x74,
-	0x63, 0x68, 0x12, 0x29, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x69, 0x65, 0x77, 0x4a, 0x53, 0x4f,
-	0x4e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e,
+	0x72, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x61, 0x2f, 0x6d, 0x6f, 0x76, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x6d, 0x0a, 0x05, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x21, 0x2e,
 	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x56, 0x69, 0x65, 0x77, 0x4a, 0x53, 0x4f, 0x4e, 0x42, 0x61, 0x74, 0x63,
-	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x24, 0x3a, 0x01, 0x2a, 0x22, 0x1f, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x6d, 0x6f,
-	0x76, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x69, 0x65, 0x77, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x2f,
-	0x62, 0x61, 0x74, 0x63, 0x68, 0x12, 0x81, 0x01, 0x0a, 0x09, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x41, 0x42, 0x49, 0x12, 0x25, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x41, 0x42, 0x49, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x69, 0x6e, 0x69,
+	0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x22, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x6d, 0x6f, 0x76, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x65,
+	0x6e, 0x6f, 0x6d, 0x12, 0x88, 0x01, 0x0a, 0x07, 0x44, 0x65, 0x78, 0x50, 0x61, 0x69, 0x72, 0x12,
+	0x23, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x78, 0x50, 0x61, 0x69, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f,
+	0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x78, 0x50, 0x61,
+	0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x2c, 0x12, 0x2a, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x6d, 0x6f, 0x76, 0x65,
+	0x2f, 0x76, 0x31, 0x2f, 0x64, 0x65, 0x78, 0x2f, 0x70, 0x61, 0x69, 0x72, 0x73, 0x2f, 0x7b, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x7d, 0x12, 0x7a,
+	0x0a, 0x08, 0x44, 0x65, 0x78, 0x50, 0x61, 0x69, 0x72, 0x73, 0x12, 0x24, 0x2e, 0x69, 0x6e, 0x69,
 	0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x41, 0x42, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x3a, 0x01, 0x2a, 0x22, 0x1a, 0x2f,
-	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x6d, 0x6f, 0x76, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x2f, 0x61, 0x62, 0x69, 0x12, 0x71, 0x0a, 0x06, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x12, 0x22, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61,
-	0x2e, 0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x6d, 0x6f,
-	0x76, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x79, 0x0a, 0x08,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x24, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69,
-	0x61, 0x2e, 0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25,
-	0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f,
-	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x6d, 0x6f, 0x76, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x6d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x6d, 0x0a, 0x05, 0x44, 0x65, 0x6e, 0x6f, 0x6d,
-	0x12, 0x21, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12,
-	0x15, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x6d, 0x6f, 0x76, 0x65, 0x2f, 0x76, 0x31,
-	0x2f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x88, 0x01, 0x0a, 0x07, 0x44, 0x65, 0x78, 0x50, 0x61,
-	0x69, 0x72, 0x12, 0x23, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x78, 0x50, 0x61, 0x69, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61,
-	0x2e, 0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65,
-	0x78, 0x50, 0x61, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x32, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x12, 0x2a, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x6d,
-	0x6f, 0x76, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x65, 0x78, 0x2f, 0x70, 0x61, 0x69, 0x72, 0x73,
-	0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x65,
-	0x7d, 0x12, 0x7a, 0x0a, 0x08, 0x44, 0x65, 0x78, 0x50, 0x61, 0x69, 0x72, 0x73, 0x12, 0x24, 0x2e,
+	0x79, 0x44, 0x65, 0x78, 0x50, 0x61, 0x69, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x25, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x78, 0x50, 0x61, 0x69, 0x72, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12,
+	0x19, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x6d, 0x6f, 0x76, 0x65, 0x2f, 0x76, 0x31,
+	0x2f, 0x64, 0x65, 0x78, 0x2f, 0x70, 0x61, 0x69, 0x72, 0x73, 0x12, 0xad, 0x01, 0x0a, 0x10, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x12,
+	0x2c, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x72, 0x65,
+	0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e,
 	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x78, 0x50, 0x61, 0x69, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x78, 0x50, 0x61, 0x69,
-	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x1b, 0x12, 0x19, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x6d, 0x6f, 0x76, 0x65,
-	0x2f, 0x76, 0x31, 0x2f, 0x64, 0x65, 0x78, 0x2f, 0x70, 0x61, 0x69, 0x72, 0x73, 0x42, 0xbb, 0x01,
-	0xc8, 0xe1, 0x1e, 0x00, 0xa8, 0xe2, 0x1e, 0x00, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x69, 0x6e,
-	0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2d, 0x6c, 0x61,
-	0x62, 0x73, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x6e,
-	0x69, 0x74, 0x69, 0x61, 0x2f, 0x6d, 0x6f, 0x76, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6d, 0x6f, 0x76,
-	0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x49, 0x4d, 0x58, 0xaa, 0x02, 0x0e, 0x49, 0x6e, 0x69, 0x74,
-	0x69, 0x61, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0e, 0x49, 0x6e, 0x69,
-	0x74, 0x69, 0x61, 0x5c, 0x4d, 0x6f, 0x76, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1a, 0x49, 0x6e,
-	0x69, 0x74, 0x69, 0x61, 0x5c, 0x4d, 0x6f, 0x76, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x49, 0x6e, 0x69, 0x74, 0x69,
-	0x61, 0x3a, 0x3a, 0x4d, 0x6f, 0x76, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x75, 0x65, 0x72, 0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b,
+	0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3c, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x36, 0x12, 0x34, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x6d, 0x6f,
+	0x76, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x7b,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x2f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x5f, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x42, 0xbb, 0x01, 0xc8, 0xe1, 0x1e,
+	0x00, 0xa8, 0xe2, 0x1e, 0x00, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x2e, 0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x2f, 0x6d, 0x6f, 0x76, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6d, 0x6f, 0x76, 0x65, 0x76, 0x31,
+	0xa2, 0x02, 0x03, 0x49, 0x4d, 0x58, 0xaa, 0x02, 0x0e, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e,
+	0x4d, 0x6f, 0x76, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0e, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61,
+	0x5c, 0x4d, 0x6f, 0x76, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1a, 0x49, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x5c, 0x4d, 0x6f, 0x76, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x3a, 0x3a,
+	0x4d, 0x6f, 0x76, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -20758,68 +22621,71 @@ func file_initia_move_v1_query_proto_rawDescGZIP() []byte {
 	return file_initia_move_v1_query_proto_rawDescData
 }
 
-var file_initia_move_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 37)
+var file_initia_move_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
 var file_initia_move_v1_query_proto_goTypes = []interface{}{
-	(*QueryModuleRequest)(nil),         // 0: initia.move.v1.QueryModuleRequest
-	(*QueryModuleResponse)(nil),        // 1: initia.move.v1.QueryModuleResponse
-	(*QueryModulesRequest)(nil),        // 2: initia.move.v1.QueryModulesRequest
-	(*QueryModulesResponse)(nil),       // 3: initia.move.v1.QueryModulesResponse
-	(*QueryResourceRequest)(nil),       // 4: initia.move.v1.QueryResourceRequest
-	(*QueryResourceResponse)(nil),      // 5: initia.move.v1.QueryResourceResponse
-	(*QueryResourcesRequest)(nil),      // 6: initia.move.v1.QueryResourcesRequest
-	(*QueryResourcesResponse)(nil),     // 7: initia.move.v1.QueryResourcesResponse
-	(*QueryTableInfoRequest)(nil),      // 8: initia.move.v1.QueryTableInfoRequest
-	(*QueryTableInfoResponse)(nil),     // 9: initia.move.v1.QueryTableInfoResponse
-	(*QueryTableEntryRequest)(nil),     // 10: initia.move.v1.QueryTableEntryRequest
-	(*QueryTableEntryResponse)(nil),    // 11: initia.move.v1.QueryTableEntryResponse
-	(*QueryTableEntriesRequest)(nil),   // 12: initia.move.v1.QueryTableEntriesRequest
-	(*QueryTableEntriesResponse)(nil),  // 13: initia.move.v1.QueryTableEntriesResponse
-	(*QueryLegacyViewRequest)(nil),     // 14: initia.move.v1.QueryLegacyViewRequest
-	(*QueryLegacyViewResponse)(nil),    // 15: initia.move.v1.QueryLegacyViewResponse
-	(*QueryViewRequest)(nil),           // 16: initia.move.v1.QueryViewRequest
-	(*QueryViewResponse)(nil),          // 17: initia.move.v1.QueryViewResponse
-	(*QueryViewBatchRequest)(nil),      // 18: initia.move.v1.QueryViewBatchRequest
-	(*QueryViewBatchResponse)(nil),     // 19: initia.move.v1.QueryViewBatchResponse
-	(*QueryViewJSONRequest)(nil),       // 20: initia.move.v1.QueryViewJSONRequest
-	(*QueryViewJSONResponse)(nil),      // 21: initia.move.v1.QueryViewJSONResponse
-	(*QueryViewJSONBatchRequest)(nil),  // 22: initia.move.v1.QueryViewJSONBatchRequest
-	(*QueryViewJSONBatchResponse)(nil), // 23: initia.move.v1.QueryViewJSONBatchResponse
-	(*VMEvent)(nil),                    // 24: initia.move.v1.VMEvent
-	(*QueryScriptABIRequest)(nil),      // 25: initia.move.v1.QueryScriptABIRequest
-	(*QueryScriptABIResponse)(nil),     // 26: initia.move.v1.QueryScriptABIResponse
-	(*QueryParamsRequest)(nil),         // 27: initia.move.v1.QueryParamsRequest
-	(*QueryParamsResponse)(nil),        // 28: initia.move.v1.QueryParamsResponse
-	(*QueryMetadataRequest)(nil),       // 29: initia.move.v1.QueryMetadataRequest
-	(*QueryMetadataResponse)(nil),      // 30: initia.move.v1.QueryMetadataResponse
-	(*QueryDenomRequest)(nil),          // 31: initia.move.v1.QueryDenomRequest
-	(*QueryDenomResponse)(nil),         // 32: initia.move.v1.QueryDenomResponse
-	(*QueryDexPairRequest)(nil),        // 33: initia.move.v1.QueryDexPairRequest
-	(*QueryDexPairResponse)(nil),       // 34: initia.move.v1.QueryDexPairResponse
-	(*QueryDexPairsRequest)(nil),       // 35: initia.move.v1.QueryDexPairsRequest
-	(*QueryDexPairsResponse)(nil),      // 36: initia.move.v1.QueryDexPairsResponse
-	(*Module)(nil),                     // 37: initia.move.v1.Module
-	(*v1beta1.PageRequest)(nil),        // 38: cosmos.base.query.v1beta1.PageRequest
-	(*v1beta1.PageResponse)(nil),       // 39: cosmos.base.query.v1beta1.PageResponse
-	(*Resource)(nil),                   // 40: initia.move.v1.Resource
-	(*TableInfo)(nil),                  // 41: initia.move.v1.TableInfo
-	(*TableEntry)(nil),                 // 42: initia.move.v1.TableEntry
-	(*Params)(nil),                     // 43: initia.move.v1.Params
-	(*DexPair)(nil),                    // 44: initia.move.v1.DexPair
+	(*QueryModuleRequest)(nil),            // 0: initia.move.v1.QueryModuleRequest
+	(*QueryModuleResponse)(nil),           // 1: initia.move.v1.QueryModuleResponse
+	(*QueryModulesRequest)(nil),           // 2: initia.move.v1.QueryModulesRequest
+	(*QueryModulesResponse)(nil),          // 3: initia.move.v1.QueryModulesResponse
+	(*QueryResourceRequest)(nil),          // 4: initia.move.v1.QueryResourceRequest
+	(*QueryResourceResponse)(nil),         // 5: initia.move.v1.QueryResourceResponse
+	(*QueryResourcesRequest)(nil),         // 6: initia.move.v1.QueryResourcesRequest
+	(*QueryResourcesResponse)(nil),        // 7: initia.move.v1.QueryResourcesResponse
+	(*QueryTableInfoRequest)(nil),         // 8: initia.move.v1.QueryTableInfoRequest
+	(*QueryTableInfoResponse)(nil),        // 9: initia.move.v1.QueryTableInfoResponse
+	(*QueryTableEntryRequest)(nil),        // 10: initia.move.v1.QueryTableEntryRequest
+	(*QueryTableEntryResponse)(nil),       // 11: initia.move.v1.QueryTableEntryResponse
+	(*QueryTableEntriesRequest)(nil),      // 12: initia.move.v1.QueryTableEntriesRequest
+	(*QueryTableEntriesResponse)(nil),     // 13: initia.move.v1.QueryTableEntriesResponse
+	(*QueryLegacyViewRequest)(nil),        // 14: initia.move.v1.QueryLegacyViewRequest
+	(*QueryLegacyViewResponse)(nil),       // 15: initia.move.v1.QueryLegacyViewResponse
+	(*QueryViewRequest)(nil),              // 16: initia.move.v1.QueryViewRequest
+	(*QueryViewResponse)(nil),             // 17: initia.move.v1.QueryViewResponse
+	(*QueryViewBatchRequest)(nil),         // 18: initia.move.v1.QueryViewBatchRequest
+	(*QueryViewBatchResponse)(nil),        // 19: initia.move.v1.QueryViewBatchResponse
+	(*QueryViewJSONRequest)(nil),          // 20: initia.move.v1.QueryViewJSONRequest
+	(*QueryViewJSONResponse)(nil),         // 21: initia.move.v1.QueryViewJSONResponse
+	(*QueryViewJSONBatchRequest)(nil),     // 22: initia.move.v1.QueryViewJSONBatchRequest
+	(*QueryViewJSONBatchResponse)(nil),    // 23: initia.move.v1.QueryViewJSONBatchResponse
+	(*VMEvent)(nil),                       // 24: initia.move.v1.VMEvent
+	(*QueryScriptABIRequest)(nil),         // 25: initia.move.v1.QueryScriptABIRequest
+	(*QueryScriptABIResponse)(nil),        // 26: initia.move.v1.QueryScriptABIResponse
+	(*QueryParamsRequest)(nil),            // 27: initia.move.v1.QueryParamsRequest
+	(*QueryParamsResponse)(nil),           // 28: initia.move.v1.QueryParamsResponse
+	(*QueryMetadataRequest)(nil),          // 29: initia.move.v1.QueryMetadataRequest
+	(*QueryMetadataResponse)(nil),         // 30: initia.move.v1.QueryMetadataResponse
+	(*QueryDenomRequest)(nil),             // 31: initia.move.v1.QueryDenomRequest
+	(*QueryDenomResponse)(nil),            // 32: initia.move.v1.QueryDenomResponse
+	(*QueryDexPairRequest)(nil),           // 33: initia.move.v1.QueryDexPairRequest
+	(*QueryDexPairResponse)(nil),          // 34: initia.move.v1.QueryDexPairResponse
+	(*QueryDexPairsRequest)(nil),          // 35: initia.move.v1.QueryDexPairsRequest
+	(*QueryDexPairsResponse)(nil),         // 36: initia.move.v1.QueryDexPairsResponse
+	(*QueryBalanceBreakdownRequest)(nil),  // 37: initia.move.v1.QueryBalanceBreakdownRequest
+	(*QueryBalanceBreakdownResponse)(nil), // 38: initia.move.v1.QueryBalanceBreakdownResponse
+	(*Module)(nil),                        // 39: initia.move.v1.Module
+	(*v1beta1.PageRequest)(nil),           // 40: cosmos.base.query.v1beta1.PageRequest
+	(*v1beta1.PageResponse)(nil),          // 41: cosmos.base.query.v1beta1.PageResponse
+	(*Resource)(nil),                      // 42: initia.move.v1.Resource
+	(*TableInfo)(nil),                     // 43: initia.move.v1.TableInfo
+	(*TableEntry)(nil),                    // 44: initia.move.v1.TableEntry
+	(*Params)(nil),                        // 45: initia.move.v1.Params
+	(*DexPair)(nil),                       // 46: initia.move.v1.DexPair
+	(*v1beta11.Coin)(nil),                 // 47: cosmos.base.v1beta1.Coin
 }
 var file_initia_move_v1_query_proto_depIdxs = []int32{
-	37, // 0: initia.move.v1.QueryModuleResponse.module:type_name -> initia.move.v1.Module
-	38, // 1: initia.move.v1.QueryModulesRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	37, // 2: initia.move.v1.QueryModulesResponse.modules:type_name -> initia.move.v1.Module
-	39, // 3: initia.move.v1.QueryModulesResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	40, // 4: initia.move.v1.QueryResourceResponse.resource:type_name -> initia.move.v1.Resource
-	38, // 5: initia.move.v1.QueryResourcesRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	40, // 6: initia.move.v1.QueryResourcesResponse.resources:type_name -> initia.move.v1.Resource
-	39, // 7: initia.move.v1.QueryResourcesResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	41, // 8: initia.move.v1.QueryTableInfoResponse.table_info:type_name -> initia.move.v1.TableInfo
-	42, // 9: initia.move.v1.QueryTableEntryResponse.table_entry:type_name -> initia.move.v1.TableEntry
-	38, // 10: initia.move.v1.QueryTableEntriesRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	42, // 11: initia.move.v1.QueryTableEntriesResponse.table_entries:type_name -> initia.move.v1.TableEntry
-	39, // 12: initia.move.v1.QueryTableEntriesResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	39, // 0: initia.move.v1.QueryModuleResponse.module:type_name -> initia.move.v1.Module
+	40, // 1: initia.move.v1.QueryModulesRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	39, // 2: initia.move.v1.QueryModulesResponse.modules:type_name -> initia.move.v1.Module
+	41, // 3: initia.move.v1.QueryModulesResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	42, // 4: initia.move.v1.QueryResourceResponse.resource:type_name -> initia.move.v1.Resource
+	40, // 5: initia.move.v1.QueryResourcesRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	42, // 6: initia.move.v1.QueryResourcesResponse.resources:type_name -> initia.move.v1.Resource
+	41, // 7: initia.move.v1.QueryResourcesResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	43, // 8: initia.move.v1.QueryTableInfoResponse.table_info:type_name -> initia.move.v1.TableInfo
+	44, // 9: initia.move.v1.QueryTableEntryResponse.table_entry:type_name -> initia.move.v1.TableEntry
+	40, // 10: initia.move.v1.QueryTableEntriesRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	44, // 11: initia.move.v1.QueryTableEntriesResponse.table_entries:type_name -> initia.move.v1.TableEntry
+	41, // 12: initia.move.v1.QueryTableEntriesResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
 	24, // 13: initia.move.v1.QueryLegacyViewResponse.events:type_name -> initia.move.v1.VMEvent
 	24, // 14: initia.move.v1.QueryViewResponse.events:type_name -> initia.move.v1.VMEvent
 	16, // 15: initia.move.v1.QueryViewBatchRequest.requests:type_name -> initia.move.v1.QueryViewRequest
@@ -20827,52 +22693,59 @@ var file_initia_move_v1_query_proto_depIdxs = []int32{
 	24, // 17: initia.move.v1.QueryViewJSONResponse.events:type_name -> initia.move.v1.VMEvent
 	20, // 18: initia.move.v1.QueryViewJSONBatchRequest.requests:type_name -> initia.move.v1.QueryViewJSONRequest
 	21, // 19: initia.move.v1.QueryViewJSONBatchResponse.responses:type_name -> initia.move.v1.QueryViewJSONResponse
-	43, // 20: initia.move.v1.QueryParamsResponse.params:type_name -> initia.move.v1.Params
-	44, // 21: initia.move.v1.QueryDexPairResponse.dex_pair:type_name -> initia.move.v1.DexPair
-	38, // 22: initia.move.v1.QueryDexPairsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	44, // 23: initia.move.v1.QueryDexPairsResponse.dex_pairs:type_name -> initia.move.v1.DexPair
-	39, // 24: initia.move.v1.QueryDexPairsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	0,  // 25: initia.move.v1.Query.Module:input_type -> initia.move.v1.QueryModuleRequest
-	2,  // 26: initia.move.v1.Query.Modules:input_type -> initia.move.v1.QueryModulesRequest
-	4,  // 27: initia.move.v1.Query.Resource:input_type -> initia.move.v1.QueryResourceRequest
-	6,  // 28: initia.move.v1.Query.Resources:input_type -> initia.move.v1.QueryResourcesRequest
-	8,  // 29: initia.move.v1.Query.TableInfo:input_type -> initia.move.v1.QueryTableInfoRequest
-	10, // 30: initia.move.v1.Query.TableEntry:input_type -> initia.move.v1.QueryTableEntryRequest
-	12, // 31: initia.move.v1.Query.TableEntries:input_type -> initia.move.v1.QueryTableEntriesRequest
-	14, // 32: initia.move.v1.Query.LegacyView:input_type -> initia.move.v1.QueryLegacyViewRequest
-	16, // 33: initia.move.v1.Query.View:input_type -> initia.move.v1.QueryViewRequest
-	18, // 34: initia.move.v1.Query.ViewBatch:input_type -> initia.move.v1.QueryViewBatchRequest
-	20, // 35: initia.move.v1.Query.ViewJSON:input_type -> initia.move.v1.QueryViewJSONRequest
-	22, // 36: initia.move.v1.Query.ViewJSONBatch:input_type -> initia.move.v1.QueryViewJSONBatchRequest
-	25, // 37: initia.move.v1.Query.ScriptABI:input_type -> initia.move.v1.QueryScriptABIRequest
-	27, // 38: initia.move.v1.Query.Params:input_type -> initia.move.v1.QueryParamsRequest
-	29, // 39: initia.move.v1.Query.Metadata:input_type -> initia.move.v1.QueryMetadataRequest
-	31, // 40: initia.move.v1.Query.Denom:input_type -> initia.move.v1.QueryDenomRequest
-	33, // 41: initia.move.v1.Query.DexPair:input_type -> initia.move.v1.QueryDexPairRequest
-	35, // 42: initia.move.v1.Query.DexPairs:input_type -> initia.move.v1.QueryDexPairsRequest
-	1,  // 43: initia.move.v1.Query.Module:output_type -> initia.move.v1.QueryModuleResponse
-	3,  // 44: initia.move.v1.Query.Modules:output_type -> initia.move.v1.QueryModulesResponse
-	5,  // 45: initia.move.v1.Query.Resource:output_type -> initia.move.v1.QueryResourceResponse
-	7,  // 46: initia.move.v1.Query.Resources:output_type -> initia.move.v1.QueryResourcesResponse
-	9,  // 47: initia.move.v1.Query.TableInfo:output_type -> initia.move.v1.QueryTableInfoResponse
-	11, // 48: initia.move.v1.Query.TableEntry:output_type -> initia.move.v1.QueryTableEntryResponse
-	13, // 49: initia.move.v1.Query.TableEntries:output_type -> initia.move.v1.QueryTableEntriesResponse
-	15, // 50: initia.move.v1.Query.LegacyView:output_type -> initia.move.v1.QueryLegacyViewResponse
-	17, // 51: initia.move.v1.Query.View:output_type -> initia.move.v1.QueryViewResponse
-	19, // 52: initia.move.v1.Query.ViewBatch:output_type -> initia.move.v1.QueryViewBatchResponse
-	21, // 53: initia.move.v1.Query.ViewJSON:output_type -> initia.move.v1.QueryViewJSONResponse
-	23, // 54: initia.move.v1.Query.ViewJSONBatch:output_type -> initia.move.v1.QueryViewJSONBatchResponse
-	26, // 55: initia.move.v1.Query.ScriptABI:output_type -> initia.move.v1.QueryScriptABIResponse
-	28, // 56: initia.move.v1.Query.Params:output_type -> initia.move.v1.QueryParamsResponse
-	30, // 57: initia.move.v1.Query.Metadata:output_type -> initia.move.v1.QueryMetadataResponse
-	32, // 58: initia.move.v1.Query.Denom:output_type -> initia.move.v1.QueryDenomResponse
-	34, // 59: initia.move.v1.Query.DexPair:output_type -> initia.move.v1.QueryDexPairResponse
-	36, // 60: initia.move.v1.Query.DexPairs:output_type -> initia.move.v1.QueryDexPairsResponse
-	43, // [43:61] is the sub-list for method output_type
-	25, // [25:43] is the sub-list for method input_type
-	25, // [25:25] is the sub-list for extension type_name
-	25, // [25:25] is the sub-list for extension extendee
-	0,  // [0:25] is the sub-list for field type_name
+	45, // 20: initia.move.v1.QueryParamsResponse.params:type_name -> initia.move.v1.Params
+	46, // 21: initia.move.v1.QueryDexPairResponse.dex_pair:type_name -> initia.move.v1.DexPair
+	40, // 22: initia.move.v1.QueryDexPairsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	46, // 23: initia.move.v1.QueryDexPairsResponse.dex_pairs:type_name -> initia.move.v1.DexPair
+	41, // 24: initia.move.v1.QueryDexPairsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	47, // 25: initia.move.v1.QueryBalanceBreakdownResponse.liquid:type_name -> cosmos.base.v1beta1.Coin
+	47, // 26: initia.move.v1.QueryBalanceBreakdownResponse.vesting_locked:type_name -> cosmos.base.v1beta1.Coin
+	47, // 27: initia.move.v1.QueryBalanceBreakdownResponse.vesting_claimable:type_name -> cosmos.base.v1beta1.Coin
+	47, // 28: initia.move.v1.QueryBalanceBreakdownResponse.delegated:type_name -> cosmos.base.v1beta1.Coin
+	47, // 29: initia.move.v1.QueryBalanceBreakdownResponse.unbonding:type_name -> cosmos.base.v1beta1.Coin
+	0,  // 30: initia.move.v1.Query.Module:input_type -> initia.move.v1.QueryModuleRequest
+	2,  // 31: initia.move.v1.Query.Modules:input_type -> initia.move.v1.QueryModulesRequest
+	4,  // 32: initia.move.v1.Query.Resource:input_type -> initia.move.v1.QueryResourceRequest
+	6,  // 33: initia.move.v1.Query.Resources:input_type -> initia.move.v1.QueryResourcesRequest
+	8,  // 34: initia.move.v1.Query.TableInfo:input_type -> initia.move.v1.QueryTableInfoRequest
+	10, // 35: initia.move.v1.Query.TableEntry:input_type -> initia.move.v1.QueryTableEntryRequest
+	12, // 36: initia.move.v1.Query.TableEntries:input_type -> initia.move.v1.QueryTableEntriesRequest
+	14, // 37: initia.move.v1.Query.LegacyView:input_type -> initia.move.v1.QueryLegacyViewRequest
+	16, // 38: initia.move.v1.Query.View:input_type -> initia.move.v1.QueryViewRequest
+	18, // 39: initia.move.v1.Query.ViewBatch:input_type -> initia.move.v1.QueryViewBatchRequest
+	20, // 40: initia.move.v1.Query.ViewJSON:input_type -> initia.move.v1.QueryViewJSONRequest
+	22, // 41: initia.move.v1.Query.ViewJSONBatch:input_type -> initia.move.v1.QueryViewJSONBatchRequest
+	25, // 42: initia.move.v1.Query.ScriptABI:input_type -> initia.move.v1.QueryScriptABIRequest
+	27, // 43: initia.move.v1.Query.Params:input_type -> initia.move.v1.QueryParamsRequest
+	29, // 44: initia.move.v1.Query.Metadata:input_type -> initia.move.v1.QueryMetadataRequest
+	31, // 45: initia.move.v1.Query.Denom:input_type -> initia.move.v1.QueryDenomRequest
+	33, // 46: initia.move.v1.Query.DexPair:input_type -> initia.move.v1.QueryDexPairRequest
+	35, // 47: initia.move.v1.Query.DexPairs:input_type -> initia.move.v1.QueryDexPairsRequest
+	37, // 48: initia.move.v1.Query.BalanceBreakdown:input_type -> initia.move.v1.QueryBalanceBreakdownRequest
+	1,  // 49: initia.move.v1.Query.Module:output_type -> initia.move.v1.QueryModuleResponse
+	3,  // 50: initia.move.v1.Query.Modules:output_type -> initia.move.v1.QueryModulesResponse
+	5,  // 51: initia.move.v1.Query.Resource:output_type -> initia.move.v1.QueryResourceResponse
+	7,  // 52: initia.move.v1.Query.Resources:output_type -> initia.move.v1.QueryResourcesResponse
+	9,  // 53: initia.move.v1.Query.TableInfo:output_type -> initia.move.v1.QueryTableInfoResponse
+	11, // 54: initia.move.v1.Query.TableEntry:output_type -> initia.move.v1.QueryTableEntryResponse
+	13, // 55: initia.move.v1.Query.TableEntries:output_type -> initia.move.v1.QueryTableEntriesResponse
+	15, // 56: initia.move.v1.Query.LegacyView:output_type -> initia.move.v1.QueryLegacyViewResponse
+	17, // 57: initia.move.v1.Query.View:output_type -> initia.move.v1.QueryViewResponse
+	19, // 58: initia.move.v1.Query.ViewBatch:output_type -> initia.move.v1.QueryViewBatchResponse
+	21, // 59: initia.move.v1.Query.ViewJSON:output_type -> initia.move.v1.QueryViewJSONResponse
+	23, // 60: initia.move.v1.Query.ViewJSONBatch:output_type -> initia.move.v1.QueryViewJSONBatchResponse
+	26, // 61: initia.move.v1.Query.ScriptABI:output_type -> initia.move.v1.QueryScriptABIResponse
+	28, // 62: initia.move.v1.Query.Params:output_type -> initia.move.v1.QueryParamsResponse
+	30, // 63: initia.move.v1.Query.Metadata:output_type -> initia.move.v1.QueryMetadataResponse
+	32, // 64: initia.move.v1.Query.Denom:output_type -> initia.move.v1.QueryDenomResponse
+	34, // 65: initia.move.v1.Query.DexPair:output_type -> initia.move.v1.QueryDexPairResponse
+	36, // 66: initia.move.v1.Query.DexPairs:output_type -> initia.move.v1.QueryDexPairsResponse
+	38, // 67: initia.move.v1.Query.BalanceBreakdown:output_type -> initia.move.v1.QueryBalanceBreakdownResponse
+	49, // [49:68] is the sub-list for method output_type
+	30, // [30:49] is the sub-list for method input_type
+	30, // [30:30] is the sub-list for extension type_name
+	30, // [30:30] is the sub-list for extension extendee
+	0,  // [0:30] is the sub-list for field type_name
 }
 
 func init() { file_initia_move_v1_query_proto_init() }
@@ -21326,6 +23199,30 @@ func file_initia_move_v1_query_proto_init() {
 				return nil
 			}
 		}
+		file_initia_move_v1_query_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryBalanceBreakdownRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_move_v1_query_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryBalanceBreakdownResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -21333,7 +23230,7 @@ func file_initia_move_v1_query_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_initia_move_v1_query_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   37,
+			NumMessages:   39,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,24 +19,25 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_Module_FullMethodName        = "/initia.move.v1.Query/Module"
-	Query_Modules_FullMethodName       = "/initia.move.v1.Query/Modules"
-	Query_Resource_FullMethodName      = "/initia.move.v1.Query/Resource"
-	Query_Resources_FullMethodName     = "/initia.move.v1.Query/Resources"
-	Query_TableInfo_FullMethodName     = "/initia.move.v1.Query/TableInfo"
-	Query_TableEntry_FullMethodName    = "/initia.move.v1.Query/TableEntry"
-	Query_TableEntries_FullMethodName  = "/initia.move.v1.Query/TableEntries"
-	Query_LegacyView_FullMethodName    = "/initia.move.v1.Query/LegacyView"
-	Query_View_FullMethodName          = "/initia.move.v1.Query/View"
-	Query_ViewBatch_FullMethodName     = "/initia.move.v1.Query/ViewBatch"
-	Query_ViewJSON_FullMethodName      = "/initia.move.v1.Query/ViewJSON"
-	Query_ViewJSONBatch_FullMethodName = "/initia.move.v1.Query/ViewJSONBatch"
-	Query_ScriptABI_FullMethodName     = "/initia.move.v1.Query/ScriptABI"
-	Query_Params_FullMethodName        = "/initia.move.v1.Query/Params"
-	Query_Metadata_FullMethodName      = "/initia.move.v1.Query/Metadata"
-	Query_Denom_FullMethodName         = "/initia.move.v1.Query/Denom"
-	Query_DexPair_FullMethodName       = "/initia.move.v1.Query/DexPair"
-	Query_DexPairs_FullMethodName      = "/initia.move.v1.Query/DexPairs"
+	Query_Module_FullMethodName           = "/initia.move.v1.Query/Module"
+	Query_Modules_FullMethodName          = "/initia.move.v1.Query/Modules"
+	Query_Resource_FullMethodName         = "/initia.move.v1.Query/Resource"
+	Query_Resources_FullMethodName        = "/initia.move.v1.Query/Resources"
+	Query_TableInfo_FullMethodName        = "/initia.move.v1.Query/TableInfo"
+	Query_TableEntry_FullMethodName       = "/initia.move.v1.Query/TableEntry"
+	Query_TableEntries_FullMethodName     = "/initia.move.v1.Query/TableEntries"
+	Query_LegacyView_FullMethodName       = "/initia.move.v1.Query/LegacyView"
+	Query_View_FullMethodName             = "/initia.move.v1.Query/View"
+	Query_ViewBatch_FullMethodName        = "/initia.move.v1.Query/ViewBatch"
+	Query_ViewJSON_FullMethodName         = "/initia.move.v1.Query/ViewJSON"
+	Query_ViewJSONBatch_FullMethodName    = "/initia.move.v1.Query/ViewJSONBatch"
+	Query_ScriptABI_FullMethodName        = "/initia.move.v1.Query/ScriptABI"
+	Query_Params_FullMethodName           = "/initia.move.v1.Query/Params"
+	Query_Metadata_FullMethodName         = "/initia.move.v1.Query/Metadata"
+	Query_Denom_FullMethodName            = "/initia.move.v1.Query/Denom"
+	Query_DexPair_FullMethodName          = "/initia.move.v1.Query/DexPair"
+	Query_DexPairs_FullMethodName         = "/initia.move.v1.Query/DexPairs"
+	Query_BalanceBreakdown_FullMethodName = "/initia.move.v1.Query/BalanceBreakdown"
 )
 
 // QueryClient is the client API for Query service.
@@ -85,6 +86,9 @@ type QueryClient interface {
 	DexPair(ctx context.Context, in *QueryDexPairRequest, opts ...grpc.CallOption) (*QueryDexPairResponse, error)
 	// DexPairs queries all dex pairs.
 	DexPairs(ctx context.Context, in *QueryDexPairsRequest, opts ...grpc.CallOption) (*QueryDexPairsResponse, error)
+	// BalanceBreakdown queries the liquid, vesting, delegated and unbonding
+	// amounts of an account.
+	BalanceBreakdown(ctx context.Context, in *QueryBalanceBreakdownRequest, opts ...grpc.CallOption) (*QueryBalanceBreakdownResponse, error)
 }
 
 type queryClient struct {
@@ -276,6 +280,16 @@ func (c *queryClient) DexPairs(ctx context.Context, in *QueryDexPairsRequest, op
 	return out, nil
 }
 
+func (c *queryClient) BalanceBreakdown(ctx context.Context, in *QueryBalanceBreakdownRequest, opts ...grpc.CallOption) (*QueryBalanceBreakdownResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryBalanceBreakdownResponse)
+	err := c.cc.Invoke(ctx, Query_BalanceBreakdown_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -322,6 +336,9 @@ type QueryServer interface {
 	DexPair(context.Context, *QueryDexPairRequest) (*QueryDexPairResponse, error)
 	// DexPairs queries all dex pairs.
 	DexPairs(context.Context, *QueryDexPairsRequest) (*QueryDexPairsResponse, error)
+	// BalanceBreakdown queries the liquid, vesting, delegated and unbonding
+	// amounts of an account.
+	BalanceBreakdown(context.Context, *QueryBalanceBreakdownRequest) (*QueryBalanceBreakdownResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -386,6 +403,9 @@ func (UnimplementedQueryServer) DexPair(context.Context, *QueryDexPairRequest) (
 func (UnimplementedQueryServer) DexPairs(context.Context, *QueryDexPairsRequest) (*QueryDexPairsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DexPairs not implemented")
 }
+func (UnimplementedQueryServer) BalanceBreakdown(context.Context, *QueryBalanceBreakdownRequest) (*QueryBalanceBreakdownResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BalanceBreakdown not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -731,6 +751,24 @@ func _Query_DexPairs_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BalanceBreakdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBalanceBreakdownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BalanceBreakdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BalanceBreakdown_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BalanceBreakdown(ctx, req.(*QueryBalanceBreakdownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -810,6 +848,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DexPairs",
 			Handler:    _Query_DexPairs_Handler,
 		},
+		{
+			MethodName: "BalanceBreakdown",
+			Handler:    _Query_BalanceBreakdown_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "initia/move/v1/query.proto",
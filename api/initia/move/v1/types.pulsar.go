@@ -10,6 +10,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	reflect "reflect"
 	sync "sync"
@@ -69,6 +70,8 @@ var (
 	fd_Params_script_enabled                protoreflect.FieldDescriptor
 	fd_Params_allowed_publishers            protoreflect.FieldDescriptor
 	fd_Params_clamm_module_address          protoreflect.FieldDescriptor
+	fd_Params_twap_window                   protoreflect.FieldDescriptor
+	fd_Params_voting_power_use_twap         protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -80,6 +83,8 @@ func init() {
 	fd_Params_script_enabled = md_Params.Fields().ByName("script_enabled")
 	fd_Params_allowed_publishers = md_Params.Fields().ByName("allowed_publishers")
 	fd_Params_clamm_module_address = md_Params.Fields().ByName("clamm_module_address")
+	fd_Params_twap_window = md_Params.Fields().ByName("twap_window")
+	fd_Params_voting_power_use_twap = md_Params.Fields().ByName("voting_power_use_twap")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -183,6 +188,18 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.TwapWindow != nil {
+		value := protoreflect.ValueOfMessage(x.TwapWindow.ProtoReflect())
+		if !f(fd_Params_twap_window, value) {
+			return
+		}
+	}
+	if x.VotingPowerUseTwap != false {
+		value := protoreflect.ValueOfBool(x.VotingPowerUseTwap)
+		if !f(fd_Params_voting_power_use_twap, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -210,6 +227,10 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return len(x.AllowedPublishers) != 0
 	case "initia.move.v1.Params.clamm_module_address":
 		return x.ClammModuleAddress != ""
+	case "initia.move.v1.Params.twap_window":
+		return x.TwapWindow != nil
+	case "initia.move.v1.Params.voting_power_use_twap":
+		return x.VotingPowerUseTwap != false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.Params"))
@@ -238,6 +259,10 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.AllowedPublishers = nil
 	case "initia.move.v1.Params.clamm_module_address":
 		x.ClammModuleAddress = ""
+	case "initia.move.v1.Params.twap_window":
+		x.TwapWindow = nil
+	case "initia.move.v1.Params.voting_power_use_twap":
+		x.VotingPowerUseTwap = false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.Params"))
@@ -275,6 +300,12 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "initia.move.v1.Params.clamm_module_address":
 		value := x.ClammModuleAddress
 		return protoreflect.ValueOfString(value)
+	case "initia.move.v1.Params.twap_window":
+		value := x.TwapWindow
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "initia.move.v1.Params.voting_power_use_twap":
+		value := x.VotingPowerUseTwap
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.Params"))
@@ -309,6 +340,10 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.AllowedPublishers = *clv.list
 	case "initia.move.v1.Params.clamm_module_address":
 		x.ClammModuleAddress = value.Interface().(string)
+	case "initia.move.v1.Params.twap_window":
+		x.TwapWindow = value.Message().Interface().(*durationpb.Duration)
+	case "initia.move.v1.Params.voting_power_use_twap":
+		x.VotingPowerUseTwap = value.Bool()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.Params"))
@@ -335,6 +370,11 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		}
 		value := &_Params_5_list{list: &x.AllowedPublishers}
 		return protoreflect.ValueOfList(value)
+	case "initia.move.v1.Params.twap_window":
+		if x.TwapWindow == nil {
+			x.TwapWindow = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.TwapWindow.ProtoReflect())
 	case "initia.move.v1.Params.base_denom":
 		panic(fmt.Errorf("field base_denom of message initia.move.v1.Params is not mutable"))
 	case "initia.move.v1.Params.base_min_gas_price":
@@ -345,6 +385,8 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field script_enabled of message initia.move.v1.Params is not mutable"))
 	case "initia.move.v1.Params.clamm_module_address":
 		panic(fmt.Errorf("field clamm_module_address of message initia.move.v1.Params is not mutable"))
+	case "initia.move.v1.Params.voting_power_use_twap":
+		panic(fmt.Errorf("field voting_power_use_twap of message initia.move.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.Params"))
@@ -371,6 +413,11 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfList(&_Params_5_list{list: &list})
 	case "initia.move.v1.Params.clamm_module_address":
 		return protoreflect.ValueOfString("")
+	case "initia.move.v1.Params.twap_window":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "initia.move.v1.Params.voting_power_use_twap":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.Params"))
@@ -465,6 +512,13 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.TwapWindow != nil {
+			l = options.Size(x.TwapWindow)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.VotingPowerUseTwap {
+			n += 2
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -494,6 +548,30 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.VotingPowerUseTwap {
+			i--
+			if x.VotingPowerUseTwap {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.TwapWindow != nil {
+			encoded, err := options.Marshal(x.TwapWindow)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
 		if len(x.ClammModuleAddress) > 0 {
 			i -= len(x.ClammModuleAddress)
 			copy(dAtA[i:], x.ClammModuleAddress)
@@ -770,6 +848,62 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				}
 				x.ClammModuleAddress = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TwapWindow", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.TwapWindow == nil {
+					x.TwapWindow = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TwapWindow); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VotingPowerUseTwap", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.VotingPowerUseTwap = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -812,6 +946,8 @@ var (
 	fd_RawParams_contract_shared_revenue_ratio protoreflect.FieldDescriptor
 	fd_RawParams_script_enabled                protoreflect.FieldDescriptor
 	fd_RawParams_clamm_module_address          protoreflect.FieldDescriptor
+	fd_RawParams_twap_window                   protoreflect.FieldDescriptor
+	fd_RawParams_voting_power_use_twap         protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -822,6 +958,8 @@ func init() {
 	fd_RawParams_contract_shared_revenue_ratio = md_RawParams.Fields().ByName("contract_shared_revenue_ratio")
 	fd_RawParams_script_enabled = md_RawParams.Fields().ByName("script_enabled")
 	fd_RawParams_clamm_module_address = md_RawParams.Fields().ByName("clamm_module_address")
+	fd_RawParams_twap_window = md_RawParams.Fields().ByName("twap_window")
+	fd_RawParams_voting_power_use_twap = md_RawParams.Fields().ByName("voting_power_use_twap")
 }
 
 var _ protoreflect.Message = (*fastReflection_RawParams)(nil)
@@ -919,6 +1057,18 @@ func (x *fastReflection_RawParams) Range(f func(protoreflect.FieldDescriptor, pr
 			return
 		}
 	}
+	if x.TwapWindow != nil {
+		value := protoreflect.ValueOfMessage(x.TwapWindow.ProtoReflect())
+		if !f(fd_RawParams_twap_window, value) {
+			return
+		}
+	}
+	if x.VotingPowerUseTwap != false {
+		value := protoreflect.ValueOfBool(x.VotingPowerUseTwap)
+		if !f(fd_RawParams_voting_power_use_twap, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -944,6 +1094,10 @@ func (x *fastReflection_RawParams) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.ScriptEnabled != false
 	case "initia.move.v1.RawParams.clamm_module_address":
 		return x.ClammModuleAddress != ""
+	case "initia.move.v1.RawParams.twap_window":
+		return x.TwapWindow != nil
+	case "initia.move.v1.RawParams.voting_power_use_twap":
+		return x.VotingPowerUseTwap != false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.RawParams"))
@@ -970,6 +1124,10 @@ func (x *fastReflection_RawParams) Clear(fd protoreflect.FieldDescriptor) {
 		x.ScriptEnabled = false
 	case "initia.move.v1.RawParams.clamm_module_address":
 		x.ClammModuleAddress = ""
+	case "initia.move.v1.RawParams.twap_window":
+		x.TwapWindow = nil
+	case "initia.move.v1.RawParams.voting_power_use_twap":
+		x.VotingPowerUseTwap = false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.RawParams"))
@@ -1001,6 +1159,12 @@ func (x *fastReflection_RawParams) Get(descriptor protoreflect.FieldDescriptor)
 	case "initia.move.v1.RawParams.clamm_module_address":
 		value := x.ClammModuleAddress
 		return protoreflect.ValueOfString(value)
+	case "initia.move.v1.RawParams.twap_window":
+		value := x.TwapWindow
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "initia.move.v1.RawParams.voting_power_use_twap":
+		value := x.VotingPowerUseTwap
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.RawParams"))
@@ -1031,6 +1195,10 @@ func (x *fastReflection_RawParams) Set(fd protoreflect.FieldDescriptor, value pr
 		x.ScriptEnabled = value.Bool()
 	case "initia.move.v1.RawParams.clamm_module_address":
 		x.ClammModuleAddress = value.Interface().(string)
+	case "initia.move.v1.RawParams.twap_window":
+		x.TwapWindow = value.Message().Interface().(*durationpb.Duration)
+	case "initia.move.v1.RawParams.voting_power_use_twap":
+		x.VotingPowerUseTwap = value.Bool()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.RawParams"))
@@ -1051,6 +1219,11 @@ func (x *fastReflection_RawParams) Set(fd protoreflect.FieldDescriptor, value pr
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_RawParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "initia.move.v1.RawParams.twap_window":
+		if x.TwapWindow == nil {
+			x.TwapWindow = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.TwapWindow.ProtoReflect())
 	case "initia.move.v1.RawParams.base_denom":
 		panic(fmt.Errorf("field base_denom of message initia.move.v1.RawParams is not mutable"))
 	case "initia.move.v1.RawParams.base_min_gas_price":
@@ -1061,6 +1234,8 @@ func (x *fastReflection_RawParams) Mutable(fd protoreflect.FieldDescriptor) prot
 		panic(fmt.Errorf("field script_enabled of message initia.move.v1.RawParams is not mutable"))
 	case "initia.move.v1.RawParams.clamm_module_address":
 		panic(fmt.Errorf("field clamm_module_address of message initia.move.v1.RawParams is not mutable"))
+	case "initia.move.v1.RawParams.voting_power_use_twap":
+		panic(fmt.Errorf("field voting_power_use_twap of message initia.move.v1.RawParams is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.RawParams"))
@@ -1084,6 +1259,11 @@ func (x *fastReflection_RawParams) NewField(fd protoreflect.FieldDescriptor) pro
 		return protoreflect.ValueOfBool(false)
 	case "initia.move.v1.RawParams.clamm_module_address":
 		return protoreflect.ValueOfString("")
+	case "initia.move.v1.RawParams.twap_window":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "initia.move.v1.RawParams.voting_power_use_twap":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.RawParams"))
@@ -1172,6 +1352,13 @@ func (x *fastReflection_RawParams) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.TwapWindow != nil {
+			l = options.Size(x.TwapWindow)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.VotingPowerUseTwap {
+			n += 2
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1201,6 +1388,30 @@ func (x *fastReflection_RawParams) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.VotingPowerUseTwap {
+			i--
+			if x.VotingPowerUseTwap {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.TwapWindow != nil {
+			encoded, err := options.Marshal(x.TwapWindow)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
 		if len(x.ClammModuleAddress) > 0 {
 			i -= len(x.ClammModuleAddress)
 			copy(dAtA[i:], x.ClammModuleAddress)
@@ -1436,6 +1647,62 @@ func (x *fastReflection_RawParams) ProtoMethods() *protoiface.Methods {
 				}
 				x.ClammModuleAddress = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TwapWindow", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.TwapWindow == nil {
+					x.TwapWindow = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TwapWindow); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VotingPowerUseTwap", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.VotingPowerUseTwap = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -6021,133 +6288,751 @@ func (x *fastReflection_ExecuteAuthorizationItem) ProtoMethods() *protoiface.Met
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: initia/move/v1/types.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
-
-// UpgradePolicy is the policy for upgrading a move module.
-type UpgradePolicy int32
-
-const (
-	// UNSPECIFIED: a placeholder for an unspecified upgrade policy.
-	UpgradePolicy_UNSPECIFIED UpgradePolicy = 0
-	// COMPATIBLE: Whether a compatibility check should be performed for upgrades. The check only passes if
-	// a new module has (a) the same public functions (b) for existing resources, no layout change.
-	UpgradePolicy_COMPATIBLE UpgradePolicy = 1
-	// IMMUTABLE: Whether the modules in the package are immutable and cannot be upgraded.
-	UpgradePolicy_IMMUTABLE UpgradePolicy = 2
-)
-
-// Enum value maps for UpgradePolicy.
 var (
-	UpgradePolicy_name = map[int32]string{
-		0: "UNSPECIFIED",
-		1: "COMPATIBLE",
-		2: "IMMUTABLE",
-	}
-	UpgradePolicy_value = map[string]int32{
-		"UNSPECIFIED": 0,
-		"COMPATIBLE":  1,
-		"IMMUTABLE":   2,
-	}
+	md_TWAPObservation                            protoreflect.MessageDescriptor
+	fd_TWAPObservation_base_spot_price            protoreflect.FieldDescriptor
+	fd_TWAPObservation_lp_base_weight             protoreflect.FieldDescriptor
+	fd_TWAPObservation_cumulative_base_spot_price protoreflect.FieldDescriptor
+	fd_TWAPObservation_cumulative_lp_base_weight  protoreflect.FieldDescriptor
 )
 
-func (x UpgradePolicy) Enum() *UpgradePolicy {
-	p := new(UpgradePolicy)
-	*p = x
-	return p
+func init() {
+	file_initia_move_v1_types_proto_init()
+	md_TWAPObservation = File_initia_move_v1_types_proto.Messages().ByName("TWAPObservation")
+	fd_TWAPObservation_base_spot_price = md_TWAPObservation.Fields().ByName("base_spot_price")
+	fd_TWAPObservation_lp_base_weight = md_TWAPObservation.Fields().ByName("lp_base_weight")
+	fd_TWAPObservation_cumulative_base_spot_price = md_TWAPObservation.Fields().ByName("cumulative_base_spot_price")
+	fd_TWAPObservation_cumulative_lp_base_weight = md_TWAPObservation.Fields().ByName("cumulative_lp_base_weight")
 }
 
-func (x UpgradePolicy) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
-}
+var _ protoreflect.Message = (*fastReflection_TWAPObservation)(nil)
 
-func (UpgradePolicy) Descriptor() protoreflect.EnumDescriptor {
-	return file_initia_move_v1_types_proto_enumTypes[0].Descriptor()
-}
+type fastReflection_TWAPObservation TWAPObservation
 
-func (UpgradePolicy) Type() protoreflect.EnumType {
-	return &file_initia_move_v1_types_proto_enumTypes[0]
+func (x *TWAPObservation) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TWAPObservation)(x)
 }
 
-func (x UpgradePolicy) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+func (x *TWAPObservation) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_move_v1_types_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpgradePolicy.Descriptor instead.
-func (UpgradePolicy) EnumDescriptor() ([]byte, []int) {
-	return file_initia_move_v1_types_proto_rawDescGZIP(), []int{0}
-}
+var _fastReflection_TWAPObservation_messageType fastReflection_TWAPObservation_messageType
+var _ protoreflect.MessageType = fastReflection_TWAPObservation_messageType{}
 
-// Params defines the set of move parameters.
-type Params struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type fastReflection_TWAPObservation_messageType struct{}
 
-	BaseDenom string `protobuf:"bytes,1,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
-	// Deprecated: Do not use.
-	BaseMinGasPrice string `protobuf:"bytes,2,opt,name=base_min_gas_price,json=baseMinGasPrice,proto3" json:"base_min_gas_price,omitempty"`
-	// CSR: Percentage of fees distributed to developers
-	ContractSharedRevenueRatio string `protobuf:"bytes,3,opt,name=contract_shared_revenue_ratio,json=contractSharedRevenueRatio,proto3" json:"contract_shared_revenue_ratio,omitempty"`
-	// flag whether to enable script execution
-	ScriptEnabled bool `protobuf:"varint,4,opt,name=script_enabled,json=scriptEnabled,proto3" json:"script_enabled,omitempty"`
-	// It is a list of addresses with permission to distribute contracts,
-	// and an empty list is interpreted as allowing anyone to distribute.
-	AllowedPublishers []string `protobuf:"bytes,5,rep,name=allowed_publishers,json=allowedPublishers,proto3" json:"allowed_publishers,omitempty"`
-	// CLAMM module address
-	ClammModuleAddress string `protobuf:"bytes,6,opt,name=clamm_module_address,json=clammModuleAddress,proto3" json:"clamm_module_address,omitempty"`
+func (x fastReflection_TWAPObservation_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TWAPObservation)(nil)
 }
-
-func (x *Params) Reset() {
-	*x = Params{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_initia_move_v1_types_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x fastReflection_TWAPObservation_messageType) New() protoreflect.Message {
+	return new(fastReflection_TWAPObservation)
 }
-
-func (x *Params) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x fastReflection_TWAPObservation_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TWAPObservation
 }
 
-func (*Params) ProtoMessage() {}
-
-// Deprecated: Use Params.ProtoReflect.Descriptor instead.
-func (*Params) Descriptor() ([]byte, []int) {
-	return file_initia_move_v1_types_proto_rawDescGZIP(), []int{0}
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TWAPObservation) Descriptor() protoreflect.MessageDescriptor {
+	return md_TWAPObservation
 }
 
-func (x *Params) GetBaseDenom() string {
-	if x != nil {
-		return x.BaseDenom
-	}
-	return ""
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TWAPObservation) Type() protoreflect.MessageType {
+	return _fastReflection_TWAPObservation_messageType
 }
 
-// Deprecated: Do not use.
-func (x *Params) GetBaseMinGasPrice() string {
-	if x != nil {
-		return x.BaseMinGasPrice
-	}
-	return ""
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TWAPObservation) New() protoreflect.Message {
+	return new(fastReflection_TWAPObservation)
 }
 
-func (x *Params) GetContractSharedRevenueRatio() string {
-	if x != nil {
-		return x.ContractSharedRevenueRatio
-	}
-	return ""
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TWAPObservation) Interface() protoreflect.ProtoMessage {
+	return (*TWAPObservation)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TWAPObservation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BaseSpotPrice != "" {
+		value := protoreflect.ValueOfString(x.BaseSpotPrice)
+		if !f(fd_TWAPObservation_base_spot_price, value) {
+			return
+		}
+	}
+	if x.LpBaseWeight != "" {
+		value := protoreflect.ValueOfString(x.LpBaseWeight)
+		if !f(fd_TWAPObservation_lp_base_weight, value) {
+			return
+		}
+	}
+	if x.CumulativeBaseSpotPrice != "" {
+		value := protoreflect.ValueOfString(x.CumulativeBaseSpotPrice)
+		if !f(fd_TWAPObservation_cumulative_base_spot_price, value) {
+			return
+		}
+	}
+	if x.CumulativeLpBaseWeight != "" {
+		value := protoreflect.ValueOfString(x.CumulativeLpBaseWeight)
+		if !f(fd_TWAPObservation_cumulative_lp_base_weight, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TWAPObservation) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.move.v1.TWAPObservation.base_spot_price":
+		return x.BaseSpotPrice != ""
+	case "initia.move.v1.TWAPObservation.lp_base_weight":
+		return x.LpBaseWeight != ""
+	case "initia.move.v1.TWAPObservation.cumulative_base_spot_price":
+		return x.CumulativeBaseSpotPrice != ""
+	case "initia.move.v1.TWAPObservation.cumulative_lp_base_weight":
+		return x.CumulativeLpBaseWeight != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.TWAPObservation"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.TWAPObservation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TWAPObservation) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.move.v1.TWAPObservation.base_spot_price":
+		x.BaseSpotPrice = ""
+	case "initia.move.v1.TWAPObservation.lp_base_weight":
+		x.LpBaseWeight = ""
+	case "initia.move.v1.TWAPObservation.cumulative_base_spot_price":
+		x.CumulativeBaseSpotPrice = ""
+	case "initia.move.v1.TWAPObservation.cumulative_lp_base_weight":
+		x.CumulativeLpBaseWeight = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.TWAPObservation"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.TWAPObservation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TWAPObservation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.move.v1.TWAPObservation.base_spot_price":
+		value := x.BaseSpotPrice
+		return protoreflect.ValueOfString(value)
+	case "initia.move.v1.TWAPObservation.lp_base_weight":
+		value := x.LpBaseWeight
+		return protoreflect.ValueOfString(value)
+	case "initia.move.v1.TWAPObservation.cumulative_base_spot_price":
+		value := x.CumulativeBaseSpotPrice
+		return protoreflect.ValueOfString(value)
+	case "initia.move.v1.TWAPObservation.cumulative_lp_base_weight":
+		value := x.CumulativeLpBaseWeight
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.TWAPObservation"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.TWAPObservation does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TWAPObservation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.move.v1.TWAPObservation.base_spot_price":
+		x.BaseSpotPrice = value.Interface().(string)
+	case "initia.move.v1.TWAPObservation.lp_base_weight":
+		x.LpBaseWeight = value.Interface().(string)
+	case "initia.move.v1.TWAPObservation.cumulative_base_spot_price":
+		x.CumulativeBaseSpotPrice = value.Interface().(string)
+	case "initia.move.v1.TWAPObservation.cumulative_lp_base_weight":
+		x.CumulativeLpBaseWeight = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.TWAPObservation"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.TWAPObservation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TWAPObservation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.move.v1.TWAPObservation.base_spot_price":
+		panic(fmt.Errorf("field base_spot_price of message initia.move.v1.TWAPObservation is not mutable"))
+	case "initia.move.v1.TWAPObservation.lp_base_weight":
+		panic(fmt.Errorf("field lp_base_weight of message initia.move.v1.TWAPObservation is not mutable"))
+	case "initia.move.v1.TWAPObservation.cumulative_base_spot_price":
+		panic(fmt.Errorf("field cumulative_base_spot_price of message initia.move.v1.TWAPObservation is not mutable"))
+	case "initia.move.v1.TWAPObservation.cumulative_lp_base_weight":
+		panic(fmt.Errorf("field cumulative_lp_base_weight of message initia.move.v1.TWAPObservation is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.TWAPObservation"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.TWAPObservation does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TWAPObservation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.move.v1.TWAPObservation.base_spot_price":
+		return protoreflect.ValueOfString("")
+	case "initia.move.v1.TWAPObservation.lp_base_weight":
+		return protoreflect.ValueOfString("")
+	case "initia.move.v1.TWAPObservation.cumulative_base_spot_price":
+		return protoreflect.ValueOfString("")
+	case "initia.move.v1.TWAPObservation.cumulative_lp_base_weight":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.TWAPObservation"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.TWAPObservation does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TWAPObservation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.move.v1.TWAPObservation", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TWAPObservation) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TWAPObservation) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TWAPObservation) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TWAPObservation) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TWAPObservation)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.BaseSpotPrice)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.LpBaseWeight)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.CumulativeBaseSpotPrice)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.CumulativeLpBaseWeight)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TWAPObservation)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.CumulativeLpBaseWeight) > 0 {
+			i -= len(x.CumulativeLpBaseWeight)
+			copy(dAtA[i:], x.CumulativeLpBaseWeight)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CumulativeLpBaseWeight)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.CumulativeBaseSpotPrice) > 0 {
+			i -= len(x.CumulativeBaseSpotPrice)
+			copy(dAtA[i:], x.CumulativeBaseSpotPrice)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CumulativeBaseSpotPrice)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.LpBaseWeight) > 0 {
+			i -= len(x.LpBaseWeight)
+			copy(dAtA[i:], x.LpBaseWeight)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LpBaseWeight)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.BaseSpotPrice) > 0 {
+			i -= len(x.BaseSpotPrice)
+			copy(dAtA[i:], x.BaseSpotPrice)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BaseSpotPrice)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TWAPObservation)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TWAPObservation: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TWAPObservation: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseSpotPrice", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BaseSpotPrice = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LpBaseWeight", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LpBaseWeight = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CumulativeBaseSpotPrice", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CumulativeBaseSpotPrice = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CumulativeLpBaseWeight", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CumulativeLpBaseWeight = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: initia/move/v1/types.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// UpgradePolicy is the policy for upgrading a move module.
+type UpgradePolicy int32
+
+const (
+	// UNSPECIFIED: a placeholder for an unspecified upgrade policy.
+	UpgradePolicy_UNSPECIFIED UpgradePolicy = 0
+	// COMPATIBLE: Whether a compatibility check should be performed for upgrades. The check only passes if
+	// a new module has (a) the same public functions (b) for existing resources, no layout change.
+	UpgradePolicy_COMPATIBLE UpgradePolicy = 1
+	// IMMUTABLE: Whether the modules in the package are immutable and cannot be upgraded.
+	UpgradePolicy_IMMUTABLE UpgradePolicy = 2
+)
+
+// Enum value maps for UpgradePolicy.
+var (
+	UpgradePolicy_name = map[int32]string{
+		0: "UNSPECIFIED",
+		1: "COMPATIBLE",
+		2: "IMMUTABLE",
+	}
+	UpgradePolicy_value = map[string]int32{
+		"UNSPECIFIED": 0,
+		"COMPATIBLE":  1,
+		"IMMUTABLE":   2,
+	}
+)
+
+func (x UpgradePolicy) Enum() *UpgradePolicy {
+	p := new(UpgradePolicy)
+	*p = x
+	return p
+}
+
+func (x UpgradePolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UpgradePolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_initia_move_v1_types_proto_enumTypes[0].Descriptor()
+}
+
+func (UpgradePolicy) Type() protoreflect.EnumType {
+	return &file_initia_move_v1_types_proto_enumTypes[0]
+}
+
+func (x UpgradePolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UpgradePolicy.Descriptor instead.
+func (UpgradePolicy) EnumDescriptor() ([]byte, []int) {
+	return file_initia_move_v1_types_proto_rawDescGZIP(), []int{0}
+}
+
+// Params defines the set of move parameters.
+type Params struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BaseDenom string `protobuf:"bytes,1,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	// Deprecated: Do not use.
+	BaseMinGasPrice string `protobuf:"bytes,2,opt,name=base_min_gas_price,json=baseMinGasPrice,proto3" json:"base_min_gas_price,omitempty"`
+	// CSR: Percentage of fees distributed to developers
+	ContractSharedRevenueRatio string `protobuf:"bytes,3,opt,name=contract_shared_revenue_ratio,json=contractSharedRevenueRatio,proto3" json:"contract_shared_revenue_ratio,omitempty"`
+	// flag whether to enable script execution
+	ScriptEnabled bool `protobuf:"varint,4,opt,name=script_enabled,json=scriptEnabled,proto3" json:"script_enabled,omitempty"`
+	// It is a list of addresses with permission to distribute contracts,
+	// and an empty list is interpreted as allowing anyone to distribute.
+	AllowedPublishers []string `protobuf:"bytes,5,rep,name=allowed_publishers,json=allowedPublishers,proto3" json:"allowed_publishers,omitempty"`
+	// CLAMM module address
+	ClammModuleAddress string `protobuf:"bytes,6,opt,name=clamm_module_address,json=clammModuleAddress,proto3" json:"clamm_module_address,omitempty"`
+	// twap_window is the period the time-weighted average prices of the
+	// whitelisted dex pairs are averaged over. Zero disables price tracking.
+	TwapWindow *durationpb.Duration `protobuf:"bytes,7,opt,name=twap_window,json=twapWindow,proto3" json:"twap_window,omitempty"`
+	// flag whether voting power weights of LP bond denoms use the
+	// time-weighted average LP value instead of the instantaneous one
+	VotingPowerUseTwap bool `protobuf:"varint,8,opt,name=voting_power_use_twap,json=votingPowerUseTwap,proto3" json:"voting_power_use_twap,omitempty"`
+}
+
+func (x *Params) Reset() {
+	*x = Params{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_move_v1_types_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Params) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Params) ProtoMessage() {}
+
+// Deprecated: Use Params.ProtoReflect.Descriptor instead.
+func (*Params) Descriptor() ([]byte, []int) {
+	return file_initia_move_v1_types_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Params) GetBaseDenom() string {
+	if x != nil {
+		return x.BaseDenom
+	}
+	return ""
+}
+
+// Deprecated: Do not use.
+func (x *Params) GetBaseMinGasPrice() string {
+	if x != nil {
+		return x.BaseMinGasPrice
+	}
+	return ""
+}
+
+func (x *Params) GetContractSharedRevenueRatio() string {
+	if x != nil {
+		return x.ContractSharedRevenueRatio
+	}
+	return ""
 }
 
 func (x *Params) GetScriptEnabled() bool {
@@ -6171,6 +7056,20 @@ func (x *Params) GetClammModuleAddress() string {
 	return ""
 }
 
+func (x *Params) GetTwapWindow() *durationpb.Duration {
+	if x != nil {
+		return x.TwapWindow
+	}
+	return nil
+}
+
+func (x *Params) GetVotingPowerUseTwap() bool {
+	if x != nil {
+		return x.VotingPowerUseTwap
+	}
+	return false
+}
+
 // RawParams defines the raw params to store.
 type RawParams struct {
 	state         protoimpl.MessageState
@@ -6185,7 +7084,9 @@ type RawParams struct {
 	// flag whether to enable script execution
 	ScriptEnabled bool `protobuf:"varint,4,opt,name=script_enabled,json=scriptEnabled,proto3" json:"script_enabled,omitempty"`
 	// CLAMM module address
-	ClammModuleAddress string `protobuf:"bytes,5,opt,name=clamm_module_address,json=clammModuleAddress,proto3" json:"clamm_module_address,omitempty"`
+	ClammModuleAddress string               `protobuf:"bytes,5,opt,name=clamm_module_address,json=clammModuleAddress,proto3" json:"clamm_module_address,omitempty"`
+	TwapWindow         *durationpb.Duration `protobuf:"bytes,6,opt,name=twap_window,json=twapWindow,proto3" json:"twap_window,omitempty"`
+	VotingPowerUseTwap bool                 `protobuf:"varint,7,opt,name=voting_power_use_twap,json=votingPowerUseTwap,proto3" json:"voting_power_use_twap,omitempty"`
 }
 
 func (x *RawParams) Reset() {
@@ -6244,6 +7145,20 @@ func (x *RawParams) GetClammModuleAddress() string {
 	return ""
 }
 
+func (x *RawParams) GetTwapWindow() *durationpb.Duration {
+	if x != nil {
+		return x.TwapWindow
+	}
+	return nil
+}
+
+func (x *RawParams) GetVotingPowerUseTwap() bool {
+	if x != nil {
+		return x.VotingPowerUseTwap
+	}
+	return false
+}
+
 // Module is data for the uploaded contract move code
 type Module struct {
 	state         protoimpl.MessageState
@@ -6680,6 +7595,71 @@ func (x *ExecuteAuthorizationItem) GetFunctionNames() []string {
 	return nil
 }
 
+// TWAPObservation is the price state of a whitelisted dex pair recorded at the
+// end of a block. The cumulative values are the sums of the observed values
+// weighted by the seconds they were held, so the time-weighted average over
+// a period is the difference of two cumulative values divided by its length.
+type TWAPObservation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// base_spot_price is the base price of the quote coin of the pair.
+	BaseSpotPrice string `protobuf:"bytes,1,opt,name=base_spot_price,json=baseSpotPrice,proto3" json:"base_spot_price,omitempty"`
+	// lp_base_weight is the locked base balance per LP share of the pair.
+	LpBaseWeight            string `protobuf:"bytes,2,opt,name=lp_base_weight,json=lpBaseWeight,proto3" json:"lp_base_weight,omitempty"`
+	CumulativeBaseSpotPrice string `protobuf:"bytes,3,opt,name=cumulative_base_spot_price,json=cumulativeBaseSpotPrice,proto3" json:"cumulative_base_spot_price,omitempty"`
+	CumulativeLpBaseWeight  string `protobuf:"bytes,4,opt,name=cumulative_lp_base_weight,json=cumulativeLpBaseWeight,proto3" json:"cumulative_lp_base_weight,omitempty"`
+}
+
+func (x *TWAPObservation) Reset() {
+	*x = TWAPObservation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_move_v1_types_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TWAPObservation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TWAPObservation) ProtoMessage() {}
+
+// Deprecated: Use TWAPObservation.ProtoReflect.Descriptor instead.
+func (*TWAPObservation) Descriptor() ([]byte, []int) {
+	return file_initia_move_v1_types_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *TWAPObservation) GetBaseSpotPrice() string {
+	if x != nil {
+		return x.BaseSpotPrice
+	}
+	return ""
+}
+
+func (x *TWAPObservation) GetLpBaseWeight() string {
+	if x != nil {
+		return x.LpBaseWeight
+	}
+	return ""
+}
+
+func (x *TWAPObservation) GetCumulativeBaseSpotPrice() string {
+	if x != nil {
+		return x.CumulativeBaseSpotPrice
+	}
+	return ""
+}
+
+func (x *TWAPObservation) GetCumulativeLpBaseWeight() string {
+	if x != nil {
+		return x.CumulativeLpBaseWeight
+	}
+	return ""
+}
+
 var File_initia_move_v1_types_proto protoreflect.FileDescriptor
 
 var file_initia_move_v1_types_proto_rawDesc = []byte{
@@ -6690,7 +7670,9 @@ var file_initia_move_v1_types_proto_rawDesc = []byte{
 	0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f,
 	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0xb7, 0x04, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x62,
+	0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0xed, 0x05, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x62,
 	0x61, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x09, 0x62, 0x61, 0x73, 0x65, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x82, 0x01, 0x0a, 0x12, 0x62,
 	0x61, 0x73, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x63,
@@ -6724,115 +7706,168 @@ var file_initia_move_v1_types_proto_rawDesc = []byte{
 	0x1b, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x6c, 0x61, 0x6d, 0x6d, 0x5f, 0x6d, 0x6f, 0x64,
 	0x75, 0x6c, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x52, 0x12, 0x63, 0x6c,
 	0x61, 0x6d, 0x6d, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x3a, 0x18, 0x98, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x0b, 0x6d,
-	0x6f, 0x76, 0x65, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0xcd, 0x03, 0x0a, 0x09, 0x52,
-	0x61, 0x77, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65,
-	0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61,
-	0x73, 0x65, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x82, 0x01, 0x0a, 0x12, 0x62, 0x61, 0x73, 0x65,
-	0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x55, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
-	0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xf2, 0xde, 0x1f, 0x19, 0x79, 0x61, 0x6d,
-	0x6c, 0x3a, 0x22, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x67, 0x61, 0x73, 0x5f,
-	0x70, 0x72, 0x69, 0x63, 0x65, 0x22, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x18, 0x01, 0x52, 0x0f, 0x62, 0x61, 0x73,
-	0x65, 0x4d, 0x69, 0x6e, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0xa1, 0x01, 0x0a,
-	0x1d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64,
-	0x5f, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x5e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
-	0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xf2, 0xde, 0x1f, 0x24, 0x79, 0x61, 0x6d,
-	0x6c, 0x3a, 0x22, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x73, 0x68, 0x61, 0x72,
-	0x65, 0x64, 0x5f, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x22, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8,
-	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x1a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x68,
-	0x61, 0x72, 0x65, 0x64, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6f,
-	0x12, 0x25, 0x0a, 0x0e, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c,
-	0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x51, 0x0a, 0x14, 0x63, 0x6c, 0x61, 0x6d, 0x6d,
-	0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1f, 0xf2, 0xde, 0x1f, 0x1b, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
-	0x22, 0x63, 0x6c, 0x61, 0x6d, 0x6d, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x52, 0x12, 0x63, 0x6c, 0x61, 0x6d, 0x6d, 0x4d, 0x6f, 0x64,
-	0x75, 0x6c, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xb8, 0x01, 0x0a, 0x06, 0x4d,
-	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0x1f, 0x0a, 0x0b, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x10, 0x0a, 0x03, 0x61, 0x62, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61,
-	0x62, 0x69, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x61, 0x77, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x72, 0x61, 0x77, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12,
-	0x44, 0x0a, 0x0e, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61,
-	0x2e, 0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65,
-	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0d, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50,
-	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x61, 0x0a, 0x08, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75,
-	0x6d, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x12, 0x5f, 0x0a, 0x0b, 0x74, 0x77, 0x61, 0x70, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x12, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
+	0x74, 0x77, 0x61, 0x70, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x22, 0x98, 0xdf, 0x1f, 0x01,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x74, 0x77, 0x61, 0x70, 0x57, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x12, 0x53, 0x0a, 0x15, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x77, 0x65,
+	0x72, 0x5f, 0x75, 0x73, 0x65, 0x5f, 0x74, 0x77, 0x61, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08,
+	0x42, 0x20, 0xf2, 0xde, 0x1f, 0x1c, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x76, 0x6f, 0x74, 0x69,
+	0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x75, 0x73, 0x65, 0x5f, 0x74, 0x77, 0x61,
+	0x70, 0x22, 0x52, 0x12, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x55,
+	0x73, 0x65, 0x54, 0x77, 0x61, 0x70, 0x3a, 0x18, 0x98, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x01,
+	0x8a, 0xe7, 0xb0, 0x2a, 0x0b, 0x6d, 0x6f, 0x76, 0x65, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x22, 0x83, 0x05, 0x0a, 0x09, 0x52, 0x61, 0x77, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x62, 0x61, 0x73, 0x65, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x82, 0x01,
+	0x0a, 0x12, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x55, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xf2,
+	0xde, 0x1f, 0x19, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x6d, 0x69,
+	0x6e, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x22, 0xd2, 0xb4, 0x2d, 0x0a,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x18,
+	0x01, 0x52, 0x0f, 0x62, 0x61, 0x73, 0x65, 0x4d, 0x69, 0x6e, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x12, 0xa1, 0x01, 0x0a, 0x1d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x5f, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x5e, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xf2,
+	0xde, 0x1f, 0x24, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65,
+	0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x22, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x1a, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75,
+	0x65, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x51, 0x0a,
+	0x14, 0x63, 0x6c, 0x61, 0x6d, 0x6d, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1f, 0xf2, 0xde, 0x1f,
+	0x1b, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x6c, 0x61, 0x6d, 0x6d, 0x5f, 0x6d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x52, 0x12, 0x63, 0x6c,
+	0x61, 0x6d, 0x6d, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x5f, 0x0a, 0x0b, 0x74, 0x77, 0x61, 0x70, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x12, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
+	0x74, 0x77, 0x61, 0x70, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x22, 0x98, 0xdf, 0x1f, 0x01,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x74, 0x77, 0x61, 0x70, 0x57, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x12, 0x53, 0x0a, 0x15, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x77, 0x65,
+	0x72, 0x5f, 0x75, 0x73, 0x65, 0x5f, 0x74, 0x77, 0x61, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
+	0x42, 0x20, 0xf2, 0xde, 0x1f, 0x1c, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x76, 0x6f, 0x74, 0x69,
+	0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x75, 0x73, 0x65, 0x5f, 0x74, 0x77, 0x61,
+	0x70, 0x22, 0x52, 0x12, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x55,
+	0x73, 0x65, 0x54, 0x77, 0x61, 0x70, 0x22, 0xb8, 0x01, 0x0a, 0x06, 0x4d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d,
 	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08,
-	0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08,
-	0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x22, 0x85, 0x01, 0x0a, 0x08, 0x52, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x54, 0x61, 0x67, 0x12, 0x23,
-	0x0a, 0x0d, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x61, 0x77, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x72, 0x61, 0x77, 0x42, 0x79, 0x74, 0x65, 0x73,
-	0x22, 0x5f, 0x0a, 0x09, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a,
+	0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x61, 0x62, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x62, 0x69, 0x12, 0x1b,
+	0x0a, 0x09, 0x72, 0x61, 0x77, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x08, 0x72, 0x61, 0x77, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x0e, 0x75,
+	0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x52, 0x0d, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x22, 0x61, 0x0a, 0x08, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x12, 0x18, 0x0a,
 	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x5f, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70,
-	0x65, 0x22, 0x8c, 0x01, 0x0a, 0x0a, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6b, 0x65, 0x79, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12,
-	0x1f, 0x0a, 0x0b, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73,
-	0x22, 0x4b, 0x0a, 0x12, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x35, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e,
-	0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50,
-	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x61, 0x0a,
-	0x07, 0x44, 0x65, 0x78, 0x50, 0x61, 0x69, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0d, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x12,
-	0x2f, 0x0a, 0x0b, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x6c, 0x70, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xe2, 0xde, 0x1f, 0x0a, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x4c, 0x50, 0x52, 0x0a, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x4c, 0x70,
-	0x22, 0x8f, 0x01, 0x0a, 0x18, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68,
-	0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x25, 0x0a,
-	0x0e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x75, 0x6c,
-	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x0e, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x04, 0xc8,
-	0xde, 0x1f, 0x01, 0x52, 0x0d, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d,
-	0x65, 0x73, 0x2a, 0x3f, 0x0a, 0x0d, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50, 0x6f, 0x6c,
-	0x69, 0x63, 0x79, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
-	0x45, 0x44, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x4f, 0x4d, 0x50, 0x41, 0x54, 0x49, 0x42,
-	0x4c, 0x45, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x49, 0x4d, 0x4d, 0x55, 0x54, 0x41, 0x42, 0x4c,
-	0x45, 0x10, 0x02, 0x42, 0xbb, 0x01, 0xc8, 0xe1, 0x1e, 0x00, 0xa8, 0xe2, 0x1e, 0x01, 0x0a, 0x12,
-	0x63, 0x6f, 0x6d, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76, 0x65, 0x2e,
-	0x76, 0x31, 0x42, 0x0a, 0x54, 0x79, 0x70, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
-	0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x69,
-	0x74, 0x69, 0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x6d, 0x6f, 0x76, 0x65, 0x2f,
-	0x76, 0x31, 0x3b, 0x6d, 0x6f, 0x76, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x49, 0x4d, 0x58, 0xaa,
-	0x02, 0x0e, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x2e, 0x56, 0x31,
-	0xca, 0x02, 0x0e, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x5c, 0x4d, 0x6f, 0x76, 0x65, 0x5c, 0x56,
-	0x31, 0xe2, 0x02, 0x1a, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x5c, 0x4d, 0x6f, 0x76, 0x65, 0x5c,
-	0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
-	0x10, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x3a, 0x3a, 0x4d, 0x6f, 0x76, 0x65, 0x3a, 0x3a, 0x56,
-	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x73, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x73, 0x75, 0x6d, 0x22, 0x85, 0x01, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x54, 0x61, 0x67, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x6f,
+	0x76, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x72, 0x61, 0x77, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x08, 0x72, 0x61, 0x77, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x5f, 0x0a, 0x09,
+	0x54, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x8c, 0x01,
+	0x0a, 0x0a, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x6b, 0x65, 0x79, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0a, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x4b, 0x0a, 0x12,
+	0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x35, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x61, 0x0a, 0x07, 0x44, 0x65, 0x78,
+	0x50, 0x61, 0x69, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x5f, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x12, 0x2f, 0x0a, 0x0b, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x6c, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0e, 0xe2, 0xde, 0x1f, 0x0a, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x4c, 0x50,
+	0x52, 0x0a, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x4c, 0x70, 0x22, 0x8f, 0x01, 0x0a,
+	0x18, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x2b, 0x0a, 0x0e, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x01, 0x52,
+	0x0d, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0xe2,
+	0x03, 0x0a, 0x0f, 0x54, 0x57, 0x41, 0x50, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x5e, 0x0a, 0x0f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x73, 0x70, 0x6f, 0x74, 0x5f,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63,
+	0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x62, 0x61, 0x73, 0x65, 0x53, 0x70, 0x6f, 0x74, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x12, 0x6c, 0x0a, 0x0e, 0x6c, 0x70, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x77, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xe2,
+	0xde, 0x1f, 0x0c, 0x4c, 0x50, 0x42, 0x61, 0x73, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0xd2,
+	0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x0c, 0x6c, 0x70, 0x42, 0x61, 0x73, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x73, 0x0a, 0x1a, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x62,
+	0x61, 0x73, 0x65, 0x5f, 0x73, 0x70, 0x6f, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
+	0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x17, 0x63, 0x75,
+	0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x42, 0x61, 0x73, 0x65, 0x53, 0x70, 0x6f, 0x74,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x8b, 0x01, 0x0a, 0x19, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61,
+	0x74, 0x69, 0x76, 0x65, 0x5f, 0x6c, 0x70, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x77, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x50, 0xc8, 0xde, 0x1f, 0x00, 0xda,
+	0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xe2, 0xde,
+	0x1f, 0x16, 0x43, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x50, 0x42, 0x61,
+	0x73, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x16, 0x63, 0x75, 0x6d,
+	0x75, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x70, 0x42, 0x61, 0x73, 0x65, 0x57, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x2a, 0x3f, 0x0a, 0x0d, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x4f, 0x4d, 0x50, 0x41, 0x54, 0x49,
+	0x42, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x49, 0x4d, 0x4d, 0x55, 0x54, 0x41, 0x42,
+	0x4c, 0x45, 0x10, 0x02, 0x42, 0xbb, 0x01, 0xc8, 0xe1, 0x1e, 0x00, 0xa8, 0xe2, 0x1e, 0x01, 0x0a,
+	0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76, 0x65,
+	0x2e, 0x76, 0x31, 0x42, 0x0a, 0x54, 0x79, 0x70, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
+	0x01, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e,
+	0x69, 0x74, 0x69, 0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x6d, 0x6f, 0x76, 0x65,
+	0x2f, 0x76, 0x31, 0x3b, 0x6d, 0x6f, 0x76, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x49, 0x4d, 0x58,
+	0xaa, 0x02, 0x0e, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x2e, 0x56,
+	0x31, 0xca, 0x02, 0x0e, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x5c, 0x4d, 0x6f, 0x76, 0x65, 0x5c,
+	0x56, 0x31, 0xe2, 0x02, 0x1a, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x5c, 0x4d, 0x6f, 0x76, 0x65,
+	0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
+	0x02, 0x10, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x3a, 0x3a, 0x4d, 0x6f, 0x76, 0x65, 0x3a, 0x3a,
+	0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -6848,7 +7883,7 @@ func file_initia_move_v1_types_proto_rawDescGZIP() []byte {
 }
 
 var file_initia_move_v1_types_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_initia_move_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_initia_move_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_initia_move_v1_types_proto_goTypes = []interface{}{
 	(UpgradePolicy)(0),               // 0: initia.move.v1.UpgradePolicy
 	(*Params)(nil),                   // 1: initia.move.v1.Params
@@ -6861,15 +7896,19 @@ var file_initia_move_v1_types_proto_goTypes = []interface{}{
 	(*UpgradePolicyProto)(nil),       // 8: initia.move.v1.UpgradePolicyProto
 	(*DexPair)(nil),                  // 9: initia.move.v1.DexPair
 	(*ExecuteAuthorizationItem)(nil), // 10: initia.move.v1.ExecuteAuthorizationItem
+	(*TWAPObservation)(nil),          // 11: initia.move.v1.TWAPObservation
+	(*durationpb.Duration)(nil),      // 12: google.protobuf.Duration
 }
 var file_initia_move_v1_types_proto_depIdxs = []int32{
-	0, // 0: initia.move.v1.Module.upgrade_policy:type_name -> initia.move.v1.UpgradePolicy
-	0, // 1: initia.move.v1.UpgradePolicyProto.policy:type_name -> initia.move.v1.UpgradePolicy
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	12, // 0: initia.move.v1.Params.twap_window:type_name -> google.protobuf.Duration
+	12, // 1: initia.move.v1.RawParams.twap_window:type_name -> google.protobuf.Duration
+	0,  // 2: initia.move.v1.Module.upgrade_policy:type_name -> initia.move.v1.UpgradePolicy
+	0,  // 3: initia.move.v1.UpgradePolicyProto.policy:type_name -> initia.move.v1.UpgradePolicy
+	4,  // [4:4] is the sub-list for method output_type
+	4,  // [4:4] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_initia_move_v1_types_proto_init() }
@@ -6998,6 +8037,18 @@ func file_initia_move_v1_types_proto_init() {
 				return nil
 			}
 		}
+		file_initia_move_v1_types_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TWAPObservation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -7005,7 +8056,7 @@ func file_initia_move_v1_types_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_initia_move_v1_types_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   10,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -31,9 +31,12 @@ const (
 	Query_DelegatorValidators_FullMethodName             = "/initia.mstaking.v1.Query/DelegatorValidators"
 	Query_DelegatorValidator_FullMethodName              = "/initia.mstaking.v1.Query/DelegatorValidator"
 	Query_DelegatorTotalDelegationBalance_FullMethodName = "/initia.mstaking.v1.Query/DelegatorTotalDelegationBalance"
+	Query_DelegatorTotalUnbondingBalance_FullMethodName  = "/initia.mstaking.v1.Query/DelegatorTotalUnbondingBalance"
 	Query_Redelegations_FullMethodName                   = "/initia.mstaking.v1.Query/Redelegations"
 	Query_Pool_FullMethodName                            = "/initia.mstaking.v1.Query/Pool"
 	Query_Params_FullMethodName                          = "/initia.mstaking.v1.Query/Params"
+	Query_TokenizeShareRecord_FullMethodName             = "/initia.mstaking.v1.Query/TokenizeShareRecord"
+	Query_TokenizeShareRecordByDenom_FullMethodName      = "/initia.mstaking.v1.Query/TokenizeShareRecordByDenom"
 )
 
 // QueryClient is the client API for Query service.
@@ -71,12 +74,19 @@ type QueryClient interface {
 	// DelegatorTotalDelegationBalance queries sum of all the delegations' balance of a
 	// delegator.
 	DelegatorTotalDelegationBalance(ctx context.Context, in *QueryDelegatorTotalDelegationBalanceRequest, opts ...grpc.CallOption) (*QueryDelegatorTotalDelegationBalanceResponse, error)
+	// DelegatorTotalUnbondingBalance queries the sum of all unbonding delegations' balance
+	// of a delegator.
+	DelegatorTotalUnbondingBalance(ctx context.Context, in *QueryDelegatorTotalUnbondingBalanceRequest, opts ...grpc.CallOption) (*QueryDelegatorTotalUnbondingBalanceResponse, error)
 	// Redelegations queries redelegations of given address.
 	Redelegations(ctx context.Context, in *QueryRedelegationsRequest, opts ...grpc.CallOption) (*QueryRedelegationsResponse, error)
 	// Pool queries the pool info.
 	Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// TokenizeShareRecord queries a tokenize share record by its id.
+	TokenizeShareRecord(ctx context.Context, in *QueryTokenizeShareRecordRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordResponse, error)
+	// TokenizeShareRecordByDenom queries a tokenize share record by its share token denom.
+	TokenizeShareRecordByDenom(ctx context.Context, in *QueryTokenizeShareRecordByDenomRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordResponse, error)
 }
 
 type queryClient struct {
@@ -207,6 +217,16 @@ func (c *queryClient) DelegatorTotalDelegationBalance(ctx context.Context, in *Q
 	return out, nil
 }
 
+func (c *queryClient) DelegatorTotalUnbondingBalance(ctx context.Context, in *QueryDelegatorTotalUnbondingBalanceRequest, opts ...grpc.CallOption) (*QueryDelegatorTotalUnbondingBalanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryDelegatorTotalUnbondingBalanceResponse)
+	err := c.cc.Invoke(ctx, Query_DelegatorTotalUnbondingBalance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Redelegations(ctx context.Context, in *QueryRedelegationsRequest, opts ...grpc.CallOption) (*QueryRedelegationsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QueryRedelegationsResponse)
@@ -237,6 +257,26 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) TokenizeShareRecord(ctx context.Context, in *QueryTokenizeShareRecordRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryTokenizeShareRecordResponse)
+	err := c.cc.Invoke(ctx, Query_TokenizeShareRecord_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TokenizeShareRecordByDenom(ctx context.Context, in *QueryTokenizeShareRecordByDenomRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryTokenizeShareRecordResponse)
+	err := c.cc.Invoke(ctx, Query_TokenizeShareRecordByDenom_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -272,12 +312,19 @@ type QueryServer interface {
 	// DelegatorTotalDelegationBalance queries sum of all the delegations' balance of a
 	// delegator.
 	DelegatorTotalDelegationBalance(context.Context, *QueryDelegatorTotalDelegationBalanceRequest) (*QueryDelegatorTotalDelegationBalanceResponse, error)
+	// DelegatorTotalUnbondingBalance queries the sum of all unbonding delegations' balance
+	// of a delegator.
+	DelegatorTotalUnbondingBalance(context.Context, *QueryDelegatorTotalUnbondingBalanceRequest) (*QueryDelegatorTotalUnbondingBalanceResponse, error)
 	// Redelegations queries redelegations of given address.
 	Redelegations(context.Context, *QueryRedelegationsRequest) (*QueryRedelegationsResponse, error)
 	// Pool queries the pool info.
 	Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// TokenizeShareRecord queries a tokenize share record by its id.
+	TokenizeShareRecord(context.Context, *QueryTokenizeShareRecordRequest) (*QueryTokenizeShareRecordResponse, error)
+	// TokenizeShareRecordByDenom queries a tokenize share record by its share token denom.
+	TokenizeShareRecordByDenom(context.Context, *QueryTokenizeShareRecordByDenomRequest) (*QueryTokenizeShareRecordResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -324,6 +371,9 @@ func (UnimplementedQueryServer) DelegatorValidator(context.Context, *QueryDelega
 func (UnimplementedQueryServer) DelegatorTotalDelegationBalance(context.Context, *QueryDelegatorTotalDelegationBalanceRequest) (*QueryDelegatorTotalDelegationBalanceResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DelegatorTotalDelegationBalance not implemented")
 }
+func (UnimplementedQueryServer) DelegatorTotalUnbondingBalance(context.Context, *QueryDelegatorTotalUnbondingBalanceRequest) (*QueryDelegatorTotalUnbondingBalanceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DelegatorTotalUnbondingBalance not implemented")
+}
 func (UnimplementedQueryServer) Redelegations(context.Context, *QueryRedelegationsRequest) (*QueryRedelegationsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method Redelegations not implemented")
 }
@@ -333,6 +383,12 @@ func (UnimplementedQueryServer) Pool(context.Context, *QueryPoolRequest) (*Query
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServer) TokenizeShareRecord(context.Context, *QueryTokenizeShareRecordRequest) (*QueryTokenizeShareRecordResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method TokenizeShareRecord not implemented")
+}
+func (UnimplementedQueryServer) TokenizeShareRecordByDenom(context.Context, *QueryTokenizeShareRecordByDenomRequest) (*QueryTokenizeShareRecordResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method TokenizeShareRecordByDenom not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -570,6 +626,24 @@ func _Query_DelegatorTotalDelegationBalance_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DelegatorTotalUnbondingBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorTotalUnbondingBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorTotalUnbondingBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DelegatorTotalUnbondingBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorTotalUnbondingBalance(ctx, req.(*QueryDelegatorTotalUnbondingBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Redelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryRedelegationsRequest)
 	if err := dec(in); err != nil {
@@ -624,6 +698,42 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TokenizeShareRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenizeShareRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TokenizeShareRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TokenizeShareRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenizeShareRecord(ctx, req.(*QueryTokenizeShareRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TokenizeShareRecordByDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenizeShareRecordByDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TokenizeShareRecordByDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TokenizeShareRecordByDenom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenizeShareRecordByDenom(ctx, req.(*QueryTokenizeShareRecordByDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -679,6 +789,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DelegatorTotalDelegationBalance",
 			Handler:    _Query_DelegatorTotalDelegationBalance_Handler,
 		},
+		{
+			MethodName: "DelegatorTotalUnbondingBalance",
+			Handler:    _Query_DelegatorTotalUnbondingBalance_Handler,
+		},
 		{
 			MethodName: "Redelegations",
 			Handler:    _Query_Redelegations_Handler,
@@ -691,6 +805,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "TokenizeShareRecord",
+			Handler:    _Query_TokenizeShareRecord_Handler,
+		},
+		{
+			MethodName: "TokenizeShareRecordByDenom",
+			Handler:    _Query_TokenizeShareRecordByDenom_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "initia/mstaking/v1/query.proto",
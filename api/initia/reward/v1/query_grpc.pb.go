@@ -22,6 +22,10 @@ const (
 	Query_Params_FullMethodName                = "/initia.reward.v1.Query/Params"
 	Query_AnnualProvisions_FullMethodName      = "/initia.reward.v1.Query/AnnualProvisions"
 	Query_LastDilutionTimestamp_FullMethodName = "/initia.reward.v1.Query/LastDilutionTimestamp"
+	Query_ProjectedRelease_FullMethodName      = "/initia.reward.v1.Query/ProjectedRelease"
+	Query_RemainingReward_FullMethodName       = "/initia.reward.v1.Query/RemainingReward"
+	Query_ReleaseForecast_FullMethodName       = "/initia.reward.v1.Query/ReleaseForecast"
+	Query_ExhaustionTime_FullMethodName        = "/initia.reward.v1.Query/ExhaustionTime"
 )
 
 // QueryClient is the client API for Query service.
@@ -36,6 +40,16 @@ type QueryClient interface {
 	AnnualProvisions(ctx context.Context, in *QueryAnnualProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualProvisionsResponse, error)
 	// LastDilutionTimestamp returns the time when the last release rate dilution occurred.
 	LastDilutionTimestamp(ctx context.Context, in *QueryLastDilutionTimestampRequest, opts ...grpc.CallOption) (*QueryLastDilutionTimestampResponse, error)
+	// ProjectedRelease returns the release of every schedule projected from
+	// the current block time until the requested time.
+	ProjectedRelease(ctx context.Context, in *QueryProjectedReleaseRequest, opts ...grpc.CallOption) (*QueryProjectedReleaseResponse, error)
+	// RemainingReward returns the reward module balance left to release.
+	RemainingReward(ctx context.Context, in *QueryRemainingRewardRequest, opts ...grpc.CallOption) (*QueryRemainingRewardResponse, error)
+	// ReleaseForecast returns the projected release of every day, month or
+	// year from the current block time until the requested horizon.
+	ReleaseForecast(ctx context.Context, in *QueryReleaseForecastRequest, opts ...grpc.CallOption) (*QueryReleaseForecastResponse, error)
+	// ExhaustionTime returns the estimated time the remaining reward runs out.
+	ExhaustionTime(ctx context.Context, in *QueryExhaustionTimeRequest, opts ...grpc.CallOption) (*QueryExhaustionTimeResponse, error)
 }
 
 type queryClient struct {
@@ -76,6 +90,46 @@ func (c *queryClient) LastDilutionTimestamp(ctx context.Context, in *QueryLastDi
 	return out, nil
 }
 
+func (c *queryClient) ProjectedRelease(ctx context.Context, in *QueryProjectedReleaseRequest, opts ...grpc.CallOption) (*QueryProjectedReleaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryProjectedReleaseResponse)
+	err := c.cc.Invoke(ctx, Query_ProjectedRelease_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RemainingReward(ctx context.Context, in *QueryRemainingRewardRequest, opts ...grpc.CallOption) (*QueryRemainingRewardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryRemainingRewardResponse)
+	err := c.cc.Invoke(ctx, Query_RemainingReward_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ReleaseForecast(ctx context.Context, in *QueryReleaseForecastRequest, opts ...grpc.CallOption) (*QueryReleaseForecastResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryReleaseForecastResponse)
+	err := c.cc.Invoke(ctx, Query_ReleaseForecast_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ExhaustionTime(ctx context.Context, in *QueryExhaustionTimeRequest, opts ...grpc.CallOption) (*QueryExhaustionTimeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryExhaustionTimeResponse)
+	err := c.cc.Invoke(ctx, Query_ExhaustionTime_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -88,6 +142,16 @@ type QueryServer interface {
 	AnnualProvisions(context.Context, *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error)
 	// LastDilutionTimestamp returns the time when the last release rate dilution occurred.
 	LastDilutionTimestamp(context.Context, *QueryLastDilutionTimestampRequest) (*QueryLastDilutionTimestampResponse, error)
+	// ProjectedRelease returns the release of every schedule projected from
+	// the current block time until the requested time.
+	ProjectedRelease(context.Context, *QueryProjectedReleaseRequest) (*QueryProjectedReleaseResponse, error)
+	// RemainingReward returns the reward module balance left to release.
+	RemainingReward(context.Context, *QueryRemainingRewardRequest) (*QueryRemainingRewardResponse, error)
+	// ReleaseForecast returns the projected release of every day, month or
+	// year from the current block time until the requested horizon.
+	ReleaseForecast(context.Context, *QueryReleaseForecastRequest) (*QueryReleaseForecastResponse, error)
+	// ExhaustionTime returns the estimated time the remaining reward runs out.
+	ExhaustionTime(context.Context, *QueryExhaustionTimeRequest) (*QueryExhaustionTimeResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -107,6 +171,18 @@ func (UnimplementedQueryServer) AnnualProvisions(context.Context, *QueryAnnualPr
 func (UnimplementedQueryServer) LastDilutionTimestamp(context.Context, *QueryLastDilutionTimestampRequest) (*QueryLastDilutionTimestampResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method LastDilutionTimestamp not implemented")
 }
+func (UnimplementedQueryServer) ProjectedRelease(context.Context, *QueryProjectedReleaseRequest) (*QueryProjectedReleaseResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ProjectedRelease not implemented")
+}
+func (UnimplementedQueryServer) RemainingReward(context.Context, *QueryRemainingRewardRequest) (*QueryRemainingRewardResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemainingReward not implemented")
+}
+func (UnimplementedQueryServer) ReleaseForecast(context.Context, *QueryReleaseForecastRequest) (*QueryReleaseForecastResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReleaseForecast not implemented")
+}
+func (UnimplementedQueryServer) ExhaustionTime(context.Context, *QueryExhaustionTimeRequest) (*QueryExhaustionTimeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExhaustionTime not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -182,6 +258,78 @@ func _Query_LastDilutionTimestamp_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ProjectedRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectedReleaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProjectedRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProjectedRelease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProjectedRelease(ctx, req.(*QueryProjectedReleaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RemainingReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRemainingRewardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RemainingReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RemainingReward_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RemainingReward(ctx, req.(*QueryRemainingRewardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ReleaseForecast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReleaseForecastRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ReleaseForecast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ReleaseForecast_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReleaseForecast(ctx, req.(*QueryReleaseForecastRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExhaustionTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExhaustionTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExhaustionTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ExhaustionTime_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExhaustionTime(ctx, req.(*QueryExhaustionTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -201,6 +349,22 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LastDilutionTimestamp",
 			Handler:    _Query_LastDilutionTimestamp_Handler,
 		},
+		{
+			MethodName: "ProjectedRelease",
+			Handler:    _Query_ProjectedRelease_Handler,
+		},
+		{
+			MethodName: "RemainingReward",
+			Handler:    _Query_RemainingReward_Handler,
+		},
+		{
+			MethodName: "ReleaseForecast",
+			Handler:    _Query_ReleaseForecast_Handler,
+		},
+		{
+			MethodName: "ExhaustionTime",
+			Handler:    _Query_ExhaustionTime_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "initia/reward/v1/query.proto",
@@ -21,6 +21,7 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	Query_GasPrices_FullMethodName   = "/initia.tx.v1.Query/GasPrices"
 	Query_GasPrice_FullMethodName    = "/initia.tx.v1.Query/GasPrice"
+	Query_FeeHistory_FullMethodName  = "/initia.tx.v1.Query/FeeHistory"
 	Query_TxsByEvents_FullMethodName = "/initia.tx.v1.Query/TxsByEvents"
 )
 
@@ -34,6 +35,9 @@ type QueryClient interface {
 	GasPrices(ctx context.Context, in *QueryGasPricesRequest, opts ...grpc.CallOption) (*QueryGasPricesResponse, error)
 	// GasPrice returns the gas price for the network.
 	GasPrice(ctx context.Context, in *QueryGasPriceRequest, opts ...grpc.CallOption) (*QueryGasPriceResponse, error)
+	// FeeHistory returns the base gas price history of the most recent blocks
+	// and the percentiles of the tip gas prices paid by their txs.
+	FeeHistory(ctx context.Context, in *QueryFeeHistoryRequest, opts ...grpc.CallOption) (*QueryFeeHistoryResponse, error)
 	// TxsByEvents fetches transactions by event criteria. This method proxies to CometBFT's TxSearchV2 RPC endpoint
 	// to efficiently search and retrieve transactions matching specified event conditions.
 	TxsByEvents(ctx context.Context, in *TxsByEventsRequest, opts ...grpc.CallOption) (*TxsByEventsResponse, error)
@@ -67,6 +71,16 @@ func (c *queryClient) GasPrice(ctx context.Context, in *QueryGasPriceRequest, op
 	return out, nil
 }
 
+func (c *queryClient) FeeHistory(ctx context.Context, in *QueryFeeHistoryRequest, opts ...grpc.CallOption) (*QueryFeeHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryFeeHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_FeeHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) TxsByEvents(ctx context.Context, in *TxsByEventsRequest, opts ...grpc.CallOption) (*TxsByEventsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(TxsByEventsResponse)
@@ -87,6 +101,9 @@ type QueryServer interface {
 	GasPrices(context.Context, *QueryGasPricesRequest) (*QueryGasPricesResponse, error)
 	// GasPrice returns the gas price for the network.
 	GasPrice(context.Context, *QueryGasPriceRequest) (*QueryGasPriceResponse, error)
+	// FeeHistory returns the base gas price history of the most recent blocks
+	// and the percentiles of the tip gas prices paid by their txs.
+	FeeHistory(context.Context, *QueryFeeHistoryRequest) (*QueryFeeHistoryResponse, error)
 	// TxsByEvents fetches transactions by event criteria. This method proxies to CometBFT's TxSearchV2 RPC endpoint
 	// to efficiently search and retrieve transactions matching specified event conditions.
 	TxsByEvents(context.Context, *TxsByEventsRequest) (*TxsByEventsResponse, error)
@@ -106,6 +123,9 @@ func (UnimplementedQueryServer) GasPrices(context.Context, *QueryGasPricesReques
 func (UnimplementedQueryServer) GasPrice(context.Context, *QueryGasPriceRequest) (*QueryGasPriceResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GasPrice not implemented")
 }
+func (UnimplementedQueryServer) FeeHistory(context.Context, *QueryFeeHistoryRequest) (*QueryFeeHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method FeeHistory not implemented")
+}
 func (UnimplementedQueryServer) TxsByEvents(context.Context, *TxsByEventsRequest) (*TxsByEventsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method TxsByEvents not implemented")
 }
@@ -166,6 +186,24 @@ func _Query_GasPrice_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FeeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FeeHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeHistory(ctx, req.(*QueryFeeHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_TxsByEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TxsByEventsRequest)
 	if err := dec(in); err != nil {
@@ -199,6 +237,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GasPrice",
 			Handler:    _Query_GasPrice_Handler,
 		},
+		{
+			MethodName: "FeeHistory",
+			Handler:    _Query_FeeHistory_Handler,
+		},
 		{
 			MethodName: "TxsByEvents",
 			Handler:    _Query_TxsByEvents_Handler,
@@ -5,6 +5,9 @@ import (
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 
+	"github.com/spf13/cast"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -84,15 +87,26 @@ func (app *InitiaApp) setupABCIPP(mempoolMaxTxs int, appOpts servertypes.AppOpti
 
 	anteHandler := appante.NewDualAnteHandler(minimalHandler, fullHandler)
 	abcippCfg := abcipp.GetConfig(appOpts)
+	app.mempoolSnapshotQuery = abcippCfg.SnapshotQueryEnabled
+	homePath := cast.ToString(appOpts.Get(flags.FlagHome))
+
+	tiers, err := buildABCIPPTiers(app.ac, abcippCfg.Tiers)
+	if err != nil {
+		return nil, nil, nil, nil, nil, err
+	}
 
 	mempool := abcipp.NewPriorityMempool(
 		abcipp.PriorityMempoolConfig{
-			MaxTx:              mempoolMaxTxs,
-			MaxQueuedPerSender: abcippCfg.MaxQueuedPerSender,
-			MaxQueuedTotal:     abcippCfg.MaxQueuedTotal,
-			QueuedGapTTL:       abcippCfg.QueuedGapTTL,
-			Tiers:              []abcipp.Tier{}, // no tiers on L1
-			AnteHandler:        fullHandler,     // for cleanup
+			MaxTx:                   mempoolMaxTxs,
+			MaxQueuedPerSender:      abcippCfg.MaxQueuedPerSender,
+			MaxQueuedTotal:          abcippCfg.MaxQueuedTotal,
+			QueuedGapTTL:            abcippCfg.QueuedGapTTL,
+			ReplacementPriorityBump: abcippCfg.ReplacementPriorityBump,
+			Tiers:                   tiers,
+			JournalPath:             abcippCfg.ResolveJournalPath(homePath),
+			TxDecoder:               app.txConfig.TxDecoder(),
+			Reputation:              abcippCfg.ReputationConfig(),
+			AnteHandler:             fullHandler, // for cleanup
 		}, app.Logger(), app.TxEncode, app.AccountKeeper,
 	)
 
@@ -109,6 +123,8 @@ func (app *InitiaApp) setupABCIPP(mempoolMaxTxs int, appOpts servertypes.AppOpti
 	if err != nil {
 		return nil, nil, nil, nil, nil, err
 	}
+	proposalHandler.SetReportProposalOrdering(abcippCfg.ReportProposalOrdering)
+	proposalHandler.SetStrictProcessProposal(abcippCfg.StrictProcessProposal)
 
 	checkTxHandler, err := abcipp.NewCheckTxHandler(
 		app.Logger(),
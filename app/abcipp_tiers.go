@@ -0,0 +1,185 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/math"
+	vmtypes "github.com/initia-labs/movevm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/initia/abcipp"
+	movetypes "github.com/initia-labs/initia/x/move/types"
+)
+
+// moveTarget is a parsed MsgExecute target; an empty function matches the whole module.
+type moveTarget struct {
+	addr     vmtypes.AccountAddress
+	module   string
+	function string
+}
+
+// buildABCIPPTiers compiles the declarative tiers from app.toml into mempool tiers.
+func buildABCIPPTiers(ac address.Codec, cfgs []abcipp.TierConfig) ([]abcipp.Tier, error) {
+	tiers := make([]abcipp.Tier, 0, len(cfgs))
+	seen := make(map[string]struct{}, len(cfgs))
+	totalReserved := math.LegacyZeroDec()
+	for _, cfg := range cfgs {
+		if err := cfg.Validate(); err != nil {
+			return nil, err
+		}
+
+		name := strings.TrimSpace(cfg.Name)
+		if _, ok := seen[name]; ok {
+			return nil, fmt.Errorf("duplicate tier name %q", name)
+		}
+		seen[name] = struct{}{}
+
+		matcher, err := newTierConfigMatcher(ac, cfg)
+		if err != nil {
+			return nil, fmt.Errorf("tier %q: %w", name, err)
+		}
+
+		maxShare, err := cfg.ParseMaxBlockShare()
+		if err != nil {
+			return nil, fmt.Errorf("tier %q: %w", name, err)
+		}
+
+		reserved, err := cfg.ParseReservedBlockShare()
+		if err != nil {
+			return nil, fmt.Errorf("tier %q: %w", name, err)
+		}
+		totalReserved = totalReserved.Add(reserved)
+
+		tiers = append(tiers, abcipp.Tier{
+			Name:               name,
+			Matcher:            matcher,
+			MaxBlockShare:      maxShare,
+			ReservedBlockShare: reserved,
+		})
+	}
+
+	if totalReserved.GT(math.LegacyOneDec()) {
+		return nil, fmt.Errorf("total reserved block share %s exceeds 1", totalReserved)
+	}
+
+	return tiers, nil
+}
+
+// newTierConfigMatcher returns a matcher accepting txs whose first signer is one
+// of the configured senders, if any, and whose messages all match one of the
+// configured message criteria, if any.
+func newTierConfigMatcher(ac address.Codec, cfg abcipp.TierConfig) (abcipp.TierMatcher, error) {
+	senders := make(map[vmtypes.AccountAddress]struct{}, len(cfg.Senders))
+	for _, sender := range cfg.Senders {
+		addr, err := movetypes.AccAddressFromString(ac, strings.TrimSpace(sender))
+		if err != nil {
+			return nil, fmt.Errorf("invalid sender %q: %w", sender, err)
+		}
+		senders[addr] = struct{}{}
+	}
+
+	typeURLs := make(map[string]struct{}, len(cfg.MsgTypeURLs))
+	for _, typeURL := range cfg.MsgTypeURLs {
+		typeURLs[strings.TrimSpace(typeURL)] = struct{}{}
+	}
+
+	targets := make([]moveTarget, 0, len(cfg.MoveFunctions))
+	for _, fn := range cfg.MoveFunctions {
+		addrStr, module, function, err := abcipp.ParseMoveFunction(fn)
+		if err != nil {
+			return nil, err
+		}
+
+		addr, err := movetypes.AccAddressFromString(ac, addrStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid move function address %q: %w", addrStr, err)
+		}
+		targets = append(targets, moveTarget{addr: addr, module: module, function: function})
+	}
+
+	hasMsgCriteria := len(typeURLs) > 0 || len(targets) > 0 || cfg.IBCRelayer
+	matchMsg := func(msg sdk.Msg) bool {
+		if _, ok := typeURLs[sdk.MsgTypeURL(msg)]; ok {
+			return true
+		}
+		if cfg.IBCRelayer && isIBCRelayerMsg(msg) {
+			return true
+		}
+		return len(targets) > 0 && matchesMoveTarget(ac, targets, msg)
+	}
+
+	return func(_ sdk.Context, tx sdk.Tx) bool {
+		if len(senders) > 0 {
+			signer, _, err := abcipp.FirstSignature(tx)
+			if err != nil {
+				return false
+			}
+			if _, ok := senders[movetypes.ConvertSDKAddressToVMAddress(signer)]; !ok {
+				return false
+			}
+		}
+
+		if !hasMsgCriteria {
+			return true
+		}
+
+		msgs := tx.GetMsgs()
+		if len(msgs) == 0 {
+			return false
+		}
+		for _, msg := range msgs {
+			if !matchMsg(msg) {
+				return false
+			}
+		}
+		return true
+	}, nil
+}
+
+// isIBCRelayerMsg reports whether msg is submitted by IBC relayers.
+func isIBCRelayerMsg(msg sdk.Msg) bool {
+	switch msg.(type) {
+	case *clienttypes.MsgUpdateClient,
+		*channeltypes.MsgRecvPacket,
+		*channeltypes.MsgAcknowledgement,
+		*channeltypes.MsgTimeout,
+		*channeltypes.MsgTimeoutOnClose:
+		return true
+	default:
+		return false
+	}
+}
+
+// matchesMoveTarget reports whether msg executes one of the targets.
+func matchesMoveTarget(ac address.Codec, targets []moveTarget, msg sdk.Msg) bool {
+	var moduleAddr, moduleName, functionName string
+	switch m := msg.(type) {
+	case *movetypes.MsgExecute:
+		moduleAddr, moduleName, functionName = m.ModuleAddress, m.ModuleName, m.FunctionName
+	case *movetypes.MsgExecuteJSON:
+		moduleAddr, moduleName, functionName = m.ModuleAddress, m.ModuleName, m.FunctionName
+	default:
+		return false
+	}
+
+	addr, err := movetypes.AccAddressFromString(ac, moduleAddr)
+	if err != nil {
+		return false
+	}
+
+	for _, target := range targets {
+		if target.addr != addr || target.module != moduleName {
+			continue
+		}
+		if target.function == "" || target.function == functionName {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,104 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/initia-labs/initia/abcipp"
+	movetypes "github.com/initia-labs/initia/x/move/types"
+)
+
+func newTierTestTx(t *testing.T, signer *secp256k1.PrivKey, msgs ...sdk.Msg) sdk.Tx {
+	t.Helper()
+
+	builder := MakeEncodingConfig().TxConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(msgs...))
+	require.NoError(t, builder.SetSignatures(signing.SignatureV2{
+		PubKey: signer.PubKey(),
+		Data: &signing.SingleSignatureData{
+			SignMode:  signing.SignMode_SIGN_MODE_DIRECT,
+			Signature: []byte{0x1},
+		},
+	}))
+	return builder.GetTx()
+}
+
+func TestBuildABCIPPTiersMatchers(t *testing.T) {
+	ac := address.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	relayer := secp256k1.GenPrivKey()
+	relayerAddr, err := ac.BytesToString(relayer.PubKey().Address())
+	require.NoError(t, err)
+	other := secp256k1.GenPrivKey()
+
+	tiers, err := buildABCIPPTiers(ac, []abcipp.TierConfig{
+		{Name: "ibc", Senders: []string{relayerAddr}, IBCRelayer: true, MaxBlockShare: "0.25", ReservedBlockShare: "0.1"},
+		{Name: "dex", MoveFunctions: []string{"0x1::dex::swap_script"}},
+		{Name: "bank", MsgTypeURLs: []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}},
+	})
+	require.NoError(t, err)
+	require.Len(t, tiers, 3)
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.25"), tiers[0].MaxBlockShare)
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.1"), tiers[0].ReservedBlockShare)
+	require.True(t, tiers[1].MaxBlockShare.IsZero())
+
+	updateClient := &clienttypes.MsgUpdateClient{}
+	swap := &movetypes.MsgExecute{ModuleAddress: "0x1", ModuleName: "dex", FunctionName: "swap_script"}
+	provide := &movetypes.MsgExecute{ModuleAddress: "0x1", ModuleName: "dex", FunctionName: "provide_liquidity_script"}
+	send := &banktypes.MsgSend{}
+
+	ctx := sdk.Context{}
+	ibc, dex, bank := tiers[0].Matcher, tiers[1].Matcher, tiers[2].Matcher
+
+	// sender and message criteria must both hold.
+	require.True(t, ibc(ctx, newTierTestTx(t, relayer, updateClient)))
+	require.False(t, ibc(ctx, newTierTestTx(t, other, updateClient)))
+	require.False(t, ibc(ctx, newTierTestTx(t, relayer, updateClient, send)))
+
+	// function targets match only the named function.
+	require.True(t, dex(ctx, newTierTestTx(t, other, swap)))
+	require.False(t, dex(ctx, newTierTestTx(t, other, provide)))
+
+	require.True(t, bank(ctx, newTierTestTx(t, other, send, send)))
+	require.False(t, bank(ctx, newTierTestTx(t, other, swap)))
+}
+
+func TestBuildABCIPPTiersRejectsInvalidConfig(t *testing.T) {
+	ac := address.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+
+	cases := []abcipp.TierConfig{
+		{Name: "", IBCRelayer: true},
+		{Name: abcipp.DefaultTierName, IBCRelayer: true},
+		{Name: "empty"},
+		{Name: "bad-move", MoveFunctions: []string{"0x1"}},
+		{Name: "bad-sender", Senders: []string{"not-an-address"}},
+		{Name: "bad-share", IBCRelayer: true, MaxBlockShare: "1.5"},
+		{Name: "bad-reserve", IBCRelayer: true, MaxBlockShare: "0.2", ReservedBlockShare: "0.3"},
+	}
+	for _, cfg := range cases {
+		_, err := buildABCIPPTiers(ac, []abcipp.TierConfig{cfg})
+		require.Error(t, err, cfg.Name)
+	}
+
+	_, err := buildABCIPPTiers(ac, []abcipp.TierConfig{
+		{Name: "dup", IBCRelayer: true},
+		{Name: "dup", IBCRelayer: true},
+	})
+	require.Error(t, err)
+
+	_, err = buildABCIPPTiers(ac, []abcipp.TierConfig{
+		{Name: "a", IBCRelayer: true, ReservedBlockShare: "0.6"},
+		{Name: "b", MsgTypeURLs: []string{"/x"}, ReservedBlockShare: "0.6"},
+	})
+	require.Error(t, err)
+}
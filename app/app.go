@@ -62,6 +62,7 @@ import (
 	"github.com/initia-labs/initia/app/keepers"
 	"github.com/initia-labs/initia/app/params"
 	upgrades_v1_4_5 "github.com/initia-labs/initia/app/upgrades/v1_4_5"
+	upgrades_v1_5_0 "github.com/initia-labs/initia/app/upgrades/v1_5_0"
 	cryptocodec "github.com/initia-labs/initia/crypto/codec"
 	initiatx "github.com/initia-labs/initia/tx"
 	moveconfig "github.com/initia-labs/initia/x/move/config"
@@ -132,6 +133,9 @@ type InitiaApp struct {
 
 	// Override of BaseApp's CheckTx
 	checkTxHandler abcipp.CheckTx
+
+	// serve the operator-only mempool snapshot query
+	mempoolSnapshotQuery bool
 }
 
 // NewInitiaApp returns a reference to an initialized Initia.
@@ -262,6 +266,7 @@ func NewInitiaApp(
 	// but this isn't required during initial encoding config setup.
 	if loadLatest {
 		upgrades_v1_4_5.RegisterUpgradeHandlers(app)
+		upgrades_v1_5_0.RegisterUpgradeHandlers(app)
 	}
 
 	autocliv1.RegisterQueryServer(app.GRPCQueryRouter(), runtimeservices.NewAutoCLIQueryService(app.ModuleManager.Modules))
@@ -530,7 +535,7 @@ func (app *InitiaApp) RegisterTxService(clientCtx client.Context) {
 		panic("mempool is not a abcipp.Mempool")
 	}
 
-	abcipp.RegisterQueryServer(app.GRPCQueryRouter(), mempool)
+	abcipp.RegisterQueryServer(app.GRPCQueryRouter(), mempool, app.mempoolSnapshotQuery)
 }
 
 // RegisterTendermintService implements the Application.RegisterTendermintService method.
@@ -324,18 +324,6 @@ func NewAppKeeper(
 	)
 	appKeepers.GroupKeeper = &groupKeeper
 
-	dynamicFeeKeeper := dynamicfeekeeper.NewKeeper(
-		appCodec,
-		runtime.NewKVStoreService(appKeepers.keys[dynamicfeetypes.StoreKey]),
-		runtime.NewTransientStoreService(appKeepers.tkeys[dynamicfeetypes.TStoreKey]),
-		movekeeper.NewDexKeeper(appKeepers.MoveKeeper),
-		appKeepers.MoveKeeper,
-		appKeepers.MoveKeeper,
-		ac,
-		authorityAddr,
-	)
-	appKeepers.DynamicFeeKeeper = dynamicFeeKeeper
-
 	// Create IBC Keeper
 	appKeepers.IBCKeeper = ibckeeper.NewKeeper(
 		appCodec,
@@ -384,6 +372,20 @@ func NewAppKeeper(
 	// and propagated to the oracle keeper.
 	appKeepers.MarketMapKeeper.SetHooks(appKeepers.OracleKeeper.Hooks())
 
+	dynamicFeeKeeper := dynamicfeekeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(appKeepers.keys[dynamicfeetypes.StoreKey]),
+		runtime.NewTransientStoreService(appKeepers.tkeys[dynamicfeetypes.TStoreKey]),
+		movekeeper.NewDexKeeper(appKeepers.MoveKeeper),
+		appKeepers.MoveKeeper,
+		appKeepers.MoveKeeper,
+		appKeepers.BankKeeper,
+		appKeepers.OracleKeeper,
+		ac,
+		authorityAddr,
+	)
+	appKeepers.DynamicFeeKeeper = dynamicFeeKeeper
+
 	appKeepers.IBCHooksKeeper = ibchookskeeper.NewKeeper(
 		appCodec,
 		runtime.NewKVStoreService(appKeepers.keys[ibchookstypes.StoreKey]),
@@ -57,6 +57,7 @@ import (
 	"github.com/initia-labs/initia/x/evidence"
 	"github.com/initia-labs/initia/x/genutil"
 	"github.com/initia-labs/initia/x/gov"
+	customgovtypes "github.com/initia-labs/initia/x/gov/types"
 	ibchooks "github.com/initia-labs/initia/x/ibc-hooks"
 	ibchookstypes "github.com/initia-labs/initia/x/ibc-hooks/types"
 	"github.com/initia-labs/initia/x/move"
@@ -86,16 +87,19 @@ import (
 )
 
 var maccPerms = map[string][]string{
-	authtypes.FeeCollectorName:      nil,
-	distrtypes.ModuleName:           nil,
-	icatypes.ModuleName:             nil,
-	ibcfeetypes.ModuleName:          nil,
-	rewardtypes.ModuleName:          nil,
-	stakingtypes.BondedPoolName:     {authtypes.Burner, authtypes.Staking},
-	stakingtypes.NotBondedPoolName:  {authtypes.Burner, authtypes.Staking},
-	govtypes.ModuleName:             {authtypes.Burner},
-	ibctransfertypes.ModuleName:     {authtypes.Minter, authtypes.Burner},
-	movetypes.MoveStakingModuleName: nil,
+	authtypes.FeeCollectorName:        nil,
+	distrtypes.ModuleName:             nil,
+	icatypes.ModuleName:               nil,
+	ibcfeetypes.ModuleName:            nil,
+	rewardtypes.ModuleName:            nil,
+	stakingtypes.BondedPoolName:       {authtypes.Burner, authtypes.Staking},
+	stakingtypes.NotBondedPoolName:    {authtypes.Burner, authtypes.Staking},
+	stakingtypes.ModuleName:           {authtypes.Minter, authtypes.Burner},
+	govtypes.ModuleName:               {authtypes.Burner},
+	customgovtypes.VotingLockPoolName: nil,
+	ibctransfertypes.ModuleName:       {authtypes.Minter, authtypes.Burner},
+	movetypes.MoveStakingModuleName:   nil,
+	dynamicfeetypes.ModuleName:        {authtypes.Burner},
 	// connect oracle permissions
 	oracletypes.ModuleName:    nil,
 	marketmaptypes.ModuleName: nil,
@@ -203,6 +207,7 @@ func orderEndBlockers() []string {
 	return []string{
 		crisistypes.ModuleName,
 		govtypes.ModuleName,
+		distrtypes.ModuleName,
 		stakingtypes.ModuleName,
 		evidencetypes.ModuleName,
 		authz.ModuleName,
@@ -212,6 +217,7 @@ func orderEndBlockers() []string {
 		marketmaptypes.ModuleName,
 		forwardingtypes.ModuleName,
 		ratelimittypes.ModuleName,
+		movetypes.ModuleName,
 		dynamicfeetypes.ModuleName,
 		ophosttypes.ModuleName,
 	}
@@ -0,0 +1,26 @@
+package v1_5_0
+
+import (
+	"context"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/initia-labs/initia/app/upgrades"
+)
+
+const upgradeName = "v1.5.0"
+
+// RegisterUpgradeHandlers returns upgrade handlers
+func RegisterUpgradeHandlers(app upgrades.InitiaApp) {
+	app.GetUpgradeKeeper().SetUpgradeHandler(
+		upgradeName,
+		func(ctx context.Context, _ upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+			// run the module migrations
+			// - move: build the denom owners index from the primary fungible stores
+			// - mstaking: set the key rotation fee and liquid staking caps
+			return app.GetModuleManager().RunMigrations(ctx, app.GetConfigurator(), vm)
+		},
+	)
+}
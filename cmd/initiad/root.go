@@ -39,6 +39,7 @@ import (
 	cosmosgenutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 
+	abcippcli "github.com/initia-labs/initia/abcipp/client/cli"
 	initiaapp "github.com/initia-labs/initia/app"
 	"github.com/initia-labs/initia/app/keepers"
 	"github.com/initia-labs/initia/app/params"
@@ -205,10 +206,14 @@ func NewRootCmd() (*cobra.Command, params.EncodingConfig) {
 func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig, basicManager module.BasicManager) {
 	a := appCreator{encodingConfig: encodingConfig}
 
+	// add mempool snapshot tools to the debug commands
+	debugCmd := debug.Cmd()
+	debugCmd.AddCommand(abcippcli.MempoolSnapshotCmd())
+
 	rootCmd.AddCommand(
 		genutilcli.InitCmd(basicManager, initiaapp.DefaultNodeHome),
 		quickstart.QuickstartCmd(initiaapp.DefaultNodeHome),
-		debug.Cmd(),
+		debugCmd,
 		confixcmd.ConfigCommand(),
 		pruning.Cmd(a.newApp, initiaapp.DefaultNodeHome),
 		snapshot.Cmd(a.newApp),
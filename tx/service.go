@@ -13,6 +13,7 @@ import (
 	sdktxtypes "github.com/cosmos/cosmos-sdk/types/tx"
 
 	txtypes "github.com/initia-labs/initia/tx/types"
+	dynamicfeetypes "github.com/initia-labs/initia/x/dynamic-fee/types"
 
 	txcli "github.com/initia-labs/initia/tx/cli"
 )
@@ -25,6 +26,7 @@ type txQueryServer struct {
 type GasPriceKeeper interface {
 	GasPrices(ctx context.Context) (sdk.DecCoins, error)
 	GasPrice(ctx context.Context, denom string) (sdk.DecCoin, error)
+	GetFeeHistory(ctx context.Context, blockCount uint64, percentiles []uint32) (dynamicfeetypes.FeeHistory, error)
 }
 
 func NewTxQueryServer(clientCtx client.Context, k GasPriceKeeper) txtypes.QueryServer {
@@ -51,6 +53,20 @@ func (t *txQueryServer) GasPrice(ctx context.Context, req *txtypes.QueryGasPrice
 	return &txtypes.QueryGasPriceResponse{GasPrice: price}, nil
 }
 
+// FeeHistory implements QueryServer.
+func (t *txQueryServer) FeeHistory(ctx context.Context, req *txtypes.QueryFeeHistoryRequest) (*txtypes.QueryFeeHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+
+	feeHistory, err := t.gpk.GetFeeHistory(ctx, req.BlockCount, req.RewardPercentiles)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txtypes.QueryFeeHistoryResponse{FeeHistory: feeHistory}, nil
+}
+
 // TxsByEvents implements QueryServer.
 func (t *txQueryServer) TxsByEvents(ctx context.Context, req *txtypes.TxsByEventsRequest) (*txtypes.TxsByEventsResponse, error) {
 	if req == nil {
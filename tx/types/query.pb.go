@@ -12,6 +12,7 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	types1 "github.com/initia-labs/initia/x/dynamic-fee/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -205,6 +206,109 @@ func (m *QueryGasPriceResponse) GetGasPrice() github_com_cosmos_cosmos_sdk_types
 	return github_com_cosmos_cosmos_sdk_types.DecCoin{}
 }
 
+// QueryFeeHistoryRequest is the request type for the Query/FeeHistory RPC method.
+type QueryFeeHistoryRequest struct {
+	// block_count is the number of most recent blocks to return. Zero returns
+	// the whole history.
+	BlockCount uint64 `protobuf:"varint,1,opt,name=block_count,json=blockCount,proto3" json:"block_count,omitempty"`
+	// reward_percentiles are the ascending percentiles, from 0 to 100, of the
+	// tip gas prices to return for each block.
+	RewardPercentiles []uint32 `protobuf:"varint,2,rep,packed,name=reward_percentiles,json=rewardPercentiles,proto3" json:"reward_percentiles,omitempty"`
+}
+
+func (m *QueryFeeHistoryRequest) Reset()         { *m = QueryFeeHistoryRequest{} }
+func (m *QueryFeeHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeHistoryRequest) ProtoMessage()    {}
+func (*QueryFeeHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8b8303a398512dd5, []int{4}
+}
+func (m *QueryFeeHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeHistoryRequest.Merge(m, src)
+}
+func (m *QueryFeeHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryFeeHistoryRequest) GetBlockCount() uint64 {
+	if m != nil {
+		return m.BlockCount
+	}
+	return 0
+}
+
+func (m *QueryFeeHistoryRequest) GetRewardPercentiles() []uint32 {
+	if m != nil {
+		return m.RewardPercentiles
+	}
+	return nil
+}
+
+// QueryFeeHistoryResponse is the response type for the Query/FeeHistory RPC method.
+type QueryFeeHistoryResponse struct {
+	// fee_history is valued in the base denom.
+	FeeHistory types1.FeeHistory `protobuf:"bytes,1,opt,name=fee_history,json=feeHistory,proto3" json:"fee_history"`
+}
+
+func (m *QueryFeeHistoryResponse) Reset()         { *m = QueryFeeHistoryResponse{} }
+func (m *QueryFeeHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeHistoryResponse) ProtoMessage()    {}
+func (*QueryFeeHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8b8303a398512dd5, []int{5}
+}
+func (m *QueryFeeHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeHistoryResponse.Merge(m, src)
+}
+func (m *QueryFeeHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryFeeHistoryResponse) GetFeeHistory() types1.FeeHistory {
+	if m != nil {
+		return m.FeeHistory
+	}
+	return types1.FeeHistory{}
+}
+
 // TxsByEventsRequest is the request type for the Service.TxsByEvents
 // RPC method.
 type TxsByEventsRequest struct {
@@ -223,7 +327,7 @@ func (m *TxsByEventsRequest) Reset()         { *m = TxsByEventsRequest{} }
 func (m *TxsByEventsRequest) String() string { return proto.CompactTextString(m) }
 func (*TxsByEventsRequest) ProtoMessage()    {}
 func (*TxsByEventsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8b8303a398512dd5, []int{4}
+	return fileDescriptor_8b8303a398512dd5, []int{6}
 }
 func (m *TxsByEventsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -288,7 +392,7 @@ func (m *TxsByEventsResponse) Reset()         { *m = TxsByEventsResponse{} }
 func (m *TxsByEventsResponse) String() string { return proto.CompactTextString(m) }
 func (*TxsByEventsResponse) ProtoMessage()    {}
 func (*TxsByEventsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8b8303a398512dd5, []int{5}
+	return fileDescriptor_8b8303a398512dd5, []int{7}
 }
 func (m *TxsByEventsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -343,6 +447,8 @@ func init() {
 	proto.RegisterType((*QueryGasPricesResponse)(nil), "initia.tx.v1.QueryGasPricesResponse")
 	proto.RegisterType((*QueryGasPriceRequest)(nil), "initia.tx.v1.QueryGasPriceRequest")
 	proto.RegisterType((*QueryGasPriceResponse)(nil), "initia.tx.v1.QueryGasPriceResponse")
+	proto.RegisterType((*QueryFeeHistoryRequest)(nil), "initia.tx.v1.QueryFeeHistoryRequest")
+	proto.RegisterType((*QueryFeeHistoryResponse)(nil), "initia.tx.v1.QueryFeeHistoryResponse")
 	proto.RegisterType((*TxsByEventsRequest)(nil), "initia.tx.v1.TxsByEventsRequest")
 	proto.RegisterType((*TxsByEventsResponse)(nil), "initia.tx.v1.TxsByEventsResponse")
 }
@@ -350,45 +456,54 @@ func init() {
 func init() { proto.RegisterFile("initia/tx/v1/query.proto", fileDescriptor_8b8303a398512dd5) }
 
 var fileDescriptor_8b8303a398512dd5 = []byte{
-	// 597 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
-	0x18, 0x8d, 0xd3, 0x16, 0x35, 0xd7, 0x0e, 0xf4, 0xda, 0x82, 0xb1, 0x2a, 0x27, 0x5c, 0x2b, 0xa8,
-	0x80, 0xfa, 0x94, 0xb2, 0x31, 0xa1, 0xf2, 0xa3, 0x8c, 0x60, 0x75, 0x62, 0xa9, 0xce, 0xee, 0xc9,
-	0x9c, 0x88, 0x7d, 0x6e, 0xee, 0x12, 0x39, 0x42, 0x1d, 0x40, 0x62, 0x47, 0x62, 0x44, 0x48, 0xcc,
-	0xfc, 0x25, 0x1d, 0x2b, 0xb1, 0x30, 0x05, 0x94, 0xb0, 0x23, 0x31, 0x32, 0x21, 0xdf, 0x9d, 0x53,
-	0x3b, 0x40, 0x60, 0xca, 0x7d, 0xf7, 0xfd, 0x78, 0xef, 0xe5, 0x7b, 0x67, 0x60, 0xb3, 0x84, 0x49,
-	0x46, 0xb0, 0xcc, 0x70, 0xbf, 0x8d, 0x8f, 0x7b, 0xb4, 0x3b, 0xf0, 0xd2, 0x2e, 0x97, 0x1c, 0x2e,
-	0xeb, 0x8c, 0x27, 0x33, 0xaf, 0xdf, 0x76, 0x36, 0x43, 0x2e, 0x62, 0x2e, 0x70, 0x40, 0x04, 0xc5,
-	0x24, 0x08, 0x19, 0xee, 0xb7, 0x03, 0x2a, 0x49, 0x5b, 0x05, 0xba, 0xc5, 0x71, 0xcb, 0x45, 0x45,
-	0x3e, 0xe4, 0x2c, 0x31, 0x79, 0xc7, 0xe4, 0x15, 0x98, 0xce, 0xca, 0xcc, 0xe4, 0xd6, 0x22, 0x1e,
-	0x71, 0x75, 0xc4, 0xf9, 0xc9, 0xdc, 0x6e, 0x44, 0x9c, 0x47, 0x1d, 0x8a, 0x49, 0xca, 0x30, 0x49,
-	0x12, 0x2e, 0x89, 0x64, 0x3c, 0x11, 0x3a, 0x8b, 0x2e, 0x83, 0xf5, 0x27, 0x39, 0xe3, 0x7d, 0x22,
-	0x1e, 0x77, 0x59, 0x48, 0x85, 0x4f, 0x8f, 0x7b, 0x54, 0x48, 0xf4, 0xc1, 0x02, 0x97, 0xa6, 0x33,
-	0x22, 0xe5, 0x89, 0xa0, 0xf0, 0xb5, 0x05, 0x40, 0x44, 0xc4, 0x61, 0xaa, 0xae, 0x6d, 0xab, 0x35,
-	0xb7, 0xbd, 0xb4, 0xbb, 0xe1, 0x69, 0x66, 0x5e, 0xce, 0xdc, 0x33, 0xdc, 0xbc, 0xfb, 0x34, 0xbc,
-	0xc7, 0x59, 0xb2, 0xf7, 0xe8, 0x74, 0xd8, 0xac, 0xfd, 0x18, 0x36, 0x57, 0x06, 0x24, 0xee, 0xdc,
-	0x41, 0xe7, 0xdd, 0xe8, 0xe3, 0x97, 0xe6, 0xcd, 0x88, 0xc9, 0x67, 0xbd, 0xc0, 0x0b, 0x79, 0x8c,
-	0x8d, 0x3c, 0xfd, 0xb3, 0x23, 0x8e, 0x9e, 0x63, 0x39, 0x48, 0xa9, 0x28, 0x06, 0x09, 0xbf, 0x11,
-	0x15, 0x7c, 0xd0, 0x2d, 0xb0, 0x56, 0x61, 0x68, 0xa8, 0xc3, 0x35, 0xb0, 0x70, 0x44, 0x13, 0x1e,
-	0xdb, 0x56, 0xcb, 0xda, 0x6e, 0xf8, 0x3a, 0x40, 0xef, 0xac, 0x29, 0xa9, 0x13, 0x3d, 0x2f, 0x2d,
-	0xd0, 0x98, 0x30, 0x52, 0x4d, 0xff, 0x92, 0xf3, 0xd0, 0xc8, 0xb9, 0x38, 0x25, 0x07, 0xfd, 0x1c,
-	0x36, 0x6f, 0xfc, 0xbf, 0x1a, 0x7f, 0xb1, 0x10, 0x83, 0x0e, 0x00, 0x3c, 0xc8, 0xc4, 0xde, 0xe0,
-	0x41, 0x9f, 0x26, 0xb2, 0x58, 0x02, 0x84, 0x60, 0x3e, 0x25, 0x91, 0xe6, 0x34, 0xef, 0xab, 0x73,
-	0xae, 0xae, 0xc3, 0x62, 0x26, 0xed, 0xba, 0xba, 0xd4, 0x41, 0x7e, 0xab, 0x9c, 0x67, 0xcf, 0x69,
-	0xcd, 0x2a, 0x40, 0xef, 0x2d, 0xb0, 0x5a, 0x19, 0x6b, 0x14, 0x5f, 0x07, 0x73, 0x32, 0x2b, 0x36,
-	0xb7, 0x5e, 0x48, 0x55, 0x36, 0xd5, 0x42, 0x0f, 0x32, 0x3f, 0xaf, 0x80, 0xfb, 0x60, 0x59, 0x66,
-	0x87, 0x5d, 0xd3, 0x27, 0xec, 0xba, 0xea, 0xd8, 0xaa, 0xfc, 0x39, 0xca, 0xbd, 0xa5, 0x46, 0x53,
-	0xec, 0x2f, 0xc9, 0xc9, 0x59, 0xe4, 0xfc, 0x24, 0x97, 0xa4, 0xa3, 0xf8, 0xcd, 0xfb, 0x3a, 0xd8,
-	0xfd, 0x5e, 0x07, 0x0b, 0x6a, 0x27, 0x30, 0x03, 0x8d, 0x89, 0xd1, 0xe0, 0xa6, 0x57, 0x7e, 0x38,
-	0xde, 0x1f, 0x0d, 0xea, 0x6c, 0xcd, 0x2e, 0xd2, 0xc0, 0xa8, 0xf5, 0xea, 0xd3, 0xb7, 0xb7, 0x75,
-	0x07, 0xda, 0xb8, 0xf2, 0x4a, 0xcf, 0x0d, 0x08, 0x4f, 0xc0, 0x62, 0xd1, 0x06, 0xd1, 0x8c, 0x99,
-	0x05, 0xee, 0xe6, 0xcc, 0x1a, 0x03, 0xbb, 0xad, 0x60, 0x11, 0x6c, 0xfd, 0x0d, 0x16, 0xbf, 0x50,
-	0xae, 0x3c, 0x81, 0x31, 0x58, 0x2a, 0x6d, 0x08, 0xb6, 0xaa, 0xd3, 0x7f, 0xf7, 0x84, 0x73, 0x75,
-	0x46, 0x85, 0x41, 0xbf, 0xa2, 0xd0, 0x57, 0xe1, 0x4a, 0x15, 0x5d, 0x66, 0x62, 0xef, 0xee, 0xe9,
-	0xc8, 0xb5, 0xce, 0x46, 0xae, 0xf5, 0x75, 0xe4, 0x5a, 0x6f, 0xc6, 0x6e, 0xed, 0x6c, 0xec, 0xd6,
-	0x3e, 0x8f, 0xdd, 0xda, 0xd3, 0x6b, 0x25, 0xdf, 0xea, 0xb6, 0x9d, 0x0e, 0x09, 0x44, 0x69, 0x84,
-	0xf2, 0x6e, 0x70, 0x41, 0x7d, 0x38, 0x6e, 0xff, 0x0a, 0x00, 0x00, 0xff, 0xff, 0xbc, 0x81, 0xc2,
-	0xbf, 0xf7, 0x04, 0x00, 0x00,
+	// 739 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcf, 0x4f, 0x13, 0x4f,
+	0x14, 0xef, 0xd2, 0xf2, 0x0d, 0x9d, 0xf2, 0x4d, 0x64, 0x00, 0x29, 0x95, 0xb4, 0xcb, 0x80, 0xda,
+	0xa8, 0xec, 0xa6, 0x78, 0xf3, 0x64, 0x8a, 0x02, 0x47, 0xdc, 0x70, 0xf2, 0xd2, 0xcc, 0x6e, 0x87,
+	0x65, 0x42, 0xbb, 0x53, 0x76, 0xa6, 0x75, 0x1b, 0xe5, 0xa0, 0x89, 0x77, 0x13, 0x8f, 0xc6, 0xc4,
+	0xb3, 0x7f, 0x09, 0x47, 0x12, 0x2f, 0x9e, 0xd0, 0x80, 0x57, 0x2f, 0x1e, 0x3d, 0x99, 0x9d, 0x9d,
+	0xdd, 0xee, 0x02, 0x16, 0x4f, 0xbb, 0xf3, 0x7e, 0xcc, 0xe7, 0xf3, 0x79, 0xf3, 0xde, 0x03, 0x65,
+	0xea, 0x51, 0x41, 0xb1, 0x29, 0x02, 0x73, 0xd0, 0x30, 0x0f, 0xfb, 0xc4, 0x1f, 0x1a, 0x3d, 0x9f,
+	0x09, 0x06, 0xa7, 0x23, 0x8f, 0x21, 0x02, 0x63, 0xd0, 0xa8, 0xac, 0x38, 0x8c, 0x77, 0x19, 0x37,
+	0x6d, 0xcc, 0x89, 0x89, 0x6d, 0x87, 0x9a, 0x83, 0x86, 0x4d, 0x04, 0x6e, 0xc8, 0x43, 0x94, 0x52,
+	0xa9, 0xa6, 0x83, 0x62, 0xbf, 0xc3, 0xa8, 0xa7, 0xfc, 0x15, 0xe5, 0x17, 0x41, 0xe2, 0x15, 0x81,
+	0xf2, 0xcd, 0xb9, 0xcc, 0x65, 0xf2, 0xd7, 0x0c, 0xff, 0x94, 0x75, 0xc9, 0x65, 0xcc, 0xed, 0x10,
+	0x13, 0xf7, 0xa8, 0x89, 0x3d, 0x8f, 0x09, 0x2c, 0x28, 0xf3, 0xb8, 0xf2, 0xea, 0x8a, 0x7c, 0x7b,
+	0xe8, 0xe1, 0x2e, 0x75, 0xf6, 0x48, 0x88, 0x6a, 0x8a, 0x61, 0x8f, 0xa8, 0x08, 0xb4, 0x00, 0xe6,
+	0x9f, 0x85, 0x9a, 0xb6, 0x30, 0xdf, 0xf1, 0xa9, 0x43, 0xb8, 0x45, 0x0e, 0xfb, 0x84, 0x0b, 0xf4,
+	0x49, 0x03, 0x37, 0x2f, 0x7a, 0x78, 0x8f, 0x79, 0x9c, 0xc0, 0xb7, 0x1a, 0x00, 0x2e, 0xe6, 0xad,
+	0x9e, 0x34, 0x97, 0x35, 0x3d, 0x5f, 0x2f, 0xad, 0x2f, 0x19, 0x11, 0x77, 0x23, 0xd4, 0x66, 0x28,
+	0xf6, 0xc6, 0x13, 0xe2, 0x6c, 0x30, 0xea, 0x35, 0xb7, 0x8f, 0x4f, 0x6b, 0xb9, 0x5f, 0xa7, 0xb5,
+	0x99, 0x21, 0xee, 0x76, 0x1e, 0xa1, 0x51, 0x36, 0xfa, 0xfc, 0xad, 0x76, 0xdf, 0xa5, 0x62, 0xbf,
+	0x6f, 0x1b, 0x0e, 0xeb, 0x9a, 0xaa, 0x00, 0xd1, 0x67, 0x8d, 0xb7, 0x0f, 0x14, 0x5b, 0x75, 0x11,
+	0xb7, 0x8a, 0x6e, 0xcc, 0x07, 0x3d, 0x00, 0x73, 0x19, 0x86, 0x8a, 0x3a, 0x9c, 0x03, 0x93, 0x6d,
+	0xe2, 0xb1, 0x6e, 0x59, 0xd3, 0xb5, 0x7a, 0xd1, 0x8a, 0x0e, 0xe8, 0x83, 0x76, 0x41, 0x6a, 0xa2,
+	0xe7, 0xb5, 0x06, 0x8a, 0x09, 0x23, 0x99, 0x74, 0x9d, 0x9c, 0x4d, 0x25, 0xe7, 0xc6, 0x05, 0x39,
+	0xe8, 0xf7, 0x69, 0xed, 0xde, 0xbf, 0xab, 0xb1, 0xa6, 0x62, 0x31, 0x68, 0x5f, 0x55, 0x7b, 0x93,
+	0x90, 0x6d, 0xca, 0x05, 0xf3, 0x87, 0xb1, 0x9a, 0x1a, 0x28, 0xd9, 0x1d, 0xe6, 0x1c, 0xb4, 0x1c,
+	0xd6, 0xf7, 0x84, 0xa4, 0x57, 0xb0, 0x80, 0x34, 0x6d, 0x84, 0x16, 0xb8, 0x06, 0xa0, 0x4f, 0x5e,
+	0x60, 0xbf, 0xdd, 0xea, 0x11, 0xdf, 0x21, 0x9e, 0xa0, 0x1d, 0xc2, 0xcb, 0x13, 0x7a, 0xbe, 0xfe,
+	0xbf, 0x35, 0x13, 0x79, 0x76, 0x46, 0x0e, 0x64, 0x83, 0x85, 0x4b, 0x48, 0xaa, 0x10, 0x5b, 0xa0,
+	0xb4, 0x47, 0x48, 0x6b, 0x3f, 0x32, 0xab, 0x4a, 0xe8, 0x86, 0xea, 0xf3, 0x51, 0x13, 0x19, 0x83,
+	0x86, 0x31, 0x4a, 0x6f, 0x16, 0xc2, 0x6a, 0x58, 0x60, 0x2f, 0xb1, 0xa0, 0x5d, 0x00, 0x77, 0x03,
+	0xde, 0x1c, 0x3e, 0x1d, 0x10, 0x4f, 0xc4, 0x2d, 0x05, 0x21, 0x28, 0xf4, 0xb0, 0x4b, 0x94, 0x04,
+	0xf9, 0x1f, 0xbe, 0x55, 0x87, 0x76, 0xa9, 0x28, 0x4f, 0x48, 0x63, 0x74, 0x08, 0xad, 0x72, 0xd2,
+	0xca, 0xf9, 0xe8, 0x05, 0xe5, 0x01, 0x7d, 0xd4, 0xc0, 0x6c, 0xe6, 0x5a, 0x45, 0xfb, 0x2e, 0xc8,
+	0x8b, 0x20, 0xee, 0xc3, 0xf9, 0xf8, 0xe1, 0x44, 0x90, 0x3c, 0xdb, 0x6e, 0x60, 0x85, 0x11, 0x70,
+	0x0b, 0x4c, 0x8b, 0xa0, 0xe5, 0xab, 0xbc, 0xa8, 0x46, 0xa5, 0xf5, 0xd5, 0xcc, 0x53, 0xcb, 0x69,
+	0x4d, 0x25, 0xaa, 0x60, 0xab, 0x24, 0x92, 0x7f, 0x1e, 0xf2, 0x13, 0x4c, 0xe0, 0x8e, 0xe4, 0x57,
+	0xb0, 0xa2, 0xc3, 0xfa, 0xcf, 0x3c, 0x98, 0x94, 0xa5, 0x85, 0x01, 0x28, 0x26, 0x63, 0x03, 0x57,
+	0x8c, 0xf4, 0xa2, 0x30, 0xae, 0x1c, 0xb7, 0xca, 0xea, 0xf8, 0xa0, 0x08, 0x18, 0xe9, 0x6f, 0xbe,
+	0xfc, 0x78, 0x3f, 0x51, 0x81, 0x65, 0x33, 0xb3, 0x95, 0x46, 0xe3, 0x04, 0x8f, 0xc0, 0x54, 0x9c,
+	0x06, 0xd1, 0x98, 0x3b, 0x63, 0xdc, 0x95, 0xb1, 0x31, 0x0a, 0xb6, 0x2e, 0x61, 0x11, 0xd4, 0xff,
+	0x06, 0x6b, 0xbe, 0x94, 0x33, 0x76, 0x04, 0x5f, 0x01, 0x30, 0x6a, 0x0c, 0x78, 0x95, 0xa8, 0x4b,
+	0x0d, 0x5e, 0xb9, 0x7d, 0x4d, 0x94, 0x22, 0xb1, 0x2c, 0x49, 0xdc, 0x82, 0x8b, 0x59, 0x12, 0xa9,
+	0x86, 0x85, 0x5d, 0x50, 0x4a, 0xf5, 0x07, 0xd4, 0xb3, 0x17, 0x5f, 0xee, 0xc8, 0xca, 0xf2, 0x98,
+	0x08, 0x05, 0xbb, 0x28, 0x61, 0x67, 0xe1, 0x4c, 0x16, 0x56, 0x04, 0xbc, 0xf9, 0xf8, 0xf8, 0xac,
+	0xaa, 0x9d, 0x9c, 0x55, 0xb5, 0xef, 0x67, 0x55, 0xed, 0xdd, 0x79, 0x35, 0x77, 0x72, 0x5e, 0xcd,
+	0x7d, 0x3d, 0xaf, 0xe6, 0x9e, 0xdf, 0x49, 0xed, 0x80, 0x28, 0x6d, 0xad, 0x83, 0x6d, 0x9e, 0xba,
+	0x42, 0xee, 0x01, 0xfb, 0x3f, 0xb9, 0x84, 0x1f, 0xfe, 0x19, 0x00, 0xf4, 0xf6, 0xc0, 0x48, 0x65,
+	0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -407,6 +522,9 @@ type QueryClient interface {
 	GasPrices(ctx context.Context, in *QueryGasPricesRequest, opts ...grpc.CallOption) (*QueryGasPricesResponse, error)
 	// GasPrice returns the gas price for the network.
 	GasPrice(ctx context.Context, in *QueryGasPriceRequest, opts ...grpc.CallOption) (*QueryGasPriceResponse, error)
+	// FeeHistory returns the base gas price history of the most recent blocks
+	// and the percentiles of the tip gas prices paid by their txs.
+	FeeHistory(ctx context.Context, in *QueryFeeHistoryRequest, opts ...grpc.CallOption) (*QueryFeeHistoryResponse, error)
 	// TxsByEvents fetches transactions by event criteria. This method proxies to CometBFT's TxSearchV2 RPC endpoint
 	// to efficiently search and retrieve transactions matching specified event conditions.
 	TxsByEvents(ctx context.Context, in *TxsByEventsRequest, opts ...grpc.CallOption) (*TxsByEventsResponse, error)
@@ -438,6 +556,15 @@ func (c *queryClient) GasPrice(ctx context.Context, in *QueryGasPriceRequest, op
 	return out, nil
 }
 
+func (c *queryClient) FeeHistory(ctx context.Context, in *QueryFeeHistoryRequest, opts ...grpc.CallOption) (*QueryFeeHistoryResponse, error) {
+	out := new(QueryFeeHistoryResponse)
+	err := c.cc.Invoke(ctx, "/initia.tx.v1.Query/FeeHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) TxsByEvents(ctx context.Context, in *TxsByEventsRequest, opts ...grpc.CallOption) (*TxsByEventsResponse, error) {
 	out := new(TxsByEventsResponse)
 	err := c.cc.Invoke(ctx, "/initia.tx.v1.Query/TxsByEvents", in, out, opts...)
@@ -453,6 +580,9 @@ type QueryServer interface {
 	GasPrices(context.Context, *QueryGasPricesRequest) (*QueryGasPricesResponse, error)
 	// GasPrice returns the gas price for the network.
 	GasPrice(context.Context, *QueryGasPriceRequest) (*QueryGasPriceResponse, error)
+	// FeeHistory returns the base gas price history of the most recent blocks
+	// and the percentiles of the tip gas prices paid by their txs.
+	FeeHistory(context.Context, *QueryFeeHistoryRequest) (*QueryFeeHistoryResponse, error)
 	// TxsByEvents fetches transactions by event criteria. This method proxies to CometBFT's TxSearchV2 RPC endpoint
 	// to efficiently search and retrieve transactions matching specified event conditions.
 	TxsByEvents(context.Context, *TxsByEventsRequest) (*TxsByEventsResponse, error)
@@ -468,6 +598,9 @@ func (*UnimplementedQueryServer) GasPrices(ctx context.Context, req *QueryGasPri
 func (*UnimplementedQueryServer) GasPrice(ctx context.Context, req *QueryGasPriceRequest) (*QueryGasPriceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GasPrice not implemented")
 }
+func (*UnimplementedQueryServer) FeeHistory(ctx context.Context, req *QueryFeeHistoryRequest) (*QueryFeeHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeHistory not implemented")
+}
 func (*UnimplementedQueryServer) TxsByEvents(ctx context.Context, req *TxsByEventsRequest) (*TxsByEventsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TxsByEvents not implemented")
 }
@@ -512,6 +645,24 @@ func _Query_GasPrice_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FeeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.tx.v1.Query/FeeHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeHistory(ctx, req.(*QueryFeeHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_TxsByEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TxsByEventsRequest)
 	if err := dec(in); err != nil {
@@ -543,6 +694,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GasPrice",
 			Handler:    _Query_GasPrice_Handler,
 		},
+		{
+			MethodName: "FeeHistory",
+			Handler:    _Query_FeeHistory_Handler,
+		},
 		{
 			MethodName: "TxsByEvents",
 			Handler:    _Query_TxsByEvents_Handler,
@@ -675,6 +830,85 @@ func (m *QueryGasPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryFeeHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardPercentiles) > 0 {
+		dAtA3 := make([]byte, len(m.RewardPercentiles)*10)
+		var j2 int
+		for _, num := range m.RewardPercentiles {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintQuery(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BlockCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FeeHistory.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *TxsByEventsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -830,6 +1064,36 @@ func (m *QueryGasPriceResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryFeeHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockCount != 0 {
+		n += 1 + sovQuery(uint64(m.BlockCount))
+	}
+	if len(m.RewardPercentiles) > 0 {
+		l = 0
+		for _, e := range m.RewardPercentiles {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QueryFeeHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FeeHistory.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func (m *TxsByEventsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1178,6 +1442,234 @@ func (m *QueryGasPriceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryFeeHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockCount", wireType)
+			}
+			m.BlockCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RewardPercentiles = append(m.RewardPercentiles, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RewardPercentiles) == 0 {
+					m.RewardPercentiles = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RewardPercentiles = append(m.RewardPercentiles, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPercentiles", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeHistory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *TxsByEventsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -33,3 +33,10 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) error {
 	consAddr := sdk.ConsAddress(ctx.BlockHeader().ProposerAddress)
 	return k.PreviousProposerConsAddr.Set(ctx, consAddr)
 }
+
+// EndBlocker compounds the rewards of the auto-compounding delegations.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) error {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	return k.AutoCompound(ctx)
+}
@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"context"
+	"maps"
+	"slices"
 
 	"cosmossdk.io/math"
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -93,6 +95,12 @@ func (k Keeper) AllocateTokens(ctx context.Context, totalPreviousPower int64, bo
 		return err
 	}
 
+	// let the allocation policy adjust the reward weights of the validators
+	multipliers, err := k.allocationPolicy.ValidatorMultipliers(ctx, sortedValidators(validators))
+	if err != nil {
+		return err
+	}
+
 	// allocate rewards proportionally to reward power
 	for _, rewardWeight := range rewardWeights {
 		poolFraction := rewardWeight.Weight.QuoTruncate(weightsSum)
@@ -106,7 +114,18 @@ func (k Keeper) AllocateTokens(ctx context.Context, totalPreviousPower int64, bo
 			continue
 		}
 
-		for _, bondedTokens := range bondedTokens[poolDenom] {
+		// only weigh validators by the policy when it returns multipliers, so the
+		// default allocation stays the plain bonded token fraction
+		var adjustedWeights []math.LegacyDec
+		var adjustedWeightsSum math.LegacyDec
+		if len(multipliers) != 0 {
+			adjustedWeights, adjustedWeightsSum = adjustedPoolWeights(bondedTokens[poolDenom], multipliers)
+			if !adjustedWeightsSum.IsPositive() {
+				continue
+			}
+		}
+
+		for i, bondedTokens := range bondedTokens[poolDenom] {
 			if bondedTokens.Amount.IsZero() {
 				continue
 			}
@@ -114,6 +133,9 @@ func (k Keeper) AllocateTokens(ctx context.Context, totalPreviousPower int64, bo
 			validator := validators[bondedTokens.ValAddr]
 
 			amountFraction := math.LegacyNewDecFromInt(bondedTokens.Amount).QuoInt(poolSize)
+			if len(multipliers) != 0 {
+				amountFraction = adjustedWeights[i].QuoTruncate(adjustedWeightsSum)
+			}
 			reward := poolReward.MulDecTruncate(amountFraction)
 			if reward.IsZero() {
 				continue
@@ -150,6 +172,17 @@ func (k Keeper) LoadRewardWeights(ctx context.Context, params customtypes.Params
 	return rewardWeights, weightsMap, weightsSum
 }
 
+// sortedValidators returns the validators sorted by operator address.
+func sortedValidators(validators map[string]stakingtypes.ValidatorI) []stakingtypes.ValidatorI {
+	valAddrs := slices.Sorted(maps.Keys(validators))
+	sorted := make([]stakingtypes.ValidatorI, len(valAddrs))
+	for i, valAddr := range valAddrs {
+		sorted[i] = validators[valAddr]
+	}
+
+	return sorted
+}
+
 type validatorBondedToken struct {
 	ValAddr string
 	Amount  math.Int
@@ -159,21 +192,34 @@ type validatorBondedToken struct {
 func (k Keeper) LoadBondedTokens(ctx context.Context, bondedVotes []abci.VoteInfo, rewardWeights map[string]math.LegacyDec) (
 	map[string]stakingtypes.ValidatorI, map[string][]validatorBondedToken, map[string]math.Int, error,
 ) {
-	numOfValidators := len(bondedVotes)
-	numOfDenoms := len(rewardWeights)
-
-	validators := make(map[string]stakingtypes.ValidatorI, numOfValidators)
-	bondedTokens := make(map[string][]validatorBondedToken, numOfDenoms)
-	bondedTokensSum := make(map[string]math.Int, numOfDenoms)
-
+	validators := make(map[string]stakingtypes.ValidatorI, len(bondedVotes))
+	validatorList := make([]stakingtypes.ValidatorI, 0, len(bondedVotes))
 	for _, vote := range bondedVotes {
 		validator, err := k.stakingKeeper.ValidatorByConsAddr(ctx, vote.Validator.Address)
 		if err != nil {
 			return nil, nil, nil, err
 		}
 
+		validators[validator.GetOperator()] = validator
+		validatorList = append(validatorList, validator)
+	}
+
+	bondedTokens, bondedTokensSum := groupBondedTokens(validatorList, rewardWeights)
+	return validators, bondedTokens, bondedTokensSum, nil
+}
+
+// groupBondedTokens build denom:(validator:amount) map of the given validators
+func groupBondedTokens(validators []stakingtypes.ValidatorI, rewardWeights map[string]math.LegacyDec) (
+	map[string][]validatorBondedToken, map[string]math.Int,
+) {
+	numOfValidators := len(validators)
+	numOfDenoms := len(rewardWeights)
+
+	bondedTokens := make(map[string][]validatorBondedToken, numOfDenoms)
+	bondedTokensSum := make(map[string]math.Int, numOfDenoms)
+
+	for _, validator := range validators {
 		valAddr := validator.GetOperator()
-		validators[valAddr] = validator
 
 		// we don't need to check bonded status, so use val.GetTokens()
 		for _, token := range validator.GetTokens() {
@@ -197,7 +243,7 @@ func (k Keeper) LoadBondedTokens(ctx context.Context, bondedVotes []abci.VoteInf
 		}
 	}
 
-	return validators, bondedTokens, bondedTokensSum, nil
+	return bondedTokens, bondedTokensSum
 }
 
 // AllocateTokensToValidatorPool allocate tokens to a particular validator's a particular pool, splitting according to commission
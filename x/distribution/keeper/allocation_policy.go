@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"cosmossdk.io/math"
+
+	customtypes "github.com/initia-labs/initia/x/distribution/types"
+	stakingtypes "github.com/initia-labs/initia/x/mstaking/types"
+)
+
+var _ customtypes.AllocationPolicy = DecentralizationBonusPolicy{}
+
+// DecentralizationBonusPolicy is the built-in allocation policy. When enabled
+// by the params, it multiplies the reward weights of the validators ranked
+// below the top N by voting power by the bonus multiplier.
+type DecentralizationBonusPolicy struct {
+	k *Keeper
+}
+
+// NewDecentralizationBonusPolicy creates a new DecentralizationBonusPolicy instance
+func NewDecentralizationBonusPolicy(k *Keeper) DecentralizationBonusPolicy {
+	return DecentralizationBonusPolicy{k}
+}
+
+// ValidatorMultipliers implements customtypes.AllocationPolicy
+func (p DecentralizationBonusPolicy) ValidatorMultipliers(
+	ctx context.Context,
+	validators []stakingtypes.ValidatorI,
+) (map[string]math.LegacyDec, error) {
+	params, err := p.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	bonus := params.DecentralizationBonus
+	if !bonus.Enabled || len(validators) <= int(bonus.TopN) {
+		return nil, nil
+	}
+
+	multipliers := make(map[string]math.LegacyDec, len(validators)-int(bonus.TopN))
+	for _, validator := range rankValidators(validators)[bonus.TopN:] {
+		multipliers[validator.GetOperator()] = bonus.Multiplier
+	}
+
+	return multipliers, nil
+}
+
+// rankValidators sorts the validators by voting power in descending order,
+// breaking ties by operator address.
+func rankValidators(validators []stakingtypes.ValidatorI) []stakingtypes.ValidatorI {
+	ranked := slices.Clone(validators)
+	slices.SortStableFunc(ranked, func(a, b stakingtypes.ValidatorI) int {
+		powerA, powerB := a.GetVotingPower(), b.GetVotingPower()
+		if powerA.GT(powerB) {
+			return -1
+		} else if powerA.LT(powerB) {
+			return 1
+		}
+
+		return strings.Compare(a.GetOperator(), b.GetOperator())
+	})
+
+	return ranked
+}
+
+// adjustedPoolWeights returns the reward weights of the validators of a
+// reward pool after applying the multipliers, with their sum.
+func adjustedPoolWeights(
+	bondedTokens []validatorBondedToken,
+	multipliers map[string]math.LegacyDec,
+) ([]math.LegacyDec, math.LegacyDec) {
+	weights := make([]math.LegacyDec, len(bondedTokens))
+	weightsSum := math.LegacyZeroDec()
+	for i, bondedToken := range bondedTokens {
+		weight := math.LegacyNewDecFromInt(bondedToken.Amount)
+		if multiplier, found := multipliers[bondedToken.ValAddr]; found {
+			weight = weight.Mul(multiplier)
+		}
+
+		weights[i] = weight
+		weightsSum = weightsSum.Add(weight)
+	}
+
+	return weights, weightsSum
+}
+
+// ValidatorAllocation returns the effect of the allocation policy on the
+// reward weights of the validator among the current bonded validators.
+func (k Keeper) ValidatorAllocation(ctx context.Context, valAddr string) (customtypes.ValidatorAllocation, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return customtypes.ValidatorAllocation{}, err
+	}
+
+	var bondedValidators []stakingtypes.ValidatorI
+	if err := k.stakingKeeper.IterateBondedValidatorsByPower(ctx, func(validator stakingtypes.ValidatorI) (bool, error) {
+		bondedValidators = append(bondedValidators, validator)
+		return false, nil
+	}); err != nil {
+		return customtypes.ValidatorAllocation{}, err
+	}
+
+	multipliers, err := k.allocationPolicy.ValidatorMultipliers(ctx, bondedValidators)
+	if err != nil {
+		return customtypes.ValidatorAllocation{}, err
+	}
+
+	allocation := customtypes.ValidatorAllocation{
+		ValidatorAddress: valAddr,
+		Multiplier:       math.LegacyOneDec(),
+		Pools:            []customtypes.PoolAllocation{},
+	}
+	if multiplier, found := multipliers[valAddr]; found {
+		allocation.Multiplier = multiplier
+	}
+
+	for i, validator := range rankValidators(bondedValidators) {
+		if validator.GetOperator() == valAddr {
+			allocation.Rank = uint32(i + 1) //nolint:gosec
+			break
+		}
+	}
+
+	rewardWeights, rewardWeightMap, _ := k.LoadRewardWeights(ctx, params)
+	bondedTokens, bondedTokensSum := groupBondedTokens(bondedValidators, rewardWeightMap)
+	for _, rewardWeight := range rewardWeights {
+		poolSize, ok := bondedTokensSum[rewardWeight.Denom]
+		if !ok || poolSize.IsZero() {
+			continue
+		}
+
+		weights, weightsSum := adjustedPoolWeights(bondedTokens[rewardWeight.Denom], multipliers)
+		for i, bondedToken := range bondedTokens[rewardWeight.Denom] {
+			if bondedToken.ValAddr != valAddr {
+				continue
+			}
+
+			allocation.Pools = append(allocation.Pools, customtypes.PoolAllocation{
+				Denom:            rewardWeight.Denom,
+				BaseFraction:     math.LegacyNewDecFromInt(bondedToken.Amount).QuoInt(poolSize),
+				AdjustedFraction: weights[i].QuoTruncate(weightsSum),
+			})
+		}
+	}
+
+	return allocation, nil
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/stretchr/testify/require"
 
+	"github.com/initia-labs/initia/x/distribution/keeper"
 	customtypes "github.com/initia-labs/initia/x/distribution/types"
 	stakingtypes "github.com/initia-labs/initia/x/mstaking/types"
 )
@@ -571,3 +572,76 @@ func Test_SwapToBase(t *testing.T) {
 		sdk.NewDecCoins(sdk.NewDecCoin(baseDenom, math.LegacyOneDec().Sub(taxRate).MulInt(fees[0].Amount).TruncateInt())),
 	)
 }
+
+func TestAllocateTokensWithDecentralizationBonus(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	valAddr1 := createValidatorWithBalance(ctx, input, 100_000_000, 3_000_000, 1)
+	valAddr2 := createValidatorWithBalance(ctx, input, 100_000_000, 1_000_000, 2)
+
+	// double the reward weight of every validator out of the top 1
+	params, err := input.DistKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.DecentralizationBonus = customtypes.DecentralizationBonus{
+		Enabled:    true,
+		TopN:       1,
+		Multiplier: math.LegacyNewDec(2),
+	}
+	require.NoError(t, params.ValidateBasic())
+	require.NoError(t, input.DistKeeper.Params.Set(ctx, params))
+
+	validator1, err := input.StakingKeeper.Validators.Get(ctx, valAddr1)
+	require.NoError(t, err)
+	validator2, err := input.StakingKeeper.Validators.Get(ctx, valAddr2)
+	require.NoError(t, err)
+
+	valConsPk1, err := validator1.ConsPubKey()
+	require.NoError(t, err)
+	valConsPk2, err := validator2.ConsPubKey()
+	require.NoError(t, err)
+
+	votes := []abci.VoteInfo{
+		{
+			Validator:   abci.Validator{Address: valConsPk1.Address(), Power: 3},
+			BlockIdFlag: types.BlockIDFlagCommit,
+		},
+		{
+			Validator:   abci.Validator{Address: valConsPk2.Address(), Power: 1},
+			BlockIdFlag: types.BlockIDFlagCommit,
+		},
+	}
+
+	fees := sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(100)))
+	input.Faucet.Fund(ctx, authtypes.NewModuleAddress(authtypes.FeeCollectorName), fees...)
+	require.NoError(t, input.DistKeeper.AllocateTokens(ctx, 4, votes))
+
+	// 98 * (3_000_000 / 5_000_000) = 58.8
+	val1OutRewards, err := input.DistKeeper.ValidatorOutstandingRewards.Get(ctx, valAddr1)
+	require.NoError(t, err)
+	require.Equal(t, "58.800000000000000000", val1OutRewards.Rewards.CoinsOf(bondDenom).AmountOf(bondDenom).String())
+
+	// 98 * (1_000_000 * 2 / 5_000_000) = 39.2
+	val2OutRewards, err := input.DistKeeper.ValidatorOutstandingRewards.Get(ctx, valAddr2)
+	require.NoError(t, err)
+	require.Equal(t, "39.200000000000000000", val2OutRewards.Rewards.CoinsOf(bondDenom).AmountOf(bondDenom).String())
+
+	// query the effect of the bonus
+	queryServer := keeper.NewCustomQueryServer(input.DistKeeper)
+	res, err := queryServer.ValidatorAllocation(ctx, &customtypes.QueryValidatorAllocationRequest{ValidatorAddress: valAddr2.String()})
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), res.Allocation.Rank)
+	require.Equal(t, math.LegacyNewDec(2), res.Allocation.Multiplier)
+	require.Len(t, res.Allocation.Pools, 1)
+	require.Equal(t, bondDenom, res.Allocation.Pools[0].Denom)
+	require.Equal(t, math.LegacyNewDecWithPrec(25, 2), res.Allocation.Pools[0].BaseFraction)
+	require.Equal(t, math.LegacyNewDecWithPrec(4, 1), res.Allocation.Pools[0].AdjustedFraction)
+
+	res, err = queryServer.ValidatorAllocation(ctx, &customtypes.QueryValidatorAllocationRequest{ValidatorAddress: valAddr1.String()})
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), res.Allocation.Rank)
+	require.Equal(t, math.LegacyOneDec(), res.Allocation.Multiplier)
+	require.Equal(t, math.LegacyNewDecWithPrec(6, 1), res.Allocation.Pools[0].AdjustedFraction)
+
+	// the multiplier cannot reduce the reward weight
+	params.DecentralizationBonus.Multiplier = math.LegacyNewDecWithPrec(5, 1)
+	require.Error(t, params.ValidateBasic())
+}
@@ -0,0 +1,185 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	customtypes "github.com/initia-labs/initia/x/distribution/types"
+	stakingtypes "github.com/initia-labs/initia/x/mstaking/types"
+)
+
+// SetAutoCompound enables or disables auto-compounding of the rewards of a delegation.
+// Enabling is rejected while auto-compounding is disabled by a zero auto compound
+// interval, which is also the value on chains upgraded without setting it.
+func (k Keeper) SetAutoCompound(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, enabled bool) error {
+	key := collections.Join([]byte(delAddr), []byte(valAddr))
+	if !enabled {
+		return k.AutoCompoundDelegations.Remove(ctx, key)
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if params.AutoCompoundInterval == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "auto compound is disabled")
+	}
+
+	// only an existing delegation can be auto-compounded
+	if _, err := k.stakingKeeper.Delegation(ctx, delAddr, valAddr); errors.Is(err, collections.ErrNotFound) {
+		return stakingtypes.ErrNoDelegation
+	} else if err != nil {
+		return err
+	}
+
+	return k.AutoCompoundDelegations.Set(ctx, key)
+}
+
+// IsAutoCompound returns whether the rewards of a delegation are auto-compounded.
+func (k Keeper) IsAutoCompound(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (bool, error) {
+	return k.AutoCompoundDelegations.Has(ctx, collections.Join([]byte(delAddr), []byte(valAddr)))
+}
+
+// AutoCompound compounds the rewards of the auto-compounding delegations. A
+// pass starts every auto compound interval blocks and processes at most auto
+// compound max per block delegations in a block, continuing from the cursor
+// in the next blocks until every delegation is processed. This should be
+// called in EndBlocker.
+func (k Keeper) AutoCompound(ctx context.Context) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if params.AutoCompoundInterval == 0 {
+		return nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	var rng collections.Ranger[collections.Pair[[]byte, []byte]]
+	if cursor, err := k.AutoCompoundCursor.Get(ctx); err == nil {
+		// continue the pass in progress
+		rng = new(collections.Range[collections.Pair[[]byte, []byte]]).StartInclusive(cursor)
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return err
+	} else if uint64(sdkCtx.BlockHeight())%params.AutoCompoundInterval != 0 { //nolint: gosec
+		return nil
+	}
+
+	// collect the keys first to avoid writing to the store while iterating it
+	maxPerBlock := int(params.AutoCompoundMaxPerBlock)
+	keys := make([]collections.Pair[[]byte, []byte], 0, maxPerBlock+1)
+	iter, err := k.AutoCompoundDelegations.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+	for ; iter.Valid() && len(keys) <= maxPerBlock; iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			iter.Close()
+			return err
+		}
+
+		keys = append(keys, key)
+	}
+	iter.Close()
+
+	if len(keys) > maxPerBlock {
+		if err := k.AutoCompoundCursor.Set(ctx, keys[maxPerBlock]); err != nil {
+			return err
+		}
+
+		keys = keys[:maxPerBlock]
+	} else if err := k.AutoCompoundCursor.Remove(ctx); err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		delAddr, valAddr := sdk.AccAddress(key.K1()), sdk.ValAddress(key.K2())
+
+		// use cache context to drop the state changes of a failed delegation
+		cacheCtx, writeCache := sdkCtx.CacheContext()
+		rewards, compounded, err := k.compoundDelegationRewards(cacheCtx, delAddr, valAddr)
+		if err != nil {
+			// ignore error to avoid chain halt due to a single delegation
+			k.Logger(ctx).Error(
+				"failed to auto compound delegation rewards",
+				"delegator", delAddr.String(),
+				"validator", valAddr.String(),
+				"error", err,
+			)
+
+			continue
+		}
+
+		writeCache()
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				customtypes.EventTypeAutoCompound,
+				sdk.NewAttribute(customtypes.AttributeKeyDelegator, delAddr.String()),
+				sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, rewards.String()),
+				sdk.NewAttribute(customtypes.AttributeKeyCompounded, compounded.String()),
+			),
+		)
+	}
+
+	return nil
+}
+
+// compoundDelegationRewards withdraws the rewards of a delegation and
+// delegates the bond denom rewards back to the validator. The rewards are
+// withdrawn without compounding when the delegator has set a different
+// withdraw address.
+func (k Keeper) compoundDelegationRewards(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (sdk.Coins, sdk.Coins, error) {
+	pools, err := k.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rewards := pools.Sum()
+	compounded := sdk.NewCoins()
+	if rewards.IsZero() {
+		return rewards, compounded, nil
+	}
+
+	withdrawAddr, err := k.GetDelegatorWithdrawAddr(ctx, delAddr)
+	if err != nil {
+		return nil, nil, err
+	} else if !withdrawAddr.Equals(delAddr) {
+		return rewards, compounded, nil
+	}
+
+	bondDenoms, err := k.stakingKeeper.BondDenoms(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, denom := range bondDenoms {
+		if amount := rewards.AmountOf(denom); amount.IsPositive() {
+			compounded = compounded.Add(sdk.NewCoin(denom, amount))
+		}
+	}
+	if compounded.IsZero() {
+		return rewards, compounded, nil
+	}
+
+	validator, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if _, err := k.stakingKeeper.Delegate(ctx, delAddr, compounded, stakingtypes.Unbonded, validator, true); err != nil {
+		return nil, nil, err
+	}
+
+	return rewards, compounded, nil
+}
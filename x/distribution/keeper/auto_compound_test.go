@@ -0,0 +1,113 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/initia-labs/initia/x/distribution/keeper"
+	customtypes "github.com/initia-labs/initia/x/distribution/types"
+	stakingtypes "github.com/initia-labs/initia/x/mstaking/types"
+)
+
+func TestAutoCompound(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	msgServer := keeper.NewCustomMsgServerImpl(input.DistKeeper)
+
+	valAddr1 := createValidatorWithBalance(ctx, input, 100_000_000, 1_000_000, 1)
+	valAddr2 := createValidatorWithBalance(ctx, input, 100_000_000, 1_000_000, 2)
+
+	// enabling is rejected while auto compound is disabled
+	params, err := input.DistKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.AutoCompoundInterval = 0
+	params.AutoCompoundMaxPerBlock = 0
+	require.NoError(t, input.DistKeeper.Params.Set(ctx, params))
+	_, err = msgServer.SetAutoCompound(ctx, &customtypes.MsgSetAutoCompound{
+		DelegatorAddress: sdk.AccAddress(valAddr1).String(),
+		ValidatorAddress: valAddr1.String(),
+		Enabled:          true,
+	})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// compound every block, one delegation per block
+	params.AutoCompoundInterval = 1
+	params.AutoCompoundMaxPerBlock = 1
+	require.NoError(t, input.DistKeeper.Params.Set(ctx, params))
+
+	// no delegation to compound
+	_, _, delAddr := keyPubAddr()
+	_, err = msgServer.SetAutoCompound(ctx, &customtypes.MsgSetAutoCompound{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr1.String(),
+		Enabled:          true,
+	})
+	require.ErrorIs(t, err, stakingtypes.ErrNoDelegation)
+
+	for _, valAddr := range []sdk.ValAddress{valAddr1, valAddr2} {
+		_, err = msgServer.SetAutoCompound(ctx, &customtypes.MsgSetAutoCompound{
+			DelegatorAddress: sdk.AccAddress(valAddr).String(),
+			ValidatorAddress: valAddr.String(),
+			Enabled:          true,
+		})
+		require.NoError(t, err)
+	}
+
+	// allocate 100 rewards to each validator
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	rewards := sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(200)))
+	input.Faucet.Fund(ctx, authtypes.NewModuleAddress(distrtypes.ModuleName), rewards...)
+	for _, valAddr := range []sdk.ValAddress{valAddr1, valAddr2} {
+		validator, err := input.StakingKeeper.Validator(ctx, valAddr)
+		require.NoError(t, err)
+		require.NoError(t, input.DistKeeper.AllocateTokensToValidatorPool(ctx, validator, bondDenom, sdk.NewDecCoins(sdk.NewDecCoin(bondDenom, math.NewInt(100)))))
+	}
+
+	selfDelegation := func(valAddr sdk.ValAddress) math.Int {
+		validator, err := input.StakingKeeper.GetValidator(ctx, valAddr)
+		require.NoError(t, err)
+		delegation, err := input.StakingKeeper.GetDelegation(ctx, sdk.AccAddress(valAddr), valAddr)
+		require.NoError(t, err)
+
+		return validator.TokensFromShares(delegation.Shares).AmountOf(bondDenom).TruncateInt()
+	}
+
+	// the first delegation is compounded, less 10% commission
+	require.NoError(t, input.DistKeeper.AutoCompound(ctx))
+	require.Equal(t, math.NewInt(2_000_090), selfDelegation(valAddr1).Add(selfDelegation(valAddr2)))
+
+	has, err := input.DistKeeper.AutoCompoundCursor.Has(ctx)
+	require.NoError(t, err)
+	require.True(t, has)
+
+	// the pass continues in the next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	require.NoError(t, input.DistKeeper.AutoCompound(ctx))
+	require.Equal(t, math.NewInt(1_000_090), selfDelegation(valAddr1))
+	require.Equal(t, math.NewInt(1_000_090), selfDelegation(valAddr2))
+
+	has, err = input.DistKeeper.AutoCompoundCursor.Has(ctx)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// disable auto-compounding
+	_, err = msgServer.SetAutoCompound(ctx, &customtypes.MsgSetAutoCompound{
+		DelegatorAddress: sdk.AccAddress(valAddr1).String(),
+		ValidatorAddress: valAddr1.String(),
+		Enabled:          false,
+	})
+	require.NoError(t, err)
+
+	enabled, err := input.DistKeeper.IsAutoCompound(ctx, sdk.AccAddress(valAddr1), valAddr1)
+	require.NoError(t, err)
+	require.False(t, enabled)
+	enabled, err = input.DistKeeper.IsAutoCompound(ctx, sdk.AccAddress(valAddr2), valAddr2)
+	require.NoError(t, err)
+	require.True(t, enabled)
+}
@@ -268,6 +268,7 @@ func _createTestInput(
 		rewardtypes.ModuleName:          nil,
 		stakingtypes.BondedPoolName:     {authtypes.Burner, authtypes.Staking},
 		stakingtypes.NotBondedPoolName:  {authtypes.Burner, authtypes.Staking},
+		stakingtypes.ModuleName:         {authtypes.Minter, authtypes.Burner},
 		govtypes.ModuleName:             {authtypes.Burner},
 		movetypes.MoveStakingModuleName: nil,
 
@@ -225,3 +225,25 @@ func (q CustomQueryServer) DelegationTotalRewards(ctx context.Context, req *cust
 
 	return &customtypes.QueryDelegationTotalRewardsResponse{Rewards: delRewards, Total: total}, nil
 }
+
+// ValidatorAllocation queries the effect of the allocation policy on the reward weights of a validator
+func (q CustomQueryServer) ValidatorAllocation(ctx context.Context, req *customtypes.QueryValidatorAllocationRequest) (*customtypes.QueryValidatorAllocationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ValidatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty validator address")
+	}
+
+	if _, err := q.Keeper.stakingKeeper.ValidatorAddressCodec().StringToBytes(req.ValidatorAddress); err != nil {
+		return nil, err
+	}
+
+	allocation, err := q.Keeper.ValidatorAllocation(ctx, req.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return &customtypes.QueryValidatorAllocationResponse{Allocation: allocation}, nil
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 
 	"cosmossdk.io/errors"
 
@@ -82,3 +83,55 @@ func (k customMsgServer) DepositValidatorRewardsPool(ctx context.Context, msg *c
 
 	return &customtypes.MsgDepositValidatorRewardsPoolResponse{}, nil
 }
+
+func (k customMsgServer) SetAutoCompound(ctx context.Context, msg *customtypes.MsgSetAutoCompound) (*customtypes.MsgSetAutoCompoundResponse, error) {
+	delAddr, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.SetAutoCompound(ctx, delAddr, valAddr, msg.Enabled); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			customtypes.EventTypeSetAutoCompound,
+			sdk.NewAttribute(customtypes.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(customtypes.AttributeKeyEnabled, strconv.FormatBool(msg.Enabled)),
+		),
+	)
+
+	return &customtypes.MsgSetAutoCompoundResponse{}, nil
+}
+
+func (k customMsgServer) WithdrawTokenizeShareRecordReward(ctx context.Context, msg *customtypes.MsgWithdrawTokenizeShareRecordReward) (*customtypes.MsgWithdrawTokenizeShareRecordRewardResponse, error) {
+	ownerAddr, err := k.authKeeper.AddressCodec().StringToBytes(msg.OwnerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := k.Keeper.WithdrawTokenizeShareRecordReward(ctx, ownerAddr, msg.RecordId)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			customtypes.EventTypeWithdrawTokenizeShareReward,
+			sdk.NewAttribute(customtypes.AttributeKeyOwner, msg.OwnerAddress),
+			sdk.NewAttribute(customtypes.AttributeKeyRecordId, strconv.FormatUint(msg.RecordId, 10)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return &customtypes.MsgWithdrawTokenizeShareRecordRewardResponse{Amount: amount}, nil
+}
@@ -109,6 +109,19 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data customtypes.GenesisState) {
 			panic(err)
 		}
 	}
+	for _, acd := range data.AutoCompoundDelegations {
+		delAddr, err := k.authKeeper.AddressCodec().StringToBytes(acd.DelegatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(acd.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		if err := k.AutoCompoundDelegations.Set(ctx, collections.Join(delAddr, valAddr)); err != nil {
+			panic(err)
+		}
+	}
 
 	moduleHoldings = moduleHoldings.Add(data.FeePool.CommunityPool...)
 	moduleHoldingsInt, _ := moduleHoldings.TruncateDecimal()
@@ -298,5 +311,29 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *customtypes.GenesisState {
 		panic(err)
 	}
 
-	return customtypes.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes)
+	autoCompounds := make([]customtypes.AutoCompoundDelegationRecord, 0)
+	err = k.AutoCompoundDelegations.Walk(ctx, nil,
+		func(key collections.Pair[[]byte, []byte]) (stop bool, err error) {
+			delAddrStr, err := k.authKeeper.AddressCodec().BytesToString(key.K1())
+			if err != nil {
+				return false, err
+			}
+			valAddrStr, err := k.stakingKeeper.ValidatorAddressCodec().BytesToString(key.K2())
+			if err != nil {
+				return false, err
+			}
+
+			autoCompounds = append(autoCompounds, customtypes.AutoCompoundDelegationRecord{
+				DelegatorAddress: delAddrStr,
+				ValidatorAddress: valAddrStr,
+			})
+
+			return false, nil
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	return customtypes.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes, autoCompounds)
 }
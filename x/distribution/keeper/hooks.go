@@ -7,6 +7,7 @@ import (
 
 	stakingtypes "github.com/initia-labs/initia/x/mstaking/types"
 
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 
@@ -192,10 +193,20 @@ func (h Hooks) AfterValidatorBeginUnbonding(_ context.Context, _ sdk.ConsAddress
 	return nil
 }
 
-func (h Hooks) BeforeDelegationRemoved(_ context.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
-	return nil
+// stop auto-compounding the removed delegation
+func (h Hooks) BeforeDelegationRemoved(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return h.k.AutoCompoundDelegations.Remove(ctx, collections.Join([]byte(delAddr), []byte(valAddr)))
 }
 
 func (h Hooks) AfterUnbondingInitiated(_ context.Context, _ uint64) error {
 	return nil
 }
+
+// charge the consensus pubkey rotation fee to the community pool
+func (h Hooks) AfterConsensusPubKeyUpdate(ctx context.Context, valAddr sdk.ValAddress, _, _ cryptotypes.PubKey, rotationFee sdk.Coins) error {
+	if rotationFee.IsZero() {
+		return nil
+	}
+
+	return h.k.FundCommunityPool(ctx, rotationFee, sdk.AccAddress(valAddr))
+}
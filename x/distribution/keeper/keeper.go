@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"cosmossdk.io/collections"
+	collectioncodec "cosmossdk.io/collections/codec"
 	"cosmossdk.io/core/store"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
@@ -27,6 +28,8 @@ type Keeper struct {
 	stakingKeeper customtypes.StakingKeeper
 	dexKeeper     customtypes.DexKeeper
 
+	allocationPolicy customtypes.AllocationPolicy
+
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
 	authority string
@@ -44,6 +47,8 @@ type Keeper struct {
 	ValidatorCurrentRewards         collections.Map[[]byte, customtypes.ValidatorCurrentRewards]
 	ValidatorAccumulatedCommissions collections.Map[[]byte, customtypes.ValidatorAccumulatedCommission]
 	ValidatorSlashEvents            collections.Map[collections.Triple[[]byte, uint64, uint64], customtypes.ValidatorSlashEvent]
+	AutoCompoundDelegations         collections.KeySet[collections.Pair[[]byte, []byte]]
+	AutoCompoundCursor              collections.Item[collections.Pair[[]byte, []byte]]
 }
 
 // NewKeeper creates a new distribution Keeper instance
@@ -82,8 +87,12 @@ func NewKeeper(
 		ValidatorCurrentRewards:         collections.NewMap(sb, types.ValidatorCurrentRewardsPrefix, "validator_current_rewards", collections.BytesKey, codec.CollValue[customtypes.ValidatorCurrentRewards](cdc)),
 		ValidatorAccumulatedCommissions: collections.NewMap(sb, types.ValidatorAccumulatedCommissionPrefix, "validator_accumulated_commissions", collections.BytesKey, codec.CollValue[customtypes.ValidatorAccumulatedCommission](cdc)),
 		ValidatorSlashEvents:            collections.NewMap(sb, types.ValidatorSlashEventPrefix, "validator_slash_events", collections.TripleKeyCodec(collections.BytesKey, collections.Uint64Key, collections.Uint64Key), codec.CollValue[customtypes.ValidatorSlashEvent](cdc)),
+		AutoCompoundDelegations:         collections.NewKeySet(sb, customtypes.AutoCompoundDelegationPrefix, "auto_compound_delegations", collections.PairKeyCodec(collections.BytesKey, collections.BytesKey)),
+		AutoCompoundCursor:              collections.NewItem(sb, customtypes.AutoCompoundCursorKey, "auto_compound_cursor", collectioncodec.KeyToValueCodec(collections.PairKeyCodec(collections.BytesKey, collections.BytesKey))),
 	}
 
+	k.allocationPolicy = NewDecentralizationBonusPolicy(k)
+
 	schema, err := sb.Build()
 	if err != nil {
 		panic(err)
@@ -92,6 +101,17 @@ func NewKeeper(
 	return k
 }
 
+// SetAllocationPolicy replaces the built-in decentralization bonus policy
+// with a custom allocation policy.
+func (k *Keeper) SetAllocationPolicy(policy customtypes.AllocationPolicy) *Keeper {
+	if policy == nil {
+		panic("cannot set nil allocation policy")
+	}
+
+	k.allocationPolicy = policy
+	return k
+}
+
 // GetAuthority returns the x/distribution module's authority.
 func (k Keeper) GetAuthority() string {
 	return k.authority
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	stakingtypes "github.com/initia-labs/initia/x/mstaking/types"
+)
+
+// WithdrawTokenizeShareRecordReward withdraws the rewards of the tokenized
+// delegation of a tokenize share record to the owner of the record.
+func (k Keeper) WithdrawTokenizeShareRecordReward(ctx context.Context, owner sdk.AccAddress, recordId uint64) (sdk.Coins, error) {
+	record, err := k.stakingKeeper.GetTokenizeShareRecord(ctx, recordId)
+	if err != nil {
+		return nil, err
+	}
+
+	ownerAddr, err := k.authKeeper.AddressCodec().StringToBytes(record.Owner)
+	if err != nil {
+		return nil, err
+	} else if !bytes.Equal(ownerAddr, owner) {
+		return nil, stakingtypes.ErrNotTokenizeShareRecordOwner
+	}
+
+	valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(record.Validator)
+	if err != nil {
+		return nil, err
+	}
+
+	recordAddr := record.GetModuleAddress()
+	if _, err := k.stakingKeeper.Delegation(ctx, recordAddr, valAddr); err == nil {
+		if _, err := k.WithdrawDelegationRewards(ctx, recordAddr, valAddr); err != nil {
+			return nil, err
+		}
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+
+	// the record account also holds the rewards withdrawn when the tokenized
+	// delegation was modified
+	rewards := k.bankKeeper.GetAllBalances(ctx, recordAddr)
+	if rewards.IsZero() {
+		return rewards, nil
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, recordAddr, owner, rewards); err != nil {
+		return nil, err
+	}
+
+	return rewards, nil
+}
@@ -0,0 +1,58 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/initia-labs/initia/x/distribution/keeper"
+	customtypes "github.com/initia-labs/initia/x/distribution/types"
+	stakingtypes "github.com/initia-labs/initia/x/mstaking/types"
+)
+
+func TestWithdrawTokenizeShareRecordReward(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	msgServer := keeper.NewCustomMsgServerImpl(input.DistKeeper)
+
+	valAddr := createValidatorWithBalance(ctx, input, 100_000_000, 1_000_000, 1)
+	delAddr := input.Faucet.NewFundedAccount(ctx, sdk.NewCoin(bondDenom, math.NewInt(1_000_000)))
+	_, _, ownerAddr := keyPubAddr()
+
+	validator, err := input.StakingKeeper.GetValidator(ctx, valAddr)
+	require.NoError(t, err)
+	_, err = input.StakingKeeper.Delegate(ctx, delAddr, sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(1_000_000))), stakingtypes.Unbonded, validator, true)
+	require.NoError(t, err)
+
+	_, record, err := input.StakingKeeper.TokenizeShares(ctx, delAddr, valAddr, sdk.NewCoin(bondDenom, math.NewInt(1_000_000)), ownerAddr)
+	require.NoError(t, err)
+
+	// allocate 100 rewards to the validator
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	rewards := sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(100)))
+	input.Faucet.Fund(ctx, authtypes.NewModuleAddress(distrtypes.ModuleName), rewards...)
+	val, err := input.StakingKeeper.Validator(ctx, valAddr)
+	require.NoError(t, err)
+	require.NoError(t, input.DistKeeper.AllocateTokensToValidatorPool(ctx, val, bondDenom, sdk.NewDecCoins(sdk.NewDecCoin(bondDenom, math.NewInt(100)))))
+
+	// only the record owner can withdraw the rewards
+	_, err = msgServer.WithdrawTokenizeShareRecordReward(ctx, &customtypes.MsgWithdrawTokenizeShareRecordReward{
+		OwnerAddress: delAddr.String(),
+		RecordId:     record.Id,
+	})
+	require.ErrorIs(t, err, stakingtypes.ErrNotTokenizeShareRecordOwner)
+
+	// half of the rewards, less 10% commission
+	res, err := msgServer.WithdrawTokenizeShareRecordReward(ctx, &customtypes.MsgWithdrawTokenizeShareRecordReward{
+		OwnerAddress: ownerAddr.String(),
+		RecordId:     record.Id,
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(45))), res.Amount)
+	require.Equal(t, math.NewInt(45), input.BankKeeper.GetBalance(ctx, ownerAddr, bondDenom).Amount)
+	require.True(t, input.BankKeeper.GetAllBalances(ctx, record.GetModuleAddress()).IsZero())
+}
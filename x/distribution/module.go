@@ -38,6 +38,7 @@ var (
 
 	_ appmodule.AppModule       = AppModule{}
 	_ appmodule.HasBeginBlocker = AppModule{}
+	_ appmodule.HasEndBlocker   = AppModule{}
 )
 
 // AppModuleBasic defines the basic application module used by the distribution module.
@@ -159,6 +160,12 @@ func (am AppModule) BeginBlock(ctx context.Context) error {
 	return BeginBlocker(c, am.keeper)
 }
 
+// EndBlock returns the end blocker for the distribution module.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	c := sdk.UnwrapSDKContext(ctx)
+	return EndBlocker(c, am.keeper)
+}
+
 //
 // App Wiring Setup
 //
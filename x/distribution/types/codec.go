@@ -15,6 +15,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	// amino is rejecting long type names, so we register under `distr`
 	legacy.RegisterAminoMsg(cdc, &MsgDepositValidatorRewardsPool{}, "distr/MsgDepositValidatorRewardsPool")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "distribution/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgSetAutoCompound{}, "distribution/MsgSetAutoCompound")
+	legacy.RegisterAminoMsg(cdc, &MsgWithdrawTokenizeShareRecordReward{}, "distr/MsgWithdrawTokenizeReward")
 	cdc.RegisterConcrete(Params{}, "distribution/Params", nil)
 }
 
@@ -23,6 +25,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgDepositValidatorRewardsPool{},
 		&MsgUpdateParams{},
+		&MsgSetAutoCompound{},
+		&MsgWithdrawTokenizeShareRecordReward{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -33,6 +33,16 @@ type Params struct {
 	CommunityTax        cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=community_tax,json=communityTax,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"community_tax" yaml:"community_tax"`
 	WithdrawAddrEnabled bool                        `protobuf:"varint,2,opt,name=withdraw_addr_enabled,json=withdrawAddrEnabled,proto3" json:"withdraw_addr_enabled,omitempty" yaml:"withdraw_addr_enabled"`
 	RewardWeights       []RewardWeight              `protobuf:"bytes,3,rep,name=reward_weights,json=rewardWeights,proto3" json:"reward_weights" yaml:"reward_weights"`
+	// decentralization_bonus configures the built-in allocation policy.
+	DecentralizationBonus DecentralizationBonus `protobuf:"bytes,4,opt,name=decentralization_bonus,json=decentralizationBonus,proto3" json:"decentralization_bonus" yaml:"decentralization_bonus"`
+	// auto_compound_interval is the number of blocks between the passes that
+	// compound the rewards of the auto-compounding delegations. Zero disables
+	// auto-compounding.
+	AutoCompoundInterval uint64 `protobuf:"varint,5,opt,name=auto_compound_interval,json=autoCompoundInterval,proto3" json:"auto_compound_interval,omitempty" yaml:"auto_compound_interval"`
+	// auto_compound_max_per_block is the maximum number of delegations
+	// compounded in a block. A pass continues in the next blocks until every
+	// delegation is processed.
+	AutoCompoundMaxPerBlock uint32 `protobuf:"varint,6,opt,name=auto_compound_max_per_block,json=autoCompoundMaxPerBlock,proto3" json:"auto_compound_max_per_block,omitempty" yaml:"auto_compound_max_per_block"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -81,6 +91,199 @@ func (m *Params) GetRewardWeights() []RewardWeight {
 	return nil
 }
 
+func (m *Params) GetDecentralizationBonus() DecentralizationBonus {
+	if m != nil {
+		return m.DecentralizationBonus
+	}
+	return DecentralizationBonus{}
+}
+
+func (m *Params) GetAutoCompoundInterval() uint64 {
+	if m != nil {
+		return m.AutoCompoundInterval
+	}
+	return 0
+}
+
+func (m *Params) GetAutoCompoundMaxPerBlock() uint32 {
+	if m != nil {
+		return m.AutoCompoundMaxPerBlock
+	}
+	return 0
+}
+
+// DecentralizationBonus multiplies the reward weights of the validators
+// ranked below the top N by voting power, directing more of every reward
+// pool toward them.
+type DecentralizationBonus struct {
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// top_n is the number of validators by voting power that get no bonus.
+	TopN uint32 `protobuf:"varint,2,opt,name=top_n,json=topN,proto3" json:"top_n,omitempty" yaml:"top_n"`
+	// multiplier is the reward weight multiplier of the other validators.
+	Multiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=multiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"multiplier"`
+}
+
+func (m *DecentralizationBonus) Reset()         { *m = DecentralizationBonus{} }
+func (m *DecentralizationBonus) String() string { return proto.CompactTextString(m) }
+func (*DecentralizationBonus) ProtoMessage()    {}
+func (*DecentralizationBonus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c78b8933cf70cc23, []int{1}
+}
+func (m *DecentralizationBonus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DecentralizationBonus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DecentralizationBonus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DecentralizationBonus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecentralizationBonus.Merge(m, src)
+}
+func (m *DecentralizationBonus) XXX_Size() int {
+	return m.Size()
+}
+func (m *DecentralizationBonus) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecentralizationBonus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecentralizationBonus proto.InternalMessageInfo
+
+func (m *DecentralizationBonus) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *DecentralizationBonus) GetTopN() uint32 {
+	if m != nil {
+		return m.TopN
+	}
+	return 0
+}
+
+// ValidatorAllocation represents the effect of the allocation policy on the
+// reward weights of a validator.
+type ValidatorAllocation struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// rank is the rank of the validator by voting power among the bonded
+	// validators, starting from 1. It is zero when the validator is not bonded.
+	Rank uint32 `protobuf:"varint,2,opt,name=rank,proto3" json:"rank,omitempty"`
+	// multiplier is the reward weight multiplier the policy applies.
+	Multiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=multiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"multiplier"`
+	Pools      []PoolAllocation            `protobuf:"bytes,4,rep,name=pools,proto3" json:"pools"`
+}
+
+func (m *ValidatorAllocation) Reset()         { *m = ValidatorAllocation{} }
+func (m *ValidatorAllocation) String() string { return proto.CompactTextString(m) }
+func (*ValidatorAllocation) ProtoMessage()    {}
+func (*ValidatorAllocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c78b8933cf70cc23, []int{2}
+}
+func (m *ValidatorAllocation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorAllocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorAllocation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorAllocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorAllocation.Merge(m, src)
+}
+func (m *ValidatorAllocation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorAllocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorAllocation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorAllocation proto.InternalMessageInfo
+
+func (m *ValidatorAllocation) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ValidatorAllocation) GetRank() uint32 {
+	if m != nil {
+		return m.Rank
+	}
+	return 0
+}
+
+func (m *ValidatorAllocation) GetPools() []PoolAllocation {
+	if m != nil {
+		return m.Pools
+	}
+	return nil
+}
+
+// PoolAllocation represents the share of a reward pool allocated to a
+// validator before and after the allocation policy.
+type PoolAllocation struct {
+	Denom            string                      `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	BaseFraction     cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=base_fraction,json=baseFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_fraction"`
+	AdjustedFraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=adjusted_fraction,json=adjustedFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"adjusted_fraction"`
+}
+
+func (m *PoolAllocation) Reset()         { *m = PoolAllocation{} }
+func (m *PoolAllocation) String() string { return proto.CompactTextString(m) }
+func (*PoolAllocation) ProtoMessage()    {}
+func (*PoolAllocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c78b8933cf70cc23, []int{3}
+}
+func (m *PoolAllocation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolAllocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolAllocation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolAllocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolAllocation.Merge(m, src)
+}
+func (m *PoolAllocation) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolAllocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolAllocation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolAllocation proto.InternalMessageInfo
+
+func (m *PoolAllocation) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 // RewardWeight represents reward allocation ratio between
 // pools.
 type RewardWeight struct {
@@ -92,7 +295,7 @@ func (m *RewardWeight) Reset()         { *m = RewardWeight{} }
 func (m *RewardWeight) String() string { return proto.CompactTextString(m) }
 func (*RewardWeight) ProtoMessage()    {}
 func (*RewardWeight) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c78b8933cf70cc23, []int{1}
+	return fileDescriptor_c78b8933cf70cc23, []int{4}
 }
 func (m *RewardWeight) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -138,7 +341,7 @@ func (m *Pool) Reset()         { *m = Pool{} }
 func (m *Pool) String() string { return proto.CompactTextString(m) }
 func (*Pool) ProtoMessage()    {}
 func (*Pool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c78b8933cf70cc23, []int{2}
+	return fileDescriptor_c78b8933cf70cc23, []int{5}
 }
 func (m *Pool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -191,7 +394,7 @@ func (m *DecPool) Reset()         { *m = DecPool{} }
 func (m *DecPool) String() string { return proto.CompactTextString(m) }
 func (*DecPool) ProtoMessage()    {}
 func (*DecPool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c78b8933cf70cc23, []int{3}
+	return fileDescriptor_c78b8933cf70cc23, []int{6}
 }
 func (m *DecPool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -256,7 +459,7 @@ func (m *ValidatorHistoricalRewards) Reset()         { *m = ValidatorHistoricalR
 func (m *ValidatorHistoricalRewards) String() string { return proto.CompactTextString(m) }
 func (*ValidatorHistoricalRewards) ProtoMessage()    {}
 func (*ValidatorHistoricalRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c78b8933cf70cc23, []int{4}
+	return fileDescriptor_c78b8933cf70cc23, []int{7}
 }
 func (m *ValidatorHistoricalRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -311,7 +514,7 @@ func (m *ValidatorCurrentRewards) Reset()         { *m = ValidatorCurrentRewards
 func (m *ValidatorCurrentRewards) String() string { return proto.CompactTextString(m) }
 func (*ValidatorCurrentRewards) ProtoMessage()    {}
 func (*ValidatorCurrentRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c78b8933cf70cc23, []int{5}
+	return fileDescriptor_c78b8933cf70cc23, []int{8}
 }
 func (m *ValidatorCurrentRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -364,7 +567,7 @@ func (m *ValidatorAccumulatedCommission) Reset()         { *m = ValidatorAccumul
 func (m *ValidatorAccumulatedCommission) String() string { return proto.CompactTextString(m) }
 func (*ValidatorAccumulatedCommission) ProtoMessage()    {}
 func (*ValidatorAccumulatedCommission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c78b8933cf70cc23, []int{6}
+	return fileDescriptor_c78b8933cf70cc23, []int{9}
 }
 func (m *ValidatorAccumulatedCommission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -410,7 +613,7 @@ func (m *ValidatorOutstandingRewards) Reset()         { *m = ValidatorOutstandin
 func (m *ValidatorOutstandingRewards) String() string { return proto.CompactTextString(m) }
 func (*ValidatorOutstandingRewards) ProtoMessage()    {}
 func (*ValidatorOutstandingRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c78b8933cf70cc23, []int{7}
+	return fileDescriptor_c78b8933cf70cc23, []int{10}
 }
 func (m *ValidatorOutstandingRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -459,7 +662,7 @@ func (m *ValidatorSlashEvent) Reset()         { *m = ValidatorSlashEvent{} }
 func (m *ValidatorSlashEvent) String() string { return proto.CompactTextString(m) }
 func (*ValidatorSlashEvent) ProtoMessage()    {}
 func (*ValidatorSlashEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c78b8933cf70cc23, []int{8}
+	return fileDescriptor_c78b8933cf70cc23, []int{11}
 }
 func (m *ValidatorSlashEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -510,7 +713,7 @@ type ValidatorSlashEvents struct {
 func (m *ValidatorSlashEvents) Reset()      { *m = ValidatorSlashEvents{} }
 func (*ValidatorSlashEvents) ProtoMessage() {}
 func (*ValidatorSlashEvents) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c78b8933cf70cc23, []int{9}
+	return fileDescriptor_c78b8933cf70cc23, []int{12}
 }
 func (m *ValidatorSlashEvents) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -562,7 +765,7 @@ func (m *DelegatorStartingInfo) Reset()         { *m = DelegatorStartingInfo{} }
 func (m *DelegatorStartingInfo) String() string { return proto.CompactTextString(m) }
 func (*DelegatorStartingInfo) ProtoMessage()    {}
 func (*DelegatorStartingInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c78b8933cf70cc23, []int{10}
+	return fileDescriptor_c78b8933cf70cc23, []int{13}
 }
 func (m *DelegatorStartingInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -623,7 +826,7 @@ func (m *DelegationDelegatorReward) Reset()         { *m = DelegationDelegatorRe
 func (m *DelegationDelegatorReward) String() string { return proto.CompactTextString(m) }
 func (*DelegationDelegatorReward) ProtoMessage()    {}
 func (*DelegationDelegatorReward) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c78b8933cf70cc23, []int{11}
+	return fileDescriptor_c78b8933cf70cc23, []int{14}
 }
 func (m *DelegationDelegatorReward) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -654,6 +857,9 @@ var xxx_messageInfo_DelegationDelegatorReward proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*Params)(nil), "initia.distribution.v1.Params")
+	proto.RegisterType((*DecentralizationBonus)(nil), "initia.distribution.v1.DecentralizationBonus")
+	proto.RegisterType((*ValidatorAllocation)(nil), "initia.distribution.v1.ValidatorAllocation")
+	proto.RegisterType((*PoolAllocation)(nil), "initia.distribution.v1.PoolAllocation")
 	proto.RegisterType((*RewardWeight)(nil), "initia.distribution.v1.RewardWeight")
 	proto.RegisterType((*Pool)(nil), "initia.distribution.v1.Pool")
 	proto.RegisterType((*DecPool)(nil), "initia.distribution.v1.DecPool")
@@ -672,75 +878,93 @@ func init() {
 }
 
 var fileDescriptor_c78b8933cf70cc23 = []byte{
-	// 1074 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xf7, 0x24, 0xa9, 0x9b, 0x4c, 0xf3, 0xab, 0x9b, 0x1f, 0x75, 0x7e, 0xd4, 0x1b, 0x8d, 0xbe,
-	0xdf, 0x2a, 0xb4, 0x8a, 0xad, 0xb4, 0xb7, 0x9c, 0xc8, 0xc6, 0xa1, 0x44, 0x42, 0x10, 0x2d, 0x15,
-	0x48, 0x48, 0x68, 0x35, 0xde, 0x9d, 0xd8, 0x43, 0xbc, 0x3b, 0x61, 0x66, 0xec, 0x24, 0x88, 0x13,
-	0x5c, 0x2a, 0x90, 0x50, 0x6f, 0xf4, 0x46, 0x38, 0x51, 0x71, 0xea, 0x01, 0x71, 0xe1, 0x1f, 0xe8,
-	0xb1, 0xe2, 0x84, 0x38, 0x2c, 0x90, 0x1c, 0x8a, 0x38, 0xfa, 0x2f, 0x40, 0x3b, 0x33, 0x5e, 0x7b,
-	0x2d, 0x47, 0x38, 0x1c, 0xb8, 0xd8, 0x3b, 0xef, 0xbd, 0xf9, 0xbc, 0xcf, 0xfb, 0xcc, 0x7b, 0x3b,
-	0x0b, 0x5f, 0xa3, 0x11, 0x95, 0x14, 0x97, 0x03, 0x2a, 0x24, 0xa7, 0xd5, 0xa6, 0xa4, 0x2c, 0x2a,
-	0xb7, 0x36, 0x33, 0xeb, 0xd2, 0x11, 0x67, 0x92, 0x59, 0x8b, 0x3a, 0xb4, 0x94, 0x71, 0xb5, 0x36,
-	0x97, 0x6f, 0xe2, 0x90, 0x46, 0xac, 0xac, 0x7e, 0x75, 0xe8, 0x72, 0xd1, 0x67, 0x22, 0x64, 0xa2,
-	0x5c, 0xc5, 0x82, 0x94, 0x5b, 0x9b, 0x55, 0x22, 0xf1, 0x66, 0xd9, 0x67, 0xd4, 0x40, 0x2d, 0x2f,
-	0x69, 0xbf, 0xa7, 0x56, 0x65, 0xbd, 0x30, 0xae, 0xf9, 0x1a, 0xab, 0x31, 0x6d, 0x4f, 0x9e, 0xb4,
-	0x15, 0xfd, 0x31, 0x02, 0xf3, 0xfb, 0x98, 0xe3, 0x50, 0x58, 0x02, 0x4e, 0xf9, 0x2c, 0x0c, 0x9b,
-	0x11, 0x95, 0xa7, 0x9e, 0xc4, 0x27, 0x05, 0xb0, 0x06, 0xd6, 0x27, 0x9c, 0xb7, 0x5f, 0xc4, 0x76,
-	0xee, 0xd7, 0xd8, 0x5e, 0xd1, 0x68, 0x22, 0x38, 0x2c, 0x51, 0x56, 0x0e, 0xb1, 0xac, 0x97, 0xde,
-	0x22, 0x35, 0xec, 0x9f, 0x56, 0x88, 0xdf, 0x8e, 0xed, 0xf9, 0x53, 0x1c, 0x36, 0xb6, 0x50, 0x06,
-	0x01, 0xfd, 0xfc, 0xc3, 0x06, 0x34, 0x24, 0x2a, 0xc4, 0x7f, 0xf6, 0xea, 0xf9, 0x5d, 0xe0, 0x4e,
-	0xa6, 0x21, 0x8f, 0xf0, 0x89, 0xf5, 0x08, 0x2e, 0x1c, 0x53, 0x59, 0x0f, 0x38, 0x3e, 0xf6, 0x70,
-	0x10, 0x70, 0x8f, 0x44, 0xb8, 0xda, 0x20, 0x41, 0x61, 0x64, 0x0d, 0xac, 0x8f, 0x3b, 0x6b, 0xed,
-	0xd8, 0x5e, 0xd5, 0xc8, 0x03, 0xc3, 0x90, 0x3b, 0xd7, 0xb1, 0x6f, 0x07, 0x01, 0xdf, 0xd5, 0x56,
-	0xeb, 0x23, 0x38, 0xcd, 0xc9, 0x31, 0xe6, 0x81, 0x77, 0x4c, 0x68, 0xad, 0x2e, 0x45, 0x61, 0x74,
-	0x6d, 0x74, 0xfd, 0xc6, 0xfd, 0xff, 0x95, 0x06, 0x4b, 0x5d, 0x72, 0x55, 0xf4, 0xfb, 0x2a, 0xd8,
-	0xb9, 0x9d, 0x54, 0xdc, 0x8e, 0xed, 0x05, 0x9d, 0x38, 0x8b, 0x84, 0xdc, 0x29, 0xde, 0x13, 0x2c,
-	0xb6, 0xd6, 0x9e, 0x9e, 0xd9, 0xb9, 0x3f, 0xcf, 0x6c, 0xf0, 0xc5, 0xab, 0xe7, 0x77, 0xe7, 0x32,
-	0xc7, 0xad, 0x85, 0x45, 0x5f, 0x03, 0x38, 0xd9, 0x9b, 0xc0, 0xba, 0x03, 0xaf, 0x05, 0x24, 0x62,
-	0xa1, 0x51, 0x78, 0xb6, 0x1d, 0xdb, 0x93, 0x3a, 0x97, 0x32, 0x23, 0x57, 0xbb, 0x2d, 0x0f, 0xe6,
-	0x75, 0x56, 0xa5, 0xc6, 0x84, 0xf3, 0x70, 0xb8, 0xa3, 0x98, 0x32, 0x82, 0xa9, 0xad, 0x03, 0xcf,
-	0xc0, 0xc0, 0xa2, 0x6f, 0x01, 0x1c, 0xdb, 0x67, 0xac, 0x31, 0x34, 0xa3, 0x8f, 0xe1, 0xb5, 0xa4,
-	0xdb, 0x44, 0x61, 0x44, 0xe9, 0xb9, 0x54, 0x32, 0xc8, 0x49, 0x3f, 0x96, 0x4c, 0x3f, 0x96, 0x76,
-	0x18, 0x8d, 0x9c, 0xd7, 0x8d, 0x88, 0x93, 0x9d, 0xbe, 0xa0, 0x91, 0x40, 0xdf, 0xff, 0x66, 0xaf,
-	0xd7, 0xa8, 0xac, 0x37, 0xab, 0x25, 0x9f, 0x85, 0xa6, 0x3f, 0xcd, 0xdf, 0x86, 0x08, 0x0e, 0xcb,
-	0xf2, 0xf4, 0x88, 0x08, 0x05, 0x20, 0x5c, 0x9d, 0x09, 0xfd, 0x08, 0xe0, 0xf5, 0x0a, 0xf1, 0xaf,
-	0x44, 0xf3, 0x33, 0x00, 0x27, 0x02, 0xe2, 0x7b, 0xbd, 0x5c, 0x57, 0x07, 0x72, 0xad, 0x10, 0x5f,
-	0xd1, 0x7d, 0x68, 0xe8, 0xce, 0x76, 0xe0, 0xcc, 0xe6, 0x84, 0xf2, 0xbd, 0x21, 0x28, 0x1b, 0x1c,
-	0xe1, 0x8e, 0x07, 0xe6, 0x09, 0xb5, 0x01, 0x5c, 0x7e, 0x0f, 0x37, 0x68, 0x80, 0x25, 0xe3, 0x6f,
-	0x52, 0x21, 0x19, 0xa7, 0x3e, 0x6e, 0xe8, 0x4e, 0x10, 0xd6, 0x57, 0x00, 0x16, 0xfc, 0x66, 0xd8,
-	0x6c, 0x60, 0x49, 0x5b, 0xc4, 0x33, 0x5d, 0xc6, 0xb1, 0xa4, 0x4c, 0x14, 0x80, 0xa2, 0x6c, 0x5f,
-	0xd6, 0xae, 0x46, 0x0f, 0xe7, 0x81, 0x61, 0x6d, 0x1b, 0x91, 0x2f, 0x81, 0x4b, 0x8a, 0x18, 0x37,
-	0x7b, 0x84, 0xbb, 0xd8, 0x0d, 0xd3, 0x5c, 0x5c, 0x15, 0x64, 0xed, 0xc0, 0x19, 0x4e, 0x0e, 0x08,
-	0x27, 0x91, 0x4f, 0x3c, 0x9f, 0x35, 0x23, 0xdd, 0x76, 0x53, 0xce, 0x72, 0x3b, 0xb6, 0x17, 0x3b,
-	0xb3, 0x90, 0x09, 0x40, 0xee, 0x74, 0x6a, 0xd9, 0x51, 0x86, 0x27, 0x00, 0xde, 0x4a, 0x8b, 0xde,
-	0x69, 0x72, 0x4e, 0x22, 0xd9, 0xa9, 0xf8, 0x43, 0x78, 0x5d, 0xd3, 0x1a, 0xba, 0x3e, 0x64, 0xea,
-	0x9b, 0xee, 0x9d, 0xc4, 0xbe, 0x72, 0x3a, 0x98, 0xd6, 0x22, 0xcc, 0x1f, 0x11, 0x4e, 0x99, 0x7e,
-	0x77, 0x8c, 0xb9, 0x66, 0x85, 0xbe, 0x04, 0xb0, 0x98, 0x52, 0xda, 0xf6, 0x4d, 0xf5, 0x24, 0xd8,
-	0x61, 0x61, 0x48, 0x85, 0xa0, 0x2c, 0xb2, 0x28, 0xbc, 0xe1, 0xa7, 0xab, 0xa1, 0xd9, 0xdd, 0x31,
-	0xec, 0xac, 0xee, 0xab, 0xcf, 0x20, 0x64, 0x19, 0xf6, 0x62, 0xa3, 0x4f, 0xe1, 0x4a, 0x4a, 0xe6,
-	0x9d, 0xa6, 0x14, 0x12, 0x47, 0x01, 0x8d, 0x6a, 0xff, 0x8d, 0x46, 0xe8, 0x02, 0xc0, 0xb9, 0x34,
-	0xfd, 0xbb, 0x0d, 0x2c, 0xea, 0xbb, 0x2d, 0x12, 0x49, 0xeb, 0x0d, 0x38, 0xdb, 0xea, 0x98, 0x3d,
-	0xa3, 0x62, 0x32, 0x63, 0x63, 0xce, 0x4a, 0x3b, 0xb6, 0x6f, 0x69, 0xe8, 0xfe, 0x08, 0xe4, 0xce,
-	0xa4, 0xa6, 0x7d, 0x65, 0xb1, 0x3e, 0x07, 0x70, 0xe2, 0x80, 0x63, 0x5f, 0x2a, 0x1d, 0xff, 0xc5,
-	0xe0, 0xa5, 0x9b, 0xaf, 0x3c, 0x78, 0xdd, 0xbc, 0xe8, 0x3b, 0x00, 0xe7, 0x07, 0x54, 0x29, 0xac,
-	0xc7, 0x00, 0x2e, 0x76, 0xab, 0x10, 0x89, 0xc7, 0x23, 0xca, 0x65, 0xd4, 0xbe, 0x77, 0x99, 0xda,
-	0x03, 0xe0, 0x9c, 0xff, 0x1b, 0xea, 0xb7, 0xfb, 0xe5, 0xe9, 0x05, 0x46, 0xee, 0x7c, 0x6b, 0x00,
-	0x95, 0xad, 0xb1, 0xe4, 0xda, 0x40, 0xdf, 0x8c, 0xc0, 0x85, 0x0a, 0x69, 0x90, 0x9a, 0x72, 0x4b,
-	0xcc, 0x25, 0x8d, 0x6a, 0x7b, 0xd1, 0x01, 0x4b, 0xa6, 0xf1, 0x88, 0x93, 0x16, 0x65, 0x4d, 0x91,
-	0x3d, 0x90, 0x9e, 0x69, 0xec, 0x0b, 0x40, 0xee, 0x74, 0xc7, 0x62, 0x8e, 0xe3, 0x13, 0x98, 0x17,
-	0x12, 0x1f, 0x92, 0xe1, 0x8e, 0xa2, 0x62, 0xea, 0x31, 0xf7, 0x87, 0xde, 0x79, 0xe5, 0x73, 0x30,
-	0x19, 0xad, 0x5d, 0x98, 0xaf, 0xeb, 0xcb, 0x6b, 0x54, 0xf1, 0xde, 0xf8, 0x2b, 0xb6, 0x67, 0x7c,
-	0x4e, 0x92, 0x97, 0x4d, 0xe4, 0x69, 0x57, 0xb7, 0x94, 0x3e, 0x07, 0x72, 0xcd, 0x66, 0xf4, 0x13,
-	0x80, 0x4b, 0x46, 0x21, 0xca, 0xa2, 0x54, 0x2b, 0x3d, 0x2f, 0xd6, 0x1e, 0xbc, 0xd9, 0x95, 0x3d,
-	0xf9, 0x30, 0x20, 0x42, 0x98, 0xcb, 0x61, 0xb5, 0x1d, 0xdb, 0x85, 0xfe, 0x93, 0x31, 0x21, 0xc8,
-	0xed, 0xb6, 0xfb, 0xb6, 0x36, 0x59, 0x7b, 0x30, 0xaf, 0xa7, 0xc4, 0x68, 0xf5, 0x8f, 0x83, 0x37,
-	0x9b, 0xc8, 0x95, 0x19, 0x33, 0x03, 0xb0, 0x35, 0xfe, 0xf8, 0xcc, 0xce, 0x3d, 0x3d, 0xb3, 0x81,
-	0xb3, 0xff, 0xec, 0xbc, 0x08, 0x5e, 0x9c, 0x17, 0xc1, 0xcb, 0xf3, 0x22, 0xf8, 0xfd, 0xbc, 0x08,
-	0x9e, 0x5c, 0x14, 0x73, 0x2f, 0x2f, 0x8a, 0xb9, 0x5f, 0x2e, 0x8a, 0xb9, 0x0f, 0xee, 0xf7, 0xe8,
-	0xaa, 0x93, 0x6d, 0x34, 0x70, 0x55, 0x98, 0xe7, 0xf2, 0x49, 0xf6, 0xe3, 0x51, 0xe9, 0x5c, 0xcd,
-	0xab, 0xef, 0xb6, 0x07, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0x54, 0xd6, 0x0b, 0x59, 0x60, 0x0a,
-	0x00, 0x00,
+	// 1370 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0x24, 0x8e, 0x9b, 0x4c, 0x93, 0x34, 0xdd, 0x26, 0xae, 0x9b, 0xb4, 0x5e, 0x7f, 0x47,
+	0xdf, 0x46, 0xa6, 0x55, 0x6c, 0xa5, 0x95, 0x38, 0xe4, 0x44, 0x37, 0x69, 0x4b, 0x24, 0x28, 0xd1,
+	0x52, 0xb5, 0x12, 0x08, 0xad, 0xc6, 0xbb, 0x53, 0x67, 0x9a, 0xdd, 0x1d, 0xb3, 0x33, 0xeb, 0x24,
+	0x15, 0x27, 0xb8, 0x54, 0x20, 0xa1, 0xde, 0xe8, 0x8d, 0x70, 0xa2, 0xe2, 0x54, 0x09, 0xc4, 0x85,
+	0x7f, 0xa0, 0xc7, 0x8a, 0x13, 0xe2, 0x60, 0x50, 0x72, 0x68, 0xc5, 0xd1, 0x17, 0xae, 0x68, 0x76,
+	0xc6, 0x3f, 0x36, 0xda, 0x20, 0x97, 0x0a, 0x2e, 0xc9, 0xce, 0x7b, 0x6f, 0x3e, 0xef, 0xf3, 0xde,
+	0xcc, 0x7b, 0x6f, 0x0c, 0xdf, 0xa0, 0x21, 0x15, 0x14, 0xd7, 0x3c, 0xca, 0x45, 0x44, 0xeb, 0xb1,
+	0xa0, 0x2c, 0xac, 0xb5, 0x56, 0x52, 0xeb, 0x6a, 0x33, 0x62, 0x82, 0x19, 0x05, 0x65, 0x5a, 0x4d,
+	0xa9, 0x5a, 0x2b, 0x0b, 0xa7, 0x71, 0x40, 0x43, 0x56, 0x4b, 0xfe, 0x2a, 0xd3, 0x85, 0x92, 0xcb,
+	0x78, 0xc0, 0x78, 0xad, 0x8e, 0x39, 0xa9, 0xb5, 0x56, 0xea, 0x44, 0xe0, 0x95, 0x9a, 0xcb, 0xa8,
+	0x86, 0x5a, 0x38, 0xa7, 0xf4, 0x4e, 0xb2, 0xaa, 0xa9, 0x85, 0x56, 0xcd, 0x35, 0x58, 0x83, 0x29,
+	0xb9, 0xfc, 0x52, 0x52, 0xf4, 0xfd, 0x38, 0xcc, 0x6f, 0xe2, 0x08, 0x07, 0xdc, 0xe0, 0x70, 0xda,
+	0x65, 0x41, 0x10, 0x87, 0x54, 0xec, 0x39, 0x02, 0xef, 0x16, 0x41, 0x19, 0x54, 0x26, 0xad, 0x5b,
+	0xcf, 0xda, 0xe6, 0xc8, 0xaf, 0x6d, 0x73, 0x51, 0xa1, 0x71, 0x6f, 0xbb, 0x4a, 0x59, 0x2d, 0xc0,
+	0x62, 0xab, 0xfa, 0x0e, 0x69, 0x60, 0x77, 0x6f, 0x9d, 0xb8, 0x9d, 0xb6, 0x39, 0xb7, 0x87, 0x03,
+	0x7f, 0x15, 0xa5, 0x10, 0xd0, 0xcf, 0x3f, 0x2c, 0x43, 0x4d, 0x62, 0x9d, 0xb8, 0x4f, 0x5e, 0x3c,
+	0xbd, 0x04, 0xec, 0xa9, 0x9e, 0xc9, 0x6d, 0xbc, 0x6b, 0xdc, 0x86, 0xf3, 0x3b, 0x54, 0x6c, 0x79,
+	0x11, 0xde, 0x71, 0xb0, 0xe7, 0x45, 0x0e, 0x09, 0x71, 0xdd, 0x27, 0x5e, 0x71, 0xb4, 0x0c, 0x2a,
+	0x13, 0x56, 0xb9, 0xd3, 0x36, 0xcf, 0x2b, 0xe4, 0x4c, 0x33, 0x64, 0x9f, 0xe9, 0xca, 0xaf, 0x79,
+	0x5e, 0x74, 0x5d, 0x49, 0x8d, 0xfb, 0x70, 0x26, 0x22, 0x3b, 0x38, 0xf2, 0x9c, 0x1d, 0x42, 0x1b,
+	0x5b, 0x82, 0x17, 0xc7, 0xca, 0x63, 0x95, 0x93, 0x57, 0xfe, 0x5f, 0xcd, 0x4e, 0x75, 0xd5, 0x4e,
+	0xac, 0xef, 0x26, 0xc6, 0xd6, 0x05, 0x19, 0x71, 0xa7, 0x6d, 0xce, 0x2b, 0xc7, 0x69, 0x24, 0x64,
+	0x4f, 0x47, 0x03, 0xc6, 0xdc, 0x78, 0x04, 0x60, 0xc1, 0x23, 0x2e, 0x09, 0x45, 0x84, 0x7d, 0xfa,
+	0x00, 0x4b, 0x48, 0xa7, 0xce, 0xc2, 0x98, 0x17, 0x73, 0x65, 0x50, 0x39, 0x79, 0x65, 0xf9, 0x38,
+	0xa7, 0xeb, 0x47, 0x76, 0x59, 0x72, 0x93, 0x75, 0x49, 0x7b, 0xbf, 0xa0, 0xbc, 0x67, 0x43, 0x23,
+	0x95, 0xcb, 0x79, 0x2f, 0x0b, 0xc2, 0xb8, 0x0b, 0x0b, 0x38, 0x16, 0xcc, 0x71, 0x59, 0xd0, 0x64,
+	0x71, 0xe8, 0x39, 0x34, 0x14, 0x24, 0x6a, 0x61, 0xbf, 0x38, 0x5e, 0x06, 0x95, 0x9c, 0xf5, 0xbf,
+	0x3e, 0x7c, 0xb6, 0x1d, 0xb2, 0xe7, 0xa4, 0x62, 0x4d, 0xcb, 0x37, 0xb4, 0xd8, 0xf0, 0xe0, 0x62,
+	0x7a, 0x43, 0x80, 0x77, 0x9d, 0x26, 0x89, 0x9c, 0xba, 0xcf, 0xdc, 0xed, 0x62, 0xbe, 0x0c, 0x2a,
+	0xd3, 0xd6, 0x52, 0xa7, 0x6d, 0xa2, 0x2c, 0xf4, 0x94, 0x31, 0xb2, 0xcf, 0x0e, 0xba, 0x78, 0x17,
+	0xef, 0x6e, 0x92, 0xc8, 0x92, 0x9a, 0xd5, 0xf2, 0xe3, 0x7d, 0x73, 0xe4, 0xe5, 0xbe, 0x09, 0x3e,
+	0x7f, 0xf1, 0xf4, 0xd2, 0x99, 0x54, 0x01, 0xa9, 0xab, 0x8a, 0x9e, 0x02, 0x38, 0x9f, 0x99, 0x3d,
+	0xa3, 0x08, 0x4f, 0x74, 0x6f, 0x90, 0xbc, 0xbe, 0x13, 0x76, 0x77, 0x69, 0x5c, 0x84, 0xe3, 0x82,
+	0x35, 0x9d, 0x30, 0xb9, 0x59, 0xd3, 0xd6, 0x6c, 0xa7, 0x6d, 0x4e, 0x29, 0x96, 0x89, 0x18, 0xd9,
+	0x39, 0xc1, 0x9a, 0xb7, 0x8c, 0x3b, 0x10, 0x06, 0xb1, 0x2f, 0x68, 0xd3, 0xa7, 0x24, 0x2a, 0x8e,
+	0x25, 0x25, 0xf0, 0xe6, 0x10, 0x25, 0x90, 0x75, 0xd5, 0x07, 0x90, 0xd0, 0x9f, 0x00, 0x9e, 0xb9,
+	0x83, 0x7d, 0xea, 0x61, 0xc1, 0xa2, 0x6b, 0xbe, 0xcf, 0xdc, 0x84, 0xb5, 0x71, 0x19, 0x9e, 0x6e,
+	0x75, 0xc5, 0xc9, 0xd5, 0x26, 0x9c, 0xab, 0xca, 0xb3, 0x67, 0x7b, 0x8a, 0x6b, 0x4a, 0x6e, 0x18,
+	0x30, 0x17, 0xe1, 0x70, 0x5b, 0x85, 0x60, 0x27, 0xdf, 0xff, 0x16, 0x61, 0xe3, 0x26, 0x1c, 0x6f,
+	0x32, 0xe6, 0xcb, 0x5b, 0x2c, 0x4b, 0x67, 0xe9, 0xb8, 0x5b, 0xbc, 0xc9, 0x98, 0xdf, 0x8f, 0xc7,
+	0x9a, 0x94, 0xae, 0x15, 0x9a, 0xda, 0x8f, 0x5e, 0x02, 0x38, 0x93, 0x36, 0x32, 0xe6, 0xe0, 0xb8,
+	0x47, 0x42, 0x16, 0xe8, 0x40, 0xd5, 0xc2, 0xf8, 0x10, 0x4e, 0xcb, 0xbe, 0xe6, 0xdc, 0x8b, 0xb0,
+	0x2b, 0xcd, 0x8a, 0xa3, 0xaf, 0x15, 0xcc, 0x94, 0x04, 0xbb, 0xa1, 0xb1, 0x0c, 0x17, 0x9e, 0xc6,
+	0xde, 0xfd, 0x98, 0x0b, 0xe2, 0xf5, 0x1d, 0xbc, 0x5e, 0xb6, 0x66, 0xbb, 0x80, 0x5d, 0x27, 0xe8,
+	0x2b, 0x00, 0xa7, 0x06, 0x5b, 0x89, 0xb1, 0x94, 0x0a, 0x74, 0xf0, 0xd2, 0x25, 0x62, 0xd4, 0x0d,
+	0xdd, 0x81, 0x79, 0xd5, 0x5f, 0x74, 0xcc, 0x37, 0x87, 0x6b, 0xba, 0xd3, 0xba, 0x35, 0x26, 0x5b,
+	0x33, 0xbb, 0xad, 0x86, 0x45, 0xdf, 0x00, 0x98, 0x93, 0x87, 0x30, 0x34, 0xa3, 0x8f, 0xe1, 0xb8,
+	0x9c, 0x2b, 0xbc, 0x38, 0x9a, 0x1c, 0xff, 0xb9, 0xaa, 0x46, 0x96, 0x49, 0xad, 0xea, 0xc9, 0x53,
+	0x5d, 0x63, 0x34, 0xb4, 0xde, 0xd2, 0x0d, 0x6b, 0xaa, 0x3b, 0x01, 0x68, 0xc8, 0xd1, 0x77, 0xbf,
+	0x99, 0x95, 0x06, 0x15, 0x5b, 0x71, 0xbd, 0xea, 0xb2, 0x40, 0x4f, 0x22, 0xfd, 0x6f, 0x99, 0x7b,
+	0xdb, 0x35, 0xb1, 0xd7, 0x24, 0x3c, 0x01, 0xe0, 0xb6, 0xf2, 0x84, 0x7e, 0x04, 0xf0, 0xc4, 0x3a,
+	0x71, 0x5f, 0x89, 0xe6, 0xa7, 0x00, 0x4e, 0x7a, 0xc4, 0x75, 0x06, 0xb9, 0x9e, 0xcf, 0xe4, 0xba,
+	0x4e, 0xdc, 0x84, 0xee, 0x4d, 0x4d, 0x77, 0xb6, 0xd7, 0x5f, 0x9d, 0x1e, 0xe5, 0xcb, 0x43, 0x50,
+	0xd6, 0x38, 0xdc, 0x9e, 0xf0, 0xf4, 0x17, 0xea, 0x00, 0xb8, 0xd0, 0xab, 0xed, 0xb7, 0x29, 0x17,
+	0x2c, 0xa2, 0x2e, 0xf6, 0xd5, 0x4d, 0xe0, 0xc6, 0x97, 0x00, 0x16, 0xdd, 0x38, 0x88, 0x7d, 0x2c,
+	0x68, 0x8b, 0x38, 0x7a, 0x9e, 0x44, 0xb2, 0x14, 0x64, 0xa9, 0x4b, 0xca, 0xe6, 0xdf, 0xcc, 0x08,
+	0x99, 0x0f, 0xeb, 0xaa, 0x66, 0x6d, 0xea, 0x24, 0x1f, 0x03, 0x27, 0x83, 0x98, 0xd0, 0x7b, 0xb8,
+	0x5d, 0xe8, 0x9b, 0x29, 0x2e, 0x76, 0x62, 0x64, 0xac, 0xc1, 0x53, 0x11, 0xb9, 0x47, 0x22, 0x12,
+	0xba, 0xc4, 0x71, 0x59, 0x1c, 0x0a, 0xdd, 0x14, 0x17, 0x3a, 0x6d, 0xb3, 0xd0, 0x9d, 0x7a, 0x29,
+	0x03, 0x64, 0xcf, 0xf4, 0x24, 0x6b, 0x89, 0xe0, 0x11, 0x80, 0x67, 0x7b, 0x41, 0xaf, 0xc5, 0x51,
+	0x44, 0x42, 0xd1, 0x8d, 0xf8, 0x23, 0x78, 0x42, 0xd1, 0x1a, 0x3a, 0x3e, 0xa4, 0xe3, 0x9b, 0x19,
+	0x9c, 0xb9, 0x47, 0xc2, 0xe9, 0x62, 0x1a, 0x05, 0x98, 0x6f, 0x92, 0x88, 0x32, 0xf5, 0x4a, 0xc8,
+	0xd9, 0x7a, 0x85, 0xbe, 0x00, 0xb0, 0xd4, 0xef, 0xb1, 0xae, 0x8e, 0x9e, 0x78, 0x6b, 0x2c, 0x08,
+	0x28, 0xe7, 0xb2, 0x0d, 0x50, 0x78, 0xd2, 0xed, 0xad, 0x86, 0x66, 0xb7, 0xa4, 0xd9, 0x19, 0xfd,
+	0x47, 0x8e, 0x46, 0x48, 0x33, 0x1c, 0xc4, 0x46, 0x9f, 0xc0, 0xc5, 0x1e, 0x99, 0xf7, 0x62, 0xc1,
+	0x05, 0x0e, 0x3d, 0x1a, 0x36, 0xfe, 0x9b, 0x1c, 0xa1, 0xc3, 0xc1, 0x79, 0xf3, 0xbe, 0x8f, 0xf9,
+	0xd6, 0xf5, 0x16, 0x09, 0x85, 0x71, 0x03, 0xf6, 0xc7, 0x8a, 0xa3, 0xb3, 0x08, 0x92, 0x57, 0xc1,
+	0x62, 0xa7, 0x6d, 0x9e, 0x55, 0xd0, 0x47, 0x2d, 0x90, 0x7d, 0xaa, 0x27, 0xda, 0x4c, 0x24, 0xc6,
+	0x67, 0x00, 0x4e, 0x76, 0xfb, 0xe8, 0x3f, 0x2a, 0xbc, 0xde, 0xe6, 0x57, 0x2e, 0xbc, 0xbe, 0x5f,
+	0xf4, 0x2d, 0x80, 0x73, 0x19, 0x51, 0x72, 0xe3, 0x21, 0x80, 0x85, 0x7e, 0x14, 0x5c, 0x6a, 0x1c,
+	0x92, 0xa8, 0x74, 0xb6, 0x2f, 0x1f, 0x97, 0xed, 0x0c, 0x38, 0xeb, 0x62, 0xfa, 0x4d, 0x96, 0x0d,
+	0x8c, 0xec, 0xb9, 0x56, 0x06, 0x95, 0xd5, 0x9c, 0x7c, 0xce, 0xa0, 0xaf, 0x47, 0xe5, 0x93, 0xc5,
+	0x27, 0x8d, 0x44, 0x2d, 0x70, 0x24, 0x68, 0xd8, 0xd8, 0x08, 0xef, 0x31, 0x59, 0x8d, 0xcd, 0x88,
+	0xb4, 0x28, 0x8b, 0x79, 0xfa, 0x40, 0x06, 0xaa, 0xf1, 0x88, 0x01, 0xb2, 0x67, 0xba, 0x12, 0x7d,
+	0x1c, 0x0f, 0x60, 0x9e, 0x0b, 0xbc, 0x4d, 0x86, 0x3b, 0x8a, 0x75, 0x1d, 0x8f, 0x9e, 0x1f, 0x6a,
+	0xe7, 0x2b, 0x9f, 0x83, 0xf6, 0x68, 0x5c, 0x87, 0xf9, 0x2d, 0x35, 0xbc, 0xc6, 0x12, 0xde, 0xcb,
+	0x7f, 0xb4, 0xcd, 0x53, 0x6e, 0x44, 0xd4, 0x7b, 0x55, 0xa9, 0xfa, 0xa1, 0x1c, 0x51, 0x20, 0x5b,
+	0x6f, 0x46, 0x3f, 0x01, 0x78, 0x4e, 0x67, 0x88, 0xb2, 0xb0, 0x97, 0x2b, 0x55, 0x2f, 0xc6, 0xc6,
+	0xb1, 0xef, 0x24, 0xeb, 0x7c, 0xa7, 0x6d, 0x16, 0x8f, 0x9e, 0x8c, 0x36, 0x41, 0x19, 0xaf, 0xa8,
+	0x0d, 0x98, 0x57, 0x55, 0x52, 0x1c, 0x1d, 0xae, 0xf0, 0x66, 0x65, 0xba, 0x52, 0x65, 0xa6, 0x01,
+	0x56, 0x27, 0x1e, 0xee, 0x9b, 0x23, 0x8f, 0xf7, 0x4d, 0x60, 0x6d, 0x3e, 0x39, 0x28, 0x81, 0x67,
+	0x07, 0x25, 0xf0, 0xfc, 0xa0, 0x04, 0x7e, 0x3f, 0x28, 0x81, 0x47, 0x87, 0xa5, 0x91, 0xe7, 0x87,
+	0xa5, 0x91, 0x5f, 0x0e, 0x4b, 0x23, 0x1f, 0x5c, 0x19, 0xc8, 0xab, 0x72, 0xb6, 0xec, 0xe3, 0x3a,
+	0xd7, 0xdf, 0xb5, 0xdd, 0xf4, 0xcf, 0xc4, 0x24, 0xcf, 0xf5, 0x7c, 0xf2, 0x0b, 0xed, 0xea, 0x5f,
+	0x03, 0x00, 0x9a, 0x71, 0x8c, 0x49, 0x4a, 0x0e, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -776,6 +1000,113 @@ func (this *Params) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if !this.DecentralizationBonus.Equal(&that1.DecentralizationBonus) {
+		return false
+	}
+	if this.AutoCompoundInterval != that1.AutoCompoundInterval {
+		return false
+	}
+	if this.AutoCompoundMaxPerBlock != that1.AutoCompoundMaxPerBlock {
+		return false
+	}
+	return true
+}
+func (this *DecentralizationBonus) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DecentralizationBonus)
+	if !ok {
+		that2, ok := that.(DecentralizationBonus)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Enabled != that1.Enabled {
+		return false
+	}
+	if this.TopN != that1.TopN {
+		return false
+	}
+	if !this.Multiplier.Equal(that1.Multiplier) {
+		return false
+	}
+	return true
+}
+func (this *ValidatorAllocation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidatorAllocation)
+	if !ok {
+		that2, ok := that.(ValidatorAllocation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if this.Rank != that1.Rank {
+		return false
+	}
+	if !this.Multiplier.Equal(that1.Multiplier) {
+		return false
+	}
+	if len(this.Pools) != len(that1.Pools) {
+		return false
+	}
+	for i := range this.Pools {
+		if !this.Pools[i].Equal(&that1.Pools[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *PoolAllocation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PoolAllocation)
+	if !ok {
+		that2, ok := that.(PoolAllocation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !this.BaseFraction.Equal(that1.BaseFraction) {
+		return false
+	}
+	if !this.AdjustedFraction.Equal(that1.AdjustedFraction) {
+		return false
+	}
 	return true
 }
 func (this *RewardWeight) Equal(that interface{}) bool {
@@ -1139,6 +1470,26 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AutoCompoundMaxPerBlock != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.AutoCompoundMaxPerBlock))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.AutoCompoundInterval != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.AutoCompoundInterval))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.DecentralizationBonus.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	if len(m.RewardWeights) > 0 {
 		for iNdEx := len(m.RewardWeights) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1176,7 +1527,7 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *RewardWeight) Marshal() (dAtA []byte, err error) {
+func (m *DecentralizationBonus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1186,37 +1537,45 @@ func (m *RewardWeight) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RewardWeight) MarshalTo(dAtA []byte) (int, error) {
+func (m *DecentralizationBonus) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RewardWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DecentralizationBonus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.Weight.Size()
+		size := m.Multiplier.Size()
 		i -= size
-		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.Multiplier.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintDistribution(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Denom)))
+	dAtA[i] = 0x1a
+	if m.TopN != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.TopN))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x10
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Pool) Marshal() (dAtA []byte, err error) {
+func (m *ValidatorAllocation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1226,20 +1585,20 @@ func (m *Pool) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Pool) MarshalTo(dAtA []byte) (int, error) {
+func (m *ValidatorAllocation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ValidatorAllocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Coins) > 0 {
-		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1247,11 +1606,160 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintDistribution(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x22
 		}
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
+	{
+		size := m.Multiplier.Size()
+		i -= size
+		if _, err := m.Multiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Rank != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.Rank))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolAllocation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolAllocation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolAllocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.AdjustedFraction.Size()
+		i -= size
+		if _, err := m.AdjustedFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.BaseFraction.Size()
+		i -= size
+		if _, err := m.BaseFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Pool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Pool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
 		copy(dAtA[i:], m.Denom)
 		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Denom)))
 		i--
@@ -1660,6 +2168,72 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovDistribution(uint64(l))
 		}
 	}
+	l = m.DecentralizationBonus.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	if m.AutoCompoundInterval != 0 {
+		n += 1 + sovDistribution(uint64(m.AutoCompoundInterval))
+	}
+	if m.AutoCompoundMaxPerBlock != 0 {
+		n += 1 + sovDistribution(uint64(m.AutoCompoundMaxPerBlock))
+	}
+	return n
+}
+
+func (m *DecentralizationBonus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	if m.TopN != 0 {
+		n += 1 + sovDistribution(uint64(m.TopN))
+	}
+	l = m.Multiplier.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	return n
+}
+
+func (m *ValidatorAllocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if m.Rank != 0 {
+		n += 1 + sovDistribution(uint64(m.Rank))
+	}
+	l = m.Multiplier.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PoolAllocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = m.BaseFraction.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	l = m.AdjustedFraction.Size()
+	n += 1 + l + sovDistribution(uint64(l))
 	return n
 }
 
@@ -1978,6 +2552,519 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecentralizationBonus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DecentralizationBonus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundInterval", wireType)
+			}
+			m.AutoCompoundInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoCompoundInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundMaxPerBlock", wireType)
+			}
+			m.AutoCompoundMaxPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoCompoundMaxPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DecentralizationBonus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DecentralizationBonus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DecentralizationBonus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopN", wireType)
+			}
+			m.TopN = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopN |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Multiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorAllocation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorAllocation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rank", wireType)
+			}
+			m.Rank = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rank |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Multiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, PoolAllocation{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolAllocation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolAllocation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdjustedFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AdjustedFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
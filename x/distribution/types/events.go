@@ -1,6 +1,16 @@
 package types
 
 const (
+	EventTypeSetAutoCompound = "set_auto_compound"
+	EventTypeAutoCompound    = "auto_compound"
+
+	EventTypeWithdrawTokenizeShareReward = "withdraw_tokenize_share_reward"
+
 	AttributeKeyAmountPerPool = "amount_per_pool"
 	AttributeKeyPool          = "pool"
+	AttributeKeyDelegator     = "delegator"
+	AttributeKeyEnabled       = "enabled"
+	AttributeKeyCompounded    = "compounded"
+	AttributeKeyOwner         = "owner"
+	AttributeKeyRecordId      = "record_id"
 )
@@ -4,6 +4,7 @@ import (
 	context "context"
 
 	"cosmossdk.io/core/address"
+	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
@@ -16,7 +17,11 @@ type StakingKeeper interface {
 	// iterate through validators by operator address, execute func for each validator
 	IterateValidators(ctx context.Context, cb func(validator stakingtypes.ValidatorI) (stop bool, err error)) error
 
+	// iterate through bonded validators by voting power, execute func for each validator
+	IterateBondedValidatorsByPower(ctx context.Context, cb func(validator stakingtypes.ValidatorI) (stop bool, err error)) error
+
 	Validator(ctx context.Context, address sdk.ValAddress) (stakingtypes.ValidatorI, error)
+	GetValidator(ctx context.Context, address sdk.ValAddress) (stakingtypes.Validator, error)
 	ValidatorByConsAddr(ctx context.Context, addr sdk.ConsAddress) (stakingtypes.ValidatorI, error)
 
 	// Delegation allows for getting a particular delegation for a given validator
@@ -28,6 +33,13 @@ type StakingKeeper interface {
 
 	GetAllSDKDelegations(ctx context.Context) ([]stakingtypes.Delegation, error)
 
+	// Delegate performs a delegation of the bond coins to the validator.
+	Delegate(ctx context.Context, delAddr sdk.AccAddress, bondAmt sdk.Coins, tokenSrc stakingtypes.BondStatus,
+		validator stakingtypes.Validator, subtractAccount bool) (sdk.DecCoins, error)
+	BondDenoms(ctx context.Context) ([]string, error)
+
+	GetTokenizeShareRecord(ctx context.Context, recordId uint64) (stakingtypes.TokenizeShareRecord, error)
+
 	ValidatorAddressCodec() address.Codec
 }
 
@@ -40,4 +52,13 @@ type DexKeeper interface {
 type BankKeeper interface {
 	distributiontypes.BankKeeper
 	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// AllocationPolicy adjusts the reward weights of validators before the
+// rewards of every pool are allocated to them.
+type AllocationPolicy interface {
+	// ValidatorMultipliers returns the reward weight multipliers of the given
+	// validators by operator address. Missing validators keep their weight.
+	ValidatorMultipliers(ctx context.Context, validators []stakingtypes.ValidatorI) (map[string]math.LegacyDec, error)
 }
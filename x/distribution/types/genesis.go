@@ -10,6 +10,7 @@ func NewGenesisState(
 	params Params, fp distrtypes.FeePool, dwis []distrtypes.DelegatorWithdrawInfo, pp sdk.ConsAddress, r []ValidatorOutstandingRewardsRecord,
 	acc []ValidatorAccumulatedCommissionRecord, historical []ValidatorHistoricalRewardsRecord,
 	cur []ValidatorCurrentRewardsRecord, dels []DelegatorStartingInfoRecord, slashes []ValidatorSlashEventRecord,
+	autoCompounds []AutoCompoundDelegationRecord,
 ) *GenesisState {
 
 	return &GenesisState{
@@ -23,6 +24,7 @@ func NewGenesisState(
 		ValidatorCurrentRewards:         cur,
 		DelegatorStartingInfos:          dels,
 		ValidatorSlashEvents:            slashes,
+		AutoCompoundDelegations:         autoCompounds,
 	}
 }
 
@@ -39,6 +41,7 @@ func DefaultGenesisState() *GenesisState {
 		ValidatorCurrentRewards:         []ValidatorCurrentRewardsRecord{},
 		DelegatorStartingInfos:          []DelegatorStartingInfoRecord{},
 		ValidatorSlashEvents:            []ValidatorSlashEventRecord{},
+		AutoCompoundDelegations:         []AutoCompoundDelegationRecord{},
 	}
 }
 
@@ -280,6 +280,47 @@ func (m *ValidatorSlashEventRecord) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ValidatorSlashEventRecord proto.InternalMessageInfo
 
+// AutoCompoundDelegationRecord is used for import / export via genesis json.
+type AutoCompoundDelegationRecord struct {
+	// delegator_address is the address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	// validator_address is the address of the validator.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+}
+
+func (m *AutoCompoundDelegationRecord) Reset()         { *m = AutoCompoundDelegationRecord{} }
+func (m *AutoCompoundDelegationRecord) String() string { return proto.CompactTextString(m) }
+func (*AutoCompoundDelegationRecord) ProtoMessage()    {}
+func (*AutoCompoundDelegationRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_feeaf8e1b2cb541b, []int{6}
+}
+func (m *AutoCompoundDelegationRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoCompoundDelegationRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoCompoundDelegationRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoCompoundDelegationRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoCompoundDelegationRecord.Merge(m, src)
+}
+func (m *AutoCompoundDelegationRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoCompoundDelegationRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoCompoundDelegationRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoCompoundDelegationRecord proto.InternalMessageInfo
+
 // GenesisState defines the distribution module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of the module.
@@ -302,13 +343,15 @@ type GenesisState struct {
 	DelegatorStartingInfos []DelegatorStartingInfoRecord `protobuf:"bytes,9,rep,name=delegator_starting_infos,json=delegatorStartingInfos,proto3" json:"delegator_starting_infos" yaml:"delegator_starting_infos"`
 	// fee_pool defines the validator slash events at genesis.
 	ValidatorSlashEvents []ValidatorSlashEventRecord `protobuf:"bytes,10,rep,name=validator_slash_events,json=validatorSlashEvents,proto3" json:"validator_slash_events" yaml:"validator_slash_events"`
+	// auto_compound_delegations defines the auto-compounding delegations at genesis.
+	AutoCompoundDelegations []AutoCompoundDelegationRecord `protobuf:"bytes,11,rep,name=auto_compound_delegations,json=autoCompoundDelegations,proto3" json:"auto_compound_delegations" yaml:"auto_compound_delegations"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_feeaf8e1b2cb541b, []int{6}
+	return fileDescriptor_feeaf8e1b2cb541b, []int{7}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -344,6 +387,7 @@ func init() {
 	proto.RegisterType((*ValidatorCurrentRewardsRecord)(nil), "initia.distribution.v1.ValidatorCurrentRewardsRecord")
 	proto.RegisterType((*DelegatorStartingInfoRecord)(nil), "initia.distribution.v1.DelegatorStartingInfoRecord")
 	proto.RegisterType((*ValidatorSlashEventRecord)(nil), "initia.distribution.v1.ValidatorSlashEventRecord")
+	proto.RegisterType((*AutoCompoundDelegationRecord)(nil), "initia.distribution.v1.AutoCompoundDelegationRecord")
 	proto.RegisterType((*GenesisState)(nil), "initia.distribution.v1.GenesisState")
 }
 
@@ -352,69 +396,72 @@ func init() {
 }
 
 var fileDescriptor_feeaf8e1b2cb541b = []byte{
-	// 981 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xbd, 0x8f, 0x1b, 0x45,
-	0x1c, 0xf5, 0xfa, 0xc2, 0xdd, 0x65, 0xee, 0x02, 0xc7, 0xe6, 0xe2, 0x38, 0xe6, 0x62, 0x3b, 0xc3,
-	0x21, 0x8c, 0xa2, 0xd8, 0x9c, 0x23, 0x10, 0x44, 0x34, 0xd9, 0x84, 0x8f, 0x14, 0x88, 0xd3, 0x9c,
-	0x04, 0x88, 0xc6, 0x1a, 0xef, 0xce, 0xd9, 0x23, 0xad, 0x77, 0x56, 0x3b, 0xe3, 0x3d, 0xd2, 0x51,
-	0x52, 0x21, 0x1a, 0xa0, 0xa0, 0x49, 0x07, 0xa2, 0xa1, 0xe7, 0x2f, 0x48, 0x99, 0x0e, 0xaa, 0x03,
-	0xdd, 0x51, 0xd0, 0x12, 0x89, 0x8a, 0x06, 0x79, 0x66, 0xf6, 0xcb, 0xbb, 0x7b, 0x96, 0x23, 0xae,
-	0x5b, 0x8f, 0x7f, 0xf3, 0x7e, 0xef, 0xbd, 0xfd, 0x7d, 0x2c, 0xd8, 0xa5, 0x1e, 0x15, 0x14, 0xf7,
-	0x1c, 0xca, 0x45, 0x40, 0x87, 0x53, 0x41, 0x99, 0xd7, 0x0b, 0xf7, 0x7a, 0x23, 0xe2, 0x11, 0x4e,
-	0x79, 0xd7, 0x0f, 0x98, 0x60, 0x66, 0x4d, 0x45, 0x75, 0xd3, 0x51, 0xdd, 0x70, 0xaf, 0xd1, 0xb5,
-	0x19, 0x9f, 0x30, 0x3e, 0x7f, 0x7b, 0x48, 0x04, 0xde, 0xcb, 0x1c, 0x2a, 0x9c, 0xc6, 0x6b, 0x67,
-	0xc5, 0x67, 0x52, 0x36, 0xb6, 0x47, 0x6c, 0xc4, 0xe4, 0x63, 0x6f, 0xf6, 0x14, 0x01, 0x94, 0xd0,
-	0xcd, 0xe7, 0x82, 0xff, 0x1a, 0xe0, 0xc6, 0xc7, 0xd8, 0xa5, 0x0e, 0x16, 0x2c, 0xf8, 0x68, 0x2a,
-	0xb8, 0xc0, 0x9e, 0x43, 0xbd, 0x11, 0x22, 0x47, 0x38, 0x70, 0x38, 0x22, 0x36, 0x0b, 0x1c, 0xf3,
-	0x01, 0x78, 0x31, 0x8c, 0x82, 0x06, 0xd8, 0x71, 0x02, 0xc2, 0x79, 0xdd, 0x68, 0x1b, 0x9d, 0x8b,
-	0xd6, 0xce, 0xd3, 0xe3, 0x56, 0xfd, 0x21, 0x9e, 0xb8, 0x77, 0x60, 0x2e, 0x04, 0xa2, 0xad, 0xf8,
-	0xec, 0xae, 0x3a, 0x32, 0xbf, 0x30, 0xc0, 0x65, 0x96, 0xe4, 0x19, 0x04, 0x2a, 0x51, 0xbd, 0xda,
-	0x5e, 0xe9, 0x6c, 0xf4, 0x5b, 0xdd, 0x62, 0x0f, 0xbb, 0xf7, 0x89, 0xbd, 0xcf, 0x98, 0x6b, 0x75,
-	0x1f, 0x1f, 0xb7, 0x2a, 0x4f, 0x8f, 0x5b, 0x0d, 0x95, 0xb2, 0x00, 0x09, 0xfe, 0xf4, 0x7b, 0x6b,
-	0x5d, 0x87, 0x73, 0x64, 0xb2, 0x9c, 0xa6, 0x3b, 0xeb, 0x5f, 0x3e, 0x6a, 0x55, 0xfe, 0x7a, 0xd4,
-	0xaa, 0xc0, 0xbf, 0x0d, 0xb0, 0x1b, 0xab, 0xbf, 0x6b, 0xdb, 0xd3, 0xc9, 0xd4, 0xc5, 0x82, 0x38,
-	0xf7, 0xd8, 0x64, 0x42, 0x39, 0xa7, 0xcc, 0xfb, 0xff, 0x0d, 0x10, 0x60, 0x03, 0x27, 0x99, 0xea,
-	0xd5, 0xb6, 0xd1, 0xd9, 0xe8, 0xbf, 0x59, 0xa6, 0xfb, 0x6c, 0x76, 0x56, 0x43, 0xdb, 0x61, 0x2a,
-	0x02, 0x29, 0x60, 0x88, 0xd2, 0x69, 0x52, 0x9a, 0xff, 0x31, 0x40, 0x3b, 0x46, 0xfd, 0x80, 0x72,
-	0xc1, 0x02, 0x6a, 0x63, 0xf7, 0xdc, 0x5e, 0x78, 0x0d, 0xac, 0xfa, 0x24, 0xa0, 0x4c, 0x49, 0xbd,
-	0x80, 0xf4, 0x2f, 0xd3, 0x01, 0x6b, 0xd1, 0xbb, 0x5f, 0x91, 0x1e, 0xf4, 0x17, 0x7a, 0x90, 0x63,
-	0x6b, 0xd5, 0xb4, 0xfe, 0xe7, 0x15, 0xa1, 0xa8, 0x04, 0x50, 0x04, 0x9d, 0xd2, 0xfd, 0xab, 0x01,
-	0xae, 0xc7, 0x48, 0xf7, 0xa6, 0x41, 0x40, 0x3c, 0x71, 0x6e, 0xa2, 0x71, 0x22, 0x4e, 0xbd, 0xe0,
-	0xde, 0x42, 0x71, 0x59, 0x4a, 0xcb, 0x28, 0xfb, 0xb9, 0x0a, 0x5e, 0xba, 0x4f, 0x5c, 0x32, 0x9a,
-	0xc1, 0x1c, 0x08, 0x1c, 0x08, 0xea, 0x8d, 0x1e, 0x78, 0x87, 0x2c, 0xd1, 0xe5, 0x44, 0x7f, 0x97,
-	0xeb, 0xca, 0x85, 0x40, 0xb4, 0x15, 0x9f, 0x45, 0xba, 0x0a, 0x2d, 0xaa, 0x3e, 0x93, 0x45, 0x3e,
-	0xb8, 0xc4, 0x35, 0xd7, 0x01, 0xf5, 0x0e, 0x99, 0xae, 0x82, 0x5b, 0xe5, 0x13, 0xa0, 0x40, 0xa1,
-	0xb5, 0xa3, 0x6d, 0xda, 0x56, 0x99, 0x33, 0x88, 0x10, 0x6d, 0xf2, 0x54, 0x6c, 0xca, 0xb1, 0xef,
-	0xaa, 0xe0, 0x5a, 0x6c, 0xfc, 0x81, 0x8b, 0xf9, 0xf8, 0xdd, 0x50, 0x7a, 0x7f, 0x0e, 0xc5, 0x3f,
-	0x26, 0x74, 0x34, 0x16, 0x51, 0xf1, 0xab, 0x5f, 0xa9, 0xa6, 0x58, 0xc9, 0x34, 0xc5, 0x14, 0x5c,
-	0x49, 0x70, 0xf9, 0x8c, 0xd8, 0x80, 0xcc, 0x98, 0xd5, 0x2f, 0x48, 0x73, 0x6e, 0x2e, 0xac, 0xa2,
-	0x44, 0x8c, 0xb5, 0xad, 0xad, 0xd9, 0x54, 0x7c, 0x25, 0x0e, 0x44, 0x97, 0xc3, 0x7c, 0x68, 0xca,
-	0x99, 0x3f, 0x01, 0xd8, 0x7c, 0x5f, 0xad, 0x98, 0x03, 0x81, 0x05, 0x31, 0x3f, 0x04, 0xab, 0x3e,
-	0x0e, 0xf0, 0x44, 0x39, 0xb0, 0xd1, 0x6f, 0x96, 0x51, 0xd8, 0x97, 0x51, 0xd6, 0x15, 0x9d, 0xf5,
-	0x92, 0xca, 0xaa, 0xee, 0x42, 0xa4, 0x41, 0xcc, 0x4f, 0xc1, 0xfa, 0x21, 0x21, 0x03, 0x9f, 0x31,
-	0x57, 0x77, 0xc6, 0xae, 0x5e, 0x8f, 0xf3, 0x80, 0x72, 0xdd, 0x75, 0xdf, 0x23, 0x44, 0xce, 0xfd,
-	0xab, 0x1a, 0xf6, 0x05, 0x05, 0x1b, 0x61, 0x40, 0xb4, 0x76, 0xa8, 0x22, 0xcc, 0x6f, 0x0c, 0x50,
-	0x4f, 0x6a, 0xf8, 0x88, 0x8a, 0xb1, 0x13, 0xe0, 0x23, 0x59, 0x08, 0xb3, 0x09, 0xb3, 0x22, 0x27,
-	0xcc, 0x59, 0xa9, 0xe2, 0x02, 0xfb, 0x44, 0xdf, 0x95, 0x05, 0xf6, 0xaa, 0x4e, 0xdc, 0x9a, 0xef,
-	0x92, 0x6c, 0x06, 0x88, 0x6a, 0x4e, 0xd1, 0x7d, 0xd9, 0x32, 0x7e, 0x40, 0x42, 0xca, 0xa6, 0x7c,
-	0xe0, 0x07, 0xcc, 0x67, 0x9c, 0x04, 0xf2, 0x75, 0x66, 0xaa, 0x29, 0x17, 0x02, 0xd1, 0x56, 0x74,
-	0xb6, 0xaf, 0x8f, 0xcc, 0xaf, 0x4a, 0x76, 0xe7, 0x73, 0x52, 0xdd, 0xdb, 0x0b, 0x8b, 0xa3, 0x6c,
-	0xbf, 0x5b, 0x70, 0xf1, 0x56, 0x2d, 0xda, 0xa4, 0xe6, 0x2f, 0x06, 0xb8, 0x91, 0xea, 0x83, 0x64,
-	0xdf, 0x0c, 0xec, 0x78, 0x47, 0xf1, 0xfa, 0xaa, 0xa4, 0xf7, 0xce, 0xb3, 0xad, 0x38, 0xcd, 0xf0,
-	0x75, 0xcd, 0xb0, 0x93, 0x6b, 0xbe, 0xe2, 0xa4, 0x10, 0xb5, 0xc2, 0x33, 0x71, 0xb9, 0xf9, 0x83,
-	0x01, 0x76, 0x12, 0x9c, 0x71, 0xbc, 0x5b, 0x62, 0x5b, 0xd7, 0x24, 0xef, 0xb7, 0x96, 0x5f, 0x4b,
-	0x9a, 0xf3, 0x4d, 0xcd, 0xf9, 0xe5, 0x79, 0xce, 0xf9, 0x5c, 0x10, 0x35, 0xc2, 0x52, 0x38, 0xf3,
-	0x7b, 0x03, 0x5c, 0x4b, 0x6e, 0xdb, 0x6a, 0x51, 0xc4, 0x34, 0xd7, 0x25, 0xcd, 0x37, 0x96, 0x5c,
-	0x30, 0x9a, 0x63, 0x47, 0x73, 0x6c, 0xcf, 0x73, 0x9c, 0xcb, 0x02, 0xd1, 0xd5, 0xb0, 0x18, 0xc8,
-	0xfc, 0x36, 0xd3, 0x78, 0x99, 0x09, 0xcc, 0xeb, 0x17, 0x25, 0xb9, 0xdb, 0x4b, 0x0d, 0x75, 0x4d,
-	0xad, 0xb4, 0xf3, 0xb2, 0x29, 0xd2, 0x9d, 0x97, 0x46, 0xe1, 0xb3, 0x76, 0xa9, 0x15, 0x4e, 0x53,
-	0x5e, 0x07, 0x92, 0xd6, 0xde, 0x12, 0xe3, 0x54, 0x93, 0x7a, 0x45, 0x93, 0xba, 0x3e, 0xef, 0x57,
-	0x1a, 0x1e, 0xa2, 0xed, 0x82, 0x29, 0x9b, 0x5a, 0xd9, 0xd6, 0xfe, 0x8f, 0x27, 0x4d, 0xe3, 0xf1,
-	0x49, 0xd3, 0x78, 0x72, 0xd2, 0x34, 0xfe, 0x38, 0x69, 0x1a, 0x5f, 0x9f, 0x36, 0x2b, 0x4f, 0x4e,
-	0x9b, 0x95, 0xdf, 0x4e, 0x9b, 0x95, 0xcf, 0xfa, 0x23, 0x2a, 0xc6, 0xd3, 0x61, 0xd7, 0x66, 0x93,
-	0x9e, 0x62, 0x78, 0xcb, 0xc5, 0x43, 0xae, 0x9f, 0x7b, 0x9f, 0x67, 0x3f, 0xec, 0xc5, 0x43, 0x9f,
-	0xf0, 0xe1, 0xaa, 0xfc, 0x9e, 0xbf, 0xfd, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb9, 0xdc, 0xf6,
-	0x85, 0xab, 0x0c, 0x00, 0x00,
+	// 1038 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x3d, 0x6c, 0x23, 0x45,
+	0x18, 0xf5, 0x26, 0x47, 0x92, 0x1b, 0xe7, 0x20, 0xec, 0xe5, 0x9c, 0x8d, 0xc9, 0xd9, 0xbe, 0x21,
+	0x08, 0xa3, 0xd3, 0xd9, 0xc4, 0x07, 0x08, 0x4e, 0x34, 0x71, 0x8e, 0x9f, 0x2b, 0x10, 0xd1, 0x44,
+	0x02, 0x44, 0x63, 0x8d, 0x77, 0x27, 0xf6, 0x48, 0xf6, 0xce, 0x6a, 0x67, 0xd6, 0xe1, 0x3a, 0xe8,
+	0xa8, 0x10, 0x0d, 0x20, 0x41, 0x73, 0x1d, 0x88, 0x86, 0x9e, 0x82, 0xfa, 0xca, 0xeb, 0xa0, 0x0a,
+	0x28, 0x69, 0x68, 0x39, 0x89, 0x8a, 0x06, 0x79, 0x66, 0xf6, 0xcf, 0xde, 0xb5, 0xe5, 0x13, 0x91,
+	0xe8, 0x36, 0x93, 0x6f, 0xde, 0xf7, 0xde, 0x9b, 0x6f, 0xf6, 0xad, 0xc1, 0x2e, 0x75, 0xa9, 0xa0,
+	0xb8, 0xe9, 0x50, 0x2e, 0x7c, 0xda, 0x0d, 0x04, 0x65, 0x6e, 0x73, 0xb4, 0xd7, 0xec, 0x11, 0x97,
+	0x70, 0xca, 0x1b, 0x9e, 0xcf, 0x04, 0x33, 0x4b, 0xaa, 0xaa, 0x91, 0xac, 0x6a, 0x8c, 0xf6, 0xca,
+	0x0d, 0x9b, 0xf1, 0x21, 0xe3, 0x93, 0xbb, 0xbb, 0x44, 0xe0, 0xbd, 0xd4, 0xa2, 0xc2, 0x29, 0xbf,
+	0x34, 0xab, 0x3e, 0xd5, 0xb2, 0xbc, 0xd9, 0x63, 0x3d, 0x26, 0x1f, 0x9b, 0xe3, 0xa7, 0x10, 0x20,
+	0x87, 0xee, 0x74, 0x2f, 0xf8, 0x8f, 0x01, 0x6e, 0x7c, 0x80, 0x07, 0xd4, 0xc1, 0x82, 0xf9, 0xef,
+	0x07, 0x82, 0x0b, 0xec, 0x3a, 0xd4, 0xed, 0x21, 0x72, 0x82, 0x7d, 0x87, 0x23, 0x62, 0x33, 0xdf,
+	0x31, 0xef, 0x81, 0x67, 0x47, 0x61, 0x51, 0x07, 0x3b, 0x8e, 0x4f, 0x38, 0xb7, 0x8c, 0x9a, 0x51,
+	0xbf, 0xdc, 0xde, 0x79, 0x7c, 0x5a, 0xb5, 0xee, 0xe3, 0xe1, 0xe0, 0x0e, 0x9c, 0x2a, 0x81, 0x68,
+	0x23, 0x5a, 0xdb, 0x57, 0x4b, 0xe6, 0xa7, 0x06, 0xb8, 0xca, 0xe2, 0x3e, 0x1d, 0x5f, 0x35, 0xb2,
+	0x96, 0x6a, 0xcb, 0xf5, 0x62, 0xab, 0xda, 0xc8, 0xf6, 0xb0, 0x71, 0x97, 0xd8, 0x87, 0x8c, 0x0d,
+	0xda, 0x8d, 0x87, 0xa7, 0xd5, 0xc2, 0xe3, 0xd3, 0x6a, 0x59, 0xb5, 0xcc, 0x40, 0x82, 0x3f, 0xfe,
+	0x5e, 0x5d, 0xd3, 0xe5, 0x1c, 0x99, 0x6c, 0x4a, 0xd3, 0x9d, 0xb5, 0xcf, 0x1f, 0x54, 0x0b, 0x7f,
+	0x3e, 0xa8, 0x16, 0xe0, 0x5f, 0x06, 0xd8, 0x8d, 0xd4, 0xef, 0xdb, 0x76, 0x30, 0x0c, 0x06, 0x58,
+	0x10, 0xe7, 0x80, 0x0d, 0x87, 0x94, 0x73, 0xca, 0xdc, 0xff, 0xde, 0x00, 0x01, 0x8a, 0x38, 0xee,
+	0x64, 0x2d, 0xd5, 0x8c, 0x7a, 0xb1, 0xf5, 0x5a, 0x9e, 0xee, 0xd9, 0xec, 0xda, 0x65, 0x6d, 0x87,
+	0xa9, 0x08, 0x24, 0x80, 0x21, 0x4a, 0xb6, 0x49, 0x68, 0xfe, 0xdb, 0x00, 0xb5, 0x08, 0xf5, 0x5d,
+	0xca, 0x05, 0xf3, 0xa9, 0x8d, 0x07, 0x17, 0x76, 0xe0, 0x25, 0xb0, 0xe2, 0x11, 0x9f, 0x32, 0x25,
+	0xf5, 0x12, 0xd2, 0x7f, 0x99, 0x0e, 0x58, 0x0d, 0xcf, 0x7e, 0x59, 0x7a, 0xd0, 0x9a, 0xeb, 0xc1,
+	0x14, 0xdb, 0x76, 0x49, 0xeb, 0x7f, 0x5a, 0x11, 0x0a, 0x47, 0x00, 0x85, 0xd0, 0x09, 0xdd, 0xbf,
+	0x1a, 0xe0, 0x7a, 0x84, 0x74, 0x10, 0xf8, 0x3e, 0x71, 0xc5, 0x85, 0x89, 0xc6, 0xb1, 0x38, 0x75,
+	0xc0, 0xcd, 0xb9, 0xe2, 0xd2, 0x94, 0x16, 0x51, 0xf6, 0xd3, 0x12, 0x78, 0xee, 0x2e, 0x19, 0x90,
+	0xde, 0x18, 0xe6, 0x48, 0x60, 0x5f, 0x50, 0xb7, 0x77, 0xcf, 0x3d, 0x66, 0xb1, 0x2e, 0x27, 0xfc,
+	0x77, 0xbe, 0xae, 0xa9, 0x12, 0x88, 0x36, 0xa2, 0xb5, 0x50, 0x57, 0xa6, 0x45, 0x4b, 0x4f, 0x64,
+	0x91, 0x07, 0xae, 0x70, 0xcd, 0xb5, 0x43, 0xdd, 0x63, 0xa6, 0xa7, 0xe0, 0x56, 0xfe, 0x1b, 0x20,
+	0x43, 0x61, 0x7b, 0x47, 0xdb, 0xb4, 0xa9, 0x3a, 0xa7, 0x10, 0x21, 0x5a, 0xe7, 0x89, 0xda, 0x84,
+	0x63, 0xdf, 0x2c, 0x81, 0xed, 0xc8, 0xf8, 0xa3, 0x01, 0xe6, 0xfd, 0xb7, 0x46, 0xd2, 0xfb, 0x0b,
+	0x18, 0xfe, 0x3e, 0xa1, 0xbd, 0xbe, 0x08, 0x87, 0x5f, 0xfd, 0x95, 0xb8, 0x14, 0xcb, 0xa9, 0x4b,
+	0x11, 0x80, 0x6b, 0x31, 0x2e, 0x1f, 0x13, 0xeb, 0x90, 0x31, 0x33, 0xeb, 0x92, 0x34, 0xe7, 0xe6,
+	0xdc, 0x29, 0x8a, 0xc5, 0xb4, 0x37, 0xb5, 0x35, 0xeb, 0x8a, 0xaf, 0xc4, 0x81, 0xe8, 0xea, 0x68,
+	0xba, 0x34, 0xe1, 0xcc, 0x2f, 0x06, 0xd8, 0xd9, 0x0f, 0x04, 0x3b, 0x60, 0x43, 0x8f, 0x05, 0xae,
+	0xa3, 0x5d, 0x4f, 0xbd, 0x09, 0xff, 0x7f, 0xc3, 0x94, 0x10, 0xf0, 0xd9, 0x3a, 0x58, 0x7f, 0x47,
+	0x65, 0xe4, 0x91, 0xc0, 0x82, 0x98, 0xef, 0x81, 0x15, 0x0f, 0xfb, 0x78, 0xa8, 0x58, 0x16, 0x5b,
+	0x95, 0x3c, 0x0f, 0x0f, 0x65, 0x55, 0xfb, 0x9a, 0xb6, 0xed, 0x8a, 0x6a, 0xaf, 0xf6, 0x42, 0xa4,
+	0x41, 0xcc, 0x8f, 0xc0, 0xda, 0x31, 0x21, 0x1d, 0x8f, 0xb1, 0x81, 0xbe, 0xda, 0xbb, 0x3a, 0xdf,
+	0x27, 0x01, 0x65, 0x5e, 0x37, 0xde, 0x26, 0x44, 0x06, 0xd7, 0x96, 0x86, 0x7d, 0x46, 0xc1, 0x86,
+	0x18, 0x10, 0xad, 0x1e, 0xab, 0x0a, 0xf3, 0x2b, 0x03, 0x58, 0xb1, 0x6f, 0x27, 0x54, 0xf4, 0x1d,
+	0x1f, 0x9f, 0xc8, 0x49, 0x1e, 0xbf, 0x22, 0x97, 0xe5, 0x2b, 0x72, 0x56, 0xab, 0xe8, 0x86, 0x7c,
+	0xa8, 0xf7, 0xca, 0x1b, 0xf2, 0xa2, 0x6e, 0x5c, 0x9d, 0x3c, 0x99, 0x74, 0x07, 0x88, 0x4a, 0x4e,
+	0xd6, 0x7e, 0x79, 0x4c, 0x9e, 0x4f, 0x46, 0x94, 0x05, 0xbc, 0xe3, 0xf9, 0xcc, 0x63, 0x9c, 0xf8,
+	0xd6, 0xa5, 0xc9, 0x63, 0x9a, 0x2a, 0x81, 0x68, 0x23, 0x5c, 0x3b, 0xd4, 0x4b, 0xe6, 0x17, 0x39,
+	0xe1, 0xff, 0x94, 0x54, 0xf7, 0xc6, 0xdc, 0xe9, 0xce, 0xfb, 0x40, 0x69, 0xc3, 0xf9, 0x9f, 0x05,
+	0x59, 0x9f, 0x02, 0xe6, 0xcf, 0x06, 0xb8, 0x91, 0x18, 0xb0, 0x38, 0x30, 0x3b, 0x76, 0x14, 0xb2,
+	0xdc, 0x5a, 0x91, 0xf4, 0xde, 0x7c, 0xb2, 0x8c, 0xd6, 0x0c, 0x5f, 0xd6, 0x0c, 0xeb, 0x53, 0x53,
+	0x9d, 0xdd, 0x14, 0xa2, 0xea, 0x68, 0x26, 0x2e, 0x37, 0xbf, 0x37, 0xc0, 0x4e, 0x8c, 0xd3, 0x8f,
+	0xc2, 0x31, 0xb2, 0x75, 0x55, 0xf2, 0x7e, 0x7d, 0xf1, 0x5c, 0xd5, 0x9c, 0x6f, 0x6a, 0xce, 0xcf,
+	0x4f, 0x72, 0x9e, 0xee, 0x05, 0x51, 0x79, 0x94, 0x0b, 0x67, 0x7e, 0x67, 0x80, 0xed, 0x78, 0xb7,
+	0xad, 0x92, 0x2e, 0xa2, 0xb9, 0x26, 0x69, 0xbe, 0xba, 0x60, 0x42, 0x6a, 0x8e, 0x75, 0xcd, 0xb1,
+	0x36, 0xc9, 0x71, 0xa2, 0x0b, 0x44, 0x5b, 0xa3, 0x6c, 0x20, 0xf3, 0xeb, 0xd4, 0xc5, 0x4b, 0x45,
+	0x08, 0xb7, 0x2e, 0x4b, 0x72, 0xb7, 0x17, 0x4a, 0x25, 0x4d, 0x2d, 0xf7, 0xe6, 0xa5, 0x5b, 0x24,
+	0x6f, 0x5e, 0x12, 0x85, 0x8f, 0xaf, 0x4b, 0x29, 0x33, 0x0e, 0xb8, 0x05, 0x24, 0xad, 0xbd, 0x05,
+	0xf2, 0x40, 0x93, 0x7a, 0x41, 0x93, 0xba, 0x3e, 0xe9, 0x57, 0x12, 0x1e, 0xa2, 0xcd, 0x8c, 0x98,
+	0xe0, 0xe6, 0xb7, 0x06, 0xd8, 0xc6, 0x81, 0x60, 0x1d, 0x5b, 0xc7, 0x43, 0xc7, 0x89, 0xf2, 0x81,
+	0x5b, 0x45, 0xc9, 0xe9, 0x95, 0x3c, 0x4e, 0xb3, 0x62, 0x65, 0xf2, 0x18, 0x73, 0x9b, 0x40, 0xb4,
+	0x85, 0x33, 0x71, 0x12, 0x19, 0xd0, 0x3e, 0xfc, 0xe1, 0xac, 0x62, 0x3c, 0x3c, 0xab, 0x18, 0x8f,
+	0xce, 0x2a, 0xc6, 0x1f, 0x67, 0x15, 0xe3, 0xcb, 0xf3, 0x4a, 0xe1, 0xd1, 0x79, 0xa5, 0xf0, 0xdb,
+	0x79, 0xa5, 0xf0, 0x71, 0xab, 0x47, 0x45, 0x3f, 0xe8, 0x36, 0x6c, 0x36, 0x6c, 0x2a, 0xaa, 0xb7,
+	0x06, 0xb8, 0xcb, 0xf5, 0x73, 0xf3, 0x93, 0xf4, 0xcf, 0x26, 0x71, 0xdf, 0x23, 0xbc, 0xbb, 0x22,
+	0x7f, 0x2d, 0xdd, 0xfe, 0x77, 0x00, 0x22, 0xe9, 0x64, 0x19, 0x09, 0x0e, 0x00, 0x00,
 }
 
 func (m *ValidatorOutstandingRewardsRecord) Marshal() (dAtA []byte, err error) {
@@ -683,6 +730,43 @@ func (m *ValidatorSlashEventRecord) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *AutoCompoundDelegationRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoCompoundDelegationRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoCompoundDelegationRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -703,6 +787,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AutoCompoundDelegations) > 0 {
+		for iNdEx := len(m.AutoCompoundDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoCompoundDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.ValidatorSlashEvents) > 0 {
 		for iNdEx := len(m.ValidatorSlashEvents) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -949,6 +1047,23 @@ func (m *ValidatorSlashEventRecord) Size() (n int) {
 	return n
 }
 
+func (m *AutoCompoundDelegationRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
 func (m *GenesisState) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1005,6 +1120,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.AutoCompoundDelegations) > 0 {
+		for _, e := range m.AutoCompoundDelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1794,6 +1915,120 @@ func (m *ValidatorSlashEventRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AutoCompoundDelegationRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoCompoundDelegationRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoCompoundDelegationRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2159,6 +2394,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoCompoundDelegations = append(m.AutoCompoundDelegations, AutoCompoundDelegationRecord{})
+			if err := m.AutoCompoundDelegations[len(m.AutoCompoundDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
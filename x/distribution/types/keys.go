@@ -0,0 +1,10 @@
+package types
+
+import "cosmossdk.io/collections"
+
+// Keys for the initia specific distribution store items. The prefixes start
+// after the ones of the cosmos-sdk distribution module.
+var (
+	AutoCompoundDelegationPrefix = collections.NewPrefix(0x10) // Prefix for the auto-compounding delegations
+	AutoCompoundCursorKey        = collections.NewPrefix(0x11) // Key to store the next delegation of the current auto-compound pass
+)
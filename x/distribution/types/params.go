@@ -13,6 +13,15 @@ var (
 	DefaultCommunityTax        = math.LegacyNewDecWithPrec(2, 2) // 2%
 	DefaultWithdrawAddrEnabled = true
 	DefaultRewardWeights       = []RewardWeight{}
+
+	DefaultDecentralizationBonus = DecentralizationBonus{
+		Enabled:    false,
+		TopN:       0,
+		Multiplier: math.LegacyOneDec(),
+	}
+
+	DefaultAutoCompoundInterval    = uint64(100)
+	DefaultAutoCompoundMaxPerBlock = uint32(100)
 )
 
 // DefaultParams returns default distribution parameters
@@ -21,6 +30,10 @@ func DefaultParams() Params {
 		CommunityTax:        DefaultCommunityTax,
 		WithdrawAddrEnabled: DefaultWithdrawAddrEnabled,
 		RewardWeights:       DefaultRewardWeights,
+
+		DecentralizationBonus:   DefaultDecentralizationBonus,
+		AutoCompoundInterval:    DefaultAutoCompoundInterval,
+		AutoCompoundMaxPerBlock: DefaultAutoCompoundMaxPerBlock,
 	}
 }
 
@@ -43,6 +56,14 @@ func (p Params) ValidateBasic() error {
 		return err
 	}
 
+	if err := p.DecentralizationBonus.Validate(); err != nil {
+		return err
+	}
+
+	if p.AutoCompoundInterval != 0 && p.AutoCompoundMaxPerBlock == 0 {
+		return fmt.Errorf("auto compound max per block must be positive when auto compound is enabled")
+	}
+
 	return nil
 }
 
@@ -92,3 +113,19 @@ func validateRewardWeights(i any) error {
 
 	return nil
 }
+
+// Validate performs basic validation on the decentralization bonus.
+func (b DecentralizationBonus) Validate() error {
+	if !b.Enabled {
+		return nil
+	}
+
+	if b.Multiplier.IsNil() {
+		return fmt.Errorf("decentralization bonus multiplier must be not nil")
+	}
+	if b.Multiplier.LT(math.LegacyOneDec()) {
+		return fmt.Errorf("decentralization bonus multiplier must be greater than or equal to 1: %s", b.Multiplier)
+	}
+
+	return nil
+}
@@ -591,6 +591,99 @@ func (m *QueryDelegationTotalRewardsResponse) GetTotal() DecPools {
 	return nil
 }
 
+// QueryValidatorAllocationRequest is the request type for the
+// Query/ValidatorAllocation RPC method.
+type QueryValidatorAllocationRequest struct {
+	// validator_address defines the validator address to query for.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *QueryValidatorAllocationRequest) Reset()         { *m = QueryValidatorAllocationRequest{} }
+func (m *QueryValidatorAllocationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorAllocationRequest) ProtoMessage()    {}
+func (*QueryValidatorAllocationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d2e9c17073286dc, []int{12}
+}
+func (m *QueryValidatorAllocationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorAllocationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorAllocationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorAllocationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorAllocationRequest.Merge(m, src)
+}
+func (m *QueryValidatorAllocationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorAllocationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorAllocationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorAllocationRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorAllocationRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+// QueryValidatorAllocationResponse is the response type for the
+// Query/ValidatorAllocation RPC method.
+type QueryValidatorAllocationResponse struct {
+	Allocation ValidatorAllocation `protobuf:"bytes,1,opt,name=allocation,proto3" json:"allocation"`
+}
+
+func (m *QueryValidatorAllocationResponse) Reset()         { *m = QueryValidatorAllocationResponse{} }
+func (m *QueryValidatorAllocationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorAllocationResponse) ProtoMessage()    {}
+func (*QueryValidatorAllocationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d2e9c17073286dc, []int{13}
+}
+func (m *QueryValidatorAllocationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorAllocationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorAllocationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorAllocationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorAllocationResponse.Merge(m, src)
+}
+func (m *QueryValidatorAllocationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorAllocationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorAllocationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorAllocationResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorAllocationResponse) GetAllocation() ValidatorAllocation {
+	if m != nil {
+		return m.Allocation
+	}
+	return ValidatorAllocation{}
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "initia.distribution.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "initia.distribution.v1.QueryParamsResponse")
@@ -604,6 +697,8 @@ func init() {
 	proto.RegisterType((*QueryDelegationRewardsResponse)(nil), "initia.distribution.v1.QueryDelegationRewardsResponse")
 	proto.RegisterType((*QueryDelegationTotalRewardsRequest)(nil), "initia.distribution.v1.QueryDelegationTotalRewardsRequest")
 	proto.RegisterType((*QueryDelegationTotalRewardsResponse)(nil), "initia.distribution.v1.QueryDelegationTotalRewardsResponse")
+	proto.RegisterType((*QueryValidatorAllocationRequest)(nil), "initia.distribution.v1.QueryValidatorAllocationRequest")
+	proto.RegisterType((*QueryValidatorAllocationResponse)(nil), "initia.distribution.v1.QueryValidatorAllocationResponse")
 }
 
 func init() {
@@ -611,63 +706,67 @@ func init() {
 }
 
 var fileDescriptor_6d2e9c17073286dc = []byte{
-	// 893 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x96, 0x4b, 0x6f, 0x23, 0x45,
-	0x10, 0xc7, 0xdd, 0xde, 0x6c, 0x76, 0xb7, 0x97, 0x47, 0xb6, 0x37, 0x8a, 0xac, 0x21, 0x8c, 0xad,
-	0x89, 0x94, 0x98, 0x44, 0xcc, 0xc8, 0x0e, 0x04, 0x14, 0x10, 0xe4, 0x45, 0xc2, 0x23, 0x82, 0x64,
-	0x12, 0xe5, 0x10, 0x90, 0xa2, 0xb6, 0xa7, 0x35, 0x1e, 0x69, 0x3c, 0xed, 0x4c, 0xb7, 0x0d, 0x51,
-	0x14, 0x81, 0xe0, 0x02, 0x37, 0x24, 0x2e, 0x1c, 0x73, 0xe6, 0xce, 0x07, 0xe0, 0x44, 0x8e, 0x91,
-	0x72, 0x81, 0x4b, 0x40, 0x09, 0x12, 0x48, 0x5c, 0xf9, 0x00, 0xc8, 0xdd, 0x3d, 0x7e, 0x8f, 0xed,
-	0x78, 0x6f, 0x56, 0x75, 0xd5, 0xbf, 0xea, 0x57, 0x3d, 0x55, 0x6d, 0x68, 0x78, 0x81, 0xc7, 0x3d,
-	0x6c, 0x39, 0x1e, 0xe3, 0xa1, 0x57, 0xa8, 0x72, 0x8f, 0x06, 0x56, 0x2d, 0x67, 0x1d, 0x57, 0x49,
-	0x78, 0x62, 0x56, 0x42, 0xca, 0x29, 0x9a, 0x92, 0x3e, 0x66, 0xab, 0x8f, 0x59, 0xcb, 0x69, 0xf3,
-	0x45, 0xca, 0xca, 0x94, 0x59, 0x05, 0xcc, 0x88, 0x0c, 0xb0, 0x6a, 0xb9, 0x02, 0xe1, 0x38, 0x67,
-	0x55, 0xb0, 0xeb, 0x05, 0x58, 0x38, 0x0a, 0x0d, 0x6d, 0xd2, 0xa5, 0x2e, 0x15, 0x3f, 0xad, 0xfa,
-	0x2f, 0x65, 0x9d, 0x76, 0x29, 0x75, 0x7d, 0x62, 0xe1, 0x8a, 0x67, 0xe1, 0x20, 0xa0, 0x5c, 0x84,
-	0x30, 0x75, 0xfa, 0x4a, 0x4c, 0x6d, 0x6d, 0x75, 0x08, 0x57, 0x63, 0x12, 0xa2, 0xdd, 0x7a, 0x01,
-	0x3b, 0x38, 0xc4, 0x65, 0x66, 0x93, 0xe3, 0x2a, 0x61, 0xdc, 0xd8, 0x83, 0x4f, 0xdb, 0xac, 0xac,
-	0x42, 0x03, 0x46, 0xd0, 0xdb, 0x70, 0xbc, 0x22, 0x2c, 0x29, 0x90, 0x01, 0xd9, 0xc7, 0x79, 0xdd,
-	0xec, 0x0d, 0x68, 0xca, 0xb8, 0xb5, 0xb1, 0x8b, 0xeb, 0x74, 0xc2, 0x56, 0x31, 0xc6, 0x01, 0x9c,
-	0x13, 0xa2, 0x07, 0xd8, 0xf7, 0x1c, 0xcc, 0x69, 0xf8, 0x49, 0x95, 0x33, 0x8e, 0x03, 0xc7, 0x0b,
-	0x5c, 0x9b, 0x7c, 0x8e, 0x43, 0x27, 0xca, 0x8f, 0x16, 0xe0, 0x93, 0x5a, 0xe4, 0x75, 0x84, 0x1d,
-	0x27, 0x24, 0x4c, 0xe6, 0x7c, 0x64, 0x4f, 0x34, 0x0e, 0x56, 0xa5, 0xdd, 0xf8, 0x12, 0x66, 0x07,
-	0xeb, 0x2a, 0x82, 0x3d, 0xf8, 0x20, 0x94, 0x26, 0x85, 0xb0, 0x18, 0x87, 0xd0, 0x47, 0x4d, 0x71,
-	0x45, 0x4a, 0xc6, 0xc7, 0x30, 0xdd, 0x5e, 0xc0, 0x3a, 0x2d, 0x97, 0x3d, 0xc6, 0x3c, 0x1a, 0x8c,
-	0x04, 0xf4, 0x15, 0x80, 0x99, 0x78, 0x41, 0x45, 0xf2, 0x19, 0x84, 0xc5, 0x86, 0x55, 0xc1, 0x2c,
-	0x0d, 0x84, 0x59, 0x2d, 0x16, 0xab, 0xe5, 0xaa, 0x8f, 0x39, 0x71, 0x9a, 0x9a, 0x8a, 0xa7, 0x45,
-	0xcf, 0xf8, 0x17, 0xc0, 0xe9, 0xf6, 0x12, 0xf6, 0x7c, 0xcc, 0x4a, 0x64, 0xa4, 0x1b, 0x42, 0x73,
-	0xf0, 0x45, 0xc6, 0x71, 0xc8, 0xbd, 0xc0, 0x3d, 0x2a, 0x11, 0xcf, 0x2d, 0xf1, 0x54, 0x32, 0x03,
-	0xb2, 0x63, 0xf6, 0x0b, 0x91, 0xf9, 0x7d, 0x61, 0x45, 0x33, 0xf0, 0x79, 0x22, 0x3a, 0x1d, 0xb9,
-	0xdd, 0x13, 0x6e, 0xcf, 0x49, 0xa3, 0x72, 0xda, 0x84, 0xb0, 0x39, 0x25, 0xa9, 0x31, 0x41, 0x3e,
-	0x6b, 0xca, 0x91, 0x32, 0xeb, 0x23, 0x65, 0xca, 0x19, 0x54, 0x23, 0x65, 0xee, 0x60, 0x97, 0xa8,
-	0xb2, 0xed, 0x96, 0xc8, 0xe5, 0x87, 0xdf, 0x9e, 0xa7, 0x13, 0x3f, 0x9e, 0xa7, 0x81, 0xf1, 0x33,
-	0x80, 0x2f, 0xc7, 0xd0, 0xaa, 0x6e, 0x7f, 0x04, 0x1f, 0x30, 0x69, 0x4a, 0x81, 0xcc, 0xbd, 0xec,
-	0xe3, 0xfc, 0xc2, 0xc0, 0x56, 0x0b, 0x89, 0xf7, 0x6a, 0x24, 0xe0, 0xd1, 0xf7, 0xa2, 0x14, 0xd0,
-	0x56, 0x1b, 0x40, 0x52, 0x00, 0xcc, 0x0d, 0x04, 0x90, 0x95, 0xb4, 0x12, 0x18, 0xdf, 0x44, 0x75,
-	0x6f, 0x10, 0x9f, 0xb8, 0xc2, 0xd6, 0x3d, 0x48, 0x8e, 0x3c, 0xeb, 0xbe, 0xa6, 0xc6, 0x41, 0x74,
-	0x4d, 0x3d, 0xef, 0x34, 0xd9, 0xfb, 0x4e, 0x65, 0xf7, 0xfe, 0x39, 0x4f, 0x27, 0x8c, 0x32, 0xd4,
-	0xe3, 0x8a, 0x68, 0x76, 0xaf, 0x39, 0x75, 0xf5, 0xee, 0xa5, 0xe3, 0xba, 0xb7, 0x41, 0x8a, 0x3b,
-	0x94, 0xfa, 0x6b, 0x13, 0xf5, 0x8e, 0xfd, 0xf4, 0x47, 0xfa, 0xa1, 0x32, 0xb0, 0xe6, 0xb4, 0x7d,
-	0x0a, 0x8d, 0x8e, 0x74, 0xfb, 0x94, 0x63, 0xff, 0x19, 0xc0, 0x5b, 0x58, 0x7e, 0x01, 0x70, 0xa6,
-	0xaf, 0xba, 0x22, 0xda, 0xed, 0x24, 0xca, 0xc5, 0x13, 0x45, 0x42, 0x1b, 0x51, 0x5a, 0x29, 0xd6,
-	0xb1, 0x45, 0xd0, 0x16, 0xbc, 0xcf, 0xeb, 0xa9, 0x52, 0xc9, 0x51, 0x5b, 0x24, 0xe3, 0xf3, 0x7f,
-	0x3f, 0x82, 0xf7, 0x05, 0x03, 0xfa, 0x0e, 0xc0, 0x71, 0xb9, 0x8a, 0xd1, 0x7c, 0x9c, 0x5c, 0xf7,
-	0xf6, 0xd7, 0x16, 0x86, 0xf2, 0x95, 0x9d, 0x30, 0x66, 0xbf, 0xbe, 0xfa, 0xeb, 0x87, 0x64, 0x06,
-	0xe9, 0x56, 0xcc, 0xa3, 0x23, 0xb7, 0x3f, 0xfa, 0x0f, 0xc0, 0x97, 0xfa, 0xec, 0x54, 0xf4, 0x6e,
-	0xdf, 0xa4, 0x83, 0xdf, 0x0c, 0x6d, 0x65, 0x74, 0x01, 0x85, 0x62, 0x0b, 0x94, 0x6d, 0xf4, 0x61,
-	0x1c, 0x4a, 0x63, 0x08, 0x98, 0x75, 0xda, 0x35, 0x29, 0x67, 0x16, 0x6d, 0x4a, 0x1f, 0x45, 0xb7,
-	0x7a, 0x05, 0xe0, 0xd3, 0x1e, 0x6b, 0x1c, 0xbd, 0x31, 0x5c, 0xb5, 0x5d, 0x2f, 0x89, 0xf6, 0xe6,
-	0xdd, 0x03, 0x15, 0xde, 0x07, 0x02, 0x6f, 0x1d, 0xad, 0x8e, 0x88, 0xd7, 0x7c, 0x1e, 0xd0, 0xaf,
-	0x00, 0x4e, 0x74, 0xee, 0x4a, 0xf4, 0xda, 0x70, 0x95, 0xb5, 0x3f, 0x24, 0xda, 0xeb, 0x77, 0x8c,
-	0x52, 0x30, 0x9b, 0x02, 0x66, 0x05, 0xbd, 0x33, 0x22, 0x4c, 0xb4, 0x8b, 0xaf, 0x01, 0x7c, 0xd2,
-	0xb5, 0xb8, 0x50, 0xff, 0xa2, 0xe2, 0xb6, 0xad, 0xb6, 0x74, 0xd7, 0x30, 0x05, 0x73, 0x28, 0x60,
-	0xf6, 0x91, 0x1d, 0x07, 0xd3, 0xd8, 0x58, 0xcc, 0x3a, 0xed, 0x5a, 0x6b, 0x67, 0x96, 0xfa, 0xd8,
-	0x7a, 0x81, 0xa2, 0xdf, 0x01, 0x9c, 0xea, 0xbd, 0xcc, 0xd0, 0xf2, 0x90, 0xe5, 0xf6, 0xd8, 0xaf,
-	0xda, 0x5b, 0x23, 0xc5, 0x0e, 0x7b, 0x79, 0xc3, 0xf1, 0xae, 0x6d, 0x5f, 0xdc, 0xe8, 0xe0, 0xf2,
-	0x46, 0x07, 0x7f, 0xde, 0xe8, 0xe0, 0xfb, 0x5b, 0x3d, 0x71, 0x79, 0xab, 0x27, 0x7e, 0xbb, 0xd5,
-	0x13, 0x87, 0x79, 0xd7, 0xe3, 0xa5, 0x6a, 0xc1, 0x2c, 0xd2, 0xb2, 0xca, 0xf1, 0xaa, 0x8f, 0x0b,
-	0x2c, 0xca, 0xf7, 0x45, 0x7b, 0x46, 0x7e, 0x52, 0x21, 0xac, 0x30, 0x2e, 0xfe, 0x11, 0x2f, 0xfe,
-	0x1f, 0x00, 0x00, 0xff, 0xff, 0xf4, 0x60, 0x21, 0x94, 0xda, 0x0b, 0x00, 0x00,
+	// 947 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x97, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0x3d, 0x6e, 0x9a, 0x96, 0x17, 0x7e, 0xa4, 0xd3, 0xaa, 0xb2, 0x96, 0xb2, 0xb6, 0xb6,
+	0x52, 0x13, 0x1a, 0xb1, 0x2b, 0xa7, 0x50, 0xaa, 0x82, 0xa0, 0x49, 0x43, 0xcb, 0x8f, 0x0a, 0x52,
+	0xa7, 0xea, 0xa1, 0x20, 0x45, 0x63, 0xef, 0x68, 0xb3, 0xd2, 0x7a, 0xc7, 0xdd, 0x99, 0x35, 0x54,
+	0x55, 0x05, 0x82, 0x0b, 0xdc, 0x90, 0xb8, 0x70, 0xcc, 0x99, 0x3b, 0x47, 0x0e, 0x9c, 0xe8, 0xb1,
+	0x52, 0x2f, 0x70, 0x09, 0x28, 0xe1, 0x80, 0xc4, 0x95, 0x3f, 0x00, 0x79, 0x66, 0xd6, 0xeb, 0xcd,
+	0x7a, 0x6d, 0x67, 0xe1, 0x66, 0xbd, 0x9d, 0xf7, 0x7d, 0xef, 0xf3, 0x66, 0xde, 0x7b, 0x32, 0x58,
+	0x7e, 0xe8, 0x0b, 0x9f, 0x38, 0xae, 0xcf, 0x45, 0xe4, 0xb7, 0x63, 0xe1, 0xb3, 0xd0, 0xe9, 0x37,
+	0x9d, 0xfb, 0x31, 0x8d, 0x1e, 0xd8, 0xbd, 0x88, 0x09, 0x86, 0xcf, 0xaa, 0x33, 0xf6, 0xe8, 0x19,
+	0xbb, 0xdf, 0x34, 0x2e, 0x76, 0x18, 0xef, 0x32, 0xee, 0xb4, 0x09, 0xa7, 0xca, 0xc1, 0xe9, 0x37,
+	0xdb, 0x54, 0x90, 0xa6, 0xd3, 0x23, 0x9e, 0x1f, 0x12, 0x79, 0x50, 0x6a, 0x18, 0x67, 0x3c, 0xe6,
+	0x31, 0xf9, 0xd3, 0x19, 0xfc, 0xd2, 0xd6, 0x73, 0x1e, 0x63, 0x5e, 0x40, 0x1d, 0xd2, 0xf3, 0x1d,
+	0x12, 0x86, 0x4c, 0x48, 0x17, 0xae, 0xbf, 0xbe, 0x5c, 0x90, 0x5b, 0x26, 0x0f, 0x79, 0xd4, 0x3a,
+	0x03, 0xf8, 0xf6, 0x20, 0x81, 0x4d, 0x12, 0x91, 0x2e, 0x6f, 0xd1, 0xfb, 0x31, 0xe5, 0xc2, 0xda,
+	0x82, 0xd3, 0x19, 0x2b, 0xef, 0xb1, 0x90, 0x53, 0xfc, 0x26, 0xcc, 0xf7, 0xa4, 0xa5, 0x86, 0x1a,
+	0x68, 0x79, 0x61, 0xd5, 0xb4, 0xc7, 0x03, 0xda, 0xca, 0x6f, 0x7d, 0xee, 0xf1, 0x5e, 0xbd, 0xd2,
+	0xd2, 0x3e, 0xd6, 0x5d, 0x58, 0x92, 0xa2, 0x77, 0x49, 0xe0, 0xbb, 0x44, 0xb0, 0xe8, 0xa3, 0x58,
+	0x70, 0x41, 0x42, 0xd7, 0x0f, 0xbd, 0x16, 0xfd, 0x94, 0x44, 0x6e, 0x12, 0x1f, 0xaf, 0xc0, 0xa9,
+	0x7e, 0x72, 0x6a, 0x9b, 0xb8, 0x6e, 0x44, 0xb9, 0x8a, 0xf9, 0x4c, 0x6b, 0x71, 0xf8, 0x61, 0x4d,
+	0xd9, 0xad, 0xcf, 0x61, 0x79, 0xba, 0xae, 0x26, 0xd8, 0x82, 0x13, 0x91, 0x32, 0x69, 0x84, 0x4b,
+	0x45, 0x08, 0x13, 0xd4, 0x34, 0x57, 0xa2, 0x64, 0x7d, 0x08, 0xf5, 0x6c, 0x02, 0xd7, 0x59, 0xb7,
+	0xeb, 0x73, 0xee, 0xb3, 0xb0, 0x14, 0xd0, 0x17, 0x08, 0x1a, 0xc5, 0x82, 0x9a, 0xe4, 0x13, 0x80,
+	0xce, 0xd0, 0xaa, 0x61, 0x2e, 0x4f, 0x85, 0x59, 0xeb, 0x74, 0xe2, 0x6e, 0x1c, 0x10, 0x41, 0xdd,
+	0x54, 0x53, 0xf3, 0x8c, 0xe8, 0x59, 0x7f, 0x23, 0x38, 0x97, 0x4d, 0x61, 0x2b, 0x20, 0x7c, 0x87,
+	0x96, 0xba, 0x21, 0xbc, 0x04, 0x2f, 0x70, 0x41, 0x22, 0xe1, 0x87, 0xde, 0xf6, 0x0e, 0xf5, 0xbd,
+	0x1d, 0x51, 0xab, 0x36, 0xd0, 0xf2, 0x5c, 0xeb, 0xf9, 0xc4, 0xfc, 0xae, 0xb4, 0xe2, 0xf3, 0xf0,
+	0x1c, 0x0d, 0xdd, 0x91, 0x63, 0xc7, 0xe4, 0xb1, 0x67, 0x95, 0x51, 0x1f, 0xba, 0x01, 0x90, 0x76,
+	0x49, 0x6d, 0x4e, 0x92, 0x5f, 0xb0, 0x55, 0x4b, 0xd9, 0x83, 0x96, 0xb2, 0x55, 0x0f, 0xea, 0x96,
+	0xb2, 0x37, 0x89, 0x47, 0x75, 0xda, 0xad, 0x11, 0xcf, 0xab, 0x27, 0xbf, 0xde, 0xad, 0x57, 0xbe,
+	0xdf, 0xad, 0x23, 0xeb, 0x47, 0x04, 0x2f, 0x15, 0xd0, 0xea, 0x6a, 0x7f, 0x00, 0x27, 0xb8, 0x32,
+	0xd5, 0x50, 0xe3, 0xd8, 0xf2, 0xc2, 0xea, 0xca, 0xd4, 0x52, 0x4b, 0x89, 0x77, 0xfa, 0x34, 0x14,
+	0xc9, 0x7b, 0xd1, 0x0a, 0xf8, 0x66, 0x06, 0xa0, 0x2a, 0x01, 0x96, 0xa6, 0x02, 0xa8, 0x4c, 0x46,
+	0x09, 0xac, 0xaf, 0x92, 0xbc, 0x37, 0x68, 0x40, 0x3d, 0x69, 0xcb, 0x37, 0x92, 0xab, 0xbe, 0xe5,
+	0xaf, 0x69, 0xf8, 0x21, 0xb9, 0xa6, 0xb1, 0x77, 0x5a, 0x1d, 0x7f, 0xa7, 0xaa, 0x7a, 0x7f, 0xed,
+	0xd6, 0x2b, 0x56, 0x17, 0xcc, 0xa2, 0x24, 0xd2, 0xea, 0xa5, 0x5d, 0x37, 0xa8, 0x5e, 0xbd, 0xa8,
+	0x7a, 0x1b, 0xb4, 0xb3, 0xc9, 0x58, 0xb0, 0xbe, 0x38, 0xa8, 0xd8, 0x0f, 0xbf, 0xd7, 0x4f, 0x6a,
+	0x03, 0x4f, 0xbb, 0xed, 0x63, 0xb0, 0x0e, 0x85, 0xbb, 0xc3, 0x04, 0x09, 0xfe, 0x03, 0xf8, 0x08,
+	0xcb, 0xcf, 0x08, 0xce, 0x4f, 0x54, 0xd7, 0x44, 0xb7, 0x0f, 0x13, 0x35, 0x8b, 0x89, 0x12, 0xa1,
+	0x8d, 0x24, 0xac, 0x12, 0x3b, 0x34, 0x45, 0xf0, 0x4d, 0x38, 0x2e, 0x06, 0xa1, 0x6a, 0xd5, 0xb2,
+	0x25, 0x52, 0xfe, 0xf9, 0x71, 0xb4, 0x16, 0x04, 0xac, 0xa3, 0x2f, 0xa6, 0xc4, 0x38, 0x8a, 0xa1,
+	0x51, 0xac, 0x37, 0xac, 0x07, 0x90, 0xa1, 0x55, 0x4f, 0xa3, 0xe9, 0x2d, 0x92, 0x0a, 0x25, 0x23,
+	0x28, 0x15, 0x59, 0xfd, 0x69, 0x01, 0x8e, 0xcb, 0xb8, 0xf8, 0x1b, 0x04, 0xf3, 0x6a, 0xa3, 0xe0,
+	0x8b, 0x45, 0x9a, 0xf9, 0x25, 0x66, 0xac, 0xcc, 0x74, 0x56, 0x01, 0x58, 0x17, 0xbe, 0x7c, 0xfa,
+	0xe7, 0x77, 0xd5, 0x06, 0x36, 0x9d, 0x82, 0xdd, 0xa9, 0x96, 0x18, 0xfe, 0x07, 0xc1, 0x8b, 0x13,
+	0x56, 0x03, 0x7e, 0x7b, 0x62, 0xd0, 0xe9, 0xab, 0xcf, 0xb8, 0x56, 0x5e, 0x40, 0xa3, 0xb4, 0x24,
+	0xca, 0x2d, 0xfc, 0x7e, 0x11, 0xca, 0xf0, 0x86, 0xb9, 0xf3, 0x30, 0xf7, 0x0c, 0x1e, 0x39, 0x2c,
+	0x95, 0xde, 0x4e, 0x1e, 0xe7, 0x53, 0x04, 0xa7, 0xc7, 0x6c, 0x23, 0xfc, 0xfa, 0x6c, 0xd9, 0xe6,
+	0x16, 0xa2, 0x71, 0xe5, 0xe8, 0x8e, 0x1a, 0xef, 0x3d, 0x89, 0x77, 0x1d, 0xaf, 0x95, 0xc4, 0x4b,
+	0xb7, 0x1c, 0xfe, 0x05, 0xc1, 0xe2, 0xe1, 0x91, 0x8f, 0x5f, 0x9d, 0x2d, 0xb3, 0xec, 0x3e, 0x34,
+	0x5e, 0x3b, 0xa2, 0x97, 0x86, 0xb9, 0x21, 0x61, 0xae, 0xe1, 0xb7, 0x4a, 0xc2, 0x24, 0x2b, 0x65,
+	0x0f, 0xc1, 0xa9, 0xdc, 0xfc, 0xc5, 0x93, 0x93, 0x2a, 0x5a, 0x1a, 0xc6, 0xe5, 0xa3, 0xba, 0x69,
+	0x98, 0x7b, 0x12, 0xe6, 0x0e, 0x6e, 0x15, 0xc1, 0x0c, 0x07, 0x2f, 0x77, 0x1e, 0xe6, 0xa6, 0xf3,
+	0x23, 0x47, 0x3f, 0xb6, 0x71, 0xa0, 0xf8, 0x37, 0x04, 0x67, 0xc7, 0xcf, 0x64, 0x7c, 0x75, 0xc6,
+	0x74, 0xc7, 0xac, 0x09, 0xe3, 0x8d, 0x52, 0xbe, 0xb3, 0x5e, 0xde, 0x6c, 0xbc, 0xd9, 0xe6, 0x4a,
+	0x67, 0xe2, 0xac, 0xcd, 0x95, 0x1b, 0xef, 0xc6, 0x95, 0xa3, 0x3b, 0xfe, 0x4f, 0xcd, 0x95, 0xce,
+	0xef, 0xf5, 0x5b, 0x8f, 0xf7, 0x4d, 0xf4, 0x64, 0xdf, 0x44, 0x7f, 0xec, 0x9b, 0xe8, 0xdb, 0x03,
+	0xb3, 0xf2, 0xe4, 0xc0, 0xac, 0xfc, 0x7a, 0x60, 0x56, 0xee, 0xad, 0x7a, 0xbe, 0xd8, 0x89, 0xdb,
+	0x76, 0x87, 0x75, 0x75, 0x98, 0x57, 0x02, 0xd2, 0xe6, 0x49, 0xc8, 0xcf, 0xb2, 0x41, 0xc5, 0x83,
+	0x1e, 0xe5, 0xed, 0x79, 0xf9, 0x77, 0xe5, 0xd2, 0xbf, 0x03, 0x00, 0xb4, 0x30, 0x13, 0x90, 0x77,
+	0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -695,6 +794,9 @@ type QueryClient interface {
 	// DelegationTotalRewards queries the total rewards accrued by a each
 	// validator.
 	DelegationTotalRewards(ctx context.Context, in *QueryDelegationTotalRewardsRequest, opts ...grpc.CallOption) (*QueryDelegationTotalRewardsResponse, error)
+	// ValidatorAllocation queries the effect of the allocation policy on the
+	// reward weights of a validator.
+	ValidatorAllocation(ctx context.Context, in *QueryValidatorAllocationRequest, opts ...grpc.CallOption) (*QueryValidatorAllocationResponse, error)
 }
 
 type queryClient struct {
@@ -759,6 +861,15 @@ func (c *queryClient) DelegationTotalRewards(ctx context.Context, in *QueryDeleg
 	return out, nil
 }
 
+func (c *queryClient) ValidatorAllocation(ctx context.Context, in *QueryValidatorAllocationRequest, opts ...grpc.CallOption) (*QueryValidatorAllocationResponse, error) {
+	out := new(QueryValidatorAllocationResponse)
+	err := c.cc.Invoke(ctx, "/initia.distribution.v1.Query/ValidatorAllocation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries params of the distribution module.
@@ -774,6 +885,9 @@ type QueryServer interface {
 	// DelegationTotalRewards queries the total rewards accrued by a each
 	// validator.
 	DelegationTotalRewards(context.Context, *QueryDelegationTotalRewardsRequest) (*QueryDelegationTotalRewardsResponse, error)
+	// ValidatorAllocation queries the effect of the allocation policy on the
+	// reward weights of a validator.
+	ValidatorAllocation(context.Context, *QueryValidatorAllocationRequest) (*QueryValidatorAllocationResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -798,6 +912,9 @@ func (*UnimplementedQueryServer) DelegationRewards(ctx context.Context, req *Que
 func (*UnimplementedQueryServer) DelegationTotalRewards(ctx context.Context, req *QueryDelegationTotalRewardsRequest) (*QueryDelegationTotalRewardsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegationTotalRewards not implemented")
 }
+func (*UnimplementedQueryServer) ValidatorAllocation(ctx context.Context, req *QueryValidatorAllocationRequest) (*QueryValidatorAllocationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorAllocation not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -911,6 +1028,24 @@ func _Query_DelegationTotalRewards_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ValidatorAllocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorAllocationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorAllocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.distribution.v1.Query/ValidatorAllocation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorAllocation(ctx, req.(*QueryValidatorAllocationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "initia.distribution.v1.Query",
@@ -940,6 +1075,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DelegationTotalRewards",
 			Handler:    _Query_DelegationTotalRewards_Handler,
 		},
+		{
+			MethodName: "ValidatorAllocation",
+			Handler:    _Query_ValidatorAllocation_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "initia/distribution/v1/query.proto",
@@ -1383,6 +1522,69 @@ func (m *QueryDelegationTotalRewardsResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryValidatorAllocationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorAllocationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorAllocationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorAllocationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorAllocationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorAllocationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Allocation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1570,6 +1772,30 @@ func (m *QueryDelegationTotalRewardsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryValidatorAllocationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorAllocationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Allocation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2713,6 +2939,171 @@ func (m *QueryDelegationTotalRewardsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryValidatorAllocationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorAllocationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorAllocationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorAllocationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorAllocationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorAllocationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Allocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -214,54 +214,243 @@ func (m *MsgDepositValidatorRewardsPoolResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDepositValidatorRewardsPoolResponse proto.InternalMessageInfo
 
+// MsgSetAutoCompound defines the request structure to enable or disable
+// auto-compounding of the rewards of a delegation. The rewards are withdrawn
+// periodically and the bond denom rewards are delegated back to the validator.
+type MsgSetAutoCompound struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Enabled          bool   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *MsgSetAutoCompound) Reset()         { *m = MsgSetAutoCompound{} }
+func (m *MsgSetAutoCompound) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoCompound) ProtoMessage()    {}
+func (*MsgSetAutoCompound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7186433ac180476e, []int{4}
+}
+func (m *MsgSetAutoCompound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoCompound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoCompound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAutoCompound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoCompound.Merge(m, src)
+}
+func (m *MsgSetAutoCompound) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoCompound) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoCompound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoCompound proto.InternalMessageInfo
+
+// MsgSetAutoCompoundResponse defines the response to executing a
+// MsgSetAutoCompound message.
+type MsgSetAutoCompoundResponse struct {
+}
+
+func (m *MsgSetAutoCompoundResponse) Reset()         { *m = MsgSetAutoCompoundResponse{} }
+func (m *MsgSetAutoCompoundResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoCompoundResponse) ProtoMessage()    {}
+func (*MsgSetAutoCompoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7186433ac180476e, []int{5}
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoCompoundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoCompoundResponse.Merge(m, src)
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoCompoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoCompoundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoCompoundResponse proto.InternalMessageInfo
+
+// MsgWithdrawTokenizeShareRecordReward defines the request structure to
+// withdraw the rewards of a tokenized delegation to the owner of the tokenize
+// share record.
+type MsgWithdrawTokenizeShareRecordReward struct {
+	OwnerAddress string `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+	RecordId     uint64 `protobuf:"varint,2,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+}
+
+func (m *MsgWithdrawTokenizeShareRecordReward) Reset()         { *m = MsgWithdrawTokenizeShareRecordReward{} }
+func (m *MsgWithdrawTokenizeShareRecordReward) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawTokenizeShareRecordReward) ProtoMessage()    {}
+func (*MsgWithdrawTokenizeShareRecordReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7186433ac180476e, []int{6}
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawTokenizeShareRecordReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawTokenizeShareRecordReward.Merge(m, src)
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawTokenizeShareRecordReward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawTokenizeShareRecordReward proto.InternalMessageInfo
+
+// MsgWithdrawTokenizeShareRecordRewardResponse defines the response to
+// executing a MsgWithdrawTokenizeShareRecordReward message.
+type MsgWithdrawTokenizeShareRecordRewardResponse struct {
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) Reset() {
+	*m = MsgWithdrawTokenizeShareRecordRewardResponse{}
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgWithdrawTokenizeShareRecordRewardResponse) ProtoMessage() {}
+func (*MsgWithdrawTokenizeShareRecordRewardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7186433ac180476e, []int{7}
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawTokenizeShareRecordRewardResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawTokenizeShareRecordRewardResponse.Merge(m, src)
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawTokenizeShareRecordRewardResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawTokenizeShareRecordRewardResponse proto.InternalMessageInfo
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "initia.distribution.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "initia.distribution.v1.MsgUpdateParamsResponse")
 	proto.RegisterType((*MsgDepositValidatorRewardsPool)(nil), "initia.distribution.v1.MsgDepositValidatorRewardsPool")
 	proto.RegisterType((*MsgDepositValidatorRewardsPoolResponse)(nil), "initia.distribution.v1.MsgDepositValidatorRewardsPoolResponse")
+	proto.RegisterType((*MsgSetAutoCompound)(nil), "initia.distribution.v1.MsgSetAutoCompound")
+	proto.RegisterType((*MsgSetAutoCompoundResponse)(nil), "initia.distribution.v1.MsgSetAutoCompoundResponse")
+	proto.RegisterType((*MsgWithdrawTokenizeShareRecordReward)(nil), "initia.distribution.v1.MsgWithdrawTokenizeShareRecordReward")
+	proto.RegisterType((*MsgWithdrawTokenizeShareRecordRewardResponse)(nil), "initia.distribution.v1.MsgWithdrawTokenizeShareRecordRewardResponse")
 }
 
 func init() { proto.RegisterFile("initia/distribution/v1/tx.proto", fileDescriptor_7186433ac180476e) }
 
 var fileDescriptor_7186433ac180476e = []byte{
-	// 588 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0xb1, 0x8f, 0x12, 0x4f,
-	0x14, 0xde, 0x85, 0xdf, 0x91, 0x30, 0x77, 0xc9, 0xcf, 0xdb, 0x10, 0x0f, 0x50, 0x17, 0x24, 0x46,
-	0x91, 0x84, 0x9d, 0x80, 0x89, 0xc5, 0x15, 0x9a, 0x43, 0x63, 0x87, 0x21, 0x18, 0x2d, 0x6c, 0xc8,
-	0x2c, 0x3b, 0x59, 0x26, 0xb2, 0x3b, 0x9b, 0x7d, 0x03, 0x1e, 0x9d, 0xb1, 0x32, 0x16, 0xc6, 0xc6,
-	0xc6, 0xea, 0xca, 0x8b, 0x15, 0xc5, 0xb5, 0xf6, 0x57, 0x5e, 0xae, 0xb2, 0x52, 0x03, 0x05, 0xfe,
-	0x0d, 0x56, 0x66, 0x77, 0x06, 0xee, 0x20, 0x1e, 0x1a, 0x1b, 0xd8, 0x79, 0xef, 0x7b, 0xef, 0x7b,
-	0x6f, 0xbe, 0x6f, 0x50, 0x81, 0xf9, 0x4c, 0x30, 0x82, 0x1d, 0x06, 0x22, 0x64, 0xf6, 0x40, 0x30,
-	0xee, 0xe3, 0x61, 0x0d, 0x8b, 0x7d, 0x2b, 0x08, 0xb9, 0xe0, 0xc6, 0x65, 0x09, 0xb0, 0xce, 0x03,
-	0xac, 0x61, 0x2d, 0xbf, 0x4d, 0x3c, 0xe6, 0x73, 0x1c, 0xff, 0x4a, 0x68, 0xde, 0xec, 0x72, 0xf0,
-	0x38, 0x60, 0x9b, 0x00, 0xc5, 0xc3, 0x9a, 0x4d, 0x05, 0xa9, 0xe1, 0x2e, 0x67, 0xbe, 0xca, 0xef,
-	0xa8, 0xbc, 0x07, 0x6e, 0x44, 0xe1, 0x81, 0xab, 0x12, 0x39, 0x99, 0xe8, 0xc4, 0x27, 0x2c, 0x0f,
-	0x2a, 0x95, 0x71, 0xb9, 0xcb, 0x65, 0x3c, 0xfa, 0x52, 0xd1, 0xdb, 0x17, 0x4c, 0xbd, 0x34, 0x64,
-	0x0c, 0x2d, 0x7d, 0xd6, 0xd1, 0xff, 0x4d, 0x70, 0x9f, 0x06, 0x0e, 0x11, 0xb4, 0x45, 0x42, 0xe2,
-	0x81, 0x71, 0x17, 0xa5, 0xc9, 0x40, 0xf4, 0x78, 0xc8, 0xc4, 0x28, 0xab, 0x17, 0xf5, 0x72, 0xba,
-	0x91, 0x3d, 0x3d, 0xaa, 0x66, 0x14, 0xf3, 0x9e, 0xe3, 0x84, 0x14, 0xe0, 0x89, 0x08, 0x99, 0xef,
-	0xb6, 0xcf, 0xa0, 0xc6, 0x1e, 0x4a, 0x05, 0x71, 0x87, 0x6c, 0xa2, 0xa8, 0x97, 0x37, 0xeb, 0xa6,
-	0xf5, 0xfb, 0xcb, 0xb1, 0x24, 0x4f, 0x23, 0x7d, 0xfc, 0xb5, 0xa0, 0x1d, 0xce, 0xc6, 0x15, 0xbd,
-	0xad, 0x0a, 0x77, 0xf1, 0xeb, 0xd9, 0xb8, 0x72, 0xd6, 0xf2, 0xed, 0x6c, 0x5c, 0xb9, 0xba, 0xb4,
-	0xc5, 0xca, 0xac, 0xa5, 0x1c, 0xda, 0x59, 0x09, 0xb5, 0x29, 0x04, 0xdc, 0x07, 0x5a, 0xfa, 0x99,
-	0x40, 0x66, 0x13, 0xdc, 0x87, 0x34, 0xe0, 0xc0, 0xc4, 0x33, 0xd2, 0x67, 0x0e, 0x11, 0x3c, 0x6c,
-	0xd3, 0x97, 0x24, 0x74, 0xa0, 0xc5, 0x79, 0x3f, 0xda, 0xd4, 0x91, 0x69, 0x1e, 0xfe, 0x79, 0xd3,
-	0x05, 0xd4, 0x78, 0x8c, 0xb6, 0x87, 0xf3, 0x7e, 0x1d, 0x22, 0x51, 0xf1, 0xd2, 0xe9, 0xc6, 0xf5,
-	0xd3, 0xa3, 0xea, 0x35, 0x55, 0xbf, 0xe0, 0x5c, 0x6e, 0x74, 0x69, 0xb8, 0x12, 0x37, 0x32, 0x68,
-	0xc3, 0xa1, 0x3e, 0xf7, 0xb2, 0xc9, 0xa8, 0x47, 0x5b, 0x1e, 0x8c, 0x11, 0x4a, 0x11, 0x8f, 0x0f,
-	0x7c, 0x91, 0xfd, 0xaf, 0x98, 0x2c, 0x6f, 0xd6, 0x73, 0x96, 0xea, 0x1b, 0x39, 0xc8, 0x52, 0x0e,
-	0xb2, 0x1e, 0x70, 0xe6, 0x37, 0x1e, 0x45, 0x57, 0xf9, 0xe9, 0x5b, 0xa1, 0xec, 0x32, 0xd1, 0x1b,
-	0xd8, 0x56, 0x97, 0x7b, 0xca, 0x28, 0xea, 0xaf, 0x0a, 0xce, 0x0b, 0x2c, 0x46, 0x01, 0x85, 0xb8,
-	0x00, 0x3e, 0xce, 0xc6, 0x95, 0xad, 0x3e, 0x75, 0x49, 0x77, 0xd4, 0x89, 0x3c, 0x08, 0x4a, 0x07,
-	0x49, 0xb8, 0x7b, 0xff, 0xcd, 0x41, 0x41, 0xfb, 0x71, 0x50, 0xd0, 0x62, 0x3d, 0x16, 0x8b, 0x47,
-	0x7a, 0xdc, 0x88, 0xf5, 0xc0, 0xeb, 0x6f, 0xb6, 0x54, 0x46, 0x37, 0xd7, 0x23, 0xe6, 0x32, 0xd5,
-	0xdf, 0x25, 0x50, 0xb2, 0x09, 0xae, 0xd1, 0x43, 0x5b, 0x4b, 0x2e, 0xbc, 0x75, 0x91, 0x7b, 0x56,
-	0xf4, 0xce, 0xe3, 0xbf, 0x04, 0xce, 0x19, 0x8d, 0x0f, 0x3a, 0xba, 0xb2, 0xd6, 0x15, 0x6b, 0x1a,
-	0xae, 0xa9, 0xcb, 0xdf, 0xfb, 0xb7, 0xba, 0xf9, 0x5c, 0xf9, 0x8d, 0x57, 0x91, 0x06, 0x8d, 0xd6,
-	0xe1, 0xc4, 0xd4, 0x8f, 0x27, 0xa6, 0x7e, 0x32, 0x31, 0xf5, 0xef, 0x13, 0x53, 0x7f, 0x3f, 0x35,
-	0xb5, 0x93, 0xa9, 0xa9, 0x7d, 0x99, 0x9a, 0xda, 0xf3, 0xfa, 0x39, 0x85, 0x25, 0x5d, 0xb5, 0x4f,
-	0x6c, 0x50, 0xdf, 0x78, 0x7f, 0xf9, 0xd1, 0xc7, 0x8a, 0xdb, 0xa9, 0xf8, 0xad, 0xdf, 0xf9, 0x15,
-	0x00, 0x00, 0xff, 0xff, 0x28, 0xba, 0x51, 0xb8, 0xce, 0x04, 0x00, 0x00,
+	// 802 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x3b, 0x4f, 0x1b, 0x4b,
+	0x14, 0xf6, 0xf2, 0xba, 0x78, 0xe0, 0x0a, 0x58, 0xa1, 0x8b, 0x59, 0xb8, 0x6b, 0xb0, 0xd0, 0xbd,
+	0xbe, 0xd6, 0xf5, 0xae, 0xec, 0x28, 0x29, 0xac, 0x84, 0x08, 0x43, 0x22, 0xa5, 0x70, 0x84, 0x96,
+	0x3c, 0xa4, 0x34, 0xd6, 0xd8, 0x33, 0x5a, 0x8f, 0xf0, 0xee, 0x38, 0x3b, 0x63, 0x83, 0x53, 0x45,
+	0xa9, 0x92, 0x54, 0x69, 0x22, 0x45, 0xa9, 0xe8, 0x82, 0x92, 0xc6, 0x05, 0x6d, 0x9a, 0x54, 0x94,
+	0x88, 0x2a, 0x55, 0x12, 0x99, 0xc2, 0xf9, 0x0d, 0xa9, 0xa2, 0x7d, 0x78, 0xb1, 0x17, 0xb0, 0x9d,
+	0x47, 0x03, 0x9e, 0x73, 0xbe, 0xf3, 0xf8, 0xbe, 0x73, 0x66, 0x16, 0x44, 0x89, 0x49, 0x38, 0x81,
+	0x2a, 0x22, 0x8c, 0x5b, 0xa4, 0x50, 0xe5, 0x84, 0x9a, 0x6a, 0x2d, 0xa5, 0xf2, 0x5d, 0xa5, 0x62,
+	0x51, 0x4e, 0xc5, 0xbf, 0x5c, 0x80, 0xd2, 0x09, 0x50, 0x6a, 0x29, 0x69, 0x06, 0x1a, 0xc4, 0xa4,
+	0xaa, 0xf3, 0xd7, 0x85, 0x4a, 0x72, 0x91, 0x32, 0x83, 0x32, 0xb5, 0x00, 0x19, 0x56, 0x6b, 0xa9,
+	0x02, 0xe6, 0x30, 0xa5, 0x16, 0x29, 0x31, 0x3d, 0xff, 0x9c, 0xe7, 0x37, 0x98, 0x6e, 0x97, 0x30,
+	0x98, 0xee, 0x39, 0xe6, 0x5d, 0x47, 0xde, 0x39, 0xa9, 0xee, 0xc1, 0x73, 0xcd, 0xea, 0x54, 0xa7,
+	0xae, 0xdd, 0xfe, 0xe5, 0x59, 0xff, 0xbb, 0xa0, 0xeb, 0xae, 0x26, 0x1d, 0x68, 0xec, 0xbd, 0x00,
+	0xa6, 0x72, 0x4c, 0xbf, 0x5b, 0x41, 0x90, 0xe3, 0x4d, 0x68, 0x41, 0x83, 0x89, 0x57, 0x40, 0x18,
+	0x56, 0x79, 0x89, 0x5a, 0x84, 0xd7, 0x23, 0xc2, 0x92, 0x10, 0x0f, 0x67, 0x23, 0xc7, 0x07, 0xc9,
+	0x59, 0xaf, 0xf2, 0x1a, 0x42, 0x16, 0x66, 0x6c, 0x8b, 0x5b, 0xc4, 0xd4, 0xb5, 0x53, 0xa8, 0xb8,
+	0x06, 0xc6, 0x2a, 0x4e, 0x86, 0xc8, 0xd0, 0x92, 0x10, 0x9f, 0x48, 0xcb, 0xca, 0xf9, 0xe2, 0x28,
+	0x6e, 0x9d, 0x6c, 0xf8, 0xf0, 0x53, 0x34, 0xb4, 0xdf, 0x6a, 0x24, 0x04, 0xcd, 0x0b, 0xcc, 0xa8,
+	0x4f, 0x5a, 0x8d, 0xc4, 0x69, 0xca, 0xe7, 0xad, 0x46, 0x62, 0xb1, 0x8b, 0x45, 0xa0, 0xd7, 0xd8,
+	0x3c, 0x98, 0x0b, 0x98, 0x34, 0xcc, 0x2a, 0xd4, 0x64, 0x38, 0xf6, 0x6d, 0x08, 0xc8, 0x39, 0xa6,
+	0x6f, 0xe0, 0x0a, 0x65, 0x84, 0xdf, 0x83, 0x65, 0x82, 0x20, 0xa7, 0x96, 0x86, 0x77, 0xa0, 0x85,
+	0xd8, 0x26, 0xa5, 0x65, 0x9b, 0x29, 0x72, 0xdd, 0xd4, 0xea, 0xcf, 0xd4, 0x87, 0x8a, 0xb7, 0xc1,
+	0x4c, 0xad, 0x9d, 0x2f, 0x0f, 0x5d, 0x94, 0x43, 0x3a, 0x9c, 0x5d, 0x3e, 0x3e, 0x48, 0xfe, 0xed,
+	0xc5, 0xfb, 0x35, 0xbb, 0x13, 0x4d, 0xd7, 0x02, 0x76, 0x71, 0x16, 0x8c, 0x22, 0x6c, 0x52, 0x23,
+	0x32, 0x6c, 0xe7, 0xd0, 0xdc, 0x83, 0x58, 0x07, 0x63, 0xd0, 0xa0, 0x55, 0x93, 0x47, 0x46, 0x96,
+	0x86, 0xe3, 0x13, 0xe9, 0x79, 0xc5, 0xcb, 0x6b, 0x6f, 0x90, 0xe2, 0x6d, 0x90, 0xb2, 0x4e, 0x89,
+	0x99, 0xbd, 0x69, 0x4b, 0xf9, 0xf6, 0x73, 0x34, 0xae, 0x13, 0x5e, 0xaa, 0x16, 0x94, 0x22, 0x35,
+	0xbc, 0x45, 0xf1, 0xfe, 0x25, 0x19, 0xda, 0x56, 0x79, 0xbd, 0x82, 0x99, 0x13, 0xc0, 0x5e, 0xb7,
+	0x1a, 0x89, 0xc9, 0x32, 0xd6, 0x61, 0xb1, 0x9e, 0xb7, 0x77, 0x90, 0x79, 0x73, 0x70, 0x0b, 0x66,
+	0xae, 0x3f, 0xdd, 0x8b, 0x86, 0xbe, 0xee, 0x45, 0x43, 0xce, 0x3c, 0x7c, 0xe2, 0xf6, 0x3c, 0x56,
+	0x9c, 0x79, 0xa8, 0xbd, 0x95, 0x8d, 0xc5, 0xc1, 0x3f, 0xbd, 0x11, 0xfe, 0x98, 0x9e, 0x0d, 0x01,
+	0x31, 0xc7, 0xf4, 0x2d, 0xcc, 0xd7, 0xaa, 0x9c, 0xae, 0x53, 0xa3, 0x42, 0xab, 0x26, 0x12, 0x6f,
+	0x80, 0x19, 0x84, 0xed, 0x06, 0x3b, 0x25, 0xee, 0x37, 0xa2, 0x69, 0x3f, 0xa4, 0xad, 0xec, 0xef,
+	0x9e, 0x54, 0x04, 0xfc, 0x81, 0x4d, 0x58, 0x28, 0x63, 0xe4, 0xcc, 0x6a, 0x5c, 0x6b, 0x1f, 0x33,
+	0xd9, 0x4e, 0xc9, 0xce, 0xf6, 0x6e, 0x4b, 0x17, 0x0d, 0xae, 0x72, 0x80, 0x74, 0x6c, 0x11, 0x48,
+	0x67, 0xad, 0xbe, 0x52, 0x1f, 0x04, 0xb0, 0x92, 0x63, 0xfa, 0x7d, 0xc2, 0x4b, 0xc8, 0x82, 0x3b,
+	0x77, 0xe8, 0x36, 0x36, 0xc9, 0x23, 0xbc, 0x55, 0x82, 0x16, 0xd6, 0x70, 0x91, 0x5a, 0xc8, 0xd5,
+	0x57, 0xbc, 0x06, 0xfe, 0xa4, 0x3b, 0x26, 0x1e, 0x5c, 0xb7, 0x49, 0x07, 0xde, 0xe6, 0xb8, 0x00,
+	0xc2, 0x96, 0x93, 0x2e, 0x4f, 0x90, 0xa3, 0xd5, 0x88, 0x36, 0xee, 0x1a, 0x6e, 0xa1, 0xcc, 0x6a,
+	0x27, 0xcd, 0xee, 0x32, 0xa7, 0x14, 0xd5, 0x73, 0xda, 0x74, 0x7b, 0x8b, 0xbd, 0x12, 0xc0, 0xff,
+	0x83, 0x90, 0x68, 0xb3, 0x16, 0x4b, 0xfe, 0x2d, 0x10, 0xfa, 0xdd, 0x82, 0xcb, 0x3f, 0x7a, 0x0b,
+	0xba, 0x96, 0x3e, 0xfd, 0x6e, 0x04, 0x0c, 0xe7, 0x98, 0x2e, 0x96, 0xc0, 0x64, 0xd7, 0x7b, 0xf8,
+	0xef, 0x45, 0xef, 0x58, 0xe0, 0xe5, 0x91, 0xd4, 0x01, 0x81, 0x3e, 0xb7, 0x97, 0x02, 0x58, 0xe8,
+	0xf9, 0x3e, 0xf5, 0x48, 0xd8, 0x23, 0x4e, 0x5a, 0xfd, 0xb9, 0x38, 0xbf, 0xaf, 0x87, 0x60, 0x2a,
+	0x78, 0x1f, 0x13, 0x3d, 0x52, 0x06, 0xb0, 0x52, 0x7a, 0x70, 0xac, 0x5f, 0xf2, 0x8d, 0x00, 0x96,
+	0xfb, 0x6f, 0xf6, 0xd5, 0x1e, 0x99, 0xfb, 0x46, 0x4b, 0x1b, 0xbf, 0x12, 0xdd, 0xee, 0x54, 0x1a,
+	0x7d, 0x6c, 0x6f, 0x4d, 0x76, 0x73, 0xbf, 0x29, 0x0b, 0x87, 0x4d, 0x59, 0x38, 0x6a, 0xca, 0xc2,
+	0x97, 0xa6, 0x2c, 0xbc, 0x38, 0x91, 0x43, 0x47, 0x27, 0x72, 0xe8, 0xe3, 0x89, 0x1c, 0x7a, 0x90,
+	0xee, 0x58, 0x41, 0xb7, 0x68, 0xb2, 0x0c, 0x0b, 0xcc, 0xfb, 0xad, 0xee, 0x76, 0x7f, 0x9b, 0x9d,
+	0x95, 0x2c, 0x8c, 0x39, 0x9f, 0xe4, 0x4b, 0xdf, 0x07, 0x00, 0xa4, 0x30, 0xd1, 0x8c, 0x75, 0x08,
+	0x00, 0x00,
 }
 
 func (this *MsgUpdateParams) Equal(that interface{}) bool {
@@ -333,6 +522,56 @@ func (this *MsgDepositValidatorRewardsPoolResponse) Equal(that interface{}) bool
 	}
 	return true
 }
+func (this *MsgSetAutoCompoundResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgSetAutoCompoundResponse)
+	if !ok {
+		that2, ok := that.(MsgSetAutoCompoundResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *MsgWithdrawTokenizeShareRecordRewardResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgWithdrawTokenizeShareRecordRewardResponse)
+	if !ok {
+		that2, ok := that.(MsgWithdrawTokenizeShareRecordRewardResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -352,6 +591,12 @@ type MsgClient interface {
 	// DepositValidatorRewardsPool defines a method to provide additional rewards
 	// to delegators to a specific validator.
 	DepositValidatorRewardsPool(ctx context.Context, in *MsgDepositValidatorRewardsPool, opts ...grpc.CallOption) (*MsgDepositValidatorRewardsPoolResponse, error)
+	// SetAutoCompound defines a method to enable or disable auto-compounding
+	// of the rewards of a delegation.
+	SetAutoCompound(ctx context.Context, in *MsgSetAutoCompound, opts ...grpc.CallOption) (*MsgSetAutoCompoundResponse, error)
+	// WithdrawTokenizeShareRecordReward defines a method to withdraw the
+	// rewards of a tokenized delegation to the owner of the tokenize share record.
+	WithdrawTokenizeShareRecordReward(ctx context.Context, in *MsgWithdrawTokenizeShareRecordReward, opts ...grpc.CallOption) (*MsgWithdrawTokenizeShareRecordRewardResponse, error)
 }
 
 type msgClient struct {
@@ -380,6 +625,24 @@ func (c *msgClient) DepositValidatorRewardsPool(ctx context.Context, in *MsgDepo
 	return out, nil
 }
 
+func (c *msgClient) SetAutoCompound(ctx context.Context, in *MsgSetAutoCompound, opts ...grpc.CallOption) (*MsgSetAutoCompoundResponse, error) {
+	out := new(MsgSetAutoCompoundResponse)
+	err := c.cc.Invoke(ctx, "/initia.distribution.v1.Msg/SetAutoCompound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawTokenizeShareRecordReward(ctx context.Context, in *MsgWithdrawTokenizeShareRecordReward, opts ...grpc.CallOption) (*MsgWithdrawTokenizeShareRecordRewardResponse, error) {
+	out := new(MsgWithdrawTokenizeShareRecordRewardResponse)
+	err := c.cc.Invoke(ctx, "/initia.distribution.v1.Msg/WithdrawTokenizeShareRecordReward", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a governance operation for updating the x/distribution
@@ -388,6 +651,12 @@ type MsgServer interface {
 	// DepositValidatorRewardsPool defines a method to provide additional rewards
 	// to delegators to a specific validator.
 	DepositValidatorRewardsPool(context.Context, *MsgDepositValidatorRewardsPool) (*MsgDepositValidatorRewardsPoolResponse, error)
+	// SetAutoCompound defines a method to enable or disable auto-compounding
+	// of the rewards of a delegation.
+	SetAutoCompound(context.Context, *MsgSetAutoCompound) (*MsgSetAutoCompoundResponse, error)
+	// WithdrawTokenizeShareRecordReward defines a method to withdraw the
+	// rewards of a tokenized delegation to the owner of the tokenize share record.
+	WithdrawTokenizeShareRecordReward(context.Context, *MsgWithdrawTokenizeShareRecordReward) (*MsgWithdrawTokenizeShareRecordRewardResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -400,6 +669,12 @@ func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateP
 func (*UnimplementedMsgServer) DepositValidatorRewardsPool(ctx context.Context, req *MsgDepositValidatorRewardsPool) (*MsgDepositValidatorRewardsPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DepositValidatorRewardsPool not implemented")
 }
+func (*UnimplementedMsgServer) SetAutoCompound(ctx context.Context, req *MsgSetAutoCompound) (*MsgSetAutoCompoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoCompound not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawTokenizeShareRecordReward(ctx context.Context, req *MsgWithdrawTokenizeShareRecordReward) (*MsgWithdrawTokenizeShareRecordRewardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawTokenizeShareRecordReward not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -441,6 +716,42 @@ func _Msg_DepositValidatorRewardsPool_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetAutoCompound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAutoCompound)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAutoCompound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.distribution.v1.Msg/SetAutoCompound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAutoCompound(ctx, req.(*MsgSetAutoCompound))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawTokenizeShareRecordReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawTokenizeShareRecordReward)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawTokenizeShareRecordReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.distribution.v1.Msg/WithdrawTokenizeShareRecordReward",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawTokenizeShareRecordReward(ctx, req.(*MsgWithdrawTokenizeShareRecordReward))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "initia.distribution.v1.Msg",
@@ -454,6 +765,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DepositValidatorRewardsPool",
 			Handler:    _Msg_DepositValidatorRewardsPool_Handler,
 		},
+		{
+			MethodName: "SetAutoCompound",
+			Handler:    _Msg_SetAutoCompound_Handler,
+		},
+		{
+			MethodName: "WithdrawTokenizeShareRecordReward",
+			Handler:    _Msg_WithdrawTokenizeShareRecordReward_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "initia/distribution/v1/tx.proto",
@@ -603,66 +922,208 @@ func (m *MsgDepositValidatorRewardsPoolResponse) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgSetAutoCompound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgSetAutoCompound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDepositValidatorRewardsPool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSetAutoCompound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Depositor)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAutoCompoundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoCompoundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoCompoundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawTokenizeShareRecordReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawTokenizeShareRecordReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawTokenizeShareRecordReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RecordId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RecordId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDepositValidatorRewardsPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
 }
 
 func (m *MsgDepositValidatorRewardsPoolResponse) Size() (n int) {
@@ -674,6 +1135,66 @@ func (m *MsgDepositValidatorRewardsPoolResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetAutoCompound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetAutoCompoundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawTokenizeShareRecordReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RecordId != 0 {
+		n += 1 + sovTx(uint64(m.RecordId))
+	}
+	return n
+}
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1075,6 +1596,375 @@ func (m *MsgDepositValidatorRewardsPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetAutoCompound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAutoCompound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAutoCompound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAutoCompoundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAutoCompoundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAutoCompoundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+			}
+			m.RecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordRewardResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
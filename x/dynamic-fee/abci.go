@@ -15,6 +15,13 @@ import (
 func EndBlocker(ctx context.Context, k keeper.Keeper) error {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
 
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// record the fee state of the block before the base fee moves
+	if err := k.TrackFeeHistory(sdkCtx); err != nil {
+		return err
+	}
+
 	// update base fee
-	return k.UpdateBaseGasPrice(sdk.UnwrapSDKContext(ctx))
+	return k.UpdateBaseGasPrice(sdkCtx)
 }
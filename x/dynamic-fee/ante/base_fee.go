@@ -0,0 +1,51 @@
+package ante
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// BaseFeeSettler moves the base fee portion of a deducted fee out of the fee collector.
+type BaseFeeSettler interface {
+	SettleBaseFee(ctx context.Context, fee sdk.Coins, gas uint64) (sdk.Coins, error)
+}
+
+// BaseFeeDecorator ante decorator to burn or redirect the base fee portion of
+// the deducted fee, leaving only the priority tip in the fee collector.
+// CONTRACT: must run after the fee has been deducted to the fee collector.
+type BaseFeeDecorator struct {
+	settler BaseFeeSettler
+}
+
+// NewBaseFeeDecorator constructor of the BaseFeeDecorator
+func NewBaseFeeDecorator(settler BaseFeeSettler) *BaseFeeDecorator {
+	return &BaseFeeDecorator{
+		settler: settler,
+	}
+}
+
+// AnteHandle that settles the base fee portion of the tx fee
+func (d BaseFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	// NOTE: use infinite gas meter to avoid gas charge for chain operation
+	if fee := feeTx.GetFee(); !simulate && !ctx.IsCheckTx() && !fee.IsZero() {
+		if _, err := d.settler.SettleBaseFee(ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()), fee, feeTx.GetGas()); err != nil {
+			return ctx, err
+		}
+	}
+
+	if next != nil {
+		return next(ctx, tx, simulate)
+	}
+
+	return ctx, nil
+}
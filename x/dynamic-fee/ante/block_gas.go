@@ -15,7 +15,9 @@ type BlockGasMeter interface {
 	AccumulateGas(ctx context.Context, gas uint64) error
 }
 
-// BlockGasDecorator ante decorator to accumulate gas used in the block
+// BlockGasDecorator ante decorator to accumulate gas limits in the block.
+// The accumulated gas only drives the base gas price when the
+// AccumulateGasUsed param is off, otherwise the block gas meter is used.
 type BlockGasDecorator struct {
 	blockGasMeter BlockGasMeter
 }
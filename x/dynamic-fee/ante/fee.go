@@ -91,8 +91,10 @@ func (fc MempoolFeeChecker) CheckTxFeeWithMinGasPrices(ctx sdk.Context, tx sdk.T
 
 			gasPriceFromTotalFee := math.LegacyNewDecFromInt(totalFeeBaseAmount).Quo(math.LegacyNewDec(int64(gas))) //nolint: gosec
 
-			// priority is max(gasPriceFromTotalFee * 1e6, 1), capped to int64 bounds.
-			priority = priorityFromGasPrice(gasPriceFromTotalFee)
+			// only the priority tip above the base gas price feeds the priority,
+			// since the base fee portion is burned or sent to the base fee recipient.
+			// priority is max(tipGasPrice * 1e6, 1), capped to int64 bounds.
+			priority = priorityFromGasPrice(gasPriceFromTotalFee.Sub(baseGasPrice))
 
 			if gasPriceFromTotalFee.LT(baseGasPrice) {
 				return nil, 0, errors.Wrapf(
@@ -0,0 +1,51 @@
+package ante
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// FeeHistoryRecorder records the fee paid by the txs included in a block.
+type FeeHistoryRecorder interface {
+	RecordFeeSample(ctx context.Context, fee sdk.Coins, gas uint64) error
+}
+
+// FeeHistoryDecorator ante decorator to record the tip gas price of every
+// included tx for the fee history.
+type FeeHistoryDecorator struct {
+	recorder FeeHistoryRecorder
+}
+
+// NewFeeHistoryDecorator constructor of the FeeHistoryDecorator
+func NewFeeHistoryDecorator(recorder FeeHistoryRecorder) *FeeHistoryDecorator {
+	return &FeeHistoryDecorator{
+		recorder: recorder,
+	}
+}
+
+// AnteHandle that records the tip gas price of the tx. Recording is best
+// effort, so a failure is logged and never rejects the tx.
+func (d FeeHistoryDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	// NOTE: use infinite gas meter to avoid gas charge for chain operation
+	if !simulate && !ctx.IsCheckTx() {
+		if err := d.recorder.RecordFeeSample(ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()), feeTx.GetFee(), feeTx.GetGas()); err != nil {
+			ctx.Logger().Error("failed to record fee sample", "error", err)
+		}
+	}
+
+	if next != nil {
+		return next(ctx, tx, simulate)
+	}
+
+	return ctx, nil
+}
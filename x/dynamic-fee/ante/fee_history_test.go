@@ -0,0 +1,76 @@
+package ante_test
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/math"
+
+	"github.com/initia-labs/initia/x/dynamic-fee/ante"
+	"github.com/initia-labs/initia/x/dynamic-fee/types"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type TestFeeHistoryRecorder struct {
+	calls int
+}
+
+func (r *TestFeeHistoryRecorder) RecordFeeSample(ctx context.Context, fee sdk.Coins, gas uint64) error {
+	r.calls++
+	return errors.New("price not available")
+}
+
+func (suite *AnteTestSuite) Test_FeeHistoryDecorator_UnpricedFee() {
+	suite.SetupTest() // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+	suite.Require().NoError(suite.app.DynamicFeeKeeper.SetParams(suite.ctx, types.DefaultParams()))
+
+	// keys and addresses
+	priv1, _, _ := testdata.KeyTestPubAddr()
+
+	feeAmount := sdk.NewCoins(sdk.NewCoin("unpriced", math.NewInt(100)))
+	suite.txBuilder.SetFeeAmount(feeAmount)
+	suite.txBuilder.SetGasLimit(200_000)
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	nextCalled := false
+	next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		nextCalled = true
+		return ctx, nil
+	}
+
+	// a fee denom without a price does not fail the tx in deliver tx mode
+	decorator := ante.NewFeeHistoryDecorator(suite.app.DynamicFeeKeeper)
+	_, err = decorator.AnteHandle(suite.ctx.WithIsCheckTx(false), tx, false, next)
+	suite.Require().NoError(err)
+	suite.Require().True(nextCalled)
+
+	// and is not sampled
+	hasSample := false
+	suite.Require().NoError(suite.app.DynamicFeeKeeper.FeeSamples.Walk(suite.ctx, nil, func(_ uint64, _ types.FeeSample) (bool, error) {
+		hasSample = true
+		return true, nil
+	}))
+	suite.Require().False(hasSample)
+
+	// recorder errors are not returned either
+	recorder := &TestFeeHistoryRecorder{}
+	nextCalled = false
+	_, err = ante.NewFeeHistoryDecorator(recorder).AnteHandle(suite.ctx.WithIsCheckTx(false), tx, false, next)
+	suite.Require().NoError(err)
+	suite.Require().True(nextCalled)
+	suite.Require().Equal(1, recorder.calls)
+
+	// nothing is recorded in check tx and simulation mode
+	_, err = ante.NewFeeHistoryDecorator(recorder).AnteHandle(suite.ctx.WithIsCheckTx(true), tx, false, nil)
+	suite.Require().NoError(err)
+	_, err = ante.NewFeeHistoryDecorator(recorder).AnteHandle(suite.ctx.WithIsCheckTx(false), tx, true, nil)
+	suite.Require().NoError(err)
+	suite.Require().Equal(1, recorder.calls)
+}
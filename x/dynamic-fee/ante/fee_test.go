@@ -55,6 +55,14 @@ func (k TestAnteKeeper) AccumulateGas(ctx context.Context, gas uint64) error {
 	return nil
 }
 
+func (k TestAnteKeeper) SettleBaseFee(ctx context.Context, fee sdk.Coins, gas uint64) (sdk.Coins, error) {
+	return sdk.NewCoins(), nil
+}
+
+func (k TestAnteKeeper) RecordFeeSample(ctx context.Context, fee sdk.Coins, gas uint64) error {
+	return nil
+}
+
 func (suite *AnteTestSuite) TestEnsureMempoolFees() {
 	suite.SetupTest() // setup
 	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
@@ -188,3 +196,41 @@ func (suite *AnteTestSuite) TestEnsureMempoolFees_PriorityOverflowCapped() {
 	suite.Require().NoError(err)
 	suite.Require().Equal(int64(stdmath.MaxInt64), priority)
 }
+
+func (suite *AnteTestSuite) TestEnsureMempoolFees_PriorityFromTipOnly() {
+	suite.SetupTest()
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	msg := testdata.NewTestMsg(addr1)
+
+	// gas price 0.005
+	suite.Require().NoError(suite.txBuilder.SetMsgs(msg))
+	suite.txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin(baseDenom, math.NewInt(1000))))
+	suite.txBuilder.SetGasLimit(200_000)
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	suite.ctx = suite.ctx.WithIsCheckTx(true)
+	suite.ctx = suite.ctx.WithMinGasPrices(nil)
+
+	// base gas price 0.004 leaves a 0.001 tip
+	fc := ante.NewMempoolFeeChecker(TestAnteKeeper{
+		baseDenom:    baseDenom,
+		baseGasPrice: math.LegacyNewDecWithPrec(4, 3),
+	})
+	_, priority, err := fc.CheckTxFeeWithMinGasPrices(suite.ctx, tx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(1000), priority) // 0.001 * 1e6
+
+	// paying exactly the base gas price leaves no tip
+	fc = ante.NewMempoolFeeChecker(TestAnteKeeper{
+		baseDenom:    baseDenom,
+		baseGasPrice: math.LegacyNewDecWithPrec(5, 3),
+	})
+	_, priority, err = fc.CheckTxFeeWithMinGasPrices(suite.ctx, tx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(1), priority)
+}
@@ -1,8 +1,12 @@
 package dynamicfee
 
 import (
+	"fmt"
+
 	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
 
+	"github.com/cosmos/cosmos-sdk/version"
+
 	dynamicfeev1 "github.com/initia-labs/initia/api/initia/dynamicfee/v1"
 )
 
@@ -16,6 +20,15 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "params",
 					Short:     "Query the current dynamic fee parameters",
 				},
+				{
+					RpcMethod: "FeeHistory",
+					Use:       "fee-history [block-count]",
+					Short:     "Query the base gas price history and tip gas price percentiles of recent blocks",
+					Example:   fmt.Sprintf("%s query dynamicfee fee-history 20 --reward-percentiles 25,50,75", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "block_count"},
+					},
+				},
 			},
 		},
 	}
@@ -22,6 +22,8 @@ func (k AnteKeeper) BaseDenom(ctx context.Context) (string, error) {
 	return k.baseDenomKeeper.BaseDenom(ctx)
 }
 
+// GetBaseSpotPrice returns the base price the fee checker values the denom
+// at, which is the time-weighted average price when UseTwapPrice is set.
 func (k AnteKeeper) GetBaseSpotPrice(ctx context.Context, denom string) (math.LegacyDec, error) {
 	baseDenom, err := k.BaseDenom(ctx)
 	if err != nil {
@@ -29,5 +31,11 @@ func (k AnteKeeper) GetBaseSpotPrice(ctx context.Context, denom string) (math.Le
 	} else if baseDenom == denom {
 		return math.LegacyOneDec(), nil
 	}
-	return k.tokenPriceKeeper.GetBaseSpotPrice(ctx, denom)
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	return k.baseTokenPrice(ctx, denom, params)
 }
@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/initia-labs/initia/x/dynamic-fee/types"
+)
+
+// FeeBaseAmount returns the value of the fee coins in the base denom.
+func (k Keeper) FeeBaseAmount(ctx context.Context, fee sdk.Coins) (math.LegacyDec, error) {
+	baseDenom, err := k.baseDenomKeeper.BaseDenom(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	total := math.LegacyZeroDec()
+	for _, coin := range fee {
+		price := math.LegacyOneDec()
+		if coin.Denom != baseDenom {
+			price, err = k.baseTokenPrice(ctx, coin.Denom, params)
+			if err != nil {
+				return math.LegacyDec{}, err
+			}
+		}
+
+		total = total.Add(price.MulInt(coin.Amount))
+	}
+
+	return total, nil
+}
+
+// BaseFeePortion returns the part of fee that pays the base fee, the base gas
+// price times gas valued in the base denom. It is taken pro rata from every
+// fee coin and never exceeds fee.
+func (k Keeper) BaseFeePortion(ctx context.Context, fee sdk.Coins, gas uint64) (sdk.Coins, error) {
+	if fee.IsZero() || gas == 0 {
+		return sdk.NewCoins(), nil
+	}
+
+	baseGasPrice, err := k.BaseGasPrice(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := k.FeeBaseAmount(ctx, fee)
+	if err != nil {
+		return nil, err
+	}
+	if !total.IsPositive() || !baseGasPrice.IsPositive() {
+		return sdk.NewCoins(), nil
+	}
+
+	ratio := baseGasPrice.MulInt64(int64(gas)).Quo(total) //nolint: gosec
+	if ratio.GTE(math.LegacyOneDec()) {
+		return fee, nil
+	}
+
+	portion := sdk.NewCoins()
+	for _, coin := range fee {
+		portion = portion.Add(sdk.NewCoin(coin.Denom, ratio.MulInt(coin.Amount).TruncateInt()))
+	}
+
+	return portion, nil
+}
+
+// SettleBaseFee moves the base fee portion of a deducted tx fee out of the fee
+// collector, so only the priority tip is left for proposer rewards. The base
+// fee is sent to the base fee recipient, or burned when none is configured.
+// Nothing is moved unless the SettleBaseFee param is enabled.
+func (k Keeper) SettleBaseFee(ctx context.Context, fee sdk.Coins, gas uint64) (sdk.Coins, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	} else if !params.SettleBaseFee {
+		return sdk.NewCoins(), nil
+	}
+
+	portion, err := k.BaseFeePortion(ctx, fee, gas)
+	if err != nil || portion.IsZero() {
+		return portion, err
+	}
+
+	if params.BaseFeeRecipient != "" {
+		recipient, err := k.ac.StringToBytes(params.BaseFeeRecipient)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, recipient, portion); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, types.ModuleName, portion); err != nil {
+			return nil, err
+		}
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, portion); err != nil {
+			return nil, err
+		}
+	}
+
+	return portion, nil
+}
@@ -0,0 +1,70 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func Test_SettleBaseFee_Disabled(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	fee := sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(1_000)))
+	input.Faucet.Fund(ctx, feeCollector, fee...)
+
+	// settlement is off by default, so the whole fee stays for proposer rewards
+	settled, err := input.DynamicFeeKeeper.SettleBaseFee(ctx, fee, 50_000)
+	require.NoError(t, err)
+	require.True(t, settled.IsZero())
+	require.Equal(t, math.NewInt(1_000), input.BankKeeper.GetBalance(ctx, feeCollector, bondDenom).Amount)
+}
+
+func Test_SettleBaseFee_Burn(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	params, err := input.DynamicFeeKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.SettleBaseFee = true
+	require.NoError(t, input.DynamicFeeKeeper.SetParams(ctx, params))
+
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	fee := sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(1_000)))
+	input.Faucet.Fund(ctx, feeCollector, fee...)
+	supplyBefore := input.BankKeeper.GetSupply(ctx, bondDenom)
+
+	// base gas price 0.01 * 50_000 gas = 500 base fee
+	settled, err := input.DynamicFeeKeeper.SettleBaseFee(ctx, fee, 50_000)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(500))), settled)
+
+	// only the tip is left for proposer rewards
+	require.Equal(t, math.NewInt(500), input.BankKeeper.GetBalance(ctx, feeCollector, bondDenom).Amount)
+	require.Equal(t, supplyBefore.Amount.SubRaw(500), input.BankKeeper.GetSupply(ctx, bondDenom).Amount)
+}
+
+func Test_SettleBaseFee_Recipient(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	_, _, recipient := keyPubAddr()
+	params, err := input.DynamicFeeKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.BaseFeeRecipient = recipient.String()
+	params.SettleBaseFee = true
+	require.NoError(t, input.DynamicFeeKeeper.SetParams(ctx, params))
+
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	fee := sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(1_000)))
+	input.Faucet.Fund(ctx, feeCollector, fee...)
+
+	// the base fee is capped by the paid fee
+	settled, err := input.DynamicFeeKeeper.SettleBaseFee(ctx, fee, 1_000_000)
+	require.NoError(t, err)
+	require.Equal(t, fee, settled)
+	require.Equal(t, math.NewInt(1_000), input.BankKeeper.GetBalance(ctx, recipient, bondDenom).Amount)
+	require.True(t, input.BankKeeper.GetBalance(ctx, feeCollector, bondDenom).IsZero())
+}
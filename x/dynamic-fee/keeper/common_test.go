@@ -316,6 +316,7 @@ func _createTestInput(
 		stakingtypes.NotBondedPoolName:  {authtypes.Burner, authtypes.Staking},
 		govtypes.ModuleName:             {authtypes.Burner},
 		movetypes.MoveStakingModuleName: nil,
+		dynamicfeetypes.ModuleName:      {authtypes.Burner},
 
 		// for testing
 		authtypes.Minter: {authtypes.Minter, authtypes.Burner},
@@ -437,6 +438,8 @@ func _createTestInput(
 		movekeeper.NewDexKeeper(moveKeeper),
 		moveKeeper,
 		moveKeeper,
+		bankKeeper,
+		&oracleKeeper,
 		ac,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/initia/x/dynamic-fee/types"
+)
+
+// RecordFeeSample records the tip gas price paid by a tx included in the
+// current block, the part of its gas price above the base gas price. A fee
+// that cannot be priced, e.g. because a fee denom has no price or a stale
+// one, is not sampled rather than failing the tx.
+func (k Keeper) RecordFeeSample(ctx context.Context, fee sdk.Coins, gas uint64) error {
+	if gas == 0 {
+		return nil
+	}
+
+	baseGasPrice, err := k.BaseGasPrice(ctx)
+	if err != nil {
+		return err
+	}
+
+	total, err := k.FeeBaseAmount(ctx, fee)
+	if err != nil {
+		// the fee history is only a statistic, so skip the sample
+		return nil
+	}
+
+	tipGasPrice := total.QuoInt64(int64(gas)).Sub(baseGasPrice) //nolint: gosec
+	if tipGasPrice.IsNegative() {
+		tipGasPrice = math.LegacyZeroDec()
+	}
+
+	seq, err := k.FeeSampleSeq.Next(ctx)
+	if err != nil {
+		return err
+	}
+
+	return k.FeeSamples.Set(ctx, seq, types.FeeSample{
+		TipGasPrice: tipGasPrice,
+		Gas:         gas,
+	})
+}
+
+// TrackFeeHistory appends the fee state of the current block to the fee
+// history and prunes the records older than FeeHistoryLength blocks.
+// It must be called in EndBlocker before the base gas price is updated.
+func (k Keeper) TrackFeeHistory(ctx sdk.Context) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	blockGas, err := k.blockGas(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	var samples []types.FeeSample
+	if err := k.FeeSamples.Walk(ctx, nil, func(_ uint64, sample types.FeeSample) (bool, error) {
+		samples = append(samples, sample)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	height := ctx.BlockHeight()
+	if err := k.FeeHistory.Set(ctx, height, types.FeeHistoryRecord{
+		Height:         height,
+		BaseGasPrice:   params.BaseGasPrice,
+		AccumulatedGas: blockGas,
+		TargetGas:      params.TargetGas,
+		TipPercentiles: types.TipPercentiles(samples),
+	}); err != nil {
+		return err
+	}
+
+	if height <= types.FeeHistoryLength {
+		return nil
+	}
+
+	return k.FeeHistory.Clear(ctx, new(collections.Range[int64]).EndInclusive(height-types.FeeHistoryLength))
+}
+
+// GetFeeHistory returns the fee history of the last blockCount blocks, oldest
+// first, with the tip gas prices at the given percentiles. A zero blockCount
+// returns the whole history.
+func (k Keeper) GetFeeHistory(ctx context.Context, blockCount uint64, percentiles []uint32) (types.FeeHistory, error) {
+	if err := types.ValidateFeePercentiles(percentiles); err != nil {
+		return types.FeeHistory{}, err
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return types.FeeHistory{}, err
+	}
+
+	iter, err := k.FeeHistory.Iterate(ctx, new(collections.Range[int64]).Descending())
+	if err != nil {
+		return types.FeeHistory{}, err
+	}
+	defer iter.Close()
+
+	var entries []types.FeeHistoryEntry
+	for ; iter.Valid() && (blockCount == 0 || uint64(len(entries)) < blockCount); iter.Next() {
+		record, err := iter.Value()
+		if err != nil {
+			return types.FeeHistory{}, err
+		}
+
+		entries = append(entries, record.Entry(percentiles))
+	}
+
+	// oldest first
+	for i, j := 0, len(entries)-1; i < j; i, j = i+1, j-1 {
+		entries[i], entries[j] = entries[j], entries[i]
+	}
+
+	return types.FeeHistory{
+		Entries:          entries,
+		NextBaseGasPrice: params.BaseGasPrice,
+	}, nil
+}
@@ -0,0 +1,125 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/initia/x/dynamic-fee/keeper"
+	"github.com/initia-labs/initia/x/dynamic-fee/types"
+)
+
+func decStrings(decs []math.LegacyDec) []string {
+	strs := make([]string, len(decs))
+	for i, dec := range decs {
+		strs[i] = dec.String()
+	}
+	return strs
+}
+
+func Test_FeeHistory(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	ctx = ctx.WithBlockHeight(1)
+
+	// base gas price 0.01, so the tips are 0.02, 0 and 0.04
+	require.NoError(t, input.DynamicFeeKeeper.RecordFeeSample(ctx, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 3_000)), 100_000))
+	require.NoError(t, input.DynamicFeeKeeper.RecordFeeSample(ctx, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1_000)), 100_000))
+	require.NoError(t, input.DynamicFeeKeeper.RecordFeeSample(ctx, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 10_000)), 200_000))
+	require.NoError(t, input.DynamicFeeKeeper.AccumulateGas(ctx, 400_000))
+	require.NoError(t, input.DynamicFeeKeeper.TrackFeeHistory(ctx))
+
+	querier := keeper.NewQuerier(&input.DynamicFeeKeeper)
+	res, err := querier.FeeHistory(ctx, &types.QueryFeeHistoryRequest{
+		RewardPercentiles: []uint32{0, 25, 50, 52, 100},
+	})
+	require.NoError(t, err)
+
+	params, err := input.DynamicFeeKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	require.Equal(t, params.BaseGasPrice, res.FeeHistory.NextBaseGasPrice)
+	require.Len(t, res.FeeHistory.Entries, 1)
+
+	entry := res.FeeHistory.Entries[0]
+	require.Equal(t, int64(1), entry.Height)
+	require.Equal(t, params.BaseGasPrice, entry.BaseGasPrice)
+	require.Equal(t, uint64(400_000), entry.AccumulatedGas)
+	require.Equal(t, params.TargetGas, entry.TargetGas)
+	require.Equal(t, []string{
+		math.LegacyZeroDec().String(),
+		math.LegacyZeroDec().String(),
+		math.LegacyNewDecWithPrec(2, 2).String(),
+		math.LegacyNewDecWithPrec(28, 3).String(), // interpolated between p50 and p55
+		math.LegacyNewDecWithPrec(4, 2).String(),
+	}, decStrings(entry.Rewards))
+
+	// percentiles must be ascending and at most 100
+	_, err = querier.FeeHistory(ctx, &types.QueryFeeHistoryRequest{RewardPercentiles: []uint32{50, 25}})
+	require.ErrorIs(t, err, types.ErrInvalidFeePercentiles)
+	_, err = querier.FeeHistory(ctx, &types.QueryFeeHistoryRequest{RewardPercentiles: []uint32{101}})
+	require.ErrorIs(t, err, types.ErrInvalidFeePercentiles)
+}
+
+func Test_FeeHistory_Pruning(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	lastHeight := int64(types.FeeHistoryLength + 2)
+	for height := int64(1); height <= lastHeight; height++ {
+		require.NoError(t, input.DynamicFeeKeeper.TrackFeeHistory(ctx.WithBlockHeight(height)))
+	}
+
+	feeHistory, err := input.DynamicFeeKeeper.GetFeeHistory(ctx, 0, []uint32{50})
+	require.NoError(t, err)
+	require.Len(t, feeHistory.Entries, types.FeeHistoryLength)
+	require.Equal(t, int64(3), feeHistory.Entries[0].Height)
+	require.Equal(t, lastHeight, feeHistory.Entries[len(feeHistory.Entries)-1].Height)
+
+	// blocks without txs report zero tips
+	require.Equal(t, []string{math.LegacyZeroDec().String()}, decStrings(feeHistory.Entries[0].Rewards))
+
+	// the most recent blocks, oldest first
+	feeHistory, err = input.DynamicFeeKeeper.GetFeeHistory(ctx, 2, nil)
+	require.NoError(t, err)
+	require.Len(t, feeHistory.Entries, 2)
+	require.Equal(t, lastHeight-1, feeHistory.Entries[0].Height)
+	require.Equal(t, lastHeight, feeHistory.Entries[1].Height)
+}
+
+func Test_FeeHistory_UnpricedFee(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	now := time.Unix(1_000_000, 0)
+	ctx = ctx.WithBlockHeight(1).WithBlockTime(now)
+
+	setOraclePrice(t, ctx, input, "INIT/USD", 2, now)
+	setOraclePrice(t, ctx, input, "USDT/USD", 1, now)
+
+	params, err := input.DynamicFeeKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.FeeDenomOracles = []types.FeeDenomOracle{
+		{Denom: bondDenom, CurrencyPair: "INIT/USD", Decimals: 6},
+		{Denom: "uusdt", CurrencyPair: "USDT/USD", Decimals: 6},
+	}
+	params.OracleMaxPriceAge = time.Minute
+	require.NoError(t, input.DynamicFeeKeeper.SetParams(ctx, params))
+
+	// a denom without a price and a stale oracle price are not sampled
+	ctx = ctx.WithBlockTime(now.Add(2 * time.Minute))
+	require.NoError(t, input.DynamicFeeKeeper.RecordFeeSample(ctx, sdk.NewCoins(sdk.NewInt64Coin("unpriced", 3_000)), 100_000))
+	require.NoError(t, input.DynamicFeeKeeper.RecordFeeSample(ctx, sdk.NewCoins(sdk.NewInt64Coin("uusdt", 3_000)), 100_000))
+	require.NoError(t, input.DynamicFeeKeeper.RecordFeeSample(ctx, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 3_000), sdk.NewInt64Coin("unpriced", 1)), 100_000))
+
+	// base denom fees are still sampled
+	require.NoError(t, input.DynamicFeeKeeper.RecordFeeSample(ctx, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 3_000)), 100_000))
+
+	var samples []types.FeeSample
+	require.NoError(t, input.DynamicFeeKeeper.FeeSamples.Walk(ctx, nil, func(_ uint64, sample types.FeeSample) (bool, error) {
+		samples = append(samples, sample)
+		return false, nil
+	}))
+	require.Len(t, samples, 1)
+	require.Equal(t, math.LegacyNewDecWithPrec(2, 2).String(), samples[0].TipGasPrice.String())
+}
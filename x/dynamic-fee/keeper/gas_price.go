@@ -11,7 +11,7 @@ import (
 
 var _ tx.GasPriceKeeper = Keeper{}
 
-// GasPrices return gas prices for all whitelisted denoms
+// GasPrices return gas prices for all whitelisted and oracle priced denoms
 func (k Keeper) GasPrices(
 	ctx context.Context,
 ) (sdk.DecCoins, error) {
@@ -33,7 +33,7 @@ func (k Keeper) GasPrices(
 
 	gasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec(baseDenom, baseGasPrice))
 	for _, denom := range whitelistedTokens {
-		baseSpotPrice, err := k.tokenPriceKeeper.GetBaseSpotPrice(ctx, denom)
+		baseSpotPrice, err := k.baseTokenPrice(ctx, denom, params)
 		if err != nil {
 			return nil, err
 		}
@@ -45,6 +45,22 @@ func (k Keeper) GasPrices(
 		gasPrices = gasPrices.Add(sdk.NewDecCoinFromDec(denom, gasPrice))
 	}
 
+	// oracle priced denoms can pay fees without a dex pair
+	for _, oracle := range params.FeeDenomOracles {
+		if oracle.Denom == baseDenom || gasPrices.AmountOf(oracle.Denom).IsPositive() {
+			continue
+		}
+
+		oraclePrice, err := k.oracleTokenPrice(ctx, oracle.Denom, params)
+		if err != nil || !oraclePrice.IsPositive() {
+			// skip the denom to keep the query available while its price is stale
+			continue
+		}
+
+		gasPrice := baseGasPrice.Quo(oraclePrice)
+		gasPrices = gasPrices.Add(sdk.NewDecCoinFromDec(oracle.Denom, gasPrice))
+	}
+
 	return gasPrices, nil
 }
 
@@ -70,7 +86,7 @@ func (k Keeper) GasPrice(
 	}
 
 	// if denom is not base denom, get base spot price
-	baseSpotPrice, err := k.tokenPriceKeeper.GetBaseSpotPrice(ctx, denom)
+	baseSpotPrice, err := k.baseTokenPrice(ctx, denom, params)
 	if err != nil {
 		return sdk.DecCoin{}, err
 	} else if baseSpotPrice.IsZero() {
@@ -9,6 +9,18 @@ import (
 // InitGenesis sets supply information for genesis.
 func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) error {
 	params := genState.GetParams()
+	if err := params.ValidateBaseFeeRecipient(k.ac); err != nil {
+		return err
+	}
+	if len(params.FeeDenomOracles) > 0 {
+		baseDenom, err := k.baseDenomKeeper.BaseDenom(ctx)
+		if err != nil {
+			return err
+		}
+		if err := params.ValidateBaseDenomOracle(baseDenom); err != nil {
+			return err
+		}
+	}
 	if err := k.SetParams(ctx, params); err != nil {
 		return err
 	}
@@ -24,11 +24,16 @@ type Keeper struct {
 	TransientSchema collections.Schema
 
 	Params         collections.Item[types.Params]
+	FeeHistory     collections.Map[int64, types.FeeHistoryRecord]
 	AccumulatedGas collections.Item[uint64]
+	FeeSamples     collections.Map[uint64, types.FeeSample]
+	FeeSampleSeq   collections.Sequence
 
 	tokenPriceKeeper types.TokenPriceKeeper
 	whitelistKeeper  types.WhitelistKeeper
 	baseDenomKeeper  types.BaseDenomKeeper
+	bankKeeper       types.BankKeeper
+	oracleKeeper     types.OracleKeeper
 
 	ac        address.Codec
 	authority string
@@ -41,6 +46,8 @@ func NewKeeper(
 	tokenPriceKeeper types.TokenPriceKeeper,
 	whitelistKeeper types.WhitelistKeeper,
 	baseDenomKeeper types.BaseDenomKeeper,
+	bankKeeper types.BankKeeper,
+	oracleKeeper types.OracleKeeper,
 	ac address.Codec,
 	authority string,
 ) *Keeper {
@@ -52,11 +59,16 @@ func NewKeeper(
 		transientService: transientService,
 
 		Params:         collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		FeeHistory:     collections.NewMap(sb, types.FeeHistoryKey, "fee_history", collections.Int64Key, codec.CollValue[types.FeeHistoryRecord](cdc)),
 		AccumulatedGas: collections.NewItem(tsb, types.AccumulatedGasKey, "accumulated_gas", collections.Uint64Value),
+		FeeSamples:     collections.NewMap(tsb, types.FeeSamplesKey, "fee_samples", collections.Uint64Key, codec.CollValue[types.FeeSample](cdc)),
+		FeeSampleSeq:   collections.NewSequence(tsb, types.FeeSampleSeqKey, "fee_sample_seq"),
 
 		tokenPriceKeeper: tokenPriceKeeper,
 		whitelistKeeper:  whitelistKeeper,
 		baseDenomKeeper:  baseDenomKeeper,
+		bankKeeper:       bankKeeper,
+		oracleKeeper:     oracleKeeper,
 		ac:               ac,
 		authority:        authority,
 	}
@@ -108,6 +120,56 @@ func (k Keeper) BaseGasPrice(ctx context.Context) (math.LegacyDec, error) {
 	return params.BaseGasPrice, nil
 }
 
+// baseTokenPrice returns the base price of a gas token used for fee pricing.
+// The dex price, time-weighted when UseTwapPrice is set, is used by default.
+// The oracle price of a denom mapped in FeeDenomOracles is used instead when
+// the denom has no dex pair or when the dex price diverges from the oracle
+// price beyond OracleDivergenceThreshold.
+func (k Keeper) baseTokenPrice(ctx context.Context, denom string, params types.Params) (math.LegacyDec, error) {
+	if _, found := params.FeeDenomOracle(denom); !found {
+		return k.dexTokenPrice(ctx, denom, params.UseTwapPrice)
+	}
+
+	hasDexPair, err := k.tokenPriceKeeper.HasDexPair(ctx, denom)
+	if err != nil {
+		return math.LegacyDec{}, err
+	} else if !hasDexPair {
+		return k.oracleTokenPrice(ctx, denom, params)
+	}
+
+	dexPrice, err := k.dexTokenPrice(ctx, denom, params.UseTwapPrice)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	threshold := params.OracleDivergenceThreshold
+	if threshold.IsNil() || !threshold.IsPositive() {
+		return dexPrice, nil
+	}
+
+	oraclePrice, err := k.oracleTokenPrice(ctx, denom, params)
+	if err != nil {
+		// keep the dex price when the oracle price is not available
+		return dexPrice, nil
+	}
+
+	if dexPrice.Sub(oraclePrice).Abs().GT(oraclePrice.Mul(threshold)) {
+		return oraclePrice, nil
+	}
+
+	return dexPrice, nil
+}
+
+// dexTokenPrice returns the base price of a gas token from its dex pair,
+// its time-weighted average price when useTWAP is set or its spot price otherwise.
+func (k Keeper) dexTokenPrice(ctx context.Context, denom string, useTWAP bool) (math.LegacyDec, error) {
+	if useTWAP {
+		return k.tokenPriceKeeper.GetBaseTWAPPrice(ctx, denom)
+	}
+
+	return k.tokenPriceKeeper.GetBaseSpotPrice(ctx, denom)
+}
+
 // this should be called in EndBlocker
 func (k Keeper) UpdateBaseGasPrice(ctx sdk.Context) error {
 	params, err := k.Params.Get(ctx)
@@ -118,10 +180,8 @@ func (k Keeper) UpdateBaseGasPrice(ctx sdk.Context) error {
 		return types.ErrTargetGasZero
 	}
 
-	accumulatedGas, err := k.GetAccumulatedGas(ctx)
-	if errors.Is(err, collections.ErrNotFound) {
-		accumulatedGas = 0
-	} else if err != nil {
+	accumulatedGas, err := k.blockGas(ctx, params)
+	if err != nil {
 		return err
 	}
 
@@ -142,7 +202,29 @@ func (k Keeper) UpdateBaseGasPrice(ctx sdk.Context) error {
 	return k.SetParams(ctx, params)
 }
 
-// AccumulateGas accumulates the gas used in the block
+// blockGas returns the gas the base gas price reacts to. It is the gas
+// consumed by the block's txs when AccumulateGasUsed is set, or the declared
+// gas limits accumulated by the ante handler otherwise.
+func (k Keeper) blockGas(ctx sdk.Context, params types.Params) (uint64, error) {
+	if params.AccumulateGasUsed {
+		// baseapp charges every finalized tx, failed or not, its consumed gas
+		// on the block gas meter after execution.
+		if blockGasMeter := ctx.BlockGasMeter(); blockGasMeter != nil {
+			return blockGasMeter.GasConsumed(), nil
+		}
+
+		return 0, nil
+	}
+
+	accumulatedGas, err := k.GetAccumulatedGas(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, nil
+	}
+
+	return accumulatedGas, err
+}
+
+// AccumulateGas accumulates the gas limit of a tx in the block
 func (k Keeper) AccumulateGas(ctx context.Context, gas uint64) error {
 	accumulatedGas, err := k.AccumulatedGas.Get(ctx)
 	if errors.Is(err, collections.ErrNotFound) {
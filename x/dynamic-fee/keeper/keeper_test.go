@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/initia-labs/initia/x/dynamic-fee/types"
@@ -56,6 +57,49 @@ func Test_UpdateBaseFee(t *testing.T) {
 	require.Equal(t, math.LegacyNewDecWithPrec(99, 4), baseGasPrice)
 }
 
+func Test_UpdateBaseFee_GasUsed(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	err := input.DynamicFeeKeeper.SetParams(ctx, types.Params{
+		MinBaseGasPrice:   math.LegacyNewDecWithPrec(1, 3),
+		MaxBaseGasPrice:   math.LegacyNewDec(200),
+		BaseGasPrice:      math.LegacyNewDecWithPrec(1, 2),
+		TargetGas:         100000,
+		MaxChangeRate:     math.LegacyNewDecWithPrec(1, 1),
+		AccumulateGasUsed: true,
+	})
+	require.NoError(t, err)
+
+	// over-provisioned gas limits are ignored
+	input.DynamicFeeKeeper.AccumulateGas(ctx, 1000000)
+
+	blockGasMeter := storetypes.NewInfiniteGasMeter()
+	blockGasMeter.ConsumeGas(200000, "block gas meter")
+	ctx = ctx.WithBlockGasMeter(blockGasMeter)
+
+	// update base fee
+	err = input.DynamicFeeKeeper.UpdateBaseGasPrice(ctx)
+	require.NoError(t, err)
+
+	baseGasPrice, err := input.DynamicFeeKeeper.BaseGasPrice(ctx)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDecWithPrec(11, 3), baseGasPrice)
+
+	// switch back to the legacy gas limit accounting
+	params, err := input.DynamicFeeKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.AccumulateGasUsed = false
+	require.NoError(t, input.DynamicFeeKeeper.SetParams(ctx, params))
+
+	// update base fee
+	err = input.DynamicFeeKeeper.UpdateBaseGasPrice(ctx)
+	require.NoError(t, err)
+
+	baseGasPrice, err = input.DynamicFeeKeeper.BaseGasPrice(ctx)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDecWithPrec(11, 3).Mul(math.LegacyNewDecWithPrec(19, 1)), baseGasPrice)
+}
+
 func Test_AccumulateGas(t *testing.T) {
 	ctx, input := createDefaultTestInput(t)
 
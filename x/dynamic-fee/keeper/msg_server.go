@@ -35,6 +35,14 @@ func (ms MsgServer) UpdateParams(context context.Context, req *types.MsgUpdatePa
 		return nil, err
 	}
 
+	baseDenom, err := ms.baseDenomKeeper.BaseDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := req.Params.ValidateBaseDenomOracle(baseDenom); err != nil {
+		return nil, err
+	}
+
 	if err := ms.SetParams(ctx, req.Params); err != nil {
 		return nil, err
 	}
@@ -31,3 +31,27 @@ func Test_UpdateParams(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, msg.Params, params)
 }
+
+func Test_UpdateParams_RequiresBaseDenomOracle(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	ms := keeper.NewMsgServerImpl(&input.DynamicFeeKeeper)
+
+	params := types.DefaultParams()
+	params.FeeDenomOracles = []types.FeeDenomOracle{
+		{Denom: "uusdc", CurrencyPair: "USDC/USD", Decimals: 6},
+	}
+	msg := &types.MsgUpdateParams{
+		Authority: input.DynamicFeeKeeper.GetAuthority(),
+		Params:    params,
+	}
+
+	// the base denom has no oracle, so mapped prices cannot be converted
+	_, err := ms.UpdateParams(ctx, msg)
+	require.ErrorContains(t, err, "base denom")
+
+	msg.Params.FeeDenomOracles = append(msg.Params.FeeDenomOracles, types.FeeDenomOracle{
+		Denom: bondDenom, CurrencyPair: "INIT/USD", Decimals: 6,
+	})
+	_, err = ms.UpdateParams(ctx, msg)
+	require.NoError(t, err)
+}
@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+
+	"github.com/initia-labs/initia/x/dynamic-fee/types"
+)
+
+// oracleTokenPrice returns the base price of a gas token derived from the
+// oracle prices of the token and the base denom, which share the same quote.
+// `base_price` * `quote_amount` == `base_amount`
+func (k Keeper) oracleTokenPrice(ctx context.Context, denom string, params types.Params) (math.LegacyDec, error) {
+	baseDenom, err := k.baseDenomKeeper.BaseDenom(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	price, exponent, err := k.oraclePrice(ctx, denom, params)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	basePrice, baseExponent, err := k.oraclePrice(ctx, baseDenom, params)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	// (price / 10^exponent) / (basePrice / 10^baseExponent)
+	return math.LegacyNewDecFromInt(price).Mul(pow10(baseExponent)).
+		Quo(math.LegacyNewDecFromInt(basePrice).Mul(pow10(exponent))), nil
+}
+
+// oraclePrice returns the oracle price of the denom with the decimal exponent
+// that converts it to the price of the smallest unit of the denom.
+func (k Keeper) oraclePrice(ctx context.Context, denom string, params types.Params) (math.Int, uint64, error) {
+	oracle, found := params.FeeDenomOracle(denom)
+	if !found {
+		return math.Int{}, 0, errorsmod.Wrap(types.ErrOracleNotConfigured, denom)
+	}
+
+	cp, err := connecttypes.CurrencyPairFromString(oracle.CurrencyPair)
+	if err != nil {
+		return math.Int{}, 0, err
+	}
+
+	quotePrice, err := k.oracleKeeper.GetPriceForCurrencyPair(ctx, cp)
+	if err != nil {
+		return math.Int{}, 0, err
+	}
+
+	if quotePrice.Price.IsNil() || !quotePrice.Price.IsPositive() {
+		return math.Int{}, 0, errorsmod.Wrapf(types.ErrOracleNotConfigured, "non-positive oracle price of %s", oracle.CurrencyPair)
+	}
+
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+	if blockTime.Sub(quotePrice.BlockTimestamp) > params.OracleMaxPriceAge {
+		return math.Int{}, 0, errorsmod.Wrapf(types.ErrStaleOraclePrice, "%s updated at %s", oracle.CurrencyPair, quotePrice.BlockTimestamp)
+	}
+
+	decimals, err := k.oracleKeeper.GetDecimalsForCurrencyPair(ctx, cp)
+	if err != nil {
+		return math.Int{}, 0, err
+	}
+
+	return quotePrice.Price, decimals + uint64(oracle.Decimals), nil
+}
+
+func pow10(exponent uint64) math.LegacyDec {
+	return math.LegacyNewDec(10).Power(exponent)
+}
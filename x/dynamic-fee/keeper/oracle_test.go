@@ -0,0 +1,128 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
+
+	"github.com/initia-labs/initia/x/dynamic-fee/types"
+)
+
+func setOraclePrice(t *testing.T, ctx sdk.Context, input TestKeepers, pair string, price int64, updatedAt time.Time) {
+	cp, err := connecttypes.CurrencyPairFromString(pair)
+	require.NoError(t, err)
+
+	// USD pairs have 8 decimals
+	err = input.OracleKeeper.SetPriceForCurrencyPair(ctx, cp, oracletypes.QuotePrice{
+		Price:          math.NewInt(price).MulRaw(100_000_000),
+		BlockTimestamp: updatedAt,
+		BlockHeight:    uint64(ctx.BlockHeight()), //nolint:gosec
+	})
+	require.NoError(t, err)
+}
+
+func TestGasPrice_Oracle(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	now := time.Unix(1_000_000, 0)
+	ctx = ctx.WithBlockTime(now)
+
+	basePrice := math.LegacyNewDecWithPrec(1, 2) // 0.01
+	denoms, prices := registerDexPool(t, ctx, input, basePrice)
+
+	// 1 INIT == 2 USD, 1 USDC == 1 USDT == 1 USD
+	setOraclePrice(t, ctx, input, "INIT/USD", 2, now)
+	setOraclePrice(t, ctx, input, "USDC/USD", 1, now)
+	setOraclePrice(t, ctx, input, "USDT/USD", 1, now)
+
+	params, err := input.DynamicFeeKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.FeeDenomOracles = []types.FeeDenomOracle{
+		{Denom: bondDenom, CurrencyPair: "INIT/USD", Decimals: 6},
+		{Denom: denoms[0], CurrencyPair: "USDC/USD", Decimals: 6},
+		{Denom: "uusdt", CurrencyPair: "USDT/USD", Decimals: 6},
+	}
+	params.OracleDivergenceThreshold = math.LegacyZeroDec()
+	params.OracleMaxPriceAge = time.Minute
+	require.NoError(t, input.DynamicFeeKeeper.SetParams(ctx, params))
+
+	// denom without a dex pair is priced by the oracle
+	gasPrice, err := input.DynamicFeeKeeper.GasPrice(ctx, "uusdt")
+	require.NoError(t, err)
+	require.Equal(t, basePrice.Quo(math.LegacyNewDecWithPrec(5, 1)), gasPrice.Amount)
+
+	gasPrices, err := input.DynamicFeeKeeper.GasPrices(ctx)
+	require.NoError(t, err)
+	require.Equal(t, gasPrice.Amount, gasPrices.AmountOf("uusdt"))
+
+	// the divergence check is disabled, so the dex price is kept
+	gasPrice, err = input.DynamicFeeKeeper.GasPrice(ctx, denoms[0])
+	require.NoError(t, err)
+	require.Equal(t, basePrice.Quo(prices[0]), gasPrice.Amount)
+
+	// the dex price diverges from the oracle price by 100%
+	params.OracleDivergenceThreshold = math.LegacyNewDecWithPrec(1, 1)
+	require.NoError(t, input.DynamicFeeKeeper.SetParams(ctx, params))
+
+	gasPrice, err = input.DynamicFeeKeeper.GasPrice(ctx, denoms[0])
+	require.NoError(t, err)
+	require.Equal(t, basePrice.Quo(math.LegacyNewDecWithPrec(5, 1)), gasPrice.Amount)
+
+	params.OracleDivergenceThreshold = math.LegacyNewDec(2)
+	require.NoError(t, input.DynamicFeeKeeper.SetParams(ctx, params))
+
+	gasPrice, err = input.DynamicFeeKeeper.GasPrice(ctx, denoms[0])
+	require.NoError(t, err)
+	require.Equal(t, basePrice.Quo(prices[0]), gasPrice.Amount)
+
+	// stale oracle prices
+	ctx = ctx.WithBlockTime(now.Add(2 * time.Minute))
+	params.OracleDivergenceThreshold = math.LegacyNewDecWithPrec(1, 1)
+	require.NoError(t, input.DynamicFeeKeeper.SetParams(ctx, params))
+
+	_, err = input.DynamicFeeKeeper.GasPrice(ctx, "uusdt")
+	require.ErrorIs(t, err, types.ErrStaleOraclePrice)
+
+	// the dex price is kept when the oracle price is not available
+	gasPrice, err = input.DynamicFeeKeeper.GasPrice(ctx, denoms[0])
+	require.NoError(t, err)
+	require.Equal(t, basePrice.Quo(prices[0]), gasPrice.Amount)
+
+	gasPrices, err = input.DynamicFeeKeeper.GasPrices(ctx)
+	require.NoError(t, err)
+	require.True(t, gasPrices.AmountOf("uusdt").IsZero())
+	require.Equal(t, basePrice.Quo(prices[1]), gasPrices.AmountOf(denoms[1]))
+}
+
+func TestParams_FeeDenomOracles(t *testing.T) {
+	params := types.DefaultParams()
+	params.FeeDenomOracles = []types.FeeDenomOracle{
+		{Denom: bondDenom, CurrencyPair: "INIT/USD", Decimals: 6},
+		{Denom: "uusdc", CurrencyPair: "USDC/USD", Decimals: 6},
+	}
+	require.NoError(t, params.Validate())
+
+	// duplicate denom
+	params.FeeDenomOracles[1].Denom = bondDenom
+	require.Error(t, params.Validate())
+
+	// different quote
+	params.FeeDenomOracles[1] = types.FeeDenomOracle{Denom: "uusdc", CurrencyPair: "USDC/EUR", Decimals: 6}
+	require.Error(t, params.Validate())
+
+	// invalid currency pair
+	params.FeeDenomOracles[1].CurrencyPair = "USDC"
+	require.Error(t, params.Validate())
+
+	// zero max price age
+	params.FeeDenomOracles[1].CurrencyPair = "USDC/USD"
+	params.OracleMaxPriceAge = 0
+	require.Error(t, params.Validate())
+}
@@ -27,3 +27,14 @@ func (q Querier) Params(ctx context.Context, req *types.QueryParamsRequest) (*ty
 		Params: params,
 	}, nil
 }
+
+func (q Querier) FeeHistory(ctx context.Context, req *types.QueryFeeHistoryRequest) (*types.QueryFeeHistoryResponse, error) {
+	feeHistory, err := q.GetFeeHistory(ctx, req.BlockCount, req.RewardPercentiles)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryFeeHistoryResponse{
+		FeeHistory: feeHistory,
+	}, nil
+}
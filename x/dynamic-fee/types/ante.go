@@ -4,6 +4,8 @@ import (
 	context "context"
 
 	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 type AnteKeeper interface {
@@ -11,4 +13,6 @@ type AnteKeeper interface {
 	BaseDenom(ctx context.Context) (string, error)
 	BaseGasPrice(ctx context.Context) (math.LegacyDec, error)
 	AccumulateGas(ctx context.Context, gas uint64) error
+	SettleBaseFee(ctx context.Context, fee sdk.Coins, gas uint64) (sdk.Coins, error)
+	RecordFeeSample(ctx context.Context, fee sdk.Coins, gas uint64) error
 }
@@ -5,5 +5,8 @@ import (
 )
 
 var (
-	ErrTargetGasZero = errorsmod.Register(ModuleName, 2, "target gas is zero")
+	ErrTargetGasZero         = errorsmod.Register(ModuleName, 2, "target gas is zero")
+	ErrInvalidFeePercentiles = errorsmod.Register(ModuleName, 3, "invalid fee history percentiles")
+	ErrOracleNotConfigured   = errorsmod.Register(ModuleName, 4, "fee denom oracle not configured")
+	ErrStaleOraclePrice      = errorsmod.Register(ModuleName, 5, "stale oracle price")
 )
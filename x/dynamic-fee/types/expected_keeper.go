@@ -4,10 +4,17 @@ import (
 	context "context"
 
 	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
 )
 
 type TokenPriceKeeper interface {
 	GetBaseSpotPrice(ctx context.Context, denom string) (math.LegacyDec, error)
+	GetBaseTWAPPrice(ctx context.Context, denom string) (math.LegacyDec, error)
+	HasDexPair(ctx context.Context, denom string) (bool, error)
 }
 
 type WhitelistKeeper interface {
@@ -17,3 +24,14 @@ type WhitelistKeeper interface {
 type BaseDenomKeeper interface {
 	BaseDenom(ctx context.Context) (string, error)
 }
+
+type BankKeeper interface {
+	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
+}
+
+type OracleKeeper interface {
+	GetPriceForCurrencyPair(ctx context.Context, cp connecttypes.CurrencyPair) (oracletypes.QuotePrice, error)
+	GetDecimalsForCurrencyPair(ctx context.Context, cp connecttypes.CurrencyPair) (decimals uint64, err error)
+}
@@ -0,0 +1,103 @@
+package types
+
+import (
+	"sort"
+
+	"cosmossdk.io/math"
+)
+
+const (
+	// FeeHistoryLength is the number of most recent blocks kept in the fee history.
+	FeeHistoryLength = 256
+
+	// FeeHistoryPercentileStep is the percentile step of the tip percentiles
+	// stored per block. Requested percentiles in between are interpolated.
+	FeeHistoryPercentileStep = 5
+)
+
+// ValidateFeePercentiles checks that the percentiles are ascending and within [0, 100].
+func ValidateFeePercentiles(percentiles []uint32) error {
+	for i, p := range percentiles {
+		if p > 100 {
+			return ErrInvalidFeePercentiles.Wrapf("percentile %d is above 100", p)
+		}
+		if i > 0 && p < percentiles[i-1] {
+			return ErrInvalidFeePercentiles.Wrap("percentiles must be ascending")
+		}
+	}
+
+	return nil
+}
+
+// TipPercentiles returns the gas weighted tip gas prices of the samples at
+// every FeeHistoryPercentileStep percent from 0 to 100.
+func TipPercentiles(samples []FeeSample) []math.LegacyDec {
+	if len(samples) == 0 {
+		return nil
+	}
+
+	sorted := make([]FeeSample, len(samples))
+	copy(sorted, samples)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].TipGasPrice.LT(sorted[j].TipGasPrice)
+	})
+
+	totalGas := math.ZeroInt()
+	for _, sample := range sorted {
+		totalGas = totalGas.Add(math.NewIntFromUint64(sample.Gas))
+	}
+
+	percentiles := make([]math.LegacyDec, 0, 100/FeeHistoryPercentileStep+1)
+	idx := 0
+	cumulativeGas := math.NewIntFromUint64(sorted[0].Gas)
+	for p := int64(0); p <= 100; p += FeeHistoryPercentileStep {
+		// the tip of the first tx at which the cumulative gas reaches p percent
+		threshold := totalGas.MulRaw(p)
+		for idx < len(sorted)-1 && cumulativeGas.MulRaw(100).LT(threshold) {
+			idx++
+			cumulativeGas = cumulativeGas.Add(math.NewIntFromUint64(sorted[idx].Gas))
+		}
+
+		percentiles = append(percentiles, sorted[idx].TipGasPrice)
+	}
+
+	return percentiles
+}
+
+// Rewards returns the tip gas prices of the record at the given percentiles,
+// interpolating linearly between the stored percentile steps.
+func (r FeeHistoryRecord) Rewards(percentiles []uint32) []math.LegacyDec {
+	rewards := make([]math.LegacyDec, len(percentiles))
+	for i, p := range percentiles {
+		if len(r.TipPercentiles) == 0 {
+			rewards[i] = math.LegacyZeroDec()
+			continue
+		}
+
+		lower := int(p / FeeHistoryPercentileStep)
+		if lower >= len(r.TipPercentiles)-1 {
+			rewards[i] = r.TipPercentiles[len(r.TipPercentiles)-1]
+			continue
+		}
+
+		low, high := r.TipPercentiles[lower], r.TipPercentiles[lower+1]
+		rewards[i] = high.Sub(low).
+			MulInt64(int64(p % FeeHistoryPercentileStep)).
+			QuoInt64(FeeHistoryPercentileStep).
+			Add(low)
+	}
+
+	return rewards
+}
+
+// Entry returns the fee history entry of the record with the tip gas prices
+// at the given percentiles.
+func (r FeeHistoryRecord) Entry(percentiles []uint32) FeeHistoryEntry {
+	return FeeHistoryEntry{
+		Height:         r.Height,
+		BaseGasPrice:   r.BaseGasPrice,
+		AccumulatedGas: r.AccumulatedGas,
+		TargetGas:      r.TargetGas,
+		Rewards:        r.Rewards(percentiles),
+	}
+}
@@ -20,10 +20,13 @@ const (
 // Keys for dynamic fee store
 // Items are stored with the following key: values
 var (
-	ParamsKey = []byte{0x11} // key for parameters for module x/dynamicfee
+	ParamsKey     = []byte{0x11} // key for parameters for module x/dynamicfee
+	FeeHistoryKey = []byte{0x12} // prefix for per-block fee history records
 )
 
 // Transient store keys
 var (
 	AccumulatedGasKey = []byte{0x21} // key for accumulated gas for module x/dynamicfee
+	FeeSamplesKey     = []byte{0x22} // prefix for the fee samples of the current block
+	FeeSampleSeqKey   = []byte{0x23} // key for the fee sample sequence of the current block
 )
@@ -2,8 +2,14 @@ package types
 
 import (
 	"fmt"
+	"time"
 
+	"cosmossdk.io/core/address"
 	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
 )
 
 var (
@@ -16,8 +22,13 @@ var (
 
 	// 0.1
 	DefaultMaxChangeRate = math.LegacyNewDecWithPrec(1, 1)
+
+	DefaultOracleMaxPriceAge = time.Minute
 )
 
+// MaxFeeDenomDecimals bounds the display unit exponent of an oracle priced fee denom.
+const MaxFeeDenomDecimals = 18
+
 func DefaultParams() Params {
 	return Params{
 		BaseGasPrice:    DefaultBaseGasPrice,
@@ -25,6 +36,11 @@ func DefaultParams() Params {
 		MaxBaseGasPrice: DefaultMaxBaseGasPrice,
 		TargetGas:       DefaultTargetGas,
 		MaxChangeRate:   DefaultMaxChangeRate,
+
+		AccumulateGasUsed: true,
+
+		OracleDivergenceThreshold: math.LegacyZeroDec(),
+		OracleMaxPriceAge:         DefaultOracleMaxPriceAge,
 	}
 }
 
@@ -35,6 +51,11 @@ func NoBaseGasPriceChangeParams() Params {
 		MaxBaseGasPrice: DefaultMaxBaseGasPrice,
 		TargetGas:       DefaultTargetGas,
 		MaxChangeRate:   math.LegacyZeroDec(),
+
+		AccumulateGasUsed: true,
+
+		OracleDivergenceThreshold: math.LegacyZeroDec(),
+		OracleMaxPriceAge:         DefaultOracleMaxPriceAge,
 	}
 }
 
@@ -67,5 +88,91 @@ func (p Params) Validate() error {
 		return fmt.Errorf("max change rate must be non-negative")
 	}
 
+	return p.validateFeeDenomOracles()
+}
+
+func (p Params) validateFeeDenomOracles() error {
+	if !p.OracleDivergenceThreshold.IsNil() && p.OracleDivergenceThreshold.IsNegative() {
+		return fmt.Errorf("oracle divergence threshold must be non-negative")
+	}
+
+	if p.OracleMaxPriceAge < 0 {
+		return fmt.Errorf("oracle max price age must be non-negative")
+	}
+
+	if len(p.FeeDenomOracles) == 0 {
+		return nil
+	}
+
+	if p.OracleMaxPriceAge == 0 {
+		return fmt.Errorf("oracle max price age must be positive when fee denom oracles are set")
+	}
+
+	var quote string
+	seen := make(map[string]bool, len(p.FeeDenomOracles))
+	for _, oracle := range p.FeeDenomOracles {
+		if err := sdk.ValidateDenom(oracle.Denom); err != nil {
+			return fmt.Errorf("invalid fee denom oracle denom: %w", err)
+		}
+
+		if seen[oracle.Denom] {
+			return fmt.Errorf("duplicate fee denom oracle: %s", oracle.Denom)
+		}
+		seen[oracle.Denom] = true
+
+		cp, err := connecttypes.CurrencyPairFromString(oracle.CurrencyPair)
+		if err != nil {
+			return fmt.Errorf("invalid currency pair of fee denom oracle %s: %w", oracle.Denom, err)
+		}
+
+		if quote == "" {
+			quote = cp.Quote
+		} else if cp.Quote != quote {
+			return fmt.Errorf("currency pairs of fee denom oracles must share the quote %s: %s", quote, oracle.CurrencyPair)
+		}
+
+		if oracle.Decimals > MaxFeeDenomDecimals {
+			return fmt.Errorf("decimals of fee denom oracle %s must be less than or equal to %d", oracle.Denom, MaxFeeDenomDecimals)
+		}
+	}
+
+	return nil
+}
+
+// ValidateBaseDenomOracle checks that the base denom is mapped whenever any fee
+// denom oracle is set, since oracle prices are converted to base prices through it.
+func (p Params) ValidateBaseDenomOracle(baseDenom string) error {
+	if len(p.FeeDenomOracles) == 0 {
+		return nil
+	}
+
+	if _, found := p.FeeDenomOracle(baseDenom); !found {
+		return fmt.Errorf("fee denom oracles must include the base denom %s", baseDenom)
+	}
+
+	return nil
+}
+
+// FeeDenomOracle returns the oracle mapping of the denom.
+func (p Params) FeeDenomOracle(denom string) (FeeDenomOracle, bool) {
+	for _, oracle := range p.FeeDenomOracles {
+		if oracle.Denom == denom {
+			return oracle, true
+		}
+	}
+
+	return FeeDenomOracle{}, false
+}
+
+// ValidateBaseFeeRecipient checks that the base fee recipient, if set, is a valid address.
+func (p Params) ValidateBaseFeeRecipient(ac address.Codec) error {
+	if p.BaseFeeRecipient == "" {
+		return nil
+	}
+
+	if _, err := ac.StringToBytes(p.BaseFeeRecipient); err != nil {
+		return fmt.Errorf("invalid base fee recipient: %w", err)
+	}
+
 	return nil
 }
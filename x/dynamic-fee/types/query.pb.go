@@ -105,34 +105,125 @@ func (m *QueryParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
 
+// QueryFeeHistoryRequest is the request type for the Query/FeeHistory RPC method.
+type QueryFeeHistoryRequest struct {
+	// block_count is the number of most recent blocks to return. Zero or a
+	// count above the history length returns the whole history.
+	BlockCount uint64 `protobuf:"varint,1,opt,name=block_count,json=blockCount,proto3" json:"block_count,omitempty"`
+	// reward_percentiles are the ascending percentiles, from 0 to 100, of the
+	// tip gas prices to return for each block.
+	RewardPercentiles []uint32 `protobuf:"varint,2,rep,packed,name=reward_percentiles,json=rewardPercentiles,proto3" json:"reward_percentiles,omitempty"`
+}
+
+func (m *QueryFeeHistoryRequest) Reset()         { *m = QueryFeeHistoryRequest{} }
+func (m *QueryFeeHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeHistoryRequest) ProtoMessage()    {}
+func (*QueryFeeHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d65cd836c44c8c1b, []int{2}
+}
+func (m *QueryFeeHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeHistoryRequest.Merge(m, src)
+}
+func (m *QueryFeeHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeHistoryRequest proto.InternalMessageInfo
+
+// QueryFeeHistoryResponse is the response type for the Query/FeeHistory RPC method.
+type QueryFeeHistoryResponse struct {
+	FeeHistory FeeHistory `protobuf:"bytes,1,opt,name=fee_history,json=feeHistory,proto3" json:"fee_history"`
+}
+
+func (m *QueryFeeHistoryResponse) Reset()         { *m = QueryFeeHistoryResponse{} }
+func (m *QueryFeeHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeHistoryResponse) ProtoMessage()    {}
+func (*QueryFeeHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d65cd836c44c8c1b, []int{3}
+}
+func (m *QueryFeeHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeHistoryResponse.Merge(m, src)
+}
+func (m *QueryFeeHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeHistoryResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "initia.dynamicfee.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "initia.dynamicfee.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryFeeHistoryRequest)(nil), "initia.dynamicfee.v1.QueryFeeHistoryRequest")
+	proto.RegisterType((*QueryFeeHistoryResponse)(nil), "initia.dynamicfee.v1.QueryFeeHistoryResponse")
 }
 
 func init() { proto.RegisterFile("initia/dynamicfee/v1/query.proto", fileDescriptor_d65cd836c44c8c1b) }
 
 var fileDescriptor_d65cd836c44c8c1b = []byte{
-	// 293 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xc8, 0xcc, 0xcb, 0x2c,
-	0xc9, 0x4c, 0xd4, 0x4f, 0xa9, 0xcc, 0x4b, 0xcc, 0xcd, 0x4c, 0x4e, 0x4b, 0x4d, 0xd5, 0x2f, 0x33,
-	0xd4, 0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x81, 0xa8,
-	0xd0, 0x43, 0xa8, 0xd0, 0x2b, 0x33, 0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x2b, 0xd0, 0x07,
-	0xb1, 0x20, 0x6a, 0xa5, 0x64, 0xd2, 0xf3, 0xf3, 0xd3, 0x73, 0x52, 0xf5, 0x13, 0x0b, 0x32, 0xf5,
-	0x13, 0xf3, 0xf2, 0xf2, 0x4b, 0x12, 0x4b, 0x32, 0xf3, 0xf3, 0x8a, 0xa1, 0xb2, 0xd8, 0xed, 0x2a,
-	0xa9, 0x2c, 0x48, 0x85, 0xaa, 0x50, 0x12, 0xe1, 0x12, 0x0a, 0x04, 0x59, 0x1d, 0x90, 0x58, 0x94,
-	0x98, 0x5b, 0x1c, 0x94, 0x5a, 0x58, 0x9a, 0x5a, 0x5c, 0xa2, 0x14, 0xc8, 0x25, 0x8c, 0x22, 0x5a,
-	0x5c, 0x90, 0x9f, 0x57, 0x9c, 0x2a, 0x64, 0xc5, 0xc5, 0x56, 0x00, 0x16, 0x91, 0x60, 0x54, 0x60,
-	0xd4, 0xe0, 0x36, 0x92, 0xd1, 0xc3, 0xe6, 0x52, 0x3d, 0x88, 0x2e, 0x27, 0x96, 0x13, 0xf7, 0xe4,
-	0x19, 0x82, 0xa0, 0x3a, 0x8c, 0x7a, 0x19, 0xb9, 0x58, 0xc1, 0x66, 0x0a, 0x35, 0x33, 0x72, 0xb1,
-	0x41, 0x94, 0x08, 0x69, 0x60, 0x37, 0x00, 0xd3, 0x45, 0x52, 0x9a, 0x44, 0xa8, 0x84, 0xb8, 0x52,
-	0x49, 0xa5, 0xe9, 0xf2, 0x93, 0xc9, 0x4c, 0x72, 0x42, 0x32, 0xfa, 0x58, 0x7d, 0x0f, 0x71, 0x8f,
-	0x53, 0xf0, 0x89, 0x87, 0x72, 0x0c, 0x2b, 0x1e, 0xc9, 0x31, 0x9c, 0x78, 0x24, 0xc7, 0x78, 0xe1,
-	0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70,
-	0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x61, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0x2e,
-	0xd4, 0x24, 0xdd, 0x9c, 0xc4, 0xa4, 0x62, 0x98, 0xa9, 0x15, 0x30, 0x73, 0x75, 0x41, 0x06, 0x83,
-	0xc3, 0x34, 0x89, 0x0d, 0x1c, 0xa8, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb4, 0x5c, 0x3f,
-	0xf8, 0xe4, 0x01, 0x00, 0x00,
+	// 422 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xbf, 0x6e, 0xd3, 0x40,
+	0x18, 0xb7, 0x43, 0xc9, 0x70, 0x11, 0x03, 0x47, 0x04, 0x55, 0x14, 0x5d, 0x8d, 0x61, 0x48, 0x25,
+	0x6c, 0x2b, 0x65, 0x63, 0x2c, 0x12, 0x30, 0xb6, 0x66, 0x63, 0x89, 0xce, 0xee, 0x17, 0xe7, 0x84,
+	0x73, 0xe7, 0xfa, 0xce, 0x05, 0xaf, 0xf0, 0x02, 0x48, 0xf0, 0x08, 0x0c, 0x3c, 0x4a, 0xc6, 0x4a,
+	0x2c, 0x4c, 0x08, 0x1c, 0x1e, 0x04, 0xf9, 0xee, 0x8a, 0x41, 0xb1, 0x50, 0x37, 0xeb, 0xf7, 0xfd,
+	0xbe, 0xdf, 0x9f, 0xcf, 0x87, 0x3c, 0xc6, 0x99, 0x62, 0x34, 0x3a, 0xab, 0x39, 0x5d, 0xb3, 0x74,
+	0x09, 0x10, 0x5d, 0xcc, 0xa3, 0xf3, 0x0a, 0xca, 0x3a, 0x2c, 0x4a, 0xa1, 0x04, 0x1e, 0x1b, 0x46,
+	0xd8, 0x31, 0xc2, 0x8b, 0xf9, 0x64, 0x9c, 0x89, 0x4c, 0x68, 0x42, 0xd4, 0x7e, 0x19, 0xee, 0x64,
+	0x9a, 0x09, 0x91, 0xe5, 0x10, 0xd1, 0x82, 0x45, 0x94, 0x73, 0xa1, 0xa8, 0x62, 0x82, 0x4b, 0x3b,
+	0xed, 0xf7, 0x52, 0x75, 0x01, 0x96, 0xe1, 0x8f, 0x11, 0x3e, 0x6d, 0xad, 0x4f, 0x68, 0x49, 0xd7,
+	0x32, 0x86, 0xf3, 0x0a, 0xa4, 0xf2, 0x4f, 0xd1, 0x9d, 0x7f, 0x50, 0x59, 0x08, 0x2e, 0x01, 0x3f,
+	0x41, 0xc3, 0x42, 0x23, 0xfb, 0xae, 0xe7, 0xce, 0x46, 0x47, 0xd3, 0xb0, 0x2f, 0x69, 0x68, 0xb6,
+	0x8e, 0xf7, 0x36, 0xdf, 0x0f, 0x9c, 0xd8, 0x6e, 0xf8, 0x2b, 0x74, 0x57, 0x4b, 0x3e, 0x03, 0x78,
+	0xc1, 0xa4, 0x12, 0x65, 0x6d, 0xcd, 0xf0, 0x01, 0x1a, 0x25, 0xb9, 0x48, 0x5f, 0x2f, 0x52, 0x51,
+	0x71, 0xa5, 0xa5, 0xf7, 0x62, 0xa4, 0xa1, 0xa7, 0x2d, 0x82, 0x03, 0x84, 0x4b, 0x78, 0x43, 0xcb,
+	0xb3, 0x45, 0x01, 0x65, 0x0a, 0x5c, 0xb1, 0x1c, 0xe4, 0xfe, 0xc0, 0xbb, 0x31, 0xbb, 0x15, 0xdf,
+	0x36, 0x93, 0x93, 0x6e, 0xe0, 0x27, 0xe8, 0xde, 0x8e, 0x93, 0x2d, 0xf0, 0x1c, 0x8d, 0x96, 0x00,
+	0x8b, 0x95, 0x81, 0x6d, 0x0b, 0xaf, 0xbf, 0x45, 0xb7, 0x6e, 0x9b, 0xa0, 0xe5, 0x1f, 0xe4, 0xe8,
+	0xf3, 0x00, 0xdd, 0xd4, 0x26, 0xf8, 0xbd, 0x8b, 0x86, 0xa6, 0x30, 0x9e, 0xf5, 0x0b, 0xed, 0xde,
+	0x77, 0x72, 0x78, 0x0d, 0xa6, 0x89, 0xec, 0x3f, 0x7c, 0xf7, 0xf5, 0xd7, 0xc7, 0x01, 0xc1, 0xd3,
+	0xa8, 0xf7, 0x5f, 0x9a, 0xeb, 0xe2, 0x4f, 0x2e, 0x42, 0x5d, 0x60, 0xfc, 0xe8, 0x3f, 0xfa, 0x3b,
+	0x3f, 0x60, 0x12, 0x5c, 0x93, 0x6d, 0x13, 0x1d, 0xea, 0x44, 0x0f, 0xf0, 0xfd, 0xfe, 0x44, 0x7f,
+	0x1d, 0xf8, 0xf8, 0xe5, 0xe6, 0x27, 0x71, 0xbe, 0x34, 0xc4, 0xd9, 0x34, 0xc4, 0xbd, 0x6c, 0x88,
+	0xfb, 0xa3, 0x21, 0xee, 0x87, 0x2d, 0x71, 0x2e, 0xb7, 0xc4, 0xf9, 0xb6, 0x25, 0xce, 0xab, 0x79,
+	0xc6, 0xd4, 0xaa, 0x4a, 0xc2, 0x54, 0xac, 0xad, 0x5c, 0x90, 0xd3, 0x44, 0x5e, 0x49, 0xbf, 0xbd,
+	0x12, 0x0f, 0x5a, 0x75, 0xfd, 0x70, 0x93, 0xa1, 0x7e, 0xb9, 0x8f, 0x7f, 0x0f, 0x00, 0x60, 0xde,
+	0x66, 0xd3, 0x49, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -149,6 +240,9 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// Params queries the params
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// FeeHistory queries the base gas price history of the most recent blocks
+	// and the percentiles of the tip gas prices paid by their txs.
+	FeeHistory(ctx context.Context, in *QueryFeeHistoryRequest, opts ...grpc.CallOption) (*QueryFeeHistoryResponse, error)
 }
 
 type queryClient struct {
@@ -168,10 +262,22 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) FeeHistory(ctx context.Context, in *QueryFeeHistoryRequest, opts ...grpc.CallOption) (*QueryFeeHistoryResponse, error) {
+	out := new(QueryFeeHistoryResponse)
+	err := c.cc.Invoke(ctx, "/initia.dynamicfee.v1.Query/FeeHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the params
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// FeeHistory queries the base gas price history of the most recent blocks
+	// and the percentiles of the tip gas prices paid by their txs.
+	FeeHistory(context.Context, *QueryFeeHistoryRequest) (*QueryFeeHistoryResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -181,6 +287,9 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) FeeHistory(ctx context.Context, req *QueryFeeHistoryRequest) (*QueryFeeHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeHistory not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -204,6 +313,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FeeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.dynamicfee.v1.Query/FeeHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeHistory(ctx, req.(*QueryFeeHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "initia.dynamicfee.v1.Query",
@@ -213,6 +340,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "FeeHistory",
+			Handler:    _Query_FeeHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "initia/dynamicfee/v1/query.proto",
@@ -274,6 +405,85 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryFeeHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardPercentiles) > 0 {
+		dAtA3 := make([]byte, len(m.RewardPercentiles)*10)
+		var j2 int
+		for _, num := range m.RewardPercentiles {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintQuery(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BlockCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FeeHistory.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -305,6 +515,36 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryFeeHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockCount != 0 {
+		n += 1 + sovQuery(uint64(m.BlockCount))
+	}
+	if len(m.RewardPercentiles) > 0 {
+		l = 0
+		for _, e := range m.RewardPercentiles {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QueryFeeHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FeeHistory.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -444,6 +684,234 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryFeeHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockCount", wireType)
+			}
+			m.BlockCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RewardPercentiles = append(m.RewardPercentiles, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RewardPercentiles) == 0 {
+					m.RewardPercentiles = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RewardPercentiles = append(m.RewardPercentiles, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPercentiles", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeHistory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -19,5 +19,9 @@ func (msg MsgUpdateParams) Validate(ac address.Codec) error {
 		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
 	}
 
-	return msg.Params.Validate()
+	if err := msg.Params.Validate(); err != nil {
+		return err
+	}
+
+	return msg.Params.ValidateBaseFeeRecipient(ac)
 }
@@ -10,15 +10,19 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -33,6 +37,39 @@ type Params struct {
 	MaxBaseGasPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=max_base_gas_price,json=maxBaseGasPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_base_gas_price" yaml:"max_base_gas_price"`
 	MaxChangeRate   cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=max_change_rate,json=maxChangeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_change_rate" yaml:"max_change_rate"`
 	TargetGas       int64                       `protobuf:"varint,5,opt,name=target_gas,json=targetGas,proto3" json:"target_gas,omitempty"`
+	// base_fee_recipient receives the base fee portion of every tx fee, the base
+	// gas price times the gas limit, when settle_base_fee is enabled. An empty
+	// recipient burns it. Only the remaining priority tip stays in the fee
+	// collector for proposer rewards.
+	BaseFeeRecipient string `protobuf:"bytes,6,opt,name=base_fee_recipient,json=baseFeeRecipient,proto3" json:"base_fee_recipient,omitempty" yaml:"base_fee_recipient"`
+	// accumulate_gas_used makes the base gas price react to the gas consumed by
+	// the txs of a block, read from the block gas meter at the end of the block.
+	// When false, the declared gas limits accumulated in the ante handler are
+	// used instead, which is the legacy behavior.
+	AccumulateGasUsed bool `protobuf:"varint,7,opt,name=accumulate_gas_used,json=accumulateGasUsed,proto3" json:"accumulate_gas_used,omitempty" yaml:"accumulate_gas_used"`
+	// use_twap_price makes fee pricing value whitelisted gas tokens at their
+	// time-weighted average base price instead of the instantaneous pool spot
+	// price, which can be moved within a single block.
+	UseTwapPrice bool `protobuf:"varint,8,opt,name=use_twap_price,json=useTwapPrice,proto3" json:"use_twap_price,omitempty" yaml:"use_twap_price"`
+	// fee_denom_oracles map fee denoms to the Connect currency pairs pricing
+	// them. A mapped denom without a dex pair is priced by the oracle, so it can
+	// pay fees without a pool. The base denom must be mapped too, since oracle
+	// prices are converted to base prices through it.
+	FeeDenomOracles []FeeDenomOracle `protobuf:"bytes,9,rep,name=fee_denom_oracles,json=feeDenomOracles,proto3" json:"fee_denom_oracles" yaml:"fee_denom_oracles"`
+	// oracle_divergence_threshold is the relative difference between the dex
+	// and the oracle price of a mapped denom above which the oracle price is
+	// used. Zero disables the check, so mapped denoms with a dex pair keep the
+	// dex price.
+	OracleDivergenceThreshold cosmossdk_io_math.LegacyDec `protobuf:"bytes,10,opt,name=oracle_divergence_threshold,json=oracleDivergenceThreshold,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"oracle_divergence_threshold" yaml:"oracle_divergence_threshold"`
+	// oracle_max_price_age is the maximum age of an oracle price before it is
+	// considered stale and not used.
+	OracleMaxPriceAge time.Duration `protobuf:"bytes,11,opt,name=oracle_max_price_age,json=oracleMaxPriceAge,proto3,stdduration" json:"oracle_max_price_age" yaml:"oracle_max_price_age"`
+	// settle_base_fee moves the base fee portion of every tx fee out of the fee
+	// collector, to base_fee_recipient or burned when it is empty. It is off by
+	// default and decodes as false on chains upgrading from a version without
+	// it, so the whole fee keeps going to proposer rewards until governance
+	// enables it with MsgUpdateParams.
+	SettleBaseFee bool `protobuf:"varint,12,opt,name=settle_base_fee,json=settleBaseFee,proto3" json:"settle_base_fee,omitempty" yaml:"settle_base_fee"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -68,39 +105,304 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+// FeeDenomOracle maps a fee denom to the Connect currency pair pricing it.
+type FeeDenomOracle struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// currency_pair is the Connect currency pair pricing one display unit of
+	// the denom, e.g. "USDC/USD". All pairs must share the same quote.
+	CurrencyPair string `protobuf:"bytes,2,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair,omitempty" yaml:"currency_pair"`
+	// decimals is the exponent of the display unit of the denom, e.g. 6 for
+	// uusdc.
+	Decimals uint32 `protobuf:"varint,3,opt,name=decimals,proto3" json:"decimals,omitempty"`
+}
+
+func (m *FeeDenomOracle) Reset()         { *m = FeeDenomOracle{} }
+func (m *FeeDenomOracle) String() string { return proto.CompactTextString(m) }
+func (*FeeDenomOracle) ProtoMessage()    {}
+func (*FeeDenomOracle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ab0bab554cc683f, []int{1}
+}
+func (m *FeeDenomOracle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeDenomOracle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeDenomOracle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeDenomOracle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeDenomOracle.Merge(m, src)
+}
+func (m *FeeDenomOracle) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeDenomOracle) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeDenomOracle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeDenomOracle proto.InternalMessageInfo
+
+// FeeSample is the effective priority paid by a tx included in the current
+// block, kept in the transient store until the block's fee history record is
+// written.
+type FeeSample struct {
+	// tip_gas_price is the gas price paid above the base gas price, valued in
+	// the base denom.
+	TipGasPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=tip_gas_price,json=tipGasPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"tip_gas_price"`
+	// gas is the gas limit of the tx, used to weight the sample.
+	Gas uint64 `protobuf:"varint,2,opt,name=gas,proto3" json:"gas,omitempty"`
+}
+
+func (m *FeeSample) Reset()         { *m = FeeSample{} }
+func (m *FeeSample) String() string { return proto.CompactTextString(m) }
+func (*FeeSample) ProtoMessage()    {}
+func (*FeeSample) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ab0bab554cc683f, []int{2}
+}
+func (m *FeeSample) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeSample) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeSample.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeSample) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeSample.Merge(m, src)
+}
+func (m *FeeSample) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeSample) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeSample.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeSample proto.InternalMessageInfo
+
+// FeeHistoryRecord is the fee state of a single block kept in the fee history
+// ring buffer.
+type FeeHistoryRecord struct {
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// base_gas_price is the base gas price the block was executed with.
+	BaseGasPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=base_gas_price,json=baseGasPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_gas_price"`
+	// accumulated_gas is the block gas the base gas price update reacted to.
+	AccumulatedGas uint64 `protobuf:"varint,3,opt,name=accumulated_gas,json=accumulatedGas,proto3" json:"accumulated_gas,omitempty"`
+	TargetGas      int64  `protobuf:"varint,4,opt,name=target_gas,json=targetGas,proto3" json:"target_gas,omitempty"`
+	// tip_percentiles are the gas weighted percentiles of the tip gas prices of
+	// the block's txs, sampled every FeeHistoryPercentileStep percent from 0 to
+	// 100. Empty when the block has no txs.
+	TipPercentiles []cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,rep,name=tip_percentiles,json=tipPercentiles,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"tip_percentiles"`
+}
+
+func (m *FeeHistoryRecord) Reset()         { *m = FeeHistoryRecord{} }
+func (m *FeeHistoryRecord) String() string { return proto.CompactTextString(m) }
+func (*FeeHistoryRecord) ProtoMessage()    {}
+func (*FeeHistoryRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ab0bab554cc683f, []int{3}
+}
+func (m *FeeHistoryRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeHistoryRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeHistoryRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeHistoryRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeHistoryRecord.Merge(m, src)
+}
+func (m *FeeHistoryRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeHistoryRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeHistoryRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeHistoryRecord proto.InternalMessageInfo
+
+// FeeHistoryEntry is the fee state of a single block, with the tip gas prices
+// at the requested percentiles.
+type FeeHistoryEntry struct {
+	Height         int64                       `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	BaseGasPrice   cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=base_gas_price,json=baseGasPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_gas_price"`
+	AccumulatedGas uint64                      `protobuf:"varint,3,opt,name=accumulated_gas,json=accumulatedGas,proto3" json:"accumulated_gas,omitempty"`
+	TargetGas      int64                       `protobuf:"varint,4,opt,name=target_gas,json=targetGas,proto3" json:"target_gas,omitempty"`
+	// rewards are the tip gas prices at the requested percentiles. The tip is
+	// what feeds the mempool priority of a tx.
+	Rewards []cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,rep,name=rewards,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rewards"`
+}
+
+func (m *FeeHistoryEntry) Reset()         { *m = FeeHistoryEntry{} }
+func (m *FeeHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*FeeHistoryEntry) ProtoMessage()    {}
+func (*FeeHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ab0bab554cc683f, []int{4}
+}
+func (m *FeeHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeHistoryEntry.Merge(m, src)
+}
+func (m *FeeHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeHistoryEntry proto.InternalMessageInfo
+
+// FeeHistory is the fee history of the most recent blocks, in base denom gas
+// prices.
+type FeeHistory struct {
+	// entries are ordered from the oldest to the newest block.
+	Entries []FeeHistoryEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+	// next_base_gas_price is the base gas price of the next block.
+	NextBaseGasPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=next_base_gas_price,json=nextBaseGasPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"next_base_gas_price"`
+}
+
+func (m *FeeHistory) Reset()         { *m = FeeHistory{} }
+func (m *FeeHistory) String() string { return proto.CompactTextString(m) }
+func (*FeeHistory) ProtoMessage()    {}
+func (*FeeHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ab0bab554cc683f, []int{5}
+}
+func (m *FeeHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeHistory.Merge(m, src)
+}
+func (m *FeeHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeHistory proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Params)(nil), "initia.dynamicfee.v1.Params")
+	proto.RegisterType((*FeeDenomOracle)(nil), "initia.dynamicfee.v1.FeeDenomOracle")
+	proto.RegisterType((*FeeSample)(nil), "initia.dynamicfee.v1.FeeSample")
+	proto.RegisterType((*FeeHistoryRecord)(nil), "initia.dynamicfee.v1.FeeHistoryRecord")
+	proto.RegisterType((*FeeHistoryEntry)(nil), "initia.dynamicfee.v1.FeeHistoryEntry")
+	proto.RegisterType((*FeeHistory)(nil), "initia.dynamicfee.v1.FeeHistory")
 }
 
 func init() { proto.RegisterFile("initia/dynamicfee/v1/types.proto", fileDescriptor_1ab0bab554cc683f) }
 
 var fileDescriptor_1ab0bab554cc683f = []byte{
-	// 399 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xbd, 0xae, 0x12, 0x41,
-	0x1c, 0xc5, 0x77, 0xbc, 0xd7, 0x9b, 0x30, 0xf1, 0x23, 0x6e, 0xd0, 0x00, 0xc6, 0x81, 0x50, 0x11,
-	0x13, 0x76, 0x42, 0xec, 0x2c, 0x91, 0x84, 0xc6, 0x44, 0x02, 0x9d, 0xcd, 0xe6, 0xbf, 0xc3, 0xb8,
-	0x4c, 0x64, 0x76, 0xc8, 0xce, 0x40, 0x76, 0x3b, 0x1f, 0xc1, 0x27, 0xb0, 0xa6, 0xb4, 0xf0, 0x21,
-	0x28, 0x89, 0x95, 0xb1, 0x20, 0xba, 0x14, 0xf6, 0x3e, 0x81, 0xd9, 0x1d, 0x0c, 0xf2, 0x61, 0x42,
-	0x6e, 0xb3, 0x99, 0x39, 0x7b, 0xe6, 0xfc, 0x4e, 0x71, 0x70, 0x43, 0x44, 0xc2, 0x08, 0xa0, 0xe3,
-	0x34, 0x02, 0x29, 0xd8, 0x3b, 0xce, 0xe9, 0xa2, 0x43, 0x4d, 0x3a, 0xe3, 0xda, 0x9b, 0xc5, 0xca,
-	0x28, 0xb7, 0x6c, 0x1d, 0xde, 0xde, 0xe1, 0x2d, 0x3a, 0xb5, 0x47, 0x20, 0x45, 0xa4, 0x68, 0xf1,
-	0xb5, 0xc6, 0x5a, 0x95, 0x29, 0x2d, 0x95, 0xf6, 0x8b, 0x1b, 0xb5, 0x97, 0xdd, 0xaf, 0x72, 0xa8,
-	0x42, 0x65, 0xf5, 0xfc, 0x64, 0xd5, 0xe6, 0xa7, 0x6b, 0x7c, 0x33, 0x80, 0x18, 0xa4, 0x76, 0xe7,
-	0xf8, 0x41, 0x00, 0x9a, 0xfb, 0x21, 0xe4, 0xef, 0x05, 0xe3, 0x15, 0xd4, 0x40, 0xad, 0x52, 0xf7,
-	0xcd, 0x6a, 0x53, 0x77, 0xbe, 0x6f, 0xea, 0x4f, 0x6d, 0x9c, 0x1e, 0xbf, 0xf7, 0x84, 0xa2, 0x12,
-	0xcc, 0xc4, 0x7b, 0xcd, 0x43, 0x60, 0x69, 0x8f, 0xb3, 0xdf, 0x9b, 0xfa, 0xe3, 0x14, 0xe4, 0xf4,
-	0x65, 0xf3, 0x30, 0xa2, 0xf9, 0xf5, 0x4b, 0x1b, 0xef, 0x6a, 0xf4, 0x38, 0x5b, 0xfe, 0xfa, 0xfc,
-	0x1c, 0x0d, 0xef, 0xe5, 0x9e, 0x3e, 0xe8, 0x41, 0xee, 0x70, 0x3f, 0x20, 0xec, 0x4a, 0x11, 0xf9,
-	0x47, 0xec, 0x3b, 0x05, 0x7b, 0x74, 0x19, 0xbb, 0x6a, 0xd9, 0xa7, 0x31, 0x67, 0xf9, 0x0f, 0xa5,
-	0x88, 0xba, 0x27, 0x15, 0x20, 0x39, 0xae, 0x70, 0x75, 0x9b, 0x0a, 0x27, 0x31, 0xff, 0xa9, 0x00,
-	0xc9, 0x41, 0x85, 0x04, 0xe7, 0x92, 0xcf, 0x26, 0x10, 0x85, 0xdc, 0x8f, 0xc1, 0xf0, 0xca, 0x75,
-	0x81, 0x1f, 0x5c, 0x86, 0x7f, 0xb2, 0xc7, 0xff, 0x93, 0x71, 0x96, 0x7d, 0x5f, 0x42, 0xf2, 0xaa,
-	0xf0, 0x0c, 0xc1, 0x70, 0xf7, 0x19, 0xc6, 0x06, 0xe2, 0x90, 0x9b, 0xbc, 0x72, 0xe5, 0x6e, 0x03,
-	0xb5, 0xae, 0x86, 0x25, 0xab, 0xf4, 0x41, 0x77, 0x47, 0xab, 0x9f, 0xc4, 0x59, 0x66, 0x04, 0xad,
-	0x32, 0x82, 0xd6, 0x19, 0x41, 0x3f, 0x32, 0x82, 0x3e, 0x6e, 0x89, 0xb3, 0xde, 0x12, 0xe7, 0xdb,
-	0x96, 0x38, 0x6f, 0x3b, 0xa1, 0x30, 0x93, 0x79, 0xe0, 0x31, 0x25, 0xa9, 0xdd, 0x69, 0x7b, 0x0a,
-	0x81, 0xde, 0x9d, 0x69, 0xf2, 0x77, 0xd7, 0xed, 0x7c, 0xd8, 0xc5, 0xaa, 0x83, 0x9b, 0x62, 0x7c,
-	0x2f, 0xfe, 0x04, 0x00, 0x00, 0xff, 0xff, 0x15, 0xb0, 0xa7, 0x61, 0xfa, 0x02, 0x00, 0x00,
+	// 988 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0xbf, 0x6f, 0xe4, 0x44,
+	0x14, 0x8e, 0xb3, 0xf9, 0xb5, 0x93, 0xdf, 0x73, 0xcb, 0xc9, 0x49, 0x84, 0x77, 0x65, 0x71, 0x22,
+	0x42, 0xc4, 0x56, 0x0e, 0x41, 0x81, 0x84, 0xd0, 0x2d, 0x21, 0x41, 0x08, 0xb8, 0x95, 0x73, 0x34,
+	0xa7, 0x93, 0xac, 0x89, 0xfd, 0xe2, 0x1d, 0xb1, 0xfe, 0xa1, 0x99, 0x71, 0xb2, 0xdb, 0xc1, 0x7f,
+	0x40, 0x49, 0x41, 0x43, 0x77, 0x25, 0x48, 0xf4, 0xb4, 0x29, 0x4f, 0x54, 0x88, 0x62, 0x81, 0xa4,
+	0x40, 0xa2, 0xcc, 0x5f, 0x80, 0x66, 0xc6, 0x1b, 0xc7, 0xd9, 0x05, 0x45, 0x84, 0xe6, 0x9a, 0x95,
+	0xe7, 0xcd, 0xb7, 0xef, 0xfb, 0xe6, 0xbd, 0x37, 0x9f, 0x8d, 0x5a, 0x34, 0xa1, 0x82, 0x12, 0x37,
+	0x1c, 0x24, 0x24, 0xa6, 0xc1, 0x31, 0x80, 0x7b, 0xb2, 0xeb, 0x8a, 0x41, 0x06, 0xdc, 0xc9, 0x58,
+	0x2a, 0x52, 0xdc, 0xd0, 0x08, 0xa7, 0x44, 0x38, 0x27, 0xbb, 0x9b, 0xeb, 0x24, 0xa6, 0x49, 0xea,
+	0xaa, 0x5f, 0x0d, 0xdc, 0xdc, 0x08, 0x52, 0x1e, 0xa7, 0xdc, 0x57, 0x2b, 0x57, 0x2f, 0x8a, 0xad,
+	0x46, 0x94, 0x46, 0xa9, 0x8e, 0xcb, 0xa7, 0x22, 0x6a, 0x45, 0x69, 0x1a, 0xf5, 0xc0, 0x55, 0xab,
+	0xa3, 0xfc, 0xd8, 0x0d, 0x73, 0x46, 0x04, 0x4d, 0x13, 0xbd, 0x6f, 0xff, 0x55, 0x47, 0x73, 0x1d,
+	0xc2, 0x48, 0xcc, 0x71, 0x8e, 0x56, 0x8e, 0x08, 0x07, 0x3f, 0x22, 0x32, 0x3f, 0x0d, 0xc0, 0x34,
+	0x5a, 0xc6, 0x76, 0xbd, 0xfd, 0xf8, 0x6c, 0xd8, 0x9c, 0xfa, 0x75, 0xd8, 0xdc, 0xd2, 0x74, 0x3c,
+	0xfc, 0xc2, 0xa1, 0xa9, 0x1b, 0x13, 0xd1, 0x75, 0x3e, 0x81, 0x88, 0x04, 0x83, 0x3d, 0x08, 0x2e,
+	0x87, 0xcd, 0x57, 0x06, 0x24, 0xee, 0xbd, 0x6b, 0x57, 0x53, 0xd8, 0x3f, 0xff, 0xb8, 0x83, 0x0a,
+	0x99, 0x7b, 0x10, 0x3c, 0xff, 0xf3, 0xfb, 0x37, 0x0c, 0x6f, 0x49, 0x62, 0x0e, 0x08, 0xef, 0x48,
+	0x04, 0xfe, 0xd2, 0x40, 0x38, 0xa6, 0x89, 0x7f, 0x83, 0x7b, 0x5a, 0x71, 0x1f, 0xde, 0x8e, 0x7b,
+	0x43, 0x73, 0x8f, 0xa7, 0x99, 0xc8, 0xbf, 0x1a, 0xd3, 0xa4, 0x3d, 0x26, 0x81, 0xf4, 0x6f, 0x4a,
+	0xa8, 0xfd, 0x17, 0x09, 0xa4, 0x7f, 0x3b, 0x09, 0xa4, 0x5f, 0x91, 0xd0, 0x47, 0x32, 0xe4, 0x07,
+	0x5d, 0x92, 0x44, 0xe0, 0x33, 0x22, 0xc0, 0x9c, 0x51, 0xf4, 0x9d, 0xdb, 0xd1, 0xdf, 0x2f, 0xe9,
+	0xaf, 0xe5, 0x98, 0xc8, 0xbd, 0x1c, 0x93, 0xfe, 0x07, 0x0a, 0xe3, 0x11, 0x01, 0xf8, 0x55, 0x84,
+	0x04, 0x61, 0x11, 0x08, 0x29, 0xd9, 0x9c, 0x6d, 0x19, 0xdb, 0x35, 0xaf, 0xae, 0x23, 0x07, 0x84,
+	0xe3, 0x00, 0x61, 0x75, 0x9e, 0x63, 0x00, 0x9f, 0x41, 0x40, 0x33, 0x0a, 0x89, 0x30, 0xe7, 0x94,
+	0xb6, 0xb7, 0xcb, 0x73, 0x8f, 0x63, 0x24, 0x77, 0xa3, 0xe0, 0x7e, 0x14, 0x86, 0x0c, 0x38, 0x3f,
+	0x14, 0x8c, 0x26, 0x91, 0xb7, 0x26, 0xc1, 0xfb, 0x00, 0xde, 0x08, 0x8a, 0x3f, 0x43, 0xf7, 0x48,
+	0x10, 0xe4, 0x71, 0xde, 0x23, 0x42, 0x97, 0x2e, 0xe7, 0x10, 0x9a, 0xf3, 0x2d, 0x63, 0x7b, 0xa1,
+	0x6d, 0x5d, 0x0e, 0x9b, 0x9b, 0x9a, 0x65, 0x02, 0xc8, 0xf6, 0xd6, 0xcb, 0xe8, 0x01, 0xe1, 0x9f,
+	0x73, 0x08, 0xf1, 0xfb, 0x68, 0x25, 0xe7, 0xe0, 0x8b, 0x53, 0x92, 0x15, 0xbd, 0x5c, 0x50, 0xa9,
+	0x36, 0xca, 0x39, 0xad, 0xee, 0xdb, 0xde, 0x52, 0xce, 0xe1, 0xc9, 0x29, 0xc9, 0x74, 0x3b, 0x4e,
+	0xd1, 0xba, 0x3c, 0x4c, 0x08, 0x49, 0x1a, 0xfb, 0x29, 0x23, 0x41, 0x0f, 0xb8, 0x59, 0x6f, 0xd5,
+	0xb6, 0x17, 0x1f, 0xbe, 0xe6, 0x4c, 0xba, 0xac, 0xce, 0x3e, 0xc0, 0x9e, 0x44, 0x3f, 0x56, 0xe0,
+	0xf6, 0x03, 0xd9, 0xb6, 0xcb, 0x61, 0xd3, 0xd4, 0x6c, 0x63, 0xc9, 0xec, 0x62, 0x0e, 0x8e, 0x2b,
+	0x7f, 0xe3, 0xf8, 0x5b, 0x03, 0x6d, 0x69, 0x88, 0x1f, 0xd2, 0x13, 0x60, 0x11, 0x24, 0x01, 0xf8,
+	0xa2, 0xcb, 0x80, 0x77, 0xd3, 0x5e, 0x68, 0x22, 0x55, 0xf8, 0x67, 0xb7, 0x1b, 0x0a, 0x5b, 0x93,
+	0xff, 0x4b, 0xbe, 0x89, 0x03, 0xb2, 0xa1, 0xff, 0xb0, 0x77, 0x85, 0x7f, 0x32, 0x82, 0xe3, 0x3e,
+	0x6a, 0x14, 0xd9, 0xe4, 0xa4, 0xa9, 0xd2, 0xf9, 0x24, 0x02, 0x73, 0xb1, 0x65, 0x6c, 0x2f, 0x3e,
+	0xdc, 0x70, 0xb4, 0xdb, 0x38, 0x23, 0xb7, 0x71, 0xf6, 0x0a, 0xb7, 0x69, 0xbf, 0x59, 0xd4, 0x63,
+	0xab, 0x22, 0xa9, 0x92, 0xc4, 0xfe, 0xe6, 0xb7, 0xa6, 0xa1, 0x15, 0xac, 0xeb, 0xfd, 0x4f, 0x49,
+	0x5f, 0xb5, 0xe3, 0x51, 0x04, 0xb8, 0x8d, 0x56, 0x39, 0x08, 0xd1, 0x03, 0x7f, 0x34, 0x6a, 0xe6,
+	0x92, 0xea, 0xe9, 0x66, 0x39, 0xfd, 0x37, 0x00, 0xb6, 0xb7, 0xac, 0x23, 0x6d, 0x3d, 0x6f, 0xf6,
+	0x57, 0x06, 0x5a, 0xa9, 0xf6, 0x09, 0x37, 0xd0, 0xac, 0xea, 0x8b, 0xf6, 0x3a, 0x4f, 0x2f, 0xf0,
+	0x7b, 0x68, 0x39, 0xc8, 0x19, 0x83, 0x24, 0x18, 0xf8, 0x19, 0xa1, 0xac, 0x70, 0x23, 0xf3, 0x72,
+	0xd8, 0x6c, 0x68, 0xaa, 0xca, 0xb6, 0xed, 0x2d, 0x8d, 0xd6, 0x1d, 0x42, 0x19, 0xde, 0x44, 0x0b,
+	0x21, 0x04, 0x34, 0x26, 0x3d, 0xae, 0x4c, 0x64, 0xd9, 0xbb, 0x5a, 0xdb, 0x03, 0x54, 0xdf, 0x07,
+	0x38, 0x24, 0x71, 0xd6, 0x03, 0xfc, 0x14, 0x2d, 0x0b, 0x9a, 0x8d, 0x39, 0xee, 0x3b, 0xb7, 0x68,
+	0xef, 0xa4, 0xc6, 0x2d, 0x0a, 0x9a, 0x5d, 0x39, 0xca, 0x1a, 0xaa, 0xc9, 0x0b, 0x2d, 0x95, 0xcf,
+	0x78, 0xf2, 0xd1, 0xfe, 0x61, 0x1a, 0xad, 0xed, 0x03, 0x7c, 0x44, 0xb9, 0x48, 0xd9, 0xc0, 0x83,
+	0x20, 0x65, 0x21, 0xbe, 0x8f, 0xe6, 0xba, 0x40, 0xa3, 0xae, 0x50, 0xdc, 0x35, 0xaf, 0x58, 0xe1,
+	0x67, 0x63, 0x6f, 0x83, 0xe9, 0x3b, 0x69, 0xab, 0x9a, 0xfe, 0xeb, 0x68, 0xb5, 0xbc, 0xb5, 0xa1,
+	0x72, 0x9e, 0x9a, 0x12, 0xba, 0x72, 0x2d, 0x2c, 0xed, 0xa7, 0xea, 0x4e, 0x33, 0x37, 0xdd, 0xc9,
+	0x47, 0xab, 0xb2, 0x80, 0x19, 0xb0, 0x00, 0x12, 0x41, 0xe5, 0x2d, 0x9d, 0x6d, 0xd5, 0xee, 0x20,
+	0x73, 0x45, 0xd0, 0xac, 0x53, 0x66, 0xb3, 0xbf, 0x9b, 0x46, 0xab, 0x65, 0xcd, 0x3e, 0x4c, 0x04,
+	0x1b, 0xbc, 0xe4, 0x25, 0xeb, 0xa0, 0x79, 0x06, 0xa7, 0x84, 0x85, 0x77, 0x2d, 0xd5, 0x28, 0x8d,
+	0xfd, 0x93, 0x81, 0x50, 0x59, 0x23, 0xfc, 0x31, 0x9a, 0x87, 0x44, 0x30, 0x0a, 0xdc, 0x34, 0x94,
+	0x63, 0x3e, 0xf8, 0x47, 0xc7, 0xbc, 0x5e, 0xd6, 0x76, 0x5d, 0xea, 0x28, 0x52, 0x17, 0x09, 0x30,
+	0xa0, 0x7b, 0x09, 0xf4, 0x85, 0xff, 0xbf, 0xd6, 0x75, 0x4d, 0xa6, 0xbc, 0xfe, 0xf6, 0x6d, 0x1f,
+	0x9e, 0xfd, 0x61, 0x4d, 0x3d, 0x3f, 0xb7, 0x8c, 0xb3, 0x73, 0xcb, 0x78, 0x71, 0x6e, 0x19, 0xbf,
+	0x9f, 0x5b, 0xc6, 0xd7, 0x17, 0xd6, 0xd4, 0x8b, 0x0b, 0x6b, 0xea, 0x97, 0x0b, 0x6b, 0xea, 0xe9,
+	0x6e, 0x44, 0x45, 0x37, 0x3f, 0x72, 0x82, 0x34, 0x76, 0xf5, 0x69, 0x76, 0x7a, 0xe4, 0x88, 0x17,
+	0xcf, 0x6e, 0x7f, 0xf4, 0x71, 0xb7, 0x23, 0xbf, 0xee, 0xd4, 0xa7, 0xdd, 0xd1, 0x9c, 0x72, 0xc1,
+	0xb7, 0xfe, 0x1e, 0x00, 0x88, 0xba, 0x0b, 0xfc, 0xff, 0x09, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -137,114 +439,1568 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.TargetGas != that1.TargetGas {
 		return false
 	}
+	if this.BaseFeeRecipient != that1.BaseFeeRecipient {
+		return false
+	}
+	if this.AccumulateGasUsed != that1.AccumulateGasUsed {
+		return false
+	}
+	if this.UseTwapPrice != that1.UseTwapPrice {
+		return false
+	}
+	if len(this.FeeDenomOracles) != len(that1.FeeDenomOracles) {
+		return false
+	}
+	for i := range this.FeeDenomOracles {
+		if !this.FeeDenomOracles[i].Equal(&that1.FeeDenomOracles[i]) {
+			return false
+		}
+	}
+	if !this.OracleDivergenceThreshold.Equal(that1.OracleDivergenceThreshold) {
+		return false
+	}
+	if this.OracleMaxPriceAge != that1.OracleMaxPriceAge {
+		return false
+	}
+	if this.SettleBaseFee != that1.SettleBaseFee {
+		return false
+	}
 	return true
 }
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *FeeDenomOracle) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*FeeDenomOracle)
+	if !ok {
+		that2, ok := that.(FeeDenomOracle)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if this.CurrencyPair != that1.CurrencyPair {
+		return false
+	}
+	if this.Decimals != that1.Decimals {
+		return false
+	}
+	return true
 }
+func (this *FeeSample) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TargetGas != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.TargetGas))
-		i--
-		dAtA[i] = 0x28
+	that1, ok := that.(*FeeSample)
+	if !ok {
+		that2, ok := that.(FeeSample)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	{
-		size := m.MaxChangeRate.Size()
-		i -= size
-		if _, err := m.MaxChangeRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.TipGasPrice.Equal(that1.TipGasPrice) {
+		return false
+	}
+	if this.Gas != that1.Gas {
+		return false
+	}
+	return true
+}
+func (this *FeeHistoryRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FeeHistoryRecord)
+	if !ok {
+		that2, ok := that.(FeeHistoryRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.MaxBaseGasPrice.Size()
-		i -= size
-		if _, err := m.MaxBaseGasPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if !this.BaseGasPrice.Equal(that1.BaseGasPrice) {
+		return false
+	}
+	if this.AccumulatedGas != that1.AccumulatedGas {
+		return false
+	}
+	if this.TargetGas != that1.TargetGas {
+		return false
+	}
+	if len(this.TipPercentiles) != len(that1.TipPercentiles) {
+		return false
+	}
+	for i := range this.TipPercentiles {
+		if !this.TipPercentiles[i].Equal(that1.TipPercentiles[i]) {
+			return false
 		}
-		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.MinBaseGasPrice.Size()
-		i -= size
-		if _, err := m.MinBaseGasPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return true
+}
+func (this *FeeHistoryEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FeeHistoryEntry)
+	if !ok {
+		that2, ok := that.(FeeHistoryEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.BaseGasPrice.Size()
-		i -= size
-		if _, err := m.BaseGasPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if !this.BaseGasPrice.Equal(that1.BaseGasPrice) {
+		return false
+	}
+	if this.AccumulatedGas != that1.AccumulatedGas {
+		return false
+	}
+	if this.TargetGas != that1.TargetGas {
+		return false
+	}
+	if len(this.Rewards) != len(that1.Rewards) {
+		return false
+	}
+	for i := range this.Rewards {
+		if !this.Rewards[i].Equal(that1.Rewards[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *FeeHistory) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FeeHistory)
+	if !ok {
+		that2, ok := that.(FeeHistory)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Entries) != len(that1.Entries) {
+		return false
+	}
+	for i := range this.Entries {
+		if !this.Entries[i].Equal(&that1.Entries[i]) {
+			return false
+		}
+	}
+	if !this.NextBaseGasPrice.Equal(that1.NextBaseGasPrice) {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SettleBaseFee {
+		i--
+		if m.SettleBaseFee {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.OracleMaxPriceAge, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.OracleMaxPriceAge):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTypes(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.OracleDivergenceThreshold.Size()
+		i -= size
+		if _, err := m.OracleDivergenceThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if len(m.FeeDenomOracles) > 0 {
+		for iNdEx := len(m.FeeDenomOracles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeDenomOracles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.UseTwapPrice {
+		i--
+		if m.UseTwapPrice {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.AccumulateGasUsed {
+		i--
+		if m.AccumulateGasUsed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.BaseFeeRecipient) > 0 {
+		i -= len(m.BaseFeeRecipient)
+		copy(dAtA[i:], m.BaseFeeRecipient)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.BaseFeeRecipient)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.TargetGas != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TargetGas))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.MaxChangeRate.Size()
+		i -= size
+		if _, err := m.MaxChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MaxBaseGasPrice.Size()
+		i -= size
+		if _, err := m.MaxBaseGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MinBaseGasPrice.Size()
+		i -= size
+		if _, err := m.MinBaseGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.BaseGasPrice.Size()
+		i -= size
+		if _, err := m.BaseGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeDenomOracle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeDenomOracle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeDenomOracle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Decimals != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CurrencyPair) > 0 {
+		i -= len(m.CurrencyPair)
+		copy(dAtA[i:], m.CurrencyPair)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.CurrencyPair)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeSample) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeSample) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeSample) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Gas != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Gas))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.TipGasPrice.Size()
+		i -= size
+		if _, err := m.TipGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeHistoryRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeHistoryRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeHistoryRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TipPercentiles) > 0 {
+		for iNdEx := len(m.TipPercentiles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.TipPercentiles[iNdEx].Size()
+				i -= size
+				if _, err := m.TipPercentiles[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.TargetGas != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TargetGas))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.AccumulatedGas != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.AccumulatedGas))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.BaseGasPrice.Size()
+		i -= size
+		if _, err := m.BaseGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Rewards[iNdEx].Size()
+				i -= size
+				if _, err := m.Rewards[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.TargetGas != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TargetGas))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.AccumulatedGas != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.AccumulatedGas))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.BaseGasPrice.Size()
+		i -= size
+		if _, err := m.BaseGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.NextBaseGasPrice.Size()
+		i -= size
+		if _, err := m.NextBaseGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BaseGasPrice.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.MinBaseGasPrice.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.MaxBaseGasPrice.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.MaxChangeRate.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.TargetGas != 0 {
+		n += 1 + sovTypes(uint64(m.TargetGas))
+	}
+	l = len(m.BaseFeeRecipient)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.AccumulateGasUsed {
+		n += 2
+	}
+	if m.UseTwapPrice {
+		n += 2
+	}
+	if len(m.FeeDenomOracles) > 0 {
+		for _, e := range m.FeeDenomOracles {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = m.OracleDivergenceThreshold.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.OracleMaxPriceAge)
+	n += 1 + l + sovTypes(uint64(l))
+	if m.SettleBaseFee {
+		n += 2
+	}
+	return n
+}
+
+func (m *FeeDenomOracle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.CurrencyPair)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovTypes(uint64(m.Decimals))
+	}
+	return n
+}
+
+func (m *FeeSample) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TipGasPrice.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Gas != 0 {
+		n += 1 + sovTypes(uint64(m.Gas))
+	}
+	return n
+}
+
+func (m *FeeHistoryRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	l = m.BaseGasPrice.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.AccumulatedGas != 0 {
+		n += 1 + sovTypes(uint64(m.AccumulatedGas))
+	}
+	if m.TargetGas != 0 {
+		n += 1 + sovTypes(uint64(m.TargetGas))
+	}
+	if len(m.TipPercentiles) > 0 {
+		for _, e := range m.TipPercentiles {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	l = m.BaseGasPrice.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.AccumulatedGas != 0 {
+		n += 1 + sovTypes(uint64(m.AccumulatedGas))
+	}
+	if m.TargetGas != 0 {
+		n += 1 + sovTypes(uint64(m.TargetGas))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = m.NextBaseGasPrice.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBaseGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBaseGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBaseGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBaseGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetGas", wireType)
+			}
+			m.TargetGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetGas |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseFeeRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccumulateGasUsed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AccumulateGasUsed = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseTwapPrice", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UseTwapPrice = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenomOracles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDenomOracles = append(m.FeeDenomOracles, FeeDenomOracle{})
+			if err := m.FeeDenomOracles[len(m.FeeDenomOracles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleDivergenceThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OracleDivergenceThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleMaxPriceAge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.OracleMaxPriceAge, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettleBaseFee", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SettleBaseFee = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeDenomOracle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeDenomOracle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeDenomOracle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrencyPair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeSample) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeSample: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeSample: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TipGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TipGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			m.Gas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeHistoryRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeHistoryRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeHistoryRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccumulatedGas", wireType)
+			}
+			m.AccumulatedGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccumulatedGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetGas", wireType)
+			}
+			m.TargetGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetGas |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TipPercentiles", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.TipPercentiles = append(m.TipPercentiles, v)
+			if err := m.TipPercentiles[len(m.TipPercentiles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.BaseGasPrice.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = m.MinBaseGasPrice.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = m.MaxBaseGasPrice.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = m.MaxChangeRate.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	if m.TargetGas != 0 {
-		n += 1 + sovTypes(uint64(m.TargetGas))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *FeeHistoryEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -267,13 +2023,32 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeeHistoryEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeeHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BaseGasPrice", wireType)
 			}
@@ -307,9 +2082,47 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccumulatedGas", wireType)
+			}
+			m.AccumulatedGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccumulatedGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetGas", wireType)
+			}
+			m.TargetGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetGas |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinBaseGasPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -337,15 +2150,67 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinBaseGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v cosmossdk_io_math.LegacyDec
+			m.Rewards = append(m.Rewards, v)
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxBaseGasPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -355,29 +2220,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxBaseGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Entries = append(m.Entries, FeeHistoryEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextBaseGasPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -405,29 +2270,10 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NextBaseGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetGas", wireType)
-			}
-			m.TargetGas = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TargetGas |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -40,6 +40,13 @@ func (k Keeper) handleEquivocationEvidence(ctx context.Context, evidence *types.
 		return nil
 	}
 
+	// The evidence can be against the old consensus key of a validator which
+	// rotated it, while the signing info is kept at the current one.
+	valConsAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return err
+	}
+
 	if len(validator.GetOperator()) != 0 {
 		if _, err := k.slashingKeeper.GetPubkey(ctx, consAddr.Bytes()); err != nil {
 			// Ignore evidence that cannot be handled.
@@ -80,12 +87,12 @@ func (k Keeper) handleEquivocationEvidence(ctx context.Context, evidence *types.
 		}
 	}
 
-	if ok := k.slashingKeeper.HasValidatorSigningInfo(ctx, consAddr); !ok {
-		panic(fmt.Sprintf("expected signing info for validator %s but not found", consAddr))
+	if ok := k.slashingKeeper.HasValidatorSigningInfo(ctx, valConsAddr); !ok {
+		panic(fmt.Sprintf("expected signing info for validator %s but not found", valConsAddr))
 	}
 
 	// ignore if the validator is already tombstoned
-	if k.slashingKeeper.IsTombstoned(ctx, consAddr) {
+	if k.slashingKeeper.IsTombstoned(ctx, valConsAddr) {
 		logger.Info(
 			"ignored equivocation; validator already tombstoned",
 			"validator", consAddr,
@@ -137,10 +144,10 @@ func (k Keeper) handleEquivocationEvidence(ctx context.Context, evidence *types.
 		}
 	}
 
-	if err := k.slashingKeeper.JailUntil(ctx, consAddr, types.DoubleSignJailEndTime); err != nil {
+	if err := k.slashingKeeper.JailUntil(ctx, valConsAddr, types.DoubleSignJailEndTime); err != nil {
 		return err
 	}
-	if err := k.slashingKeeper.Tombstone(ctx, consAddr); err != nil {
+	if err := k.slashingKeeper.Tombstone(ctx, valConsAddr); err != nil {
 		return err
 	}
 
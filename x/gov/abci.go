@@ -89,7 +89,7 @@ func EndBlocker(ctx sdk.Context, k *keeper.Keeper) error {
 			"proposal", proposal.Id,
 			"expedited", proposal.Expedited,
 			"title", proposal.Title,
-			"min_deposit", sdk.NewCoins(proposal.GetMinDepositFromParams(params)...).String(),
+			"min_deposit", sdk.NewCoins(proposal.GetMinDepositFromParams(params.WithMessageBasedParams(proposal.MessageBasedParams))...).String(),
 			"total_deposit", sdk.NewCoins(proposal.TotalDeposit...).String(),
 		)
 
@@ -337,6 +337,7 @@ func handleTallyResult(
 		if err != nil {
 			return err
 		}
+		params = params.WithMessageBasedParams(proposal.MessageBasedParams)
 		endTime := proposal.VotingStartTime.Add(params.VotingPeriod)
 		proposal.VotingEndTime = &endTime
 
@@ -44,6 +44,9 @@ func GetQueryCmd(ac address.Codec) *cobra.Command {
 		GetCmdQueryParams(),
 		GetCmdQueryTally(),
 		GetCmdSimulateProposal(),
+		GetCmdQueryVotingLocks(),
+		GetCmdQueryEffectiveVoteDelegate(),
+		GetCmdQueryDelegatedVotingPower(),
 	)
 
 	return govQueryCmd
@@ -98,6 +101,139 @@ $ %s query gov proposals --page=2 --limit=100
 	return cmd
 }
 
+// GetCmdQueryVotingLocks implements the query voting locks command.
+func GetCmdQueryVotingLocks() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "voting-locks [owner]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query voting locks of an owner",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the conviction voting locks of an owner.
+
+Example:
+$ %s query gov voting-locks init1...
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := customtypes.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.VotingLocks(
+				cmd.Context(),
+				&customtypes.QueryVotingLocksRequest{
+					Owner:      args[0],
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "voting locks")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryEffectiveVoteDelegate implements the query effective vote delegate command.
+func GetCmdQueryEffectiveVoteDelegate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "effective-vote-delegate [delegator] [msg-type]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "Query the effective vote delegate of a delegator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the delegate at the end of the vote delegation chain of a delegator.
+The optional msg-type selects the delegations scoped to the message type.
+
+Example:
+$ %s query gov effective-vote-delegate init1...
+$ %s query gov effective-vote-delegate init1... /cosmos.upgrade.v1beta1.MsgSoftwareUpgrade
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := customtypes.NewQueryClient(clientCtx)
+
+			req := &customtypes.QueryEffectiveVoteDelegateRequest{Delegator: args[0]}
+			if len(args) > 1 {
+				req.MsgType = args[1]
+			}
+
+			res, err := queryClient.EffectiveVoteDelegate(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryDelegatedVotingPower implements the query delegated voting power command.
+func GetCmdQueryDelegatedVotingPower() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegated-voting-power [delegate] [msg-type]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "Query the voting power delegated to a delegate",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the voting power of the delegators whose vote delegation chain reaches a delegate.
+The optional msg-type selects the delegations scoped to the message type.
+
+Example:
+$ %s query gov delegated-voting-power init1...
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := customtypes.NewQueryClient(clientCtx)
+
+			req := &customtypes.QueryDelegatedVotingPowerRequest{Delegate: args[0]}
+			if len(args) > 1 {
+				req.MsgType = args[1]
+			}
+
+			res, err := queryClient.DelegatedVotingPower(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryProposal implements the query proposal command.
 func GetCmdQueryProposal() *cobra.Command {
 	cmd := &cobra.Command{
@@ -2,6 +2,7 @@ package gov
 
 import (
 	"fmt"
+	"time"
 
 	"cosmossdk.io/collections"
 
@@ -45,6 +46,34 @@ func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, k
 		totalDeposits = totalDeposits.Add(deposit.Amount...)
 	}
 
+	var totalLocks sdk.Coins
+	for _, lock := range data.VotingLocks {
+		addr, err := ak.AddressCodec().StringToBytes(lock.Owner)
+		if err != nil {
+			panic(err)
+		}
+		err = k.VotingLocks.Set(ctx, collections.Join(sdk.AccAddress(addr), lock.EndTime), lock)
+		if err != nil {
+			panic(err)
+		}
+		totalLocks = totalLocks.Add(lock.Amount...)
+	}
+
+	for _, delegation := range data.VoteDelegations {
+		delegator, err := ak.AddressCodec().StringToBytes(delegation.Delegator)
+		if err != nil {
+			panic(err)
+		}
+		delegate, err := ak.AddressCodec().StringToBytes(delegation.Delegate)
+		if err != nil {
+			panic(err)
+		}
+		err = k.DelegateVote(ctx, delegator, delegate, delegation.MsgType)
+		if err != nil {
+			panic(err)
+		}
+	}
+
 	for _, vote := range data.Votes {
 		addr, err := ak.AddressCodec().StringToBytes(vote.Voter)
 		if err != nil {
@@ -96,6 +125,17 @@ func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, k
 	if !balance.Equal(totalDeposits) {
 		panic(fmt.Sprintf("expected module account was %s but we got %s", balance.String(), totalDeposits.String()))
 	}
+
+	// check if the voting lock pool account exists
+	lockPoolAcc := k.GetVotingLockPool(ctx)
+	if lockPoolAcc == nil {
+		panic(fmt.Sprintf("%s module account has not been set", customtypes.VotingLockPoolName))
+	}
+
+	// check if total locks equals balance, if it doesn't panic because there were export/import errors
+	if lockPoolBalance := bk.GetAllBalances(ctx, lockPoolAcc.GetAddress()); !lockPoolBalance.Equal(totalLocks) {
+		panic(fmt.Sprintf("expected voting lock pool was %s but we got %s", lockPoolBalance.String(), totalLocks.String()))
+	}
 }
 
 // ExportGenesis - output genesis parameters
@@ -143,6 +183,26 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) (*customtypes.GenesisState
 		panic(err)
 	}
 
+	// export voting locks
+	var votingLocks []customtypes.VotingLock
+	err = k.VotingLocks.Walk(ctx, nil, func(_ collections.Pair[sdk.AccAddress, time.Time], value customtypes.VotingLock) (stop bool, err error) {
+		votingLocks = append(votingLocks, value)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	// export vote delegations
+	var voteDelegations []customtypes.VoteDelegation
+	err = k.VoteDelegations.Walk(ctx, nil, func(_ collections.Pair[sdk.AccAddress, string], value customtypes.VoteDelegation) (stop bool, err error) {
+		voteDelegations = append(voteDelegations, value)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
 	return &customtypes.GenesisState{
 		StartingProposalId: startingProposalID,
 		Deposits:           proposalsDeposits,
@@ -150,5 +210,7 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) (*customtypes.GenesisState
 		Proposals:          proposals,
 		Params:             &params,
 		Constitution:       constitution,
+		VotingLocks:        votingLocks,
+		VoteDelegations:    voteDelegations,
 	}, nil
 }
@@ -283,13 +283,14 @@ func _createTestInput(
 
 	moveKeeper := &movekeeper.Keeper{}
 	maccPerms := map[string][]string{ // module account permissions
-		authtypes.FeeCollectorName:      nil,
-		distributiontypes.ModuleName:    nil,
-		rewardtypes.ModuleName:          nil,
-		stakingtypes.BondedPoolName:     {authtypes.Burner, authtypes.Staking},
-		stakingtypes.NotBondedPoolName:  {authtypes.Burner, authtypes.Staking},
-		govtypes.ModuleName:             {authtypes.Burner},
-		movetypes.MoveStakingModuleName: nil,
+		authtypes.FeeCollectorName:        nil,
+		distributiontypes.ModuleName:      nil,
+		rewardtypes.ModuleName:            nil,
+		stakingtypes.BondedPoolName:       {authtypes.Burner, authtypes.Staking},
+		stakingtypes.NotBondedPoolName:    {authtypes.Burner, authtypes.Staking},
+		govtypes.ModuleName:               {authtypes.Burner},
+		customgovtypes.VotingLockPoolName: nil,
+		movetypes.MoveStakingModuleName:   nil,
 
 		// for testing
 		authtypes.Minter: {authtypes.Minter, authtypes.Burner},
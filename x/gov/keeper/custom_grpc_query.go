@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -50,6 +51,89 @@ func (q CustomQueryServer) EmergencyProposals(c context.Context, req *customtype
 	return &customtypes.QueryEmergencyProposalsResponse{Proposals: proposals, Pagination: pageRes}, nil
 }
 
+// VotingLocks implements the Query/VotingLocks gRPC method
+func (q CustomQueryServer) VotingLocks(ctx context.Context, req *customtypes.QueryVotingLocksRequest) (*customtypes.QueryVotingLocksResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	owner, err := q.Keeper.authKeeper.AddressCodec().StringToBytes(req.Owner)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	locks, pageRes, err := query.CollectionPaginate(ctx, q.Keeper.VotingLocks, req.Pagination, func(_ collections.Pair[sdk.AccAddress, time.Time], lock customtypes.VotingLock) (customtypes.VotingLock, error) {
+		return lock, nil
+	}, query.WithCollectionPaginationPairPrefix[sdk.AccAddress, time.Time](owner))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &customtypes.QueryVotingLocksResponse{VotingLocks: locks, Pagination: pageRes}, nil
+}
+
+// EffectiveVoteDelegate implements the Query/EffectiveVoteDelegate gRPC method
+func (q CustomQueryServer) EffectiveVoteDelegate(ctx context.Context, req *customtypes.QueryEffectiveVoteDelegateRequest) (*customtypes.QueryEffectiveVoteDelegateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	delegator, err := q.Keeper.authKeeper.AddressCodec().StringToBytes(req.Delegator)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	delegate, chain, err := q.Keeper.GetEffectiveVoteDelegate(ctx, delegator, req.MsgType)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := &customtypes.QueryEffectiveVoteDelegateResponse{}
+	if delegate != nil {
+		res.Delegate, err = q.Keeper.authKeeper.AddressCodec().BytesToString(delegate)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+	for _, addr := range chain {
+		addrStr, err := q.Keeper.authKeeper.AddressCodec().BytesToString(addr)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		res.Chain = append(res.Chain, addrStr)
+	}
+
+	return res, nil
+}
+
+// DelegatedVotingPower implements the Query/DelegatedVotingPower gRPC method
+func (q CustomQueryServer) DelegatedVotingPower(ctx context.Context, req *customtypes.QueryDelegatedVotingPowerRequest) (*customtypes.QueryDelegatedVotingPowerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	delegate, err := q.Keeper.authKeeper.AddressCodec().StringToBytes(req.Delegate)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	votingPower, delegators, err := q.Keeper.GetDelegatedVotingPower(ctx, delegate, req.MsgType)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := &customtypes.QueryDelegatedVotingPowerResponse{VotingPower: votingPower.String()}
+	for _, addr := range delegators {
+		addrStr, err := q.Keeper.authKeeper.AddressCodec().BytesToString(addr)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		res.Delegators = append(res.Delegators, addrStr)
+	}
+
+	return res, nil
+}
+
 // Proposal returns proposal details based on ProposalID
 func (q CustomQueryServer) Proposal(ctx context.Context, req *customtypes.QueryProposalRequest) (*customtypes.QueryProposalResponse, error) {
 	if req == nil {
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"slices"
+	"time"
 
 	"cosmossdk.io/errors"
 
@@ -139,3 +140,108 @@ func (k customMsgServer) ActivateEmergencyProposal(ctx context.Context, req *cus
 
 	return &customtypes.MsgActivateEmergencyProposalResponse{}, nil
 }
+
+// LockTokens locks the tokens to get the conviction voting power.
+func (k customMsgServer) LockTokens(ctx context.Context, req *customtypes.MsgLockTokens) (*customtypes.MsgLockTokensResponse, error) {
+	owner, err := k.authKeeper.AddressCodec().StringToBytes(req.Owner)
+	if err != nil {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid owner: %s", req.Owner)
+	}
+
+	if !req.Amount.IsValid() || !req.Amount.IsAllPositive() {
+		return nil, sdkerrors.ErrInvalidCoins.Wrap(req.Amount.String())
+	}
+
+	endTime, err := k.Keeper.LockTokens(ctx, owner, req.Amount, req.LockDuration)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			customtypes.EventTypeLockTokens,
+			sdk.NewAttribute(customtypes.AttributeKeyOwner, req.Owner),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, req.Amount.String()),
+			sdk.NewAttribute(customtypes.AttributeKeyEndTime, endTime.Format(time.RFC3339)),
+		),
+	)
+
+	return &customtypes.MsgLockTokensResponse{EndTime: endTime}, nil
+}
+
+// UnlockTokens unlocks the tokens of the expired voting locks.
+func (k customMsgServer) UnlockTokens(ctx context.Context, req *customtypes.MsgUnlockTokens) (*customtypes.MsgUnlockTokensResponse, error) {
+	owner, err := k.authKeeper.AddressCodec().StringToBytes(req.Owner)
+	if err != nil {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid owner: %s", req.Owner)
+	}
+
+	amount, err := k.Keeper.UnlockTokens(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			customtypes.EventTypeUnlockTokens,
+			sdk.NewAttribute(customtypes.AttributeKeyOwner, req.Owner),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return &customtypes.MsgUnlockTokensResponse{Amount: amount}, nil
+}
+
+// DelegateVote delegates the governance vote of the delegator to the delegate.
+func (k customMsgServer) DelegateVote(ctx context.Context, req *customtypes.MsgDelegateVote) (*customtypes.MsgDelegateVoteResponse, error) {
+	delegator, err := k.authKeeper.AddressCodec().StringToBytes(req.Delegator)
+	if err != nil {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid delegator: %s", req.Delegator)
+	}
+
+	delegate, err := k.authKeeper.AddressCodec().StringToBytes(req.Delegate)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegate: %s", req.Delegate)
+	}
+
+	if err := k.Keeper.DelegateVote(ctx, delegator, delegate, req.MsgType); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			customtypes.EventTypeDelegateVote,
+			sdk.NewAttribute(customtypes.AttributeKeyDelegator, req.Delegator),
+			sdk.NewAttribute(customtypes.AttributeKeyDelegate, req.Delegate),
+			sdk.NewAttribute(customtypes.AttributeKeyMsgType, req.MsgType),
+		),
+	)
+
+	return &customtypes.MsgDelegateVoteResponse{}, nil
+}
+
+// UndelegateVote removes the vote delegation of the delegator.
+func (k customMsgServer) UndelegateVote(ctx context.Context, req *customtypes.MsgUndelegateVote) (*customtypes.MsgUndelegateVoteResponse, error) {
+	delegator, err := k.authKeeper.AddressCodec().StringToBytes(req.Delegator)
+	if err != nil {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid delegator: %s", req.Delegator)
+	}
+
+	if err := k.Keeper.UndelegateVote(ctx, delegator, req.MsgType); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			customtypes.EventTypeUndelegateVote,
+			sdk.NewAttribute(customtypes.AttributeKeyDelegator, req.Delegator),
+			sdk.NewAttribute(customtypes.AttributeKeyMsgType, req.MsgType),
+		),
+	)
+
+	return &customtypes.MsgUndelegateVoteResponse{}, nil
+}
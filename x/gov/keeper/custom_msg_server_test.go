@@ -4,6 +4,8 @@ import (
 	"testing"
 	"time"
 
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -227,3 +229,64 @@ func Test_CustomMsgServer_ActivateEmergencyProposal(t *testing.T) {
 	})
 	require.Error(t, err)
 }
+
+func Test_CustomMsgServer_LockTokens(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	ms := keeper.NewCustomMsgServerImpl(&input.GovKeeper)
+
+	now := time.Now().UTC()
+	ctx = ctx.WithBlockTime(now)
+
+	amount := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1_000_000))
+	owner := input.Faucet.NewFundedAccount(ctx, amount...)
+
+	// conviction voting is not enabled
+	_, err := ms.LockTokens(ctx, &types.MsgLockTokens{
+		Owner:        owner.String(),
+		Amount:       amount,
+		LockDuration: time.Hour,
+	})
+	require.Error(t, err)
+
+	params, err := input.GovKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	convictionVoting := types.NewConvictionVoting(math.LegacyNewDec(3), time.Hour*24)
+	params.ConvictionVoting = &convictionVoting
+	require.NoError(t, input.GovKeeper.Params.Set(ctx, params))
+
+	// exceeds the max lock duration
+	_, err = ms.LockTokens(ctx, &types.MsgLockTokens{
+		Owner:        owner.String(),
+		Amount:       amount,
+		LockDuration: time.Hour * 25,
+	})
+	require.Error(t, err)
+
+	res, err := ms.LockTokens(ctx, &types.MsgLockTokens{
+		Owner:        owner.String(),
+		Amount:       amount,
+		LockDuration: time.Hour,
+	})
+	require.NoError(t, err)
+	require.Equal(t, now.Add(time.Hour), res.EndTime)
+	require.True(t, input.BankKeeper.GetBalance(ctx, owner, bondDenom).IsZero())
+	require.Equal(t, amount, input.BankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.VotingLockPoolName)))
+
+	lock, err := input.GovKeeper.VotingLocks.Get(ctx, collections.Join(owner, res.EndTime))
+	require.NoError(t, err)
+	require.Equal(t, amount, lock.Amount)
+
+	// the lock is not expired yet
+	unlockRes, err := ms.UnlockTokens(ctx, &types.MsgUnlockTokens{Owner: owner.String()})
+	require.NoError(t, err)
+	require.True(t, unlockRes.Amount.IsZero())
+
+	ctx = ctx.WithBlockTime(res.EndTime)
+	unlockRes, err = ms.UnlockTokens(ctx, &types.MsgUnlockTokens{Owner: owner.String()})
+	require.NoError(t, err)
+	require.Equal(t, amount, unlockRes.Amount)
+	require.Equal(t, amount, sdk.NewCoins(input.BankKeeper.GetBalance(ctx, owner, bondDenom)))
+
+	_, err = input.GovKeeper.VotingLocks.Get(ctx, collections.Join(owner, res.EndTime))
+	require.ErrorIs(t, err, collections.ErrNotFound)
+}
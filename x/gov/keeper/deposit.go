@@ -80,6 +80,7 @@ func (k Keeper) AddDeposit(ctx context.Context, proposalID uint64, depositorAddr
 	if err != nil {
 		return false, err
 	}
+	params = params.WithMessageBasedParams(proposal.MessageBasedParams)
 
 	minDepositAmount := proposal.GetMinDepositFromParams(params)
 	minDepositRatio, err := sdkmath.LegacyNewDecFromStr(params.GetMinDepositRatio())
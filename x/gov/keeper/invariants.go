@@ -4,17 +4,21 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	"cosmossdk.io/collections"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	customtypes "github.com/initia-labs/initia/x/gov/types"
 )
 
 // RegisterInvariants registers all governance invariants
 func RegisterInvariants(ir sdk.InvariantRegistry, keeper *Keeper, bk types.BankKeeper) {
 	ir.RegisterRoute(types.ModuleName, "module-account", ModuleAccountInvariant(keeper, bk))
+	ir.RegisterRoute(types.ModuleName, "voting-lock-pool", VotingLockPoolInvariant(keeper, bk))
 }
 
 // ModuleAccountInvariant checks that the module account coins reflects the sum of
@@ -44,3 +48,29 @@ func ModuleAccountInvariant(keeper *Keeper, bk types.BankKeeper) sdk.Invariant {
 				balances, expectedDeposits)), broken
 	}
 }
+
+// VotingLockPoolInvariant checks that the voting lock pool coins reflects the sum of
+// voting lock amounts held on store
+func VotingLockPoolInvariant(keeper *Keeper, bk types.BankKeeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var expectedLocks sdk.Coins
+
+		err := keeper.VotingLocks.Walk(ctx, nil, func(_ collections.Pair[sdk.AccAddress, time.Time], value customtypes.VotingLock) (stop bool, err error) {
+			expectedLocks = expectedLocks.Add(value.Amount...)
+			return false, nil
+		})
+		if err != nil {
+			panic(err)
+		}
+
+		balances := bk.GetAllBalances(ctx, keeper.GetVotingLockPool(ctx).GetAddress())
+
+		// Require that the lock balances are <= than the voting lock pool's total
+		// balances, since external funds can be sent to the pool.
+		broken := !balances.IsAllGTE(expectedLocks)
+
+		return sdk.FormatInvariant(types.ModuleName, "voting locks",
+			fmt.Sprintf("\tvoting lock pool coins: %s\n\tsum of voting lock amounts:  %s\n",
+				balances, expectedLocks)), broken
+	}
+}
@@ -62,6 +62,9 @@ type Keeper struct {
 	EmergencyProposalsQueue collections.Map[collections.Pair[time.Time, uint64], uint64]
 	VotingPeriodProposals   collections.Map[uint64, []byte] // TODO(tip): this could be a keyset or index.
 	EmergencyProposals      collections.Map[uint64, []byte]
+	VotingLocks             collections.Map[collections.Pair[sdk.AccAddress, time.Time], customtypes.VotingLock]
+	VoteDelegations         collections.Map[collections.Pair[sdk.AccAddress, string], customtypes.VoteDelegation]
+	VoteDelegators          collections.KeySet[collections.Triple[sdk.AccAddress, string, sdk.AccAddress]] // delegate, msg type, delegator
 }
 
 // NewKeeper returns a governance keeper. It handles:
@@ -113,6 +116,9 @@ func NewKeeper(
 		EmergencyProposalsQueue: collections.NewMap(sb, customtypes.EmergencyProposalQueuePrefix, "emergency_proposals_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key), collections.Uint64Value),
 		VotingPeriodProposals:   collections.NewMap(sb, types.VotingPeriodProposalKeyPrefix, "voting_period_proposals", collections.Uint64Key, collections.BytesValue),
 		EmergencyProposals:      collections.NewMap(sb, customtypes.EmergencyProposalsPrefix, "emergency_proposals", collections.Uint64Key, collections.BytesValue),
+		VotingLocks:             collections.NewMap(sb, customtypes.VotingLocksPrefix, "voting_locks", collections.PairKeyCodec(sdk.AccAddressKey, sdk.TimeKey), codec.CollValue[customtypes.VotingLock](cdc)),
+		VoteDelegations:         collections.NewMap(sb, customtypes.VoteDelegationsPrefix, "vote_delegations", collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), codec.CollValue[customtypes.VoteDelegation](cdc)),
+		VoteDelegators:          collections.NewKeySet(sb, customtypes.VoteDelegatorsPrefix, "vote_delegators", collections.TripleKeyCodec(sdk.AccAddressKey, collections.StringKey, sdk.AccAddressKey)),
 	}
 	schema, err := sb.Build()
 	if err != nil {
@@ -173,6 +179,11 @@ func (k Keeper) GetGovernanceAccount(ctx context.Context) sdk.ModuleAccountI {
 	return k.authKeeper.GetModuleAccount(ctx, types.ModuleName)
 }
 
+// GetVotingLockPool returns the voting lock pool ModuleAccount
+func (k Keeper) GetVotingLockPool(ctx context.Context) sdk.ModuleAccountI {
+	return k.authKeeper.GetModuleAccount(ctx, customtypes.VotingLockPoolName)
+}
+
 // ModuleAccountAddress returns gov module account address
 func (k Keeper) ModuleAccountAddress() sdk.AccAddress {
 	return k.authKeeper.GetModuleAddress(types.ModuleName)
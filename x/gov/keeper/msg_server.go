@@ -77,6 +77,7 @@ func (k msgServer) SubmitProposal(goCtx context.Context, msg *v1.MsgSubmitPropos
 	if err != nil {
 		return nil, fmt.Errorf("failed to get governance parameters: %w", err)
 	}
+	params = params.WithMessageBasedParams(GetProposalMessageBasedParams(params, proposalMsgs))
 
 	if err := validateInitialDeposit(params, initialDeposit, msg.Expedited); err != nil {
 		return nil, err
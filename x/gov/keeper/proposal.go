@@ -113,6 +113,9 @@ func (k Keeper) SubmitProposal(ctx context.Context, messages []sdk.Msg, metadata
 		return customtypes.Proposal{}, err
 	}
 
+	// resolve the message based params, so the later param changes do not affect the proposal
+	proposal.MessageBasedParams = GetProposalMessageBasedParams(params, messages)
+
 	err = k.SetProposal(ctx, proposal)
 	if err != nil {
 		return customtypes.Proposal{}, err
@@ -267,6 +270,7 @@ func (k Keeper) ActivateVotingPeriod(ctx context.Context, proposal customtypes.P
 	if err != nil {
 		return err
 	}
+	params = params.WithMessageBasedParams(proposal.MessageBasedParams)
 
 	if proposal.Expedited {
 		votingPeriod = params.ExpeditedVotingPeriod
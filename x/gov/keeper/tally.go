@@ -25,6 +25,9 @@ func (k Keeper) Tally(ctx context.Context, params customtypes.Params, proposal c
 		return false, false, false, tallyResults, err
 	}
 
+	// apply the message based params resolved at the proposal submission
+	params = params.WithMessageBasedParams(proposal.MessageBasedParams)
+
 	results := make(map[v1.VoteOption]math.LegacyDec)
 	results[v1.OptionYes] = math.LegacyZeroDec()
 	results[v1.OptionAbstain] = math.LegacyZeroDec()
@@ -32,63 +35,27 @@ func (k Keeper) Tally(ctx context.Context, params customtypes.Params, proposal c
 	results[v1.OptionNoWithVeto] = math.LegacyZeroDec()
 
 	totalVotingPower := math.LegacyZeroDec()
-	totalStakingPower := math.ZeroInt()
 	totalVestingPower := math.ZeroInt()
-	currValidators := make(map[string]customtypes.ValidatorGovInfo)
-
-	// fetch all the bonded validators, insert them into currValidators
-	err = k.sk.IterateBondedValidatorsByPower(ctx, func(validator stakingtypes.ValidatorI) (stop bool, err error) {
-		valAddr, err := k.sk.ValidatorAddressCodec().StringToBytes(validator.GetOperator())
-		if err != nil {
-			return false, err
-		}
+	totalLockPower := math.ZeroInt()
+	totalConvictionPower := math.LegacyZeroDec()
 
-		currValidators[validator.GetOperator()] = customtypes.NewValidatorGovInfo(
-			valAddr,
-			validator.GetBondedTokens(),
-			validator.GetDelegatorShares(),
-			sdk.NewDecCoins(),
-			v1.WeightedVoteOptions{},
-		)
-
-		votingPower, _ := stakingtypes.CalculateVotingPower(validator.GetBondedTokens(), weights)
-		totalStakingPower = totalStakingPower.Add(votingPower)
-
-		return false, nil
-	})
+	// fetch all the bonded validators
+	currValidators, totalStakingPower, err := k.getCurrValidators(ctx, weights)
 	if err != nil {
 		return false, false, false, tallyResults, err
 	}
 
 	// fetch vesting table handle if vesting params are provided
-	var vestingHandle *sdk.AccAddress
-	if params.Vesting != nil {
-		vesting := *params.Vesting
-		moduleAddr, err := k.authKeeper.AddressCodec().StringToBytes(vesting.ModuleAddr)
-		if err != nil {
-			return false, false, false, tallyResults, err
-		}
-
-		creatorAddr, err := k.authKeeper.AddressCodec().StringToBytes(vesting.CreatorAddr)
-		if err != nil {
-			return false, false, false, tallyResults, err
-		}
-
-		// base denom validation will be done in vesting keeper
-		vestingHandle, err = k.vestingKeeper.GetVestingHandle(ctx, moduleAddr, vesting.ModuleName, creatorAddr)
-		if err != nil {
-			return false, false, false, tallyResults, err
-		}
+	vestingHandle, err := k.getVestingHandle(ctx, params)
+	if err != nil {
+		return false, false, false, tallyResults, err
 	}
 
-	rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposal.Id)
-	err = k.Votes.Walk(ctx, rng, func(key collections.Pair[uint64, sdk.AccAddress], vote v1.Vote) (bool, error) {
+	tallyVote := func(voter sdk.AccAddress, options v1.WeightedVoteOptions) error {
 		// if validator, just record it in the map
-		voter := sdk.MustAccAddressFromBech32(vote.Voter)
-
 		valAddrStr := sdk.ValAddress(voter.Bytes()).String()
 		if val, ok := currValidators[valAddrStr]; ok {
-			val.Vote = vote.Options
+			val.Vote = options
 			currValidators[valAddrStr] = val
 		}
 
@@ -96,14 +63,14 @@ func (k Keeper) Tally(ctx context.Context, params customtypes.Params, proposal c
 		if vestingHandle != nil {
 			amount, err := k.vestingKeeper.GetUnclaimedVestedAmount(ctx, *vestingHandle, voter)
 			if err != nil {
-				return false, err
+				return err
 			}
 
 			if !amount.IsZero() {
 				// `the vesting token == the base denom`` check is done in vesting keeper,
 				// so we can safely convert the amount to voting power
 				votingPower := math.LegacyNewDecFromInt(amount)
-				for _, option := range vote.Options {
+				for _, option := range options {
 					subPower := votingPower.Mul(math.LegacyMustNewDecFromStr(option.Weight))
 					results[option.Option] = results[option.Option].Add(subPower)
 				}
@@ -112,8 +79,24 @@ func (k Keeper) Tally(ctx context.Context, params customtypes.Params, proposal c
 			}
 		}
 
+		// add conviction weighted voting power of the locked tokens
+		lockPower, convictionPower, err := k.GetLockVotingPower(ctx, params.ConvictionVoting, weights, voter)
+		if err != nil {
+			return err
+		}
+
+		if !lockPower.IsZero() {
+			for _, option := range options {
+				subPower := convictionPower.Mul(math.LegacyMustNewDecFromStr(option.Weight))
+				results[option.Option] = results[option.Option].Add(subPower)
+			}
+			totalVotingPower = totalVotingPower.Add(convictionPower)
+			totalLockPower = totalLockPower.Add(lockPower)
+			totalConvictionPower = totalConvictionPower.Add(convictionPower)
+		}
+
 		// iterate over all delegations from voter, deduct from any delegated-to validators
-		err = k.sk.IterateDelegations(ctx, voter, func(delegation stakingtypes.DelegationI) (stop bool, err error) {
+		return k.sk.IterateDelegations(ctx, voter, func(delegation stakingtypes.DelegationI) (stop bool, err error) {
 			valAddrStr := delegation.GetValidatorAddr()
 
 			if val, ok := currValidators[valAddrStr]; ok {
@@ -122,18 +105,8 @@ func (k Keeper) Tally(ctx context.Context, params customtypes.Params, proposal c
 				val.DelegatorDeductions = val.DelegatorDeductions.Add(delegation.GetShares()...)
 				currValidators[valAddrStr] = val
 
-				// votingPower = delegation shares * bonded / total shares * denom weight
-				votingPower := math.LegacyZeroDec()
-				for _, share := range delegation.GetShares() {
-					votingPower = votingPower.Add(
-						share.Amount.
-							MulInt(val.BondedTokens.AmountOf(share.Denom)).
-							Quo(val.DelegatorShares.AmountOf(share.Denom)).
-							Mul(weights.AmountOf(share.Denom)),
-					)
-				}
-
-				for _, option := range vote.Options {
+				votingPower := delegationVotingPower(val, delegation.GetShares(), weights)
+				for _, option := range options {
 					subPower := votingPower.Mul(math.LegacyMustNewDecFromStr(option.Weight))
 					results[option.Option] = results[option.Option].Add(subPower)
 				}
@@ -142,7 +115,17 @@ func (k Keeper) Tally(ctx context.Context, params customtypes.Params, proposal c
 
 			return false, nil
 		})
-		if err != nil {
+	}
+
+	var voters []sdk.AccAddress
+	directVotes := make(map[string]v1.WeightedVoteOptions)
+	rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposal.Id)
+	err = k.Votes.Walk(ctx, rng, func(key collections.Pair[uint64, sdk.AccAddress], vote v1.Vote) (bool, error) {
+		voter := sdk.MustAccAddressFromBech32(vote.Voter)
+		voters = append(voters, voter)
+		directVotes[voter.String()] = vote.Options
+
+		if err := tallyVote(voter, vote.Options); err != nil {
 			return false, err
 		}
 
@@ -152,6 +135,15 @@ func (k Keeper) Tally(ctx context.Context, params customtypes.Params, proposal c
 		return false, false, false, tallyResults, err
 	}
 
+	// tally the votes of the accounts who delegated their vote and did not vote directly,
+	// with the vote of the first voter in their delegation chain
+	err = k.walkVoteDelegators(ctx, proposalVoteTopic(proposal), voters, func(delegator, voter sdk.AccAddress) error {
+		return tallyVote(delegator, directVotes[voter.String()])
+	})
+	if err != nil {
+		return false, false, false, tallyResults, err
+	}
+
 	// iterate over the validators again to tally their voting power
 	for _, val := range currValidators {
 		if len(val.Vote) == 0 {
@@ -159,15 +151,7 @@ func (k Keeper) Tally(ctx context.Context, params customtypes.Params, proposal c
 		}
 
 		sharesAfterDeductions := val.DelegatorShares.Sub(val.DelegatorDeductions)
-		votingPower := math.LegacyZeroDec()
-		for _, share := range sharesAfterDeductions {
-			votingPower = votingPower.Add(
-				share.Amount.
-					MulInt(val.BondedTokens.AmountOf(share.Denom)).
-					Quo(val.DelegatorShares.AmountOf(share.Denom)).
-					Mul(weights.AmountOf(share.Denom)),
-			)
-		}
+		votingPower := delegationVotingPower(val, sharesAfterDeductions, weights)
 
 		for _, option := range val.Vote {
 			subPower := votingPower.Mul(math.LegacyMustNewDecFromStr(option.Weight))
@@ -178,20 +162,24 @@ func (k Keeper) Tally(ctx context.Context, params customtypes.Params, proposal c
 
 	v1TallyResult := v1.NewTallyResultFromMap(results)
 	tallyResults = customtypes.TallyResult{
-		V1TallyResult:     &v1TallyResult,
-		TallyHeight:       uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()), //nolint: gosec
-		TotalStakingPower: totalStakingPower.String(),
-		TotalVestingPower: totalVestingPower.String(),
+		V1TallyResult:        &v1TallyResult,
+		TallyHeight:          uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()), //nolint: gosec
+		TotalStakingPower:    totalStakingPower.String(),
+		TotalVestingPower:    totalVestingPower.String(),
+		TotalLockPower:       totalLockPower.String(),
+		TotalConvictionPower: totalConvictionPower.String(),
 	}
 
-	// If there is no (staked + vesting) coins, the proposal fails
-	totalPower := totalVestingPower.Add(totalStakingPower)
+	// If there is no (staked + vesting + locked) coins, the proposal fails
+	totalPower := totalVestingPower.Add(totalStakingPower).Add(totalLockPower)
 	if totalPower.IsZero() {
 		return false, false, false, tallyResults, nil
 	}
 
-	// If there is not enough quorum of votes, the proposal fails
-	percentVoting := totalVotingPower.Quo(math.LegacyNewDecFromInt(totalPower))
+	// If there is not enough quorum of votes, the proposal fails.
+	// The quorum is computed without the conviction multiplier.
+	quorumVotingPower := totalVotingPower.Sub(totalConvictionPower).Add(math.LegacyNewDecFromInt(totalLockPower))
+	percentVoting := quorumVotingPower.Quo(math.LegacyNewDecFromInt(totalPower))
 	quorum, _ := math.LegacyNewDecFromStr(params.Quorum)
 	if percentVoting.LT(quorum) {
 		return false, false, params.BurnVoteQuorum, tallyResults, nil
@@ -227,6 +215,71 @@ func (k Keeper) Tally(ctx context.Context, params customtypes.Params, proposal c
 	return true, false, false, tallyResults, nil
 }
 
+// getCurrValidators returns all the bonded validators with their total staking voting power.
+func (k Keeper) getCurrValidators(ctx context.Context, weights sdk.DecCoins) (map[string]customtypes.ValidatorGovInfo, math.Int, error) {
+	totalStakingPower := math.ZeroInt()
+	currValidators := make(map[string]customtypes.ValidatorGovInfo)
+	err := k.sk.IterateBondedValidatorsByPower(ctx, func(validator stakingtypes.ValidatorI) (stop bool, err error) {
+		valAddr, err := k.sk.ValidatorAddressCodec().StringToBytes(validator.GetOperator())
+		if err != nil {
+			return false, err
+		}
+
+		currValidators[validator.GetOperator()] = customtypes.NewValidatorGovInfo(
+			valAddr,
+			validator.GetBondedTokens(),
+			validator.GetDelegatorShares(),
+			sdk.NewDecCoins(),
+			v1.WeightedVoteOptions{},
+		)
+
+		votingPower, _ := stakingtypes.CalculateVotingPower(validator.GetBondedTokens(), weights)
+		totalStakingPower = totalStakingPower.Add(votingPower)
+
+		return false, nil
+	})
+
+	return currValidators, totalStakingPower, err
+}
+
+// getVestingHandle returns the vesting table handle if vesting params are provided.
+func (k Keeper) getVestingHandle(ctx context.Context, params customtypes.Params) (*sdk.AccAddress, error) {
+	if params.Vesting == nil {
+		return nil, nil
+	}
+
+	vesting := *params.Vesting
+	moduleAddr, err := k.authKeeper.AddressCodec().StringToBytes(vesting.ModuleAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	creatorAddr, err := k.authKeeper.AddressCodec().StringToBytes(vesting.CreatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	// base denom validation will be done in vesting keeper
+	return k.vestingKeeper.GetVestingHandle(ctx, moduleAddr, vesting.ModuleName, creatorAddr)
+}
+
+// delegationVotingPower returns the voting power of the shares of the validator.
+//
+// votingPower = delegation shares * bonded / total shares * denom weight
+func delegationVotingPower(val customtypes.ValidatorGovInfo, shares sdk.DecCoins, weights sdk.DecCoins) math.LegacyDec {
+	votingPower := math.LegacyZeroDec()
+	for _, share := range shares {
+		votingPower = votingPower.Add(
+			share.Amount.
+				MulInt(val.BondedTokens.AmountOf(share.Denom)).
+				Quo(val.DelegatorShares.AmountOf(share.Denom)).
+				Mul(weights.AmountOf(share.Denom)),
+		)
+	}
+
+	return votingPower
+}
+
 // IsLowThresholdProposal checks if the proposal is a low threshold proposal
 func IsLowThresholdProposal(params customtypes.Params, proposal customtypes.Proposal) bool {
 	messages, err := proposal.GetMsgs()
@@ -235,14 +288,8 @@ func IsLowThresholdProposal(params customtypes.Params, proposal customtypes.Prop
 	}
 
 	for _, msg := range messages {
-
-		var fid string
-		switch msg := msg.(type) {
-		case *movetypes.MsgExecute:
-			fid = fmt.Sprintf("%s::%s::%s", msg.ModuleAddress, msg.ModuleName, msg.FunctionName)
-		case *movetypes.MsgExecuteJSON:
-			fid = fmt.Sprintf("%s::%s::%s", msg.ModuleAddress, msg.ModuleName, msg.FunctionName)
-		default:
+		fid, ok := moveFunctionIdentifier(msg)
+		if !ok {
 			return false
 		}
 
@@ -253,3 +300,56 @@ func IsLowThresholdProposal(params customtypes.Params, proposal customtypes.Prop
 
 	return true
 }
+
+// moveFunctionIdentifier returns the Move function identifier of the message,
+// if the message is a Move execute message.
+func moveFunctionIdentifier(msg sdk.Msg) (string, bool) {
+	switch msg := msg.(type) {
+	case *movetypes.MsgExecute:
+		return fmt.Sprintf("%s::%s::%s", msg.ModuleAddress, msg.ModuleName, msg.FunctionName), true
+	case *movetypes.MsgExecuteJSON:
+		return fmt.Sprintf("%s::%s::%s", msg.ModuleAddress, msg.ModuleName, msg.FunctionName), true
+	default:
+		return "", false
+	}
+}
+
+// GetProposalMessageBasedParams returns the strictest message based params among the
+// messages. The global params are applied to the messages without overrides, and nil
+// is returned if none of the messages has an override.
+func GetProposalMessageBasedParams(params customtypes.Params, messages []sdk.Msg) *customtypes.MessageBasedParams {
+	var strictest *customtypes.MessageBasedParams
+	found := false
+	for _, msg := range messages {
+		m, ok := getMessageBasedParams(params, msg)
+		found = found || ok
+
+		if strictest != nil {
+			m = strictest.Strictest(m)
+		}
+		strictest = &m
+	}
+
+	if !found {
+		return nil
+	}
+
+	return strictest
+}
+
+// getMessageBasedParams returns the message based params of the message, looked up
+// by the Move function identifier first and then by the type URL. If there is no
+// override, the global params are returned with false.
+func getMessageBasedParams(params customtypes.Params, msg sdk.Msg) (customtypes.MessageBasedParams, bool) {
+	if fid, ok := moveFunctionIdentifier(msg); ok {
+		if m, found := params.FindMessageBasedParams(fid); found {
+			return m, true
+		}
+	}
+
+	if m, found := params.FindMessageBasedParams(sdk.MsgTypeURL(msg)); found {
+		return m, true
+	}
+
+	return params.DefaultMessageBasedParams(), false
+}
@@ -178,3 +178,170 @@ func Test_Tally(t *testing.T) {
 	require.Equal(t, tallyResults.V1TallyResult.YesCount, math.LegacyNewDec(1_500_000+100_000_000).TruncateInt().String())
 	require.Equal(t, tallyResults.V1TallyResult.NoCount, math.LegacyNewDec(100_000_000).TruncateInt().String())
 }
+
+func Test_Tally_ConvictionVoting(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	now := time.Now().UTC()
+	ctx = ctx.WithBlockTime(now)
+
+	params, err := input.GovKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	convictionVoting := customtypes.NewConvictionVoting(math.LegacyNewDec(3), time.Hour*24)
+	params.ConvictionVoting = &convictionVoting
+	require.NoError(t, input.GovKeeper.Params.Set(ctx, params))
+
+	proposal, err := input.GovKeeper.SubmitProposal(ctx, nil, "", "test", "description", addrs[0], false)
+	require.NoError(t, err)
+
+	proposalID := proposal.Id
+	proposal.Status = v1.StatusVotingPeriod
+	err = input.GovKeeper.SetProposal(ctx, proposal)
+	require.NoError(t, err)
+
+	valAddr1 := createValidatorWithCoin(ctx, input,
+		sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100_000_000)),
+		sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100_000_000)),
+		1,
+	)
+	valAddr2 := createValidatorWithCoin(ctx, input,
+		sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100_000_000)),
+		sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100_000_000)),
+		2,
+	)
+
+	// lock 20_000_000 for the max lock duration and 20_000_000 for the half
+	lockAmount := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 20_000_000))
+	lockVoter := input.Faucet.NewFundedAccount(ctx, lockAmount.Add(lockAmount...)...)
+	_, err = input.GovKeeper.LockTokens(ctx, lockVoter, lockAmount, time.Hour*24)
+	require.NoError(t, err)
+	_, err = input.GovKeeper.LockTokens(ctx, lockVoter, lockAmount, time.Hour*12)
+	require.NoError(t, err)
+
+	for voter, option := range map[string]v1.VoteOption{
+		sdk.AccAddress(valAddr1).String(): v1.OptionYes,
+		sdk.AccAddress(valAddr2).String(): v1.OptionNo,
+		lockVoter.String():                v1.OptionYes,
+	} {
+		err = input.GovKeeper.AddVote(ctx, proposalID, sdk.MustAccAddressFromBech32(voter), v1.NewNonSplitVoteOption(option), "")
+		require.NoError(t, err)
+	}
+
+	quorumReached, passed, _, tallyResults, err := input.GovKeeper.Tally(ctx, params, proposal)
+	require.NoError(t, err)
+	require.True(t, quorumReached)
+	require.True(t, passed)
+
+	// 20_000_000 * 3 + 20_000_000 * 2
+	require.Equal(t, math.NewInt(40_000_000).String(), tallyResults.TotalLockPower)
+	require.Equal(t, math.LegacyNewDec(100_000_000).String(), tallyResults.TotalConvictionPower)
+	require.Equal(t, math.NewInt(200_000_000).String(), tallyResults.V1TallyResult.YesCount)
+	require.Equal(t, math.NewInt(100_000_000).String(), tallyResults.V1TallyResult.NoCount)
+}
+
+func Test_GetProposalMessageBasedParams(t *testing.T) {
+	params := customtypes.DefaultParams()
+
+	fidOverride := customtypes.NewMessageBasedParams("0x1::vip::register_snapshot", time.Hour, "0.1", "0.4", "0.4", params.MinDeposit)
+	urlOverride := customtypes.NewMessageBasedParams(sdk.MsgTypeURL(&movetypes.MsgExecute{}), params.VotingPeriod*2, "0.5", "0.8", "0.2", params.ExpeditedMinDeposit)
+	params.MessageBasedParams = []customtypes.MessageBasedParams{fidOverride, urlOverride}
+
+	// no override
+	require.Nil(t, keeper.GetProposalMessageBasedParams(params, []sdk.Msg{&movetypes.MsgScript{}}))
+
+	// the function identifier takes precedence over the type URL
+	m := keeper.GetProposalMessageBasedParams(params, []sdk.Msg{
+		&movetypes.MsgExecute{ModuleAddress: "0x1", ModuleName: "vip", FunctionName: "register_snapshot"},
+	})
+	require.Equal(t, &fidOverride, m)
+
+	// the strictest among the messages, including the global params of
+	// the messages without override
+	m = keeper.GetProposalMessageBasedParams(params, []sdk.Msg{
+		&movetypes.MsgExecute{ModuleAddress: "0x1", ModuleName: "vip", FunctionName: "register_snapshot"},
+		&movetypes.MsgScript{},
+	})
+	require.Equal(t, params.VotingPeriod, m.VotingPeriod)
+	require.Equal(t, math.LegacyMustNewDecFromStr(params.Quorum), math.LegacyMustNewDecFromStr(m.Quorum))
+	require.Equal(t, math.LegacyMustNewDecFromStr(params.Threshold), math.LegacyMustNewDecFromStr(m.Threshold))
+	require.Equal(t, math.LegacyMustNewDecFromStr(params.VetoThreshold), math.LegacyMustNewDecFromStr(m.VetoThreshold))
+
+	m = keeper.GetProposalMessageBasedParams(params, []sdk.Msg{
+		&movetypes.MsgExecute{ModuleAddress: "0x1", ModuleName: "vip", FunctionName: "register_snapshot"},
+		&movetypes.MsgExecute{ModuleAddress: "0x1", ModuleName: "coin", FunctionName: "transfer"},
+	})
+	require.Equal(t, urlOverride.VotingPeriod, m.VotingPeriod)
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.5"), math.LegacyMustNewDecFromStr(m.Quorum))
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.8"), math.LegacyMustNewDecFromStr(m.Threshold))
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.2"), math.LegacyMustNewDecFromStr(m.VetoThreshold))
+	require.Equal(t, urlOverride.MinDeposit, m.MinDeposit)
+}
+
+func Test_Tally_ConvictionVoting_QuorumLockOnly(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	now := time.Now().UTC()
+	ctx = ctx.WithBlockTime(now)
+
+	params, err := input.GovKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	convictionVoting := customtypes.NewConvictionVoting(math.LegacyNewDec(3), time.Hour*24)
+	params.ConvictionVoting = &convictionVoting
+	require.NoError(t, input.GovKeeper.Params.Set(ctx, params))
+
+	createValidatorWithCoin(ctx, input,
+		sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100_000_000)),
+		sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100_000_000)),
+		1,
+	)
+	createValidatorWithCoin(ctx, input,
+		sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100_000_000)),
+		sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100_000_000)),
+		2,
+	)
+
+	lockAmount1 := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 50_000_000))
+	lockVoter1 := input.Faucet.NewFundedAccount(ctx, lockAmount1...)
+	_, err = input.GovKeeper.LockTokens(ctx, lockVoter1, lockAmount1, time.Hour*24)
+	require.NoError(t, err)
+
+	lockAmount2 := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100_000_000))
+	lockVoter2 := input.Faucet.NewFundedAccount(ctx, lockAmount2...)
+	_, err = input.GovKeeper.LockTokens(ctx, lockVoter2, lockAmount2, time.Hour*24)
+	require.NoError(t, err)
+
+	submitVotingProposal := func() customtypes.Proposal {
+		proposal, err := input.GovKeeper.SubmitProposal(ctx, nil, "", "test", "description", addrs[0], false)
+		require.NoError(t, err)
+
+		proposal.Status = v1.StatusVotingPeriod
+		require.NoError(t, input.GovKeeper.SetProposal(ctx, proposal))
+		return proposal
+	}
+
+	// only the 50_000_000 locked tokens vote; the quorum counts them without the
+	// multiplier against the 200_000_000 staked and the 150_000_000 locked tokens
+	proposal := submitVotingProposal()
+	require.NoError(t, input.GovKeeper.AddVote(ctx, proposal.Id, lockVoter1, v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+
+	quorumReached, passed, _, tallyResults, err := input.GovKeeper.Tally(ctx, params, proposal)
+	require.NoError(t, err)
+	require.False(t, quorumReached)
+	require.False(t, passed)
+	require.Equal(t, math.NewInt(50_000_000).String(), tallyResults.TotalLockPower)
+	require.Equal(t, math.LegacyNewDec(150_000_000).String(), tallyResults.TotalConvictionPower)
+	require.Equal(t, math.NewInt(150_000_000).String(), tallyResults.V1TallyResult.YesCount)
+
+	// all the 150_000_000 locked tokens vote; 150_000_000 / 350_000_000 reaches the quorum
+	proposal = submitVotingProposal()
+	require.NoError(t, input.GovKeeper.AddVote(ctx, proposal.Id, lockVoter1, v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+	require.NoError(t, input.GovKeeper.AddVote(ctx, proposal.Id, lockVoter2, v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+
+	quorumReached, passed, _, tallyResults, err = input.GovKeeper.Tally(ctx, params, proposal)
+	require.NoError(t, err)
+	require.True(t, quorumReached)
+	require.True(t, passed)
+	require.Equal(t, math.NewInt(150_000_000).String(), tallyResults.TotalLockPower)
+	require.Equal(t, math.LegacyNewDec(450_000_000).String(), tallyResults.TotalConvictionPower)
+	require.Equal(t, math.NewInt(450_000_000).String(), tallyResults.V1TallyResult.YesCount)
+}
@@ -0,0 +1,319 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	customtypes "github.com/initia-labs/initia/x/gov/types"
+	stakingtypes "github.com/initia-labs/initia/x/mstaking/types"
+)
+
+// DelegateVote delegates the governance vote of the delegator to the delegate. If msgType
+// is not empty, the delegation is scoped to the proposals of the message type.
+func (k Keeper) DelegateVote(ctx context.Context, delegator, delegate sdk.AccAddress, msgType string) error {
+	if delegator.Equals(delegate) {
+		return sdkerrors.ErrInvalidRequest.Wrap("cannot delegate vote to self")
+	}
+
+	delegatorStr, err := k.authKeeper.AddressCodec().BytesToString(delegator)
+	if err != nil {
+		return err
+	}
+
+	delegateStr, err := k.authKeeper.AddressCodec().BytesToString(delegate)
+	if err != nil {
+		return err
+	}
+
+	// remove the previous delegation to drop its delegators index entry
+	if _, err := k.removeVoteDelegation(ctx, delegator, msgType); err != nil {
+		return err
+	}
+
+	if err := k.VoteDelegators.Set(ctx, collections.Join3(delegate, msgType, delegator)); err != nil {
+		return err
+	}
+
+	return k.VoteDelegations.Set(
+		ctx,
+		collections.Join(delegator, msgType),
+		customtypes.NewVoteDelegation(delegatorStr, delegateStr, msgType),
+	)
+}
+
+// UndelegateVote removes the vote delegation of the delegator for the message type.
+func (k Keeper) UndelegateVote(ctx context.Context, delegator sdk.AccAddress, msgType string) error {
+	if found, err := k.removeVoteDelegation(ctx, delegator, msgType); err != nil {
+		return err
+	} else if !found {
+		return sdkerrors.ErrNotFound.Wrapf("vote delegation of %s for %q", delegator, msgType)
+	}
+
+	return nil
+}
+
+// removeVoteDelegation removes the vote delegation of the delegator for the message type
+// with its delegators index entry, and returns whether the delegation existed.
+func (k Keeper) removeVoteDelegation(ctx context.Context, delegator sdk.AccAddress, msgType string) (bool, error) {
+	key := collections.Join(delegator, msgType)
+	delegation, err := k.VoteDelegations.Get(ctx, key)
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	delegate, err := k.authKeeper.AddressCodec().StringToBytes(delegation.Delegate)
+	if err != nil {
+		return false, err
+	}
+
+	if err := k.VoteDelegators.Remove(ctx, collections.Join3(sdk.AccAddress(delegate), msgType, delegator)); err != nil {
+		return false, err
+	}
+
+	return true, k.VoteDelegations.Remove(ctx, key)
+}
+
+// GetVoteDelegate returns the delegate of the delegator for the topic. The delegation
+// scoped to the topic takes precedence over the unscoped one.
+func (k Keeper) GetVoteDelegate(ctx context.Context, delegator sdk.AccAddress, topic string) (sdk.AccAddress, bool, error) {
+	msgTypes := []string{""}
+	if topic != "" {
+		msgTypes = []string{topic, ""}
+	}
+
+	for _, msgType := range msgTypes {
+		delegation, err := k.VoteDelegations.Get(ctx, collections.Join(delegator, msgType))
+		if err != nil && errors.Is(err, collections.ErrNotFound) {
+			continue
+		} else if err != nil {
+			return nil, false, err
+		}
+
+		delegate, err := k.authKeeper.AddressCodec().StringToBytes(delegation.Delegate)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return delegate, true, nil
+	}
+
+	return nil, false, nil
+}
+
+// IterateVoteDelegationChain follows the delegation chain of the delegator for the topic,
+// up to MaxVoteDelegationDepth delegates, and calls the callback for each delegate.
+// The iteration stops when the chain returns to the delegator.
+func (k Keeper) IterateVoteDelegationChain(ctx context.Context, delegator sdk.AccAddress, topic string, cb func(delegate sdk.AccAddress) (stop bool)) error {
+	current := delegator
+	for i := 0; i < customtypes.MaxVoteDelegationDepth; i++ {
+		delegate, found, err := k.GetVoteDelegate(ctx, current, topic)
+		if err != nil {
+			return err
+		} else if !found || delegate.Equals(delegator) {
+			return nil
+		}
+
+		if cb(delegate) {
+			return nil
+		}
+
+		current = delegate
+	}
+
+	return nil
+}
+
+// GetEffectiveVoteDelegate returns the delegate at the end of the delegation chain of the
+// delegator for the topic, with the delegates in the chain.
+func (k Keeper) GetEffectiveVoteDelegate(ctx context.Context, delegator sdk.AccAddress, topic string) (sdk.AccAddress, []sdk.AccAddress, error) {
+	var chain []sdk.AccAddress
+	err := k.IterateVoteDelegationChain(ctx, delegator, topic, func(delegate sdk.AccAddress) bool {
+		chain = append(chain, delegate)
+		return false
+	})
+	if err != nil || len(chain) == 0 {
+		return nil, nil, err
+	}
+
+	return chain[len(chain)-1], chain, nil
+}
+
+// GetDelegatedVotingPower returns the voting power of the delegators whose delegation chain
+// for the topic reaches the delegate, with the delegators.
+func (k Keeper) GetDelegatedVotingPower(ctx context.Context, delegate sdk.AccAddress, topic string) (math.LegacyDec, []sdk.AccAddress, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return math.LegacyDec{}, nil, err
+	}
+
+	weights, err := k.sk.GetVotingPowerWeights(ctx)
+	if err != nil {
+		return math.LegacyDec{}, nil, err
+	}
+
+	currValidators, _, err := k.getCurrValidators(ctx, weights)
+	if err != nil {
+		return math.LegacyDec{}, nil, err
+	}
+
+	vestingHandle, err := k.getVestingHandle(ctx, params)
+	if err != nil {
+		return math.LegacyDec{}, nil, err
+	}
+
+	votingPower := math.LegacyZeroDec()
+	var delegatedFrom []sdk.AccAddress
+	err = k.walkVoteDelegators(ctx, topic, []sdk.AccAddress{delegate}, func(delegator, _ sdk.AccAddress) error {
+		power, err := k.getVotingPower(ctx, params, weights, currValidators, vestingHandle, delegator)
+		if err != nil {
+			return err
+		}
+
+		votingPower = votingPower.Add(power)
+		delegatedFrom = append(delegatedFrom, delegator)
+		return nil
+	})
+	if err != nil {
+		return math.LegacyDec{}, nil, err
+	}
+
+	return votingPower, delegatedFrom, nil
+}
+
+// walkVoteDelegators walks the delegators whose delegation chain for the topic reaches
+// one of the roots within MaxVoteDelegationDepth delegates, nearest first, and calls the
+// callback with the first root in the chain. Only the delegators index entries of the
+// walked accounts are read, and the roots are never walked as delegators.
+func (k Keeper) walkVoteDelegators(ctx context.Context, topic string, roots []sdk.AccAddress, cb func(delegator, root sdk.AccAddress) error) error {
+	type delegatorWithRoot struct {
+		delegator sdk.AccAddress
+		root      sdk.AccAddress
+	}
+
+	visited := make(map[string]bool, len(roots))
+	level := make([]delegatorWithRoot, 0, len(roots))
+	for _, root := range roots {
+		visited[root.String()] = true
+		level = append(level, delegatorWithRoot{root, root})
+	}
+
+	for depth := 0; depth < customtypes.MaxVoteDelegationDepth && len(level) > 0; depth++ {
+		var next []delegatorWithRoot
+		for _, delegate := range level {
+			delegators, err := k.getVoteDelegators(ctx, delegate.delegator, topic)
+			if err != nil {
+				return err
+			}
+
+			for _, delegator := range delegators {
+				if visited[delegator.String()] {
+					continue
+				}
+				visited[delegator.String()] = true
+
+				if err := cb(delegator, delegate.root); err != nil {
+					return err
+				}
+
+				next = append(next, delegatorWithRoot{delegator, delegate.root})
+			}
+		}
+
+		level = next
+	}
+
+	return nil
+}
+
+// getVoteDelegators returns the delegators whose delegation for the topic points to the
+// delegate. The delegations scoped to the topic take precedence over the unscoped ones,
+// as in GetVoteDelegate.
+func (k Keeper) getVoteDelegators(ctx context.Context, delegate sdk.AccAddress, topic string) ([]sdk.AccAddress, error) {
+	msgTypes := []string{""}
+	if topic != "" {
+		msgTypes = []string{topic, ""}
+	}
+
+	var delegators []sdk.AccAddress
+	for _, msgType := range msgTypes {
+		rng := collections.NewSuperPrefixedTripleRange[sdk.AccAddress, string, sdk.AccAddress](delegate, msgType)
+		err := k.VoteDelegators.Walk(ctx, rng, func(key collections.Triple[sdk.AccAddress, string, sdk.AccAddress]) (stop bool, err error) {
+			delegator := key.K3()
+
+			// skip the unscoped delegation overridden by the scoped one
+			if msgType != topic {
+				if scoped, err := k.VoteDelegations.Has(ctx, collections.Join(delegator, topic)); err != nil {
+					return true, err
+				} else if scoped {
+					return false, nil
+				}
+			}
+
+			delegators = append(delegators, delegator)
+			return false, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return delegators, nil
+}
+
+// getVotingPower returns the voting power of the account from its delegations, vesting
+// and voting locks, as it would be tallied if the account votes.
+func (k Keeper) getVotingPower(
+	ctx context.Context, params customtypes.Params, weights sdk.DecCoins,
+	currValidators map[string]customtypes.ValidatorGovInfo, vestingHandle *sdk.AccAddress, voter sdk.AccAddress,
+) (math.LegacyDec, error) {
+	votingPower := math.LegacyZeroDec()
+	if vestingHandle != nil {
+		amount, err := k.vestingKeeper.GetUnclaimedVestedAmount(ctx, *vestingHandle, voter)
+		if err != nil {
+			return math.LegacyDec{}, err
+		}
+
+		votingPower = votingPower.Add(math.LegacyNewDecFromInt(amount))
+	}
+
+	_, convictionPower, err := k.GetLockVotingPower(ctx, params.ConvictionVoting, weights, voter)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	votingPower = votingPower.Add(convictionPower)
+
+	err = k.sk.IterateDelegations(ctx, voter, func(delegation stakingtypes.DelegationI) (stop bool, err error) {
+		if val, ok := currValidators[delegation.GetValidatorAddr()]; ok {
+			votingPower = votingPower.Add(delegationVotingPower(val, delegation.GetShares(), weights))
+		}
+
+		return false, nil
+	})
+
+	return votingPower, err
+}
+
+// proposalVoteTopic returns the message type URL of the proposal messages if all the
+// messages have the same type, which selects the scoped vote delegations. Otherwise,
+// returns an empty string, so only the unscoped delegations are followed.
+func proposalVoteTopic(proposal customtypes.Proposal) string {
+	topic := ""
+	for i, msg := range proposal.Messages {
+		if i == 0 {
+			topic = msg.TypeUrl
+		} else if topic != msg.TypeUrl {
+			return ""
+		}
+	}
+
+	return topic
+}
@@ -0,0 +1,196 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	"github.com/initia-labs/initia/x/gov/keeper"
+	customtypes "github.com/initia-labs/initia/x/gov/types"
+	stakingkeeper "github.com/initia-labs/initia/x/mstaking/keeper"
+	stakingtypes "github.com/initia-labs/initia/x/mstaking/types"
+)
+
+func Test_VoteDelegation(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	ms := keeper.NewCustomMsgServerImpl(&input.GovKeeper)
+	qs := keeper.NewCustomQueryServer(&input.GovKeeper)
+
+	_, _, delegatorAddr := keyPubAddr()
+	_, _, middleAddr := keyPubAddr()
+	_, _, delegateAddr := keyPubAddr()
+
+	// cannot delegate to self
+	_, err := ms.DelegateVote(ctx, &customtypes.MsgDelegateVote{
+		Delegator: delegatorAddr.String(),
+		Delegate:  delegatorAddr.String(),
+	})
+	require.Error(t, err)
+
+	// delegator -> middle -> delegate
+	_, err = ms.DelegateVote(ctx, &customtypes.MsgDelegateVote{
+		Delegator: delegatorAddr.String(),
+		Delegate:  middleAddr.String(),
+	})
+	require.NoError(t, err)
+	_, err = ms.DelegateVote(ctx, &customtypes.MsgDelegateVote{
+		Delegator: middleAddr.String(),
+		Delegate:  delegateAddr.String(),
+	})
+	require.NoError(t, err)
+
+	res, err := qs.EffectiveVoteDelegate(ctx, &customtypes.QueryEffectiveVoteDelegateRequest{Delegator: delegatorAddr.String()})
+	require.NoError(t, err)
+	require.Equal(t, delegateAddr.String(), res.Delegate)
+	require.Equal(t, []string{middleAddr.String(), delegateAddr.String()}, res.Chain)
+
+	// the scoped delegation takes precedence for the topic
+	msgType := sdk.MsgTypeURL(&v1.MsgUpdateParams{})
+	_, err = ms.DelegateVote(ctx, &customtypes.MsgDelegateVote{
+		Delegator: delegatorAddr.String(),
+		Delegate:  delegateAddr.String(),
+		MsgType:   msgType,
+	})
+	require.NoError(t, err)
+
+	res, err = qs.EffectiveVoteDelegate(ctx, &customtypes.QueryEffectiveVoteDelegateRequest{Delegator: delegatorAddr.String(), MsgType: msgType})
+	require.NoError(t, err)
+	require.Equal(t, delegateAddr.String(), res.Delegate)
+	require.Equal(t, []string{delegateAddr.String()}, res.Chain)
+
+	// a cycle ends at the delegator
+	_, err = ms.DelegateVote(ctx, &customtypes.MsgDelegateVote{
+		Delegator: delegateAddr.String(),
+		Delegate:  delegatorAddr.String(),
+	})
+	require.NoError(t, err)
+
+	res, err = qs.EffectiveVoteDelegate(ctx, &customtypes.QueryEffectiveVoteDelegateRequest{Delegator: delegatorAddr.String()})
+	require.NoError(t, err)
+	require.Equal(t, delegateAddr.String(), res.Delegate)
+
+	// redelegating replaces the delegators index entry
+	_, err = ms.DelegateVote(ctx, &customtypes.MsgDelegateVote{
+		Delegator: delegatorAddr.String(),
+		Delegate:  delegateAddr.String(),
+	})
+	require.NoError(t, err)
+
+	found, err := input.GovKeeper.VoteDelegators.Has(ctx, collections.Join3(middleAddr, "", delegatorAddr))
+	require.NoError(t, err)
+	require.False(t, found)
+	found, err = input.GovKeeper.VoteDelegators.Has(ctx, collections.Join3(delegateAddr, "", delegatorAddr))
+	require.NoError(t, err)
+	require.True(t, found)
+
+	_, err = ms.UndelegateVote(ctx, &customtypes.MsgUndelegateVote{Delegator: delegatorAddr.String()})
+	require.NoError(t, err)
+	_, err = ms.UndelegateVote(ctx, &customtypes.MsgUndelegateVote{Delegator: delegatorAddr.String()})
+	require.Error(t, err)
+
+	res, err = qs.EffectiveVoteDelegate(ctx, &customtypes.QueryEffectiveVoteDelegateRequest{Delegator: delegatorAddr.String()})
+	require.NoError(t, err)
+	require.Empty(t, res.Delegate)
+
+	found, err = input.GovKeeper.VoteDelegators.Has(ctx, collections.Join3(delegateAddr, "", delegatorAddr))
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
+func Test_Tally_VoteDelegation(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	ms := keeper.NewCustomMsgServerImpl(&input.GovKeeper)
+	qs := keeper.NewCustomQueryServer(&input.GovKeeper)
+
+	proposal, err := input.GovKeeper.SubmitProposal(ctx, nil, "", "test", "description", addrs[0], false)
+	require.NoError(t, err)
+
+	proposalID := proposal.Id
+	proposal.Status = v1.StatusVotingPeriod
+	err = input.GovKeeper.SetProposal(ctx, proposal)
+	require.NoError(t, err)
+
+	valAddr1 := createValidatorWithCoin(ctx, input,
+		sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100_000_000)),
+		sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100_000_000)),
+		1,
+	)
+	valAddr2 := createValidatorWithCoin(ctx, input,
+		sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100_000_000)),
+		sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100_000_000)),
+		2,
+	)
+
+	// the delegator stakes to validator 2
+	delegateAmount := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 20_000_000))
+	delegatorAddr := input.Faucet.NewFundedAccount(ctx, delegateAmount...)
+	_, err = stakingkeeper.NewMsgServerImpl(input.StakingKeeper).Delegate(ctx, stakingtypes.NewMsgDelegate(delegatorAddr.String(), valAddr2.String(), delegateAmount))
+	require.NoError(t, err)
+
+	// delegator -> middle -> validator 1
+	_, _, middleAddr := keyPubAddr()
+	_, err = ms.DelegateVote(ctx, &customtypes.MsgDelegateVote{Delegator: delegatorAddr.String(), Delegate: middleAddr.String()})
+	require.NoError(t, err)
+	_, err = ms.DelegateVote(ctx, &customtypes.MsgDelegateVote{Delegator: middleAddr.String(), Delegate: sdk.AccAddress(valAddr1).String()})
+	require.NoError(t, err)
+
+	powerRes, err := qs.DelegatedVotingPower(ctx, &customtypes.QueryDelegatedVotingPowerRequest{Delegate: sdk.AccAddress(valAddr1).String()})
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(20_000_000).String(), powerRes.VotingPower)
+	require.ElementsMatch(t, []string{delegatorAddr.String(), middleAddr.String()}, powerRes.Delegators)
+
+	// the scoped delegation to validator 2 overrides the unscoped one for the topic
+	msgType := sdk.MsgTypeURL(&v1.MsgUpdateParams{})
+	_, err = ms.DelegateVote(ctx, &customtypes.MsgDelegateVote{Delegator: delegatorAddr.String(), Delegate: sdk.AccAddress(valAddr2).String(), MsgType: msgType})
+	require.NoError(t, err)
+
+	powerRes, err = qs.DelegatedVotingPower(ctx, &customtypes.QueryDelegatedVotingPowerRequest{Delegate: sdk.AccAddress(valAddr1).String(), MsgType: msgType})
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyZeroDec().String(), powerRes.VotingPower)
+	require.Equal(t, []string{middleAddr.String()}, powerRes.Delegators)
+
+	powerRes, err = qs.DelegatedVotingPower(ctx, &customtypes.QueryDelegatedVotingPowerRequest{Delegate: sdk.AccAddress(valAddr2).String(), MsgType: msgType})
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(20_000_000).String(), powerRes.VotingPower)
+	require.Equal(t, []string{delegatorAddr.String()}, powerRes.Delegators)
+
+	addVotes := func(votes map[string]v1.VoteOption) {
+		for voter, option := range votes {
+			err := input.GovKeeper.AddVote(ctx, proposalID, sdk.MustAccAddressFromBech32(voter), v1.NewNonSplitVoteOption(option), "")
+			require.NoError(t, err)
+		}
+	}
+
+	// the delegated vote follows validator 1 instead of inheriting validator 2
+	addVotes(map[string]v1.VoteOption{
+		sdk.AccAddress(valAddr1).String(): v1.OptionYes,
+		sdk.AccAddress(valAddr2).String(): v1.OptionNo,
+	})
+
+	params, err := input.GovKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+
+	_, passed, _, tallyResults, err := input.GovKeeper.Tally(ctx, params, proposal)
+	require.NoError(t, err)
+	require.True(t, passed)
+	require.Equal(t, math.NewInt(120_000_000).String(), tallyResults.V1TallyResult.YesCount)
+	require.Equal(t, math.NewInt(100_000_000).String(), tallyResults.V1TallyResult.NoCount)
+
+	// the direct vote overrides the delegated one
+	addVotes(map[string]v1.VoteOption{
+		sdk.AccAddress(valAddr1).String(): v1.OptionYes,
+		sdk.AccAddress(valAddr2).String(): v1.OptionNo,
+		delegatorAddr.String():            v1.OptionAbstain,
+	})
+
+	_, _, _, tallyResults, err = input.GovKeeper.Tally(ctx, params, proposal)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(100_000_000).String(), tallyResults.V1TallyResult.YesCount)
+	require.Equal(t, math.NewInt(100_000_000).String(), tallyResults.V1TallyResult.NoCount)
+	require.Equal(t, math.NewInt(20_000_000).String(), tallyResults.V1TallyResult.AbstainCount)
+}
@@ -0,0 +1,131 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	customtypes "github.com/initia-labs/initia/x/gov/types"
+	stakingtypes "github.com/initia-labs/initia/x/mstaking/types"
+)
+
+// LockTokens escrows the tokens to the voting lock pool until the lock duration
+// has passed, and returns the end time of the lock. Locks of the same owner with
+// the same end time are merged.
+func (k Keeper) LockTokens(ctx context.Context, owner sdk.AccAddress, amount sdk.Coins, lockDuration time.Duration) (time.Time, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if params.ConvictionVoting == nil {
+		return time.Time{}, sdkerrors.ErrInvalidRequest.Wrap("conviction voting is not enabled")
+	}
+
+	if lockDuration <= 0 || lockDuration > params.ConvictionVoting.MaxLockDuration {
+		return time.Time{}, sdkerrors.ErrInvalidRequest.Wrapf("lock duration must be in (0, %s]", params.ConvictionVoting.MaxLockDuration)
+	}
+
+	weights, err := k.sk.GetVotingPowerWeights(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	for _, coin := range amount {
+		if !weights.AmountOf(coin.Denom).IsPositive() {
+			return time.Time{}, sdkerrors.ErrInvalidRequest.Wrapf("denom %s has no voting power", coin.Denom)
+		}
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, customtypes.VotingLockPoolName, amount); err != nil {
+		return time.Time{}, err
+	}
+
+	endTime := sdk.UnwrapSDKContext(ctx).BlockTime().Add(lockDuration)
+	key := collections.Join(owner, endTime)
+	lock, err := k.VotingLocks.Get(ctx, key)
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		lock = customtypes.NewVotingLock(owner.String(), sdk.NewCoins(), endTime)
+	} else if err != nil {
+		return time.Time{}, err
+	}
+
+	lock.Amount = lock.Amount.Add(amount...)
+	if err := k.VotingLocks.Set(ctx, key, lock); err != nil {
+		return time.Time{}, err
+	}
+
+	return endTime, nil
+}
+
+// UnlockTokens returns the tokens of all the expired voting locks of the owner.
+func (k Keeper) UnlockTokens(ctx context.Context, owner sdk.AccAddress) (sdk.Coins, error) {
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+
+	var keys []collections.Pair[sdk.AccAddress, time.Time]
+	amount := sdk.NewCoins()
+	rng := collections.NewPrefixedPairRange[sdk.AccAddress, time.Time](owner)
+	err := k.VotingLocks.Walk(ctx, rng, func(key collections.Pair[sdk.AccAddress, time.Time], lock customtypes.VotingLock) (stop bool, err error) {
+		if lock.EndTime.After(blockTime) {
+			// locks are sorted by the end time
+			return true, nil
+		}
+
+		keys = append(keys, key)
+		amount = amount.Add(lock.Amount...)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, key := range keys {
+		if err := k.VotingLocks.Remove(ctx, key); err != nil {
+			return nil, err
+		}
+	}
+
+	if amount.IsZero() {
+		return amount, nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, customtypes.VotingLockPoolName, owner, amount); err != nil {
+		return nil, err
+	}
+
+	return amount, nil
+}
+
+// GetLockVotingPower returns the voting power of the unexpired voting locks of the
+// voter, before and after the conviction multiplier is applied.
+func (k Keeper) GetLockVotingPower(ctx context.Context, convictionVoting *customtypes.ConvictionVoting, weights sdk.DecCoins, voter sdk.AccAddress) (lockPower math.Int, convictionPower math.LegacyDec, err error) {
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+
+	lockPower = math.ZeroInt()
+	convictionPower = math.LegacyZeroDec()
+	rng := collections.NewPrefixedPairRange[sdk.AccAddress, time.Time](voter).StartExclusive(blockTime)
+	err = k.VotingLocks.Walk(ctx, rng, func(_ collections.Pair[sdk.AccAddress, time.Time], lock customtypes.VotingLock) (stop bool, err error) {
+		votingPower, _ := stakingtypes.CalculateVotingPower(lock.Amount, weights)
+		if votingPower.IsZero() {
+			return false, nil
+		}
+
+		multiplier := math.LegacyOneDec()
+		if convictionVoting != nil {
+			multiplier = convictionVoting.Multiplier(lock.EndTime.Sub(blockTime))
+		}
+
+		lockPower = lockPower.Add(votingPower)
+		convictionPower = convictionPower.Add(multiplier.MulInt(votingPower))
+		return false, nil
+	})
+
+	return lockPower, convictionPower, err
+}
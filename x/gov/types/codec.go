@@ -16,6 +16,10 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgAddEmergencySubmitters{}, "gov/MsgAddEmergencySubmitters")
 	legacy.RegisterAminoMsg(cdc, &MsgRemoveEmergencySubmitters{}, "gov/MsgRemoveEmergencySubmitters")
 	legacy.RegisterAminoMsg(cdc, &MsgActivateEmergencyProposal{}, "gov/MsgActivateEmergencyProposal")
+	legacy.RegisterAminoMsg(cdc, &MsgLockTokens{}, "gov/MsgLockTokens")
+	legacy.RegisterAminoMsg(cdc, &MsgUnlockTokens{}, "gov/MsgUnlockTokens")
+	legacy.RegisterAminoMsg(cdc, &MsgDelegateVote{}, "gov/MsgDelegateVote")
+	legacy.RegisterAminoMsg(cdc, &MsgUndelegateVote{}, "gov/MsgUndelegateVote")
 	cdc.RegisterConcrete(Params{}, "gov/Params", nil)
 }
 
@@ -26,6 +30,10 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgAddEmergencySubmitters{},
 		&MsgRemoveEmergencySubmitters{},
 		&MsgActivateEmergencyProposal{},
+		&MsgLockTokens{},
+		&MsgUnlockTokens{},
+		&MsgDelegateVote{},
+		&MsgUndelegateVote{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewConvictionVoting creates a new ConvictionVoting instance
+func NewConvictionVoting(maxMultiplier math.LegacyDec, maxLockDuration time.Duration) ConvictionVoting {
+	return ConvictionVoting{
+		MaxMultiplier:   maxMultiplier.String(),
+		MaxLockDuration: maxLockDuration,
+	}
+}
+
+// Validate checks for the validity of the ConvictionVoting struct
+func (c ConvictionVoting) Validate() error {
+	maxMultiplier, err := math.LegacyNewDecFromStr(c.MaxMultiplier)
+	if err != nil {
+		return fmt.Errorf("invalid max multiplier string: %w", err)
+	}
+	if maxMultiplier.LT(math.LegacyOneDec()) {
+		return fmt.Errorf("max multiplier must be greater than or equal to one: %s", maxMultiplier)
+	}
+
+	if c.MaxLockDuration <= 0 {
+		return errors.New("max lock duration must be positive")
+	}
+
+	return nil
+}
+
+// Multiplier returns the conviction multiplier for the remaining lock duration,
+// which grows linearly from one to the max multiplier over the max lock duration.
+func (c ConvictionVoting) Multiplier(remaining time.Duration) math.LegacyDec {
+	if remaining <= 0 {
+		return math.LegacyOneDec()
+	}
+
+	remaining = min(remaining, c.MaxLockDuration)
+	maxMultiplier := math.LegacyMustNewDecFromStr(c.MaxMultiplier)
+	return maxMultiplier.Sub(math.LegacyOneDec()).
+		MulInt64(int64(remaining)).
+		QuoInt64(int64(c.MaxLockDuration)).
+		Add(math.LegacyOneDec())
+}
+
+// NewVotingLock creates a new VotingLock instance
+func NewVotingLock(owner string, amount sdk.Coins, endTime time.Time) VotingLock {
+	return VotingLock{
+		Owner:   owner,
+		Amount:  amount,
+		EndTime: endTime,
+	}
+}
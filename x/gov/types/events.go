@@ -2,7 +2,16 @@ package types
 
 const (
 	EventTypeEmergencyProposal = "emergency_proposal"
+	EventTypeLockTokens        = "lock_tokens"
+	EventTypeUnlockTokens      = "unlock_tokens"
+	EventTypeDelegateVote      = "delegate_vote"
+	EventTypeUndelegateVote    = "undelegate_vote"
 
 	AttributeKeyProposalID    = "proposal_id"
 	AttributeKeyNextTallyTime = "next_tally_time"
+	AttributeKeyOwner         = "owner"
+	AttributeKeyEndTime       = "end_time"
+	AttributeKeyDelegator     = "delegator"
+	AttributeKeyDelegate      = "delegate"
+	AttributeKeyMsgType       = "msg_type"
 )
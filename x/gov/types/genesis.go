@@ -2,6 +2,7 @@ package types
 
 import (
 	"errors"
+	"fmt"
 
 	"cosmossdk.io/core/address"
 
@@ -36,6 +37,30 @@ func ValidateGenesis(data *GenesisState, ac address.Codec) error {
 		return errors.New("starting proposal id must be greater than 0")
 	}
 
+	for _, lock := range data.VotingLocks {
+		if _, err := ac.StringToBytes(lock.Owner); err != nil {
+			return err
+		}
+
+		if !lock.Amount.IsValid() {
+			return fmt.Errorf("invalid voting lock amount: %s", lock.Amount)
+		}
+	}
+
+	for _, delegation := range data.VoteDelegations {
+		if _, err := ac.StringToBytes(delegation.Delegator); err != nil {
+			return err
+		}
+
+		if _, err := ac.StringToBytes(delegation.Delegate); err != nil {
+			return err
+		}
+
+		if delegation.Delegator == delegation.Delegate {
+			return fmt.Errorf("cannot delegate vote to self: %s", delegation.Delegator)
+		}
+	}
+
 	return data.Params.Validate(ac)
 }
 
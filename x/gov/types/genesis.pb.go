@@ -6,6 +6,7 @@ package types
 import (
 	fmt "fmt"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -42,6 +43,10 @@ type GenesisState struct {
 	//
 	// Since: cosmos-sdk 0.50
 	Constitution string `protobuf:"bytes,6,opt,name=constitution,proto3" json:"constitution,omitempty"`
+	// voting_locks defines all the voting locks present at genesis.
+	VotingLocks []VotingLock `protobuf:"bytes,7,rep,name=voting_locks,json=votingLocks,proto3" json:"voting_locks"`
+	// vote_delegations defines all the vote delegations present at genesis.
+	VoteDelegations []VoteDelegation `protobuf:"bytes,8,rep,name=vote_delegations,json=voteDelegations,proto3" json:"vote_delegations"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -119,6 +124,20 @@ func (m *GenesisState) GetConstitution() string {
 	return ""
 }
 
+func (m *GenesisState) GetVotingLocks() []VotingLock {
+	if m != nil {
+		return m.VotingLocks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVoteDelegations() []VoteDelegation {
+	if m != nil {
+		return m.VoteDelegations
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "initia.gov.v1.GenesisState")
 }
@@ -126,27 +145,32 @@ func init() {
 func init() { proto.RegisterFile("initia/gov/v1/genesis.proto", fileDescriptor_67ed99a117f3a32d) }
 
 var fileDescriptor_67ed99a117f3a32d = []byte{
-	// 318 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0xcd, 0x4e, 0xf2, 0x40,
-	0x14, 0x86, 0x19, 0xfe, 0xf2, 0x31, 0xf0, 0x6d, 0xc6, 0x1f, 0x1a, 0x4c, 0x9a, 0x86, 0x55, 0x59,
-	0x30, 0x15, 0x8c, 0x37, 0xa0, 0x26, 0xc6, 0x9d, 0xa9, 0x89, 0x0b, 0x37, 0x64, 0x80, 0x49, 0x9d,
-	0x04, 0x7a, 0x9a, 0x9e, 0x43, 0xa3, 0x77, 0xe1, 0x6d, 0x78, 0x27, 0x2e, 0x59, 0xba, 0x34, 0x70,
-	0x23, 0x26, 0x9d, 0x16, 0x43, 0x77, 0x93, 0x79, 0x9e, 0xf7, 0xcc, 0x3b, 0x87, 0x5f, 0x98, 0xd8,
-	0x90, 0x51, 0x41, 0x04, 0x59, 0x90, 0x4d, 0x82, 0x48, 0xc7, 0x1a, 0x0d, 0xca, 0x24, 0x05, 0x02,
-	0xf1, 0xdf, 0x42, 0x19, 0x41, 0x26, 0xb3, 0xc9, 0xa0, 0xbf, 0x00, 0x5c, 0x03, 0x1e, 0x5c, 0xc8,
-	0xac, 0x37, 0xe8, 0x57, 0x86, 0x94, 0x60, 0xf8, 0x59, 0xe7, 0xbd, 0x7b, 0x3b, 0xf2, 0x89, 0x14,
-	0x69, 0x71, 0xc9, 0x4f, 0x91, 0x54, 0x4a, 0x26, 0x8e, 0x66, 0x49, 0x0a, 0x09, 0xa0, 0x5a, 0xcd,
-	0xcc, 0xd2, 0x61, 0x1e, 0xf3, 0x9b, 0xa1, 0x28, 0xd9, 0x63, 0x81, 0x1e, 0x96, 0x62, 0xca, 0xff,
-	0x2d, 0x75, 0x02, 0x68, 0x08, 0x9d, 0xba, 0xd7, 0xf0, 0xbb, 0xd3, 0x73, 0x69, 0x7b, 0x14, 0xb5,
-	0xe4, 0x9d, 0xc5, 0xe1, 0xc1, 0x13, 0x23, 0xde, 0xca, 0x80, 0x34, 0x3a, 0x8d, 0x3c, 0x70, 0x52,
-	0x09, 0x3c, 0x03, 0xe9, 0xd0, 0x1a, 0xe2, 0x9a, 0x77, 0xca, 0x1e, 0xe8, 0x34, 0x73, 0xbd, 0x2f,
-	0x8f, 0xbe, 0x2d, 0xcb, 0x32, 0xe1, 0x9f, 0x29, 0xc6, 0xbc, 0x9d, 0xa8, 0x54, 0xad, 0xd1, 0x69,
-	0x79, 0xcc, 0xef, 0x4e, 0xcf, 0xaa, 0x99, 0x1c, 0x86, 0x85, 0x24, 0x86, 0xbc, 0xb7, 0x80, 0x18,
-	0xc9, 0xd0, 0x86, 0x0c, 0xc4, 0x4e, 0xdb, 0x63, 0x7e, 0x27, 0x3c, 0xba, 0xbb, 0xb9, 0xfd, 0xda,
-	0xb9, 0x6c, 0xbb, 0x73, 0xd9, 0xcf, 0xce, 0x65, 0x1f, 0x7b, 0xb7, 0xb6, 0xdd, 0xbb, 0xb5, 0xef,
-	0xbd, 0x5b, 0x7b, 0x19, 0x45, 0x86, 0x5e, 0x37, 0x73, 0xb9, 0x80, 0x75, 0x60, 0x9f, 0x19, 0xaf,
-	0xd4, 0x1c, 0x8b, 0x73, 0xf0, 0x96, 0xef, 0x9d, 0xde, 0x13, 0x8d, 0xf3, 0x76, 0xbe, 0xf7, 0xab,
-	0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc8, 0xd2, 0x42, 0x04, 0xd7, 0x01, 0x00, 0x00,
+	// 393 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x92, 0xcd, 0x6e, 0xd3, 0x40,
+	0x14, 0x85, 0x6d, 0x92, 0x86, 0x76, 0x12, 0x04, 0x1a, 0x0a, 0x19, 0x8a, 0x30, 0x56, 0x57, 0xee,
+	0xa2, 0x63, 0x1a, 0xc4, 0x0b, 0x84, 0x4a, 0x08, 0x09, 0x21, 0x64, 0xa4, 0x2e, 0xd8, 0x44, 0x13,
+	0x7b, 0x34, 0x8c, 0xea, 0xf8, 0x5a, 0xbe, 0xd3, 0x11, 0xbc, 0x05, 0x2f, 0xc4, 0x3e, 0xcb, 0x2c,
+	0x59, 0x21, 0x94, 0xbc, 0x08, 0xca, 0xf8, 0x07, 0xc5, 0xec, 0xae, 0xce, 0xf9, 0xee, 0xf1, 0xf1,
+	0xe8, 0x92, 0xe7, 0xba, 0xd0, 0x46, 0x8b, 0x58, 0x81, 0x8d, 0xed, 0x55, 0xac, 0x64, 0x21, 0x51,
+	0x23, 0x2f, 0x2b, 0x30, 0x40, 0x1f, 0xd4, 0x26, 0x57, 0x60, 0xb9, 0xbd, 0x3a, 0x9b, 0xa6, 0x80,
+	0x2b, 0xc0, 0x8e, 0x05, 0x5b, 0x73, 0x67, 0xa7, 0x0a, 0x14, 0xb8, 0x31, 0xde, 0x4f, 0x8d, 0x3a,
+	0xed, 0x45, 0xb7, 0xf8, 0xf9, 0xcf, 0x01, 0x99, 0xbc, 0xab, 0x3f, 0xf4, 0xd9, 0x08, 0x23, 0xe9,
+	0x2b, 0x72, 0x8a, 0x46, 0x54, 0x46, 0x17, 0x6a, 0x51, 0x56, 0x50, 0x02, 0x8a, 0x7c, 0xa1, 0x33,
+	0xe6, 0x87, 0x7e, 0x34, 0x4c, 0x68, 0xeb, 0x7d, 0x6a, 0xac, 0xf7, 0x19, 0x9d, 0x91, 0xe3, 0x4c,
+	0x96, 0x80, 0xda, 0x20, 0xbb, 0x17, 0x0e, 0xa2, 0xf1, 0xec, 0x29, 0xaf, 0xdb, 0x35, 0x65, 0xf9,
+	0x75, 0x6d, 0x27, 0x1d, 0x47, 0x2f, 0xc8, 0x91, 0x05, 0x23, 0x91, 0x0d, 0xdc, 0xc2, 0xe3, 0xde,
+	0xc2, 0x0d, 0x18, 0x99, 0xd4, 0x04, 0x7d, 0x43, 0x4e, 0xda, 0x1e, 0xc8, 0x86, 0x0e, 0x9f, 0xf2,
+	0x83, 0xc7, 0xe0, 0x6d, 0x99, 0xe4, 0x1f, 0x49, 0x2f, 0xc9, 0xa8, 0x14, 0x95, 0x58, 0x21, 0x3b,
+	0x0a, 0xfd, 0x68, 0x3c, 0x7b, 0xd2, 0xdf, 0x71, 0x66, 0xd2, 0x40, 0xf4, 0x9c, 0x4c, 0x52, 0x28,
+	0xd0, 0x68, 0x73, 0x67, 0x34, 0x14, 0x6c, 0x14, 0xfa, 0xd1, 0x49, 0x72, 0xa0, 0xd1, 0x39, 0x99,
+	0x58, 0x70, 0x0f, 0x93, 0x43, 0x7a, 0x8b, 0xec, 0xbe, 0x2b, 0xf3, 0xac, 0x17, 0x7c, 0xe3, 0x90,
+	0x0f, 0x90, 0xde, 0xce, 0x87, 0xeb, 0xdf, 0x2f, 0xbd, 0x64, 0x6c, 0x3b, 0x05, 0xe9, 0x47, 0xf2,
+	0x68, 0xff, 0x5b, 0x8b, 0x4c, 0xe6, 0x52, 0x89, 0x7d, 0x2c, 0xb2, 0x63, 0x97, 0xf3, 0xe2, 0xff,
+	0x1c, 0x79, 0xdd, 0x51, 0x4d, 0xd6, 0x43, 0x7b, 0xa0, 0xe2, 0xfc, 0xed, 0x7a, 0x1b, 0xf8, 0x9b,
+	0x6d, 0xe0, 0xff, 0xd9, 0x06, 0xfe, 0x8f, 0x5d, 0xe0, 0x6d, 0x76, 0x81, 0xf7, 0x6b, 0x17, 0x78,
+	0x5f, 0x2e, 0x94, 0x36, 0x5f, 0xef, 0x96, 0x3c, 0x85, 0x55, 0x5c, 0x27, 0x5f, 0xe6, 0x62, 0x89,
+	0xcd, 0x1c, 0x7f, 0x73, 0xb7, 0x60, 0xbe, 0x97, 0x12, 0x97, 0x23, 0x77, 0x0b, 0xaf, 0xff, 0x0e,
+	0x00, 0x1c, 0xac, 0x47, 0x25, 0x81, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -169,6 +193,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.VoteDelegations) > 0 {
+		for iNdEx := len(m.VoteDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VoteDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.VotingLocks) > 0 {
+		for iNdEx := len(m.VotingLocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VotingLocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.Constitution) > 0 {
 		i -= len(m.Constitution)
 		copy(dAtA[i:], m.Constitution)
@@ -284,6 +336,18 @@ func (m *GenesisState) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.VotingLocks) > 0 {
+		for _, e := range m.VotingLocks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VoteDelegations) > 0 {
+		for _, e := range m.VoteDelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -511,6 +575,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.Constitution = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingLocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VotingLocks = append(m.VotingLocks, VotingLock{})
+			if err := m.VotingLocks[len(m.VotingLocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteDelegations = append(m.VoteDelegations, VoteDelegation{})
+			if err := m.VoteDelegations[len(m.VoteDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
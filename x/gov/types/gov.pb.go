@@ -7,6 +7,7 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types1 "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
@@ -95,6 +96,13 @@ type Params struct {
 	Vesting *Vesting `protobuf:"bytes,94,opt,name=vesting,proto3" json:"vesting,omitempty"`
 	// Whitelist addresses that can submit emergency proposals.
 	EmergencySubmitters []string `protobuf:"bytes,95,rep,name=emergency_submitters,json=emergencySubmitters,proto3" json:"emergency_submitters,omitempty"`
+	// ConvictionVoting is the conviction multiplier config for tally.
+	// If it is not set, tokens cannot be locked and the locked tokens
+	// are tallied without multiplier.
+	ConvictionVoting *ConvictionVoting `protobuf:"bytes,96,opt,name=conviction_voting,json=convictionVoting,proto3" json:"conviction_voting,omitempty"`
+	// Message based params override the voting period, quorum, thresholds
+	// and min deposit of the proposals which contain the given messages.
+	MessageBasedParams []MessageBasedParams `protobuf:"bytes,97,rep,name=message_based_params,json=messageBasedParams,proto3" json:"message_based_params"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -276,6 +284,307 @@ func (m *Params) GetEmergencySubmitters() []string {
 	return nil
 }
 
+func (m *Params) GetConvictionVoting() *ConvictionVoting {
+	if m != nil {
+		return m.ConvictionVoting
+	}
+	return nil
+}
+
+func (m *Params) GetMessageBasedParams() []MessageBasedParams {
+	if m != nil {
+		return m.MessageBasedParams
+	}
+	return nil
+}
+
+// MessageBasedParams defines the governance params applied to the proposals
+// which contain the message. When a proposal contains multiple messages, the
+// strictest params among the messages are applied.
+type MessageBasedParams struct {
+	// msg_type is either the type URL of a sdk.Msg or a Move function
+	// identifier (e.g. 0x1::vip::register_snapshot) of MsgExecute and
+	// MsgExecuteJSON. The function identifier takes precedence over the
+	// type URL.
+	MsgType string `protobuf:"bytes,1,opt,name=msg_type,json=msgType,proto3" json:"msg_type,omitempty"`
+	// Duration of the voting period.
+	VotingPeriod time.Duration `protobuf:"bytes,2,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period"`
+	//  Minimum percentage of total stake needed to vote for a result to be
+	//  considered valid.
+	Quorum string `protobuf:"bytes,3,opt,name=quorum,proto3" json:"quorum,omitempty"`
+	//  Minimum proportion of Yes votes for proposal to pass.
+	Threshold string `protobuf:"bytes,4,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	//  Minimum value of Veto votes to Total votes ratio for proposal to be
+	//  vetoed.
+	VetoThreshold string `protobuf:"bytes,5,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
+	// Minimum deposit for a proposal to enter voting period.
+	MinDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=min_deposit,json=minDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_deposit"`
+}
+
+func (m *MessageBasedParams) Reset()         { *m = MessageBasedParams{} }
+func (m *MessageBasedParams) String() string { return proto.CompactTextString(m) }
+func (*MessageBasedParams) ProtoMessage()    {}
+func (*MessageBasedParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6adfe7a550f5e4ec, []int{1}
+}
+func (m *MessageBasedParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessageBasedParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessageBasedParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MessageBasedParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageBasedParams.Merge(m, src)
+}
+func (m *MessageBasedParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessageBasedParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageBasedParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessageBasedParams proto.InternalMessageInfo
+
+func (m *MessageBasedParams) GetMsgType() string {
+	if m != nil {
+		return m.MsgType
+	}
+	return ""
+}
+
+func (m *MessageBasedParams) GetVotingPeriod() time.Duration {
+	if m != nil {
+		return m.VotingPeriod
+	}
+	return 0
+}
+
+func (m *MessageBasedParams) GetQuorum() string {
+	if m != nil {
+		return m.Quorum
+	}
+	return ""
+}
+
+func (m *MessageBasedParams) GetThreshold() string {
+	if m != nil {
+		return m.Threshold
+	}
+	return ""
+}
+
+func (m *MessageBasedParams) GetVetoThreshold() string {
+	if m != nil {
+		return m.VetoThreshold
+	}
+	return ""
+}
+
+func (m *MessageBasedParams) GetMinDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MinDeposit
+	}
+	return nil
+}
+
+// ConvictionVoting defines the multiplier applied to the voting power of
+// the locked tokens by their remaining lock duration. The multiplier grows
+// linearly from 1 to max_multiplier over max_lock_duration.
+type ConvictionVoting struct {
+	// max_multiplier is the multiplier of the tokens locked for
+	// max_lock_duration or longer.
+	MaxMultiplier string `protobuf:"bytes,1,opt,name=max_multiplier,json=maxMultiplier,proto3" json:"max_multiplier,omitempty"`
+	// max_lock_duration is the maximum duration of a voting lock.
+	MaxLockDuration time.Duration `protobuf:"bytes,2,opt,name=max_lock_duration,json=maxLockDuration,proto3,stdduration" json:"max_lock_duration"`
+}
+
+func (m *ConvictionVoting) Reset()         { *m = ConvictionVoting{} }
+func (m *ConvictionVoting) String() string { return proto.CompactTextString(m) }
+func (*ConvictionVoting) ProtoMessage()    {}
+func (*ConvictionVoting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6adfe7a550f5e4ec, []int{2}
+}
+func (m *ConvictionVoting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConvictionVoting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConvictionVoting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConvictionVoting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConvictionVoting.Merge(m, src)
+}
+func (m *ConvictionVoting) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConvictionVoting) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConvictionVoting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConvictionVoting proto.InternalMessageInfo
+
+func (m *ConvictionVoting) GetMaxMultiplier() string {
+	if m != nil {
+		return m.MaxMultiplier
+	}
+	return ""
+}
+
+func (m *ConvictionVoting) GetMaxLockDuration() time.Duration {
+	if m != nil {
+		return m.MaxLockDuration
+	}
+	return 0
+}
+
+// VotingLock defines the tokens locked to the gov module for the
+// conviction voting.
+type VotingLock struct {
+	// owner is the address of the lock owner.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// amount is the locked tokens.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// end_time is the time when the tokens can be unlocked.
+	EndTime time.Time `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+}
+
+func (m *VotingLock) Reset()         { *m = VotingLock{} }
+func (m *VotingLock) String() string { return proto.CompactTextString(m) }
+func (*VotingLock) ProtoMessage()    {}
+func (*VotingLock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6adfe7a550f5e4ec, []int{3}
+}
+func (m *VotingLock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VotingLock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VotingLock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VotingLock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VotingLock.Merge(m, src)
+}
+func (m *VotingLock) XXX_Size() int {
+	return m.Size()
+}
+func (m *VotingLock) XXX_DiscardUnknown() {
+	xxx_messageInfo_VotingLock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VotingLock proto.InternalMessageInfo
+
+func (m *VotingLock) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *VotingLock) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *VotingLock) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+// VoteDelegation defines the delegation of the governance vote. The delegate
+// votes on behalf of the delegator, unless the delegator votes directly.
+type VoteDelegation struct {
+	// delegator is the address of the account delegating the vote.
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	// delegate is the address of the account receiving the vote.
+	Delegate string `protobuf:"bytes,2,opt,name=delegate,proto3" json:"delegate,omitempty"`
+	// msg_type is the message type URL of the proposals the delegation is
+	// scoped to. If it is empty, the delegation applies to all proposals.
+	MsgType string `protobuf:"bytes,3,opt,name=msg_type,json=msgType,proto3" json:"msg_type,omitempty"`
+}
+
+func (m *VoteDelegation) Reset()         { *m = VoteDelegation{} }
+func (m *VoteDelegation) String() string { return proto.CompactTextString(m) }
+func (*VoteDelegation) ProtoMessage()    {}
+func (*VoteDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6adfe7a550f5e4ec, []int{4}
+}
+func (m *VoteDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoteDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoteDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VoteDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteDelegation.Merge(m, src)
+}
+func (m *VoteDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoteDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteDelegation proto.InternalMessageInfo
+
+func (m *VoteDelegation) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+func (m *VoteDelegation) GetDelegate() string {
+	if m != nil {
+		return m.Delegate
+	}
+	return ""
+}
+
+func (m *VoteDelegation) GetMsgType() string {
+	if m != nil {
+		return m.MsgType
+	}
+	return ""
+}
+
 // Vesting defines the vesting contract info for MsgVestingVote.
 // At tally time, gov module would check vesting token amount.
 type Vesting struct {
@@ -290,7 +599,7 @@ type Vesting struct {
 func (m *Vesting) Reset()      { *m = Vesting{} }
 func (*Vesting) ProtoMessage() {}
 func (*Vesting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6adfe7a550f5e4ec, []int{1}
+	return fileDescriptor_6adfe7a550f5e4ec, []int{5}
 }
 func (m *Vesting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -346,15 +655,21 @@ type TallyResult struct {
 	TotalStakingPower string `protobuf:"bytes,2,opt,name=total_staking_power,json=totalStakingPower,proto3" json:"total_staking_power,omitempty"`
 	TotalVestingPower string `protobuf:"bytes,3,opt,name=total_vesting_power,json=totalVestingPower,proto3" json:"total_vesting_power,omitempty"`
 	// v1_tally_result is the original TallyResult from cosmos-sdk,
-	// which contains both staking and vesting power.
+	// which contains staking, vesting and conviction weighted lock power.
 	V1TallyResult *v1.TallyResult `protobuf:"bytes,4,opt,name=v1_tally_result,json=v1TallyResult,proto3" json:"v1_tally_result,omitempty"`
+	// total_lock_power is the voting power of the voters' locked tokens
+	// before the conviction multiplier is applied.
+	TotalLockPower string `protobuf:"bytes,5,opt,name=total_lock_power,json=totalLockPower,proto3" json:"total_lock_power,omitempty"`
+	// total_conviction_power is the voting power of the voters' locked tokens
+	// after the conviction multiplier is applied.
+	TotalConvictionPower string `protobuf:"bytes,6,opt,name=total_conviction_power,json=totalConvictionPower,proto3" json:"total_conviction_power,omitempty"`
 }
 
 func (m *TallyResult) Reset()         { *m = TallyResult{} }
 func (m *TallyResult) String() string { return proto.CompactTextString(m) }
 func (*TallyResult) ProtoMessage()    {}
 func (*TallyResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6adfe7a550f5e4ec, []int{2}
+	return fileDescriptor_6adfe7a550f5e4ec, []int{6}
 }
 func (m *TallyResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -411,6 +726,20 @@ func (m *TallyResult) GetV1TallyResult() *v1.TallyResult {
 	return nil
 }
 
+func (m *TallyResult) GetTotalLockPower() string {
+	if m != nil {
+		return m.TotalLockPower
+	}
+	return ""
+}
+
+func (m *TallyResult) GetTotalConvictionPower() string {
+	if m != nil {
+		return m.TotalConvictionPower
+	}
+	return ""
+}
+
 // Proposal defines the core field members of a governance proposal.
 type Proposal struct {
 	// id defines the unique id of the proposal.
@@ -460,13 +789,17 @@ type Proposal struct {
 	//
 	// Since: cosmos-sdk 0.50
 	FailedReason string `protobuf:"bytes,18,opt,name=failed_reason,json=failedReason,proto3" json:"failed_reason,omitempty"`
+	// message_based_params is the strictest message based params among the
+	// proposal messages, resolved at the submission. If it is not set, the
+	// global params are applied.
+	MessageBasedParams *MessageBasedParams `protobuf:"bytes,19,opt,name=message_based_params,json=messageBasedParams,proto3" json:"message_based_params,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
 func (m *Proposal) String() string { return proto.CompactTextString(m) }
 func (*Proposal) ProtoMessage()    {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6adfe7a550f5e4ec, []int{3}
+	return fileDescriptor_6adfe7a550f5e4ec, []int{7}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -621,8 +954,19 @@ func (m *Proposal) GetFailedReason() string {
 	return ""
 }
 
+func (m *Proposal) GetMessageBasedParams() *MessageBasedParams {
+	if m != nil {
+		return m.MessageBasedParams
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "initia.gov.v1.Params")
+	proto.RegisterType((*MessageBasedParams)(nil), "initia.gov.v1.MessageBasedParams")
+	proto.RegisterType((*ConvictionVoting)(nil), "initia.gov.v1.ConvictionVoting")
+	proto.RegisterType((*VotingLock)(nil), "initia.gov.v1.VotingLock")
+	proto.RegisterType((*VoteDelegation)(nil), "initia.gov.v1.VoteDelegation")
 	proto.RegisterType((*Vesting)(nil), "initia.gov.v1.Vesting")
 	proto.RegisterType((*TallyResult)(nil), "initia.gov.v1.TallyResult")
 	proto.RegisterType((*Proposal)(nil), "initia.gov.v1.Proposal")
@@ -631,87 +975,109 @@ func init() {
 func init() { proto.RegisterFile("initia/gov/v1/gov.proto", fileDescriptor_6adfe7a550f5e4ec) }
 
 var fileDescriptor_6adfe7a550f5e4ec = []byte{
-	// 1280 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0x26, 0x6d, 0x62, 0x3f, 0xff, 0x49, 0x32, 0x4d, 0x9b, 0x4d, 0x44, 0xed, 0x10, 0x10,
-	0x0a, 0x15, 0xb5, 0x49, 0xa1, 0x1c, 0x8a, 0x04, 0x8a, 0x9b, 0xa2, 0xa6, 0xa2, 0x55, 0xd8, 0x54,
-	0x11, 0xa2, 0xc0, 0x32, 0xf6, 0x4e, 0x9c, 0x11, 0xbb, 0x3b, 0x66, 0x67, 0xd6, 0x75, 0xbe, 0x02,
-	0xa7, 0x1e, 0x7b, 0xec, 0x91, 0x63, 0x0f, 0xfd, 0x10, 0x3d, 0x56, 0x3d, 0x71, 0x6a, 0x51, 0x73,
-	0x28, 0x1f, 0x80, 0x0f, 0x80, 0xe6, 0xdf, 0xae, 0x6d, 0x4c, 0x15, 0x5f, 0x12, 0xcf, 0x7b, 0xbf,
-	0xf7, 0x9b, 0xf7, 0xde, 0xbc, 0x3f, 0x0b, 0xab, 0x34, 0xa6, 0x82, 0xe2, 0x66, 0x97, 0xf5, 0x9b,
-	0xfd, 0x6d, 0xf9, 0xaf, 0xd1, 0x4b, 0x98, 0x60, 0xa8, 0xa2, 0x15, 0x0d, 0x29, 0xe9, 0x6f, 0xaf,
-	0x2f, 0xe3, 0x88, 0xc6, 0xac, 0xa9, 0xfe, 0x6a, 0xc4, 0x7a, 0xad, 0xc3, 0x78, 0xc4, 0x78, 0xb3,
-	0x8d, 0x39, 0x69, 0xf6, 0xb7, 0xdb, 0x44, 0xe0, 0xed, 0x66, 0x87, 0xd1, 0xd8, 0xe8, 0x57, 0x8d,
-	0x7e, 0x9c, 0x7a, 0x7d, 0x4d, 0x2b, 0x7c, 0x75, 0x6a, 0xea, 0x83, 0x51, 0xad, 0x74, 0x59, 0x97,
-	0x69, 0xb9, 0xfc, 0x65, 0x0d, 0xba, 0x8c, 0x75, 0x43, 0xd2, 0x54, 0xa7, 0x76, 0x7a, 0xd4, 0xc4,
-	0xf1, 0x89, 0x75, 0x62, 0x5c, 0x15, 0xa4, 0x09, 0x16, 0x94, 0x59, 0x27, 0xea, 0xe3, 0x7a, 0x41,
-	0x23, 0xc2, 0x05, 0x8e, 0x7a, 0x1a, 0xb0, 0xf9, 0xbc, 0x04, 0xf3, 0xfb, 0x38, 0xc1, 0x11, 0x47,
-	0xb7, 0xa0, 0x14, 0xd1, 0xd8, 0x0f, 0x48, 0x8f, 0x71, 0x2a, 0x5c, 0x67, 0x63, 0x6e, 0xab, 0x74,
-	0x6d, 0xad, 0x61, 0x1c, 0x94, 0x61, 0x36, 0x4c, 0x98, 0x8d, 0x9b, 0x8c, 0xc6, 0xad, 0xe2, 0xf3,
-	0x57, 0xf5, 0x99, 0x3f, 0xde, 0x3e, 0xbd, 0xe2, 0x78, 0x10, 0xd1, 0x78, 0x57, 0xdb, 0xa1, 0x43,
-	0x40, 0x11, 0x1e, 0x58, 0x1a, 0xbf, 0x47, 0x12, 0xca, 0x02, 0x77, 0x76, 0xc3, 0x51, 0x6c, 0xda,
-	0x9f, 0x86, 0xf5, 0xa7, 0xb1, 0x6b, 0xfc, 0x6d, 0x55, 0x24, 0xdb, 0xe3, 0xd7, 0x75, 0x47, 0x33,
-	0x2e, 0x45, 0x78, 0x60, 0x18, 0xf7, 0x15, 0x03, 0xba, 0x0b, 0x95, 0x3e, 0x13, 0x34, 0xee, 0x5a,
-	0xca, 0xb9, 0x29, 0x29, 0xcb, 0xda, 0xdc, 0xd0, 0x7d, 0x04, 0xf3, 0xbf, 0xa5, 0x2c, 0x49, 0x23,
-	0xf7, 0xdc, 0x86, 0xb3, 0x55, 0x6c, 0x55, 0x5f, 0x3e, 0xbb, 0x0a, 0x26, 0xd6, 0x5d, 0xd2, 0xf1,
-	0x8c, 0x16, 0x7d, 0x02, 0x45, 0x71, 0x9c, 0x10, 0x7e, 0xcc, 0xc2, 0xc0, 0x3d, 0x3f, 0x11, 0x9a,
-	0x03, 0xd0, 0x75, 0xa8, 0xf6, 0x89, 0x60, 0x7e, 0x6e, 0x32, 0x3f, 0xd1, 0xa4, 0x22, 0x51, 0xf7,
-	0x33, 0xb3, 0x3d, 0x58, 0x93, 0xa9, 0xd7, 0x35, 0x17, 0x66, 0xb9, 0x53, 0x71, 0xb8, 0x0b, 0x13,
-	0x19, 0x2e, 0x45, 0x34, 0xde, 0xd3, 0x78, 0x93, 0x27, 0x4f, 0xa2, 0x51, 0x0b, 0x2e, 0xf6, 0x12,
-	0xd6, 0x63, 0x1c, 0x87, 0x7e, 0x07, 0xc7, 0x1d, 0x12, 0x1a, 0x9a, 0xc2, 0x44, 0x9a, 0x0b, 0x16,
-	0x7c, 0x53, 0x61, 0x35, 0xc7, 0x1d, 0x58, 0x19, 0xe7, 0x08, 0x08, 0x17, 0x6e, 0x51, 0x51, 0xb8,
-	0x2f, 0x9f, 0x5d, 0x5d, 0x31, 0x14, 0x3b, 0x41, 0x90, 0x10, 0xce, 0x0f, 0x44, 0x42, 0xe3, 0xae,
-	0x87, 0x46, 0xc9, 0x76, 0x09, 0x17, 0xe8, 0x17, 0x58, 0x25, 0x83, 0x1e, 0x09, 0xa8, 0x20, 0x81,
-	0x3f, 0xfa, 0x80, 0x30, 0xe5, 0x03, 0x5e, 0xcc, 0x88, 0x0e, 0x87, 0x5f, 0xf2, 0x6b, 0xb8, 0x90,
-	0xdf, 0x90, 0x27, 0xbe, 0x34, 0x31, 0x5e, 0x94, 0x41, 0xf3, 0xec, 0x7f, 0x0f, 0x39, 0xb3, 0x3f,
-	0xdc, 0x02, 0xe5, 0x29, 0x5a, 0x20, 0xf7, 0xe1, 0x6e, 0xde, 0x0b, 0x5b, 0xb0, 0xd4, 0x4e, 0x93,
-	0x58, 0xc6, 0x4d, 0x7c, 0x53, 0x6e, 0x95, 0x0d, 0x67, 0xab, 0xe0, 0x55, 0xa5, 0xfc, 0x90, 0x09,
-	0xf2, 0x9d, 0x2e, 0xb3, 0x1d, 0xb8, 0xac, 0x90, 0x59, 0xde, 0xb3, 0xfe, 0x49, 0x88, 0xb4, 0x76,
-	0xab, 0xca, 0x6c, 0x5d, 0x82, 0xf6, 0x0d, 0xc6, 0xf6, 0x87, 0x46, 0xa0, 0x0f, 0xa1, 0x9a, 0x5f,
-	0x26, 0xeb, 0xcb, 0x5d, 0x54, 0x36, 0x65, 0x7b, 0xd5, 0x21, 0x11, 0x0c, 0xdd, 0x80, 0xe5, 0xa1,
-	0x10, 0x4d, 0x6d, 0x2c, 0x4d, 0xcc, 0xd5, 0x62, 0xde, 0xd5, 0xba, 0x2e, 0x64, 0xa2, 0x22, 0x92,
-	0x74, 0x49, 0xdc, 0x39, 0x19, 0x49, 0xd4, 0x83, 0xa9, 0x12, 0x65, 0x29, 0x86, 0x12, 0xd5, 0x06,
-	0x37, 0x67, 0x16, 0x38, 0x0c, 0x4f, 0x7c, 0x1a, 0x0b, 0x92, 0xf4, 0x71, 0xe8, 0xfe, 0x38, 0x65,
-	0x99, 0x5c, 0xca, 0x98, 0xee, 0x4b, 0xa2, 0x3d, 0xc3, 0x83, 0xbe, 0x80, 0xd5, 0x90, 0x3d, 0xcc,
-	0x2b, 0xc4, 0x3f, 0x4a, 0xe3, 0x8e, 0x24, 0xe0, 0xee, 0x4f, 0x1b, 0x73, 0x5b, 0x45, 0xef, 0x62,
-	0xc8, 0x1e, 0x66, 0x55, 0xf1, 0x8d, 0x55, 0xa2, 0x2f, 0x61, 0xa1, 0x4f, 0xb8, 0x2c, 0x38, 0xf7,
-	0x67, 0xe5, 0xca, 0xa5, 0xc6, 0xc8, 0x72, 0x68, 0x1c, 0x6a, 0xad, 0x0a, 0x52, 0xf9, 0x30, 0xe3,
-	0x59, 0x0b, 0xb4, 0x0f, 0x2b, 0x79, 0x60, 0x3c, 0x6d, 0x47, 0x54, 0x08, 0x92, 0x70, 0xd7, 0x97,
-	0x37, 0xb6, 0x2e, 0xff, 0x5f, 0x2b, 0x8d, 0xa7, 0xea, 0x20, 0xb3, 0xbc, 0xb1, 0xfa, 0xf8, 0x49,
-	0x7d, 0xe6, 0xef, 0x27, 0x75, 0xe7, 0xf7, 0xb7, 0x4f, 0xaf, 0x80, 0xdc, 0x2e, 0x7a, 0x7e, 0x6f,
-	0x0e, 0x60, 0xc1, 0x78, 0x82, 0xea, 0x50, 0x8a, 0x58, 0x90, 0x86, 0xc4, 0xc7, 0x41, 0x90, 0xb8,
-	0x8e, 0x7c, 0x5e, 0x0f, 0xb4, 0x48, 0xde, 0x33, 0x04, 0x88, 0x71, 0x44, 0xd4, 0x74, 0xce, 0x00,
-	0xf7, 0x70, 0x44, 0xd0, 0xfb, 0x50, 0xee, 0x24, 0x04, 0x0b, 0x96, 0x68, 0x8a, 0x39, 0x85, 0x28,
-	0x19, 0x99, 0xe4, 0xb8, 0x51, 0xb0, 0x8e, 0x6c, 0xfe, 0xe3, 0x40, 0x49, 0xe5, 0xda, 0x23, 0x3c,
-	0x0d, 0x85, 0x34, 0xd6, 0x6f, 0x78, 0x4c, 0x68, 0xf7, 0x58, 0xa8, 0xfb, 0xcf, 0x79, 0x25, 0x25,
-	0xbb, 0xad, 0x44, 0xe8, 0x2b, 0xb8, 0x20, 0x98, 0xc0, 0xa1, 0xcf, 0x05, 0xfe, 0x55, 0xcd, 0x04,
-	0xf6, 0x90, 0x24, 0xda, 0x91, 0x91, 0x42, 0xdc, 0x8b, 0x85, 0xb7, 0xac, 0xa0, 0x07, 0x1a, 0xb9,
-	0x2f, 0x81, 0xb9, 0xbd, 0x49, 0xb4, 0xb1, 0x9f, 0x7b, 0x87, 0xbd, 0x49, 0x8e, 0xb6, 0x6f, 0xc1,
-	0x62, 0x7f, 0xdb, 0x54, 0x5a, 0xa2, 0xbc, 0x56, 0x7b, 0xa0, 0x74, 0x6d, 0xdd, 0x16, 0xb1, 0x79,
-	0xdc, 0xa1, 0xb8, 0xbc, 0x4a, 0x7f, 0x7b, 0xe8, 0xb8, 0xf9, 0x6a, 0x01, 0x0a, 0xb6, 0x17, 0x51,
-	0x15, 0x66, 0x69, 0x60, 0x22, 0x9d, 0xa5, 0x01, 0xfa, 0x14, 0x0a, 0x11, 0xe1, 0x1c, 0x77, 0x09,
-	0x77, 0x67, 0x55, 0x7b, 0xac, 0xfc, 0xa7, 0x82, 0x77, 0xe2, 0x13, 0x2f, 0x43, 0xa1, 0xeb, 0x30,
-	0xcf, 0x05, 0x16, 0x29, 0x57, 0x51, 0x54, 0xaf, 0x5d, 0x1e, 0xf3, 0xc4, 0x5e, 0x75, 0xa0, 0x40,
-	0x9e, 0x01, 0xa3, 0x03, 0x40, 0x47, 0x34, 0xc6, 0xe1, 0xe4, 0x60, 0x46, 0x2b, 0x75, 0xc8, 0xfb,
-	0xe1, 0x96, 0x5c, 0x52, 0x04, 0xc3, 0x2f, 0xb8, 0x03, 0x25, 0x5d, 0xac, 0xbe, 0xfc, 0x60, 0x50,
-	0x7b, 0x4f, 0xb2, 0x8d, 0x07, 0x70, 0xdf, 0x7e, 0x4d, 0xb4, 0xce, 0x3d, 0x7a, 0x5d, 0x77, 0x3c,
-	0xd0, 0x46, 0x52, 0x8c, 0xee, 0xc0, 0x92, 0x1d, 0x32, 0x24, 0x0e, 0x34, 0xcf, 0xfc, 0x19, 0x79,
-	0xaa, 0xc6, 0xf2, 0x56, 0x1c, 0x28, 0xae, 0x3d, 0xa8, 0xe8, 0xd7, 0xb6, 0x03, 0x67, 0x61, 0x8a,
-	0x81, 0x53, 0x56, 0xa6, 0x76, 0xd2, 0x7c, 0x0b, 0xcb, 0x66, 0x0b, 0x71, 0x81, 0x13, 0x13, 0x5f,
-	0xe1, 0x8c, 0x7e, 0x2d, 0x6a, 0xd3, 0x03, 0x69, 0xa9, 0x1c, 0xbb, 0x0d, 0x46, 0x94, 0xc7, 0x58,
-	0x3c, 0x23, 0x97, 0xf9, 0x9a, 0xb1, 0x21, 0x7a, 0x23, 0x83, 0x22, 0x77, 0x0d, 0xce, 0x48, 0x87,
-	0xf2, 0x59, 0x91, 0x79, 0xf7, 0x00, 0xd6, 0x72, 0xce, 0x98, 0x0c, 0x84, 0xa9, 0x11, 0x45, 0x5c,
-	0x3a, 0x23, 0x71, 0x3e, 0x4e, 0xef, 0x91, 0x81, 0x50, 0x45, 0xa2, 0xc8, 0xd7, 0x65, 0x81, 0x0b,
-	0x1c, 0x60, 0x81, 0xdd, 0xb2, 0x9a, 0x0e, 0xd9, 0x19, 0xad, 0xc0, 0x79, 0x41, 0x45, 0x48, 0xd4,
-	0xb2, 0x2b, 0x7a, 0xfa, 0x80, 0x5c, 0x58, 0xe0, 0x69, 0x14, 0xe1, 0xe4, 0x44, 0x6d, 0xb3, 0xa2,
-	0x67, 0x8f, 0xe8, 0x73, 0x28, 0xe8, 0xc5, 0x47, 0x12, 0xb5, 0xb4, 0xde, 0xf5, 0x91, 0x91, 0x21,
-	0xd1, 0x7b, 0x50, 0xcc, 0x96, 0xae, 0x5a, 0x61, 0x05, 0x2f, 0x17, 0x28, 0xad, 0xf5, 0xdc, 0x5d,
-	0x36, 0x5a, 0x2b, 0x40, 0x1f, 0x40, 0xe5, 0x08, 0xd3, 0x90, 0x04, 0x7e, 0x42, 0x30, 0x67, 0xb1,
-	0x8b, 0x94, 0x47, 0x65, 0x2d, 0xf4, 0x94, 0xac, 0x75, 0xf3, 0xf9, 0x9b, 0x9a, 0xf3, 0xe2, 0x4d,
-	0xcd, 0xf9, 0xeb, 0x4d, 0xcd, 0x79, 0x74, 0x5a, 0x9b, 0x79, 0x71, 0x5a, 0x9b, 0xf9, 0xf3, 0xb4,
-	0x36, 0xf3, 0xc3, 0xc7, 0x5d, 0x2a, 0x8e, 0xd3, 0x76, 0xa3, 0xc3, 0xa2, 0xa6, 0x6e, 0xb1, 0xab,
-	0x21, 0x6e, 0x73, 0xf3, 0xbb, 0x39, 0x50, 0x5f, 0xfd, 0xe2, 0xa4, 0x47, 0x78, 0x7b, 0x5e, 0x65,
-	0xf6, 0xb3, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x15, 0x4e, 0xef, 0x47, 0x6b, 0x0c, 0x00, 0x00,
+	// 1623 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcf, 0x4f, 0x1b, 0xcf,
+	0x15, 0x67, 0x0d, 0x18, 0xfb, 0x19, 0x1b, 0x33, 0x38, 0xb0, 0xa0, 0x06, 0x13, 0x5a, 0x55, 0x34,
+	0x2a, 0x76, 0x49, 0x9b, 0xa8, 0xa2, 0x52, 0x2b, 0x0c, 0xa9, 0x42, 0x14, 0x22, 0xba, 0x20, 0xfa,
+	0x23, 0x6d, 0x36, 0x63, 0xef, 0x60, 0x56, 0xec, 0xee, 0x38, 0x3b, 0x63, 0x63, 0x8e, 0x95, 0x7a,
+	0xea, 0x29, 0xc7, 0xf4, 0x96, 0x4b, 0xa5, 0xaa, 0xa7, 0x1c, 0x72, 0xe9, 0x7f, 0x90, 0x63, 0x94,
+	0x53, 0x4f, 0x4d, 0x95, 0x1c, 0xd2, 0x43, 0x8f, 0xdf, 0x3f, 0xe0, 0xab, 0xf9, 0xb1, 0xbb, 0xb6,
+	0x71, 0xbe, 0xd8, 0xd2, 0xf7, 0x7b, 0xc1, 0x9e, 0x79, 0x9f, 0xf7, 0x99, 0xf7, 0xde, 0xbc, 0x37,
+	0xef, 0x61, 0x58, 0x72, 0x03, 0x97, 0xbb, 0xb8, 0xda, 0xa4, 0x9d, 0x6a, 0x67, 0x4b, 0x7c, 0x54,
+	0x5a, 0x21, 0xe5, 0x14, 0xe5, 0x95, 0xa0, 0x22, 0x76, 0x3a, 0x5b, 0x2b, 0xf3, 0xd8, 0x77, 0x03,
+	0x5a, 0x95, 0x7f, 0x15, 0x62, 0x65, 0xb5, 0x41, 0x99, 0x4f, 0x59, 0xb5, 0x8e, 0x19, 0xa9, 0x76,
+	0xb6, 0xea, 0x84, 0xe3, 0xad, 0x6a, 0x83, 0xba, 0x81, 0x96, 0x2f, 0x69, 0xf9, 0x20, 0xf5, 0xca,
+	0xb2, 0x12, 0xd8, 0x72, 0x55, 0x55, 0x0b, 0x2d, 0x2a, 0x35, 0x69, 0x93, 0xaa, 0x7d, 0xf1, 0x2d,
+	0x52, 0x68, 0x52, 0xda, 0xf4, 0x48, 0x55, 0xae, 0xea, 0xed, 0xd3, 0x2a, 0x0e, 0x2e, 0x23, 0x23,
+	0x06, 0x45, 0x4e, 0x3b, 0xc4, 0xdc, 0xa5, 0x91, 0x11, 0xe5, 0x41, 0x39, 0x77, 0x7d, 0xc2, 0x38,
+	0xf6, 0x5b, 0x0a, 0xb0, 0xfe, 0xe7, 0x3c, 0xa4, 0x0f, 0x71, 0x88, 0x7d, 0x86, 0xee, 0x43, 0xce,
+	0x77, 0x03, 0xdb, 0x21, 0x2d, 0xca, 0x5c, 0x6e, 0x1a, 0x6b, 0x93, 0x1b, 0xb9, 0x3b, 0xcb, 0x15,
+	0x6d, 0xa0, 0x70, 0xb3, 0xa2, 0xdd, 0xac, 0xec, 0x52, 0x37, 0xa8, 0x65, 0xdf, 0xfe, 0xa7, 0x3c,
+	0xf1, 0x8f, 0xcf, 0xaf, 0x6f, 0x1b, 0x16, 0xf8, 0x6e, 0xb0, 0xa7, 0xf4, 0xd0, 0x09, 0x20, 0x1f,
+	0x77, 0x23, 0x1a, 0xbb, 0x45, 0x42, 0x97, 0x3a, 0x66, 0x6a, 0xcd, 0x90, 0x6c, 0xca, 0x9e, 0x4a,
+	0x64, 0x4f, 0x65, 0x4f, 0xdb, 0x5b, 0xcb, 0x0b, 0xb6, 0x97, 0x1f, 0xca, 0x86, 0x62, 0x2c, 0xfa,
+	0xb8, 0xab, 0x19, 0x0f, 0x25, 0x03, 0x3a, 0x80, 0x7c, 0x87, 0x72, 0x37, 0x68, 0x46, 0x94, 0x93,
+	0x63, 0x52, 0xce, 0x2a, 0x75, 0x4d, 0xf7, 0x43, 0x48, 0x3f, 0x6f, 0xd3, 0xb0, 0xed, 0x9b, 0x53,
+	0x6b, 0xc6, 0x46, 0xb6, 0x56, 0x78, 0xff, 0x66, 0x13, 0xb4, 0xaf, 0x7b, 0xa4, 0x61, 0x69, 0x29,
+	0xfa, 0x31, 0x64, 0xf9, 0x59, 0x48, 0xd8, 0x19, 0xf5, 0x1c, 0x73, 0x7a, 0x28, 0x34, 0x01, 0xa0,
+	0xbb, 0x50, 0xe8, 0x10, 0x4e, 0xed, 0x44, 0x25, 0x3d, 0x54, 0x25, 0x2f, 0x50, 0xc7, 0xb1, 0xda,
+	0x3e, 0x2c, 0x8b, 0xd0, 0xab, 0x9c, 0xf3, 0xe2, 0xd8, 0x49, 0x3f, 0xcc, 0x99, 0xa1, 0x0c, 0x8b,
+	0xbe, 0x1b, 0xec, 0x2b, 0xbc, 0x8e, 0x93, 0x25, 0xd0, 0xa8, 0x06, 0x37, 0x5a, 0x21, 0x6d, 0x51,
+	0x86, 0x3d, 0xbb, 0x81, 0x83, 0x06, 0xf1, 0x34, 0x4d, 0x66, 0x28, 0xcd, 0x42, 0x04, 0xde, 0x95,
+	0x58, 0xc5, 0xf1, 0x10, 0x4a, 0x83, 0x1c, 0x0e, 0x61, 0xdc, 0xcc, 0x4a, 0x0a, 0xf3, 0xfd, 0x9b,
+	0xcd, 0x92, 0xa6, 0xd8, 0x71, 0x9c, 0x90, 0x30, 0x76, 0xc4, 0x43, 0x37, 0x68, 0x5a, 0xa8, 0x9f,
+	0x6c, 0x8f, 0x30, 0x8e, 0x9e, 0xc1, 0x12, 0xe9, 0xb6, 0x88, 0xe3, 0x72, 0xe2, 0xd8, 0xfd, 0x17,
+	0x08, 0x63, 0x5e, 0xe0, 0x8d, 0x98, 0xe8, 0xa4, 0xf7, 0x26, 0x7f, 0x05, 0x0b, 0xc9, 0x09, 0x49,
+	0xe0, 0x73, 0x43, 0xfd, 0x45, 0x31, 0x34, 0x89, 0xfe, 0xef, 0x20, 0x61, 0xb6, 0x7b, 0x4b, 0x60,
+	0x76, 0x8c, 0x12, 0x48, 0x6c, 0x38, 0x48, 0x6a, 0x61, 0x03, 0x8a, 0xf5, 0x76, 0x18, 0x08, 0xbf,
+	0x89, 0xad, 0xd3, 0x2d, 0xbf, 0x66, 0x6c, 0x64, 0xac, 0x82, 0xd8, 0x3f, 0xa1, 0x9c, 0xfc, 0x46,
+	0xa5, 0xd9, 0x0e, 0xdc, 0x94, 0xc8, 0x38, 0xee, 0x71, 0xfd, 0x84, 0x44, 0x68, 0x9b, 0x05, 0xa9,
+	0xb6, 0x22, 0x40, 0x87, 0x1a, 0x13, 0xd5, 0x87, 0x42, 0xa0, 0x1f, 0x40, 0x21, 0x39, 0x4c, 0xe4,
+	0x97, 0x39, 0x27, 0x75, 0x66, 0xa3, 0xa3, 0x4e, 0x08, 0xa7, 0x68, 0x1b, 0xe6, 0x7b, 0x5c, 0xd4,
+	0xb9, 0x51, 0x1c, 0x1a, 0xab, 0xb9, 0xa4, 0xaa, 0x55, 0x5e, 0x88, 0x40, 0xf9, 0x24, 0x6c, 0x92,
+	0xa0, 0x71, 0xd9, 0x17, 0xa8, 0x27, 0x63, 0x05, 0x2a, 0xa2, 0xe8, 0x09, 0x54, 0x1d, 0xcc, 0x84,
+	0x99, 0x63, 0xcf, 0xbb, 0xb4, 0xdd, 0x80, 0x93, 0xb0, 0x83, 0x3d, 0xf3, 0x8f, 0x63, 0xa6, 0xc9,
+	0x62, 0xcc, 0x74, 0x2c, 0x88, 0xf6, 0x35, 0x0f, 0xba, 0x07, 0x4b, 0x1e, 0xbd, 0x48, 0x32, 0xc4,
+	0x3e, 0x6d, 0x07, 0x0d, 0x41, 0xc0, 0xcc, 0x3f, 0xad, 0x4d, 0x6e, 0x64, 0xad, 0x1b, 0x1e, 0xbd,
+	0x88, 0xb3, 0xe2, 0xd7, 0x91, 0x10, 0xfd, 0x02, 0x66, 0x3a, 0x84, 0x89, 0x84, 0x33, 0x9f, 0x4a,
+	0x53, 0x16, 0x2b, 0x7d, 0xcd, 0xa1, 0x72, 0xa2, 0xa4, 0xd2, 0x49, 0x69, 0xc3, 0x84, 0x15, 0x69,
+	0xa0, 0x43, 0x28, 0x25, 0x8e, 0xb1, 0x76, 0xdd, 0x77, 0x39, 0x27, 0x21, 0x33, 0x6d, 0x71, 0x62,
+	0xed, 0xe6, 0x97, 0x4a, 0x69, 0x30, 0x54, 0x47, 0xb1, 0x26, 0xfa, 0x2d, 0xcc, 0x37, 0x68, 0xd0,
+	0x71, 0xa5, 0x75, 0xba, 0xa2, 0xcc, 0x67, 0xd2, 0xb0, 0xf2, 0x80, 0x61, 0xbb, 0x31, 0xee, 0x84,
+	0x0e, 0x5a, 0x58, 0x6c, 0x0c, 0x08, 0xd1, 0x53, 0x28, 0xf9, 0x84, 0x31, 0xdc, 0x24, 0xb6, 0xb8,
+	0x40, 0xc7, 0x6e, 0xc9, 0xbe, 0x60, 0x62, 0x79, 0xb9, 0xb7, 0x06, 0xb8, 0x0f, 0x14, 0xb4, 0x26,
+	0x90, 0xaa, 0x81, 0xf4, 0x5e, 0x32, 0xf2, 0xaf, 0x88, 0xb7, 0x97, 0x5e, 0xbe, 0x2a, 0x4f, 0xfc,
+	0xef, 0x55, 0xd9, 0xf8, 0xeb, 0xe7, 0xd7, 0xb7, 0x41, 0xb4, 0x45, 0x25, 0x58, 0xff, 0xcb, 0x24,
+	0xa0, 0xab, 0x74, 0x68, 0x19, 0x32, 0x3e, 0x6b, 0xda, 0xfc, 0xb2, 0x45, 0x4c, 0x43, 0x24, 0xa8,
+	0x35, 0xe3, 0xb3, 0xe6, 0xf1, 0x65, 0x8b, 0x5c, 0xed, 0x05, 0xa9, 0x6f, 0xa9, 0x17, 0x4c, 0x8e,
+	0xde, 0x0b, 0xa6, 0xc6, 0xef, 0x05, 0xd3, 0xa3, 0xf4, 0x82, 0xe7, 0xfd, 0x6d, 0x38, 0x7d, 0x5d,
+	0x69, 0xdd, 0x15, 0x9e, 0xfd, 0xf3, 0x43, 0x79, 0xa3, 0xe9, 0xf2, 0xb3, 0x76, 0xbd, 0xd2, 0xa0,
+	0xbe, 0x1e, 0x2a, 0xf4, 0xc7, 0x26, 0x73, 0xce, 0xab, 0x22, 0x8a, 0x4c, 0x2a, 0xb0, 0x2b, 0x2d,
+	0x7b, 0x7b, 0x4a, 0xdc, 0xca, 0xfa, 0xdf, 0x0d, 0x28, 0x0e, 0x66, 0x8c, 0x70, 0x42, 0x74, 0x73,
+	0xbf, 0xed, 0x71, 0xb7, 0xe5, 0xb9, 0x24, 0x34, 0x8d, 0xe1, 0x4e, 0xf8, 0xb8, 0x7b, 0x10, 0x83,
+	0xd0, 0x31, 0xcc, 0x0b, 0x35, 0x8f, 0x36, 0xce, 0xed, 0x68, 0x24, 0x19, 0xfb, 0x92, 0xe6, 0x7c,
+	0xdc, 0x7d, 0x44, 0x1b, 0xe7, 0x91, 0x5c, 0xdb, 0xf9, 0x7f, 0x03, 0x40, 0x59, 0x27, 0x84, 0xa8,
+	0x02, 0xd3, 0xf4, 0x22, 0x88, 0x0d, 0xfb, 0x72, 0x77, 0x52, 0x30, 0x74, 0x06, 0x69, 0xec, 0xd3,
+	0x76, 0xc0, 0xcd, 0xd4, 0x77, 0x14, 0x5a, 0xcd, 0x8f, 0xf6, 0x20, 0x43, 0x02, 0xc7, 0x16, 0x23,
+	0x97, 0x1e, 0x56, 0x56, 0xae, 0xf8, 0x7e, 0x1c, 0xcd, 0x63, 0xca, 0xf9, 0x17, 0xb1, 0xf3, 0x33,
+	0x24, 0x70, 0x84, 0x70, 0xfd, 0x6f, 0x06, 0x14, 0xc4, 0xeb, 0xbd, 0x47, 0x3c, 0xd2, 0x94, 0x71,
+	0x40, 0xf7, 0x20, 0xeb, 0xa8, 0x15, 0xbd, 0xde, 0xed, 0x04, 0x8a, 0x7e, 0x06, 0x19, 0xbd, 0x20,
+	0x66, 0xea, 0x1a, 0xb5, 0x18, 0xd9, 0x57, 0x87, 0x93, 0x7d, 0x75, 0xb8, 0xde, 0x85, 0x19, 0xfd,
+	0xf8, 0xa1, 0x32, 0xe4, 0x7c, 0xea, 0xb4, 0x3d, 0x62, 0x63, 0xc7, 0xd1, 0x56, 0x59, 0xa0, 0xb6,
+	0x04, 0x73, 0x0f, 0x20, 0xc0, 0xbe, 0x3e, 0x3f, 0x02, 0x3c, 0xc6, 0x3e, 0x41, 0xb7, 0x60, 0xb6,
+	0x11, 0x12, 0x61, 0xa8, 0xa2, 0x50, 0x67, 0xe5, 0xf4, 0x9e, 0xe0, 0xd8, 0xce, 0x44, 0x4f, 0xc8,
+	0xfa, 0x57, 0x29, 0xc8, 0xc9, 0xe7, 0xdd, 0x22, 0xac, 0xed, 0x71, 0xa1, 0xac, 0xda, 0xc6, 0x19,
+	0x71, 0x9b, 0x67, 0x5c, 0x9e, 0x3f, 0x65, 0xe5, 0xe4, 0xde, 0x03, 0xb9, 0x85, 0x7e, 0x09, 0x0b,
+	0x9c, 0x72, 0xec, 0xd9, 0x8c, 0xe3, 0x73, 0xf9, 0x76, 0xd0, 0x0b, 0x12, 0x9a, 0xa9, 0x2b, 0xf9,
+	0xbc, 0x1f, 0x70, 0x6b, 0x5e, 0x42, 0x8f, 0x14, 0xf2, 0x50, 0x00, 0x13, 0x7d, 0xfd, 0xb6, 0x6b,
+	0xfd, 0xc9, 0x6f, 0xd0, 0xd7, 0xc1, 0x51, 0xfa, 0x35, 0x98, 0xeb, 0x6c, 0xe9, 0xe6, 0x16, 0x4a,
+	0xab, 0xcd, 0x29, 0x9d, 0x15, 0x5a, 0x51, 0x3f, 0xad, 0x3d, 0x7e, 0x59, 0xf9, 0xce, 0x56, 0xaf,
+	0x9b, 0x3f, 0x87, 0xa2, 0xb2, 0x41, 0x56, 0x96, 0x32, 0x60, 0x7a, 0xa8, 0x01, 0x05, 0x89, 0x13,
+	0x15, 0xa2, 0x4e, 0xdf, 0x83, 0x45, 0xa5, 0xd9, 0xd3, 0x3c, 0x94, 0xfe, 0xf0, 0x09, 0xb5, 0x24,
+	0xd1, 0xc9, 0x7b, 0x20, 0x59, 0xd6, 0xff, 0x95, 0x81, 0x4c, 0x34, 0x7e, 0xa0, 0x02, 0xa4, 0x5c,
+	0x47, 0x47, 0x3a, 0xe5, 0x3a, 0xe8, 0x27, 0x90, 0xd1, 0xcf, 0x3e, 0xd3, 0xb5, 0x55, 0xba, 0x92,
+	0xef, 0x3b, 0xc1, 0xa5, 0x15, 0xa3, 0xd0, 0x5d, 0x48, 0x33, 0x8e, 0x79, 0x9b, 0xc9, 0x28, 0x16,
+	0xee, 0xdc, 0x1c, 0x88, 0x44, 0x74, 0xd4, 0x91, 0x04, 0x59, 0x1a, 0x8c, 0x8e, 0x00, 0x9d, 0xba,
+	0x01, 0xf6, 0x86, 0x07, 0xb3, 0xbf, 0x4f, 0xf5, 0x44, 0xaf, 0xb7, 0x41, 0x15, 0x25, 0x41, 0x6f,
+	0x68, 0x77, 0x20, 0xa7, 0xfa, 0xb3, 0x2a, 0xd8, 0xe9, 0x6b, 0x0b, 0x76, 0x4a, 0x14, 0xab, 0x05,
+	0x4a, 0x49, 0x6c, 0xa3, 0x87, 0x50, 0x8c, 0xe6, 0xaa, 0xb8, 0xf0, 0xd3, 0x23, 0xf2, 0x14, 0xb4,
+	0xe6, 0x7d, 0x55, 0xf6, 0x68, 0x1f, 0xf2, 0xea, 0xbe, 0xf4, 0xbe, 0x39, 0x33, 0xc6, 0x8c, 0x35,
+	0x2b, 0x55, 0xa3, 0xe1, 0xea, 0x11, 0xcc, 0xeb, 0x6e, 0xc9, 0x38, 0x0e, 0xb5, 0x7f, 0x99, 0x11,
+	0xed, 0x9a, 0x53, 0xaa, 0x47, 0x42, 0x53, 0x1a, 0xf6, 0x00, 0xf4, 0x56, 0xe2, 0x63, 0x76, 0x44,
+	0x2e, 0xdd, 0xb4, 0x23, 0x17, 0xad, 0xbe, 0xd9, 0x28, 0x31, 0x0d, 0x46, 0xa4, 0x43, 0xc9, 0x78,
+	0x14, 0x5b, 0xf7, 0x04, 0x96, 0x13, 0xce, 0x80, 0x74, 0xb9, 0xce, 0x11, 0x49, 0x9c, 0x1b, 0x91,
+	0x38, 0x99, 0x20, 0x1f, 0x93, 0x2e, 0x97, 0x49, 0x22, 0xc9, 0x57, 0x44, 0x82, 0x73, 0xec, 0x60,
+	0x8e, 0xcd, 0x59, 0xf9, 0x3a, 0xc5, 0x6b, 0x54, 0x82, 0x69, 0xee, 0x72, 0x8f, 0xc8, 0xf9, 0x3e,
+	0x6b, 0xa9, 0x05, 0x32, 0x61, 0x86, 0xb5, 0x7d, 0x1f, 0x87, 0x97, 0x72, 0x80, 0xcf, 0x5a, 0xd1,
+	0x52, 0xbc, 0xc5, 0x6a, 0xd6, 0x27, 0xa1, 0x39, 0x77, 0xdd, 0x5b, 0x1c, 0x21, 0xd1, 0xf7, 0x20,
+	0x1b, 0xff, 0x9f, 0x21, 0xa7, 0xf6, 0x8c, 0x95, 0x6c, 0x48, 0x69, 0x64, 0xb9, 0x39, 0xaf, 0xa5,
+	0xd1, 0x06, 0xfa, 0x3e, 0xe4, 0x4f, 0xb1, 0xeb, 0x11, 0xc7, 0x0e, 0x09, 0x66, 0x34, 0x30, 0x91,
+	0xb4, 0x68, 0x56, 0x6d, 0x5a, 0x72, 0x0f, 0xfd, 0xfe, 0x0b, 0x43, 0xe0, 0xc2, 0x9a, 0x31, 0xda,
+	0x10, 0x38, 0x25, 0x46, 0xcc, 0x61, 0xf3, 0x5f, 0x6d, 0xf7, 0xed, 0xc7, 0x55, 0xe3, 0xdd, 0xc7,
+	0x55, 0xe3, 0xbf, 0x1f, 0x57, 0x8d, 0x17, 0x9f, 0x56, 0x27, 0xde, 0x7d, 0x5a, 0x9d, 0xf8, 0xf7,
+	0xa7, 0xd5, 0x89, 0x3f, 0xfc, 0xa8, 0xa7, 0xbf, 0xaa, 0x03, 0x36, 0x3d, 0x5c, 0x67, 0xfa, 0x7b,
+	0xb5, 0x2b, 0x7f, 0x43, 0x91, 0x6d, 0xb6, 0x9e, 0x96, 0x97, 0xf6, 0xd3, 0xaf, 0x07, 0x00, 0x58,
+	0x8c, 0x63, 0x05, 0xb9, 0x11, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -821,16 +1187,27 @@ func (this *Params) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if !this.ConvictionVoting.Equal(that1.ConvictionVoting) {
+		return false
+	}
+	if len(this.MessageBasedParams) != len(that1.MessageBasedParams) {
+		return false
+	}
+	for i := range this.MessageBasedParams {
+		if !this.MessageBasedParams[i].Equal(&that1.MessageBasedParams[i]) {
+			return false
+		}
+	}
 	return true
 }
-func (this *Vesting) Equal(that interface{}) bool {
+func (this *MessageBasedParams) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Vesting)
+	that1, ok := that.(*MessageBasedParams)
 	if !ok {
-		that2, ok := that.(Vesting)
+		that2, ok := that.(MessageBasedParams)
 		if ok {
 			that1 = &that2
 		} else {
@@ -842,37 +1219,138 @@ func (this *Vesting) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.ModuleAddr != that1.ModuleAddr {
+	if this.MsgType != that1.MsgType {
 		return false
 	}
-	if this.ModuleName != that1.ModuleName {
+	if this.VotingPeriod != that1.VotingPeriod {
 		return false
 	}
-	if this.CreatorAddr != that1.CreatorAddr {
+	if this.Quorum != that1.Quorum {
+		return false
+	}
+	if this.Threshold != that1.Threshold {
+		return false
+	}
+	if this.VetoThreshold != that1.VetoThreshold {
+		return false
+	}
+	if len(this.MinDeposit) != len(that1.MinDeposit) {
 		return false
 	}
+	for i := range this.MinDeposit {
+		if !this.MinDeposit[i].Equal(&that1.MinDeposit[i]) {
+			return false
+		}
+	}
 	return true
 }
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *ConvictionVoting) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*ConvictionVoting)
+	if !ok {
+		that2, ok := that.(ConvictionVoting)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MaxMultiplier != that1.MaxMultiplier {
+		return false
+	}
+	if this.MaxLockDuration != that1.MaxLockDuration {
+		return false
+	}
+	return true
 }
-
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
+func (this *Vesting) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Vesting)
+	if !ok {
+		that2, ok := that.(Vesting)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ModuleAddr != that1.ModuleAddr {
+		return false
+	}
+	if this.ModuleName != that1.ModuleName {
+		return false
+	}
+	if this.CreatorAddr != that1.CreatorAddr {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.MessageBasedParams) > 0 {
+		for iNdEx := len(m.MessageBasedParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MessageBasedParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if m.ConvictionVoting != nil {
+		{
+			size, err := m.ConvictionVoting.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x82
+	}
 	if len(m.EmergencySubmitters) > 0 {
 		for iNdEx := len(m.EmergencySubmitters) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.EmergencySubmitters[iNdEx])
@@ -909,12 +1387,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0xea
 		}
 	}
-	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.EmergencyTallyInterval, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.EmergencyTallyInterval):])
-	if err2 != nil {
-		return 0, err2
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.EmergencyTallyInterval, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.EmergencyTallyInterval):])
+	if err3 != nil {
+		return 0, err3
 	}
-	i -= n2
-	i = encodeVarintGov(dAtA, i, uint64(n2))
+	i -= n3
+	i = encodeVarintGov(dAtA, i, uint64(n3))
 	i--
 	dAtA[i] = 0x5
 	i--
@@ -995,12 +1473,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x5a
 	}
-	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ExpeditedVotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ExpeditedVotingPeriod):])
-	if err3 != nil {
-		return 0, err3
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ExpeditedVotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ExpeditedVotingPeriod):])
+	if err4 != nil {
+		return 0, err4
 	}
-	i -= n3
-	i = encodeVarintGov(dAtA, i, uint64(n3))
+	i -= n4
+	i = encodeVarintGov(dAtA, i, uint64(n4))
 	i--
 	dAtA[i] = 0x52
 	if len(m.ProposalCancelDest) > 0 {
@@ -1045,21 +1523,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x22
 	}
-	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VotingPeriod):])
-	if err4 != nil {
-		return 0, err4
-	}
-	i -= n4
-	i = encodeVarintGov(dAtA, i, uint64(n4))
-	i--
-	dAtA[i] = 0x1a
-	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxDepositPeriod):])
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VotingPeriod):])
 	if err5 != nil {
 		return 0, err5
 	}
 	i -= n5
 	i = encodeVarintGov(dAtA, i, uint64(n5))
 	i--
+	dAtA[i] = 0x1a
+	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxDepositPeriod):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintGov(dAtA, i, uint64(n6))
+	i--
 	dAtA[i] = 0x12
 	if len(m.MinDeposit) > 0 {
 		for iNdEx := len(m.MinDeposit) - 1; iNdEx >= 0; iNdEx-- {
@@ -1078,6 +1556,213 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MessageBasedParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageBasedParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageBasedParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MinDeposit) > 0 {
+		for iNdEx := len(m.MinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.VetoThreshold) > 0 {
+		i -= len(m.VetoThreshold)
+		copy(dAtA[i:], m.VetoThreshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.VetoThreshold)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Threshold) > 0 {
+		i -= len(m.Threshold)
+		copy(dAtA[i:], m.Threshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Threshold)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Quorum) > 0 {
+		i -= len(m.Quorum)
+		copy(dAtA[i:], m.Quorum)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Quorum)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VotingPeriod):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintGov(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x12
+	if len(m.MsgType) > 0 {
+		i -= len(m.MsgType)
+		copy(dAtA[i:], m.MsgType)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.MsgType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConvictionVoting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConvictionVoting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConvictionVoting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxLockDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxLockDuration):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintGov(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x12
+	if len(m.MaxMultiplier) > 0 {
+		i -= len(m.MaxMultiplier)
+		copy(dAtA[i:], m.MaxMultiplier)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.MaxMultiplier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VotingLock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VotingLock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VotingLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintGov(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VoteDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoteDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoteDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgType) > 0 {
+		i -= len(m.MsgType)
+		copy(dAtA[i:], m.MsgType)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.MsgType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Vesting) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1142,6 +1827,20 @@ func (m *TallyResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TotalConvictionPower) > 0 {
+		i -= len(m.TotalConvictionPower)
+		copy(dAtA[i:], m.TotalConvictionPower)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.TotalConvictionPower)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.TotalLockPower) > 0 {
+		i -= len(m.TotalLockPower)
+		copy(dAtA[i:], m.TotalLockPower)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.TotalLockPower)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.V1TallyResult != nil {
 		{
 			size, err := m.V1TallyResult.MarshalToSizedBuffer(dAtA[:i])
@@ -1196,13 +1895,27 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.FailedReason) > 0 {
-		i -= len(m.FailedReason)
-		copy(dAtA[i:], m.FailedReason)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.FailedReason)))
-		i--
-		dAtA[i] = 0x1
-		i--
+	if m.MessageBasedParams != nil {
+		{
+			size, err := m.MessageBasedParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.FailedReason) > 0 {
+		i -= len(m.FailedReason)
+		copy(dAtA[i:], m.FailedReason)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.FailedReason)))
+		i--
+		dAtA[i] = 0x1
+		i--
 		dAtA[i] = 0x92
 	}
 	if m.Emergency {
@@ -1258,42 +1971,42 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x62
 	}
 	if m.EmergencyNextTallyTime != nil {
-		n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EmergencyNextTallyTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EmergencyNextTallyTime):])
-		if err7 != nil {
-			return 0, err7
+		n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EmergencyNextTallyTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EmergencyNextTallyTime):])
+		if err12 != nil {
+			return 0, err12
 		}
-		i -= n7
-		i = encodeVarintGov(dAtA, i, uint64(n7))
+		i -= n12
+		i = encodeVarintGov(dAtA, i, uint64(n12))
 		i--
 		dAtA[i] = 0x5a
 	}
 	if m.EmergencyStartTime != nil {
-		n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EmergencyStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EmergencyStartTime):])
-		if err8 != nil {
-			return 0, err8
+		n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EmergencyStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EmergencyStartTime):])
+		if err13 != nil {
+			return 0, err13
 		}
-		i -= n8
-		i = encodeVarintGov(dAtA, i, uint64(n8))
+		i -= n13
+		i = encodeVarintGov(dAtA, i, uint64(n13))
 		i--
 		dAtA[i] = 0x52
 	}
 	if m.VotingEndTime != nil {
-		n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingEndTime):])
-		if err9 != nil {
-			return 0, err9
+		n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingEndTime):])
+		if err14 != nil {
+			return 0, err14
 		}
-		i -= n9
-		i = encodeVarintGov(dAtA, i, uint64(n9))
+		i -= n14
+		i = encodeVarintGov(dAtA, i, uint64(n14))
 		i--
 		dAtA[i] = 0x4a
 	}
 	if m.VotingStartTime != nil {
-		n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingStartTime):])
-		if err10 != nil {
-			return 0, err10
+		n15, err15 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingStartTime):])
+		if err15 != nil {
+			return 0, err15
 		}
-		i -= n10
-		i = encodeVarintGov(dAtA, i, uint64(n10))
+		i -= n15
+		i = encodeVarintGov(dAtA, i, uint64(n15))
 		i--
 		dAtA[i] = 0x42
 	}
@@ -1312,22 +2025,22 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if m.DepositEndTime != nil {
-		n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DepositEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DepositEndTime):])
-		if err11 != nil {
-			return 0, err11
+		n16, err16 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DepositEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DepositEndTime):])
+		if err16 != nil {
+			return 0, err16
 		}
-		i -= n11
-		i = encodeVarintGov(dAtA, i, uint64(n11))
+		i -= n16
+		i = encodeVarintGov(dAtA, i, uint64(n16))
 		i--
 		dAtA[i] = 0x32
 	}
 	if m.SubmitTime != nil {
-		n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime):])
-		if err12 != nil {
-			return 0, err12
+		n17, err17 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime):])
+		if err17 != nil {
+			return 0, err17
 		}
-		i -= n12
-		i = encodeVarintGov(dAtA, i, uint64(n12))
+		i -= n17
+		i = encodeVarintGov(dAtA, i, uint64(n17))
 		i--
 		dAtA[i] = 0x2a
 	}
@@ -1468,6 +2181,106 @@ func (m *Params) Size() (n int) {
 			n += 2 + l + sovGov(uint64(l))
 		}
 	}
+	if m.ConvictionVoting != nil {
+		l = m.ConvictionVoting.Size()
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if len(m.MessageBasedParams) > 0 {
+		for _, e := range m.MessageBasedParams {
+			l = e.Size()
+			n += 2 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MessageBasedParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgType)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VotingPeriod)
+	n += 1 + l + sovGov(uint64(l))
+	l = len(m.Quorum)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Threshold)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.VetoThreshold)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.MinDeposit) > 0 {
+		for _, e := range m.MinDeposit {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConvictionVoting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MaxMultiplier)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxLockDuration)
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func (m *VotingLock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func (m *VoteDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.MsgType)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -1513,6 +2326,14 @@ func (m *TallyResult) Size() (n int) {
 		l = m.V1TallyResult.Size()
 		n += 1 + l + sovGov(uint64(l))
 	}
+	l = len(m.TotalLockPower)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.TotalConvictionPower)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -1592,6 +2413,10 @@ func (m *Proposal) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovGov(uint64(l))
 	}
+	if m.MessageBasedParams != nil {
+		l = m.MessageBasedParams.Size()
+		n += 2 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -1632,7 +2457,524 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinDeposit = append(m.MinDeposit, types.Coin{})
+			if err := m.MinDeposit[len(m.MinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDepositPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxDepositPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quorum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Threshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VetoThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinInitialDepositRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinInitialDepositRatio = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalCancelRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalCancelRatio = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalCancelDest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalCancelDest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedVotingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.ExpeditedVotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpeditedThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedMinDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpeditedMinDeposit = append(m.ExpeditedMinDeposit, types.Coin{})
+			if err := m.ExpeditedMinDeposit[len(m.ExpeditedMinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnVoteQuorum", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BurnVoteQuorum = bool(v != 0)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnProposalDepositPrevote", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BurnProposalDepositPrevote = bool(v != 0)
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnVoteVeto", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BurnVoteVeto = bool(v != 0)
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDepositRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinDepositRatio = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 91:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmergencyMinDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EmergencyMinDeposit = append(m.EmergencyMinDeposit, types.Coin{})
+			if err := m.EmergencyMinDeposit[len(m.EmergencyMinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 92:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmergencyTallyInterval", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1659,16 +3001,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinDeposit = append(m.MinDeposit, types.Coin{})
-			if err := m.MinDeposit[len(m.MinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.EmergencyTallyInterval, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 93:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxDepositPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LowThresholdFunctions", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -1678,28 +3019,27 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxDepositPeriod, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.LowThresholdFunctions = append(m.LowThresholdFunctions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 94:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vesting", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1726,13 +3066,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+			if m.Vesting == nil {
+				m.Vesting = &Vesting{}
+			}
+			if err := m.Vesting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 95:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EmergencySubmitters", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1760,13 +3103,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Quorum = string(dAtA[iNdEx:postIndex])
+			m.EmergencySubmitters = append(m.EmergencySubmitters, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 5:
+		case 96:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConvictionVoting", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -1776,29 +3119,33 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Threshold = string(dAtA[iNdEx:postIndex])
+			if m.ConvictionVoting == nil {
+				m.ConvictionVoting = &ConvictionVoting{}
+			}
+			if err := m.ConvictionVoting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 97:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageBasedParams", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -1808,27 +3155,79 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VetoThreshold = string(dAtA[iNdEx:postIndex])
+			m.MessageBasedParams = append(m.MessageBasedParams, MessageBasedParams{})
+			if err := m.MessageBasedParams[len(m.MessageBasedParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MessageBasedParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageBasedParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageBasedParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinInitialDepositRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1856,13 +3255,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinInitialDepositRatio = string(dAtA[iNdEx:postIndex])
+			m.MsgType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalCancelRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -1872,27 +3271,28 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProposalCancelRatio = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalCancelDest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1920,13 +3320,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProposalCancelDest = string(dAtA[iNdEx:postIndex])
+			m.Quorum = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedVotingPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -1936,28 +3336,27 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.ExpeditedVotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Threshold = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1985,11 +3384,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExpeditedThreshold = string(dAtA[iNdEx:postIndex])
+			m.VetoThreshold = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedMinDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2016,36 +3415,66 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExpeditedMinDeposit = append(m.ExpeditedMinDeposit, types.Coin{})
-			if err := m.ExpeditedMinDeposit[len(m.ExpeditedMinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MinDeposit = append(m.MinDeposit, types.Coin{})
+			if err := m.MinDeposit[len(m.MinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnVoteQuorum", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
 			}
-			m.BurnVoteQuorum = bool(v != 0)
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnProposalDepositPrevote", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConvictionVoting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConvictionVoting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConvictionVoting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMultiplier", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2055,17 +3484,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.BurnProposalDepositPrevote = bool(v != 0)
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnVoteVeto", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
 			}
-			var v int
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxMultiplier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLockDuration", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2075,15 +3516,78 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.BurnVoteVeto = bool(v != 0)
-		case 16:
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxLockDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VotingLock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VotingLock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VotingLock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinDepositRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2111,11 +3615,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinDepositRatio = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 91:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EmergencyMinDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2142,14 +3646,14 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EmergencyMinDeposit = append(m.EmergencyMinDeposit, types.Coin{})
-			if err := m.EmergencyMinDeposit[len(m.EmergencyMinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 92:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EmergencyTallyInterval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2176,13 +3680,63 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.EmergencyTallyInterval, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 93:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VoteDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoteDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoteDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LowThresholdFunctions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2210,13 +3764,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LowThresholdFunctions = append(m.LowThresholdFunctions, string(dAtA[iNdEx:postIndex]))
+			m.Delegator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 94:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vesting", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2226,31 +3780,27 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Vesting == nil {
-				m.Vesting = &Vesting{}
-			}
-			if err := m.Vesting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Delegate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 95:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EmergencySubmitters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2278,7 +3828,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EmergencySubmitters = append(m.EmergencySubmitters, string(dAtA[iNdEx:postIndex]))
+			m.MsgType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2595,6 +4145,70 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalLockPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalLockPower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalConvictionPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalConvictionPower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -3200,6 +4814,42 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			}
 			m.FailedReason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageBasedParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MessageBasedParams == nil {
+				m.MessageBasedParams = &MessageBasedParams{}
+			}
+			if err := m.MessageBasedParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
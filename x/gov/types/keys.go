@@ -1,6 +1,14 @@
 package types
 
+const (
+	// VotingLockPoolName is the name of the module account escrowing the voting locks
+	VotingLockPoolName = "gov_voting_lock_pool"
+)
+
 var (
 	EmergencyProposalsPrefix     = []byte{0x90}
 	EmergencyProposalQueuePrefix = []byte{0x91}
+	VotingLocksPrefix            = []byte{0x92}
+	VoteDelegationsPrefix        = []byte{0x93}
+	VoteDelegatorsPrefix         = []byte{0x94}
 )
@@ -0,0 +1,117 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewMessageBasedParams creates a new MessageBasedParams instance
+func NewMessageBasedParams(msgType string, votingPeriod time.Duration, quorum, threshold, vetoThreshold string, minDeposit sdk.Coins) MessageBasedParams {
+	return MessageBasedParams{
+		MsgType:       msgType,
+		VotingPeriod:  votingPeriod,
+		Quorum:        quorum,
+		Threshold:     threshold,
+		VetoThreshold: vetoThreshold,
+		MinDeposit:    minDeposit,
+	}
+}
+
+// Validate checks for the validity of the MessageBasedParams struct
+func (m MessageBasedParams) Validate() error {
+	if m.MsgType == "" {
+		return errors.New("message type cannot be empty")
+	}
+
+	if m.MinDeposit.Empty() || !m.MinDeposit.IsValid() {
+		return fmt.Errorf("invalid minimum deposit of %s: %s", m.MsgType, m.MinDeposit)
+	}
+
+	if m.VotingPeriod <= 0 {
+		return fmt.Errorf("voting period of %s must be positive: %s", m.MsgType, m.VotingPeriod)
+	}
+
+	quorum, err := math.LegacyNewDecFromStr(m.Quorum)
+	if err != nil {
+		return fmt.Errorf("invalid quorum string of %s: %w", m.MsgType, err)
+	}
+	if quorum.IsNegative() || quorum.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("quorum of %s must be in [0, 1]: %s", m.MsgType, quorum)
+	}
+
+	threshold, err := math.LegacyNewDecFromStr(m.Threshold)
+	if err != nil {
+		return fmt.Errorf("invalid threshold string of %s: %w", m.MsgType, err)
+	}
+	if !threshold.IsPositive() || threshold.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("vote threshold of %s must be in (0, 1]: %s", m.MsgType, threshold)
+	}
+
+	vetoThreshold, err := math.LegacyNewDecFromStr(m.VetoThreshold)
+	if err != nil {
+		return fmt.Errorf("invalid veto threshold string of %s: %w", m.MsgType, err)
+	}
+	if !vetoThreshold.IsPositive() || vetoThreshold.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("veto threshold of %s must be in (0, 1]: %s", m.MsgType, vetoThreshold)
+	}
+
+	return nil
+}
+
+// Strictest returns the params which take the strictest value of each field
+// from the two params; the longest voting period, the highest quorum and threshold,
+// the lowest veto threshold and the highest min deposit.
+func (m MessageBasedParams) Strictest(other MessageBasedParams) MessageBasedParams {
+	return MessageBasedParams{
+		MsgType:       m.MsgType,
+		VotingPeriod:  max(m.VotingPeriod, other.VotingPeriod),
+		Quorum:        math.LegacyMaxDec(math.LegacyMustNewDecFromStr(m.Quorum), math.LegacyMustNewDecFromStr(other.Quorum)).String(),
+		Threshold:     math.LegacyMaxDec(math.LegacyMustNewDecFromStr(m.Threshold), math.LegacyMustNewDecFromStr(other.Threshold)).String(),
+		VetoThreshold: math.LegacyMinDec(math.LegacyMustNewDecFromStr(m.VetoThreshold), math.LegacyMustNewDecFromStr(other.VetoThreshold)).String(),
+		MinDeposit:    m.MinDeposit.Max(other.MinDeposit),
+	}
+}
+
+// FindMessageBasedParams returns the message based params of the given
+// message type, if registered.
+func (p Params) FindMessageBasedParams(msgType string) (MessageBasedParams, bool) {
+	for _, m := range p.MessageBasedParams {
+		if m.MsgType == msgType {
+			return m, true
+		}
+	}
+
+	return MessageBasedParams{}, false
+}
+
+// DefaultMessageBasedParams returns the global params as message based params,
+// which are applied to the messages without overrides.
+func (p Params) DefaultMessageBasedParams() MessageBasedParams {
+	return NewMessageBasedParams("", p.VotingPeriod, p.Quorum, p.Threshold, p.VetoThreshold, sdk.NewCoins(p.MinDeposit...))
+}
+
+// WithMessageBasedParams returns the params overridden by the given message based
+// params. The expedited params are adjusted to be no weaker than the overridden
+// regular params.
+func (p Params) WithMessageBasedParams(m *MessageBasedParams) Params {
+	if m == nil {
+		return p
+	}
+
+	p.VotingPeriod = m.VotingPeriod
+	p.Quorum = m.Quorum
+	p.Threshold = m.Threshold
+	p.VetoThreshold = m.VetoThreshold
+	p.MinDeposit = sdk.NewCoins(m.MinDeposit...)
+
+	p.ExpeditedVotingPeriod = min(p.ExpeditedVotingPeriod, m.VotingPeriod)
+	p.ExpeditedThreshold = math.LegacyMaxDec(math.LegacyMustNewDecFromStr(p.ExpeditedThreshold), math.LegacyMustNewDecFromStr(m.Threshold)).String()
+	p.ExpeditedMinDeposit = m.MinDeposit.Max(p.ExpeditedMinDeposit)
+
+	return p
+}
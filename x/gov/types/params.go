@@ -219,6 +219,24 @@ func (p Params) Validate(ac address.Codec) error {
 		return fmt.Errorf("emergency minimum deposit must be greater than or equal to expedited minimum deposit")
 	}
 
+	if p.ConvictionVoting != nil {
+		if err := p.ConvictionVoting.Validate(); err != nil {
+			return err
+		}
+	}
+
+	msgTypes := make(map[string]bool, len(p.MessageBasedParams))
+	for _, m := range p.MessageBasedParams {
+		if err := m.Validate(); err != nil {
+			return err
+		}
+
+		if msgTypes[m.MsgType] {
+			return fmt.Errorf("duplicate message based params: %s", m.MsgType)
+		}
+		msgTypes[m.MsgType] = true
+	}
+
 	return nil
 }
 
@@ -105,3 +105,49 @@ func Test_Params_IsLowThresholdFunction(t *testing.T) {
 	params.LowThresholdFunctions = []string{"foo"}
 	require.True(t, params.IsLowThresholdFunction("foo"))
 }
+
+func Test_Params_MessageBasedParams(t *testing.T) {
+	ac := address.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	params := types.DefaultParams()
+
+	override := types.NewMessageBasedParams(
+		"/cosmos.upgrade.v1beta1.MsgSoftwareUpgrade",
+		params.VotingPeriod*2,
+		"0.5",
+		"0.8",
+		"0.2",
+		sdk.NewCoins(sdk.NewCoin(params.MinDeposit[0].Denom, math.NewInt(200_000_000))),
+	)
+	params.MessageBasedParams = []types.MessageBasedParams{override}
+	require.NoError(t, params.Validate(ac))
+
+	// duplicate message type
+	params.MessageBasedParams = []types.MessageBasedParams{override, override}
+	require.Error(t, params.Validate(ac))
+
+	invalid := override
+	invalid.Threshold = "0"
+	params.MessageBasedParams = []types.MessageBasedParams{invalid}
+	require.Error(t, params.Validate(ac))
+
+	params.MessageBasedParams = []types.MessageBasedParams{override}
+	m, found := params.FindMessageBasedParams(override.MsgType)
+	require.True(t, found)
+	require.Equal(t, override, m)
+
+	// the strictest value of each field is taken
+	strictest := params.DefaultMessageBasedParams().Strictest(override)
+	require.Equal(t, override.VotingPeriod, strictest.VotingPeriod)
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.5"), math.LegacyMustNewDecFromStr(strictest.Quorum))
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.8"), math.LegacyMustNewDecFromStr(strictest.Threshold))
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.2"), math.LegacyMustNewDecFromStr(strictest.VetoThreshold))
+	require.Equal(t, override.MinDeposit, strictest.MinDeposit)
+
+	// the expedited params are not weaker than the overridden params
+	overridden := params.WithMessageBasedParams(&override)
+	require.Equal(t, override.VotingPeriod, overridden.VotingPeriod)
+	require.Equal(t, params.ExpeditedVotingPeriod, overridden.ExpeditedVotingPeriod)
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.8"), math.LegacyMustNewDecFromStr(overridden.ExpeditedThreshold))
+	require.Equal(t, sdk.Coins(override.MinDeposit), sdk.Coins(overridden.ExpeditedMinDeposit))
+	require.Equal(t, params, params.WithMessageBasedParams(nil))
+}
@@ -149,9 +149,11 @@ func ValidProposalStatus(status v1.ProposalStatus) bool {
 func EmptyTallyResult() TallyResult {
 	v1TallyResult := v1.EmptyTallyResult()
 	return TallyResult{
-		TallyHeight:       0,
-		TotalStakingPower: math.ZeroInt().String(),
-		TotalVestingPower: math.ZeroInt().String(),
-		V1TallyResult:     &v1TallyResult,
+		TallyHeight:          0,
+		TotalStakingPower:    math.ZeroInt().String(),
+		TotalVestingPower:    math.ZeroInt().String(),
+		TotalLockPower:       math.ZeroInt().String(),
+		TotalConvictionPower: math.LegacyZeroDec().String(),
+		V1TallyResult:        &v1TallyResult,
 	}
 }
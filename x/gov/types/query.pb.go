@@ -525,6 +525,234 @@ func (m *QueryTallyResultResponse) GetTallyResult() TallyResult {
 	return TallyResult{}
 }
 
+// QueryEffectiveVoteDelegateRequest is the request type for the
+// Query/EffectiveVoteDelegate RPC method.
+type QueryEffectiveVoteDelegateRequest struct {
+	// delegator defines the address of the delegator.
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	// msg_type defines the message type URL of the proposal topic.
+	// If it is empty, only the unscoped delegations are followed.
+	MsgType string `protobuf:"bytes,2,opt,name=msg_type,json=msgType,proto3" json:"msg_type,omitempty"`
+}
+
+func (m *QueryEffectiveVoteDelegateRequest) Reset()         { *m = QueryEffectiveVoteDelegateRequest{} }
+func (m *QueryEffectiveVoteDelegateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEffectiveVoteDelegateRequest) ProtoMessage()    {}
+func (*QueryEffectiveVoteDelegateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7763af3b20d09a12, []int{10}
+}
+func (m *QueryEffectiveVoteDelegateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEffectiveVoteDelegateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEffectiveVoteDelegateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEffectiveVoteDelegateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEffectiveVoteDelegateRequest.Merge(m, src)
+}
+func (m *QueryEffectiveVoteDelegateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEffectiveVoteDelegateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEffectiveVoteDelegateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEffectiveVoteDelegateRequest proto.InternalMessageInfo
+
+func (m *QueryEffectiveVoteDelegateRequest) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+func (m *QueryEffectiveVoteDelegateRequest) GetMsgType() string {
+	if m != nil {
+		return m.MsgType
+	}
+	return ""
+}
+
+// QueryEffectiveVoteDelegateResponse is the response type for the
+// Query/EffectiveVoteDelegate RPC method.
+type QueryEffectiveVoteDelegateResponse struct {
+	// delegate is the address at the end of the delegation chain.
+	// It is empty if the delegator has no vote delegation.
+	Delegate string `protobuf:"bytes,1,opt,name=delegate,proto3" json:"delegate,omitempty"`
+	// chain is the delegates in the delegation chain, in order.
+	Chain []string `protobuf:"bytes,2,rep,name=chain,proto3" json:"chain,omitempty"`
+}
+
+func (m *QueryEffectiveVoteDelegateResponse) Reset()         { *m = QueryEffectiveVoteDelegateResponse{} }
+func (m *QueryEffectiveVoteDelegateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEffectiveVoteDelegateResponse) ProtoMessage()    {}
+func (*QueryEffectiveVoteDelegateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7763af3b20d09a12, []int{11}
+}
+func (m *QueryEffectiveVoteDelegateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEffectiveVoteDelegateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEffectiveVoteDelegateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEffectiveVoteDelegateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEffectiveVoteDelegateResponse.Merge(m, src)
+}
+func (m *QueryEffectiveVoteDelegateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEffectiveVoteDelegateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEffectiveVoteDelegateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEffectiveVoteDelegateResponse proto.InternalMessageInfo
+
+func (m *QueryEffectiveVoteDelegateResponse) GetDelegate() string {
+	if m != nil {
+		return m.Delegate
+	}
+	return ""
+}
+
+func (m *QueryEffectiveVoteDelegateResponse) GetChain() []string {
+	if m != nil {
+		return m.Chain
+	}
+	return nil
+}
+
+// QueryDelegatedVotingPowerRequest is the request type for the
+// Query/DelegatedVotingPower RPC method.
+type QueryDelegatedVotingPowerRequest struct {
+	// delegate defines the address of the delegate.
+	Delegate string `protobuf:"bytes,1,opt,name=delegate,proto3" json:"delegate,omitempty"`
+	// msg_type defines the message type URL of the proposal topic.
+	// If it is empty, only the unscoped delegations are followed.
+	MsgType string `protobuf:"bytes,2,opt,name=msg_type,json=msgType,proto3" json:"msg_type,omitempty"`
+}
+
+func (m *QueryDelegatedVotingPowerRequest) Reset()         { *m = QueryDelegatedVotingPowerRequest{} }
+func (m *QueryDelegatedVotingPowerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatedVotingPowerRequest) ProtoMessage()    {}
+func (*QueryDelegatedVotingPowerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7763af3b20d09a12, []int{12}
+}
+func (m *QueryDelegatedVotingPowerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatedVotingPowerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatedVotingPowerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatedVotingPowerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatedVotingPowerRequest.Merge(m, src)
+}
+func (m *QueryDelegatedVotingPowerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatedVotingPowerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatedVotingPowerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatedVotingPowerRequest proto.InternalMessageInfo
+
+func (m *QueryDelegatedVotingPowerRequest) GetDelegate() string {
+	if m != nil {
+		return m.Delegate
+	}
+	return ""
+}
+
+func (m *QueryDelegatedVotingPowerRequest) GetMsgType() string {
+	if m != nil {
+		return m.MsgType
+	}
+	return ""
+}
+
+// QueryDelegatedVotingPowerResponse is the response type for the
+// Query/DelegatedVotingPower RPC method.
+type QueryDelegatedVotingPowerResponse struct {
+	// voting_power is the voting power of the delegators whose delegation
+	// chain reaches the delegate.
+	VotingPower string `protobuf:"bytes,1,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
+	// delegators is the addresses of the delegators.
+	Delegators []string `protobuf:"bytes,2,rep,name=delegators,proto3" json:"delegators,omitempty"`
+}
+
+func (m *QueryDelegatedVotingPowerResponse) Reset()         { *m = QueryDelegatedVotingPowerResponse{} }
+func (m *QueryDelegatedVotingPowerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatedVotingPowerResponse) ProtoMessage()    {}
+func (*QueryDelegatedVotingPowerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7763af3b20d09a12, []int{13}
+}
+func (m *QueryDelegatedVotingPowerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatedVotingPowerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatedVotingPowerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatedVotingPowerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatedVotingPowerResponse.Merge(m, src)
+}
+func (m *QueryDelegatedVotingPowerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatedVotingPowerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatedVotingPowerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatedVotingPowerResponse proto.InternalMessageInfo
+
+func (m *QueryDelegatedVotingPowerResponse) GetVotingPower() string {
+	if m != nil {
+		return m.VotingPower
+	}
+	return ""
+}
+
+func (m *QueryDelegatedVotingPowerResponse) GetDelegators() []string {
+	if m != nil {
+		return m.Delegators
+	}
+	return nil
+}
+
 // QuerySimulateProposalRequest is the request type for the Query/SimulateProposal RPC method.
 type QuerySimulateProposalRequest struct {
 	MsgSubmitProposal v1.MsgSubmitProposal `protobuf:"bytes,1,opt,name=msg_submit_proposal,json=msgSubmitProposal,proto3" json:"msg_submit_proposal"`
@@ -534,7 +762,7 @@ func (m *QuerySimulateProposalRequest) Reset()         { *m = QuerySimulatePropo
 func (m *QuerySimulateProposalRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySimulateProposalRequest) ProtoMessage()    {}
 func (*QuerySimulateProposalRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7763af3b20d09a12, []int{10}
+	return fileDescriptor_7763af3b20d09a12, []int{14}
 }
 func (m *QuerySimulateProposalRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -582,7 +810,7 @@ func (m *QuerySimulateProposalResponse) Reset()         { *m = QuerySimulateProp
 func (m *QuerySimulateProposalResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySimulateProposalResponse) ProtoMessage()    {}
 func (*QuerySimulateProposalResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7763af3b20d09a12, []int{11}
+	return fileDescriptor_7763af3b20d09a12, []int{15}
 }
 func (m *QuerySimulateProposalResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -625,6 +853,115 @@ func (m *QuerySimulateProposalResponse) GetResults() []types.Result {
 	return nil
 }
 
+// QueryVotingLocksRequest is the request type for the Query/VotingLocks RPC method.
+type QueryVotingLocksRequest struct {
+	// owner defines the address of the lock owner.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVotingLocksRequest) Reset()         { *m = QueryVotingLocksRequest{} }
+func (m *QueryVotingLocksRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVotingLocksRequest) ProtoMessage()    {}
+func (*QueryVotingLocksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7763af3b20d09a12, []int{16}
+}
+func (m *QueryVotingLocksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVotingLocksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVotingLocksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVotingLocksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVotingLocksRequest.Merge(m, src)
+}
+func (m *QueryVotingLocksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVotingLocksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVotingLocksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVotingLocksRequest proto.InternalMessageInfo
+
+func (m *QueryVotingLocksRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QueryVotingLocksRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryVotingLocksResponse is the response type for the Query/VotingLocks RPC method.
+type QueryVotingLocksResponse struct {
+	VotingLocks []VotingLock `protobuf:"bytes,1,rep,name=voting_locks,json=votingLocks,proto3" json:"voting_locks"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVotingLocksResponse) Reset()         { *m = QueryVotingLocksResponse{} }
+func (m *QueryVotingLocksResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVotingLocksResponse) ProtoMessage()    {}
+func (*QueryVotingLocksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7763af3b20d09a12, []int{17}
+}
+func (m *QueryVotingLocksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVotingLocksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVotingLocksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVotingLocksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVotingLocksResponse.Merge(m, src)
+}
+func (m *QueryVotingLocksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVotingLocksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVotingLocksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVotingLocksResponse proto.InternalMessageInfo
+
+func (m *QueryVotingLocksResponse) GetVotingLocks() []VotingLock {
+	if m != nil {
+		return m.VotingLocks
+	}
+	return nil
+}
+
+func (m *QueryVotingLocksResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "initia.gov.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "initia.gov.v1.QueryParamsResponse")
@@ -636,72 +973,98 @@ func init() {
 	proto.RegisterType((*QueryProposalsResponse)(nil), "initia.gov.v1.QueryProposalsResponse")
 	proto.RegisterType((*QueryTallyResultRequest)(nil), "initia.gov.v1.QueryTallyResultRequest")
 	proto.RegisterType((*QueryTallyResultResponse)(nil), "initia.gov.v1.QueryTallyResultResponse")
+	proto.RegisterType((*QueryEffectiveVoteDelegateRequest)(nil), "initia.gov.v1.QueryEffectiveVoteDelegateRequest")
+	proto.RegisterType((*QueryEffectiveVoteDelegateResponse)(nil), "initia.gov.v1.QueryEffectiveVoteDelegateResponse")
+	proto.RegisterType((*QueryDelegatedVotingPowerRequest)(nil), "initia.gov.v1.QueryDelegatedVotingPowerRequest")
+	proto.RegisterType((*QueryDelegatedVotingPowerResponse)(nil), "initia.gov.v1.QueryDelegatedVotingPowerResponse")
 	proto.RegisterType((*QuerySimulateProposalRequest)(nil), "initia.gov.v1.QuerySimulateProposalRequest")
 	proto.RegisterType((*QuerySimulateProposalResponse)(nil), "initia.gov.v1.QuerySimulateProposalResponse")
+	proto.RegisterType((*QueryVotingLocksRequest)(nil), "initia.gov.v1.QueryVotingLocksRequest")
+	proto.RegisterType((*QueryVotingLocksResponse)(nil), "initia.gov.v1.QueryVotingLocksResponse")
 }
 
 func init() { proto.RegisterFile("initia/gov/v1/query.proto", fileDescriptor_7763af3b20d09a12) }
 
 var fileDescriptor_7763af3b20d09a12 = []byte{
-	// 924 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xa4, 0x69, 0x1a, 0x8f, 0xa1, 0xd0, 0x69, 0x52, 0xbb, 0xab, 0xc6, 0x76, 0xb7, 0x6d,
-	0x1a, 0xd2, 0x66, 0x57, 0x76, 0xf9, 0x21, 0x85, 0x0b, 0x18, 0x41, 0x29, 0x02, 0x29, 0xac, 0x11,
-	0x07, 0x2e, 0xd6, 0xd8, 0x9e, 0x2e, 0x2b, 0x79, 0x77, 0xb6, 0x3b, 0x63, 0xab, 0x06, 0x71, 0x41,
-	0x42, 0xe2, 0x06, 0x12, 0x1c, 0x2a, 0x2e, 0xf4, 0x82, 0xe0, 0xc8, 0x81, 0x3f, 0xa2, 0xc7, 0x02,
-	0x17, 0x4e, 0x08, 0x25, 0x48, 0xfc, 0x1b, 0xc8, 0x33, 0x6f, 0x6d, 0xef, 0xd8, 0x8e, 0x2d, 0x24,
-	0x2e, 0x51, 0xf6, 0xcd, 0xf7, 0xbe, 0xf7, 0xbd, 0x6f, 0xde, 0x1b, 0x19, 0x5f, 0x0e, 0xa2, 0x40,
-	0x06, 0xd4, 0xf5, 0xf9, 0xc0, 0x1d, 0xd4, 0xdc, 0x07, 0x7d, 0x96, 0x0c, 0x9d, 0x38, 0xe1, 0x92,
-	0x93, 0x67, 0xf5, 0x91, 0xe3, 0xf3, 0x81, 0x33, 0xa8, 0x59, 0x17, 0x68, 0x18, 0x44, 0xdc, 0x55,
-	0x7f, 0x35, 0xc2, 0xba, 0xd6, 0xe1, 0x22, 0xe4, 0xc2, 0x6d, 0x53, 0xc1, 0x5c, 0xda, 0xee, 0x04,
-	0xee, 0xa0, 0xd6, 0x66, 0x92, 0xd6, 0xd4, 0x07, 0x80, 0xf6, 0xa7, 0x41, 0x8a, 0x7f, 0x8c, 0x8a,
-	0xa9, 0x1f, 0x44, 0x54, 0x06, 0x3c, 0x02, 0x6c, 0x11, 0xb0, 0xa0, 0x66, 0x54, 0x59, 0x1f, 0x5c,
-	0xca, 0x1e, 0xc8, 0x87, 0x10, 0xbf, 0xac, 0xe3, 0x2d, 0xf5, 0xe5, 0xea, 0x0f, 0x38, 0xda, 0xf2,
-	0xb9, 0xcf, 0x75, 0x7c, 0xf4, 0x1f, 0x44, 0xaf, 0xf8, 0x9c, 0xfb, 0x3d, 0xe6, 0xd2, 0x38, 0x70,
-	0x69, 0x14, 0x71, 0xa9, 0xca, 0xa7, 0x39, 0xc5, 0xac, 0x1b, 0xe3, 0xfa, 0xf6, 0x16, 0x26, 0xef,
-	0x8f, 0xa4, 0x1f, 0xd1, 0x84, 0x86, 0xc2, 0x63, 0x0f, 0xfa, 0x4c, 0x48, 0xfb, 0x1d, 0x7c, 0x31,
-	0x13, 0x15, 0x31, 0x8f, 0x04, 0x23, 0x77, 0xf0, 0x46, 0xac, 0x22, 0x25, 0x54, 0x45, 0x7b, 0x85,
-	0xfa, 0xb6, 0x93, 0x71, 0xd2, 0xd1, 0xf0, 0xc6, 0xfa, 0x93, 0x3f, 0x2b, 0x39, 0x0f, 0xa0, 0x76,
-	0x82, 0xcb, 0x8a, 0xeb, 0xcd, 0x90, 0x25, 0x3e, 0x8b, 0x3a, 0xc3, 0xa3, 0x84, 0xc7, 0x5c, 0xd0,
-	0x5e, 0x5a, 0x8d, 0xbc, 0x85, 0xf1, 0xc4, 0x30, 0xa0, 0xde, 0x75, 0xa0, 0xe7, 0x91, 0xbb, 0x8e,
-	0xbe, 0x3d, 0x70, 0xd7, 0x39, 0xa2, 0x3e, 0x83, 0x5c, 0x6f, 0x2a, 0xf3, 0x70, 0xf3, 0xcb, 0xc7,
-	0x95, 0xdc, 0xa3, 0xc7, 0x15, 0x64, 0xff, 0x88, 0x70, 0x65, 0x61, 0x51, 0x68, 0xe6, 0x55, 0x9c,
-	0x8f, 0xd3, 0x60, 0x09, 0x55, 0xcf, 0xec, 0x15, 0xea, 0x45, 0xb3, 0x1f, 0x38, 0x87, 0x8e, 0x26,
-	0x78, 0x72, 0x37, 0x23, 0x79, 0x4d, 0x49, 0xbe, 0xb9, 0x54, 0xb2, 0xae, 0x3c, 0xad, 0xd9, 0x7e,
-	0x05, 0x6f, 0x69, 0xa7, 0x81, 0x3a, 0xf5, 0xa4, 0x82, 0x0b, 0x69, 0xb5, 0x56, 0xd0, 0x55, 0xa6,
-	0xac, 0x7b, 0x38, 0x0d, 0xdd, 0xeb, 0xda, 0xef, 0xe2, 0x6d, 0x23, 0x71, 0x7c, 0x49, 0x9b, 0x29,
-	0x0c, 0xbc, 0x5c, 0xd4, 0x96, 0x37, 0x06, 0xda, 0x5f, 0xad, 0x19, 0x74, 0x53, 0x97, 0xf3, 0xdc,
-	0x58, 0x88, 0x90, 0x54, 0xf6, 0xf5, 0xe5, 0x9f, 0xaf, 0xef, 0xa4, 0xed, 0x1a, 0xac, 0x4d, 0x05,
-	0xf2, 0xce, 0xc7, 0x99, 0x6f, 0xe2, 0xe0, 0xb3, 0x03, 0x2e, 0x59, 0xa2, 0xcc, 0xca, 0x37, 0x4a,
-	0xbf, 0xfd, 0x72, 0xb0, 0x05, 0x04, 0xaf, 0x77, 0xbb, 0x09, 0x13, 0xa2, 0x29, 0x93, 0x20, 0xf2,
-	0x3d, 0x0d, 0x23, 0x2f, 0xe3, 0x7c, 0x97, 0xc5, 0x5c, 0x04, 0x92, 0x27, 0xa5, 0x33, 0x4b, 0x72,
-	0x26, 0x50, 0x63, 0x98, 0xd6, 0xff, 0xeb, 0x30, 0xd9, 0x8f, 0x10, 0xbe, 0x64, 0x3a, 0x02, 0x0e,
-	0xbf, 0xb4, 0xfa, 0xe4, 0xfc, 0x2f, 0x33, 0x73, 0x88, 0x8b, 0x4a, 0xd9, 0x07, 0xb4, 0xd7, 0x1b,
-	0x7a, 0x4c, 0xf4, 0x7b, 0x72, 0xe5, 0xb1, 0xe9, 0xe2, 0xd2, 0x6c, 0x2e, 0xf4, 0xf5, 0x36, 0x7e,
-	0x46, 0x8e, 0xc2, 0xad, 0x44, 0xc5, 0x61, 0x7a, 0x2c, 0xa3, 0xb5, 0xa9, 0xcc, 0x46, 0x7e, 0xb4,
-	0x17, 0x3f, 0xfd, 0xf3, 0xf3, 0x3e, 0xf2, 0x0a, 0x72, 0x12, 0xb7, 0x07, 0xf8, 0x8a, 0xaa, 0xd2,
-	0x0c, 0xc2, 0x7e, 0x8f, 0x4a, 0x66, 0x4e, 0xf7, 0x87, 0xf8, 0x62, 0x28, 0xfc, 0x96, 0xe8, 0xb7,
-	0xc3, 0x40, 0xb6, 0x8c, 0x71, 0xad, 0x1a, 0x83, 0xf5, 0x9e, 0xf0, 0x9b, 0x0a, 0x68, 0xac, 0xe3,
-	0x85, 0xd0, 0x3c, 0xb0, 0x7f, 0x40, 0x78, 0x67, 0x41, 0x61, 0xe8, 0xb1, 0x81, 0x37, 0x7d, 0x2a,
-	0x5a, 0x41, 0x74, 0x9f, 0x43, 0xb9, 0xab, 0x99, 0x2b, 0x50, 0xef, 0x7b, 0x7a, 0x03, 0x77, 0xa9,
-	0xb8, 0x17, 0xdd, 0xe7, 0x50, 0xef, 0x9c, 0xaf, 0x3f, 0xc9, 0x6b, 0xf8, 0x9c, 0x76, 0x48, 0x94,
-	0xd6, 0xd4, 0xed, 0x57, 0x17, 0x53, 0x80, 0x51, 0xc0, 0x00, 0x69, 0xf5, 0x5f, 0x37, 0xf0, 0x59,
-	0xa5, 0x93, 0x44, 0x78, 0x43, 0xbf, 0x9a, 0xe4, 0xaa, 0xe1, 0xf3, 0xec, 0xb3, 0x6c, 0xd9, 0xa7,
-	0x41, 0x74, 0x83, 0xf6, 0xce, 0xe7, 0xbf, 0xff, 0xfd, 0xcd, 0x5a, 0x91, 0x6c, 0xbb, 0xd9, 0x27,
-	0x5f, 0xbf, 0xc6, 0xe4, 0x7b, 0x84, 0xc9, 0xec, 0xa3, 0x48, 0x0e, 0xe6, 0x31, 0x2f, 0x7c, 0xb1,
-	0x2d, 0x67, 0x55, 0x38, 0x88, 0xda, 0x57, 0xa2, 0xae, 0x13, 0xdb, 0x10, 0xc5, 0xd2, 0x94, 0xd6,
-	0x64, 0x4d, 0xbe, 0x40, 0x78, 0x33, 0x65, 0x20, 0xd7, 0xe6, 0x76, 0x9c, 0x9d, 0x26, 0xeb, 0xfa,
-	0xe9, 0x20, 0xd0, 0xe0, 0x28, 0x0d, 0x7b, 0x64, 0xd7, 0x34, 0x26, 0xad, 0xec, 0x7e, 0x3a, 0xb5,
-	0x3a, 0x9f, 0x91, 0x4f, 0x70, 0x7e, 0xe2, 0xcf, 0xa9, 0x25, 0xc6, 0xb6, 0xdc, 0x58, 0x82, 0x02,
-	0x25, 0x55, 0xa5, 0xc4, 0x22, 0xa5, 0x45, 0x4a, 0xc8, 0xb7, 0x08, 0x17, 0xa6, 0xf6, 0x8c, 0xec,
-	0xce, 0x23, 0x9e, 0x5d, 0x7f, 0xeb, 0xe6, 0x52, 0x1c, 0x48, 0x78, 0x51, 0x49, 0x70, 0xc8, 0xed,
-	0xd5, 0xcc, 0x70, 0xd5, 0x72, 0x93, 0xef, 0x10, 0x7e, 0xde, 0xdc, 0x2c, 0x72, 0x6b, 0x5e, 0xcd,
-	0x05, 0x8b, 0x6f, 0xdd, 0x5e, 0x0d, 0x0c, 0x2a, 0x6f, 0x29, 0x95, 0x37, 0x0e, 0xd1, 0xbe, 0x5d,
-	0x35, 0x84, 0x0a, 0xc8, 0x19, 0x0f, 0x4e, 0xe3, 0x8d, 0x27, 0xc7, 0x65, 0xf4, 0xf4, 0xb8, 0x8c,
-	0xfe, 0x3a, 0x2e, 0xa3, 0xaf, 0x4f, 0xca, 0xb9, 0xa7, 0x27, 0xe5, 0xdc, 0x1f, 0x27, 0xe5, 0xdc,
-	0x47, 0x2f, 0xf8, 0x81, 0xfc, 0xb8, 0xdf, 0x76, 0x3a, 0x3c, 0x04, 0x96, 0x83, 0x1e, 0x6d, 0x8b,
-	0x94, 0xf1, 0xa1, 0xe2, 0x94, 0xc3, 0x98, 0x89, 0xf6, 0x86, 0xfa, 0x55, 0x74, 0xe7, 0xdf, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x25, 0xa9, 0x46, 0x62, 0x3e, 0x0a, 0x00, 0x00,
+	// 1247 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0xce, 0xa4, 0xbf, 0x36, 0x6f, 0x4b, 0xa1, 0xd3, 0xa4, 0xd9, 0x58, 0xed, 0x66, 0xe3, 0xb4,
+	0x49, 0x48, 0x1b, 0xbb, 0x9b, 0xb6, 0x14, 0x05, 0x81, 0x4a, 0x28, 0x0d, 0x45, 0x45, 0x0a, 0x9b,
+	0x2a, 0x07, 0x2e, 0x2b, 0xef, 0xee, 0xc4, 0xb5, 0xd8, 0xf5, 0xb8, 0x1e, 0xaf, 0xdb, 0x10, 0xe5,
+	0x82, 0x84, 0x84, 0xe0, 0x40, 0x11, 0x1c, 0x2a, 0x2e, 0xf4, 0x82, 0xe0, 0xc0, 0x81, 0x43, 0x0f,
+	0xfc, 0x01, 0x1c, 0x7a, 0xac, 0xca, 0x85, 0x13, 0x82, 0x04, 0x89, 0x7f, 0x03, 0x79, 0xfc, 0xec,
+	0xf5, 0x7a, 0x7f, 0x12, 0xc1, 0x25, 0x8a, 0xdf, 0x7c, 0xef, 0xbd, 0xef, 0x7d, 0x33, 0xf3, 0xde,
+	0x2c, 0x4c, 0x59, 0xb6, 0xe5, 0x59, 0x86, 0x6e, 0x72, 0x5f, 0xf7, 0x8b, 0xfa, 0xbd, 0x26, 0x73,
+	0xb7, 0x35, 0xc7, 0xe5, 0x1e, 0xa7, 0x2f, 0x84, 0x4b, 0x9a, 0xc9, 0x7d, 0xcd, 0x2f, 0x2a, 0x27,
+	0x8d, 0x86, 0x65, 0x73, 0x5d, 0xfe, 0x0d, 0x11, 0xca, 0x6c, 0x95, 0x8b, 0x06, 0x17, 0x7a, 0xc5,
+	0x10, 0x4c, 0x37, 0x2a, 0x55, 0x4b, 0xf7, 0x8b, 0x15, 0xe6, 0x19, 0x45, 0xf9, 0x81, 0xa0, 0xc5,
+	0x24, 0x48, 0xc6, 0x8f, 0x51, 0x8e, 0x61, 0x5a, 0xb6, 0xe1, 0x59, 0xdc, 0x46, 0xec, 0x24, 0x62,
+	0x91, 0x4d, 0x90, 0x39, 0x5c, 0x38, 0xdd, 0xbe, 0xe0, 0x3d, 0x40, 0xfb, 0x54, 0x68, 0x2f, 0xcb,
+	0x2f, 0x3d, 0xfc, 0xc0, 0xa5, 0x71, 0x93, 0x9b, 0x3c, 0xb4, 0x07, 0xff, 0xa1, 0xf5, 0x8c, 0xc9,
+	0xb9, 0x59, 0x67, 0xba, 0xe1, 0x58, 0xba, 0x61, 0xdb, 0xdc, 0x93, 0xe9, 0x23, 0x9f, 0xc9, 0x76,
+	0x35, 0xe2, 0xfc, 0xea, 0x38, 0xd0, 0xf7, 0x03, 0xea, 0xeb, 0x86, 0x6b, 0x34, 0x44, 0x89, 0xdd,
+	0x6b, 0x32, 0xe1, 0xa9, 0xef, 0xc2, 0xa9, 0x36, 0xab, 0x70, 0xb8, 0x2d, 0x18, 0xbd, 0x0c, 0x47,
+	0x1d, 0x69, 0xc9, 0x91, 0x02, 0x59, 0xc8, 0x2e, 0x4f, 0x68, 0x6d, 0x4a, 0x6a, 0x21, 0x7c, 0xf5,
+	0xf0, 0xd3, 0xdf, 0xa7, 0x47, 0x4a, 0x08, 0x55, 0x5d, 0xc8, 0xcb, 0x58, 0x6f, 0x37, 0x98, 0x6b,
+	0x32, 0xbb, 0xba, 0xbd, 0xee, 0x72, 0x87, 0x0b, 0xa3, 0x1e, 0x65, 0xa3, 0x37, 0x01, 0x5a, 0x82,
+	0x61, 0xe8, 0x39, 0x0d, 0x6b, 0x0e, 0xd4, 0xd5, 0xc2, 0xdd, 0x43, 0x75, 0xb5, 0x75, 0xc3, 0x64,
+	0xe8, 0x5b, 0x4a, 0x78, 0xae, 0x64, 0x3e, 0x7d, 0x3c, 0x3d, 0xf2, 0xe8, 0xf1, 0x34, 0x51, 0xbf,
+	0x27, 0x30, 0xdd, 0x33, 0x29, 0x16, 0xf3, 0x1a, 0x8c, 0x39, 0x91, 0x31, 0x47, 0x0a, 0x87, 0x16,
+	0xb2, 0xcb, 0x93, 0xe9, 0x7a, 0x70, 0x1d, 0x2b, 0x6a, 0xe1, 0xe9, 0x5a, 0x1b, 0xe5, 0x51, 0x49,
+	0x79, 0x7e, 0x20, 0xe5, 0x30, 0x73, 0x92, 0xb3, 0x7a, 0x0d, 0xc6, 0x43, 0xa5, 0x31, 0x74, 0xa4,
+	0xc9, 0x34, 0x64, 0xa3, 0x6c, 0x65, 0xab, 0x26, 0x45, 0x39, 0x5c, 0x82, 0xc8, 0x74, 0xab, 0xa6,
+	0xde, 0x86, 0x89, 0x94, 0x63, 0xbc, 0x49, 0x99, 0x08, 0x86, 0x5a, 0xf6, 0x2a, 0xab, 0x14, 0x03,
+	0xd5, 0x2f, 0x46, 0x53, 0xe1, 0x12, 0x9b, 0xf3, 0x62, 0x4c, 0x44, 0x78, 0x86, 0xd7, 0x0c, 0x37,
+	0xff, 0xc4, 0xf2, 0xd9, 0xa8, 0xdc, 0x54, 0xd4, 0x0d, 0x09, 0x2a, 0x9d, 0x70, 0xda, 0xbe, 0xa9,
+	0x06, 0x47, 0x7c, 0xee, 0x31, 0x57, 0x8a, 0x35, 0xb6, 0x9a, 0x7b, 0xfe, 0x64, 0x69, 0x1c, 0x03,
+	0xbc, 0x59, 0xab, 0xb9, 0x4c, 0x88, 0x0d, 0xcf, 0xb5, 0x6c, 0xb3, 0x14, 0xc2, 0xe8, 0x2b, 0x30,
+	0x56, 0x63, 0x0e, 0x17, 0x96, 0xc7, 0xdd, 0xdc, 0xa1, 0x01, 0x3e, 0x2d, 0x68, 0xea, 0x30, 0x1d,
+	0x3e, 0xe8, 0x61, 0x52, 0x1f, 0x11, 0x38, 0x9d, 0x56, 0x04, 0x15, 0xbe, 0x3a, 0xfc, 0xc9, 0xf9,
+	0x5f, 0xce, 0xcc, 0x0a, 0x4c, 0x4a, 0x66, 0x77, 0x8c, 0x7a, 0x7d, 0xbb, 0xc4, 0x44, 0xb3, 0xee,
+	0x0d, 0x7d, 0x6c, 0x6a, 0x90, 0xeb, 0xf4, 0xc5, 0xba, 0xde, 0x81, 0xe3, 0x5e, 0x60, 0x2e, 0xbb,
+	0xd2, 0x8e, 0xa7, 0x47, 0x49, 0x95, 0x96, 0xf0, 0x5c, 0x1d, 0x0b, 0xee, 0xc5, 0x0f, 0x7f, 0xff,
+	0xb4, 0x48, 0x4a, 0x59, 0xaf, 0x65, 0x57, 0x7d, 0x98, 0x09, 0xaf, 0xdf, 0xd6, 0x16, 0xab, 0x7a,
+	0x96, 0xcf, 0x36, 0xb9, 0xc7, 0x6e, 0xb0, 0x3a, 0x33, 0x0d, 0x2f, 0x52, 0x3b, 0xdc, 0x61, 0x69,
+	0xe2, 0x6e, 0x8e, 0x0c, 0xde, 0x61, 0x84, 0xd2, 0x29, 0xc8, 0x34, 0x84, 0x59, 0xf6, 0xb6, 0x1d,
+	0x16, 0x1e, 0xa6, 0xd2, 0xb1, 0x86, 0x30, 0xef, 0x6c, 0x3b, 0x4c, 0xfd, 0x8c, 0x80, 0xda, 0x2f,
+	0x31, 0x16, 0x7a, 0x05, 0x32, 0x18, 0x8e, 0x0d, 0x4c, 0x1c, 0x23, 0x83, 0x13, 0x5c, 0xbd, 0x6b,
+	0x58, 0xc1, 0xd6, 0x1d, 0xea, 0x7f, 0x82, 0x25, 0x4c, 0x15, 0x50, 0x90, 0x5c, 0xa2, 0xf4, 0xb5,
+	0x4d, 0xee, 0x59, 0xb6, 0xb9, 0xce, 0xef, 0x33, 0x37, 0xd2, 0xe0, 0x60, 0x4c, 0xfa, 0x28, 0xf0,
+	0x90, 0xc0, 0x4c, 0x9f, 0xac, 0x28, 0x40, 0x11, 0x8e, 0xfb, 0xd2, 0x5c, 0x76, 0x02, 0x3b, 0xa6,
+	0x3e, 0xf1, 0xfc, 0xc9, 0x12, 0x60, 0xea, 0x1b, 0xac, 0x5a, 0xca, 0xfa, 0x2d, 0x57, 0xfa, 0x2a,
+	0x40, 0xbc, 0x05, 0x62, 0xa0, 0x04, 0x09, 0xac, 0xea, 0xc3, 0x19, 0xc9, 0x68, 0xc3, 0x6a, 0x34,
+	0xeb, 0x86, 0xc7, 0xd2, 0xad, 0x6e, 0x13, 0x4e, 0x05, 0xd5, 0x88, 0x66, 0xa5, 0x61, 0x79, 0xe5,
+	0x54, 0xef, 0x2a, 0xa4, 0xba, 0xcc, 0x7b, 0xc2, 0xdc, 0x90, 0xc0, 0x54, 0x6f, 0x3e, 0xd9, 0x48,
+	0x2f, 0xa8, 0xdf, 0x11, 0x38, 0xdb, 0x23, 0x31, 0xca, 0xb0, 0x0a, 0x19, 0xd3, 0x10, 0x65, 0xcb,
+	0xde, 0xe2, 0x98, 0x6e, 0xa6, 0xed, 0x3e, 0xca, 0x61, 0x1f, 0x5d, 0xc7, 0x35, 0x43, 0xdc, 0xb2,
+	0xb7, 0x38, 0xe6, 0x3b, 0x66, 0x86, 0x9f, 0xf4, 0x3a, 0x1c, 0x0b, 0xaf, 0x4b, 0x28, 0x4a, 0x82,
+	0x71, 0x67, 0x08, 0xbc, 0x35, 0x18, 0x01, 0xdd, 0xd4, 0x2f, 0x09, 0xde, 0xe7, 0x70, 0xa7, 0x6e,
+	0xf3, 0xea, 0x87, 0x71, 0xf7, 0xd5, 0xe0, 0x08, 0xbf, 0x6f, 0xb3, 0xc1, 0xf7, 0x23, 0x84, 0xa5,
+	0xba, 0xdf, 0xe8, 0x81, 0xbb, 0xdf, 0x8f, 0x04, 0x72, 0x9d, 0x9c, 0x50, 0xb6, 0xb5, 0xf8, 0xf4,
+	0xd4, 0x03, 0x3b, 0xb6, 0xc0, 0xa9, 0x54, 0x9f, 0x68, 0x79, 0xb6, 0xb5, 0x09, 0xbf, 0x15, 0xf0,
+	0x3f, 0xeb, 0x88, 0xcb, 0x7f, 0x02, 0x1c, 0x91, 0x74, 0xa9, 0x0d, 0x47, 0xc3, 0x57, 0x08, 0x9d,
+	0x49, 0xf1, 0xe9, 0x7c, 0xe6, 0x28, 0x6a, 0x3f, 0x48, 0x98, 0x46, 0x3d, 0xfb, 0xf1, 0xaf, 0x7f,
+	0x7d, 0x35, 0x3a, 0x49, 0x27, 0xf4, 0xf6, 0x27, 0x54, 0xf8, 0xba, 0xa1, 0xdf, 0x12, 0xa0, 0x9d,
+	0x8f, 0x0c, 0xba, 0xd4, 0x2d, 0x72, 0xcf, 0x17, 0x90, 0xa2, 0x0d, 0x0b, 0x47, 0x52, 0x8b, 0x92,
+	0xd4, 0x39, 0xaa, 0xa6, 0x48, 0xb1, 0xc8, 0xa5, 0xdc, 0x1a, 0x3b, 0x9f, 0x10, 0xc8, 0x44, 0x11,
+	0xe8, 0x6c, 0xd7, 0x8a, 0xdb, 0x2f, 0xa4, 0x72, 0xae, 0x3f, 0x08, 0x39, 0x68, 0x92, 0xc3, 0x02,
+	0x9d, 0x4b, 0x0b, 0x13, 0x65, 0xd6, 0x77, 0x12, 0xa3, 0x68, 0x97, 0x7e, 0x04, 0x63, 0x2d, 0x7d,
+	0xfa, 0xa6, 0x88, 0x65, 0x39, 0x3f, 0x00, 0x85, 0x4c, 0x0a, 0x92, 0x89, 0x42, 0x73, 0xbd, 0x98,
+	0xd0, 0xaf, 0x09, 0x64, 0x13, 0x73, 0x8b, 0xce, 0x75, 0x0b, 0xdc, 0x39, 0x4e, 0x95, 0xf9, 0x81,
+	0x38, 0xa4, 0x70, 0x45, 0x52, 0xd0, 0xe8, 0xc5, 0xe1, 0xc4, 0xd0, 0xe5, 0xb0, 0xa4, 0x9f, 0x13,
+	0xc8, 0x26, 0x2e, 0x58, 0x77, 0x5a, 0x9d, 0x5d, 0x41, 0x99, 0x1f, 0x88, 0x43, 0x5a, 0x17, 0x24,
+	0xad, 0xf3, 0x74, 0x36, 0x45, 0x2b, 0x79, 0x7d, 0xf5, 0x1d, 0xd9, 0x3a, 0x76, 0xe9, 0x2f, 0x04,
+	0x26, 0xba, 0xce, 0x4d, 0x7a, 0xa9, 0xeb, 0xf1, 0xec, 0x33, 0xdb, 0x95, 0xe2, 0xbf, 0xf0, 0x40,
+	0xae, 0x37, 0x25, 0xd7, 0xeb, 0xf4, 0x8d, 0x4e, 0xae, 0xac, 0x8c, 0xe3, 0xc4, 0xe2, 0xb6, 0xd0,
+	0x77, 0xe2, 0xd9, 0xb2, 0xab, 0xb3, 0x28, 0x64, 0x39, 0x1e, 0x8e, 0x3f, 0x13, 0x18, 0xef, 0x36,
+	0xfc, 0xa8, 0xde, 0x8d, 0x53, 0x9f, 0xe1, 0xac, 0x5c, 0x1a, 0xde, 0x01, 0x6b, 0x78, 0x5d, 0xd6,
+	0x70, 0x8d, 0x5e, 0xed, 0x53, 0x03, 0x6b, 0x55, 0xc0, 0x76, 0xf5, 0xe4, 0x18, 0xa6, 0xdf, 0x10,
+	0x78, 0x29, 0x3d, 0xac, 0xe8, 0x85, 0x6e, 0x2c, 0x7a, 0xcc, 0x52, 0xe5, 0xe2, 0x70, 0xe0, 0xf6,
+	0xe3, 0xb1, 0x42, 0x16, 0xd5, 0x42, 0x8a, 0xb1, 0x40, 0x9f, 0xb8, 0x91, 0xac, 0xbe, 0xf5, 0x74,
+	0x2f, 0x4f, 0x9e, 0xed, 0xe5, 0xc9, 0x1f, 0x7b, 0x79, 0xf2, 0x70, 0x3f, 0x3f, 0xf2, 0x6c, 0x3f,
+	0x3f, 0xf2, 0xdb, 0x7e, 0x7e, 0xe4, 0x83, 0x97, 0x4d, 0xcb, 0xbb, 0xdb, 0xac, 0x68, 0x55, 0xde,
+	0xc0, 0x28, 0x4b, 0x75, 0xa3, 0x22, 0xa2, 0x88, 0x0f, 0x64, 0xcc, 0xe0, 0xb1, 0x22, 0x2a, 0x47,
+	0xe5, 0xaf, 0xce, 0xcb, 0xff, 0x0c, 0x00, 0xf8, 0x6c, 0x92, 0xa1, 0x9e, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -726,6 +1089,13 @@ type QueryClient interface {
 	Proposals(ctx context.Context, in *QueryProposalsRequest, opts ...grpc.CallOption) (*QueryProposalsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
+	// VotingLocks queries the voting locks of an owner.
+	VotingLocks(ctx context.Context, in *QueryVotingLocksRequest, opts ...grpc.CallOption) (*QueryVotingLocksResponse, error)
+	// EffectiveVoteDelegate queries the delegate at the end of the vote delegation
+	// chain of a delegator.
+	EffectiveVoteDelegate(ctx context.Context, in *QueryEffectiveVoteDelegateRequest, opts ...grpc.CallOption) (*QueryEffectiveVoteDelegateResponse, error)
+	// DelegatedVotingPower queries the voting power delegated to a delegate.
+	DelegatedVotingPower(ctx context.Context, in *QueryDelegatedVotingPowerRequest, opts ...grpc.CallOption) (*QueryDelegatedVotingPowerResponse, error)
 	// SimulateProposal queries the simulation of a proposal.
 	SimulateProposal(ctx context.Context, in *QuerySimulateProposalRequest, opts ...grpc.CallOption) (*QuerySimulateProposalResponse, error)
 }
@@ -783,6 +1153,33 @@ func (c *queryClient) TallyResult(ctx context.Context, in *QueryTallyResultReque
 	return out, nil
 }
 
+func (c *queryClient) VotingLocks(ctx context.Context, in *QueryVotingLocksRequest, opts ...grpc.CallOption) (*QueryVotingLocksResponse, error) {
+	out := new(QueryVotingLocksResponse)
+	err := c.cc.Invoke(ctx, "/initia.gov.v1.Query/VotingLocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EffectiveVoteDelegate(ctx context.Context, in *QueryEffectiveVoteDelegateRequest, opts ...grpc.CallOption) (*QueryEffectiveVoteDelegateResponse, error) {
+	out := new(QueryEffectiveVoteDelegateResponse)
+	err := c.cc.Invoke(ctx, "/initia.gov.v1.Query/EffectiveVoteDelegate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegatedVotingPower(ctx context.Context, in *QueryDelegatedVotingPowerRequest, opts ...grpc.CallOption) (*QueryDelegatedVotingPowerResponse, error) {
+	out := new(QueryDelegatedVotingPowerResponse)
+	err := c.cc.Invoke(ctx, "/initia.gov.v1.Query/DelegatedVotingPower", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) SimulateProposal(ctx context.Context, in *QuerySimulateProposalRequest, opts ...grpc.CallOption) (*QuerySimulateProposalResponse, error) {
 	out := new(QuerySimulateProposalResponse)
 	err := c.cc.Invoke(ctx, "/initia.gov.v1.Query/SimulateProposal", in, out, opts...)
@@ -804,6 +1201,13 @@ type QueryServer interface {
 	Proposals(context.Context, *QueryProposalsRequest) (*QueryProposalsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
+	// VotingLocks queries the voting locks of an owner.
+	VotingLocks(context.Context, *QueryVotingLocksRequest) (*QueryVotingLocksResponse, error)
+	// EffectiveVoteDelegate queries the delegate at the end of the vote delegation
+	// chain of a delegator.
+	EffectiveVoteDelegate(context.Context, *QueryEffectiveVoteDelegateRequest) (*QueryEffectiveVoteDelegateResponse, error)
+	// DelegatedVotingPower queries the voting power delegated to a delegate.
+	DelegatedVotingPower(context.Context, *QueryDelegatedVotingPowerRequest) (*QueryDelegatedVotingPowerResponse, error)
 	// SimulateProposal queries the simulation of a proposal.
 	SimulateProposal(context.Context, *QuerySimulateProposalRequest) (*QuerySimulateProposalResponse, error)
 }
@@ -827,6 +1231,15 @@ func (*UnimplementedQueryServer) Proposals(ctx context.Context, req *QueryPropos
 func (*UnimplementedQueryServer) TallyResult(ctx context.Context, req *QueryTallyResultRequest) (*QueryTallyResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TallyResult not implemented")
 }
+func (*UnimplementedQueryServer) VotingLocks(ctx context.Context, req *QueryVotingLocksRequest) (*QueryVotingLocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VotingLocks not implemented")
+}
+func (*UnimplementedQueryServer) EffectiveVoteDelegate(ctx context.Context, req *QueryEffectiveVoteDelegateRequest) (*QueryEffectiveVoteDelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EffectiveVoteDelegate not implemented")
+}
+func (*UnimplementedQueryServer) DelegatedVotingPower(ctx context.Context, req *QueryDelegatedVotingPowerRequest) (*QueryDelegatedVotingPowerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatedVotingPower not implemented")
+}
 func (*UnimplementedQueryServer) SimulateProposal(ctx context.Context, req *QuerySimulateProposalRequest) (*QuerySimulateProposalResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SimulateProposal not implemented")
 }
@@ -925,6 +1338,60 @@ func _Query_TallyResult_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_VotingLocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVotingLocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VotingLocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.gov.v1.Query/VotingLocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VotingLocks(ctx, req.(*QueryVotingLocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EffectiveVoteDelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEffectiveVoteDelegateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EffectiveVoteDelegate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.gov.v1.Query/EffectiveVoteDelegate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EffectiveVoteDelegate(ctx, req.(*QueryEffectiveVoteDelegateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegatedVotingPower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatedVotingPowerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatedVotingPower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.gov.v1.Query/DelegatedVotingPower",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatedVotingPower(ctx, req.(*QueryDelegatedVotingPowerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_SimulateProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QuerySimulateProposalRequest)
 	if err := dec(in); err != nil {
@@ -969,10 +1436,22 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			Handler:    _Query_TallyResult_Handler,
 		},
 		{
-			MethodName: "SimulateProposal",
-			Handler:    _Query_SimulateProposal_Handler,
+			MethodName: "VotingLocks",
+			Handler:    _Query_VotingLocks_Handler,
 		},
-	},
+		{
+			MethodName: "EffectiveVoteDelegate",
+			Handler:    _Query_EffectiveVoteDelegate_Handler,
+		},
+		{
+			MethodName: "DelegatedVotingPower",
+			Handler:    _Query_DelegatedVotingPower_Handler,
+		},
+		{
+			MethodName: "SimulateProposal",
+			Handler:    _Query_SimulateProposal_Handler,
+		},
+	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "initia/gov/v1/query.proto",
 }
@@ -1344,6 +1823,158 @@ func (m *QueryTallyResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryEffectiveVoteDelegateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEffectiveVoteDelegateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEffectiveVoteDelegateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgType) > 0 {
+		i -= len(m.MsgType)
+		copy(dAtA[i:], m.MsgType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEffectiveVoteDelegateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEffectiveVoteDelegateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEffectiveVoteDelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Chain) > 0 {
+		for iNdEx := len(m.Chain) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Chain[iNdEx])
+			copy(dAtA[i:], m.Chain[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Chain[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatedVotingPowerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatedVotingPowerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatedVotingPowerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgType) > 0 {
+		i -= len(m.MsgType)
+		copy(dAtA[i:], m.MsgType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatedVotingPowerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatedVotingPowerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatedVotingPowerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegators) > 0 {
+		for iNdEx := len(m.Delegators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Delegators[iNdEx])
+			copy(dAtA[i:], m.Delegators[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Delegators[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.VotingPower) > 0 {
+		i -= len(m.VotingPower)
+		copy(dAtA[i:], m.VotingPower)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VotingPower)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QuerySimulateProposalRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1424,6 +2055,97 @@ func (m *QuerySimulateProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryVotingLocksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVotingLocksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVotingLocksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVotingLocksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVotingLocksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVotingLocksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.VotingLocks) > 0 {
+		for iNdEx := len(m.VotingLocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VotingLocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1578,6 +2300,78 @@ func (m *QueryTallyResultResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryEffectiveVoteDelegateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.MsgType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEffectiveVoteDelegateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Chain) > 0 {
+		for _, s := range m.Chain {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDelegatedVotingPowerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.MsgType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatedVotingPowerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VotingPower)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Delegators) > 0 {
+		for _, s := range m.Delegators {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *QuerySimulateProposalRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1606,13 +2400,388 @@ func (m *QuerySimulateProposalResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QueryVotingLocksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVotingLocksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.VotingLocks) > 0 {
+		for _, e := range m.VotingLocks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEmergencyProposalsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEmergencyProposalsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEmergencyProposalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEmergencyProposalsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEmergencyProposalsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEmergencyProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposals = append(m.Proposals, Proposal{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProposalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1635,12 +2804,31 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProposalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1662,7 +2850,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1685,15 +2873,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1720,7 +2908,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Proposal == nil {
+				m.Proposal = &Proposal{}
+			}
+			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1745,7 +2936,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEmergencyProposalsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProposalsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1768,13 +2959,96 @@ func (m *QueryEmergencyProposalsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEmergencyProposalsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProposalsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEmergencyProposalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProposalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalStatus", wireType)
+			}
+			m.ProposalStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalStatus |= v1.ProposalStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -1831,7 +3105,7 @@ func (m *QueryEmergencyProposalsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEmergencyProposalsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProposalsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1854,10 +3128,10 @@ func (m *QueryEmergencyProposalsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEmergencyProposalsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProposalsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEmergencyProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1889,7 +3163,7 @@ func (m *QueryEmergencyProposalsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proposals = append(m.Proposals, Proposal{})
+			m.Proposals = append(m.Proposals, &Proposal{})
 			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -1951,7 +3225,7 @@ func (m *QueryEmergencyProposalsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProposalRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTallyResultRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1974,10 +3248,10 @@ func (m *QueryProposalRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProposalRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTallyResultRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTallyResultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2020,7 +3294,7 @@ func (m *QueryProposalRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTallyResultResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2043,15 +3317,15 @@ func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTallyResultResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTallyResultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TallyResult", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2078,12 +3352,123 @@ func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Proposal == nil {
-				m.Proposal = &Proposal{}
+			if err := m.TallyResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEffectiveVoteDelegateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEffectiveVoteDelegateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEffectiveVoteDelegateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.MsgType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2106,7 +3491,7 @@ func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProposalsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEffectiveVoteDelegateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2129,17 +3514,17 @@ func (m *QueryProposalsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProposalsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEffectiveVoteDelegateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProposalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEffectiveVoteDelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalStatus", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
 			}
-			m.ProposalStatus = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2149,14 +3534,27 @@ func (m *QueryProposalsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalStatus |= v1.ProposalStatus(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2184,11 +3582,61 @@ func (m *QueryProposalsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
+			m.Chain = append(m.Chain, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegatedVotingPowerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatedVotingPowerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatedVotingPowerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2216,13 +3664,13 @@ func (m *QueryProposalsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = string(dAtA[iNdEx:postIndex])
+			m.Delegate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgType", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2232,27 +3680,23 @@ func (m *QueryProposalsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MsgType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2275,7 +3719,7 @@ func (m *QueryProposalsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProposalsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatedVotingPowerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2298,17 +3742,17 @@ func (m *QueryProposalsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProposalsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatedVotingPowerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatedVotingPowerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2318,31 +3762,29 @@ func (m *QueryProposalsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proposals = append(m.Proposals, &Proposal{})
-			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.VotingPower = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegators", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2352,27 +3794,23 @@ func (m *QueryProposalsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Delegators = append(m.Delegators, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2395,7 +3833,7 @@ func (m *QueryProposalsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTallyResultRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateProposalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2418,17 +3856,17 @@ func (m *QueryTallyResultRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTallyResultRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateProposalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTallyResultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgSubmitProposal", wireType)
 			}
-			m.ProposalId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2438,11 +3876,25 @@ func (m *QueryTallyResultRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MsgSubmitProposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2464,7 +3916,7 @@ func (m *QueryTallyResultRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTallyResultResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2487,15 +3939,15 @@ func (m *QueryTallyResultResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTallyResultResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTallyResultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TallyResult", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2522,7 +3974,41 @@ func (m *QueryTallyResultResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TallyResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GasInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, types.Result{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2547,7 +4033,7 @@ func (m *QueryTallyResultResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySimulateProposalRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVotingLocksRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2570,15 +4056,47 @@ func (m *QuerySimulateProposalRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySimulateProposalRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVotingLocksRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySimulateProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVotingLocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgSubmitProposal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2605,7 +4123,10 @@ func (m *QuerySimulateProposalRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MsgSubmitProposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2630,7 +4151,7 @@ func (m *QuerySimulateProposalRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySimulateProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVotingLocksResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2653,15 +4174,15 @@ func (m *QuerySimulateProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySimulateProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVotingLocksResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySimulateProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVotingLocksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingLocks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2688,13 +4209,14 @@ func (m *QuerySimulateProposalResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GasInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.VotingLocks = append(m.VotingLocks, VotingLock{})
+			if err := m.VotingLocks[len(m.VotingLocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2721,8 +4243,10 @@ func (m *QuerySimulateProposalResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Results = append(m.Results, types.Result{})
-			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7,23 +7,30 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -399,6 +406,397 @@ func (m *MsgActivateEmergencyProposalResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgActivateEmergencyProposalResponse proto.InternalMessageInfo
 
+// MsgLockTokens is the Msg/LockTokens request type.
+type MsgLockTokens struct {
+	Owner        string                                   `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Amount       github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	LockDuration time.Duration                            `protobuf:"bytes,3,opt,name=lock_duration,json=lockDuration,proto3,stdduration" json:"lock_duration"`
+}
+
+func (m *MsgLockTokens) Reset()         { *m = MsgLockTokens{} }
+func (m *MsgLockTokens) String() string { return proto.CompactTextString(m) }
+func (*MsgLockTokens) ProtoMessage()    {}
+func (*MsgLockTokens) Descriptor() ([]byte, []int) {
+	return fileDescriptor_baa84aaddaa44003, []int{8}
+}
+func (m *MsgLockTokens) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLockTokens) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLockTokens.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgLockTokens) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLockTokens.Merge(m, src)
+}
+func (m *MsgLockTokens) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLockTokens) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLockTokens.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgLockTokens proto.InternalMessageInfo
+
+func (m *MsgLockTokens) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgLockTokens) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *MsgLockTokens) GetLockDuration() time.Duration {
+	if m != nil {
+		return m.LockDuration
+	}
+	return 0
+}
+
+// MsgLockTokensResponse defines the response structure for executing a
+// MsgLockTokens message.
+type MsgLockTokensResponse struct {
+	EndTime time.Time `protobuf:"bytes,1,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+}
+
+func (m *MsgLockTokensResponse) Reset()         { *m = MsgLockTokensResponse{} }
+func (m *MsgLockTokensResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgLockTokensResponse) ProtoMessage()    {}
+func (*MsgLockTokensResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_baa84aaddaa44003, []int{9}
+}
+func (m *MsgLockTokensResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLockTokensResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLockTokensResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgLockTokensResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLockTokensResponse.Merge(m, src)
+}
+func (m *MsgLockTokensResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLockTokensResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLockTokensResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgLockTokensResponse proto.InternalMessageInfo
+
+func (m *MsgLockTokensResponse) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+// MsgUnlockTokens is the Msg/UnlockTokens request type.
+type MsgUnlockTokens struct {
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *MsgUnlockTokens) Reset()         { *m = MsgUnlockTokens{} }
+func (m *MsgUnlockTokens) String() string { return proto.CompactTextString(m) }
+func (*MsgUnlockTokens) ProtoMessage()    {}
+func (*MsgUnlockTokens) Descriptor() ([]byte, []int) {
+	return fileDescriptor_baa84aaddaa44003, []int{10}
+}
+func (m *MsgUnlockTokens) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnlockTokens) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnlockTokens.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnlockTokens) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnlockTokens.Merge(m, src)
+}
+func (m *MsgUnlockTokens) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnlockTokens) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnlockTokens.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnlockTokens proto.InternalMessageInfo
+
+func (m *MsgUnlockTokens) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+// MsgUnlockTokensResponse defines the response structure for executing a
+// MsgUnlockTokens message.
+type MsgUnlockTokensResponse struct {
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgUnlockTokensResponse) Reset()         { *m = MsgUnlockTokensResponse{} }
+func (m *MsgUnlockTokensResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnlockTokensResponse) ProtoMessage()    {}
+func (*MsgUnlockTokensResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_baa84aaddaa44003, []int{11}
+}
+func (m *MsgUnlockTokensResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnlockTokensResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnlockTokensResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnlockTokensResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnlockTokensResponse.Merge(m, src)
+}
+func (m *MsgUnlockTokensResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnlockTokensResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnlockTokensResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnlockTokensResponse proto.InternalMessageInfo
+
+func (m *MsgUnlockTokensResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// MsgDelegateVote is the Msg/DelegateVote request type.
+type MsgDelegateVote struct {
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	Delegate  string `protobuf:"bytes,2,opt,name=delegate,proto3" json:"delegate,omitempty"`
+	// msg_type is the message type URL of the proposals the delegation is
+	// scoped to. If it is empty, the delegation applies to all proposals.
+	MsgType string `protobuf:"bytes,3,opt,name=msg_type,json=msgType,proto3" json:"msg_type,omitempty"`
+}
+
+func (m *MsgDelegateVote) Reset()         { *m = MsgDelegateVote{} }
+func (m *MsgDelegateVote) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateVote) ProtoMessage()    {}
+func (*MsgDelegateVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_baa84aaddaa44003, []int{12}
+}
+func (m *MsgDelegateVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegateVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegateVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDelegateVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateVote.Merge(m, src)
+}
+func (m *MsgDelegateVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDelegateVote proto.InternalMessageInfo
+
+func (m *MsgDelegateVote) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+func (m *MsgDelegateVote) GetDelegate() string {
+	if m != nil {
+		return m.Delegate
+	}
+	return ""
+}
+
+func (m *MsgDelegateVote) GetMsgType() string {
+	if m != nil {
+		return m.MsgType
+	}
+	return ""
+}
+
+// MsgDelegateVoteResponse defines the response structure for executing a
+// MsgDelegateVote message.
+type MsgDelegateVoteResponse struct {
+}
+
+func (m *MsgDelegateVoteResponse) Reset()         { *m = MsgDelegateVoteResponse{} }
+func (m *MsgDelegateVoteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateVoteResponse) ProtoMessage()    {}
+func (*MsgDelegateVoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_baa84aaddaa44003, []int{13}
+}
+func (m *MsgDelegateVoteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegateVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegateVoteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDelegateVoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateVoteResponse.Merge(m, src)
+}
+func (m *MsgDelegateVoteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateVoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateVoteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDelegateVoteResponse proto.InternalMessageInfo
+
+// MsgUndelegateVote is the Msg/UndelegateVote request type.
+type MsgUndelegateVote struct {
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	// msg_type is the message type URL the delegation is scoped to.
+	MsgType string `protobuf:"bytes,2,opt,name=msg_type,json=msgType,proto3" json:"msg_type,omitempty"`
+}
+
+func (m *MsgUndelegateVote) Reset()         { *m = MsgUndelegateVote{} }
+func (m *MsgUndelegateVote) String() string { return proto.CompactTextString(m) }
+func (*MsgUndelegateVote) ProtoMessage()    {}
+func (*MsgUndelegateVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_baa84aaddaa44003, []int{14}
+}
+func (m *MsgUndelegateVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUndelegateVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUndelegateVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUndelegateVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUndelegateVote.Merge(m, src)
+}
+func (m *MsgUndelegateVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUndelegateVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUndelegateVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUndelegateVote proto.InternalMessageInfo
+
+func (m *MsgUndelegateVote) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+func (m *MsgUndelegateVote) GetMsgType() string {
+	if m != nil {
+		return m.MsgType
+	}
+	return ""
+}
+
+// MsgUndelegateVoteResponse defines the response structure for executing a
+// MsgUndelegateVote message.
+type MsgUndelegateVoteResponse struct {
+}
+
+func (m *MsgUndelegateVoteResponse) Reset()         { *m = MsgUndelegateVoteResponse{} }
+func (m *MsgUndelegateVoteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUndelegateVoteResponse) ProtoMessage()    {}
+func (*MsgUndelegateVoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_baa84aaddaa44003, []int{15}
+}
+func (m *MsgUndelegateVoteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUndelegateVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUndelegateVoteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUndelegateVoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUndelegateVoteResponse.Merge(m, src)
+}
+func (m *MsgUndelegateVoteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUndelegateVoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUndelegateVoteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUndelegateVoteResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "initia.gov.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "initia.gov.v1.MsgUpdateParamsResponse")
@@ -408,48 +806,82 @@ func init() {
 	proto.RegisterType((*MsgRemoveEmergencySubmittersResponse)(nil), "initia.gov.v1.MsgRemoveEmergencySubmittersResponse")
 	proto.RegisterType((*MsgActivateEmergencyProposal)(nil), "initia.gov.v1.MsgActivateEmergencyProposal")
 	proto.RegisterType((*MsgActivateEmergencyProposalResponse)(nil), "initia.gov.v1.MsgActivateEmergencyProposalResponse")
+	proto.RegisterType((*MsgLockTokens)(nil), "initia.gov.v1.MsgLockTokens")
+	proto.RegisterType((*MsgLockTokensResponse)(nil), "initia.gov.v1.MsgLockTokensResponse")
+	proto.RegisterType((*MsgUnlockTokens)(nil), "initia.gov.v1.MsgUnlockTokens")
+	proto.RegisterType((*MsgUnlockTokensResponse)(nil), "initia.gov.v1.MsgUnlockTokensResponse")
+	proto.RegisterType((*MsgDelegateVote)(nil), "initia.gov.v1.MsgDelegateVote")
+	proto.RegisterType((*MsgDelegateVoteResponse)(nil), "initia.gov.v1.MsgDelegateVoteResponse")
+	proto.RegisterType((*MsgUndelegateVote)(nil), "initia.gov.v1.MsgUndelegateVote")
+	proto.RegisterType((*MsgUndelegateVoteResponse)(nil), "initia.gov.v1.MsgUndelegateVoteResponse")
 }
 
 func init() { proto.RegisterFile("initia/gov/v1/tx.proto", fileDescriptor_baa84aaddaa44003) }
 
 var fileDescriptor_baa84aaddaa44003 = []byte{
-	// 566 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0x41, 0x8b, 0xd3, 0x40,
-	0x18, 0xed, 0x6c, 0xb5, 0xd0, 0x59, 0x45, 0xcc, 0xd6, 0xdd, 0x36, 0x68, 0xb6, 0x46, 0x29, 0xb5,
-	0xb2, 0x49, 0x77, 0x0b, 0x22, 0x7b, 0x6b, 0x41, 0x44, 0xa4, 0xb0, 0x64, 0xd1, 0x83, 0x97, 0x92,
-	0x36, 0xc3, 0xec, 0xc0, 0x26, 0x13, 0x32, 0xd3, 0xb0, 0x3d, 0x08, 0xe2, 0xd1, 0x93, 0xff, 0xc1,
-	0xcb, 0x82, 0x97, 0x1e, 0xfc, 0x11, 0x7b, 0x5c, 0xf6, 0xd4, 0x93, 0x48, 0x7b, 0xe8, 0xdf, 0x90,
-	0x24, 0x93, 0x76, 0xdb, 0x34, 0x5d, 0xf5, 0xe4, 0x25, 0x24, 0xdf, 0x7b, 0x79, 0xdf, 0x7b, 0x33,
-	0xf3, 0x0d, 0xdc, 0x26, 0x0e, 0xe1, 0xc4, 0xd4, 0x31, 0xf5, 0x75, 0x7f, 0x5f, 0xe7, 0x67, 0x9a,
-	0xeb, 0x51, 0x4e, 0xa5, 0xbb, 0x51, 0x5d, 0xc3, 0xd4, 0xd7, 0xfc, 0x7d, 0xf9, 0xbe, 0x69, 0x13,
-	0x87, 0xea, 0xe1, 0x33, 0x62, 0xc8, 0x3b, 0x3d, 0xca, 0x6c, 0xca, 0x74, 0x9b, 0xe1, 0xe0, 0x4f,
-	0x9b, 0x61, 0x01, 0x94, 0x22, 0xa0, 0x13, 0x7e, 0xe9, 0xd1, 0x87, 0x80, 0x0a, 0x98, 0x62, 0x1a,
-	0xd5, 0x83, 0xb7, 0x58, 0x69, 0xd1, 0x43, 0xd0, 0x32, 0x04, 0xd4, 0xef, 0x00, 0xde, 0x6b, 0x33,
-	0xfc, 0xce, 0xb5, 0x4c, 0x8e, 0x8e, 0x4c, 0xcf, 0xb4, 0x99, 0xf4, 0x02, 0xe6, 0xcd, 0x3e, 0x3f,
-	0xa1, 0x1e, 0xe1, 0x83, 0x22, 0x28, 0x83, 0x6a, 0xbe, 0x55, 0xbc, 0xfa, 0xb1, 0x57, 0x10, 0x7d,
-	0x9a, 0x96, 0xe5, 0x21, 0xc6, 0x8e, 0xb9, 0x47, 0x1c, 0x6c, 0xcc, 0xa9, 0xd2, 0x4b, 0x98, 0x73,
-	0x43, 0x85, 0xe2, 0x46, 0x19, 0x54, 0x37, 0x0f, 0x1e, 0x68, 0x0b, 0x09, 0xb5, 0x48, 0xbe, 0x95,
-	0xbf, 0xf8, 0xb9, 0x9b, 0x39, 0x9f, 0x0e, 0x6b, 0xc0, 0x10, 0xfc, 0xc3, 0xca, 0xe7, 0xe9, 0xb0,
-	0x36, 0x57, 0xfa, 0x32, 0x1d, 0xd6, 0xb6, 0x02, 0xab, 0x4b, 0xce, 0xd4, 0x12, 0xdc, 0x59, 0x2a,
-	0x19, 0x88, 0xb9, 0xd4, 0x61, 0x48, 0xbd, 0x02, 0xb0, 0xd4, 0x66, 0xb8, 0x69, 0x59, 0xaf, 0x6c,
-	0xe4, 0x61, 0xe4, 0xf4, 0x06, 0xc7, 0xfd, 0xae, 0x4d, 0x38, 0x47, 0xde, 0xbf, 0x47, 0x7a, 0x0b,
-	0x0b, 0x28, 0x96, 0xeb, 0xb0, 0x99, 0x5e, 0x71, 0xa3, 0x9c, 0x5d, 0x2b, 0xb1, 0x85, 0x92, 0x26,
-	0x0e, 0xeb, 0xc9, 0x94, 0x8f, 0x44, 0xca, 0xd5, 0xb6, 0xd5, 0x27, 0xf0, 0x71, 0x2a, 0x38, 0x4b,
-	0x3e, 0x02, 0xf0, 0x61, 0x9b, 0x61, 0x03, 0xd9, 0xd4, 0x47, 0xff, 0x6d, 0xf8, 0x46, 0x32, 0x7c,
-	0x59, 0x84, 0x4f, 0x75, 0xae, 0x56, 0xe0, 0xd3, 0x75, 0xf8, 0x6c, 0x09, 0xbe, 0x45, 0x4b, 0xd0,
-	0xec, 0x71, 0xe2, 0x9b, 0x7c, 0x4e, 0x3d, 0xf2, 0xa8, 0x4b, 0x99, 0x79, 0x2a, 0xd5, 0x61, 0x8e,
-	0x21, 0xc7, 0x42, 0xde, 0x8d, 0xf9, 0x05, 0x4f, 0xda, 0x85, 0x9b, 0xae, 0xf8, 0xbb, 0x43, 0xac,
-	0xf0, 0x44, 0xdf, 0x32, 0x60, 0x5c, 0x7a, 0x63, 0x45, 0xbb, 0x29, 0xd8, 0xd7, 0xd3, 0xa4, 0x9a,
-	0x10, 0x69, 0x52, 0xf1, 0x38, 0xcd, 0xc1, 0x28, 0x0b, 0xb3, 0x6d, 0x86, 0xa5, 0xf7, 0xf0, 0xce,
-	0xc2, 0x5c, 0x2a, 0x4b, 0xf3, 0xb4, 0x34, 0x0a, 0x72, 0x65, 0x3d, 0x1e, 0xeb, 0x4b, 0x1c, 0x6e,
-	0xa7, 0x8c, 0x49, 0x35, 0xa9, 0xb0, 0x9a, 0x29, 0xd7, 0xff, 0x94, 0x39, 0xeb, 0xfa, 0x11, 0x96,
-	0xd2, 0x8f, 0xe8, 0xf3, 0xa4, 0x5c, 0x2a, 0x59, 0x6e, 0xfc, 0x05, 0xf9, 0x7a, 0xfb, 0xf4, 0xe3,
-	0xb1, 0xa2, 0x7d, 0x2a, 0x79, 0x55, 0xfb, 0x1b, 0xf7, 0x54, 0xbe, 0xfd, 0x29, 0xb8, 0xf0, 0x5a,
-	0xaf, 0xcf, 0xc7, 0x0a, 0xb8, 0x18, 0x2b, 0xe0, 0x72, 0xac, 0x80, 0x5f, 0x63, 0x05, 0x7c, 0x9d,
-	0x28, 0x99, 0xcb, 0x89, 0x92, 0x19, 0x4d, 0x94, 0xcc, 0x87, 0x67, 0x98, 0xf0, 0x93, 0x7e, 0x57,
-	0xeb, 0x51, 0x5b, 0x8f, 0x7a, 0xec, 0x9d, 0x9a, 0x5d, 0x26, 0xde, 0xf5, 0xb3, 0xf0, 0xfa, 0xe6,
-	0x03, 0x17, 0xb1, 0x6e, 0x2e, 0xbc, 0xbe, 0x1b, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x86, 0xd4,
-	0x76, 0x43, 0x5d, 0x06, 0x00, 0x00,
+	// 990 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0xae, 0x53, 0x36, 0xdb, 0x4e, 0x5b, 0x50, 0xbd, 0xed, 0x36, 0x36, 0x8b, 0x13, 0xcc, 0x2a,
+	0x0a, 0x45, 0xb5, 0xfb, 0x03, 0x21, 0xd4, 0x5b, 0x43, 0x01, 0x21, 0x88, 0x54, 0x65, 0xcb, 0x1e,
+	0x10, 0x28, 0x72, 0xec, 0x61, 0xd6, 0x6a, 0xec, 0xb1, 0x3c, 0x93, 0xb0, 0x39, 0x20, 0x21, 0x8e,
+	0x9c, 0x7a, 0xe0, 0x80, 0xb8, 0x72, 0x59, 0xb1, 0x97, 0x1e, 0x38, 0x70, 0xe6, 0xb4, 0xc7, 0xd5,
+	0x9e, 0xf6, 0xc4, 0xa2, 0xf6, 0xd0, 0x7f, 0x03, 0x8d, 0x67, 0x3c, 0x71, 0xe2, 0x38, 0xdd, 0x5d,
+	0x21, 0xb4, 0x97, 0xd6, 0x33, 0xdf, 0xe7, 0xf7, 0xbe, 0xf7, 0x66, 0xfc, 0xbd, 0x80, 0x9b, 0x7e,
+	0xe8, 0x53, 0xdf, 0xb1, 0x11, 0x1e, 0xd8, 0x83, 0x1d, 0x9b, 0xde, 0xb7, 0xa2, 0x18, 0x53, 0xac,
+	0xae, 0xf0, 0x7d, 0x0b, 0xe1, 0x81, 0x35, 0xd8, 0xd1, 0x57, 0x9d, 0xc0, 0x0f, 0xb1, 0x9d, 0xfc,
+	0xe5, 0x0c, 0xdd, 0x70, 0x31, 0x09, 0x30, 0xb1, 0xbb, 0x0e, 0x81, 0xf6, 0x60, 0xa7, 0x0b, 0xa9,
+	0xb3, 0x63, 0xbb, 0xd8, 0x0f, 0x05, 0xbe, 0x21, 0xf0, 0x80, 0x20, 0x16, 0x39, 0x20, 0x48, 0x00,
+	0x1a, 0x07, 0x3a, 0xc9, 0xca, 0xe6, 0x0b, 0x01, 0xad, 0x21, 0x8c, 0x30, 0xdf, 0x67, 0x4f, 0x69,
+	0x26, 0x84, 0x31, 0xea, 0x41, 0x3b, 0x59, 0x75, 0xfb, 0xdf, 0xda, 0x5e, 0x3f, 0x76, 0xa8, 0x8f,
+	0xd3, 0x4c, 0xd5, 0x49, 0x9c, 0xfa, 0x01, 0x24, 0xd4, 0x09, 0xa2, 0x54, 0xca, 0x78, 0x91, 0xac,
+	0xa6, 0x04, 0x30, 0x1f, 0x2a, 0xe0, 0x8d, 0x16, 0x41, 0x5f, 0x46, 0x9e, 0x43, 0xe1, 0x91, 0x13,
+	0x3b, 0x01, 0x51, 0x3f, 0x00, 0x8b, 0x4e, 0x9f, 0xde, 0xc3, 0xb1, 0x4f, 0x87, 0x15, 0xa5, 0xa6,
+	0x34, 0x16, 0x9b, 0x95, 0x27, 0x7f, 0x6c, 0xad, 0x09, 0xa1, 0x07, 0x9e, 0x17, 0x43, 0x42, 0xee,
+	0xd0, 0xd8, 0x0f, 0x51, 0x7b, 0x44, 0x55, 0x3f, 0x04, 0xe5, 0x28, 0x89, 0x50, 0x29, 0xd5, 0x94,
+	0xc6, 0xd2, 0xee, 0xba, 0x35, 0xd6, 0x42, 0x8b, 0x87, 0x6f, 0x2e, 0x3e, 0xfa, 0xbb, 0x3a, 0xf7,
+	0xe0, 0xf2, 0x6c, 0x53, 0x69, 0x0b, 0xfe, 0x7e, 0xfd, 0xc7, 0xcb, 0xb3, 0xcd, 0x51, 0xa4, 0x9f,
+	0x2e, 0xcf, 0x36, 0x6f, 0x30, 0xa9, 0x13, 0xca, 0x4c, 0x0d, 0x6c, 0x4c, 0x6c, 0xb5, 0x21, 0x89,
+	0x70, 0x48, 0xa0, 0xf9, 0x44, 0x01, 0x5a, 0x8b, 0xa0, 0x03, 0xcf, 0xfb, 0x38, 0x80, 0x31, 0x82,
+	0xa1, 0x3b, 0xbc, 0xd3, 0xef, 0x06, 0x3e, 0xa5, 0x30, 0x7e, 0xf9, 0x92, 0x3e, 0x07, 0x6b, 0x30,
+	0x0d, 0xd7, 0x21, 0x32, 0x5e, 0xa5, 0x54, 0x9b, 0x9f, 0x19, 0xe2, 0x06, 0xcc, 0x8b, 0xd8, 0xdf,
+	0xce, 0x57, 0xf9, 0x96, 0xa8, 0x72, 0xba, 0x6c, 0xf3, 0x1d, 0xf0, 0x76, 0x21, 0x28, 0x2b, 0x7f,
+	0xaa, 0x80, 0x5b, 0x2d, 0x82, 0xda, 0x30, 0xc0, 0x03, 0xf8, 0xca, 0x16, 0xbf, 0x97, 0x2f, 0xbe,
+	0x26, 0x8a, 0x2f, 0x54, 0x6e, 0xd6, 0xc1, 0xed, 0x59, 0xb8, 0x6c, 0xc1, 0x6f, 0xbc, 0x05, 0x07,
+	0x2e, 0xf5, 0x07, 0x0e, 0x1d, 0x51, 0x8f, 0x62, 0x1c, 0x61, 0xe2, 0xf4, 0xd4, 0x6d, 0x50, 0x26,
+	0x30, 0xf4, 0x60, 0x7c, 0x65, 0xfd, 0x82, 0xa7, 0x56, 0xc1, 0x52, 0x24, 0xde, 0xee, 0xf8, 0x5e,
+	0x72, 0xa3, 0x5f, 0x6b, 0x83, 0x74, 0xeb, 0x33, 0x8f, 0x9f, 0xa6, 0x60, 0x67, 0xab, 0x29, 0x14,
+	0x21, 0xaa, 0x29, 0xc4, 0x65, 0x35, 0x0f, 0x4b, 0x60, 0xa5, 0x45, 0xd0, 0x17, 0xd8, 0x3d, 0x39,
+	0xc6, 0x27, 0x30, 0x24, 0xaa, 0x05, 0xae, 0xe1, 0xef, 0xc2, 0xe7, 0x50, 0xcf, 0x69, 0xea, 0x10,
+	0x94, 0x9d, 0x00, 0xf7, 0x43, 0x9a, 0x9c, 0xd5, 0xd2, 0xae, 0x66, 0x09, 0x36, 0xb3, 0x2a, 0x4b,
+	0x58, 0x95, 0xf5, 0x11, 0xf6, 0xc3, 0xe6, 0x27, 0xec, 0x6b, 0xfc, 0xfd, 0x59, 0xb5, 0x81, 0x7c,
+	0x7a, 0xaf, 0xdf, 0xb5, 0x5c, 0x1c, 0x08, 0x47, 0x12, 0xff, 0xb6, 0x88, 0x77, 0x62, 0xd3, 0x61,
+	0x04, 0x49, 0xf2, 0x02, 0xf9, 0xf5, 0xf2, 0x6c, 0x73, 0xb9, 0x07, 0x91, 0xe3, 0x0e, 0x3b, 0xcc,
+	0xec, 0x88, 0xf8, 0x94, 0x79, 0x42, 0xb5, 0x05, 0x56, 0x7a, 0xd8, 0x3d, 0xe9, 0xa4, 0x0e, 0x55,
+	0x99, 0x4f, 0xbc, 0x40, 0xb3, 0xb8, 0x45, 0x59, 0xa9, 0x45, 0x59, 0x87, 0x82, 0xd0, 0x5c, 0x61,
+	0x0a, 0x7e, 0x79, 0x56, 0x55, 0x78, 0xa0, 0x65, 0xf6, 0x7a, 0x0a, 0xee, 0xd7, 0x58, 0x97, 0x79,
+	0x55, 0xac, 0xc9, 0xab, 0xa2, 0xc9, 0xa3, 0xde, 0x98, 0xdf, 0x80, 0xf5, 0xb1, 0x8d, 0xb4, 0x8d,
+	0xea, 0x21, 0x58, 0x80, 0xa1, 0xd7, 0x61, 0x56, 0x98, 0xf4, 0x6d, 0x69, 0x57, 0xcf, 0x89, 0x38,
+	0x4e, 0x7d, 0x92, 0xab, 0x38, 0x95, 0x2a, 0xae, 0xc3, 0xd0, 0x63, 0xa0, 0x09, 0xb9, 0x3f, 0x86,
+	0xbd, 0x97, 0x3e, 0x8d, 0x7d, 0x73, 0xbc, 0x06, 0xe9, 0x6c, 0x99, 0x98, 0xe6, 0xcf, 0x0a, 0xd8,
+	0x98, 0xd8, 0x93, 0x85, 0x8c, 0x4e, 0x53, 0xf9, 0x9f, 0x4f, 0xd3, 0xfc, 0x8b, 0x8f, 0x87, 0x43,
+	0xc8, 0x28, 0x14, 0xde, 0xc5, 0x14, 0x32, 0x3b, 0xf1, 0xf8, 0x1a, 0x5f, 0xdd, 0x82, 0x11, 0x55,
+	0x7d, 0x1f, 0x2c, 0x88, 0x05, 0xac, 0x94, 0xae, 0x78, 0x4d, 0x32, 0x55, 0x0d, 0x2c, 0x04, 0x04,
+	0x75, 0x98, 0xe4, 0xe4, 0x2a, 0x2d, 0xb6, 0xaf, 0x07, 0x04, 0x1d, 0x0f, 0x23, 0x28, 0xa6, 0x86,
+	0x4c, 0x90, 0xed, 0x6d, 0x56, 0xb0, 0x98, 0x1a, 0xd9, 0x2d, 0xf9, 0xa9, 0x9d, 0x2a, 0x60, 0x35,
+	0x69, 0xbb, 0xf7, 0x5f, 0x54, 0x98, 0xd5, 0x5a, 0x1a, 0xd7, 0xda, 0xc8, 0x6b, 0x5d, 0x97, 0xf7,
+	0x20, 0x9b, 0xdc, 0x7c, 0x13, 0x68, 0xb9, 0xcd, 0x54, 0xef, 0xee, 0x9f, 0x65, 0x30, 0xdf, 0x22,
+	0x48, 0xbd, 0x0b, 0x96, 0xc7, 0x46, 0xb6, 0x31, 0x31, 0x6a, 0x27, 0xa6, 0xa4, 0x5e, 0x9f, 0x8d,
+	0xcb, 0xab, 0x46, 0xc1, 0xcd, 0x82, 0x09, 0xda, 0xc8, 0x47, 0x98, 0xce, 0xd4, 0xb7, 0x9f, 0x97,
+	0x29, 0xb3, 0x7e, 0x0f, 0xb4, 0xe2, 0xe9, 0xf5, 0x5e, 0x3e, 0x5c, 0x21, 0x59, 0xdf, 0x7b, 0x01,
+	0x72, 0x36, 0x7d, 0xf1, 0xe4, 0x98, 0x92, 0xbe, 0x90, 0xac, 0xef, 0xbd, 0x00, 0x59, 0xa6, 0x3f,
+	0x02, 0x20, 0x63, 0xf5, 0xb7, 0xf2, 0x21, 0x46, 0xa8, 0x7e, 0x7b, 0x16, 0x2a, 0x23, 0xb2, 0xdb,
+	0x91, 0x35, 0xac, 0x69, 0xb7, 0x23, 0x83, 0xeb, 0xf5, 0xd9, 0x78, 0x36, 0xee, 0x98, 0x13, 0x4c,
+	0x89, 0x9b, 0xc5, 0xf5, 0xfa, 0x6c, 0x5c, 0xc6, 0xfd, 0x1a, 0xbc, 0x3e, 0xf1, 0x05, 0xd6, 0xa6,
+	0x29, 0xca, 0x32, 0xf4, 0xc6, 0x55, 0x8c, 0x34, 0xba, 0x7e, 0xed, 0x07, 0x66, 0x69, 0xcd, 0x4f,
+	0x1f, 0x9c, 0x1b, 0xca, 0xa3, 0x73, 0x43, 0x79, 0x7c, 0x6e, 0x28, 0xff, 0x9c, 0x1b, 0xca, 0xe9,
+	0x85, 0x31, 0xf7, 0xf8, 0xc2, 0x98, 0x7b, 0x7a, 0x61, 0xcc, 0x7d, 0xf5, 0x6e, 0xc6, 0x30, 0x79,
+	0xe0, 0xad, 0x9e, 0xd3, 0x25, 0xe2, 0xd9, 0xbe, 0x9f, 0xfc, 0x72, 0x4e, 0x7c, 0xb3, 0x5b, 0x4e,
+	0xa6, 0xc7, 0xde, 0xbf, 0x03, 0x00, 0xb1, 0xc8, 0xf6, 0xd8, 0x39, 0x0c, 0x00, 0x00,
 }
 
 func (this *MsgUpdateParams) Equal(that interface{}) bool {
@@ -654,13 +1086,224 @@ func (this *MsgActivateEmergencyProposalResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgLockTokens) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+	that1, ok := that.(*MsgLockTokens)
+	if !ok {
+		that2, ok := that.(MsgLockTokens)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Owner != that1.Owner {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	if this.LockDuration != that1.LockDuration {
+		return false
+	}
+	return true
+}
+func (this *MsgLockTokensResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
+	that1, ok := that.(*MsgLockTokensResponse)
+	if !ok {
+		that2, ok := that.(MsgLockTokensResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.EndTime.Equal(that1.EndTime) {
+		return false
+	}
+	return true
+}
+func (this *MsgUnlockTokens) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgUnlockTokens)
+	if !ok {
+		that2, ok := that.(MsgUnlockTokens)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Owner != that1.Owner {
+		return false
+	}
+	return true
+}
+func (this *MsgUnlockTokensResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgUnlockTokensResponse)
+	if !ok {
+		that2, ok := that.(MsgUnlockTokensResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *MsgDelegateVote) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgDelegateVote)
+	if !ok {
+		that2, ok := that.(MsgDelegateVote)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Delegator != that1.Delegator {
+		return false
+	}
+	if this.Delegate != that1.Delegate {
+		return false
+	}
+	if this.MsgType != that1.MsgType {
+		return false
+	}
+	return true
+}
+func (this *MsgDelegateVoteResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgDelegateVoteResponse)
+	if !ok {
+		that2, ok := that.(MsgDelegateVoteResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *MsgUndelegateVote) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgUndelegateVote)
+	if !ok {
+		that2, ok := that.(MsgUndelegateVote)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Delegator != that1.Delegator {
+		return false
+	}
+	if this.MsgType != that1.MsgType {
+		return false
+	}
+	return true
+}
+func (this *MsgUndelegateVoteResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgUndelegateVoteResponse)
+	if !ok {
+		that2, ok := that.(MsgUndelegateVoteResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
 const _ = grpc.SupportPackageIsVersion4
 
 // MsgClient is the client API for Msg service.
@@ -676,6 +1319,14 @@ type MsgClient interface {
 	RemoveEmergencySubmitters(ctx context.Context, in *MsgRemoveEmergencySubmitters, opts ...grpc.CallOption) (*MsgRemoveEmergencySubmittersResponse, error)
 	// ActivateEmergencyProposal defines a governance operation for activating an emergency proposal.
 	ActivateEmergencyProposal(ctx context.Context, in *MsgActivateEmergencyProposal, opts ...grpc.CallOption) (*MsgActivateEmergencyProposalResponse, error)
+	// LockTokens defines a method for locking tokens to get the conviction voting power.
+	LockTokens(ctx context.Context, in *MsgLockTokens, opts ...grpc.CallOption) (*MsgLockTokensResponse, error)
+	// UnlockTokens defines a method for unlocking the expired voting locks.
+	UnlockTokens(ctx context.Context, in *MsgUnlockTokens, opts ...grpc.CallOption) (*MsgUnlockTokensResponse, error)
+	// DelegateVote defines a method for delegating the governance vote to another account.
+	DelegateVote(ctx context.Context, in *MsgDelegateVote, opts ...grpc.CallOption) (*MsgDelegateVoteResponse, error)
+	// UndelegateVote defines a method for removing a vote delegation.
+	UndelegateVote(ctx context.Context, in *MsgUndelegateVote, opts ...grpc.CallOption) (*MsgUndelegateVoteResponse, error)
 }
 
 type msgClient struct {
@@ -722,6 +1373,42 @@ func (c *msgClient) ActivateEmergencyProposal(ctx context.Context, in *MsgActiva
 	return out, nil
 }
 
+func (c *msgClient) LockTokens(ctx context.Context, in *MsgLockTokens, opts ...grpc.CallOption) (*MsgLockTokensResponse, error) {
+	out := new(MsgLockTokensResponse)
+	err := c.cc.Invoke(ctx, "/initia.gov.v1.Msg/LockTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnlockTokens(ctx context.Context, in *MsgUnlockTokens, opts ...grpc.CallOption) (*MsgUnlockTokensResponse, error) {
+	out := new(MsgUnlockTokensResponse)
+	err := c.cc.Invoke(ctx, "/initia.gov.v1.Msg/UnlockTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DelegateVote(ctx context.Context, in *MsgDelegateVote, opts ...grpc.CallOption) (*MsgDelegateVoteResponse, error) {
+	out := new(MsgDelegateVoteResponse)
+	err := c.cc.Invoke(ctx, "/initia.gov.v1.Msg/DelegateVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UndelegateVote(ctx context.Context, in *MsgUndelegateVote, opts ...grpc.CallOption) (*MsgUndelegateVoteResponse, error) {
+	out := new(MsgUndelegateVoteResponse)
+	err := c.cc.Invoke(ctx, "/initia.gov.v1.Msg/UndelegateVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a governance operation for updating the x/gov
@@ -733,6 +1420,14 @@ type MsgServer interface {
 	RemoveEmergencySubmitters(context.Context, *MsgRemoveEmergencySubmitters) (*MsgRemoveEmergencySubmittersResponse, error)
 	// ActivateEmergencyProposal defines a governance operation for activating an emergency proposal.
 	ActivateEmergencyProposal(context.Context, *MsgActivateEmergencyProposal) (*MsgActivateEmergencyProposalResponse, error)
+	// LockTokens defines a method for locking tokens to get the conviction voting power.
+	LockTokens(context.Context, *MsgLockTokens) (*MsgLockTokensResponse, error)
+	// UnlockTokens defines a method for unlocking the expired voting locks.
+	UnlockTokens(context.Context, *MsgUnlockTokens) (*MsgUnlockTokensResponse, error)
+	// DelegateVote defines a method for delegating the governance vote to another account.
+	DelegateVote(context.Context, *MsgDelegateVote) (*MsgDelegateVoteResponse, error)
+	// UndelegateVote defines a method for removing a vote delegation.
+	UndelegateVote(context.Context, *MsgUndelegateVote) (*MsgUndelegateVoteResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -751,6 +1446,18 @@ func (*UnimplementedMsgServer) RemoveEmergencySubmitters(ctx context.Context, re
 func (*UnimplementedMsgServer) ActivateEmergencyProposal(ctx context.Context, req *MsgActivateEmergencyProposal) (*MsgActivateEmergencyProposalResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ActivateEmergencyProposal not implemented")
 }
+func (*UnimplementedMsgServer) LockTokens(ctx context.Context, req *MsgLockTokens) (*MsgLockTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockTokens not implemented")
+}
+func (*UnimplementedMsgServer) UnlockTokens(ctx context.Context, req *MsgUnlockTokens) (*MsgUnlockTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockTokens not implemented")
+}
+func (*UnimplementedMsgServer) DelegateVote(ctx context.Context, req *MsgDelegateVote) (*MsgDelegateVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateVote not implemented")
+}
+func (*UnimplementedMsgServer) UndelegateVote(ctx context.Context, req *MsgUndelegateVote) (*MsgUndelegateVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndelegateVote not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -828,6 +1535,78 @@ func _Msg_ActivateEmergencyProposal_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_LockTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLockTokens)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).LockTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.gov.v1.Msg/LockTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LockTokens(ctx, req.(*MsgLockTokens))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnlockTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnlockTokens)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnlockTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.gov.v1.Msg/UnlockTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnlockTokens(ctx, req.(*MsgUnlockTokens))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DelegateVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateVote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.gov.v1.Msg/DelegateVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateVote(ctx, req.(*MsgDelegateVote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UndelegateVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUndelegateVote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UndelegateVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.gov.v1.Msg/UndelegateVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UndelegateVote(ctx, req.(*MsgUndelegateVote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "initia.gov.v1.Msg",
@@ -849,6 +1628,22 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ActivateEmergencyProposal",
 			Handler:    _Msg_ActivateEmergencyProposal_Handler,
 		},
+		{
+			MethodName: "LockTokens",
+			Handler:    _Msg_LockTokens_Handler,
+		},
+		{
+			MethodName: "UnlockTokens",
+			Handler:    _Msg_UnlockTokens_Handler,
+		},
+		{
+			MethodName: "DelegateVote",
+			Handler:    _Msg_DelegateVote_Handler,
+		},
+		{
+			MethodName: "UndelegateVote",
+			Handler:    _Msg_UndelegateVote_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "initia/gov/v1/tx.proto",
@@ -1099,22 +1894,299 @@ func (m *MsgActivateEmergencyProposalResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgLockTokens) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
+
+func (m *MsgLockTokens) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLockTokens) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.LockDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.LockDuration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTx(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLockTokensResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLockTokensResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLockTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTx(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnlockTokens) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnlockTokens) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnlockTokens) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnlockTokensResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnlockTokensResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnlockTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelegateVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgType) > 0 {
+		i -= len(m.MsgType)
+		copy(dAtA[i:], m.MsgType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MsgType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelegateVoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateVoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUndelegateVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUndelegateVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUndelegateVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgType) > 0 {
+		i -= len(m.MsgType)
+		copy(dAtA[i:], m.MsgType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MsgType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUndelegateVoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUndelegateVoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUndelegateVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
 	l = len(m.Authority)
 	if l > 0 {
@@ -1178,50 +2250,810 @@ func (m *MsgRemoveEmergencySubmitters) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
-	return n
-}
-
-func (m *MsgRemoveEmergencySubmittersResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgActivateEmergencyProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ProposalId != 0 {
-		n += 1 + sovTx(uint64(m.ProposalId))
-	}
-	return n
-}
+	return n
+}
+
+func (m *MsgRemoveEmergencySubmittersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgActivateEmergencyProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *MsgActivateEmergencyProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgLockTokens) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.LockDuration)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgLockTokensResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUnlockTokens) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnlockTokensResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDelegateVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MsgType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDelegateVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUndelegateVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MsgType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUndelegateVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddEmergencySubmitters) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddEmergencySubmitters: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddEmergencySubmitters: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmergencySubmitters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EmergencySubmitters = append(m.EmergencySubmitters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddEmergencySubmittersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddEmergencySubmittersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddEmergencySubmittersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveEmergencySubmitters) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveEmergencySubmitters: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveEmergencySubmitters: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmergencySubmitters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EmergencySubmitters = append(m.EmergencySubmitters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveEmergencySubmittersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveEmergencySubmittersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveEmergencySubmittersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgActivateEmergencyProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgActivateEmergencyProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgActivateEmergencyProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgActivateEmergencyProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgActivateEmergencyProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgActivateEmergencyProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgActivateEmergencyProposalResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgLockTokens) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1244,15 +3076,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLockTokens: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLockTokens: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1280,11 +3112,11 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1311,7 +3143,41 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.LockDuration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1336,7 +3202,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgLockTokensResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1359,12 +3225,45 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLockTokensResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLockTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1386,7 +3285,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddEmergencySubmitters) Unmarshal(dAtA []byte) error {
+func (m *MsgUnlockTokens) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1409,47 +3308,15 @@ func (m *MsgAddEmergencySubmitters) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddEmergencySubmitters: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnlockTokens: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddEmergencySubmitters: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnlockTokens: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EmergencySubmitters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1477,7 +3344,7 @@ func (m *MsgAddEmergencySubmitters) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EmergencySubmitters = append(m.EmergencySubmitters, string(dAtA[iNdEx:postIndex]))
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1500,7 +3367,7 @@ func (m *MsgAddEmergencySubmitters) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddEmergencySubmittersResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnlockTokensResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1523,12 +3390,46 @@ func (m *MsgAddEmergencySubmittersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddEmergencySubmittersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnlockTokensResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddEmergencySubmittersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnlockTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1550,7 +3451,7 @@ func (m *MsgAddEmergencySubmittersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveEmergencySubmitters) Unmarshal(dAtA []byte) error {
+func (m *MsgDelegateVote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1573,15 +3474,15 @@ func (m *MsgRemoveEmergencySubmitters) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveEmergencySubmitters: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelegateVote: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveEmergencySubmitters: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelegateVote: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1609,11 +3510,11 @@ func (m *MsgRemoveEmergencySubmitters) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Delegator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EmergencySubmitters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1641,7 +3542,39 @@ func (m *MsgRemoveEmergencySubmitters) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EmergencySubmitters = append(m.EmergencySubmitters, string(dAtA[iNdEx:postIndex]))
+			m.Delegate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1664,7 +3597,7 @@ func (m *MsgRemoveEmergencySubmitters) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveEmergencySubmittersResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDelegateVoteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1687,10 +3620,10 @@ func (m *MsgRemoveEmergencySubmittersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveEmergencySubmittersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelegateVoteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveEmergencySubmittersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelegateVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1714,7 +3647,7 @@ func (m *MsgRemoveEmergencySubmittersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgActivateEmergencyProposal) Unmarshal(dAtA []byte) error {
+func (m *MsgUndelegateVote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1737,15 +3670,15 @@ func (m *MsgActivateEmergencyProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgActivateEmergencyProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUndelegateVote: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgActivateEmergencyProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUndelegateVote: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1773,13 +3706,13 @@ func (m *MsgActivateEmergencyProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Delegator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgType", wireType)
 			}
-			m.ProposalId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1789,11 +3722,24 @@ func (m *MsgActivateEmergencyProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1815,7 +3761,7 @@ func (m *MsgActivateEmergencyProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgActivateEmergencyProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUndelegateVoteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1838,10 +3784,10 @@ func (m *MsgActivateEmergencyProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgActivateEmergencyProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUndelegateVoteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgActivateEmergencyProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUndelegateVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -0,0 +1,14 @@
+package types
+
+// MaxVoteDelegationDepth is the maximum number of delegates followed when
+// resolving a vote delegation chain.
+const MaxVoteDelegationDepth = 5
+
+// NewVoteDelegation creates a new VoteDelegation instance
+func NewVoteDelegation(delegator, delegate, msgType string) VoteDelegation {
+	return VoteDelegation{
+		Delegator: delegator,
+		Delegate:  delegate,
+		MsgType:   msgType,
+	}
+}
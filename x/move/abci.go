@@ -101,3 +101,10 @@ func BeginBlocker(ctx context.Context, k keeper.Keeper, vc address.Codec) error
 
 	return nil
 }
+
+func EndBlocker(ctx context.Context, k keeper.Keeper) error {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	// record the prices of the whitelisted dex pairs for the twap
+	return keeper.NewDexKeeper(&k).UpdateTWAPObservations(ctx)
+}
@@ -226,6 +226,56 @@ func Test_SendCoins(t *testing.T) {
 	require.Equal(t, amount, sdk.NewCoins(input.BankKeeper.GetBalance(ctx, threeAddr, bondDenom)))
 }
 
+func Test_GetPaginatedDenomOwners(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	moveBankKeeper := input.MoveKeeper.MoveBankKeeper()
+
+	bz, err := hex.DecodeString("0000000000000000000000000000000000000002")
+	require.NoError(t, err)
+	twoAddr := sdk.AccAddress(bz)
+
+	bz, err = hex.DecodeString("0000000000000000000000000000000000000003")
+	require.NoError(t, err)
+	threeAddr := sdk.AccAddress(bz)
+
+	denom := "test"
+	err = moveBankKeeper.MintCoins(ctx, twoAddr, sdk.NewCoins(sdk.NewInt64Coin(denom, 100)))
+	require.NoError(t, err)
+	err = moveBankKeeper.SendCoins(ctx, twoAddr, threeAddr, sdk.NewCoins(sdk.NewInt64Coin(denom, 40)))
+	require.NoError(t, err)
+
+	expected := []*cosmosbanktypes.DenomOwner{
+		{Address: twoAddr.String(), Balance: sdk.NewInt64Coin(denom, 60)},
+		{Address: threeAddr.String(), Balance: sdk.NewInt64Coin(denom, 40)},
+	}
+
+	owners, pageRes, err := moveBankKeeper.GetPaginatedDenomOwners(ctx, denom, &query.PageRequest{Limit: 1})
+	require.NoError(t, err)
+	require.Equal(t, expected[:1], owners)
+
+	owners, _, err = moveBankKeeper.GetPaginatedDenomOwners(ctx, denom, &query.PageRequest{Key: pageRes.NextKey})
+	require.NoError(t, err)
+	require.Equal(t, expected[1:], owners)
+
+	// the owner is removed when the balance becomes zero
+	err = moveBankKeeper.SendCoins(ctx, threeAddr, twoAddr, sdk.NewCoins(sdk.NewInt64Coin(denom, 40)))
+	require.NoError(t, err)
+
+	owners, _, err = moveBankKeeper.GetPaginatedDenomOwners(ctx, denom, nil)
+	require.NoError(t, err)
+	require.Equal(t, []*cosmosbanktypes.DenomOwner{
+		{Address: twoAddr.String(), Balance: sdk.NewInt64Coin(denom, 100)},
+	}, owners)
+
+	// rebuild the index from the fungible stores
+	require.NoError(t, input.MoveKeeper.DenomOwners.Clear(ctx, nil))
+	require.NoError(t, moveBankKeeper.RebuildDenomOwners(ctx))
+
+	rebuilt, _, err := moveBankKeeper.GetPaginatedDenomOwners(ctx, denom, nil)
+	require.NoError(t, err)
+	require.Equal(t, owners, rebuilt)
+}
+
 func Test_GetMetadata(t *testing.T) {
 	ctx, input := createDefaultTestInput(t)
 	moveBankKeeper := input.MoveKeeper.MoveBankKeeper()
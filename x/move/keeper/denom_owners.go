@@ -0,0 +1,207 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	cosmosbanktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	vmtypes "github.com/initia-labs/movevm/types"
+
+	"github.com/initia-labs/initia/x/move/types"
+)
+
+// fungibleAssetEvent is the common layout of fungible_asset::DepositEvent and
+// fungible_asset::WithdrawEvent.
+type fungibleAssetEvent struct {
+	StoreAddr    string `json:"store_addr"`
+	MetadataAddr string `json:"metadata_addr"`
+}
+
+// GetPaginatedDenomOwners returns the owners of the given denom with their
+// primary fungible store balances.
+//
+// @devs: The index is built from the raw fungible store balances, so the
+// balances of dispatchable fungible assets are not derived.
+func (k MoveBankKeeper) GetPaginatedDenomOwners(ctx context.Context, denom string, pageReq *query.PageRequest) ([]*cosmosbanktypes.DenomOwner, *query.PageResponse, error) {
+	metadata, err := types.MetadataAddressFromDenom(denom)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return query.CollectionPaginate(ctx, k.DenomOwners, pageReq, func(key collections.Pair[[]byte, sdk.AccAddress], amount math.Int) (*cosmosbanktypes.DenomOwner, error) {
+		return &cosmosbanktypes.DenomOwner{
+			Address: key.K2().String(),
+			Balance: sdk.NewCoin(denom, amount),
+		}, nil
+	}, query.WithCollectionPaginationPairPrefix[[]byte, sdk.AccAddress](metadata[:]))
+}
+
+// RebuildDenomOwners clears the denom owners index and rebuilds it from all
+// the primary fungible stores.
+func (k MoveBankKeeper) RebuildDenomOwners(ctx context.Context) error {
+	if err := k.DenomOwners.Clear(ctx, nil); err != nil {
+		return err
+	}
+
+	bz, err := k.GetResourceBytes(ctx, vmtypes.StdAddress, vmtypes.StructTag{
+		Address: vmtypes.StdAddress,
+		Module:  types.MoveModuleNamePrimaryFungibleStore,
+		Name:    types.ResourceNameModuleStore,
+	})
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	userStoresTableAddr, err := types.ReadUserStoresTableHandleFromModuleStore(bz)
+	if err != nil {
+		return err
+	}
+
+	// collect the stores table of each owner
+	type userStores struct {
+		owner     vmtypes.AccountAddress
+		tableAddr vmtypes.AccountAddress
+	}
+
+	var allUserStores []userStores
+	prefix := types.GetTableEntryPrefix(userStoresTableAddr)
+	err = k.VMStore.Walk(ctx, new(collections.Range[[]byte]).Prefix(collections.NewPrefix(prefix)), func(key, value []byte) (stop bool, err error) {
+		owner, err := vmtypes.NewAccountAddressFromBytes(key[len(prefix):])
+		if err != nil {
+			return true, err
+		}
+
+		tableAddr, err := types.ReadTableHandleFromTable(value)
+		if err != nil {
+			return true, err
+		}
+
+		allUserStores = append(allUserStores, userStores{owner, tableAddr})
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, stores := range allUserStores {
+		var storeAddrs []vmtypes.AccountAddress
+		prefix := types.GetTableEntryPrefix(stores.tableAddr)
+		err = k.VMStore.Walk(ctx, new(collections.Range[[]byte]).Prefix(collections.NewPrefix(prefix)), func(_, value []byte) (stop bool, err error) {
+			storeAddr, err := vmtypes.NewAccountAddressFromBytes(value)
+			if err != nil {
+				return true, err
+			}
+
+			storeAddrs = append(storeAddrs, storeAddr)
+			return false, nil
+		})
+		if err != nil {
+			return err
+		}
+
+		owner := types.ConvertVMAddressToSDKAddress(stores.owner)
+		for _, storeAddr := range storeAddrs {
+			metadata, amount, err := k.Balance(ctx, storeAddr)
+			if err != nil {
+				return err
+			}
+
+			if err := k.setDenomOwner(ctx, metadata, owner, amount); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// updateDenomOwners refreshes the denom owners index for the primary fungible
+// stores touched by the deposit and withdraw events of an execution. The
+// caller's gas meter is charged for the store accesses, which grow with the
+// number of distinct stores touched.
+func (k MoveBankKeeper) updateDenomOwners(ctx context.Context, events []vmtypes.JsonEvent) error {
+	visited := make(map[vmtypes.AccountAddress]bool)
+	for _, event := range events {
+		if event.TypeTag != types.EventTypeTagFungibleAssetDeposit &&
+			event.TypeTag != types.EventTypeTagFungibleAssetWithdraw {
+			continue
+		}
+
+		var data fungibleAssetEvent
+		if err := json.Unmarshal([]byte(event.EventData), &data); err != nil {
+			return err
+		}
+
+		storeAddr, err := vmtypes.NewAccountAddress(data.StoreAddr)
+		if err != nil {
+			return err
+		}
+		if visited[storeAddr] {
+			continue
+		}
+		visited[storeAddr] = true
+
+		metadata, err := vmtypes.NewAccountAddress(data.MetadataAddr)
+		if err != nil {
+			return err
+		}
+
+		if err := k.updateDenomOwner(ctx, storeAddr, metadata); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// updateDenomOwner sets the balance of a fungible store to the denom owners
+// index, if the store is the primary fungible store of its owner.
+func (k MoveBankKeeper) updateDenomOwner(ctx context.Context, storeAddr, metadata vmtypes.AccountAddress) error {
+	bz, err := k.GetResourceBytes(ctx, storeAddr, vmtypes.StructTag{
+		Address: vmtypes.StdAddress,
+		Module:  types.MoveModuleNameObject,
+		Name:    types.ResourceNameObjectCore,
+	})
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	owner, err := types.ReadOwnerFromObjectCore(bz)
+	if err != nil {
+		return err
+	}
+
+	// skip secondary stores
+	if types.UserDerivedObjectAddress(owner, metadata) != storeAddr {
+		return nil
+	}
+
+	_, amount, err := k.Balance(ctx, storeAddr)
+	if err != nil {
+		return err
+	}
+
+	return k.setDenomOwner(ctx, metadata, types.ConvertVMAddressToSDKAddress(owner), amount)
+}
+
+// setDenomOwner stores the balance to the denom owners index, or removes the
+// entry if the balance is zero.
+func (k MoveBankKeeper) setDenomOwner(ctx context.Context, metadata vmtypes.AccountAddress, owner sdk.AccAddress, amount math.Int) error {
+	key := collections.Join(metadata.Bytes(), owner)
+	if !amount.IsPositive() {
+		return k.DenomOwners.Remove(ctx, key)
+	}
+
+	return k.DenomOwners.Set(ctx, key, amount)
+}
@@ -2,7 +2,9 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -65,11 +67,22 @@ func (k DexKeeper) DeleteDexPair(
 	return k.deleteDexPair(ctx, metadata)
 }
 
-// deleteDexPair remove types.DexPair from the store
+// deleteDexPair remove types.DexPair and its twap observations from the store
 func (k DexKeeper) deleteDexPair(
 	ctx context.Context,
 	metadataQuote vmtypes.AccountAddress,
 ) error {
+	metadataLP, err := k.getMetadataLP(ctx, metadataQuote)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if err := k.TWAPObservations.Clear(ctx, collections.NewPrefixedPairRange[[]byte, int64](metadataLP[:])); err != nil {
+		return err
+	}
+
 	return k.DexPairs.Remove(ctx, metadataQuote[:])
 }
 
@@ -151,6 +151,14 @@ func (k Keeper) InitGenesis(ctx context.Context, moduleNames []string, genState
 			return err
 		}
 	}
+
+	// build denom owners index from the imported fungible stores
+	if len(genState.GetModules()) != 0 {
+		if err := k.moveBankKeeper.RebuildDenomOwners(ctx); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
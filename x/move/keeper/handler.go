@@ -355,6 +355,14 @@ func (k Keeper) handleExecuteResponse(
 	// NOTE: this line should be here to avoid charging any extra gas for CSR
 	ctx = ctx.WithGasMeter(gasMeter)
 
+	// update denom owners index with the touched fungible stores
+	// NOTE: this runs after the gas meter is restored on purpose, so the index
+	// reads and writes are charged to the tx; each touched store costs one
+	// object and one fungible store resource read plus one index write
+	if err := k.moveBankKeeper.updateDenomOwners(ctx, execRes.Events); err != nil {
+		return err
+	}
+
 	// apply staking delta
 	if err := k.ApplyStakingDeltas(ctx, execRes.StakingDeltas); err != nil {
 		return err
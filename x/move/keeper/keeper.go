@@ -59,8 +59,12 @@ type Keeper struct {
 	ExecutionCounter collections.Sequence
 	Params           collections.Item[types.RawParams]
 	DexPairs         collections.Map[[]byte, []byte]
+	TWAPObservations collections.Map[collections.Pair[[]byte, int64], types.TWAPObservation]
 	VMStore          collections.Map[[]byte, []byte]
 
+	// DenomOwners is an index of the primary fungible store balances by (metadata, owner)
+	DenomOwners collections.Map[collections.Pair[[]byte, sdk.AccAddress], math.Int]
+
 	ac address.Codec
 	vc address.Codec
 
@@ -131,7 +135,9 @@ func NewKeeper(
 		ExecutionCounter: collections.NewSequence(sb, types.ExecutionCounterKey, "execution_counter"),
 		Params:           collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.RawParams](cdc)),
 		DexPairs:         collections.NewMap(sb, types.DexPairPrefix, "dex_pairs", collections.BytesKey, collections.BytesValue),
+		TWAPObservations: collections.NewMap(sb, types.TWAPObservationPrefix, "twap_observations", collections.PairKeyCodec(collections.BytesKey, collections.Int64Key), codec.CollValue[types.TWAPObservation](cdc)),
 		VMStore:          collections.NewMap(sb, types.VMStorePrefix, "vm_store", collections.BytesKey, collections.BytesValue),
+		DenomOwners:      collections.NewMap(sb, types.DenomOwnersPrefix, "denom_owners", collections.PairKeyCodec(collections.BytesKey, sdk.AccAddressKey), sdk.IntValue),
 
 		ac: ac,
 		vc: vc,
@@ -6,9 +6,13 @@ import (
 
 	"cosmossdk.io/errors"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	vmapi "github.com/initia-labs/movevm/api"
 	vmtypes "github.com/initia-labs/movevm/types"
 
@@ -518,3 +522,89 @@ func (q Querier) Metadata(ctx context.Context, req *types.QueryMetadataRequest)
 		Metadata: metadataAddr.String(),
 	}, nil
 }
+
+// BalanceBreakdown implements types.QueryServer.
+func (q Querier) BalanceBreakdown(ctx context.Context, req *types.QueryBalanceBreakdownRequest) (*types.QueryBalanceBreakdownResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := q.ac.StringToBytes(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	liquid := sdk.NewCoins()
+	err = q.MoveBankKeeper().IterateAccountBalances(ctx, addr, func(coin sdk.Coin) (bool, error) {
+		liquid = liquid.Add(coin)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	vestingLocked := sdk.NewCoins()
+	vestingClaimable := sdk.NewCoins()
+	if req.VestingModuleAddress != "" {
+		moduleAddr, err := q.ac.StringToBytes(req.VestingModuleAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		creatorAddr, err := q.ac.StringToBytes(req.VestingCreatorAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		locked, claimable, err := NewVestingKeeper(q.Keeper).GetVestingBalances(ctx, moduleAddr, req.VestingModuleName, creatorAddr, addr)
+		if err != nil {
+			return nil, err
+		}
+
+		vestingLocked = vestingLocked.Add(locked)
+		vestingClaimable = vestingClaimable.Add(claimable)
+	}
+
+	delegated := sdk.NewCoins()
+	unbonding := sdk.NewCoins()
+	if q.StakingKeeper != nil {
+		delegations, err := q.StakingKeeper.GetAllDelegatorDelegations(ctx, addr)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, delegation := range delegations {
+			valAddr, err := q.vc.StringToBytes(delegation.ValidatorAddress)
+			if err != nil {
+				return nil, err
+			}
+
+			validator, err := q.StakingKeeper.GetValidator(ctx, valAddr)
+			if err != nil {
+				return nil, err
+			}
+
+			tokens, _ := validator.TokensFromSharesTruncated(delegation.Shares).TruncateDecimal()
+			delegated = delegated.Add(tokens...)
+		}
+
+		ubds, err := q.StakingKeeper.GetAllUnbondingDelegations(ctx, addr)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, ubd := range ubds {
+			for _, entry := range ubd.Entries {
+				unbonding = unbonding.Add(entry.Balance...)
+			}
+		}
+	}
+
+	return &types.QueryBalanceBreakdownResponse{
+		Liquid:           liquid,
+		VestingLocked:    vestingLocked,
+		VestingClaimable: vestingClaimable,
+		Delegated:        delegated,
+		Unbonding:        unbonding,
+	}, nil
+}
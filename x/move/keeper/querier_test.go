@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
@@ -11,6 +12,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	vmtypes "github.com/initia-labs/movevm/types"
 
 	"github.com/initia-labs/initia/x/move/keeper"
@@ -531,3 +535,75 @@ func Test_Query_DexPairs(t *testing.T) {
 		},
 	}, res.DexPairs)
 }
+
+func TestBalanceBreakdown(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	querier := keeper.NewQuerier(&input.MoveKeeper)
+
+	err := input.MoveKeeper.PublishModuleBundle(ctx, vmtypes.TestAddress, vmtypes.NewModuleBundle(vmtypes.NewModule(vestingModule)), types.UpgradePolicy_COMPATIBLE)
+	require.NoError(t, err)
+
+	// delegate 1_000_000 and unbond 400_000 of them
+	valAddr := createValidatorWithBalance(t, ctx, input, 100_000_000, 1_000_000)
+	delAddr := addrs[0]
+	_, _, err = input.StakingKeeper.Undelegate(ctx, delAddr, valAddr, sdk.NewDecCoins(sdk.NewDecCoin(bondDenom, math.NewInt(400_000))))
+	require.NoError(t, err)
+
+	// add vesting of 6_000_000 for an hour
+	creatorAddr, err := vmtypes.NewAccountAddressFromBytes(addrs[1])
+	require.NoError(t, err)
+	recipientAddr, err := vmtypes.NewAccountAddressFromBytes(delAddr)
+	require.NoError(t, err)
+
+	metadata, err := types.MetadataAddressFromDenom(bondDenom)
+	require.NoError(t, err)
+
+	moduleName := "Vesting"
+	err = input.MoveKeeper.ExecuteEntryFunctionJSON(ctx, creatorAddr, vmtypes.TestAddress, moduleName, "create_vesting_store", []vmtypes.TypeTag{}, []string{fmt.Sprintf("\"%s\"", metadata)})
+	require.NoError(t, err)
+
+	now := time.Now().UTC()
+	ctx = ctx.WithBlockTime(now)
+	err = input.MoveKeeper.ExecuteEntryFunctionJSON(ctx, creatorAddr, vmtypes.TestAddress, moduleName, "add_vesting", []vmtypes.TypeTag{},
+		[]string{
+			fmt.Sprintf("\"%s\"", recipientAddr), // recipient
+			fmt.Sprintf("\"%d\"", 6000000),       // allocation
+			fmt.Sprintf("\"%d\"", now.Unix()),    // start_time
+			fmt.Sprintf("\"%d\"", 3600),          // vesting_period
+			fmt.Sprintf("\"%d\"", 1800),          // cliff_period
+			fmt.Sprintf("\"%d\"", 60),            // cliff_frequency
+		},
+	)
+	require.NoError(t, err)
+
+	input.Faucet.Fund(ctx, addrs[1], sdk.NewCoin(bondDenom, math.NewInt(6000000)))
+	err = input.MoveKeeper.ExecuteEntryFunctionJSON(ctx, creatorAddr, vmtypes.TestAddress, moduleName, "fund_vesting", []vmtypes.TypeTag{}, []string{fmt.Sprintf("\"%s\"", creatorAddr), fmt.Sprintf("\"%d\"", 6000000)})
+	require.NoError(t, err)
+
+	// a quarter of the vesting period has passed
+	ctx = ctx.WithBlockTime(now.Add(time.Minute * 15))
+
+	res, err := querier.BalanceBreakdown(ctx, &types.QueryBalanceBreakdownRequest{
+		Address:               delAddr.String(),
+		VestingModuleAddress:  types.TestAddr.String(),
+		VestingModuleName:     moduleName,
+		VestingCreatorAddress: addrs[1].String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, input.BankKeeper.GetAllBalances(ctx, delAddr), res.Liquid)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 4_500_000)), res.VestingLocked)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1_500_000)), res.VestingClaimable)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 600_000)), res.Delegated)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 400_000)), res.Unbonding)
+
+	// the vesting amounts are not queried without the vesting module
+	res, err = querier.BalanceBreakdown(ctx, &types.QueryBalanceBreakdownRequest{
+		Address: delAddr.String(),
+	})
+	require.NoError(t, err)
+	require.True(t, res.VestingLocked.IsZero())
+	require.True(t, res.VestingClaimable.IsZero())
+
+	_, err = querier.BalanceBreakdown(ctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,296 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	vmtypes "github.com/initia-labs/movevm/types"
+
+	"github.com/initia-labs/initia/x/move/types"
+)
+
+// UpdateTWAPObservations records the price state of every whitelisted dex pair
+// at the current block time and prunes the observations that fell out of the
+// TWAP window. This should be called in EndBlocker.
+func (k DexKeeper) UpdateTWAPObservations(ctx context.Context) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	if params.TwapWindow == 0 {
+		return nil
+	}
+
+	// collect the pairs first to avoid writing to the store while iterating it
+	var pairs [][2]vmtypes.AccountAddress
+	if err := k.DexPairs.Walk(ctx, nil, func(key, value []byte) (stop bool, err error) {
+		metadataQuote, err := vmtypes.NewAccountAddressFromBytes(key)
+		if err != nil {
+			return true, err
+		}
+
+		metadataLP, err := vmtypes.NewAccountAddressFromBytes(value)
+		if err != nil {
+			return true, err
+		}
+
+		pairs = append(pairs, [2]vmtypes.AccountAddress{metadataQuote, metadataLP})
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	now := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+	boundary := now - int64(params.TwapWindow/time.Second)
+	for _, pair := range pairs {
+		metadataQuote, metadataLP := pair[0], pair[1]
+
+		spotPrice, err := k.getBaseSpotPrice(ctx, metadataQuote, metadataLP)
+		if err != nil {
+			// ignore error to avoid chain halt due to a broken pool
+			continue
+		}
+
+		denomLP, err := types.DenomFromMetadataAddress(ctx, k.MoveBankKeeper(), metadataLP)
+		if err != nil {
+			// ignore error to avoid chain halt due to a broken pool
+			continue
+		}
+
+		lpBaseWeight, err := k.lpBaseWeight(ctx, params.BaseDenom, denomLP, metadataLP)
+		if err != nil {
+			// ignore error to avoid chain halt due to a broken pool
+			continue
+		}
+
+		if err := k.recordTWAPObservation(ctx, metadataLP, now, spotPrice, lpBaseWeight); err != nil {
+			return err
+		}
+
+		if err := k.pruneTWAPObservations(ctx, metadataLP, boundary); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetBaseTWAPPrice returns the time-weighted average base price of the quote
+// coin over the TWAP window. It falls back to the spot price when the
+// TWAP is disabled or no observation has been recorded yet.
+// `base_price` * `quote_amount` == `base_amount`
+func (k DexKeeper) GetBaseTWAPPrice(
+	ctx context.Context,
+	denomQuote string,
+) (math.LegacyDec, error) {
+	metadataQuote, err := types.MetadataAddressFromDenom(denomQuote)
+	if err != nil {
+		return math.LegacyZeroDec(), err
+	}
+
+	metadataLP, err := k.getMetadataLP(ctx, metadataQuote)
+	if err != nil {
+		return math.LegacyZeroDec(), err
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return math.LegacyZeroDec(), err
+	}
+
+	if params.TwapWindow > 0 {
+		price, _, found, err := k.getTWAP(ctx, metadataLP, params.TwapWindow)
+		if err != nil {
+			return math.LegacyZeroDec(), err
+		} else if found {
+			return price, nil
+		}
+	}
+
+	return k.getBaseSpotPrice(ctx, metadataQuote, metadataLP)
+}
+
+// lpBaseWeight returns the locked base balance per LP share of the pool.
+func (k DexKeeper) lpBaseWeight(
+	ctx context.Context,
+	baseDenom, denomLP string,
+	metadataLP vmtypes.AccountAddress,
+) (math.LegacyDec, error) {
+	bk := k.MoveBankKeeper()
+	balanceBase, err := bk.GetBalance(ctx, metadataLP[:], baseDenom)
+	if err != nil {
+		return math.LegacyZeroDec(), err
+	}
+
+	totalShare, err := bk.GetSupply(ctx, denomLP)
+	if err != nil {
+		return math.LegacyZeroDec(), err
+	}
+
+	if balanceBase.IsZero() || totalShare.IsZero() {
+		return math.LegacyZeroDec(), nil
+	}
+
+	return math.LegacyNewDecFromInt(balanceBase).QuoInt(totalShare), nil
+}
+
+// recordTWAPObservation stores the observation of the pool at the given time,
+// accumulating the previous observation over the elapsed seconds.
+func (k DexKeeper) recordTWAPObservation(
+	ctx context.Context,
+	metadataLP vmtypes.AccountAddress,
+	now int64,
+	spotPrice, lpBaseWeight math.LegacyDec,
+) error {
+	observation := types.TWAPObservation{
+		BaseSpotPrice:           spotPrice,
+		LPBaseWeight:            lpBaseWeight,
+		CumulativeBaseSpotPrice: math.LegacyZeroDec(),
+		CumulativeLPBaseWeight:  math.LegacyZeroDec(),
+	}
+
+	lastTime, last, found, err := k.latestTWAPObservation(ctx, metadataLP, nil)
+	if err != nil {
+		return err
+	} else if found {
+		observation.CumulativeBaseSpotPrice = last.CumulativeBaseSpotPriceAt(lastTime, now)
+		observation.CumulativeLPBaseWeight = last.CumulativeLPBaseWeightAt(lastTime, now)
+	}
+
+	return k.TWAPObservations.Set(ctx, collections.Join(metadataLP[:], now), observation)
+}
+
+// pruneTWAPObservations removes the observations older than the boundary,
+// keeping the newest of them to accumulate the start of the window from.
+func (k DexKeeper) pruneTWAPObservations(ctx context.Context, metadataLP vmtypes.AccountAddress, boundary int64) error {
+	rng := collections.NewPrefixedPairRange[[]byte, int64](metadataLP[:]).EndInclusive(boundary).Descending()
+	iter, err := k.TWAPObservations.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+
+	var staleKeys []collections.Pair[[]byte, int64]
+	for first := true; iter.Valid(); iter.Next() {
+		if first {
+			first = false
+			continue
+		}
+
+		key, err := iter.Key()
+		if err != nil {
+			iter.Close()
+			return err
+		}
+
+		staleKeys = append(staleKeys, key)
+	}
+	iter.Close()
+
+	for _, key := range staleKeys {
+		if err := k.TWAPObservations.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// latestTWAPObservation returns the newest observation of the pool, recorded
+// at or before the given time when it is not nil.
+func (k DexKeeper) latestTWAPObservation(
+	ctx context.Context,
+	metadataLP vmtypes.AccountAddress,
+	before *int64,
+) (int64, types.TWAPObservation, bool, error) {
+	rng := collections.NewPrefixedPairRange[[]byte, int64](metadataLP[:])
+	if before != nil {
+		rng = rng.EndInclusive(*before)
+	}
+
+	iter, err := k.TWAPObservations.Iterate(ctx, rng.Descending())
+	if err != nil {
+		return 0, types.TWAPObservation{}, false, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return 0, types.TWAPObservation{}, false, nil
+	}
+
+	kv, err := iter.KeyValue()
+	if err != nil {
+		return 0, types.TWAPObservation{}, false, err
+	}
+
+	return kv.Key.K2(), kv.Value, true, nil
+}
+
+// oldestTWAPObservation returns the oldest observation of the pool.
+func (k DexKeeper) oldestTWAPObservation(
+	ctx context.Context,
+	metadataLP vmtypes.AccountAddress,
+) (int64, types.TWAPObservation, bool, error) {
+	iter, err := k.TWAPObservations.Iterate(ctx, collections.NewPrefixedPairRange[[]byte, int64](metadataLP[:]))
+	if err != nil {
+		return 0, types.TWAPObservation{}, false, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return 0, types.TWAPObservation{}, false, nil
+	}
+
+	kv, err := iter.KeyValue()
+	if err != nil {
+		return 0, types.TWAPObservation{}, false, err
+	}
+
+	return kv.Key.K2(), kv.Value, true, nil
+}
+
+// getTWAP returns the time-weighted average base spot price and LP base weight
+// of the pool over the window ending at the block time. When the observations
+// do not cover the whole window, the average starts from the oldest one.
+func (k DexKeeper) getTWAP(
+	ctx context.Context,
+	metadataLP vmtypes.AccountAddress,
+	window time.Duration,
+) (math.LegacyDec, math.LegacyDec, bool, error) {
+	now := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+	latestTime, latest, found, err := k.latestTWAPObservation(ctx, metadataLP, &now)
+	if err != nil || !found {
+		return math.LegacyZeroDec(), math.LegacyZeroDec(), false, err
+	}
+
+	start := now - int64(window/time.Second)
+	startTime, startObservation, found, err := k.latestTWAPObservation(ctx, metadataLP, &start)
+	if err != nil {
+		return math.LegacyZeroDec(), math.LegacyZeroDec(), false, err
+	} else if !found {
+		startTime, startObservation, _, err = k.oldestTWAPObservation(ctx, metadataLP)
+		if err != nil {
+			return math.LegacyZeroDec(), math.LegacyZeroDec(), false, err
+		}
+
+		start = startTime
+	}
+
+	if now <= start {
+		return latest.BaseSpotPrice, latest.LPBaseWeight, true, nil
+	}
+
+	elapsed := now - start
+	spotPrice := latest.CumulativeBaseSpotPriceAt(latestTime, now).
+		Sub(startObservation.CumulativeBaseSpotPriceAt(startTime, start)).
+		QuoInt64(elapsed)
+	lpBaseWeight := latest.CumulativeLPBaseWeightAt(latestTime, now).
+		Sub(startObservation.CumulativeLPBaseWeightAt(startTime, start)).
+		QuoInt64(elapsed)
+
+	return spotPrice, lpBaseWeight, true, nil
+}
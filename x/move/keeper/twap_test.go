@@ -0,0 +1,121 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/initia/x/move/keeper"
+	"github.com/initia-labs/initia/x/move/types"
+)
+
+func TestDex_GetBaseTWAPPrice(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	dexKeeper := keeper.NewDexKeeper(&input.MoveKeeper)
+
+	params, err := input.MoveKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultTWAPWindow, params.TwapWindow)
+	params.VotingPowerUseTwap = true
+	require.NoError(t, input.MoveKeeper.SetParams(ctx, params))
+
+	baseAmount := math.NewInt(4_000_000_000_000)
+	quoteDenom := "uusdc"
+	quoteAmount := math.NewInt(1_000_000_000_000)
+
+	metadataQuote, err := types.MetadataAddressFromDenom(quoteDenom)
+	require.NoError(t, err)
+
+	metadataLP := createBalancerPool(
+		t, ctx, input,
+		sdk.NewCoin(bondDenom, baseAmount), sdk.NewCoin(quoteDenom, quoteAmount),
+		math.LegacyNewDecWithPrec(8, 1), math.LegacyNewDecWithPrec(2, 1),
+	)
+	require.NoError(t, dexKeeper.SetDexPair(ctx, types.DexPair{
+		MetadataQuote: metadataQuote.String(),
+		MetadataLP:    metadataLP.String(),
+	}))
+
+	denomLP, err := types.DenomFromMetadataAddress(ctx, input.MoveKeeper.MoveBankKeeper(), metadataLP)
+	require.NoError(t, err)
+
+	spotPrice, err := dexKeeper.GetBaseSpotPrice(ctx, quoteDenom)
+	require.NoError(t, err)
+
+	votingPowerKeeper := keeper.NewVotingPowerKeeper(&input.MoveKeeper)
+	weights, err := votingPowerKeeper.GetVotingPowerWeights(ctx, []string{denomLP})
+	require.NoError(t, err)
+	lpBaseWeight := weights.AmountOf(denomLP)
+
+	// without observations the spot price is used
+	twapPrice, err := dexKeeper.GetBaseTWAPPrice(ctx, quoteDenom)
+	require.NoError(t, err)
+	require.Equal(t, spotPrice, twapPrice)
+
+	// first observation
+	ctx = ctx.WithBlockTime(time.Unix(1_000, 0))
+	require.NoError(t, dexKeeper.UpdateTWAPObservations(ctx))
+
+	twapPrice, err = dexKeeper.GetBaseTWAPPrice(ctx, quoteDenom)
+	require.NoError(t, err)
+	require.Equal(t, spotPrice, twapPrice)
+
+	// a manipulated pool state observed 600 seconds later
+	require.NoError(t, input.MoveKeeper.TWAPObservations.Set(ctx, collections.Join(metadataLP[:], int64(1_600)), types.TWAPObservation{
+		BaseSpotPrice:           spotPrice.MulInt64(10),
+		LPBaseWeight:            lpBaseWeight.MulInt64(10),
+		CumulativeBaseSpotPrice: spotPrice.MulInt64(600),
+		CumulativeLPBaseWeight:  lpBaseWeight.MulInt64(600),
+	}))
+
+	// the window is not covered yet, so the average starts from the oldest observation
+	ctx = ctx.WithBlockTime(time.Unix(2_200, 0))
+	twapPrice, err = dexKeeper.GetBaseTWAPPrice(ctx, quoteDenom)
+	require.NoError(t, err)
+	require.Equal(t, spotPrice.MulInt64(600+10*600).QuoInt64(1_200), twapPrice)
+
+	// recording at 3_500 prunes the observations before the window start at 1_700,
+	// except the newest of them
+	ctx = ctx.WithBlockTime(time.Unix(3_500, 0))
+	require.NoError(t, dexKeeper.UpdateTWAPObservations(ctx))
+
+	has, err := input.MoveKeeper.TWAPObservations.Has(ctx, collections.Join(metadataLP[:], int64(1_000)))
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = input.MoveKeeper.TWAPObservations.Has(ctx, collections.Join(metadataLP[:], int64(1_600)))
+	require.NoError(t, err)
+	require.True(t, has)
+
+	// the manipulated state was held for the whole window
+	twapPrice, err = dexKeeper.GetBaseTWAPPrice(ctx, quoteDenom)
+	require.NoError(t, err)
+	require.Equal(t, spotPrice.MulInt64(10), twapPrice)
+
+	weights, err = votingPowerKeeper.GetVotingPowerWeights(ctx, []string{denomLP})
+	require.NoError(t, err)
+	require.Equal(t, lpBaseWeight.MulInt64(10), weights.AmountOf(denomLP))
+
+	// half of the window later, the pool is back to the spot price
+	ctx = ctx.WithBlockTime(time.Unix(4_400, 0))
+	twapPrice, err = dexKeeper.GetBaseTWAPPrice(ctx, quoteDenom)
+	require.NoError(t, err)
+	require.Equal(t, spotPrice.MulInt64(10*900+900).QuoInt64(1_800), twapPrice)
+
+	// voting power ignores the twap unless opted in
+	params.VotingPowerUseTwap = false
+	require.NoError(t, input.MoveKeeper.SetParams(ctx, params))
+	weights, err = votingPowerKeeper.GetVotingPowerWeights(ctx, []string{denomLP})
+	require.NoError(t, err)
+	require.Equal(t, lpBaseWeight, weights.AmountOf(denomLP))
+
+	// deleting the dex pair removes its observations
+	require.NoError(t, dexKeeper.DeleteDexPair(ctx, quoteDenom))
+	has, err = input.MoveKeeper.TWAPObservations.Has(ctx, collections.Join(metadataLP[:], int64(1_600)))
+	require.NoError(t, err)
+	require.False(t, has)
+}
@@ -150,16 +150,58 @@ func (vk VestingKeeper) GetUnclaimedVestedAmount(ctx context.Context, tableHandl
 		return math.ZeroInt(), err
 	}
 
+	vestedAmount := getVestedAmount(ctx, allocation, startTime, vestingPeriod)
+	return vestedAmount.Sub(math.NewIntFromUint64(claimedAmount)), nil
+}
+
+// GetVestingBalances returns the locked amount, which is not vested yet, and the
+// claimable amount, which is vested but not claimed yet, of the recipient.
+func (vk VestingKeeper) GetVestingBalances(ctx context.Context, moduleAccAddr sdk.AccAddress, moduleName string, creatorAccAddr, recipientAccAddr sdk.AccAddress) (locked sdk.Coin, claimable sdk.Coin, err error) {
+	denom, err := vk.getVestingTokenDenom(ctx, moduleAccAddr, moduleName, creatorAccAddr)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	tableHandle, err := vk.getVestingTableHandler(ctx, moduleAccAddr, moduleName, creatorAccAddr)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	recipientAddr, err := vmtypes.NewAccountAddressFromBytes(recipientAccAddr.Bytes())
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	entry, err := vk.GetTableEntryBytes(ctx, vmtypes.AccountAddress(tableHandle), recipientAddr.Bytes())
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return sdk.NewCoin(denom, math.ZeroInt()), sdk.NewCoin(denom, math.ZeroInt()), nil
+	} else if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	allocation, claimedAmount, startTime, vestingPeriod, err := types.ReadVesting(entry.ValueBytes)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	vestedAmount := getVestedAmount(ctx, allocation, startTime, vestingPeriod)
+	lockedAmount := math.NewIntFromUint64(allocation).Sub(vestedAmount)
+	claimableAmount := vestedAmount.Sub(math.NewIntFromUint64(claimedAmount))
+	return sdk.NewCoin(denom, lockedAmount), sdk.NewCoin(denom, claimableAmount), nil
+}
+
+// getVestedAmount returns the vested amount of the allocation at the current block
+// time, assuming the vesting is linear.
+func getVestedAmount(ctx context.Context, allocation, startTime, vestingPeriod uint64) math.Int {
 	curTime := uint64(sdk.UnwrapSDKContext(ctx).BlockTime().Unix()) //nolint: gosec
 	if curTime < startTime {
-		return math.ZeroInt(), nil
+		return math.ZeroInt()
 	}
 	if curTime >= startTime+vestingPeriod {
-		return math.NewIntFromUint64(allocation).Sub(math.NewIntFromUint64(claimedAmount)), nil
+		return math.NewIntFromUint64(allocation)
 	}
 
-	vestedAmountInLinearVesting := math.NewIntFromUint64(allocation).
+	return math.NewIntFromUint64(allocation).
 		Mul(math.NewIntFromUint64(curTime - startTime)).
 		Quo(math.NewIntFromUint64(vestingPeriod))
-	return vestedAmountInLinearVesting.Sub(math.NewIntFromUint64(claimedAmount)), nil
 }
@@ -27,12 +27,17 @@ func NewVotingPowerKeeper(k *Keeper) VotingPowerKeeper {
 // if denom is base denom, weight is 1.
 // if denom is not base denom, weight = locked base balance / total share,
 // which means we only consider locked base balance for voting power.
+// when VotingPowerUseTwap is set, the weight of an LP with twap observations
+// is averaged over the twap window.
 func (k VotingPowerKeeper) GetVotingPowerWeights(ctx context.Context, bondDenoms []string) (sdk.DecCoins, error) {
-	baseDenom, err := k.BaseDenom(ctx)
+	params, err := k.GetParams(ctx)
 	if err != nil {
 		return nil, err
 	}
+	baseDenom := params.BaseDenom
+	useTWAP := params.VotingPowerUseTwap && params.TwapWindow > 0
 
+	dexKeeper := NewDexKeeper(k.Keeper)
 	powerWeights := sdk.NewDecCoins()
 	for _, denom := range bondDenoms {
 		var powerWeight math.LegacyDec
@@ -45,26 +50,27 @@ func (k VotingPowerKeeper) GetVotingPowerWeights(ctx context.Context, bondDenoms
 				continue
 			}
 
-			bk := k.MoveBankKeeper()
-			balanceBase, err := bk.GetBalance(ctx, metadataLP[:], baseDenom)
-			if err != nil {
-				// ignore error to avoid chain halt due to wrong denom
-				continue
+			found := false
+			if useTWAP {
+				_, powerWeight, found, err = dexKeeper.getTWAP(ctx, metadataLP, params.TwapWindow)
+				if err != nil {
+					// ignore error to avoid chain halt due to wrong denom
+					continue
+				}
 			}
 
-			totalShare, err := bk.GetSupply(ctx, denom)
-			if err != nil {
-				// ignore error to avoid chain halt due to wrong denom
-				continue
+			if !found {
+				powerWeight, err = dexKeeper.lpBaseWeight(ctx, baseDenom, denom, metadataLP)
+				if err != nil {
+					// ignore error to avoid chain halt due to wrong denom
+					continue
+				}
 			}
 
 			// if balance is zero, use zero power
-			if balanceBase.IsZero() || totalShare.IsZero() {
+			if powerWeight.IsZero() {
 				continue
 			}
-
-			// weight = balanceBase / totalShare => compute locked base balance
-			powerWeight = math.LegacyNewDecFromInt(balanceBase).QuoInt(totalShare)
 		}
 
 		powerWeights = powerWeights.Add(sdk.NewDecCoinFromDec(denom, powerWeight))
@@ -108,10 +108,15 @@ const (
 	ResourceNameCollection    = "Collection"
 	ResourceNameInitiaNft     = "InitiaNft"
 	ResourceNameNft           = "Nft"
+	ResourceNameObjectCore    = "ObjectCore"
 
 	// resource names for dispatchable fungible assets
 	ResourceNameDispatchFunctionStore = "DispatchFunctionStore"
 	ResourceNameDispatchSupply        = "DeriveSupply"
+
+	// event type tags for fungible assets
+	EventTypeTagFungibleAssetDeposit  = "0x1::fungible_asset::DepositEvent"
+	EventTypeTagFungibleAssetWithdraw = "0x1::fungible_asset::WithdrawEvent"
 )
 
 // TypeTagFromStructTag return type tag with struct tag
@@ -344,6 +349,11 @@ func ReadBalanceFromFungibleStore(bz []byte) (vmtypes.AccountAddress, math.Int,
 	return metadata, math.NewIntFromUint64(amount), nil
 }
 
+// ReadOwnerFromObjectCore util function to read owner from object::ObjectCore
+func ReadOwnerFromObjectCore(bz []byte) (vmtypes.AccountAddress, error) {
+	return vmtypes.NewAccountAddressFromBytes(bz[:AddressBytesLength])
+}
+
 // ReadIssuersTableHandleFromModuleStore util function to read issuers table handle from primary_fungible_store::ModuleStore
 func ReadIssuersTableHandleFromModuleStore(bz []byte) (vmtypes.AccountAddress, error) {
 	cursor := int(0)
@@ -65,6 +65,8 @@ type StakingKeeper interface {
 	Delegation(context.Context, sdk.AccAddress, sdk.ValAddress) (stakingtypes.DelegationI, error)
 	BondDenoms(ctx context.Context) (res []string, err error)
 	SetBondDenoms(ctx context.Context, bondDenoms []string) error
+	GetAllDelegatorDelegations(ctx context.Context, delegator sdk.AccAddress) ([]stakingtypes.Delegation, error)
+	GetAllUnbondingDelegations(ctx context.Context, delegator sdk.AccAddress) ([]stakingtypes.UnbondingDelegation, error)
 }
 
 // DistributionKeeper is expected keeper for distribution module
@@ -58,9 +58,11 @@ var TestAddr = sdk.AccAddress(vmtypes.TestAddress[:])
 // Keys for move store
 // Items are stored with the following key: values
 var (
-	ExecutionCounterKey = []byte{0x11}
-	DexPairPrefix       = []byte{0x12} // prefix for dex pairs
-	VMStorePrefix       = []byte{0x21} // prefix for vm
+	ExecutionCounterKey   = []byte{0x11}
+	DexPairPrefix         = []byte{0x12} // prefix for dex pairs
+	TWAPObservationPrefix = []byte{0x13} // prefix for dex pair twap observations
+	DenomOwnersPrefix     = []byte{0x14} // prefix for primary fungible store balances by metadata and owner
+	VMStorePrefix         = []byte{0x21} // prefix for vm
 
 	ParamsKey = []byte{0x31} // prefix for parameters for module x/move
 
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/math"
@@ -23,6 +24,13 @@ var (
 	DefaultContractSharedRevenueRatio = math.LegacyZeroDec()
 )
 
+const (
+	DefaultTWAPWindow = 30 * time.Minute
+
+	// MaxTWAPWindow bounds the number of observations kept per dex pair.
+	MaxTWAPWindow = 24 * time.Hour
+)
+
 const (
 	ModuleSizeHardLimit         = int(1024 * 1024) // 1MB
 	ModuleNameLengthHardLimit   = int(128)
@@ -38,6 +46,7 @@ func DefaultParams() Params {
 		ContractSharedRevenueRatio: DefaultContractSharedRevenueRatio,
 		ScriptEnabled:              DefaultScriptEnabled,
 		AllowedPublishers:          nil,
+		TwapWindow:                 DefaultTWAPWindow,
 	}
 }
 
@@ -73,6 +82,10 @@ func (p Params) Validate(ac address.Codec) error {
 		}
 	}
 
+	if err := validateTWAPWindow(p.TwapWindow); err != nil {
+		return errors.Wrap(err, "invalid twap_window")
+	}
+
 	return nil
 }
 
@@ -84,6 +97,8 @@ func (p Params) ToRaw() RawParams {
 		ContractSharedRevenueRatio: p.ContractSharedRevenueRatio,
 		ScriptEnabled:              p.ScriptEnabled,
 		ClammModuleAddress:         p.ClammModuleAddress,
+		TwapWindow:                 p.TwapWindow,
+		VotingPowerUseTwap:         p.VotingPowerUseTwap,
 	}
 }
 
@@ -96,6 +111,8 @@ func (p RawParams) ToParams(allowedPublishers []string) Params {
 		AllowedPublishers:          allowedPublishers,
 		ScriptEnabled:              p.ScriptEnabled,
 		ClammModuleAddress:         p.ClammModuleAddress,
+		TwapWindow:                 p.TwapWindow,
+		VotingPowerUseTwap:         p.VotingPowerUseTwap,
 	}
 }
 
@@ -156,3 +173,20 @@ func validateAllowedPublishers(ac address.Codec, i any) error {
 
 	return nil
 }
+
+func validateTWAPWindow(i any) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("twap_window must be non-negative value: %v", v)
+	}
+
+	if v > MaxTWAPWindow {
+		return fmt.Errorf("twap_window must be smaller than or equal to %v: %v", MaxTWAPWindow, v)
+	}
+
+	return nil
+}
@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -1533,6 +1535,101 @@ func (m *QueryDexPairsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryDexPairsResponse proto.InternalMessageInfo
 
+// QueryBalanceBreakdownRequest is the request type for the Query/BalanceBreakdown
+// RPC method.
+type QueryBalanceBreakdownRequest struct {
+	// address is the account address to query
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// vesting_module_address is the address of the vesting module. The vesting
+	// amounts are not queried if the vesting module is not given.
+	VestingModuleAddress string `protobuf:"bytes,2,opt,name=vesting_module_address,json=vestingModuleAddress,proto3" json:"vesting_module_address,omitempty"`
+	// vesting_module_name is the name of the vesting module
+	VestingModuleName string `protobuf:"bytes,3,opt,name=vesting_module_name,json=vestingModuleName,proto3" json:"vesting_module_name,omitempty"`
+	// vesting_creator_address is the creator address of the vesting
+	VestingCreatorAddress string `protobuf:"bytes,4,opt,name=vesting_creator_address,json=vestingCreatorAddress,proto3" json:"vesting_creator_address,omitempty"`
+}
+
+func (m *QueryBalanceBreakdownRequest) Reset()         { *m = QueryBalanceBreakdownRequest{} }
+func (m *QueryBalanceBreakdownRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBalanceBreakdownRequest) ProtoMessage()    {}
+func (*QueryBalanceBreakdownRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9396b98b4ea22694, []int{37}
+}
+func (m *QueryBalanceBreakdownRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBalanceBreakdownRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBalanceBreakdownRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBalanceBreakdownRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBalanceBreakdownRequest.Merge(m, src)
+}
+func (m *QueryBalanceBreakdownRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBalanceBreakdownRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBalanceBreakdownRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBalanceBreakdownRequest proto.InternalMessageInfo
+
+// QueryBalanceBreakdownResponse is the response type for the Query/BalanceBreakdown
+// RPC method.
+type QueryBalanceBreakdownResponse struct {
+	// liquid is the balances of the primary fungible stores
+	Liquid github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=liquid,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"liquid"`
+	// vesting_locked is the amounts not vested yet
+	VestingLocked github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=vesting_locked,json=vestingLocked,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"vesting_locked"`
+	// vesting_claimable is the amounts vested but not claimed yet
+	VestingClaimable github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=vesting_claimable,json=vestingClaimable,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"vesting_claimable"`
+	// delegated is the token amounts of the delegations
+	Delegated github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=delegated,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"delegated"`
+	// unbonding is the amounts of the unbonding delegations
+	Unbonding github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=unbonding,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"unbonding"`
+}
+
+func (m *QueryBalanceBreakdownResponse) Reset()         { *m = QueryBalanceBreakdownResponse{} }
+func (m *QueryBalanceBreakdownResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBalanceBreakdownResponse) ProtoMessage()    {}
+func (*QueryBalanceBreakdownResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9396b98b4ea22694, []int{38}
+}
+func (m *QueryBalanceBreakdownResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBalanceBreakdownResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBalanceBreakdownResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBalanceBreakdownResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBalanceBreakdownResponse.Merge(m, src)
+}
+func (m *QueryBalanceBreakdownResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBalanceBreakdownResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBalanceBreakdownResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBalanceBreakdownResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*QueryModuleRequest)(nil), "initia.move.v1.QueryModuleRequest")
 	proto.RegisterType((*QueryModuleResponse)(nil), "initia.move.v1.QueryModuleResponse")
@@ -1571,119 +1668,137 @@ func init() {
 	proto.RegisterType((*QueryDexPairResponse)(nil), "initia.move.v1.QueryDexPairResponse")
 	proto.RegisterType((*QueryDexPairsRequest)(nil), "initia.move.v1.QueryDexPairsRequest")
 	proto.RegisterType((*QueryDexPairsResponse)(nil), "initia.move.v1.QueryDexPairsResponse")
+	proto.RegisterType((*QueryBalanceBreakdownRequest)(nil), "initia.move.v1.QueryBalanceBreakdownRequest")
+	proto.RegisterType((*QueryBalanceBreakdownResponse)(nil), "initia.move.v1.QueryBalanceBreakdownResponse")
 }
 
 func init() { proto.RegisterFile("initia/move/v1/query.proto", fileDescriptor_9396b98b4ea22694) }
 
 var fileDescriptor_9396b98b4ea22694 = []byte{
-	// 1704 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcd, 0x6f, 0x1b, 0x41,
-	0x15, 0xcf, 0xe6, 0xd3, 0x7e, 0x49, 0xaa, 0x76, 0x9a, 0xb6, 0xce, 0x96, 0xda, 0xe9, 0x34, 0x69,
-	0x53, 0xab, 0x78, 0x49, 0x5a, 0xa0, 0x0a, 0xad, 0xa0, 0xa6, 0x1f, 0xea, 0x67, 0x5a, 0xf7, 0x03,
-	0x81, 0x00, 0x33, 0xb6, 0xa7, 0xee, 0xd2, 0x78, 0xd7, 0xf1, 0xae, 0xd3, 0x98, 0x10, 0x24, 0xaa,
-	0x1e, 0x7a, 0xac, 0xa8, 0x38, 0xd1, 0x03, 0x08, 0x24, 0xca, 0xad, 0x7f, 0x04, 0x87, 0x1c, 0x38,
-	0x54, 0xe2, 0xc2, 0xa9, 0x82, 0x14, 0x09, 0xc4, 0x5f, 0x81, 0x66, 0xf6, 0xcd, 0x7a, 0x77, 0xed,
-	0xb5, 0x4d, 0xa9, 0xa2, 0x5e, 0xa2, 0xf5, 0xdb, 0xf7, 0xe6, 0xf7, 0x7b, 0xef, 0xcd, 0xce, 0xfc,
-	0x9e, 0x02, 0xba, 0x69, 0x99, 0xae, 0xc9, 0x8c, 0x9a, 0xbd, 0xc1, 0x8d, 0x8d, 0x25, 0x63, 0xbd,
-	0xc9, 0x1b, 0xad, 0x5c, 0xbd, 0x61, 0xbb, 0x36, 0xd9, 0xe7, 0xbd, 0xcb, 0x89, 0x77, 0xb9, 0x8d,
-	0x25, 0xfd, 0x00, 0xab, 0x99, 0x96, 0x6d, 0xc8, 0xbf, 0x9e, 0x8b, 0x9e, 0x2d, 0xdb, 0x4e, 0xcd,
-	0x76, 0x8c, 0x12, 0x73, 0xb8, 0x17, 0x6b, 0x6c, 0x2c, 0x95, 0xb8, 0xcb, 0x96, 0x8c, 0x3a, 0xab,
-	0x9a, 0x16, 0x73, 0x4d, 0xdb, 0x42, 0xdf, 0x99, 0xaa, 0x5d, 0xb5, 0xe5, 0xa3, 0x21, 0x9e, 0xd0,
-	0xfa, 0x95, 0xaa, 0x6d, 0x57, 0xd7, 0xb8, 0xc1, 0xea, 0xa6, 0xc1, 0x2c, 0xcb, 0x76, 0x65, 0x88,
-	0x83, 0x6f, 0xa3, 0xf4, 0xdc, 0x56, 0x9d, 0xe3, 0x3b, 0xba, 0x0a, 0xe4, 0x9e, 0x40, 0xbc, 0x6d,
-	0x57, 0x9a, 0x6b, 0xbc, 0xc0, 0xd7, 0x9b, 0xdc, 0x71, 0x49, 0x0a, 0x26, 0x58, 0xa5, 0xd2, 0xe0,
-	0x8e, 0x93, 0xd2, 0xe6, 0xb4, 0xc5, 0x64, 0x41, 0xfd, 0x24, 0x19, 0x98, 0xac, 0x49, 0xd7, 0xa2,
-	0xc5, 0x6a, 0x3c, 0x35, 0x2c, 0xdf, 0x82, 0x67, 0xba, 0xc3, 0x6a, 0x9c, 0x7e, 0x1f, 0x0e, 0x86,
-	0x16, 0x74, 0xea, 0xb6, 0xe5, 0x70, 0x72, 0x01, 0xc6, 0x3d, 0x27, 0xb9, 0xe0, 0xe4, 0xf2, 0xe1,
-	0x5c, 0xb8, 0x2e, 0x39, 0xcf, 0x3f, 0x3f, 0xb5, 0xf3, 0x21, 0x33, 0xf4, 0xfe, 0x43, 0x46, 0xfb,
-	0xcf, 0x87, 0xcc, 0x50, 0x01, 0x63, 0x56, 0x46, 0xff, 0xfd, 0xdb, 0x8c, 0x46, 0x9f, 0x85, 0x96,
-	0x76, 0xfa, 0x93, 0xbd, 0x0a, 0xd0, 0x2e, 0xa0, 0xe4, 0x3a, 0xb9, 0x7c, 0x32, 0xe7, 0x55, 0x3b,
-	0x27, 0xaa, 0x9d, 0xf3, 0x3a, 0x85, 0xd5, 0xce, 0xdd, 0x65, 0x55, 0x55, 0x82, 0x42, 0x20, 0x92,
-	0xbe, 0xd1, 0x60, 0x26, 0x8c, 0x8c, 0x59, 0x7d, 0x0b, 0x26, 0x3c, 0x86, 0x02, 0x7a, 0xa4, 0x47,
-	0x5a, 0x49, 0x91, 0xd6, 0xdb, 0x7f, 0xbd, 0xcb, 0x6a, 0x05, 0x15, 0x41, 0xae, 0x75, 0x61, 0x77,
-	0xaa, 0x2f, 0x3b, 0x0f, 0x39, 0x44, 0x6f, 0x15, 0xd9, 0x15, 0xb8, 0x63, 0x37, 0x1b, 0xe5, 0x01,
-	0xba, 0x78, 0x0c, 0xc0, 0x71, 0x1b, 0xcd, 0xb2, 0x5b, 0x74, 0x59, 0x15, 0x9b, 0x98, 0xf4, 0x2c,
-	0x0f, 0x58, 0x95, 0x32, 0x38, 0x14, 0x59, 0x10, 0xf3, 0xcd, 0x43, 0xa2, 0x81, 0x36, 0xec, 0x63,
-	0x2a, 0x9a, 0xb0, 0x8a, 0x89, 0x74, 0xd2, 0x8f, 0xc3, 0x5e, 0xb6, 0x22, 0x10, 0x7b, 0xd8, 0xcd,
-	0x3f, 0x68, 0x70, 0x38, 0x8a, 0x8d, 0xf9, 0x5d, 0x82, 0xa4, 0xe2, 0xa9, 0x3a, 0x1a, 0x9f, 0x60,
-	0xa0, 0xa7, 0xed, 0xa8, 0xcf, 0xd7, 0xd5, 0x25, 0xac, 0xd0, 0x03, 0x56, 0x5a, 0xe3, 0xd7, 0xad,
-	0xc7, 0x76, 0xdf, 0x0a, 0xd1, 0x1f, 0x61, 0x62, 0x81, 0x10, 0x4c, 0xec, 0xbb, 0x00, 0xae, 0x30,
-	0x16, 0x4d, 0xeb, 0xb1, 0x8d, 0xad, 0x9b, 0x8d, 0x66, 0xe6, 0x87, 0x85, 0x52, 0x73, 0x95, 0x95,
-	0xae, 0x06, 0x97, 0xbf, 0x62, 0xb9, 0xa2, 0x82, 0xfd, 0x9a, 0x76, 0x14, 0x92, 0x4f, 0x79, 0xab,
-	0x58, 0x6a, 0xb9, 0xdc, 0x91, 0xd5, 0x98, 0x2a, 0x24, 0x9e, 0xf2, 0x56, 0x5e, 0xfc, 0xa6, 0x0c,
-	0x8e, 0x74, 0x2c, 0x88, 0x84, 0xaf, 0xc2, 0xa4, 0x47, 0x98, 0x0b, 0x33, 0x32, 0xd6, 0xbb, 0x32,
-	0x96, 0x81, 0x41, 0xca, 0x5e, 0xaa, 0xd2, 0x4c, 0x7f, 0x0e, 0xa9, 0x30, 0x84, 0xb9, 0x97, 0x5b,
-	0xed, 0x9d, 0x06, 0xb3, 0x5d, 0xe0, 0x31, 0xc7, 0x1b, 0x30, 0xdd, 0xce, 0xd1, 0xf4, 0x77, 0xdc,
-	0x80, 0x59, 0x4e, 0xb9, 0x81, 0x35, 0x3f, 0xdf, 0xb6, 0xfb, 0x93, 0xfa, 0x3a, 0x6e, 0xf1, 0x2a,
-	0x2b, 0xb7, 0x1e, 0x99, 0xfc, 0xd9, 0xff, 0x7f, 0x2b, 0x90, 0x13, 0x30, 0xfd, 0xb8, 0x69, 0x95,
-	0x05, 0x82, 0xe7, 0x32, 0x22, 0x5d, 0xa6, 0x94, 0x51, 0x3a, 0x1d, 0x85, 0xa4, 0xb8, 0x9a, 0x8a,
-	0xac, 0x51, 0x75, 0x52, 0xa3, 0x73, 0x23, 0x8b, 0xc9, 0x42, 0x42, 0x18, 0x2e, 0x35, 0xaa, 0x0e,
-	0x21, 0x30, 0x2a, 0xed, 0x63, 0x73, 0x23, 0x8b, 0x53, 0x05, 0xf9, 0x4c, 0x5f, 0x68, 0xb8, 0x81,
-	0x82, 0x5c, 0xb1, 0xb8, 0x04, 0x46, 0x2b, 0xcc, 0x65, 0xc8, 0x54, 0x3e, 0x93, 0x15, 0x18, 0xe7,
-	0x1b, 0xdc, 0x72, 0xc5, 0x4e, 0x14, 0x95, 0x3e, 0x12, 0xad, 0xf4, 0xa3, 0xdb, 0x57, 0xc4, 0xfb,
-	0x60, 0x99, 0x31, 0x82, 0xcc, 0x42, 0xa2, 0xca, 0x9c, 0x62, 0xd3, 0xe1, 0x15, 0x49, 0x7e, 0xb4,
-	0x30, 0x51, 0x65, 0xce, 0x43, 0x87, 0x57, 0xe8, 0xef, 0x35, 0xd8, 0x2f, 0x69, 0x7c, 0xd1, 0xc5,
-	0xfa, 0x05, 0x1c, 0x08, 0x90, 0xdc, 0xfb, 0x2a, 0xfd, 0x04, 0xcf, 0x33, 0x81, 0x9f, 0x67, 0x6e,
-	0xf9, 0x89, 0xaa, 0xd4, 0x35, 0x71, 0xa9, 0xc8, 0x47, 0xf5, 0x05, 0xcc, 0x45, 0x11, 0xa3, 0xd5,
-	0x0d, 0x42, 0xfb, 0xc1, 0xb4, 0x82, 0x3b, 0x37, 0x80, 0xe0, 0x7f, 0x69, 0xe2, 0x84, 0x96, 0xcf,
-	0x0a, 0xe3, 0x78, 0x0f, 0x0c, 0xcf, 0x33, 0x7a, 0xc0, 0x7b, 0xe1, 0xf4, 0x8f, 0x4a, 0x0c, 0x08,
-	0xdf, 0x1b, 0xf7, 0x57, 0xef, 0x7c, 0x71, 0x1d, 0x4f, 0x62, 0xc7, 0x9f, 0x6b, 0x81, 0x92, 0x7b,
-	0x4c, 0xf7, 0xbe, 0xed, 0x4f, 0xf0, 0x04, 0x54, 0x1c, 0x42, 0xad, 0xbf, 0xd9, 0xd1, 0xfa, 0xf9,
-	0xd8, 0xb6, 0x04, 0x4a, 0xdd, 0xbd, 0xfd, 0x6b, 0xa0, 0x77, 0x43, 0xc2, 0x94, 0xef, 0x74, 0x6e,
-	0x81, 0x85, 0x3e, 0x58, 0x3d, 0xb7, 0xc1, 0x79, 0x98, 0xc0, 0x82, 0x88, 0xec, 0x65, 0x63, 0x84,
-	0x96, 0xc2, 0xce, 0x8b, 0xdf, 0x0f, 0x58, 0xd5, 0x2f, 0xf4, 0x70, 0xbb, 0xd0, 0xf4, 0x1b, 0xd8,
-	0x95, 0xfb, 0xe5, 0x86, 0x59, 0x77, 0x2f, 0xe5, 0xaf, 0xab, 0x6a, 0x1c, 0x03, 0x28, 0xdb, 0x15,
-	0x8e, 0x97, 0xa5, 0x26, 0x2f, 0xcb, 0xa4, 0xb0, 0x78, 0xb7, 0x65, 0x16, 0xb7, 0x77, 0x20, 0x0e,
-	0x73, 0xdb, 0x0f, 0x23, 0xac, 0x64, 0x62, 0x84, 0x78, 0xa4, 0x33, 0x28, 0xeb, 0xef, 0xb2, 0x06,
-	0xab, 0xa9, 0x0b, 0x8f, 0xde, 0x44, 0x01, 0xad, 0xac, 0x18, 0x7e, 0x0e, 0xc6, 0xeb, 0xd2, 0x12,
-	0xa7, 0xcd, 0x3d, 0xff, 0xfc, 0xe8, 0x8e, 0xd4, 0xe4, 0x9e, 0x2f, 0x3d, 0xa3, 0x34, 0x31, 0x77,
-	0x99, 0xc8, 0x4b, 0x65, 0x31, 0x03, 0x63, 0x15, 0x6e, 0xd9, 0x35, 0x2c, 0x85, 0xf7, 0x83, 0x9e,
-	0xc5, 0xa4, 0xdb, 0xde, 0x08, 0xae, 0x43, 0xa2, 0x86, 0x36, 0x8c, 0xf0, 0x7f, 0x53, 0x03, 0x8f,
-	0xac, 0xcb, 0x62, 0x09, 0xb5, 0x7e, 0xaf, 0x80, 0x2c, 0xa6, 0x8d, 0x01, 0x08, 0xd1, 0x9d, 0xd1,
-	0x05, 0x2c, 0xc6, 0x65, 0xbe, 0x79, 0x97, 0x99, 0x0d, 0xb5, 0xfc, 0x02, 0xec, 0x53, 0xcb, 0x15,
-	0xd7, 0x9b, 0xb6, 0xcb, 0x31, 0x6a, 0x5a, 0x59, 0xef, 0x09, 0x23, 0x7d, 0x88, 0xd9, 0xfb, 0xd1,
-	0x88, 0x75, 0x11, 0x12, 0x15, 0xbe, 0x59, 0xac, 0x33, 0xb3, 0x81, 0xd5, 0xec, 0xf8, 0x8e, 0x30,
-	0x24, 0x34, 0x13, 0x54, 0x3c, 0x1b, 0xfd, 0x71, 0x78, 0x59, 0x5f, 0xaa, 0x84, 0x05, 0x89, 0xf6,
-	0xc9, 0x82, 0xe4, 0x77, 0xea, 0x48, 0x68, 0x03, 0x20, 0xf1, 0x6f, 0x43, 0x52, 0x11, 0x57, 0xdf,
-	0xc7, 0x20, 0xcc, 0x13, 0xc8, 0xfc, 0xf3, 0x29, 0x90, 0xe5, 0xbf, 0x1c, 0x84, 0x31, 0xc9, 0x91,
-	0xbc, 0xd6, 0x60, 0xdc, 0x1b, 0xa0, 0x08, 0xed, 0xfa, 0xad, 0x86, 0xa6, 0x56, 0xfd, 0x44, 0x4f,
-	0x1f, 0x0f, 0x89, 0x7e, 0xe7, 0xf9, 0x5f, 0xff, 0xf9, 0x7a, 0x78, 0x85, 0x9c, 0x37, 0x22, 0x53,
-	0x31, 0x2b, 0x97, 0xed, 0xa6, 0xe5, 0x3a, 0xc6, 0x16, 0x1e, 0xdb, 0xdb, 0x06, 0x4e, 0x6a, 0xc6,
-	0x56, 0xe0, 0xf4, 0xde, 0x26, 0x2f, 0x35, 0x98, 0xc0, 0x41, 0x90, 0xf4, 0x82, 0x54, 0xcd, 0xd3,
-	0xe7, 0x7b, 0x3b, 0x21, 0xb1, 0x65, 0x49, 0xec, 0x0c, 0xc9, 0x0e, 0x4e, 0x8c, 0xfc, 0x46, 0x83,
-	0x84, 0x9a, 0x47, 0x48, 0x77, 0x98, 0xc8, 0x50, 0xa8, 0x2f, 0xf4, 0xf1, 0x42, 0x36, 0x79, 0xc9,
-	0xe6, 0x02, 0x59, 0x19, 0x80, 0x8d, 0x3f, 0xfc, 0x18, 0xa5, 0x56, 0xb1, 0x3d, 0x57, 0x92, 0x57,
-	0x1a, 0x24, 0xfd, 0x19, 0x8b, 0xf4, 0x06, 0xf6, 0x8b, 0x75, 0xb2, 0x9f, 0x1b, 0x12, 0x3c, 0x27,
-	0x09, 0xe6, 0xc8, 0x99, 0xff, 0x85, 0x20, 0x79, 0xa1, 0x41, 0xd2, 0x1f, 0x73, 0x62, 0x28, 0x45,
-	0x07, 0xae, 0x18, 0x4a, 0x1d, 0x43, 0x16, 0x5d, 0x94, 0x94, 0x28, 0x99, 0x8b, 0x52, 0x92, 0x4a,
-	0x3d, 0x40, 0x88, 0xbc, 0xd1, 0x00, 0xda, 0xaa, 0x9e, 0xf4, 0x00, 0x08, 0x8e, 0x59, 0xfa, 0xa9,
-	0xbe, 0x7e, 0xc8, 0xe4, 0xa2, 0x64, 0xf2, 0x4d, 0xf2, 0xf5, 0x7e, 0x4c, 0x0c, 0x1c, 0x3d, 0x44,
-	0xe7, 0xfc, 0x39, 0x8d, 0xfc, 0x5a, 0x83, 0xa9, 0xe0, 0xc4, 0x42, 0x16, 0x7b, 0x03, 0xb7, 0x67,
-	0x2a, 0xfd, 0xf4, 0x00, 0x9e, 0x48, 0xf2, 0x6b, 0x92, 0x64, 0x96, 0x2c, 0x0e, 0x4a, 0x92, 0xfc,
-	0x59, 0x03, 0x68, 0x4b, 0xfd, 0x98, 0xb2, 0x75, 0xcc, 0x2d, 0x31, 0x65, 0xeb, 0x9c, 0x19, 0x28,
-	0x97, 0x8c, 0x7e, 0xb8, 0xa2, 0x65, 0xe9, 0xf7, 0x3e, 0xf5, 0x78, 0x30, 0x36, 0x4c, 0xfe, 0xac,
-	0xa8, 0x84, 0x9b, 0x63, 0x6c, 0x85, 0x84, 0xdd, 0xf6, 0xcb, 0x61, 0x8d, 0xac, 0xc1, 0xa8, 0xe4,
-	0xdf, 0x57, 0xe6, 0xea, 0xfd, 0x45, 0x2a, 0xcd, 0x48, 0xce, 0xb3, 0x82, 0xf3, 0x4c, 0x94, 0xb3,
-	0xa0, 0x42, 0x7e, 0xa9, 0x41, 0xd2, 0x57, 0xc4, 0x24, 0x5e, 0xf3, 0x04, 0x85, 0x59, 0xcc, 0x96,
-	0xef, 0x10, 0xd6, 0x74, 0x41, 0xa2, 0x67, 0x04, 0xba, 0xde, 0x0d, 0xdd, 0x28, 0x49, 0xd4, 0x6d,
-	0x48, 0x28, 0x59, 0x45, 0x06, 0x52, 0x78, 0xfa, 0x60, 0xda, 0x8c, 0xce, 0x4b, 0xfc, 0xb4, 0xc0,
-	0x9f, 0xed, 0x8a, 0xff, 0x53, 0xc7, 0xb6, 0xc8, 0xaf, 0x34, 0x98, 0x0e, 0xa9, 0x42, 0x72, 0xba,
-	0xe7, 0xf2, 0xa1, 0x52, 0x64, 0x07, 0x71, 0x45, 0x3a, 0x59, 0x49, 0x67, 0x5e, 0xd0, 0xc9, 0xc4,
-	0xd2, 0xc1, 0x9a, 0x88, 0xbe, 0xf8, 0x52, 0x2e, 0xa6, 0x2f, 0x51, 0x89, 0x18, 0xd3, 0x97, 0x0e,
-	0x45, 0xd8, 0xb3, 0x2f, 0x8e, 0xf4, 0x36, 0x58, 0xc9, 0x24, 0xeb, 0x30, 0xee, 0x69, 0xbb, 0x98,
-	0xfb, 0x35, 0x24, 0x1f, 0x63, 0xee, 0xd7, 0xb0, 0x98, 0xa4, 0x69, 0x89, 0x9c, 0x22, 0x87, 0xa3,
-	0xb0, 0x9e, 0x6c, 0x24, 0x2d, 0x48, 0x28, 0x0d, 0x18, 0xb3, 0x15, 0x22, 0x82, 0x32, 0x66, 0x2b,
-	0x44, 0x85, 0x24, 0x9d, 0x93, 0xc0, 0x3a, 0x49, 0x45, 0x81, 0x95, 0x70, 0x23, 0x35, 0x18, 0x93,
-	0xc2, 0x90, 0x74, 0xff, 0xac, 0x82, 0x2a, 0x53, 0xa7, 0xbd, 0x5c, 0x10, 0xf1, 0x98, 0x44, 0x3c,
-	0x42, 0x0e, 0x45, 0x11, 0xa5, 0xc0, 0x94, 0x3a, 0x01, 0x15, 0x53, 0x8c, 0x4e, 0x08, 0x4b, 0xcf,
-	0x18, 0x9d, 0x10, 0x51, 0x98, 0xf1, 0x3a, 0xa1, 0xc2, 0x37, 0x0d, 0x29, 0xdf, 0x8c, 0xad, 0xb0,
-	0x82, 0xdd, 0x26, 0x3f, 0x83, 0x84, 0x12, 0x7c, 0xa4, 0x27, 0x8a, 0xd3, 0xbb, 0xe8, 0x51, 0xd5,
-	0x48, 0x8f, 0x4b, 0x32, 0x47, 0xc9, 0x6c, 0x2c, 0x99, 0xfc, 0xad, 0x9d, 0x7f, 0xa4, 0x87, 0xde,
-	0xee, 0xa6, 0x87, 0x76, 0x76, 0xd3, 0xda, 0xfb, 0xdd, 0xb4, 0xf6, 0xf7, 0xdd, 0xb4, 0xf6, 0xea,
-	0x63, 0x7a, 0xe8, 0xfd, 0xc7, 0xf4, 0xd0, 0xdf, 0x3e, 0xa6, 0x87, 0x7e, 0x90, 0xad, 0x9a, 0xee,
-	0x93, 0x66, 0x29, 0x57, 0xb6, 0x6b, 0xb8, 0xcc, 0x57, 0xd7, 0x58, 0xc9, 0x51, 0x4b, 0x6e, 0x7a,
-	0x8b, 0xca, 0xff, 0x5a, 0x94, 0xc6, 0xe5, 0xbf, 0x2d, 0xce, 0xfe, 0x37, 0x00, 0x00, 0xff, 0xff,
-	0x6d, 0xc3, 0x62, 0xe4, 0x73, 0x19, 0x00, 0x00,
+	// 1957 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcf, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd6, 0x4a, 0x94, 0x44, 0x3e, 0x4b, 0x86, 0x3d, 0xd6, 0x0f, 0x6a, 0x1d, 0x53, 0xca, 0x5a,
+	0x92, 0x15, 0xc2, 0xe6, 0x56, 0x8e, 0xe3, 0x06, 0xaa, 0x83, 0xd6, 0xb4, 0x93, 0x20, 0x89, 0x63,
+	0x3b, 0x8c, 0x93, 0xa2, 0x45, 0x5b, 0x76, 0xc8, 0x1d, 0xaf, 0xb7, 0x22, 0x77, 0xa5, 0xdd, 0xa5,
+	0x64, 0xd6, 0x55, 0x81, 0x06, 0x39, 0xe4, 0x18, 0x34, 0xe8, 0xa9, 0x39, 0xb4, 0x68, 0x81, 0xa6,
+	0x05, 0x0a, 0xe4, 0x8f, 0xe8, 0xc1, 0x47, 0x03, 0x05, 0x8a, 0x9e, 0xdc, 0xc6, 0x2e, 0xd0, 0xa2,
+	0x7f, 0x43, 0x0f, 0xc5, 0xcc, 0xbc, 0x59, 0xee, 0xae, 0x48, 0x2e, 0x9b, 0xda, 0x46, 0x2e, 0xf6,
+	0x72, 0xe6, 0xbd, 0xf7, 0x7d, 0xef, 0xbd, 0xd9, 0xd9, 0xf9, 0x46, 0xa0, 0x3b, 0xae, 0x13, 0x3a,
+	0xd4, 0x6c, 0x7b, 0x7b, 0xcc, 0xdc, 0xdb, 0x34, 0x77, 0x3b, 0xcc, 0xef, 0x56, 0x76, 0x7c, 0x2f,
+	0xf4, 0xc8, 0x51, 0x39, 0x57, 0xe1, 0x73, 0x95, 0xbd, 0x4d, 0xfd, 0x38, 0x6d, 0x3b, 0xae, 0x67,
+	0x8a, 0x7f, 0xa5, 0x89, 0x5e, 0x6e, 0x7a, 0x41, 0xdb, 0x0b, 0xcc, 0x06, 0x0d, 0x98, 0xf4, 0x35,
+	0xf7, 0x36, 0x1b, 0x2c, 0xa4, 0x9b, 0xe6, 0x0e, 0xb5, 0x1d, 0x97, 0x86, 0x8e, 0xe7, 0xa2, 0x6d,
+	0x29, 0x6e, 0xab, 0xac, 0x9a, 0x9e, 0xa3, 0xe6, 0xe7, 0x6c, 0xcf, 0xf6, 0xc4, 0xa3, 0xc9, 0x9f,
+	0x70, 0xf4, 0x39, 0xdb, 0xf3, 0xec, 0x16, 0x33, 0xe9, 0x8e, 0x63, 0x52, 0xd7, 0xf5, 0x42, 0x11,
+	0x32, 0xc0, 0xd9, 0x34, 0xfd, 0xb0, 0xbb, 0xc3, 0x70, 0xce, 0xb8, 0x01, 0xe4, 0x1d, 0xce, 0xe8,
+	0x6d, 0xcf, 0xea, 0xb4, 0x58, 0x8d, 0xed, 0x76, 0x58, 0x10, 0x92, 0x22, 0x4c, 0x53, 0xcb, 0xf2,
+	0x59, 0x10, 0x14, 0xb5, 0x15, 0x6d, 0xa3, 0x50, 0x53, 0x3f, 0xc9, 0x32, 0x1c, 0x69, 0x0b, 0xd3,
+	0xba, 0x4b, 0xdb, 0xac, 0x38, 0x2e, 0x66, 0x41, 0x0e, 0x5d, 0xa7, 0x6d, 0x66, 0x7c, 0x07, 0x4e,
+	0x24, 0x02, 0x06, 0x3b, 0x9e, 0x1b, 0x30, 0x72, 0x09, 0xa6, 0xa4, 0x91, 0x08, 0x78, 0xe4, 0xfc,
+	0x42, 0x25, 0x59, 0xb7, 0x8a, 0xb4, 0xaf, 0xce, 0xdc, 0x7f, 0xb8, 0x3c, 0xf6, 0xe0, 0xe1, 0xb2,
+	0xf6, 0xef, 0x87, 0xcb, 0x63, 0x35, 0xf4, 0xd9, 0xca, 0xfd, 0xeb, 0x57, 0xcb, 0x9a, 0xb1, 0x9f,
+	0x08, 0x1d, 0x64, 0x93, 0x7d, 0x0d, 0xa0, 0x57, 0x60, 0xc1, 0xf5, 0xc8, 0xf9, 0xf5, 0x8a, 0xac,
+	0x70, 0x85, 0x57, 0xb8, 0x22, 0x3b, 0x89, 0x75, 0xae, 0xdc, 0xa4, 0xb6, 0x2a, 0x41, 0x2d, 0xe6,
+	0x69, 0x7c, 0xaa, 0xc1, 0x5c, 0x12, 0x19, 0xb3, 0xfa, 0x06, 0x4c, 0x4b, 0x86, 0x1c, 0x7a, 0x62,
+	0x48, 0x5a, 0x05, 0x9e, 0xd6, 0x67, 0xff, 0xfc, 0xbc, 0xac, 0xd5, 0x94, 0x07, 0x79, 0xbd, 0x0f,
+	0xbb, 0x33, 0x99, 0xec, 0x24, 0x72, 0x82, 0xde, 0x0d, 0x64, 0x57, 0x63, 0x81, 0xd7, 0xf1, 0x9b,
+	0x23, 0x74, 0xf1, 0x14, 0x40, 0x10, 0xfa, 0x9d, 0x66, 0x58, 0x0f, 0xa9, 0x8d, 0x4d, 0x2c, 0xc8,
+	0x91, 0x5b, 0xd4, 0x36, 0x28, 0xcc, 0xa7, 0x02, 0x62, 0xbe, 0x55, 0xc8, 0xfb, 0x38, 0x86, 0x7d,
+	0x2c, 0xa6, 0x13, 0x56, 0x3e, 0xa9, 0x4e, 0x46, 0x7e, 0xd8, 0xcb, 0x6e, 0x0a, 0xe2, 0x19, 0x76,
+	0xf3, 0xb7, 0x1a, 0x2c, 0xa4, 0xb1, 0x31, 0xbf, 0xcb, 0x50, 0x50, 0x3c, 0x55, 0x47, 0x07, 0x27,
+	0x18, 0xeb, 0x69, 0xcf, 0xeb, 0xc9, 0x75, 0x75, 0x13, 0x2b, 0x74, 0x8b, 0x36, 0x5a, 0xec, 0x0d,
+	0xf7, 0xb6, 0x97, 0x59, 0x21, 0xe3, 0xfb, 0xb0, 0x90, 0x76, 0xc1, 0xc4, 0xae, 0x00, 0x84, 0x7c,
+	0xb0, 0xee, 0xb8, 0xb7, 0x3d, 0x6c, 0xdd, 0x52, 0x3a, 0xb3, 0xc8, 0x2d, 0x91, 0x5a, 0xa8, 0x46,
+	0x8d, 0x1b, 0xf1, 0xf0, 0xaf, 0xba, 0xa1, 0xdf, 0xcd, 0xa4, 0x44, 0x4e, 0x42, 0x61, 0x9b, 0x75,
+	0xeb, 0x8d, 0x6e, 0xc8, 0x02, 0x51, 0x8d, 0x99, 0x5a, 0x7e, 0x9b, 0x75, 0xab, 0xfc, 0xb7, 0x41,
+	0x61, 0xf1, 0x50, 0x40, 0x24, 0xfc, 0x1a, 0x1c, 0x91, 0x84, 0x19, 0x1f, 0x46, 0xc6, 0x7a, 0x5f,
+	0xc6, 0xc2, 0x31, 0x4e, 0x19, 0xc2, 0x68, 0xd8, 0xf8, 0x09, 0x14, 0x93, 0x10, 0xce, 0xb3, 0x5c,
+	0x6a, 0x9f, 0x6b, 0xb0, 0xd4, 0x07, 0x1e, 0x73, 0x7c, 0x13, 0x66, 0x7b, 0x39, 0x3a, 0xd1, 0x8a,
+	0x1b, 0x31, 0xcb, 0x99, 0x30, 0x16, 0xf3, 0xc9, 0x2d, 0xbb, 0xdf, 0xab, 0xb7, 0xe3, 0x1a, 0xb3,
+	0x69, 0xb3, 0xfb, 0xbe, 0xc3, 0xf6, 0xff, 0xff, 0xaf, 0x02, 0x39, 0x0d, 0xb3, 0xb7, 0x3b, 0x6e,
+	0x93, 0x23, 0x48, 0x93, 0x09, 0x61, 0x32, 0xa3, 0x06, 0x85, 0xd1, 0x49, 0x28, 0xf0, 0x4f, 0x53,
+	0x9d, 0xfa, 0x76, 0x50, 0xcc, 0xad, 0x4c, 0x6c, 0x14, 0x6a, 0x79, 0x3e, 0x70, 0xd9, 0xb7, 0x03,
+	0x42, 0x20, 0x27, 0xc6, 0x27, 0x57, 0x26, 0x36, 0x66, 0x6a, 0xe2, 0xd9, 0xf8, 0x50, 0x83, 0xc5,
+	0x43, 0x5c, 0xb1, 0xb8, 0x04, 0x72, 0x16, 0x0d, 0x29, 0x32, 0x15, 0xcf, 0x64, 0x0b, 0xa6, 0xd8,
+	0x1e, 0x73, 0x43, 0xbe, 0x12, 0x79, 0xa5, 0x17, 0xd3, 0x95, 0x7e, 0xff, 0xed, 0x57, 0xf9, 0x7c,
+	0xbc, 0xcc, 0xe8, 0x41, 0x96, 0x20, 0x6f, 0xd3, 0xa0, 0xde, 0x09, 0x98, 0x25, 0xc8, 0xe7, 0x6a,
+	0xd3, 0x36, 0x0d, 0xde, 0x0b, 0x98, 0x65, 0xfc, 0x46, 0x83, 0x63, 0x82, 0xc6, 0x57, 0xba, 0x58,
+	0x3f, 0x85, 0xe3, 0x31, 0x92, 0xcf, 0xbe, 0x4a, 0x3f, 0x84, 0xf9, 0x08, 0xbf, 0x4a, 0xc3, 0xe6,
+	0x1d, 0x55, 0xa9, 0xd7, 0xf9, 0x47, 0x45, 0x3c, 0xaa, 0x37, 0x60, 0x25, 0x8d, 0x98, 0xae, 0x6e,
+	0x1c, 0x3a, 0x72, 0x36, 0x2c, 0x58, 0x88, 0x0c, 0x11, 0x21, 0x7a, 0xd3, 0x0a, 0x3e, 0x3e, 0x2b,
+	0x8c, 0xe7, 0x87, 0x60, 0x48, 0xcb, 0xf4, 0x06, 0x2f, 0xdd, 0x8d, 0xdf, 0xa9, 0xc3, 0x00, 0xb7,
+	0x7d, 0xf3, 0xdd, 0x1b, 0xd7, 0xbf, 0x72, 0x1d, 0x2f, 0x60, 0xc7, 0x3f, 0xd0, 0x60, 0x3e, 0xc5,
+	0xf4, 0xd9, 0xb7, 0xfd, 0x0e, 0x2c, 0x25, 0x38, 0x24, 0x5a, 0xff, 0xd6, 0xa1, 0xd6, 0xaf, 0x0e,
+	0x6c, 0x4b, 0xac, 0xd4, 0xfd, 0xdb, 0xdf, 0x02, 0xbd, 0x1f, 0x12, 0xa6, 0x7c, 0xfd, 0xf0, 0x12,
+	0x58, 0xcb, 0xc0, 0x1a, 0xba, 0x0c, 0x5e, 0x86, 0x69, 0x2c, 0x08, 0xcf, 0x5e, 0x34, 0x86, 0x9f,
+	0xa5, 0xb0, 0xf3, 0xfc, 0xf7, 0x2d, 0x6a, 0x47, 0x85, 0x1e, 0xef, 0x15, 0xda, 0xb8, 0x88, 0x5d,
+	0x79, 0xb7, 0xe9, 0x3b, 0x3b, 0xe1, 0xe5, 0xea, 0x1b, 0xaa, 0x1a, 0xa7, 0x00, 0x9a, 0x9e, 0xc5,
+	0xf0, 0x63, 0xa9, 0x89, 0x8f, 0x65, 0x81, 0x8f, 0xc8, 0xaf, 0x65, 0x19, 0x16, 0xd2, 0x7e, 0x98,
+	0xdb, 0x31, 0x98, 0xa0, 0x0d, 0x07, 0x3d, 0xf8, 0xa3, 0x31, 0x87, 0xc7, 0xfa, 0x9b, 0xd4, 0xa7,
+	0x6d, 0xf5, 0xc1, 0x33, 0xde, 0x82, 0x13, 0x89, 0x51, 0x74, 0xbf, 0x00, 0x53, 0x3b, 0x62, 0x64,
+	0xd0, 0xd9, 0x5c, 0xda, 0x57, 0x73, 0xf7, 0xc5, 0x99, 0x5c, 0xda, 0x1a, 0x67, 0xd5, 0x99, 0x98,
+	0x85, 0x94, 0xe7, 0xa5, 0xb2, 0x98, 0x83, 0x49, 0x8b, 0xb9, 0x5e, 0x1b, 0x4b, 0x21, 0x7f, 0x18,
+	0x2f, 0xc2, 0x7c, 0xca, 0x1a, 0xc1, 0x75, 0xc8, 0xb7, 0x71, 0x0c, 0x3d, 0xa2, 0xdf, 0x86, 0x89,
+	0x5b, 0xd6, 0x55, 0x1e, 0x42, 0xc5, 0x1f, 0xe6, 0x50, 0x06, 0x12, 0x77, 0x40, 0x88, 0xfe, 0x8c,
+	0x2e, 0x61, 0x31, 0xae, 0xb2, 0xbb, 0x37, 0xa9, 0xe3, 0xab, 0xf0, 0x6b, 0x70, 0x54, 0x85, 0xab,
+	0xef, 0x76, 0xbc, 0x90, 0xa1, 0xd7, 0xac, 0x1a, 0x7d, 0x87, 0x0f, 0x1a, 0xef, 0xc1, 0x5c, 0xd2,
+	0x1b, 0xb1, 0x5e, 0x81, 0xbc, 0xc5, 0xee, 0xd6, 0x77, 0xa8, 0xe3, 0x63, 0x35, 0x0f, 0xbd, 0x47,
+	0xe8, 0x92, 0xd0, 0x04, 0x96, 0x1c, 0x33, 0x7e, 0x90, 0x0c, 0x1b, 0x1d, 0x55, 0x92, 0x07, 0x12,
+	0xed, 0x4b, 0x1f, 0x48, 0x7e, 0xad, 0xb6, 0x84, 0x1e, 0x00, 0x12, 0xff, 0x26, 0x14, 0x14, 0x71,
+	0xf5, 0x7e, 0x8c, 0xc2, 0x3c, 0x8f, 0xcc, 0x9f, 0xe0, 0x09, 0xe4, 0x2f, 0x1a, 0x3c, 0x27, 0x38,
+	0x56, 0x69, 0x8b, 0xba, 0x4d, 0x56, 0xf5, 0x19, 0xdd, 0xb6, 0xbc, 0x7d, 0x37, 0x7b, 0xa3, 0xbd,
+	0x00, 0x0b, 0x7b, 0x2c, 0x08, 0x1d, 0xd7, 0xae, 0xe3, 0x86, 0xab, 0x0c, 0xe5, 0x0b, 0x38, 0x87,
+	0xb3, 0x52, 0x95, 0x5d, 0x46, 0xaf, 0x0a, 0x9c, 0x48, 0x79, 0xc5, 0xf6, 0xe0, 0xe3, 0x09, 0x17,
+	0xb1, 0x11, 0x5f, 0x84, 0x45, 0x65, 0xdf, 0xf4, 0x19, 0x0d, 0x3d, 0x3f, 0x82, 0xc9, 0x09, 0x9f,
+	0x79, 0x9c, 0xbe, 0x22, 0x67, 0x11, 0xc7, 0xf8, 0x22, 0x07, 0xa7, 0x06, 0x24, 0x86, 0x4d, 0xb8,
+	0x03, 0x53, 0x2d, 0x67, 0xb7, 0xe3, 0x58, 0xd8, 0x81, 0xa5, 0x44, 0xfd, 0x54, 0xe5, 0xae, 0x78,
+	0x8e, 0x5b, 0x7d, 0x89, 0xf7, 0xe0, 0x0f, 0x7f, 0x5b, 0xde, 0xb0, 0x9d, 0xf0, 0x4e, 0xa7, 0x51,
+	0x69, 0x7a, 0x6d, 0x53, 0x1a, 0xe3, 0x7f, 0xe7, 0x02, 0x6b, 0x1b, 0xa5, 0x3e, 0x77, 0x08, 0x70,
+	0xc7, 0x96, 0xf1, 0xc9, 0x3e, 0x1c, 0x55, 0x39, 0xb4, 0xbc, 0xe6, 0x36, 0xb3, 0x8a, 0xe3, 0x4f,
+	0x09, 0x71, 0x16, 0x71, 0xae, 0x09, 0x18, 0x72, 0x00, 0xc7, 0xa3, 0xe2, 0xb5, 0xa8, 0xd3, 0xe6,
+	0x87, 0xd8, 0xe2, 0xc4, 0x53, 0xc2, 0x3e, 0xa6, 0x1a, 0xa1, 0x90, 0x88, 0xcb, 0x97, 0x79, 0x8b,
+	0xd9, 0x34, 0x64, 0x56, 0x31, 0xf7, 0x94, 0x60, 0x7b, 0x10, 0x1c, 0xaf, 0xe3, 0x36, 0x3c, 0xd7,
+	0x72, 0x5c, 0xbb, 0x38, 0xf9, 0xb4, 0xf0, 0x22, 0x88, 0xf3, 0xff, 0x99, 0x83, 0x49, 0xb1, 0xc6,
+	0xc8, 0x27, 0x1a, 0x4c, 0xc9, 0x45, 0x4b, 0x8c, 0xbe, 0x1f, 0xba, 0xc4, 0x95, 0x8f, 0x7e, 0x7a,
+	0xa8, 0x8d, 0x5c, 0x9f, 0xc6, 0xb7, 0x3e, 0xf8, 0xf3, 0x3f, 0x3e, 0x19, 0xdf, 0x22, 0x2f, 0x9b,
+	0xa9, 0x2b, 0x25, 0xda, 0x6c, 0x7a, 0x1d, 0x37, 0x0c, 0xcc, 0x7b, 0xf8, 0x26, 0x1c, 0x98, 0x78,
+	0xcd, 0x61, 0xde, 0x8b, 0xbd, 0x53, 0x07, 0xe4, 0x23, 0x0d, 0xa6, 0x65, 0xd0, 0x80, 0x0c, 0x83,
+	0x54, 0x3b, 0x9f, 0xbe, 0x3a, 0xdc, 0x08, 0x89, 0x9d, 0x17, 0xc4, 0xce, 0x92, 0xf2, 0xe8, 0xc4,
+	0xc8, 0x2f, 0x35, 0xc8, 0x2b, 0x31, 0x4f, 0xfa, 0xc3, 0xa4, 0x6e, 0x54, 0xf4, 0xb5, 0x0c, 0x2b,
+	0x64, 0x53, 0x15, 0x6c, 0x2e, 0x91, 0xad, 0x11, 0xd8, 0x44, 0x37, 0x07, 0x66, 0xa3, 0x5b, 0xef,
+	0x5d, 0xca, 0x90, 0x8f, 0x35, 0x28, 0xa8, 0xc0, 0x01, 0x19, 0x0e, 0x1c, 0x15, 0x6b, 0x3d, 0xcb,
+	0x0c, 0x09, 0x5e, 0x10, 0x04, 0x2b, 0xe4, 0xec, 0xff, 0x42, 0x90, 0x7c, 0xa8, 0x41, 0x21, 0xba,
+	0x23, 0x18, 0x40, 0x29, 0x7d, 0x5b, 0xa1, 0xaf, 0x67, 0x99, 0x21, 0xa5, 0x0d, 0x41, 0xc9, 0x20,
+	0x2b, 0x69, 0x4a, 0x42, 0xe6, 0xc6, 0x08, 0x91, 0x4f, 0x35, 0x80, 0x9e, 0x24, 0x26, 0x43, 0x00,
+	0xe2, 0x77, 0x14, 0xfa, 0x99, 0x4c, 0x3b, 0x64, 0xf2, 0x8a, 0x60, 0xf2, 0x75, 0xf2, 0x52, 0x16,
+	0x13, 0x13, 0x75, 0x3b, 0xef, 0x5c, 0x74, 0xc9, 0x41, 0x7e, 0xa1, 0xc1, 0x4c, 0x5c, 0xee, 0x93,
+	0x8d, 0xe1, 0xc0, 0xbd, 0x0b, 0x09, 0xfd, 0x85, 0x11, 0x2c, 0x91, 0xe4, 0xd7, 0x04, 0xc9, 0x32,
+	0xd9, 0x18, 0x95, 0x24, 0xf9, 0x93, 0x06, 0xd0, 0xd3, 0xc9, 0x03, 0xca, 0x76, 0x48, 0xf4, 0xeb,
+	0x67, 0x32, 0xed, 0x90, 0x11, 0x13, 0x8c, 0xbe, 0xb7, 0xa5, 0x95, 0x8d, 0x6f, 0x7f, 0xd9, 0xed,
+	0xc1, 0xdc, 0x73, 0xd8, 0x7e, 0x5d, 0xa9, 0x9e, 0xc0, 0xbc, 0x97, 0x50, 0x45, 0x07, 0x1f, 0x8d,
+	0x6b, 0xa4, 0x05, 0x39, 0xc1, 0x3f, 0x53, 0x23, 0xea, 0xd9, 0x0a, 0xcf, 0x58, 0x16, 0x9c, 0x97,
+	0x38, 0xe7, 0xb9, 0x34, 0x67, 0x4e, 0x85, 0xfc, 0x4c, 0x83, 0x42, 0x24, 0x27, 0xc9, 0x60, 0xc1,
+	0x10, 0x57, 0x35, 0xfa, 0x7a, 0x96, 0x19, 0xa2, 0xaf, 0x09, 0xf4, 0x65, 0x8e, 0xae, 0xf7, 0x43,
+	0x37, 0x1b, 0x02, 0xf5, 0x00, 0xf2, 0x4a, 0x93, 0x90, 0x91, 0xe4, 0x91, 0x3e, 0x9a, 0xb0, 0x31,
+	0x56, 0x05, 0x7e, 0x89, 0xe3, 0x2f, 0xf5, 0xc5, 0xff, 0x51, 0xe0, 0xb9, 0xe4, 0xe7, 0x1a, 0xcc,
+	0x26, 0x24, 0x15, 0x79, 0x61, 0x68, 0xf8, 0x44, 0x29, 0xca, 0xa3, 0x98, 0x22, 0x9d, 0xb2, 0xa0,
+	0xb3, 0xca, 0xe9, 0x2c, 0x0f, 0xa4, 0x83, 0x35, 0xe1, 0x7d, 0x89, 0x74, 0xd0, 0x80, 0xbe, 0xa4,
+	0xf5, 0x95, 0xbe, 0x9e, 0x65, 0x36, 0x42, 0x5f, 0x02, 0x61, 0x6d, 0xd2, 0x86, 0x43, 0x76, 0x61,
+	0x4a, 0x0a, 0xa3, 0x01, 0xdf, 0xd7, 0x84, 0xf6, 0xd2, 0x4f, 0x0f, 0xb5, 0x41, 0xe4, 0x92, 0x40,
+	0x2e, 0x92, 0x85, 0x34, 0xac, 0xd4, 0x5c, 0xa4, 0x0b, 0x79, 0x25, 0xa0, 0x06, 0x2c, 0x85, 0x94,
+	0x1a, 0xd3, 0xd7, 0x32, 0xac, 0x10, 0x78, 0x45, 0x00, 0xeb, 0xa4, 0x98, 0x06, 0x56, 0xaa, 0x87,
+	0xb4, 0x61, 0x52, 0xa8, 0x2a, 0xd2, 0xff, 0xb5, 0x8a, 0x4b, 0x34, 0xdd, 0x18, 0x66, 0x82, 0x88,
+	0xa7, 0x04, 0xe2, 0x22, 0x99, 0x4f, 0x23, 0x0a, 0x75, 0x26, 0xce, 0x09, 0x28, 0x37, 0x06, 0x9c,
+	0x13, 0x92, 0xba, 0x4d, 0x5f, 0x1d, 0x6e, 0x94, 0x75, 0x4e, 0xb0, 0xd8, 0x5d, 0x53, 0x68, 0x1f,
+	0xf3, 0x5e, 0x52, 0xfe, 0x1d, 0x90, 0x1f, 0x43, 0xfe, 0xaa, 0x12, 0x39, 0x43, 0x51, 0x82, 0xe1,
+	0x45, 0x4f, 0x4b, 0x2e, 0xe3, 0x79, 0x41, 0xe6, 0x24, 0x59, 0x1a, 0x48, 0x86, 0xfc, 0x51, 0x83,
+	0x63, 0x69, 0xb5, 0x40, 0xce, 0xf6, 0x0d, 0x3f, 0x40, 0x2d, 0xe9, 0xe7, 0x46, 0xb4, 0x46, 0x52,
+	0x97, 0x04, 0xa9, 0x8b, 0xe4, 0xc2, 0x08, 0x7b, 0x78, 0x43, 0x06, 0xa9, 0x37, 0x54, 0x94, 0xea,
+	0xb5, 0xfb, 0x5f, 0x94, 0xc6, 0x3e, 0x7b, 0x54, 0x1a, 0xbb, 0xff, 0xa8, 0xa4, 0x3d, 0x78, 0x54,
+	0xd2, 0xfe, 0xfe, 0xa8, 0xa4, 0x7d, 0xfc, 0xb8, 0x34, 0xf6, 0xe0, 0x71, 0x69, 0xec, 0xaf, 0x8f,
+	0x4b, 0x63, 0xdf, 0x2d, 0xc7, 0x8e, 0xb6, 0x12, 0xe1, 0x5c, 0x8b, 0x36, 0x02, 0x85, 0x76, 0x57,
+	0xe2, 0x89, 0x23, 0x6e, 0x63, 0x4a, 0xfc, 0x8d, 0xf2, 0xc5, 0xff, 0x0e, 0x00, 0x79, 0x04, 0x04,
+	0xfe, 0x80, 0x1d, 0x00, 0x00,
 }
 
 func (this *QueryModuleResponse) Equal(that interface{}) bool {
@@ -1786,6 +1901,9 @@ type QueryClient interface {
 	DexPair(ctx context.Context, in *QueryDexPairRequest, opts ...grpc.CallOption) (*QueryDexPairResponse, error)
 	// DexPairs queries all dex pairs.
 	DexPairs(ctx context.Context, in *QueryDexPairsRequest, opts ...grpc.CallOption) (*QueryDexPairsResponse, error)
+	// BalanceBreakdown queries the liquid, vesting, delegated and unbonding
+	// amounts of an account.
+	BalanceBreakdown(ctx context.Context, in *QueryBalanceBreakdownRequest, opts ...grpc.CallOption) (*QueryBalanceBreakdownResponse, error)
 }
 
 type queryClient struct {
@@ -1959,6 +2077,15 @@ func (c *queryClient) DexPairs(ctx context.Context, in *QueryDexPairsRequest, op
 	return out, nil
 }
 
+func (c *queryClient) BalanceBreakdown(ctx context.Context, in *QueryBalanceBreakdownRequest, opts ...grpc.CallOption) (*QueryBalanceBreakdownResponse, error) {
+	out := new(QueryBalanceBreakdownResponse)
+	err := c.cc.Invoke(ctx, "/initia.move.v1.Query/BalanceBreakdown", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Module gets the module info
@@ -2000,6 +2127,9 @@ type QueryServer interface {
 	DexPair(context.Context, *QueryDexPairRequest) (*QueryDexPairResponse, error)
 	// DexPairs queries all dex pairs.
 	DexPairs(context.Context, *QueryDexPairsRequest) (*QueryDexPairsResponse, error)
+	// BalanceBreakdown queries the liquid, vesting, delegated and unbonding
+	// amounts of an account.
+	BalanceBreakdown(context.Context, *QueryBalanceBreakdownRequest) (*QueryBalanceBreakdownResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -2060,6 +2190,9 @@ func (*UnimplementedQueryServer) DexPair(ctx context.Context, req *QueryDexPairR
 func (*UnimplementedQueryServer) DexPairs(ctx context.Context, req *QueryDexPairsRequest) (*QueryDexPairsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DexPairs not implemented")
 }
+func (*UnimplementedQueryServer) BalanceBreakdown(ctx context.Context, req *QueryBalanceBreakdownRequest) (*QueryBalanceBreakdownResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BalanceBreakdown not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2389,6 +2522,24 @@ func _Query_DexPairs_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BalanceBreakdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBalanceBreakdownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BalanceBreakdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.move.v1.Query/BalanceBreakdown",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BalanceBreakdown(ctx, req.(*QueryBalanceBreakdownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "initia.move.v1.Query",
@@ -2466,6 +2617,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DexPairs",
 			Handler:    _Query_DexPairs_Handler,
 		},
+		{
+			MethodName: "BalanceBreakdown",
+			Handler:    _Query_BalanceBreakdown_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "initia/move/v1/query.proto",
@@ -3918,6 +4073,150 @@ func (m *QueryDexPairsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryBalanceBreakdownRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBalanceBreakdownRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBalanceBreakdownRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VestingCreatorAddress) > 0 {
+		i -= len(m.VestingCreatorAddress)
+		copy(dAtA[i:], m.VestingCreatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VestingCreatorAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.VestingModuleName) > 0 {
+		i -= len(m.VestingModuleName)
+		copy(dAtA[i:], m.VestingModuleName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VestingModuleName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.VestingModuleAddress) > 0 {
+		i -= len(m.VestingModuleAddress)
+		copy(dAtA[i:], m.VestingModuleAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VestingModuleAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBalanceBreakdownResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBalanceBreakdownResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBalanceBreakdownResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Unbonding) > 0 {
+		for iNdEx := len(m.Unbonding) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unbonding[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Delegated) > 0 {
+		for iNdEx := len(m.Delegated) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegated[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.VestingClaimable) > 0 {
+		for iNdEx := len(m.VestingClaimable) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingClaimable[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.VestingLocked) > 0 {
+		for iNdEx := len(m.VestingLocked) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingLocked[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Liquid) > 0 {
+		for iNdEx := len(m.Liquid) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Liquid[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -4541,6 +4840,70 @@ func (m *QueryDexPairsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryBalanceBreakdownRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.VestingModuleAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.VestingModuleName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.VestingCreatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBalanceBreakdownResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Liquid) > 0 {
+		for _, e := range m.Liquid {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.VestingLocked) > 0 {
+		for _, e := range m.VestingLocked {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.VestingClaimable) > 0 {
+		for _, e := range m.VestingClaimable {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Delegated) > 0 {
+		for _, e := range m.Delegated {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Unbonding) > 0 {
+		for _, e := range m.Unbonding {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -8504,6 +8867,404 @@ func (m *QueryDexPairsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryBalanceBreakdownRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBalanceBreakdownRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBalanceBreakdownRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingModuleAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingModuleAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingCreatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingCreatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBalanceBreakdownResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBalanceBreakdownResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBalanceBreakdownResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Liquid = append(m.Liquid, types.Coin{})
+			if err := m.Liquid[len(m.Liquid)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingLocked", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingLocked = append(m.VestingLocked, types.Coin{})
+			if err := m.VestingLocked[len(m.VestingLocked)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingClaimable", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingClaimable = append(m.VestingClaimable, types.Coin{})
+			if err := m.VestingClaimable[len(m.VestingClaimable)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegated = append(m.Delegated, types.Coin{})
+			if err := m.Delegated[len(m.Delegated)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unbonding", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unbonding = append(m.Unbonding, types.Coin{})
+			if err := m.Unbonding[len(m.Unbonding)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
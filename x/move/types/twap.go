@@ -0,0 +1,17 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+)
+
+// CumulativeBaseSpotPriceAt returns the cumulative base spot price at time t
+// of the observation recorded at observedAt, in unix seconds.
+func (o TWAPObservation) CumulativeBaseSpotPriceAt(observedAt, t int64) math.LegacyDec {
+	return o.CumulativeBaseSpotPrice.Add(o.BaseSpotPrice.MulInt64(t - observedAt))
+}
+
+// CumulativeLPBaseWeightAt returns the cumulative LP base weight at time t of
+// the observation recorded at observedAt, in unix seconds.
+func (o TWAPObservation) CumulativeLPBaseWeightAt(observedAt, t int64) math.LegacyDec {
+	return o.CumulativeLPBaseWeight.Add(o.LPBaseWeight.MulInt64(t - observedAt))
+}
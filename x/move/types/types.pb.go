@@ -11,15 +11,19 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -73,6 +77,12 @@ type Params struct {
 	AllowedPublishers []string `protobuf:"bytes,5,rep,name=allowed_publishers,json=allowedPublishers,proto3" json:"allowed_publishers,omitempty" yaml:"allowed_publishers"`
 	// CLAMM module address
 	ClammModuleAddress string `protobuf:"bytes,6,opt,name=clamm_module_address,json=clammModuleAddress,proto3" json:"clamm_module_address,omitempty" yaml:"clamm_module_address"`
+	// twap_window is the period the time-weighted average prices of the
+	// whitelisted dex pairs are averaged over. Zero disables price tracking.
+	TwapWindow time.Duration `protobuf:"bytes,7,opt,name=twap_window,json=twapWindow,proto3,stdduration" json:"twap_window" yaml:"twap_window"`
+	// flag whether voting power weights of LP bond denoms use the
+	// time-weighted average LP value instead of the instantaneous one
+	VotingPowerUseTwap bool `protobuf:"varint,8,opt,name=voting_power_use_twap,json=votingPowerUseTwap,proto3" json:"voting_power_use_twap,omitempty" yaml:"voting_power_use_twap"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -116,7 +126,9 @@ type RawParams struct {
 	// flag whether to enable script execution
 	ScriptEnabled bool `protobuf:"varint,4,opt,name=script_enabled,json=scriptEnabled,proto3" json:"script_enabled,omitempty"`
 	// CLAMM module address
-	ClammModuleAddress string `protobuf:"bytes,5,opt,name=clamm_module_address,json=clammModuleAddress,proto3" json:"clamm_module_address,omitempty" yaml:"clamm_module_address"`
+	ClammModuleAddress string        `protobuf:"bytes,5,opt,name=clamm_module_address,json=clammModuleAddress,proto3" json:"clamm_module_address,omitempty" yaml:"clamm_module_address"`
+	TwapWindow         time.Duration `protobuf:"bytes,6,opt,name=twap_window,json=twapWindow,proto3,stdduration" json:"twap_window" yaml:"twap_window"`
+	VotingPowerUseTwap bool          `protobuf:"varint,7,opt,name=voting_power_use_twap,json=votingPowerUseTwap,proto3" json:"voting_power_use_twap,omitempty" yaml:"voting_power_use_twap"`
 }
 
 func (m *RawParams) Reset()         { *m = RawParams{} }
@@ -478,6 +490,52 @@ func (m *ExecuteAuthorizationItem) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ExecuteAuthorizationItem proto.InternalMessageInfo
 
+// TWAPObservation is the price state of a whitelisted dex pair recorded at the
+// end of a block. The cumulative values are the sums of the observed values
+// weighted by the seconds they were held, so the time-weighted average over
+// a period is the difference of two cumulative values divided by its length.
+type TWAPObservation struct {
+	// base_spot_price is the base price of the quote coin of the pair.
+	BaseSpotPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=base_spot_price,json=baseSpotPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_spot_price"`
+	// lp_base_weight is the locked base balance per LP share of the pair.
+	LPBaseWeight            cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=lp_base_weight,json=lpBaseWeight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"lp_base_weight"`
+	CumulativeBaseSpotPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=cumulative_base_spot_price,json=cumulativeBaseSpotPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"cumulative_base_spot_price"`
+	CumulativeLPBaseWeight  cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=cumulative_lp_base_weight,json=cumulativeLpBaseWeight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"cumulative_lp_base_weight"`
+}
+
+func (m *TWAPObservation) Reset()         { *m = TWAPObservation{} }
+func (m *TWAPObservation) String() string { return proto.CompactTextString(m) }
+func (*TWAPObservation) ProtoMessage()    {}
+func (*TWAPObservation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ab4b0783858a3a5, []int{10}
+}
+func (m *TWAPObservation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TWAPObservation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TWAPObservation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TWAPObservation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TWAPObservation.Merge(m, src)
+}
+func (m *TWAPObservation) XXX_Size() int {
+	return m.Size()
+}
+func (m *TWAPObservation) XXX_DiscardUnknown() {
+	xxx_messageInfo_TWAPObservation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TWAPObservation proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("initia.move.v1.UpgradePolicy", UpgradePolicy_name, UpgradePolicy_value)
 	proto.RegisterType((*Params)(nil), "initia.move.v1.Params")
@@ -490,72 +548,89 @@ func init() {
 	proto.RegisterType((*UpgradePolicyProto)(nil), "initia.move.v1.UpgradePolicyProto")
 	proto.RegisterType((*DexPair)(nil), "initia.move.v1.DexPair")
 	proto.RegisterType((*ExecuteAuthorizationItem)(nil), "initia.move.v1.ExecuteAuthorizationItem")
+	proto.RegisterType((*TWAPObservation)(nil), "initia.move.v1.TWAPObservation")
 }
 
 func init() { proto.RegisterFile("initia/move/v1/types.proto", fileDescriptor_5ab4b0783858a3a5) }
 
 var fileDescriptor_5ab4b0783858a3a5 = []byte{
-	// 959 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xbf, 0x6f, 0x23, 0x45,
-	0x14, 0xf6, 0x9e, 0x13, 0xc7, 0x7e, 0x8e, 0x7d, 0xb9, 0x51, 0x8a, 0x8d, 0xa3, 0x78, 0xa3, 0x05,
-	0xa4, 0x28, 0xe8, 0x6c, 0x1d, 0x88, 0xe6, 0x1a, 0x14, 0xc7, 0x06, 0x59, 0xd8, 0x87, 0xb3, 0xe7,
-	0x34, 0x14, 0xac, 0xc6, 0xbb, 0x73, 0xf6, 0xca, 0xbb, 0x3b, 0x66, 0x67, 0xd6, 0xc9, 0x52, 0x22,
-	0xd1, 0x20, 0x24, 0x28, 0x29, 0x8f, 0xee, 0xca, 0x2b, 0x90, 0xe0, 0x4f, 0x48, 0x83, 0x74, 0xa2,
-	0x42, 0x14, 0x16, 0x38, 0xc5, 0x51, 0xdf, 0x5f, 0x80, 0x66, 0x66, 0x1d, 0xce, 0xba, 0xc8, 0x44,
-	0x50, 0xd0, 0x5c, 0x63, 0xcd, 0xfb, 0xde, 0xb7, 0xef, 0x7d, 0xef, 0xc7, 0x78, 0x17, 0x2a, 0x5e,
-	0xe8, 0x71, 0x0f, 0xd7, 0x03, 0x3a, 0x25, 0xf5, 0xe9, 0xbd, 0x3a, 0x4f, 0x26, 0x84, 0xd5, 0x26,
-	0x11, 0xe5, 0x14, 0x95, 0x95, 0xaf, 0x26, 0x7c, 0xb5, 0xe9, 0xbd, 0xca, 0x1d, 0x1c, 0x78, 0x21,
-	0xad, 0xcb, 0x5f, 0x45, 0xa9, 0xec, 0x38, 0x94, 0x05, 0x94, 0xd9, 0xd2, 0xaa, 0x2b, 0x23, 0x75,
-	0x6d, 0x0f, 0xe9, 0x90, 0x2a, 0x5c, 0x9c, 0x14, 0x6a, 0xfe, 0xb8, 0x06, 0xb9, 0x1e, 0x8e, 0x70,
-	0xc0, 0xd0, 0x1e, 0xc0, 0x00, 0x33, 0x62, 0xbb, 0x24, 0xa4, 0x81, 0xae, 0xed, 0x6b, 0x07, 0x05,
-	0xab, 0x20, 0x90, 0xa6, 0x00, 0xd0, 0x17, 0x1a, 0x20, 0xe9, 0x0f, 0xbc, 0xd0, 0x1e, 0x62, 0x91,
-	0xc3, 0x73, 0x88, 0x7e, 0x4b, 0xf0, 0x1a, 0xa7, 0x17, 0x33, 0x23, 0xf3, 0xdb, 0xcc, 0xd8, 0x55,
-	0x29, 0x99, 0x3b, 0xae, 0x79, 0xb4, 0x1e, 0x60, 0x3e, 0xaa, 0x75, 0xc8, 0x10, 0x3b, 0x49, 0x93,
-	0x38, 0x2f, 0x66, 0xc6, 0x4e, 0x82, 0x03, 0xff, 0xbe, 0xf9, 0x6a, 0x18, 0xf3, 0x97, 0x1f, 0xee,
-	0x42, 0x2a, 0xb7, 0x49, 0x9c, 0x27, 0xcf, 0x9f, 0x1e, 0x6a, 0xba, 0x66, 0xdd, 0x16, 0xcc, 0xae,
-	0x17, 0x7e, 0x88, 0x59, 0x4f, 0xd0, 0xd0, 0xf7, 0x1a, 0xec, 0x39, 0x34, 0xe4, 0x11, 0x76, 0xb8,
-	0xcd, 0x46, 0x38, 0x22, 0xae, 0x1d, 0x91, 0x29, 0x09, 0x63, 0x62, 0x47, 0x98, 0x7b, 0x54, 0xcf,
-	0x4a, 0x3d, 0x9f, 0xde, 0x4c, 0xcf, 0x9b, 0x4a, 0xcf, 0xca, 0x88, 0xd7, 0x49, 0xb3, 0x2a, 0x8b,
-	0x47, 0x1e, 0xca, 0x27, 0x2c, 0xf5, 0x80, 0x25, 0xf8, 0xe8, 0x2d, 0x28, 0x33, 0x27, 0xf2, 0x26,
-	0xdc, 0x26, 0x21, 0x1e, 0xf8, 0xc4, 0xd5, 0xd7, 0xf6, 0xb5, 0x83, 0xbc, 0x55, 0x52, 0x68, 0x4b,
-	0x81, 0xe8, 0x04, 0x10, 0xf6, 0x7d, 0x7a, 0x46, 0x5c, 0x7b, 0x12, 0x0f, 0x7c, 0x8f, 0x8d, 0x48,
-	0xc4, 0xf4, 0xf5, 0xfd, 0xec, 0x41, 0xa1, 0x61, 0xfe, 0xdd, 0xab, 0x57, 0x39, 0xa6, 0x92, 0x70,
-	0x27, 0xf5, 0xf4, 0xae, 0x1c, 0xe8, 0x04, 0xb6, 0x1d, 0x1f, 0x07, 0x81, 0x1d, 0x50, 0x37, 0xf6,
-	0x89, 0x8d, 0x5d, 0x37, 0x22, 0x8c, 0xe9, 0x39, 0xd9, 0x13, 0xe3, 0xc5, 0xcc, 0xd8, 0x4d, 0x0b,
-	0xbe, 0x86, 0x65, 0x5a, 0x48, 0xc2, 0x5d, 0x89, 0x1e, 0x29, 0xf0, 0xbe, 0xfe, 0xdd, 0x63, 0x23,
-	0xf3, 0xe7, 0x63, 0x43, 0xfb, 0xea, 0xf9, 0xd3, 0xc3, 0xa2, 0xdc, 0x4a, 0xb5, 0x2e, 0xe6, 0xcf,
-	0x59, 0x28, 0x58, 0xf8, 0xec, 0xf5, 0xf2, 0xfc, 0x3f, 0xcb, 0x73, 0xfd, 0xa4, 0xd7, 0xff, 0xf5,
-	0xa4, 0xcd, 0x9f, 0x34, 0xc8, 0x29, 0x04, 0xe9, 0xb0, 0xb1, 0x08, 0xa8, 0x26, 0xb9, 0x30, 0x91,
-	0x01, 0xc5, 0x34, 0x56, 0x88, 0x83, 0x74, 0x7e, 0x16, 0x28, 0xe8, 0x01, 0x0e, 0x08, 0xda, 0x82,
-	0x2c, 0x1e, 0x78, 0xaa, 0x91, 0x96, 0x38, 0xa2, 0x5d, 0x28, 0x44, 0xf8, 0xcc, 0x1e, 0x24, 0x9c,
-	0x30, 0x59, 0xcc, 0xa6, 0x95, 0x8f, 0xf0, 0x59, 0x43, 0xd8, 0xa8, 0x09, 0xe5, 0x78, 0x32, 0x8c,
-	0xb0, 0x4b, 0xec, 0x09, 0xf5, 0x3d, 0x27, 0x91, 0x15, 0x94, 0xdf, 0xd9, 0xab, 0x2d, 0xff, 0xd7,
-	0xd5, 0x4e, 0x15, 0xab, 0x27, 0x49, 0x56, 0x29, 0x7e, 0xd9, 0x34, 0x31, 0xe4, 0x8f, 0x47, 0xc4,
-	0x19, 0xb3, 0x38, 0xf8, 0x2f, 0xda, 0x2b, 0x90, 0x77, 0xd2, 0x30, 0xb2, 0x80, 0x4d, 0xeb, 0xca,
-	0x36, 0xbf, 0xd4, 0x20, 0x6f, 0x11, 0x46, 0xe3, 0xc8, 0x59, 0xd5, 0x9f, 0x3d, 0x00, 0xc6, 0xa3,
-	0xd8, 0xe1, 0x36, 0xc7, 0xc3, 0x34, 0x45, 0x41, 0x21, 0x7d, 0x3c, 0x44, 0x6f, 0x40, 0x49, 0x14,
-	0x64, 0x47, 0x69, 0xa4, 0xb4, 0x4f, 0x9b, 0x02, 0xbc, 0x8a, 0xbe, 0xaa, 0x61, 0xa6, 0x0d, 0x85,
-	0xbe, 0x58, 0x81, 0x76, 0xf8, 0x88, 0xae, 0xd0, 0xb1, 0x03, 0xf9, 0x31, 0x49, 0x6c, 0xf1, 0xf6,
-	0x48, 0x55, 0x6c, 0x8c, 0x49, 0xd2, 0x4f, 0x26, 0x44, 0x48, 0x9c, 0x62, 0x3f, 0x26, 0xca, 0xa9,
-	0x04, 0x14, 0x24, 0x22, 0xdc, 0xe6, 0xd7, 0x1a, 0x80, 0xcc, 0xd0, 0x0a, 0x79, 0x94, 0xac, 0x48,
-	0xb1, 0x05, 0xd9, 0x31, 0x49, 0xd2, 0xe8, 0xe2, 0x88, 0xb6, 0x61, 0x5d, 0xc6, 0x49, 0x83, 0x2a,
-	0x43, 0x94, 0x23, 0xa4, 0x2c, 0x95, 0x33, 0x26, 0x89, 0x9a, 0xbf, 0x01, 0x45, 0x25, 0x46, 0xb9,
-	0xd7, 0xa5, 0x5b, 0xe9, 0x53, 0xf5, 0x7e, 0x04, 0x68, 0x69, 0xf4, 0x3d, 0xf9, 0x26, 0x7c, 0x0f,
-	0x72, 0xe9, 0xba, 0x68, 0x37, 0x59, 0x97, 0x94, 0x6c, 0x62, 0xd8, 0x68, 0x92, 0xf3, 0x1e, 0xf6,
-	0x22, 0x71, 0xcf, 0x02, 0xc2, 0xb1, 0x8b, 0x39, 0xb6, 0x3f, 0x8b, 0x29, 0x27, 0x69, 0x79, 0xa5,
-	0x05, 0x7a, 0x22, 0x40, 0x54, 0x87, 0xe2, 0x15, 0xcd, 0x9f, 0xa4, 0xff, 0x57, 0xe5, 0xf9, 0xcc,
-	0x80, 0x6e, 0x0a, 0x77, 0x7a, 0x16, 0x2c, 0x28, 0x9d, 0x89, 0xf9, 0x8d, 0x06, 0x7a, 0xeb, 0x9c,
-	0x38, 0x31, 0x27, 0x47, 0x31, 0x1f, 0xd1, 0xc8, 0xfb, 0x5c, 0xdc, 0xeb, 0xb0, 0xcd, 0x49, 0x20,
-	0x93, 0x2e, 0xdf, 0xd7, 0x45, 0xd2, 0x97, 0x6f, 0xe2, 0x3f, 0x2f, 0xea, 0xdb, 0x50, 0x7e, 0x14,
-	0x87, 0x8e, 0x88, 0x2b, 0x29, 0x4c, 0xcf, 0xca, 0xd7, 0xc6, 0xda, 0xc5, 0xcc, 0xd0, 0xac, 0xd2,
-	0xc2, 0x27, 0xb8, 0xec, 0xf0, 0x7d, 0x28, 0x2d, 0x75, 0x03, 0xdd, 0x86, 0xe2, 0xe9, 0x83, 0x87,
-	0xbd, 0xd6, 0x71, 0xfb, 0x83, 0x76, 0xab, 0xb9, 0x95, 0x41, 0x65, 0x80, 0xe3, 0x8f, 0xbb, 0xbd,
-	0xa3, 0x7e, 0xbb, 0xd1, 0x69, 0x6d, 0x69, 0xa8, 0x04, 0x85, 0x76, 0xb7, 0x7b, 0xda, 0x3f, 0x12,
-	0xe6, 0xad, 0x46, 0xe7, 0xe2, 0x8f, 0x6a, 0xe6, 0xc9, 0xbc, 0xaa, 0x5d, 0xcc, 0xab, 0xda, 0xb3,
-	0x79, 0x55, 0xfb, 0x7d, 0x5e, 0xd5, 0xbe, 0xbd, 0xac, 0x66, 0x9e, 0x5d, 0x56, 0x33, 0xbf, 0x5e,
-	0x56, 0x33, 0x9f, 0x1c, 0x0e, 0x3d, 0x3e, 0x8a, 0x07, 0x35, 0x87, 0x06, 0x75, 0x35, 0x88, 0xbb,
-	0x3e, 0x1e, 0xb0, 0xf4, 0x5c, 0x3f, 0x57, 0x5f, 0x33, 0xf2, 0x53, 0x66, 0x90, 0x93, 0xdf, 0x1d,
-	0xef, 0xfe, 0x15, 0x00, 0x00, 0xff, 0xff, 0xdf, 0x24, 0x38, 0xd0, 0xe9, 0x08, 0x00, 0x00,
+	// 1211 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xd6, 0x4d, 0x62, 0x3f, 0xc7, 0x6e, 0x3a, 0x2a, 0x65, 0x93, 0x12, 0x6f, 0xb4, 0x05,
+	0x29, 0x0a, 0xaa, 0xad, 0x16, 0xc1, 0xa1, 0x17, 0x14, 0xc7, 0x29, 0x8a, 0x70, 0xda, 0xed, 0xc6,
+	0x51, 0x25, 0x0e, 0x5d, 0x8d, 0xd7, 0x53, 0x7b, 0x95, 0xdd, 0x9d, 0x65, 0x67, 0xd6, 0xae, 0x39,
+	0x82, 0xb8, 0x00, 0x12, 0x1c, 0x7b, 0x2c, 0xb7, 0x1e, 0x7b, 0xe0, 0xc0, 0x4f, 0xc8, 0xb1, 0xe2,
+	0x84, 0x38, 0x18, 0x70, 0x0e, 0xe5, 0xc2, 0x25, 0xbf, 0x00, 0xcd, 0xcc, 0x3a, 0x89, 0x5b, 0x2b,
+	0x8d, 0x28, 0x82, 0x0b, 0x97, 0x68, 0xde, 0xf7, 0xde, 0xbc, 0xf7, 0xbd, 0x37, 0xdf, 0xce, 0xc4,
+	0xb0, 0xe4, 0x85, 0x1e, 0xf7, 0x70, 0x35, 0xa0, 0x3d, 0x52, 0xed, 0x5d, 0xaf, 0xf2, 0x41, 0x44,
+	0x58, 0x25, 0x8a, 0x29, 0xa7, 0xa8, 0xa4, 0x7c, 0x15, 0xe1, 0xab, 0xf4, 0xae, 0x2f, 0x5d, 0xc4,
+	0x81, 0x17, 0xd2, 0xaa, 0xfc, 0xab, 0x42, 0x96, 0x16, 0x5d, 0xca, 0x02, 0xca, 0x1c, 0x69, 0x55,
+	0x95, 0x91, 0xba, 0x2e, 0x75, 0x68, 0x87, 0x2a, 0x5c, 0xac, 0x52, 0xb4, 0xdc, 0xa1, 0xb4, 0xe3,
+	0x93, 0xaa, 0xb4, 0x5a, 0xc9, 0x83, 0x6a, 0x3b, 0x89, 0x31, 0xf7, 0x68, 0xa8, 0xfc, 0xe6, 0x9f,
+	0x33, 0x30, 0x6b, 0xe1, 0x18, 0x07, 0x0c, 0x2d, 0x03, 0xb4, 0x30, 0x23, 0x4e, 0x9b, 0x84, 0x34,
+	0xd0, 0xb5, 0x15, 0x6d, 0x35, 0x6f, 0xe7, 0x05, 0x52, 0x17, 0x00, 0xfa, 0x5c, 0x03, 0x24, 0xfd,
+	0x81, 0x17, 0x3a, 0x1d, 0x2c, 0x38, 0x78, 0x2e, 0xd1, 0xcf, 0x89, 0xb8, 0xda, 0xee, 0xfe, 0xd0,
+	0xc8, 0xfc, 0x32, 0x34, 0xae, 0x28, 0x4a, 0xac, 0xbd, 0x57, 0xf1, 0x68, 0x35, 0xc0, 0xbc, 0x5b,
+	0x69, 0x90, 0x0e, 0x76, 0x07, 0x75, 0xe2, 0x1e, 0x0e, 0x8d, 0xc5, 0x01, 0x0e, 0xfc, 0x9b, 0xe6,
+	0xcb, 0x69, 0xcc, 0x9f, 0x7e, 0xb8, 0x06, 0x69, 0x3b, 0x75, 0xe2, 0x3e, 0x79, 0xfe, 0x74, 0x4d,
+	0xd3, 0x35, 0xfb, 0x82, 0x88, 0xdc, 0xf6, 0xc2, 0x8f, 0x30, 0xb3, 0x44, 0x18, 0xfa, 0x5e, 0x83,
+	0x65, 0x97, 0x86, 0x3c, 0xc6, 0x2e, 0x77, 0x58, 0x17, 0xc7, 0xa4, 0xed, 0xc4, 0xa4, 0x47, 0xc2,
+	0x84, 0x38, 0xb2, 0x2f, 0x3d, 0x2b, 0xf9, 0xdc, 0x3f, 0x1b, 0x9f, 0xb7, 0x15, 0x9f, 0x53, 0x33,
+	0x4e, 0xa3, 0x66, 0x2f, 0x8d, 0xb7, 0xec, 0xc8, 0x1d, 0xb6, 0xda, 0x60, 0x8b, 0x78, 0xf4, 0x0e,
+	0x94, 0x98, 0x1b, 0x7b, 0x11, 0x77, 0x48, 0x88, 0x5b, 0x3e, 0x69, 0xeb, 0xe7, 0x57, 0xb4, 0xd5,
+	0x9c, 0x5d, 0x54, 0xe8, 0xa6, 0x02, 0xd1, 0x5d, 0x40, 0xd8, 0xf7, 0x69, 0x9f, 0xb4, 0x9d, 0x28,
+	0x69, 0xf9, 0x1e, 0xeb, 0x92, 0x98, 0xe9, 0x33, 0x2b, 0xd9, 0xd5, 0x7c, 0xcd, 0x3c, 0x9e, 0xd5,
+	0xcb, 0x31, 0xa6, 0xa2, 0x70, 0x31, 0xf5, 0x58, 0x47, 0x0e, 0x74, 0x17, 0x2e, 0xb9, 0x3e, 0x0e,
+	0x02, 0x27, 0xa0, 0xed, 0xc4, 0x27, 0x0e, 0x6e, 0xb7, 0x63, 0xc2, 0x98, 0x3e, 0x2b, 0x67, 0x62,
+	0x1c, 0x0e, 0x8d, 0x2b, 0x69, 0xc3, 0x53, 0xa2, 0x4c, 0x1b, 0x49, 0x78, 0x5b, 0xa2, 0xeb, 0x0a,
+	0x44, 0x0e, 0x14, 0x78, 0x1f, 0x47, 0x4e, 0xdf, 0x0b, 0xdb, 0xb4, 0xaf, 0xcf, 0xad, 0x68, 0xab,
+	0x85, 0x1b, 0x8b, 0x15, 0xa5, 0xaa, 0xca, 0x58, 0x55, 0x95, 0x7a, 0xaa, 0xaa, 0xda, 0x55, 0x31,
+	0xf8, 0xc3, 0xa1, 0x81, 0x54, 0xa1, 0x13, 0x7b, 0xcd, 0x47, 0xbf, 0x1a, 0x9a, 0xa2, 0x0e, 0x02,
+	0xbe, 0x27, 0x51, 0xb4, 0x03, 0x6f, 0xf4, 0x28, 0xf7, 0xc2, 0x8e, 0x13, 0xd1, 0x3e, 0x89, 0x9d,
+	0x84, 0x11, 0x47, 0xb8, 0xf5, 0x9c, 0x18, 0x5a, 0x6d, 0xe5, 0x70, 0x68, 0xbc, 0xa5, 0x72, 0x4d,
+	0x0d, 0x33, 0x6d, 0xa4, 0x70, 0x4b, 0xc0, 0xbb, 0x8c, 0x34, 0xfb, 0x38, 0xba, 0xa9, 0x3f, 0x7a,
+	0x6c, 0x64, 0xfe, 0x78, 0x6c, 0x68, 0x5f, 0x3d, 0x7f, 0xba, 0x56, 0x90, 0xdf, 0x9a, 0x12, 0xb9,
+	0xf9, 0xc5, 0x0c, 0xe4, 0x6d, 0xdc, 0xff, 0x5f, 0xf2, 0xff, 0x8d, 0xe4, 0xa7, 0xeb, 0x73, 0xe6,
+	0x1f, 0xd3, 0xe7, 0xec, 0xbf, 0xa7, 0xcf, 0xb9, 0xbf, 0xaf, 0x4f, 0xf3, 0x47, 0x0d, 0x66, 0x55,
+	0x1f, 0x48, 0x87, 0xb9, 0xf1, 0x18, 0x94, 0xfe, 0xc6, 0x26, 0x32, 0xa0, 0x90, 0x4e, 0x20, 0xc4,
+	0x41, 0xaa, 0x3a, 0x1b, 0x14, 0x74, 0x1b, 0x07, 0x04, 0x2d, 0x40, 0x16, 0xb7, 0x3c, 0x75, 0xfc,
+	0xb6, 0x58, 0xa2, 0x2b, 0x90, 0x8f, 0x71, 0xdf, 0x69, 0x0d, 0x38, 0x61, 0xf2, 0x08, 0xe6, 0xed,
+	0x5c, 0x8c, 0xfb, 0x35, 0x61, 0xa3, 0x3a, 0x94, 0x92, 0xa8, 0x13, 0xe3, 0x36, 0x71, 0x22, 0xea,
+	0x7b, 0xee, 0x40, 0xce, 0xbd, 0x74, 0x63, 0xb9, 0x32, 0xf9, 0xee, 0x54, 0x76, 0x55, 0x94, 0x25,
+	0x83, 0xec, 0x62, 0x72, 0xd2, 0x34, 0x31, 0xe4, 0x36, 0xba, 0xc4, 0xdd, 0x63, 0x49, 0xf0, 0x3a,
+	0xdc, 0x97, 0x20, 0xe7, 0xa6, 0x69, 0x64, 0x03, 0xf3, 0xf6, 0x91, 0x6d, 0x7e, 0xa9, 0x41, 0xce,
+	0x26, 0x8c, 0x26, 0xb1, 0x7b, 0xda, 0x7c, 0x96, 0x01, 0x18, 0x8f, 0x13, 0x97, 0x3b, 0x1c, 0x77,
+	0xd2, 0x12, 0x79, 0x85, 0x34, 0x71, 0x07, 0x5d, 0x85, 0xa2, 0x68, 0xc8, 0x89, 0xd3, 0x4c, 0xe9,
+	0x9c, 0xe6, 0x05, 0x78, 0x94, 0xfd, 0xb4, 0x81, 0x99, 0x0e, 0xe4, 0x9b, 0x42, 0xb8, 0x5b, 0xe1,
+	0x03, 0x7a, 0x0a, 0x8f, 0x45, 0xc8, 0xed, 0x91, 0x81, 0x23, 0x5e, 0xf2, 0x94, 0xc5, 0xdc, 0x1e,
+	0x19, 0x34, 0x07, 0x11, 0x11, 0x14, 0x7b, 0xd8, 0x4f, 0x88, 0x72, 0x2a, 0x02, 0x79, 0x89, 0x08,
+	0xb7, 0xf9, 0x8d, 0x06, 0x20, 0x2b, 0x6c, 0x86, 0x3c, 0x1e, 0x9c, 0x52, 0x62, 0x01, 0xb2, 0x7b,
+	0x64, 0x90, 0x66, 0x17, 0x4b, 0x74, 0x09, 0x66, 0x64, 0x9e, 0x34, 0xa9, 0x32, 0x44, 0x3b, 0x82,
+	0xca, 0x44, 0x3b, 0x7b, 0x64, 0xa0, 0xce, 0xdf, 0x80, 0x82, 0x22, 0xa3, 0xdc, 0x33, 0xd2, 0xad,
+	0xf8, 0xa9, 0x7e, 0x3f, 0x06, 0x34, 0x71, 0xf4, 0x96, 0xfc, 0xaf, 0xe4, 0x7d, 0x98, 0x4d, 0xe5,
+	0xa2, 0x9d, 0x45, 0x2e, 0x69, 0xb0, 0x89, 0x61, 0xae, 0x4e, 0x1e, 0x5a, 0xd8, 0x8b, 0xc5, 0xed,
+	0x10, 0x10, 0x8e, 0xdb, 0x98, 0x63, 0xe7, 0xd3, 0x84, 0x72, 0x92, 0xb6, 0x57, 0x1c, 0xa3, 0x77,
+	0x05, 0x88, 0xaa, 0x50, 0x38, 0x0a, 0xf3, 0xa3, 0xf4, 0x96, 0x2d, 0x8d, 0x86, 0x06, 0x6c, 0xa7,
+	0x70, 0xc3, 0xb2, 0x61, 0x1c, 0xd2, 0x88, 0xcc, 0x6f, 0x35, 0xd0, 0x37, 0x1f, 0x12, 0x37, 0xe1,
+	0x64, 0x3d, 0xe1, 0x5d, 0x1a, 0x7b, 0x9f, 0xc9, 0x8f, 0x7c, 0x8b, 0x93, 0x40, 0x16, 0x9d, 0xbc,
+	0x65, 0xc6, 0x45, 0x27, 0xee, 0x8f, 0x57, 0x0a, 0xf5, 0x5d, 0x28, 0x3d, 0x48, 0x42, 0x57, 0xe4,
+	0x95, 0x21, 0x4c, 0xcf, 0xca, 0x27, 0xfa, 0xfc, 0xfe, 0xd0, 0xd0, 0xec, 0xe2, 0xd8, 0x27, 0x62,
+	0x99, 0x39, 0xca, 0xc2, 0x85, 0xe6, 0xbd, 0x75, 0xeb, 0x4e, 0x8b, 0x91, 0xb8, 0x27, 0xc9, 0xa0,
+	0xfb, 0x20, 0xaf, 0x74, 0x87, 0x45, 0x94, 0xa7, 0x0f, 0x88, 0x64, 0x52, 0xfb, 0xe0, 0x0c, 0x17,
+	0xf6, 0xb4, 0x8b, 0xb8, 0x28, 0xd2, 0xed, 0x44, 0x94, 0xab, 0xf7, 0xc1, 0x87, 0x92, 0x1f, 0x39,
+	0xb2, 0x44, 0x9f, 0x78, 0x9d, 0x2e, 0x4f, 0x27, 0x77, 0xeb, 0x0c, 0xe9, 0x47, 0x43, 0x63, 0xbe,
+	0x61, 0xd5, 0x30, 0x23, 0xf7, 0xe4, 0xd6, 0x69, 0xe5, 0xe6, 0xfd, 0xe8, 0x38, 0x00, 0x31, 0x58,
+	0x72, 0x93, 0x20, 0xf1, 0x31, 0xf7, 0x7a, 0xc4, 0x79, 0xb1, 0xb1, 0xec, 0x6b, 0x35, 0xf6, 0xe6,
+	0x71, 0xe6, 0xda, 0x44, 0x8b, 0x5f, 0x6b, 0xb0, 0x78, 0xa2, 0xea, 0x0b, 0xed, 0x9e, 0x97, 0x45,
+	0xad, 0xb3, 0xb5, 0x7b, 0x79, 0xe3, 0x28, 0xcf, 0xab, 0x1a, 0xbf, 0x7c, 0x5c, 0xb2, 0x71, 0x62,
+	0x04, 0x6b, 0x1f, 0x42, 0x71, 0x42, 0xf2, 0xe8, 0x02, 0x14, 0x76, 0x6f, 0xef, 0x58, 0x9b, 0x1b,
+	0x5b, 0xb7, 0xb6, 0x36, 0xeb, 0x0b, 0x19, 0x54, 0x02, 0xd8, 0xb8, 0xb3, 0x6d, 0xad, 0x37, 0xb7,
+	0x6a, 0x8d, 0xcd, 0x05, 0x0d, 0x15, 0x21, 0xbf, 0xb5, 0xbd, 0xbd, 0xdb, 0x5c, 0x17, 0xe6, 0xb9,
+	0x5a, 0x63, 0xff, 0xf7, 0x72, 0xe6, 0xc9, 0xa8, 0xac, 0xed, 0x8f, 0xca, 0xda, 0xb3, 0x51, 0x59,
+	0xfb, 0x6d, 0x54, 0xd6, 0xbe, 0x3b, 0x28, 0x67, 0x9e, 0x1d, 0x94, 0x33, 0x3f, 0x1f, 0x94, 0x33,
+	0x9f, 0xac, 0x75, 0x3c, 0xde, 0x4d, 0x5a, 0x15, 0x97, 0x06, 0x55, 0xf5, 0xb5, 0x5d, 0xf3, 0x71,
+	0x8b, 0xa5, 0xeb, 0xea, 0x43, 0xf5, 0xf3, 0x41, 0xfe, 0x76, 0x68, 0xcd, 0xca, 0x47, 0xee, 0xbd,
+	0xbf, 0x06, 0x00, 0x57, 0xed, 0x27, 0x7c, 0x5a, 0x0c, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -600,6 +675,12 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.ClammModuleAddress != that1.ClammModuleAddress {
 		return false
 	}
+	if this.TwapWindow != that1.TwapWindow {
+		return false
+	}
+	if this.VotingPowerUseTwap != that1.VotingPowerUseTwap {
+		return false
+	}
 	return true
 }
 func (this *RawParams) Equal(that interface{}) bool {
@@ -636,6 +717,12 @@ func (this *RawParams) Equal(that interface{}) bool {
 	if this.ClammModuleAddress != that1.ClammModuleAddress {
 		return false
 	}
+	if this.TwapWindow != that1.TwapWindow {
+		return false
+	}
+	if this.VotingPowerUseTwap != that1.VotingPowerUseTwap {
+		return false
+	}
 	return true
 }
 func (this *Module) Equal(that interface{}) bool {
@@ -889,6 +976,39 @@ func (this *ExecuteAuthorizationItem) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *TWAPObservation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TWAPObservation)
+	if !ok {
+		that2, ok := that.(TWAPObservation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.BaseSpotPrice.Equal(that1.BaseSpotPrice) {
+		return false
+	}
+	if !this.LPBaseWeight.Equal(that1.LPBaseWeight) {
+		return false
+	}
+	if !this.CumulativeBaseSpotPrice.Equal(that1.CumulativeBaseSpotPrice) {
+		return false
+	}
+	if !this.CumulativeLPBaseWeight.Equal(that1.CumulativeLPBaseWeight) {
+		return false
+	}
+	return true
+}
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -909,6 +1029,24 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.VotingPowerUseTwap {
+		i--
+		if m.VotingPowerUseTwap {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TwapWindow, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TwapWindow):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTypes(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x3a
 	if len(m.ClammModuleAddress) > 0 {
 		i -= len(m.ClammModuleAddress)
 		copy(dAtA[i:], m.ClammModuleAddress)
@@ -985,6 +1123,24 @@ func (m *RawParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.VotingPowerUseTwap {
+		i--
+		if m.VotingPowerUseTwap {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TwapWindow, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TwapWindow):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTypes(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x32
 	if len(m.ClammModuleAddress) > 0 {
 		i -= len(m.ClammModuleAddress)
 		copy(dAtA[i:], m.ClammModuleAddress)
@@ -1396,6 +1552,69 @@ func (m *ExecuteAuthorizationItem) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *TWAPObservation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TWAPObservation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TWAPObservation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CumulativeLPBaseWeight.Size()
+		i -= size
+		if _, err := m.CumulativeLPBaseWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.CumulativeBaseSpotPrice.Size()
+		i -= size
+		if _, err := m.CumulativeBaseSpotPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.LPBaseWeight.Size()
+		i -= size
+		if _, err := m.LPBaseWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.BaseSpotPrice.Size()
+		i -= size
+		if _, err := m.BaseSpotPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -1434,6 +1653,11 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TwapWindow)
+	n += 1 + l + sovTypes(uint64(l))
+	if m.VotingPowerUseTwap {
+		n += 2
+	}
 	return n
 }
 
@@ -1458,6 +1682,11 @@ func (m *RawParams) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TwapWindow)
+	n += 1 + l + sovTypes(uint64(l))
+	if m.VotingPowerUseTwap {
+		n += 2
+	}
 	return n
 }
 
@@ -1637,6 +1866,23 @@ func (m *ExecuteAuthorizationItem) Size() (n int) {
 	return n
 }
 
+func (m *TWAPObservation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BaseSpotPrice.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.LPBaseWeight.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.CumulativeBaseSpotPrice.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.CumulativeLPBaseWeight.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1856,6 +2102,59 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.ClammModuleAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TwapWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPowerUseTwap", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.VotingPowerUseTwap = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2058,6 +2357,59 @@ func (m *RawParams) Unmarshal(dAtA []byte) error {
 			}
 			m.ClammModuleAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TwapWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPowerUseTwap", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.VotingPowerUseTwap = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -3295,6 +3647,192 @@ func (m *ExecuteAuthorizationItem) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TWAPObservation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TWAPObservation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TWAPObservation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseSpotPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseSpotPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LPBaseWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LPBaseWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeBaseSpotPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CumulativeBaseSpotPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeLPBaseWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CumulativeLPBaseWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
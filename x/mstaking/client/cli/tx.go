@@ -3,6 +3,7 @@ package cli
 import (
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 
 	"cosmossdk.io/core/address"
@@ -46,6 +47,10 @@ func NewTxCmd(ac, vc address.Codec) *cobra.Command {
 		NewDelegateCmd(ac, vc),
 		NewRedelegateCmd(ac, vc),
 		NewUnbondCmd(ac, vc),
+		NewRotateConsPubKeyCmd(vc),
+		NewTokenizeSharesCmd(ac, vc),
+		NewRedeemTokensCmd(ac),
+		NewTransferTokenizeShareRecordCmd(ac),
 	)
 
 	return stakingTxCmd
@@ -300,6 +305,197 @@ $ %s tx staking unbond %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 100stake --from
 	return cmd
 }
 
+func NewRotateConsPubKeyCmd(vc address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate-cons-pubkey [pubkey]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Rotate the consensus pubkey of an existing validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Rotate the consensus pubkey of the validator operated by the sender.
+The rotation fee is charged to the operator and a validator can rotate its
+consensus pubkey once per unbonding period.
+
+Example:
+$ %s tx mstaking rotate-cons-pubkey '{"@type":"/cosmos.crypto.ed25519.PubKey","key":"..."}' --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			valAddr := clientCtx.GetFromAddress()
+			valAddrStr, err := vc.BytesToString(valAddr)
+			if err != nil {
+				return err
+			}
+
+			var pk cryptotypes.PubKey
+			if err := clientCtx.Codec.UnmarshalInterfaceJSON([]byte(args[0]), &pk); err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgRotateConsPubKey(valAddrStr, pk)
+			if err != nil {
+				return err
+			}
+			if err := msg.Validate(vc); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewTokenizeSharesCmd(ac, vc address.Codec) *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "tokenize-share [validator-addr] [amount] [reward-owner]",
+		Short: "Tokenize delegation to share tokens",
+		Args:  cobra.ExactArgs(3),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Tokenize an amount of delegation to share tokens. The rewards of
+the tokenized delegation are withdrawn to the reward owner.
+
+Example:
+$ %s tx mstaking tokenize-share %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 100stake %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --from mykey
+`,
+				version.AppName, bech32PrefixValAddr, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			delAddr := clientCtx.GetFromAddress()
+			delAddrStr, err := ac.BytesToString(delAddr)
+			if err != nil {
+				return err
+			}
+
+			valAddrStr := args[0]
+			if _, err := vc.StringToBytes(valAddrStr); err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTokenizeShares(delAddrStr, valAddrStr, amount, args[2])
+			if err := msg.Validate(ac, vc); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewRedeemTokensCmd(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redeem-tokens [amount]",
+		Short: "Redeem share tokens for delegation",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Redeem an amount of share tokens for the delegation they represent.
+
+Example:
+$ %s tx mstaking redeem-tokens 100%s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj/1 --from mykey
+`,
+				version.AppName, sdk.GetConfig().GetBech32ValidatorAddrPrefix(),
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			delAddr := clientCtx.GetFromAddress()
+			delAddrStr, err := ac.BytesToString(delAddr)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRedeemTokensForShares(delAddrStr, amount)
+			if err := msg.Validate(ac); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewTransferTokenizeShareRecordCmd(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-tokenize-share-record [record-id] [new-owner]",
+		Short: "Transfer the ownership of a tokenize share record",
+		Args:  cobra.ExactArgs(2),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Transfer the ownership of a tokenize share record. The new owner
+receives the rewards of the tokenized delegation.
+
+Example:
+$ %s tx mstaking transfer-tokenize-share-record 1 %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --from mykey
+`,
+				version.AppName, sdk.GetConfig().GetBech32AccountAddrPrefix(),
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			sender := clientCtx.GetFromAddress()
+			senderStr, err := ac.BytesToString(sender)
+			if err != nil {
+				return err
+			}
+
+			recordId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransferTokenizeShareRecord(recordId, senderStr, args[1])
+			if err := msg.Validate(ac); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func newBuildCreateValidatorMsg(clientCtx client.Context, txf tx.Factory, fs *flag.FlagSet, vc address.Codec) (tx.Factory, *types.MsgCreateValidator, error) {
 	fAmount, _ := fs.GetString(FlagAmount)
 	amount, err := sdk.ParseCoinsNormalized(fAmount)
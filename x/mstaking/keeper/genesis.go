@@ -2,11 +2,14 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 
+	"cosmossdk.io/collections"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/initia-labs/initia/x/mstaking/types"
@@ -187,6 +190,39 @@ func (k Keeper) InitGenesis(
 		panic(err)
 	}
 
+	for _, history := range data.ConsPubkeyRotationHistories {
+		if err := k.setConsPubKeyRotationHistory(ctx, history); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, record := range data.TokenizeShareRecords {
+		if err := k.SetTokenizeShareRecord(ctx, record); err != nil {
+			panic(err)
+		}
+
+		valAddr, err := k.validatorAddressCodec.StringToBytes(record.Validator)
+		if err != nil {
+			panic(err)
+		}
+
+		// restore the liquid shares from the tokenized delegation
+		delegation, err := k.GetDelegation(ctx, record.GetModuleAddress(), valAddr)
+		if errors.Is(err, collections.ErrNotFound) {
+			continue
+		} else if err != nil {
+			panic(err)
+		}
+
+		if err := k.addLiquidShares(ctx, valAddr, delegation.Shares); err != nil {
+			panic(err)
+		}
+	}
+
+	if err := k.LastTokenizeShareRecordId.Set(ctx, data.LastTokenizeShareRecordId); err != nil {
+		panic(err)
+	}
+
 	// don't need to run Tendermint updates if we exported
 	if data.Exported {
 		for _, lv := range data.LastValidatorPowers {
@@ -280,6 +316,29 @@ func (k Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
 		panic(err)
 	}
 
+	var rotationHistories []types.ConsPubKeyRotationHistory
+	err = k.ConsPubKeyRotationHistories.Walk(ctx, nil, func(_ collections.Pair[[]byte, int64], history types.ConsPubKeyRotationHistory) (stop bool, err error) {
+		rotationHistories = append(rotationHistories, history)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var tokenizeShareRecords []types.TokenizeShareRecord
+	err = k.TokenizeShareRecords.Walk(ctx, nil, func(_ uint64, record types.TokenizeShareRecord) (stop bool, err error) {
+		tokenizeShareRecords = append(tokenizeShareRecords, record)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	lastTokenizeShareRecordId, err := k.LastTokenizeShareRecordId.Peek(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	return &types.GenesisState{
 		Params:               params,
 		LastValidatorPowers:  lastValidatorPowers,
@@ -289,5 +348,9 @@ func (k Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
 		Redelegations:        redelegations,
 		Exported:             true,
 		NextUnbondingId:      nextUnbondingId,
+
+		ConsPubkeyRotationHistories: rotationHistories,
+		TokenizeShareRecords:        tokenizeShareRecords,
+		LastTokenizeShareRecordId:   lastTokenizeShareRecordId,
 	}
 }
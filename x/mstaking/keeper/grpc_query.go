@@ -503,6 +503,41 @@ func (q Querier) Params(ctx context.Context, _ *types.QueryParamsRequest) (*type
 	return &types.QueryParamsResponse{Params: params}, nil
 }
 
+// TokenizeShareRecord queries a tokenize share record by its id
+func (q Querier) TokenizeShareRecord(ctx context.Context, req *types.QueryTokenizeShareRecordRequest) (*types.QueryTokenizeShareRecordResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	record, err := q.Keeper.GetTokenizeShareRecord(ctx, req.Id)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryTokenizeShareRecordResponse{Record: record}, nil
+}
+
+// TokenizeShareRecordByDenom queries a tokenize share record by its share token denom
+func (q Querier) TokenizeShareRecordByDenom(ctx context.Context, req *types.QueryTokenizeShareRecordByDenomRequest) (*types.QueryTokenizeShareRecordResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	_, recordId, err := types.ParseShareTokenDenom(req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	record, err := q.Keeper.GetTokenizeShareRecord(ctx, recordId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	} else if record.GetShareTokenDenom() != req.Denom {
+		return nil, status.Error(codes.NotFound, types.ErrTokenizeShareRecordNotFound.Error())
+	}
+
+	return &types.QueryTokenizeShareRecordResponse{Record: record}, nil
+}
+
 func queryRedelegation(ctx context.Context, q Querier, req *types.QueryRedelegationsRequest) (redels types.Redelegations, err error) {
 	delAddr, err := q.authKeeper.AddressCodec().StringToBytes(req.DelegatorAddr)
 	if err != nil {
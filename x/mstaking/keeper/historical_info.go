@@ -71,8 +71,22 @@ func (k Keeper) TrackHistoricalInfo(ctx context.Context) error {
 	}
 
 	for _, v := range lastVals {
+		valAddr, err := k.validatorAddressCodec.StringToBytes(v.GetOperator())
+		if err != nil {
+			return err
+		}
+
+		// the consensus pubkey rotated at the previous height takes effect
+		// from the next height, so the current block is signed with the old one
+		consPubKey := v.ConsensusPubkey
+		if history, found, err := k.getConsPubKeyRotation(ctx, valAddr, sdkCtx.BlockHeight()-1); err != nil {
+			return err
+		} else if found {
+			consPubKey = history.OldConsPubkey
+		}
+
 		lastCosmosVals.Validators = append(lastCosmosVals.Validators, cosmostypes.Validator{
-			ConsensusPubkey: v.ConsensusPubkey,
+			ConsensusPubkey: consPubKey,
 			Tokens:          v.VotingPower,
 			Status:          cosmostypes.BondStatus(v.Status),
 		})
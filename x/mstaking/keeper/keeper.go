@@ -12,6 +12,7 @@ import (
 	addresscodec "cosmossdk.io/core/address"
 	corestoretypes "cosmossdk.io/core/store"
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -67,6 +68,15 @@ type Keeper struct {
 	HistoricalInfos collections.Map[int64, cosmostypes.HistoricalInfo]
 
 	Params collections.Item[types.Params]
+
+	ConsPubKeyRotationHistories collections.Map[collections.Pair[[]byte, int64], types.ConsPubKeyRotationHistory] // valAddr, height
+	ConsPubKeyRotationQueue     collections.Map[collections.Pair[time.Time, []byte], []byte]                      // (time, oldConsAddr), valAddr
+	PendingConsPubKeyRotations  collections.Map[[]byte, int64]                                                    // valAddr, height
+
+	TokenizeShareRecords        collections.Map[uint64, types.TokenizeShareRecord]
+	TokenizeShareRecordsByOwner collections.KeySet[collections.Pair[[]byte, uint64]] // owner, id
+	LastTokenizeShareRecordId   collections.Sequence
+	LiquidShares                collections.Map[collections.Pair[[]byte, string], math.LegacyDec] // valAddr, bond denom
 }
 
 // NewKeeper creates a new staking Keeper instance
@@ -135,6 +145,15 @@ func NewKeeper(
 		HistoricalInfos: collections.NewMap(sb, types.HistoricalInfosPrefix, "historical_infos", collections.Int64Key, codec.CollValue[cosmostypes.HistoricalInfo](cdc)),
 
 		Params: collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+
+		ConsPubKeyRotationHistories: collections.NewMap(sb, types.ConsPubKeyRotationHistoriesPrefix, "cons_pubkey_rotation_histories", collections.PairKeyCodec(collections.BytesKey, collections.Int64Key), codec.CollValue[types.ConsPubKeyRotationHistory](cdc)),
+		ConsPubKeyRotationQueue:     collections.NewMap(sb, types.ConsPubKeyRotationQueuePrefix, "cons_pubkey_rotation_queue", collections.PairKeyCodec(sdk.TimeKey, collections.BytesKey), collections.BytesValue),
+		PendingConsPubKeyRotations:  collections.NewMap(sb, types.PendingConsPubKeyRotationsPrefix, "pending_cons_pubkey_rotations", collections.BytesKey, collections.Int64Value),
+
+		TokenizeShareRecords:        collections.NewMap(sb, types.TokenizeShareRecordsPrefix, "tokenize_share_records", collections.Uint64Key, codec.CollValue[types.TokenizeShareRecord](cdc)),
+		TokenizeShareRecordsByOwner: collections.NewKeySet(sb, types.TokenizeShareRecordsByOwnerPrefix, "tokenize_share_records_by_owner", collections.PairKeyCodec(collections.BytesKey, collections.Uint64Key)),
+		LastTokenizeShareRecordId:   collections.NewSequence(sb, types.LastTokenizeShareRecordIdKey, "last_tokenize_share_record_id"),
+		LiquidShares:                collections.NewMap(sb, types.LiquidSharesPrefix, "liquid_shares", collections.PairKeyCodec(collections.BytesKey, collections.StringKey), sdk.LegacyDecValue),
 	}
 
 	schema, err := sb.Build()
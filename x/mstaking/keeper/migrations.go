@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/initia/x/mstaking/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper *Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper *Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2 by setting the key rotation fee
+// and the liquid staking caps, which are absent from the stored params, to
+// their defaults. The key rotation fee is charged in the first bond denom.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	params, err := m.keeper.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.KeyRotationFee.Empty() && len(params.BondDenoms) != 0 {
+		params.KeyRotationFee = sdk.NewCoins(sdk.NewCoin(params.BondDenoms[0], types.DefaultKeyRotationFeeAmount))
+	}
+	if params.GlobalLiquidStakingCap.IsNil() {
+		params.GlobalLiquidStakingCap = types.DefaultGlobalLiquidStakingCap
+	}
+	if params.ValidatorLiquidStakingCap.IsNil() {
+		params.ValidatorLiquidStakingCap = types.DefaultValidatorLiquidStakingCap
+	}
+
+	return m.keeper.SetParams(ctx, params)
+}
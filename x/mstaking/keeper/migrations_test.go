@@ -0,0 +1,56 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/initia/x/mstaking/keeper"
+	"github.com/initia-labs/initia/x/mstaking/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	params, err := input.StakingKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	bz, err := input.EncodingConfig.Codec.Marshal(&params)
+	require.NoError(t, err)
+
+	// drop the fields added in version 2 to store the params as version 1 did
+	var legacyBz []byte
+	for len(bz) > 0 {
+		num, typ, n := protowire.ConsumeTag(bz)
+		require.GreaterOrEqual(t, n, 0)
+		m := protowire.ConsumeFieldValue(num, typ, bz[n:])
+		require.GreaterOrEqual(t, m, 0)
+		if num < 8 {
+			legacyBz = append(legacyBz, bz[:n+m]...)
+		}
+		bz = bz[n+m:]
+	}
+	store := input.MultiStore.(*rootmulti.Store).GetStoreByName(types.StoreKey).(storetypes.KVStore)
+	store.Set(types.ParamsKey, legacyBz)
+
+	legacyParams, err := input.StakingKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	require.True(t, legacyParams.GlobalLiquidStakingCap.IsNil())
+	require.True(t, legacyParams.ValidatorLiquidStakingCap.IsNil())
+	require.True(t, legacyParams.KeyRotationFee.Empty())
+
+	m := keeper.NewMigrator(&input.StakingKeeper)
+	require.NoError(t, m.Migrate1to2(ctx))
+
+	migratedParams, err := input.StakingKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultGlobalLiquidStakingCap, migratedParams.GlobalLiquidStakingCap)
+	require.Equal(t, types.DefaultValidatorLiquidStakingCap, migratedParams.ValidatorLiquidStakingCap)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(bondDenom, types.DefaultKeyRotationFeeAmount)), migratedParams.KeyRotationFee)
+	require.NoError(t, migratedParams.Validate())
+}
@@ -540,3 +540,158 @@ func (ms msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// RotateConsPubKey defines a method for rotating the consensus pubkey of a validator
+func (k msgServer) RotateConsPubKey(ctx context.Context, msg *types.MsgRotateConsPubKey) (*types.MsgRotateConsPubKeyResponse, error) {
+	if err := msg.Validate(k.validatorAddressCodec); err != nil {
+		return nil, err
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	pk, ok := msg.NewPubkey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidType, "Expecting cryptotypes.PubKey, got %T", pk)
+	}
+
+	history, err := k.Keeper.RotateConsPubKey(ctx, valAddr, pk)
+	if err != nil {
+		return nil, err
+	}
+
+	oldPk, err := history.OldConsPubKey()
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRotateConsPubKey,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyOldConsAddress, sdk.GetConsAddress(oldPk).String()),
+			sdk.NewAttribute(types.AttributeKeyNewConsAddress, sdk.GetConsAddress(pk).String()),
+			sdk.NewAttribute(sdk.AttributeKeyFee, history.Fee.String()),
+		),
+	)
+
+	return &types.MsgRotateConsPubKeyResponse{}, nil
+}
+
+func (k msgServer) TokenizeShares(ctx context.Context, msg *types.MsgTokenizeShares) (*types.MsgTokenizeSharesResponse, error) {
+	if err := msg.Validate(k.authKeeper.AddressCodec(), k.validatorAddressCodec); err != nil {
+		return nil, err
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	ownerAddress, err := k.authKeeper.AddressCodec().StringToBytes(msg.TokenizedShareOwner)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid tokenized share owner address: %s", err)
+	}
+
+	bondDenoms, err := k.BondDenoms(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !types.IsAllBondDenoms(sdk.NewCoins(msg.Amount), bondDenoms) {
+		return nil, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest, "invalid coin denomination: got %s, expected one of %s", msg.Amount, bondDenoms,
+		)
+	}
+
+	shareToken, record, err := k.Keeper.TokenizeShares(ctx, delegatorAddress, valAddr, msg.Amount, ownerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTokenizeShares,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyShareOwner, msg.TokenizedShareOwner),
+			sdk.NewAttribute(types.AttributeKeyShareRecordId, strconv.FormatUint(record.Id, 10)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyShareToken, shareToken.String()),
+		),
+	)
+
+	return &types.MsgTokenizeSharesResponse{
+		Amount: shareToken,
+	}, nil
+}
+
+func (k msgServer) RedeemTokensForShares(ctx context.Context, msg *types.MsgRedeemTokensForShares) (*types.MsgRedeemTokensForSharesResponse, error) {
+	if err := msg.Validate(k.authKeeper.AddressCodec()); err != nil {
+		return nil, err
+	}
+
+	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	amount, err := k.Keeper.RedeemTokensForShares(ctx, delegatorAddress, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRedeemShares,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyShareToken, msg.Amount.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return &types.MsgRedeemTokensForSharesResponse{
+		Amount: amount,
+	}, nil
+}
+
+func (k msgServer) TransferTokenizeShareRecord(ctx context.Context, msg *types.MsgTransferTokenizeShareRecord) (*types.MsgTransferTokenizeShareRecordResponse, error) {
+	if err := msg.Validate(k.authKeeper.AddressCodec()); err != nil {
+		return nil, err
+	}
+
+	sender, err := k.authKeeper.AddressCodec().StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	newOwner, err := k.authKeeper.AddressCodec().StringToBytes(msg.NewOwner)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid new owner address: %s", err)
+	}
+
+	if err := k.Keeper.TransferTokenizeShareRecord(ctx, msg.TokenizeShareRecordId, sender, newOwner); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTransferShareRecord,
+			sdk.NewAttribute(types.AttributeKeyShareRecordId, strconv.FormatUint(msg.TokenizeShareRecordId, 10)),
+			sdk.NewAttribute(types.AttributeKeyShareOwner, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyNewShareOwner, msg.NewOwner),
+		),
+	)
+
+	return &types.MsgTransferTokenizeShareRecordResponse{}, nil
+}
@@ -0,0 +1,290 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmstrings "github.com/cometbft/cometbft/libs/strings"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/initia/x/mstaking/types"
+)
+
+// RotateConsPubKey rotates the consensus pubkey of a validator. The old
+// consensus address keeps pointing to the validator until the unbonding
+// period passes, so evidence against the old key stays slashable. A validator
+// can rotate its consensus pubkey once per unbonding period.
+func (k Keeper) RotateConsPubKey(ctx context.Context, valAddr sdk.ValAddress, newPubKey cryptotypes.PubKey) (types.ConsPubKeyRotationHistory, error) {
+	validator, err := k.Validators.Get(ctx, valAddr)
+	if err != nil {
+		return types.ConsPubKeyRotationHistory{}, err
+	}
+
+	// the new pubkey must not be used by any validator, including the old
+	// pubkeys of rotated validators
+	newConsAddr := sdk.GetConsAddress(newPubKey)
+	if found, err := k.ValidatorsByConsAddr.Has(ctx, newConsAddr); err != nil {
+		return types.ConsPubKeyRotationHistory{}, err
+	} else if found {
+		return types.ConsPubKeyRotationHistory{}, types.ErrConsensusPubKeyAlreadyUsed
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cp := sdkCtx.ConsensusParams()
+	if cp.Validator != nil {
+		if !tmstrings.StringInSlice(newPubKey.Type(), cp.Validator.PubKeyTypes) {
+			return types.ConsPubKeyRotationHistory{}, errorsmod.Wrapf(
+				types.ErrValidatorPubKeyTypeNotSupported,
+				"got: %s, expected: %s", newPubKey.Type(), cp.Validator.PubKeyTypes,
+			)
+		}
+	}
+
+	// the validator set update of the previous rotation must be applied first
+	if found, err := k.PendingConsPubKeyRotations.Has(ctx, valAddr); err != nil {
+		return types.ConsPubKeyRotationHistory{}, err
+	} else if found {
+		return types.ConsPubKeyRotationHistory{}, types.ErrConsPubKeyRotationPending
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return types.ConsPubKeyRotationHistory{}, err
+	}
+
+	blockTime := sdkCtx.BlockTime()
+	if last, found, err := k.latestConsPubKeyRotation(ctx, valAddr); err != nil {
+		return types.ConsPubKeyRotationHistory{}, err
+	} else if found && last.Time.Add(params.UnbondingTime).After(blockTime) {
+		return types.ConsPubKeyRotationHistory{}, errorsmod.Wrapf(
+			types.ErrExceedingMaxConsPubKeyRotations,
+			"next rotation is allowed after %s", last.Time.Add(params.UnbondingTime),
+		)
+	}
+
+	oldPkAny := validator.ConsensusPubkey
+	oldPubKey, err := validator.ConsPubKey()
+	if err != nil {
+		return types.ConsPubKeyRotationHistory{}, err
+	}
+
+	newPkAny, err := codectypes.NewAnyWithValue(newPubKey)
+	if err != nil {
+		return types.ConsPubKeyRotationHistory{}, err
+	}
+
+	validator.ConsensusPubkey = newPkAny
+	if err := k.SetValidator(ctx, validator); err != nil {
+		return types.ConsPubKeyRotationHistory{}, err
+	}
+	if err := k.SetValidatorByConsAddr(ctx, validator); err != nil {
+		return types.ConsPubKeyRotationHistory{}, err
+	}
+
+	// keep the old consensus address until the unbonding period passes
+	oldConsAddr := sdk.GetConsAddress(oldPubKey)
+	if err := k.ConsPubKeyRotationQueue.Set(ctx, collections.Join(blockTime.Add(params.UnbondingTime), []byte(oldConsAddr)), valAddr); err != nil {
+		return types.ConsPubKeyRotationHistory{}, err
+	}
+
+	history := types.ConsPubKeyRotationHistory{
+		OperatorAddress: validator.OperatorAddress,
+		OldConsPubkey:   oldPkAny,
+		NewConsPubkey:   newPkAny,
+		Height:          sdkCtx.BlockHeight(),
+		Time:            blockTime,
+		Fee:             params.KeyRotationFee,
+	}
+	if err := k.ConsPubKeyRotationHistories.Set(ctx, collections.Join([]byte(valAddr), history.Height), history); err != nil {
+		return types.ConsPubKeyRotationHistory{}, err
+	}
+	if err := k.PendingConsPubKeyRotations.Set(ctx, valAddr, history.Height); err != nil {
+		return types.ConsPubKeyRotationHistory{}, err
+	}
+
+	// the hooks move the signing info and charge the rotation fee
+	if err := k.Hooks().AfterConsensusPubKeyUpdate(ctx, valAddr, oldPubKey, newPubKey, params.KeyRotationFee); err != nil {
+		return types.ConsPubKeyRotationHistory{}, err
+	}
+
+	return history, nil
+}
+
+// GetConsPubKeyRotationHistories returns the consensus pubkey rotation
+// histories of a validator, oldest first.
+func (k Keeper) GetConsPubKeyRotationHistories(ctx context.Context, valAddr sdk.ValAddress) (histories []types.ConsPubKeyRotationHistory, err error) {
+	err = k.ConsPubKeyRotationHistories.Walk(ctx, collections.NewPrefixedPairRange[[]byte, int64](valAddr), func(_ collections.Pair[[]byte, int64], history types.ConsPubKeyRotationHistory) (stop bool, err error) {
+		histories = append(histories, history)
+		return false, nil
+	})
+
+	return
+}
+
+// setConsPubKeyRotationHistory stores a consensus pubkey rotation history from
+// genesis and keeps the old consensus address until the unbonding period passes.
+func (k Keeper) setConsPubKeyRotationHistory(ctx context.Context, history types.ConsPubKeyRotationHistory) error {
+	valAddr, err := k.validatorAddressCodec.StringToBytes(history.OperatorAddress)
+	if err != nil {
+		return err
+	}
+
+	if err := k.ConsPubKeyRotationHistories.Set(ctx, collections.Join(valAddr, history.Height), history); err != nil {
+		return err
+	}
+
+	unbondingTime, err := k.UnbondingTime(ctx)
+	if err != nil {
+		return err
+	}
+
+	matureTime := history.Time.Add(unbondingTime)
+	if !matureTime.After(sdk.UnwrapSDKContext(ctx).BlockTime()) {
+		return nil
+	}
+
+	oldPubKey, err := history.OldConsPubKey()
+	if err != nil {
+		return err
+	}
+
+	oldConsAddr := sdk.GetConsAddress(oldPubKey)
+	if err := k.ValidatorsByConsAddr.Set(ctx, oldConsAddr, valAddr); err != nil {
+		return err
+	}
+
+	return k.ConsPubKeyRotationQueue.Set(ctx, collections.Join(matureTime, []byte(oldConsAddr)), valAddr)
+}
+
+// latestConsPubKeyRotation returns the latest consensus pubkey rotation history of a validator.
+func (k Keeper) latestConsPubKeyRotation(ctx context.Context, valAddr sdk.ValAddress) (types.ConsPubKeyRotationHistory, bool, error) {
+	iter, err := k.ConsPubKeyRotationHistories.Iterate(ctx, collections.NewPrefixedPairRange[[]byte, int64](valAddr).Descending())
+	if err != nil {
+		return types.ConsPubKeyRotationHistory{}, false, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return types.ConsPubKeyRotationHistory{}, false, nil
+	}
+
+	history, err := iter.Value()
+	if err != nil {
+		return types.ConsPubKeyRotationHistory{}, false, err
+	}
+
+	return history, true, nil
+}
+
+// getConsPubKeyRotation returns the consensus pubkey rotation history of a
+// validator at the given height, if the validator rotated it at that height.
+func (k Keeper) getConsPubKeyRotation(ctx context.Context, valAddr sdk.ValAddress, height int64) (types.ConsPubKeyRotationHistory, bool, error) {
+	history, err := k.ConsPubKeyRotationHistories.Get(ctx, collections.Join([]byte(valAddr), height))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.ConsPubKeyRotationHistory{}, false, nil
+	} else if err != nil {
+		return types.ConsPubKeyRotationHistory{}, false, err
+	}
+
+	return history, true, nil
+}
+
+// popPendingConsPubKeyRotations returns the old consensus pubkeys of the
+// rotations not yet applied to the validator set, keyed by validator
+// operator address, and clears them.
+func (k Keeper) popPendingConsPubKeyRotations(ctx context.Context) (map[string]cryptotypes.PubKey, error) {
+	oldPubKeys := make(map[string]cryptotypes.PubKey)
+	err := k.PendingConsPubKeyRotations.Walk(ctx, nil, func(valAddr []byte, height int64) (stop bool, err error) {
+		history, found, err := k.getConsPubKeyRotation(ctx, valAddr, height)
+		if err != nil {
+			return true, err
+		} else if !found {
+			return false, nil
+		}
+
+		oldPubKey, err := history.OldConsPubKey()
+		if err != nil {
+			return true, err
+		}
+
+		valAddrStr, err := k.validatorAddressCodec.BytesToString(valAddr)
+		if err != nil {
+			return true, err
+		}
+
+		oldPubKeys[valAddrStr] = oldPubKey
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.PendingConsPubKeyRotations.Clear(ctx, nil); err != nil {
+		return nil, err
+	}
+
+	return oldPubKeys, nil
+}
+
+// DeleteMatureConsPubKeyRotations removes the old consensus addresses of the
+// rotations whose unbonding period has passed.
+func (k Keeper) DeleteMatureConsPubKeyRotations(ctx context.Context, blockTime time.Time) error {
+	var keys []collections.Pair[time.Time, []byte]
+	err := k.ConsPubKeyRotationQueue.Walk(ctx, nil, func(key collections.Pair[time.Time, []byte], _ []byte) (stop bool, err error) {
+		if key.K1().After(blockTime) {
+			return true, nil
+		}
+
+		keys = append(keys, key)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		valAddr, err := k.ConsPubKeyRotationQueue.Get(ctx, key)
+		if err != nil {
+			return err
+		}
+
+		// remove the old consensus address only if it still points to the validator
+		oldConsAddr := key.K2()
+		if owner, err := k.ValidatorsByConsAddr.Get(ctx, oldConsAddr); err == nil && sdk.ValAddress(owner).Equals(sdk.ValAddress(valAddr)) {
+			if err := k.ValidatorsByConsAddr.Remove(ctx, oldConsAddr); err != nil {
+				return err
+			}
+		} else if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+
+		if err := k.ConsPubKeyRotationQueue.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// consPubKeyUpdateZero returns an abci.ValidatorUpdate removing the given
+// consensus pubkey from the validator set.
+func consPubKeyUpdateZero(pk cryptotypes.PubKey) (abci.ValidatorUpdate, error) {
+	tmPk, err := cryptocodec.ToCmtProtoPublicKey(pk)
+	if err != nil {
+		return abci.ValidatorUpdate{}, err
+	}
+
+	return abci.ValidatorUpdate{
+		PubKey: tmPk,
+		Power:  0,
+	}, nil
+}
@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/initia/x/mstaking/keeper"
+	"github.com/initia-labs/initia/x/mstaking/types"
+)
+
+func TestRotateConsPubKey(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	ms := keeper.NewMsgServerImpl(input.StakingKeeper)
+
+	params, err := input.StakingKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.KeyRotationFee = sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(100)))
+	require.NoError(t, input.StakingKeeper.SetParams(ctx, params))
+
+	valAddr1 := createValidatorWithBalance(ctx, input, 2_000_000, 1_000_000, 1)
+	valAddr2 := createValidatorWithBalance(ctx, input, 2_000_000, 1_000_000, 2)
+
+	validator1, err := input.StakingKeeper.GetValidator(ctx, valAddr1)
+	require.NoError(t, err)
+	oldPubKey, err := validator1.ConsPubKey()
+	require.NoError(t, err)
+	validator2, err := input.StakingKeeper.GetValidator(ctx, valAddr2)
+	require.NoError(t, err)
+	usedPubKey, err := validator2.ConsPubKey()
+	require.NoError(t, err)
+
+	// cannot rotate to a pubkey used by another validator
+	msg, err := types.NewMsgRotateConsPubKey(valAddr1.String(), usedPubKey)
+	require.NoError(t, err)
+	_, err = ms.RotateConsPubKey(ctx, msg)
+	require.ErrorIs(t, err, types.ErrConsensusPubKeyAlreadyUsed)
+
+	newPubKey := ed25519.GenPrivKey().PubKey()
+	msg, err = types.NewMsgRotateConsPubKey(valAddr1.String(), newPubKey)
+	require.NoError(t, err)
+	_, err = ms.RotateConsPubKey(ctx, msg)
+	require.NoError(t, err)
+
+	// the rotation fee is charged to the operator
+	require.Equal(t, math.NewInt(999_900), input.BankKeeper.GetBalance(ctx, sdk.AccAddress(valAddr1), bondDenom).Amount)
+
+	// both of the old and new consensus addresses point to the validator
+	for _, pk := range []cryptotypes.PubKey{oldPubKey, newPubKey} {
+		validator, err := input.StakingKeeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(pk))
+		require.NoError(t, err)
+		require.Equal(t, valAddr1.String(), validator.GetOperator())
+	}
+
+	// the old pubkey is replaced with the new one in the validator set
+	updates, err := input.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(t, err)
+	require.Len(t, updates, 2)
+	require.Equal(t, oldPubKey.Bytes(), updates[0].PubKey.GetEd25519())
+	require.Equal(t, int64(0), updates[0].Power)
+	require.Equal(t, newPubKey.Bytes(), updates[1].PubKey.GetEd25519())
+	require.Equal(t, int64(1), updates[1].Power)
+
+	// the next block is still signed with the old pubkey
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	require.NoError(t, input.StakingKeeper.TrackHistoricalInfo(ctx))
+	historicalInfo, err := input.StakingKeeper.GetHistoricalInfo(ctx, ctx.BlockHeight())
+	require.NoError(t, err)
+
+	pubKeys := make([][]byte, 0, len(historicalInfo.Valset))
+	for _, validator := range historicalInfo.Valset {
+		pk, err := validator.ConsPubKey()
+		require.NoError(t, err)
+		pubKeys = append(pubKeys, pk.Bytes())
+	}
+	require.Contains(t, pubKeys, oldPubKey.Bytes())
+	require.NotContains(t, pubKeys, newPubKey.Bytes())
+
+	// only one rotation is allowed per unbonding period
+	msg, err = types.NewMsgRotateConsPubKey(valAddr1.String(), ed25519.GenPrivKey().PubKey())
+	require.NoError(t, err)
+	_, err = ms.RotateConsPubKey(ctx, msg)
+	require.ErrorIs(t, err, types.ErrExceedingMaxConsPubKeyRotations)
+
+	// the old consensus address is removed after the unbonding period
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(params.UnbondingTime))
+	_, err = input.StakingKeeper.BlockValidatorUpdates(ctx)
+	require.NoError(t, err)
+
+	_, err = input.StakingKeeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(oldPubKey))
+	require.Error(t, err)
+
+	_, err = ms.RotateConsPubKey(ctx, msg)
+	require.NoError(t, err)
+
+	histories, err := input.StakingKeeper.GetConsPubKeyRotationHistories(ctx, valAddr1)
+	require.NoError(t, err)
+	require.Len(t, histories, 2)
+}
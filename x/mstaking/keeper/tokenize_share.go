@@ -0,0 +1,391 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/initia/x/mstaking/types"
+)
+
+// TokenizeShares converts a part of a delegation into share tokens. The
+// delegation shares are moved to the module account of a new tokenize share
+// record, so they stay slashable and keep earning rewards for the record
+// owner, and the share tokens are minted to the delegator through the bank.
+func (k Keeper) TokenizeShares(
+	ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coin, owner sdk.AccAddress,
+) (sdk.Coin, types.TokenizeShareRecord, error) {
+	// the self delegation is used to keep the minimum self delegation
+	if bytes.Equal(delAddr, valAddr) {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, types.ErrTokenizeSelfDelegation
+	}
+
+	validator, err := k.Validators.Get(ctx, valAddr)
+	if err != nil {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, err
+	}
+	if validator.InvalidExRate() {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, types.ErrDelegatorShareExRateInvalid
+	}
+
+	// the redelegation slashing requires the shares to stay in the delegation
+	if found, err := k.HasReceivingRedelegation(ctx, delAddr, valAddr); err != nil {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, err
+	} else if found {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, types.ErrRedelegationInProgress
+	}
+
+	shares, err := k.ValidateUnbondAmount(ctx, delAddr, valAddr, sdk.NewCoins(amount))
+	if err != nil {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, err
+	}
+
+	ownerStr, err := k.authKeeper.AddressCodec().BytesToString(owner)
+	if err != nil {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, err
+	}
+
+	recordId, err := k.LastTokenizeShareRecordId.Peek(ctx)
+	if err != nil {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, err
+	}
+	recordId++
+	if err := k.LastTokenizeShareRecordId.Set(ctx, recordId); err != nil {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, err
+	}
+
+	record := types.NewTokenizeShareRecord(recordId, ownerStr, validator.OperatorAddress)
+	if err := k.SetTokenizeShareRecord(ctx, record); err != nil {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, err
+	}
+
+	if err := k.transferDelegationShares(ctx, delAddr, record.GetModuleAddress(), valAddr, shares); err != nil {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, err
+	}
+
+	if err := k.addLiquidShares(ctx, valAddr, shares); err != nil {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, err
+	}
+	if err := k.checkLiquidStakingCaps(ctx, validator, shares); err != nil {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, err
+	}
+
+	shareToken := sdk.NewCoin(record.GetShareTokenDenom(), amount.Amount)
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(shareToken)); err != nil {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, delAddr, sdk.NewCoins(shareToken)); err != nil {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, err
+	}
+
+	return shareToken, record, nil
+}
+
+// RedeemTokensForShares burns share tokens and moves the corresponding part of
+// the tokenized delegation back to the delegator. The share tokens of a record
+// represent its delegation pro rata to their supply, so slashing is reflected
+// in the redeemed amount.
+func (k Keeper) RedeemTokensForShares(ctx context.Context, delAddr sdk.AccAddress, shareToken sdk.Coin) (sdk.Coins, error) {
+	_, recordId, err := types.ParseShareTokenDenom(shareToken.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := k.GetTokenizeShareRecord(ctx, recordId)
+	if err != nil {
+		return nil, err
+	} else if record.GetShareTokenDenom() != shareToken.Denom {
+		return nil, types.ErrInvalidShareTokenDenom
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(record.Validator)
+	if err != nil {
+		return nil, err
+	}
+
+	validator, err := k.Validators.Get(ctx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	recordAddr := record.GetModuleAddress()
+	delegation, err := k.GetDelegation(ctx, recordAddr, valAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, types.ErrNoDelegation
+	} else if err != nil {
+		return nil, err
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, shareToken.Denom).Amount
+	if shareToken.Amount.GT(supply) {
+		return nil, errorsmod.Wrapf(types.ErrInsufficientShares, "share token supply: %s", supply)
+	}
+
+	shares := delegation.Shares
+	if shareToken.Amount.LT(supply) {
+		shares = shares.MulDecTruncate(math.LegacyNewDecFromInt(shareToken.Amount).QuoInt(supply))
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, delAddr, types.ModuleName, sdk.NewCoins(shareToken)); err != nil {
+		return nil, err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(shareToken)); err != nil {
+		return nil, err
+	}
+
+	if err := k.transferDelegationShares(ctx, recordAddr, delAddr, valAddr, shares); err != nil {
+		return nil, err
+	}
+	if err := k.removeLiquidShares(ctx, valAddr, shares); err != nil {
+		return nil, err
+	}
+
+	amount, _ := validator.TokensFromShares(shares).TruncateDecimal()
+	return amount, nil
+}
+
+// TransferTokenizeShareRecord transfers the ownership of a tokenize share
+// record, which receives the rewards of the tokenized delegation.
+func (k Keeper) TransferTokenizeShareRecord(ctx context.Context, recordId uint64, sender, newOwner sdk.AccAddress) error {
+	record, err := k.GetTokenizeShareRecord(ctx, recordId)
+	if err != nil {
+		return err
+	}
+
+	ownerAddr, err := k.authKeeper.AddressCodec().StringToBytes(record.Owner)
+	if err != nil {
+		return err
+	} else if !bytes.Equal(ownerAddr, sender) {
+		return types.ErrNotTokenizeShareRecordOwner
+	}
+
+	newOwnerStr, err := k.authKeeper.AddressCodec().BytesToString(newOwner)
+	if err != nil {
+		return err
+	}
+
+	if err := k.TokenizeShareRecordsByOwner.Remove(ctx, collections.Join(ownerAddr, record.Id)); err != nil {
+		return err
+	}
+
+	record.Owner = newOwnerStr
+	return k.SetTokenizeShareRecord(ctx, record)
+}
+
+// GetTokenizeShareRecord returns a tokenize share record by its id.
+func (k Keeper) GetTokenizeShareRecord(ctx context.Context, recordId uint64) (types.TokenizeShareRecord, error) {
+	record, err := k.TokenizeShareRecords.Get(ctx, recordId)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.TokenizeShareRecord{}, types.ErrTokenizeShareRecordNotFound
+	}
+
+	return record, err
+}
+
+// SetTokenizeShareRecord stores a tokenize share record and its owner index.
+func (k Keeper) SetTokenizeShareRecord(ctx context.Context, record types.TokenizeShareRecord) error {
+	ownerAddr, err := k.authKeeper.AddressCodec().StringToBytes(record.Owner)
+	if err != nil {
+		return err
+	}
+
+	if err := k.TokenizeShareRecords.Set(ctx, record.Id, record); err != nil {
+		return err
+	}
+
+	return k.TokenizeShareRecordsByOwner.Set(ctx, collections.Join(ownerAddr, record.Id))
+}
+
+// GetTokenizeShareRecordsByOwner returns the tokenize share records of an owner.
+func (k Keeper) GetTokenizeShareRecordsByOwner(ctx context.Context, owner sdk.AccAddress) (records []types.TokenizeShareRecord, err error) {
+	err = k.TokenizeShareRecordsByOwner.Walk(ctx, collections.NewPrefixedPairRange[[]byte, uint64](owner), func(key collections.Pair[[]byte, uint64]) (stop bool, err error) {
+		record, err := k.TokenizeShareRecords.Get(ctx, key.K2())
+		if err != nil {
+			return true, err
+		}
+
+		records = append(records, record)
+		return false, nil
+	})
+
+	return
+}
+
+// GetLiquidShares returns the tokenized delegator shares of a bond denom of a validator.
+func (k Keeper) GetLiquidShares(ctx context.Context, valAddr sdk.ValAddress, denom string) (math.LegacyDec, error) {
+	shares, err := k.LiquidShares.Get(ctx, collections.Join([]byte(valAddr), denom))
+	if errors.Is(err, collections.ErrNotFound) {
+		return math.LegacyZeroDec(), nil
+	}
+
+	return shares, err
+}
+
+// addLiquidShares adds tokenized delegator shares of a validator.
+func (k Keeper) addLiquidShares(ctx context.Context, valAddr sdk.ValAddress, shares sdk.DecCoins) error {
+	for _, share := range shares {
+		liquidShares, err := k.GetLiquidShares(ctx, valAddr, share.Denom)
+		if err != nil {
+			return err
+		}
+
+		if err := k.LiquidShares.Set(ctx, collections.Join([]byte(valAddr), share.Denom), liquidShares.Add(share.Amount)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// removeLiquidShares removes tokenized delegator shares of a validator.
+func (k Keeper) removeLiquidShares(ctx context.Context, valAddr sdk.ValAddress, shares sdk.DecCoins) error {
+	for _, share := range shares {
+		liquidShares, err := k.GetLiquidShares(ctx, valAddr, share.Denom)
+		if err != nil {
+			return err
+		}
+
+		key := collections.Join([]byte(valAddr), share.Denom)
+		if liquidShares = liquidShares.Sub(share.Amount); liquidShares.IsPositive() {
+			err = k.LiquidShares.Set(ctx, key, liquidShares)
+		} else {
+			err = k.LiquidShares.Remove(ctx, key)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkLiquidStakingCaps checks that the tokenized delegator shares of the
+// given bond denoms do not exceed the validator and global liquid staking caps.
+// A cap of one disables the check.
+func (k Keeper) checkLiquidStakingCaps(ctx context.Context, validator types.Validator, shares sdk.DecCoins) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(validator.GetOperator())
+	if err != nil {
+		return err
+	}
+
+	for _, share := range shares {
+		denom := share.Denom
+
+		if params.ValidatorLiquidStakingCap.LT(math.LegacyOneDec()) {
+			liquidShares, err := k.GetLiquidShares(ctx, valAddr, denom)
+			if err != nil {
+				return err
+			}
+
+			if liquidShares.GT(params.ValidatorLiquidStakingCap.Mul(validator.DelegatorShares.AmountOf(denom))) {
+				return errorsmod.Wrapf(types.ErrValidatorLiquidCapExceeded, "denom: %s", denom)
+			}
+		}
+
+		if params.GlobalLiquidStakingCap.LT(math.LegacyOneDec()) {
+			liquidTokens, err := k.totalLiquidTokens(ctx, denom)
+			if err != nil {
+				return err
+			}
+
+			bondedTokens := k.bankKeeper.GetBalance(ctx, k.GetBondedPool(ctx).GetAddress(), denom).Amount
+			if liquidTokens.GT(params.GlobalLiquidStakingCap.MulInt(bondedTokens)) {
+				return errorsmod.Wrapf(types.ErrGlobalLiquidCapExceeded, "denom: %s", denom)
+			}
+		}
+	}
+
+	return nil
+}
+
+// totalLiquidTokens returns the tokens of the tokenized delegator shares of a
+// bond denom across all validators.
+func (k Keeper) totalLiquidTokens(ctx context.Context, denom string) (math.LegacyDec, error) {
+	total := math.LegacyZeroDec()
+	err := k.LiquidShares.Walk(ctx, nil, func(key collections.Pair[[]byte, string], shares math.LegacyDec) (stop bool, err error) {
+		if key.K2() != denom {
+			return false, nil
+		}
+
+		validator, err := k.Validators.Get(ctx, key.K1())
+		if err != nil {
+			return true, err
+		}
+
+		tokens := validator.TokensFromShares(sdk.NewDecCoins(sdk.NewDecCoinFromDec(denom, shares)))
+		total = total.Add(tokens.AmountOf(denom))
+		return false, nil
+	})
+
+	return total, err
+}
+
+// transferDelegationShares moves delegation shares from a delegator to another
+// one without changing the tokens and shares of the validator.
+func (k Keeper) transferDelegationShares(
+	ctx context.Context, fromAddr, toAddr sdk.AccAddress, valAddr sdk.ValAddress, shares sdk.DecCoins,
+) error {
+	from, err := k.GetDelegation(ctx, fromAddr, valAddr)
+	if err != nil {
+		return err
+	}
+
+	if err := k.Hooks().BeforeDelegationSharesModified(ctx, fromAddr, valAddr); err != nil {
+		return err
+	}
+
+	if _, hasNeg := from.Shares.SafeSub(shares); hasNeg {
+		return errorsmod.Wrap(types.ErrNotEnoughDelegationShares, from.Shares.String())
+	}
+
+	from.Shares = from.Shares.Sub(shares)
+	if from.Shares.IsZero() {
+		err = k.RemoveDelegation(ctx, from)
+	} else {
+		if err = k.SetDelegation(ctx, from); err != nil {
+			return err
+		}
+
+		err = k.Hooks().AfterDelegationModified(ctx, fromAddr, valAddr)
+	}
+	if err != nil {
+		return err
+	}
+
+	to, err := k.GetDelegation(ctx, toAddr, valAddr)
+	if err == nil {
+		if err := k.Hooks().BeforeDelegationSharesModified(ctx, toAddr, valAddr); err != nil {
+			return err
+		}
+	} else if errors.Is(err, collections.ErrNotFound) {
+		toAddrStr, err := k.authKeeper.AddressCodec().BytesToString(toAddr)
+		if err != nil {
+			return err
+		}
+
+		to = types.NewDelegation(toAddrStr, from.ValidatorAddress, sdk.NewDecCoins())
+		if err := k.Hooks().BeforeDelegationCreated(ctx, toAddr, valAddr); err != nil {
+			return err
+		}
+	} else {
+		return err
+	}
+
+	to.Shares = to.Shares.Add(shares...)
+	if err := k.SetDelegation(ctx, to); err != nil {
+		return err
+	}
+
+	return k.Hooks().AfterDelegationModified(ctx, toAddr, valAddr)
+}
@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/initia/x/mstaking/keeper"
+	"github.com/initia-labs/initia/x/mstaking/types"
+)
+
+func TestTokenizeShares(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	ms := keeper.NewMsgServerImpl(input.StakingKeeper)
+
+	params, err := input.StakingKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.ValidatorLiquidStakingCap = math.LegacyNewDecWithPrec(2, 1)
+	require.NoError(t, input.StakingKeeper.SetParams(ctx, params))
+
+	valAddr := createValidatorWithBalance(ctx, input, 2_000_000, 1_000_000, 1)
+	delAddr := input.Faucet.NewFundedAccount(ctx, sdk.NewCoin(bondDenom, math.NewInt(1_000_000)))
+	_, _, ownerAddr := keyPubAddr()
+	_, _, otherAddr := keyPubAddr()
+
+	_, err = ms.Delegate(ctx, types.NewMsgDelegate(delAddr.String(), valAddr.String(), sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(1_000_000)))))
+	require.NoError(t, err)
+
+	// the self delegation cannot be tokenized
+	_, err = ms.TokenizeShares(ctx, types.NewMsgTokenizeShares(sdk.AccAddress(valAddr).String(), valAddr.String(), sdk.NewCoin(bondDenom, math.NewInt(100_000)), ownerAddr.String()))
+	require.ErrorIs(t, err, types.ErrTokenizeSelfDelegation)
+
+	// exceeds the validator liquid staking cap, 20% of 2_000_000 shares
+	_, err = ms.TokenizeShares(ctx, types.NewMsgTokenizeShares(delAddr.String(), valAddr.String(), sdk.NewCoin(bondDenom, math.NewInt(500_000)), ownerAddr.String()))
+	require.ErrorIs(t, err, types.ErrValidatorLiquidCapExceeded)
+
+	res, err := ms.TokenizeShares(ctx, types.NewMsgTokenizeShares(delAddr.String(), valAddr.String(), sdk.NewCoin(bondDenom, math.NewInt(400_000)), ownerAddr.String()))
+	require.NoError(t, err)
+
+	shareDenom := valAddr.String() + "/1"
+	require.Equal(t, sdk.NewCoin(shareDenom, math.NewInt(400_000)), res.Amount)
+	require.Equal(t, math.NewInt(400_000), input.BankKeeper.GetBalance(ctx, delAddr, shareDenom).Amount)
+
+	record, err := input.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, ownerAddr.String(), record.Owner)
+
+	// the shares are moved to the record account without changing the validator
+	delegation, err := input.StakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(600_000), delegation.Shares.AmountOf(bondDenom))
+	delegation, err = input.StakingKeeper.GetDelegation(ctx, record.GetModuleAddress(), valAddr)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(400_000), delegation.Shares.AmountOf(bondDenom))
+
+	validator, err := input.StakingKeeper.GetValidator(ctx, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(2_000_000), validator.Tokens.AmountOf(bondDenom))
+
+	liquidShares, err := input.StakingKeeper.GetLiquidShares(ctx, valAddr, bondDenom)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(400_000), liquidShares)
+
+	// share tokens are transferable and redeemable by any holder
+	require.NoError(t, input.BankKeeper.SendCoins(ctx, delAddr, otherAddr, sdk.NewCoins(sdk.NewCoin(shareDenom, math.NewInt(100_000)))))
+	redeemRes, err := ms.RedeemTokensForShares(ctx, types.NewMsgRedeemTokensForShares(otherAddr.String(), sdk.NewCoin(shareDenom, math.NewInt(100_000))))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(100_000))), redeemRes.Amount)
+
+	delegation, err = input.StakingKeeper.GetDelegation(ctx, otherAddr, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(100_000), delegation.Shares.AmountOf(bondDenom))
+
+	// the tokenized delegation is slashed with the validator
+	consAddr, err := validator.GetConsAddr()
+	require.NoError(t, err)
+	_, err = input.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), math.LegacyNewDecWithPrec(1, 1))
+	require.NoError(t, err)
+
+	redeemRes, err = ms.RedeemTokensForShares(ctx, types.NewMsgRedeemTokensForShares(delAddr.String(), sdk.NewCoin(shareDenom, math.NewInt(300_000))))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(270_000))), redeemRes.Amount)
+
+	_, err = input.StakingKeeper.GetDelegation(ctx, record.GetModuleAddress(), valAddr)
+	require.Error(t, err)
+	liquidShares, err = input.StakingKeeper.GetLiquidShares(ctx, valAddr, bondDenom)
+	require.NoError(t, err)
+	require.True(t, liquidShares.IsZero())
+	require.True(t, input.BankKeeper.GetSupply(ctx, shareDenom).IsZero())
+
+	// only the owner can transfer the record
+	_, err = ms.TransferTokenizeShareRecord(ctx, types.NewMsgTransferTokenizeShareRecord(1, delAddr.String(), otherAddr.String()))
+	require.ErrorIs(t, err, types.ErrNotTokenizeShareRecordOwner)
+	_, err = ms.TransferTokenizeShareRecord(ctx, types.NewMsgTransferTokenizeShareRecord(1, ownerAddr.String(), otherAddr.String()))
+	require.NoError(t, err)
+
+	records, err := input.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, ownerAddr)
+	require.NoError(t, err)
+	require.Empty(t, records)
+	records, err = input.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, otherAddr)
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+}
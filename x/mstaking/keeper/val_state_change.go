@@ -38,6 +38,11 @@ func (k Keeper) BlockValidatorUpdates(ctx context.Context) ([]abci.ValidatorUpda
 	}
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	// Remove all mature old consensus addresses of the rotated validators.
+	if err := k.DeleteMatureConsPubKeyRotations(ctx, sdkCtx.BlockHeader().Time); err != nil {
+		return nil, err
+	}
+
 	// Remove all mature unbonding delegations from the ubd queue.
 	matureUnbonds, err := k.DequeueAllMatureUBDQueue(ctx, sdkCtx.BlockHeader().Time)
 	if err != nil {
@@ -191,6 +196,13 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx context.Context) (updates
 		return nil, err
 	}
 
+	// Retrieve the old consensus pubkeys of the validators which rotated
+	// their consensus pubkey since the last update.
+	rotated, err := k.popPendingConsPubKeyRotations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	counter := 0
 
 	// Iterate over validators, highest power to lowest.
@@ -237,8 +249,19 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx context.Context) (updates
 		oldPower, found := last[valAddrStr]
 		newPower := validator.ConsensusPower(powerReduction)
 
-		// update the validator set if power has changed
-		if !found || oldPower != newPower {
+		// replace the old consensus pubkey with the new one if rotated
+		oldPubKey, isRotated := rotated[valAddrStr]
+		if found && isRotated {
+			update, err := consPubKeyUpdateZero(oldPubKey)
+			if err != nil {
+				return true, err
+			}
+
+			updates = append(updates, update)
+		}
+
+		// update the validator set if power or consensus pubkey has changed
+		if !found || oldPower != newPower || isRotated {
 			updates = append(updates, validator.ABCIValidatorUpdate(powerReduction))
 
 			err = k.SetLastValidatorConsPower(ctx, valAddr, newPower)
@@ -272,7 +295,18 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx context.Context) (updates
 		if err := k.DeleteLastValidatorConsPower(ctx, valAddr); err != nil {
 			return nil, err
 		}
-		updates = append(updates, validator.ABCIValidatorUpdateZero())
+
+		// the validator set still knows the old consensus pubkey if rotated
+		if oldPubKey, isRotated := rotated[validator.GetOperator()]; isRotated {
+			update, err := consPubKeyUpdateZero(oldPubKey)
+			if err != nil {
+				return nil, err
+			}
+
+			updates = append(updates, update)
+		} else {
+			updates = append(updates, validator.ABCIValidatorUpdateZero())
+		}
 	}
 
 	// Update the pools based on the recent updates in the validator set:
@@ -19,6 +19,10 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgBeginRedelegate{}, "mstaking/MsgBeginRedelegate")
 	legacy.RegisterAminoMsg(cdc, &MsgCancelUnbondingDelegation{}, "mstaking/MsgCancelUnbondingDelegation")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "mstaking/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgRotateConsPubKey{}, "mstaking/MsgRotateConsPubKey")
+	legacy.RegisterAminoMsg(cdc, &MsgTokenizeShares{}, "mstaking/MsgTokenizeShares")
+	legacy.RegisterAminoMsg(cdc, &MsgRedeemTokensForShares{}, "mstaking/MsgRedeemTokensForShares")
+	legacy.RegisterAminoMsg(cdc, &MsgTransferTokenizeShareRecord{}, "mstaking/MsgTransferTokenizeShareRecord")
 
 	cdc.RegisterInterface((*isStakeAuthorization_Validators)(nil), nil)
 	cdc.RegisterConcrete(&StakeAuthorization_AllowList{}, "mstaking/StakeAuthorization/AllowList", nil)
@@ -37,6 +41,10 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgBeginRedelegate{},
 		&MsgCancelUnbondingDelegation{},
 		&MsgUpdateParams{},
+		&MsgRotateConsPubKey{},
+		&MsgTokenizeShares{},
+		&MsgRedeemTokensForShares{},
+		&MsgTransferTokenizeShareRecord{},
 	)
 	registry.RegisterImplementations(
 		(*authz.Authorization)(nil),
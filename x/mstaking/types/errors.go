@@ -55,4 +55,14 @@ var (
 	ErrInvalidSigner                   = errorsmod.Register(ModuleName, 43, "expected authority account as only signer for proposal message")
 	ErrBadRedelegationSrc              = errorsmod.Register(ModuleName, 44, "redelegation source validator not found")
 	ErrNoUnbondingType                 = errorsmod.Register(ModuleName, 45, "unbonding type not found")
+	ErrConsensusPubKeyAlreadyUsed      = errorsmod.Register(ModuleName, 46, "consensus pubkey is already used for a validator")
+	ErrExceedingMaxConsPubKeyRotations = errorsmod.Register(ModuleName, 47, "exceeding maximum consensus pubkey rotations within unbonding period")
+	ErrConsPubKeyRotationPending       = errorsmod.Register(ModuleName, 48, "consensus pubkey rotation is already pending for the validator")
+	ErrInvalidShareTokenDenom          = errorsmod.Register(ModuleName, 49, "invalid share token denom")
+	ErrTokenizeShareRecordNotFound     = errorsmod.Register(ModuleName, 50, "tokenize share record not found")
+	ErrNotTokenizeShareRecordOwner     = errorsmod.Register(ModuleName, 51, "not the owner of the tokenize share record")
+	ErrTokenizeSelfDelegation          = errorsmod.Register(ModuleName, 52, "validator operator cannot tokenize its self delegation")
+	ErrRedelegationInProgress          = errorsmod.Register(ModuleName, 53, "delegator has an incoming redelegation in progress to the validator")
+	ErrGlobalLiquidCapExceeded         = errorsmod.Register(ModuleName, 54, "tokenization exceeds the global liquid staking cap")
+	ErrValidatorLiquidCapExceeded      = errorsmod.Register(ModuleName, 55, "tokenization exceeds the validator liquid staking cap")
 )
@@ -10,6 +10,10 @@ const (
 	EventTypeUnbond                    = "unbond"
 	EventTypeCancelUnbondingDelegation = "cancel_unbonding_delegation"
 	EventTypeRedelegate                = "redelegate"
+	EventTypeRotateConsPubKey          = "rotate_cons_pubkey"
+	EventTypeTokenizeShares            = "tokenize_shares"
+	EventTypeRedeemShares              = "redeem_tokens_for_shares"
+	EventTypeTransferShareRecord       = "transfer_tokenize_share_record"
 
 	AttributeKeyValidator      = "validator"
 	AttributeKeyCommissionRate = "commission_rate"
@@ -19,5 +23,11 @@ const (
 	AttributeKeyCompletionTime = "completion_time"
 	AttributeKeyCreationHeight = "creation_height"
 	AttributeKeyNewShares      = "new_shares"
+	AttributeKeyOldConsAddress = "old_consensus_address"
+	AttributeKeyNewConsAddress = "new_consensus_address"
+	AttributeKeyShareToken     = "share_token"
+	AttributeKeyShareOwner     = "share_owner"
+	AttributeKeyShareRecordId  = "share_record_id"
+	AttributeKeyNewShareOwner  = "new_share_owner"
 	AttributeValueCategory     = ModuleName
 )
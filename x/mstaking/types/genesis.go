@@ -45,5 +45,10 @@ func (g GenesisState) UnpackInterfaces(c codectypes.AnyUnpacker) error {
 			return err
 		}
 	}
+	for i := range g.ConsPubkeyRotationHistories {
+		if err := g.ConsPubkeyRotationHistories[i].UnpackInterfaces(c); err != nil {
+			return err
+		}
+	}
 	return nil
 }
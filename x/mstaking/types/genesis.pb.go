@@ -43,6 +43,12 @@ type GenesisState struct {
 	Exported      bool           `protobuf:"varint,7,opt,name=exported,proto3" json:"exported,omitempty"`
 	// sdk missed this
 	NextUnbondingId uint64 `protobuf:"varint,8,opt,name=next_unbonding_id,json=nextUnbondingId,proto3" json:"next_unbonding_id,omitempty"`
+	// cons_pubkey_rotation_histories defines the consensus pubkey rotations of the validators.
+	ConsPubkeyRotationHistories []ConsPubKeyRotationHistory `protobuf:"bytes,9,rep,name=cons_pubkey_rotation_histories,json=consPubkeyRotationHistories,proto3" json:"cons_pubkey_rotation_histories" yaml:"cons_pubkey_rotation_histories"`
+	// tokenize_share_records defines the tokenized delegations.
+	TokenizeShareRecords []TokenizeShareRecord `protobuf:"bytes,10,rep,name=tokenize_share_records,json=tokenizeShareRecords,proto3" json:"tokenize_share_records" yaml:"tokenize_share_records"`
+	// last_tokenize_share_record_id is the id of the last created tokenize share record.
+	LastTokenizeShareRecordId uint64 `protobuf:"varint,11,opt,name=last_tokenize_share_record_id,json=lastTokenizeShareRecordId,proto3" json:"last_tokenize_share_record_id,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -134,6 +140,27 @@ func (m *GenesisState) GetNextUnbondingId() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetConsPubkeyRotationHistories() []ConsPubKeyRotationHistory {
+	if m != nil {
+		return m.ConsPubkeyRotationHistories
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTokenizeShareRecords() []TokenizeShareRecord {
+	if m != nil {
+		return m.TokenizeShareRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastTokenizeShareRecordId() uint64 {
+	if m != nil {
+		return m.LastTokenizeShareRecordId
+	}
+	return 0
+}
+
 // LastValidatorPower required for validator set update logic.
 type LastValidatorPower struct {
 	// address is the address of the validator.
@@ -183,41 +210,49 @@ func init() {
 func init() { proto.RegisterFile("initia/mstaking/v1/genesis.proto", fileDescriptor_57b79fb94e52eb30) }
 
 var fileDescriptor_57b79fb94e52eb30 = []byte{
-	// 538 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
-	0x18, 0xc7, 0xe3, 0x75, 0xed, 0x3a, 0x17, 0x84, 0x6a, 0x3a, 0x29, 0x54, 0x90, 0x86, 0x1e, 0xa0,
-	0x4c, 0x5a, 0xa2, 0x95, 0xdb, 0x24, 0x0e, 0x44, 0x48, 0x80, 0xc4, 0x61, 0xca, 0x04, 0x07, 0x24,
-	0x54, 0xb9, 0x8b, 0x95, 0x59, 0x24, 0x76, 0x15, 0xbb, 0xa5, 0x7b, 0x00, 0x04, 0x47, 0x1e, 0x61,
-	0x47, 0x8e, 0x1c, 0x78, 0x88, 0x1d, 0x27, 0xc4, 0x81, 0xd3, 0x84, 0xda, 0x03, 0x9c, 0x79, 0x02,
-	0x54, 0xdb, 0x4d, 0xb3, 0x35, 0x97, 0x28, 0x9f, 0xfd, 0xff, 0xfe, 0xbf, 0xbf, 0x2d, 0x7f, 0xd0,
-	0xa5, 0x8c, 0x4a, 0x8a, 0xfd, 0x54, 0x48, 0xfc, 0x9e, 0xb2, 0xd8, 0x9f, 0xec, 0xfb, 0x31, 0x61,
-	0x44, 0x50, 0xe1, 0x8d, 0x32, 0x2e, 0x39, 0x42, 0x5a, 0xe1, 0x2d, 0x15, 0xde, 0x64, 0xbf, 0xdd,
-	0xc4, 0x29, 0x65, 0xdc, 0x57, 0x5f, 0x2d, 0x6b, 0xdf, 0x39, 0xe6, 0x22, 0xe5, 0x62, 0xa0, 0x2a,
-	0x5f, 0x17, 0x66, 0xab, 0x15, 0xf3, 0x98, 0xeb, 0xf5, 0xc5, 0x9f, 0x59, 0x2d, 0x23, 0x2f, 0x11,
-	0x4a, 0xd1, 0xfd, 0x59, 0x85, 0x37, 0x9e, 0xeb, 0x2c, 0x47, 0x12, 0x4b, 0x82, 0x9e, 0xc0, 0xda,
-	0x08, 0x67, 0x38, 0x15, 0x36, 0x70, 0x41, 0xaf, 0xd1, 0x6f, 0x7b, 0xeb, 0xd9, 0xbc, 0x43, 0xa5,
-	0x08, 0xb6, 0xcf, 0x2f, 0x3b, 0xd6, 0xd7, 0x3f, 0xdf, 0x76, 0x41, 0x68, 0x9a, 0xd0, 0x47, 0x00,
-	0x77, 0x12, 0x2c, 0xe4, 0x60, 0x82, 0x13, 0x1a, 0x61, 0xc9, 0xb3, 0xc1, 0x88, 0x7f, 0x20, 0x99,
-	0xb0, 0x37, 0xdc, 0x4a, 0xaf, 0xd1, 0x7f, 0x50, 0x66, 0xf7, 0x0a, 0x0b, 0xf9, 0x66, 0xa9, 0x3f,
-	0x5c, 0xc8, 0x83, 0x47, 0x0b, 0xeb, 0x7f, 0x97, 0x9d, 0xbb, 0xa7, 0x38, 0x4d, 0x0e, 0xba, 0xa5,
-	0x96, 0x5d, 0x8d, 0xbe, 0x9d, 0xac, 0xb5, 0x0b, 0xf4, 0x0e, 0xc2, 0x5c, 0x2e, 0xec, 0x8a, 0x62,
-	0xdf, 0x2b, 0x63, 0xe7, 0x8d, 0x81, 0x63, 0x90, 0x4d, 0x8d, 0x5c, 0xb5, 0x1b, 0x4e, 0xc1, 0x10,
-	0x61, 0xd8, 0x88, 0x48, 0x42, 0x62, 0x2c, 0x29, 0x67, 0xc2, 0xde, 0x54, 0xfe, 0x4e, 0x99, 0xff,
-	0xb3, 0x5c, 0x16, 0x74, 0x0c, 0x00, 0x69, 0x40, 0xc1, 0xc0, 0x10, 0x8a, 0x9e, 0xe8, 0x13, 0x80,
-	0x3b, 0x63, 0x36, 0xe4, 0x2c, 0xa2, 0x2c, 0x1e, 0x14, 0x69, 0x55, 0x45, 0x7b, 0x58, 0x46, 0x7b,
-	0xbd, 0x6c, 0x28, 0x60, 0xaf, 0x5d, 0x65, 0xa9, 0xa7, 0x09, 0xd0, 0x1a, 0xaf, 0xf7, 0x0b, 0x44,
-	0xe1, 0xcd, 0x8c, 0x14, 0x03, 0xd4, 0x54, 0x00, 0xb7, 0x2c, 0x40, 0x58, 0x10, 0x06, 0xf7, 0x0d,
-	0xb9, 0xa5, 0xc9, 0x57, 0x4c, 0x0c, 0xf1, 0xaa, 0x33, 0x6a, 0xc3, 0x3a, 0x99, 0x8e, 0x78, 0x26,
-	0x49, 0x64, 0x6f, 0xb9, 0xa0, 0x57, 0x0f, 0xf3, 0x1a, 0xed, 0xc2, 0x26, 0x23, 0x53, 0x39, 0x58,
-	0x1d, 0x80, 0x46, 0x76, 0xdd, 0x05, 0xbd, 0xcd, 0xf0, 0xd6, 0x62, 0x23, 0x3f, 0xfb, 0xcb, 0xa8,
-	0x7b, 0x02, 0xd1, 0xfa, 0xa3, 0x42, 0x7d, 0xb8, 0x85, 0xa3, 0x28, 0x23, 0x42, 0x3f, 0xee, 0xed,
-	0xc0, 0xfe, 0xf1, 0x7d, 0xaf, 0x65, 0xe6, 0xe8, 0xa9, 0xde, 0x39, 0x92, 0x19, 0x65, 0x71, 0xb8,
-	0x14, 0xa2, 0x16, 0xac, 0xaa, 0xd7, 0x66, 0x6f, 0xb8, 0xa0, 0x57, 0x09, 0x75, 0x71, 0x50, 0xff,
-	0x7c, 0xd6, 0xb1, 0xfe, 0x9e, 0x75, 0xac, 0xe0, 0xc5, 0xf9, 0xcc, 0x01, 0x17, 0x33, 0x07, 0xfc,
-	0x9e, 0x39, 0xe0, 0xcb, 0xdc, 0xb1, 0x2e, 0xe6, 0x8e, 0xf5, 0x6b, 0xee, 0x58, 0x6f, 0xbd, 0x98,
-	0xca, 0x93, 0xf1, 0xd0, 0x3b, 0xe6, 0xa9, 0xaf, 0x6f, 0x6a, 0x2f, 0xc1, 0x43, 0x61, 0xfe, 0xfd,
-	0xe9, 0x6a, 0x2a, 0xe5, 0xe9, 0x88, 0x88, 0x61, 0x4d, 0x4d, 0xe4, 0xe3, 0xff, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0xa2, 0x53, 0x0f, 0xd2, 0x2f, 0x04, 0x00, 0x00,
+	// 668 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0x9b, 0xfd, 0xed, 0x5c, 0x10, 0x9a, 0xe9, 0x50, 0x56, 0x58, 0x1a, 0x2a, 0x01, 0x65,
+	0xd2, 0x12, 0xad, 0xdc, 0x26, 0x21, 0x41, 0x40, 0x62, 0x13, 0x1c, 0xa6, 0x0c, 0x38, 0x20, 0xa1,
+	0xc8, 0x6d, 0xac, 0xd4, 0x6a, 0x6b, 0x57, 0xb6, 0x3b, 0x56, 0xee, 0x08, 0xb8, 0xf1, 0x11, 0x76,
+	0x42, 0x1c, 0x39, 0xf0, 0x21, 0x26, 0x71, 0x99, 0x38, 0x71, 0x9a, 0xd0, 0x76, 0x80, 0x33, 0x9f,
+	0x00, 0xc5, 0x76, 0xdb, 0xb0, 0x06, 0x2e, 0x51, 0x1c, 0x3f, 0xcf, 0xf3, 0x7b, 0x5f, 0x47, 0x7e,
+	0x81, 0x4b, 0x28, 0x91, 0x04, 0xf9, 0x3d, 0x21, 0x51, 0x87, 0xd0, 0xc4, 0xdf, 0xdf, 0xf4, 0x13,
+	0x4c, 0xb1, 0x20, 0xc2, 0xeb, 0x73, 0x26, 0x19, 0x84, 0x5a, 0xe1, 0x8d, 0x14, 0xde, 0xfe, 0x66,
+	0x65, 0x19, 0xf5, 0x08, 0x65, 0xbe, 0x7a, 0x6a, 0x59, 0x65, 0xb5, 0xc5, 0x44, 0x8f, 0x89, 0x48,
+	0xad, 0x7c, 0xbd, 0x30, 0x5b, 0xe5, 0x84, 0x25, 0x4c, 0x7f, 0x4f, 0xdf, 0xcc, 0xd7, 0x3c, 0xf2,
+	0x08, 0xa1, 0x14, 0xb5, 0xaf, 0x45, 0x70, 0xe1, 0x91, 0xae, 0x65, 0x4f, 0x22, 0x89, 0xe1, 0x5d,
+	0xb0, 0xd0, 0x47, 0x1c, 0xf5, 0x84, 0x6d, 0xb9, 0x56, 0xbd, 0xd4, 0xa8, 0x78, 0xd3, 0xb5, 0x79,
+	0xbb, 0x4a, 0x11, 0x2c, 0x1d, 0x9d, 0x54, 0x0b, 0x9f, 0x7e, 0x7e, 0x5e, 0xb7, 0x42, 0x63, 0x82,
+	0x6f, 0x2c, 0xb0, 0xd2, 0x45, 0x42, 0x46, 0xfb, 0xa8, 0x4b, 0x62, 0x24, 0x19, 0x8f, 0xfa, 0xec,
+	0x15, 0xe6, 0xc2, 0x9e, 0x71, 0x67, 0xeb, 0xa5, 0xc6, 0xcd, 0xbc, 0xb8, 0x27, 0x48, 0xc8, 0xe7,
+	0x23, 0xfd, 0x6e, 0x2a, 0x0f, 0x6e, 0xa7, 0xd1, 0xbf, 0x4f, 0xaa, 0xd7, 0x86, 0xa8, 0xd7, 0xdd,
+	0xaa, 0xe5, 0x46, 0xd6, 0x34, 0xfa, 0x72, 0x77, 0xca, 0x2e, 0xe0, 0x4b, 0x00, 0xc6, 0x72, 0x61,
+	0xcf, 0x2a, 0xf6, 0x5a, 0x1e, 0x7b, 0x6c, 0x0c, 0x1c, 0x83, 0x5c, 0xd6, 0xc8, 0x89, 0xdd, 0x70,
+	0x32, 0x81, 0x10, 0x81, 0x52, 0x8c, 0xbb, 0x38, 0x41, 0x92, 0x30, 0x2a, 0xec, 0x39, 0x95, 0xef,
+	0xe4, 0xe5, 0x3f, 0x1c, 0xcb, 0x82, 0xaa, 0x01, 0x40, 0x0d, 0xc8, 0x04, 0x18, 0x42, 0x36, 0x13,
+	0xbe, 0xb5, 0xc0, 0xca, 0x80, 0x36, 0x19, 0x8d, 0x09, 0x4d, 0xa2, 0x2c, 0x6d, 0x5e, 0xd1, 0x6e,
+	0xe5, 0xd1, 0x9e, 0x8d, 0x0c, 0x19, 0xec, 0xb9, 0xa3, 0xcc, 0xcd, 0x34, 0x05, 0x94, 0x07, 0xd3,
+	0x7e, 0x01, 0x09, 0xb8, 0xc8, 0x71, 0xb6, 0x80, 0x05, 0x55, 0x80, 0x9b, 0x57, 0x40, 0x98, 0x11,
+	0x06, 0xd7, 0x0d, 0xb9, 0xac, 0xc9, 0x1c, 0x4f, 0x13, 0xff, 0x4e, 0x86, 0x15, 0x50, 0xc4, 0x07,
+	0x7d, 0xc6, 0x25, 0x8e, 0xed, 0x45, 0xd7, 0xaa, 0x17, 0xc3, 0xf1, 0x1a, 0xae, 0x83, 0x65, 0x8a,
+	0x0f, 0x64, 0x34, 0x69, 0x80, 0xc4, 0x76, 0xd1, 0xb5, 0xea, 0x73, 0xe1, 0xa5, 0x74, 0x63, 0xdc,
+	0xfb, 0x4e, 0x0c, 0x3f, 0x5a, 0xc0, 0x69, 0x31, 0x2a, 0xa2, 0xfe, 0xa0, 0xd9, 0xc1, 0xc3, 0x88,
+	0x33, 0xa9, 0x08, 0x51, 0x9b, 0x08, 0xc9, 0x38, 0xc1, 0xc2, 0x5e, 0x52, 0x4d, 0x6c, 0xe4, 0x35,
+	0xf1, 0x80, 0x51, 0xb1, 0x3b, 0x68, 0x3e, 0xc6, 0xc3, 0xd0, 0xf8, 0xb6, 0x95, 0x6d, 0x18, 0x34,
+	0x4c, 0x47, 0x37, 0x74, 0x47, 0xff, 0x47, 0x98, 0x16, 0xaf, 0xb6, 0x74, 0x5c, 0xe7, 0x7c, 0x1c,
+	0xc1, 0x02, 0xbe, 0xb7, 0xc0, 0x15, 0xc9, 0x3a, 0x98, 0x92, 0xd7, 0x38, 0x12, 0x6d, 0xc4, 0x71,
+	0xc4, 0x71, 0x8b, 0xf1, 0x58, 0xd8, 0xe0, 0xdf, 0xbf, 0xf9, 0xa9, 0x71, 0xec, 0xa5, 0x86, 0x50,
+	0xe9, 0x83, 0x75, 0x53, 0xda, 0x9a, 0x2e, 0x2d, 0x3f, 0x74, 0xf4, 0x9f, 0xe5, 0x74, 0x80, 0x80,
+	0xf7, 0xc0, 0x9a, 0xba, 0x67, 0xb9, 0xd6, 0xf4, 0xb0, 0x4b, 0xea, 0xb0, 0x57, 0x53, 0x51, 0x4e,
+	0x05, 0x3b, 0x71, 0xad, 0x0d, 0xe0, 0xf4, 0x5d, 0x86, 0x0d, 0xb0, 0x88, 0xe2, 0x98, 0x63, 0xa1,
+	0x67, 0xca, 0x52, 0x60, 0x7f, 0xfb, 0xb2, 0x51, 0x36, 0xe3, 0xeb, 0xbe, 0xde, 0xd9, 0x93, 0x9c,
+	0xd0, 0x24, 0x1c, 0x09, 0x61, 0x19, 0xcc, 0xab, 0x4b, 0x6e, 0xcf, 0xb8, 0x56, 0x7d, 0x36, 0xd4,
+	0x8b, 0xad, 0xe2, 0xbb, 0xc3, 0x6a, 0xe1, 0xd7, 0x61, 0xb5, 0x10, 0x6c, 0x1f, 0x9d, 0x3a, 0xd6,
+	0xf1, 0xa9, 0x63, 0xfd, 0x38, 0x75, 0xac, 0x0f, 0x67, 0x4e, 0xe1, 0xf8, 0xcc, 0x29, 0x7c, 0x3f,
+	0x73, 0x0a, 0x2f, 0xbc, 0x84, 0xc8, 0xf6, 0xa0, 0xe9, 0xb5, 0x58, 0xcf, 0xd7, 0x47, 0xb7, 0xd1,
+	0x45, 0x4d, 0x61, 0xde, 0xfd, 0x83, 0xc9, 0x30, 0x94, 0xc3, 0x3e, 0x16, 0xcd, 0x05, 0x35, 0x08,
+	0xef, 0xfc, 0x19, 0x00, 0xeb, 0x29, 0x5b, 0xbd, 0xa6, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -240,6 +275,39 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LastTokenizeShareRecordId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastTokenizeShareRecordId))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.TokenizeShareRecords) > 0 {
+		for iNdEx := len(m.TokenizeShareRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenizeShareRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.ConsPubkeyRotationHistories) > 0 {
+		for iNdEx := len(m.ConsPubkeyRotationHistories) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConsPubkeyRotationHistories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.NextUnbondingId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.NextUnbondingId))
 		i--
@@ -428,6 +496,21 @@ func (m *GenesisState) Size() (n int) {
 	if m.NextUnbondingId != 0 {
 		n += 1 + sovGenesis(uint64(m.NextUnbondingId))
 	}
+	if len(m.ConsPubkeyRotationHistories) > 0 {
+		for _, e := range m.ConsPubkeyRotationHistories {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TokenizeShareRecords) > 0 {
+		for _, e := range m.TokenizeShareRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastTokenizeShareRecordId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastTokenizeShareRecordId))
+	}
 	return n
 }
 
@@ -724,6 +807,93 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsPubkeyRotationHistories", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsPubkeyRotationHistories = append(m.ConsPubkeyRotationHistories, ConsPubKeyRotationHistory{})
+			if err := m.ConsPubkeyRotationHistories[len(m.ConsPubkeyRotationHistories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenizeShareRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenizeShareRecords = append(m.TokenizeShareRecords, TokenizeShareRecord{})
+			if err := m.TokenizeShareRecords[len(m.TokenizeShareRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTokenizeShareRecordId", wireType)
+			}
+			m.LastTokenizeShareRecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastTokenizeShareRecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
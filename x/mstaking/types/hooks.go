@@ -5,6 +5,7 @@ import (
 
 	"cosmossdk.io/math"
 
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -119,6 +120,16 @@ func (h MultiStakingHooks) AfterUnbondingInitiated(ctx context.Context, id uint6
 	return nil
 }
 
+func (h MultiStakingHooks) AfterConsensusPubKeyUpdate(ctx context.Context, valAddr sdk.ValAddress, oldPubKey, newPubKey cryptotypes.PubKey, rotationFee sdk.Coins) error {
+	var err error
+	for i := range h {
+		if err = h[i].AfterConsensusPubKeyUpdate(ctx, valAddr, oldPubKey, newPubKey, rotationFee); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // combine multiple slashing hooks, all hook functions are run in array sequence
 var _ SlashingHooks = &MultiSlashingHooks{}
 
@@ -43,6 +43,15 @@ var (
 	HistoricalInfosPrefix = []byte{0x61} // prefix for the historical info
 
 	ParamsKey = []byte{0x71} // prefix for parameters for module x/staking
+
+	ConsPubKeyRotationHistoriesPrefix = []byte{0x81} // prefix for the consensus pubkey rotation histories, by validator operator
+	ConsPubKeyRotationQueuePrefix     = []byte{0x82} // prefix for the timestamps in consensus pubkey rotation queue
+	PendingConsPubKeyRotationsPrefix  = []byte{0x83} // prefix for the consensus pubkey rotations not yet applied to the validator set
+
+	TokenizeShareRecordsPrefix        = []byte{0x91} // prefix for the tokenize share records
+	TokenizeShareRecordsByOwnerPrefix = []byte{0x92} // prefix for each key to a tokenize share record, by owner
+	LastTokenizeShareRecordIdKey      = []byte{0x93} // key for the counter for the incrementing id for tokenize share records
+	LiquidSharesPrefix                = []byte{0x94} // prefix for the tokenized delegator shares, by validator operator and bond denom
 )
 
 // UnbondingType defines the type of unbonding operation
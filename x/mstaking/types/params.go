@@ -35,15 +35,35 @@ const (
 	DefaultMinVotingPower uint64 = 1_000_000
 )
 
+var (
+	// DefaultKeyRotationFeeAmount is the default amount charged for rotating a consensus pubkey
+	DefaultKeyRotationFeeAmount = math.NewInt(1_000_000)
+
+	// DefaultKeyRotationFee is the default fee charged for rotating a consensus pubkey
+	DefaultKeyRotationFee = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultKeyRotationFeeAmount))
+
+	// DefaultGlobalLiquidStakingCap is the default global liquid staking cap, which disables the cap
+	DefaultGlobalLiquidStakingCap = math.LegacyOneDec()
+
+	// DefaultValidatorLiquidStakingCap is the default validator liquid staking cap, which disables the cap
+	DefaultValidatorLiquidStakingCap = math.LegacyOneDec()
+)
+
 // NewParams creates a new Params instance
-func NewParams(unbondingTime time.Duration, maxValidators, maxEntries uint32, historicalEntries uint32, bondDenoms []string, minVotingPower uint64) Params {
+func NewParams(
+	unbondingTime time.Duration, maxValidators, maxEntries uint32, historicalEntries uint32, bondDenoms []string, minVotingPower uint64,
+	keyRotationFee sdk.Coins, globalLiquidStakingCap, validatorLiquidStakingCap math.LegacyDec,
+) Params {
 	return Params{
-		UnbondingTime:     unbondingTime,
-		MaxValidators:     maxValidators,
-		MaxEntries:        maxEntries,
-		HistoricalEntries: historicalEntries,
-		BondDenoms:        bondDenoms,
-		MinVotingPower:    minVotingPower,
+		UnbondingTime:             unbondingTime,
+		MaxValidators:             maxValidators,
+		MaxEntries:                maxEntries,
+		HistoricalEntries:         historicalEntries,
+		BondDenoms:                bondDenoms,
+		MinVotingPower:            minVotingPower,
+		KeyRotationFee:            keyRotationFee,
+		GlobalLiquidStakingCap:    globalLiquidStakingCap,
+		ValidatorLiquidStakingCap: validatorLiquidStakingCap,
 	}
 }
 
@@ -56,6 +76,9 @@ func DefaultParams() Params {
 		DefaultHistoricalEntries,
 		[]string{sdk.DefaultBondDenom},
 		DefaultMinVotingPower,
+		DefaultKeyRotationFee,
+		DefaultGlobalLiquidStakingCap,
+		DefaultValidatorLiquidStakingCap,
 	)
 }
 
@@ -111,6 +134,18 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateKeyRotationFee(p.KeyRotationFee); err != nil {
+		return err
+	}
+
+	if err := validateLiquidStakingCap(p.GlobalLiquidStakingCap); err != nil {
+		return fmt.Errorf("invalid global liquid staking cap: %w", err)
+	}
+
+	if err := validateLiquidStakingCap(p.ValidatorLiquidStakingCap); err != nil {
+		return fmt.Errorf("invalid validator liquid staking cap: %w", err)
+	}
+
 	return nil
 }
 
@@ -206,3 +241,29 @@ func validateMinVotingPower(i any) error {
 
 	return nil
 }
+
+func validateKeyRotationFee(i any) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := v.Validate(); err != nil {
+		return fmt.Errorf("invalid key rotation fee: %w", err)
+	}
+
+	return nil
+}
+
+func validateLiquidStakingCap(i any) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("liquid staking cap must be between 0 and 1: %s", v)
+	}
+
+	return nil
+}
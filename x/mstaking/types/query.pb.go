@@ -1514,6 +1514,149 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryTokenizeShareRecordRequest is request type for the
+// Query/TokenizeShareRecord RPC method.
+type QueryTokenizeShareRecordRequest struct {
+	// id defines the id of the record to query for.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryTokenizeShareRecordRequest) Reset()         { *m = QueryTokenizeShareRecordRequest{} }
+func (m *QueryTokenizeShareRecordRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTokenizeShareRecordRequest) ProtoMessage()    {}
+func (*QueryTokenizeShareRecordRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b07a5668550bc410, []int{30}
+}
+func (m *QueryTokenizeShareRecordRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTokenizeShareRecordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTokenizeShareRecordRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTokenizeShareRecordRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTokenizeShareRecordRequest.Merge(m, src)
+}
+func (m *QueryTokenizeShareRecordRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTokenizeShareRecordRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTokenizeShareRecordRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTokenizeShareRecordRequest proto.InternalMessageInfo
+
+func (m *QueryTokenizeShareRecordRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// QueryTokenizeShareRecordByDenomRequest is request type for the
+// Query/TokenizeShareRecordByDenom RPC method.
+type QueryTokenizeShareRecordByDenomRequest struct {
+	// denom defines the share token denom of the record to query for.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryTokenizeShareRecordByDenomRequest) Reset() {
+	*m = QueryTokenizeShareRecordByDenomRequest{}
+}
+func (m *QueryTokenizeShareRecordByDenomRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTokenizeShareRecordByDenomRequest) ProtoMessage()    {}
+func (*QueryTokenizeShareRecordByDenomRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b07a5668550bc410, []int{31}
+}
+func (m *QueryTokenizeShareRecordByDenomRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTokenizeShareRecordByDenomRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTokenizeShareRecordByDenomRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTokenizeShareRecordByDenomRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTokenizeShareRecordByDenomRequest.Merge(m, src)
+}
+func (m *QueryTokenizeShareRecordByDenomRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTokenizeShareRecordByDenomRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTokenizeShareRecordByDenomRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTokenizeShareRecordByDenomRequest proto.InternalMessageInfo
+
+func (m *QueryTokenizeShareRecordByDenomRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QueryTokenizeShareRecordResponse is response type for the
+// Query/TokenizeShareRecord and Query/TokenizeShareRecordByDenom RPC methods.
+type QueryTokenizeShareRecordResponse struct {
+	// record defines the tokenize share record.
+	Record TokenizeShareRecord `protobuf:"bytes,1,opt,name=record,proto3" json:"record"`
+}
+
+func (m *QueryTokenizeShareRecordResponse) Reset()         { *m = QueryTokenizeShareRecordResponse{} }
+func (m *QueryTokenizeShareRecordResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTokenizeShareRecordResponse) ProtoMessage()    {}
+func (*QueryTokenizeShareRecordResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b07a5668550bc410, []int{32}
+}
+func (m *QueryTokenizeShareRecordResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTokenizeShareRecordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTokenizeShareRecordResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTokenizeShareRecordResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTokenizeShareRecordResponse.Merge(m, src)
+}
+func (m *QueryTokenizeShareRecordResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTokenizeShareRecordResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTokenizeShareRecordResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTokenizeShareRecordResponse proto.InternalMessageInfo
+
+func (m *QueryTokenizeShareRecordResponse) GetRecord() TokenizeShareRecord {
+	if m != nil {
+		return m.Record
+	}
+	return TokenizeShareRecord{}
+}
+
 func init() {
 	proto.RegisterType((*QueryValidatorsRequest)(nil), "initia.mstaking.v1.QueryValidatorsRequest")
 	proto.RegisterType((*QueryValidatorsResponse)(nil), "initia.mstaking.v1.QueryValidatorsResponse")
@@ -1545,103 +1688,114 @@ func init() {
 	proto.RegisterType((*QueryPoolResponse)(nil), "initia.mstaking.v1.QueryPoolResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "initia.mstaking.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "initia.mstaking.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryTokenizeShareRecordRequest)(nil), "initia.mstaking.v1.QueryTokenizeShareRecordRequest")
+	proto.RegisterType((*QueryTokenizeShareRecordByDenomRequest)(nil), "initia.mstaking.v1.QueryTokenizeShareRecordByDenomRequest")
+	proto.RegisterType((*QueryTokenizeShareRecordResponse)(nil), "initia.mstaking.v1.QueryTokenizeShareRecordResponse")
 }
 
 func init() { proto.RegisterFile("initia/mstaking/v1/query.proto", fileDescriptor_b07a5668550bc410) }
 
 var fileDescriptor_b07a5668550bc410 = []byte{
-	// 1447 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0x4d, 0x6c, 0xdc, 0xd4,
-	0x13, 0xdf, 0x97, 0xe6, 0x9f, 0x3f, 0x9d, 0xaa, 0x55, 0x99, 0x4d, 0xcb, 0xd6, 0xb4, 0xbb, 0x5b,
-	0x43, 0xd3, 0x34, 0x6d, 0x6d, 0xb2, 0x2d, 0xa2, 0x2a, 0xb4, 0xa5, 0x69, 0x43, 0xca, 0x47, 0x49,
-	0xd8, 0x96, 0x20, 0x81, 0x44, 0xe4, 0x5d, 0x9b, 0xc5, 0xea, 0xc6, 0xde, 0xda, 0xde, 0x90, 0xa8,
-	0xca, 0x85, 0x13, 0x17, 0x24, 0x10, 0x27, 0xe0, 0xd2, 0x03, 0x02, 0xa9, 0x5c, 0x90, 0x38, 0x22,
-	0x24, 0x0e, 0x1c, 0x72, 0x00, 0xa9, 0x02, 0x21, 0x71, 0x6a, 0x51, 0xd2, 0x03, 0x12, 0x42, 0x42,
-	0x3d, 0x70, 0x46, 0xfb, 0xfc, 0xec, 0xb5, 0xe3, 0xb7, 0x5e, 0x7b, 0xd9, 0x25, 0x70, 0x6a, 0xfa,
-	0xfc, 0x66, 0xe6, 0xf7, 0x9b, 0xaf, 0x37, 0x93, 0x40, 0x5e, 0x37, 0x74, 0x47, 0x57, 0xe4, 0x45,
-	0xdb, 0x51, 0xae, 0xe9, 0x46, 0x4d, 0x5e, 0x9a, 0x94, 0xaf, 0x37, 0x35, 0x6b, 0x45, 0x6a, 0x58,
-	0xa6, 0x63, 0x22, 0xba, 0xdf, 0x25, 0xef, 0xbb, 0xb4, 0x34, 0x29, 0x4c, 0x54, 0x4d, 0x7b, 0xd1,
-	0xb4, 0xe5, 0x8a, 0x62, 0x6b, 0xee, 0x65, 0x79, 0x69, 0xb2, 0xa2, 0x39, 0xca, 0xa4, 0xdc, 0x50,
-	0x6a, 0xba, 0xa1, 0x38, 0xba, 0x69, 0xb8, 0xf2, 0x42, 0x3e, 0x78, 0xd7, 0xbb, 0x55, 0x35, 0x75,
-	0xef, 0xfb, 0x68, 0xcd, 0xac, 0x99, 0xf4, 0x47, 0xb9, 0xf5, 0x13, 0x3b, 0xdd, 0x5f, 0x33, 0xcd,
-	0x5a, 0x5d, 0x93, 0x95, 0x86, 0x2e, 0x2b, 0x86, 0x61, 0x3a, 0x54, 0xa5, 0xcd, 0xbe, 0x16, 0x39,
-	0x98, 0x3d, 0x78, 0xf4, 0x86, 0xb8, 0x0c, 0x7b, 0x5f, 0x6a, 0xe1, 0x9a, 0x57, 0xea, 0xba, 0xaa,
-	0x38, 0xa6, 0x65, 0x97, 0xb5, 0xeb, 0x4d, 0xcd, 0x76, 0x70, 0x2f, 0x8c, 0xd8, 0x8e, 0xe2, 0x34,
-	0xed, 0x1c, 0x29, 0x92, 0xf1, 0xed, 0x65, 0xf6, 0x3f, 0x7c, 0x06, 0xa0, 0x8d, 0x3d, 0x37, 0x54,
-	0x24, 0xe3, 0x3b, 0x4a, 0x63, 0x92, 0x0b, 0x5e, 0x6a, 0x81, 0x97, 0x5c, 0xaf, 0x30, 0x0a, 0xd2,
-	0x9c, 0x52, 0xd3, 0x98, 0xce, 0x72, 0x40, 0x52, 0xfc, 0x8c, 0xc0, 0x43, 0x11, 0xd3, 0x76, 0xc3,
-	0x34, 0x6c, 0x0d, 0x2f, 0x00, 0x2c, 0xf9, 0xa7, 0x39, 0x52, 0xdc, 0x36, 0xbe, 0xa3, 0x74, 0x40,
-	0x8a, 0x3a, 0x58, 0xf2, 0x65, 0xa7, 0x86, 0xd7, 0xee, 0x14, 0x32, 0xe5, 0x80, 0x18, 0xce, 0x70,
-	0x80, 0x1e, 0xee, 0x0a, 0xd4, 0x45, 0x10, 0x42, 0x7a, 0x16, 0xf6, 0x84, 0x81, 0x7a, 0x2e, 0x3a,
-	0x04, 0xbb, 0x7c, 0x7b, 0x0b, 0x8a, 0xaa, 0x5a, 0xcc, 0x55, 0x3b, 0xfd, 0xd3, 0xf3, 0xaa, 0x6a,
-	0x89, 0xaf, 0x6d, 0xf6, 0xb1, 0xcf, 0xf3, 0x3c, 0x6c, 0xf7, 0xaf, 0x52, 0xd9, 0x84, 0x34, 0xdb,
-	0x52, 0xe2, 0xfb, 0x04, 0x8a, 0x61, 0xed, 0x17, 0xb5, 0xba, 0x56, 0x73, 0xd3, 0x20, 0x1d, 0xd0,
-	0xbe, 0x85, 0xf6, 0x1e, 0x81, 0x83, 0x31, 0x98, 0x18, 0xf9, 0x25, 0x18, 0x55, 0xfd, 0xe3, 0x05,
-	0x8b, 0x1d, 0x7b, 0xe1, 0x1e, 0xe3, 0xf9, 0xa1, 0xad, 0xc6, 0xd3, 0x32, 0xf5, 0x70, 0xcb, 0x21,
-	0xb7, 0xee, 0x16, 0xb2, 0xd1, 0x6f, 0x76, 0x39, 0xab, 0x46, 0x0f, 0xfb, 0x97, 0x17, 0x1f, 0x11,
-	0x38, 0x12, 0xa6, 0xf9, 0xb2, 0x51, 0x31, 0x0d, 0x55, 0x37, 0x6a, 0x5b, 0x1f, 0x83, 0x9f, 0x08,
-	0x4c, 0x24, 0x01, 0xc7, 0x82, 0xf1, 0x3a, 0x64, 0x9b, 0xde, 0xf7, 0x48, 0x2c, 0x0e, 0xf3, 0x62,
-	0xc1, 0x51, 0xc7, 0xb2, 0x13, 0x7d, 0x4d, 0x03, 0x70, 0x7a, 0x83, 0x15, 0x53, 0x30, 0xdc, 0xbe,
-	0x83, 0x59, 0xb8, 0x37, 0x39, 0xd8, 0x3f, 0xa5, 0x0e, 0x8e, 0xc6, 0x61, 0x88, 0x13, 0x87, 0xd3,
-	0x0f, 0xbc, 0x73, 0xb3, 0x90, 0xf9, 0xf5, 0x66, 0x21, 0x23, 0x5a, 0xac, 0x4f, 0x45, 0x13, 0x0c,
-	0x5f, 0x81, 0x2c, 0x27, 0x85, 0x59, 0x25, 0x27, 0xcc, 0xe0, 0x32, 0x46, 0x93, 0x54, 0x5c, 0x81,
-	0x02, 0xb5, 0xc9, 0x71, 0xf2, 0xa0, 0xe9, 0xea, 0xac, 0x9f, 0x70, 0x4d, 0x33, 0xde, 0xd3, 0x30,
-	0xe2, 0xc6, 0x98, 0x51, 0x4d, 0x99, 0x20, 0x4c, 0x58, 0xfc, 0xd2, 0xeb, 0x5d, 0x17, 0x3d, 0xc8,
-	0xfc, 0xba, 0x49, 0xc2, 0xb3, 0x4f, 0x75, 0x13, 0x78, 0xf6, 0xb6, 0x05, 0x9f, 0xbd, 0x80, 0x83,
-	0xbe, 0xf7, 0xba, 0x1b, 0x1f, 0x35, 0x73, 0xd1, 0x42, 0x5f, 0xba, 0x9b, 0xeb, 0xaf, 0xc1, 0xb6,
-	0xb1, 0x4f, 0xbc, 0x36, 0xe6, 0xf3, 0xe9, 0xd2, 0xc6, 0xfe, 0xc1, 0x70, 0x04, 0xdc, 0xee, 0x37,
-	0xb4, 0x2e, 0x30, 0xff, 0x6b, 0x0d, 0xed, 0x0f, 0x02, 0xfb, 0x28, 0xaf, 0xb2, 0xa6, 0xf6, 0xec,
-	0xee, 0x63, 0x80, 0xb6, 0x55, 0x5d, 0xe0, 0x56, 0xfa, 0x6e, 0xdb, 0xaa, 0xce, 0x87, 0xde, 0x98,
-	0x63, 0x80, 0xaa, 0xed, 0x6c, 0xbe, 0xed, 0xe6, 0xfb, 0x6e, 0xd5, 0x76, 0xe6, 0x63, 0x5e, 0xa4,
-	0xe1, 0x3e, 0x84, 0xf2, 0x3b, 0x02, 0x02, 0x8f, 0x32, 0x0b, 0x9d, 0x06, 0x7b, 0x2d, 0x2d, 0xa6,
-	0x78, 0xc6, 0x79, 0xd1, 0x0b, 0xaa, 0xda, 0x54, 0x3e, 0x7b, 0x2c, 0x6d, 0xd0, 0x73, 0x40, 0x21,
-	0x9c, 0x99, 0xd1, 0x69, 0x7a, 0xcb, 0xca, 0xe6, 0x8b, 0x48, 0x8f, 0xfd, 0xd7, 0xcf, 0xdb, 0xcb,
-	0x90, 0xef, 0x80, 0x78, 0xd0, 0x6f, 0x9f, 0xda, 0x31, 0x90, 0xfd, 0x1c, 0xd9, 0xeb, 0x70, 0x34,
-	0x6c, 0xe5, 0xaa, 0xe9, 0x28, 0xf5, 0x40, 0xd7, 0x51, 0xea, 0x8a, 0x51, 0xd5, 0x52, 0x92, 0x6d,
-	0x3f, 0x5c, 0x43, 0xc1, 0x87, 0xab, 0xb5, 0x67, 0x1d, 0x4b, 0x66, 0x8e, 0x31, 0x7c, 0x0b, 0xfe,
-	0x5f, 0x71, 0x8f, 0x58, 0x26, 0xec, 0x0b, 0x05, 0xd1, 0x0b, 0xdf, 0x05, 0x53, 0x37, 0xa6, 0xa6,
-	0x5a, 0xdc, 0xee, 0xdf, 0x29, 0xec, 0x5a, 0x51, 0x16, 0xeb, 0xa7, 0x45, 0x26, 0x27, 0xde, 0xba,
-	0x5b, 0x18, 0xaf, 0xe9, 0xce, 0x9b, 0xcd, 0x8a, 0x54, 0x35, 0x17, 0x65, 0xb6, 0xd9, 0xba, 0xff,
-	0x1c, 0xb7, 0xd5, 0x6b, 0xb2, 0xb3, 0xd2, 0xd0, 0x6c, 0xaa, 0xc2, 0x2e, 0x7b, 0xd6, 0xc4, 0x2b,
-	0x9b, 0x1b, 0x3c, 0x05, 0xea, 0xb7, 0xe5, 0x9e, 0xdc, 0x22, 0x7e, 0x4a, 0xb8, 0xde, 0x8e, 0x6a,
-	0xdd, 0x6a, 0xf6, 0x08, 0xbb, 0x29, 0xce, 0x39, 0xd3, 0xac, 0x33, 0x8e, 0xe2, 0x0c, 0x3c, 0x18,
-	0x38, 0x63, 0x08, 0x4b, 0x30, 0xdc, 0x30, 0xcd, 0x3a, 0x4b, 0xbe, 0x1c, 0x2f, 0xf9, 0x5a, 0xf7,
-	0x59, 0xde, 0xd1, 0xbb, 0xe2, 0x28, 0xa0, 0xab, 0x48, 0xb1, 0x94, 0x45, 0xaf, 0x29, 0x89, 0xb3,
-	0x90, 0x0d, 0x9d, 0x32, 0x03, 0xa7, 0x60, 0xa4, 0x41, 0x4f, 0x98, 0x09, 0x81, 0x6b, 0x82, 0xde,
-	0xf0, 0x06, 0x3a, 0xf7, 0x7e, 0xe9, 0xf3, 0x1c, 0xfc, 0x8f, 0x6a, 0xc4, 0x77, 0x09, 0x40, 0xbb,
-	0xd1, 0xe0, 0x04, 0x4f, 0x05, 0xff, 0x17, 0x0f, 0xc2, 0xd1, 0x44, 0x77, 0xd9, 0xa0, 0x3c, 0xf6,
-	0xf6, 0x8f, 0xf7, 0x3e, 0x18, 0x2a, 0x62, 0x5e, 0xe6, 0xfc, 0xaa, 0x23, 0xd0, 0x9c, 0x3e, 0x26,
-	0xb0, 0xdd, 0x17, 0xc7, 0x23, 0xdd, 0x4d, 0x78, 0x68, 0x26, 0x92, 0x5c, 0x65, 0x60, 0x9e, 0xa0,
-	0x60, 0x26, 0x51, 0x8e, 0x07, 0x23, 0xdf, 0x08, 0x77, 0xa5, 0x55, 0x5c, 0x23, 0x30, 0xca, 0xdb,
-	0x95, 0xf1, 0x64, 0x77, 0xeb, 0xd1, 0x19, 0x4d, 0x78, 0x3c, 0xa5, 0x14, 0x83, 0x7f, 0x81, 0xc2,
-	0x3f, 0x83, 0x4f, 0xa6, 0x84, 0x2f, 0x07, 0x1e, 0x71, 0xfc, 0x9d, 0xc0, 0x81, 0xd8, 0x95, 0x13,
-	0xcf, 0x74, 0x47, 0x17, 0x33, 0x80, 0x0a, 0x67, 0x7b, 0x15, 0x67, 0x2c, 0x2f, 0x53, 0x96, 0x33,
-	0x38, 0x9d, 0x96, 0x65, 0x7b, 0x9c, 0x0c, 0xf2, 0xfd, 0x8a, 0x00, 0xb4, 0xcd, 0xc4, 0x24, 0x7a,
-	0x64, 0x83, 0x8b, 0x49, 0xf4, 0xe8, 0x26, 0x20, 0x5e, 0xa5, 0xb0, 0x5f, 0xc4, 0x17, 0xfe, 0x46,
-	0x70, 0xe4, 0x1b, 0xe1, 0x8e, 0xb9, 0x8a, 0xbf, 0x11, 0xc8, 0x72, 0xbc, 0x85, 0x27, 0x3a, 0x42,
-	0xeb, 0xbc, 0x91, 0x0a, 0x27, 0xd3, 0x09, 0x31, 0x62, 0x3a, 0x25, 0x56, 0x45, 0xa5, 0x9f, 0xc4,
-	0xb8, 0xc1, 0xc2, 0xaf, 0x09, 0x8c, 0xf2, 0x96, 0xb6, 0x98, 0x32, 0x8b, 0xd9, 0x4c, 0x63, 0xca,
-	0x2c, 0x6e, 0x33, 0x14, 0x4f, 0x51, 0xc2, 0x25, 0x7c, 0x8c, 0x47, 0x38, 0x36, 0x5a, 0xad, 0xda,
-	0x8a, 0xdd, 0x7e, 0x62, 0x6a, 0x2b, 0xc9, 0x72, 0x17, 0x53, 0x5b, 0x89, 0x96, 0xae, 0xf8, 0xda,
-	0xf2, 0xd9, 0x24, 0x0c, 0x97, 0x8d, 0xdf, 0x10, 0xc8, 0x85, 0x56, 0x84, 0xd9, 0x37, 0x7c, 0x1c,
-	0x78, 0xbc, 0x23, 0x56, 0xde, 0x22, 0x25, 0x48, 0x49, 0xaf, 0x33, 0x2a, 0xd3, 0x94, 0xca, 0x39,
-	0x3c, 0x93, 0x96, 0x4a, 0x70, 0xd9, 0xb0, 0xf1, 0x5b, 0x02, 0x59, 0xce, 0xe4, 0x1d, 0x53, 0x60,
-	0x9d, 0x97, 0x08, 0xe1, 0x64, 0x3a, 0x21, 0xc6, 0x64, 0x8a, 0x32, 0x79, 0x0a, 0x4f, 0xa7, 0x65,
-	0x12, 0x78, 0x3e, 0x7f, 0x20, 0x80, 0x51, 0x1b, 0x58, 0x4a, 0x01, 0xc8, 0x23, 0x71, 0x22, 0x95,
-	0x0c, 0xe3, 0x70, 0x85, 0x72, 0xb8, 0x8c, 0xcf, 0xf7, 0xce, 0x21, 0xfa, 0xea, 0xfe, 0x49, 0xa0,
-	0xd0, 0x65, 0x28, 0xc6, 0x73, 0xdd, 0xd1, 0xc6, 0x4e, 0xef, 0xc2, 0xd3, 0xbd, 0x2b, 0x60, 0xdc,
-	0xe7, 0x28, 0xf7, 0xe7, 0xf0, 0x52, 0x5a, 0xee, 0x4e, 0x4b, 0x6f, 0xa0, 0xa0, 0x16, 0xd8, 0xa8,
-	0x89, 0xf7, 0x09, 0xe4, 0xe3, 0xc7, 0x61, 0x3c, 0x9b, 0x10, 0x76, 0x87, 0xe9, 0x5c, 0x38, 0xd7,
-	0xb3, 0x3c, 0x63, 0x3d, 0x4b, 0x59, 0x3f, 0x8b, 0x33, 0xbd, 0xb1, 0x6e, 0x37, 0x14, 0x8f, 0xf4,
-	0x87, 0x04, 0x76, 0x86, 0x4a, 0x7d, 0xd0, 0x1d, 0xe4, 0x08, 0x65, 0xf0, 0x08, 0x1e, 0xe4, 0x31,
-	0x08, 0x77, 0x89, 0x26, 0x0c, 0xb7, 0x46, 0x76, 0x7c, 0xb4, 0xa3, 0x89, 0xc0, 0x56, 0x20, 0x1c,
-	0xea, 0x72, 0x8b, 0xd9, 0x2f, 0x52, 0xfb, 0x02, 0xe6, 0x78, 0xf6, 0x5b, 0x5b, 0x01, 0xae, 0xc2,
-	0x88, 0x3b, 0xc6, 0xe3, 0x58, 0x67, 0x95, 0xc1, 0x8d, 0x41, 0x38, 0xdc, 0xf5, 0x1e, 0x33, 0x2e,
-	0x52, 0xe3, 0xfb, 0x51, 0xe0, 0x1a, 0x77, 0x77, 0x87, 0x4b, 0x6b, 0xeb, 0x79, 0x72, 0x7b, 0x3d,
-	0x4f, 0x7e, 0x59, 0xcf, 0x93, 0xf7, 0x36, 0xf2, 0x99, 0xdb, 0x1b, 0xf9, 0xcc, 0xcf, 0x1b, 0xf9,
-	0xcc, 0xab, 0x52, 0x60, 0x7d, 0x72, 0xe5, 0x8f, 0xd7, 0x95, 0x8a, 0xed, 0xe9, 0x5a, 0x6e, 0x6b,
-	0xa3, 0xab, 0x54, 0x65, 0x84, 0xfe, 0x31, 0xf3, 0xc4, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x64,
-	0x55, 0x70, 0x17, 0xa4, 0x1d, 0x00, 0x00,
+	// 1576 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0x4f, 0x6c, 0x14, 0x65,
+	0x14, 0xef, 0x57, 0x4a, 0x95, 0x47, 0x20, 0xf8, 0xb6, 0x40, 0x19, 0x60, 0x77, 0x19, 0xa5, 0x94,
+	0x16, 0x76, 0xec, 0x52, 0x23, 0xa9, 0x52, 0x64, 0x01, 0x8b, 0x7f, 0x10, 0x5c, 0x10, 0x13, 0x4d,
+	0xdc, 0xcc, 0xee, 0x8c, 0xcb, 0x84, 0xed, 0xcc, 0x32, 0x33, 0xad, 0x54, 0xd2, 0x8b, 0x27, 0x2f,
+	0x26, 0x1a, 0x4f, 0xea, 0x85, 0x83, 0xd1, 0x84, 0x93, 0x89, 0x5e, 0x8c, 0x31, 0xf1, 0xe0, 0x81,
+	0x18, 0x4d, 0x88, 0xc6, 0xc4, 0x13, 0x18, 0xe0, 0x60, 0x62, 0x4c, 0x0c, 0x07, 0xcf, 0x66, 0xbe,
+	0xf9, 0x66, 0x76, 0xa6, 0xf3, 0xcd, 0xec, 0xcc, 0xba, 0xb5, 0x7a, 0xa2, 0xfd, 0xe6, 0xfd, 0xf9,
+	0xfd, 0xde, 0xfb, 0xde, 0xfb, 0xde, 0x2b, 0x90, 0xd7, 0x74, 0xcd, 0xd6, 0x64, 0x69, 0xde, 0xb2,
+	0xe5, 0x4b, 0x9a, 0xde, 0x94, 0x16, 0xa7, 0xa4, 0xcb, 0x0b, 0xaa, 0xb9, 0x54, 0x6a, 0x9b, 0x86,
+	0x6d, 0x20, 0xba, 0xdf, 0x4b, 0xde, 0xf7, 0xd2, 0xe2, 0x94, 0x30, 0xd1, 0x30, 0xac, 0x79, 0xc3,
+	0x92, 0xea, 0xb2, 0xa5, 0xba, 0xc2, 0xd2, 0xe2, 0x54, 0x5d, 0xb5, 0xe5, 0x29, 0xa9, 0x2d, 0x37,
+	0x35, 0x5d, 0xb6, 0x35, 0x43, 0x77, 0xf5, 0x85, 0x7c, 0x50, 0xd6, 0x93, 0x6a, 0x18, 0x9a, 0xf7,
+	0x7d, 0xa4, 0x69, 0x34, 0x0d, 0xfa, 0xa3, 0xe4, 0xfc, 0xc4, 0x4e, 0x77, 0x35, 0x0d, 0xa3, 0xd9,
+	0x52, 0x25, 0xb9, 0xad, 0x49, 0xb2, 0xae, 0x1b, 0x36, 0x35, 0x69, 0xb1, 0xaf, 0x45, 0x0e, 0x66,
+	0x0f, 0x1e, 0x95, 0x10, 0xaf, 0xc0, 0xb6, 0x17, 0x1d, 0x5c, 0x17, 0xe4, 0x96, 0xa6, 0xc8, 0xb6,
+	0x61, 0x5a, 0x55, 0xf5, 0xf2, 0x82, 0x6a, 0xd9, 0xb8, 0x0d, 0x86, 0x2d, 0x5b, 0xb6, 0x17, 0xac,
+	0x51, 0x52, 0x24, 0xe3, 0x1b, 0xaa, 0xec, 0x37, 0x7c, 0x1a, 0xa0, 0x83, 0x7d, 0x74, 0xb0, 0x48,
+	0xc6, 0x37, 0x96, 0xc7, 0x4a, 0x2e, 0xf8, 0x92, 0x03, 0xbe, 0xe4, 0x46, 0x85, 0x51, 0x28, 0x9d,
+	0x95, 0x9b, 0x2a, 0xb3, 0x59, 0x0d, 0x68, 0x8a, 0x9f, 0x12, 0xd8, 0x1e, 0x71, 0x6d, 0xb5, 0x0d,
+	0xdd, 0x52, 0xf1, 0x38, 0xc0, 0xa2, 0x7f, 0x3a, 0x4a, 0x8a, 0xeb, 0xc6, 0x37, 0x96, 0x77, 0x97,
+	0xa2, 0x01, 0x2e, 0xf9, 0xba, 0x95, 0xa1, 0x1b, 0xb7, 0x0a, 0x03, 0xd5, 0x80, 0x1a, 0xce, 0x71,
+	0x80, 0xee, 0xeb, 0x0a, 0xd4, 0x45, 0x10, 0x42, 0x3a, 0x0b, 0x5b, 0xc3, 0x40, 0xbd, 0x10, 0xed,
+	0x85, 0xcd, 0xbe, 0xbf, 0x9a, 0xac, 0x28, 0x26, 0x0b, 0xd5, 0x26, 0xff, 0xf4, 0x98, 0xa2, 0x98,
+	0xe2, 0xab, 0x2b, 0x63, 0xec, 0xf3, 0x3c, 0x06, 0x1b, 0x7c, 0x51, 0xaa, 0x9b, 0x92, 0x66, 0x47,
+	0x4b, 0x7c, 0x8f, 0x40, 0x31, 0x6c, 0xfd, 0x84, 0xda, 0x52, 0x9b, 0xee, 0x35, 0xc8, 0x06, 0xb4,
+	0x6f, 0xa9, 0xbd, 0x47, 0x60, 0x4f, 0x02, 0x26, 0x46, 0x7e, 0x11, 0x46, 0x14, 0xff, 0xb8, 0x66,
+	0xb2, 0x63, 0x2f, 0xdd, 0x63, 0xbc, 0x38, 0x74, 0xcc, 0x78, 0x56, 0x2a, 0x3b, 0x9d, 0x80, 0x5c,
+	0xbf, 0x5d, 0xc8, 0x45, 0xbf, 0x59, 0xd5, 0x9c, 0x12, 0x3d, 0xec, 0xdf, 0xbd, 0xf8, 0x90, 0xc0,
+	0xfe, 0x30, 0xcd, 0x97, 0xf4, 0xba, 0xa1, 0x2b, 0x9a, 0xde, 0x5c, 0xfb, 0x1c, 0xfc, 0x4c, 0x60,
+	0x22, 0x0d, 0x38, 0x96, 0x8c, 0xd7, 0x20, 0xb7, 0xe0, 0x7d, 0x8f, 0xe4, 0x62, 0x1f, 0x2f, 0x17,
+	0x1c, 0x73, 0xec, 0x76, 0xa2, 0x6f, 0x69, 0x15, 0x82, 0xde, 0x66, 0xc5, 0x14, 0x4c, 0xb7, 0x1f,
+	0x60, 0x96, 0xee, 0x15, 0x01, 0xf6, 0x4f, 0x69, 0x80, 0xa3, 0x79, 0x18, 0xe4, 0xe4, 0x61, 0xe6,
+	0xc1, 0xb7, 0xaf, 0x15, 0x06, 0x7e, 0xbb, 0x56, 0x18, 0x10, 0x4d, 0xd8, 0x1e, 0xf1, 0xc8, 0xa2,
+	0xf6, 0x32, 0xe4, 0x38, 0x57, 0x98, 0x55, 0x72, 0xca, 0x1b, 0x5c, 0xc5, 0xe8, 0x25, 0x15, 0x97,
+	0xa0, 0x40, 0x7d, 0x72, 0x82, 0xbc, 0xda, 0x74, 0x35, 0x28, 0xc6, 0xbb, 0x66, 0xbc, 0x4f, 0xc2,
+	0xb0, 0x9b, 0x63, 0x46, 0x35, 0xe3, 0x05, 0x61, 0xca, 0xe2, 0xe7, 0x5e, 0xef, 0x3a, 0xe1, 0x41,
+	0xe6, 0xd7, 0x4d, 0x1a, 0x9e, 0x7d, 0xaa, 0x9b, 0xc0, 0xb3, 0xb7, 0x2e, 0xf8, 0xec, 0x05, 0x02,
+	0xf4, 0x83, 0xd7, 0xdd, 0xf8, 0xa8, 0x59, 0x88, 0x6a, 0x7d, 0xe9, 0x6e, 0x6e, 0xbc, 0x56, 0xb7,
+	0x8d, 0x7d, 0xec, 0xb5, 0x31, 0x9f, 0x4f, 0x97, 0x36, 0xf6, 0x2f, 0xa6, 0x23, 0x10, 0x76, 0xbf,
+	0xa1, 0x75, 0x81, 0xf9, 0x7f, 0x6b, 0x68, 0x7f, 0x12, 0xd8, 0x41, 0x79, 0x55, 0x55, 0xa5, 0xe7,
+	0x70, 0x1f, 0x00, 0xb4, 0xcc, 0x46, 0x8d, 0x5b, 0xe9, 0x5b, 0x2c, 0xb3, 0x71, 0x21, 0xf4, 0xc6,
+	0x1c, 0x00, 0x54, 0x2c, 0x7b, 0xa5, 0xb4, 0x7b, 0xdf, 0xb7, 0x28, 0x96, 0x7d, 0x21, 0xe1, 0x45,
+	0x1a, 0xea, 0x43, 0x2a, 0xbf, 0x27, 0x20, 0xf0, 0x28, 0xb3, 0xd4, 0xa9, 0xb0, 0xcd, 0x54, 0x13,
+	0x8a, 0x67, 0x9c, 0x97, 0xbd, 0xa0, 0xa9, 0x15, 0xe5, 0xb3, 0xd5, 0x54, 0x57, 0x7b, 0x0e, 0x28,
+	0x84, 0x6f, 0x66, 0x74, 0x9a, 0x5e, 0xb3, 0xb2, 0xf9, 0x2c, 0xd2, 0x63, 0xff, 0xf3, 0xf3, 0xf6,
+	0x15, 0xc8, 0xc7, 0x20, 0x5e, 0xed, 0xb7, 0x4f, 0x89, 0x4d, 0x64, 0x3f, 0x47, 0xf6, 0x16, 0x4c,
+	0x86, 0xbd, 0x9c, 0x37, 0x6c, 0xb9, 0x15, 0xe8, 0x3a, 0x72, 0x4b, 0xd6, 0x1b, 0x6a, 0x46, 0xb2,
+	0x9d, 0x87, 0x6b, 0x30, 0xf8, 0x70, 0x39, 0x7b, 0xd6, 0x81, 0x74, 0xee, 0x18, 0xc3, 0x37, 0xe0,
+	0x81, 0xba, 0x7b, 0xc4, 0x6e, 0xc2, 0x8e, 0x50, 0x12, 0xbd, 0xf4, 0x1d, 0x37, 0x34, 0xbd, 0x52,
+	0x71, 0xb8, 0xdd, 0xbf, 0x55, 0xd8, 0xbc, 0x24, 0xcf, 0xb7, 0x66, 0x44, 0xa6, 0x27, 0x5e, 0xbf,
+	0x5d, 0x18, 0x6f, 0x6a, 0xf6, 0xc5, 0x85, 0x7a, 0xa9, 0x61, 0xcc, 0x4b, 0xae, 0x3a, 0xfb, 0xe7,
+	0xa0, 0xa5, 0x5c, 0x92, 0xec, 0xa5, 0xb6, 0x6a, 0x51, 0x13, 0x56, 0xd5, 0xf3, 0x26, 0x9e, 0x83,
+	0x09, 0x0e, 0x50, 0xbf, 0x2d, 0xf7, 0x14, 0x16, 0xf1, 0x13, 0x02, 0x93, 0xa9, 0xac, 0xae, 0x35,
+	0x7b, 0x84, 0x2d, 0x14, 0xe7, 0x59, 0xc3, 0x68, 0x31, 0x8e, 0xe2, 0x1c, 0x3c, 0x14, 0x38, 0x63,
+	0x08, 0xcb, 0x30, 0xd4, 0x36, 0x8c, 0x16, 0xbb, 0x7c, 0xa3, 0xbc, 0xcb, 0xe7, 0xc8, 0xb3, 0x7b,
+	0x47, 0x65, 0xc5, 0x11, 0x40, 0xd7, 0x90, 0x6c, 0xca, 0xf3, 0x5e, 0x53, 0x12, 0xcf, 0x40, 0x2e,
+	0x74, 0xca, 0x1c, 0x1c, 0x86, 0xe1, 0x36, 0x3d, 0x61, 0x2e, 0x04, 0xae, 0x0b, 0x2a, 0xe1, 0x0d,
+	0x74, 0xae, 0xbc, 0x38, 0xc5, 0xea, 0xe7, 0xbc, 0x71, 0x49, 0xd5, 0xb5, 0x37, 0xd5, 0x73, 0x17,
+	0x65, 0x53, 0xad, 0xaa, 0x0d, 0xc3, 0x54, 0xbc, 0xb4, 0x6d, 0x86, 0x41, 0xcd, 0x1d, 0x1b, 0x87,
+	0xaa, 0x83, 0x9a, 0x22, 0xce, 0xc2, 0x58, 0x9c, 0x4a, 0x65, 0xe9, 0x84, 0xaa, 0x1b, 0xf3, 0x9e,
+	0xe6, 0x08, 0xac, 0x57, 0x9c, 0xdf, 0x59, 0x9e, 0xdd, 0x5f, 0xfc, 0x71, 0x95, 0xeb, 0xb2, 0x33,
+	0xae, 0x9a, 0xf4, 0x24, 0x69, 0x5c, 0xe5, 0x01, 0x60, 0xec, 0x5c, 0xe5, 0xf2, 0x17, 0x3b, 0x61,
+	0x3d, 0xf5, 0x85, 0xef, 0x10, 0x80, 0x4e, 0x1b, 0xc5, 0x09, 0x9e, 0x3d, 0xfe, 0x9f, 0x55, 0x84,
+	0xc9, 0x54, 0xb2, 0x6c, 0x0d, 0x18, 0x7b, 0xeb, 0xa7, 0x7b, 0xef, 0x0f, 0x16, 0x31, 0x2f, 0x71,
+	0xfe, 0x90, 0x13, 0x68, 0xbd, 0x1f, 0x11, 0xd8, 0xe0, 0xab, 0xe3, 0xfe, 0xee, 0x2e, 0x3c, 0x34,
+	0x13, 0x69, 0x44, 0x19, 0x98, 0xc7, 0x29, 0x98, 0x29, 0x94, 0x92, 0xc1, 0x48, 0x57, 0xc3, 0x3d,
+	0x77, 0x19, 0x6f, 0x10, 0x18, 0xe1, 0xfd, 0x25, 0x00, 0xa7, 0xbb, 0x7b, 0x8f, 0x4e, 0xa0, 0xc2,
+	0x63, 0x19, 0xb5, 0x18, 0xfc, 0xe3, 0x14, 0xfe, 0x11, 0x7c, 0x22, 0x23, 0x7c, 0x29, 0x30, 0xa2,
+	0xe0, 0x1f, 0x04, 0x76, 0x27, 0x2e, 0xd4, 0x78, 0xa4, 0x3b, 0xba, 0x84, 0xf1, 0x5a, 0x98, 0xed,
+	0x55, 0x9d, 0xb1, 0x3c, 0x4d, 0x59, 0xce, 0xe1, 0xc9, 0xac, 0x2c, 0x3b, 0xc3, 0x72, 0x90, 0xef,
+	0x57, 0x04, 0xa0, 0xe3, 0x26, 0xe1, 0xa2, 0x47, 0xf6, 0x53, 0x61, 0x32, 0x95, 0x2c, 0x83, 0x7d,
+	0x9e, 0xc2, 0x7e, 0x01, 0x9f, 0xff, 0x07, 0xc9, 0x91, 0xae, 0x86, 0xdf, 0x83, 0x65, 0xfc, 0x9d,
+	0x40, 0x8e, 0x13, 0x2d, 0x3c, 0x14, 0x0b, 0x2d, 0x7e, 0xdf, 0x16, 0xa6, 0xb3, 0x29, 0x31, 0x62,
+	0x1a, 0x25, 0xd6, 0x40, 0xb9, 0x9f, 0xc4, 0xb8, 0xc9, 0xc2, 0xaf, 0x09, 0x8c, 0xf0, 0x56, 0xd2,
+	0x84, 0x32, 0x4b, 0xd8, 0xbb, 0x13, 0xca, 0x2c, 0x69, 0xef, 0x15, 0x0f, 0x53, 0xc2, 0x65, 0x7c,
+	0x94, 0x47, 0x38, 0x31, 0x5b, 0x4e, 0x6d, 0x25, 0xee, 0x76, 0x09, 0xb5, 0x95, 0x66, 0x75, 0x4d,
+	0xa8, 0xad, 0x54, 0x2b, 0x65, 0x72, 0x6d, 0xf9, 0x6c, 0x52, 0xa6, 0xcb, 0xc2, 0x6f, 0x08, 0x8c,
+	0x86, 0x16, 0xa0, 0x33, 0xaf, 0xfb, 0x38, 0xf0, 0x60, 0x2c, 0x56, 0xde, 0x9a, 0x28, 0x94, 0xd2,
+	0x8a, 0x33, 0x2a, 0x27, 0x29, 0x95, 0xa3, 0x78, 0x24, 0x2b, 0x95, 0xe0, 0x2a, 0x65, 0xe1, 0xb7,
+	0x04, 0x72, 0x9c, 0xbd, 0x22, 0xa1, 0xc0, 0xe2, 0x57, 0x24, 0x61, 0x3a, 0x9b, 0x12, 0x63, 0x52,
+	0xa1, 0x4c, 0x9e, 0xc4, 0x99, 0xac, 0x4c, 0x02, 0xcf, 0xe7, 0x8f, 0x04, 0x30, 0xea, 0x03, 0xcb,
+	0x19, 0x00, 0x79, 0x24, 0x0e, 0x65, 0xd2, 0x61, 0x1c, 0xce, 0x51, 0x0e, 0xa7, 0xf1, 0xb9, 0xde,
+	0x39, 0x44, 0x5f, 0xdd, 0xbf, 0x08, 0x14, 0xba, 0x8c, 0xfc, 0x78, 0xb4, 0x3b, 0xda, 0xc4, 0xdd,
+	0x44, 0x78, 0xaa, 0x77, 0x03, 0x8c, 0xfb, 0x59, 0xca, 0xfd, 0x59, 0x3c, 0x95, 0x95, 0xbb, 0xed,
+	0xd8, 0x0d, 0x14, 0x54, 0x8d, 0x0d, 0xd2, 0x78, 0x9f, 0x40, 0x3e, 0x79, 0xd8, 0xc7, 0xd9, 0x94,
+	0xb0, 0x63, 0x76, 0x0f, 0xe1, 0x68, 0xcf, 0xfa, 0x8c, 0xf5, 0x19, 0xca, 0xfa, 0x19, 0x9c, 0xeb,
+	0x8d, 0x75, 0xa7, 0xa1, 0x78, 0xa4, 0x3f, 0x20, 0xb0, 0x29, 0x54, 0xea, 0xab, 0xdd, 0x41, 0xf6,
+	0x53, 0x06, 0x0f, 0xe3, 0x1e, 0x1e, 0x83, 0x70, 0x97, 0x58, 0x80, 0x21, 0x67, 0x21, 0xc1, 0x47,
+	0x62, 0x5d, 0x04, 0x76, 0x1e, 0x61, 0x6f, 0x17, 0x29, 0xe6, 0xbf, 0x48, 0xfd, 0x0b, 0x38, 0xca,
+	0xf3, 0xef, 0xec, 0x3c, 0xb8, 0x0c, 0xc3, 0xee, 0x92, 0x82, 0x63, 0xf1, 0x26, 0x83, 0xfb, 0x90,
+	0xb0, 0xaf, 0xab, 0x1c, 0x73, 0x2e, 0x52, 0xe7, 0xbb, 0x50, 0xe0, 0x3a, 0x77, 0x9d, 0x7e, 0x49,
+	0x20, 0xc7, 0xd9, 0x29, 0x12, 0x7a, 0x63, 0xfc, 0xd6, 0x24, 0x4c, 0x67, 0x53, 0x4a, 0x33, 0xb1,
+	0xdb, 0x4c, 0xb1, 0x66, 0x39, 0x9a, 0x35, 0x77, 0xc7, 0xb1, 0xa4, 0xab, 0x9a, 0xb2, 0x8c, 0xdf,
+	0x11, 0x10, 0xe2, 0x17, 0x32, 0x9c, 0xc9, 0x82, 0x26, 0xbc, 0xc5, 0xf5, 0xc8, 0x64, 0x86, 0x32,
+	0x99, 0xc6, 0x72, 0x6a, 0x26, 0xb5, 0xfa, 0x52, 0x8d, 0x6e, 0x88, 0x95, 0x53, 0x37, 0xee, 0xe4,
+	0xc9, 0xcd, 0x3b, 0x79, 0xf2, 0xeb, 0x9d, 0x3c, 0x79, 0xf7, 0x6e, 0x7e, 0xe0, 0xe6, 0xdd, 0xfc,
+	0xc0, 0x2f, 0x77, 0xf3, 0x03, 0xaf, 0x94, 0x02, 0x5b, 0xba, 0x6b, 0xf7, 0x60, 0x4b, 0xae, 0x5b,
+	0x9e, 0x8f, 0x2b, 0x1d, 0x2f, 0x74, 0x63, 0xaf, 0x0f, 0xd3, 0xff, 0x33, 0x3f, 0xf4, 0xf7, 0x00,
+	0x83, 0x4a, 0xa9, 0xe4, 0x0b, 0x20, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1694,6 +1848,10 @@ type QueryClient interface {
 	Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// TokenizeShareRecord queries a tokenize share record by its id.
+	TokenizeShareRecord(ctx context.Context, in *QueryTokenizeShareRecordRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordResponse, error)
+	// TokenizeShareRecordByDenom queries a tokenize share record by its share token denom.
+	TokenizeShareRecordByDenom(ctx context.Context, in *QueryTokenizeShareRecordByDenomRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordResponse, error)
 }
 
 type queryClient struct {
@@ -1848,6 +2006,24 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) TokenizeShareRecord(ctx context.Context, in *QueryTokenizeShareRecordRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordResponse, error) {
+	out := new(QueryTokenizeShareRecordResponse)
+	err := c.cc.Invoke(ctx, "/initia.mstaking.v1.Query/TokenizeShareRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TokenizeShareRecordByDenom(ctx context.Context, in *QueryTokenizeShareRecordByDenomRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordResponse, error) {
+	out := new(QueryTokenizeShareRecordResponse)
+	err := c.cc.Invoke(ctx, "/initia.mstaking.v1.Query/TokenizeShareRecordByDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Validators queries all validators that match the given status.
@@ -1888,6 +2064,10 @@ type QueryServer interface {
 	Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// TokenizeShareRecord queries a tokenize share record by its id.
+	TokenizeShareRecord(context.Context, *QueryTokenizeShareRecordRequest) (*QueryTokenizeShareRecordResponse, error)
+	// TokenizeShareRecordByDenom queries a tokenize share record by its share token denom.
+	TokenizeShareRecordByDenom(context.Context, *QueryTokenizeShareRecordByDenomRequest) (*QueryTokenizeShareRecordResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1942,6 +2122,12 @@ func (*UnimplementedQueryServer) Pool(ctx context.Context, req *QueryPoolRequest
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) TokenizeShareRecord(ctx context.Context, req *QueryTokenizeShareRecordRequest) (*QueryTokenizeShareRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenizeShareRecord not implemented")
+}
+func (*UnimplementedQueryServer) TokenizeShareRecordByDenom(ctx context.Context, req *QueryTokenizeShareRecordByDenomRequest) (*QueryTokenizeShareRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenizeShareRecordByDenom not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2235,6 +2421,42 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TokenizeShareRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenizeShareRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TokenizeShareRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.mstaking.v1.Query/TokenizeShareRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenizeShareRecord(ctx, req.(*QueryTokenizeShareRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TokenizeShareRecordByDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenizeShareRecordByDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TokenizeShareRecordByDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.mstaking.v1.Query/TokenizeShareRecordByDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenizeShareRecordByDenom(ctx, req.(*QueryTokenizeShareRecordByDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "initia.mstaking.v1.Query",
@@ -2304,6 +2526,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "TokenizeShareRecord",
+			Handler:    _Query_TokenizeShareRecord_Handler,
+		},
+		{
+			MethodName: "TokenizeShareRecordByDenom",
+			Handler:    _Query_TokenizeShareRecordByDenom_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "initia/mstaking/v1/query.proto",
@@ -3495,6 +3725,97 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryTokenizeShareRecordRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokenizeShareRecordRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenizeShareRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTokenizeShareRecordByDenomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokenizeShareRecordByDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenizeShareRecordByDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTokenizeShareRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokenizeShareRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenizeShareRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -3980,6 +4301,42 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryTokenizeShareRecordRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryTokenizeShareRecordByDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTokenizeShareRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Record.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -7137,6 +7494,240 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryTokenizeShareRecordRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenizeShareRecordRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenizeShareRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenizeShareRecordByDenomRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenizeShareRecordByDenomRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenizeShareRecordByDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenizeShareRecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenizeShareRecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenizeShareRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,31 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ codectypes.UnpackInterfacesMessage = (*ConsPubKeyRotationHistory)(nil)
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (h ConsPubKeyRotationHistory) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pk cryptotypes.PubKey
+	if err := unpacker.UnpackAny(h.OldConsPubkey, &pk); err != nil {
+		return err
+	}
+
+	return unpacker.UnpackAny(h.NewConsPubkey, &pk)
+}
+
+// OldConsPubKey returns the consensus pubkey before the rotation.
+func (h ConsPubKeyRotationHistory) OldConsPubKey() (cryptotypes.PubKey, error) {
+	pk, ok := h.OldConsPubkey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidType, "expecting cryptotypes.PubKey, got %T", pk)
+	}
+
+	return pk, nil
+}
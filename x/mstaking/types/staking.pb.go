@@ -895,6 +895,14 @@ type Params struct {
 	MinVotingPower uint64 `protobuf:"varint,6,opt,name=min_voting_power,json=minVotingPower,proto3" json:"min_voting_power,omitempty" yaml:"min_voting_power"`
 	// min_commission_rate is the chain-wide minimum commission rate that a validator can charge their delegators
 	MinCommissionRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=min_commission_rate,json=minCommissionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_commission_rate" yaml:"min_commission_rate"`
+	// key_rotation_fee is the fee charged to a validator for rotating its consensus pubkey.
+	KeyRotationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=key_rotation_fee,json=keyRotationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"key_rotation_fee" yaml:"key_rotation_fee"`
+	// global_liquid_staking_cap is the maximum fraction of the bonded tokens of a bond denom
+	// that can be tokenized.
+	GlobalLiquidStakingCap cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=global_liquid_staking_cap,json=globalLiquidStakingCap,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"global_liquid_staking_cap" yaml:"global_liquid_staking_cap"`
+	// validator_liquid_staking_cap is the maximum fraction of the delegator shares of a bond denom
+	// of a validator that can be tokenized.
+	ValidatorLiquidStakingCap cosmossdk_io_math.LegacyDec `protobuf:"bytes,10,opt,name=validator_liquid_staking_cap,json=validatorLiquidStakingCap,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"validator_liquid_staking_cap" yaml:"validator_liquid_staking_cap"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -971,6 +979,107 @@ func (m *Params) GetMinVotingPower() uint64 {
 	return 0
 }
 
+func (m *Params) GetKeyRotationFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.KeyRotationFee
+	}
+	return nil
+}
+
+// ConsPubKeyRotationHistory records a rotation of the consensus pubkey of a validator.
+type ConsPubKeyRotationHistory struct {
+	// operator_address defines the address of the validator's operator; bech encoded in JSON.
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	// old_cons_pubkey is the consensus public key of the validator before the rotation.
+	OldConsPubkey *types.Any `protobuf:"bytes,2,opt,name=old_cons_pubkey,json=oldConsPubkey,proto3" json:"old_cons_pubkey,omitempty"`
+	// new_cons_pubkey is the consensus public key of the validator after the rotation.
+	NewConsPubkey *types.Any `protobuf:"bytes,3,opt,name=new_cons_pubkey,json=newConsPubkey,proto3" json:"new_cons_pubkey,omitempty"`
+	// height is the block height at which the rotation happened.
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time at which the rotation happened.
+	Time time.Time `protobuf:"bytes,5,opt,name=time,proto3,stdtime" json:"time"`
+	// fee is the rotation fee paid by the validator.
+	Fee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
+}
+
+func (m *ConsPubKeyRotationHistory) Reset()         { *m = ConsPubKeyRotationHistory{} }
+func (m *ConsPubKeyRotationHistory) String() string { return proto.CompactTextString(m) }
+func (*ConsPubKeyRotationHistory) ProtoMessage()    {}
+func (*ConsPubKeyRotationHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_869fea6a46e7b076, []int{15}
+}
+func (m *ConsPubKeyRotationHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsPubKeyRotationHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsPubKeyRotationHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsPubKeyRotationHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsPubKeyRotationHistory.Merge(m, src)
+}
+func (m *ConsPubKeyRotationHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsPubKeyRotationHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsPubKeyRotationHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsPubKeyRotationHistory proto.InternalMessageInfo
+
+// TokenizeShareRecord represents a delegation converted into share tokens. The
+// delegation is held by the module account of the record and its rewards are
+// withdrawn to the owner of the record.
+type TokenizeShareRecord struct {
+	// id is the unique identifier of the record.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner is the address receiving the rewards of the tokenized delegation.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// validator is the address of the validator of the tokenized delegation.
+	Validator string `protobuf:"bytes,3,opt,name=validator,proto3" json:"validator,omitempty"`
+}
+
+func (m *TokenizeShareRecord) Reset()         { *m = TokenizeShareRecord{} }
+func (m *TokenizeShareRecord) String() string { return proto.CompactTextString(m) }
+func (*TokenizeShareRecord) ProtoMessage()    {}
+func (*TokenizeShareRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_869fea6a46e7b076, []int{16}
+}
+func (m *TokenizeShareRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenizeShareRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenizeShareRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenizeShareRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenizeShareRecord.Merge(m, src)
+}
+func (m *TokenizeShareRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenizeShareRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenizeShareRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenizeShareRecord proto.InternalMessageInfo
+
 // DelegationResponse is equivalent to Delegation except that it contains a
 // balance in addition to shares which is more suitable for client responses.
 type DelegationResponse struct {
@@ -981,7 +1090,7 @@ type DelegationResponse struct {
 func (m *DelegationResponse) Reset()      { *m = DelegationResponse{} }
 func (*DelegationResponse) ProtoMessage() {}
 func (*DelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_869fea6a46e7b076, []int{15}
+	return fileDescriptor_869fea6a46e7b076, []int{17}
 }
 func (m *DelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1036,7 +1145,7 @@ func (m *RedelegationEntryResponse) Reset()         { *m = RedelegationEntryResp
 func (m *RedelegationEntryResponse) String() string { return proto.CompactTextString(m) }
 func (*RedelegationEntryResponse) ProtoMessage()    {}
 func (*RedelegationEntryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_869fea6a46e7b076, []int{16}
+	return fileDescriptor_869fea6a46e7b076, []int{18}
 }
 func (m *RedelegationEntryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1091,7 +1200,7 @@ func (m *RedelegationResponse) Reset()         { *m = RedelegationResponse{} }
 func (m *RedelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*RedelegationResponse) ProtoMessage()    {}
 func (*RedelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_869fea6a46e7b076, []int{17}
+	return fileDescriptor_869fea6a46e7b076, []int{19}
 }
 func (m *RedelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1146,7 +1255,7 @@ func (m *Pool) Reset()         { *m = Pool{} }
 func (m *Pool) String() string { return proto.CompactTextString(m) }
 func (*Pool) ProtoMessage()    {}
 func (*Pool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_869fea6a46e7b076, []int{18}
+	return fileDescriptor_869fea6a46e7b076, []int{20}
 }
 func (m *Pool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1214,6 +1323,8 @@ func init() {
 	proto.RegisterType((*RedelegationEntry)(nil), "initia.mstaking.v1.RedelegationEntry")
 	proto.RegisterType((*Redelegation)(nil), "initia.mstaking.v1.Redelegation")
 	proto.RegisterType((*Params)(nil), "initia.mstaking.v1.Params")
+	proto.RegisterType((*ConsPubKeyRotationHistory)(nil), "initia.mstaking.v1.ConsPubKeyRotationHistory")
+	proto.RegisterType((*TokenizeShareRecord)(nil), "initia.mstaking.v1.TokenizeShareRecord")
 	proto.RegisterType((*DelegationResponse)(nil), "initia.mstaking.v1.DelegationResponse")
 	proto.RegisterType((*RedelegationEntryResponse)(nil), "initia.mstaking.v1.RedelegationEntryResponse")
 	proto.RegisterType((*RedelegationResponse)(nil), "initia.mstaking.v1.RedelegationResponse")
@@ -1223,139 +1334,157 @@ func init() {
 func init() { proto.RegisterFile("initia/mstaking/v1/staking.proto", fileDescriptor_869fea6a46e7b076) }
 
 var fileDescriptor_869fea6a46e7b076 = []byte{
-	// 2101 bytes of a gzipped FileDescriptorProto
+	// 2397 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x39, 0x4d, 0x6c, 0x1b, 0x59,
-	0xfd, 0x99, 0xc4, 0x75, 0xe2, 0x9f, 0x9d, 0xd8, 0x79, 0x4d, 0x5b, 0xc7, 0xed, 0xdf, 0xf6, 0xce,
-	0x5f, 0xa0, 0xa8, 0x6c, 0x6d, 0xda, 0x45, 0x5d, 0xa9, 0xe2, 0xb0, 0x71, 0x9c, 0xb6, 0x2e, 0xdd,
-	0x34, 0x9a, 0xa4, 0x29, 0x2c, 0x42, 0xa3, 0xf1, 0xcc, 0xab, 0x33, 0xc4, 0x33, 0x63, 0xcd, 0x7b,
-	0x6e, 0x63, 0x4e, 0x1c, 0x40, 0x54, 0x3d, 0xa0, 0xe5, 0xc4, 0x72, 0xa8, 0x54, 0xc1, 0x65, 0x85,
-	0xf6, 0xc0, 0x61, 0x05, 0xe2, 0xc0, 0x11, 0xed, 0x02, 0x42, 0xaa, 0x38, 0xa1, 0x15, 0xf2, 0xa2,
-	0x16, 0x09, 0xc4, 0x09, 0xe5, 0xcc, 0x01, 0xbd, 0x8f, 0xf9, 0xf0, 0xd8, 0x9b, 0x8f, 0x6a, 0xb5,
-	0x5a, 0x09, 0x2e, 0xc9, 0xbc, 0xdf, 0xfb, 0x7d, 0x7f, 0xbe, 0xf7, 0x0c, 0x55, 0xdb, 0xb5, 0xa9,
-	0x6d, 0xd4, 0x1d, 0x42, 0x8d, 0x3d, 0xdb, 0xed, 0xd4, 0x1f, 0x5c, 0xae, 0xcb, 0xcf, 0x5a, 0xcf,
-	0xf7, 0xa8, 0x87, 0x90, 0xc0, 0xa8, 0x05, 0x18, 0xb5, 0x07, 0x97, 0x4b, 0x8b, 0x86, 0x63, 0xbb,
-	0x5e, 0x9d, 0xff, 0x15, 0x68, 0xa5, 0xb2, 0xe9, 0x11, 0xc7, 0x23, 0xf5, 0xb6, 0x41, 0x70, 0xfd,
-	0xc1, 0xe5, 0x36, 0xa6, 0xc6, 0xe5, 0xba, 0xe9, 0xd9, 0xae, 0xdc, 0x5f, 0x16, 0xfb, 0x3a, 0x5f,
-	0xd5, 0xc5, 0x42, 0x6e, 0x2d, 0x75, 0xbc, 0x8e, 0x27, 0xe0, 0xec, 0x2b, 0x20, 0xe8, 0x78, 0x5e,
-	0xa7, 0x8b, 0xeb, 0x7c, 0xd5, 0xee, 0xdf, 0xaf, 0x1b, 0xee, 0x20, 0x90, 0x95, 0xdc, 0xb2, 0xfa,
-	0xbe, 0x41, 0x6d, 0x2f, 0x90, 0x55, 0x49, 0xee, 0x53, 0xdb, 0xc1, 0x84, 0x1a, 0x4e, 0x4f, 0x20,
-	0xa8, 0x1f, 0x4c, 0x43, 0x7e, 0xcd, 0x73, 0x1c, 0x9b, 0x10, 0xdb, 0x73, 0x35, 0x83, 0x62, 0x82,
-	0x6e, 0x41, 0xca, 0x37, 0x28, 0x2e, 0x2a, 0x55, 0x65, 0x25, 0xd3, 0xb8, 0xfa, 0xe1, 0xb0, 0x32,
-	0xf5, 0xd1, 0xb0, 0x72, 0x5e, 0x68, 0x4a, 0xac, 0xbd, 0x9a, 0xed, 0xd5, 0x1d, 0x83, 0xee, 0xd6,
-	0x6e, 0xe3, 0x8e, 0x61, 0x0e, 0x9a, 0xd8, 0xfc, 0xd3, 0xfb, 0x97, 0x40, 0x1a, 0xd2, 0xc4, 0xe6,
-	0xbb, 0x7f, 0xff, 0xc5, 0x45, 0x45, 0xe3, 0x3c, 0x90, 0x05, 0x73, 0x8e, 0xb1, 0xaf, 0x73, 0x7e,
-	0xd3, 0x9c, 0x5f, 0xeb, 0x18, 0xfc, 0x0e, 0x86, 0x95, 0xfc, 0xc0, 0x70, 0xba, 0xd7, 0xd4, 0x80,
-	0x58, 0x9d, 0x24, 0x62, 0xd6, 0x31, 0xf6, 0x99, 0xca, 0x68, 0x1f, 0xf2, 0x0c, 0xd1, 0xdc, 0x35,
-	0xdc, 0x0e, 0x16, 0xc2, 0x66, 0xb8, 0xb0, 0xcd, 0xe3, 0x09, 0x3b, 0x1b, 0x09, 0x8b, 0xf1, 0x98,
-	0x28, 0x73, 0xde, 0x31, 0xf6, 0xd7, 0x38, 0x0e, 0x93, 0x7c, 0x6d, 0xee, 0x9d, 0xa7, 0x95, 0xa9,
-	0x7f, 0x3c, 0xad, 0x28, 0xea, 0x1f, 0x15, 0x80, 0xc8, 0x93, 0xe8, 0xeb, 0x50, 0x30, 0xc3, 0x15,
-	0x67, 0x47, 0xb8, 0x43, 0xb3, 0x57, 0xfe, 0xbf, 0x36, 0x9e, 0x47, 0xb5, 0x44, 0x0c, 0x1a, 0x73,
-	0x4c, 0xf1, 0x67, 0xc3, 0x8a, 0xa2, 0xe5, 0xcd, 0x44, 0x78, 0xbe, 0x09, 0xd9, 0x7e, 0xcf, 0x32,
-	0x28, 0xd6, 0x59, 0x30, 0xb9, 0x57, 0xb3, 0x57, 0x4a, 0x35, 0x11, 0xe9, 0x5a, 0x10, 0xe9, 0xda,
-	0x76, 0x10, 0xe9, 0x46, 0x99, 0xf1, 0x3a, 0x18, 0x56, 0x90, 0xb0, 0x32, 0x46, 0xac, 0xbe, 0xfd,
-	0x71, 0x45, 0xd1, 0x40, 0x40, 0x18, 0x41, 0xcc, 0x9e, 0xdf, 0x29, 0x90, 0x6d, 0x62, 0x62, 0xfa,
-	0x76, 0x8f, 0x25, 0x14, 0x2a, 0xc2, 0xac, 0xe3, 0xb9, 0xf6, 0x1e, 0xf6, 0x45, 0x62, 0x68, 0xc1,
-	0x12, 0x95, 0x60, 0xce, 0xb6, 0xb0, 0x4b, 0x6d, 0x3a, 0x10, 0x31, 0xd6, 0xc2, 0x35, 0xa3, 0x7a,
-	0x88, 0xdb, 0xc4, 0x0e, 0x22, 0xa2, 0x05, 0x4b, 0x74, 0x1d, 0x0a, 0x04, 0x9b, 0x7d, 0xdf, 0xa6,
-	0x03, 0xdd, 0xf4, 0x5c, 0x6a, 0x98, 0xb4, 0x98, 0xe2, 0x41, 0x3b, 0x7f, 0x30, 0xac, 0x9c, 0x13,
-	0xba, 0x26, 0x31, 0x54, 0x2d, 0x1f, 0x80, 0xd6, 0x04, 0x84, 0x49, 0xb0, 0x30, 0x35, 0xec, 0x2e,
-	0x29, 0x9e, 0x12, 0x12, 0xe4, 0x32, 0x66, 0xcb, 0xaf, 0x33, 0x90, 0xd9, 0x31, 0xba, 0xb6, 0x65,
-	0x50, 0xcf, 0x67, 0x92, 0xbd, 0x1e, 0xf6, 0xd9, 0xb7, 0x6e, 0x58, 0x96, 0x8f, 0x09, 0x91, 0xb9,
-	0x1e, 0x93, 0x9c, 0xc4, 0x50, 0xb5, 0x7c, 0x00, 0x5a, 0x15, 0x10, 0x44, 0x59, 0x88, 0x5d, 0x82,
-	0x5d, 0xd2, 0x27, 0x7a, 0xaf, 0xdf, 0xde, 0xc3, 0x03, 0x19, 0x8d, 0xa5, 0xb1, 0x68, 0xac, 0xba,
-	0x83, 0xc6, 0x6b, 0x11, 0xf7, 0x24, 0x9d, 0xfa, 0xfb, 0xf7, 0x2f, 0x2d, 0xc9, 0x54, 0x33, 0xfd,
-	0x41, 0x8f, 0x7a, 0xb5, 0xcd, 0x7e, 0xfb, 0x6b, 0x78, 0xc0, 0xc2, 0x2f, 0x51, 0x37, 0x39, 0x26,
-	0x3a, 0x0b, 0xe9, 0x6f, 0x1b, 0x76, 0x17, 0x5b, 0xdc, 0xa1, 0x73, 0x9a, 0x5c, 0xa1, 0xab, 0x90,
-	0x26, 0xd4, 0xa0, 0x7d, 0xc2, 0xbd, 0xb8, 0x70, 0xa5, 0x3c, 0x29, 0xcd, 0x1a, 0x9e, 0x6b, 0x6d,
-	0x71, 0x2c, 0x4d, 0x62, 0x23, 0x0a, 0x69, 0xea, 0xed, 0x61, 0x97, 0xb9, 0x6f, 0x66, 0x25, 0x7b,
-	0x65, 0xb9, 0x26, 0xf5, 0x60, 0xfd, 0xab, 0x26, 0xfb, 0x57, 0x6d, 0xcd, 0xb3, 0xdd, 0xc6, 0xaa,
-	0x4c, 0xa4, 0x79, 0x61, 0x84, 0x20, 0x53, 0x7f, 0xfe, 0x71, 0x65, 0xa5, 0x63, 0xd3, 0xdd, 0x7e,
-	0xbb, 0x66, 0x7a, 0x8e, 0x6c, 0x68, 0xf2, 0xdf, 0x25, 0x62, 0xed, 0xd5, 0xe9, 0xa0, 0x87, 0x09,
-	0xe7, 0x40, 0x34, 0x29, 0x0b, 0xfd, 0x58, 0x81, 0x82, 0x85, 0xbb, 0xb8, 0xc3, 0x7d, 0x4c, 0x76,
-	0x0d, 0x1f, 0x93, 0x62, 0x9a, 0x2b, 0x70, 0x61, 0xa2, 0x02, 0x4d, 0x6c, 0x72, 0x1d, 0x36, 0xa4,
-	0x0e, 0xd2, 0x91, 0x49, 0x1e, 0x4c, 0x9b, 0x2f, 0x1d, 0x43, 0x1b, 0xc9, 0x8e, 0x68, 0xf9, 0x90,
-	0xc3, 0x16, 0x67, 0x80, 0x6e, 0x40, 0xd6, 0x8a, 0xd2, 0xbe, 0x38, 0xcb, 0x03, 0x5a, 0x99, 0xe4,
-	0xcc, 0x58, 0x75, 0x34, 0x52, 0x4c, 0x2d, 0x2d, 0x4e, 0xc9, 0xd2, 0xac, 0xef, 0xb6, 0x3d, 0xd7,
-	0xb2, 0xdd, 0x8e, 0xbe, 0x8b, 0xed, 0xce, 0x2e, 0x2d, 0xce, 0x55, 0x95, 0x95, 0x99, 0x78, 0x9a,
-	0x25, 0x31, 0x54, 0x2d, 0x1f, 0x82, 0x6e, 0x72, 0x08, 0xb2, 0x60, 0x21, 0xc2, 0xe2, 0x25, 0x9f,
-	0x39, 0xb2, 0xe4, 0x5f, 0x91, 0x5e, 0x3a, 0x93, 0x94, 0x12, 0x55, 0xfd, 0x7c, 0x08, 0x64, 0x64,
-	0xa8, 0x09, 0x10, 0x35, 0x9a, 0x22, 0x70, 0x09, 0xe5, 0xc3, 0x3b, 0x95, 0x34, 0x3a, 0x46, 0x87,
-	0x1e, 0x29, 0x30, 0xff, 0xc0, 0xa3, 0x4c, 0x52, 0xcf, 0x7b, 0x88, 0x7d, 0x52, 0xcc, 0x1e, 0x95,
-	0x54, 0x37, 0xa5, 0xaa, 0x4b, 0x42, 0xd5, 0x11, 0xea, 0x93, 0xe5, 0x56, 0x4e, 0xd0, 0x6e, 0x72,
-	0x52, 0x74, 0x0f, 0x72, 0x71, 0x5e, 0xc5, 0x1c, 0xaf, 0xf0, 0xaf, 0xc8, 0x81, 0x70, 0x66, 0x7c,
-	0x20, 0xb4, 0x5c, 0x7a, 0x30, 0xac, 0x9c, 0x1e, 0x57, 0x43, 0xd5, 0xb2, 0x31, 0xce, 0xe8, 0xab,
-	0x70, 0x3e, 0xf2, 0xa7, 0xe7, 0xea, 0xbb, 0x5e, 0xd7, 0xd2, 0x7d, 0x7c, 0x5f, 0x37, 0xbd, 0xbe,
-	0x4b, 0x8b, 0xf3, 0x2c, 0xc4, 0xda, 0xb9, 0x10, 0xe5, 0x8e, 0x7b, 0xd3, 0xeb, 0x5a, 0x1a, 0xbe,
-	0xbf, 0xc6, 0xb6, 0xd1, 0x2b, 0x90, 0x8b, 0xa8, 0x6d, 0xab, 0xb8, 0x50, 0x55, 0x56, 0x52, 0x5a,
-	0x36, 0x84, 0xb5, 0xac, 0x6b, 0xb9, 0x47, 0x4f, 0x2b, 0x53, 0xb2, 0x77, 0x4d, 0xa9, 0x57, 0x21,
-	0xb7, 0x63, 0x74, 0x65, 0xcf, 0xc1, 0x04, 0x5d, 0x80, 0x8c, 0x11, 0x2c, 0x8a, 0x4a, 0x75, 0x66,
-	0x25, 0xa3, 0x45, 0x00, 0xd1, 0xf3, 0xbe, 0xfb, 0x97, 0xaa, 0xa2, 0xbe, 0xa7, 0x40, 0xba, 0xb9,
-	0xb3, 0x69, 0xd8, 0x3e, 0x6a, 0xc1, 0x62, 0x54, 0x27, 0xa3, 0x1d, 0xef, 0xc2, 0xc1, 0xb0, 0x52,
-	0x4c, 0x96, 0x52, 0xd8, 0xf2, 0xa2, 0x12, 0x0d, 0x7a, 0x5e, 0x0b, 0x16, 0x1f, 0x04, 0x8d, 0x34,
-	0x64, 0x35, 0x9d, 0x64, 0x35, 0x86, 0xa2, 0x6a, 0x85, 0x10, 0x26, 0x59, 0x25, 0xcc, 0x5c, 0x85,
-	0x59, 0xa1, 0x2d, 0x41, 0x57, 0xe1, 0x54, 0x8f, 0x7d, 0x70, 0xeb, 0x58, 0x9e, 0x4f, 0xaa, 0x3d,
-	0x8e, 0x2b, 0x33, 0x50, 0xa0, 0xab, 0x3f, 0x9a, 0x06, 0x68, 0xee, 0xec, 0x6c, 0xfb, 0x76, 0xaf,
-	0x8b, 0xe9, 0xa7, 0x69, 0xf5, 0x36, 0x9c, 0x89, 0x4c, 0x22, 0xbe, 0x99, 0xb0, 0xbc, 0x7a, 0x30,
-	0xac, 0x5c, 0x48, 0x5a, 0x1e, 0x43, 0x53, 0xb5, 0xd3, 0x21, 0x7c, 0xcb, 0x37, 0x27, 0x72, 0xb5,
-	0x08, 0x0d, 0xb9, 0xce, 0x7c, 0x32, 0xd7, 0x18, 0x5a, 0x9c, 0x6b, 0x93, 0xd0, 0xc9, 0x6e, 0xbd,
-	0x03, 0xd9, 0xc8, 0x25, 0x04, 0xbd, 0x01, 0x73, 0x54, 0x7e, 0x4b, 0xef, 0x96, 0x27, 0x7b, 0x37,
-	0x20, 0x91, 0x1e, 0x0e, 0xa9, 0xd4, 0xf7, 0x98, 0x93, 0x85, 0x7f, 0x58, 0xc1, 0x7f, 0x2e, 0x53,
-	0x0b, 0x7d, 0x07, 0xd2, 0x72, 0xa4, 0xcc, 0x1c, 0x63, 0xa4, 0x34, 0x47, 0xc7, 0xda, 0x4b, 0x0e,
-	0x12, 0x29, 0x31, 0xe1, 0xff, 0x1f, 0x4c, 0xc3, 0xe9, 0xbb, 0x41, 0x6d, 0x7f, 0xee, 0xfd, 0x76,
-	0x1b, 0x66, 0xb1, 0x4b, 0x7d, 0x3b, 0x74, 0xdc, 0xab, 0x93, 0xb2, 0x63, 0x82, 0x3d, 0xeb, 0x2e,
-	0xf5, 0x07, 0x32, 0x57, 0x02, 0x16, 0x09, 0x4f, 0xfc, 0x26, 0x05, 0xc5, 0x4f, 0xa2, 0x44, 0x6b,
-	0x90, 0x37, 0x7d, 0xcc, 0x01, 0xc1, 0xa8, 0x54, 0xf8, 0xa8, 0x2c, 0x45, 0xa7, 0xf3, 0x04, 0x82,
-	0xaa, 0x2d, 0x04, 0x10, 0x39, 0x28, 0x3b, 0xc0, 0xce, 0xca, 0x2c, 0x4d, 0x19, 0xd6, 0x31, 0x0f,
-	0xc7, 0xaa, 0x0c, 0x7e, 0x20, 0x64, 0x94, 0x81, 0x18, 0x95, 0x0b, 0x11, 0x94, 0xcf, 0xca, 0x1f,
-	0x2a, 0x90, 0x17, 0x7e, 0xe9, 0xea, 0x6d, 0xa3, 0x6b, 0xb8, 0x26, 0x96, 0xfe, 0x3a, 0x64, 0xce,
-	0xdd, 0x1a, 0x15, 0x94, 0xa0, 0x3f, 0xd9, 0xa4, 0x5b, 0x90, 0xd4, 0x0d, 0x41, 0x8c, 0x1e, 0xc2,
-	0x6c, 0xa0, 0x47, 0xea, 0x28, 0x3d, 0x1a, 0x52, 0x8f, 0x05, 0xa1, 0xc7, 0x4b, 0xc9, 0x0f, 0xa4,
-	0x8d, 0x4d, 0xb3, 0x53, 0x63, 0xd3, 0xec, 0xa8, 0x71, 0x99, 0x3e, 0x74, 0x5c, 0xc6, 0xce, 0xf0,
-	0xbf, 0x4d, 0xc1, 0xa2, 0x86, 0xad, 0xff, 0x25, 0xce, 0xa7, 0x99, 0x38, 0xdf, 0x57, 0x00, 0x44,
-	0xdf, 0x62, 0x93, 0x45, 0x26, 0xcf, 0xe1, 0xdd, 0x32, 0x38, 0xaf, 0x2d, 0xc6, 0xbb, 0x25, 0xa3,
-	0x3e, 0x71, 0xc7, 0xcc, 0x08, 0xda, 0x26, 0xa1, 0x9f, 0x65, 0x1e, 0x7d, 0x34, 0x0d, 0xb9, 0x78,
-	0x1e, 0xfd, 0x97, 0x9e, 0x13, 0xd0, 0x7a, 0xd4, 0xeb, 0x45, 0xd8, 0xbf, 0x30, 0xa9, 0xd7, 0x8f,
-	0x55, 0xdc, 0xe1, 0x4d, 0xfe, 0x83, 0x14, 0xa4, 0x37, 0x0d, 0xdf, 0x70, 0x08, 0x32, 0xc7, 0xae,
-	0x2d, 0xe2, 0xf9, 0x63, 0x79, 0xac, 0xa6, 0x9a, 0xf2, 0xcd, 0xea, 0x88, 0x5b, 0xcb, 0x3b, 0x13,
-	0x6e, 0x2d, 0x6f, 0xc0, 0x82, 0x63, 0xec, 0xeb, 0xa1, 0x7d, 0xc2, 0xd3, 0xf3, 0x8d, 0xe5, 0x88,
-	0xcb, 0xe8, 0xbe, 0xca, 0x1f, 0x70, 0xc2, 0xb7, 0x00, 0x82, 0x5e, 0x87, 0x2c, 0xc3, 0x88, 0xc6,
-	0x1e, 0x23, 0x3f, 0x1b, 0xbd, 0x96, 0xc4, 0x36, 0x55, 0x0d, 0x1c, 0x63, 0x7f, 0x5d, 0x2c, 0xd0,
-	0x6d, 0x40, 0xbb, 0x36, 0xa1, 0x9e, 0x6f, 0x9b, 0x46, 0x57, 0x8f, 0x5c, 0xc9, 0xe8, 0xff, 0xef,
-	0x60, 0x58, 0x59, 0x16, 0xf4, 0xe3, 0x38, 0xaa, 0xb6, 0x18, 0x01, 0x03, 0x6e, 0xaf, 0x43, 0x96,
-	0xd9, 0xa5, 0x5b, 0xd8, 0xf5, 0x1c, 0x71, 0x15, 0xcf, 0xc4, 0xd5, 0x88, 0x6d, 0xaa, 0x1a, 0xb0,
-	0x55, 0x93, 0x2f, 0xd0, 0x3a, 0x14, 0x1c, 0xdb, 0xd5, 0x47, 0xae, 0x3a, 0xac, 0x16, 0x52, 0xf1,
-	0x5b, 0x66, 0x12, 0x43, 0xd5, 0x16, 0x1c, 0xdb, 0xdd, 0x89, 0x5d, 0x6a, 0xbe, 0xa7, 0xc0, 0x69,
-	0x86, 0x95, 0x78, 0xb3, 0xe2, 0xd7, 0xdf, 0x4c, 0x63, 0xfb, 0x78, 0xcf, 0x68, 0xa5, 0x48, 0x5a,
-	0x82, 0xcf, 0xc4, 0xa7, 0xb4, 0x45, 0xc7, 0x76, 0x47, 0xdf, 0xbd, 0x62, 0x65, 0xfa, 0x07, 0x05,
-	0x50, 0x74, 0x4a, 0xd0, 0x30, 0xe9, 0x79, 0x2e, 0xe1, 0xd7, 0xd4, 0x28, 0x21, 0x65, 0x46, 0x4d,
-	0x3e, 0xc2, 0x86, 0x58, 0xc1, 0x35, 0x35, 0x56, 0xf2, 0x38, 0x9a, 0x97, 0xd3, 0x47, 0xb5, 0xdf,
-	0x2f, 0x33, 0xea, 0x97, 0x9a, 0x8e, 0xa1, 0x35, 0x53, 0xea, 0xdf, 0x14, 0x58, 0x1e, 0x2b, 0xa5,
-	0xd0, 0xa8, 0xb7, 0x00, 0xf9, 0xb1, 0x4d, 0x9e, 0x28, 0x03, 0x69, 0xdc, 0x89, 0xaa, 0x72, 0xd1,
-	0x1f, 0x1b, 0x90, 0x9f, 0x91, 0xa9, 0x29, 0x1e, 0xb4, 0x5f, 0x29, 0xb0, 0x14, 0xd7, 0x2d, 0xb4,
-	0xf0, 0x16, 0xe4, 0xe2, 0xaa, 0x49, 0xdb, 0xaa, 0x47, 0xd9, 0x26, 0xcd, 0x1a, 0xa1, 0x45, 0x6f,
-	0x46, 0x8d, 0x4b, 0x58, 0x74, 0xe9, 0x58, 0x2e, 0x0a, 0x74, 0x49, 0x36, 0xb0, 0x14, 0x0f, 0xd0,
-	0xbf, 0x67, 0x20, 0xb5, 0xe9, 0x79, 0x5d, 0xf4, 0xae, 0x02, 0x8b, 0xae, 0x47, 0x75, 0x56, 0x62,
-	0xd8, 0xd2, 0xe5, 0xd3, 0x98, 0x72, 0x94, 0xeb, 0x0c, 0xc6, 0xf4, 0x9f, 0xc3, 0xca, 0x38, 0x6d,
-	0x34, 0x42, 0xc6, 0xb6, 0x4e, 0x36, 0xbb, 0xf3, 0xae, 0x47, 0x1b, 0x9c, 0x7c, 0x5b, 0xbc, 0xa1,
-	0xfd, 0x44, 0x81, 0xf9, 0x51, 0x35, 0x8f, 0x8c, 0xf0, 0x37, 0xa4, 0x9a, 0xf3, 0x49, 0x15, 0x97,
-	0xa2, 0x36, 0xf3, 0x92, 0xea, 0xe5, 0xda, 0x71, 0xdd, 0x7e, 0xaa, 0xc0, 0x52, 0xbc, 0xe3, 0xe8,
-	0x0f, 0xf9, 0x51, 0xeb, 0x78, 0x17, 0x32, 0x4d, 0xce, 0x81, 0xf3, 0xe3, 0x6f, 0x31, 0x01, 0x9f,
-	0x13, 0x1f, 0x36, 0x50, 0xec, 0x09, 0xe7, 0x9e, 0xe0, 0x71, 0x6d, 0x8e, 0x25, 0xed, 0xbf, 0x9e,
-	0x56, 0x94, 0x8b, 0xbf, 0x54, 0x00, 0xa2, 0xb7, 0x51, 0xf4, 0x2a, 0x9c, 0x6b, 0xdc, 0xd9, 0x68,
-	0xea, 0x5b, 0xdb, 0xab, 0xdb, 0x77, 0xb7, 0xf4, 0xbb, 0x1b, 0x5b, 0x9b, 0xeb, 0x6b, 0xad, 0xeb,
-	0xad, 0xf5, 0x66, 0x61, 0xaa, 0x94, 0x7f, 0xfc, 0xa4, 0x9a, 0xbd, 0xeb, 0x92, 0x1e, 0x36, 0xed,
-	0xfb, 0x36, 0xb6, 0xd0, 0x17, 0x61, 0x69, 0x14, 0x9b, 0xad, 0xd6, 0x9b, 0x05, 0xa5, 0x94, 0x7b,
-	0xfc, 0xa4, 0x3a, 0x27, 0x2e, 0x3d, 0xd8, 0x42, 0x2b, 0x70, 0x66, 0x1c, 0xaf, 0xb5, 0x71, 0xa3,
-	0x30, 0x5d, 0x9a, 0x7f, 0xfc, 0xa4, 0x9a, 0x09, 0x6f, 0x47, 0x48, 0x05, 0x14, 0xc7, 0x94, 0xfc,
-	0x66, 0x4a, 0xf0, 0xf8, 0x49, 0x35, 0x2d, 0x72, 0xa0, 0x94, 0x7a, 0xf4, 0xb3, 0xf2, 0xd4, 0xc5,
-	0x6f, 0x01, 0xb4, 0xdc, 0xfb, 0xbe, 0x61, 0xf2, 0xd2, 0x28, 0xc1, 0xd9, 0xd6, 0xc6, 0x75, 0x6d,
-	0x75, 0x6d, 0xbb, 0x75, 0x67, 0x63, 0x54, 0xed, 0xc4, 0x5e, 0xf3, 0xce, 0xdd, 0xc6, 0xed, 0x75,
-	0x7d, 0xab, 0x75, 0x63, 0xa3, 0xa0, 0xa0, 0x73, 0x70, 0x7a, 0x64, 0xef, 0xde, 0xc6, 0x76, 0xeb,
-	0xcd, 0xf5, 0xc2, 0x74, 0xe3, 0xe6, 0x87, 0xcf, 0xcb, 0xca, 0xb3, 0xe7, 0x65, 0xe5, 0xaf, 0xcf,
-	0xcb, 0xca, 0xdb, 0x2f, 0xca, 0x53, 0xcf, 0x5e, 0x94, 0xa7, 0xfe, 0xfc, 0xa2, 0x3c, 0xf5, 0x56,
-	0x2d, 0xe6, 0x7c, 0x51, 0x7e, 0x97, 0xba, 0x46, 0x9b, 0xc8, 0xef, 0xfa, 0x7e, 0xf4, 0x3b, 0x1a,
-	0x0f, 0x44, 0x3b, 0xcd, 0xc7, 0xfd, 0x6b, 0xff, 0x09, 0x00, 0x00, 0xff, 0xff, 0x53, 0x42, 0xd8,
-	0x5d, 0x67, 0x1b, 0x00, 0x00,
+	0xfd, 0x99, 0xd8, 0x75, 0xec, 0x9f, 0x93, 0xd8, 0x79, 0x49, 0xd3, 0x89, 0xdb, 0xb5, 0xbd, 0xb3,
+	0xfa, 0xff, 0x15, 0x95, 0x8d, 0x4d, 0xbb, 0xd0, 0x95, 0x2a, 0x10, 0x1b, 0xc7, 0x69, 0xeb, 0x92,
+	0x4d, 0xa3, 0x49, 0x9a, 0x42, 0xd1, 0x6a, 0x34, 0x9e, 0x79, 0x71, 0x86, 0xd8, 0x33, 0x66, 0x66,
+	0xdc, 0xc4, 0x7b, 0xe2, 0x00, 0xa2, 0xea, 0x01, 0x2d, 0x07, 0x44, 0x39, 0x14, 0x55, 0x70, 0x59,
+	0xa1, 0x3d, 0x70, 0xa8, 0x40, 0x1c, 0x38, 0x22, 0x16, 0x10, 0x52, 0xd5, 0x13, 0x5a, 0x21, 0x2f,
+	0x6a, 0x91, 0x40, 0x9c, 0x50, 0xce, 0x1c, 0xd0, 0xfb, 0x98, 0x0f, 0x8f, 0xdd, 0xda, 0xa9, 0x56,
+	0xab, 0x95, 0xe0, 0x92, 0xcc, 0x7b, 0xef, 0xf7, 0xfd, 0xf9, 0xde, 0xcf, 0x50, 0x34, 0x4c, 0xc3,
+	0x35, 0xd4, 0x72, 0xcb, 0x71, 0xd5, 0x03, 0xc3, 0x6c, 0x94, 0xef, 0x5c, 0x28, 0xf3, 0xcf, 0x52,
+	0xdb, 0xb6, 0x5c, 0x0b, 0x21, 0x06, 0x51, 0xf2, 0x20, 0x4a, 0x77, 0x2e, 0xe4, 0xe6, 0xd4, 0x96,
+	0x61, 0x5a, 0x65, 0xfa, 0x97, 0x81, 0xe5, 0xf2, 0x9a, 0xe5, 0xb4, 0x2c, 0xa7, 0x5c, 0x57, 0x1d,
+	0x5c, 0xbe, 0x73, 0xa1, 0x8e, 0x5d, 0xf5, 0x42, 0x59, 0xb3, 0x0c, 0x93, 0x9f, 0x2f, 0xb1, 0x73,
+	0x85, 0xae, 0xca, 0x6c, 0xc1, 0x8f, 0x16, 0x1a, 0x56, 0xc3, 0x62, 0xfb, 0xe4, 0xcb, 0x43, 0x68,
+	0x58, 0x56, 0xa3, 0x89, 0xcb, 0x74, 0x55, 0xef, 0xec, 0x95, 0x55, 0xb3, 0xeb, 0xf1, 0x8a, 0x1e,
+	0xe9, 0x1d, 0x5b, 0x75, 0x0d, 0xcb, 0xe3, 0x55, 0x88, 0x9e, 0xbb, 0x46, 0x0b, 0x3b, 0xae, 0xda,
+	0x6a, 0x33, 0x00, 0xe9, 0x77, 0x93, 0x90, 0x59, 0xb3, 0x5a, 0x2d, 0xc3, 0x71, 0x0c, 0xcb, 0x94,
+	0x55, 0x17, 0x3b, 0xe8, 0x3a, 0xc4, 0x6d, 0xd5, 0xc5, 0xa2, 0x50, 0x14, 0x96, 0x53, 0x95, 0x4b,
+	0x1f, 0xf6, 0x0a, 0x13, 0x1f, 0xf5, 0x0a, 0x67, 0x99, 0xa4, 0x8e, 0x7e, 0x50, 0x32, 0xac, 0x72,
+	0x4b, 0x75, 0xf7, 0x4b, 0x1b, 0xb8, 0xa1, 0x6a, 0xdd, 0x2a, 0xd6, 0x9e, 0x3c, 0x5a, 0x01, 0xae,
+	0x48, 0x15, 0x6b, 0xef, 0xff, 0xfd, 0x17, 0xe7, 0x05, 0x99, 0xd2, 0x40, 0x3a, 0x24, 0x5b, 0xea,
+	0x91, 0x42, 0xe9, 0x4d, 0x52, 0x7a, 0xb5, 0x31, 0xe8, 0x1d, 0xf7, 0x0a, 0x99, 0xae, 0xda, 0x6a,
+	0x5e, 0x96, 0x3c, 0x64, 0x69, 0x18, 0x8b, 0xa9, 0x96, 0x7a, 0x44, 0x44, 0x46, 0x47, 0x90, 0x21,
+	0x80, 0xda, 0xbe, 0x6a, 0x36, 0x30, 0x63, 0x16, 0xa3, 0xcc, 0xb6, 0xc6, 0x63, 0xb6, 0x18, 0x30,
+	0x0b, 0xd1, 0x18, 0xca, 0x73, 0xa6, 0xa5, 0x1e, 0xad, 0x51, 0x18, 0xc2, 0xf9, 0x72, 0xf2, 0xfe,
+	0xc3, 0xc2, 0xc4, 0x3f, 0x1e, 0x16, 0x04, 0xe9, 0x4f, 0x02, 0x40, 0x60, 0x49, 0xf4, 0x35, 0xc8,
+	0x6a, 0xfe, 0x8a, 0x92, 0x73, 0xa8, 0x41, 0xd3, 0x17, 0x5f, 0x2b, 0x0d, 0xc6, 0x51, 0x29, 0xe2,
+	0x83, 0x4a, 0x92, 0x08, 0xfe, 0xb8, 0x57, 0x10, 0xe4, 0x8c, 0x16, 0x71, 0xcf, 0x37, 0x20, 0xdd,
+	0x69, 0xeb, 0xaa, 0x8b, 0x15, 0xe2, 0x4c, 0x6a, 0xd5, 0xf4, 0xc5, 0x5c, 0x89, 0x79, 0xba, 0xe4,
+	0x79, 0xba, 0xb4, 0xe3, 0x79, 0xba, 0x92, 0x27, 0xb4, 0x8e, 0x7b, 0x05, 0xc4, 0xb4, 0x0c, 0x21,
+	0x4b, 0xef, 0x7d, 0x5c, 0x10, 0x64, 0x60, 0x3b, 0x04, 0x21, 0xa4, 0xcf, 0xef, 0x05, 0x48, 0x57,
+	0xb1, 0xa3, 0xd9, 0x46, 0x9b, 0x04, 0x14, 0x12, 0x61, 0xaa, 0x65, 0x99, 0xc6, 0x01, 0xb6, 0x59,
+	0x60, 0xc8, 0xde, 0x12, 0xe5, 0x20, 0x69, 0xe8, 0xd8, 0x74, 0x0d, 0xb7, 0xcb, 0x7c, 0x2c, 0xfb,
+	0x6b, 0x82, 0x75, 0x88, 0xeb, 0x8e, 0xe1, 0x79, 0x44, 0xf6, 0x96, 0xe8, 0x0a, 0x64, 0x1d, 0xac,
+	0x75, 0x6c, 0xc3, 0xed, 0x2a, 0x9a, 0x65, 0xba, 0xaa, 0xe6, 0x8a, 0x71, 0xea, 0xb4, 0xb3, 0xc7,
+	0xbd, 0xc2, 0x19, 0x26, 0x6b, 0x14, 0x42, 0x92, 0x33, 0xde, 0xd6, 0x1a, 0xdb, 0x21, 0x1c, 0x74,
+	0xec, 0xaa, 0x46, 0xd3, 0x11, 0x4f, 0x31, 0x0e, 0x7c, 0x19, 0xd2, 0xe5, 0xd7, 0x29, 0x48, 0xed,
+	0xaa, 0x4d, 0x43, 0x57, 0x5d, 0xcb, 0x26, 0x9c, 0xad, 0x36, 0xb6, 0xc9, 0xb7, 0xa2, 0xea, 0xba,
+	0x8d, 0x1d, 0x47, 0x14, 0xa2, 0x9c, 0xa3, 0x10, 0x92, 0x9c, 0xf1, 0xb6, 0x56, 0xd9, 0x0e, 0x72,
+	0x89, 0x8b, 0x4d, 0x07, 0x9b, 0x4e, 0xc7, 0x51, 0xda, 0x9d, 0xfa, 0x01, 0xee, 0x72, 0x6f, 0x2c,
+	0x0c, 0x78, 0x63, 0xd5, 0xec, 0x56, 0xde, 0x08, 0xa8, 0x47, 0xf1, 0xa4, 0x3f, 0x3c, 0x5a, 0x59,
+	0xe0, 0xa1, 0xa6, 0xd9, 0xdd, 0xb6, 0x6b, 0x95, 0xb6, 0x3a, 0xf5, 0xaf, 0xe2, 0xae, 0x9c, 0xf1,
+	0x41, 0xb7, 0x28, 0x24, 0x5a, 0x84, 0xc4, 0x37, 0x55, 0xa3, 0x89, 0x75, 0x6a, 0xd0, 0xa4, 0xcc,
+	0x57, 0xe8, 0x12, 0x24, 0x1c, 0x57, 0x75, 0x3b, 0x0e, 0xb5, 0xe2, 0xec, 0xc5, 0xfc, 0xb0, 0x30,
+	0xab, 0x58, 0xa6, 0xbe, 0x4d, 0xa1, 0x64, 0x0e, 0x8d, 0x5c, 0x48, 0xb8, 0xd6, 0x01, 0x36, 0x89,
+	0xf9, 0x62, 0xcb, 0xe9, 0x8b, 0x4b, 0x25, 0x2e, 0x07, 0xa9, 0x5f, 0x25, 0x5e, 0xbf, 0x4a, 0x6b,
+	0x96, 0x61, 0x56, 0x56, 0x79, 0x20, 0xcd, 0x30, 0x25, 0x18, 0x9a, 0xf4, 0xf3, 0x8f, 0x0b, 0xcb,
+	0x0d, 0xc3, 0xdd, 0xef, 0xd4, 0x4b, 0x9a, 0xd5, 0xe2, 0x05, 0x8d, 0xff, 0x5b, 0x71, 0xf4, 0x83,
+	0xb2, 0xdb, 0x6d, 0x63, 0x87, 0x52, 0x70, 0x64, 0xce, 0x0b, 0xfd, 0x48, 0x80, 0xac, 0x8e, 0x9b,
+	0xb8, 0x41, 0x6d, 0xec, 0xec, 0xab, 0x36, 0x76, 0xc4, 0x04, 0x15, 0xe0, 0xdc, 0x50, 0x01, 0xaa,
+	0x58, 0xa3, 0x32, 0x6c, 0x72, 0x19, 0xb8, 0x21, 0xa3, 0x34, 0x88, 0x34, 0x9f, 0x1b, 0x43, 0x1a,
+	0x4e, 0xce, 0x91, 0x33, 0x3e, 0x85, 0x6d, 0x4a, 0x00, 0x5d, 0x85, 0xb4, 0x1e, 0x84, 0xbd, 0x38,
+	0x45, 0x1d, 0x5a, 0x18, 0x66, 0xcc, 0x50, 0x76, 0x54, 0xe2, 0x44, 0x2c, 0x39, 0x8c, 0x49, 0xc2,
+	0xac, 0x63, 0xd6, 0x2d, 0x53, 0x37, 0xcc, 0x86, 0xb2, 0x8f, 0x8d, 0xc6, 0xbe, 0x2b, 0x26, 0x8b,
+	0xc2, 0x72, 0x2c, 0x1c, 0x66, 0x51, 0x08, 0x49, 0xce, 0xf8, 0x5b, 0xd7, 0xe8, 0x0e, 0xd2, 0x61,
+	0x36, 0x80, 0xa2, 0x29, 0x9f, 0x1a, 0x99, 0xf2, 0xaf, 0x72, 0x2b, 0x9d, 0x8e, 0x72, 0x09, 0xb2,
+	0x7e, 0xc6, 0xdf, 0x24, 0x68, 0xa8, 0x0a, 0x10, 0x14, 0x1a, 0x11, 0x28, 0x87, 0xfc, 0x8b, 0x2b,
+	0x15, 0x57, 0x3a, 0x84, 0x87, 0xee, 0x0a, 0x30, 0x73, 0xc7, 0x72, 0x09, 0xa7, 0xb6, 0x75, 0x88,
+	0x6d, 0x47, 0x4c, 0x8f, 0x0a, 0xaa, 0x6b, 0x5c, 0xd4, 0x05, 0x26, 0x6a, 0x1f, 0xf6, 0xc9, 0x62,
+	0x6b, 0x9a, 0xe1, 0x6e, 0x51, 0x54, 0x74, 0x0b, 0xa6, 0xc3, 0xb4, 0xc4, 0x69, 0x9a, 0xe1, 0x5f,
+	0xe0, 0x0d, 0xe1, 0xf4, 0x60, 0x43, 0xa8, 0x99, 0xee, 0x71, 0xaf, 0x30, 0x3f, 0x28, 0x86, 0x24,
+	0xa7, 0x43, 0x94, 0xd1, 0x97, 0xe0, 0x6c, 0x60, 0x4f, 0xcb, 0x54, 0xf6, 0xad, 0xa6, 0xae, 0xd8,
+	0x78, 0x4f, 0xd1, 0xac, 0x8e, 0xe9, 0x8a, 0x33, 0xc4, 0xc5, 0xf2, 0x19, 0x1f, 0xe4, 0x86, 0x79,
+	0xcd, 0x6a, 0xea, 0x32, 0xde, 0x5b, 0x23, 0xc7, 0xe8, 0x55, 0x98, 0x0e, 0xb0, 0x0d, 0x5d, 0x9c,
+	0x2d, 0x0a, 0xcb, 0x71, 0x39, 0xed, 0xef, 0xd5, 0xf4, 0xcb, 0xd3, 0x77, 0x1f, 0x16, 0x26, 0x78,
+	0xed, 0x9a, 0x90, 0x2e, 0xc1, 0xf4, 0xae, 0xda, 0xe4, 0x35, 0x07, 0x3b, 0xe8, 0x1c, 0xa4, 0x54,
+	0x6f, 0x21, 0x0a, 0xc5, 0xd8, 0x72, 0x4a, 0x0e, 0x36, 0x58, 0xcd, 0xfb, 0xf6, 0x5f, 0x8a, 0x82,
+	0xf4, 0x81, 0x00, 0x89, 0xea, 0xee, 0x96, 0x6a, 0xd8, 0xa8, 0x06, 0x73, 0x41, 0x9e, 0xf4, 0x57,
+	0xbc, 0x73, 0xc7, 0xbd, 0x82, 0x18, 0x4d, 0x25, 0xbf, 0xe4, 0x05, 0x29, 0xea, 0xd5, 0xbc, 0x1a,
+	0xcc, 0xdd, 0xf1, 0x0a, 0xa9, 0x4f, 0x6a, 0x32, 0x4a, 0x6a, 0x00, 0x44, 0x92, 0xb3, 0xfe, 0x1e,
+	0x27, 0x15, 0x51, 0x73, 0x15, 0xa6, 0x98, 0xb4, 0x0e, 0xba, 0x04, 0xa7, 0xda, 0xe4, 0x83, 0x6a,
+	0x47, 0xe2, 0x7c, 0x58, 0xee, 0x51, 0x58, 0x1e, 0x81, 0x0c, 0x5c, 0xfa, 0xc1, 0x24, 0x40, 0x75,
+	0x77, 0x77, 0xc7, 0x36, 0xda, 0x4d, 0xec, 0x7e, 0x92, 0x5a, 0xef, 0xc0, 0xe9, 0x40, 0x25, 0xc7,
+	0xd6, 0x22, 0x9a, 0x17, 0x8f, 0x7b, 0x85, 0x73, 0x51, 0xcd, 0x43, 0x60, 0x92, 0x3c, 0xef, 0xef,
+	0x6f, 0xdb, 0xda, 0x50, 0xaa, 0xba, 0xe3, 0xfa, 0x54, 0x63, 0xcf, 0xa7, 0x1a, 0x02, 0x0b, 0x53,
+	0xad, 0x3a, 0xee, 0x70, 0xb3, 0xde, 0x80, 0x74, 0x60, 0x12, 0x07, 0xbd, 0x05, 0x49, 0x97, 0x7f,
+	0x73, 0xeb, 0xe6, 0x87, 0x5b, 0xd7, 0x43, 0xe1, 0x16, 0xf6, 0xb1, 0xa4, 0x0f, 0x88, 0x91, 0x99,
+	0x7d, 0x48, 0xc2, 0x7f, 0x26, 0x43, 0x0b, 0xbd, 0x0b, 0x09, 0xde, 0x52, 0x62, 0x63, 0xb4, 0x94,
+	0x6a, 0x7f, 0x5b, 0x7b, 0xc9, 0x46, 0xc2, 0x39, 0x46, 0xec, 0xff, 0xbd, 0x49, 0x98, 0xbf, 0xe9,
+	0xe5, 0xf6, 0x67, 0xde, 0x6e, 0x1b, 0x30, 0x85, 0x4d, 0xd7, 0x36, 0x7c, 0xc3, 0xbd, 0x3e, 0x2c,
+	0x3a, 0x86, 0xe8, 0xb3, 0x6e, 0xba, 0x76, 0x97, 0xc7, 0x8a, 0x47, 0x22, 0x62, 0x89, 0xdf, 0xc4,
+	0x41, 0x7c, 0x1e, 0x26, 0x5a, 0x83, 0x8c, 0x66, 0x63, 0xba, 0xe1, 0xb5, 0x4a, 0x81, 0xb6, 0xca,
+	0x5c, 0x70, 0x3b, 0x8f, 0x00, 0x48, 0xf2, 0xac, 0xb7, 0xc3, 0x1b, 0x65, 0x03, 0xc8, 0x5d, 0x99,
+	0x84, 0x29, 0x81, 0x1a, 0xf3, 0x72, 0x2c, 0x71, 0xe7, 0x7b, 0x4c, 0xfa, 0x09, 0xb0, 0x56, 0x39,
+	0x1b, 0xec, 0xd2, 0x5e, 0xf9, 0x7d, 0x01, 0x32, 0xcc, 0x2e, 0x4d, 0xa5, 0xae, 0x36, 0x55, 0x53,
+	0xc3, 0x62, 0x6c, 0x54, 0x9f, 0xbb, 0xde, 0xcf, 0x28, 0x82, 0x7f, 0xb2, 0x4e, 0x37, 0xcb, 0xb1,
+	0x2b, 0x0c, 0x19, 0x1d, 0xc2, 0x94, 0x27, 0x47, 0x7c, 0x94, 0x1c, 0x15, 0x2e, 0xc7, 0x2c, 0x93,
+	0xe3, 0xa5, 0xf8, 0x7b, 0xdc, 0x06, 0xba, 0xd9, 0xa9, 0x81, 0x6e, 0x36, 0xaa, 0x5d, 0x26, 0x5e,
+	0xd8, 0x2e, 0x43, 0x77, 0xf8, 0xdf, 0xc6, 0x61, 0x4e, 0xc6, 0xfa, 0xff, 0x02, 0xe7, 0x93, 0x0c,
+	0x9c, 0xef, 0x0a, 0x00, 0xac, 0x6e, 0x91, 0xce, 0x22, 0xc6, 0xc7, 0xa8, 0x96, 0xde, 0x7d, 0x6d,
+	0x2e, 0x5c, 0x2d, 0x09, 0xf6, 0x89, 0x2b, 0x66, 0x8a, 0xe1, 0x56, 0x1d, 0xf7, 0xd3, 0x8c, 0xa3,
+	0x8f, 0x26, 0x61, 0x3a, 0x1c, 0x47, 0xff, 0xa5, 0xf7, 0x04, 0xb4, 0x1e, 0xd4, 0x7a, 0xe6, 0xf6,
+	0xff, 0x1b, 0x56, 0xeb, 0x07, 0x32, 0xee, 0xc5, 0x45, 0xfe, 0x7e, 0x12, 0x12, 0x5b, 0xaa, 0xad,
+	0xb6, 0x1c, 0xa4, 0x0d, 0x3c, 0x5b, 0xd8, 0xf8, 0x63, 0x69, 0x20, 0xa7, 0xaa, 0x7c, 0x66, 0x35,
+	0xe2, 0xd5, 0x72, 0x7f, 0xc8, 0xab, 0xe5, 0x2d, 0x98, 0x25, 0x43, 0x1b, 0x5f, 0x3f, 0x66, 0xe9,
+	0x99, 0xca, 0x52, 0x40, 0xa5, 0xff, 0x5c, 0xa2, 0x03, 0x1c, 0x7f, 0x16, 0xe0, 0xa0, 0x37, 0x21,
+	0x4d, 0x20, 0x82, 0xb6, 0x47, 0xd0, 0x17, 0x83, 0x69, 0x49, 0xe8, 0x50, 0x92, 0xa1, 0xa5, 0x1e,
+	0xad, 0xb3, 0x05, 0xda, 0x00, 0xb4, 0x6f, 0x38, 0xae, 0x65, 0x1b, 0x9a, 0xda, 0x54, 0x02, 0x53,
+	0x12, 0xfc, 0x57, 0x8e, 0x7b, 0x85, 0x25, 0x86, 0x3f, 0x08, 0x23, 0xc9, 0x73, 0xc1, 0xa6, 0x47,
+	0xed, 0x4d, 0x48, 0x13, 0xbd, 0x14, 0x1d, 0x9b, 0x56, 0x8b, 0x3d, 0xc5, 0x53, 0x61, 0x31, 0x42,
+	0x87, 0x92, 0x0c, 0x64, 0x55, 0xa5, 0x0b, 0xb4, 0x0e, 0xd9, 0x96, 0x61, 0x2a, 0x7d, 0x4f, 0x1d,
+	0x92, 0x0b, 0xf1, 0xf0, 0x2b, 0x33, 0x0a, 0x21, 0xc9, 0xb3, 0x2d, 0xc3, 0xdc, 0x0d, 0x3d, 0x6a,
+	0xbe, 0x23, 0xc0, 0x3c, 0x81, 0x8a, 0xcc, 0xac, 0xe8, 0xf3, 0x37, 0x55, 0xd9, 0x19, 0x6f, 0x8c,
+	0x96, 0x0b, 0xb8, 0x45, 0xe8, 0x0c, 0x1d, 0xa5, 0xcd, 0xb5, 0x0c, 0xb3, 0x7f, 0xee, 0x85, 0x7e,
+	0x28, 0x40, 0xf6, 0x00, 0x77, 0x15, 0xdb, 0x72, 0x59, 0xc9, 0xde, 0xc3, 0x58, 0x4c, 0x8e, 0xaa,
+	0x90, 0x5b, 0xfd, 0x33, 0x81, 0x28, 0x81, 0x13, 0x95, 0x48, 0x26, 0xda, 0xec, 0x01, 0xee, 0xca,
+	0x9c, 0xc4, 0x15, 0x4c, 0xe5, 0x5a, 0x6a, 0x34, 0xad, 0xba, 0xda, 0x54, 0x9a, 0xc6, 0xb7, 0x3a,
+	0x86, 0xae, 0xf0, 0x1c, 0x51, 0x34, 0xb5, 0x4d, 0xdf, 0xe3, 0xa9, 0xca, 0xed, 0xf1, 0x8c, 0x54,
+	0x64, 0x42, 0x3e, 0x97, 0xda, 0x50, 0x53, 0x2d, 0x32, 0xf0, 0x0d, 0x0a, 0xbd, 0xcd, 0x80, 0xd7,
+	0xd4, 0x36, 0xfa, 0x89, 0x00, 0xe7, 0x82, 0xa4, 0x1f, 0x22, 0x1a, 0x50, 0xd1, 0xde, 0x19, 0x4f,
+	0xb4, 0xd7, 0xa2, 0x55, 0x64, 0x4c, 0xe9, 0x96, 0x7c, 0x8c, 0xa8, 0x80, 0xa1, 0xba, 0xdb, 0x8b,
+	0xc1, 0xd2, 0x9a, 0x65, 0x3a, 0x7c, 0xae, 0xc5, 0x8d, 0x7b, 0x8d, 0x66, 0x42, 0x17, 0x6d, 0x3c,
+	0x77, 0x26, 0xf7, 0xea, 0x93, 0x47, 0x2b, 0xaf, 0x70, 0x8e, 0xbb, 0x91, 0x0b, 0xeb, 0xb6, 0x6b,
+	0x1b, 0x66, 0x63, 0x70, 0x32, 0xb7, 0x0b, 0x19, 0xd2, 0x1d, 0xc8, 0xe8, 0x6c, 0x9c, 0xc1, 0x9c,
+	0xf8, 0xdc, 0xe9, 0xdb, 0x8c, 0xd5, 0xd4, 0xb9, 0xd0, 0x64, 0xf6, 0xb6, 0x0b, 0x19, 0x13, 0x1f,
+	0xf6, 0xd1, 0x8d, 0xbd, 0x1c, 0x5d, 0x13, 0x1f, 0x86, 0xe8, 0x2e, 0x42, 0x82, 0x5f, 0x5e, 0xe2,
+	0xb4, 0x8d, 0xf1, 0x15, 0xfa, 0x32, 0xc4, 0x69, 0xe5, 0x3c, 0x35, 0xf2, 0x36, 0x32, 0x43, 0x3c,
+	0x4c, 0xee, 0x1c, 0x7c, 0xf8, 0x4e, 0xd0, 0x50, 0x1d, 0x62, 0x24, 0x7f, 0x12, 0xa3, 0xf2, 0xe7,
+	0x8b, 0x04, 0xf9, 0xe4, 0x49, 0x42, 0x88, 0x5f, 0x4e, 0xde, 0xf5, 0x6a, 0xff, 0x43, 0x01, 0xe6,
+	0x77, 0xc8, 0x74, 0xcf, 0x78, 0x17, 0xd3, 0x59, 0x9a, 0x8c, 0x35, 0xcb, 0xd6, 0xd1, 0x2c, 0x4c,
+	0x1a, 0x3a, 0x75, 0x66, 0x5c, 0x9e, 0x34, 0x74, 0x54, 0x82, 0x53, 0xd6, 0xa1, 0x89, 0x6d, 0xde,
+	0x14, 0xc5, 0x27, 0x81, 0x91, 0xfa, 0xdd, 0xca, 0xc0, 0xd0, 0x57, 0x20, 0xe5, 0xc7, 0x97, 0x18,
+	0x1b, 0x37, 0x26, 0x02, 0x9c, 0x90, 0x88, 0x7f, 0x14, 0x00, 0x05, 0x4f, 0x0f, 0x19, 0x3b, 0x6d,
+	0xcb, 0x74, 0xe8, 0xec, 0x2b, 0xe8, 0x72, 0xbc, 0x4d, 0x0d, 0x7f, 0x17, 0xfb, 0x50, 0xde, 0xec,
+	0x2b, 0xc0, 0x43, 0x38, 0xb8, 0x84, 0x4f, 0x8e, 0xb2, 0xf8, 0xe7, 0x4f, 0x6a, 0x71, 0xff, 0xca,
+	0xed, 0x67, 0xd4, 0x84, 0xf4, 0x37, 0x01, 0x96, 0x06, 0xfa, 0xb3, 0xaf, 0xd4, 0x6d, 0x40, 0x76,
+	0xe8, 0x90, 0x76, 0x9f, 0x2e, 0x57, 0xee, 0x44, 0xad, 0x7e, 0xce, 0x8e, 0x1e, 0x7c, 0x5a, 0xaa,
+	0xc6, 0x69, 0xe1, 0xf8, 0x95, 0x00, 0x0b, 0x61, 0xd9, 0x7c, 0x0d, 0xaf, 0xc3, 0x74, 0x58, 0x34,
+	0xae, 0x5b, 0x71, 0x94, 0x6e, 0x5c, 0xad, 0x3e, 0x5c, 0xf4, 0x76, 0x70, 0x1b, 0x62, 0x1a, 0xad,
+	0x8c, 0x65, 0x22, 0x4f, 0x96, 0xe8, 0xad, 0x28, 0x4e, 0x1d, 0xf4, 0xef, 0x18, 0xc4, 0xb7, 0x2c,
+	0xab, 0x89, 0xde, 0x17, 0x60, 0xce, 0xb4, 0x5c, 0x85, 0xf4, 0x6d, 0xac, 0x2b, 0x7c, 0xde, 0x2e,
+	0x8c, 0x32, 0x9d, 0x4a, 0x88, 0xfe, 0xb3, 0x57, 0x18, 0xc4, 0x0d, 0xee, 0xa5, 0x03, 0x47, 0x27,
+	0x7b, 0x10, 0x64, 0x4c, 0xcb, 0xad, 0x50, 0x74, 0x9a, 0xba, 0x0e, 0xfa, 0xb1, 0x00, 0x33, 0xfd,
+	0x62, 0x8e, 0xf4, 0xf0, 0xd7, 0xb9, 0x98, 0x33, 0x51, 0x11, 0x17, 0x82, 0xbb, 0xcb, 0x4b, 0x8a,
+	0x37, 0x5d, 0x0f, 0xcb, 0xf6, 0x53, 0x01, 0x16, 0xc2, 0xd7, 0x18, 0xe5, 0x90, 0x96, 0xc9, 0xf1,
+	0xa6, 0x3c, 0x32, 0xbf, 0x24, 0x9c, 0x1d, 0x1c, 0xf0, 0x7a, 0x74, 0x4e, 0xfc, 0x82, 0x41, 0xa1,
+	0xb9, 0xf0, 0x2d, 0x46, 0xe3, 0x72, 0x92, 0x04, 0xed, 0xbf, 0x1e, 0x16, 0x84, 0xf3, 0xbf, 0x14,
+	0x00, 0x82, 0x1f, 0x5c, 0xd0, 0xeb, 0x70, 0xa6, 0x72, 0x63, 0xb3, 0xaa, 0x6c, 0xef, 0xac, 0xee,
+	0xdc, 0xdc, 0x56, 0x6e, 0x6e, 0x6e, 0x6f, 0xad, 0xaf, 0xd5, 0xae, 0xd4, 0xd6, 0xab, 0xd9, 0x89,
+	0x5c, 0xe6, 0xde, 0x83, 0x62, 0xfa, 0xa6, 0xe9, 0xb4, 0xb1, 0x66, 0xec, 0x19, 0x58, 0x47, 0xff,
+	0x0f, 0x0b, 0xfd, 0xd0, 0x64, 0xb5, 0x5e, 0xcd, 0x0a, 0xb9, 0xe9, 0x7b, 0x0f, 0x8a, 0x49, 0x36,
+	0x49, 0xc1, 0x3a, 0x5a, 0x86, 0xd3, 0x83, 0x70, 0xb5, 0xcd, 0xab, 0xd9, 0xc9, 0xdc, 0xcc, 0xbd,
+	0x07, 0xc5, 0x94, 0x3f, 0x72, 0x41, 0x12, 0xa0, 0x30, 0x24, 0xa7, 0x17, 0xcb, 0xc1, 0xbd, 0x07,
+	0xc5, 0x04, 0x8b, 0x81, 0x5c, 0xfc, 0xee, 0xcf, 0xf2, 0x13, 0xe7, 0xdf, 0x01, 0xa8, 0x99, 0x7b,
+	0xb6, 0xaa, 0xd1, 0xd4, 0xc8, 0xc1, 0x62, 0x6d, 0xf3, 0x8a, 0xbc, 0xba, 0xb6, 0x53, 0xbb, 0xb1,
+	0xd9, 0x2f, 0x76, 0xe4, 0xac, 0x7a, 0xe3, 0x66, 0x65, 0x63, 0x5d, 0xd9, 0xae, 0x5d, 0xdd, 0xcc,
+	0x0a, 0xe8, 0x0c, 0xcc, 0xf7, 0x9d, 0xdd, 0xda, 0xdc, 0xa9, 0xbd, 0xbd, 0x9e, 0x9d, 0xac, 0x5c,
+	0xfb, 0xf0, 0x69, 0x5e, 0x78, 0xfc, 0x34, 0x2f, 0xfc, 0xf5, 0x69, 0x5e, 0x78, 0xef, 0x59, 0x7e,
+	0xe2, 0xf1, 0xb3, 0xfc, 0xc4, 0x9f, 0x9f, 0xe5, 0x27, 0x6e, 0x97, 0x42, 0xc6, 0x67, 0xe9, 0xb7,
+	0xd2, 0x54, 0xeb, 0x0e, 0xff, 0x2e, 0x1f, 0x05, 0x3f, 0xce, 0x53, 0x47, 0xd4, 0x13, 0xb4, 0x13,
+	0xbe, 0xf1, 0x9f, 0x01, 0x00, 0xe1, 0x39, 0x54, 0x3f, 0xbc, 0x1f, 0x00, 0x00,
 }
 
 func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
@@ -1364,542 +1493,537 @@ func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_go
 func StakingDescription() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
 	d := &github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet{}
 	var gzipped = []byte{
-		// 8547 bytes of a gzipped FileDescriptorSet
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x7b, 0x90, 0x5b, 0xd7,
-		0x79, 0x1f, 0x2f, 0x80, 0xc5, 0xe3, 0xdb, 0x5d, 0xe0, 0xee, 0xd9, 0x25, 0x09, 0x82, 0x12, 0x77,
-		0x05, 0xc5, 0x12, 0x45, 0x49, 0x4b, 0x91, 0xa2, 0x48, 0x11, 0xb2, 0x23, 0xe3, 0xc5, 0x25, 0x56,
-		0x58, 0x60, 0x73, 0x81, 0xe5, 0x43, 0x69, 0xe6, 0xf6, 0xee, 0xc5, 0xd9, 0x5d, 0x88, 0xc0, 0xbd,
-		0x08, 0xee, 0x05, 0xc9, 0x55, 0x67, 0x3c, 0x72, 0xed, 0x24, 0x8a, 0x1d, 0xbb, 0x76, 0x93, 0xa9,
-		0x69, 0x3b, 0x72, 0x6c, 0x65, 0x6a, 0xbb, 0x8e, 0xd3, 0x3a, 0x89, 0xeb, 0xd4, 0xed, 0xa4, 0xd3,
-		0x4e, 0xc7, 0xb5, 0xe3, 0x3e, 0xc6, 0xe9, 0x4c, 0x3b, 0x9e, 0x4c, 0x87, 0x69, 0x6d, 0x4f, 0x25,
-		0x3f, 0xd2, 0x24, 0xaa, 0xda, 0x49, 0xc7, 0xc9, 0xb8, 0x73, 0x5e, 0xf7, 0x05, 0xec, 0x02, 0xcb,
-		0x26, 0x1e, 0xcd, 0xb4, 0xff, 0x90, 0xb8, 0xe7, 0x7c, 0xbf, 0xdf, 0xf9, 0xce, 0x77, 0xbe, 0xf3,
-		0x9d, 0xef, 0x9c, 0xfb, 0x58, 0xf8, 0xcb, 0x36, 0x2c, 0x6d, 0x9b, 0xe6, 0x76, 0x07, 0x9f, 0xee,
-		0xf5, 0x4d, 0xdb, 0xdc, 0x1c, 0x6c, 0x9d, 0x6e, 0x61, 0x4b, 0xef, 0xb7, 0x7b, 0xb6, 0xd9, 0x5f,
-		0xa6, 0x65, 0x28, 0xc5, 0x24, 0x96, 0x85, 0x44, 0xf6, 0xeb, 0x12, 0xcc, 0x5d, 0x6a, 0x77, 0x70,
-		0xc9, 0x91, 0x6c, 0x60, 0x1b, 0x3d, 0x0d, 0x91, 0xad, 0x76, 0x07, 0xa7, 0xa5, 0xa5, 0xf0, 0xc9,
-		0xe9, 0xb3, 0x3f, 0xb1, 0x1c, 0x40, 0x2d, 0xfb, 0x11, 0xeb, 0xa4, 0x58, 0xa1, 0x88, 0x53, 0x7f,
-		0x2b, 0xfe, 0xd2, 0x0f, 0xbe, 0xfe, 0x23, 0x49, 0x7e, 0x37, 0xf9, 0x37, 0xd3, 0x45, 0x37, 0xd8,
-		0x35, 0x3a, 0xb7, 0x4c, 0x70, 0x1e, 0x7d, 0x6e, 0x9e, 0x21, 0x25, 0x2a, 0x01, 0xa9, 0x6e, 0xb1,
-		0x6a, 0x61, 0x5b, 0xc5, 0xb7, 0x6d, 0x6c, 0x58, 0x6d, 0xd3, 0xc8, 0x3c, 0x3e, 0x02, 0x35, 0xa4,
-		0x6d, 0x59, 0x88, 0x67, 0xbf, 0x32, 0x05, 0xf3, 0x23, 0x54, 0x43, 0x08, 0x22, 0x86, 0xd6, 0x25,
-		0xdd, 0x91, 0x4e, 0x26, 0x14, 0xfa, 0x1b, 0xa5, 0x21, 0xd6, 0xd3, 0xf4, 0x1b, 0xda, 0x36, 0x4e,
-		0x87, 0x68, 0xb1, 0xb8, 0x44, 0x27, 0x00, 0x5a, 0xb8, 0x87, 0x8d, 0x16, 0x36, 0xf4, 0xdd, 0x74,
-		0x78, 0x29, 0x7c, 0x32, 0xa1, 0x78, 0x4a, 0xd0, 0xa3, 0x30, 0xd7, 0x1b, 0x6c, 0x76, 0xda, 0xba,
-		0xea, 0x11, 0x83, 0xa5, 0xf0, 0xc9, 0x29, 0x45, 0x66, 0x15, 0x25, 0x57, 0xf8, 0x61, 0x48, 0xdd,
-		0xc2, 0xda, 0x0d, 0xaf, 0xe8, 0x34, 0x15, 0x4d, 0x92, 0xe2, 0x92, 0x8f, 0xd5, 0xec, 0xd9, 0x6d,
-		0xd3, 0xf0, 0x8a, 0xa6, 0x68, 0xe3, 0x32, 0xab, 0xf0, 0x08, 0x17, 0x61, 0xa6, 0x8b, 0x2d, 0x4b,
-		0xdb, 0xc6, 0xaa, 0xbd, 0xdb, 0xc3, 0xe9, 0x08, 0x1d, 0xa7, 0xa5, 0xa1, 0x71, 0x0a, 0x8e, 0xd1,
-		0x34, 0x47, 0x35, 0x77, 0x7b, 0x18, 0xe5, 0x21, 0x81, 0x8d, 0x41, 0x97, 0x31, 0x4c, 0xed, 0x31,
-		0xd2, 0x65, 0x63, 0xd0, 0x0d, 0xb2, 0xc4, 0x09, 0x8c, 0x53, 0xc4, 0x2c, 0xdc, 0xbf, 0xd9, 0xd6,
-		0x71, 0x3a, 0x4a, 0x09, 0x1e, 0x1e, 0x22, 0x68, 0xb0, 0xfa, 0x20, 0x87, 0xc0, 0xa1, 0x22, 0x24,
-		0x9c, 0xf1, 0x4e, 0xc7, 0x28, 0xc9, 0xdb, 0x46, 0xf8, 0x1b, 0xee, 0xb4, 0x82, 0x14, 0x2e, 0x0e,
-		0x9d, 0x87, 0x18, 0xb3, 0x91, 0x95, 0x8e, 0x2f, 0x49, 0x27, 0xa7, 0xcf, 0xde, 0x37, 0xd2, 0x65,
-		0xeb, 0x4c, 0x46, 0x11, 0xc2, 0xa8, 0x02, 0xb2, 0x65, 0x0e, 0xfa, 0x3a, 0x56, 0x75, 0xb3, 0x85,
-		0xd5, 0xb6, 0xb1, 0x65, 0xa6, 0x13, 0x94, 0x60, 0x71, 0xb8, 0x23, 0x54, 0xb0, 0x68, 0xb6, 0x70,
-		0xc5, 0xd8, 0x32, 0x95, 0xa4, 0xe5, 0xbb, 0x46, 0x47, 0x20, 0x6a, 0xed, 0x1a, 0xb6, 0x76, 0x3b,
-		0x3d, 0x43, 0xdd, 0x89, 0x5f, 0xa1, 0xb3, 0x10, 0xc3, 0xad, 0x36, 0x69, 0x2e, 0x9d, 0x5c, 0x92,
-		0x4e, 0x26, 0xcf, 0xa6, 0x87, 0x6d, 0xcc, 0xea, 0x15, 0x21, 0x98, 0xfd, 0x8b, 0x28, 0xa4, 0x26,
-		0xf1, 0xe1, 0x67, 0x60, 0x6a, 0x8b, 0x58, 0x26, 0x1d, 0x3a, 0x88, 0xdd, 0x18, 0xc6, 0x6f, 0xf8,
-		0xe8, 0x3d, 0x1a, 0x3e, 0x0f, 0xd3, 0x06, 0xb6, 0x6c, 0xdc, 0x62, 0x5e, 0x14, 0x9e, 0xd0, 0x0f,
-		0x81, 0x81, 0x86, 0xdd, 0x30, 0x72, 0x4f, 0x6e, 0x78, 0x0d, 0x52, 0x8e, 0x4a, 0x6a, 0x5f, 0x33,
-		0xb6, 0x85, 0x3f, 0x9f, 0x1e, 0xa7, 0xc9, 0xb2, 0x13, 0x3c, 0x14, 0x02, 0x53, 0x92, 0xd8, 0x77,
-		0x8d, 0x4a, 0x00, 0xa6, 0x81, 0xcd, 0x2d, 0xb5, 0x85, 0xf5, 0x4e, 0x3a, 0xbe, 0x87, 0x95, 0xea,
-		0x44, 0x64, 0xc8, 0x4a, 0x26, 0x2b, 0xd5, 0x3b, 0xe8, 0xa2, 0xeb, 0x9e, 0xb1, 0x3d, 0xbc, 0x6b,
-		0x8d, 0x4d, 0xcc, 0x21, 0x0f, 0xdd, 0x80, 0x64, 0x1f, 0x93, 0xb9, 0x82, 0x5b, 0xbc, 0x67, 0x09,
-		0xaa, 0xc4, 0xf2, 0xd8, 0x9e, 0x29, 0x1c, 0xc6, 0x3a, 0x36, 0xdb, 0xf7, 0x5e, 0xa2, 0x07, 0xc1,
-		0x29, 0x50, 0xa9, 0x5b, 0x01, 0x8d, 0x34, 0x33, 0xa2, 0xb0, 0x46, 0xdc, 0x2b, 0x0f, 0x70, 0xb3,
-		0x6d, 0xb5, 0x37, 0xdb, 0x9d, 0xb6, 0x4d, 0xc2, 0x16, 0xf1, 0xde, 0x07, 0x86, 0xe7, 0xc5, 0x6e,
-		0x77, 0xd3, 0xec, 0x5c, 0x71, 0x04, 0x15, 0x0f, 0x28, 0xf3, 0x22, 0x24, 0xfd, 0x16, 0x46, 0x0b,
-		0x30, 0x65, 0xd9, 0x5a, 0xdf, 0xa6, 0x8e, 0x3c, 0xa5, 0xb0, 0x0b, 0x24, 0x43, 0x18, 0x1b, 0x2d,
-		0x1a, 0x89, 0xa7, 0x14, 0xf2, 0x13, 0xbd, 0xd3, 0xb5, 0x59, 0x98, 0xda, 0xec, 0xa1, 0x61, 0xa7,
-		0xf0, 0x31, 0x07, 0x4d, 0x97, 0xb9, 0x00, 0xb3, 0x3e, 0x1b, 0x4c, 0xda, 0x74, 0xf6, 0xdf, 0x46,
-		0xe0, 0xf0, 0x48, 0x6e, 0x74, 0x0d, 0x16, 0x06, 0x46, 0xdb, 0xb0, 0x71, 0xbf, 0xd7, 0xc7, 0xc4,
-		0xeb, 0x59, 0x5b, 0xe9, 0xd7, 0x62, 0x7b, 0xf8, 0xed, 0x86, 0x57, 0x9a, 0xb1, 0x28, 0xf3, 0x83,
-		0xe1, 0x42, 0x74, 0x1d, 0xa6, 0x89, 0x8b, 0x69, 0x7d, 0x8d, 0x12, 0xb2, 0x09, 0x7d, 0x76, 0xb2,
-		0x2e, 0x2f, 0x97, 0x5c, 0x64, 0x21, 0xfc, 0xb2, 0x14, 0x52, 0xbc, 0x5c, 0xe8, 0x02, 0xc4, 0xb7,
-		0xb0, 0x66, 0x0f, 0xfa, 0xd8, 0x4a, 0x9f, 0xa5, 0xa6, 0x3c, 0x3e, 0x3c, 0xcf, 0x99, 0x40, 0x03,
-		0xdb, 0x8a, 0x23, 0x8c, 0xba, 0x30, 0x73, 0x13, 0xf7, 0xdb, 0x5b, 0x6d, 0x9d, 0x29, 0x15, 0xa6,
-		0x1e, 0xf0, 0xf4, 0x84, 0x4a, 0x5d, 0xf1, 0x40, 0x1b, 0xb6, 0x66, 0xe3, 0x1c, 0x6c, 0xd4, 0xae,
-		0x94, 0x95, 0xca, 0xa5, 0x4a, 0xb9, 0xc4, 0xd4, 0xf4, 0xd1, 0x67, 0x7e, 0x45, 0x82, 0x69, 0x4f,
-		0x4f, 0x48, 0x44, 0x35, 0x06, 0xdd, 0x4d, 0xdc, 0xe7, 0xe3, 0xc5, 0xaf, 0xd0, 0x71, 0x48, 0x6c,
-		0x0d, 0x3a, 0x1d, 0xe6, 0xb7, 0x6c, 0xed, 0x8e, 0x93, 0x02, 0xea, 0xb3, 0x08, 0x22, 0x3c, 0x12,
-		0xd1, 0x30, 0x49, 0x7e, 0xa3, 0x0c, 0xc4, 0x85, 0x5f, 0xa7, 0xa7, 0x96, 0xa4, 0x93, 0x71, 0xc5,
-		0xb9, 0x66, 0x75, 0x3d, 0xac, 0xd9, 0xb8, 0x95, 0x8e, 0x8a, 0x3a, 0x76, 0xbd, 0x1a, 0x89, 0x47,
-		0xe4, 0xa9, 0xec, 0x39, 0x98, 0x1b, 0xea, 0x0a, 0x4a, 0xc1, 0x74, 0xa9, 0x5c, 0xac, 0xe6, 0x95,
-		0x7c, 0xb3, 0x52, 0xaf, 0xc9, 0x87, 0x50, 0x12, 0x3c, 0xbd, 0x93, 0xa5, 0x53, 0x89, 0xf8, 0xeb,
-		0x31, 0xf9, 0xa5, 0x97, 0x5e, 0x7a, 0x29, 0x94, 0xfd, 0x97, 0x51, 0x58, 0x18, 0x15, 0x47, 0x47,
-		0x86, 0x74, 0xb7, 0xd3, 0x61, 0x5f, 0xa7, 0xf3, 0x30, 0xd5, 0xd1, 0x36, 0x71, 0x27, 0x1d, 0xa1,
-		0x83, 0xf0, 0xe8, 0x44, 0x91, 0x7a, 0xb9, 0x4a, 0x20, 0x0a, 0x43, 0xa2, 0x9f, 0xe4, 0xa6, 0x99,
-		0xa2, 0x0c, 0xa7, 0x26, 0x63, 0x20, 0xf1, 0x95, 0x9b, 0xf1, 0x38, 0x24, 0xc8, 0xff, 0xcc, 0xee,
-		0x51, 0x66, 0x77, 0x52, 0x40, 0xed, 0x9e, 0x81, 0x38, 0x0d, 0x9d, 0x2d, 0xec, 0x8c, 0x89, 0xb8,
-		0x26, 0xc1, 0xa6, 0x85, 0xb7, 0xb4, 0x41, 0xc7, 0x56, 0x6f, 0x6a, 0x9d, 0x01, 0xa6, 0x41, 0x30,
-		0xa1, 0xcc, 0xf0, 0xc2, 0x2b, 0xa4, 0x0c, 0x2d, 0xc2, 0x34, 0x8b, 0xb4, 0x6d, 0xa3, 0x85, 0x6f,
-		0xd3, 0x55, 0x78, 0x4a, 0x61, 0xc1, 0xb7, 0x42, 0x4a, 0x48, 0xf3, 0x2f, 0x58, 0xa6, 0x21, 0xc2,
-		0x15, 0x6d, 0x82, 0x14, 0xd0, 0xe6, 0x2f, 0x04, 0x13, 0x80, 0xfb, 0x47, 0x77, 0x6f, 0x28, 0xbe,
-		0x3e, 0x0c, 0x29, 0x2a, 0xf1, 0x24, 0x9f, 0xca, 0x5a, 0x27, 0x3d, 0x47, 0xdd, 0x20, 0xc9, 0x8a,
-		0xeb, 0xbc, 0x34, 0xfb, 0xa5, 0x10, 0x44, 0xe8, 0x62, 0x93, 0x82, 0xe9, 0xe6, 0xf5, 0xf5, 0xb2,
-		0x5a, 0xaa, 0x6f, 0x14, 0xaa, 0x65, 0x59, 0x22, 0x43, 0x4f, 0x0b, 0x2e, 0x55, 0xeb, 0xf9, 0xa6,
-		0x1c, 0x72, 0xae, 0x2b, 0xb5, 0xe6, 0xf9, 0x73, 0x72, 0xd8, 0x01, 0x6c, 0xb0, 0x82, 0x88, 0x57,
-		0xe0, 0xc9, 0xb3, 0xf2, 0x14, 0x92, 0x61, 0x86, 0x11, 0x54, 0xae, 0x95, 0x4b, 0xe7, 0xcf, 0xc9,
-		0x51, 0x7f, 0xc9, 0x93, 0x67, 0xe5, 0x18, 0x9a, 0x85, 0x04, 0x2d, 0x29, 0xd4, 0xeb, 0x55, 0x39,
-		0xee, 0x70, 0x36, 0x9a, 0x4a, 0xa5, 0xb6, 0x22, 0x27, 0x1c, 0xce, 0x15, 0xa5, 0xbe, 0xb1, 0x2e,
-		0x83, 0xc3, 0xb0, 0x56, 0x6e, 0x34, 0xf2, 0x2b, 0x65, 0x79, 0xda, 0x91, 0x28, 0x5c, 0x6f, 0x96,
-		0x1b, 0xf2, 0x8c, 0x4f, 0xad, 0x27, 0xcf, 0xca, 0xb3, 0x4e, 0x13, 0xe5, 0xda, 0xc6, 0x9a, 0x9c,
-		0x44, 0x73, 0x30, 0xcb, 0x9a, 0x10, 0x4a, 0xa4, 0x02, 0x45, 0xe7, 0xcf, 0xc9, 0xb2, 0xab, 0x08,
-		0x63, 0x99, 0xf3, 0x15, 0x9c, 0x3f, 0x27, 0xa3, 0x6c, 0x11, 0xa6, 0xa8, 0x1b, 0x22, 0x04, 0xc9,
-		0x6a, 0xbe, 0x50, 0xae, 0xaa, 0xf5, 0x75, 0x32, 0x69, 0xf2, 0x55, 0x59, 0x72, 0xcb, 0x94, 0xf2,
-		0x7a, 0x39, 0xdf, 0x2c, 0x97, 0xe4, 0xb0, 0xb7, 0xec, 0xa7, 0x36, 0x2a, 0x4a, 0xb9, 0x24, 0x87,
-		0xb2, 0x3a, 0x2c, 0x8c, 0x5a, 0x64, 0x47, 0x4e, 0x21, 0x8f, 0x2f, 0x84, 0xf6, 0xf0, 0x05, 0xca,
-		0x15, 0xf4, 0x85, 0xec, 0xa7, 0xc3, 0x30, 0x3f, 0x22, 0xd1, 0x18, 0xd9, 0xc8, 0xb3, 0x30, 0xc5,
-		0x7c, 0x99, 0x45, 0xea, 0x47, 0x46, 0x66, 0x2c, 0xd4, 0xb3, 0x87, 0xd2, 0x2f, 0x8a, 0xf3, 0xa6,
-		0xac, 0xe1, 0x3d, 0x52, 0x56, 0x42, 0x31, 0xe4, 0xb0, 0x3f, 0x33, 0x94, 0x10, 0xb0, 0x9c, 0xe9,
-		0xfc, 0x24, 0x39, 0x13, 0x2d, 0x3b, 0x58, 0x62, 0x30, 0x35, 0x36, 0x31, 0x88, 0xde, 0x4b, 0x62,
-		0xf0, 0x0c, 0xcc, 0x0d, 0xe9, 0x32, 0xf1, 0x02, 0xfd, 0x1e, 0x09, 0xd2, 0x7b, 0xd9, 0x77, 0x4c,
-		0x54, 0x0d, 0xf9, 0xa2, 0xea, 0x33, 0xc1, 0x41, 0x78, 0x60, 0xef, 0x71, 0x1c, 0x72, 0x97, 0xcf,
-		0x48, 0x70, 0x64, 0xf4, 0xee, 0x66, 0xa4, 0x0e, 0x3f, 0x09, 0xd1, 0x2e, 0xb6, 0x77, 0x4c, 0x91,
-		0xad, 0x3f, 0x34, 0x22, 0x07, 0x24, 0xd5, 0x41, 0x7f, 0xe1, 0x28, 0x6f, 0x12, 0x19, 0xde, 0x6b,
-		0x8b, 0xc2, 0xb4, 0x19, 0xd2, 0xf4, 0x17, 0x43, 0x70, 0x78, 0x24, 0xf9, 0x48, 0x45, 0xef, 0x07,
-		0x68, 0x1b, 0xbd, 0x81, 0xcd, 0x32, 0x72, 0x16, 0xcc, 0x13, 0xb4, 0x84, 0xc6, 0x3f, 0x12, 0xa8,
-		0x07, 0xb6, 0x53, 0xcf, 0x16, 0x5a, 0x60, 0x45, 0x54, 0xe0, 0x69, 0x57, 0xd1, 0x08, 0x55, 0xf4,
-		0xc4, 0x1e, 0x3d, 0x1d, 0xf2, 0xed, 0x27, 0x40, 0xd6, 0x3b, 0x6d, 0x6c, 0xd8, 0xaa, 0x65, 0xf7,
-		0xb1, 0xd6, 0x6d, 0x1b, 0xdb, 0x6c, 0xc1, 0xce, 0x4d, 0x6d, 0x69, 0x1d, 0x0b, 0x2b, 0x29, 0x56,
-		0xdd, 0x10, 0xb5, 0x04, 0x41, 0x1d, 0xa8, 0xef, 0x41, 0x44, 0x7d, 0x08, 0x56, 0xed, 0x20, 0xb2,
-		0xbf, 0x99, 0x80, 0x69, 0xcf, 0x5e, 0x10, 0x3d, 0x00, 0x33, 0x2f, 0x68, 0x37, 0x35, 0x55, 0x1c,
-		0x06, 0x30, 0x4b, 0x4c, 0x93, 0xb2, 0x75, 0x7e, 0x20, 0xf0, 0x04, 0x2c, 0x50, 0x11, 0x73, 0x60,
-		0xe3, 0xbe, 0xaa, 0x77, 0x34, 0xcb, 0xa2, 0x46, 0x8b, 0x53, 0x51, 0x44, 0xea, 0xea, 0xa4, 0xaa,
-		0x28, 0x6a, 0xd0, 0x53, 0x30, 0x4f, 0x11, 0xdd, 0x41, 0xc7, 0x6e, 0xf7, 0x3a, 0x98, 0x1e, 0x73,
-		0x58, 0x74, 0xd5, 0x72, 0x34, 0x9b, 0x23, 0x12, 0x6b, 0x5c, 0x80, 0x68, 0x64, 0xa1, 0x12, 0xdc,
-		0x4f, 0x61, 0xdb, 0xd8, 0xc0, 0x7d, 0xcd, 0xc6, 0x2a, 0xfe, 0xd9, 0x81, 0xd6, 0xb1, 0x54, 0xcd,
-		0x68, 0xa9, 0x3b, 0x9a, 0xb5, 0x93, 0x5e, 0x20, 0x04, 0x85, 0x50, 0x5a, 0x52, 0x8e, 0x11, 0xc1,
-		0x15, 0x2e, 0x57, 0xa6, 0x62, 0x79, 0xa3, 0x75, 0x59, 0xb3, 0x76, 0x50, 0x0e, 0x8e, 0x50, 0x16,
-		0xcb, 0xee, 0xb7, 0x8d, 0x6d, 0x55, 0xdf, 0xc1, 0xfa, 0x0d, 0x75, 0x60, 0x6f, 0x3d, 0x9d, 0x3e,
-		0xee, 0x6d, 0x9f, 0x6a, 0xd8, 0xa0, 0x32, 0x45, 0x22, 0xb2, 0x61, 0x6f, 0x3d, 0x8d, 0x1a, 0x30,
-		0x43, 0x06, 0xa3, 0xdb, 0x7e, 0x11, 0xab, 0x5b, 0x66, 0x9f, 0x2e, 0xc3, 0xc9, 0x11, 0xd1, 0xcd,
-		0x63, 0xc1, 0xe5, 0x3a, 0x07, 0xac, 0x99, 0x2d, 0x9c, 0x9b, 0x6a, 0xac, 0x97, 0xcb, 0x25, 0x65,
-		0x5a, 0xb0, 0x5c, 0x32, 0xfb, 0xc4, 0xa1, 0xb6, 0x4d, 0xc7, 0xc0, 0xd3, 0xcc, 0xa1, 0xb6, 0x4d,
-		0x61, 0xde, 0xa7, 0x60, 0x5e, 0xd7, 0x59, 0x9f, 0xdb, 0xba, 0xca, 0xcf, 0x05, 0xac, 0xb4, 0xec,
-		0x33, 0x96, 0xae, 0xaf, 0x30, 0x01, 0xee, 0xe3, 0x16, 0xba, 0x08, 0x87, 0x5d, 0x63, 0x79, 0x81,
-		0x73, 0x43, 0xbd, 0x0c, 0x42, 0x9f, 0x82, 0xf9, 0xde, 0xee, 0x30, 0x10, 0xf9, 0x5a, 0xec, 0xed,
-		0x06, 0x61, 0x6f, 0xa3, 0x07, 0x43, 0x7d, 0xac, 0xd3, 0x6c, 0xf1, 0xa8, 0x57, 0xda, 0x53, 0x81,
-		0x96, 0x41, 0xd6, 0x75, 0x15, 0x1b, 0xda, 0x66, 0x07, 0xab, 0x5a, 0x1f, 0x1b, 0x9a, 0x95, 0x5e,
-		0xa4, 0xc2, 0x11, 0xbb, 0x3f, 0xc0, 0x4a, 0x52, 0xd7, 0xcb, 0xb4, 0x32, 0x4f, 0xeb, 0xd0, 0x29,
-		0x98, 0x33, 0x37, 0x5f, 0xd0, 0x99, 0x63, 0xa9, 0xbd, 0x3e, 0xde, 0x6a, 0xdf, 0x4e, 0xff, 0x04,
-		0xb5, 0x52, 0x8a, 0x54, 0x50, 0xb7, 0x5a, 0xa7, 0xc5, 0xe8, 0x11, 0x90, 0x75, 0x6b, 0x47, 0xeb,
-		0xf7, 0x68, 0x70, 0xb6, 0x7a, 0x9a, 0x8e, 0xd3, 0x6f, 0x63, 0xa2, 0xac, 0xbc, 0x26, 0x8a, 0x89,
-		0x63, 0x5b, 0xb7, 0xda, 0x5b, 0xb6, 0x60, 0x7c, 0x98, 0x39, 0x36, 0x2d, 0xe3, 0x6c, 0x27, 0x41,
-		0xee, 0xed, 0xf4, 0xfc, 0x0d, 0x9f, 0xa4, 0x62, 0xc9, 0xde, 0x4e, 0xcf, 0xdb, 0xee, 0x83, 0x30,
-		0x4b, 0x24, 0xdd, 0x46, 0x1f, 0x61, 0x29, 0x5c, 0x6f, 0xc7, 0xd3, 0xe2, 0x39, 0x38, 0x42, 0x84,
-		0xba, 0xd8, 0xd6, 0x5a, 0x9a, 0xad, 0x79, 0xa4, 0x1f, 0xa3, 0xd2, 0x0b, 0xbd, 0x9d, 0xde, 0x1a,
-		0xaf, 0xf4, 0xe9, 0xd9, 0x1f, 0x6c, 0xee, 0x3a, 0xfe, 0xf1, 0x38, 0xd3, 0x93, 0x94, 0x09, 0x0f,
-		0xb9, 0xe7, 0x1d, 0xcc, 0x5f, 0xdb, 0x7e, 0x2d, 0x9b, 0x83, 0x19, 0xaf, 0xdf, 0xa3, 0x04, 0x30,
-		0xcf, 0x97, 0x25, 0x92, 0x47, 0x15, 0xeb, 0x25, 0x92, 0x01, 0x3d, 0x5f, 0x96, 0x43, 0x24, 0x13,
-		0xab, 0x56, 0x9a, 0x65, 0x55, 0xd9, 0xa8, 0x35, 0x2b, 0x6b, 0x65, 0x39, 0xec, 0xd9, 0x1b, 0xac,
-		0x46, 0xe2, 0xa7, 0xe4, 0x47, 0x57, 0x23, 0xf1, 0x87, 0xe4, 0x87, 0xa9, 0x79, 0x86, 0x9c, 0x32,
-		0xfb, 0x66, 0x18, 0x92, 0xfe, 0xc3, 0x01, 0xf4, 0x76, 0x38, 0x2a, 0x4e, 0xff, 0x2c, 0x6c, 0xab,
-		0xb7, 0xda, 0x7d, 0x3a, 0x59, 0xbb, 0x1a, 0x5b, 0x38, 0x1d, 0xa7, 0x5c, 0xe0, 0x52, 0x0d, 0x6c,
-		0x5f, 0x6d, 0xf7, 0xc9, 0x54, 0xec, 0x6a, 0x36, 0xaa, 0xc2, 0xa2, 0x61, 0xaa, 0x96, 0xad, 0x19,
-		0x2d, 0xad, 0xdf, 0xf2, 0x9e, 0xbd, 0x6a, 0xba, 0x8e, 0x2d, 0xcb, 0x64, 0x8b, 0xa4, 0xc3, 0x72,
-		0x9f, 0x61, 0x36, 0xb8, 0xb0, 0xbb, 0x7a, 0xe4, 0xb9, 0x68, 0x60, 0x4e, 0x84, 0xf7, 0x9a, 0x13,
-		0xc7, 0x21, 0xd1, 0xd5, 0x7a, 0x2a, 0x36, 0xec, 0xfe, 0x2e, 0x4d, 0xff, 0xe3, 0x4a, 0xbc, 0xab,
-		0xf5, 0xca, 0xe4, 0x1a, 0x5d, 0x81, 0x87, 0x5c, 0x51, 0xb5, 0x83, 0xb7, 0x35, 0x7d, 0x57, 0xa5,
-		0xb9, 0x3e, 0x3d, 0xa9, 0x52, 0x75, 0xd3, 0xd8, 0xea, 0xb4, 0x75, 0xdb, 0xa2, 0xb1, 0x83, 0xc5,
-		0xbf, 0xac, 0x8b, 0xa8, 0x52, 0xc0, 0xaa, 0x65, 0x1a, 0x34, 0xc5, 0x2f, 0x0a, 0x69, 0x9f, 0xdb,
-		0xcc, 0xbc, 0x25, 0xdc, 0xc6, 0x3f, 0xf4, 0x11, 0x79, 0x6a, 0x35, 0x12, 0x9f, 0x92, 0xa3, 0xab,
-		0x91, 0x78, 0x54, 0x8e, 0xad, 0x46, 0xe2, 0x71, 0x39, 0xb1, 0x1a, 0x89, 0x27, 0x64, 0xc8, 0xbe,
-		0x32, 0x0b, 0x33, 0xde, 0x1d, 0x0b, 0xd9, 0x00, 0xea, 0x74, 0xc1, 0x95, 0x68, 0x48, 0x7e, 0x70,
-		0xdf, 0xfd, 0xcd, 0x72, 0x91, 0xac, 0xc4, 0xb9, 0x28, 0xdb, 0x1e, 0x28, 0x0c, 0x49, 0xb2, 0x20,
-		0x32, 0xc9, 0x30, 0xcb, 0xa5, 0xe2, 0x0a, 0xbf, 0x42, 0x2b, 0x10, 0x7d, 0xc1, 0xa2, 0xdc, 0x2c,
-		0x95, 0xfb, 0x89, 0xfd, 0xb9, 0x57, 0x1b, 0x94, 0x3c, 0xb1, 0xda, 0x50, 0x6b, 0x75, 0x65, 0x2d,
-		0x5f, 0x55, 0x38, 0x1c, 0x1d, 0x83, 0x48, 0x47, 0x7b, 0x71, 0xd7, 0xbf, 0x66, 0xd3, 0x22, 0xb4,
-		0x0c, 0xa9, 0x81, 0xc1, 0xb6, 0xfb, 0x64, 0x8c, 0x89, 0x54, 0xca, 0x2b, 0x95, 0x74, 0x6b, 0xab,
-		0x44, 0x7e, 0x42, 0xbf, 0x3a, 0x06, 0x91, 0x5b, 0x58, 0xbb, 0xe1, 0x5f, 0x59, 0x69, 0x11, 0x3a,
-		0x09, 0x33, 0x2d, 0xbc, 0x39, 0xd8, 0x56, 0xfb, 0xb8, 0xa5, 0xe9, 0xb6, 0x7f, 0x3d, 0x99, 0xa6,
-		0x55, 0x0a, 0xad, 0x41, 0xcf, 0x41, 0x82, 0x8c, 0x91, 0x41, 0xc7, 0x78, 0x8e, 0x9a, 0xe0, 0xf1,
-		0xfd, 0x4d, 0xc0, 0x87, 0x58, 0x80, 0x14, 0x17, 0x8f, 0x2e, 0x43, 0xcc, 0xd6, 0xfa, 0xdb, 0xd8,
-		0xb6, 0xd2, 0xf3, 0x4b, 0xe1, 0x93, 0xc9, 0x11, 0x27, 0x75, 0x23, 0xa8, 0x9a, 0x14, 0x42, 0x37,
-		0xdb, 0x02, 0x8e, 0xae, 0x82, 0xcc, 0x0f, 0x84, 0x55, 0xbe, 0x53, 0xb6, 0xd2, 0x0b, 0xd4, 0x01,
-		0x1f, 0xdb, 0x9f, 0x92, 0x9f, 0x27, 0x97, 0x18, 0x48, 0x49, 0x61, 0xdf, 0xb5, 0x7f, 0x5e, 0x1c,
-		0x3e, 0xc8, 0xbc, 0xd8, 0x80, 0x14, 0xff, 0xad, 0x5a, 0x83, 0x5e, 0xcf, 0xec, 0xdb, 0xe9, 0x23,
-		0x14, 0x3f, 0x46, 0x21, 0x41, 0xc6, 0x30, 0x4a, 0x72, 0xcb, 0x77, 0xfd, 0xd7, 0x37, 0xdd, 0x32,
-		0xcf, 0x43, 0xd2, 0x6f, 0x0c, 0xef, 0x71, 0x7c, 0x78, 0xc2, 0xe3, 0x78, 0xb2, 0x2d, 0x11, 0x7b,
-		0x3d, 0xb2, 0x34, 0xb1, 0x8b, 0xcc, 0xdf, 0x0b, 0x41, 0xd2, 0xdf, 0x31, 0xb4, 0x02, 0x48, 0x8c,
-		0x58, 0xdb, 0xb0, 0xfb, 0x66, 0x6b, 0xa0, 0xe3, 0x16, 0x9f, 0xb0, 0x7b, 0xb7, 0x33, 0xc7, 0x31,
-		0x15, 0x07, 0xe2, 0x25, 0xf2, 0xcc, 0x82, 0xd0, 0x84, 0x44, 0x25, 0x77, 0x7e, 0x9c, 0x86, 0x79,
-		0x41, 0x40, 0xc8, 0x6e, 0x69, 0x7d, 0x83, 0xa4, 0xc8, 0x2c, 0x69, 0x47, 0x9e, 0xaa, 0xab, 0xac,
-		0x06, 0xe5, 0x41, 0xb8, 0x8b, 0xda, 0xc7, 0x5d, 0xf3, 0x26, 0x6e, 0xf1, 0x13, 0xa7, 0xbd, 0x9b,
-		0x4d, 0x72, 0x80, 0xc2, 0xe4, 0xb3, 0xa7, 0x61, 0x8a, 0x86, 0x1f, 0x04, 0xc0, 0x03, 0x90, 0x7c,
-		0x08, 0xc5, 0x21, 0x52, 0xac, 0x2b, 0x64, 0x79, 0x94, 0x61, 0x86, 0x95, 0xaa, 0xeb, 0x95, 0x72,
-		0xb1, 0x2c, 0x87, 0xb2, 0x4f, 0x41, 0x94, 0xc5, 0x14, 0xb2, 0x74, 0x3a, 0x51, 0x45, 0x3e, 0xc4,
-		0x2f, 0x39, 0x87, 0x24, 0x6a, 0x37, 0xd6, 0x0a, 0x65, 0x45, 0x0e, 0x65, 0x37, 0x20, 0x15, 0x98,
-		0x87, 0xe8, 0x30, 0xcc, 0x29, 0xe5, 0x66, 0xb9, 0xd6, 0xac, 0xd4, 0x6b, 0xea, 0x46, 0xed, 0xb9,
-		0x5a, 0xfd, 0x6a, 0x4d, 0x3e, 0xe4, 0x2f, 0x16, 0xeb, 0xb0, 0x84, 0x16, 0x40, 0x76, 0x8b, 0x1b,
-		0xf5, 0x0d, 0x85, 0x6a, 0xf3, 0x4b, 0x21, 0x90, 0x83, 0x93, 0x12, 0x1d, 0x85, 0xf9, 0x66, 0x5e,
-		0x59, 0x29, 0x37, 0x55, 0x76, 0x66, 0xe2, 0x50, 0x2f, 0x80, 0xec, 0xad, 0xb8, 0x54, 0xa1, 0x47,
-		0x42, 0x8b, 0x70, 0xdc, 0x5b, 0x5a, 0xbe, 0xd6, 0x2c, 0xd7, 0x1a, 0xb4, 0xf1, 0x7c, 0x6d, 0x85,
-		0x24, 0x05, 0x01, 0x3e, 0x71, 0x4a, 0x13, 0x26, 0xaa, 0xfa, 0xf9, 0xca, 0xd5, 0x92, 0x1c, 0x09,
-		0x16, 0xd7, 0x6b, 0xe5, 0xfa, 0x25, 0x79, 0x2a, 0xd8, 0x3a, 0x3d, 0xb9, 0x89, 0xa2, 0x0c, 0x1c,
-		0x09, 0x96, 0xaa, 0xe5, 0x5a, 0x53, 0xb9, 0x2e, 0xc7, 0x82, 0x0d, 0x37, 0xca, 0xca, 0x95, 0x4a,
-		0xb1, 0x2c, 0xc7, 0xd1, 0x11, 0x40, 0x7e, 0x8d, 0x9a, 0x97, 0xeb, 0x25, 0x39, 0x31, 0x6a, 0xc5,
-		0x42, 0xf2, 0x7c, 0xf6, 0xf3, 0x12, 0xcc, 0x78, 0x4f, 0x51, 0x7c, 0x41, 0x45, 0x7a, 0xab, 0x2d,
-		0xb6, 0xd9, 0x3f, 0x08, 0xc1, 0xb4, 0xe7, 0x38, 0x85, 0x6c, 0x62, 0xb5, 0x4e, 0xc7, 0xbc, 0xa5,
-		0x6a, 0x9d, 0xb6, 0x66, 0xf1, 0xf5, 0x10, 0x68, 0x51, 0x9e, 0x94, 0x4c, 0xba, 0xfe, 0x4c, 0x9e,
-		0xba, 0x44, 0xef, 0x39, 0x75, 0x89, 0xbd, 0x05, 0x53, 0x97, 0x29, 0x39, 0x9a, 0xfd, 0x66, 0x08,
-		0xe4, 0xe0, 0xe9, 0x48, 0xc0, 0x6e, 0xd2, 0x5e, 0x76, 0xf3, 0xf6, 0x2f, 0x74, 0x90, 0xfe, 0x05,
-		0x57, 0xf5, 0xf0, 0x9e, 0xab, 0xfa, 0x88, 0xc5, 0x2a, 0xf2, 0x56, 0x5e, 0xac, 0xbc, 0xee, 0xfa,
-		0x1f, 0x25, 0x48, 0xfa, 0x0f, 0x73, 0x7c, 0x16, 0xcb, 0x1e, 0xc4, 0x62, 0xfe, 0x11, 0x79, 0x60,
-		0xaf, 0x11, 0xf9, 0xb1, 0xf4, 0xeb, 0xa3, 0x61, 0x98, 0xf5, 0x9d, 0xfd, 0x4c, 0xaa, 0xdd, 0xcf,
-		0xc2, 0x5c, 0xbb, 0x85, 0xbb, 0x3d, 0xd3, 0xc6, 0x86, 0xbe, 0xab, 0x76, 0xf0, 0x4d, 0xdc, 0xa1,
-		0x66, 0x48, 0x8e, 0xb8, 0xc7, 0xeb, 0x6b, 0x61, 0xb9, 0xe2, 0xe2, 0xaa, 0x04, 0x96, 0x9b, 0xaf,
-		0x94, 0xca, 0x6b, 0xeb, 0xf5, 0x66, 0xb9, 0x56, 0xbc, 0x2e, 0x22, 0xb9, 0x22, 0xb7, 0x03, 0x62,
-		0x3e, 0x83, 0x3f, 0xf8, 0xd6, 0xd8, 0x74, 0xae, 0x83, 0x1c, 0xec, 0x0d, 0x09, 0xe8, 0x23, 0xfa,
-		0x23, 0x1f, 0x42, 0xf3, 0x90, 0xaa, 0xd5, 0xd5, 0x46, 0xa5, 0x54, 0x56, 0xcb, 0x97, 0x2e, 0x95,
-		0x8b, 0xcd, 0x06, 0xbb, 0x57, 0xe1, 0x48, 0x37, 0xe5, 0x90, 0x77, 0x6c, 0x3e, 0x16, 0x86, 0xf9,
-		0x11, 0x9a, 0xa0, 0x3c, 0x3f, 0x22, 0x64, 0xa7, 0x96, 0x8f, 0x4f, 0xa2, 0xfd, 0x32, 0xd9, 0xdd,
-		0xaf, 0x6b, 0x7d, 0x9b, 0x9f, 0x28, 0x3e, 0x02, 0xc4, 0xbc, 0x86, 0x4d, 0xd2, 0xfb, 0x3e, 0xbf,
-		0x07, 0xc4, 0x52, 0x90, 0x94, 0x5b, 0xce, 0x6e, 0x03, 0x3d, 0x06, 0xa8, 0x67, 0x5a, 0x6d, 0xbb,
-		0x7d, 0x13, 0x93, 0x1c, 0x8a, 0x0b, 0x93, 0x89, 0x1b, 0x51, 0x64, 0x51, 0x53, 0x31, 0x6c, 0x47,
-		0xda, 0xc0, 0xdb, 0x5a, 0x40, 0x9a, 0x6c, 0x3f, 0xc2, 0x8a, 0x2c, 0x6a, 0x1c, 0xe9, 0x07, 0x60,
-		0xa6, 0x65, 0x0e, 0x36, 0x3b, 0x98, 0xcb, 0x91, 0x90, 0x2c, 0x29, 0xd3, 0xac, 0xcc, 0x11, 0xe1,
-		0xc7, 0x66, 0xee, 0x9d, 0xaa, 0x19, 0x65, 0x9a, 0x95, 0x31, 0x91, 0x87, 0x21, 0xa5, 0x6d, 0x6f,
-		0xf7, 0x09, 0xb9, 0x20, 0x62, 0x07, 0x81, 0x49, 0xa7, 0x98, 0x0a, 0x66, 0x56, 0x21, 0x2e, 0xec,
-		0x40, 0xf6, 0xbf, 0xc4, 0x12, 0x6a, 0x8f, 0x9d, 0x6e, 0x87, 0x4e, 0x26, 0x94, 0xb8, 0x21, 0x2a,
-		0x1f, 0x80, 0x99, 0xb6, 0xe5, 0x3e, 0xf5, 0x94, 0x0e, 0x2d, 0x85, 0x4e, 0xc6, 0x95, 0xe9, 0xb6,
-		0xe5, 0x3e, 0xd9, 0xf4, 0x65, 0x19, 0xc0, 0x75, 0x36, 0xf4, 0x61, 0x09, 0x92, 0x6c, 0x81, 0xe9,
-		0xf5, 0xb1, 0x85, 0x0d, 0x5d, 0x6c, 0x0b, 0x1f, 0xd9, 0xc7, 0x45, 0x59, 0x98, 0x5b, 0xe7, 0x80,
-		0xc2, 0xb3, 0x2f, 0x4b, 0xd2, 0x1d, 0x29, 0x72, 0x47, 0x92, 0x5e, 0x95, 0x66, 0x51, 0xbc, 0x7c,
-		0x6d, 0xbd, 0x5a, 0x29, 0x56, 0x9a, 0xe9, 0xf7, 0xc6, 0xe8, 0x75, 0x65, 0x8d, 0x5f, 0xbf, 0x16,
-		0xf3, 0xd7, 0xbf, 0x1e, 0xfb, 0x6d, 0x29, 0x1c, 0x7f, 0x3d, 0xa6, 0xcc, 0x6e, 0x79, 0xf9, 0x50,
-		0xc7, 0xfb, 0x1c, 0x47, 0x68, 0xaf, 0x8d, 0xa4, 0xab, 0x4d, 0x99, 0x3f, 0xbd, 0x51, 0x78, 0x84,
-		0x2a, 0x12, 0xa5, 0x8a, 0x4c, 0xa3, 0x68, 0xb1, 0x5a, 0x6f, 0x94, 0x4b, 0x54, 0x8d, 0x04, 0x8a,
-		0xd4, 0xd7, 0xcb, 0xb5, 0xf4, 0x6b, 0xa2, 0x49, 0xf7, 0x91, 0x8f, 0x3b, 0x12, 0x1c, 0x15, 0x37,
-		0x6a, 0xf9, 0x5a, 0x8b, 0x0d, 0xdd, 0x6c, 0x89, 0xec, 0x36, 0x79, 0xf6, 0xcc, 0x7e, 0x8d, 0x2b,
-		0x1c, 0x4a, 0x4d, 0x52, 0xe6, 0xc0, 0xc2, 0xe3, 0x43, 0x26, 0xc9, 0xd7, 0x4a, 0x5c, 0x97, 0x69,
-		0x14, 0x5d, 0xcf, 0x17, 0x9f, 0x2b, 0x97, 0x5c, 0x6d, 0x0e, 0xf7, 0x47, 0xb1, 0xa0, 0x77, 0x41,
-		0x6a, 0x60, 0x6f, 0x3d, 0x4d, 0x7c, 0xa3, 0xdd, 0x62, 0x77, 0xce, 0x23, 0x7b, 0xdd, 0x72, 0x75,
-		0x35, 0xda, 0xb0, 0xb7, 0x9e, 0xbe, 0xe2, 0x20, 0xb8, 0x51, 0x98, 0x2a, 0x09, 0x14, 0xa9, 0xd5,
-		0x6b, 0x65, 0xa1, 0x06, 0xbd, 0xcb, 0x7c, 0xdd, 0x55, 0x23, 0x39, 0xf0, 0x41, 0xd1, 0xbb, 0x40,
-		0x16, 0xc7, 0x43, 0x8e, 0x49, 0xa6, 0xf6, 0xba, 0x6b, 0xec, 0x2a, 0xc0, 0x0f, 0x99, 0x1c, 0x63,
-		0x3c, 0xe4, 0xd1, 0x60, 0x01, 0xa5, 0xaa, 0xe5, 0xda, 0x4a, 0xf3, 0xb2, 0xba, 0xae, 0x94, 0xe9,
-		0xcd, 0xbf, 0xf4, 0x7b, 0x45, 0xf3, 0xa9, 0xae, 0x1f, 0x88, 0xfe, 0xb6, 0x04, 0xd3, 0x2c, 0x05,
-		0x62, 0x67, 0x52, 0xec, 0x50, 0xe1, 0xa1, 0xfd, 0xda, 0xa6, 0x19, 0x10, 0x95, 0x2e, 0x5c, 0xa4,
-		0xcd, 0x86, 0x85, 0x43, 0x1c, 0x45, 0xa8, 0x5a, 0x5e, 0xc9, 0x17, 0xaf, 0xab, 0x85, 0x72, 0xa3,
-		0x49, 0x22, 0x59, 0x5d, 0x61, 0x3e, 0x0a, 0x68, 0x2a, 0x5f, 0xad, 0xd6, 0xaf, 0xba, 0x86, 0x80,
-		0x17, 0x1c, 0x1a, 0xf4, 0x1b, 0x12, 0x2c, 0x60, 0x63, 0xcb, 0xec, 0xeb, 0xf4, 0x86, 0x35, 0x99,
-		0xd1, 0x96, 0xbd, 0xdb, 0x61, 0x33, 0x7a, 0xe4, 0xa6, 0xdc, 0xeb, 0x99, 0x14, 0x57, 0xa3, 0xb0,
-		0x06, 0x41, 0x15, 0x2a, 0x2f, 0x4b, 0xa1, 0x3b, 0x44, 0xb1, 0x10, 0xd5, 0x2d, 0x72, 0x47, 0x9a,
-		0xa2, 0x1a, 0xc6, 0xee, 0x48, 0xf1, 0x3b, 0x52, 0xe2, 0x55, 0x69, 0x0e, 0xcd, 0x34, 0x9a, 0xd7,
-		0xab, 0x65, 0x95, 0x69, 0x4b, 0x35, 0x4c, 0xa2, 0x04, 0x2d, 0x3b, 0xfb, 0xc4, 0xd9, 0x73, 0xe9,
-		0xef, 0x52, 0x2d, 0xbf, 0x1b, 0x53, 0x10, 0x1e, 0xa2, 0x47, 0x5f, 0x96, 0xe0, 0x98, 0xb8, 0x45,
-		0x6e, 0xd1, 0xdb, 0x66, 0xaa, 0xe7, 0x06, 0x5b, 0x9c, 0xaa, 0x5c, 0xde, 0x4f, 0x65, 0xf7, 0x2e,
-		0x1b, 0x2f, 0x5c, 0xe6, 0x1b, 0xde, 0xe0, 0x4d, 0xb8, 0xc2, 0x79, 0xd6, 0x93, 0x57, 0xa5, 0x14,
-		0x82, 0xf2, 0xb5, 0xf5, 0xba, 0xd2, 0x54, 0xf3, 0xd5, 0x2a, 0xd5, 0xf7, 0x30, 0x92, 0x79, 0x49,
-		0xb3, 0xbe, 0xae, 0x56, 0xcb, 0x57, 0xca, 0x55, 0x57, 0xed, 0xa3, 0xad, 0xd1, 0x84, 0x99, 0x4f,
-		0x49, 0x30, 0x37, 0xd4, 0x7c, 0xf6, 0xdd, 0x12, 0x1c, 0xdd, 0x43, 0x05, 0xf4, 0x36, 0x78, 0xa0,
-		0x54, 0xbe, 0x94, 0xdf, 0xa8, 0x36, 0xd5, 0xc6, 0xf5, 0xb5, 0x42, 0xbd, 0xaa, 0x5e, 0xa9, 0x34,
-		0x2a, 0x85, 0x4a, 0xb5, 0xd2, 0xf4, 0x2e, 0x60, 0x49, 0xf0, 0x28, 0xc8, 0xb6, 0x6b, 0x41, 0xf5,
-		0xe4, 0x10, 0xd9, 0x14, 0x56, 0xeb, 0xc5, 0x7c, 0x95, 0x0a, 0x85, 0xc5, 0x9e, 0xb3, 0xd8, 0x94,
-		0x23, 0xab, 0xf1, 0xb8, 0xc4, 0xd7, 0xb6, 0xbf, 0x01, 0xb3, 0xbe, 0xe0, 0x47, 0xb6, 0x48, 0x74,
-		0x6b, 0x45, 0xfc, 0xb9, 0x51, 0xae, 0x15, 0xbd, 0x5b, 0xba, 0x19, 0x70, 0x82, 0x9d, 0x2c, 0x91,
-		0x2b, 0x11, 0x0a, 0xe5, 0x10, 0x59, 0x54, 0xb9, 0x3b, 0x3a, 0x37, 0xa7, 0xc3, 0xd9, 0x0b, 0x10,
-		0x17, 0xc1, 0x8c, 0x6c, 0xd4, 0xe8, 0x7e, 0x2b, 0xb0, 0x4d, 0x8c, 0x03, 0x8d, 0x64, 0xb2, 0x44,
-		0x14, 0x64, 0x11, 0x4e, 0x0e, 0x65, 0xaf, 0xc0, 0xe1, 0x91, 0x81, 0x08, 0x3d, 0x08, 0x8b, 0xe2,
-		0x86, 0x38, 0xdb, 0x02, 0xaa, 0xe5, 0x5a, 0xb1, 0x5e, 0x22, 0x9b, 0x66, 0x97, 0x13, 0x80, 0x47,
-		0x24, 0xa6, 0xa5, 0x88, 0x56, 0x72, 0x28, 0x5b, 0x81, 0xa4, 0x3f, 0x9c, 0xa0, 0xe3, 0x70, 0x74,
-		0xa3, 0x79, 0xe9, 0x69, 0xf5, 0x4a, 0xbe, 0x5a, 0x29, 0xe5, 0x03, 0xdb, 0x63, 0x00, 0x1e, 0x53,
-		0xe4, 0x10, 0x51, 0x94, 0xc4, 0x1a, 0x39, 0x9c, 0x8d, 0xc4, 0x25, 0x59, 0xca, 0x36, 0x20, 0x15,
-		0x08, 0x0c, 0xe8, 0x3e, 0x48, 0xf3, 0xfd, 0xea, 0x28, 0xad, 0xa8, 0x85, 0x7c, 0xa1, 0x82, 0xed,
-		0xdc, 0x4b, 0xe5, 0x6a, 0x65, 0xad, 0xd2, 0xa4, 0xfa, 0x5d, 0x06, 0x70, 0x67, 0x3c, 0xc9, 0x60,
-		0x56, 0x1b, 0xf5, 0x9a, 0x7a, 0x89, 0x6c, 0xfb, 0x9b, 0x1e, 0xaa, 0x04, 0xb0, 0x19, 0x2e, 0x4b,
-		0x64, 0x77, 0x3a, 0x1c, 0x06, 0xe4, 0x50, 0xf6, 0x2a, 0xa0, 0xe1, 0xd9, 0x8a, 0x96, 0xe0, 0xbe,
-		0x72, 0xed, 0x52, 0x5d, 0x29, 0x96, 0xd5, 0x5a, 0x7e, 0x8d, 0xe8, 0xc7, 0xe6, 0xa6, 0x4b, 0x3d,
-		0x0b, 0xee, 0xd4, 0x14, 0x67, 0x12, 0xee, 0xec, 0x95, 0x43, 0xa7, 0xbe, 0x20, 0x91, 0xcc, 0xe8,
-		0xfd, 0xb5, 0xcc, 0x67, 0x24, 0x74, 0x7f, 0xfc, 0xf5, 0x18, 0x8a, 0x2d, 0xf7, 0x36, 0x97, 0xf5,
-		0x5e, 0x2f, 0x93, 0x22, 0x3f, 0x8a, 0xbd, 0xde, 0x25, 0x91, 0xef, 0x2d, 0xc6, 0xbf, 0x1b, 0x43,
-		0x71, 0x52, 0xfa, 0x82, 0x76, 0x53, 0xcb, 0xc8, 0xe4, 0xd7, 0xaa, 0x76, 0x53, 0x73, 0x04, 0x8e,
-		0xc7, 0xbf, 0x17, 0x43, 0x51, 0x52, 0xbc, 0x6d, 0x66, 0x92, 0xe4, 0xff, 0x15, 0xd3, 0xa9, 0x7c,
-		0x30, 0xfe, 0xfd, 0x18, 0x02, 0x52, 0xd8, 0xdb, 0xb5, 0x77, 0x4c, 0x23, 0x83, 0xc8, 0xef, 0x75,
-		0xfa, 0xdb, 0x23, 0xf4, 0xf3, 0x35, 0x2e, 0x44, 0x26, 0xff, 0x19, 0x2e, 0x44, 0x7f, 0x0b, 0xa1,
-		0x53, 0xd1, 0xf8, 0xfb, 0x6b, 0xf2, 0x87, 0x6a, 0xa7, 0xa2, 0xf1, 0x0f, 0xd5, 0xe4, 0x0f, 0xd7,
-		0x56, 0xa3, 0xf1, 0xd7, 0x62, 0xf2, 0xeb, 0xb1, 0xec, 0x9f, 0x84, 0x01, 0xb9, 0xa1, 0xc2, 0x39,
-		0x05, 0xbc, 0x06, 0x71, 0xe7, 0x58, 0x91, 0x3d, 0xe7, 0xfd, 0xf6, 0x7d, 0x22, 0x8c, 0x80, 0x79,
-		0x8a, 0x02, 0xc7, 0x8c, 0x0e, 0x1b, 0xca, 0x43, 0xaa, 0xdb, 0x36, 0xda, 0xdd, 0x41, 0x57, 0x15,
-		0x67, 0x6d, 0x63, 0xcf, 0x90, 0x38, 0x80, 0x5f, 0x53, 0x0a, 0xed, 0xb6, 0x8f, 0x62, 0x6a, 0x2c,
-		0x05, 0x03, 0xf0, 0xeb, 0xcc, 0x9f, 0x4b, 0x90, 0xde, 0x4b, 0xd9, 0x7b, 0x3a, 0x06, 0xac, 0xc1,
-		0x82, 0x79, 0x13, 0xf7, 0xfb, 0xed, 0x16, 0xbd, 0xb3, 0xe7, 0x6c, 0x0e, 0x22, 0xe3, 0x37, 0x07,
-		0xf3, 0x1e, 0xa0, 0x33, 0xa8, 0x05, 0x92, 0xc3, 0xdd, 0x26, 0xe9, 0x8b, 0x60, 0x9a, 0x1a, 0xcf,
-		0x34, 0x4b, 0x21, 0x82, 0x63, 0x95, 0x4c, 0x52, 0xb2, 0x1f, 0x0f, 0xc9, 0x61, 0x77, 0x07, 0x92,
-		0xfd, 0x44, 0x18, 0x92, 0xfe, 0xc7, 0x95, 0x51, 0x09, 0xe2, 0x1d, 0x93, 0x3f, 0xc7, 0xc7, 0x46,
-		0xfb, 0xe4, 0x98, 0x27, 0x9c, 0x97, 0xab, 0x5c, 0x5e, 0x71, 0x90, 0x99, 0x7f, 0x2f, 0x41, 0x5c,
-		0x14, 0xa3, 0x23, 0x10, 0xe9, 0x69, 0xf6, 0x0e, 0xa5, 0x9b, 0x2a, 0x84, 0x64, 0x49, 0xa1, 0xd7,
-		0xa4, 0xdc, 0xea, 0x69, 0xec, 0x19, 0x46, 0x5e, 0x4e, 0xae, 0xc9, 0x2e, 0xa0, 0x83, 0xb5, 0x16,
-		0xbd, 0x27, 0x6d, 0x76, 0xbb, 0xd8, 0xb0, 0x2d, 0xb1, 0x0b, 0xe0, 0xe5, 0x45, 0x5e, 0x8c, 0x1e,
-		0x85, 0x39, 0xbb, 0xaf, 0xb5, 0x3b, 0x3e, 0xd9, 0x08, 0x95, 0x95, 0x45, 0x85, 0x23, 0x9c, 0x83,
-		0x63, 0x82, 0xb7, 0x85, 0x6d, 0x4d, 0xdf, 0xc1, 0x2d, 0x17, 0x14, 0xa5, 0x8f, 0xaf, 0x1c, 0xe5,
-		0x02, 0x25, 0x5e, 0x2f, 0xb0, 0xa7, 0x06, 0xbe, 0xd7, 0x15, 0xb6, 0x11, 0xe6, 0xaf, 0x2b, 0x9c,
-		0xd9, 0xe3, 0x75, 0x85, 0xe0, 0xd3, 0xe2, 0x9e, 0x77, 0x15, 0x4e, 0x8d, 0x80, 0xf8, 0x2d, 0xea,
-		0xa6, 0xf3, 0xdf, 0x08, 0xc1, 0x9c, 0xb8, 0x79, 0xdf, 0x72, 0xc6, 0x68, 0x0d, 0x40, 0x33, 0x0c,
-		0xd3, 0xf6, 0x8e, 0xd2, 0xf0, 0x7e, 0x6b, 0x08, 0xb7, 0x9c, 0x77, 0x40, 0x8a, 0x87, 0x20, 0xf3,
-		0x7d, 0x09, 0xc0, 0xad, 0xda, 0x73, 0xb8, 0x16, 0x61, 0x9a, 0xf7, 0x8a, 0xbe, 0xf2, 0xc1, 0xce,
-		0xb8, 0x81, 0x15, 0x5d, 0x6a, 0x77, 0xe8, 0x53, 0x39, 0x9b, 0x78, 0xbb, 0x6d, 0xf0, 0x27, 0x12,
-		0xd9, 0x85, 0x78, 0x2a, 0x27, 0xe2, 0x3e, 0xb1, 0xab, 0x40, 0xdc, 0xc2, 0x5d, 0xcd, 0xb0, 0xdb,
-		0x3a, 0x9f, 0xac, 0xe7, 0x0f, 0xa4, 0xfc, 0x72, 0x83, 0xa3, 0x15, 0x87, 0x27, 0x7b, 0x12, 0xe2,
-		0xa2, 0xd4, 0x59, 0x9a, 0x0e, 0xa1, 0x18, 0x84, 0x1b, 0x65, 0xb2, 0x38, 0xd3, 0x15, 0xa2, 0x92,
-		0x6f, 0xc8, 0xa1, 0x53, 0x9f, 0x09, 0x41, 0x4c, 0x44, 0x8f, 0x79, 0x48, 0x95, 0x4b, 0x95, 0xc0,
-		0x2a, 0x37, 0x0f, 0x49, 0x51, 0xc8, 0xa3, 0xfc, 0x7b, 0x63, 0xde, 0xc2, 0x75, 0xa5, 0xde, 0xac,
-		0x9f, 0x95, 0xff, 0xdb, 0x70, 0xe1, 0x93, 0xf2, 0x6b, 0x31, 0x34, 0x07, 0x33, 0xa2, 0xf0, 0xec,
-		0x13, 0x67, 0x9f, 0x94, 0x5f, 0x0f, 0x16, 0x9d, 0x93, 0xbf, 0x4b, 0x8f, 0x57, 0x45, 0xd1, 0x19,
-		0xb5, 0x49, 0x96, 0xaa, 0x7a, 0xad, 0x7a, 0x5d, 0x96, 0xbc, 0x15, 0x67, 0x3d, 0x15, 0x21, 0x74,
-		0x3f, 0x1c, 0x15, 0x15, 0x17, 0x2f, 0x5e, 0xbc, 0x78, 0xc1, 0x53, 0xf9, 0xca, 0x07, 0xa2, 0xc1,
-		0xea, 0xa7, 0x3d, 0xd5, 0x9f, 0x18, 0xae, 0xbe, 0xe8, 0xa9, 0xfe, 0xb5, 0x0f, 0x44, 0xd1, 0x3c,
-		0x4c, 0x8b, 0xea, 0xb5, 0xfc, 0x35, 0xf9, 0x47, 0x3f, 0xfa, 0xd1, 0x8f, 0x62, 0xa7, 0x36, 0x40,
-		0x1e, 0x4a, 0xc9, 0x16, 0x40, 0xf6, 0xe5, 0x60, 0xc4, 0xbc, 0x87, 0x02, 0xa5, 0x34, 0xcd, 0x92,
-		0x25, 0x92, 0xe2, 0x78, 0x4a, 0x59, 0x4a, 0x26, 0x87, 0x0a, 0xef, 0x82, 0x79, 0xdd, 0xec, 0x06,
-		0x47, 0xbc, 0x20, 0x07, 0x1e, 0x39, 0xb2, 0x2e, 0x4b, 0xcf, 0x3f, 0xce, 0x85, 0xb6, 0xcd, 0x8e,
-		0x66, 0x6c, 0x2f, 0x9b, 0xfd, 0x6d, 0xf7, 0x95, 0x25, 0xb2, 0x7d, 0xb4, 0x3c, 0x2f, 0x2e, 0xf5,
-		0x36, 0xff, 0x5c, 0x92, 0x5e, 0x0d, 0x85, 0x57, 0xd6, 0x0b, 0x9f, 0x0b, 0x65, 0x56, 0x18, 0x70,
-		0x5d, 0xf8, 0x93, 0x82, 0xb7, 0x3a, 0x58, 0x27, 0x83, 0x0e, 0x1f, 0x8f, 0xc0, 0x1c, 0xc9, 0x01,
-		0xcc, 0xd3, 0xf4, 0x5f, 0xfe, 0xc2, 0xd3, 0x14, 0xbd, 0xc8, 0x8c, 0x7d, 0x33, 0x2a, 0x77, 0x9e,
-		0x1d, 0x73, 0xa0, 0x71, 0x8f, 0xe6, 0xa7, 0xff, 0xec, 0x97, 0x3e, 0x3d, 0xe5, 0x3e, 0x2d, 0x95,
-		0x5b, 0x1b, 0xde, 0x6d, 0x8d, 0xe7, 0x78, 0x43, 0x70, 0x04, 0x37, 0x4f, 0xb9, 0xb7, 0x43, 0xdc,
-		0xa1, 0xd9, 0xff, 0x19, 0xd6, 0xf4, 0xff, 0x10, 0x24, 0x0e, 0x22, 0xf7, 0x2c, 0x00, 0xdb, 0x0b,
-		0xb3, 0x07, 0xb9, 0xf6, 0xc7, 0xbf, 0x29, 0xf0, 0x09, 0x8a, 0xa9, 0x91, 0xde, 0xac, 0x40, 0xb2,
-		0x65, 0x1a, 0xb6, 0x6a, 0x76, 0xdb, 0x36, 0xee, 0xf6, 0xec, 0xdd, 0x71, 0x24, 0xff, 0x93, 0x91,
-		0xc4, 0x95, 0x59, 0x82, 0xab, 0x0b, 0x18, 0xd1, 0x84, 0x3d, 0xce, 0x3b, 0x89, 0x26, 0xff, 0xcb,
-		0xd1, 0x84, 0x62, 0x88, 0x26, 0x85, 0xf2, 0xd7, 0xbe, 0x75, 0x42, 0xfa, 0xc6, 0xb7, 0x4e, 0x48,
-		0xff, 0xe5, 0x5b, 0x27, 0xa4, 0x0f, 0x7d, 0xfb, 0xc4, 0xa1, 0x6f, 0x7c, 0xfb, 0xc4, 0xa1, 0x6f,
-		0x7e, 0xfb, 0xc4, 0xa1, 0xe7, 0x1f, 0xdd, 0x6e, 0xdb, 0x3b, 0x83, 0xcd, 0x65, 0xdd, 0xec, 0x9e,
-		0xd6, 0x4d, 0xab, 0x6b, 0x5a, 0xfc, 0xbf, 0xc7, 0xad, 0xd6, 0x0d, 0xee, 0x3f, 0xf6, 0x6d, 0xe6,
-		0x05, 0x9b, 0x51, 0xf6, 0x18, 0x2f, 0xfc, 0xf1, 0xa3, 0xb0, 0xb0, 0x6d, 0x6e, 0x9b, 0xf4, 0xf2,
-		0x34, 0xf9, 0xc5, 0x1d, 0x24, 0xe1, 0x94, 0x4e, 0xe0, 0x24, 0x35, 0x98, 0xe7, 0xc2, 0x2a, 0x3d,
-		0xeb, 0x60, 0xcf, 0xcc, 0xa0, 0x7d, 0x1f, 0xdd, 0x4c, 0xff, 0xd6, 0x77, 0xe8, 0x21, 0xb5, 0x32,
-		0xc7, 0xa1, 0xa4, 0x8e, 0x3d, 0x56, 0x93, 0x53, 0xe0, 0xb0, 0x8f, 0x8f, 0x9d, 0x33, 0xe1, 0xfe,
-		0x18, 0xc6, 0xaf, 0x70, 0xc6, 0x79, 0x0f, 0x63, 0x83, 0x43, 0x73, 0x45, 0x98, 0x3d, 0x08, 0xd7,
-		0xbf, 0xe6, 0x5c, 0x33, 0xd8, 0x4b, 0xb2, 0x02, 0x29, 0x4a, 0xa2, 0x0f, 0x2c, 0xdb, 0xec, 0xd2,
-		0x31, 0xdc, 0x9f, 0xe6, 0xab, 0xdf, 0x61, 0x4b, 0x79, 0x92, 0xc0, 0x8a, 0x0e, 0x2a, 0x97, 0x03,
-		0x7a, 0x66, 0xd3, 0xc2, 0x7a, 0x67, 0x0c, 0xc3, 0xd7, 0xb8, 0x22, 0x8e, 0x7c, 0xee, 0x0a, 0xd9,
-		0xc2, 0x0f, 0xba, 0xf4, 0x8c, 0xcd, 0xab, 0xc9, 0xf8, 0x87, 0x34, 0xd3, 0x7f, 0xf0, 0x1e, 0x96,
-		0x2d, 0xcc, 0x3b, 0x04, 0x1e, 0x9d, 0x3c, 0xa3, 0xb8, 0x8d, 0x6d, 0x1b, 0xf7, 0x2d, 0x55, 0xeb,
-		0x8c, 0x52, 0xcf, 0xf3, 0x94, 0x5b, 0xfa, 0xa3, 0x3f, 0xf0, 0x8f, 0xe2, 0x0a, 0x43, 0xe6, 0x3b,
-		0x9d, 0xdc, 0x06, 0x1c, 0x1d, 0xe1, 0x15, 0x13, 0x70, 0x7e, 0x8c, 0x73, 0x2e, 0x0c, 0x79, 0x06,
-		0xa1, 0x5d, 0x07, 0x51, 0xee, 0x8c, 0xe5, 0x04, 0x9c, 0x1f, 0xe7, 0x9c, 0x88, 0x63, 0xc5, 0x90,
-		0x12, 0xc6, 0x55, 0x98, 0xbb, 0x89, 0xfb, 0x9b, 0xa6, 0xc5, 0x9f, 0x2c, 0x9c, 0x80, 0xee, 0x57,
-		0x39, 0x5d, 0x8a, 0x03, 0xe9, 0xa3, 0x86, 0x84, 0xeb, 0x22, 0xc4, 0xb7, 0x34, 0x1d, 0x4f, 0x40,
-		0xf1, 0x0a, 0xa7, 0x88, 0x11, 0x79, 0x02, 0xcd, 0xc3, 0xcc, 0xb6, 0xc9, 0x8f, 0x59, 0xc7, 0xc3,
-		0x3f, 0xc1, 0xe1, 0xd3, 0x02, 0xc3, 0x29, 0x7a, 0x66, 0x6f, 0xd0, 0xd1, 0xec, 0x49, 0x34, 0xf8,
-		0x35, 0x41, 0x21, 0x30, 0x9c, 0xe2, 0x00, 0x66, 0xfd, 0xa4, 0xa0, 0xb0, 0x3c, 0xf6, 0x7c, 0x16,
-		0xa6, 0x4d, 0xa3, 0xb3, 0x6b, 0x1a, 0x93, 0x28, 0xf1, 0x29, 0xce, 0x00, 0x1c, 0x42, 0x08, 0x9e,
-		0x81, 0xc4, 0xa4, 0x03, 0xf1, 0xf7, 0x7f, 0x20, 0xa6, 0x87, 0x18, 0x81, 0x15, 0x48, 0x89, 0x00,
-		0xd5, 0x36, 0x8d, 0x09, 0x28, 0x3e, 0xcd, 0x29, 0x92, 0x1e, 0x18, 0xef, 0x86, 0x8d, 0x2d, 0x7b,
-		0x1b, 0x4f, 0x42, 0xf2, 0x19, 0xd1, 0x0d, 0x0e, 0xe1, 0xa6, 0xdc, 0xc4, 0x86, 0xbe, 0x33, 0x19,
-		0xc3, 0x67, 0x85, 0x29, 0x05, 0x86, 0x50, 0x14, 0x61, 0xb6, 0xab, 0xf5, 0xad, 0x1d, 0xad, 0x33,
-		0xd1, 0x70, 0xfc, 0x03, 0xce, 0x31, 0xe3, 0x80, 0xb8, 0x45, 0x06, 0xc6, 0x41, 0x68, 0x3e, 0x27,
-		0x2c, 0xe2, 0x81, 0xf1, 0xa9, 0x67, 0xd9, 0x74, 0x97, 0x77, 0x10, 0xb6, 0xdf, 0x10, 0x53, 0x8f,
-		0x61, 0xd7, 0xbc, 0x8c, 0xcf, 0x40, 0xc2, 0x6a, 0xbf, 0x38, 0x11, 0xcd, 0xe7, 0xc5, 0x48, 0x53,
-		0x00, 0x01, 0x5f, 0x87, 0x63, 0x23, 0x97, 0x89, 0x09, 0xc8, 0x7e, 0x93, 0x93, 0x1d, 0x19, 0xb1,
-		0x54, 0xf0, 0x90, 0x70, 0x50, 0xca, 0x7f, 0x28, 0x42, 0x02, 0x0e, 0x70, 0xad, 0xc3, 0xc2, 0xc0,
-		0xb0, 0xb4, 0xad, 0x83, 0x59, 0xed, 0x1f, 0x09, 0xab, 0x31, 0xac, 0xcf, 0x6a, 0x4d, 0x38, 0xc2,
-		0x19, 0x0f, 0x36, 0xae, 0x5f, 0x10, 0x81, 0x95, 0xa1, 0x37, 0xfc, 0xa3, 0xfb, 0xd3, 0x90, 0x71,
-		0xcc, 0x29, 0xb6, 0x64, 0x96, 0xda, 0xd5, 0x7a, 0x13, 0x30, 0xff, 0x16, 0x67, 0x16, 0x11, 0xdf,
-		0xd9, 0xd3, 0x59, 0x6b, 0x5a, 0x8f, 0x90, 0x5f, 0x83, 0xb4, 0x20, 0x1f, 0x18, 0x7d, 0xac, 0x9b,
-		0xdb, 0x46, 0xfb, 0x45, 0xdc, 0x9a, 0x80, 0xfa, 0xb7, 0x03, 0x43, 0xb5, 0xe1, 0x81, 0x13, 0xe6,
-		0x0a, 0xc8, 0x4e, 0xae, 0xa2, 0xb6, 0xbb, 0xf4, 0x6e, 0xf4, 0xfe, 0x8c, 0xbf, 0x23, 0x46, 0xca,
-		0xc1, 0x55, 0x28, 0x2c, 0x57, 0x06, 0xf6, 0x7a, 0xd3, 0xa4, 0x2e, 0xf9, 0x45, 0x4e, 0x34, 0xeb,
-		0xa2, 0x78, 0xe0, 0xd0, 0xcd, 0x6e, 0x4f, 0xeb, 0x4f, 0x12, 0xff, 0xfe, 0xb1, 0x08, 0x1c, 0x1c,
-		0xc2, 0x03, 0x07, 0xc9, 0xd7, 0xc8, 0x6a, 0x3f, 0x01, 0xc3, 0x97, 0x44, 0xe0, 0x10, 0x18, 0x4e,
-		0x21, 0x12, 0x86, 0x09, 0x28, 0x7e, 0x57, 0x50, 0x08, 0x0c, 0xa1, 0xf8, 0x29, 0x77, 0xa1, 0xed,
-		0xe3, 0xed, 0xb6, 0x65, 0xf3, 0x17, 0x10, 0xf7, 0xa7, 0xfa, 0x27, 0x3f, 0xf0, 0x27, 0x61, 0x8a,
-		0x07, 0x4a, 0x22, 0x11, 0xcf, 0xec, 0xe9, 0x6d, 0xbf, 0xf1, 0x8a, 0x7d, 0x59, 0x44, 0x22, 0x0f,
-		0x8c, 0xe8, 0xe6, 0xc9, 0x10, 0x89, 0xd9, 0x75, 0x4d, 0xdf, 0x99, 0x84, 0xee, 0x9f, 0x06, 0x94,
-		0x6b, 0x08, 0x2c, 0xe1, 0xf4, 0xe4, 0x3f, 0x03, 0xe3, 0x06, 0xde, 0x9d, 0xc8, 0x3b, 0xff, 0x59,
-		0x20, 0xff, 0xd9, 0x60, 0x48, 0x16, 0x43, 0x52, 0x81, 0x7c, 0x6a, 0xfc, 0x0e, 0xe8, 0xdd, 0x6f,
-		0xf2, 0xfe, 0xfa, 0xd3, 0xa9, 0x5c, 0x95, 0x38, 0xb9, 0x3f, 0xe9, 0x19, 0x4f, 0xf6, 0x9e, 0x37,
-		0x1d, 0x3f, 0xf7, 0xe5, 0x3c, 0xb9, 0x4b, 0x30, 0xeb, 0x4b, 0x78, 0xc6, 0x53, 0xbd, 0x97, 0x53,
-		0xcd, 0x78, 0xf3, 0x9d, 0xdc, 0x53, 0x10, 0x21, 0xc9, 0xcb, 0x78, 0xf8, 0xcf, 0x71, 0x38, 0x15,
-		0xcf, 0xbd, 0x03, 0xe2, 0x22, 0x69, 0x19, 0x0f, 0xfd, 0x79, 0x0e, 0x75, 0x20, 0x04, 0x2e, 0x12,
-		0x96, 0xf1, 0xf0, 0x5f, 0x10, 0x70, 0x01, 0x21, 0xf0, 0xc9, 0x4d, 0xf8, 0x2f, 0xde, 0x1f, 0xe1,
-		0x8b, 0x8e, 0xb0, 0xdd, 0x33, 0x10, 0xe3, 0x99, 0xca, 0x78, 0xf4, 0x2f, 0xf2, 0xc6, 0x05, 0x22,
-		0x77, 0x01, 0xa6, 0x26, 0x34, 0xf8, 0x07, 0x38, 0x94, 0xc9, 0xe7, 0x8a, 0x30, 0xed, 0xc9, 0x4e,
-		0xc6, 0xc3, 0x3f, 0xc8, 0xe1, 0x5e, 0x14, 0x51, 0x9d, 0x67, 0x27, 0xe3, 0x09, 0xfe, 0x8e, 0x50,
-		0x9d, 0x23, 0x88, 0xd9, 0x44, 0x62, 0x32, 0x1e, 0xfd, 0x21, 0x61, 0x75, 0x01, 0xc9, 0x3d, 0x0b,
-		0x09, 0x67, 0xb1, 0x19, 0x8f, 0xff, 0x30, 0xc7, 0xbb, 0x18, 0x62, 0x01, 0xcf, 0x62, 0x37, 0x9e,
-		0xe2, 0xef, 0x0a, 0x0b, 0x78, 0x50, 0x64, 0x1a, 0x05, 0x13, 0x98, 0xf1, 0x4c, 0xbf, 0x2c, 0xa6,
-		0x51, 0x20, 0x7f, 0x21, 0xa3, 0x49, 0x63, 0xfe, 0x78, 0x8a, 0x5f, 0x11, 0xa3, 0x49, 0xe5, 0x89,
-		0x1a, 0xc1, 0x8c, 0x60, 0x3c, 0xc7, 0x47, 0x84, 0x1a, 0x81, 0x84, 0x20, 0xb7, 0x0e, 0x68, 0x38,
-		0x1b, 0x18, 0xcf, 0x77, 0x87, 0xf3, 0xcd, 0x0d, 0x25, 0x03, 0xb9, 0xab, 0x70, 0x64, 0x74, 0x26,
-		0x30, 0x9e, 0xf5, 0xa3, 0x6f, 0x06, 0xf6, 0x6e, 0xde, 0x44, 0x20, 0xd7, 0x74, 0x97, 0x14, 0x6f,
-		0x16, 0x30, 0x9e, 0xf6, 0x63, 0x6f, 0xfa, 0x03, 0xb7, 0x37, 0x09, 0xc8, 0xe5, 0x01, 0xdc, 0x05,
-		0x78, 0x3c, 0xd7, 0xaf, 0x72, 0x2e, 0x0f, 0x88, 0x4c, 0x0d, 0xbe, 0xfe, 0x8e, 0xc7, 0xbf, 0x22,
-		0xa6, 0x06, 0x47, 0x90, 0xa9, 0x21, 0x96, 0xde, 0xf1, 0xe8, 0x4f, 0x88, 0xa9, 0x21, 0x20, 0xc4,
-		0xb3, 0x3d, 0xab, 0xdb, 0x78, 0x86, 0x4f, 0x09, 0xcf, 0xf6, 0xa0, 0x72, 0x35, 0x98, 0x1b, 0x5a,
-		0x10, 0xc7, 0x53, 0xbd, 0xca, 0xa9, 0xe4, 0xe0, 0x7a, 0xe8, 0x5d, 0xbc, 0xf8, 0x62, 0x38, 0x9e,
-		0xed, 0xd7, 0x03, 0x8b, 0x17, 0x5f, 0x0b, 0x73, 0xcf, 0x40, 0xdc, 0x18, 0x74, 0x3a, 0x64, 0xf2,
-		0x8c, 0x3b, 0xf2, 0xfa, 0xee, 0x0f, 0xb9, 0x75, 0x04, 0x20, 0xf7, 0x14, 0x4c, 0xe1, 0xee, 0x26,
-		0x6e, 0x8d, 0x43, 0x7e, 0xef, 0x87, 0x22, 0x60, 0x12, 0xe9, 0xdc, 0xb3, 0x00, 0xec, 0x68, 0x84,
-		0xbe, 0x81, 0x31, 0x06, 0xfb, 0xfd, 0x1f, 0xf2, 0xd7, 0x35, 0x5d, 0x88, 0x4b, 0x30, 0xc9, 0x49,
-		0xdd, 0x0f, 0xfc, 0x04, 0x74, 0x44, 0x2e, 0x42, 0xec, 0x05, 0xcb, 0x34, 0x6c, 0x6d, 0xec, 0x89,
-		0xe5, 0x1f, 0x73, 0xb4, 0x90, 0x27, 0x06, 0xeb, 0x9a, 0x7d, 0x6c, 0x6b, 0xdb, 0xd6, 0x38, 0xec,
-		0x7f, 0xe7, 0x58, 0x07, 0x40, 0xc0, 0xba, 0x66, 0xd9, 0x93, 0xf4, 0xfb, 0x4f, 0x04, 0x58, 0x00,
-		0x88, 0xd2, 0xe4, 0xf7, 0x0d, 0x3c, 0xf6, 0x84, 0xf3, 0x4f, 0x85, 0xd2, 0x5c, 0x3e, 0xf7, 0x0e,
-		0x48, 0x90, 0x9f, 0xec, 0x35, 0xee, 0x31, 0xe0, 0x3f, 0xe3, 0x60, 0x17, 0x41, 0x5a, 0xb6, 0xec,
-		0x96, 0xdd, 0x1e, 0x6f, 0xec, 0x37, 0xf8, 0x48, 0x0b, 0xf9, 0x5c, 0x1e, 0xa6, 0x2d, 0xbb, 0xd5,
-		0x1a, 0xf0, 0xfc, 0x74, 0xdc, 0xf9, 0xf0, 0x0f, 0x9d, 0x23, 0x0b, 0x07, 0x43, 0x46, 0xfb, 0xd6,
-		0x0d, 0xbb, 0x67, 0xd2, 0x67, 0xf6, 0xc6, 0x9e, 0x10, 0x73, 0x06, 0x0f, 0x24, 0x57, 0x84, 0x19,
-		0xd2, 0x17, 0xf1, 0xe8, 0xd3, 0xd8, 0xf3, 0x61, 0x6e, 0x00, 0x1f, 0xa8, 0xf0, 0x37, 0xf7, 0x3a,
-		0xdc, 0x1d, 0x7d, 0x0f, 0x01, 0x56, 0xcc, 0x15, 0x93, 0xdd, 0x3d, 0x78, 0xfe, 0x6d, 0xc3, 0xa7,
-		0xbf, 0xfe, 0x73, 0x5d, 0xfa, 0x0b, 0xfe, 0x77, 0x14, 0x8e, 0xb1, 0x6a, 0x95, 0x55, 0xb1, 0x0b,
-		0x7e, 0xe8, 0x3b, 0xe3, 0xad, 0x1a, 0x7f, 0xee, 0x9b, 0x7d, 0x0e, 0xe6, 0x2b, 0xa4, 0xf7, 0x24,
-		0xab, 0x73, 0xef, 0x67, 0x8c, 0x7c, 0x7b, 0x7a, 0xc9, 0x97, 0xc0, 0xf0, 0xdb, 0x69, 0xde, 0xa2,
-		0xec, 0xbb, 0x25, 0x90, 0x1b, 0xba, 0xd6, 0xd1, 0xfa, 0xff, 0xb7, 0x54, 0xe8, 0x82, 0x38, 0xef,
-		0x77, 0xbe, 0xbe, 0x94, 0x3c, 0x9b, 0x5e, 0xf6, 0x76, 0x6e, 0x99, 0xb5, 0x44, 0xdf, 0x2c, 0x62,
-		0xe7, 0xfc, 0xe4, 0xe7, 0xa9, 0x6b, 0x00, 0x6e, 0x05, 0x3a, 0x0e, 0x47, 0x1b, 0xc5, 0x7c, 0x35,
-		0xaf, 0x88, 0xc7, 0x56, 0x1a, 0xeb, 0xe5, 0x22, 0xfb, 0x92, 0xc9, 0x21, 0x74, 0x04, 0x90, 0xb7,
-		0xd2, 0x79, 0x1b, 0xe3, 0x30, 0xcc, 0x79, 0xcb, 0xd9, 0x67, 0x25, 0x42, 0x64, 0xe5, 0x6b, 0x77,
-		0x7b, 0x1d, 0x4c, 0xef, 0xaf, 0xaa, 0x6d, 0x61, 0xb5, 0xf1, 0x41, 0xf5, 0xf7, 0xff, 0x13, 0xfb,
-		0xd4, 0xc0, 0xbc, 0x0b, 0x77, 0x6c, 0x9e, 0xab, 0xc2, 0x9c, 0xa6, 0xeb, 0xb8, 0xe7, 0xa3, 0x1c,
-		0xe3, 0x7a, 0x84, 0x90, 0xde, 0x31, 0xe6, 0x48, 0x97, 0xed, 0x02, 0x44, 0x2d, 0xda, 0xfb, 0x71,
-		0x14, 0x5f, 0xe7, 0x14, 0x5c, 0x3c, 0x67, 0xc0, 0x1c, 0xfb, 0xb2, 0x0e, 0xf6, 0xa8, 0xb1, 0xff,
-		0xbe, 0xe9, 0xf7, 0x7e, 0xe7, 0x09, 0x7a, 0x23, 0xf7, 0x01, 0xff, 0xb0, 0x8c, 0x70, 0x27, 0x45,
-		0xe6, 0xdc, 0xae, 0xa2, 0x18, 0x92, 0xa2, 0x3d, 0xae, 0xf0, 0xfe, 0x8d, 0xfd, 0x73, 0xde, 0xd8,
-		0x89, 0x51, 0x3e, 0xe0, 0x69, 0x69, 0x96, 0xb3, 0xb2, 0x8a, 0x7b, 0xba, 0xd7, 0x42, 0x99, 0x9f,
-		0xf1, 0x36, 0xe3, 0xdc, 0x6b, 0xf9, 0x57, 0x11, 0x38, 0xc1, 0x85, 0x37, 0x35, 0x0b, 0x9f, 0xbe,
-		0x79, 0x66, 0x13, 0xdb, 0xda, 0x99, 0xd3, 0xba, 0xd9, 0x36, 0xf8, 0x04, 0x9c, 0xe7, 0xd3, 0x91,
-		0xd4, 0x2f, 0xf3, 0xfa, 0xcc, 0xc8, 0x1b, 0x34, 0x99, 0xbd, 0xa7, 0x71, 0x66, 0xf8, 0x7e, 0x5f,
-		0xb6, 0x03, 0x91, 0xa2, 0xd9, 0xa6, 0xef, 0x6c, 0xb5, 0xb0, 0x61, 0x76, 0xf9, 0x84, 0x62, 0x17,
-		0xe8, 0x32, 0x44, 0xb5, 0xae, 0x39, 0x30, 0x6c, 0x36, 0x99, 0x0a, 0x4f, 0x7c, 0xed, 0xee, 0xe2,
-		0xa1, 0x3f, 0xbc, 0xbb, 0x78, 0x98, 0xd1, 0x5a, 0xad, 0x1b, 0xcb, 0x6d, 0xf3, 0x74, 0x57, 0xb3,
-		0x77, 0xc8, 0x00, 0xfd, 0x87, 0x2f, 0x3e, 0x0e, 0xbc, 0xbd, 0x8a, 0x61, 0x7f, 0xf6, 0xb5, 0x2f,
-		0x9c, 0x92, 0x14, 0x8e, 0xcf, 0x45, 0x5e, 0xff, 0xe4, 0xa2, 0x94, 0xed, 0x41, 0xac, 0x84, 0xf5,
-		0x7d, 0x1a, 0xac, 0x04, 0x1a, 0x3c, 0xc3, 0x1b, 0x3c, 0x3e, 0xdc, 0x20, 0x7b, 0x17, 0xa4, 0x84,
-		0x75, 0x4f, 0xb3, 0x25, 0xac, 0x07, 0x5a, 0xac, 0x40, 0xbc, 0x62, 0xd8, 0xec, 0xe3, 0x0d, 0xef,
-		0x80, 0x70, 0xdb, 0x60, 0xef, 0xfc, 0x26, 0x0a, 0x8f, 0x1e, 0xa0, 0x2b, 0x0a, 0xc1, 0x65, 0xeb,
-		0x10, 0x2f, 0x61, 0x9d, 0x51, 0x15, 0x21, 0xdc, 0xc2, 0x3a, 0xa7, 0xba, 0x07, 0x25, 0x09, 0xba,
-		0x50, 0xfa, 0xe6, 0x7f, 0x3d, 0x71, 0xe8, 0xa5, 0x6f, 0x9d, 0x38, 0xb4, 0xa7, 0x3b, 0x65, 0xc7,
-		0xdf, 0xba, 0x73, 0xbc, 0xe8, 0x2f, 0x25, 0x38, 0x16, 0x0c, 0xcc, 0x9a, 0xb1, 0xbb, 0xd7, 0x87,
-		0x2c, 0xcf, 0x43, 0x38, 0x6f, 0xec, 0xa2, 0x63, 0x2c, 0x45, 0x55, 0x07, 0xfd, 0x0e, 0x1f, 0x89,
-		0x18, 0xb9, 0xde, 0xe8, 0x77, 0xfc, 0xaf, 0xf1, 0xcd, 0xf0, 0xd7, 0xf8, 0x0a, 0x1f, 0x94, 0x0e,
-		0xb6, 0x16, 0xc5, 0xf3, 0xc6, 0x2e, 0xb5, 0xd9, 0xba, 0xf4, 0xfc, 0x63, 0x63, 0xef, 0x63, 0xdf,
-		0x30, 0xcc, 0x5b, 0x06, 0x51, 0xbb, 0xb7, 0x29, 0xee, 0x61, 0x9f, 0x08, 0xde, 0xc3, 0xbe, 0x8a,
-		0x3b, 0x9d, 0xe7, 0x88, 0x5c, 0xd3, 0xd7, 0xff, 0x5f, 0x0e, 0xc1, 0x89, 0xa1, 0x85, 0x89, 0x2f,
-		0xe3, 0x7b, 0x19, 0x21, 0x07, 0xf1, 0x92, 0xc8, 0x0e, 0xd2, 0x10, 0xb3, 0xb0, 0x6e, 0x1a, 0x2d,
-		0xf6, 0x3e, 0x56, 0x58, 0x11, 0x97, 0xc4, 0x10, 0x86, 0x66, 0x98, 0x16, 0xff, 0x18, 0x0a, 0xbb,
-		0x28, 0x7c, 0xfc, 0x80, 0x86, 0x98, 0x15, 0x2d, 0x09, 0x6b, 0x9c, 0x99, 0xd0, 0x1a, 0xa2, 0x13,
-		0xbe, 0x3b, 0xfb, 0x93, 0x5a, 0xe5, 0x23, 0x21, 0x58, 0x0c, 0x5a, 0x85, 0xe4, 0x46, 0x96, 0xad,
-		0x75, 0x7b, 0x7b, 0x99, 0xe5, 0x19, 0x48, 0x34, 0x85, 0xcc, 0x81, 0xed, 0xf2, 0xca, 0x01, 0xed,
-		0x92, 0x74, 0x9a, 0x12, 0x86, 0x39, 0x3b, 0xa1, 0x61, 0x9c, 0x7e, 0xdc, 0x93, 0x65, 0x5e, 0x3b,
-		0x0f, 0x4b, 0x6d, 0xa3, 0x6d, 0xb7, 0xb5, 0xd3, 0x5d, 0xcb, 0xd6, 0x6e, 0xb4, 0x8d, 0xed, 0xd3,
-		0x37, 0xcf, 0x9c, 0xe6, 0x3f, 0xb9, 0x69, 0x10, 0x93, 0x58, 0x16, 0x12, 0xcb, 0x37, 0xcf, 0x8c,
-		0x88, 0xa2, 0x99, 0x31, 0xe1, 0x7b, 0xbf, 0x98, 0xbc, 0x47, 0x10, 0xdf, 0x73, 0x26, 0x67, 0xc6,
-		0x38, 0x79, 0x66, 0xdc, 0x70, 0x67, 0xbf, 0x1a, 0x82, 0x54, 0xd1, 0xec, 0x76, 0xdb, 0x16, 0xfb,
-		0x06, 0x9d, 0x8d, 0x2d, 0xb4, 0x0a, 0x91, 0xbe, 0x66, 0xf3, 0x74, 0xaa, 0x70, 0xfe, 0xc0, 0x01,
-		0x8d, 0x05, 0x7b, 0xca, 0x81, 0x5a, 0x10, 0xef, 0x6a, 0xb7, 0x55, 0xca, 0xc7, 0xa2, 0x78, 0x65,
-		0x02, 0xbe, 0x37, 0xee, 0x2e, 0xa6, 0x76, 0xb5, 0x6e, 0x27, 0x97, 0x15, 0xe0, 0xec, 0xa8, 0x26,
-		0x62, 0x5d, 0xed, 0x36, 0x51, 0x19, 0xdd, 0xa6, 0x4f, 0x3c, 0xaa, 0xfa, 0x8e, 0x66, 0x6c, 0x63,
-		0xd6, 0x18, 0xcd, 0x9c, 0x0b, 0xeb, 0x93, 0x35, 0x76, 0xc4, 0x6d, 0xcc, 0xc3, 0x31, 0xb2, 0xcd,
-		0xd9, 0xae, 0x76, 0xbb, 0x48, 0x65, 0x48, 0xcb, 0xb9, 0xf8, 0x9d, 0x4f, 0x2e, 0x1e, 0xa2, 0x8b,
-		0xcb, 0xbf, 0x93, 0x00, 0x5c, 0x4b, 0xa2, 0x6b, 0x20, 0xeb, 0xce, 0x15, 0xa5, 0x13, 0xaf, 0x76,
-		0x3e, 0xb8, 0x3c, 0xec, 0x47, 0xcb, 0x81, 0x31, 0x28, 0xc4, 0x89, 0xe2, 0xdf, 0xb8, 0xbb, 0x28,
-		0x29, 0x29, 0x3d, 0x30, 0x3c, 0x3f, 0x0d, 0xd3, 0x83, 0x5e, 0x4b, 0xb3, 0xb1, 0x4a, 0x06, 0x93,
-		0xbf, 0x01, 0x98, 0x19, 0x4a, 0x6d, 0x9c, 0x99, 0x54, 0x38, 0x41, 0xb8, 0xde, 0xb8, 0xbb, 0x88,
-		0x58, 0x2f, 0x3d, 0xe0, 0xec, 0x87, 0xfe, 0x68, 0x51, 0x52, 0x80, 0x95, 0x10, 0x80, 0xa7, 0x3f,
-		0xbf, 0x4f, 0xbf, 0x28, 0xe8, 0xa6, 0xcb, 0x69, 0x88, 0x75, 0x4d, 0xa3, 0x7d, 0x83, 0x7f, 0x52,
-		0x30, 0xa1, 0x88, 0x4b, 0x94, 0x81, 0x38, 0x7b, 0x13, 0xc9, 0xde, 0x15, 0x9f, 0xaf, 0x13, 0xd7,
-		0x04, 0x75, 0x0b, 0x6f, 0x5a, 0x6d, 0x31, 0x22, 0x8a, 0xb8, 0x44, 0x97, 0x40, 0xb6, 0xb0, 0x3e,
-		0xe8, 0xb7, 0xed, 0x5d, 0x55, 0x27, 0x3b, 0x5a, 0x9d, 0xbd, 0x63, 0x98, 0x28, 0x1c, 0x7f, 0xe3,
-		0xee, 0xe2, 0x51, 0xa6, 0x6b, 0x50, 0x22, 0xab, 0xa4, 0x44, 0x51, 0x91, 0x95, 0x90, 0x16, 0x5a,
-		0xd8, 0xd6, 0xda, 0x1d, 0xf6, 0x08, 0x68, 0x42, 0x11, 0x97, 0x9e, 0xbe, 0x7c, 0x39, 0x01, 0x09,
-		0xfe, 0x40, 0xb7, 0xd9, 0x27, 0x2d, 0x9b, 0x3d, 0xdc, 0xd7, 0xe8, 0x67, 0x3b, 0x5a, 0xad, 0x3e,
-		0xb6, 0x2c, 0xee, 0xeb, 0x9e, 0x96, 0x83, 0x12, 0x59, 0x25, 0x25, 0x8a, 0xf2, 0xac, 0x04, 0xd9,
-		0x64, 0x88, 0x0d, 0x0b, 0x1b, 0xd6, 0xc0, 0x52, 0x7b, 0x83, 0xcd, 0x1b, 0x78, 0x97, 0x8f, 0xc6,
-		0xc2, 0xd0, 0x68, 0xe4, 0x8d, 0xdd, 0xc2, 0x93, 0x2e, 0x7b, 0x10, 0x97, 0xfd, 0xfa, 0x17, 0x1f,
-		0x5f, 0xe0, 0xae, 0xa6, 0xf7, 0x77, 0x7b, 0xb6, 0xb9, 0xbc, 0x3e, 0xd8, 0x7c, 0x0e, 0xef, 0x92,
-		0xe1, 0xe7, 0xa2, 0xeb, 0x54, 0x12, 0x1d, 0x81, 0xe8, 0x0b, 0x5a, 0xbb, 0x23, 0x5e, 0xac, 0x55,
-		0xf8, 0x15, 0x3a, 0x0f, 0x51, 0xcb, 0xd6, 0xec, 0x81, 0xc5, 0x9f, 0x17, 0x3e, 0x31, 0xca, 0xcd,
-		0x0a, 0xa6, 0xd1, 0x6a, 0x50, 0x29, 0x85, 0x4b, 0x23, 0x1b, 0xa2, 0xb6, 0x79, 0x03, 0x1b, 0x16,
-		0xff, 0xec, 0xeb, 0xb1, 0xe5, 0x11, 0xe9, 0xe5, 0x32, 0x49, 0xd3, 0x0a, 0x79, 0xee, 0x48, 0xb3,
-		0xac, 0x13, 0x0c, 0x96, 0xfd, 0xdc, 0x1f, 0x2d, 0x9e, 0x1c, 0x9f, 0x9a, 0x50, 0x06, 0x4b, 0xe1,
-		0x6d, 0xa1, 0x8f, 0x48, 0x20, 0xb7, 0x70, 0x07, 0x6f, 0x53, 0x1b, 0x5b, 0x3b, 0x5a, 0x1f, 0x5b,
-		0xfc, 0x43, 0xba, 0xf7, 0x8d, 0x54, 0x80, 0xa7, 0x8a, 0x85, 0x1a, 0xd7, 0x81, 0x1b, 0x32, 0xc8,
-		0x41, 0xb4, 0x99, 0xe0, 0x19, 0x27, 0x41, 0x67, 0x29, 0x29, 0x87, 0xa1, 0x41, 0x09, 0xd0, 0x8a,
-		0x7f, 0xe3, 0x28, 0xbe, 0x39, 0x3b, 0xc2, 0x98, 0x9e, 0xd9, 0x51, 0x88, 0x10, 0xb5, 0xfc, 0xfb,
-		0xcb, 0x4b, 0x20, 0x0f, 0x8c, 0x4d, 0xd3, 0xa0, 0x0f, 0xd7, 0xee, 0xe0, 0xf6, 0xf6, 0x8e, 0x4d,
-		0xdf, 0x46, 0x09, 0x7b, 0xdd, 0x2c, 0x28, 0x91, 0x55, 0x52, 0x4e, 0xd1, 0x65, 0x5a, 0x82, 0x5a,
-		0x90, 0x74, 0xa5, 0xe8, 0x94, 0x4f, 0x8c, 0x9d, 0xf2, 0x0f, 0x70, 0x2b, 0x1d, 0x0e, 0xb6, 0xe2,
-		0xce, 0xfa, 0x59, 0xa7, 0x90, 0xc0, 0x50, 0x09, 0xc0, 0x0d, 0x34, 0xf4, 0x93, 0x20, 0xd3, 0xa3,
-		0x5d, 0xc8, 0x8d, 0x54, 0xbc, 0xd3, 0x1e, 0x1c, 0x7a, 0x59, 0x82, 0xd9, 0x9b, 0xa6, 0x4d, 0x5a,
-		0xea, 0x99, 0xb7, 0x70, 0xdf, 0xa2, 0x1f, 0xec, 0xde, 0xd7, 0xa9, 0x2e, 0x73, 0x55, 0x17, 0x98,
-		0xaa, 0x3e, 0xf4, 0xc1, 0x7c, 0x6b, 0x86, 0x61, 0xd7, 0x29, 0x14, 0x5d, 0x85, 0x19, 0x2f, 0x17,
-		0xfb, 0xb2, 0x74, 0xe1, 0xdc, 0xbe, 0x99, 0xfe, 0x1b, 0x77, 0x17, 0xe7, 0x87, 0xd5, 0xc8, 0x2a,
-		0xd3, 0x1e, 0x66, 0xf4, 0x76, 0x38, 0xee, 0xda, 0xd3, 0x34, 0xd4, 0x1d, 0xb3, 0xd3, 0x52, 0xfb,
-		0x78, 0x4b, 0xd5, 0xe9, 0x5e, 0x65, 0x96, 0xe6, 0x45, 0x47, 0x1d, 0x91, 0xba, 0x71, 0xd9, 0xec,
-		0xb4, 0x14, 0xbc, 0x55, 0x24, 0xd5, 0xe8, 0x01, 0x98, 0x71, 0xd1, 0xed, 0x16, 0xfd, 0xae, 0x75,
-		0x44, 0x99, 0x76, 0xca, 0x2a, 0xad, 0xdc, 0xcc, 0xcb, 0x9f, 0x5c, 0x3c, 0xc4, 0x63, 0xd7, 0xa1,
-		0xec, 0x79, 0x98, 0xb9, 0xa2, 0x75, 0x78, 0xcc, 0xc1, 0x16, 0xba, 0x0f, 0x12, 0x9a, 0xb8, 0xa0,
-		0xcf, 0x23, 0x27, 0x14, 0xb7, 0x80, 0xc5, 0xbc, 0x97, 0xfe, 0xf3, 0x92, 0x94, 0xfd, 0xbc, 0x04,
-		0xd1, 0xd2, 0x95, 0x75, 0xad, 0xdd, 0x47, 0x15, 0xb2, 0xf3, 0x16, 0xf3, 0xc4, 0x1f, 0xf1, 0xee,
-		0x7b, 0xe3, 0xee, 0x62, 0x3a, 0x38, 0x95, 0x9c, 0x90, 0xe7, 0x4e, 0x51, 0x11, 0xf3, 0x2a, 0x30,
-		0x77, 0x53, 0x04, 0x52, 0x87, 0x2a, 0x14, 0xa4, 0x1a, 0x12, 0xc9, 0x2a, 0xb2, 0x53, 0xc6, 0xa9,
-		0x02, 0xdd, 0xcc, 0x43, 0x8c, 0x69, 0x6b, 0xa1, 0xf3, 0x30, 0xd5, 0x23, 0x3f, 0xf8, 0x53, 0xdc,
-		0x99, 0x91, 0x73, 0x8f, 0xca, 0x72, 0x0f, 0x64, 0xe2, 0xd9, 0x0f, 0x87, 0x00, 0x4a, 0x57, 0xae,
-		0x34, 0xfb, 0xed, 0x5e, 0x07, 0xdb, 0x7f, 0x95, 0xbd, 0x6e, 0xc2, 0x61, 0xb7, 0x4b, 0x56, 0x5f,
-		0x0f, 0xf4, 0x7c, 0xe9, 0x8d, 0xbb, 0x8b, 0xf7, 0x05, 0x7b, 0xee, 0x11, 0xcb, 0x2a, 0xf3, 0x4e,
-		0x79, 0xa3, 0xaf, 0x8f, 0x64, 0x6d, 0x59, 0xb6, 0xc3, 0x1a, 0xde, 0x9b, 0xd5, 0x23, 0xe6, 0x65,
-		0x2d, 0x59, 0xf6, 0x68, 0xb3, 0xd6, 0x61, 0xda, 0x35, 0x89, 0x85, 0xde, 0x09, 0x71, 0x9b, 0xff,
-		0xe6, 0xd6, 0x3d, 0x31, 0xda, 0xba, 0x02, 0xc2, 0x2d, 0xec, 0xa0, 0xb2, 0x9f, 0x27, 0x46, 0x66,
-		0xf6, 0x21, 0x13, 0xfe, 0x2d, 0xe9, 0x5a, 0xe8, 0x45, 0x88, 0xf2, 0x25, 0x25, 0x3c, 0xc1, 0x92,
-		0x52, 0xf2, 0x2f, 0x6b, 0xf7, 0xb8, 0x90, 0xf0, 0x16, 0x03, 0xf6, 0xff, 0x85, 0x10, 0xcc, 0x6f,
-		0x88, 0xb9, 0xfd, 0x96, 0xb7, 0x5b, 0x15, 0x62, 0xd8, 0xb0, 0xfb, 0x6d, 0xc7, 0x70, 0x8f, 0x8d,
-		0xf2, 0x8e, 0x11, 0xfd, 0xa1, 0xdf, 0x22, 0xe3, 0xbe, 0x22, 0x28, 0x02, 0x96, 0xf8, 0xbd, 0x08,
-		0xa4, 0xf7, 0x42, 0xa2, 0x22, 0xa4, 0xf4, 0x3e, 0x66, 0xdf, 0xd9, 0xe1, 0x4b, 0x25, 0xdd, 0x5f,
-		0x16, 0x32, 0x6e, 0x76, 0x1e, 0x10, 0xc8, 0x2a, 0x49, 0x51, 0xc2, 0x17, 0xca, 0x6d, 0x20, 0xb9,
-		0x32, 0x71, 0x53, 0x22, 0x35, 0x61, 0x72, 0x9c, 0xe5, 0x83, 0x2f, 0x1a, 0xf1, 0x13, 0xb0, 0xa5,
-		0x32, 0xe9, 0x96, 0xd2, 0xb5, 0xf2, 0x83, 0x12, 0xa4, 0x98, 0x5d, 0x3a, 0xea, 0xa6, 0xd6, 0xd1,
-		0x0c, 0x5d, 0x7c, 0xbd, 0x7f, 0x9f, 0x75, 0x6e, 0xd5, 0xdf, 0x50, 0x00, 0x7f, 0xb0, 0x95, 0x2e,
-		0xc9, 0xd1, 0x05, 0x06, 0x46, 0xb7, 0x20, 0x26, 0xf4, 0x88, 0x8c, 0xd3, 0xa3, 0xc0, 0xf5, 0x48,
-		0x32, 0x3d, 0xee, 0xa9, 0x7d, 0xd1, 0xda, 0xd0, 0x6a, 0x36, 0x35, 0xb4, 0x9a, 0x8d, 0x5b, 0x2e,
-		0xa3, 0xfb, 0x2e, 0x97, 0x9e, 0x1c, 0xfe, 0x2b, 0x11, 0x98, 0x53, 0x70, 0xeb, 0xff, 0x3b, 0xce,
-		0x5f, 0xa5, 0xe3, 0xfc, 0x9c, 0x04, 0xc0, 0xe2, 0x16, 0x59, 0x59, 0xb8, 0xf3, 0xec, 0x1f, 0x2d,
-		0x45, 0xbe, 0x36, 0xe7, 0x8d, 0x96, 0x04, 0x7d, 0xe0, 0x88, 0x99, 0x60, 0xd8, 0x92, 0x65, 0xff,
-		0x38, 0xfd, 0xe8, 0x0f, 0x43, 0x30, 0xe3, 0xf5, 0xa3, 0xff, 0x47, 0xf3, 0x04, 0x54, 0x76, 0x63,
-		0x7d, 0x84, 0xff, 0x69, 0x8e, 0x11, 0xb1, 0x7e, 0x68, 0xc6, 0xed, 0x1f, 0xe4, 0xbf, 0x1a, 0x81,
-		0xe8, 0xba, 0xd6, 0xd7, 0xba, 0x16, 0xd2, 0x87, 0xb6, 0x2d, 0xec, 0xf8, 0xe3, 0xd8, 0xf0, 0x1f,
-		0xdf, 0xe0, 0x67, 0x56, 0x63, 0x76, 0x2d, 0x77, 0x46, 0xec, 0x5a, 0xde, 0x09, 0xc9, 0xae, 0x76,
-		0x5b, 0x75, 0xfa, 0xc7, 0x2c, 0x3d, 0x5b, 0x38, 0xe6, 0xb2, 0xf8, 0xeb, 0xb3, 0xf4, 0x00, 0xc7,
-		0x39, 0x0b, 0xb0, 0xd0, 0x05, 0x98, 0x26, 0x12, 0xee, 0xb2, 0x47, 0xe0, 0x47, 0xdc, 0xd3, 0x12,
-		0x4f, 0x65, 0x56, 0x81, 0xae, 0x76, 0xbb, 0xcc, 0x2e, 0x50, 0x15, 0xd0, 0x4e, 0xdb, 0xb2, 0xcd,
-		0x7e, 0x5b, 0xd7, 0x3a, 0xaa, 0x6b, 0x4a, 0x82, 0xbf, 0xff, 0x8d, 0xbb, 0x8b, 0xc7, 0x18, 0x7e,
-		0x58, 0x26, 0xab, 0xcc, 0xb9, 0x85, 0x82, 0xed, 0x02, 0x4c, 0x93, 0x7e, 0xa9, 0xf4, 0xce, 0x07,
-		0xdb, 0x8a, 0x27, 0xbc, 0x6a, 0x78, 0x2a, 0xb3, 0x0a, 0x90, 0xab, 0x12, 0xbd, 0x40, 0x65, 0x90,
-		0xbb, 0x6d, 0x43, 0xf5, 0x6d, 0x75, 0xc8, 0x5c, 0x88, 0x78, 0x77, 0x99, 0x41, 0x89, 0x2c, 0x7d,
-		0x67, 0xf8, 0x8a, 0x67, 0x53, 0xf3, 0x5e, 0x09, 0xe6, 0x89, 0x54, 0xe0, 0xcc, 0x8a, 0xfd, 0xb5,
-		0x81, 0x42, 0x73, 0xb2, 0x63, 0xb4, 0x8c, 0xdb, 0x5a, 0x80, 0x67, 0xe4, 0x51, 0xda, 0x5c, 0xb7,
-		0x6d, 0xf8, 0xcf, 0xbd, 0x3c, 0xd3, 0xf4, 0xdf, 0x48, 0x80, 0xdc, 0x2c, 0x41, 0xc1, 0x56, 0xcf,
-		0x34, 0x2c, 0xba, 0x4d, 0x75, 0x1d, 0x92, 0x7b, 0xd4, 0xe8, 0x14, 0xd6, 0x91, 0x12, 0xdb, 0x54,
-		0xcf, 0x94, 0xc7, 0xee, 0x7a, 0x19, 0x1a, 0x17, 0x7e, 0xe9, 0x6d, 0xae, 0x7b, 0x5a, 0x1d, 0x9d,
-		0xde, 0x1c, 0xca, 0x7e, 0x47, 0x82, 0x63, 0x43, 0x53, 0xc9, 0xe9, 0xd4, 0xf3, 0x80, 0xfa, 0x9e,
-		0x4a, 0xfe, 0xa5, 0x57, 0xd6, 0xb9, 0x03, 0xcd, 0xca, 0xb9, 0xfe, 0xd0, 0x02, 0xf9, 0x63, 0xea,
-		0x2a, 0xbb, 0xc1, 0xf6, 0xbb, 0x12, 0x2c, 0x78, 0x75, 0x73, 0x7a, 0xb8, 0x0a, 0x33, 0x5e, 0xd5,
-		0x78, 0xdf, 0x96, 0xc6, 0xf5, 0x8d, 0x77, 0xcb, 0x87, 0x45, 0x6b, 0x6e, 0xe0, 0x12, 0x9f, 0x55,
-		0x9a, 0xc4, 0x44, 0x42, 0x97, 0x60, 0x00, 0x8b, 0xd0, 0x01, 0xfa, 0x8b, 0x30, 0x44, 0xd6, 0x4d,
-		0xb3, 0x83, 0x3e, 0x2b, 0xc1, 0x9c, 0x61, 0xda, 0x2a, 0x99, 0x62, 0xb8, 0xa5, 0xf2, 0xa3, 0x31,
-		0x69, 0x9c, 0xe9, 0x34, 0x42, 0xfa, 0xbd, 0xbb, 0x8b, 0xc3, 0x58, 0x77, 0x09, 0x19, 0xaa, 0x3a,
-		0xd8, 0xda, 0x9d, 0x32, 0x4c, 0xbb, 0x40, 0xe1, 0x4d, 0x76, 0x86, 0xf6, 0x51, 0x09, 0x66, 0xfd,
-		0x6a, 0x8e, 0x1d, 0xe1, 0xeb, 0x5c, 0xcd, 0xd9, 0xa0, 0x8a, 0x0b, 0x6e, 0x98, 0xb9, 0x47, 0xf5,
-		0x66, 0x36, 0xbd, 0xba, 0xbd, 0x2a, 0xc1, 0x82, 0x37, 0xe2, 0xa8, 0xb7, 0x68, 0xaa, 0x35, 0xd9,
-		0x86, 0x4c, 0xe1, 0xeb, 0xc0, 0xf1, 0xe1, 0xb3, 0x18, 0xc1, 0x73, 0xe0, 0x64, 0x03, 0x79, 0x8e,
-		0x70, 0xae, 0x32, 0x8e, 0x5c, 0x9c, 0x38, 0xed, 0x9f, 0x7e, 0x72, 0x51, 0x3a, 0xf5, 0x25, 0x09,
-		0xc0, 0x3d, 0x1b, 0x45, 0x8f, 0xc1, 0xd1, 0x42, 0xbd, 0x56, 0x52, 0x1b, 0xcd, 0x7c, 0x73, 0xa3,
-		0xe1, 0x7f, 0xa6, 0x23, 0x93, 0x7a, 0xdf, 0x2b, 0x4b, 0xd3, 0x1b, 0x86, 0xd5, 0xc3, 0x3a, 0xfd,
-		0xe4, 0x2e, 0x7a, 0x08, 0x16, 0xfc, 0xd2, 0xe4, 0xaa, 0x5c, 0x92, 0xa5, 0xcc, 0xcc, 0xfb, 0x5e,
-		0x59, 0x8a, 0xb3, 0x4d, 0x0f, 0x6e, 0xa1, 0x93, 0x70, 0x78, 0x58, 0xae, 0x52, 0x5b, 0x91, 0x43,
-		0x99, 0xd9, 0xf7, 0xbd, 0xb2, 0x94, 0x70, 0x76, 0x47, 0x28, 0x0b, 0xc8, 0x2b, 0xc9, 0xf9, 0xc2,
-		0x19, 0x78, 0xdf, 0x2b, 0x4b, 0x51, 0xe6, 0x03, 0x99, 0xc8, 0xcb, 0xbf, 0x7e, 0xe2, 0xd0, 0xa9,
-		0x9f, 0x01, 0xa8, 0x18, 0x5b, 0x7d, 0x8d, 0xbe, 0xc6, 0x8b, 0x32, 0x70, 0xa4, 0x52, 0xbb, 0xa4,
-		0xe4, 0x8b, 0xfc, 0xf5, 0x6d, 0xef, 0xa3, 0x28, 0xfe, 0x3a, 0xf6, 0x07, 0x58, 0xd4, 0x46, 0x65,
-		0xa5, 0xc6, 0x5e, 0xb0, 0xf6, 0xd5, 0x5d, 0x65, 0x5f, 0xf9, 0x0c, 0x15, 0x2e, 0xef, 0x79, 0x37,
-		0x7a, 0xd9, 0x63, 0x7c, 0x36, 0xfd, 0x1e, 0xef, 0x68, 0x9b, 0x16, 0xff, 0x7d, 0xfa, 0xb6, 0x7b,
-		0x1f, 0xcd, 0x77, 0x67, 0xfa, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0xcd, 0x81, 0x7a, 0xc2, 0x6a,
-		0x71, 0x00, 0x00,
+		// 8472 bytes of a gzipped FileDescriptorSet
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x7b, 0x90, 0x1c, 0xc7,
+		0x79, 0x1f, 0x66, 0x77, 0x6f, 0x6f, 0xf7, 0xdb, 0xbb, 0xdd, 0xb9, 0xbe, 0x03, 0xb0, 0x38, 0x90,
+		0xb8, 0xe3, 0x52, 0x22, 0x41, 0x90, 0x38, 0x10, 0x20, 0x09, 0x12, 0x4b, 0xc9, 0xd4, 0xbe, 0x70,
+		0x58, 0xf0, 0xb0, 0x77, 0x9e, 0xdd, 0xc3, 0x83, 0x8e, 0x6a, 0x3c, 0xb7, 0xdb, 0x77, 0x37, 0xc4,
+		0xee, 0xcc, 0x6a, 0x66, 0x16, 0xc0, 0xf1, 0x0f, 0x15, 0x6d, 0xc9, 0x36, 0x2d, 0x59, 0x0a, 0x15,
+		0x3b, 0x11, 0x25, 0x99, 0x32, 0x2d, 0x57, 0x2c, 0x45, 0x91, 0x12, 0xdb, 0x52, 0xe4, 0x28, 0x89,
+		0xf3, 0xa8, 0x94, 0x63, 0xd9, 0x4e, 0x52, 0xb2, 0xaa, 0x92, 0x52, 0xb9, 0x52, 0x70, 0x22, 0xa9,
+		0x44, 0x4a, 0x96, 0x63, 0x1b, 0x61, 0x52, 0xae, 0x52, 0x52, 0x4a, 0xf5, 0x6b, 0x5e, 0xbb, 0x77,
+		0xb3, 0x87, 0x58, 0x2a, 0x56, 0xc5, 0xff, 0x00, 0x3b, 0xdd, 0xdf, 0xef, 0xd7, 0x5f, 0x7f, 0xfd,
+		0x75, 0xf7, 0xd7, 0x8f, 0x99, 0x83, 0x3b, 0x3f, 0x09, 0x8b, 0x5b, 0xa6, 0xb9, 0xd5, 0xc5, 0xa7,
+		0xfa, 0x96, 0xe9, 0x98, 0x1b, 0x83, 0xcd, 0x53, 0x1d, 0x6c, 0xb7, 0x2d, 0xbd, 0xef, 0x98, 0xd6,
+		0x12, 0x4d, 0x43, 0x39, 0x26, 0xb1, 0x24, 0x24, 0x0a, 0x97, 0x60, 0xe6, 0xbc, 0xde, 0xc5, 0x55,
+		0x57, 0xb0, 0x89, 0x1d, 0xf4, 0x14, 0x24, 0x36, 0xf5, 0x2e, 0xce, 0x4b, 0x8b, 0xf1, 0xe3, 0x99,
+		0x33, 0x6f, 0x5b, 0x0a, 0x81, 0x96, 0x82, 0x88, 0x35, 0x92, 0xac, 0x50, 0x44, 0xe1, 0x95, 0x09,
+		0x98, 0x1d, 0x91, 0x8b, 0x10, 0x24, 0x0c, 0xad, 0x47, 0x18, 0xa5, 0xe3, 0x69, 0x85, 0xfe, 0x46,
+		0x79, 0x98, 0xec, 0x6b, 0xed, 0xeb, 0xda, 0x16, 0xce, 0xc7, 0x68, 0xb2, 0x78, 0x44, 0xc7, 0x00,
+		0x3a, 0xb8, 0x8f, 0x8d, 0x0e, 0x36, 0xda, 0x3b, 0xf9, 0xf8, 0x62, 0xfc, 0x78, 0x5a, 0xf1, 0xa5,
+		0xa0, 0x87, 0x61, 0xa6, 0x3f, 0xd8, 0xe8, 0xea, 0x6d, 0xd5, 0x27, 0x06, 0x8b, 0xf1, 0xe3, 0x13,
+		0x8a, 0xcc, 0x32, 0xaa, 0x9e, 0xf0, 0x83, 0x90, 0xbb, 0x89, 0xb5, 0xeb, 0x7e, 0xd1, 0x0c, 0x15,
+		0xcd, 0x92, 0x64, 0x9f, 0x60, 0x05, 0xa6, 0x7a, 0xd8, 0xb6, 0xb5, 0x2d, 0xac, 0x3a, 0x3b, 0x7d,
+		0x9c, 0x4f, 0xd0, 0xda, 0x2f, 0x0e, 0xd5, 0x3e, 0x5c, 0xf3, 0x0c, 0x47, 0xb5, 0x76, 0xfa, 0x18,
+		0x95, 0x20, 0x8d, 0x8d, 0x41, 0x8f, 0x31, 0x4c, 0xec, 0x62, 0xbf, 0x9a, 0x31, 0xe8, 0x85, 0x59,
+		0x52, 0x04, 0xc6, 0x29, 0x26, 0x6d, 0x6c, 0xdd, 0xd0, 0xdb, 0x38, 0x9f, 0xa4, 0x04, 0x0f, 0x0e,
+		0x11, 0x34, 0x59, 0x7e, 0x98, 0x43, 0xe0, 0x50, 0x05, 0xd2, 0xf8, 0x96, 0x83, 0x0d, 0x5b, 0x37,
+		0x8d, 0xfc, 0x24, 0x25, 0x79, 0xfb, 0x88, 0x56, 0xc4, 0xdd, 0x4e, 0x98, 0xc2, 0xc3, 0xa1, 0xb3,
+		0x30, 0x69, 0xf6, 0x1d, 0xdd, 0x34, 0xec, 0x7c, 0x6a, 0x51, 0x3a, 0x9e, 0x39, 0x73, 0xcf, 0x48,
+		0x47, 0x58, 0x65, 0x32, 0x8a, 0x10, 0x46, 0x75, 0x90, 0x6d, 0x73, 0x60, 0xb5, 0xb1, 0xda, 0x36,
+		0x3b, 0x58, 0xd5, 0x8d, 0x4d, 0x33, 0x9f, 0xa6, 0x04, 0x0b, 0xc3, 0x15, 0xa1, 0x82, 0x15, 0xb3,
+		0x83, 0xeb, 0xc6, 0xa6, 0xa9, 0x64, 0xed, 0xc0, 0x33, 0x3a, 0x04, 0x49, 0x7b, 0xc7, 0x70, 0xb4,
+		0x5b, 0xf9, 0x29, 0xea, 0x21, 0xfc, 0x09, 0x9d, 0x81, 0x49, 0xdc, 0xd1, 0x49, 0x71, 0xf9, 0xec,
+		0xa2, 0x74, 0x3c, 0x7b, 0x26, 0x3f, 0x6c, 0x63, 0x96, 0xaf, 0x08, 0xc1, 0xc2, 0x97, 0x93, 0x90,
+		0x1b, 0xc7, 0x2d, 0x9f, 0x86, 0x89, 0x4d, 0x62, 0x99, 0x7c, 0x6c, 0x3f, 0x76, 0x63, 0x98, 0xa0,
+		0xe1, 0x93, 0x77, 0x69, 0xf8, 0x12, 0x64, 0x0c, 0x6c, 0x3b, 0xb8, 0xc3, 0xbc, 0x28, 0x3e, 0xa6,
+		0x1f, 0x02, 0x03, 0x0d, 0xbb, 0x61, 0xe2, 0xae, 0xdc, 0xf0, 0x2a, 0xe4, 0x5c, 0x95, 0x54, 0x4b,
+		0x33, 0xb6, 0x84, 0x3f, 0x9f, 0x8a, 0xd2, 0x64, 0xa9, 0x26, 0x70, 0x0a, 0x81, 0x29, 0x59, 0x1c,
+		0x78, 0x46, 0x55, 0x00, 0xd3, 0xc0, 0xe6, 0xa6, 0xda, 0xc1, 0xed, 0x6e, 0x3e, 0xb5, 0x8b, 0x95,
+		0x56, 0x89, 0xc8, 0x90, 0x95, 0x4c, 0x96, 0xda, 0xee, 0xa2, 0x73, 0x9e, 0x7b, 0x4e, 0xee, 0xe2,
+		0x5d, 0x97, 0x58, 0xc7, 0x1c, 0xf2, 0xd0, 0x75, 0xc8, 0x5a, 0x98, 0xf4, 0x15, 0xdc, 0xe1, 0x35,
+		0x4b, 0x53, 0x25, 0x96, 0x22, 0x6b, 0xa6, 0x70, 0x18, 0xab, 0xd8, 0xb4, 0xe5, 0x7f, 0x44, 0xf7,
+		0x83, 0x9b, 0xa0, 0x52, 0xb7, 0x02, 0x3a, 0x72, 0x4d, 0x89, 0xc4, 0x86, 0xd6, 0xc3, 0xf3, 0x2f,
+		0x40, 0x36, 0x68, 0x1e, 0x34, 0x07, 0x13, 0xb6, 0xa3, 0x59, 0x0e, 0xf5, 0xc2, 0x09, 0x85, 0x3d,
+		0x20, 0x19, 0xe2, 0xd8, 0xe8, 0xd0, 0x91, 0x71, 0x42, 0x21, 0x3f, 0xd1, 0xbb, 0xbc, 0x0a, 0xc7,
+		0x69, 0x85, 0x1f, 0x18, 0x6e, 0xd1, 0x00, 0x73, 0xb8, 0xde, 0xf3, 0x4f, 0xc2, 0x74, 0xa0, 0x02,
+		0xe3, 0x16, 0x5d, 0xf8, 0xf7, 0x09, 0x38, 0x38, 0x92, 0x1b, 0x5d, 0x85, 0xb9, 0x81, 0xa1, 0x1b,
+		0x0e, 0xb6, 0xfa, 0x16, 0x26, 0x2e, 0xcb, 0xca, 0xca, 0xbf, 0x3e, 0xb9, 0x8b, 0xd3, 0xad, 0xfb,
+		0xa5, 0x19, 0x8b, 0x32, 0x3b, 0x18, 0x4e, 0x44, 0xd7, 0x20, 0x43, 0xfc, 0x43, 0xb3, 0x34, 0x4a,
+		0xc8, 0x7a, 0xe3, 0x99, 0xf1, 0xaa, 0xbc, 0x54, 0xf5, 0x90, 0xe5, 0xf8, 0x4b, 0x52, 0x4c, 0xf1,
+		0x73, 0xa1, 0x27, 0x21, 0xb5, 0x89, 0x35, 0x67, 0x60, 0x61, 0x3b, 0x7f, 0x86, 0x9a, 0xf2, 0xe8,
+		0x70, 0x27, 0x65, 0x02, 0x4d, 0xec, 0x28, 0xae, 0x30, 0xea, 0xc1, 0xd4, 0x0d, 0x6c, 0xe9, 0x9b,
+		0x7a, 0x9b, 0x29, 0x15, 0xa7, 0x83, 0xcf, 0x53, 0x63, 0x2a, 0x75, 0xd9, 0x07, 0x6d, 0x3a, 0x9a,
+		0x83, 0x8b, 0xb0, 0xde, 0xb8, 0x5c, 0x53, 0xea, 0xe7, 0xeb, 0xb5, 0x2a, 0x53, 0x33, 0x40, 0x3f,
+		0xff, 0x4b, 0x12, 0x64, 0x7c, 0x35, 0x21, 0xc3, 0xa1, 0x31, 0xe8, 0x6d, 0x60, 0x8b, 0xb7, 0x17,
+		0x7f, 0x42, 0x47, 0x21, 0xbd, 0x39, 0xe8, 0x76, 0x99, 0xd3, 0xb1, 0xb9, 0x34, 0x45, 0x12, 0x88,
+		0xc3, 0x91, 0x31, 0x8e, 0x0f, 0x23, 0x74, 0x8c, 0x23, 0xbf, 0xd1, 0x3c, 0xa4, 0x84, 0x53, 0xe6,
+		0x27, 0x16, 0xa5, 0xe3, 0x29, 0xc5, 0x7d, 0x66, 0x79, 0x7d, 0xac, 0x39, 0xb8, 0x93, 0x4f, 0x8a,
+		0x3c, 0xf6, 0x7c, 0x31, 0x91, 0x4a, 0xc8, 0x13, 0x85, 0xc7, 0x61, 0x66, 0xa8, 0x2a, 0x28, 0x07,
+		0x99, 0x6a, 0xad, 0xb2, 0x52, 0x52, 0x4a, 0xad, 0xfa, 0x6a, 0x43, 0x3e, 0x80, 0xb2, 0xe0, 0xab,
+		0x9d, 0x2c, 0x9d, 0x48, 0xa7, 0xde, 0x98, 0x94, 0x5f, 0x7c, 0xf1, 0xc5, 0x17, 0x63, 0x85, 0x7f,
+		0x93, 0x84, 0xb9, 0x51, 0x83, 0xe0, 0xc8, 0xf1, 0xd8, 0xab, 0x74, 0x3c, 0x50, 0xe9, 0x12, 0x4c,
+		0x74, 0xb5, 0x0d, 0xdc, 0xcd, 0x27, 0x68, 0x23, 0x3c, 0x3c, 0xd6, 0x30, 0xbb, 0xb4, 0x42, 0x20,
+		0x0a, 0x43, 0xa2, 0x1f, 0xe3, 0xa6, 0x99, 0xa0, 0x0c, 0x27, 0xc6, 0x63, 0x20, 0x83, 0x23, 0x37,
+		0xe3, 0x51, 0x48, 0x93, 0xff, 0x99, 0xdd, 0x93, 0xcc, 0xee, 0x24, 0x81, 0xda, 0x7d, 0x1e, 0x52,
+		0x74, 0xdc, 0xeb, 0x60, 0xb7, 0x4d, 0xc4, 0x33, 0x19, 0x29, 0x3a, 0x78, 0x53, 0x1b, 0x74, 0x1d,
+		0xf5, 0x86, 0xd6, 0x1d, 0x60, 0x3a, 0x82, 0xa5, 0x95, 0x29, 0x9e, 0x78, 0x99, 0xa4, 0xa1, 0x05,
+		0xc8, 0xb0, 0x61, 0x52, 0x37, 0x3a, 0xf8, 0x16, 0x9d, 0x42, 0x27, 0x14, 0x36, 0x72, 0xd6, 0x49,
+		0x0a, 0x29, 0xfe, 0x79, 0xdb, 0x34, 0xc4, 0x58, 0x43, 0x8b, 0x20, 0x09, 0xb4, 0xf8, 0x27, 0xc3,
+		0xb3, 0xf7, 0xbd, 0xa3, 0xab, 0x37, 0x34, 0x38, 0x3e, 0x08, 0x39, 0x2a, 0xf1, 0x18, 0xef, 0xca,
+		0x5a, 0x37, 0x3f, 0x43, 0xdd, 0x20, 0xcb, 0x92, 0x57, 0x79, 0x6a, 0xe1, 0x4b, 0x31, 0x48, 0xd0,
+		0x99, 0x22, 0x07, 0x99, 0xd6, 0xb5, 0xb5, 0x9a, 0x5a, 0x5d, 0x5d, 0x2f, 0xaf, 0xd4, 0x64, 0x89,
+		0x34, 0x3d, 0x4d, 0x38, 0xbf, 0xb2, 0x5a, 0x6a, 0xc9, 0x31, 0xf7, 0xb9, 0xde, 0x68, 0x9d, 0x7d,
+		0x5c, 0x8e, 0xbb, 0x80, 0x75, 0x96, 0x90, 0xf0, 0x0b, 0x3c, 0x76, 0x46, 0x9e, 0x40, 0x32, 0x4c,
+		0x31, 0x82, 0xfa, 0xd5, 0x5a, 0xf5, 0xec, 0xe3, 0x72, 0x32, 0x98, 0xf2, 0xd8, 0x19, 0x79, 0x12,
+		0x4d, 0x43, 0x9a, 0xa6, 0x94, 0x57, 0x57, 0x57, 0xe4, 0x94, 0xcb, 0xd9, 0x6c, 0x29, 0xf5, 0xc6,
+		0xb2, 0x9c, 0x76, 0x39, 0x97, 0x95, 0xd5, 0xf5, 0x35, 0x19, 0x5c, 0x86, 0x4b, 0xb5, 0x66, 0xb3,
+		0xb4, 0x5c, 0x93, 0x33, 0xae, 0x44, 0xf9, 0x5a, 0xab, 0xd6, 0x94, 0xa7, 0x02, 0x6a, 0x3d, 0x76,
+		0x46, 0x9e, 0x76, 0x8b, 0xa8, 0x35, 0xd6, 0x2f, 0xc9, 0x59, 0x34, 0x03, 0xd3, 0xac, 0x08, 0xa1,
+		0x44, 0x2e, 0x94, 0x74, 0xf6, 0x71, 0x59, 0xf6, 0x14, 0x61, 0x2c, 0x33, 0x81, 0x84, 0xb3, 0x8f,
+		0xcb, 0xa8, 0x50, 0x81, 0x09, 0xea, 0x86, 0x08, 0x41, 0x76, 0xa5, 0x54, 0xae, 0xad, 0xa8, 0xab,
+		0x6b, 0xa4, 0xd3, 0x94, 0x56, 0x64, 0xc9, 0x4b, 0x53, 0x6a, 0x6b, 0xb5, 0x52, 0xab, 0x56, 0x95,
+		0xe3, 0xfe, 0xb4, 0x1f, 0x5f, 0xaf, 0x2b, 0xb5, 0xaa, 0x1c, 0x2b, 0xb4, 0x61, 0x6e, 0xd4, 0x0c,
+		0x39, 0xb2, 0x0b, 0xf9, 0x7c, 0x21, 0xb6, 0x8b, 0x2f, 0x50, 0xae, 0xb0, 0x2f, 0x14, 0xbe, 0x19,
+		0x83, 0xd9, 0x11, 0x51, 0xc2, 0xc8, 0x42, 0x9e, 0x81, 0x09, 0xe6, 0xcb, 0x6c, 0xa4, 0x7e, 0x68,
+		0x64, 0xb8, 0x41, 0x3d, 0x7b, 0x28, 0x76, 0xa2, 0x38, 0x7f, 0xbc, 0x19, 0xdf, 0x25, 0xde, 0x24,
+		0x14, 0x43, 0x0e, 0xfb, 0xee, 0xa1, 0xd9, 0x9c, 0x05, 0x3c, 0x67, 0xc7, 0x09, 0x78, 0x68, 0xda,
+		0xfe, 0x66, 0xf5, 0x89, 0x11, 0xb3, 0xfa, 0xd3, 0x30, 0x33, 0x44, 0x34, 0xf6, 0xec, 0xfa, 0x3e,
+		0x09, 0xf2, 0xbb, 0x19, 0x27, 0x62, 0x48, 0x8c, 0x05, 0x86, 0xc4, 0xa7, 0xc3, 0x16, 0xbc, 0x6f,
+		0xf7, 0x46, 0x18, 0x6a, 0xeb, 0x4f, 0x4b, 0x70, 0x68, 0xf4, 0xba, 0x62, 0xa4, 0x0e, 0x3f, 0x06,
+		0xc9, 0x1e, 0x76, 0xb6, 0x4d, 0x11, 0x27, 0x3f, 0x30, 0x22, 0xfa, 0x22, 0xd9, 0xe1, 0xc6, 0xe6,
+		0x28, 0x74, 0x2e, 0xac, 0xeb, 0xc2, 0x6e, 0xab, 0x9c, 0x21, 0x4d, 0x7f, 0x3e, 0x06, 0x07, 0x47,
+		0x92, 0x8f, 0x54, 0xf4, 0x5e, 0x00, 0xdd, 0xe8, 0x0f, 0x1c, 0x16, 0x0b, 0xb3, 0x91, 0x38, 0x4d,
+		0x53, 0xe8, 0xe0, 0x45, 0x46, 0xd9, 0x81, 0xe3, 0xe6, 0xb3, 0x59, 0x12, 0x58, 0x12, 0x15, 0x78,
+		0xca, 0x53, 0x34, 0x41, 0x15, 0x3d, 0xb6, 0x4b, 0x4d, 0x87, 0x1c, 0xf3, 0x51, 0x90, 0xdb, 0x5d,
+		0x1d, 0x1b, 0x8e, 0x6a, 0x3b, 0x16, 0xd6, 0x7a, 0xba, 0xb1, 0xc5, 0x66, 0xdb, 0xe2, 0xc4, 0xa6,
+		0xd6, 0xb5, 0xb1, 0x92, 0x63, 0xd9, 0x4d, 0x91, 0x4b, 0x10, 0xd4, 0x81, 0x2c, 0x1f, 0x22, 0x19,
+		0x40, 0xb0, 0x6c, 0x17, 0x51, 0xf8, 0x7c, 0x1a, 0x32, 0xbe, 0x55, 0x18, 0xba, 0x0f, 0xa6, 0x9e,
+		0xd7, 0x6e, 0x68, 0xaa, 0x58, 0x59, 0x33, 0x4b, 0x64, 0x48, 0xda, 0x1a, 0x4b, 0x42, 0x8f, 0xc2,
+		0x1c, 0x15, 0x31, 0x07, 0x0e, 0xb6, 0xd4, 0x76, 0x57, 0xb3, 0x6d, 0x6a, 0xb4, 0x14, 0x15, 0x45,
+		0x24, 0x6f, 0x95, 0x64, 0x55, 0x44, 0x0e, 0x7a, 0x02, 0x66, 0x29, 0xa2, 0x37, 0xe8, 0x3a, 0x7a,
+		0xbf, 0x8b, 0x55, 0xb2, 0xd6, 0xb7, 0xf3, 0xe0, 0xd7, 0x6c, 0x86, 0x48, 0x5c, 0xe2, 0x02, 0x44,
+		0x23, 0x1b, 0x55, 0xe1, 0x5e, 0x0a, 0xdb, 0xc2, 0x06, 0xb6, 0x34, 0x07, 0xab, 0xf8, 0x3d, 0x03,
+		0xad, 0x6b, 0xab, 0x9a, 0xd1, 0x51, 0xb7, 0x35, 0x7b, 0x3b, 0x3f, 0x47, 0x08, 0xca, 0xb1, 0xbc,
+		0xa4, 0x1c, 0x21, 0x82, 0xcb, 0x5c, 0xae, 0x46, 0xc5, 0x4a, 0x46, 0xe7, 0x82, 0x66, 0x6f, 0xa3,
+		0x22, 0x1c, 0xa2, 0x2c, 0xb6, 0x63, 0xe9, 0xc6, 0x96, 0xda, 0xde, 0xc6, 0xed, 0xeb, 0xea, 0xc0,
+		0xd9, 0x7c, 0x2a, 0x7f, 0xd4, 0x5f, 0x3e, 0xd5, 0xb0, 0x49, 0x65, 0x2a, 0x44, 0x64, 0xdd, 0xd9,
+		0x7c, 0x0a, 0x35, 0x61, 0x8a, 0x34, 0x46, 0x4f, 0x7f, 0x01, 0xab, 0x9b, 0xa6, 0x45, 0xe7, 0xd0,
+		0xec, 0x88, 0xa1, 0xc9, 0x67, 0xc1, 0xa5, 0x55, 0x0e, 0xb8, 0x64, 0x76, 0x70, 0x71, 0xa2, 0xb9,
+		0x56, 0xab, 0x55, 0x95, 0x8c, 0x60, 0x39, 0x6f, 0x5a, 0xc4, 0xa1, 0xb6, 0x4c, 0xd7, 0xc0, 0x19,
+		0xe6, 0x50, 0x5b, 0xa6, 0x30, 0xef, 0x13, 0x30, 0xdb, 0x6e, 0xb3, 0x3a, 0xeb, 0x6d, 0x95, 0xaf,
+		0xc8, 0xed, 0xbc, 0x1c, 0x30, 0x56, 0xbb, 0xbd, 0xcc, 0x04, 0xb8, 0x8f, 0xdb, 0xe8, 0x1c, 0x1c,
+		0xf4, 0x8c, 0xe5, 0x07, 0xce, 0x0c, 0xd5, 0x32, 0x0c, 0x7d, 0x02, 0x66, 0xfb, 0x3b, 0xc3, 0x40,
+		0x14, 0x28, 0xb1, 0xbf, 0x13, 0x86, 0xbd, 0x9d, 0xee, 0xb2, 0x58, 0xb8, 0x4d, 0x43, 0xbd, 0xc3,
+		0x7e, 0x69, 0x5f, 0x06, 0x5a, 0x02, 0xb9, 0xdd, 0x56, 0xb1, 0xa1, 0x6d, 0x74, 0xb1, 0xaa, 0x59,
+		0xd8, 0xd0, 0xec, 0xfc, 0x02, 0x15, 0x4e, 0x38, 0xd6, 0x00, 0x2b, 0xd9, 0x76, 0xbb, 0x46, 0x33,
+		0x4b, 0x34, 0x0f, 0x9d, 0x80, 0x19, 0x73, 0xe3, 0xf9, 0x36, 0x73, 0x2c, 0xb5, 0x6f, 0xe1, 0x4d,
+		0xfd, 0x56, 0xfe, 0x6d, 0xd4, 0x4a, 0x39, 0x92, 0x41, 0xdd, 0x6a, 0x8d, 0x26, 0xa3, 0x87, 0x40,
+		0x6e, 0xdb, 0xdb, 0x9a, 0xd5, 0xa7, 0x23, 0xab, 0xdd, 0xd7, 0xda, 0x38, 0xff, 0x76, 0x26, 0xca,
+		0xd2, 0x1b, 0x22, 0x99, 0x38, 0xb6, 0x7d, 0x53, 0xdf, 0x74, 0x04, 0xe3, 0x83, 0xcc, 0xb1, 0x69,
+		0x1a, 0x67, 0x3b, 0x0e, 0x72, 0x7f, 0xbb, 0x1f, 0x2c, 0xf8, 0x38, 0x15, 0xcb, 0xf6, 0xb7, 0xfb,
+		0xfe, 0x72, 0xef, 0x87, 0xe9, 0xfe, 0xb6, 0xbf, 0xd0, 0x87, 0x58, 0xfc, 0xd5, 0xdf, 0xf6, 0x95,
+		0xf8, 0x38, 0x1c, 0x22, 0x42, 0x3d, 0xec, 0x68, 0x1d, 0xcd, 0xd1, 0x7c, 0xd2, 0x8f, 0x50, 0xe9,
+		0xb9, 0xfe, 0x76, 0xff, 0x12, 0xcf, 0x0c, 0xe8, 0x69, 0x0d, 0x36, 0x76, 0x5c, 0xff, 0x38, 0xc9,
+		0xf4, 0x24, 0x69, 0xc2, 0x43, 0xee, 0x7a, 0xf9, 0xf1, 0x43, 0x5b, 0x6c, 0x15, 0x8a, 0x30, 0xe5,
+		0xf7, 0x7b, 0x94, 0x06, 0xe6, 0xf9, 0xb2, 0x44, 0x82, 0xa0, 0xca, 0x6a, 0x95, 0x84, 0x2f, 0xcf,
+		0xd5, 0xe4, 0x18, 0x09, 0xa3, 0x56, 0xea, 0xad, 0x9a, 0xaa, 0xac, 0x37, 0x5a, 0xf5, 0x4b, 0x35,
+		0x39, 0xee, 0x0b, 0xec, 0x2f, 0x26, 0x52, 0x27, 0xe4, 0x87, 0x2f, 0x26, 0x52, 0x0f, 0xc8, 0x0f,
+		0x52, 0xf3, 0x0c, 0x39, 0x65, 0xe1, 0xcd, 0x38, 0x64, 0x83, 0xcb, 0x72, 0xf4, 0x0e, 0x38, 0x2c,
+		0xf6, 0xdd, 0x6c, 0xec, 0xa8, 0x37, 0x75, 0x8b, 0x76, 0xd6, 0x9e, 0xc6, 0x26, 0x4e, 0xd7, 0x29,
+		0xe7, 0xb8, 0x54, 0x13, 0x3b, 0x57, 0x74, 0x8b, 0x74, 0xc5, 0x9e, 0xe6, 0xa0, 0x15, 0x58, 0x30,
+		0x4c, 0xd5, 0x76, 0x34, 0xa3, 0xa3, 0x59, 0x1d, 0xd5, 0xdb, 0xf1, 0x54, 0xb5, 0x76, 0x1b, 0xdb,
+		0xb6, 0xc9, 0x26, 0x49, 0x97, 0xe5, 0x1e, 0xc3, 0x6c, 0x72, 0x61, 0x6f, 0xf6, 0x28, 0x71, 0xd1,
+		0x50, 0x9f, 0x88, 0xef, 0xd6, 0x27, 0x8e, 0x42, 0xba, 0xa7, 0xf5, 0x55, 0x6c, 0x38, 0xd6, 0x0e,
+		0x8d, 0xdd, 0x53, 0x4a, 0xaa, 0xa7, 0xf5, 0x6b, 0xe4, 0x19, 0x5d, 0x86, 0x07, 0x3c, 0x51, 0xb5,
+		0x8b, 0xb7, 0xb4, 0xf6, 0x8e, 0x4a, 0x03, 0x75, 0xba, 0x47, 0xa4, 0xb6, 0x4d, 0x63, 0xb3, 0xab,
+		0xb7, 0x1d, 0x3b, 0x9f, 0x71, 0xc7, 0xbf, 0x82, 0x87, 0x58, 0xa1, 0x80, 0x8b, 0xb6, 0x69, 0xd0,
+		0xf8, 0xbc, 0x22, 0xa4, 0x03, 0x6e, 0x33, 0xf5, 0x96, 0x70, 0x9b, 0x60, 0xd3, 0x27, 0xe4, 0x89,
+		0x8b, 0x89, 0xd4, 0x84, 0x9c, 0xbc, 0x98, 0x48, 0x25, 0xe5, 0xc9, 0x8b, 0x89, 0x54, 0x4a, 0x4e,
+		0x5f, 0x4c, 0xa4, 0xd2, 0x32, 0x14, 0x5e, 0x9d, 0x86, 0x29, 0xff, 0x72, 0x83, 0xac, 0xde, 0xda,
+		0x74, 0xc2, 0x95, 0xe8, 0x90, 0x7c, 0xff, 0x9e, 0x8b, 0x93, 0xa5, 0x0a, 0x99, 0x89, 0x8b, 0x49,
+		0x16, 0xdb, 0x2b, 0x0c, 0x49, 0xa2, 0x20, 0xd2, 0xc9, 0x30, 0x8b, 0xa5, 0x52, 0x0a, 0x7f, 0x42,
+		0xcb, 0x90, 0x7c, 0xde, 0xa6, 0xdc, 0x49, 0xca, 0xfd, 0xb6, 0xbd, 0xb9, 0x2f, 0x36, 0x29, 0x79,
+		0xfa, 0x62, 0x53, 0x6d, 0xac, 0x2a, 0x97, 0x4a, 0x2b, 0x0a, 0x87, 0xa3, 0x23, 0x90, 0xe8, 0x6a,
+		0x2f, 0xec, 0x04, 0xe7, 0x6c, 0x9a, 0x84, 0x96, 0x20, 0x37, 0x30, 0xd8, 0x5a, 0x9d, 0xb4, 0x31,
+		0x91, 0xca, 0xf9, 0xa5, 0xb2, 0x5e, 0xee, 0x0a, 0x91, 0x1f, 0xd3, 0xaf, 0x8e, 0x40, 0x82, 0x6c,
+		0x4a, 0x07, 0x67, 0x56, 0x9a, 0x84, 0x8e, 0xc3, 0x54, 0x07, 0x6f, 0x0c, 0xb6, 0x54, 0x0b, 0x77,
+		0xb4, 0xb6, 0x13, 0x9c, 0x4f, 0x32, 0x34, 0x4b, 0xa1, 0x39, 0xe8, 0x59, 0x48, 0x93, 0x36, 0x32,
+		0x68, 0x1b, 0xcf, 0x50, 0x13, 0x9c, 0xdc, 0xdb, 0x04, 0xbc, 0x89, 0x05, 0x48, 0xf1, 0xf0, 0xe8,
+		0x02, 0x4c, 0x3a, 0x9a, 0xb5, 0x85, 0x1d, 0x3b, 0x3f, 0xbb, 0x18, 0x3f, 0x9e, 0x3d, 0xb3, 0x34,
+		0x0e, 0x55, 0x8b, 0x42, 0xe8, 0x4a, 0x59, 0xc0, 0xd1, 0x15, 0x90, 0xf9, 0x56, 0xac, 0xca, 0x97,
+		0xb9, 0x76, 0x7e, 0x8e, 0x3a, 0xe0, 0x23, 0x7b, 0x53, 0xf2, 0x9d, 0xdc, 0x2a, 0x03, 0x29, 0x39,
+		0x1c, 0x78, 0x0e, 0xf6, 0x8b, 0x83, 0xfb, 0xe9, 0x17, 0xeb, 0x90, 0xe3, 0xbf, 0x55, 0x7b, 0xd0,
+		0xef, 0x9b, 0x96, 0x93, 0x3f, 0xb4, 0x28, 0x45, 0x2b, 0x24, 0xc8, 0x18, 0x46, 0xc9, 0x6e, 0x06,
+		0x9e, 0x7f, 0x78, 0xdd, 0x6d, 0xfe, 0x39, 0xc8, 0x06, 0x8d, 0xe1, 0xdf, 0x08, 0x8f, 0x8f, 0xb9,
+		0x11, 0x4e, 0x96, 0x25, 0x62, 0xa1, 0x46, 0xa6, 0x26, 0xf6, 0x30, 0xff, 0xf7, 0x62, 0x90, 0x0d,
+		0x56, 0x0c, 0x2d, 0x03, 0x12, 0x2d, 0xa6, 0x1b, 0x8e, 0x65, 0x76, 0x06, 0x6d, 0xdc, 0xc9, 0x4b,
+		0x11, 0xe5, 0xcc, 0x70, 0x4c, 0xdd, 0x85, 0xf8, 0x89, 0x7c, 0xbd, 0x20, 0x36, 0x26, 0x51, 0xd5,
+		0xeb, 0x1f, 0xa7, 0x60, 0x56, 0x10, 0x10, 0xb2, 0x9b, 0x9a, 0x65, 0x90, 0x10, 0x99, 0x05, 0xed,
+		0xc8, 0x97, 0x75, 0x85, 0xe5, 0xa0, 0x12, 0x08, 0x77, 0x51, 0x2d, 0xdc, 0x33, 0xc9, 0x7e, 0x57,
+		0x22, 0xa2, 0xd8, 0x2c, 0x07, 0x28, 0x4c, 0xbe, 0x70, 0x0a, 0x26, 0xe8, 0xf0, 0x83, 0x00, 0xf8,
+		0x00, 0x24, 0x1f, 0x40, 0x29, 0x48, 0x54, 0x56, 0x15, 0x32, 0x3d, 0xca, 0x30, 0xc5, 0x52, 0xd5,
+		0xb5, 0x7a, 0xad, 0x52, 0x93, 0x63, 0x85, 0x27, 0x20, 0xc9, 0xc6, 0x14, 0x32, 0x75, 0xba, 0xa3,
+		0x8a, 0x7c, 0x80, 0x3f, 0x72, 0x0e, 0x49, 0xe4, 0xae, 0x5f, 0x2a, 0xd7, 0x14, 0x39, 0x56, 0x58,
+		0x87, 0x5c, 0xa8, 0x1f, 0xa2, 0x83, 0x30, 0xa3, 0xd4, 0x5a, 0xb5, 0x06, 0xd9, 0x1c, 0x50, 0xd7,
+		0x1b, 0xcf, 0x36, 0x56, 0xaf, 0x90, 0x9d, 0xb5, 0x40, 0xb2, 0x98, 0x87, 0x25, 0x34, 0x07, 0xb2,
+		0x97, 0xdc, 0x5c, 0x5d, 0x57, 0xa8, 0x36, 0xbf, 0x10, 0x03, 0x39, 0xdc, 0x29, 0xd1, 0x61, 0x98,
+		0x6d, 0x95, 0x94, 0xe5, 0x5a, 0x4b, 0x65, 0x1b, 0x1e, 0x2e, 0xf5, 0x1c, 0xc8, 0xfe, 0x8c, 0xf3,
+		0x75, 0xba, 0x9f, 0xb3, 0x00, 0x47, 0xfd, 0xa9, 0xb5, 0xab, 0xad, 0x5a, 0xa3, 0x49, 0x0b, 0x2f,
+		0x35, 0x96, 0x49, 0x50, 0x10, 0xe2, 0x13, 0x5b, 0x2c, 0x71, 0xa2, 0x6a, 0x90, 0xaf, 0xb6, 0x52,
+		0x95, 0x13, 0xe1, 0xe4, 0xd5, 0x46, 0x6d, 0xf5, 0xbc, 0x3c, 0x11, 0x2e, 0x9d, 0x6e, 0xbb, 0x24,
+		0xd1, 0x3c, 0x1c, 0x0a, 0xa7, 0xaa, 0xb5, 0x46, 0x4b, 0xb9, 0x26, 0x4f, 0x86, 0x0b, 0x6e, 0xd6,
+		0x94, 0xcb, 0xf5, 0x4a, 0x4d, 0x4e, 0xa1, 0x43, 0x80, 0x82, 0x1a, 0xb5, 0x2e, 0xac, 0x56, 0xe5,
+		0xf4, 0xa8, 0x19, 0x0b, 0xc9, 0xb3, 0x85, 0xcf, 0x49, 0x30, 0xe5, 0xdf, 0x02, 0x09, 0x0c, 0x2a,
+		0xd2, 0x5b, 0x6d, 0xb2, 0x2d, 0xfc, 0x51, 0x0c, 0x32, 0xbe, 0xbd, 0x10, 0xb2, 0x88, 0xd5, 0xba,
+		0x5d, 0xf3, 0xa6, 0xaa, 0x75, 0x75, 0xcd, 0xe6, 0xf3, 0x21, 0xd0, 0xa4, 0x12, 0x49, 0x19, 0x77,
+		0xfe, 0x19, 0x3f, 0x74, 0x49, 0xde, 0x75, 0xe8, 0x32, 0xf9, 0x16, 0x0c, 0x5d, 0x26, 0xe4, 0x64,
+		0xe1, 0xeb, 0x31, 0x90, 0xc3, 0xbb, 0x23, 0x21, 0xbb, 0x49, 0xbb, 0xd9, 0xcd, 0x5f, 0xbf, 0xd8,
+		0x7e, 0xea, 0x17, 0x9e, 0xd5, 0xe3, 0xbb, 0xce, 0xea, 0x23, 0x26, 0xab, 0xc4, 0x5b, 0x79, 0xb2,
+		0xf2, 0xbb, 0xeb, 0x7f, 0x92, 0x20, 0x1b, 0xdc, 0xcc, 0x09, 0x58, 0xac, 0xb0, 0x1f, 0x8b, 0x05,
+		0x5b, 0xe4, 0xbe, 0xdd, 0x5a, 0xe4, 0x47, 0x52, 0xaf, 0x8f, 0xc5, 0x61, 0x3a, 0xb0, 0xf7, 0x33,
+		0xae, 0x76, 0xef, 0x81, 0x19, 0xbd, 0x83, 0x7b, 0x7d, 0xd3, 0x21, 0x37, 0x0f, 0xd4, 0x2e, 0xbe,
+		0x81, 0xbb, 0xd4, 0x0c, 0xd9, 0x11, 0xa7, 0xab, 0x81, 0x12, 0x96, 0xea, 0x1e, 0x6e, 0x85, 0xc0,
+		0x8a, 0xb3, 0xf5, 0x6a, 0xed, 0xd2, 0xda, 0x6a, 0xab, 0xd6, 0xa8, 0x5c, 0x13, 0x23, 0xb9, 0x22,
+		0xeb, 0x21, 0xb1, 0x80, 0xc1, 0xef, 0x7f, 0x6b, 0x2c, 0x3a, 0xd7, 0x40, 0x0e, 0xd7, 0x86, 0x0c,
+		0xe8, 0x23, 0xea, 0x23, 0x1f, 0x40, 0xb3, 0x90, 0x6b, 0xac, 0xaa, 0xcd, 0x7a, 0xb5, 0xa6, 0xd6,
+		0xce, 0x9f, 0xaf, 0x55, 0x5a, 0x4d, 0x76, 0xd0, 0xe0, 0x4a, 0xb7, 0xe4, 0x98, 0xbf, 0x6d, 0x3e,
+		0x1e, 0x87, 0xd9, 0x11, 0x9a, 0xa0, 0x12, 0xdf, 0x22, 0x64, 0xbb, 0x96, 0x27, 0xc7, 0xd1, 0x7e,
+		0x89, 0xac, 0xee, 0xd7, 0x34, 0xcb, 0xe1, 0x3b, 0x8a, 0x0f, 0x01, 0x31, 0xaf, 0xe1, 0x90, 0xf0,
+		0xde, 0xe2, 0x07, 0x38, 0x2c, 0x04, 0xc9, 0x79, 0xe9, 0xec, 0x0c, 0xe7, 0x11, 0x40, 0x7d, 0xd3,
+		0xd6, 0x1d, 0xfd, 0x06, 0xb9, 0x08, 0x21, 0x4e, 0x7b, 0x48, 0xc7, 0x4d, 0x28, 0xb2, 0xc8, 0xa9,
+		0x1b, 0x8e, 0x2b, 0x6d, 0xe0, 0x2d, 0x2d, 0x24, 0x4d, 0x96, 0x1f, 0x71, 0x45, 0x16, 0x39, 0xae,
+		0xf4, 0x7d, 0x30, 0xd5, 0x31, 0x07, 0x64, 0x57, 0x86, 0xc9, 0x91, 0x21, 0x59, 0x52, 0x32, 0x2c,
+		0xcd, 0x15, 0xe1, 0xdb, 0x66, 0xde, 0x31, 0xd3, 0x94, 0x92, 0x61, 0x69, 0x4c, 0xe4, 0x41, 0xc8,
+		0x69, 0x5b, 0x5b, 0x16, 0x21, 0x17, 0x44, 0x6c, 0x23, 0x30, 0xeb, 0x26, 0x53, 0xc1, 0xf9, 0x8b,
+		0x90, 0x12, 0x76, 0x20, 0xeb, 0x5f, 0x62, 0x09, 0xb5, 0xcf, 0x76, 0xb7, 0x63, 0xe4, 0xe4, 0xc9,
+		0x10, 0x99, 0xf7, 0xc1, 0x94, 0x6e, 0xab, 0xde, 0x35, 0x88, 0xd8, 0x62, 0xec, 0x78, 0x4a, 0xc9,
+		0xe8, 0xb6, 0x7b, 0x2a, 0x5a, 0xf8, 0x7c, 0x06, 0xc0, 0x73, 0x36, 0xf4, 0x11, 0x09, 0xb2, 0x6c,
+		0x82, 0xe9, 0x5b, 0xd8, 0xc6, 0x46, 0x5b, 0x2c, 0x0b, 0x1f, 0xda, 0xc3, 0x45, 0xd9, 0x30, 0xb7,
+		0xc6, 0x01, 0xe5, 0x67, 0x5e, 0x92, 0xa4, 0x57, 0xa4, 0xc4, 0x2b, 0x92, 0xf4, 0x29, 0x69, 0x1a,
+		0xa5, 0x6a, 0x57, 0xd7, 0x56, 0xea, 0x95, 0x7a, 0x2b, 0xff, 0xed, 0x49, 0xfa, 0x5c, 0xbf, 0xc4,
+		0x9f, 0x5f, 0x9f, 0x0c, 0xe6, 0xbf, 0x31, 0xf9, 0x5b, 0x52, 0x3c, 0xf5, 0xc6, 0xa4, 0x32, 0xbd,
+		0xe9, 0xe7, 0x43, 0x5d, 0xff, 0x0d, 0x8a, 0xd8, 0x6e, 0x0b, 0x49, 0x4f, 0x9b, 0x1a, 0xbf, 0x37,
+		0x51, 0x7e, 0x88, 0x2a, 0x92, 0xa4, 0x8a, 0x64, 0x50, 0xb2, 0xb2, 0xb2, 0xda, 0xac, 0x55, 0xa9,
+		0x1a, 0x69, 0x94, 0x58, 0x5d, 0xab, 0x35, 0xf2, 0xaf, 0x8b, 0x22, 0xbd, 0xcb, 0x16, 0xaf, 0x48,
+		0x70, 0x58, 0x9c, 0xb2, 0xf2, 0xb9, 0x16, 0x1b, 0x6d, 0xb3, 0x23, 0xa2, 0xdb, 0xec, 0x99, 0xd3,
+		0x7b, 0x15, 0xae, 0x70, 0x28, 0x35, 0x49, 0x8d, 0x03, 0xcb, 0x27, 0x87, 0x4c, 0x52, 0x6a, 0x54,
+		0xb9, 0x2e, 0x19, 0x94, 0x5c, 0x2b, 0x55, 0x9e, 0xad, 0x55, 0x3d, 0x6d, 0x0e, 0x5a, 0xa3, 0x58,
+		0xd0, 0x7b, 0x21, 0x47, 0x76, 0x5b, 0x89, 0x6f, 0xe8, 0x1d, 0x76, 0xec, 0x9d, 0xd8, 0xed, 0xbc,
+		0xd4, 0xd3, 0x88, 0x6c, 0xbf, 0x5e, 0x76, 0x11, 0xe5, 0x87, 0x7c, 0xaa, 0xa4, 0x51, 0xa2, 0xb1,
+		0xda, 0xa8, 0x09, 0x35, 0xe8, 0x11, 0xf1, 0x35, 0x4f, 0x8d, 0xec, 0x20, 0x00, 0x45, 0xef, 0x05,
+		0x59, 0x6c, 0x0f, 0xb9, 0x26, 0x99, 0xd8, 0xed, 0xc8, 0xd7, 0x53, 0x80, 0x6f, 0x32, 0xb9, 0xc6,
+		0x78, 0xc0, 0xa7, 0xc1, 0x1c, 0xca, 0xad, 0xd4, 0x1a, 0xcb, 0xad, 0x0b, 0xea, 0x9a, 0x52, 0xa3,
+		0x27, 0x77, 0xf9, 0x6f, 0x8b, 0xe2, 0x73, 0xbd, 0x20, 0x10, 0xfd, 0xb4, 0x04, 0x19, 0x16, 0x02,
+		0xb1, 0x3d, 0x29, 0xb6, 0xa9, 0xf0, 0xc0, 0x5e, 0x65, 0xd3, 0x08, 0x88, 0x4a, 0x97, 0xcf, 0xd1,
+		0x62, 0xe3, 0xc2, 0x21, 0x0e, 0x23, 0xb4, 0x52, 0x5b, 0x2e, 0x55, 0xae, 0xa9, 0xe5, 0x5a, 0xb3,
+		0x45, 0x46, 0xb2, 0x55, 0x85, 0xf9, 0x28, 0xa0, 0x89, 0xd2, 0xca, 0xca, 0xea, 0x15, 0xcf, 0x10,
+		0xf0, 0xbc, 0x4b, 0x53, 0xf8, 0x5b, 0x30, 0x1d, 0x70, 0x77, 0x12, 0x14, 0xd3, 0x60, 0x9a, 0xd4,
+		0xa0, 0x59, 0x6b, 0x54, 0xfc, 0x41, 0xfc, 0x14, 0xb8, 0xee, 0x2d, 0x4b, 0xe4, 0x49, 0x38, 0xbf,
+		0x1c, 0x23, 0xc3, 0x28, 0x57, 0xc0, 0x3d, 0x4b, 0x8c, 0x17, 0x9e, 0x84, 0x94, 0x70, 0x5f, 0x12,
+		0x9a, 0xd3, 0x08, 0x3b, 0xb4, 0x30, 0x48, 0x01, 0xf5, 0x5d, 0x59, 0x22, 0xcb, 0x20, 0xe6, 0xd3,
+		0x72, 0xac, 0x70, 0x19, 0x0e, 0x8e, 0x74, 0x3d, 0x74, 0x3f, 0x2c, 0x88, 0xf3, 0x4b, 0x16, 0xf4,
+		0xab, 0xb5, 0x46, 0x65, 0xb5, 0x4a, 0x96, 0x49, 0x1e, 0x27, 0x00, 0xf7, 0x41, 0xa6, 0xa5, 0xf0,
+		0x4f, 0x39, 0x56, 0xa8, 0x43, 0x36, 0xe8, 0x40, 0xe8, 0x28, 0x1c, 0x5e, 0x6f, 0x9d, 0x7f, 0x4a,
+		0xbd, 0x5c, 0x5a, 0xa9, 0x57, 0x4b, 0xa1, 0x05, 0x11, 0x00, 0xf7, 0x22, 0x39, 0x46, 0x14, 0x25,
+		0xde, 0x25, 0xc7, 0x0b, 0x89, 0x94, 0x24, 0x4b, 0x85, 0x26, 0xe4, 0x42, 0xae, 0x80, 0xee, 0x81,
+		0x3c, 0x5f, 0xa1, 0x8c, 0xd2, 0x6a, 0x16, 0xc2, 0xce, 0xc1, 0xd6, 0x6a, 0xd5, 0xda, 0x4a, 0xfd,
+		0x52, 0xbd, 0x45, 0xf5, 0xbb, 0x00, 0xe0, 0xb5, 0x31, 0x99, 0xb3, 0x2e, 0x36, 0x57, 0x1b, 0xea,
+		0x79, 0xb2, 0xd0, 0x6b, 0xf9, 0xa8, 0xd2, 0xc0, 0xda, 0x54, 0x96, 0xc8, 0x7a, 0x64, 0xb8, 0xe1,
+		0xe5, 0xd8, 0x89, 0x0f, 0x4a, 0x64, 0xca, 0xfa, 0x60, 0x63, 0xfe, 0xa7, 0x25, 0x74, 0x6f, 0xea,
+		0x8d, 0x49, 0x34, 0xb9, 0xd4, 0xdf, 0x58, 0x6a, 0xf7, 0xfb, 0xf3, 0x39, 0xf2, 0xa3, 0xd2, 0xef,
+		0x9f, 0x17, 0x13, 0xf1, 0x42, 0xea, 0x3b, 0x93, 0x28, 0x45, 0x52, 0xc9, 0x21, 0xc0, 0xbc, 0x4c,
+		0x7e, 0x5d, 0xd4, 0x6e, 0x68, 0xae, 0xc0, 0xd1, 0xd4, 0x77, 0x27, 0x51, 0x92, 0x24, 0x6f, 0x99,
+		0xf3, 0x59, 0xf2, 0xff, 0xb2, 0xe9, 0x66, 0xde, 0x9f, 0xfa, 0xd9, 0x06, 0x02, 0x92, 0x48, 0x3d,
+		0xf6, 0xf4, 0x3c, 0x22, 0xbf, 0xe9, 0xb1, 0xd9, 0x69, 0x21, 0x74, 0x22, 0x99, 0xfa, 0x60, 0x43,
+		0x7e, 0xb9, 0x71, 0x22, 0x99, 0x7a, 0xb9, 0x21, 0x7f, 0xa4, 0x71, 0x31, 0x99, 0x7a, 0x7d, 0x52,
+		0x7e, 0x63, 0xb2, 0xf0, 0xe7, 0x71, 0x40, 0x9e, 0x7f, 0xbb, 0x3b, 0x2f, 0x57, 0x21, 0xe5, 0x6e,
+		0xe5, 0xb0, 0xbb, 0xa2, 0xef, 0xd8, 0xa3, 0x5b, 0x08, 0x98, 0x2f, 0x29, 0xb4, 0xb5, 0xe3, 0xb2,
+		0x91, 0x75, 0x7b, 0x4f, 0x37, 0xf4, 0xde, 0xa0, 0xa7, 0x8a, 0xfd, 0x8d, 0xc8, 0x75, 0x3b, 0x07,
+		0xf0, 0x67, 0x4a, 0xa1, 0xdd, 0x0a, 0x50, 0x4c, 0x44, 0x52, 0x30, 0x00, 0x7f, 0x9e, 0xff, 0x2b,
+		0x09, 0xf2, 0xbb, 0x29, 0x7b, 0x57, 0x5b, 0x2f, 0x0d, 0x98, 0x33, 0x6f, 0x60, 0xcb, 0xd2, 0x3b,
+		0xf4, 0x34, 0xc5, 0x0d, 0xc8, 0x12, 0xd1, 0x01, 0xd9, 0xac, 0x0f, 0xe8, 0x36, 0x6a, 0x99, 0xcc,
+		0x9b, 0xb7, 0xc8, 0x94, 0x21, 0x98, 0x26, 0xa2, 0x99, 0xa6, 0x29, 0x44, 0x70, 0x5c, 0x24, 0xdd,
+		0x84, 0xac, 0x81, 0x62, 0x72, 0xdc, 0x8b, 0xfa, 0x0a, 0x9f, 0x8e, 0x41, 0x36, 0x78, 0x39, 0x13,
+		0x55, 0x21, 0xd5, 0x35, 0xf9, 0xc5, 0x27, 0xd6, 0xda, 0xc7, 0x23, 0xee, 0x73, 0x2e, 0xad, 0x70,
+		0x79, 0xc5, 0x45, 0xce, 0xff, 0x47, 0x09, 0x52, 0x22, 0x19, 0x1d, 0x82, 0x44, 0x5f, 0x73, 0xb6,
+		0x29, 0xdd, 0x44, 0x39, 0x26, 0x4b, 0x0a, 0x7d, 0x26, 0xe9, 0x76, 0x5f, 0x63, 0x97, 0xbe, 0x78,
+		0x3a, 0x79, 0x26, 0x91, 0x57, 0x17, 0x6b, 0x1d, 0x7a, 0x0e, 0x68, 0xf6, 0x7a, 0xd8, 0x70, 0x6c,
+		0x11, 0x79, 0xf1, 0xf4, 0x0a, 0x4f, 0x26, 0x77, 0x84, 0x1d, 0x4b, 0xd3, 0xbb, 0x01, 0xd9, 0x04,
+		0x95, 0x95, 0x45, 0x86, 0x2b, 0x5c, 0x84, 0x23, 0x82, 0xb7, 0x83, 0x1d, 0xad, 0xbd, 0x8d, 0x3b,
+		0x1e, 0x28, 0x49, 0xcf, 0xfb, 0x0f, 0x73, 0x81, 0x2a, 0xcf, 0x17, 0xd8, 0xc2, 0x57, 0x63, 0x30,
+		0x23, 0x4e, 0x2e, 0x3b, 0xae, 0xb1, 0x2e, 0x01, 0x68, 0x86, 0x61, 0x3a, 0x7e, 0x73, 0x0d, 0x07,
+		0x9b, 0x43, 0xb8, 0xa5, 0x92, 0x0b, 0x52, 0x7c, 0x04, 0xf3, 0x7f, 0x2a, 0x01, 0x78, 0x59, 0xbb,
+		0xda, 0x6d, 0x01, 0x32, 0xfc, 0xea, 0x2d, 0xbd, 0xbf, 0xcd, 0x36, 0xf8, 0x80, 0x25, 0x91, 0x33,
+		0x4e, 0xb2, 0xf7, 0xb7, 0x81, 0xb7, 0x74, 0x83, 0xdf, 0xa5, 0x62, 0x0f, 0xe2, 0x4a, 0x42, 0xc2,
+		0xbb, 0x6b, 0xa8, 0x40, 0xca, 0xc6, 0x3d, 0xcd, 0x70, 0xf4, 0x36, 0xef, 0x35, 0x67, 0xf7, 0xa5,
+		0xfc, 0x52, 0x93, 0xa3, 0x15, 0x97, 0xa7, 0x70, 0x1c, 0x52, 0x22, 0xd5, 0x1d, 0xa5, 0x0f, 0xa0,
+		0x49, 0x88, 0x37, 0x6b, 0x64, 0x9e, 0xa2, 0x83, 0x65, 0xbd, 0xd4, 0x94, 0x63, 0x27, 0x3e, 0x1d,
+		0x83, 0x49, 0xd1, 0x8d, 0x67, 0x21, 0x57, 0xab, 0xd6, 0x43, 0x03, 0xfe, 0x2c, 0x64, 0x45, 0x22,
+		0x1b, 0x55, 0xe5, 0xf7, 0x4f, 0xfa, 0x13, 0xd7, 0x94, 0xd5, 0xd6, 0xea, 0x19, 0xf9, 0xdb, 0xc3,
+		0x89, 0x8f, 0xc9, 0xaf, 0x4f, 0xa2, 0x19, 0x98, 0x12, 0x89, 0x67, 0x1e, 0x3d, 0xf3, 0x98, 0xfc,
+		0x46, 0x38, 0xe9, 0x71, 0xf9, 0x3b, 0x74, 0x6f, 0x49, 0x24, 0x9d, 0x56, 0x5b, 0x64, 0xd4, 0x5e,
+		0x6d, 0xac, 0x5c, 0x93, 0x25, 0x7f, 0xc6, 0x19, 0x5f, 0x46, 0x0c, 0xdd, 0x0b, 0x87, 0x45, 0xc6,
+		0xb9, 0x73, 0xe7, 0xce, 0x3d, 0xe9, 0xcb, 0x7c, 0xf5, 0x43, 0xc9, 0x70, 0xf6, 0x53, 0xbe, 0xec,
+		0x4f, 0x0e, 0x67, 0x9f, 0xf3, 0x65, 0xff, 0xca, 0x87, 0x92, 0x68, 0x16, 0x32, 0x22, 0xfb, 0x52,
+		0xe9, 0xaa, 0xfc, 0x83, 0x1f, 0xfc, 0xe0, 0x07, 0x93, 0xe5, 0xf7, 0xc2, 0x6c, 0xdb, 0xec, 0x85,
+		0x9b, 0xa6, 0x2c, 0x87, 0x2e, 0x46, 0xd8, 0x17, 0xa4, 0xe7, 0x4e, 0x72, 0xa1, 0x2d, 0xb3, 0xab,
+		0x19, 0x5b, 0x4b, 0xa6, 0xb5, 0xe5, 0xbd, 0x27, 0x40, 0x82, 0x5c, 0xdb, 0xf7, 0xb6, 0x40, 0x7f,
+		0xe3, 0xaf, 0x24, 0xe9, 0x53, 0xb1, 0xf8, 0xf2, 0x5a, 0xf9, 0xb3, 0xb1, 0xf9, 0x65, 0x06, 0x5c,
+		0x13, 0x0d, 0xaf, 0xe0, 0xcd, 0x2e, 0x6e, 0x93, 0xd6, 0x81, 0x4f, 0x24, 0x60, 0x86, 0x5c, 0x44,
+		0x30, 0x4f, 0xd1, 0x7f, 0x99, 0x06, 0x68, 0x82, 0x3e, 0xcc, 0x47, 0xbe, 0x8e, 0x50, 0x3c, 0xcb,
+		0x16, 0x63, 0x28, 0xea, 0xea, 0x6e, 0xfe, 0x2f, 0x7f, 0xe1, 0xd7, 0x27, 0xbc, 0x3b, 0x1d, 0xc5,
+		0x4b, 0xc3, 0x31, 0x61, 0x34, 0xc7, 0x1d, 0xc1, 0x11, 0x0e, 0xf1, 0x8a, 0xef, 0x80, 0x94, 0x4b,
+		0xb3, 0xf7, 0x35, 0xb9, 0xfc, 0xff, 0x10, 0x24, 0x2e, 0xa2, 0xf8, 0x0c, 0x00, 0x8b, 0xd8, 0xd9,
+		0x75, 0x93, 0xbd, 0xf1, 0x6f, 0x0a, 0x7c, 0x9a, 0x62, 0xc8, 0x8a, 0xaa, 0xb8, 0x0c, 0xd9, 0x8e,
+		0x69, 0x38, 0xaa, 0xd9, 0xd3, 0x1d, 0xdc, 0xeb, 0x3b, 0x3b, 0x51, 0x24, 0xff, 0x93, 0x91, 0xa4,
+		0x94, 0x69, 0x82, 0x5b, 0x15, 0x30, 0xa2, 0x09, 0xbb, 0x31, 0x38, 0x8e, 0x26, 0xff, 0xcb, 0xd5,
+		0x84, 0x62, 0x88, 0x26, 0xe5, 0xda, 0x57, 0xbe, 0x71, 0x4c, 0xfa, 0xea, 0x37, 0x8e, 0x49, 0xff,
+		0xf5, 0x1b, 0xc7, 0xa4, 0x97, 0xbf, 0x79, 0xec, 0xc0, 0x57, 0xbf, 0x79, 0xec, 0xc0, 0xd7, 0xbf,
+		0x79, 0xec, 0xc0, 0x73, 0x0f, 0x6f, 0xe9, 0xce, 0xf6, 0x60, 0x63, 0xa9, 0x6d, 0xf6, 0x4e, 0xb5,
+		0x4d, 0xbb, 0x67, 0xda, 0xfc, 0xbf, 0x93, 0x76, 0xe7, 0x3a, 0xf7, 0x1f, 0xe7, 0x16, 0xf3, 0x82,
+		0x8d, 0x24, 0xbb, 0x29, 0x08, 0x7f, 0xf6, 0x30, 0xcc, 0x6d, 0x99, 0x5b, 0x26, 0x7d, 0x3c, 0x45,
+		0x7e, 0x71, 0x07, 0x49, 0xbb, 0xa9, 0x63, 0x38, 0x49, 0x03, 0x66, 0xb9, 0xb0, 0x4a, 0x57, 0x64,
+		0xec, 0x64, 0x1f, 0xed, 0x79, 0x3b, 0x2c, 0xff, 0x9b, 0xdf, 0xa2, 0x5b, 0x69, 0xca, 0x0c, 0x87,
+		0x92, 0x3c, 0x76, 0xf8, 0x5f, 0x54, 0xe0, 0x60, 0x80, 0x8f, 0xad, 0x86, 0xb1, 0x15, 0xc1, 0xf8,
+		0xbb, 0x9c, 0x71, 0xd6, 0xc7, 0xd8, 0xe4, 0xd0, 0x62, 0x05, 0xa6, 0xf7, 0xc3, 0xf5, 0xef, 0x38,
+		0xd7, 0x14, 0xf6, 0x93, 0x2c, 0x43, 0x8e, 0x92, 0xb4, 0x07, 0xb6, 0x63, 0xf6, 0x68, 0x1b, 0xee,
+		0x4d, 0xf3, 0x7b, 0xdf, 0x62, 0x93, 0x5f, 0x96, 0xc0, 0x2a, 0x2e, 0xaa, 0x58, 0x04, 0xba, 0xb2,
+		0x24, 0x57, 0x9e, 0x23, 0x18, 0xbe, 0xc2, 0x15, 0x71, 0xe5, 0x8b, 0x97, 0x61, 0x8e, 0xfc, 0xa6,
+		0x3b, 0x01, 0x7e, 0x4d, 0xa2, 0xaf, 0x92, 0xe5, 0xff, 0xe8, 0x7d, 0x6c, 0x7e, 0x9d, 0x75, 0x09,
+		0x7c, 0x3a, 0xf9, 0x5a, 0x71, 0x0b, 0x3b, 0x0e, 0xb6, 0x6c, 0x55, 0xeb, 0x8e, 0x52, 0xcf, 0x77,
+		0x17, 0x27, 0xff, 0xb1, 0xef, 0x05, 0x5b, 0x71, 0x99, 0x21, 0x4b, 0xdd, 0x6e, 0x71, 0x1d, 0x0e,
+		0x8f, 0xf0, 0x8a, 0x31, 0x38, 0x3f, 0xce, 0x39, 0xe7, 0x86, 0x3c, 0x83, 0xd0, 0xae, 0x81, 0x48,
+		0x77, 0xdb, 0x72, 0x0c, 0xce, 0x4f, 0x70, 0x4e, 0xc4, 0xb1, 0xa2, 0x49, 0x09, 0xe3, 0x45, 0x98,
+		0xb9, 0x81, 0xad, 0x0d, 0xd3, 0xe6, 0xf7, 0x9f, 0xc6, 0xa0, 0xfb, 0x65, 0x4e, 0x97, 0xe3, 0x40,
+		0x7a, 0x21, 0x8a, 0x70, 0x9d, 0x83, 0xd4, 0xa6, 0xd6, 0xc6, 0x63, 0x50, 0xbc, 0xca, 0x29, 0x26,
+		0x89, 0x3c, 0x81, 0x96, 0x60, 0x6a, 0xcb, 0xe4, 0x9b, 0x41, 0xd1, 0xf0, 0x4f, 0x72, 0x78, 0x46,
+		0x60, 0x38, 0x45, 0xdf, 0xec, 0x0f, 0xba, 0x64, 0xa7, 0x28, 0x9a, 0xe2, 0x57, 0x04, 0x85, 0xc0,
+		0x70, 0x8a, 0x7d, 0x98, 0xf5, 0x35, 0x41, 0x61, 0xfb, 0xec, 0xf9, 0x0c, 0xb9, 0x16, 0xdd, 0xdd,
+		0x31, 0x8d, 0x71, 0x94, 0xf8, 0x55, 0xce, 0x00, 0x1c, 0x42, 0x08, 0x9e, 0x86, 0xf4, 0xb8, 0x0d,
+		0xf1, 0xf7, 0xbf, 0x27, 0xba, 0x87, 0x68, 0x81, 0x65, 0xc8, 0x89, 0x01, 0x8a, 0x1c, 0x2a, 0x46,
+		0x53, 0xfc, 0x3a, 0xa7, 0xc8, 0xfa, 0x60, 0xbc, 0x1a, 0x0e, 0xb6, 0x9d, 0x2d, 0x3c, 0x0e, 0xc9,
+		0xa7, 0x45, 0x35, 0x38, 0x84, 0x9b, 0x72, 0x03, 0x1b, 0xed, 0xed, 0xf1, 0x18, 0x3e, 0x23, 0x4c,
+		0x29, 0x30, 0x84, 0xa2, 0x02, 0xd3, 0x3d, 0xcd, 0xb2, 0xb7, 0xb5, 0xee, 0x58, 0xcd, 0xf1, 0x0f,
+		0x38, 0xc7, 0x94, 0x0b, 0xe2, 0x16, 0x19, 0x18, 0xfb, 0xa1, 0xf9, 0xac, 0xb0, 0xc8, 0xc0, 0x08,
+		0x10, 0xad, 0xc1, 0x9c, 0xed, 0xd0, 0x75, 0xd1, 0x7e, 0xd8, 0xfe, 0xa1, 0xe8, 0x7a, 0x0c, 0x7b,
+		0xc9, 0xcf, 0xf8, 0x34, 0xa4, 0x6d, 0xfd, 0x85, 0xb1, 0x68, 0x3e, 0x27, 0x5a, 0x9a, 0x02, 0x08,
+		0xf8, 0x1a, 0x1c, 0x19, 0x39, 0x4d, 0x8c, 0x41, 0xf6, 0x79, 0x4e, 0x76, 0x68, 0xc4, 0x54, 0xc1,
+		0x87, 0x84, 0xfd, 0x52, 0xfe, 0x23, 0x31, 0x24, 0xe0, 0x10, 0xd7, 0x1a, 0xd9, 0x9e, 0xb7, 0xb5,
+		0xcd, 0xfd, 0x59, 0xed, 0x1f, 0x0b, 0xab, 0x31, 0x6c, 0xc0, 0x6a, 0x2d, 0x38, 0xc4, 0x19, 0xf7,
+		0xd7, 0xae, 0xbf, 0x21, 0x06, 0x56, 0x86, 0x5e, 0x0f, 0xb6, 0xee, 0x4f, 0xc0, 0xbc, 0x6b, 0x4e,
+		0xb1, 0x0f, 0x6c, 0xab, 0xe4, 0x16, 0x55, 0x34, 0xf3, 0x6f, 0x72, 0x66, 0x31, 0xe2, 0xbb, 0x1b,
+		0xc9, 0xf6, 0x25, 0xad, 0x4f, 0xc8, 0xaf, 0x42, 0x5e, 0x90, 0x0f, 0x0c, 0x0b, 0xb7, 0xcd, 0x2d,
+		0x43, 0x7f, 0x01, 0x77, 0xc6, 0xa0, 0xfe, 0xad, 0x50, 0x53, 0xad, 0xfb, 0xe0, 0x84, 0xb9, 0x0e,
+		0xb2, 0x1b, 0xab, 0xa8, 0x7a, 0x8f, 0x9e, 0x99, 0xed, 0xcd, 0xf8, 0x05, 0xd1, 0x52, 0x2e, 0xae,
+		0x4e, 0x61, 0xc5, 0x1a, 0xb0, 0x37, 0x28, 0xc6, 0x75, 0xc9, 0x2f, 0x72, 0xa2, 0x69, 0x0f, 0xc5,
+		0x07, 0x8e, 0xb6, 0xd9, 0xeb, 0x6b, 0xd6, 0x38, 0xe3, 0xdf, 0x3f, 0x11, 0x03, 0x07, 0x87, 0xf0,
+		0x81, 0x83, 0xc4, 0x6b, 0x64, 0xb6, 0x1f, 0x83, 0xe1, 0x4b, 0x62, 0xe0, 0x10, 0x18, 0x4e, 0x21,
+		0x02, 0x86, 0x31, 0x28, 0x7e, 0x5b, 0x50, 0x08, 0x0c, 0xa1, 0xf8, 0x71, 0x6f, 0xa2, 0xb5, 0xf0,
+		0x96, 0x6e, 0x3b, 0xfc, 0x1d, 0xa7, 0xbd, 0xa9, 0xfe, 0xe9, 0xf7, 0x82, 0x41, 0x98, 0xe2, 0x83,
+		0x92, 0x91, 0x88, 0x47, 0xf6, 0xf4, 0x70, 0x22, 0x5a, 0xb1, 0x2f, 0x8b, 0x91, 0xc8, 0x07, 0x23,
+		0xba, 0xf9, 0x22, 0x44, 0x62, 0xf6, 0x36, 0x59, 0xf0, 0x8f, 0x41, 0xf7, 0xcf, 0x42, 0xca, 0x35,
+		0x05, 0x96, 0x70, 0xfa, 0xe2, 0x9f, 0x81, 0x71, 0x1d, 0xef, 0x8c, 0xe5, 0x9d, 0xff, 0x3c, 0x14,
+		0xff, 0xac, 0x33, 0x24, 0x1b, 0x43, 0x72, 0xa1, 0x78, 0x2a, 0x7a, 0x05, 0xf4, 0x53, 0x6f, 0xf2,
+		0xfa, 0x06, 0xc3, 0xa9, 0xe2, 0x0a, 0xc8, 0x3c, 0xc5, 0x0b, 0x60, 0x23, 0xc9, 0xde, 0xf7, 0xa6,
+		0xeb, 0xe7, 0x81, 0x98, 0xa7, 0x78, 0x1e, 0xa6, 0x03, 0x01, 0x4f, 0x34, 0xd5, 0xfb, 0x39, 0xd5,
+		0x94, 0x3f, 0xde, 0x29, 0x3e, 0x01, 0x09, 0x12, 0xbc, 0x44, 0xc3, 0x7f, 0x86, 0xc3, 0xa9, 0x78,
+		0xf1, 0x9d, 0x90, 0x12, 0x41, 0x4b, 0x34, 0xf4, 0x67, 0x39, 0xd4, 0x85, 0x10, 0xb8, 0x08, 0x58,
+		0xa2, 0xe1, 0x3f, 0x27, 0xe0, 0x02, 0x42, 0xe0, 0xe3, 0x9b, 0xf0, 0x5f, 0x7f, 0x30, 0xc1, 0xe0,
+		0x02, 0x52, 0x24, 0x6f, 0x70, 0xb0, 0x48, 0x25, 0x1a, 0xfd, 0xf3, 0xbc, 0x70, 0x81, 0x28, 0x3e,
+		0x09, 0x13, 0x63, 0x1a, 0xfc, 0x43, 0x1c, 0xca, 0xe4, 0x8b, 0x15, 0xc8, 0xf8, 0xa2, 0x93, 0x68,
+		0xf8, 0x87, 0x39, 0xdc, 0x8f, 0x22, 0xaa, 0xf3, 0xe8, 0x24, 0x9a, 0xe0, 0x6f, 0x0b, 0xd5, 0x39,
+		0x82, 0x98, 0x4d, 0x04, 0x26, 0xd1, 0xe8, 0x97, 0x85, 0xd5, 0x05, 0xa4, 0xf8, 0x0c, 0xa4, 0xdd,
+		0xc9, 0x26, 0x1a, 0xff, 0x11, 0x8e, 0xf7, 0x30, 0xc4, 0x02, 0x03, 0x63, 0x1f, 0x14, 0x7f, 0x47,
+		0x58, 0xc0, 0x87, 0x22, 0xdd, 0x28, 0x1c, 0xc0, 0x44, 0x33, 0xfd, 0xa2, 0xe8, 0x46, 0xa1, 0xf8,
+		0x85, 0xb4, 0x26, 0x1d, 0xf3, 0xa3, 0x29, 0x7e, 0x49, 0xb4, 0x26, 0x95, 0x27, 0x6a, 0x84, 0x23,
+		0x82, 0x68, 0x8e, 0x8f, 0x0a, 0x35, 0x42, 0x01, 0x41, 0x71, 0x0d, 0xd0, 0x70, 0x34, 0x10, 0xcd,
+		0xf7, 0x0a, 0xe7, 0x9b, 0x19, 0x0a, 0x06, 0x8a, 0x57, 0xe0, 0xd0, 0xe8, 0x48, 0x20, 0x9a, 0xf5,
+		0x63, 0x6f, 0x86, 0xd6, 0x6e, 0xfe, 0x40, 0xa0, 0xd8, 0x82, 0xb9, 0x51, 0x51, 0x40, 0x34, 0xed,
+		0xc7, 0xdf, 0x0c, 0x0e, 0xdc, 0xfe, 0x20, 0xa0, 0x58, 0x02, 0xf0, 0x26, 0xe0, 0x68, 0xae, 0x5f,
+		0xe6, 0x5c, 0x3e, 0x10, 0xe9, 0x1a, 0x7c, 0xfe, 0x8d, 0xc6, 0xbf, 0x2a, 0xba, 0x06, 0x47, 0x90,
+		0xae, 0x21, 0xa6, 0xde, 0x68, 0xf4, 0x27, 0x45, 0xd7, 0x10, 0x10, 0xe2, 0xd9, 0xbe, 0xd9, 0x2d,
+		0x9a, 0xe1, 0x57, 0x85, 0x67, 0xfb, 0x50, 0xc5, 0x06, 0xcc, 0x0c, 0x4d, 0x88, 0xd1, 0x54, 0x9f,
+		0xe2, 0x54, 0x72, 0x78, 0x3e, 0xf4, 0x4f, 0x5e, 0x7c, 0x32, 0x8c, 0x66, 0xfb, 0xb5, 0xd0, 0xe4,
+		0xc5, 0xe7, 0xc2, 0xe2, 0xd3, 0x90, 0x32, 0x06, 0xdd, 0x2e, 0xe9, 0x3c, 0x51, 0x5b, 0x5e, 0xdf,
+		0xf9, 0x3e, 0xb7, 0x8e, 0x00, 0x14, 0x9f, 0x80, 0x09, 0xdc, 0xdb, 0xc0, 0x9d, 0x28, 0xe4, 0x77,
+		0xbf, 0x2f, 0x06, 0x4c, 0x22, 0x4d, 0x36, 0xda, 0xd8, 0xd6, 0x08, 0xbd, 0x27, 0x1e, 0x81, 0xfd,
+		0xd3, 0xef, 0xf3, 0x97, 0xca, 0x3c, 0x88, 0x47, 0x30, 0xce, 0x4e, 0xdd, 0xf7, 0x82, 0x04, 0xb4,
+		0x45, 0xce, 0xc1, 0x24, 0x39, 0x1e, 0x76, 0xb4, 0xc8, 0x1d, 0xcb, 0x3f, 0xe3, 0x68, 0x21, 0x4f,
+		0x0c, 0xd6, 0x33, 0x2d, 0xec, 0x68, 0x5b, 0x76, 0x14, 0xf6, 0xbf, 0x73, 0xac, 0x0b, 0x20, 0xe0,
+		0xb6, 0x66, 0x3b, 0xe3, 0xd4, 0xfb, 0xcf, 0x05, 0x58, 0x00, 0x88, 0xd2, 0xe4, 0xf7, 0x75, 0x1c,
+		0xb9, 0xc3, 0xf9, 0x17, 0x42, 0x69, 0x2e, 0x5f, 0x7c, 0x27, 0xa4, 0xc9, 0x4f, 0xf6, 0xa6, 0x68,
+		0x04, 0xf8, 0x2f, 0x39, 0xd8, 0x43, 0x90, 0x92, 0x6d, 0xa7, 0xe3, 0xe8, 0xd1, 0xc6, 0xbe, 0xc3,
+		0x5b, 0x5a, 0xc8, 0x17, 0x4b, 0x90, 0xb1, 0x9d, 0x4e, 0x67, 0xc0, 0xe3, 0xd3, 0xa8, 0xfd, 0xe1,
+		0xef, 0xbb, 0x5b, 0x16, 0x2e, 0x86, 0xb4, 0xf6, 0xcd, 0xeb, 0x4e, 0xdf, 0xa4, 0x37, 0x8b, 0x22,
+		0x77, 0x88, 0x39, 0x83, 0x0f, 0x52, 0xac, 0xc0, 0x14, 0xa9, 0x8b, 0xb8, 0xa0, 0x11, 0xb9, 0x3f,
+		0xcc, 0x0d, 0x10, 0x00, 0x95, 0x7f, 0x72, 0xb7, 0xcd, 0xdd, 0xd1, 0x67, 0x08, 0xb0, 0x6c, 0x2e,
+		0x9b, 0xec, 0xf4, 0xe0, 0xb9, 0xb7, 0x0f, 0xef, 0xfe, 0x06, 0xf7, 0x75, 0xe9, 0x2f, 0xf8, 0x44,
+		0x1a, 0x8e, 0xb0, 0x6c, 0x95, 0x65, 0xb1, 0x07, 0xc6, 0x89, 0xa6, 0xfc, 0x59, 0xd1, 0xfb, 0xbe,
+		0x85, 0x67, 0x61, 0xb6, 0x4e, 0x6a, 0x4f, 0xa2, 0x3a, 0xef, 0x3c, 0x63, 0xe4, 0x3b, 0x9e, 0x8b,
+		0x81, 0x00, 0x86, 0x9f, 0x7b, 0xf9, 0x93, 0x0a, 0x3f, 0x25, 0x81, 0xdc, 0x6c, 0x6b, 0x5d, 0xcd,
+		0xfa, 0x7f, 0xa5, 0x42, 0x4f, 0x8a, 0xfd, 0x7e, 0xf7, 0xeb, 0x2c, 0xe4, 0xec, 0xd7, 0x5f, 0xb9,
+		0x25, 0x56, 0x12, 0x7d, 0xff, 0x81, 0xed, 0xf3, 0x93, 0x9f, 0x27, 0xae, 0x02, 0x78, 0x19, 0xe4,
+		0x46, 0x43, 0xb3, 0x52, 0x5a, 0x29, 0x29, 0xe2, 0xaa, 0x45, 0x73, 0xad, 0x56, 0x61, 0x1f, 0x4b,
+		0x38, 0x40, 0xae, 0x0b, 0xf8, 0x33, 0xdd, 0x3b, 0xe3, 0x07, 0x61, 0xc6, 0x9f, 0xce, 0xde, 0x5c,
+		0x8f, 0x15, 0x2f, 0x90, 0x95, 0x0f, 0xb9, 0x25, 0xa8, 0x6a, 0x9d, 0x0e, 0xee, 0xa8, 0xba, 0x81,
+		0x22, 0xde, 0x52, 0xcd, 0xff, 0xfe, 0x7f, 0x66, 0xbb, 0xff, 0xd3, 0x0c, 0x58, 0x22, 0xb8, 0xba,
+		0x41, 0xe6, 0x50, 0xbd, 0xd7, 0xef, 0x62, 0x7a, 0xb6, 0xa9, 0xea, 0xc2, 0xfe, 0xd1, 0xc3, 0x33,
+		0xe1, 0x23, 0xe7, 0xa4, 0xb3, 0x1e, 0xdc, 0x6d, 0xbd, 0xe2, 0xb3, 0xde, 0x79, 0x8d, 0xab, 0x60,
+		0x24, 0xe3, 0x1f, 0x70, 0x0d, 0xc5, 0xea, 0x4c, 0xa8, 0xb8, 0x02, 0x33, 0xe4, 0xd5, 0xae, 0x7e,
+		0x40, 0xbf, 0x88, 0x1e, 0x21, 0x6a, 0x2b, 0x73, 0xa4, 0xa7, 0xda, 0x93, 0x90, 0xb4, 0x69, 0xa3,
+		0x44, 0x51, 0x08, 0x75, 0xb8, 0x38, 0x59, 0xce, 0x33, 0x37, 0x70, 0x6b, 0x14, 0x41, 0xf0, 0x87,
+		0x9c, 0x60, 0x8a, 0xc2, 0x44, 0x6d, 0x0c, 0x98, 0x61, 0x9f, 0x26, 0xc1, 0xbe, 0xda, 0xec, 0xbd,
+		0x2a, 0xfc, 0x9d, 0x2f, 0x3c, 0x4a, 0xcf, 0x93, 0xef, 0x0b, 0x3a, 0xdd, 0x88, 0xce, 0xa2, 0xc8,
+		0x9c, 0xdb, 0xab, 0x2f, 0x86, 0xac, 0x28, 0x8f, 0xd7, 0x7b, 0xef, 0xc2, 0xfe, 0x25, 0x2f, 0xec,
+		0xd8, 0x28, 0x0f, 0xf7, 0x95, 0x34, 0xcd, 0x59, 0x59, 0x46, 0xb1, 0x0c, 0xd3, 0xe4, 0x08, 0xda,
+		0x33, 0xce, 0xde, 0xa5, 0xfc, 0xab, 0x2f, 0x3c, 0xca, 0x3a, 0x1a, 0x01, 0x71, 0xd3, 0xdc, 0xd5,
+		0x69, 0x14, 0x65, 0x7f, 0xda, 0xaf, 0xaa, 0x7b, 0x1a, 0xf5, 0x6f, 0x13, 0x70, 0x8c, 0x0b, 0x6f,
+		0x68, 0x36, 0x3e, 0x75, 0xe3, 0xf4, 0x06, 0x76, 0xb4, 0xd3, 0xa7, 0xda, 0xa6, 0x6e, 0xf0, 0x21,
+		0x6a, 0x96, 0xe5, 0x2f, 0x91, 0xfc, 0x25, 0x9e, 0x3f, 0x3f, 0xf2, 0x08, 0x6b, 0x7e, 0xf7, 0x81,
+		0x6e, 0x7e, 0xf8, 0x44, 0xb4, 0xd0, 0x85, 0x44, 0xc5, 0xd4, 0xe9, 0xbb, 0x37, 0x1d, 0x6c, 0x98,
+		0x3d, 0x3e, 0xe4, 0xb0, 0x07, 0x74, 0x01, 0x92, 0x5a, 0xcf, 0x1c, 0x18, 0x0e, 0x1b, 0x6e, 0xca,
+		0x8f, 0x7e, 0xe5, 0xf6, 0xc2, 0x81, 0x3f, 0xbe, 0xbd, 0x70, 0x90, 0xd1, 0xda, 0x9d, 0xeb, 0x4b,
+		0xba, 0x79, 0xaa, 0xa7, 0x39, 0xdb, 0xa4, 0x91, 0xbf, 0xf6, 0xc5, 0x93, 0xc0, 0xcb, 0xab, 0x1b,
+		0xce, 0x67, 0x5e, 0xff, 0x8d, 0x13, 0x92, 0xc2, 0xf1, 0xc5, 0xc4, 0x1b, 0xaf, 0x2d, 0x48, 0x85,
+		0x3e, 0x4c, 0x56, 0x71, 0x7b, 0x8f, 0x02, 0xeb, 0xa1, 0x02, 0x4f, 0xf3, 0x02, 0x8f, 0x0e, 0x17,
+		0xc8, 0xee, 0xf4, 0x57, 0x71, 0xdb, 0x57, 0x6c, 0x15, 0xb7, 0x43, 0x25, 0xd6, 0x21, 0x55, 0x37,
+		0x1c, 0xf6, 0x12, 0xfe, 0x3b, 0x21, 0xae, 0x1b, 0xec, 0xdd, 0xcd, 0x74, 0xf9, 0xe1, 0x7d, 0x54,
+		0x45, 0x21, 0xb8, 0xc2, 0x2a, 0xa4, 0xaa, 0xb8, 0xcd, 0xa8, 0x2a, 0x10, 0xef, 0xe0, 0x76, 0x5e,
+		0xba, 0x5b, 0x25, 0x09, 0xba, 0x5c, 0xfd, 0xfa, 0x7f, 0x3b, 0x76, 0xe0, 0xc5, 0x6f, 0x1c, 0x3b,
+		0xb0, 0xab, 0x3b, 0x15, 0xa2, 0x0f, 0x37, 0x5d, 0x2f, 0xfa, 0x3f, 0x12, 0x1c, 0x61, 0x9e, 0xec,
+		0x4d, 0x5d, 0x9a, 0xb1, 0xb3, 0xdb, 0xf7, 0xf5, 0xce, 0x42, 0xbc, 0x64, 0xec, 0xa0, 0x23, 0x2c,
+		0x88, 0x57, 0x07, 0x56, 0x97, 0xb7, 0xc4, 0x24, 0x79, 0x5e, 0xb7, 0xba, 0xc1, 0xd7, 0xb1, 0xa6,
+		0xf8, 0xeb, 0x58, 0xe5, 0x0f, 0x4b, 0xfb, 0x9b, 0xad, 0x53, 0x25, 0x63, 0x87, 0xda, 0x6c, 0x4d,
+		0x7a, 0xee, 0x91, 0xc8, 0x93, 0xfe, 0xeb, 0x86, 0x79, 0xd3, 0x20, 0x6a, 0xf7, 0x37, 0xc4, 0x29,
+		0xff, 0xb1, 0xf0, 0x29, 0xff, 0x15, 0xdc, 0xed, 0x3e, 0x4b, 0xe4, 0x5a, 0x81, 0xfa, 0xff, 0x62,
+		0x0c, 0x8e, 0x0d, 0x4d, 0xdd, 0x3c, 0xd0, 0xd9, 0xcd, 0x08, 0x45, 0x48, 0x55, 0xb9, 0x08, 0xf9,
+		0xea, 0x9f, 0x8d, 0xdb, 0xa6, 0xd1, 0x61, 0xef, 0xd5, 0xc4, 0x15, 0xf1, 0x48, 0x0c, 0x61, 0x68,
+		0x86, 0x69, 0xf3, 0x8f, 0x5a, 0xb0, 0x87, 0xf2, 0x27, 0xf6, 0x69, 0x88, 0x69, 0x51, 0x92, 0xb0,
+		0xc6, 0xe9, 0x31, 0xad, 0x21, 0x2a, 0x11, 0xb8, 0xfb, 0x30, 0xae, 0x55, 0x3e, 0x1a, 0x83, 0x85,
+		0xb0, 0x55, 0x48, 0xf4, 0x68, 0x3b, 0x5a, 0xaf, 0xbf, 0x9b, 0x59, 0x9e, 0x86, 0x74, 0x4b, 0xc8,
+		0xec, 0xdb, 0x2e, 0xaf, 0xee, 0xd3, 0x2e, 0x59, 0xb7, 0x28, 0x61, 0x98, 0x33, 0x63, 0x1a, 0xc6,
+		0xad, 0xc7, 0x5d, 0x59, 0xe6, 0x5f, 0x3c, 0x03, 0x8b, 0xba, 0xa1, 0x3b, 0xba, 0x76, 0xaa, 0x67,
+		0x3b, 0xda, 0x75, 0xdd, 0xd8, 0x3a, 0x75, 0xe3, 0xf4, 0x29, 0xfe, 0x93, 0x9b, 0x06, 0x31, 0x89,
+		0x25, 0x21, 0xb1, 0x74, 0xe3, 0xf4, 0x88, 0x51, 0x74, 0x3e, 0x62, 0xf8, 0xde, 0x6b, 0x4c, 0xde,
+		0x65, 0x10, 0xdf, 0xb5, 0x27, 0xcf, 0x47, 0x38, 0xf9, 0x7c, 0x54, 0x73, 0x17, 0x7e, 0x2f, 0x06,
+		0x39, 0x72, 0x49, 0x4c, 0xb7, 0xd9, 0x87, 0xc0, 0x1c, 0x6c, 0xa3, 0x8b, 0x90, 0xb0, 0x34, 0x87,
+		0x07, 0x9c, 0xe5, 0xb3, 0xfb, 0x1e, 0xd0, 0xd8, 0x60, 0x4f, 0x39, 0x50, 0x07, 0x52, 0x3d, 0xed,
+		0x96, 0x4a, 0xf9, 0xd8, 0x28, 0x5e, 0x1f, 0x83, 0xef, 0xce, 0xed, 0x85, 0xdc, 0x8e, 0xd6, 0xeb,
+		0x16, 0x0b, 0x02, 0x5c, 0x18, 0x55, 0xc4, 0x64, 0x4f, 0xbb, 0x45, 0x54, 0x46, 0xb7, 0xe8, 0x2d,
+		0x4a, 0xb5, 0xbd, 0xad, 0x19, 0x5b, 0x98, 0x15, 0x46, 0xd7, 0x16, 0xe5, 0xb5, 0xf1, 0x0a, 0x3b,
+		0xe4, 0x15, 0xe6, 0xe3, 0x18, 0x59, 0xe6, 0x74, 0x4f, 0xbb, 0x55, 0xa1, 0x32, 0xa4, 0xe4, 0x62,
+		0xea, 0x95, 0xd7, 0x16, 0x0e, 0xd0, 0xc9, 0xe5, 0x3f, 0x48, 0x00, 0x9e, 0x25, 0xd1, 0x55, 0x90,
+		0xdb, 0xee, 0x13, 0xa5, 0x13, 0xaf, 0xe8, 0xdd, 0xbf, 0x34, 0xec, 0x47, 0x4b, 0xa1, 0x36, 0x28,
+		0xa7, 0x88, 0xe2, 0x5f, 0xbd, 0xbd, 0x20, 0x29, 0xb9, 0x76, 0xa8, 0x79, 0x7e, 0x02, 0x32, 0x83,
+		0x7e, 0x87, 0x1c, 0x6f, 0x93, 0xc6, 0xe4, 0x6f, 0x72, 0xcd, 0x0f, 0x05, 0x2e, 0x6e, 0x4f, 0x2a,
+		0x1f, 0x23, 0x5c, 0x77, 0x6e, 0x2f, 0x20, 0x56, 0x4b, 0x1f, 0xb8, 0xf0, 0xf2, 0x9f, 0x2c, 0x48,
+		0x0a, 0xb0, 0x14, 0x02, 0xf0, 0xd5, 0xe7, 0xf7, 0xe9, 0x67, 0xdd, 0xbc, 0x05, 0x45, 0x1e, 0x26,
+		0x7b, 0xa6, 0xa1, 0x5f, 0xe7, 0xdf, 0x75, 0x4b, 0x2b, 0xe2, 0x91, 0x7c, 0x43, 0x8c, 0xbd, 0x51,
+		0xe2, 0xec, 0x88, 0x6f, 0x88, 0x89, 0x67, 0x82, 0xba, 0x89, 0x37, 0x6c, 0x5d, 0xb4, 0x88, 0x22,
+		0x1e, 0xd1, 0x79, 0xf2, 0x15, 0x99, 0xf6, 0xc0, 0xd2, 0x9d, 0x1d, 0xf2, 0xc6, 0x9e, 0x43, 0x5e,
+		0x2c, 0xa3, 0x37, 0x33, 0xca, 0x47, 0xef, 0xdc, 0x5e, 0x38, 0xcc, 0x74, 0x0d, 0x4b, 0x14, 0x94,
+		0x9c, 0x48, 0xaa, 0xb0, 0x14, 0x52, 0x42, 0x07, 0x3b, 0x9a, 0xde, 0x65, 0xd7, 0x4a, 0xd3, 0x8a,
+		0x78, 0xf4, 0xd5, 0xe5, 0xcb, 0x69, 0x48, 0xf3, 0x6b, 0xda, 0xa6, 0x45, 0x4a, 0x36, 0xfb, 0xd8,
+		0xd2, 0xe8, 0xe7, 0x17, 0x3a, 0x1d, 0x0b, 0xdb, 0x76, 0x5e, 0x0a, 0x97, 0x1c, 0x96, 0x28, 0x28,
+		0x39, 0x91, 0x54, 0x62, 0x29, 0xc8, 0x21, 0x4d, 0x6c, 0xd8, 0xd8, 0xb0, 0x07, 0xb6, 0xda, 0x1f,
+		0x6c, 0x5c, 0xc7, 0x3b, 0xbc, 0x35, 0xe6, 0x86, 0x5a, 0xa3, 0x64, 0xec, 0x94, 0x1f, 0xf3, 0xd8,
+		0xc3, 0xb8, 0xc2, 0x1f, 0x7c, 0xf1, 0xe4, 0x1c, 0x77, 0xb5, 0xb6, 0xb5, 0xd3, 0x77, 0xcc, 0xa5,
+		0xb5, 0xc1, 0xc6, 0xb3, 0x78, 0x47, 0xc9, 0xb9, 0xa2, 0x6b, 0x54, 0x92, 0x7c, 0x50, 0xe0, 0x79,
+		0x4d, 0xef, 0x8a, 0x17, 0x24, 0x15, 0xfe, 0x84, 0xce, 0x42, 0xd2, 0x76, 0x34, 0x67, 0x60, 0xf3,
+		0x3b, 0xc8, 0xc7, 0x46, 0xb9, 0x59, 0xd9, 0x34, 0x3a, 0x4d, 0x2a, 0xa5, 0x70, 0x69, 0xe4, 0x40,
+		0xd2, 0x31, 0xaf, 0x63, 0xc3, 0xe6, 0x1f, 0xce, 0x3c, 0xb2, 0x34, 0x22, 0xbc, 0x5c, 0x22, 0x61,
+		0x5a, 0xb9, 0xc4, 0x1d, 0x69, 0x9a, 0x55, 0x82, 0xc1, 0x0a, 0x9f, 0xfd, 0x93, 0x85, 0xe3, 0xd1,
+		0xa1, 0x09, 0x65, 0xb0, 0x15, 0x5e, 0x16, 0xfa, 0xa8, 0x04, 0x72, 0x07, 0x77, 0xf1, 0x16, 0xb5,
+		0x31, 0xf9, 0x8a, 0x0a, 0xb6, 0xf9, 0xa7, 0x48, 0xef, 0x19, 0xa9, 0x00, 0x0f, 0x15, 0xcb, 0x0d,
+		0xae, 0x03, 0x37, 0x64, 0x98, 0x83, 0x68, 0x33, 0xc6, 0x2d, 0x30, 0x41, 0x67, 0x2b, 0x39, 0x97,
+		0xa1, 0x49, 0x09, 0xd0, 0x72, 0x70, 0x69, 0x2d, 0xbe, 0xda, 0x39, 0xc2, 0x98, 0xbe, 0xde, 0x51,
+		0x4e, 0x10, 0xb5, 0x82, 0x2b, 0xf0, 0xf3, 0x64, 0x87, 0x7b, 0xc3, 0x34, 0xe8, 0x85, 0xdd, 0x6d,
+		0xac, 0x6f, 0x6d, 0x3b, 0xf4, 0xa5, 0xa5, 0xb8, 0xdf, 0xcd, 0xc2, 0x12, 0x05, 0x25, 0xe7, 0x26,
+		0x5d, 0xa0, 0x29, 0xa8, 0x03, 0x59, 0x4f, 0x8a, 0x76, 0xf9, 0x74, 0x64, 0x97, 0xbf, 0x8f, 0x5b,
+		0xe9, 0x60, 0xb8, 0x14, 0xaf, 0xd7, 0x4f, 0xbb, 0x89, 0x04, 0x46, 0x3e, 0x77, 0xea, 0x0d, 0x34,
+		0xf4, 0xd3, 0x0e, 0x99, 0x33, 0xc7, 0xf6, 0x1e, 0xa9, 0x78, 0xa5, 0x7d, 0x38, 0xf4, 0x92, 0x04,
+		0xd3, 0x37, 0x4c, 0x87, 0x94, 0xd4, 0x37, 0x6f, 0x62, 0xcb, 0xce, 0x67, 0xa2, 0x9c, 0xea, 0x02,
+		0x57, 0x75, 0x8e, 0xa9, 0x1a, 0x40, 0xef, 0xcf, 0xb7, 0xa6, 0x18, 0x76, 0x8d, 0x42, 0xd1, 0x15,
+		0x98, 0xf2, 0x73, 0xb1, 0x6f, 0xf3, 0x96, 0x1f, 0xdf, 0x33, 0xd2, 0xbf, 0x73, 0x7b, 0x61, 0x76,
+		0x58, 0x8d, 0x82, 0x92, 0xf1, 0x31, 0xa3, 0x77, 0xc0, 0x51, 0xcf, 0x9e, 0xa6, 0xa1, 0x6e, 0x9b,
+		0xdd, 0x8e, 0x6a, 0xe1, 0x4d, 0xb5, 0x4d, 0xd7, 0x2a, 0xd3, 0x34, 0x2e, 0x3a, 0xec, 0x8a, 0xac,
+		0x1a, 0x17, 0xcc, 0x6e, 0x47, 0xc1, 0x9b, 0x15, 0x92, 0x4d, 0xde, 0x3b, 0xf3, 0xd0, 0x7a, 0x87,
+		0x7e, 0x19, 0x38, 0xa1, 0x64, 0xdc, 0xb4, 0x7a, 0xa7, 0x38, 0xf5, 0xd2, 0x6b, 0x0b, 0x07, 0xf8,
+		0xd8, 0x75, 0xa0, 0x70, 0x16, 0xa6, 0x2e, 0x6b, 0x5d, 0x3e, 0xe6, 0x60, 0x1b, 0xdd, 0x03, 0x69,
+		0x4d, 0x3c, 0xd0, 0xab, 0xd5, 0x69, 0xc5, 0x4b, 0x60, 0x63, 0xde, 0x8b, 0xff, 0x65, 0x51, 0x22,
+		0x2f, 0x8b, 0x27, 0xab, 0x97, 0xd7, 0x34, 0xdd, 0x42, 0x75, 0x98, 0x71, 0xbd, 0x3c, 0x34, 0xe2,
+		0xdd, 0x73, 0xe7, 0xf6, 0x42, 0x3e, 0xdc, 0x95, 0xdc, 0x21, 0xcf, 0xeb, 0xa2, 0x62, 0xcc, 0xab,
+		0xc3, 0xcc, 0x0d, 0x31, 0x90, 0xba, 0x54, 0xb1, 0x30, 0xd5, 0x90, 0x48, 0x41, 0x91, 0xdd, 0x34,
+		0x4e, 0x15, 0xaa, 0x66, 0x09, 0x26, 0x99, 0xb6, 0x36, 0x3a, 0x0b, 0x13, 0x7d, 0xf2, 0x83, 0x5f,
+		0x48, 0x9f, 0x1f, 0xd9, 0xf7, 0xa8, 0x2c, 0xf7, 0x40, 0x26, 0x5e, 0xf8, 0x48, 0x0c, 0xa0, 0x7a,
+		0xf9, 0x72, 0xcb, 0xd2, 0xfb, 0x5d, 0xec, 0xfc, 0x75, 0xd6, 0xba, 0x05, 0x07, 0xbd, 0x2a, 0xd9,
+		0x56, 0x3b, 0x54, 0xf3, 0xc5, 0x3b, 0xb7, 0x17, 0xee, 0x09, 0xd7, 0xdc, 0x27, 0x56, 0x50, 0x66,
+		0xdd, 0xf4, 0xa6, 0xd5, 0x1e, 0xc9, 0xda, 0xb1, 0x1d, 0x97, 0x35, 0xbe, 0x3b, 0xab, 0x4f, 0xcc,
+		0xcf, 0x5a, 0xb5, 0x9d, 0xd1, 0x66, 0x5d, 0x85, 0x8c, 0x67, 0x12, 0x1b, 0xbd, 0x0b, 0x52, 0x0e,
+		0xff, 0xcd, 0xad, 0x7b, 0x6c, 0xb4, 0x75, 0x05, 0x84, 0x5b, 0xd8, 0x45, 0x15, 0x3e, 0x47, 0x8c,
+		0xcc, 0xec, 0x43, 0x3a, 0xfc, 0x5b, 0xd2, 0xb5, 0xd0, 0x0b, 0x90, 0xe4, 0x53, 0x4a, 0x7c, 0x8c,
+		0x29, 0xa5, 0x1a, 0x9c, 0xd6, 0xee, 0x72, 0x22, 0xe1, 0x25, 0x86, 0xec, 0xff, 0x73, 0x31, 0xf2,
+		0x82, 0x2f, 0xef, 0xdb, 0x6f, 0x79, 0xbb, 0xad, 0xc0, 0x24, 0x36, 0x1c, 0x4b, 0x77, 0x0d, 0xf7,
+		0xc8, 0x28, 0xef, 0x18, 0x51, 0x1f, 0xfa, 0x4d, 0x29, 0xee, 0x2b, 0x82, 0x22, 0x64, 0x89, 0xdf,
+		0x49, 0x40, 0x7e, 0x37, 0x24, 0xaa, 0x40, 0xae, 0x6d, 0x61, 0x9a, 0x20, 0xa6, 0x4a, 0xba, 0xbe,
+		0x2c, 0xcf, 0x7b, 0xd1, 0x79, 0x48, 0xa0, 0xa0, 0x64, 0x45, 0x0a, 0x9f, 0x28, 0xb7, 0x80, 0xc4,
+		0xca, 0xc4, 0x4d, 0x89, 0xd4, 0x98, 0xc1, 0x71, 0x81, 0x37, 0xbe, 0x28, 0x24, 0x48, 0xc0, 0xa6,
+		0xca, 0xac, 0x97, 0x4a, 0xe7, 0xca, 0x0f, 0x4b, 0x90, 0x63, 0x76, 0xe9, 0xaa, 0x1b, 0x5a, 0x57,
+		0x23, 0xaf, 0x02, 0xc7, 0xa3, 0xe6, 0xb9, 0x8b, 0xc1, 0x82, 0x42, 0xf8, 0xfd, 0xcd, 0x74, 0x59,
+		0x8e, 0x2e, 0x33, 0x30, 0xba, 0x09, 0x93, 0x42, 0x8f, 0x44, 0x94, 0x1e, 0x65, 0xae, 0x47, 0x96,
+		0xe9, 0x71, 0x57, 0xe5, 0x8b, 0xd2, 0x86, 0x66, 0xb3, 0x89, 0xa1, 0xd9, 0x2c, 0x6a, 0xba, 0x4c,
+		0xee, 0x39, 0x5d, 0xfa, 0x62, 0xf8, 0xdf, 0x4d, 0xc0, 0x8c, 0x82, 0x3b, 0x7f, 0xe3, 0x38, 0x7f,
+		0x9d, 0x8e, 0xf3, 0x33, 0x12, 0x00, 0x1b, 0xb7, 0xc8, 0xcc, 0x92, 0x4f, 0x8c, 0x31, 0x5a, 0x8a,
+		0x78, 0x6d, 0xc6, 0x3f, 0x5a, 0x12, 0xf4, 0xbe, 0x47, 0xcc, 0x34, 0xc3, 0x56, 0x6d, 0xe7, 0x47,
+		0xe9, 0x47, 0x7f, 0x1c, 0x83, 0x29, 0xbf, 0x1f, 0xfd, 0x7f, 0x1a, 0x27, 0xa0, 0x9a, 0x37, 0xd6,
+		0x27, 0xf8, 0x1f, 0x37, 0x18, 0x31, 0xd6, 0x0f, 0xf5, 0xb8, 0xbd, 0x07, 0xf9, 0x57, 0x52, 0x90,
+		0x5c, 0xd3, 0x2c, 0xad, 0x67, 0xa3, 0xf6, 0xd0, 0xb2, 0x85, 0x6d, 0x7f, 0x1c, 0x19, 0xfe, 0xf3,
+		0x05, 0x7c, 0xcf, 0x2a, 0x62, 0xd5, 0xf2, 0xca, 0x88, 0x55, 0xcb, 0xbb, 0x80, 0xbc, 0x0d, 0xab,
+		0xba, 0xf5, 0x63, 0x96, 0x9e, 0x2e, 0x1f, 0xf1, 0x58, 0x82, 0xf9, 0x05, 0xba, 0x81, 0xe3, 0xee,
+		0x05, 0x90, 0x4f, 0xbc, 0x64, 0x88, 0x84, 0x37, 0xed, 0x11, 0xf8, 0x21, 0x6f, 0xb7, 0xc4, 0x97,
+		0x59, 0x50, 0xa0, 0xa7, 0xdd, 0xaa, 0xb1, 0x07, 0xb4, 0x02, 0x68, 0x5b, 0xb7, 0x1d, 0xd3, 0xd2,
+		0xdb, 0x5a, 0x57, 0xf5, 0x4c, 0x49, 0xf0, 0xf7, 0xde, 0xb9, 0xbd, 0x70, 0x84, 0xe1, 0x87, 0x65,
+		0x0a, 0xca, 0x8c, 0x97, 0x28, 0xd8, 0x9e, 0x84, 0x0c, 0xa9, 0x97, 0x4a, 0x4f, 0x3e, 0xd8, 0x52,
+		0x3c, 0xed, 0x57, 0xc3, 0x97, 0x59, 0x50, 0x80, 0x3c, 0x55, 0xe9, 0x03, 0xaa, 0x81, 0xdc, 0xd3,
+		0x0d, 0x35, 0xb0, 0xd4, 0x21, 0x7d, 0x21, 0xe1, 0x5f, 0x65, 0x86, 0x25, 0x0a, 0xf4, 0x3d, 0xe4,
+		0xcb, 0xbe, 0x45, 0xcd, 0xfb, 0x25, 0x98, 0x25, 0x52, 0xa1, 0x3d, 0x2b, 0xf6, 0xc9, 0xf7, 0x72,
+		0x6b, 0xbc, 0x6d, 0xb4, 0x79, 0xaf, 0xb4, 0x10, 0xcf, 0xc8, 0xad, 0xb4, 0x99, 0x9e, 0x6e, 0x04,
+		0xf7, 0xbd, 0xd0, 0xdf, 0x95, 0x40, 0xbe, 0x8e, 0x77, 0x54, 0x8b, 0xbf, 0xa1, 0xa9, 0x6e, 0x62,
+		0x9c, 0x4f, 0x45, 0x8d, 0x90, 0x6b, 0xc1, 0x3d, 0x81, 0x30, 0xc1, 0xbe, 0x86, 0x48, 0xa6, 0x5a,
+		0xf6, 0x3a, 0xde, 0x51, 0x38, 0xc5, 0x79, 0x4c, 0xf5, 0x3a, 0xb2, 0xd5, 0x35, 0x37, 0xb4, 0xae,
+		0xda, 0xd5, 0xdf, 0x33, 0xd0, 0x3b, 0x2a, 0xef, 0x23, 0x6a, 0x5b, 0xeb, 0xd3, 0xf5, 0x78, 0xba,
+		0xfc, 0xdc, 0x78, 0x46, 0x5a, 0x64, 0x4a, 0xee, 0xca, 0x36, 0xd2, 0x54, 0x87, 0x98, 0xf8, 0x0a,
+		0x95, 0x6e, 0x32, 0xe1, 0x8a, 0xd6, 0x47, 0x9f, 0x94, 0xe0, 0x1e, 0xaf, 0xd3, 0x8f, 0x50, 0x8d,
+		0x7e, 0x70, 0xbf, 0xfc, 0xee, 0xf1, 0x54, 0xbb, 0x3f, 0x3c, 0x8a, 0x8c, 0xa9, 0xdd, 0x11, 0x17,
+		0x11, 0x56, 0xd0, 0x37, 0xee, 0xde, 0x8e, 0xc3, 0x91, 0x8a, 0x69, 0xd8, 0x7c, 0x5f, 0x8b, 0x1b,
+		0xf7, 0x02, 0xed, 0x09, 0x3b, 0x68, 0x65, 0xd7, 0x3d, 0xb9, 0xfb, 0xbe, 0xf6, 0xc5, 0x93, 0xf7,
+		0xf2, 0x12, 0x2f, 0x87, 0x02, 0x56, 0x76, 0x9b, 0x77, 0x78, 0x67, 0xee, 0x32, 0xe4, 0x4c, 0xf6,
+		0x21, 0xb0, 0xb1, 0x36, 0xe6, 0xf2, 0xbb, 0xee, 0xbe, 0x4d, 0x9b, 0xdd, 0x0e, 0x57, 0x9a, 0xec,
+		0xbd, 0x5d, 0x86, 0x9c, 0x81, 0x6f, 0x06, 0x78, 0xe3, 0x77, 0xc7, 0x6b, 0xe0, 0x9b, 0x3e, 0xde,
+		0x43, 0x90, 0xe4, 0xc1, 0x4b, 0x82, 0x4e, 0x63, 0xfc, 0x09, 0xbd, 0x13, 0x12, 0x74, 0xe4, 0x9c,
+		0x88, 0x8c, 0x46, 0xa6, 0x49, 0x0b, 0x93, 0x98, 0x83, 0x6f, 0xbe, 0x13, 0x18, 0xda, 0x80, 0x38,
+		0xe9, 0x3f, 0xc9, 0xa8, 0xfe, 0xf3, 0x04, 0x01, 0xef, 0xbf, 0x93, 0x10, 0xf2, 0x62, 0xea, 0x25,
+		0x31, 0xf6, 0xbf, 0x26, 0xc1, 0x6c, 0x8b, 0xec, 0xee, 0xe9, 0x2f, 0x60, 0xba, 0x97, 0xa6, 0xe0,
+		0xb6, 0x69, 0x75, 0x50, 0x16, 0x62, 0x3a, 0xfb, 0x2a, 0x59, 0x42, 0x89, 0xe9, 0xe4, 0x53, 0xcd,
+		0x13, 0xe6, 0x4d, 0x83, 0x7f, 0x16, 0x3e, 0x5d, 0xce, 0x7f, 0xcd, 0x33, 0x52, 0xb0, 0x59, 0x99,
+		0x18, 0x7a, 0x06, 0xd2, 0xae, 0x7f, 0xe5, 0xe3, 0xe3, 0xfa, 0x84, 0x87, 0xf1, 0xa9, 0xf8, 0x87,
+		0x12, 0x20, 0x6f, 0xe9, 0xa1, 0x60, 0xbb, 0x6f, 0x1a, 0x36, 0xdd, 0xfb, 0xf2, 0x66, 0x39, 0x3e,
+		0x4d, 0x8d, 0x5e, 0x17, 0xbb, 0x52, 0x62, 0xef, 0xcb, 0xc3, 0x21, 0xec, 0x05, 0xe1, 0xb1, 0x28,
+		0x8b, 0x3f, 0xba, 0x5f, 0x8b, 0xbb, 0x21, 0xb7, 0xdb, 0xa3, 0x0e, 0x14, 0xbe, 0x25, 0xc1, 0x91,
+		0xa1, 0xf9, 0xd9, 0xad, 0xd4, 0x73, 0x80, 0x2c, 0x5f, 0x26, 0xff, 0x0c, 0x30, 0xab, 0xdc, 0xbe,
+		0xa6, 0xfa, 0x19, 0x2b, 0x9c, 0xf1, 0xa3, 0xaa, 0x2a, 0x3b, 0xb5, 0xff, 0x6d, 0x09, 0xe6, 0xfc,
+		0xba, 0xb9, 0x35, 0xbc, 0x08, 0x53, 0x7e, 0xd5, 0x78, 0xdd, 0x16, 0xa3, 0xea, 0xc6, 0xab, 0x15,
+		0xc0, 0xa2, 0x4b, 0x5e, 0x34, 0x24, 0xbe, 0xb9, 0x35, 0x8e, 0x89, 0x84, 0x2e, 0xe1, 0xa8, 0x28,
+		0x41, 0x1b, 0xe8, 0x7f, 0xc7, 0x21, 0xb1, 0x66, 0x9a, 0x5d, 0xf4, 0x19, 0x09, 0x66, 0x0c, 0xd3,
+		0x51, 0xc9, 0xbc, 0x8d, 0x3b, 0x2a, 0xdf, 0x6f, 0x97, 0xa2, 0x4c, 0xa7, 0x11, 0xd2, 0xef, 0xde,
+		0x5e, 0x18, 0xc6, 0x7a, 0x71, 0xe9, 0x50, 0xd6, 0xfe, 0x16, 0x04, 0x39, 0xc3, 0x74, 0xca, 0x14,
+		0x4e, 0xbb, 0xae, 0x8d, 0x3e, 0x26, 0xc1, 0x74, 0x50, 0xcd, 0xc8, 0x16, 0xbe, 0xc6, 0xd5, 0x9c,
+		0x0e, 0xab, 0x38, 0xe7, 0xc5, 0x2e, 0x77, 0xa9, 0xde, 0xd4, 0x86, 0x5f, 0xb7, 0x4f, 0x49, 0x30,
+		0xe7, 0x0f, 0x63, 0xd4, 0x9b, 0x74, 0x98, 0x1c, 0x6f, 0x97, 0x47, 0xe1, 0x41, 0xc2, 0xd1, 0xe1,
+		0x0d, 0x5e, 0xc1, 0xb3, 0xef, 0x15, 0x0c, 0xf2, 0xed, 0x0b, 0x5f, 0x61, 0x1c, 0xc5, 0x14, 0x71,
+		0xda, 0xbf, 0x78, 0x6d, 0x41, 0x3a, 0xf1, 0x25, 0x09, 0xc0, 0x3b, 0x70, 0x41, 0x8f, 0xc0, 0xe1,
+		0xf2, 0x6a, 0xa3, 0xaa, 0x36, 0x5b, 0xa5, 0xd6, 0x7a, 0x33, 0x78, 0x95, 0x6e, 0x3e, 0xf7, 0x81,
+		0x57, 0x17, 0x33, 0xeb, 0x86, 0xdd, 0xc7, 0x6d, 0xfa, 0x3d, 0x66, 0xf4, 0x00, 0xcc, 0x05, 0xa5,
+		0xc9, 0x13, 0xf9, 0xca, 0xcf, 0xfc, 0xd4, 0x07, 0x5e, 0x5d, 0x4c, 0xb1, 0x9d, 0x14, 0xdc, 0x41,
+		0xc7, 0xe1, 0xe0, 0xb0, 0x1c, 0xb9, 0x86, 0x17, 0x9b, 0x9f, 0xfe, 0xc0, 0xab, 0x8b, 0x69, 0x77,
+		0xcb, 0x05, 0x15, 0x00, 0xf9, 0x25, 0x39, 0x5f, 0x7c, 0x1e, 0x3e, 0xf0, 0xea, 0x62, 0x92, 0xf9,
+		0xc0, 0x7c, 0xe2, 0xa5, 0x5f, 0x3b, 0x76, 0xe0, 0xc4, 0xbb, 0x01, 0xea, 0xc6, 0xa6, 0xa5, 0xd1,
+		0xaf, 0x27, 0x90, 0x8f, 0x38, 0xd5, 0x1b, 0xe7, 0x95, 0x52, 0x85, 0x7f, 0xde, 0xc2, 0xa7, 0x76,
+		0x28, 0x8f, 0xfd, 0x69, 0x1d, 0xb5, 0x59, 0x5f, 0x6e, 0xb0, 0x0f, 0x50, 0x04, 0xf2, 0xae, 0xb0,
+		0x4f, 0xc0, 0xc6, 0xca, 0x17, 0x76, 0xbd, 0xe2, 0xb2, 0xe4, 0x33, 0x3e, 0xeb, 0x7e, 0x27, 0xbb,
+		0xda, 0x86, 0xcd, 0x7f, 0x9f, 0xba, 0xe5, 0x1d, 0xce, 0x07, 0xae, 0xbb, 0xfc, 0xdf, 0x01, 0x00,
+		0x5a, 0x33, 0x53, 0x52, 0x56, 0x72, 0x00, 0x00,
 	}
 	r := bytes.NewReader(gzipped)
 	gzipr, err := compress_gzip.NewReader(r)
@@ -2151,6 +2275,20 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.MinCommissionRate.Equal(that1.MinCommissionRate) {
 		return false
 	}
+	if len(this.KeyRotationFee) != len(that1.KeyRotationFee) {
+		return false
+	}
+	for i := range this.KeyRotationFee {
+		if !this.KeyRotationFee[i].Equal(&that1.KeyRotationFee[i]) {
+			return false
+		}
+	}
+	if !this.GlobalLiquidStakingCap.Equal(that1.GlobalLiquidStakingCap) {
+		return false
+	}
+	if !this.ValidatorLiquidStakingCap.Equal(that1.ValidatorLiquidStakingCap) {
+		return false
+	}
 	return true
 }
 func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
@@ -3049,6 +3187,40 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.ValidatorLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.ValidatorLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.GlobalLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.GlobalLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.KeyRotationFee) > 0 {
+		for iNdEx := len(m.KeyRotationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.KeyRotationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	{
 		size := m.MinCommissionRate.Size()
 		i -= size
@@ -3099,6 +3271,129 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ConsPubKeyRotationHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsPubKeyRotationHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsPubKeyRotationHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintStaking(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x2a
+	if m.Height != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NewConsPubkey != nil {
+		{
+			size, err := m.NewConsPubkey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.OldConsPubkey != nil {
+		{
+			size, err := m.OldConsPubkey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenizeShareRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenizeShareRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenizeShareRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DelegationResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3679,6 +3974,68 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.MinCommissionRate.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	if len(m.KeyRotationFee) > 0 {
+		for _, e := range m.KeyRotationFee {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	l = m.GlobalLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.ValidatorLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *ConsPubKeyRotationHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.OldConsPubkey != nil {
+		l = m.OldConsPubkey.Size()
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.NewConsPubkey != nil {
+		l = m.NewConsPubkey.Size()
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovStaking(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovStaking(uint64(l))
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TokenizeShareRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovStaking(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
 	return n
 }
 
@@ -6317,6 +6674,481 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyRotationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyRotationFee = append(m.KeyRotationFee, types1.Coin{})
+			if err := m.KeyRotationFee[len(m.KeyRotationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalLiquidStakingCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GlobalLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorLiquidStakingCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsPubKeyRotationHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsPubKeyRotationHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsPubKeyRotationHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldConsPubkey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OldConsPubkey == nil {
+				m.OldConsPubkey = &types.Any{}
+			}
+			if err := m.OldConsPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewConsPubkey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewConsPubkey == nil {
+				m.NewConsPubkey = &types.Any{}
+			}
+			if err := m.NewConsPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, types1.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenizeShareRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenizeShareRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenizeShareRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
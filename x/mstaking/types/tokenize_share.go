@@ -0,0 +1,51 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// TokenizeShareModuleAccountPrefix is the derivation key prefix of the
+// module accounts holding the tokenized delegations.
+const TokenizeShareModuleAccountPrefix = "tokenize_share"
+
+// NewTokenizeShareRecord creates a new TokenizeShareRecord instance.
+func NewTokenizeShareRecord(id uint64, owner, validator string) TokenizeShareRecord {
+	return TokenizeShareRecord{
+		Id:        id,
+		Owner:     owner,
+		Validator: validator,
+	}
+}
+
+// GetModuleAddress returns the address of the module account holding the
+// tokenized delegation of the record.
+func (r TokenizeShareRecord) GetModuleAddress() sdk.AccAddress {
+	return address.Module(ModuleName, []byte(TokenizeShareModuleAccountPrefix), sdk.Uint64ToBigEndian(r.Id))
+}
+
+// GetShareTokenDenom returns the denom of the share tokens of the record,
+// which is "{validator}/{id}".
+func (r TokenizeShareRecord) GetShareTokenDenom() string {
+	return fmt.Sprintf("%s/%d", r.Validator, r.Id)
+}
+
+// ParseShareTokenDenom returns the validator address and the record id of a
+// share token denom.
+func ParseShareTokenDenom(denom string) (string, uint64, error) {
+	validator, idStr, found := strings.Cut(denom, "/")
+	if !found {
+		return "", 0, ErrInvalidShareTokenDenom
+	}
+
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		return "", 0, ErrInvalidShareTokenDenom
+	}
+
+	return validator, id, nil
+}
@@ -20,6 +20,11 @@ var (
 	_ sdk.Msg                            = &MsgBeginRedelegate{}
 	_ sdk.Msg                            = &MsgCancelUnbondingDelegation{}
 	_ sdk.Msg                            = &MsgUpdateParams{}
+	_ sdk.Msg                            = &MsgRotateConsPubKey{}
+	_ codectypes.UnpackInterfacesMessage = (*MsgRotateConsPubKey)(nil)
+	_ sdk.Msg                            = &MsgTokenizeShares{}
+	_ sdk.Msg                            = &MsgRedeemTokensForShares{}
+	_ sdk.Msg                            = &MsgTransferTokenizeShareRecord{}
 )
 
 // NewMsgCreateValidator creates a new MsgCreateValidator instance.
@@ -288,3 +293,133 @@ func (msg MsgUpdateParams) Validate(accAddrCodec address.Codec) error {
 
 	return msg.Params.Validate()
 }
+
+// NewMsgRotateConsPubKey creates a new MsgRotateConsPubKey instance.
+//
+//nolint:interfacer
+func NewMsgRotateConsPubKey(valAddr string, pubKey cryptotypes.PubKey) (*MsgRotateConsPubKey, error) {
+	var pkAny *codectypes.Any
+	if pubKey != nil {
+		var err error
+		if pkAny, err = codectypes.NewAnyWithValue(pubKey); err != nil {
+			return nil, err
+		}
+	}
+	return &MsgRotateConsPubKey{
+		ValidatorAddress: valAddr,
+		NewPubkey:        pkAny,
+	}, nil
+}
+
+// Validate implements the sdk.Msg interface.
+func (msg MsgRotateConsPubKey) Validate(valAddrCodec address.Codec) error {
+	if valAddr, err := valAddrCodec.StringToBytes(msg.ValidatorAddress); err != nil {
+		return err
+	} else if len(valAddr) == 0 {
+		return ErrEmptyValidatorAddr
+	}
+
+	if msg.NewPubkey == nil {
+		return ErrEmptyValidatorPubKey
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgRotateConsPubKey) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(msg.NewPubkey, &pubKey)
+}
+
+// NewMsgTokenizeShares creates a new MsgTokenizeShares instance.
+//
+//nolint:interfacer
+func NewMsgTokenizeShares(delAddr, valAddr string, amount sdk.Coin, owner string) *MsgTokenizeShares {
+	return &MsgTokenizeShares{
+		DelegatorAddress:    delAddr,
+		ValidatorAddress:    valAddr,
+		Amount:              amount,
+		TokenizedShareOwner: owner,
+	}
+}
+
+// Validate implements the sdk.Msg interface.
+func (msg MsgTokenizeShares) Validate(accAddrCodec address.Codec, valAddrCodec address.Codec) error {
+	if addr, err := accAddrCodec.StringToBytes(msg.DelegatorAddress); err != nil {
+		return err
+	} else if len(addr) == 0 {
+		return ErrEmptyDelegatorAddr
+	}
+
+	if addr, err := valAddrCodec.StringToBytes(msg.ValidatorAddress); err != nil {
+		return err
+	} else if len(addr) == 0 {
+		return ErrEmptyValidatorAddr
+	}
+
+	if _, err := accAddrCodec.StringToBytes(msg.TokenizedShareOwner); err != nil {
+		return err
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return errors.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"invalid shares amount",
+		)
+	}
+
+	return nil
+}
+
+// NewMsgRedeemTokensForShares creates a new MsgRedeemTokensForShares instance.
+//
+//nolint:interfacer
+func NewMsgRedeemTokensForShares(delAddr string, amount sdk.Coin) *MsgRedeemTokensForShares {
+	return &MsgRedeemTokensForShares{
+		DelegatorAddress: delAddr,
+		Amount:           amount,
+	}
+}
+
+// Validate implements the sdk.Msg interface.
+func (msg MsgRedeemTokensForShares) Validate(accAddrCodec address.Codec) error {
+	if addr, err := accAddrCodec.StringToBytes(msg.DelegatorAddress); err != nil {
+		return err
+	} else if len(addr) == 0 {
+		return ErrEmptyDelegatorAddr
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return errors.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"invalid shares amount",
+		)
+	}
+
+	return nil
+}
+
+// NewMsgTransferTokenizeShareRecord creates a new MsgTransferTokenizeShareRecord instance.
+//
+//nolint:interfacer
+func NewMsgTransferTokenizeShareRecord(recordId uint64, sender, newOwner string) *MsgTransferTokenizeShareRecord {
+	return &MsgTransferTokenizeShareRecord{
+		TokenizeShareRecordId: recordId,
+		Sender:                sender,
+		NewOwner:              newOwner,
+	}
+}
+
+// Validate implements the sdk.Msg interface.
+func (msg MsgTransferTokenizeShareRecord) Validate(accAddrCodec address.Codec) error {
+	if _, err := accAddrCodec.StringToBytes(msg.Sender); err != nil {
+		return err
+	}
+
+	if _, err := accAddrCodec.StringToBytes(msg.NewOwner); err != nil {
+		return err
+	}
+
+	return nil
+}
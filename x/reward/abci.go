@@ -64,6 +64,11 @@ func BeginBlocker(ctx context.Context, k keeper.Keeper) error {
 		return err
 	}
 
+	// release the release schedules from the rest of the reward balance
+	if _, err := k.ReleaseBySchedules(ctx, params, lastReleaseTimestamp, remainRewardAmt.Sub(blockProvisionAmt)); err != nil {
+		return err
+	}
+
 	// update release rate on every year
 	if sdkCtx.BlockTime().Sub(lastDilutionTimestamp) >= params.DilutionPeriod {
 		// dilute release rate
@@ -2,15 +2,20 @@ package cli
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
 
 	"github.com/initia-labs/initia/x/reward/types"
 )
 
+// FlagBlockTime is the flag of the expected block interval of a release projection.
+const FlagBlockTime = "block-time"
+
 // GetQueryCmd returns the cli query commands for the minting module.
 func GetQueryCmd() *cobra.Command {
 	mintingQueryCmd := &cobra.Command{
@@ -25,6 +30,10 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryParams(),
 		GetCmdQueryAnnualProvisions(),
 		GetCmdQueryLastDilutionTimestamp(),
+		GetCmdQueryProjectedRelease(),
+		GetCmdQueryRemainingReward(),
+		GetCmdQueryReleaseForecast(),
+		GetCmdQueryExhaustionTime(),
 	)
 
 	return mintingQueryCmd
@@ -117,3 +126,172 @@ func GetCmdQueryLastDilutionTimestamp() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryProjectedRelease implements a command to return the release
+// projected until the given time.
+func GetCmdQueryProjectedRelease() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "projected-release [time]",
+		Short: "Query the release of every release schedule projected until the given RFC3339 time",
+		Example: fmt.Sprintf(
+			"%s query reward projected-release 2030-01-01T00:00:00Z --block-time 2s",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			projectionTime, err := time.Parse(time.RFC3339, args[0])
+			if err != nil {
+				return err
+			}
+
+			blockTime, err := cmd.Flags().GetDuration(FlagBlockTime)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ProjectedRelease(cmd.Context(), &types.QueryProjectedReleaseRequest{
+				Time:      projectionTime,
+				BlockTime: blockTime,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Duration(FlagBlockTime, 0, "Expected block interval to project fixed per block schedules with")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryRemainingReward implements a command to return the reward
+// balance left to release.
+func GetCmdQueryRemainingReward() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remaining-reward",
+		Short: "Query the reward module balance left to release",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RemainingReward(cmd.Context(), &types.QueryRemainingRewardRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Remaining)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryReleaseForecast implements a command to return the release
+// projected for every day, month or year until the given horizon.
+func GetCmdQueryReleaseForecast() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "release-forecast [day|month|year] [horizon]",
+		Short: "Query the release projected for every day, month or year until the given RFC3339 horizon",
+		Example: fmt.Sprintf(
+			"%s query reward release-forecast month 2030-01-01T00:00:00Z",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			var interval types.ForecastInterval
+			switch args[0] {
+			case "day":
+				interval = types.ForecastIntervalDay
+			case "month":
+				interval = types.ForecastIntervalMonth
+			case "year":
+				interval = types.ForecastIntervalYear
+			default:
+				return fmt.Errorf("invalid interval %s; expected day, month or year", args[0])
+			}
+
+			horizon, err := time.Parse(time.RFC3339, args[1])
+			if err != nil {
+				return err
+			}
+
+			blockTime, err := cmd.Flags().GetDuration(FlagBlockTime)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ReleaseForecast(cmd.Context(), &types.QueryReleaseForecastRequest{
+				Interval:  interval,
+				Horizon:   horizon,
+				BlockTime: blockTime,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Duration(FlagBlockTime, 0, "Expected block interval to project fixed per block schedules with")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryExhaustionTime implements a command to return the estimated
+// time the remaining reward runs out.
+func GetCmdQueryExhaustionTime() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exhaustion-time",
+		Short: "Query the estimated time the remaining reward runs out",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			blockTime, err := cmd.Flags().GetDuration(FlagBlockTime)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ExhaustionTime(cmd.Context(), &types.QueryExhaustionTimeRequest{
+				BlockTime: blockTime,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Duration(FlagBlockTime, 0, "Expected block interval to project fixed per block schedules with")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
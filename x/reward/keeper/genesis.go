@@ -8,6 +8,9 @@ import (
 
 // InitGenesis new mint genesis
 func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) {
+	if err := k.ValidateScheduleDestinations(data.Params.ReleaseSchedules); err != nil {
+		panic(err)
+	}
 
 	if err := k.SetParams(ctx, data.Params); err != nil {
 		panic(err)
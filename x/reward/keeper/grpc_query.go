@@ -2,6 +2,10 @@ package keeper
 
 import (
 	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/initia-labs/initia/x/reward/types"
 
@@ -39,3 +43,104 @@ func (qs QueryServer) LastDilutionTimestamp(c context.Context, _ *types.QueryLas
 	lastDilutionTimestamp, err := qs.GetLastDilutionTimestamp(ctx)
 	return &types.QueryLastDilutionTimestampResponse{LastDilutionTimestamp: lastDilutionTimestamp}, err
 }
+
+// ProjectedRelease returns the release projected until the requested time.
+func (qs QueryServer) ProjectedRelease(c context.Context, req *types.QueryProjectedReleaseRequest) (*types.QueryProjectedReleaseResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if !req.Time.After(ctx.BlockTime()) {
+		return nil, status.Error(codes.InvalidArgument, "projection time must be after the current block time")
+	}
+	if req.Time.Sub(ctx.BlockTime()) > types.MaxProjectionHorizon {
+		return nil, status.Errorf(codes.InvalidArgument, "projection time must be within %s", types.MaxProjectionHorizon)
+	}
+
+	blockTime, err := projectionBlockTime(req.BlockTime)
+	if err != nil {
+		return nil, err
+	}
+
+	projections, total, err := qs.ProjectRelease(ctx, req.Time, blockTime)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryProjectedReleaseResponse{Projections: projections, Total: total}, nil
+}
+
+// RemainingReward returns the reward module balance left to release.
+func (qs QueryServer) RemainingReward(c context.Context, _ *types.QueryRemainingRewardRequest) (*types.QueryRemainingRewardResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params, err := qs.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	remaining := qs.GetRemainRewardAmount(ctx, params.RewardDenom)
+	return &types.QueryRemainingRewardResponse{Remaining: sdk.NewCoin(params.RewardDenom, remaining)}, nil
+}
+
+// ReleaseForecast returns the release projected for every interval until the horizon.
+func (qs QueryServer) ReleaseForecast(c context.Context, req *types.QueryReleaseForecastRequest) (*types.QueryReleaseForecastResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := req.Interval.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if !req.Horizon.After(ctx.BlockTime()) {
+		return nil, status.Error(codes.InvalidArgument, "horizon must be after the current block time")
+	}
+	if req.Horizon.Sub(ctx.BlockTime()) > types.MaxProjectionHorizon {
+		return nil, status.Errorf(codes.InvalidArgument, "horizon must be within %s", types.MaxProjectionHorizon)
+	}
+
+	blockTime, err := projectionBlockTime(req.BlockTime)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := qs.Keeper.ReleaseForecast(ctx, req.Interval, req.Horizon, blockTime)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryReleaseForecastResponse{Entries: entries}, nil
+}
+
+// ExhaustionTime returns the estimated time the remaining reward runs out.
+func (qs QueryServer) ExhaustionTime(c context.Context, req *types.QueryExhaustionTimeRequest) (*types.QueryExhaustionTimeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	blockTime, err := projectionBlockTime(req.BlockTime)
+	if err != nil {
+		return nil, err
+	}
+
+	exhaustionTime, exhausted, err := qs.Keeper.ExhaustionTime(sdk.UnwrapSDKContext(c), blockTime)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryExhaustionTimeResponse{Exhausted: exhausted, ExhaustionTime: exhaustionTime}, nil
+}
+
+// projectionBlockTime returns the block time to project fixed per block
+// schedules with, defaulting a zero block time.
+func projectionBlockTime(blockTime time.Duration) (time.Duration, error) {
+	if blockTime < 0 {
+		return 0, status.Error(codes.InvalidArgument, "block time must be non-negative")
+	} else if blockTime == 0 {
+		return types.DefaultProjectionBlockTime, nil
+	}
+
+	return blockTime, nil
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
 
 	"github.com/initia-labs/initia/x/reward/keeper"
@@ -46,3 +47,80 @@ func Test_GRPCLastDilutionTimestamp(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, now, lastDilutionTimestamp.LastDilutionTimestamp)
 }
+
+func Test_GRPCReleaseForecast(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	params, err := input.RewardKeeper.GetParams(ctx)
+	require.NoError(t, err)
+
+	rewardModuleAddr := input.AccountKeeper.GetModuleAddress(types.ModuleName)
+	input.Faucet.Fund(ctx, rewardModuleAddr, sdk.NewCoin(params.RewardDenom, math.NewInt(1_000_000)))
+
+	now := ctx.BlockTime()
+	require.NoError(t, input.RewardKeeper.SetLastDilutionTimestamp(ctx, now))
+
+	params.ReleaseEnabled = true
+	params.ReleaseRate = math.LegacyZeroDec()
+	params.ReleaseSchedules = []types.ReleaseSchedule{
+		{
+			Name:           "fixed",
+			ScheduleType:   types.ScheduleTypeFixedPerBlock,
+			StartTime:      now,
+			AmountPerBlock: math.NewInt(100),
+		},
+	}
+	require.NoError(t, input.RewardKeeper.SetParams(ctx, params))
+
+	qs := keeper.NewQueryServerImpl(&input.RewardKeeper)
+	remainRes, err := qs.RemainingReward(ctx, &types.QueryRemainingRewardRequest{})
+	require.NoError(t, err)
+	remain := input.RewardKeeper.GetRemainRewardAmount(ctx, params.RewardDenom)
+	require.Equal(t, sdk.NewCoin(params.RewardDenom, remain), remainRes.Remaining)
+
+	// a block per minute releases 144_000 a day
+	forecastRes, err := qs.ReleaseForecast(ctx, &types.QueryReleaseForecastRequest{
+		Interval:  types.ForecastIntervalDay,
+		Horizon:   now.Add(60 * time.Hour),
+		BlockTime: time.Minute,
+	})
+	require.NoError(t, err)
+	require.Len(t, forecastRes.Entries, 3)
+	require.Equal(t, math.NewInt(144_000), forecastRes.Entries[0].Amount)
+	require.Equal(t, math.NewInt(144_000), forecastRes.Entries[1].Amount)
+	require.Equal(t, math.NewInt(72_000), forecastRes.Entries[2].Amount)
+	require.Equal(t, now.Add(60*time.Hour), forecastRes.Entries[2].EndTime)
+	require.Equal(t, math.NewInt(360_000), forecastRes.Entries[2].CumulativeAmount)
+	require.Equal(t, remain.SubRaw(360_000), forecastRes.Entries[2].Remaining)
+
+	_, err = qs.ReleaseForecast(ctx, &types.QueryReleaseForecastRequest{
+		Horizon: now.Add(time.Hour),
+	})
+	require.Error(t, err)
+
+	// too many entries
+	_, err = qs.ReleaseForecast(ctx, &types.QueryReleaseForecastRequest{
+		Interval: types.ForecastIntervalDay,
+		Horizon:  now.Add(10 * types.AnnualPeriod),
+	})
+	require.Error(t, err)
+
+	exhaustionRes, err := qs.ExhaustionTime(ctx, &types.QueryExhaustionTimeRequest{BlockTime: time.Minute})
+	require.NoError(t, err)
+	require.True(t, exhaustionRes.Exhausted)
+
+	_, total, err := input.RewardKeeper.ProjectRelease(ctx, exhaustionRes.ExhaustionTime, time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, remain, total)
+
+	_, total, err = input.RewardKeeper.ProjectRelease(ctx, exhaustionRes.ExhaustionTime.Add(-time.Second), time.Minute)
+	require.NoError(t, err)
+	require.True(t, total.LT(remain))
+
+	// nothing is released once the release is disabled
+	params.ReleaseEnabled = false
+	require.NoError(t, input.RewardKeeper.SetParams(ctx, params))
+	exhaustionRes, err = qs.ExhaustionTime(ctx, &types.QueryExhaustionTimeRequest{})
+	require.NoError(t, err)
+	require.False(t, exhaustionRes.Exhausted)
+}
@@ -6,7 +6,6 @@ import (
 	"github.com/initia-labs/initia/x/reward/types"
 
 	"cosmossdk.io/errors"
-
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
@@ -31,6 +30,10 @@ func (ms msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams
 		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
 	}
 
+	if err := ms.ValidateScheduleDestinations(msg.Params.ReleaseSchedules); err != nil {
+		return nil, err
+	}
+
 	// store params
 	if err := ms.SetParams(ctx, msg.Params); err != nil {
 		return nil, err
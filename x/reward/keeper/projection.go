@@ -0,0 +1,164 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/initia-labs/initia/x/reward/types"
+)
+
+// ProjectRelease projects the release of the release rate of the params and
+// of every release schedule from the current block time until `to`. Fixed per
+// block schedules are projected with the given block time. The returned
+// total is capped by the remaining reward module balance.
+func (k Keeper) ProjectRelease(ctx context.Context, to time.Time, blockTime time.Duration) ([]types.ReleaseProjection, math.Int, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, math.ZeroInt(), err
+	}
+
+	lastDilutionTimestamp, err := k.GetLastDilutionTimestamp(ctx)
+	if err != nil {
+		return nil, math.ZeroInt(), err
+	}
+
+	now := sdk.UnwrapSDKContext(ctx).BlockTime()
+	supply := k.bankKeeper.GetSupply(ctx, params.RewardDenom).Amount
+	remain := k.GetRemainRewardAmount(ctx, params.RewardDenom)
+	dilutionFactor := math.LegacyOneDec().Sub(params.DilutionRate)
+
+	projections := make([]types.ReleaseProjection, 0, len(params.ReleaseSchedules)+1)
+	projections = append(projections, types.ReleaseProjection{
+		Amount: types.GeometricRelease(
+			params.ReleaseRate, dilutionFactor,
+			lastDilutionTimestamp, params.DilutionPeriod,
+			now, to, supply,
+		).TruncateInt(),
+		ReleaseRate: types.GeometricRateAt(params.ReleaseRate, dilutionFactor, lastDilutionTimestamp, params.DilutionPeriod, to),
+	})
+
+	for _, schedule := range params.ReleaseSchedules {
+		projection := types.ReleaseProjection{
+			Name:        schedule.Name,
+			Destination: schedule.Destination,
+			ReleaseRate: schedule.ReleaseRateAt(to),
+		}
+
+		if schedule.ScheduleType == types.ScheduleTypeFixedPerBlock {
+			blocks := int64(schedule.ActiveDuration(now, to) / blockTime)
+			projection.Amount = schedule.AmountPerBlock.MulRaw(blocks)
+		} else {
+			projection.Amount = schedule.Release(now, to, supply).TruncateInt()
+		}
+
+		projections = append(projections, projection)
+	}
+
+	total := math.ZeroInt()
+	for i := range projections {
+		if !params.ReleaseEnabled {
+			projections[i].Amount = math.ZeroInt()
+		}
+
+		// releases are capped in order by the remaining balance
+		projections[i].Amount = math.MinInt(projections[i].Amount, remain.Sub(total))
+		total = total.Add(projections[i].Amount)
+	}
+
+	return projections, total, nil
+}
+
+// ReleaseForecast returns the projected release of every interval from the
+// current block time until the horizon.
+func (k Keeper) ReleaseForecast(
+	ctx context.Context,
+	interval types.ForecastInterval,
+	horizon time.Time,
+	blockTime time.Duration,
+) ([]types.ReleaseForecastEntry, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	remain := k.GetRemainRewardAmount(ctx, params.RewardDenom)
+	cumulative := math.ZeroInt()
+
+	var entries []types.ReleaseForecastEntry
+	for start := sdk.UnwrapSDKContext(ctx).BlockTime(); start.Before(horizon); {
+		if len(entries) >= types.MaxForecastEntries {
+			return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "forecast exceeds %d entries", types.MaxForecastEntries)
+		}
+
+		end := interval.Next(start)
+		if end.After(horizon) {
+			end = horizon
+		}
+
+		_, total, err := k.ProjectRelease(ctx, end, blockTime)
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, types.ReleaseForecastEntry{
+			StartTime:        start,
+			EndTime:          end,
+			Amount:           total.Sub(cumulative),
+			CumulativeAmount: total,
+			Remaining:        remain.Sub(total),
+		})
+
+		cumulative = total
+		start = end
+	}
+
+	return entries, nil
+}
+
+// ExhaustionTime estimates the time the remaining reward runs out by
+// searching the projected release to a second. It returns false when the
+// reward does not run out within the maximum projection horizon.
+func (k Keeper) ExhaustionTime(ctx context.Context, blockTime time.Duration) (time.Time, bool, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+
+	now := sdk.UnwrapSDKContext(ctx).BlockTime()
+	remain := k.GetRemainRewardAmount(ctx, params.RewardDenom)
+	if !remain.IsPositive() {
+		return now, true, nil
+	}
+
+	exhausted := func(elapsed time.Duration) (bool, error) {
+		_, total, err := k.ProjectRelease(ctx, now.Add(elapsed), blockTime)
+		return total.GTE(remain), err
+	}
+
+	lo, hi := time.Duration(0), types.MaxProjectionHorizon
+	if ok, err := exhausted(hi); err != nil || !ok {
+		return time.Time{}, false, err
+	}
+
+	for hi-lo > time.Second {
+		mid := lo + (hi-lo)/2
+		ok, err := exhausted(mid)
+		if err != nil {
+			return time.Time{}, false, err
+		}
+
+		if ok {
+			hi = mid
+		} else {
+			lo = mid
+		}
+	}
+
+	return now.Add(hi), true, nil
+}
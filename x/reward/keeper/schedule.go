@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/initia-labs/initia/x/reward/types"
+)
+
+// ReleaseBySchedules releases the amount every release schedule releases
+// from `from` until the current block time, in order and capped by the
+// remaining amount. It returns the total released amount.
+func (k Keeper) ReleaseBySchedules(ctx context.Context, params types.Params, from time.Time, remain math.Int) (math.Int, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	blockTime := sdkCtx.BlockTime()
+	supply := k.bankKeeper.GetSupply(ctx, params.RewardDenom).Amount
+
+	released := math.ZeroInt()
+	for _, schedule := range params.ReleaseSchedules {
+		// a destination module account can be missing when it was set by
+		// genesis or removed by an upgrade; skip it rather than halt the chain.
+		if !k.scheduleDestinationExists(schedule.Destination) {
+			k.Logger(ctx).Error("skipping release schedule with unknown destination", "schedule", schedule.Name, "destination", schedule.Destination)
+			continue
+		}
+
+		var amount math.Int
+		if schedule.ScheduleType == types.ScheduleTypeFixedPerBlock {
+			// a block interval the schedule is only partly active for, because
+			// the schedule starts or ends within it, releases that share only.
+			amount = math.ZeroInt()
+			if elapsed := blockTime.Sub(from); elapsed > 0 {
+				amount = schedule.AmountPerBlock.
+					MulRaw(int64(schedule.ActiveDuration(from, blockTime))).
+					QuoRaw(int64(elapsed))
+			}
+		} else {
+			amount = schedule.Release(from, blockTime, supply).TruncateInt()
+		}
+
+		amount = math.MinInt(amount, remain.Sub(released))
+		if !amount.IsPositive() {
+			continue
+		}
+
+		if err := k.releaseTo(ctx, schedule.Destination, sdk.NewCoins(sdk.NewCoin(params.RewardDenom, amount))); err != nil {
+			return math.ZeroInt(), err
+		}
+		released = released.Add(amount)
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeScheduleRelease,
+				sdk.NewAttribute(types.AttributeKeySchedule, schedule.Name),
+				sdk.NewAttribute(types.AttributeKeyDestination, schedule.Destination),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			),
+		)
+	}
+
+	return released, nil
+}
+
+// ValidateScheduleDestinations checks that the module account of every release
+// schedule destination exists.
+func (k Keeper) ValidateScheduleDestinations(schedules []types.ReleaseSchedule) error {
+	for _, schedule := range schedules {
+		if !k.scheduleDestinationExists(schedule.Destination) {
+			return errors.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s of schedule %s does not exist", schedule.Destination, schedule.Name)
+		}
+	}
+
+	return nil
+}
+
+// scheduleDestinationExists returns whether released coins can be sent to the destination.
+func (k Keeper) scheduleDestinationExists(destination string) bool {
+	if destination == "" || destination == types.DestinationCommunityPool {
+		return true
+	}
+
+	return k.accKeeper.GetModuleAddress(destination) != nil
+}
+
+// releaseTo sends released reward coins to the destination of a release schedule.
+func (k Keeper) releaseTo(ctx context.Context, destination string, coins sdk.Coins) error {
+	switch destination {
+	case "":
+		return k.AddCollectedFees(ctx, coins)
+	case types.DestinationCommunityPool:
+		return k.communityPoolKeeper.FundCommunityPool(ctx, coins, k.accKeeper.GetModuleAddress(types.ModuleName))
+	default:
+		return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, destination, coins)
+	}
+}
@@ -0,0 +1,211 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/initia-labs/initia/x/reward/keeper"
+	"github.com/initia-labs/initia/x/reward/types"
+)
+
+func annualRelease(rate math.LegacyDec, elapsed time.Duration, supply math.Int) math.Int {
+	return rate.MulInt64(int64(elapsed)).MulInt(supply).QuoInt64(int64(types.AnnualPeriod)).TruncateInt()
+}
+
+func Test_ReleaseSchedule_Release(t *testing.T) {
+	start := time.Date(2020, time.April, 22, 12, 0, 0, 0, time.UTC)
+	supply := math.NewInt(1_000_000_000_000)
+
+	linear := types.ReleaseSchedule{
+		Name:         "linear",
+		ScheduleType: types.ScheduleTypePiecewiseLinear,
+		Points: []types.SchedulePoint{
+			{Time: start, ReleaseRate: math.LegacyZeroDec()},
+			{Time: start.Add(10 * time.Hour), ReleaseRate: math.LegacyNewDecWithPrec(1, 1)},
+		},
+	}
+	require.NoError(t, linear.Validate())
+	require.Equal(t, math.LegacyNewDecWithPrec(5, 2), linear.ReleaseRateAt(start.Add(5*time.Hour)))
+	require.Equal(t, math.LegacyNewDecWithPrec(1, 1), linear.ReleaseRateAt(start.Add(20*time.Hour)))
+	require.Equal(t,
+		annualRelease(math.LegacyNewDecWithPrec(5, 2), 10*time.Hour, supply),
+		linear.Release(start.Add(-time.Hour), start.Add(10*time.Hour), supply).TruncateInt(),
+	)
+
+	table := types.ReleaseSchedule{
+		Name:         "table",
+		ScheduleType: types.ScheduleTypeTable,
+		EndTime:      start.Add(2 * time.Hour),
+		Points: []types.SchedulePoint{
+			{Time: start, ReleaseRate: math.LegacyNewDecWithPrec(1, 2)},
+			{Time: start.Add(time.Hour), ReleaseRate: math.LegacyNewDecWithPrec(2, 2)},
+		},
+	}
+	require.NoError(t, table.Validate())
+	require.Equal(t, math.LegacyNewDecWithPrec(2, 2), table.ReleaseRateAt(start.Add(time.Hour)))
+	require.True(t, table.ReleaseRateAt(start.Add(2*time.Hour)).IsZero())
+	require.Equal(t,
+		annualRelease(math.LegacyNewDecWithPrec(3, 2), time.Hour, supply),
+		table.Release(start, start.Add(3*time.Hour), supply).TruncateInt(),
+	)
+
+	halving := types.ReleaseSchedule{
+		Name:          "halving",
+		ScheduleType:  types.ScheduleTypeHalving,
+		StartTime:     start,
+		ReleaseRate:   math.LegacyNewDecWithPrec(1, 1),
+		HalvingPeriod: time.Hour,
+	}
+	require.NoError(t, halving.Validate())
+	require.Equal(t, math.LegacyNewDecWithPrec(25, 3), halving.ReleaseRateAt(start.Add(150*time.Minute)))
+
+	// 0.1 + 0.05 + 0.025
+	require.Equal(t,
+		annualRelease(math.LegacyNewDecWithPrec(175, 3), time.Hour, supply),
+		halving.Release(start, start.Add(3*time.Hour), supply).TruncateInt(),
+	)
+
+	// points out of order
+	table.Points[1].Time = start
+	require.Error(t, table.Validate())
+
+	// missing halving period
+	halving.HalvingPeriod = 0
+	require.Error(t, halving.Validate())
+}
+
+func Test_ReleaseBySchedules(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	params, err := input.RewardKeeper.GetParams(ctx)
+	require.NoError(t, err)
+
+	rewardModuleAddr := input.AccountKeeper.GetModuleAddress(types.ModuleName)
+	input.Faucet.Fund(ctx, rewardModuleAddr, sdk.NewCoin(params.RewardDenom, math.NewInt(1_000_000_000)))
+	supply := input.BankKeeper.GetSupply(ctx, params.RewardDenom).Amount
+
+	start := ctx.BlockTime()
+	params.ReleaseEnabled = true
+	params.ReleaseRate = math.LegacyZeroDec()
+	params.ReleaseSchedules = []types.ReleaseSchedule{
+		{
+			Name:           "fixed",
+			ScheduleType:   types.ScheduleTypeFixedPerBlock,
+			Destination:    types.DestinationCommunityPool,
+			StartTime:      start,
+			EndTime:        start.Add(time.Hour),
+			AmountPerBlock: math.NewInt(10),
+		},
+		{
+			Name:         "table",
+			ScheduleType: types.ScheduleTypeTable,
+			Points: []types.SchedulePoint{
+				{Time: start, ReleaseRate: math.LegacyNewDecWithPrec(1, 2)},
+				{Time: start.Add(12 * time.Hour), ReleaseRate: math.LegacyNewDecWithPrec(2, 2)},
+			},
+		},
+	}
+	require.NoError(t, params.Validate())
+
+	// unknown destination module
+	msgServer := keeper.NewMsgServerImpl(&input.RewardKeeper)
+	params.ReleaseSchedules[1].Destination = "unknown"
+	_, err = msgServer.UpdateParams(ctx, &types.MsgUpdateParams{
+		Authority: input.RewardKeeper.GetAuthority(),
+		Params:    params,
+	})
+	require.Error(t, err)
+
+	params.ReleaseSchedules[1].Destination = ""
+	_, err = msgServer.UpdateParams(ctx, &types.MsgUpdateParams{
+		Authority: input.RewardKeeper.GetAuthority(),
+		Params:    params,
+	})
+	require.NoError(t, err)
+
+	// project a day of release with a block per minute
+	require.NoError(t, input.RewardKeeper.SetLastDilutionTimestamp(ctx, start))
+	projections, total, err := input.RewardKeeper.ProjectRelease(ctx, start.Add(24*time.Hour), time.Minute)
+	require.NoError(t, err)
+	require.Len(t, projections, 3)
+
+	expectedTable := annualRelease(math.LegacyNewDecWithPrec(1, 2), 12*time.Hour, supply).
+		Add(annualRelease(math.LegacyNewDecWithPrec(2, 2), 12*time.Hour, supply))
+	require.True(t, projections[0].Amount.IsZero())
+	require.Equal(t, math.NewInt(600), projections[1].Amount)
+	require.Equal(t, "table", projections[2].Name)
+	require.Equal(t, math.LegacyNewDecWithPrec(2, 2), projections[2].ReleaseRate)
+	require.True(t, expectedTable.Sub(projections[2].Amount).Abs().LTE(math.OneInt()))
+	require.Equal(t, projections[1].Amount.Add(projections[2].Amount), total)
+
+	// release a block within the fixed schedule window
+	feeCollectorAddr := input.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	feeCollectorBefore := input.BankKeeper.GetBalance(ctx, feeCollectorAddr, params.RewardDenom).Amount
+	remain := input.RewardKeeper.GetRemainRewardAmount(ctx, params.RewardDenom)
+
+	ctx = ctx.WithBlockTime(start.Add(30 * time.Minute))
+	released, err := input.RewardKeeper.ReleaseBySchedules(ctx, params, start, remain)
+	require.NoError(t, err)
+
+	expectedFees := annualRelease(math.LegacyNewDecWithPrec(1, 2), 30*time.Minute, supply)
+	require.Equal(t, expectedFees.AddRaw(10), released)
+	require.Equal(t, feeCollectorBefore.Add(expectedFees), input.BankKeeper.GetBalance(ctx, feeCollectorAddr, params.RewardDenom).Amount)
+	require.Equal(t, remain.Sub(released), input.RewardKeeper.GetRemainRewardAmount(ctx, params.RewardDenom))
+
+	// releases are capped by the remaining amount in order
+	released, err = input.RewardKeeper.ReleaseBySchedules(ctx, params, start, math.NewInt(5))
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(5), released)
+}
+
+func Test_ReleaseBySchedules_FixedPerBlockProratesPartialInterval(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	params, err := input.RewardKeeper.GetParams(ctx)
+	require.NoError(t, err)
+
+	rewardModuleAddr := input.AccountKeeper.GetModuleAddress(types.ModuleName)
+	input.Faucet.Fund(ctx, rewardModuleAddr, sdk.NewCoin(params.RewardDenom, math.NewInt(1_000_000_000)))
+
+	start := ctx.BlockTime()
+	params.ReleaseSchedules = []types.ReleaseSchedule{
+		{
+			Name:           "fixed",
+			ScheduleType:   types.ScheduleTypeFixedPerBlock,
+			Destination:    types.DestinationCommunityPool,
+			StartTime:      start,
+			EndTime:        start.Add(time.Minute),
+			AmountPerBlock: math.NewInt(100),
+		},
+		{
+			// a destination that was never registered is skipped
+			Name:           "unknown",
+			ScheduleType:   types.ScheduleTypeFixedPerBlock,
+			Destination:    "unknown",
+			StartTime:      start,
+			AmountPerBlock: math.NewInt(100),
+		},
+	}
+	remain := input.RewardKeeper.GetRemainRewardAmount(ctx, params.RewardDenom)
+
+	// the schedule ends a quarter into the block interval
+	ctx = ctx.WithBlockTime(start.Add(105 * time.Second))
+	released, err := input.RewardKeeper.ReleaseBySchedules(ctx, params, start.Add(45*time.Second), remain)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(25), released)
+
+	// an interval after the end releases nothing
+	ctx = ctx.WithBlockTime(start.Add(3 * time.Minute))
+	released, err = input.RewardKeeper.ReleaseBySchedules(ctx, params, start.Add(2*time.Minute), remain)
+	require.NoError(t, err)
+	require.True(t, released.IsZero())
+
+	// genesis rejects the unknown destination
+	require.Error(t, input.RewardKeeper.ValidateScheduleDestinations(params.ReleaseSchedules))
+}
@@ -2,8 +2,11 @@ package types
 
 // Reward module event types
 const (
-	EventTypeReward = ModuleName
+	EventTypeReward          = ModuleName
+	EventTypeScheduleRelease = "schedule_release"
 
 	AttributeKeyReleaseRate      = "release_rate"
 	AttributeKeyAnnualProvisions = "annual_provisions"
+	AttributeKeySchedule         = "schedule"
+	AttributeKeyDestination      = "destination"
 )
@@ -0,0 +1,31 @@
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+// MaxForecastEntries bounds the entries of a release forecast.
+const MaxForecastEntries = 1000
+
+// Validate checks the forecast interval is specified.
+func (i ForecastInterval) Validate() error {
+	switch i {
+	case ForecastIntervalDay, ForecastIntervalMonth, ForecastIntervalYear:
+		return nil
+	}
+
+	return fmt.Errorf("invalid forecast interval: %s", i)
+}
+
+// Next returns the end of the forecast period starting at t.
+func (i ForecastInterval) Next(t time.Time) time.Time {
+	switch i {
+	case ForecastIntervalMonth:
+		return t.AddDate(0, 1, 0)
+	case ForecastIntervalYear:
+		return t.AddDate(1, 0, 0)
+	default:
+		return t.AddDate(0, 0, 1)
+	}
+}
@@ -76,6 +76,10 @@ func (p Params) Validate() error {
 		return errors.Wrap(err, "invalid release enabled")
 	}
 
+	if err := validateReleaseSchedules(p.ReleaseSchedules); err != nil {
+		return errors.Wrap(err, "invalid release schedules")
+	}
+
 	return nil
 }
 
@@ -147,3 +151,19 @@ func validateReleaseEnabled(i any) error {
 
 	return nil
 }
+
+func validateReleaseSchedules(schedules []ReleaseSchedule) error {
+	names := make(map[string]bool, len(schedules))
+	for _, schedule := range schedules {
+		if err := schedule.Validate(); err != nil {
+			return err
+		}
+
+		if names[schedule.Name] {
+			return fmt.Errorf("duplicate schedule name: %s", schedule.Name)
+		}
+		names[schedule.Name] = true
+	}
+
+	return nil
+}
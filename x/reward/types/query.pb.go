@@ -8,6 +8,7 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -17,6 +18,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -36,6 +38,42 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ForecastInterval defines the period of a release forecast entry.
+type ForecastInterval int32
+
+const (
+	// FORECAST_INTERVAL_UNSPECIFIED defines an invalid interval.
+	ForecastIntervalUnspecified ForecastInterval = 0
+	// FORECAST_INTERVAL_DAY defines a daily forecast.
+	ForecastIntervalDay ForecastInterval = 1
+	// FORECAST_INTERVAL_MONTH defines a monthly forecast.
+	ForecastIntervalMonth ForecastInterval = 2
+	// FORECAST_INTERVAL_YEAR defines a yearly forecast.
+	ForecastIntervalYear ForecastInterval = 3
+)
+
+var ForecastInterval_name = map[int32]string{
+	0: "FORECAST_INTERVAL_UNSPECIFIED",
+	1: "FORECAST_INTERVAL_DAY",
+	2: "FORECAST_INTERVAL_MONTH",
+	3: "FORECAST_INTERVAL_YEAR",
+}
+
+var ForecastInterval_value = map[string]int32{
+	"FORECAST_INTERVAL_UNSPECIFIED": 0,
+	"FORECAST_INTERVAL_DAY":         1,
+	"FORECAST_INTERVAL_MONTH":       2,
+	"FORECAST_INTERVAL_YEAR":        3,
+}
+
+func (x ForecastInterval) String() string {
+	return proto.EnumName(ForecastInterval_name, int32(x))
+}
+
+func (ForecastInterval) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c0604a422725e0bb, []int{0}
+}
+
 // QueryParamsRequest is the request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 }
@@ -282,486 +320,2503 @@ func (m *QueryLastDilutionTimestampResponse) GetLastDilutionTimestamp() time.Tim
 	return time.Time{}
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "initia.reward.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "initia.reward.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryAnnualProvisionsRequest)(nil), "initia.reward.v1.QueryAnnualProvisionsRequest")
-	proto.RegisterType((*QueryAnnualProvisionsResponse)(nil), "initia.reward.v1.QueryAnnualProvisionsResponse")
-	proto.RegisterType((*QueryLastDilutionTimestampRequest)(nil), "initia.reward.v1.QueryLastDilutionTimestampRequest")
-	proto.RegisterType((*QueryLastDilutionTimestampResponse)(nil), "initia.reward.v1.QueryLastDilutionTimestampResponse")
+// QueryProjectedReleaseRequest is the request type for the
+// Query/ProjectedRelease RPC method.
+type QueryProjectedReleaseRequest struct {
+	// time is the time to project the release until.
+	Time time.Time `protobuf:"bytes,1,opt,name=time,proto3,stdtime" json:"time"`
+	// block_time is the expected block interval used to project fixed per
+	// block schedules. The default block time is used when it is zero.
+	BlockTime time.Duration `protobuf:"bytes,2,opt,name=block_time,json=blockTime,proto3,stdduration" json:"block_time"`
 }
 
-func init() { proto.RegisterFile("initia/reward/v1/query.proto", fileDescriptor_c0604a422725e0bb) }
-
-var fileDescriptor_c0604a422725e0bb = []byte{
-	// 540 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xce, 0x58, 0x0d, 0x32, 0x7a, 0x48, 0xc7, 0x16, 0xe3, 0x1a, 0x37, 0x75, 0x6b, 0x41, 0xad,
-	0x9d, 0x21, 0xad, 0xf4, 0x6e, 0x08, 0x9e, 0x2a, 0xd4, 0xe0, 0x49, 0x84, 0x30, 0xd9, 0x8c, 0xdb,
-	0xc1, 0xdd, 0x9d, 0xed, 0xce, 0x6c, 0x6a, 0xae, 0xc5, 0x1f, 0x50, 0xf0, 0x0f, 0x78, 0xf4, 0xe8,
-	0x41, 0xff, 0x43, 0x8f, 0x45, 0x2f, 0xa2, 0x50, 0x25, 0x11, 0xfc, 0x1b, 0xb2, 0x33, 0xb3, 0x05,
-	0xb3, 0x4d, 0xb5, 0x97, 0x90, 0x79, 0xef, 0x7b, 0xef, 0x7d, 0xef, 0xfb, 0xde, 0xc2, 0x06, 0x8f,
-	0xb9, 0xe2, 0x94, 0xa4, 0x6c, 0x8f, 0xa6, 0x03, 0x32, 0x6c, 0x91, 0xdd, 0x8c, 0xa5, 0x23, 0x9c,
-	0xa4, 0x42, 0x09, 0x54, 0x33, 0x59, 0x6c, 0xb2, 0x78, 0xd8, 0x72, 0xe6, 0x69, 0xc4, 0x63, 0x41,
-	0xf4, 0xaf, 0x01, 0x39, 0x37, 0x7c, 0x21, 0x23, 0x21, 0x7b, 0xfa, 0x45, 0xcc, 0xc3, 0xa6, 0x16,
-	0x02, 0x11, 0x08, 0x13, 0xcf, 0xff, 0xd9, 0x68, 0x23, 0x10, 0x22, 0x08, 0x19, 0xa1, 0x09, 0x27,
-	0x34, 0x8e, 0x85, 0xa2, 0x8a, 0x8b, 0xb8, 0xa8, 0x69, 0xda, 0xac, 0x7e, 0xf5, 0xb3, 0x97, 0x44,
-	0xf1, 0x88, 0x49, 0x45, 0xa3, 0xa4, 0x28, 0x2f, 0x51, 0x56, 0xa3, 0x84, 0xd9, 0x72, 0x6f, 0x01,
-	0xa2, 0xa7, 0xf9, 0x06, 0xdb, 0x34, 0xa5, 0x91, 0xec, 0xb2, 0xdd, 0x8c, 0x49, 0xe5, 0x3d, 0x81,
-	0xd7, 0xfe, 0x8a, 0xca, 0x44, 0xc4, 0x92, 0xa1, 0x4d, 0x58, 0x4d, 0x74, 0xa4, 0x0e, 0x96, 0xc0,
-	0xdd, 0x2b, 0xeb, 0x75, 0x3c, 0xbd, 0x30, 0x36, 0x15, 0xed, 0x8b, 0x87, 0xc7, 0xcd, 0x4a, 0xd7,
-	0xa2, 0x3d, 0x17, 0x36, 0x74, 0xbb, 0x47, 0x71, 0x9c, 0xd1, 0x70, 0x3b, 0x15, 0x43, 0x2e, 0xf3,
-	0x15, 0x8a, 0x71, 0x6f, 0x00, 0xbc, 0x35, 0x03, 0x60, 0x27, 0xfb, 0x70, 0x9e, 0xea, 0x5c, 0x2e,
-	0x9b, 0x4d, 0x6a, 0x12, 0x57, 0xdb, 0x9b, 0xf9, 0xa8, 0x6f, 0xc7, 0xcd, 0x9b, 0x46, 0x4a, 0x39,
-	0x78, 0x85, 0xb9, 0x20, 0x11, 0x55, 0x3b, 0x78, 0x8b, 0x05, 0xd4, 0x1f, 0x75, 0x98, 0xff, 0xf9,
-	0xe3, 0x1a, 0xb4, 0x4a, 0x77, 0x98, 0xff, 0xfe, 0xf7, 0x87, 0xfb, 0xa0, 0x5b, 0xa3, 0x53, 0xc3,
-	0xbc, 0x65, 0x78, 0x5b, 0xb3, 0xd8, 0xa2, 0x52, 0x75, 0x78, 0x98, 0xe5, 0x32, 0x3f, 0x2b, 0xd4,
-	0x2c, 0xb8, 0xee, 0x03, 0xe8, 0x9d, 0x85, 0xb2, 0x84, 0x5f, 0xc0, 0xeb, 0x21, 0x95, 0xaa, 0x37,
-	0xb0, 0x88, 0xde, 0x89, 0x2d, 0xf5, 0x39, 0xad, 0x9d, 0x83, 0x8d, 0x71, 0xb8, 0x30, 0x0e, 0x9f,
-	0x34, 0x69, 0x5f, 0xce, 0x57, 0x3a, 0xf8, 0xd1, 0x04, 0xdd, 0xc5, 0xf0, 0xb4, 0x29, 0xeb, 0xdf,
-	0xe7, 0xe0, 0x25, 0x4d, 0x02, 0xed, 0xc1, 0xaa, 0x91, 0x1c, 0xdd, 0x29, 0x9b, 0x51, 0x76, 0xd6,
-	0x59, 0xf9, 0x07, 0xca, 0xd0, 0xf7, 0x96, 0xf6, 0xbf, 0xfc, 0x7a, 0x7b, 0xc1, 0x41, 0x75, 0x52,
-	0xba, 0x1e, 0xe3, 0x29, 0x7a, 0x07, 0x60, 0x6d, 0xda, 0x2e, 0x84, 0x67, 0x74, 0x9f, 0x61, 0xbc,
-	0x43, 0xfe, 0x1b, 0x6f, 0x79, 0xad, 0x6a, 0x5e, 0x2b, 0x68, 0xb9, 0xcc, 0xab, 0x74, 0x1f, 0xe8,
-	0x13, 0x80, 0x8b, 0xa7, 0xba, 0x84, 0x36, 0x66, 0xcc, 0x3d, 0xcb, 0x79, 0xe7, 0xe1, 0xf9, 0x8a,
-	0x2c, 0xe3, 0x96, 0x66, 0xbc, 0x8a, 0xee, 0x95, 0x19, 0xcf, 0x38, 0x90, 0xf6, 0xe3, 0xc3, 0xb1,
-	0x0b, 0x8e, 0xc6, 0x2e, 0xf8, 0x39, 0x76, 0xc1, 0xc1, 0xc4, 0xad, 0x1c, 0x4d, 0xdc, 0xca, 0xd7,
-	0x89, 0x5b, 0x79, 0xfe, 0x20, 0xe0, 0x6a, 0x27, 0xeb, 0x63, 0x5f, 0x44, 0xb6, 0xdd, 0x5a, 0x48,
-	0xfb, 0xb2, 0x68, 0xfd, 0xba, 0x68, 0xae, 0xbf, 0xf0, 0x7e, 0x55, 0x9f, 0xd6, 0xc6, 0x9f, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x28, 0x84, 0x6b, 0xa0, 0xb5, 0x04, 0x00, 0x00,
+func (m *QueryProjectedReleaseRequest) Reset()         { *m = QueryProjectedReleaseRequest{} }
+func (m *QueryProjectedReleaseRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectedReleaseRequest) ProtoMessage()    {}
+func (*QueryProjectedReleaseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0604a422725e0bb, []int{6}
+}
+func (m *QueryProjectedReleaseRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectedReleaseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectedReleaseRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectedReleaseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectedReleaseRequest.Merge(m, src)
+}
+func (m *QueryProjectedReleaseRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectedReleaseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectedReleaseRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryProjectedReleaseRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params returns the total set of minting parameters.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// AnnualProvisions returns current minting annual provisions value.
-	AnnualProvisions(ctx context.Context, in *QueryAnnualProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualProvisionsResponse, error)
-	// LastDilutionTimestamp returns the time when the last release rate dilution occurred.
-	LastDilutionTimestamp(ctx context.Context, in *QueryLastDilutionTimestampRequest, opts ...grpc.CallOption) (*QueryLastDilutionTimestampResponse, error)
+func (m *QueryProjectedReleaseRequest) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryProjectedReleaseRequest) GetBlockTime() time.Duration {
+	if m != nil {
+		return m.BlockTime
+	}
+	return 0
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+// QueryProjectedReleaseResponse is the response type for the
+// Query/ProjectedRelease RPC method.
+type QueryProjectedReleaseResponse struct {
+	// projections are the projected releases of the release rate of the
+	// params followed by the release schedules.
+	Projections []ReleaseProjection `protobuf:"bytes,1,rep,name=projections,proto3" json:"projections"`
+	// total is the sum of the projections, capped by the remaining reward
+	// module balance.
+	Total cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=total,proto3,customtype=cosmossdk.io/math.Int" json:"total"`
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/initia.reward.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryProjectedReleaseResponse) Reset()         { *m = QueryProjectedReleaseResponse{} }
+func (m *QueryProjectedReleaseResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectedReleaseResponse) ProtoMessage()    {}
+func (*QueryProjectedReleaseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0604a422725e0bb, []int{7}
 }
-
-func (c *queryClient) AnnualProvisions(ctx context.Context, in *QueryAnnualProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualProvisionsResponse, error) {
-	out := new(QueryAnnualProvisionsResponse)
-	err := c.cc.Invoke(ctx, "/initia.reward.v1.Query/AnnualProvisions", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryProjectedReleaseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectedReleaseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectedReleaseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryProjectedReleaseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectedReleaseResponse.Merge(m, src)
+}
+func (m *QueryProjectedReleaseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectedReleaseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectedReleaseResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) LastDilutionTimestamp(ctx context.Context, in *QueryLastDilutionTimestampRequest, opts ...grpc.CallOption) (*QueryLastDilutionTimestampResponse, error) {
-	out := new(QueryLastDilutionTimestampResponse)
-	err := c.cc.Invoke(ctx, "/initia.reward.v1.Query/LastDilutionTimestamp", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryProjectedReleaseResponse proto.InternalMessageInfo
+
+func (m *QueryProjectedReleaseResponse) GetProjections() []ReleaseProjection {
+	if m != nil {
+		return m.Projections
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params returns the total set of minting parameters.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// AnnualProvisions returns current minting annual provisions value.
-	AnnualProvisions(context.Context, *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error)
-	// LastDilutionTimestamp returns the time when the last release rate dilution occurred.
-	LastDilutionTimestamp(context.Context, *QueryLastDilutionTimestampRequest) (*QueryLastDilutionTimestampResponse, error)
+// QueryRemainingRewardRequest is the request type for the
+// Query/RemainingReward RPC method.
+type QueryRemainingRewardRequest struct {
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryRemainingRewardRequest) Reset()         { *m = QueryRemainingRewardRequest{} }
+func (m *QueryRemainingRewardRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRemainingRewardRequest) ProtoMessage()    {}
+func (*QueryRemainingRewardRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0604a422725e0bb, []int{8}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryRemainingRewardRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) AnnualProvisions(ctx context.Context, req *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AnnualProvisions not implemented")
+func (m *QueryRemainingRewardRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRemainingRewardRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) LastDilutionTimestamp(ctx context.Context, req *QueryLastDilutionTimestampRequest) (*QueryLastDilutionTimestampResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LastDilutionTimestamp not implemented")
+func (m *QueryRemainingRewardRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRemainingRewardRequest.Merge(m, src)
+}
+func (m *QueryRemainingRewardRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRemainingRewardRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRemainingRewardRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+var xxx_messageInfo_QueryRemainingRewardRequest proto.InternalMessageInfo
+
+// QueryRemainingRewardResponse is the response type for the
+// Query/RemainingReward RPC method.
+type QueryRemainingRewardResponse struct {
+	// remaining is the reward module balance of the reward denom.
+	Remaining types.Coin `protobuf:"bytes,1,opt,name=remaining,proto3" json:"remaining"`
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/initia.reward.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryRemainingRewardResponse) Reset()         { *m = QueryRemainingRewardResponse{} }
+func (m *QueryRemainingRewardResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRemainingRewardResponse) ProtoMessage()    {}
+func (*QueryRemainingRewardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0604a422725e0bb, []int{9}
+}
+func (m *QueryRemainingRewardResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRemainingRewardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRemainingRewardResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryRemainingRewardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRemainingRewardResponse.Merge(m, src)
+}
+func (m *QueryRemainingRewardResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRemainingRewardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRemainingRewardResponse.DiscardUnknown(m)
 }
 
-func _Query_AnnualProvisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAnnualProvisionsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AnnualProvisions(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/initia.reward.v1.Query/AnnualProvisions",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AnnualProvisions(ctx, req.(*QueryAnnualProvisionsRequest))
+var xxx_messageInfo_QueryRemainingRewardResponse proto.InternalMessageInfo
+
+func (m *QueryRemainingRewardResponse) GetRemaining() types.Coin {
+	if m != nil {
+		return m.Remaining
 	}
-	return interceptor(ctx, in, info, handler)
+	return types.Coin{}
 }
 
-func _Query_LastDilutionTimestamp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLastDilutionTimestampRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LastDilutionTimestamp(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/initia.reward.v1.Query/LastDilutionTimestamp",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LastDilutionTimestamp(ctx, req.(*QueryLastDilutionTimestampRequest))
+// QueryReleaseForecastRequest is the request type for the
+// Query/ReleaseForecast RPC method.
+type QueryReleaseForecastRequest struct {
+	Interval ForecastInterval `protobuf:"varint,1,opt,name=interval,proto3,enum=initia.reward.v1.ForecastInterval" json:"interval,omitempty"`
+	// horizon is the time to forecast the release until.
+	Horizon time.Time `protobuf:"bytes,2,opt,name=horizon,proto3,stdtime" json:"horizon"`
+	// block_time is the expected block interval used to project fixed per
+	// block schedules. The default block time is used when it is zero.
+	BlockTime time.Duration `protobuf:"bytes,3,opt,name=block_time,json=blockTime,proto3,stdduration" json:"block_time"`
+}
+
+func (m *QueryReleaseForecastRequest) Reset()         { *m = QueryReleaseForecastRequest{} }
+func (m *QueryReleaseForecastRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReleaseForecastRequest) ProtoMessage()    {}
+func (*QueryReleaseForecastRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0604a422725e0bb, []int{10}
+}
+func (m *QueryReleaseForecastRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReleaseForecastRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReleaseForecastRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryReleaseForecastRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReleaseForecastRequest.Merge(m, src)
+}
+func (m *QueryReleaseForecastRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReleaseForecastRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReleaseForecastRequest.DiscardUnknown(m)
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "initia.reward.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "AnnualProvisions",
-			Handler:    _Query_AnnualProvisions_Handler,
-		},
-		{
-			MethodName: "LastDilutionTimestamp",
-			Handler:    _Query_LastDilutionTimestamp_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "initia/reward/v1/query.proto",
+var xxx_messageInfo_QueryReleaseForecastRequest proto.InternalMessageInfo
+
+func (m *QueryReleaseForecastRequest) GetInterval() ForecastInterval {
+	if m != nil {
+		return m.Interval
+	}
+	return ForecastIntervalUnspecified
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryReleaseForecastRequest) GetHorizon() time.Time {
+	if m != nil {
+		return m.Horizon
 	}
-	return dAtA[:n], nil
+	return time.Time{}
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryReleaseForecastRequest) GetBlockTime() time.Duration {
+	if m != nil {
+		return m.BlockTime
+	}
+	return 0
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// QueryReleaseForecastResponse is the response type for the
+// Query/ReleaseForecast RPC method.
+type QueryReleaseForecastResponse struct {
+	Entries []ReleaseForecastEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryReleaseForecastResponse) Reset()         { *m = QueryReleaseForecastResponse{} }
+func (m *QueryReleaseForecastResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReleaseForecastResponse) ProtoMessage()    {}
+func (*QueryReleaseForecastResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0604a422725e0bb, []int{11}
 }
-
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryReleaseForecastResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryReleaseForecastResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReleaseForecastResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAnnualProvisionsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryReleaseForecastResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReleaseForecastResponse.Merge(m, src)
 }
-
-func (m *QueryAnnualProvisionsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryReleaseForecastResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryAnnualProvisionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryReleaseForecastResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReleaseForecastResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAnnualProvisionsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryReleaseForecastResponse proto.InternalMessageInfo
+
+func (m *QueryReleaseForecastResponse) GetEntries() []ReleaseForecastEntry {
+	if m != nil {
+		return m.Entries
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAnnualProvisionsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ReleaseForecastEntry defines the projected release of a period.
+type ReleaseForecastEntry struct {
+	StartTime time.Time `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	EndTime   time.Time `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	// amount is the amount released within the period.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// cumulative_amount is the amount released from the current block time
+	// until the end of the period.
+	CumulativeAmount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=cumulative_amount,json=cumulativeAmount,proto3,customtype=cosmossdk.io/math.Int" json:"cumulative_amount"`
+	// remaining is the reward balance left at the end of the period.
+	Remaining cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=remaining,proto3,customtype=cosmossdk.io/math.Int" json:"remaining"`
 }
 
-func (m *QueryAnnualProvisionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.AnnualProvisions.Size()
-		i -= size
-		if _, err := m.AnnualProvisions.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *ReleaseForecastEntry) Reset()         { *m = ReleaseForecastEntry{} }
+func (m *ReleaseForecastEntry) String() string { return proto.CompactTextString(m) }
+func (*ReleaseForecastEntry) ProtoMessage()    {}
+func (*ReleaseForecastEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0604a422725e0bb, []int{12}
+}
+func (m *ReleaseForecastEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReleaseForecastEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReleaseForecastEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryLastDilutionTimestampRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ReleaseForecastEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseForecastEntry.Merge(m, src)
 }
-
-func (m *QueryLastDilutionTimestampRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ReleaseForecastEntry) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryLastDilutionTimestampRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *ReleaseForecastEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseForecastEntry.DiscardUnknown(m)
 }
 
-func (m *QueryLastDilutionTimestampResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ReleaseForecastEntry proto.InternalMessageInfo
+
+func (m *ReleaseForecastEntry) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
 	}
-	return dAtA[:n], nil
+	return time.Time{}
 }
 
-func (m *QueryLastDilutionTimestampResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ReleaseForecastEntry) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
 }
 
-func (m *QueryLastDilutionTimestampResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastDilutionTimestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastDilutionTimestamp):])
-	if err2 != nil {
-		return 0, err2
-	}
-	i -= n2
-	i = encodeVarintQuery(dAtA, i, uint64(n2))
-	i--
-	dAtA[i] = 0x1a
-	return len(dAtA) - i, nil
+// QueryExhaustionTimeRequest is the request type for the
+// Query/ExhaustionTime RPC method.
+type QueryExhaustionTimeRequest struct {
+	// block_time is the expected block interval used to project fixed per
+	// block schedules. The default block time is used when it is zero.
+	BlockTime time.Duration `protobuf:"bytes,1,opt,name=block_time,json=blockTime,proto3,stdduration" json:"block_time"`
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *QueryExhaustionTimeRequest) Reset()         { *m = QueryExhaustionTimeRequest{} }
+func (m *QueryExhaustionTimeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryExhaustionTimeRequest) ProtoMessage()    {}
+func (*QueryExhaustionTimeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0604a422725e0bb, []int{13}
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryExhaustionTimeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExhaustionTimeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExhaustionTimeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *QueryExhaustionTimeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExhaustionTimeRequest.Merge(m, src)
+}
+func (m *QueryExhaustionTimeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExhaustionTimeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExhaustionTimeRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryExhaustionTimeRequest proto.InternalMessageInfo
+
+func (m *QueryExhaustionTimeRequest) GetBlockTime() time.Duration {
+	if m != nil {
+		return m.BlockTime
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return 0
 }
 
-func (m *QueryAnnualProvisionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+// QueryExhaustionTimeResponse is the response type for the
+// Query/ExhaustionTime RPC method.
+type QueryExhaustionTimeResponse struct {
+	// exhausted is false when the remaining reward does not run out within
+	// the maximum projection horizon.
+	Exhausted bool `protobuf:"varint,1,opt,name=exhausted,proto3" json:"exhausted,omitempty"`
+	// exhaustion_time is the estimated time the remaining reward runs out.
+	ExhaustionTime time.Time `protobuf:"bytes,2,opt,name=exhaustion_time,json=exhaustionTime,proto3,stdtime" json:"exhaustion_time"`
 }
 
-func (m *QueryAnnualProvisionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryExhaustionTimeResponse) Reset()         { *m = QueryExhaustionTimeResponse{} }
+func (m *QueryExhaustionTimeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryExhaustionTimeResponse) ProtoMessage()    {}
+func (*QueryExhaustionTimeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0604a422725e0bb, []int{14}
+}
+func (m *QueryExhaustionTimeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExhaustionTimeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExhaustionTimeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = m.AnnualProvisions.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+}
+func (m *QueryExhaustionTimeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExhaustionTimeResponse.Merge(m, src)
+}
+func (m *QueryExhaustionTimeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExhaustionTimeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExhaustionTimeResponse.DiscardUnknown(m)
 }
 
-func (m *QueryLastDilutionTimestampRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryExhaustionTimeResponse proto.InternalMessageInfo
+
+func (m *QueryExhaustionTimeResponse) GetExhausted() bool {
+	if m != nil {
+		return m.Exhausted
 	}
-	var l int
-	_ = l
-	return n
+	return false
 }
 
-func (m *QueryLastDilutionTimestampResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryExhaustionTimeResponse) GetExhaustionTime() time.Time {
+	if m != nil {
+		return m.ExhaustionTime
 	}
-	var l int
-	_ = l
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastDilutionTimestamp)
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return time.Time{}
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func init() {
+	proto.RegisterEnum("initia.reward.v1.ForecastInterval", ForecastInterval_name, ForecastInterval_value)
+	proto.RegisterType((*QueryParamsRequest)(nil), "initia.reward.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "initia.reward.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryAnnualProvisionsRequest)(nil), "initia.reward.v1.QueryAnnualProvisionsRequest")
+	proto.RegisterType((*QueryAnnualProvisionsResponse)(nil), "initia.reward.v1.QueryAnnualProvisionsResponse")
+	proto.RegisterType((*QueryLastDilutionTimestampRequest)(nil), "initia.reward.v1.QueryLastDilutionTimestampRequest")
+	proto.RegisterType((*QueryLastDilutionTimestampResponse)(nil), "initia.reward.v1.QueryLastDilutionTimestampResponse")
+	proto.RegisterType((*QueryProjectedReleaseRequest)(nil), "initia.reward.v1.QueryProjectedReleaseRequest")
+	proto.RegisterType((*QueryProjectedReleaseResponse)(nil), "initia.reward.v1.QueryProjectedReleaseResponse")
+	proto.RegisterType((*QueryRemainingRewardRequest)(nil), "initia.reward.v1.QueryRemainingRewardRequest")
+	proto.RegisterType((*QueryRemainingRewardResponse)(nil), "initia.reward.v1.QueryRemainingRewardResponse")
+	proto.RegisterType((*QueryReleaseForecastRequest)(nil), "initia.reward.v1.QueryReleaseForecastRequest")
+	proto.RegisterType((*QueryReleaseForecastResponse)(nil), "initia.reward.v1.QueryReleaseForecastResponse")
+	proto.RegisterType((*ReleaseForecastEntry)(nil), "initia.reward.v1.ReleaseForecastEntry")
+	proto.RegisterType((*QueryExhaustionTimeRequest)(nil), "initia.reward.v1.QueryExhaustionTimeRequest")
+	proto.RegisterType((*QueryExhaustionTimeResponse)(nil), "initia.reward.v1.QueryExhaustionTimeResponse")
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func init() { proto.RegisterFile("initia/reward/v1/query.proto", fileDescriptor_c0604a422725e0bb) }
+
+var fileDescriptor_c0604a422725e0bb = []byte{
+	// 1215 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x41, 0x4f, 0x1b, 0x47,
+	0x14, 0xf6, 0x62, 0x42, 0xf0, 0xa4, 0x4a, 0xcc, 0x04, 0x8a, 0x59, 0x8c, 0x71, 0x96, 0xa4, 0x4a,
+	0x48, 0xd8, 0x2d, 0x24, 0x42, 0x3d, 0xa5, 0xb2, 0xb1, 0x51, 0xac, 0x02, 0x71, 0x37, 0xa4, 0x12,
+	0x55, 0x2b, 0x77, 0xbc, 0x1e, 0xcc, 0x16, 0x7b, 0xc6, 0xd9, 0x1d, 0x3b, 0xa1, 0xc7, 0xa8, 0x87,
+	0x8a, 0x53, 0xa4, 0xaa, 0x52, 0x0e, 0x41, 0xaa, 0xd4, 0x4b, 0x8f, 0x3d, 0xb4, 0x87, 0xfe, 0x83,
+	0x1c, 0xa3, 0xf6, 0xd0, 0xaa, 0x87, 0xb4, 0x85, 0x4a, 0xfd, 0x17, 0x55, 0xb5, 0xb3, 0x33, 0xc6,
+	0xde, 0xb5, 0x89, 0xe1, 0x82, 0xd8, 0x79, 0xef, 0xfb, 0xde, 0x37, 0xef, 0xbd, 0x7d, 0xfb, 0x0c,
+	0x92, 0x36, 0xb1, 0x99, 0x8d, 0x0c, 0x07, 0x3f, 0x46, 0x4e, 0xc5, 0x68, 0x2d, 0x1a, 0x8f, 0x9a,
+	0xd8, 0xd9, 0xd3, 0x1b, 0x0e, 0x65, 0x14, 0xc6, 0x7d, 0xab, 0xee, 0x5b, 0xf5, 0xd6, 0xa2, 0x3a,
+	0x86, 0xea, 0x36, 0xa1, 0x06, 0xff, 0xeb, 0x3b, 0xa9, 0x29, 0x8b, 0xba, 0x75, 0xea, 0x1a, 0x65,
+	0xe4, 0x62, 0xa3, 0xb5, 0x58, 0xc6, 0x0c, 0x2d, 0x1a, 0x16, 0xb5, 0x89, 0xb0, 0x4f, 0xf9, 0xf6,
+	0x12, 0x7f, 0x32, 0xfc, 0x07, 0x61, 0x1a, 0xaf, 0xd2, 0x2a, 0xf5, 0xcf, 0xbd, 0xff, 0xc4, 0x69,
+	0xb2, 0x4a, 0x69, 0xb5, 0x86, 0x0d, 0xd4, 0xb0, 0x0d, 0x44, 0x08, 0x65, 0x88, 0xd9, 0x94, 0x48,
+	0x4c, 0x4a, 0x58, 0xf9, 0x53, 0xb9, 0xb9, 0x6d, 0x54, 0x9a, 0x0e, 0x77, 0x10, 0xf6, 0xd9, 0xa0,
+	0x9d, 0xd9, 0x75, 0xec, 0x32, 0x54, 0x6f, 0x48, 0xfa, 0xd0, 0x95, 0xd9, 0x5e, 0x03, 0x0b, 0x7a,
+	0x6d, 0x1c, 0xc0, 0x0f, 0xbd, 0x0c, 0x14, 0x91, 0x83, 0xea, 0xae, 0x89, 0x1f, 0x35, 0xb1, 0xcb,
+	0xb4, 0x75, 0x70, 0xb9, 0xeb, 0xd4, 0x6d, 0x50, 0xe2, 0x62, 0xb8, 0x0c, 0x46, 0x1a, 0xfc, 0x24,
+	0xa1, 0xa4, 0x95, 0xeb, 0x17, 0x96, 0x12, 0x7a, 0x30, 0x61, 0xba, 0x8f, 0xc8, 0x0e, 0xbf, 0x7c,
+	0x3d, 0x1b, 0x31, 0x85, 0xb7, 0x96, 0x02, 0x49, 0x4e, 0x97, 0x21, 0xa4, 0x89, 0x6a, 0x45, 0x87,
+	0xb6, 0x6c, 0xd7, 0xbb, 0xa2, 0x0c, 0xf7, 0xa5, 0x02, 0x66, 0xfa, 0x38, 0x88, 0xc8, 0x16, 0x18,
+	0x43, 0xdc, 0x56, 0x6a, 0xb4, 0x8d, 0x5c, 0xc4, 0x5b, 0xd9, 0x65, 0x2f, 0xd4, 0x1f, 0xaf, 0x67,
+	0xa7, 0xfd, 0x54, 0xbb, 0x95, 0x5d, 0xdd, 0xa6, 0x46, 0x1d, 0xb1, 0x1d, 0x7d, 0x0d, 0x57, 0x91,
+	0xb5, 0x97, 0xc3, 0xd6, 0x2f, 0x3f, 0x2e, 0x00, 0x51, 0x89, 0x1c, 0xb6, 0xbe, 0xff, 0xf7, 0x87,
+	0x79, 0xc5, 0x8c, 0xa3, 0x40, 0x30, 0x6d, 0x0e, 0x5c, 0xe1, 0x2a, 0xd6, 0x90, 0xcb, 0x72, 0x76,
+	0xad, 0xe9, 0x65, 0x79, 0x53, 0x66, 0x53, 0x6a, 0x7d, 0xaa, 0x00, 0xed, 0x24, 0x2f, 0x21, 0xf8,
+	0x13, 0x30, 0x59, 0x43, 0x2e, 0x2b, 0x55, 0x84, 0x47, 0xa9, 0x5d, 0x96, 0x44, 0x94, 0xe7, 0x4e,
+	0xd5, 0xfd, 0xc2, 0xe9, 0xb2, 0x70, 0x7a, 0x9b, 0x24, 0x3b, 0xea, 0x5d, 0xe9, 0xd9, 0x9f, 0xb3,
+	0x8a, 0x39, 0x51, 0xeb, 0x15, 0x45, 0x7b, 0xa1, 0x88, 0x8c, 0x16, 0x1d, 0xfa, 0x39, 0xb6, 0x18,
+	0xae, 0x98, 0xb8, 0x86, 0x91, 0x8b, 0x85, 0x4a, 0xf8, 0x1e, 0x18, 0xf6, 0x02, 0x26, 0x94, 0x53,
+	0xc4, 0xe2, 0x08, 0x98, 0x05, 0xa0, 0x5c, 0xa3, 0xd6, 0x2e, 0x17, 0x9c, 0x18, 0xe2, 0xf8, 0xa9,
+	0x10, 0x3e, 0x27, 0x9a, 0xd0, 0x87, 0x3f, 0xf7, 0xe0, 0x31, 0x0e, 0xf3, 0x88, 0xb5, 0x9f, 0x65,
+	0x3d, 0xc3, 0xf2, 0x44, 0x7a, 0x8a, 0xe0, 0x42, 0xc3, 0xb7, 0x89, 0x4a, 0x46, 0xaf, 0x5f, 0x58,
+	0x9a, 0x0b, 0xb7, 0x93, 0xc0, 0x15, 0xdb, 0xbe, 0xd9, 0x98, 0x17, 0xd0, 0xaf, 0x60, 0x27, 0x05,
+	0x5c, 0x05, 0xe7, 0x18, 0x65, 0xa8, 0xc6, 0x25, 0xc7, 0xb2, 0xef, 0x8a, 0xae, 0x98, 0x08, 0x77,
+	0x45, 0x81, 0xb0, 0x8e, 0x7e, 0x28, 0x10, 0xe6, 0xb3, 0xf9, 0x70, 0x6d, 0x06, 0x4c, 0x73, 0xe9,
+	0x26, 0xae, 0x23, 0x9b, 0xd8, 0xa4, 0x6a, 0x72, 0x31, 0xb2, 0xfc, 0x65, 0x90, 0xec, 0x6d, 0x16,
+	0x17, 0xcb, 0x82, 0x98, 0x23, 0x4d, 0x22, 0xfb, 0x53, 0xba, 0x08, 0xe5, 0x4d, 0x0c, 0x5d, 0x4c,
+	0x0c, 0x7d, 0x85, 0xda, 0x5d, 0x97, 0x39, 0x86, 0x69, 0x7f, 0x2b, 0x6d, 0x0d, 0xfc, 0xf6, 0xab,
+	0xd4, 0xc1, 0x16, 0x72, 0x99, 0x2c, 0xee, 0x5d, 0x30, 0x6a, 0x13, 0x86, 0x9d, 0x16, 0xaa, 0xf1,
+	0x10, 0x17, 0x97, 0xb4, 0x70, 0xe6, 0x24, 0xa8, 0x20, 0x3c, 0xcd, 0x36, 0x06, 0xde, 0x05, 0xe7,
+	0x77, 0xa8, 0x63, 0x7f, 0x41, 0x49, 0x62, 0xe8, 0x14, 0xfd, 0x21, 0x41, 0x81, 0x16, 0x89, 0x9e,
+	0xa9, 0x45, 0x76, 0x41, 0xb2, 0xf7, 0x15, 0x45, 0x1e, 0x3f, 0x00, 0xe7, 0x31, 0x61, 0x8e, 0x8d,
+	0x65, 0x73, 0xbc, 0xd3, 0xb7, 0x39, 0x24, 0x36, 0x4f, 0x98, 0xb3, 0xd7, 0x99, 0x52, 0xc9, 0xa0,
+	0x7d, 0x13, 0x05, 0xe3, 0xbd, 0x9c, 0xe1, 0x0a, 0x00, 0x2e, 0x43, 0x0e, 0x2b, 0x9d, 0xfa, 0x65,
+	0x89, 0x71, 0x9c, 0x67, 0x81, 0xef, 0x83, 0x51, 0x4c, 0x2a, 0x9d, 0xef, 0xcb, 0x80, 0xf9, 0xc4,
+	0xa4, 0xc2, 0x09, 0xee, 0x81, 0x11, 0x54, 0xa7, 0x4d, 0xc2, 0x12, 0xd1, 0x33, 0xf6, 0xae, 0xc0,
+	0xc3, 0x4f, 0xc1, 0x98, 0xd5, 0xac, 0x37, 0x6b, 0x88, 0xd9, 0x2d, 0x5c, 0x12, 0xa4, 0xc3, 0x67,
+	0x24, 0x8d, 0x1f, 0x53, 0x65, 0x7c, 0xfa, 0x8d, 0xce, 0xe6, 0x3e, 0x77, 0x46, 0xda, 0x8e, 0x46,
+	0xff, 0x0c, 0xa8, 0xbc, 0x09, 0xf2, 0x4f, 0x76, 0x50, 0xd3, 0x95, 0x23, 0x4e, 0xb6, 0x79, 0x77,
+	0x9b, 0x29, 0x67, 0x6a, 0xb3, 0x7d, 0xf9, 0x2a, 0x05, 0x43, 0x88, 0x36, 0x4b, 0x82, 0x18, 0xf6,
+	0x2d, 0xb8, 0xc2, 0x43, 0x8c, 0x9a, 0xc7, 0x07, 0x70, 0x1d, 0x5c, 0xc2, 0x6d, 0xdc, 0xe9, 0x0b,
+	0x7c, 0x11, 0x77, 0x05, 0x9d, 0xff, 0x4f, 0x01, 0xf1, 0xe0, 0x6b, 0x09, 0xb3, 0x60, 0x66, 0xf5,
+	0xbe, 0x99, 0x5f, 0xc9, 0x3c, 0xd8, 0x2c, 0x15, 0x36, 0x36, 0xf3, 0xe6, 0x47, 0x99, 0xb5, 0xd2,
+	0xc3, 0x8d, 0x07, 0xc5, 0xfc, 0x4a, 0x61, 0xb5, 0x90, 0xcf, 0xc5, 0x23, 0xea, 0xec, 0xfe, 0x41,
+	0x7a, 0x3a, 0x08, 0x7c, 0x48, 0xdc, 0x06, 0xb6, 0xec, 0x6d, 0x1b, 0x57, 0xe0, 0x12, 0x98, 0x08,
+	0x73, 0xe4, 0x32, 0x5b, 0x71, 0x45, 0x9d, 0xdc, 0x3f, 0x48, 0x5f, 0x0e, 0x62, 0x73, 0x68, 0x0f,
+	0x2e, 0x83, 0xc9, 0x30, 0x66, 0xfd, 0xfe, 0xc6, 0xe6, 0xbd, 0xf8, 0x90, 0x3a, 0xb5, 0x7f, 0x90,
+	0x9e, 0x08, 0xa2, 0xd6, 0x29, 0x61, 0x3b, 0xf0, 0x0e, 0x78, 0x3b, 0x8c, 0xdb, 0xca, 0x67, 0xcc,
+	0x78, 0x54, 0x4d, 0xec, 0x1f, 0xa4, 0xc7, 0x83, 0xb0, 0x2d, 0x8c, 0x1c, 0x75, 0xf8, 0xab, 0xef,
+	0x52, 0x91, 0xa5, 0xdf, 0x46, 0xc1, 0x39, 0x5e, 0x0d, 0xf8, 0x18, 0x8c, 0xf8, 0x9b, 0x02, 0xbc,
+	0x1a, 0x7e, 0xaf, 0xc3, 0x0b, 0x89, 0x7a, 0xed, 0x0d, 0x5e, 0x7e, 0x39, 0xb5, 0xf4, 0xd3, 0x5f,
+	0xff, 0xf9, 0x7a, 0x48, 0x85, 0x09, 0x23, 0xb4, 0xf4, 0xf8, 0xab, 0x08, 0xfc, 0x56, 0x01, 0xf1,
+	0xe0, 0x96, 0x01, 0xf5, 0x3e, 0xec, 0x7d, 0xf6, 0x15, 0xd5, 0x18, 0xd8, 0x5f, 0xe8, 0xba, 0xc9,
+	0x75, 0x5d, 0x83, 0x73, 0x61, 0x5d, 0xa1, 0xb5, 0x06, 0xfe, 0xa4, 0x80, 0x89, 0x9e, 0xcb, 0x05,
+	0xbc, 0xdd, 0x27, 0xee, 0x49, 0x0b, 0x8b, 0x7a, 0xe7, 0x74, 0x20, 0xa1, 0x78, 0x91, 0x2b, 0xbe,
+	0x09, 0x6f, 0x84, 0x15, 0xf7, 0xd9, 0x6b, 0x78, 0x6a, 0x83, 0x1f, 0xfc, 0xbe, 0xa9, 0xed, 0xb3,
+	0xb8, 0xa8, 0xc6, 0xc0, 0xfe, 0x6f, 0x4e, 0x6d, 0x43, 0x62, 0x4a, 0x8e, 0x50, 0xf3, 0x42, 0x01,
+	0x97, 0x02, 0x5f, 0x6e, 0xb8, 0xd0, 0x27, 0x62, 0xef, 0x05, 0x40, 0xd5, 0x07, 0x75, 0x17, 0xfa,
+	0xe6, 0xb9, 0xbe, 0xab, 0x50, 0x0b, 0xeb, 0x6b, 0x0f, 0xc2, 0x92, 0x7f, 0x26, 0xe4, 0x75, 0x7d,
+	0xa7, 0x4e, 0x90, 0xd7, 0x6b, 0x37, 0x50, 0xf5, 0x41, 0xdd, 0x07, 0x91, 0xc7, 0x21, 0xa5, 0x6d,
+	0x29, 0xe5, 0xb9, 0x02, 0x2e, 0x76, 0xcf, 0x51, 0x78, 0xab, 0x4f, 0xb8, 0x9e, 0x13, 0x5d, 0x5d,
+	0x18, 0xd0, 0x5b, 0x68, 0xbb, 0xc1, 0xb5, 0xcd, 0xc1, 0x2b, 0x61, 0x6d, 0x81, 0xb1, 0x9c, 0x5d,
+	0x7d, 0x79, 0x98, 0x52, 0x5e, 0x1d, 0xa6, 0x94, 0xbf, 0x0e, 0x53, 0xca, 0xb3, 0xa3, 0x54, 0xe4,
+	0xd5, 0x51, 0x2a, 0xf2, 0xfb, 0x51, 0x2a, 0xf2, 0xf1, 0xad, 0xaa, 0xcd, 0x76, 0x9a, 0x65, 0xdd,
+	0xa2, 0x75, 0x41, 0xb3, 0x50, 0x43, 0x65, 0x57, 0x52, 0x3e, 0x91, 0xa4, 0xfc, 0x47, 0x51, 0x79,
+	0x84, 0x0f, 0xf4, 0xdb, 0xff, 0x0f, 0x00, 0x32, 0xec, 0x7e, 0x27, 0x28, 0x0e, 0x00, 0x00,
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params returns the total set of minting parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// AnnualProvisions returns current minting annual provisions value.
+	AnnualProvisions(ctx context.Context, in *QueryAnnualProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualProvisionsResponse, error)
+	// LastDilutionTimestamp returns the time when the last release rate dilution occurred.
+	LastDilutionTimestamp(ctx context.Context, in *QueryLastDilutionTimestampRequest, opts ...grpc.CallOption) (*QueryLastDilutionTimestampResponse, error)
+	// ProjectedRelease returns the release of every schedule projected from
+	// the current block time until the requested time.
+	ProjectedRelease(ctx context.Context, in *QueryProjectedReleaseRequest, opts ...grpc.CallOption) (*QueryProjectedReleaseResponse, error)
+	// RemainingReward returns the reward module balance left to release.
+	RemainingReward(ctx context.Context, in *QueryRemainingRewardRequest, opts ...grpc.CallOption) (*QueryRemainingRewardResponse, error)
+	// ReleaseForecast returns the projected release of every day, month or
+	// year from the current block time until the requested horizon.
+	ReleaseForecast(ctx context.Context, in *QueryReleaseForecastRequest, opts ...grpc.CallOption) (*QueryReleaseForecastResponse, error)
+	// ExhaustionTime returns the estimated time the remaining reward runs out.
+	ExhaustionTime(ctx context.Context, in *QueryExhaustionTimeRequest, opts ...grpc.CallOption) (*QueryExhaustionTimeResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/initia.reward.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AnnualProvisions(ctx context.Context, in *QueryAnnualProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualProvisionsResponse, error) {
+	out := new(QueryAnnualProvisionsResponse)
+	err := c.cc.Invoke(ctx, "/initia.reward.v1.Query/AnnualProvisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LastDilutionTimestamp(ctx context.Context, in *QueryLastDilutionTimestampRequest, opts ...grpc.CallOption) (*QueryLastDilutionTimestampResponse, error) {
+	out := new(QueryLastDilutionTimestampResponse)
+	err := c.cc.Invoke(ctx, "/initia.reward.v1.Query/LastDilutionTimestamp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProjectedRelease(ctx context.Context, in *QueryProjectedReleaseRequest, opts ...grpc.CallOption) (*QueryProjectedReleaseResponse, error) {
+	out := new(QueryProjectedReleaseResponse)
+	err := c.cc.Invoke(ctx, "/initia.reward.v1.Query/ProjectedRelease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RemainingReward(ctx context.Context, in *QueryRemainingRewardRequest, opts ...grpc.CallOption) (*QueryRemainingRewardResponse, error) {
+	out := new(QueryRemainingRewardResponse)
+	err := c.cc.Invoke(ctx, "/initia.reward.v1.Query/RemainingReward", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ReleaseForecast(ctx context.Context, in *QueryReleaseForecastRequest, opts ...grpc.CallOption) (*QueryReleaseForecastResponse, error) {
+	out := new(QueryReleaseForecastResponse)
+	err := c.cc.Invoke(ctx, "/initia.reward.v1.Query/ReleaseForecast", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ExhaustionTime(ctx context.Context, in *QueryExhaustionTimeRequest, opts ...grpc.CallOption) (*QueryExhaustionTimeResponse, error) {
+	out := new(QueryExhaustionTimeResponse)
+	err := c.cc.Invoke(ctx, "/initia.reward.v1.Query/ExhaustionTime", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params returns the total set of minting parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// AnnualProvisions returns current minting annual provisions value.
+	AnnualProvisions(context.Context, *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error)
+	// LastDilutionTimestamp returns the time when the last release rate dilution occurred.
+	LastDilutionTimestamp(context.Context, *QueryLastDilutionTimestampRequest) (*QueryLastDilutionTimestampResponse, error)
+	// ProjectedRelease returns the release of every schedule projected from
+	// the current block time until the requested time.
+	ProjectedRelease(context.Context, *QueryProjectedReleaseRequest) (*QueryProjectedReleaseResponse, error)
+	// RemainingReward returns the reward module balance left to release.
+	RemainingReward(context.Context, *QueryRemainingRewardRequest) (*QueryRemainingRewardResponse, error)
+	// ReleaseForecast returns the projected release of every day, month or
+	// year from the current block time until the requested horizon.
+	ReleaseForecast(context.Context, *QueryReleaseForecastRequest) (*QueryReleaseForecastResponse, error)
+	// ExhaustionTime returns the estimated time the remaining reward runs out.
+	ExhaustionTime(context.Context, *QueryExhaustionTimeRequest) (*QueryExhaustionTimeResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) AnnualProvisions(ctx context.Context, req *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnnualProvisions not implemented")
+}
+func (*UnimplementedQueryServer) LastDilutionTimestamp(ctx context.Context, req *QueryLastDilutionTimestampRequest) (*QueryLastDilutionTimestampResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LastDilutionTimestamp not implemented")
+}
+func (*UnimplementedQueryServer) ProjectedRelease(ctx context.Context, req *QueryProjectedReleaseRequest) (*QueryProjectedReleaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectedRelease not implemented")
+}
+func (*UnimplementedQueryServer) RemainingReward(ctx context.Context, req *QueryRemainingRewardRequest) (*QueryRemainingRewardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemainingReward not implemented")
+}
+func (*UnimplementedQueryServer) ReleaseForecast(ctx context.Context, req *QueryReleaseForecastRequest) (*QueryReleaseForecastResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseForecast not implemented")
+}
+func (*UnimplementedQueryServer) ExhaustionTime(ctx context.Context, req *QueryExhaustionTimeRequest) (*QueryExhaustionTimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExhaustionTime not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.reward.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AnnualProvisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAnnualProvisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AnnualProvisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.reward.v1.Query/AnnualProvisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AnnualProvisions(ctx, req.(*QueryAnnualProvisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LastDilutionTimestamp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLastDilutionTimestampRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LastDilutionTimestamp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.reward.v1.Query/LastDilutionTimestamp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LastDilutionTimestamp(ctx, req.(*QueryLastDilutionTimestampRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProjectedRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectedReleaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProjectedRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.reward.v1.Query/ProjectedRelease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProjectedRelease(ctx, req.(*QueryProjectedReleaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RemainingReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRemainingRewardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RemainingReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.reward.v1.Query/RemainingReward",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RemainingReward(ctx, req.(*QueryRemainingRewardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ReleaseForecast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReleaseForecastRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ReleaseForecast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.reward.v1.Query/ReleaseForecast",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReleaseForecast(ctx, req.(*QueryReleaseForecastRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExhaustionTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExhaustionTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExhaustionTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.reward.v1.Query/ExhaustionTime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExhaustionTime(ctx, req.(*QueryExhaustionTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "initia.reward.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "AnnualProvisions",
+			Handler:    _Query_AnnualProvisions_Handler,
+		},
+		{
+			MethodName: "LastDilutionTimestamp",
+			Handler:    _Query_LastDilutionTimestamp_Handler,
+		},
+		{
+			MethodName: "ProjectedRelease",
+			Handler:    _Query_ProjectedRelease_Handler,
+		},
+		{
+			MethodName: "RemainingReward",
+			Handler:    _Query_RemainingReward_Handler,
+		},
+		{
+			MethodName: "ReleaseForecast",
+			Handler:    _Query_ReleaseForecast_Handler,
+		},
+		{
+			MethodName: "ExhaustionTime",
+			Handler:    _Query_ExhaustionTime_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "initia/reward/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAnnualProvisionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAnnualProvisionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAnnualProvisionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAnnualProvisionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAnnualProvisionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAnnualProvisionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.AnnualProvisions.Size()
+		i -= size
+		if _, err := m.AnnualProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLastDilutionTimestampRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLastDilutionTimestampRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLastDilutionTimestampRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLastDilutionTimestampResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLastDilutionTimestampResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLastDilutionTimestampResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastDilutionTimestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastDilutionTimestamp):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintQuery(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProjectedReleaseRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectedReleaseRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectedReleaseRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.BlockTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintQuery(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintQuery(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProjectedReleaseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectedReleaseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectedReleaseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Total.Size()
+		i -= size
+		if _, err := m.Total.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Projections) > 0 {
+		for iNdEx := len(m.Projections) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Projections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRemainingRewardRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRemainingRewardRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRemainingRewardRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRemainingRewardResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRemainingRewardResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRemainingRewardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Remaining.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReleaseForecastRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReleaseForecastRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReleaseForecastRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.BlockTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintQuery(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x1a
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Horizon, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Horizon):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintQuery(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x12
+	if m.Interval != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReleaseForecastResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReleaseForecastResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReleaseForecastResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReleaseForecastEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReleaseForecastEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReleaseForecastEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Remaining.Size()
+		i -= size
+		if _, err := m.Remaining.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.CumulativeAmount.Size()
+		i -= size
+		if _, err := m.CumulativeAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintQuery(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x12
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintQuery(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExhaustionTimeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExhaustionTimeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExhaustionTimeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.BlockTime):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintQuery(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExhaustionTimeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExhaustionTimeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExhaustionTimeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExhaustionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExhaustionTime):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintQuery(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x12
+	if m.Exhausted {
+		i--
+		if m.Exhausted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAnnualProvisionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAnnualProvisionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AnnualProvisions.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryLastDilutionTimestampRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLastDilutionTimestampResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastDilutionTimestamp)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryProjectedReleaseRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.BlockTime)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryProjectedReleaseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Projections) > 0 {
+		for _, e := range m.Projections {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Total.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRemainingRewardRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRemainingRewardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Remaining.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryReleaseForecastRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Interval != 0 {
+		n += 1 + sovQuery(uint64(m.Interval))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Horizon)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.BlockTime)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryReleaseForecastResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ReleaseForecastEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CumulativeAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Remaining.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryExhaustionTimeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.BlockTime)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryExhaustionTimeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Exhausted {
+		n += 2
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExhaustionTime)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAnnualProvisionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAnnualProvisionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAnnualProvisionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAnnualProvisionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAnnualProvisionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAnnualProvisionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLastDilutionTimestampRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLastDilutionTimestampRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLastDilutionTimestampRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLastDilutionTimestampResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLastDilutionTimestampResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLastDilutionTimestampResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDilutionTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.LastDilutionTimestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProjectedReleaseRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProjectedReleaseRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProjectedReleaseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProjectedReleaseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProjectedReleaseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProjectedReleaseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Projections", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Projections = append(m.Projections, ReleaseProjection{})
+			if err := m.Projections[len(m.Projections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRemainingRewardRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRemainingRewardRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRemainingRewardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRemainingRewardResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRemainingRewardResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRemainingRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Remaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReleaseForecastRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReleaseForecastRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReleaseForecastRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= ForecastInterval(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Horizon", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Horizon, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -783,7 +2838,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryReleaseForecastResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -806,15 +2861,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryReleaseForecastResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryReleaseForecastResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -841,7 +2896,8 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Entries = append(m.Entries, ReleaseForecastEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -866,7 +2922,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAnnualProvisionsRequest) Unmarshal(dAtA []byte) error {
+func (m *ReleaseForecastEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -889,67 +2945,117 @@ func (m *QueryAnnualProvisionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAnnualProvisionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReleaseForecastEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAnnualProvisionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReleaseForecastEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAnnualProvisionsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAnnualProvisionsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAnnualProvisionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeAmount", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -959,22 +3065,57 @@ func (m *QueryAnnualProvisionsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CumulativeAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Remaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -999,7 +3140,7 @@ func (m *QueryAnnualProvisionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLastDilutionTimestampRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryExhaustionTimeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1022,12 +3163,45 @@ func (m *QueryLastDilutionTimestampRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLastDilutionTimestampRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryExhaustionTimeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLastDilutionTimestampRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryExhaustionTimeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1049,7 +3223,7 @@ func (m *QueryLastDilutionTimestampRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLastDilutionTimestampResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryExhaustionTimeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1072,15 +3246,35 @@ func (m *QueryLastDilutionTimestampResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLastDilutionTimestampResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryExhaustionTimeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLastDilutionTimestampResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryExhaustionTimeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 3:
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exhausted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Exhausted = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastDilutionTimestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExhaustionTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1107,7 +3301,7 @@ func (m *QueryLastDilutionTimestampResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.LastDilutionTimestamp, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExhaustionTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,234 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+)
+
+const (
+	// DestinationCommunityPool is the release schedule destination of the community pool.
+	DestinationCommunityPool = "community_pool"
+
+	// AnnualPeriod is the period an annual release rate is released over.
+	AnnualPeriod = time.Hour * 24 * 365
+
+	// DefaultProjectionBlockTime is the block interval fixed per block schedules
+	// are projected with, which is the default commit timeout.
+	DefaultProjectionBlockTime = 2 * time.Second
+
+	// MaxProjectionHorizon bounds how far a release can be projected.
+	MaxProjectionHorizon = 100 * AnnualPeriod
+)
+
+// Validate performs basic validation on the release schedule.
+func (s ReleaseSchedule) Validate() error {
+	if s.Name == "" {
+		return fmt.Errorf("empty schedule name")
+	}
+
+	if s.Destination == ModuleName {
+		return fmt.Errorf("schedule %s releases to the reward module itself", s.Name)
+	}
+
+	if !s.EndTime.IsZero() && !s.EndTime.After(s.StartTime) {
+		return fmt.Errorf("end time of schedule %s must be after its start time", s.Name)
+	}
+
+	switch s.ScheduleType {
+	case ScheduleTypeFixedPerBlock:
+		if s.AmountPerBlock.IsNil() || !s.AmountPerBlock.IsPositive() {
+			return fmt.Errorf("amount per block of schedule %s must be positive", s.Name)
+		}
+	case ScheduleTypeHalving:
+		if s.StartTime.IsZero() {
+			return fmt.Errorf("halving schedule %s must have a start time", s.Name)
+		}
+		if s.HalvingPeriod <= 0 {
+			return fmt.Errorf("halving period of schedule %s must be positive", s.Name)
+		}
+		if s.ReleaseRate.IsNil() {
+			return fmt.Errorf("empty release rate of schedule %s", s.Name)
+		}
+		if err := validateReleaseRate(s.ReleaseRate); err != nil {
+			return fmt.Errorf("invalid release rate of schedule %s: %w", s.Name, err)
+		}
+	case ScheduleTypePiecewiseLinear, ScheduleTypeTable:
+		if len(s.Points) == 0 {
+			return fmt.Errorf("schedule %s has no points", s.Name)
+		}
+		for i, point := range s.Points {
+			if i > 0 && !point.Time.After(s.Points[i-1].Time) {
+				return fmt.Errorf("points of schedule %s must be in ascending time order", s.Name)
+			}
+			if point.ReleaseRate.IsNil() {
+				return fmt.Errorf("empty release rate of schedule %s", s.Name)
+			}
+			if err := validateReleaseRate(point.ReleaseRate); err != nil {
+				return fmt.Errorf("invalid release rate of schedule %s: %w", s.Name, err)
+			}
+		}
+	default:
+		return fmt.Errorf("invalid type of schedule %s: %s", s.Name, s.ScheduleType)
+	}
+
+	return nil
+}
+
+// IsActive returns whether the schedule releases at t.
+func (s ReleaseSchedule) IsActive(t time.Time) bool {
+	return !t.Before(s.StartTime) && (s.EndTime.IsZero() || t.Before(s.EndTime))
+}
+
+// ReleaseRateAt returns the annual release rate of the schedule at t. It is
+// zero for fixed per block schedules.
+func (s ReleaseSchedule) ReleaseRateAt(t time.Time) math.LegacyDec {
+	if !s.IsActive(t) {
+		return math.LegacyZeroDec()
+	}
+
+	switch s.ScheduleType {
+	case ScheduleTypeHalving:
+		return GeometricRateAt(s.ReleaseRate, math.LegacyNewDecWithPrec(5, 1), s.StartTime, s.HalvingPeriod, t)
+	case ScheduleTypeTable:
+		rate := math.LegacyZeroDec()
+		for _, point := range s.Points {
+			if point.Time.After(t) {
+				break
+			}
+
+			rate = point.ReleaseRate
+		}
+
+		return rate
+	case ScheduleTypePiecewiseLinear:
+		if len(s.Points) == 0 || t.Before(s.Points[0].Time) {
+			return math.LegacyZeroDec()
+		}
+
+		for i := 1; i < len(s.Points); i++ {
+			prev, next := s.Points[i-1], s.Points[i]
+			if t.Before(next.Time) {
+				// prev + (next - prev) * (t - prev.time) / (next.time - prev.time)
+				return next.ReleaseRate.Sub(prev.ReleaseRate).
+					MulInt64(int64(t.Sub(prev.Time))).
+					QuoInt64(int64(next.Time.Sub(prev.Time))).
+					Add(prev.ReleaseRate)
+			}
+		}
+
+		return s.Points[len(s.Points)-1].ReleaseRate
+	}
+
+	return math.LegacyZeroDec()
+}
+
+// Release returns the amount a rate based schedule releases of the supply
+// from `from` until `to`. It is zero for fixed per block schedules.
+func (s ReleaseSchedule) Release(from, to time.Time, supply math.Int) math.LegacyDec {
+	// clip the period to the active window
+	if from.Before(s.StartTime) {
+		from = s.StartTime
+	}
+	if !s.EndTime.IsZero() && to.After(s.EndTime) {
+		to = s.EndTime
+	}
+	if !to.After(from) {
+		return math.LegacyZeroDec()
+	}
+
+	switch s.ScheduleType {
+	case ScheduleTypeHalving:
+		return GeometricRelease(s.ReleaseRate, math.LegacyNewDecWithPrec(5, 1), s.StartTime, s.HalvingPeriod, from, to, supply)
+	case ScheduleTypePiecewiseLinear, ScheduleTypeTable:
+		// the rate is constant or linear between two points, so the rate at the
+		// midpoint of each segment gives its exact average.
+		times := []time.Time{from}
+		for _, point := range s.Points {
+			if point.Time.After(from) && point.Time.Before(to) {
+				times = append(times, point.Time)
+			}
+		}
+		times = append(times, to)
+
+		integral := math.LegacyZeroDec()
+		for i := 1; i < len(times); i++ {
+			elapsed := times[i].Sub(times[i-1])
+			mid := times[i-1].Add(elapsed / 2)
+			integral = integral.Add(s.ReleaseRateAt(mid).MulInt64(int64(elapsed)))
+		}
+
+		return integral.MulInt(supply).QuoInt64(int64(AnnualPeriod))
+	}
+
+	return math.LegacyZeroDec()
+}
+
+// ActiveDuration returns how long the schedule is active from `from` until `to`.
+func (s ReleaseSchedule) ActiveDuration(from, to time.Time) time.Duration {
+	if from.Before(s.StartTime) {
+		from = s.StartTime
+	}
+	if !s.EndTime.IsZero() && to.After(s.EndTime) {
+		to = s.EndTime
+	}
+	if !to.After(from) {
+		return 0
+	}
+
+	return to.Sub(from)
+}
+
+// GeometricRelease returns the amount released of the supply from `from`
+// until `to` at an annual rate that is multiplied by the factor every period
+// after start.
+// CONTRACT: start is not after from.
+func GeometricRelease(
+	rate, factor math.LegacyDec,
+	start time.Time, period time.Duration,
+	from, to time.Time,
+	supply math.Int,
+) math.LegacyDec {
+	if !to.After(from) {
+		return math.LegacyZeroDec()
+	}
+
+	fromPeriod := uint64(from.Sub(start) / period) //nolint:gosec
+	toPeriod := uint64(to.Sub(start) / period)     //nolint:gosec
+	if fromPeriod == toPeriod {
+		return rate.Mul(factor.Power(fromPeriod)).
+			MulInt64(int64(to.Sub(from))).
+			MulInt(supply).QuoInt64(int64(AnnualPeriod))
+	}
+
+	// the partial periods at both ends
+	fromEnd := start.Add(time.Duration(fromPeriod+1) * period)
+	toStart := start.Add(time.Duration(toPeriod) * period)
+	integral := rate.Mul(factor.Power(fromPeriod)).MulInt64(int64(fromEnd.Sub(from))).
+		Add(rate.Mul(factor.Power(toPeriod)).MulInt64(int64(to.Sub(toStart))))
+
+	// the full periods in between, a geometric series
+	if fullPeriods := toPeriod - fromPeriod - 1; fullPeriods > 0 {
+		first := rate.Mul(factor.Power(fromPeriod + 1)).MulInt64(int64(period))
+		if factor.Equal(math.LegacyOneDec()) {
+			integral = integral.Add(first.MulInt64(int64(fullPeriods))) //nolint:gosec
+		} else {
+			integral = integral.Add(first.
+				Mul(math.LegacyOneDec().Sub(factor.Power(fullPeriods))).
+				Quo(math.LegacyOneDec().Sub(factor)))
+		}
+	}
+
+	return integral.MulInt(supply).QuoInt64(int64(AnnualPeriod))
+}
+
+// GeometricRateAt returns the annual rate at t that is multiplied by the
+// factor every period after start.
+func GeometricRateAt(rate, factor math.LegacyDec, start time.Time, period time.Duration, t time.Time) math.LegacyDec {
+	if t.Before(start) {
+		return rate
+	}
+
+	return rate.Mul(factor.Power(uint64(t.Sub(start) / period))) //nolint:gosec
+}
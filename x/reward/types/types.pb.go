@@ -12,6 +12,7 @@ import (
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -30,6 +31,48 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ScheduleType defines the release curve of a release schedule.
+type ScheduleType int32
+
+const (
+	// SCHEDULE_TYPE_UNSPECIFIED defines an invalid schedule type.
+	ScheduleTypeUnspecified ScheduleType = 0
+	// SCHEDULE_TYPE_FIXED_PER_BLOCK releases a fixed amount on every block.
+	ScheduleTypeFixedPerBlock ScheduleType = 1
+	// SCHEDULE_TYPE_PIECEWISE_LINEAR interpolates the release rate linearly
+	// between the schedule points.
+	ScheduleTypePiecewiseLinear ScheduleType = 2
+	// SCHEDULE_TYPE_HALVING halves the release rate every halving period.
+	ScheduleTypeHalving ScheduleType = 3
+	// SCHEDULE_TYPE_TABLE holds the release rate of a schedule point until
+	// the next point.
+	ScheduleTypeTable ScheduleType = 4
+)
+
+var ScheduleType_name = map[int32]string{
+	0: "SCHEDULE_TYPE_UNSPECIFIED",
+	1: "SCHEDULE_TYPE_FIXED_PER_BLOCK",
+	2: "SCHEDULE_TYPE_PIECEWISE_LINEAR",
+	3: "SCHEDULE_TYPE_HALVING",
+	4: "SCHEDULE_TYPE_TABLE",
+}
+
+var ScheduleType_value = map[string]int32{
+	"SCHEDULE_TYPE_UNSPECIFIED":      0,
+	"SCHEDULE_TYPE_FIXED_PER_BLOCK":  1,
+	"SCHEDULE_TYPE_PIECEWISE_LINEAR": 2,
+	"SCHEDULE_TYPE_HALVING":          3,
+	"SCHEDULE_TYPE_TABLE":            4,
+}
+
+func (x ScheduleType) String() string {
+	return proto.EnumName(ScheduleType_name, int32(x))
+}
+
+func (ScheduleType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4a8f585f1737696c, []int{0}
+}
+
 // Params defines the set of mint parameters.
 type Params struct {
 	RewardDenom    string                      `protobuf:"bytes,1,opt,name=reward_denom,json=rewardDenom,proto3" json:"reward_denom,omitempty"`
@@ -41,6 +84,9 @@ type Params struct {
 	//    `last_dilution_timestamp = block.timestamp`
 	DilutionRate   cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=dilution_rate,json=dilutionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"dilution_rate" yaml:"dilution_rate"`
 	ReleaseEnabled bool                        `protobuf:"varint,5,opt,name=release_enabled,json=releaseEnabled,proto3" json:"release_enabled,omitempty"`
+	// release_schedules are released in addition to the release rate above,
+	// which can be set to zero to release by the schedules only.
+	ReleaseSchedules []ReleaseSchedule `protobuf:"bytes,6,rep,name=release_schedules,json=releaseSchedules,proto3" json:"release_schedules" yaml:"release_schedules"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -75,42 +121,227 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+// ReleaseSchedule defines a governance configured release of the reward
+// module balance. Release rates are annual rates of the reward denom supply.
+type ReleaseSchedule struct {
+	// name identifies the schedule in events and queries.
+	Name         string       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ScheduleType ScheduleType `protobuf:"varint,2,opt,name=schedule_type,json=scheduleType,proto3,enum=initia.reward.v1.ScheduleType" json:"schedule_type,omitempty" yaml:"schedule_type"`
+	// destination receives the released coins. It is the fee collector when
+	// empty, the community pool when "community_pool", or the module account
+	// of the given module name otherwise.
+	Destination string `protobuf:"bytes,3,opt,name=destination,proto3" json:"destination,omitempty"`
+	// start_time and end_time bound the release. A zero end_time never ends.
+	StartTime time.Time `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	EndTime   time.Time `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time" yaml:"end_time"`
+	// amount_per_block is the amount released on every block by a fixed per
+	// block schedule. A block whose interval the schedule starts or ends within
+	// releases the active share of it only.
+	AmountPerBlock cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=amount_per_block,json=amountPerBlock,proto3,customtype=cosmossdk.io/math.Int" json:"amount_per_block" yaml:"amount_per_block"`
+	// release_rate is the initial release rate of a halving schedule.
+	ReleaseRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=release_rate,json=releaseRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"release_rate" yaml:"release_rate"`
+	// halving_period is the period the release rate of a halving schedule is
+	// halved every, counted from the start time.
+	HalvingPeriod time.Duration `protobuf:"bytes,8,opt,name=halving_period,json=halvingPeriod,proto3,stdduration" json:"halving_period" yaml:"halving_period"`
+	// points are the release rates of a piecewise linear or table schedule in
+	// ascending time order. Nothing is released before the first point and
+	// the rate of the last point holds after it.
+	Points []SchedulePoint `protobuf:"bytes,9,rep,name=points,proto3" json:"points"`
+}
+
+func (m *ReleaseSchedule) Reset()         { *m = ReleaseSchedule{} }
+func (m *ReleaseSchedule) String() string { return proto.CompactTextString(m) }
+func (*ReleaseSchedule) ProtoMessage()    {}
+func (*ReleaseSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8f585f1737696c, []int{1}
+}
+func (m *ReleaseSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReleaseSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReleaseSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReleaseSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseSchedule.Merge(m, src)
+}
+func (m *ReleaseSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReleaseSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleaseSchedule proto.InternalMessageInfo
+
+// SchedulePoint defines the release rate of a schedule at a time.
+type SchedulePoint struct {
+	Time        time.Time                   `protobuf:"bytes,1,opt,name=time,proto3,stdtime" json:"time"`
+	ReleaseRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=release_rate,json=releaseRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"release_rate" yaml:"release_rate"`
+}
+
+func (m *SchedulePoint) Reset()         { *m = SchedulePoint{} }
+func (m *SchedulePoint) String() string { return proto.CompactTextString(m) }
+func (*SchedulePoint) ProtoMessage()    {}
+func (*SchedulePoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8f585f1737696c, []int{2}
+}
+func (m *SchedulePoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SchedulePoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SchedulePoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SchedulePoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SchedulePoint.Merge(m, src)
+}
+func (m *SchedulePoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *SchedulePoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_SchedulePoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SchedulePoint proto.InternalMessageInfo
+
+// ReleaseProjection defines the projected release of a schedule.
+type ReleaseProjection struct {
+	// name is the schedule name, or empty for the release rate of the params.
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Destination string `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
+	// amount is the amount released from the current block time until the
+	// projected time.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// release_rate is the annual release rate of the schedule at the projected
+	// time. It is zero for fixed per block schedules.
+	ReleaseRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=release_rate,json=releaseRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"release_rate" yaml:"release_rate"`
+}
+
+func (m *ReleaseProjection) Reset()         { *m = ReleaseProjection{} }
+func (m *ReleaseProjection) String() string { return proto.CompactTextString(m) }
+func (*ReleaseProjection) ProtoMessage()    {}
+func (*ReleaseProjection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8f585f1737696c, []int{3}
+}
+func (m *ReleaseProjection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReleaseProjection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReleaseProjection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReleaseProjection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseProjection.Merge(m, src)
+}
+func (m *ReleaseProjection) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReleaseProjection) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseProjection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleaseProjection proto.InternalMessageInfo
+
 func init() {
+	proto.RegisterEnum("initia.reward.v1.ScheduleType", ScheduleType_name, ScheduleType_value)
 	proto.RegisterType((*Params)(nil), "initia.reward.v1.Params")
+	proto.RegisterType((*ReleaseSchedule)(nil), "initia.reward.v1.ReleaseSchedule")
+	proto.RegisterType((*SchedulePoint)(nil), "initia.reward.v1.SchedulePoint")
+	proto.RegisterType((*ReleaseProjection)(nil), "initia.reward.v1.ReleaseProjection")
 }
 
 func init() { proto.RegisterFile("initia/reward/v1/types.proto", fileDescriptor_4a8f585f1737696c) }
 
 var fileDescriptor_4a8f585f1737696c = []byte{
-	// 437 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x3f, 0x6f, 0xd4, 0x30,
-	0x18, 0xc6, 0x63, 0x0a, 0x15, 0xe4, 0x7a, 0x2d, 0x84, 0x0e, 0xe9, 0x81, 0x9c, 0xe3, 0x16, 0x4e,
-	0x15, 0xb5, 0x55, 0xd8, 0x3a, 0x9e, 0xc2, 0x06, 0x55, 0xc9, 0xc8, 0x12, 0x39, 0x89, 0x49, 0x2d,
-	0x92, 0x38, 0x8a, 0x9d, 0xc2, 0x7d, 0x05, 0x26, 0xc6, 0x8e, 0x1d, 0x4f, 0x4c, 0x37, 0xf0, 0x21,
-	0x6e, 0x3c, 0x31, 0x21, 0x86, 0x03, 0x72, 0xc3, 0x31, 0xf3, 0x09, 0x50, 0x6c, 0x07, 0x81, 0xc4,
-	0xc0, 0x12, 0xf9, 0x7d, 0xde, 0x3f, 0xbf, 0xd8, 0xef, 0x63, 0xdf, 0x67, 0x05, 0x93, 0x8c, 0xe0,
-	0x8a, 0xbe, 0x21, 0x55, 0x82, 0x2f, 0x8e, 0xb1, 0x9c, 0x96, 0x54, 0xa0, 0xb2, 0xe2, 0x92, 0x3b,
-	0xb7, 0x75, 0x16, 0xe9, 0x2c, 0xba, 0x38, 0x1e, 0xdc, 0x21, 0x39, 0x2b, 0x38, 0x56, 0x5f, 0x5d,
-	0x34, 0x38, 0x88, 0xb9, 0xc8, 0xb9, 0x08, 0x55, 0x84, 0x75, 0x60, 0x52, 0xfb, 0x29, 0x4f, 0xb9,
-	0xd6, 0xdb, 0x93, 0x51, 0x61, 0xca, 0x79, 0x9a, 0x51, 0xac, 0xa2, 0xa8, 0x7e, 0x85, 0x93, 0xba,
-	0x22, 0x92, 0xf1, 0x42, 0xe7, 0x47, 0x1f, 0xb6, 0xec, 0xed, 0x33, 0x52, 0x91, 0x5c, 0x38, 0x0f,
-	0xec, 0x1d, 0xcd, 0x0e, 0x13, 0x5a, 0xf0, 0xdc, 0x05, 0x43, 0x30, 0xbe, 0x15, 0xf4, 0xb4, 0xe6,
-	0xb7, 0x92, 0xf3, 0xc2, 0xde, 0x4b, 0x58, 0x56, 0xb7, 0xfd, 0x61, 0x49, 0x2b, 0xc6, 0x13, 0xf7,
-	0xda, 0x10, 0x8c, 0x7b, 0x8f, 0x0f, 0x90, 0xe6, 0xa0, 0x8e, 0x83, 0x7c, 0xc3, 0x99, 0xf4, 0x17,
-	0x2b, 0xcf, 0xba, 0xfc, 0xea, 0x81, 0xd9, 0x66, 0x7e, 0x08, 0x82, 0xdd, 0x6e, 0xc0, 0x99, 0xea,
-	0x77, 0xca, 0x96, 0x9a, 0x51, 0x22, 0x68, 0x58, 0x11, 0x49, 0xdd, 0xad, 0x96, 0x3a, 0x79, 0xde,
-	0x36, 0x7d, 0x59, 0x79, 0xf7, 0xf4, 0x15, 0x45, 0xf2, 0x1a, 0x31, 0x8e, 0x73, 0x22, 0xcf, 0xd1,
-	0x33, 0x9a, 0x92, 0x78, 0xea, 0xd3, 0xf8, 0xe7, 0xca, 0xbb, 0x3b, 0x25, 0x79, 0x76, 0x32, 0xfa,
-	0x73, 0xc0, 0xe8, 0xd3, 0xc7, 0x23, 0xdb, 0x3c, 0x8c, 0x4f, 0x63, 0x0d, 0xed, 0x99, 0x8a, 0x80,
-	0x48, 0xea, 0x08, 0xbb, 0xff, 0xfb, 0x12, 0x0a, 0x79, 0x5d, 0x21, 0x4f, 0xff, 0x0f, 0xb9, 0xaf,
-	0x91, 0x7f, 0x4d, 0xf8, 0x27, 0x73, 0xa7, 0x2b, 0x51, 0xd0, 0x87, 0xf6, 0x5e, 0xf7, 0x97, 0xb4,
-	0x20, 0x51, 0x46, 0x13, 0xf7, 0xc6, 0x10, 0x8c, 0x6f, 0x06, 0xbb, 0x46, 0x7e, 0xaa, 0xd5, 0x93,
-	0xc1, 0xe5, 0x95, 0x67, 0xfd, 0xb8, 0xf2, 0xc0, 0xbb, 0xcd, 0xfc, 0xb0, 0x6f, 0xac, 0xa2, 0x37,
-	0x34, 0x39, 0x5d, 0x7c, 0x87, 0xd6, 0xac, 0x81, 0x60, 0xd1, 0x40, 0xb0, 0x6c, 0x20, 0xf8, 0xd6,
-	0x40, 0xf0, 0x7e, 0x0d, 0xad, 0xe5, 0x1a, 0x5a, 0x9f, 0xd7, 0xd0, 0x7a, 0xf9, 0x28, 0x65, 0xf2,
-	0xbc, 0x8e, 0x50, 0xcc, 0x73, 0xac, 0xfd, 0x74, 0x94, 0x91, 0x48, 0x98, 0x33, 0x7e, 0xdb, 0x79,
-	0x4f, 0x19, 0x2f, 0xda, 0x56, 0xdb, 0x7a, 0xf2, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x99, 0xa9, 0x5a,
-	0x9b, 0x99, 0x02, 0x00, 0x00,
+	// 1007 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x31, 0x6f, 0xdb, 0x46,
+	0x18, 0x15, 0x65, 0x59, 0xb1, 0x4f, 0x96, 0x2d, 0xd3, 0x31, 0x2c, 0xd3, 0x0d, 0xc9, 0x10, 0x68,
+	0x6b, 0x18, 0x0d, 0xd9, 0xb8, 0x9b, 0x81, 0x02, 0x35, 0x2d, 0xba, 0x16, 0xa2, 0xba, 0xac, 0x6c,
+	0x37, 0x6d, 0x81, 0x80, 0x38, 0x91, 0x17, 0xf9, 0x1a, 0x91, 0x14, 0x48, 0xca, 0x89, 0x7f, 0x40,
+	0x81, 0x42, 0x53, 0xc6, 0x2c, 0x06, 0x52, 0x74, 0xc9, 0x98, 0xa1, 0x5b, 0x97, 0x8e, 0x1e, 0x83,
+	0x4e, 0x45, 0x07, 0xb5, 0xb5, 0x87, 0x74, 0x36, 0xd0, 0xbd, 0xe0, 0xdd, 0x51, 0x26, 0x25, 0x37,
+	0xe9, 0xe2, 0x45, 0x20, 0xbf, 0xfb, 0xde, 0x7b, 0xc7, 0x8f, 0xef, 0x9d, 0x08, 0xde, 0xc1, 0x1e,
+	0x8e, 0x30, 0xd4, 0x02, 0xf4, 0x18, 0x06, 0x8e, 0x76, 0x74, 0x57, 0x8b, 0x8e, 0xbb, 0x28, 0x54,
+	0xbb, 0x81, 0x1f, 0xf9, 0x7c, 0x85, 0xae, 0xaa, 0x74, 0x55, 0x3d, 0xba, 0x2b, 0xcc, 0x43, 0x17,
+	0x7b, 0xbe, 0x46, 0x7e, 0x69, 0x93, 0xb0, 0x6c, 0xfb, 0xa1, 0xeb, 0x87, 0x16, 0xb9, 0xd3, 0xe8,
+	0x0d, 0x5b, 0xba, 0xd9, 0xf6, 0xdb, 0x3e, 0xad, 0xc7, 0x57, 0xac, 0x2a, 0xb6, 0x7d, 0xbf, 0xdd,
+	0x41, 0x1a, 0xb9, 0x6b, 0xf5, 0x1e, 0x6a, 0x4e, 0x2f, 0x80, 0x11, 0xf6, 0x3d, 0xb6, 0x2e, 0x8d,
+	0xae, 0x47, 0xd8, 0x45, 0x61, 0x04, 0xdd, 0x2e, 0x6d, 0x50, 0x7e, 0x28, 0x80, 0xa2, 0x09, 0x03,
+	0xe8, 0x86, 0xfc, 0x6d, 0x30, 0x43, 0x37, 0x67, 0x39, 0xc8, 0xf3, 0xdd, 0x2a, 0x27, 0x73, 0xab,
+	0xd3, 0xcd, 0x12, 0xad, 0xd5, 0xe2, 0x12, 0xff, 0x05, 0x98, 0x73, 0x70, 0xa7, 0x17, 0x0b, 0x58,
+	0x5d, 0x14, 0x60, 0xdf, 0xa9, 0xe6, 0x65, 0x6e, 0xb5, 0xb4, 0xbe, 0xac, 0x52, 0x21, 0x35, 0x11,
+	0x52, 0x6b, 0x6c, 0x23, 0x7a, 0xf9, 0x74, 0x20, 0xe5, 0x9e, 0xfd, 0x21, 0x71, 0x2f, 0x5e, 0xbf,
+	0x5c, 0xe3, 0x9a, 0xb3, 0x09, 0x81, 0x49, 0xf0, 0x7c, 0x37, 0x56, 0xed, 0x20, 0x18, 0x22, 0x2b,
+	0x80, 0x11, 0xaa, 0x4e, 0xc4, 0xaa, 0xfa, 0x67, 0x31, 0xe8, 0xf7, 0x81, 0xb4, 0x42, 0x67, 0x10,
+	0x3a, 0x8f, 0x54, 0xec, 0x6b, 0x2e, 0x8c, 0x0e, 0xd5, 0x06, 0x6a, 0x43, 0xfb, 0xb8, 0x86, 0xec,
+	0x8b, 0x81, 0xb4, 0x70, 0x0c, 0xdd, 0xce, 0x86, 0x92, 0x26, 0x50, 0x7e, 0xfd, 0xe9, 0x0e, 0x60,
+	0x93, 0xab, 0x21, 0x9b, 0x8a, 0x96, 0x58, 0x47, 0x13, 0x46, 0x88, 0x0f, 0x41, 0x79, 0xf8, 0x10,
+	0x44, 0xb2, 0x40, 0x24, 0x77, 0xff, 0x9f, 0xe4, 0x4d, 0x2a, 0x99, 0x61, 0xb8, 0x52, 0x73, 0x26,
+	0x69, 0x21, 0xa2, 0xef, 0x83, 0xb9, 0x64, 0x97, 0xc8, 0x83, 0xad, 0x0e, 0x72, 0xaa, 0x93, 0x32,
+	0xb7, 0x3a, 0xd5, 0x9c, 0x65, 0x65, 0x83, 0x56, 0xf9, 0x1e, 0x98, 0x4f, 0x1a, 0x43, 0xfb, 0x10,
+	0x39, 0xbd, 0x0e, 0x0a, 0xab, 0x45, 0x79, 0x62, 0xb5, 0xb4, 0x7e, 0x5b, 0x1d, 0xf5, 0x90, 0xda,
+	0xa4, 0xad, 0x7b, 0xac, 0x53, 0x7f, 0x37, 0x7e, 0x88, 0x8b, 0x81, 0x54, 0xcd, 0x0e, 0x66, 0xc8,
+	0xa4, 0xd0, 0xbd, 0x55, 0x82, 0x2c, 0x2e, 0xdc, 0x10, 0x9e, 0x3d, 0x97, 0x72, 0x7f, 0x3f, 0x97,
+	0xb8, 0xfe, 0xeb, 0x97, 0x6b, 0x65, 0x66, 0x61, 0x6a, 0x0c, 0xe5, 0x9f, 0x49, 0x30, 0x37, 0x22,
+	0xc4, 0xf3, 0xa0, 0xe0, 0x41, 0x17, 0x31, 0x93, 0x90, 0x6b, 0xfe, 0x01, 0x28, 0x27, 0x42, 0x56,
+	0x6c, 0x7d, 0xe2, 0x8d, 0xd9, 0x75, 0x71, 0x7c, 0xdb, 0x09, 0xcd, 0xfe, 0x71, 0x17, 0xe9, 0xd5,
+	0xcb, 0xa9, 0x66, 0xe0, 0x4a, 0x73, 0x26, 0x4c, 0xf5, 0xf1, 0x32, 0x28, 0x39, 0x28, 0x8c, 0xb0,
+	0x47, 0x7c, 0x45, 0x8d, 0xd2, 0x4c, 0x97, 0xf8, 0x07, 0x00, 0x84, 0x11, 0x0c, 0x22, 0x2b, 0x76,
+	0x39, 0x79, 0xad, 0xa5, 0x75, 0x61, 0xcc, 0x99, 0xfb, 0x49, 0x04, 0x74, 0x85, 0x4d, 0x6b, 0x9e,
+	0xa9, 0x0f, 0xb1, 0xca, 0xd3, 0xa1, 0x5f, 0xa7, 0x49, 0x35, 0xc6, 0xf0, 0xf7, 0xc1, 0x14, 0xf2,
+	0x1c, 0x4a, 0x3e, 0xf9, 0x56, 0x72, 0x99, 0x91, 0xcf, 0x51, 0xf2, 0x04, 0x99, 0xa2, 0xbe, 0x81,
+	0x3c, 0x87, 0x10, 0xf7, 0x40, 0x05, 0xba, 0x7e, 0xcf, 0x8b, 0xe2, 0x50, 0x59, 0xad, 0x8e, 0x6f,
+	0x3f, 0xaa, 0x16, 0x89, 0x29, 0xef, 0x31, 0x53, 0x2e, 0x8e, 0x9b, 0xb2, 0xee, 0x45, 0x17, 0x03,
+	0x69, 0x89, 0xb2, 0x8f, 0xc2, 0xd3, 0x8e, 0xac, 0x7b, 0x11, 0x8b, 0x1e, 0xed, 0x32, 0x51, 0xa0,
+	0xc7, 0x3d, 0x63, 0xd1, 0xbb, 0x71, 0xed, 0xd1, 0x3b, 0x04, 0xb3, 0x87, 0xb0, 0x73, 0x84, 0xbd,
+	0x76, 0x72, 0x7c, 0x4c, 0xbd, 0xed, 0xf8, 0x78, 0x8f, 0x8d, 0x71, 0x91, 0xea, 0x65, 0xe1, 0xca,
+	0xe5, 0xb9, 0x52, 0x66, 0x2b, 0xec, 0x58, 0xd1, 0x41, 0xb1, 0xeb, 0x63, 0x2f, 0x0a, 0xab, 0xd3,
+	0x24, 0x3b, 0xd2, 0x7f, 0x9b, 0xd0, 0x8c, 0xfb, 0xf4, 0xe9, 0x58, 0x87, 0x52, 0x31, 0xa4, 0xf2,
+	0x0b, 0x07, 0xca, 0x99, 0x26, 0xfe, 0x63, 0x50, 0x88, 0x30, 0x73, 0xfd, 0x9b, 0xdf, 0x3e, 0x39,
+	0xf5, 0x2e, 0x5f, 0x35, 0x81, 0x8d, 0x0d, 0x3c, 0x7f, 0xdd, 0x03, 0x57, 0xbe, 0xcb, 0x83, 0x79,
+	0x16, 0x5d, 0x33, 0xf0, 0xbf, 0x45, 0x36, 0xc9, 0xc9, 0x55, 0xe1, 0x1d, 0x49, 0x57, 0x7e, 0x3c,
+	0x5d, 0x3b, 0xa0, 0x48, 0x0d, 0xc4, 0xce, 0xe8, 0x0f, 0xdf, 0xe8, 0xcd, 0xab, 0x0c, 0xc8, 0xf0,
+	0x63, 0x73, 0x28, 0x5c, 0xf7, 0x1c, 0xd6, 0x7e, 0xce, 0x83, 0x99, 0xf4, 0xa1, 0xc3, 0x6f, 0x80,
+	0xe5, 0xbd, 0xad, 0x1d, 0xa3, 0x76, 0xd0, 0x30, 0xac, 0xfd, 0xaf, 0x4d, 0xc3, 0x3a, 0xd8, 0xdd,
+	0x33, 0x8d, 0xad, 0xfa, 0x76, 0xdd, 0xa8, 0x55, 0x72, 0xc2, 0x4a, 0xff, 0x44, 0x5e, 0x4a, 0x03,
+	0x0e, 0xbc, 0xb0, 0x8b, 0x6c, 0xfc, 0x10, 0x23, 0x87, 0xff, 0x04, 0xdc, 0xca, 0x62, 0xb7, 0xeb,
+	0x5f, 0x19, 0x35, 0xcb, 0x34, 0x9a, 0x96, 0xde, 0xf8, 0x7c, 0xeb, 0x5e, 0x85, 0x13, 0x6e, 0xf5,
+	0x4f, 0xe4, 0xe5, 0x34, 0x7e, 0x1b, 0x3f, 0x41, 0xce, 0x30, 0x79, 0x5b, 0x40, 0xcc, 0x32, 0x98,
+	0x75, 0x63, 0xcb, 0xb8, 0x5f, 0xdf, 0x33, 0xac, 0x46, 0x7d, 0xd7, 0xd8, 0x6c, 0x56, 0xf2, 0x82,
+	0xd4, 0x3f, 0x91, 0x57, 0xd2, 0x14, 0x26, 0x46, 0x36, 0x7a, 0x8c, 0x43, 0xd4, 0xc0, 0x1e, 0x82,
+	0x01, 0xbf, 0x0e, 0x16, 0xb3, 0x24, 0x3b, 0x9b, 0x8d, 0x2f, 0xeb, 0xbb, 0x9f, 0x56, 0x26, 0x84,
+	0xa5, 0xfe, 0x89, 0xbc, 0x90, 0xc6, 0xee, 0xd0, 0x70, 0xf0, 0x2a, 0x58, 0xc8, 0x62, 0xf6, 0x37,
+	0xf5, 0x86, 0x51, 0x29, 0x08, 0x8b, 0xfd, 0x13, 0x79, 0x3e, 0x8d, 0xd8, 0x8f, 0xff, 0x8e, 0x84,
+	0xc2, 0xf7, 0x3f, 0x8a, 0x39, 0x7d, 0xf7, 0xf4, 0x2f, 0x31, 0xf7, 0xe2, 0x4c, 0xe4, 0x4e, 0xcf,
+	0x44, 0xee, 0xd5, 0x99, 0xc8, 0xfd, 0x79, 0x26, 0x72, 0x4f, 0xcf, 0xc5, 0xdc, 0xab, 0x73, 0x31,
+	0xf7, 0xdb, 0xb9, 0x98, 0xfb, 0xe6, 0x83, 0x36, 0x8e, 0x0e, 0x7b, 0x2d, 0xd5, 0xf6, 0x5d, 0x8d,
+	0x06, 0xed, 0x4e, 0x07, 0xb6, 0x42, 0x76, 0xad, 0x3d, 0x49, 0x3e, 0x8a, 0xc8, 0x17, 0x51, 0xab,
+	0x48, 0x02, 0xf3, 0xd1, 0xbf, 0x03, 0x00, 0x27, 0x26, 0x1c, 0xe9, 0x32, 0x09, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -147,6 +378,127 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.ReleaseEnabled != that1.ReleaseEnabled {
 		return false
 	}
+	if len(this.ReleaseSchedules) != len(that1.ReleaseSchedules) {
+		return false
+	}
+	for i := range this.ReleaseSchedules {
+		if !this.ReleaseSchedules[i].Equal(&that1.ReleaseSchedules[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ReleaseSchedule) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ReleaseSchedule)
+	if !ok {
+		that2, ok := that.(ReleaseSchedule)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.ScheduleType != that1.ScheduleType {
+		return false
+	}
+	if this.Destination != that1.Destination {
+		return false
+	}
+	if !this.StartTime.Equal(that1.StartTime) {
+		return false
+	}
+	if !this.EndTime.Equal(that1.EndTime) {
+		return false
+	}
+	if !this.AmountPerBlock.Equal(that1.AmountPerBlock) {
+		return false
+	}
+	if !this.ReleaseRate.Equal(that1.ReleaseRate) {
+		return false
+	}
+	if this.HalvingPeriod != that1.HalvingPeriod {
+		return false
+	}
+	if len(this.Points) != len(that1.Points) {
+		return false
+	}
+	for i := range this.Points {
+		if !this.Points[i].Equal(&that1.Points[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *SchedulePoint) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SchedulePoint)
+	if !ok {
+		that2, ok := that.(SchedulePoint)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Time.Equal(that1.Time) {
+		return false
+	}
+	if !this.ReleaseRate.Equal(that1.ReleaseRate) {
+		return false
+	}
+	return true
+}
+func (this *ReleaseProjection) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ReleaseProjection)
+	if !ok {
+		that2, ok := that.(ReleaseProjection)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Destination != that1.Destination {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	if !this.ReleaseRate.Equal(that1.ReleaseRate) {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -169,6 +521,20 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ReleaseSchedules) > 0 {
+		for iNdEx := len(m.ReleaseSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReleaseSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if m.ReleaseEnabled {
 		i--
 		if m.ReleaseEnabled {
@@ -217,44 +583,318 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ReleaseSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ReleaseSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReleaseSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.RewardDenom)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.Points) > 0 {
+		for iNdEx := len(m.Points) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Points[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DilutionPeriod)
-	n += 1 + l + sovTypes(uint64(l))
-	l = m.ReleaseRate.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = m.DilutionRate.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	if m.ReleaseEnabled {
-		n += 2
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.HalvingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.HalvingPeriod):])
+	if err2 != nil {
+		return 0, err2
 	}
-	return n
+	i -= n2
+	i = encodeVarintTypes(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.ReleaseRate.Size()
+		i -= size
+		if _, err := m.ReleaseRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.AmountPerBlock.Size()
+		i -= size
+		if _, err := m.AmountPerBlock.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTypes(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x2a
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintTypes(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ScheduleType != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ScheduleType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *SchedulePoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *SchedulePoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SchedulePoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ReleaseRate.Size()
+		i -= size
+		if _, err := m.ReleaseRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintTypes(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ReleaseProjection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReleaseProjection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReleaseProjection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ReleaseRate.Size()
+		i -= size
+		if _, err := m.ReleaseRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RewardDenom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DilutionPeriod)
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.ReleaseRate.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.DilutionRate.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.ReleaseEnabled {
+		n += 2
+	}
+	if len(m.ReleaseSchedules) > 0 {
+		for _, e := range m.ReleaseSchedules {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ReleaseSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ScheduleType != 0 {
+		n += 1 + sovTypes(uint64(m.ScheduleType))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTypes(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.AmountPerBlock.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.ReleaseRate.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.HalvingPeriod)
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.Points) > 0 {
+		for _, e := range m.Points {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SchedulePoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.ReleaseRate.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *ReleaseProjection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.ReleaseRate.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -438,6 +1078,673 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.ReleaseEnabled = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReleaseSchedules = append(m.ReleaseSchedules, ReleaseSchedule{})
+			if err := m.ReleaseSchedules[len(m.ReleaseSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReleaseSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReleaseSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReleaseSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleType", wireType)
+			}
+			m.ScheduleType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduleType |= ScheduleType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountPerBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountPerBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReleaseRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HalvingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.HalvingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Points", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Points = append(m.Points, SchedulePoint{})
+			if err := m.Points[len(m.Points)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SchedulePoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SchedulePoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SchedulePoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReleaseRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReleaseProjection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReleaseProjection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReleaseProjection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReleaseRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
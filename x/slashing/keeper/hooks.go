@@ -6,6 +6,7 @@ import (
 
 	"github.com/cometbft/cometbft/crypto"
 
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
 
@@ -96,3 +97,14 @@ func (h Hooks) BeforeValidatorSlashed(_ context.Context, _ sdk.ValAddress, _ sdk
 func (h Hooks) AfterUnbondingInitiated(_ context.Context, _ uint64) error {
 	return nil
 }
+
+// AfterConsensusPubKeyUpdate adds the address-pubkey relation of the new consensus
+// pubkey and moves the signing info to the new consensus address. The relation of
+// the old pubkey is kept, so evidence against the old key can still be handled.
+func (h Hooks) AfterConsensusPubKeyUpdate(ctx context.Context, _ sdk.ValAddress, oldPubKey, newPubKey cryptotypes.PubKey, _ sdk.Coins) error {
+	if err := h.k.AddPubkey(ctx, newPubKey); err != nil {
+		return err
+	}
+
+	return h.k.moveValidatorSigningInfo(ctx, sdk.ConsAddress(oldPubKey.Address()), sdk.ConsAddress(newPubKey.Address()))
+}
@@ -20,16 +20,20 @@ func (k Keeper) HandleValidatorSignature(ctx context.Context, addr cryptotypes.A
 	logger := k.Logger(ctx)
 	height := sdkCtx.BlockHeight()
 
-	// fetch the validator public key
-	consAddr := sdk.ConsAddress(addr)
+	// fetch the validator; it can still sign with its old consensus key for a
+	// few blocks after rotating it, so use its current consensus address
+	validator, err := k.sk.ValidatorByConsAddr(ctx, sdk.ConsAddress(addr))
+	if err != nil {
+		return err
+	}
 
-	// don't update missed blocks when validator's jailed
-	isJailed, err := k.sk.IsValidatorJailed(ctx, consAddr)
+	consAddr, err := validator.GetConsAddr()
 	if err != nil {
 		return err
 	}
 
-	if isJailed {
+	// don't update missed blocks when validator's jailed
+	if validator.IsJailed() {
 		return nil
 	}
 
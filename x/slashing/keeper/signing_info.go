@@ -113,6 +113,55 @@ func (k Keeper) IsTombstoned(ctx context.Context, consAddr sdk.ConsAddress) bool
 	return signInfo.Tombstoned
 }
 
+// moveValidatorSigningInfo moves the signing info and the missed block bitmap
+// of a validator from the old consensus address to the new one.
+func (k Keeper) moveValidatorSigningInfo(ctx context.Context, oldAddr, newAddr sdk.ConsAddress) error {
+	info, err := k.GetValidatorSigningInfo(ctx, oldAddr)
+	if errors.IsOf(err, types.ErrNoSigningInfoFound) {
+		// the validator has never been bonded
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	info.Address = newAddr.String()
+	if err := k.SetValidatorSigningInfo(ctx, newAddr, info); err != nil {
+		return err
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.ValidatorSigningInfoKey(oldAddr)); err != nil {
+		return err
+	}
+
+	oldPrefix := types.ValidatorMissedBlockBitmapPrefixKey(oldAddr)
+	newPrefix := types.ValidatorMissedBlockBitmapPrefixKey(newAddr)
+	iter, err := store.Iterator(oldPrefix, storetypes.PrefixEndBytes(oldPrefix))
+	if err != nil {
+		return err
+	}
+
+	// collect the chunks first to avoid writing to the store while iterating it
+	var keys, chunks [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, append(append([]byte{}, newPrefix...), iter.Key()[len(oldPrefix):]...))
+		chunks = append(chunks, iter.Value())
+	}
+	iter.Close()
+
+	if err := k.DeleteMissedBlockBitmap(ctx, oldAddr); err != nil {
+		return err
+	}
+
+	for i, key := range keys {
+		if err := store.Set(key, chunks[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // getMissedBlockBitmapChunk gets the bitmap chunk at the given chunk index for
 // a validator's missed block signing window.
 func (k Keeper) getMissedBlockBitmapChunk(ctx context.Context, addr sdk.ConsAddress, chunkIndex int64) ([]byte, error) {